@@ -2,6 +2,8 @@ package distsys
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/UBC-NSS/pgo/distsys/tla"
 	"github.com/benbjohnson/immutable"
 )
@@ -27,6 +29,17 @@ func (iface ArchetypeInterface) ensureCriticalSectionWith(handle ArchetypeResour
 // Write models the MPCal statement resourceFromHandle[indices...] := value.
 // It is expected to be called only from PGo-generated code.
 func (iface ArchetypeInterface) Write(handle ArchetypeResourceHandle, indices []tla.TLAValue, value tla.TLAValue) (err error) {
+	if len(iface.ctx.observers) != 0 {
+		start := time.Now()
+		defer func() { iface.ctx.observeResourceOperation(handle, ResourceWrite, err, start) }()
+	}
+	if iface.ctx.replay != nil && iface.ctx.isExternal(handle) {
+		_, err = iface.ctx.replayAccess(RecordedWrite, handle, indices, value)
+		return
+	}
+	if iface.ctx.recording != nil && iface.ctx.isExternal(handle) {
+		defer func() { iface.ctx.recordAccess(RecordedWrite, handle, indices, value, err) }()
+	}
 	iface.ensureCriticalSectionWith(handle)
 	res := iface.ctx.getResourceByHandle(handle)
 	for _, index := range indices {
@@ -35,6 +48,9 @@ func (iface ArchetypeInterface) Write(handle ArchetypeResourceHandle, indices []
 			return
 		}
 	}
+	if iface.ctx.span != nil {
+		iface.ctx.traceWrite(res)
+	}
 	err = res.WriteValue(value)
 	return
 }
@@ -42,6 +58,16 @@ func (iface ArchetypeInterface) Write(handle ArchetypeResourceHandle, indices []
 // Read models the MPCal expression resourceFromHandle[indices...].
 // If is expected to be called only from PGo-generated code.
 func (iface ArchetypeInterface) Read(handle ArchetypeResourceHandle, indices []tla.TLAValue) (value tla.TLAValue, err error) {
+	if len(iface.ctx.observers) != 0 {
+		start := time.Now()
+		defer func() { iface.ctx.observeResourceOperation(handle, ResourceRead, err, start) }()
+	}
+	if iface.ctx.replay != nil && iface.ctx.isExternal(handle) {
+		return iface.ctx.replayAccess(RecordedRead, handle, indices, tla.TLAValue{})
+	}
+	if iface.ctx.recording != nil && iface.ctx.isExternal(handle) {
+		defer func() { iface.ctx.recordAccess(RecordedRead, handle, indices, value, err) }()
+	}
 	iface.ensureCriticalSectionWith(handle)
 	res := iface.ctx.getResourceByHandle(handle)
 	for _, index := range indices {
@@ -51,12 +77,25 @@ func (iface ArchetypeInterface) Read(handle ArchetypeResourceHandle, indices []t
 		}
 	}
 	value, err = res.ReadValue()
+	if err == nil && iface.ctx.span != nil {
+		iface.ctx.traceRead(res)
+	}
 	return
 }
 
 // NextFairnessCounter returns an int, which, from call to call, for the same id, follows the looping sequence 0..ceiling
 // This allows an archetype to explore different branches of an either statement (each of which has its own id) during execution.
-func (iface ArchetypeInterface) NextFairnessCounter(id string, ceiling int) int {
+// If the context was configured WithBranchChooser, the chooser decides instead.
+func (iface ArchetypeInterface) NextFairnessCounter(id string, ceiling int) (branch int) {
+	if iface.ctx.replay != nil {
+		return iface.ctx.replayBranch(id, ceiling)
+	}
+	if iface.ctx.recording != nil {
+		defer func() { iface.ctx.recordBranch(id, branch) }()
+	}
+	if iface.ctx.branchChooser != nil {
+		return iface.ctx.branchChooser(id, ceiling)
+	}
 	fairnessCounters := iface.ctx.fairnessCounters
 	counter := fairnessCounters[id]
 	var nextCounter int
@@ -79,6 +118,21 @@ func (iface ArchetypeInterface) GetConstant(name string) func(args ...tla.TLAVal
 	return fn
 }
 
+// MemoizeDefinition returns the value of the TLA+ definition with the given name, computing it with compute only the
+// first time it is needed. PGo-generated code uses it for definitions without arguments that depend only on constants,
+// and so always have the same value for a given context, so that sets such as MSG_TYP_SET are not rebuilt in every
+// critical section. Definitions referring to constant operators, that is constants with arguments, are not memoized,
+// since the Go funcs given to DefineConstantOperator are called every time they are evaluated. The name must identify
+// the definition among all those evaluated with the context, and so includes the Go package of the definition.
+// Concurrent calls may each compute the value, but all return the same one.
+func (iface ArchetypeInterface) MemoizeDefinition(name string, compute func(iface ArchetypeInterface) tla.TLAValue) tla.TLAValue {
+	if value, ok := iface.ctx.memoizedDefns.Load(name); ok {
+		return value.(tla.TLAValue)
+	}
+	value, _ := iface.ctx.memoizedDefns.LoadOrStore(name, compute(iface))
+	return value.(tla.TLAValue)
+}
+
 // RequireArchetypeResource returns a handle to the archetype resource with the given name. It panics if this resource
 // does not exist.
 func (iface ArchetypeInterface) RequireArchetypeResource(name string) ArchetypeResourceHandle {
@@ -102,14 +156,19 @@ func (iface ArchetypeInterface) RequireArchetypeResourceRef(name string) (Archet
 // EnsureArchetypeResourceLocal ensures that a local state variable exists (local to an archetype or procedure), creating
 // it with the given default value if not.
 func (iface ArchetypeInterface) EnsureArchetypeResourceLocal(name string, value tla.TLAValue) {
-	_ = iface.ctx.ensureArchetypeResource(name, LocalArchetypeResourceMaker(value))
+	_ = iface.ctx.ensureArchetypeResource(name, iface.ctx.localArchetypeResourceMaker(name, value))
 }
 
 // ReadArchetypeResourceLocal is a short-cut to reading a local state variable, which, unlike other resources, is
 // statically known to not require any critical section management. It will return the resource's value as-is, and
 // will crash if the named resource isn't exactly a local state variable.
 func (iface ArchetypeInterface) ReadArchetypeResourceLocal(name string) tla.TLAValue {
-	return iface.ctx.getResourceByHandle(ArchetypeResourceHandle(name)).(*LocalArchetypeResource).value
+	switch res := iface.ctx.getResourceByHandle(ArchetypeResourceHandle(name)).(type) {
+	case *PersistentLocalArchetypeResource:
+		return res.value
+	default:
+		return res.(*LocalArchetypeResource).value
+	}
 }
 
 func (iface ArchetypeInterface) getCriticalSection(name string) MPCalCriticalSection {
@@ -129,6 +188,9 @@ func (iface ArchetypeInterface) getProc(name string) MPCalProc {
 var defaultLocalArchetypeResourceMaker = LocalArchetypeResourceMaker(tla.TLAValue{})
 
 func (iface ArchetypeInterface) ensureArchetypeResourceLocalWithDefault(name string) ArchetypeResourceHandle {
+	if iface.ctx.localStateLog != nil {
+		return iface.ctx.ensureArchetypeResource(name, iface.ctx.localArchetypeResourceMaker(name, tla.TLAValue{}))
+	}
 	return iface.ctx.ensureArchetypeResource(name, defaultLocalArchetypeResourceMaker)
 }
 
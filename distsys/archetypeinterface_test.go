@@ -0,0 +1,58 @@
+package distsys
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func TestMemoizeDefinition(t *testing.T) {
+	var computations int32
+	// as PGo would generate for NODE_SET == 1..NUM_NODES
+	nodeSet := func(iface ArchetypeInterface) tla.TLAValue {
+		return iface.MemoizeDefinition("test.NODE_SET", func(iface ArchetypeInterface) tla.TLAValue {
+			atomic.AddInt32(&computations, 1)
+			return tla.TLA_DotDotSymbol(tla.MakeTLANumber(1), iface.GetConstant("NUM_NODES")())
+		})
+	}
+	expectNodeSet := func(iface ArchetypeInterface, numNodes int32, expectedComputations int32) {
+		t.Helper()
+		expected := tla.TLA_DotDotSymbol(tla.MakeTLANumber(1), tla.MakeTLANumber(numNodes))
+		if value := nodeSet(iface); !value.Equal(expected) {
+			t.Errorf("expected %v, got %v", expected, value)
+		}
+		if n := atomic.LoadInt32(&computations); n != expectedComputations {
+			t.Errorf("expected the definition to have been computed %d times, but it was computed %d times", expectedComputations, n)
+		}
+	}
+
+	ctx := NewMPCalContextWithoutArchetype(DefineConstantValue("NUM_NODES", tla.MakeTLANumber(3)))
+	expectNodeSet(ctx.IFace(), 3, 1)
+	expectNodeSet(ctx.IFace(), 3, 1)
+
+	// a fresh context computes the value again, from its own constants
+	other := NewMPCalContextWithoutArchetype(DefineConstantValue("NUM_NODES", tla.MakeTLANumber(5)))
+	expectNodeSet(other.IFace(), 5, 2)
+	expectNodeSet(ctx.IFace(), 3, 2)
+
+	// concurrent first calls may each compute the value, but all return the same one
+	concurrent := NewMPCalContextWithoutArchetype(DefineConstantValue("NUM_NODES", tla.MakeTLANumber(7)))
+	values := make([]tla.TLAValue, 8)
+	var wg sync.WaitGroup
+	for i := range values {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values[i] = nodeSet(concurrent.IFace())
+		}()
+	}
+	wg.Wait()
+	for _, value := range values {
+		if value.Hash() != values[0].Hash() || !value.Equal(values[0]) {
+			t.Errorf("concurrent calls returned %v and %v", values[0], value)
+		}
+	}
+}
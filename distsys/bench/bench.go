@@ -0,0 +1,366 @@
+// Package bench generates load on running archetypes through their input channels, and measures how they cope:
+// end-to-end latency percentiles, as observed on their output channels, throughput, and the allocation and garbage
+// collection work done by the process meanwhile. It makes performance regressions in the runtime and resources
+// measurable. For example:
+//
+//	targets := []bench.Target{{Input: inChan, Output: outChan}}
+//	result, err := bench.Run(targets, func(i int) tla.TLAValue {
+//		return makeRequest(i)
+//	}, bench.WithRate(1000), bench.WithDuration(30*time.Second))
+//	fmt.Println(result)
+//
+// Each target is typically the input and output channels of one client archetype, which must respond to the
+// requests it reads with exactly one output each, in order.
+package bench
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ErrNoTargets is returned by Run when it is given no targets to send requests to.
+var ErrNoTargets = errors.New("no targets to benchmark")
+
+const (
+	defaultDuration = 10 * time.Second
+	defaultTimeout  = 10 * time.Second
+)
+
+// Target is a pair of channels through which a client archetype is driven: requests are sent to Input, and the
+// response to each is expected, in order, from Output.
+type Target struct {
+	Input  chan<- tla.TLAValue
+	Output <-chan tla.TLAValue
+}
+
+type config struct {
+	rate        float64
+	outstanding int
+	requests    int
+	duration    time.Duration
+	warmup      int
+	timeout     time.Duration
+}
+
+// Option configures Run.
+type Option func(cfg *config)
+
+// WithRate sends requests at a fixed total rate, in requests per second, spread evenly over the targets, whether or
+// not earlier requests have been answered, so that latency includes any queueing. By default, requests are sent as
+// fast as the targets answer them, as limited by WithOutstanding.
+func WithRate(requestsPerSecond float64) Option {
+	return func(cfg *config) {
+		cfg.rate = requestsPerSecond
+	}
+}
+
+// WithOutstanding sets how many requests may await a response from each target at once, one by default. When
+// sending at a fixed rate, requests are delayed once the limit is reached, which then counts towards their latency.
+func WithOutstanding(n int) Option {
+	return func(cfg *config) {
+		cfg.outstanding = n
+	}
+}
+
+// WithRequests stops sending after n requests in total, rather than after a duration.
+func WithRequests(n int) Option {
+	return func(cfg *config) {
+		cfg.requests = n
+	}
+}
+
+// WithDuration stops sending after d, ten seconds by default. It is ignored if WithRequests is given.
+func WithDuration(d time.Duration) Option {
+	return func(cfg *config) {
+		cfg.duration = d
+	}
+}
+
+// WithWarmup leaves the first n responses out of the results, so that connection setup and other one-off costs do
+// not skew them.
+func WithWarmup(n int) Option {
+	return func(cfg *config) {
+		cfg.warmup = n
+	}
+}
+
+// WithTimeout sets how long to wait for outstanding responses once sending has stopped, ten seconds by default.
+// Responses that do not arrive in time are counted as lost.
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *config) {
+		cfg.timeout = timeout
+	}
+}
+
+// Result holds the measurements of a benchmark run.
+type Result struct {
+	Sent     int           // requests sent, including warmup
+	Received int           // responses received, including warmup
+	Lost     int           // requests whose response did not arrive before the timeout
+	Elapsed  time.Duration // from the first request sent to the last response received
+	// Throughput is the rate of responses, in responses per second, over Elapsed.
+	Throughput float64
+
+	// Latencies of the measured requests, from sending the request to receiving the response.
+	Mean, P50, P90, P99, P999, Max time.Duration
+
+	// Mallocs, AllocBytes and NumGC are the heap allocations, bytes allocated and garbage collections performed by
+	// the whole process during the run, and GCPause the total time the world was stopped for garbage collection.
+	Mallocs    uint64
+	AllocBytes uint64
+	NumGC      uint32
+	GCPause    time.Duration
+}
+
+func (result Result) String() string {
+	var builder strings.Builder
+	_, _ = fmt.Fprintf(&builder, "%d sent, %d received, %d lost in %v (%.1f/s)\n", result.Sent, result.Received, result.Lost, result.Elapsed, result.Throughput)
+	_, _ = fmt.Fprintf(&builder, "latency: mean %v, p50 %v, p90 %v, p99 %v, p99.9 %v, max %v\n", result.Mean, result.P50, result.P90, result.P99, result.P999, result.Max)
+	allocsPerOp := 0.0
+	if result.Received > 0 {
+		allocsPerOp = float64(result.Mallocs) / float64(result.Received)
+	}
+	_, _ = fmt.Fprintf(&builder, "memory: %d allocs (%.1f/op), %d bytes, %d GCs, %v GC pause", result.Mallocs, allocsPerOp, result.AllocBytes, result.NumGC, result.GCPause)
+	return builder.String()
+}
+
+// ReportMetrics reports the result's throughput, latency percentiles and allocations as custom metrics of b, so
+// that they show up in the output of go test -bench, and can be compared with benchstat.
+func ReportMetrics(b *testing.B, result Result) {
+	b.ReportMetric(result.Throughput, "ops/s")
+	b.ReportMetric(float64(result.P50.Nanoseconds()), "p50-ns")
+	b.ReportMetric(float64(result.P99.Nanoseconds()), "p99-ns")
+	if result.Received > 0 {
+		b.ReportMetric(float64(result.Mallocs)/float64(result.Received), "allocs/op")
+	}
+}
+
+// percentile returns the p-th percentile of sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(p * float64(len(sorted)-1))
+	return sorted[i]
+}
+
+// Run sends requests to targets, as made by request from a sequence number starting at 0, and measures the
+// responses, as configured by opts. It returns once sending has stopped and all responses have arrived, or the
+// timeout has passed. Responses are not checked; they are only counted.
+func Run(targets []Target, request func(i int) tla.TLAValue, opts ...Option) (Result, error) {
+	cfg := config{
+		outstanding: 1,
+		duration:    defaultDuration,
+		timeout:     defaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if len(targets) == 0 {
+		return Result{}, ErrNoTargets
+	}
+	if cfg.outstanding < 1 {
+		cfg.outstanding = 1
+	}
+
+	r := &run{
+		config:   cfg,
+		request:  request,
+		stopSend: make(chan struct{}),
+		stopRecv: make(chan struct{}),
+	}
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	r.start = time.Now()
+	if cfg.requests == 0 {
+		time.AfterFunc(cfg.duration, r.stopSending)
+	}
+
+	var sendGroup, recvGroup sync.WaitGroup
+	for _, target := range targets {
+		// slots holds a token per request awaiting a response, so that sendTimes never fills up
+		slots := make(chan struct{}, cfg.outstanding)
+		sendTimes := make(chan time.Time, cfg.outstanding)
+		var interval time.Duration
+		if cfg.rate > 0 {
+			interval = time.Duration(float64(time.Second) * float64(len(targets)) / cfg.rate)
+		}
+		sendGroup.Add(1)
+		go func(target Target) {
+			defer sendGroup.Done()
+			defer close(sendTimes)
+			r.send(target, slots, sendTimes, interval)
+		}(target)
+		recvGroup.Add(1)
+		go func(target Target) {
+			defer recvGroup.Done()
+			r.receive(target, slots, sendTimes)
+		}(target)
+	}
+	sendGroup.Wait()
+	recvDone := make(chan struct{})
+	go func() {
+		recvGroup.Wait()
+		close(recvDone)
+	}()
+	select {
+	case <-recvDone:
+	case <-time.After(cfg.timeout):
+		close(r.stopRecv)
+		<-recvDone
+	}
+	runtime.ReadMemStats(&after)
+	return r.result(before, after), nil
+}
+
+type run struct {
+	config  config
+	request func(i int) tla.TLAValue
+	start   time.Time
+
+	stopSend     chan struct{}
+	stopSendOnce sync.Once
+	stopRecv     chan struct{}
+
+	lock         sync.Mutex
+	sent         int
+	received     int
+	lost         int
+	lastReceived time.Time
+	latencies    []time.Duration
+}
+
+func (r *run) stopSending() {
+	r.stopSendOnce.Do(func() {
+		close(r.stopSend)
+	})
+}
+
+// next returns the sequence number of the next request to send, or false if sending should stop.
+func (r *run) next() (int, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	select {
+	case <-r.stopSend:
+		return 0, false
+	default:
+	}
+	if r.config.requests > 0 && r.sent >= r.config.requests {
+		return 0, false
+	}
+	i := r.sent
+	r.sent++
+	return i, true
+}
+
+func (r *run) send(target Target, slots chan struct{}, sendTimes chan<- time.Time, interval time.Duration) {
+	var ticker *time.Ticker
+	if interval > 0 {
+		ticker = time.NewTicker(interval)
+		defer ticker.Stop()
+	}
+	for {
+		if ticker != nil {
+			select {
+			case <-ticker.C:
+			case <-r.stopSend:
+				return
+			}
+		}
+		i, ok := r.next()
+		if !ok {
+			return
+		}
+		// the latency of a request includes any wait for an outstanding slot, and for the target to read it
+		sentAt := time.Now()
+		select {
+		case slots <- struct{}{}:
+		case <-r.stopSend:
+			r.unsend()
+			return
+		}
+		select {
+		case target.Input <- r.request(i):
+		case <-r.stopSend:
+			<-slots
+			r.unsend()
+			return
+		}
+		sendTimes <- sentAt
+	}
+}
+
+func (r *run) unsend() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.sent--
+}
+
+func (r *run) receive(target Target, slots <-chan struct{}, sendTimes <-chan time.Time) {
+	for sentAt := range sendTimes {
+		select {
+		case <-target.Output:
+			now := time.Now()
+			<-slots
+			r.lock.Lock()
+			r.received++
+			r.lastReceived = now
+			if r.received > r.config.warmup {
+				r.latencies = append(r.latencies, now.Sub(sentAt))
+			}
+			r.lock.Unlock()
+		case <-r.stopRecv:
+			r.lock.Lock()
+			r.lost++
+			for range sendTimes {
+				r.lost++
+			}
+			r.lock.Unlock()
+			return
+		}
+	}
+}
+
+func (r *run) result(before, after runtime.MemStats) Result {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	result := Result{
+		Sent:       r.sent,
+		Received:   r.received,
+		Lost:       r.lost,
+		Mallocs:    after.Mallocs - before.Mallocs,
+		AllocBytes: after.TotalAlloc - before.TotalAlloc,
+		NumGC:      after.NumGC - before.NumGC,
+		GCPause:    time.Duration(after.PauseTotalNs - before.PauseTotalNs),
+	}
+	if r.received > 0 {
+		result.Elapsed = r.lastReceived.Sub(r.start)
+		result.Throughput = float64(r.received) / result.Elapsed.Seconds()
+	}
+	latencies := r.latencies
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+	if len(latencies) > 0 {
+		var total time.Duration
+		for _, latency := range latencies {
+			total += latency
+		}
+		result.Mean = total / time.Duration(len(latencies))
+		result.Max = latencies[len(latencies)-1]
+	}
+	result.P50 = percentile(latencies, 0.5)
+	result.P90 = percentile(latencies, 0.9)
+	result.P99 = percentile(latencies, 0.99)
+	result.P999 = percentile(latencies, 0.999)
+	return result
+}
@@ -0,0 +1,100 @@
+package bench
+
+import (
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// benchTestAEcho outputs each value it reads from its input, as a client archetype answering requests would.
+var benchTestAEcho = distsys.MPCalArchetype{
+	Name:              "AEcho",
+	Label:             "AEcho.loop",
+	RequiredRefParams: []string{"AEcho.in", "AEcho.out"},
+	RequiredValParams: []string{},
+	JumpTable: distsys.MakeMPCalJumpTable(distsys.MPCalCriticalSection{
+		Name: "AEcho.loop",
+		Body: func(iface distsys.ArchetypeInterface) error {
+			in, err := iface.RequireArchetypeResourceRef("AEcho.in")
+			if err != nil {
+				return err
+			}
+			out, err := iface.RequireArchetypeResourceRef("AEcho.out")
+			if err != nil {
+				return err
+			}
+			value, err := iface.Read(in, nil)
+			if err != nil {
+				return err
+			}
+			if err := iface.Write(out, nil, value); err != nil {
+				return err
+			}
+			return iface.Goto("AEcho.loop")
+		},
+	}),
+	ProcTable: distsys.MakeMPCalProcTable(),
+	PreAmble:  func(distsys.ArchetypeInterface) {},
+}
+
+// startBenchTestEchoes runs n echo archetypes until the test ends, and returns their channels as targets.
+func startBenchTestEchoes(tb testing.TB, n int) []Target {
+	var targets []Target
+	for self := int32(1); self <= int32(n); self++ {
+		inCh, outCh := make(chan tla.TLAValue, 1), make(chan tla.TLAValue, 1)
+		ctx := distsys.NewMPCalContext(tla.MakeTLANumber(self), benchTestAEcho,
+			distsys.EnsureArchetypeRefParam("in", resources.InputChannelMaker(inCh)),
+			distsys.EnsureArchetypeRefParam("out", resources.OutputChannelMaker(outCh)))
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- ctx.Run()
+		}()
+		tb.Cleanup(func() {
+			if err := ctx.Close(); err != nil {
+				tb.Error(err)
+			}
+			if err := <-errCh; err != distsys.ErrContextClosed {
+				tb.Errorf("expected the echo archetype to run until closed, got %v", err)
+			}
+		})
+		targets = append(targets, Target{Input: inCh, Output: outCh})
+	}
+	return targets
+}
+
+func TestRun(t *testing.T) {
+	if _, err := Run(nil, nil); err != ErrNoTargets {
+		t.Fatalf("expected running without targets to fail, got %v", err)
+	}
+
+	result, err := Run(startBenchTestEchoes(t, 2), func(i int) tla.TLAValue {
+		return tla.MakeTLANumber(int32(i))
+	}, WithRequests(100), WithOutstanding(4), WithWarmup(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Sent != 100 || result.Received != 100 || result.Lost != 0 {
+		t.Fatalf("expected all 100 requests to be answered, got %v", result)
+	}
+	if result.Throughput <= 0 || result.P50 <= 0 || result.P50 > result.P99 || result.P99 > result.Max {
+		t.Fatalf("expected ordered, positive latencies, got %v", result)
+	}
+}
+
+func BenchmarkEcho(b *testing.B) {
+	targets := startBenchTestEchoes(b, 4)
+	b.ResetTimer()
+	result, err := Run(targets, func(i int) tla.TLAValue {
+		return tla.MakeTLANumber(int32(i))
+	}, WithRequests(b.N), WithOutstanding(8))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.StopTimer()
+	if result.Lost != 0 {
+		b.Fatalf("expected every request to be answered, got %v", result)
+	}
+	ReportMetrics(b, result)
+}
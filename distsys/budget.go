@@ -0,0 +1,77 @@
+package distsys
+
+import (
+	"runtime"
+	"time"
+)
+
+// ExecutionBudget limits how much work a single archetype may do per Interval, so that a busy archetype sharing a
+// process with others cannot starve them. Once either limit is reached, the archetype is paused until the current
+// interval ends. Zero-valued limits are not enforced.
+type ExecutionBudget struct {
+	Interval            time.Duration // length of each budgeting interval; must be positive
+	MaxCriticalSections int           // max critical sections attempted per interval, including aborted ones
+	MaxExecutionTime    time.Duration // max time spent executing and committing critical sections per interval
+}
+
+type executionBudgetState struct {
+	budget ExecutionBudget
+
+	intervalStart    time.Time
+	criticalSections int
+	executionTime    time.Duration
+}
+
+// WithExecutionBudget configures an MPCalContext to respect the given ExecutionBudget while running. The time a
+// critical section takes includes rolling back its resources if it aborts. Independently of the limits, an
+// archetype with a budget will also yield the processor between critical sections, giving co-located archetypes a
+// chance to run.
+func WithExecutionBudget(budget ExecutionBudget) MPCalContextConfigFn {
+	if budget.Interval <= 0 {
+		panic("execution budget interval must be positive")
+	}
+	return func(ctx *MPCalContext) {
+		ctx.budget = &executionBudgetState{budget: budget}
+	}
+}
+
+func (state *executionBudgetState) exhausted() bool {
+	budget := state.budget
+	return (budget.MaxCriticalSections > 0 && state.criticalSections >= budget.MaxCriticalSections) ||
+		(budget.MaxExecutionTime > 0 && state.executionTime >= budget.MaxExecutionTime)
+}
+
+// await blocks until the archetype is allowed to execute another critical section. It returns false if the done
+// channel was signalled while waiting.
+func (state *executionBudgetState) await(done <-chan struct{}) bool {
+	now := time.Now()
+	if now.Sub(state.intervalStart) >= state.budget.Interval {
+		state.intervalStart = now
+		state.criticalSections = 0
+		state.executionTime = 0
+	}
+	if state.exhausted() {
+		select {
+		case <-time.After(state.intervalStart.Add(state.budget.Interval).Sub(now)):
+		case <-done:
+			return false
+		}
+		state.intervalStart = time.Now()
+		state.criticalSections = 0
+		state.executionTime = 0
+	} else {
+		runtime.Gosched()
+	}
+	return true
+}
+
+// record accounts for one critical section that started at the given time.
+func (state *executionBudgetState) record(start time.Time) {
+	state.criticalSections++
+	state.executionTime += time.Since(start)
+}
+
+// recordAbort accounts for aborting the last critical section recorded, which started at the given time.
+func (state *executionBudgetState) recordAbort(start time.Time) {
+	state.executionTime += time.Since(start)
+}
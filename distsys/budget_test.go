@@ -0,0 +1,84 @@
+package distsys
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// slowAbortResource is a local resource whose aborts take abortDelay.
+type slowAbortResource struct {
+	LocalArchetypeResource
+	abortDelay time.Duration
+}
+
+func (res *slowAbortResource) Abort() chan struct{} {
+	time.Sleep(res.abortDelay)
+	return res.LocalArchetypeResource.Abort()
+}
+
+// runBudgetTestArchetype runs an archetype for duration, whose only critical section, run over and over, writes to
+// a resource whose aborts take abortDelay, and then returns result. It returns how many critical sections ran.
+func runBudgetTestArchetype(t *testing.T, budget ExecutionBudget, abortDelay time.Duration, result error, duration time.Duration) int32 {
+	t.Helper()
+	var runs int32
+	archetype := MPCalArchetype{
+		Name:              "ABudget",
+		Label:             "ABudget.loop",
+		RequiredRefParams: []string{"ABudget.res"},
+		RequiredValParams: []string{},
+		JumpTable: MakeMPCalJumpTable(MPCalCriticalSection{
+			Name: "ABudget.loop",
+			Body: func(iface ArchetypeInterface) error {
+				atomic.AddInt32(&runs, 1)
+				res, err := iface.RequireArchetypeResourceRef("ABudget.res")
+				if err != nil {
+					return err
+				}
+				if err := iface.Write(res, nil, tla.MakeTLANumber(1)); err != nil {
+					return err
+				}
+				return result
+			},
+		}),
+		ProcTable: MakeMPCalProcTable(),
+		PreAmble:  func(ArchetypeInterface) {},
+	}
+	ctx := NewMPCalContext(tla.MakeTLANumber(1), archetype,
+		EnsureArchetypeRefParam("res", ArchetypeResourceMakerFn(func() ArchetypeResource {
+			return &slowAbortResource{abortDelay: abortDelay}
+		})),
+		WithExecutionBudget(budget))
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ctx.Run()
+	}()
+	time.Sleep(duration)
+	if err := ctx.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errCh; err != ErrContextClosed {
+		t.Fatalf("expected the archetype to run until closed, got %v", err)
+	}
+	return atomic.LoadInt32(&runs)
+}
+
+func TestExecutionBudget(t *testing.T) {
+	// 5 critical sections in each of the intervals starting at 0, 100 and 200ms
+	runs := runBudgetTestArchetype(t, ExecutionBudget{Interval: 100 * time.Millisecond, MaxCriticalSections: 5}, 0, nil, 250*time.Millisecond)
+	if runs < 5 || runs > 15 {
+		t.Fatalf("expected at most 5 critical sections per interval, but %d ran in 3 intervals", runs)
+	}
+}
+
+func TestExecutionBudgetAbort(t *testing.T) {
+	// the critical sections themselves take no time, but each abort takes 20ms, so only 2 fit in the 30ms allowed by
+	// each of the intervals starting at 0 and 200ms; were aborts not accounted for, 15 would run
+	runs := runBudgetTestArchetype(t, ExecutionBudget{Interval: 200 * time.Millisecond, MaxExecutionTime: 30 * time.Millisecond},
+		20*time.Millisecond, ErrCriticalSectionAborted, 300*time.Millisecond)
+	if runs < 2 || runs > 5 {
+		t.Fatalf("expected aborts to count towards the execution time, but %d critical sections ran in 2 intervals", runs)
+	}
+}
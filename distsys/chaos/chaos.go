@@ -0,0 +1,362 @@
+// Package chaos tests archetypes against randomized fault schedules: node crashes, network partitions, message
+// delays and clock skew, injected at random points of a deterministic simulation (see package sim), with
+// user-supplied invariants checked after every step. When a schedule breaks an invariant, it is minimized to the
+// fewest faults that still break it, and can be replayed exactly from its seed. For example:
+//
+//	explorer := chaos.New(func(s *sim.Simulation) {
+//		for _, self := range servers {
+//			s.AddArchetype(self, AServer, distsys.EnsureArchetypeRefParam("net", s.Network().MailboxesMaker(self)))
+//		}
+//	}, chaos.WithInvariant("single leader", checkSingleLeader))
+//	if failure := explorer.Explore(1, 500); failure != nil {
+//		t.Fatal(failure)
+//	}
+package chaos
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/sim"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// FaultKind is a kind of fault that can be injected.
+type FaultKind int
+
+const (
+	FaultCrash     FaultKind = iota // stops Nodes
+	FaultPartition                  // drops messages between Nodes and Others, in both directions
+	FaultHeal                       // clears all partitions and delays
+	FaultDelay                      // delays messages to and from Nodes by up to Delay
+	FaultClockSkew                  // shifts the clocks of Nodes by Skew
+)
+
+func (kind FaultKind) String() string {
+	switch kind {
+	case FaultCrash:
+		return "crash"
+	case FaultPartition:
+		return "partition"
+	case FaultHeal:
+		return "heal"
+	case FaultDelay:
+		return "delay"
+	case FaultClockSkew:
+		return "skew"
+	default:
+		return fmt.Sprintf("FaultKind(%d)", int(kind))
+	}
+}
+
+// Fault is a fault injected after a given step of a simulation.
+type Fault struct {
+	Step   int
+	Kind   FaultKind
+	Nodes  []tla.TLAValue
+	Others []tla.TLAValue // the other side of a partition
+	Delay  time.Duration
+	Skew   time.Duration
+}
+
+func formatNodes(nodes []tla.TLAValue) string {
+	strs := make([]string, len(nodes))
+	for i, node := range nodes {
+		strs[i] = node.String()
+	}
+	return "{" + strings.Join(strs, ", ") + "}"
+}
+
+func (fault Fault) String() string {
+	switch fault.Kind {
+	case FaultCrash:
+		return fmt.Sprintf("%d: crash %s", fault.Step, formatNodes(fault.Nodes))
+	case FaultPartition:
+		return fmt.Sprintf("%d: partition %s from %s", fault.Step, formatNodes(fault.Nodes), formatNodes(fault.Others))
+	case FaultHeal:
+		return fmt.Sprintf("%d: heal", fault.Step)
+	case FaultDelay:
+		return fmt.Sprintf("%d: delay %s by up to %v", fault.Step, formatNodes(fault.Nodes), fault.Delay)
+	case FaultClockSkew:
+		return fmt.Sprintf("%d: skew %s by %v", fault.Step, formatNodes(fault.Nodes), fault.Skew)
+	default:
+		return fmt.Sprintf("%d: %v", fault.Step, fault.Kind)
+	}
+}
+
+// Schedule is a run of a simulation: the seed of the simulation, how many steps to run, and the faults to inject,
+// ordered by step. Replaying a schedule always gives the same run.
+type Schedule struct {
+	Seed   int64
+	Steps  int
+	Faults []Fault
+}
+
+func (schedule Schedule) String() string {
+	var builder strings.Builder
+	_, _ = fmt.Fprintf(&builder, "seed %d, %d steps, %d faults", schedule.Seed, schedule.Steps, len(schedule.Faults))
+	for _, fault := range schedule.Faults {
+		builder.WriteString("\n  ")
+		builder.WriteString(fault.String())
+	}
+	return builder.String()
+}
+
+// Invariant checks a property of the simulated system, e.g. by inspecting the archetypes' contexts. It returns an
+// error describing the violation if the property does not hold.
+type Invariant func(s *sim.Simulation) error
+
+type namedInvariant struct {
+	name      string
+	invariant Invariant
+}
+
+type config struct {
+	steps      int
+	maxFaults  int
+	kinds      []FaultKind
+	maxDelay   time.Duration
+	maxSkew    time.Duration
+	invariants []namedInvariant
+	simOpts    []sim.Option
+}
+
+// Option configures an Explorer, as made by New.
+type Option func(cfg *config)
+
+// WithInvariant adds an invariant, checked after every step of every run.
+func WithInvariant(name string, invariant Invariant) Option {
+	return func(cfg *config) {
+		cfg.invariants = append(cfg.invariants, namedInvariant{name: name, invariant: invariant})
+	}
+}
+
+// WithSteps sets how many critical sections each run executes at most, 10000 by default.
+func WithSteps(steps int) Option {
+	return func(cfg *config) {
+		cfg.steps = steps
+	}
+}
+
+// WithMaxFaults sets the most faults injected in a run, 5 by default. Each run injects a random number of faults,
+// up to this.
+func WithMaxFaults(n int) Option {
+	return func(cfg *config) {
+		cfg.maxFaults = n
+	}
+}
+
+// WithFaultKinds restricts the kinds of faults injected. All kinds are injected by default.
+func WithFaultKinds(kinds ...FaultKind) Option {
+	return func(cfg *config) {
+		cfg.kinds = kinds
+	}
+}
+
+// WithMaxDelay sets the longest message delay injected by FaultDelay, one second by default.
+func WithMaxDelay(d time.Duration) Option {
+	return func(cfg *config) {
+		cfg.maxDelay = d
+	}
+}
+
+// WithMaxSkew sets the largest clock skew, ahead or behind, injected by FaultClockSkew, one minute by default.
+func WithMaxSkew(d time.Duration) Option {
+	return func(cfg *config) {
+		cfg.maxSkew = d
+	}
+}
+
+// WithSimulationOptions passes opts to every simulation the Explorer creates.
+func WithSimulationOptions(opts ...sim.Option) Option {
+	return func(cfg *config) {
+		cfg.simOpts = append(cfg.simOpts, opts...)
+	}
+}
+
+// Failure is a schedule that broke an invariant or made an archetype fail.
+type Failure struct {
+	Schedule Schedule // minimized, if found by Explore
+	Step     int      // the step after which the failure was detected
+	Err      error
+}
+
+func (failure *Failure) Error() string {
+	return fmt.Sprintf("after step %d: %v\nschedule: %v", failure.Step, failure.Err, failure.Schedule)
+}
+
+func (failure *Failure) Unwrap() error {
+	return failure.Err
+}
+
+// Explorer runs simulations of a system under randomized fault schedules.
+type Explorer struct {
+	setup  func(s *sim.Simulation)
+	config config
+}
+
+// New creates an Explorer, which calls setup to add the system's archetypes to each fresh simulation it runs.
+func New(setup func(s *sim.Simulation), opts ...Option) *Explorer {
+	cfg := config{
+		steps:     10000,
+		maxFaults: 5,
+		kinds:     []FaultKind{FaultCrash, FaultPartition, FaultHeal, FaultDelay, FaultClockSkew},
+		maxDelay:  time.Second,
+		maxSkew:   time.Minute,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Explorer{setup: setup, config: cfg}
+}
+
+// randomSubset returns a non-empty random subset of nodes, and the remaining nodes.
+func randomSubset(rng *rand.Rand, nodes []tla.TLAValue) (subset, rest []tla.TLAValue) {
+	perm := rng.Perm(len(nodes))
+	size := 1 + rng.Intn(len(nodes))
+	for i, j := range perm {
+		if i < size {
+			subset = append(subset, nodes[j])
+		} else {
+			rest = append(rest, nodes[j])
+		}
+	}
+	return subset, rest
+}
+
+// ScheduleForSeed returns the random schedule that Explore runs for seed.
+func (explorer *Explorer) ScheduleForSeed(seed int64) Schedule {
+	schedule := Schedule{Seed: seed, Steps: explorer.config.steps}
+	// faults are drawn from their own source, so that the simulation sees the seed itself
+	rng := rand.New(rand.NewSource(seed ^ 0x5eed))
+	s := sim.New(seed, explorer.config.simOpts...)
+	explorer.setup(s)
+	nodes := s.Selves()
+	_ = s.Close()
+	if len(nodes) == 0 || len(explorer.config.kinds) == 0 || explorer.config.maxFaults <= 0 || schedule.Steps <= 1 {
+		return schedule
+	}
+
+	count := 1 + rng.Intn(explorer.config.maxFaults)
+	for i := 0; i < count; i++ {
+		fault := Fault{
+			Step: 1 + rng.Intn(schedule.Steps-1),
+			Kind: explorer.config.kinds[rng.Intn(len(explorer.config.kinds))],
+		}
+		switch fault.Kind {
+		case FaultCrash:
+			fault.Nodes = []tla.TLAValue{nodes[rng.Intn(len(nodes))]}
+		case FaultPartition:
+			fault.Nodes, fault.Others = randomSubset(rng, nodes)
+			if len(fault.Others) == 0 {
+				continue
+			}
+		case FaultDelay:
+			fault.Nodes, _ = randomSubset(rng, nodes)
+			fault.Delay = time.Duration(1 + rng.Int63n(int64(explorer.config.maxDelay)))
+		case FaultClockSkew:
+			fault.Nodes = []tla.TLAValue{nodes[rng.Intn(len(nodes))]}
+			fault.Skew = time.Duration(rng.Int63n(2*int64(explorer.config.maxSkew)+1)) - explorer.config.maxSkew
+		}
+		schedule.Faults = append(schedule.Faults, fault)
+	}
+	sort.SliceStable(schedule.Faults, func(i, j int) bool {
+		return schedule.Faults[i].Step < schedule.Faults[j].Step
+	})
+	return schedule
+}
+
+func applyFault(s *sim.Simulation, fault Fault) {
+	switch fault.Kind {
+	case FaultCrash:
+		for _, node := range fault.Nodes {
+			s.Crash(node)
+		}
+	case FaultPartition:
+		s.Network().Partition(fault.Nodes, fault.Others)
+	case FaultHeal:
+		s.Network().Heal()
+	case FaultDelay:
+		delay := sim.Fault{MaxDelay: fault.Delay}
+		for _, node := range fault.Nodes {
+			for _, other := range s.Selves() {
+				s.Network().SetFault(node, other, delay)
+				s.Network().SetFault(other, node, delay)
+			}
+		}
+	case FaultClockSkew:
+		for _, node := range fault.Nodes {
+			s.SetClockSkew(node, fault.Skew)
+		}
+	}
+}
+
+// Replay runs schedule, and returns the resulting Failure, or nil if every invariant held throughout. A run in
+// which the archetypes deadlock, e.g. because of a partition that is never healed, ends without failing.
+func (explorer *Explorer) Replay(schedule Schedule) *Failure {
+	nextFault := 0
+	hook := func(s *sim.Simulation) error {
+		for nextFault < len(schedule.Faults) && schedule.Faults[nextFault].Step <= s.Steps() {
+			applyFault(s, schedule.Faults[nextFault])
+			nextFault++
+		}
+		for _, inv := range explorer.config.invariants {
+			if err := inv.invariant(s); err != nil {
+				return fmt.Errorf("invariant %q violated: %w", inv.name, err)
+			}
+		}
+		return nil
+	}
+	opts := append(append([]sim.Option(nil), explorer.config.simOpts...), sim.WithStepHook(hook))
+	s := sim.New(schedule.Seed, opts...)
+	explorer.setup(s)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	err := s.Run(schedule.Steps)
+	if err == nil || errors.Is(err, sim.ErrDeadlock) {
+		return nil
+	}
+	return &Failure{Schedule: schedule, Step: s.Steps(), Err: err}
+}
+
+// Minimize looks for a smaller schedule that still fails: it stops at the failing step, and drops every fault that
+// is not needed for some failure to happen. As dropping a fault changes the rest of the run, the minimized
+// schedule may fail differently, or earlier.
+func (explorer *Explorer) Minimize(failure *Failure) *Failure {
+	best := *failure
+	best.Schedule.Steps = best.Step
+	for changed := true; changed; {
+		changed = false
+		for i := range best.Schedule.Faults {
+			candidate := Schedule{
+				Seed:   best.Schedule.Seed,
+				Steps:  best.Schedule.Steps,
+				Faults: append(append([]Fault(nil), best.Schedule.Faults[:i]...), best.Schedule.Faults[i+1:]...),
+			}
+			if candidateFailure := explorer.Replay(candidate); candidateFailure != nil {
+				candidateFailure.Schedule.Steps = candidateFailure.Step
+				best = *candidateFailure
+				changed = true
+				break
+			}
+		}
+	}
+	return &best
+}
+
+// Explore runs the random schedules for seeds firstSeed, firstSeed+1, ..., for runs runs, and returns the first
+// failure found, minimized, or nil if there was none.
+func (explorer *Explorer) Explore(firstSeed int64, runs int) *Failure {
+	for i := 0; i < runs; i++ {
+		if failure := explorer.Replay(explorer.ScheduleForSeed(firstSeed + int64(i))); failure != nil {
+			return explorer.Minimize(failure)
+		}
+	}
+	return nil
+}
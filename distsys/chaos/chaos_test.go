@@ -0,0 +1,175 @@
+package chaos
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/sim"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// chaosTestACount counts in a local variable forever.
+var chaosTestACount = distsys.MPCalArchetype{
+	Name:              "ACount",
+	Label:             "ACount.loop",
+	RequiredRefParams: []string{},
+	RequiredValParams: []string{},
+	JumpTable: distsys.MakeMPCalJumpTable(distsys.MPCalCriticalSection{
+		Name: "ACount.loop",
+		Body: func(iface distsys.ArchetypeInterface) error {
+			count := iface.RequireArchetypeResource("ACount.count")
+			value, err := iface.Read(count, nil)
+			if err != nil {
+				return err
+			}
+			if err := iface.Write(count, nil, tla.TLA_PlusSymbol(value, tla.MakeTLANumber(1))); err != nil {
+				return err
+			}
+			return iface.Goto("ACount.loop")
+		},
+	}),
+	ProcTable: distsys.MakeMPCalProcTable(),
+	PreAmble: func(iface distsys.ArchetypeInterface) {
+		iface.EnsureArchetypeResourceLocal("ACount.count", tla.MakeTLANumber(0))
+	},
+}
+
+// newChaosTestExplorer explores three counting archetypes, under the invariant that none of them crashes, so that
+// exactly the schedules with a crash fail.
+func newChaosTestExplorer(opts ...Option) *Explorer {
+	opts = append([]Option{
+		WithSteps(200),
+		WithFaultKinds(FaultCrash, FaultPartition, FaultHeal, FaultDelay, FaultClockSkew),
+		WithInvariant("no crash", func(s *sim.Simulation) error {
+			for _, self := range s.Selves() {
+				if s.Status(self) == sim.NodeCrashed {
+					return fmt.Errorf("archetype %v crashed", self)
+				}
+			}
+			return nil
+		}),
+	}, opts...)
+	return New(func(s *sim.Simulation) {
+		for self := int32(1); self <= 3; self++ {
+			s.AddArchetype(tla.MakeTLANumber(self), chaosTestACount)
+		}
+	}, opts...)
+}
+
+func chaosTestHasCrash(schedule Schedule) bool {
+	for _, fault := range schedule.Faults {
+		if fault.Kind == FaultCrash {
+			return true
+		}
+	}
+	return false
+}
+
+func TestScheduleForSeed(t *testing.T) {
+	explorer := newChaosTestExplorer(WithMaxFaults(4))
+	different := false
+	for seed := int64(1); seed <= 20; seed++ {
+		schedule := explorer.ScheduleForSeed(seed)
+		if again := explorer.ScheduleForSeed(seed); !reflect.DeepEqual(schedule, again) {
+			t.Fatalf("expected seed %d to give the same schedule each time, got\n%v\nthen\n%v", seed, schedule, again)
+		}
+		if !reflect.DeepEqual(schedule, explorer.ScheduleForSeed(seed+1)) {
+			different = true
+		}
+		if schedule.Seed != seed || schedule.Steps != 200 || len(schedule.Faults) > 4 {
+			t.Fatalf("expected at most 4 faults in 200 steps of seed %d, got %v", seed, schedule)
+		}
+		for i, fault := range schedule.Faults {
+			if fault.Step < 1 || fault.Step >= schedule.Steps || i > 0 && fault.Step < schedule.Faults[i-1].Step {
+				t.Fatalf("expected faults ordered by step, within the run, got %v", schedule)
+			}
+		}
+	}
+	if !different {
+		t.Fatal("expected different seeds to give different schedules")
+	}
+
+	// with no fault kinds to pick from, a schedule has no faults
+	if schedule := newChaosTestExplorer(WithFaultKinds()).ScheduleForSeed(1); len(schedule.Faults) != 0 {
+		t.Fatalf("expected no faults, got %v", schedule)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	explorer := newChaosTestExplorer()
+	if failure := explorer.Replay(Schedule{Seed: 1, Steps: 200}); failure != nil {
+		t.Fatalf("expected a run without faults not to fail, got %v", failure)
+	}
+
+	schedule := Schedule{Seed: 1, Steps: 200, Faults: []Fault{
+		{Step: 10, Kind: FaultPartition, Nodes: []tla.TLAValue{tla.MakeTLANumber(1)}, Others: []tla.TLAValue{tla.MakeTLANumber(2), tla.MakeTLANumber(3)}},
+		{Step: 50, Kind: FaultCrash, Nodes: []tla.TLAValue{tla.MakeTLANumber(2)}},
+	}}
+	failure := explorer.Replay(schedule)
+	if failure == nil || failure.Step != 50 || failure.Err.Error() != `invariant "no crash" violated: archetype 2 crashed` {
+		t.Fatalf("expected the crash at step 50 to break the invariant, got %v", failure)
+	}
+	if !reflect.DeepEqual(failure.Schedule, schedule) {
+		t.Fatalf("expected the failure to hold the schedule replayed, got %v", failure.Schedule)
+	}
+	if again := explorer.Replay(schedule); again == nil || again.Step != failure.Step || again.Err.Error() != failure.Err.Error() {
+		t.Fatalf("expected replaying the schedule to fail the same way, got %v", again)
+	}
+}
+
+func TestMinimize(t *testing.T) {
+	explorer := newChaosTestExplorer()
+	// find a schedule that fails, with faults besides the crash that breaks the invariant
+	var failure *Failure
+	for seed := int64(1); failure == nil; seed++ {
+		if seed > 1000 {
+			t.Fatal("found no failing schedule with several faults")
+		}
+		schedule := explorer.ScheduleForSeed(seed)
+		if len(schedule.Faults) < 2 || schedule.Faults[0].Kind == FaultCrash {
+			continue
+		}
+		failure = explorer.Replay(schedule)
+	}
+
+	minimized := explorer.Minimize(failure)
+	if len(minimized.Schedule.Faults) > len(failure.Schedule.Faults) || minimized.Schedule.Steps > failure.Step {
+		t.Fatalf("expected the minimized schedule to be no larger than\n%v\ngot\n%v", failure.Schedule, minimized.Schedule)
+	}
+	if len(minimized.Schedule.Faults) != 1 || minimized.Schedule.Faults[0].Kind != FaultCrash {
+		t.Fatalf("expected only the crash to be kept, got %v", minimized.Schedule)
+	}
+	again := explorer.Replay(minimized.Schedule)
+	if again == nil || again.Step != minimized.Step {
+		t.Fatalf("expected the minimized schedule to fail at step %d, got %v", minimized.Step, again)
+	}
+}
+
+func TestExplore(t *testing.T) {
+	explorer := newChaosTestExplorer(WithFaultKinds(FaultPartition, FaultHeal, FaultDelay, FaultClockSkew))
+	if failure := explorer.Explore(1, 10); failure != nil {
+		t.Fatalf("expected no failure without crashes, got %v", failure)
+	}
+
+	explorer = newChaosTestExplorer()
+	failure := explorer.Explore(1, 100)
+	if failure == nil {
+		t.Fatal("expected a schedule with a crash to fail")
+	}
+	var firstFailing Schedule
+	for seed := int64(1); ; seed++ {
+		if schedule := explorer.ScheduleForSeed(seed); chaosTestHasCrash(schedule) {
+			firstFailing = schedule
+			break
+		}
+	}
+	if failure.Schedule.Seed != firstFailing.Seed || len(failure.Schedule.Faults) != 1 {
+		t.Fatalf("expected the first failing schedule, minimized, got %v", failure)
+	}
+	if err := failure.Unwrap(); err == nil || !strings.HasPrefix(err.Error(), `invariant "no crash" violated`) {
+		t.Fatalf("expected the failure to wrap the violation, got %v", err)
+	}
+}
@@ -0,0 +1,200 @@
+// Package debug serves the live state of the archetypes running in a process over HTTP, in the spirit of expvar and
+// net/http/pprof, but for MPCal state: for each running context, it shows the current label, the values of the
+// archetype's local state, the status of its resources and its latest critical section outcomes. For example:
+//
+//	server, err := debug.StartServer("localhost:6061")
+//
+// after which the state can be seen at http://localhost:6061/debug/mpcal, or at /debug/mpcal?format=json for tools.
+// As local state may hold sensitive values, the server should not be exposed beyond trusted networks.
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// Path is where StartServer serves the state of archetypes.
+const Path = "/debug/mpcal"
+
+const defaultInspectTimeout = time.Second
+
+type config struct {
+	inspectTimeout time.Duration
+}
+
+// Option configures a Handler, or the server made by StartServer.
+type Option func(cfg *config)
+
+// WithInspectTimeout sets how long to wait for each archetype to reach the end of its critical section, so that its
+// state can be captured, one second by default. Archetypes that take longer are shown with their current label only.
+func WithInspectTimeout(timeout time.Duration) Option {
+	return func(cfg *config) {
+		cfg.inspectTimeout = timeout
+	}
+}
+
+type handler struct {
+	config config
+}
+
+// Handler returns an http.Handler rendering the state of every running context, as plain text, or as JSON if the
+// request has the query parameter format=json.
+func Handler(opts ...Option) http.Handler {
+	cfg := config{inspectTimeout: defaultInspectTimeout}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &handler{config: cfg}
+}
+
+// StartServer starts serving Handler at Path on listenAddr, in the background. It returns once the listener is
+// open; the returned server should be closed when the process no longer needs it.
+func StartServer(listenAddr string, opts ...Option) (*http.Server, error) {
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("could not listen on address %s: %w", listenAddr, err)
+	}
+	distsys.DefaultLogger.Log(distsys.LogInfo, "serving archetype state", "address", listener.Addr())
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler(opts...))
+	server := &http.Server{Handler: mux}
+	go func() {
+		err := server.Serve(listener)
+		if err != http.ErrServerClosed {
+			distsys.DefaultLogger.Log(distsys.LogError, "error serving archetype state", "address", listenAddr, "error", err)
+		}
+	}()
+	return server, nil
+}
+
+// contextView is what the handler shows of one context.
+type contextView struct {
+	ctx          *distsys.MPCalContext
+	self         tla.TLAValue
+	state        distsys.ArchetypeState
+	err          error // why state could not be captured, if it could not
+	currentLabel string
+	records      []distsys.FlightRecord
+}
+
+func (h *handler) inspect(ctx *distsys.MPCalContext) contextView {
+	view := contextView{
+		ctx:          ctx,
+		self:         ctx.IFace().Self(),
+		currentLabel: ctx.CurrentLabel(),
+		records:      ctx.FlightRecords(),
+	}
+	type result struct {
+		state distsys.ArchetypeState
+		err   error
+	}
+	// buffered, so that a late inspection does not block the archetype once we have stopped waiting for it
+	resultCh := make(chan result, 1)
+	go func() {
+		state, err := ctx.Inspect()
+		resultCh <- result{state: state, err: err}
+	}()
+	select {
+	case res := <-resultCh:
+		view.state, view.err = res.state, res.err
+	case <-time.After(h.config.inspectTimeout):
+		view.err = fmt.Errorf("timed out after %v waiting for the critical section %s to end", h.config.inspectTimeout, view.currentLabel)
+	}
+	return view
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var views []contextView
+	for _, ctx := range distsys.RunningContexts() {
+		views = append(views, h.inspect(ctx))
+	}
+	if r.URL.Query().Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(makeJSONViews(views))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = fmt.Fprintf(w, "%d running archetypes\n", len(views))
+	for _, view := range views {
+		_, _ = fmt.Fprintln(w)
+		if view.err != nil {
+			_, _ = fmt.Fprintf(w, "archetype with self = %v, label = %s: %v\n", view.self, view.currentLabel, view.err)
+		} else {
+			_, _ = fmt.Fprint(w, view.state)
+		}
+		_ = view.ctx.DumpFlightRecords(w)
+	}
+}
+
+type jsonResource struct {
+	Handle string `json:"handle"`
+	Type   string `json:"type"`
+	Status string `json:"status,omitempty"`
+}
+
+type jsonFlightRecord struct {
+	Label     string  `json:"label"`
+	Start     string  `json:"start"`
+	ElapsedMS float64 `json:"elapsedMs"`
+	Outcome   string  `json:"outcome"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// jsonView is the JSON rendering of a contextView. TLA+ values are rendered as strings in TLA+ syntax.
+type jsonView struct {
+	Self          string             `json:"self"`
+	Archetype     string             `json:"archetype,omitempty"`
+	Label         string             `json:"label"`
+	Error         string             `json:"error,omitempty"`
+	Locals        map[string]string  `json:"locals,omitempty"`
+	Resources     []jsonResource     `json:"resources,omitempty"`
+	FlightRecords []jsonFlightRecord `json:"flightRecords,omitempty"`
+}
+
+func makeJSONViews(views []contextView) []jsonView {
+	result := make([]jsonView, 0, len(views))
+	for _, view := range views {
+		jv := jsonView{
+			Self:      view.self.String(),
+			Archetype: view.state.Archetype,
+			Label:     view.currentLabel,
+		}
+		if view.err != nil {
+			jv.Error = view.err.Error()
+		} else {
+			jv.Label = view.state.Label
+			jv.Locals = make(map[string]string, len(view.state.Locals))
+			for handle, value := range view.state.Locals {
+				jv.Locals[string(handle)] = value.String()
+			}
+			for _, res := range view.state.Resources {
+				jv.Resources = append(jv.Resources, jsonResource{
+					Handle: string(res.Handle),
+					Type:   res.Type,
+					Status: res.Status,
+				})
+			}
+		}
+		for _, record := range view.records {
+			jr := jsonFlightRecord{
+				Label:     record.Label,
+				Start:     record.Start.Format(time.RFC3339Nano),
+				ElapsedMS: float64(record.Elapsed) / float64(time.Millisecond),
+				Outcome:   record.Outcome,
+			}
+			if record.Err != nil {
+				jr.Error = record.Err.Error()
+			}
+			jv.FlightRecords = append(jv.FlightRecords, jr)
+		}
+		result = append(result, jv)
+	}
+	return result
+}
@@ -0,0 +1,187 @@
+package debug
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// runDebugTestArchetypes runs two archetypes until the test ends: ACount, with self 1, which counts in a local
+// variable, and AStuck, with self 2, whose critical section does not end until then. It returns once both are
+// running, and ACount has committed a critical section.
+func runDebugTestArchetypes(t *testing.T) {
+	t.Helper()
+	counting := distsys.MPCalArchetype{
+		Name:              "ACount",
+		Label:             "ACount.loop",
+		RequiredRefParams: []string{},
+		RequiredValParams: []string{},
+		JumpTable: distsys.MakeMPCalJumpTable(distsys.MPCalCriticalSection{
+			Name: "ACount.loop",
+			Body: func(iface distsys.ArchetypeInterface) error {
+				count := iface.RequireArchetypeResource("ACount.count")
+				value, err := iface.Read(count, nil)
+				if err != nil {
+					return err
+				}
+				if err := iface.Write(count, nil, tla.TLA_PlusSymbol(value, tla.MakeTLANumber(1))); err != nil {
+					return err
+				}
+				time.Sleep(time.Millisecond)
+				return iface.Goto("ACount.loop")
+			},
+		}),
+		ProcTable: distsys.MakeMPCalProcTable(),
+		PreAmble: func(iface distsys.ArchetypeInterface) {
+			iface.EnsureArchetypeResourceLocal("ACount.count", tla.MakeTLANumber(0))
+		},
+	}
+	unstuck := make(chan struct{})
+	stuck := distsys.MPCalArchetype{
+		Name:              "AStuck",
+		Label:             "AStuck.wait",
+		RequiredRefParams: []string{},
+		RequiredValParams: []string{},
+		JumpTable: distsys.MakeMPCalJumpTable(distsys.MPCalCriticalSection{
+			Name: "AStuck.wait",
+			Body: func(iface distsys.ArchetypeInterface) error {
+				<-unstuck
+				return iface.Goto("AStuck.wait")
+			},
+		}),
+		ProcTable: distsys.MakeMPCalProcTable(),
+		PreAmble:  func(distsys.ArchetypeInterface) {},
+	}
+
+	contexts := []*distsys.MPCalContext{
+		distsys.NewMPCalContext(tla.MakeTLANumber(1), counting),
+		distsys.NewMPCalContext(tla.MakeTLANumber(2), stuck),
+	}
+	errCh := make(chan error, len(contexts))
+	for _, ctx := range contexts {
+		ctx := ctx
+		go func() {
+			errCh <- ctx.Run()
+		}()
+	}
+	t.Cleanup(func() {
+		close(unstuck)
+		for _, ctx := range contexts {
+			if err := ctx.Close(); err != nil {
+				t.Error(err)
+			}
+		}
+		for range contexts {
+			if err := <-errCh; err != distsys.ErrContextClosed {
+				t.Errorf("expected the archetype to run until closed, got %v", err)
+			}
+		}
+	})
+
+	deadline := time.Now().Add(10 * time.Second)
+	for len(distsys.RunningContexts()) < len(contexts) || len(contexts[0].FlightRecords()) == 0 || contexts[1].CurrentLabel() == "" {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the archetypes to run")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func debugTestGet(t *testing.T, url string) string {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %s: %s", resp.Status, body)
+	}
+	return string(body)
+}
+
+func TestHandlerText(t *testing.T) {
+	runDebugTestArchetypes(t)
+	server := httptest.NewServer(Handler(WithInspectTimeout(50 * time.Millisecond)))
+	defer server.Close()
+
+	body := debugTestGet(t, server.URL)
+	for _, expected := range []string{
+		"2 running archetypes\n",
+		"archetype ACount, self = 1, label = ACount.loop, running = true\n",
+		"  ACount.count = ",
+		"flight recorder of 1: last ",
+		// the stuck archetype cannot be inspected, but its label and flight records are still shown
+		"archetype with self = 2, label = AStuck.wait: timed out after 50ms waiting for the critical section AStuck.wait to end\n",
+		"flight recorder of 2: last 0 of 0 critical sections",
+	} {
+		if !strings.Contains(body, expected) {
+			t.Fatalf("expected the state to contain %q, got:\n%s", expected, body)
+		}
+	}
+}
+
+func TestHandlerJSON(t *testing.T) {
+	runDebugTestArchetypes(t)
+	server := httptest.NewServer(Handler(WithInspectTimeout(50 * time.Millisecond)))
+	defer server.Close()
+
+	var views []jsonView
+	if err := json.Unmarshal([]byte(debugTestGet(t, server.URL+"?format=json")), &views); err != nil {
+		t.Fatal(err)
+	}
+	if len(views) != 2 {
+		t.Fatalf("expected 2 archetypes, got %+v", views)
+	}
+	counting, stuck := views[0], views[1]
+	if counting.Self != "1" || counting.Archetype != "ACount" || counting.Label != "ACount.loop" || counting.Error != "" {
+		t.Fatalf("expected the counting archetype to be inspected, got %+v", counting)
+	}
+	if _, ok := counting.Locals["ACount.count"]; !ok || counting.Locals[".pc"] != `"ACount.loop"` {
+		t.Fatalf("expected the counting archetype's locals, got %v", counting.Locals)
+	}
+	if len(counting.FlightRecords) == 0 || counting.FlightRecords[0].Label != "ACount.loop" || counting.FlightRecords[0].Outcome != "committed" {
+		t.Fatalf("expected the counting archetype's committed critical sections, got %+v", counting.FlightRecords)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, counting.FlightRecords[0].Start); err != nil {
+		t.Fatal(err)
+	}
+	if stuck.Self != "2" || stuck.Label != "AStuck.wait" || !strings.Contains(stuck.Error, "timed out") || stuck.Locals != nil {
+		t.Fatalf("expected the stuck archetype to time out, got %+v", stuck)
+	}
+}
+
+func TestStartServer(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	if _, err := StartServer(addr); err == nil {
+		t.Fatal("expected listening on an address in use to fail")
+	}
+	if err := listener.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	server, err := StartServer(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	if body := debugTestGet(t, "http://"+addr+Path); !strings.HasPrefix(body, "0 running archetypes\n") {
+		t.Fatalf("expected no running archetypes, got:\n%s", body)
+	}
+}
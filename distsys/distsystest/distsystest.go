@@ -0,0 +1,249 @@
+// Package distsystest provides helpers for testing archetypes that run over real resources, such as TCP mailboxes:
+// allocating free ports, mapping mailboxes to addresses, running groups of contexts that are stopped when the test
+// ends, and waiting for conditions with timeouts. For example:
+//
+//	addrs := distsystest.NewMailboxAddresses(t)
+//	group := distsystest.NewGroup(t)
+//	for _, self := range selves {
+//		group.Start(distsys.NewMPCalContext(self, AServer,
+//			distsys.EnsureArchetypeRefParam("net", addrs.Maker(self))))
+//	}
+//	out := distsystest.Receive(t, outChan, 10*time.Second)
+//
+// The package is meant to be used from tests only; failures are reported through testing.TB.
+package distsystest
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+	"go.uber.org/multierr"
+)
+
+const pollInterval = 10 * time.Millisecond
+
+// FreePort returns a TCP port on localhost that nothing listens on at the time of the call. Another process may
+// still take it before it is used, but this is unlikely in practice.
+func FreePort(t testing.TB) int {
+	t.Helper()
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("could not find a free port: %v", err)
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+// FreeAddress returns a localhost address with a free port, as found by FreePort.
+func FreeAddress(t testing.TB) string {
+	t.Helper()
+	return fmt.Sprintf("localhost:%d", FreePort(t))
+}
+
+// MailboxAddressesOption configures MailboxAddresses, as made by NewMailboxAddresses.
+type MailboxAddressesOption func(addrs *MailboxAddresses)
+
+// WithMailboxOwner sets which archetype owns, and so listens on, the mailbox at each index. By default, the mailbox
+// at index i is owned by the archetype whose self is i. Specs that give each archetype several mailboxes, e.g.
+// indexed by <<self, msgType>>, should map such indices back to self.
+func WithMailboxOwner(owner func(index tla.TLAValue) tla.TLAValue) MailboxAddressesOption {
+	return func(addrs *MailboxAddresses) {
+		addrs.owner = owner
+	}
+}
+
+// MailboxAddresses assigns a free localhost address to each mailbox index, the first time the index is looked up, so
+// that all archetypes of a test agree on the addresses without hard-coding ports. It is safe for concurrent use.
+type MailboxAddresses struct {
+	t     testing.TB
+	owner func(index tla.TLAValue) tla.TLAValue
+
+	lock  sync.Mutex
+	addrs *immutable.Map // index -> string
+}
+
+// NewMailboxAddresses creates an empty set of mailbox addresses, whose ports are allocated with FreePort.
+func NewMailboxAddresses(t testing.TB, opts ...MailboxAddressesOption) *MailboxAddresses {
+	addrs := &MailboxAddresses{
+		t:     t,
+		owner: func(index tla.TLAValue) tla.TLAValue { return index },
+		addrs: immutable.NewMap(tla.TLAValueHasher{}),
+	}
+	for _, opt := range opts {
+		opt(addrs)
+	}
+	return addrs
+}
+
+// Address returns the address of the mailbox at index, allocating one if needed.
+func (addrs *MailboxAddresses) Address(index tla.TLAValue) string {
+	addrs.lock.Lock()
+	defer addrs.lock.Unlock()
+	if addr, ok := addrs.addrs.Get(index); ok {
+		return addr.(string)
+	}
+	addr := FreeAddress(addrs.t)
+	addrs.addrs = addrs.addrs.Set(index, addr)
+	return addr
+}
+
+// MappingFn returns the address mapping to give to TCP mailboxes for the archetype with the given self: mailboxes
+// it owns are local, and all others are remote.
+func (addrs *MailboxAddresses) MappingFn(self tla.TLAValue) resources.TCPMailboxesAddressMappingFn {
+	return func(index tla.TLAValue) (resources.TCPMailboxKind, string) {
+		kind := resources.TCPMailboxesRemote
+		if addrs.owner(index).Equal(self) {
+			kind = resources.TCPMailboxesLocal
+		}
+		return kind, addrs.Address(index)
+	}
+}
+
+// Maker returns a maker for TCP mailboxes, as used by the archetype with the given self, with addresses from addrs.
+func (addrs *MailboxAddresses) Maker(self tla.TLAValue, opts ...resources.TCPMailboxesOption) distsys.ArchetypeResourceMaker {
+	return resources.TCPMailboxesMaker(addrs.MappingFn(self), opts...)
+}
+
+// Group runs a set of contexts, each in its own goroutine, and stops them when the test ends. Errors returned by the
+// archetypes, other than those caused by stopping them, fail the test.
+type Group struct {
+	t testing.TB
+
+	lock    sync.Mutex
+	ctxs    []*distsys.MPCalContext
+	errs    chan error
+	started int
+	stopped bool
+}
+
+// NewGroup creates an empty group, to be stopped by Stop, or else once the test and its subtests have completed.
+func NewGroup(t testing.TB) *Group {
+	group := &Group{
+		t:    t,
+		errs: make(chan error, 16),
+	}
+	t.Cleanup(func() {
+		if err := group.Stop(); err != nil {
+			t.Errorf("archetype error: %v", err)
+		}
+	})
+	return group
+}
+
+// Start runs each of ctxs in the background, by calling its Run method.
+func (group *Group) Start(ctxs ...*distsys.MPCalContext) {
+	for _, ctx := range ctxs {
+		group.Go(ctx, ctx.Run)
+	}
+}
+
+// Go runs ctx in the background, by calling run, which should run the context's archetype until it terminates or
+// the context is closed. This allows for wrappers such as Monitor.RunArchetype.
+func (group *Group) Go(ctx *distsys.MPCalContext, run func() error) {
+	group.lock.Lock()
+	defer group.lock.Unlock()
+	if group.stopped {
+		group.t.Fatalf("cannot start archetype %v: the group was already stopped", ctx.IFace().Self())
+	}
+	group.ctxs = append(group.ctxs, ctx)
+	group.started++
+	self := ctx.IFace().Self()
+	go func() {
+		err := run()
+		if err == distsys.ErrContextClosed {
+			err = nil
+		}
+		if err != nil {
+			err = fmt.Errorf("archetype %v: %w", self, err)
+		}
+		group.errs <- err
+	}()
+}
+
+// Contexts returns the contexts of the group, in the order they were started.
+func (group *Group) Contexts() []*distsys.MPCalContext {
+	group.lock.Lock()
+	defer group.lock.Unlock()
+	return append([]*distsys.MPCalContext(nil), group.ctxs...)
+}
+
+// Wait waits up to timeout for all archetypes of the group to terminate by themselves, and returns their errors. It
+// fails the test if some are still running after timeout.
+func (group *Group) Wait(timeout time.Duration) error {
+	group.t.Helper()
+	group.lock.Lock()
+	defer group.lock.Unlock()
+	var err error
+	deadline := time.After(timeout)
+	for group.started > 0 {
+		select {
+		case archetypeErr := <-group.errs:
+			group.started--
+			err = multierr.Append(err, archetypeErr)
+		case <-deadline:
+			group.t.Fatalf("%d archetypes still running after %v", group.started, timeout)
+		}
+	}
+	return err
+}
+
+// Stop closes all contexts of the group, which also closes their resources, waits for the archetypes to return, and
+// returns their errors. Calling Stop more than once is safe, and after the first call it returns nil.
+func (group *Group) Stop() error {
+	group.lock.Lock()
+	defer group.lock.Unlock()
+	if group.stopped {
+		return nil
+	}
+	group.stopped = true
+	var err error
+	for _, ctx := range group.ctxs {
+		err = multierr.Append(err, ctx.Close())
+	}
+	for ; group.started > 0; group.started-- {
+		err = multierr.Append(err, <-group.errs)
+	}
+	return err
+}
+
+// Eventually reports whether cond becomes true within timeout, checking it at regular intervals.
+func Eventually(timeout time.Duration, cond func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if cond() {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// Await fails the test, with a message formatted from format and args, unless cond becomes true within timeout.
+func Await(t testing.TB, timeout time.Duration, cond func() bool, format string, args ...interface{}) {
+	t.Helper()
+	if !Eventually(timeout, cond) {
+		t.Fatalf("timed out after %v: %s", timeout, fmt.Sprintf(format, args...))
+	}
+}
+
+// Receive returns the next value from ch, typically the output channel of an archetype, and fails the test if none
+// arrives within timeout.
+func Receive(t testing.TB, ch <-chan tla.TLAValue, timeout time.Duration) tla.TLAValue {
+	t.Helper()
+	select {
+	case value := <-ch:
+		return value
+	case <-time.After(timeout):
+		t.Fatalf("timed out after %v waiting for a value", timeout)
+		return tla.TLAValue{}
+	}
+}
@@ -0,0 +1,193 @@
+package distsystest
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// makeTestArchetype makes an archetype named name whose only critical section runs body, then goes to Done.
+func makeTestArchetype(name string, requiredRefParams []string, body func(iface distsys.ArchetypeInterface) error) distsys.MPCalArchetype {
+	return distsys.MPCalArchetype{
+		Name:              name,
+		Label:             name + ".run",
+		RequiredRefParams: requiredRefParams,
+		RequiredValParams: []string{},
+		JumpTable: distsys.MakeMPCalJumpTable(
+			distsys.MPCalCriticalSection{
+				Name: name + ".run",
+				Body: func(iface distsys.ArchetypeInterface) error {
+					if err := body(iface); err != nil {
+						return err
+					}
+					return iface.Goto(name + ".Done")
+				},
+			},
+			distsys.MPCalCriticalSection{
+				Name: name + ".Done",
+				Body: func(distsys.ArchetypeInterface) error {
+					return distsys.ErrDone
+				},
+			},
+		),
+		ProcTable: distsys.MakeMPCalProcTable(),
+		PreAmble:  func(distsys.ArchetypeInterface) {},
+	}
+}
+
+func TestFreeAddress(t *testing.T) {
+	addr := FreeAddress(t)
+	if !strings.HasPrefix(addr, "localhost:") {
+		t.Fatalf("expected a localhost address, got %q", addr)
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		t.Fatalf("expected %s to be free: %v", addr, err)
+	}
+	_ = listener.Close()
+}
+
+func TestMailboxAddresses(t *testing.T) {
+	// mailboxes are indexed by <<self, msgType>>, and owned by self
+	addrs := NewMailboxAddresses(t, WithMailboxOwner(func(index tla.TLAValue) tla.TLAValue {
+		return index.ApplyFunction(tla.MakeTLANumber(1))
+	}))
+	req1 := tla.MakeTLATuple(tla.MakeTLANumber(1), tla.MakeTLAString("req"))
+	resp1 := tla.MakeTLATuple(tla.MakeTLANumber(1), tla.MakeTLAString("resp"))
+	req2 := tla.MakeTLATuple(tla.MakeTLANumber(2), tla.MakeTLAString("req"))
+
+	if addrs.Address(req1) != addrs.Address(req1) {
+		t.Fatal("expected looking up the same index twice to give the same address")
+	}
+	if addrs.Address(req1) == addrs.Address(resp1) || addrs.Address(req1) == addrs.Address(req2) {
+		t.Fatal("expected each index to get its own address")
+	}
+
+	mapping := addrs.MappingFn(tla.MakeTLANumber(1))
+	for _, tc := range []struct {
+		index tla.TLAValue
+		kind  resources.TCPMailboxKind
+	}{
+		{req1, resources.TCPMailboxesLocal},
+		{resp1, resources.TCPMailboxesLocal},
+		{req2, resources.TCPMailboxesRemote},
+	} {
+		kind, addr := mapping(tc.index)
+		if kind != tc.kind || addr != addrs.Address(tc.index) {
+			t.Fatalf("expected %v to map to kind %v at %s, got kind %v at %s", tc.index, tc.kind, addrs.Address(tc.index), kind, addr)
+		}
+	}
+}
+
+func TestGroupMailboxes(t *testing.T) {
+	// the sender, 1, writes a message to the mailbox of 2, where the receiver reads it and outputs it
+	refParams := []string{"A.net"}
+	send := makeTestArchetype("A", refParams, func(iface distsys.ArchetypeInterface) error {
+		mailboxes, err := iface.RequireArchetypeResourceRef("A.net")
+		if err != nil {
+			return err
+		}
+		return iface.Write(mailboxes, []tla.TLAValue{tla.MakeTLANumber(2)}, tla.MakeTLAString("hello"))
+	})
+	receive := makeTestArchetype("A", append(refParams, "A.out"), func(iface distsys.ArchetypeInterface) error {
+		mailboxes, err := iface.RequireArchetypeResourceRef("A.net")
+		if err != nil {
+			return err
+		}
+		out, err := iface.RequireArchetypeResourceRef("A.out")
+		if err != nil {
+			return err
+		}
+		msg, err := iface.Read(mailboxes, []tla.TLAValue{iface.Self()})
+		if err != nil {
+			return err
+		}
+		return iface.Write(out, nil, msg)
+	})
+
+	addrs := NewMailboxAddresses(t)
+	outCh := make(chan tla.TLAValue, 1)
+	group := NewGroup(t)
+	sender, receiver := tla.MakeTLANumber(1), tla.MakeTLANumber(2)
+	group.Start(
+		distsys.NewMPCalContext(receiver, receive,
+			distsys.EnsureArchetypeRefParam("net", addrs.Maker(receiver)),
+			distsys.EnsureArchetypeRefParam("out", resources.OutputChannelMaker(outCh))),
+		distsys.NewMPCalContext(sender, send,
+			distsys.EnsureArchetypeRefParam("net", addrs.Maker(sender))))
+	if len(group.Contexts()) != 2 {
+		t.Fatalf("expected 2 contexts, got %v", group.Contexts())
+	}
+
+	if msg := Receive(t, outCh, 10*time.Second); !msg.Equal(tla.MakeTLAString("hello")) {
+		t.Fatalf("expected to receive the message sent, got %v", msg)
+	}
+	if err := group.Wait(10 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := group.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err := group.Stop(); err != nil {
+		t.Fatalf("expected stopping twice to be safe, got %v", err)
+	}
+}
+
+func TestGroupErrors(t *testing.T) {
+	boom := errors.New("boom")
+	failing := makeTestArchetype("AFail", []string{}, func(distsys.ArchetypeInterface) error {
+		return boom
+	})
+	group := NewGroup(t)
+	group.Start(distsys.NewMPCalContext(tla.MakeTLANumber(1), failing))
+	err := group.Wait(10 * time.Second)
+	if !errors.Is(err, boom) || !strings.HasPrefix(err.Error(), "archetype 1: ") {
+		t.Fatalf("expected the archetype's error, with its self, got %v", err)
+	}
+
+	// archetypes that run until they are stopped are not in error
+	blocked := make(chan tla.TLAValue)
+	waiting := makeTestArchetype("AWait", []string{"AWait.in"}, func(iface distsys.ArchetypeInterface) error {
+		in, err := iface.RequireArchetypeResourceRef("AWait.in")
+		if err != nil {
+			return err
+		}
+		_, err = iface.Read(in, nil)
+		return err
+	})
+	group = NewGroup(t)
+	ctx := distsys.NewMPCalContext(tla.MakeTLANumber(2), waiting,
+		distsys.EnsureArchetypeRefParam("in", resources.InputChannelMaker(blocked)))
+	group.Go(ctx, ctx.Run)
+	if err := group.Stop(); err != nil {
+		t.Fatalf("expected stopping a running archetype not to be an error, got %v", err)
+	}
+}
+
+func TestEventually(t *testing.T) {
+	start := time.Now()
+	if !Eventually(time.Second, func() bool { return time.Since(start) > 3*pollInterval }) {
+		t.Fatal("expected a condition that becomes true to be met")
+	}
+	if Eventually(5*pollInterval, func() bool { return false }) {
+		t.Fatal("expected a condition that never becomes true not to be met")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the conditions to be checked within their timeouts, took %v", elapsed)
+	}
+	Await(t, time.Second, func() bool { return true }, "a true condition")
+}
+
+func TestReceive(t *testing.T) {
+	ch := make(chan tla.TLAValue, 1)
+	ch <- tla.MakeTLANumber(42)
+	if value := Receive(t, ch, time.Second); !value.Equal(tla.MakeTLANumber(42)) {
+		t.Fatalf("expected 42, got %v", value)
+	}
+}
@@ -0,0 +1,395 @@
+package distsystest
+
+import (
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// FailureDetectorHarness lets RunFailureDetectorConformance drive a failure detector implementation: it provides
+// the resource under test, and controls the archetypes the resource watches.
+type FailureDetectorHarness interface {
+	// Maker returns a maker for the failure detector resource under test, a map from archetype IDs to TRUE if the
+	// archetype is considered failed, and FALSE if it is considered alive.
+	Maker() distsys.ArchetypeResourceMaker
+	// Start starts the archetype with the given ID, or restarts it if it crashed, as if it joined the system again.
+	Start(id tla.TLAValue) error
+	// Crash stops the archetype with the given ID, as if its process had crashed.
+	Crash(id tla.TLAValue) error
+	// Close stops all archetypes, and releases what the harness holds.
+	Close() error
+}
+
+// DelayingFailureDetectorHarness is a FailureDetectorHarness that can also slow down the communication between the
+// failure detector and the archetype with the given ID, or whatever the detector queries about it. Harnesses that
+// implement it are also checked for false positives under delay.
+type DelayingFailureDetectorHarness interface {
+	FailureDetectorHarness
+	SetDelay(id tla.TLAValue, delay time.Duration) error
+}
+
+type fdConformanceConfig struct {
+	detectionBound time.Duration
+	delay          time.Duration
+	observation    time.Duration
+}
+
+// FailureDetectorConformanceOption configures RunFailureDetectorConformance.
+type FailureDetectorConformanceOption func(cfg *fdConformanceConfig)
+
+// WithDetectionBound sets how soon after an archetype crashes, starts or rejoins the failure detector must report
+// it, five seconds by default. It should match the guarantees the system is designed around.
+func WithDetectionBound(bound time.Duration) FailureDetectorConformanceOption {
+	return func(cfg *fdConformanceConfig) {
+		cfg.detectionBound = bound
+	}
+}
+
+// WithTolerableDelay sets the communication delay that the failure detector must tolerate without reporting a live
+// archetype as failed, 100 milliseconds by default.
+func WithTolerableDelay(delay time.Duration) FailureDetectorConformanceOption {
+	return func(cfg *fdConformanceConfig) {
+		cfg.delay = delay
+	}
+}
+
+// WithObservationPeriod sets how long the failure detector is watched for false positives, two seconds by
+// default.
+func WithObservationPeriod(period time.Duration) FailureDetectorConformanceOption {
+	return func(cfg *fdConformanceConfig) {
+		cfg.observation = period
+	}
+}
+
+// RunFailureDetectorConformance checks that a failure detector has the semantics that compiled MPCal code assumes
+// of one, running each check as a subtest with a fresh harness from newHarness:
+//   - it never reports an archetype as alive before it has started;
+//   - it reports a started archetype as alive, and a crashed one as failed, within the detection bound;
+//   - it does not report a live archetype as failed while communication with it is delayed by a tolerable amount,
+//     if the harness implements DelayingFailureDetectorHarness;
+//   - it reports an archetype that restarts after a crash as alive again, within the detection bound.
+func RunFailureDetectorConformance(t *testing.T, newHarness func(t *testing.T) FailureDetectorHarness, opts ...FailureDetectorConformanceOption) {
+	cfg := fdConformanceConfig{
+		detectionBound: 5 * time.Second,
+		delay:          100 * time.Millisecond,
+		observation:    2 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	id := tla.MakeTLANumber(1)
+
+	run := func(name string, check func(t *testing.T, harness FailureDetectorHarness, detector *fdProbe)) {
+		t.Run(name, func(t *testing.T) {
+			harness := newHarness(t)
+			detector := newFDProbe(t, harness.Maker())
+			t.Cleanup(func() {
+				detector.close()
+				if err := harness.Close(); err != nil {
+					t.Errorf("could not close harness: %v", err)
+				}
+			})
+			check(t, harness, detector)
+		})
+	}
+
+	run("NotAliveBeforeStart", func(t *testing.T, harness FailureDetectorHarness, detector *fdProbe) {
+		deadline := time.Now().Add(cfg.observation)
+		for time.Now().Before(deadline) {
+			if failed, ok := detector.read(id); ok && !failed {
+				t.Fatalf("archetype %v reported alive before it started", id)
+			}
+			time.Sleep(pollInterval)
+		}
+	})
+	run("DetectsCrash", func(t *testing.T, harness FailureDetectorHarness, detector *fdProbe) {
+		mustStart(t, harness, id)
+		detector.await(id, false, cfg.detectionBound, "started")
+		if err := harness.Crash(id); err != nil {
+			t.Fatalf("could not crash archetype %v: %v", id, err)
+		}
+		detector.await(id, true, cfg.detectionBound, "crashed")
+	})
+	run("NoFalsePositiveUnderDelay", func(t *testing.T, harness FailureDetectorHarness, detector *fdProbe) {
+		delaying, ok := harness.(DelayingFailureDetectorHarness)
+		if !ok {
+			t.Skip("the harness cannot delay communication")
+		}
+		mustStart(t, harness, id)
+		detector.await(id, false, cfg.detectionBound, "started")
+		if err := delaying.SetDelay(id, cfg.delay); err != nil {
+			t.Fatalf("could not delay archetype %v: %v", id, err)
+		}
+		deadline := time.Now().Add(cfg.observation)
+		for time.Now().Before(deadline) {
+			if failed, ok := detector.read(id); ok && failed {
+				t.Fatalf("live archetype %v reported failed under a delay of %v", id, cfg.delay)
+			}
+			time.Sleep(pollInterval)
+		}
+	})
+	run("RecoversAfterRejoin", func(t *testing.T, harness FailureDetectorHarness, detector *fdProbe) {
+		mustStart(t, harness, id)
+		detector.await(id, false, cfg.detectionBound, "started")
+		if err := harness.Crash(id); err != nil {
+			t.Fatalf("could not crash archetype %v: %v", id, err)
+		}
+		detector.await(id, true, cfg.detectionBound, "crashed")
+		mustStart(t, harness, id)
+		detector.await(id, false, cfg.detectionBound, "rejoined")
+	})
+}
+
+func mustStart(t *testing.T, harness FailureDetectorHarness, id tla.TLAValue) {
+	t.Helper()
+	if err := harness.Start(id); err != nil {
+		t.Fatalf("could not start archetype %v: %v", id, err)
+	}
+}
+
+// fdProbe reads a failure detector resource the way an archetype would, one critical section per read.
+type fdProbe struct {
+	t   *testing.T
+	res distsys.ArchetypeResource
+}
+
+func newFDProbe(t *testing.T, maker distsys.ArchetypeResourceMaker) *fdProbe {
+	res := maker.Make()
+	maker.Configure(res)
+	return &fdProbe{t: t, res: res}
+}
+
+// read returns whether the archetype with the given ID is considered failed, or false if the read aborted.
+func (probe *fdProbe) read(id tla.TLAValue) (failed bool, ok bool) {
+	probe.t.Helper()
+	subRes, err := probe.res.Index(id)
+	if err != nil {
+		probe.t.Fatalf("could not index failure detector by %v: %v", id, err)
+	}
+	value, err := subRes.ReadValue()
+	if err == distsys.ErrCriticalSectionAborted {
+		probe.end(probe.res.Abort())
+		return false, false
+	}
+	if err != nil {
+		probe.t.Fatalf("could not read failure detector at %v: %v", id, err)
+	}
+	if !value.IsBool() {
+		probe.t.Fatalf("failure detector gave %v for %v, which is not a boolean", value, id)
+	}
+	if ch := probe.res.PreCommit(); ch != nil {
+		if err := <-ch; err != nil {
+			probe.t.Fatalf("could not commit failure detector read: %v", err)
+		}
+	}
+	probe.end(probe.res.Commit())
+	return value.AsBool(), true
+}
+
+func (probe *fdProbe) end(ch chan struct{}) {
+	if ch != nil {
+		<-ch
+	}
+}
+
+// await fails the test unless the detector reports the archetype as failed, or alive, within bound.
+func (probe *fdProbe) await(id tla.TLAValue, failed bool, bound time.Duration, event string) {
+	probe.t.Helper()
+	start := time.Now()
+	for time.Since(start) < bound {
+		if actual, ok := probe.read(id); ok && actual == failed {
+			return
+		}
+		time.Sleep(pollInterval)
+	}
+	state := "alive"
+	if failed {
+		state = "failed"
+	}
+	probe.t.Fatalf("archetype %v was not reported %s within %v of being %s", id, state, bound, event)
+}
+
+func (probe *fdProbe) close() {
+	if err := probe.res.Close(); err != nil {
+		probe.t.Errorf("could not close failure detector: %v", err)
+	}
+}
+
+// MonitorHarness is a DelayingFailureDetectorHarness for the failure detector of resources.FailureDetectorMaker.
+// It runs archetypes in a resources.Monitor, crashing them by closing their contexts, and delays the detector's
+// queries to the monitor with a proxy.
+type MonitorHarness struct {
+	t       testing.TB
+	monitor *resources.Monitor
+	proxy   *delayProxy
+	opts    []resources.FailureDetectorOption
+
+	lock sync.Mutex
+	ctxs map[int32]*distsys.MPCalContext
+}
+
+var _ DelayingFailureDetectorHarness = &MonitorHarness{}
+
+// NewMonitorHarness starts a monitor, and returns a harness whose detectors are configured with opts.
+func NewMonitorHarness(t testing.TB, opts ...resources.FailureDetectorOption) *MonitorHarness {
+	monitorAddr := FreeAddress(t)
+	monitor := resources.NewMonitor(monitorAddr)
+	go func() {
+		_ = monitor.ListenAndServe()
+	}()
+	proxy, err := newDelayProxy(monitorAddr)
+	if err != nil {
+		t.Fatalf("could not start proxy to monitor: %v", err)
+	}
+	return &MonitorHarness{
+		t:       t,
+		monitor: monitor,
+		proxy:   proxy,
+		opts:    opts,
+		ctxs:    make(map[int32]*distsys.MPCalContext),
+	}
+}
+
+func (harness *MonitorHarness) Maker() distsys.ArchetypeResourceMaker {
+	return resources.FailureDetectorMaker(func(tla.TLAValue) string {
+		return harness.proxy.addr()
+	}, harness.opts...)
+}
+
+// idleArchetype runs forever, doing nothing, so that the monitor sees it alive until its context is closed.
+var idleArchetype = distsys.MPCalArchetype{
+	Name:  "Idle",
+	Label: "Idle.idle",
+	JumpTable: distsys.MakeMPCalJumpTable(distsys.MPCalCriticalSection{
+		Name: "Idle.idle",
+		Body: func(iface distsys.ArchetypeInterface) error {
+			time.Sleep(pollInterval)
+			return iface.Goto("Idle.idle")
+		},
+	}),
+	ProcTable: distsys.MakeMPCalProcTable(),
+	PreAmble:  func(distsys.ArchetypeInterface) {},
+}
+
+// Start runs an idle archetype with the given ID, which must be a number, in the monitor.
+func (harness *MonitorHarness) Start(id tla.TLAValue) error {
+	harness.lock.Lock()
+	defer harness.lock.Unlock()
+	ctx := distsys.NewMPCalContext(id, idleArchetype)
+	harness.ctxs[id.AsNumber()] = ctx
+	go func() {
+		_ = harness.monitor.RunArchetype(ctx)
+	}()
+	return nil
+}
+
+// Crash closes the context of the archetype with the given ID, which the monitor then reports as failed.
+func (harness *MonitorHarness) Crash(id tla.TLAValue) error {
+	harness.lock.Lock()
+	defer harness.lock.Unlock()
+	ctx, ok := harness.ctxs[id.AsNumber()]
+	if !ok {
+		return nil
+	}
+	delete(harness.ctxs, id.AsNumber())
+	return ctx.Close()
+}
+
+// SetDelay delays all traffic between the detectors and the monitor, whatever the ID.
+func (harness *MonitorHarness) SetDelay(id tla.TLAValue, delay time.Duration) error {
+	harness.proxy.setDelay(delay)
+	return nil
+}
+
+func (harness *MonitorHarness) Close() error {
+	harness.lock.Lock()
+	for id, ctx := range harness.ctxs {
+		_ = ctx.Close()
+		delete(harness.ctxs, id)
+	}
+	harness.lock.Unlock()
+	harness.proxy.close()
+	return harness.monitor.Close()
+}
+
+// delayProxy forwards TCP connections to a target address, holding back each chunk of data, in each direction, by
+// an adjustable delay.
+type delayProxy struct {
+	listener net.Listener
+	target   string
+	delay    int64 // a time.Duration, accessed atomically
+
+	lock  sync.Mutex
+	conns []net.Conn
+}
+
+func newDelayProxy(target string) (*delayProxy, error) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return nil, err
+	}
+	proxy := &delayProxy{listener: listener, target: target}
+	go proxy.serve()
+	return proxy, nil
+}
+
+func (proxy *delayProxy) addr() string {
+	return proxy.listener.Addr().String()
+}
+
+func (proxy *delayProxy) setDelay(delay time.Duration) {
+	atomic.StoreInt64(&proxy.delay, int64(delay))
+}
+
+func (proxy *delayProxy) serve() {
+	for {
+		conn, err := proxy.listener.Accept()
+		if err != nil {
+			return
+		}
+		upstream, err := net.Dial("tcp", proxy.target)
+		if err != nil {
+			_ = conn.Close()
+			continue
+		}
+		proxy.lock.Lock()
+		proxy.conns = append(proxy.conns, conn, upstream)
+		proxy.lock.Unlock()
+		go proxy.forward(upstream, conn)
+		go proxy.forward(conn, upstream)
+	}
+}
+
+func (proxy *delayProxy) forward(dst io.WriteCloser, src io.ReadCloser) {
+	defer dst.Close()
+	defer src.Close()
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			time.Sleep(time.Duration(atomic.LoadInt64(&proxy.delay)))
+			if _, err := dst.Write(buf[:n]); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (proxy *delayProxy) close() {
+	_ = proxy.listener.Close()
+	proxy.lock.Lock()
+	defer proxy.lock.Unlock()
+	for _, conn := range proxy.conns {
+		_ = conn.Close()
+	}
+}
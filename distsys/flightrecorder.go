@@ -0,0 +1,235 @@
+package distsys
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// DefaultFlightRecorderSize is how many critical section outcomes each context remembers, unless configured
+// otherwise with WithFlightRecorderSize.
+const DefaultFlightRecorderSize = 256
+
+// FlightRecord is the outcome of one critical section, as remembered by a context's flight recorder.
+type FlightRecord struct {
+	Label   string
+	Start   time.Time
+	Elapsed time.Duration // covers execution and commit
+	// Outcome is one of "committed", "aborted", "done" or "failed".
+	Outcome string
+	// Err is the error that stopped the archetype, if Outcome is "failed".
+	Err error
+}
+
+func (record FlightRecord) String() string {
+	str := fmt.Sprintf("%s %s %s (%v)", record.Start.Format("15:04:05.000000"), record.Label, record.Outcome, record.Elapsed)
+	if record.Err != nil {
+		str += ": " + record.Err.Error()
+	}
+	return str
+}
+
+// flightRecorder is a ring buffer of the latest critical section outcomes of a context. It is always on, unless its
+// size is set to 0, and cheap enough to be: recording an outcome only takes an uncontended lock and a copy. The lock
+// is there so that the records can be dumped from another goroutine, e.g. on SIGQUIT.
+type flightRecorder struct {
+	lock    sync.Mutex
+	records []FlightRecord
+	next    int   // where the next record goes, once records is full
+	total   int64 // how many records were ever made, including overwritten ones
+	// dumpOnError receives the records when Run fails, if set with WithFlightRecorderDump
+	dumpOnError io.Writer
+}
+
+func newFlightRecorder(size int) *flightRecorder {
+	return &flightRecorder{records: make([]FlightRecord, 0, size)}
+}
+
+func flightRecordOutcome(err error) string {
+	switch {
+	case err == nil:
+		return "committed"
+	case errors.Is(err, ErrCriticalSectionAborted):
+		return "aborted"
+	case errors.Is(err, ErrDone):
+		return "done"
+	default:
+		return "failed"
+	}
+}
+
+func (recorder *flightRecorder) record(label string, start time.Time, err error) {
+	record := FlightRecord{
+		Label:   label,
+		Start:   start,
+		Elapsed: time.Since(start),
+		Outcome: flightRecordOutcome(err),
+	}
+	if record.Outcome == "failed" {
+		record.Err = err
+	}
+
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if len(recorder.records) < cap(recorder.records) {
+		recorder.records = append(recorder.records, record)
+	} else {
+		recorder.records[recorder.next] = record
+		recorder.next = (recorder.next + 1) % len(recorder.records)
+	}
+	recorder.total++
+}
+
+// snapshot returns the records, oldest first, along with the number of records ever made.
+func (recorder *flightRecorder) snapshot() ([]FlightRecord, int64) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	result := make([]FlightRecord, 0, len(recorder.records))
+	result = append(result, recorder.records[recorder.next:]...)
+	result = append(result, recorder.records[:recorder.next]...)
+	return result, recorder.total
+}
+
+// WithFlightRecorderSize sets how many critical section outcomes the context remembers, DefaultFlightRecorderSize by
+// default. A size of 0 disables the flight recorder.
+func WithFlightRecorderSize(size int) MPCalContextConfigFn {
+	if size < 0 {
+		panic("flight recorder size must not be negative")
+	}
+	return func(ctx *MPCalContext) {
+		if size == 0 {
+			ctx.flightRecorder = nil
+			return
+		}
+		dumpOnError := ctx.flightRecorder != nil && ctx.flightRecorder.dumpOnError != nil
+		recorder := newFlightRecorder(size)
+		if dumpOnError {
+			recorder.dumpOnError = ctx.flightRecorder.dumpOnError
+		}
+		ctx.flightRecorder = recorder
+	}
+}
+
+// WithFlightRecorderDump makes Run write the context's flight records to w when the archetype fails with an error,
+// as by DumpFlightRecords. It has no effect if the flight recorder is disabled.
+func WithFlightRecorderDump(w io.Writer) MPCalContextConfigFn {
+	return func(ctx *MPCalContext) {
+		if ctx.flightRecorder != nil {
+			ctx.flightRecorder.dumpOnError = w
+		}
+	}
+}
+
+// FlightRecords returns the latest critical section outcomes of the context, oldest first. It may be called from any
+// goroutine, including while the archetype runs. It returns nil if the flight recorder is disabled.
+func (ctx *MPCalContext) FlightRecords() []FlightRecord {
+	if ctx.flightRecorder == nil {
+		return nil
+	}
+	records, _ := ctx.flightRecorder.snapshot()
+	return records
+}
+
+// DumpFlightRecords writes the latest critical section outcomes of the context to w, oldest first, preceded by a
+// count of each outcome among them. A burst of aborts shows up as a run of "aborted" records, without needing tracing
+// to have been enabled beforehand.
+func (ctx *MPCalContext) DumpFlightRecords(w io.Writer) error {
+	if ctx.flightRecorder == nil {
+		_, err := fmt.Fprintf(w, "flight recorder of %v: disabled\n", ctx.self)
+		return err
+	}
+	records, total := ctx.flightRecorder.snapshot()
+	counts := make(map[string]int)
+	for _, record := range records {
+		counts[record.Outcome]++
+	}
+	_, err := fmt.Fprintf(w, "flight recorder of %v: last %d of %d critical sections: %d committed, %d aborted, %d done, %d failed\n",
+		ctx.self, len(records), total, counts["committed"], counts["aborted"], counts["done"], counts["failed"])
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if _, err := fmt.Fprintf(w, "  %v\n", record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runningContexts holds the contexts currently in Run, so they can be found by DumpFlightRecordsOnSignal and
+// debugging tools.
+var runningContexts = struct {
+	lock     sync.Mutex
+	contexts map[*MPCalContext]struct{}
+}{contexts: make(map[*MPCalContext]struct{})}
+
+func (ctx *MPCalContext) registerRunning() {
+	runningContexts.lock.Lock()
+	defer runningContexts.lock.Unlock()
+	runningContexts.contexts[ctx] = struct{}{}
+}
+
+func (ctx *MPCalContext) unregisterRunning() {
+	runningContexts.lock.Lock()
+	defer runningContexts.lock.Unlock()
+	delete(runningContexts.contexts, ctx)
+}
+
+// RunningContexts returns the contexts whose Run method is executing in the process, ordered by self.
+func RunningContexts() []*MPCalContext {
+	runningContexts.lock.Lock()
+	contexts := make([]*MPCalContext, 0, len(runningContexts.contexts))
+	for ctx := range runningContexts.contexts {
+		contexts = append(contexts, ctx)
+	}
+	runningContexts.lock.Unlock()
+	sort.Slice(contexts, func(i, j int) bool {
+		return contexts[i].self.String() < contexts[j].self.String()
+	})
+	return contexts
+}
+
+// DumpAllFlightRecords writes the flight records of every context currently running in the process to w.
+func DumpAllFlightRecords(w io.Writer) error {
+	for _, ctx := range RunningContexts() {
+		if err := ctx.DumpFlightRecords(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpFlightRecordsOnSignal writes the flight records of every running context to w, as by DumpAllFlightRecords,
+// whenever the process receives one of sigs, SIGQUIT if none are given. Note that this replaces Go's default handling
+// of SIGQUIT, which dumps goroutines and exits. The returned function stops the handling of the signals.
+func DumpFlightRecordsOnSignal(w io.Writer, sigs ...os.Signal) (stop func()) {
+	if len(sigs) == 0 {
+		sigs = []os.Signal{syscall.SIGQUIT}
+	}
+	sigCh := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(sigCh, sigs...)
+	go func() {
+		for {
+			select {
+			case <-sigCh:
+				_ = DumpAllFlightRecords(w)
+			case <-done:
+				return
+			}
+		}
+	}()
+	var stopOnce sync.Once
+	return func() {
+		stopOnce.Do(func() {
+			signal.Stop(sigCh)
+			close(done)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+//go:build !windows && !plan9 && !js
+// +build !windows,!plan9,!js
+
+package distsys
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// lockedBuffer is a bytes.Buffer that can be written to from several goroutines.
+type lockedBuffer struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.String()
+}
+
+func TestDumpAllFlightRecordsOnSignal(t *testing.T) {
+	var contexts []*MPCalContext
+	errCh := make(chan error, 2)
+	for _, self := range []int32{1, 2} {
+		ctx := newFlightRecorderTestContext(self, func(int) error {
+			time.Sleep(time.Millisecond)
+			return nil
+		})
+		contexts = append(contexts, ctx)
+		go func() {
+			errCh <- ctx.Run()
+		}()
+	}
+	defer func() {
+		for _, ctx := range contexts {
+			if err := ctx.Close(); err != nil {
+				t.Error(err)
+			}
+		}
+		for range contexts {
+			if err := <-errCh; err != ErrContextClosed {
+				t.Errorf("expected the archetype to run until closed, got %v", err)
+			}
+		}
+	}()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for len(RunningContexts()) < len(contexts) {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the contexts to run")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	var dump lockedBuffer
+	stop := DumpFlightRecordsOnSignal(&dump, syscall.SIGUSR1)
+	defer stop()
+	for dump.String() == "" {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for the running contexts to be dumped, got %q", dump.String())
+		}
+		if err := syscall.Kill(syscall.Getpid(), syscall.SIGUSR1); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	// every running context is dumped, ordered by self
+	output := dump.String()
+	first, second := strings.Index(output, "flight recorder of 1:"), strings.Index(output, "flight recorder of 2:")
+	if first < 0 || first > second {
+		t.Fatalf("expected both contexts to be dumped in order, got %q", output)
+	}
+}
@@ -0,0 +1,142 @@
+package distsys
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// newFlightRecorderTestContext makes a context for self, running an archetype whose only critical section,
+// AFlight.loop, runs over and over, giving the result of outcome on its nth run, counting from 0.
+func newFlightRecorderTestContext(self int32, outcome func(n int) error, configFns ...MPCalContextConfigFn) *MPCalContext {
+	n := 0
+	archetype := MPCalArchetype{
+		Name:              "AFlight",
+		Label:             "AFlight.loop",
+		RequiredRefParams: []string{},
+		RequiredValParams: []string{},
+		JumpTable: MakeMPCalJumpTable(MPCalCriticalSection{
+			Name: "AFlight.loop",
+			Body: func(iface ArchetypeInterface) error {
+				err := outcome(n)
+				n++
+				if err != nil {
+					return err
+				}
+				return iface.Goto("AFlight.loop")
+			},
+		}),
+		ProcTable: MakeMPCalProcTable(),
+		PreAmble:  func(ArchetypeInterface) {},
+	}
+	return NewMPCalContext(tla.MakeTLANumber(self), archetype, configFns...)
+}
+
+// flightRecorderTestAbortOdd commits even runs and aborts odd ones.
+func flightRecorderTestAbortOdd(n int) error {
+	if n%2 == 1 {
+		return ErrCriticalSectionAborted
+	}
+	return nil
+}
+
+var flightRecordTestLine = regexp.MustCompile(`^  \d\d:\d\d:\d\d\.\d{6} AFlight\.loop (committed|aborted|done|failed) \(\S+\)(: .*)?$`)
+
+// flightRecorderTestDump dumps ctx's flight records, checks that each record is on a line of its own, and returns
+// the header line and the record lines.
+func flightRecorderTestDump(t *testing.T, ctx *MPCalContext) (string, []string) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := ctx.DumpFlightRecords(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	for _, line := range lines[1:] {
+		if !flightRecordTestLine.MatchString(line) {
+			t.Fatalf("expected a flight record, got %q", line)
+		}
+	}
+	return lines[0], lines[1:]
+}
+
+func TestFlightRecorderWraparound(t *testing.T) {
+	ctx := newFlightRecorderTestContext(7, flightRecorderTestAbortOdd, WithFlightRecorderSize(4))
+	defer ctx.Close()
+	for n := 0; n < 7; n++ {
+		if err := ctx.Step(); err != nil && err != ErrCriticalSectionAborted {
+			t.Fatal(err)
+		}
+	}
+
+	// only the last 4 of the 7 outcomes are kept, oldest first
+	records := ctx.FlightRecords()
+	expected := []string{"aborted", "committed", "aborted", "committed"}
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %v", len(expected), records)
+	}
+	for i, record := range records {
+		if record.Label != "AFlight.loop" || record.Outcome != expected[i] || record.Err != nil {
+			t.Fatalf("expected record %d to be a %s run of AFlight.loop, got %v", i, expected[i], record)
+		}
+		if i > 0 && record.Start.Before(records[i-1].Start) {
+			t.Fatalf("expected records oldest first, got %v", records)
+		}
+	}
+
+	header, lines := flightRecorderTestDump(t, ctx)
+	if expected := "flight recorder of 7: last 4 of 7 critical sections: 2 committed, 2 aborted, 0 done, 0 failed"; header != expected {
+		t.Fatalf("expected the header %q, got %q", expected, header)
+	}
+	if len(lines) != 4 || !strings.Contains(lines[0], " aborted ") || !strings.Contains(lines[3], " committed ") {
+		t.Fatalf("expected the 4 records, oldest first, got %q", lines)
+	}
+}
+
+func TestFlightRecorderFailure(t *testing.T) {
+	var dump bytes.Buffer
+	ctx := newFlightRecorderTestContext(7, func(n int) error {
+		if n == 2 {
+			return errors.New("boom")
+		}
+		return nil
+	}, WithFlightRecorderDump(&dump))
+	defer ctx.Close()
+	if err := ctx.Run(); err == nil || err.Error() != "boom" {
+		t.Fatalf("expected the archetype to fail, got %v", err)
+	}
+
+	// the failure is recorded with its error, and the records are dumped
+	records := ctx.FlightRecords()
+	if len(records) != 3 || records[2].Outcome != "failed" || records[2].Err == nil || records[2].Err.Error() != "boom" {
+		t.Fatalf("expected the third record to be the failure, got %v", records)
+	}
+	lines := strings.Split(strings.TrimSuffix(dump.String(), "\n"), "\n")
+	if expected := "flight recorder of 7: last 3 of 3 critical sections: 2 committed, 0 aborted, 0 done, 1 failed"; lines[0] != expected {
+		t.Fatalf("expected the header %q, got %q", expected, lines[0])
+	}
+	if len(lines) != 4 || !flightRecordTestLine.MatchString(lines[3]) || !strings.HasSuffix(lines[3], " failed ("+records[2].Elapsed.String()+"): boom") {
+		t.Fatalf("expected the failure to be dumped last, with its error, got %q", lines)
+	}
+}
+
+func TestFlightRecorderDisabled(t *testing.T) {
+	ctx := newFlightRecorderTestContext(7, flightRecorderTestAbortOdd, WithFlightRecorderSize(0))
+	defer ctx.Close()
+	if err := ctx.Step(); err != nil {
+		t.Fatal(err)
+	}
+	if records := ctx.FlightRecords(); records != nil {
+		t.Fatalf("expected no records, got %v", records)
+	}
+	var buf bytes.Buffer
+	if err := ctx.DumpFlightRecords(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "flight recorder of 7: disabled\n"; buf.String() != expected {
+		t.Fatalf("expected the dump %q, got %q", expected, buf.String())
+	}
+}
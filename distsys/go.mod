@@ -1,6 +1,6 @@
 module github.com/UBC-NSS/pgo/distsys
 
-go 1.13
+go 1.18
 
 require (
 	github.com/benbjohnson/immutable v0.3.0
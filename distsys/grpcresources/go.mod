@@ -0,0 +1,21 @@
+module github.com/UBC-NSS/pgo/distsys/grpcresources
+
+go 1.25.0
+
+replace github.com/UBC-NSS/pgo/distsys => ../
+
+require (
+	github.com/UBC-NSS/pgo/distsys v0.0.0-00010101000000-000000000000
+	google.golang.org/grpc v1.84.0
+	google.golang.org/protobuf v1.36.12
+)
+
+require (
+	github.com/benbjohnson/immutable v0.3.0 // indirect
+	go.uber.org/atomic v1.9.0 // indirect
+	go.uber.org/multierr v1.7.0 // indirect
+	golang.org/x/net v0.57.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260706201446-f0a921348800 // indirect
+)
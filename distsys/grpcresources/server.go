@@ -0,0 +1,182 @@
+package grpcresources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Codec converts between the messages of a gRPC method and TLA+ values. For protobuf services, it would typically
+// wrap generated message types, unmarshalling requests with proto.Unmarshal and building the corresponding TLA+
+// values, and the reverse for responses.
+//
+// A Codec may return an error made with status.Error to choose the status of a failed call; other errors are
+// reported as codes.InvalidArgument when decoding a request, and as codes.Internal when encoding a response.
+type Codec interface {
+	DecodeRequest(data []byte) (tla.TLAValue, error)
+	EncodeResponse(value tla.TLAValue) ([]byte, error)
+}
+
+// CodecFuncs adapts a pair of functions to the Codec interface.
+type CodecFuncs struct {
+	Decode func(data []byte) (tla.TLAValue, error)
+	Encode func(value tla.TLAValue) ([]byte, error)
+}
+
+var _ Codec = CodecFuncs{}
+
+func (codec CodecFuncs) DecodeRequest(data []byte) (tla.TLAValue, error) {
+	return codec.Decode(data)
+}
+
+func (codec CodecFuncs) EncodeResponse(value tla.TLAValue) ([]byte, error) {
+	return codec.Encode(value)
+}
+
+// JSONCodec encodes messages as JSON, as gRPC clients configured with a JSON codec send them. Values are converted
+// as described in resources.RPCEndpoint.ServeHTTP.
+type JSONCodec struct{}
+
+var _ Codec = JSONCodec{}
+
+func (JSONCodec) DecodeRequest(data []byte) (tla.TLAValue, error) {
+	return resources.DecodeRPCJSON(data)
+}
+
+func (JSONCodec) EncodeResponse(value tla.TLAValue) ([]byte, error) {
+	return resources.EncodeRPCJSON(value)
+}
+
+// MethodBinding binds one gRPC method to an archetype, through a resources.RequestResponseChannel passed to the
+// archetype with resources.RequestResponseChannelMaker, and converts its messages with Codec.
+type MethodBinding struct {
+	Channel *resources.RequestResponseChannel
+	Codec   Codec
+}
+
+// rawCodec passes messages through as bytes, leaving their conversion to each method's Codec.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	data, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("cannot send a %T as a raw message", v)
+	}
+	return data, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	dest, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("cannot receive a raw message into a %T", v)
+	}
+	*dest = append([]byte(nil), data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "raw"
+}
+
+// NewServer creates a gRPC server serving the unary methods in bindings, keyed by full method name, such as
+// "/helloworld.Greeter/SayHello", by forwarding each call to an archetype, as one request on the
+// RequestResponseChannel its method is bound to. The archetype reads calls and writes their responses in order, and
+// the runtime correlates them, as with resources.RPCEndpoint; the archetype never handles call IDs.
+//
+// The binding from methods to channels is made at run time: no code is generated from .proto files, and messages
+// are converted by each method's Codec rather than by a protobuf runtime. As the server passes all messages to the
+// codecs as bytes, it should only serve archetypes; register generated services on a server of their own.
+//
+// Calls are bounded by their deadline, if any, and by timeout; calls that take longer fail with
+// codes.DeadlineExceeded, and a timeout of 0 means no limit beyond the client's. The server is configured with
+// opts, e.g. grpc.Creds for TLS, and serves once the caller passes it a listener with Serve.
+func NewServer(bindings map[string]MethodBinding, timeout time.Duration, opts ...grpc.ServerOption) (*grpc.Server, error) {
+	services := make(map[string]*grpc.ServiceDesc)
+	for name, binding := range bindings {
+		trimmed := strings.TrimPrefix(name, "/")
+		split := strings.LastIndexByte(trimmed, '/')
+		if split <= 0 || split == len(trimmed)-1 {
+			return nil, fmt.Errorf("%q is not a full gRPC method name, of the form /package.Service/Method", name)
+		}
+		fullName, serviceName, methodName := "/"+trimmed, trimmed[:split], trimmed[split+1:]
+		desc, ok := services[serviceName]
+		if !ok {
+			desc = &grpc.ServiceDesc{
+				ServiceName: serviceName,
+				HandlerType: (*interface{})(nil),
+			}
+			services[serviceName] = desc
+		}
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: methodName,
+			Handler:    methodHandler(fullName, binding, timeout),
+		})
+	}
+
+	server := grpc.NewServer(append([]grpc.ServerOption{grpc.ForceServerCodec(rawCodec{})}, opts...)...)
+	for _, desc := range services {
+		server.RegisterService(desc, nil)
+	}
+	return server, nil
+}
+
+// methodHandler returns the handler of the method fullName, calling through any unary interceptor the server is
+// configured with.
+func methodHandler(fullName string, binding MethodBinding, timeout time.Duration) grpc.MethodHandler {
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return callArchetype(ctx, binding, timeout, *req.(*[]byte))
+	}
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		var data []byte
+		if err := dec(&data); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(ctx, &data)
+		}
+		return interceptor(ctx, &data, &grpc.UnaryServerInfo{Server: srv, FullMethod: fullName}, call)
+	}
+}
+
+// callArchetype forwards one call, whose request message is data, to the archetype bound to its method.
+func callArchetype(ctx context.Context, binding MethodBinding, timeout time.Duration, data []byte) ([]byte, error) {
+	request, err := binding.Codec.DecodeRequest(data)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "could not decode request: %v", err)
+	}
+
+	if timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	response, err := binding.Channel.Call(ctx, request)
+	switch err {
+	case nil:
+	case context.DeadlineExceeded:
+		return nil, status.Error(codes.DeadlineExceeded, "the archetype did not respond in time")
+	case context.Canceled:
+		return nil, status.Error(codes.Canceled, "the call was cancelled")
+	default:
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	encoded, err := binding.Codec.EncodeResponse(response)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "could not encode response: %v", err)
+	}
+	return encoded, nil
+}
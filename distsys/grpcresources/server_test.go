@@ -0,0 +1,185 @@
+package grpcresources
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// serveTestArchetype stands in for an archetype reading requests from ch, and responding to each with double its
+// value, until the test ends.
+func serveTestArchetype(t *testing.T, ch *resources.RequestResponseChannel) {
+	maker := resources.RequestResponseChannelMaker(ch)
+	res := maker.Make()
+	maker.Configure(res)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	t.Cleanup(func() {
+		close(done)
+		<-stopped
+	})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			request, err := res.ReadValue()
+			if errors.Is(err, distsys.ErrCriticalSectionAborted) {
+				if ch := res.Abort(); ch != nil {
+					<-ch
+				}
+				continue
+			}
+			if err == nil {
+				err = res.WriteValue(tla.MakeTLANumber(request.AsNumber() * 2))
+			}
+			if err == nil {
+				if ch := res.PreCommit(); ch != nil {
+					err = <-ch
+				}
+			}
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if ch := res.Commit(); ch != nil {
+				<-ch
+			}
+		}
+	}()
+}
+
+// startTestServer serves server on a local port until the test ends, and returns a client connected to it.
+func startTestServer(t *testing.T, server *grpc.Server) *grpc.ClientConn {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	client, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client
+}
+
+// testCall makes a unary call, returning the response message and the call's status.
+func testCall(t *testing.T, ctx context.Context, client *grpc.ClientConn, method, request string) (string, *status.Status) {
+	t.Helper()
+	var response []byte
+	err := client.Invoke(ctx, method, []byte(request), &response, grpc.ForceCodec(rawCodec{}))
+	return string(response), status.Convert(err)
+}
+
+func TestServer(t *testing.T) {
+	double, stalled := resources.NewRequestResponseChannel(1), resources.NewRequestResponseChannel(1)
+	serveTestArchetype(t, double)
+	const serverTimeout = time.Second
+	var interceptedLock sync.Mutex
+	var intercepted []string
+	server, err := NewServer(map[string]MethodBinding{
+		"test.Doubler/Double": {Channel: double, Codec: JSONCodec{}},
+		// no archetype serves this one
+		"/test.Doubler/Stall": {Channel: stalled, Codec: JSONCodec{}},
+		"/test.Doubler/Deny": {Channel: double, Codec: CodecFuncs{
+			Decode: func([]byte) (tla.TLAValue, error) {
+				return tla.TLAValue{}, status.Error(codes.PermissionDenied, "denied")
+			},
+		}},
+	}, serverTimeout, grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		interceptedLock.Lock()
+		intercepted = append(intercepted, info.FullMethod)
+		interceptedLock.Unlock()
+		return handler(ctx, req)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := startTestServer(t, server)
+
+	t.Run("unary call", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			response, callStatus := testCall(t, context.Background(), client, "/test.Doubler/Double", strconv.Itoa(i))
+			if callStatus.Code() != codes.OK || response != strconv.Itoa(i*2) {
+				t.Fatalf("expected %d with status OK, got %q with status %v", i*2, response, callStatus)
+			}
+		}
+		interceptedLock.Lock()
+		defer interceptedLock.Unlock()
+		if len(intercepted) != 3 || intercepted[0] != "/test.Doubler/Double" {
+			t.Fatalf("expected each call to go through the interceptor, got %v", intercepted)
+		}
+	})
+
+	t.Run("client deadline", func(t *testing.T) {
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		if _, callStatus := testCall(t, ctx, client, "/test.Doubler/Stall", "1"); callStatus.Code() != codes.DeadlineExceeded {
+			t.Fatalf("expected DEADLINE_EXCEEDED, got %v", callStatus)
+		}
+		if elapsed := time.Since(start); elapsed >= serverTimeout {
+			t.Fatalf("expected the call to time out after 50ms, but it took %v", elapsed)
+		}
+	})
+
+	t.Run("server timeout", func(t *testing.T) {
+		start := time.Now()
+		if _, callStatus := testCall(t, context.Background(), client, "/test.Doubler/Stall", "1"); callStatus.Code() != codes.DeadlineExceeded {
+			t.Fatalf("expected DEADLINE_EXCEEDED, got %v", callStatus)
+		}
+		if elapsed := time.Since(start); elapsed < serverTimeout {
+			t.Fatalf("expected the call to time out after %v, but it took %v", serverTimeout, elapsed)
+		}
+	})
+
+	for _, test := range []struct {
+		name    string
+		method  string
+		request string
+		code    codes.Code
+	}{
+		{"unknown method", "/test.Doubler/Triple", "1", codes.Unimplemented},
+		{"unknown service", "/test.Tripler/Triple", "1", codes.Unimplemented},
+		{"invalid message", "/test.Doubler/Double", "{", codes.InvalidArgument},
+		{"codec status", "/test.Doubler/Deny", "1", codes.PermissionDenied},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, callStatus := testCall(t, context.Background(), client, test.method, test.request)
+			if callStatus.Code() != test.code || callStatus.Message() == "" {
+				t.Fatalf("expected status %v with a message, got %v", test.code, callStatus)
+			}
+		})
+	}
+}
+
+func TestServerMethodNames(t *testing.T) {
+	for _, name := range []string{"Double", "/Double", "test.Doubler/", "/test.Doubler/"} {
+		if _, err := NewServer(map[string]MethodBinding{name: {}}, 0); err == nil {
+			t.Errorf("expected %q to be rejected as a method name", name)
+		}
+	}
+}
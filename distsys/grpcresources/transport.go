@@ -0,0 +1,483 @@
+// Package grpcresources provides a gRPC transport for TCP mailboxes, and a gRPC server forwarding calls to
+// archetypes, both built on grpc-go.
+//
+// It is a separate module from distsys, so that only programs using it depend on grpc-go, and on the Go version it
+// requires.
+package grpcresources
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	mailboxService = "pgo.distsys.Mailboxes"
+	mailboxStream  = "Stream"
+	mailboxMethod  = "/" + mailboxService + "/" + mailboxStream
+	// chunkSize bounds the data carried by one message, well below gRPC's default limit of 4MiB
+	chunkSize = 1 << 20
+	// linger is how long closing a connection waits for the other side to finish, so that what was written last
+	// is not lost when the stream is cancelled
+	linger = 1 * time.Second
+)
+
+var errMailboxRejected = errors.New("gRPC mailbox stream rejected")
+
+var mailboxStreamDesc = grpc.StreamDesc{
+	StreamName:    mailboxStream,
+	ServerStreams: true,
+	ClientStreams: true,
+}
+
+// Transport carries each mailbox connection as a call to a bidirectional streaming gRPC method, over TLS. The
+// method is Stream, of the service
+//
+//	package pgo.distsys;
+//
+//	import "google/protobuf/wrappers.proto";
+//
+//	service Mailboxes {
+//	  rpc Stream(stream google.protobuf.BytesValue) returns (stream google.protobuf.BytesValue);
+//	}
+//
+// where each message carries a piece of the connection's byte stream; message boundaries carry no meaning. As
+// connections are ordinary gRPC calls, they can pass through gRPC-aware proxies and load balancers, and all
+// connections to the same address are multiplexed over one HTTP/2 connection. Addresses are host:port pairs, as for
+// resources.DefaultMailboxTransport.
+//
+// Listeners use serverConfig, which must provide a certificate, and dialers use clientConfig; requiring client
+// certificates in serverConfig gives mutual authentication between nodes, as with resources.TLSMailboxTransport.
+func Transport(serverConfig, clientConfig *tls.Config) resources.MailboxTransport {
+	return &grpcTransport{
+		serverConfig: serverConfig,
+		clientConfig: clientConfig,
+		clients:      make(map[string]*grpc.ClientConn),
+	}
+}
+
+type grpcTransport struct {
+	serverConfig, clientConfig *tls.Config
+
+	lock    sync.Mutex
+	clients map[string]*grpc.ClientConn // by dialed address; shared by all the mailbox connections to that address
+}
+
+var _ resources.MailboxTransport = &grpcTransport{}
+
+// Listen accepts gRPC mailbox streams on addr. Closing the listener stops accepting streams, but, as with TCP,
+// streams already accepted stay open until closed themselves.
+func (transport *grpcTransport) Listen(addr string) (net.Listener, error) {
+	if transport.serverConfig == nil || (len(transport.serverConfig.Certificates) == 0 && transport.serverConfig.GetCertificate == nil) {
+		return nil, fmt.Errorf("cannot listen for gRPC mailbox streams on %s without a TLS certificate", addr)
+	}
+	baseListener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	listener := &streamListener{
+		Listener: baseListener,
+		server:   grpc.NewServer(grpc.Creds(credentials.NewTLS(transport.serverConfig))),
+		conns:    make(chan net.Conn),
+		closed:   make(chan struct{}),
+	}
+	desc := mailboxStreamDesc
+	desc.Handler = listener.serveStream
+	listener.server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: mailboxService,
+		HandlerType: (*interface{})(nil),
+		Streams:     []grpc.StreamDesc{desc},
+	}, listener)
+	go func() {
+		_ = listener.server.Serve(baseListener)
+		_ = listener.Close()
+	}()
+	return listener, nil
+}
+
+// clientTo returns the client connection to addr, shared by all the streams to it. The connection reconnects by
+// itself when it fails.
+func (transport *grpcTransport) clientTo(addr string) (*grpc.ClientConn, error) {
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+	if client, ok := transport.clients[addr]; ok {
+		return client, nil
+	}
+	client, err := grpc.NewClient(addr, grpc.WithTransportCredentials(credentials.NewTLS(transport.clientConfig)))
+	if err != nil {
+		return nil, err
+	}
+	transport.clients[addr] = client
+	return client, nil
+}
+
+// Dial opens a gRPC mailbox stream to addr, returning once the other side has accepted it.
+func (transport *grpcTransport) Dial(addr string, timeout time.Duration) (net.Conn, error) {
+	client, err := transport.clientTo(addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	timer := time.AfterFunc(timeout, cancel)
+	stream, err := client.NewStream(ctx, &mailboxStreamDesc, mailboxMethod)
+	var header metadata.MD
+	if err == nil {
+		header, err = stream.Header()
+	}
+	if err == nil && header == nil {
+		// the stream ended without being accepted; its status says why
+		err = stream.RecvMsg(new(wrapperspb.BytesValue))
+		if st, ok := status.FromError(err); ok {
+			err = fmt.Errorf("%w with status %v: %s", errMailboxRejected, st.Code(), st.Message())
+		}
+	}
+	if !timer.Stop() {
+		err = fmt.Errorf("timed out opening a gRPC mailbox stream to %s", addr)
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	conn := newStreamConn(stream, streamAddr("client"), streamAddr(addr))
+	conn.onClose = func() {
+		// end our side of the stream once no write is in progress, then cancel it once the other side has ended it
+		// too, or after a while
+		go func() {
+			lingered := time.After(linger)
+			select {
+			case <-conn.writesDone():
+				_ = stream.CloseSend()
+				select {
+				case <-conn.readDone:
+				case <-lingered:
+				}
+			case <-lingered:
+			}
+			cancel()
+		}()
+	}
+	go conn.readLoop()
+	return conn, nil
+}
+
+type streamAddr string
+
+func (addr streamAddr) Network() string {
+	return "grpc"
+}
+
+func (addr streamAddr) String() string {
+	return string(addr)
+}
+
+// streamListener queues the streams of calls to the mailbox method for Accept.
+type streamListener struct {
+	net.Listener
+	server *grpc.Server
+
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	lock   sync.Mutex
+	active int // the streams being served
+}
+
+func (listener *streamListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-listener.conns:
+		return conn, nil
+	case <-listener.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close stops accepting streams. The server is stopped once the streams it accepted are closed too.
+func (listener *streamListener) Close() error {
+	var err error
+	listener.closeOnce.Do(func() {
+		listener.lock.Lock()
+		close(listener.closed)
+		if listener.active == 0 {
+			go listener.server.Stop()
+		}
+		listener.lock.Unlock()
+		err = listener.Listener.Close()
+	})
+	return err
+}
+
+func (listener *streamListener) serveStream(_ interface{}, stream grpc.ServerStream) error {
+	listener.lock.Lock()
+	select {
+	case <-listener.closed:
+		listener.lock.Unlock()
+		return status.Error(codes.Unavailable, "not accepting mailbox streams")
+	default:
+	}
+	listener.active++
+	listener.lock.Unlock()
+	defer func() {
+		listener.lock.Lock()
+		listener.active--
+		select {
+		case <-listener.closed:
+			if listener.active == 0 {
+				go listener.server.Stop()
+			}
+		default:
+		}
+		listener.lock.Unlock()
+	}()
+
+	remoteAddr := streamAddr("unknown")
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		remoteAddr = streamAddr(p.Addr.String())
+	}
+	conn := newStreamConn(stream, streamAddr(listener.Addr().String()), remoteAddr)
+	// the response headers, which complete the client's Dial, are only sent once the stream is accepted, and before
+	// anything written to it
+	conn.headersSent = make(chan struct{})
+	select {
+	case listener.conns <- conn:
+	case <-listener.closed:
+		return status.Error(codes.Unavailable, "not accepting mailbox streams")
+	case <-stream.Context().Done():
+		return stream.Context().Err()
+	}
+	go conn.readLoop()
+	err := stream.SendHeader(metadata.MD{})
+	close(conn.headersSent)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	select {
+	case <-conn.closed:
+	case <-stream.Context().Done():
+		_ = conn.Close()
+	}
+	// the stream ends when we return, after which it must not be written to
+	select {
+	case <-conn.writesDone():
+	case <-time.After(linger):
+	}
+	return nil
+}
+
+// messageStream is what client and server streams have in common.
+type messageStream interface {
+	SendMsg(m interface{}) error
+	RecvMsg(m interface{}) error
+}
+
+// streamConn presents the data of the messages exchanged over a gRPC stream as a byte stream. Each Write is sent
+// as one or more messages, and messages are read back-to-back.
+//
+// Deadlines apply to the reads and writes started after they are set, which is how mailboxes use them. A write
+// that misses its deadline leaves part of the data unsent, so the connection is closed.
+type streamConn struct {
+	stream      messageStream
+	headersSent chan struct{} // if not nil, closed once writing to the stream is allowed
+	onClose     func()
+	localAddr   net.Addr
+	remoteAddr  net.Addr
+
+	messages chan []byte // the data read by readLoop
+	readDone chan struct{}
+	readErr  error // set before readDone is closed
+	pending  []byte
+
+	writeLock sync.Mutex
+	writes    sync.WaitGroup // sends in progress
+
+	lock          sync.Mutex
+	readDeadline  time.Time
+	writeDeadline time.Time
+	isClosed      bool
+	closed        chan struct{}
+}
+
+var _ net.Conn = &streamConn{}
+
+func newStreamConn(stream messageStream, localAddr, remoteAddr net.Addr) *streamConn {
+	return &streamConn{
+		stream:     stream,
+		localAddr:  localAddr,
+		remoteAddr: remoteAddr,
+		messages:   make(chan []byte),
+		readDone:   make(chan struct{}),
+		closed:     make(chan struct{}),
+	}
+}
+
+func (conn *streamConn) readLoop() {
+	defer close(conn.readDone)
+	for {
+		msg := new(wrapperspb.BytesValue)
+		if err := conn.stream.RecvMsg(msg); err != nil {
+			conn.readErr = err
+			return
+		}
+		if len(msg.Value) == 0 {
+			continue
+		}
+		select {
+		case conn.messages <- msg.Value:
+		case <-conn.closed:
+			conn.readErr = net.ErrClosed
+			return
+		}
+	}
+}
+
+// writesDone returns a channel that is closed once no write is in progress, as of when the connection closed.
+func (conn *streamConn) writesDone() <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		conn.writes.Wait()
+		close(done)
+	}()
+	return done
+}
+
+// deadlineTimer returns a channel that fires at deadline, or never if it is zero, and a function to release it.
+func deadlineTimer(deadline time.Time) (<-chan time.Time, func()) {
+	if deadline.IsZero() {
+		return nil, func() {}
+	}
+	timer := time.NewTimer(time.Until(deadline))
+	return timer.C, func() {
+		timer.Stop()
+	}
+}
+
+func (conn *streamConn) Read(data []byte) (int, error) {
+	for len(conn.pending) == 0 {
+		conn.lock.Lock()
+		timeout, stop := deadlineTimer(conn.readDeadline)
+		conn.lock.Unlock()
+		select {
+		case conn.pending = <-conn.messages:
+		case <-conn.readDone:
+			stop()
+			return 0, conn.readErr
+		case <-conn.closed:
+			stop()
+			return 0, net.ErrClosed
+		case <-timeout:
+			return 0, os.ErrDeadlineExceeded
+		}
+		stop()
+	}
+	n := copy(data, conn.pending)
+	conn.pending = conn.pending[n:]
+	return n, nil
+}
+
+func (conn *streamConn) Write(data []byte) (int, error) {
+	conn.writeLock.Lock()
+	defer conn.writeLock.Unlock()
+	written := 0
+	for written < len(data) {
+		end := written + chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		if err := conn.send(data[written:end]); err != nil {
+			return written, err
+		}
+		written = end
+	}
+	return written, nil
+}
+
+func (conn *streamConn) send(data []byte) error {
+	conn.lock.Lock()
+	if conn.isClosed {
+		conn.lock.Unlock()
+		return net.ErrClosed
+	}
+	timeout, stop := deadlineTimer(conn.writeDeadline)
+	defer stop()
+	// added under the lock, so that none are added once closing has started to wait for them
+	conn.writes.Add(1)
+	conn.lock.Unlock()
+
+	result := make(chan error, 1)
+	go func() {
+		defer conn.writes.Done()
+		if conn.headersSent != nil {
+			<-conn.headersSent
+		}
+		result <- conn.stream.SendMsg(wrapperspb.Bytes(data))
+	}()
+	select {
+	case err := <-result:
+		return err
+	case <-conn.closed:
+		return net.ErrClosed
+	case <-timeout:
+		_ = conn.Close()
+		return os.ErrDeadlineExceeded
+	}
+}
+
+func (conn *streamConn) Close() error {
+	conn.lock.Lock()
+	if conn.isClosed {
+		conn.lock.Unlock()
+		return nil
+	}
+	conn.isClosed = true
+	close(conn.closed)
+	conn.lock.Unlock()
+	if conn.onClose != nil {
+		conn.onClose()
+	}
+	return nil
+}
+
+func (conn *streamConn) LocalAddr() net.Addr {
+	return conn.localAddr
+}
+
+func (conn *streamConn) RemoteAddr() net.Addr {
+	return conn.remoteAddr
+}
+
+func (conn *streamConn) SetDeadline(t time.Time) error {
+	conn.lock.Lock()
+	defer conn.lock.Unlock()
+	conn.readDeadline, conn.writeDeadline = t, t
+	return nil
+}
+
+func (conn *streamConn) SetReadDeadline(t time.Time) error {
+	conn.lock.Lock()
+	defer conn.lock.Unlock()
+	conn.readDeadline = t
+	return nil
+}
+
+func (conn *streamConn) SetWriteDeadline(t time.Time) error {
+	conn.lock.Lock()
+	defer conn.lock.Unlock()
+	conn.writeDeadline = t
+	return nil
+}
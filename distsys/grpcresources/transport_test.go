@@ -0,0 +1,293 @@
+package grpcresources
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testTimeout = 5 * time.Second
+
+// testTLSConfigs returns server and client TLS configurations for 127.0.0.1, borrowed from an httptest.Server.
+func testTLSConfigs(t *testing.T) (serverConfig, clientConfig *tls.Config) {
+	t.Helper()
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+	serverConfig = &tls.Config{Certificates: server.TLS.Certificates}
+	clientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig.Clone()
+	return serverConfig, clientConfig
+}
+
+// testListen listens with transport, and closes the listener when the test ends.
+func testListen(t *testing.T, transport resources.MailboxTransport) net.Listener {
+	t.Helper()
+	listener, err := transport.Listen("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	return listener
+}
+
+// testDial opens a connection through transport to listener, and returns both ends.
+func testDial(t *testing.T, transport resources.MailboxTransport, listener net.Listener) (client, server net.Conn) {
+	t.Helper()
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			accepted <- conn
+		}
+	}()
+	client, err := transport.Dial(listener.Addr().String(), testTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	select {
+	case server = <-accepted:
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for Accept")
+	}
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+	return client, server
+}
+
+func TestTransport(t *testing.T) {
+	serverConfig, clientConfig := testTLSConfigs(t)
+	transport := Transport(serverConfig, clientConfig)
+
+	t.Run("round trip", func(t *testing.T) {
+		listener := testListen(t, transport)
+		client, server := testDial(t, transport, listener)
+		// the largest write is split into several messages
+		for _, size := range []int{5, 300, 3*chunkSize + 7} {
+			data := bytes.Repeat([]byte{byte(size)}, size)
+			for _, pair := range [][2]net.Conn{{client, server}, {server, client}} {
+				from, to := pair[0], pair[1]
+				go func() {
+					_, _ = from.Write(data)
+				}()
+				received := make([]byte, size)
+				if err := to.SetReadDeadline(time.Now().Add(testTimeout)); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := io.ReadFull(to, received); err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(received, data) {
+					t.Fatalf("sent %d bytes, but received different bytes", size)
+				}
+			}
+		}
+	})
+
+	t.Run("multiplexing", func(t *testing.T) {
+		listener := testListen(t, transport)
+		client1, server1 := testDial(t, transport, listener)
+		client2, server2 := testDial(t, transport, listener)
+		if server1.RemoteAddr().String() != server2.RemoteAddr().String() {
+			t.Fatalf("expected both streams to share one connection, but they came from %v and %v", server1.RemoteAddr(), server2.RemoteAddr())
+		}
+		// the streams are independent
+		for _, pair := range [][3]interface{}{{client1, server1, "one"}, {client2, server2, "two"}} {
+			if _, err := pair[0].(net.Conn).Write([]byte(pair[2].(string))); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, pair := range [][2]interface{}{{server2, "two"}, {server1, "one"}} {
+			received := make([]byte, 3)
+			if _, err := io.ReadFull(pair[0].(net.Conn), received); err != nil || string(received) != pair[1] {
+				t.Fatalf("expected %q, received %q, %v", pair[1], received, err)
+			}
+		}
+	})
+
+	t.Run("deadlines and close", func(t *testing.T) {
+		listener := testListen(t, transport)
+		client, server := testDial(t, transport, listener)
+		if err := server.SetReadDeadline(time.Now().Add(50 * time.Millisecond)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := server.Read(make([]byte, 1)); !errors.Is(err, os.ErrDeadlineExceeded) {
+			t.Fatalf("expected the read to miss its deadline, got %v", err)
+		}
+		// a missed read deadline leaves the connection usable
+		if err := server.SetReadDeadline(time.Time{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Write([]byte("last")); err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Close(); err != nil {
+			t.Fatal(err)
+		}
+		// what was written before closing arrives, followed by the end of the stream
+		if received, err := io.ReadAll(server); err != nil || string(received) != "last" {
+			t.Fatalf("expected %q then the end of the stream, received %q, %v", "last", received, err)
+		}
+		if _, err := client.Write([]byte("x")); !errors.Is(err, net.ErrClosed) {
+			t.Fatalf("expected writing to a closed connection to fail, got %v", err)
+		}
+		if err := server.Close(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("server close", func(t *testing.T) {
+		listener := testListen(t, transport)
+		client, server := testDial(t, transport, listener)
+		if err := server.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := client.SetReadDeadline(time.Now().Add(testTimeout)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Read(make([]byte, 1)); err != io.EOF {
+			t.Fatalf("expected the stream closed by the server to end, got %v", err)
+		}
+	})
+
+	t.Run("listener close", func(t *testing.T) {
+		listener, err := transport.Listen("127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		client, server := testDial(t, transport, listener)
+		if err := listener.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := listener.Accept(); !errors.Is(err, net.ErrClosed) {
+			t.Fatalf("expected Accept on a closed listener to fail with net.ErrClosed, got %v", err)
+		}
+		if _, err := transport.Dial(listener.Addr().String(), testTimeout); err == nil {
+			t.Fatal("expected dialing a closed listener to fail")
+		}
+		// streams already accepted survive the listener
+		if _, err := server.Write([]byte("pong")); err != nil {
+			t.Fatal(err)
+		}
+		received := make([]byte, 4)
+		if _, err := io.ReadFull(client, received); err != nil || string(received) != "pong" {
+			t.Fatalf("received %q, %v", received, err)
+		}
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		listener := testListen(t, transport)
+		client, err := transport.(*grpcTransport).clientTo(listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+		defer cancel()
+		stream, err := client.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}, "/pgo.distsys.Mailboxes/Other")
+		if err == nil {
+			err = stream.RecvMsg(new(struct{}))
+		}
+		if status.Code(err) != codes.Unimplemented {
+			t.Fatalf("expected the call to be rejected as UNIMPLEMENTED, got %v", err)
+		}
+	})
+
+	t.Run("no certificate", func(t *testing.T) {
+		if _, err := Transport(&tls.Config{}, clientConfig).Listen("127.0.0.1:0"); err == nil || !strings.Contains(err.Error(), "certificate") {
+			t.Fatalf("expected listening without a certificate to fail, got %v", err)
+		}
+	})
+}
+
+func TestTransportMailboxes(t *testing.T) {
+	serverConfig, clientConfig := testTLSConfigs(t)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	if err := listener.Close(); err != nil {
+		t.Fatal(err)
+	}
+	makeMailboxes := func(self int32) distsys.ArchetypeResource {
+		maker := resources.TCPMailboxesMaker(func(index tla.TLAValue) (resources.TCPMailboxKind, string) {
+			kind := resources.TCPMailboxesRemote
+			if index.AsNumber() == self {
+				kind = resources.TCPMailboxesLocal
+			}
+			return kind, addr
+		}, resources.WithTCPMailboxesTransport(Transport(serverConfig, clientConfig)))
+		res := maker.Make()
+		maker.Configure(res)
+		t.Cleanup(func() {
+			_ = res.Close()
+		})
+		return res
+	}
+	index := tla.MakeTLANumber(0)
+	mailboxIndex := func(res distsys.ArchetypeResource) distsys.ArchetypeResource {
+		t.Helper()
+		mailbox, err := res.Index(index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return mailbox
+	}
+	commit := func(res distsys.ArchetypeResource) {
+		t.Helper()
+		if ch := res.PreCommit(); ch != nil {
+			if err := <-ch; err != nil {
+				t.Fatal(err)
+			}
+		}
+		if ch := res.Commit(); ch != nil {
+			<-ch
+		}
+	}
+	receiver, sender := makeMailboxes(0), makeMailboxes(1)
+	mailboxIndex(receiver) // start listening
+
+	for _, value := range []int32{1, 2} {
+		if err := mailboxIndex(sender).WriteValue(tla.MakeTLANumber(value)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	commit(sender)
+
+	var values []tla.TLAValue
+	deadline := time.Now().Add(testTimeout)
+	for len(values) < 2 && time.Now().Before(deadline) {
+		value, err := mailboxIndex(receiver).ReadValue()
+		if errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, value)
+	}
+	commit(receiver)
+	if len(values) != 2 || !values[0].Equal(tla.MakeTLANumber(1)) || !values[1].Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected to receive 1 and 2, got %v", values)
+	}
+}
@@ -0,0 +1,120 @@
+package distsys
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// StatusArchetypeResource may be implemented by resources to describe their status in an ArchetypeState, e.g. which
+// connections they hold, or how many values are waiting to be read. Status is only called between critical sections,
+// from the goroutine running the archetype.
+type StatusArchetypeResource interface {
+	Status() string
+}
+
+// ResourceState describes one of the resources of an archetype, as part of an ArchetypeState.
+type ResourceState struct {
+	Handle ArchetypeResourceHandle
+	Type   string // the Go type of the resource
+	// Status is the description given by the resource, if it implements StatusArchetypeResource.
+	Status string
+}
+
+// ArchetypeState is a view of a context's state, as returned by MPCalContext.Inspect.
+type ArchetypeState struct {
+	Self      tla.TLAValue
+	Archetype string
+	Running   bool
+	// Label is the label of the next critical section to run, read from the program counter.
+	Label string
+	// Locals holds the committed values of the archetype's local state, including its program counter (.pc), call
+	// stack (.stack) and the bindings of its ref parameters.
+	Locals map[ArchetypeResourceHandle]tla.TLAValue
+	// Resources describes the archetype's other resources, ordered by handle.
+	Resources []ResourceState
+}
+
+func (state ArchetypeState) String() string {
+	var builder strings.Builder
+	_, _ = fmt.Fprintf(&builder, "archetype %s, self = %v, label = %s, running = %t\n", state.Archetype, state.Self, state.Label, state.Running)
+	handles := make([]string, 0, len(state.Locals))
+	for handle := range state.Locals {
+		handles = append(handles, string(handle))
+	}
+	sort.Strings(handles)
+	for _, handle := range handles {
+		_, _ = fmt.Fprintf(&builder, "  %s = %v\n", handle, state.Locals[ArchetypeResourceHandle(handle)])
+	}
+	for _, res := range state.Resources {
+		_, _ = fmt.Fprintf(&builder, "  %s: %s", res.Handle, res.Type)
+		if res.Status != "" {
+			_, _ = fmt.Fprintf(&builder, ", %s", strings.ReplaceAll(res.Status, "\n", "\n    "))
+		}
+		builder.WriteByte('\n')
+	}
+	return builder.String()
+}
+
+// localValuer is implemented by local state resources, whose committed value can be read without side effects.
+type localValuer interface {
+	localValue() tla.TLAValue
+}
+
+func (res *LocalArchetypeResource) localValue() tla.TLAValue {
+	if res.hasOldValue {
+		return res.oldValue
+	}
+	return res.value
+}
+
+// Inspect returns a view of the archetype's state, for debugging. If the archetype is running, the state is captured
+// between two critical sections, and Inspect blocks until then. If the context is closed, it returns
+// ErrContextClosed.
+func (ctx *MPCalContext) Inspect() (ArchetypeState, error) {
+	ctx.requireArchetype()
+	var state ArchetypeState
+	err := ctx.whilePaused(func() {
+		state = ctx.inspect()
+	})
+	return state, err
+}
+
+func (ctx *MPCalContext) inspect() ArchetypeState {
+	state := ArchetypeState{
+		Self:      ctx.self,
+		Archetype: ctx.archetype.Name,
+		Running:   ctx.running,
+		Locals:    make(map[ArchetypeResourceHandle]tla.TLAValue),
+	}
+	for handle, res := range ctx.resources {
+		if valuer, ok := res.(localValuer); ok {
+			state.Locals[handle] = valuer.localValue()
+			continue
+		}
+		resState := ResourceState{
+			Handle: handle,
+			Type:   fmt.Sprintf("%T", res),
+		}
+		if statusRes, ok := res.(StatusArchetypeResource); ok {
+			resState.Status = statusRes.Status()
+		}
+		state.Resources = append(state.Resources, resState)
+	}
+	sort.Slice(state.Resources, func(i, j int) bool {
+		return state.Resources[i].Handle < state.Resources[j].Handle
+	})
+	if pc, ok := state.Locals[".pc"]; ok {
+		state.Label = pc.AsString()
+	}
+	return state
+}
+
+// CurrentLabel returns the label of the running or most recently run critical section, or the empty string if none
+// has run yet. Unlike Inspect, it never blocks, so it is useful to find where a stuck archetype is.
+func (ctx *MPCalContext) CurrentLabel() string {
+	label, _ := ctx.currentLabel.Load().(string)
+	return label
+}
@@ -0,0 +1,66 @@
+package distsys
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvariantViolated is matched, using errors.Is, by the InvariantViolation returned by Run or Step once an
+// invariant registered WithInvariant does not hold.
+var ErrInvariantViolated = errors.New("invariant violated")
+
+// Invariant is a safety property of an archetype, checked against its state after each commit. It returns nil if
+// the property holds, and otherwise an error describing how it is violated. The state's Locals hold the archetype's
+// local state by handle, e.g. "AServer.log", so that properties of the spec can be stated over TLA+ values.
+type Invariant func(state ArchetypeState) error
+
+type namedInvariant struct {
+	name      string
+	invariant Invariant
+}
+
+// InvariantViolation is the error returned when an invariant registered WithInvariant does not hold.
+type InvariantViolation struct {
+	Name  string         // the name the invariant was registered with
+	Label string         // the critical section after whose commit the invariant was violated
+	State ArchetypeState // the violating state
+	Err   error          // the error returned by the invariant
+}
+
+func (violation *InvariantViolation) Error() string {
+	return fmt.Sprintf("invariant %s violated after %s: %v\n%v", violation.Name, violation.Label, violation.Err, strings.TrimSuffix(violation.State.String(), "\n"))
+}
+
+func (violation *InvariantViolation) Unwrap() error {
+	return violation.Err
+}
+
+func (violation *InvariantViolation) Is(target error) bool {
+	return target == ErrInvariantViolated
+}
+
+// WithInvariant checks invariant after each critical section the archetype commits. Once it is violated, the
+// archetype stops, and Run returns an InvariantViolation holding the violating state. As capturing the state after
+// every commit is costly, this is meant for tests. Invariants are checked in the order they were registered.
+func WithInvariant(name string, invariant Invariant) MPCalContextConfigFn {
+	return func(ctx *MPCalContext) {
+		ctx.invariants = append(ctx.invariants, namedInvariant{name: name, invariant: invariant})
+	}
+}
+
+// checkInvariants checks the registered invariants, after the critical section with the given label has committed.
+func (ctx *MPCalContext) checkInvariants(label string) error {
+	state := ctx.inspect()
+	for _, inv := range ctx.invariants {
+		if err := inv.invariant(state); err != nil {
+			return &InvariantViolation{
+				Name:  inv.name,
+				Label: label,
+				State: state,
+				Err:   err,
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package distsys
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func TestInvariantHolds(t *testing.T) {
+	checks := 0
+	ctx := newSnapshotTestContext(WithInvariant("count is at most 5", func(state ArchetypeState) error {
+		checks++
+		if count := state.Locals["ACount.count"]; count.AsNumber() > 5 {
+			return fmt.Errorf("count is %v", count)
+		}
+		return nil
+	}))
+	defer ctx.Close()
+	if err := ctx.Run(); err != nil {
+		t.Fatal(err)
+	}
+	// checked after each of the 5 counting steps; the Done label does not commit
+	if checks != 5 {
+		t.Fatalf("expected the invariant to be checked after each of 5 commits, got %d checks", checks)
+	}
+}
+
+func TestInvariantViolated(t *testing.T) {
+	var order []string
+	ctx := newSnapshotTestContext(
+		WithInvariant("always holds", func(ArchetypeState) error {
+			order = append(order, "always holds")
+			return nil
+		}),
+		WithInvariant("count is below 3", func(state ArchetypeState) error {
+			order = append(order, "count is below 3")
+			if count := state.Locals["ACount.count"]; count.AsNumber() >= 3 {
+				return fmt.Errorf("count is %v", count)
+			}
+			return nil
+		}),
+		WithInvariant("never checked", func(ArchetypeState) error {
+			order = append(order, "never checked")
+			return nil
+		}))
+	defer ctx.Close()
+
+	err := ctx.Run()
+	if !errors.Is(err, ErrInvariantViolated) {
+		t.Fatalf("expected an invariant violation, got %v", err)
+	}
+	var violation *InvariantViolation
+	if !errors.As(err, &violation) {
+		t.Fatalf("expected an InvariantViolation, got %T", err)
+	}
+	if violation.Name != "count is below 3" || violation.Label != "ACount.loop" || violation.Err == nil || violation.Err.Error() != "count is 3" {
+		t.Fatalf("expected the second invariant to be violated after ACount.loop, got %+v", violation)
+	}
+
+	// the violation is checked against the committed state, including resources written in the critical section
+	expectSnapshotTestLocals(t, violation.State.Locals, map[ArchetypeResourceHandle]tla.TLAValue{
+		".pc":          tla.MakeTLAString("ACount.loop"),
+		".stack":       tla.MakeTLATuple(),
+		"ACount.count": tla.MakeTLANumber(3),
+		"ACount.out":   tla.MakeTLAString("&ACount.out"),
+		"&ACount.out":  tla.MakeTLANumber(30),
+	})
+	expectSnapshotTestLocals(t, snapshotTestLocals(t, ctx), violation.State.Locals)
+
+	// invariants are checked in order, stopping at the first violated one
+	expected := []string{
+		"always holds", "count is below 3", "never checked",
+		"always holds", "count is below 3", "never checked",
+		"always holds", "count is below 3",
+	}
+	if fmt.Sprint(order) != fmt.Sprint(expected) {
+		t.Fatalf("expected the invariants to be checked as %q, got %q", expected, order)
+	}
+}
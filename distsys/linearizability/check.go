@@ -0,0 +1,275 @@
+package linearizability
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ErrNotLinearizable is matched, using errors.Is, by the NotLinearizableError returned by Check.
+var ErrNotLinearizable = errors.New("history is not linearizable")
+
+// ErrCheckTimedOut is returned by Check if it could not decide whether a history is linearizable in time.
+var ErrCheckTimedOut = errors.New("linearizability check timed out")
+
+// Model is a sequential specification of a system, against which histories are checked.
+type Model struct {
+	// Init returns the initial state.
+	Init func() tla.TLAValue
+	// Step applies op to state. It reports whether op is allowed in state, i.e. whether its output is correct, and
+	// returns the resulting state. If op did not return, any output should be allowed.
+	Step func(state tla.TLAValue, op Operation) (bool, tla.TLAValue)
+	// Partition, if set, splits a history into independent histories, e.g. one per key of a key-value store, which
+	// are checked separately. This is much faster than checking the whole history at once.
+	Partition func(ops []Operation) [][]Operation
+}
+
+// PartitionBy returns a Model.Partition function that groups operations by the value returned by key, e.g. the
+// key that an operation on a key-value store reads or writes.
+func PartitionBy(key func(op Operation) tla.TLAValue) func(ops []Operation) [][]Operation {
+	return func(ops []Operation) [][]Operation {
+		var keys []tla.TLAValue
+		var partitions [][]Operation
+	opsLoop:
+		for _, op := range ops {
+			k := key(op)
+			for i := range keys {
+				if keys[i].Equal(k) {
+					partitions[i] = append(partitions[i], op)
+					continue opsLoop
+				}
+			}
+			keys = append(keys, k)
+			partitions = append(partitions, []Operation{op})
+		}
+		return partitions
+	}
+}
+
+// KVOperation describes an operation on a key-value store, as decoded for KVModel.
+type KVOperation struct {
+	Key tla.TLAValue
+	// IsPut is true for an operation that writes Value to Key, and false for one that reads Key, returning Value.
+	IsPut bool
+	Value tla.TLAValue
+}
+
+// KVModel models a key-value store whose keys all start with the value initial. As the format of requests and
+// responses depends on the spec, decode must describe each recorded operation; the value of a get is only used if
+// the operation returned. Histories are partitioned by key.
+func KVModel(initial tla.TLAValue, decode func(op Operation) KVOperation) Model {
+	return Model{
+		Init: func() tla.TLAValue {
+			return initial
+		},
+		Step: func(state tla.TLAValue, op Operation) (bool, tla.TLAValue) {
+			kvOp := decode(op)
+			if kvOp.IsPut {
+				return true, kvOp.Value
+			}
+			return !op.Returned || kvOp.Value.Equal(state), state
+		},
+		Partition: PartitionBy(func(op Operation) tla.TLAValue {
+			return decode(op).Key
+		}),
+	}
+}
+
+// NotLinearizableError is returned by Check when a history is not linearizable.
+type NotLinearizableError struct {
+	// Operations is the (partition of the) history that is not linearizable.
+	Operations []Operation
+	// Longest is the longest sequence of operations of the history that could be linearized, in order, which helps
+	// find the first offending operation.
+	Longest []Operation
+}
+
+func (e *NotLinearizableError) Error() string {
+	var builder strings.Builder
+	_, _ = fmt.Fprintf(&builder, "%v: %d operations, of which at most %d can be linearized:", ErrNotLinearizable, len(e.Operations), len(e.Longest))
+	for _, op := range e.Longest {
+		_, _ = fmt.Fprintf(&builder, "\n  %s", formatOperation(op))
+	}
+	return builder.String()
+}
+
+func (e *NotLinearizableError) Is(target error) bool {
+	return target == ErrNotLinearizable
+}
+
+func formatOperation(op Operation) string {
+	if !op.Returned {
+		return fmt.Sprintf("client %v: %v -> (no response)", op.ClientID, op.Input)
+	}
+	return fmt.Sprintf("client %v: %v -> %v", op.ClientID, op.Input, op.Output)
+}
+
+type checkConfig struct {
+	timeout time.Duration
+}
+
+// CheckOption configures Check.
+type CheckOption func(cfg *checkConfig)
+
+// WithTimeout makes Check give up with ErrCheckTimedOut after timeout. By default, it never gives up, although
+// checking some histories takes time exponential in the number of concurrent operations.
+func WithTimeout(timeout time.Duration) CheckOption {
+	return func(cfg *checkConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// Check reports whether ops, as recorded by a History, are linearizable with respect to model: whether there is an
+// order of the operations, consistent with their call and return times, in which model accepts each of them.
+// Operations that did not return may be placed anywhere after their call, or left out. Check returns nil if the
+// history is linearizable, and a NotLinearizableError otherwise.
+func Check(model Model, ops []Operation, opts ...CheckOption) error {
+	var cfg checkConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	var deadline time.Time
+	if cfg.timeout > 0 {
+		deadline = time.Now().Add(cfg.timeout)
+	}
+	partitions := [][]Operation{ops}
+	if model.Partition != nil {
+		partitions = model.Partition(ops)
+	}
+	for _, partition := range partitions {
+		c := newChecker(model, partition, deadline)
+		ok, err := c.run()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &NotLinearizableError{
+				Operations: partition,
+				Longest:    c.longest,
+			}
+		}
+	}
+	return nil
+}
+
+// checker searches for a linearization of a history, in the manner of Wing and Gong, with the memoization of Lowe:
+// operations are linearized one at a time, each time choosing among those that may come first, and backtracking
+// from dead ends. Combinations of linearized operations and model states already found to be dead ends are not
+// explored again.
+type checker struct {
+	model    Model
+	ops      []Operation
+	deadline time.Time
+
+	linearized []bool
+	returned   int // how many returned operations remain to be linearized
+	path       []Operation
+	longest    []Operation
+	visited    map[string][]tla.TLAValue // linearized set -> model states already explored from it
+	steps      int
+}
+
+func newChecker(model Model, ops []Operation, deadline time.Time) *checker {
+	sorted := append([]Operation(nil), ops...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Call.Before(sorted[j].Call)
+	})
+	c := &checker{
+		model:      model,
+		ops:        sorted,
+		deadline:   deadline,
+		linearized: make([]bool, len(sorted)),
+		visited:    make(map[string][]tla.TLAValue),
+	}
+	for _, op := range sorted {
+		if op.Returned {
+			c.returned++
+		}
+	}
+	return c
+}
+
+func (c *checker) run() (bool, error) {
+	return c.search(c.model.Init())
+}
+
+func (c *checker) key() string {
+	var builder strings.Builder
+	for _, linearized := range c.linearized {
+		if linearized {
+			builder.WriteByte('1')
+		} else {
+			builder.WriteByte('0')
+		}
+	}
+	return builder.String()
+}
+
+// markVisited records that the search reached state with the current set of linearized operations, and reports
+// whether it had already done so.
+func (c *checker) markVisited(state tla.TLAValue) bool {
+	key := c.key()
+	for _, other := range c.visited[key] {
+		if other.Equal(state) {
+			return true
+		}
+	}
+	c.visited[key] = append(c.visited[key], state)
+	return false
+}
+
+func (c *checker) search(state tla.TLAValue) (bool, error) {
+	if c.returned == 0 {
+		return true, nil
+	}
+	c.steps++
+	if !c.deadline.IsZero() && c.steps%1000 == 0 && time.Now().After(c.deadline) {
+		return false, ErrCheckTimedOut
+	}
+	// an operation may come next only if it was called before every remaining operation returned
+	var firstReturn time.Time
+	for i, op := range c.ops {
+		if !c.linearized[i] && op.Returned && (firstReturn.IsZero() || op.Return.Before(firstReturn)) {
+			firstReturn = op.Return
+		}
+	}
+	for i, op := range c.ops {
+		if c.linearized[i] {
+			continue
+		}
+		if op.Call.After(firstReturn) {
+			// operations are sorted by call time, so no later one can come next either
+			break
+		}
+		ok, next := c.model.Step(state, op)
+		if !ok {
+			continue
+		}
+		c.linearized[i] = true
+		if c.markVisited(next) {
+			c.linearized[i] = false
+			continue
+		}
+		if op.Returned {
+			c.returned--
+		}
+		c.path = append(c.path, op)
+		if len(c.path) > len(c.longest) {
+			c.longest = append(c.longest[:0], c.path...)
+		}
+		found, err := c.search(next)
+		if found || err != nil {
+			return found, err
+		}
+		c.path = c.path[:len(c.path)-1]
+		if op.Returned {
+			c.returned++
+		}
+		c.linearized[i] = false
+	}
+	return false, nil
+}
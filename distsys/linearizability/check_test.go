@@ -0,0 +1,201 @@
+package linearizability
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+var checkTestStart = time.Unix(0, 0)
+
+func checkTestTime(ms int) time.Time {
+	return checkTestStart.Add(time.Duration(ms) * time.Millisecond)
+}
+
+// checkTestOp is an operation by client on key, which is a put if put is non-nil, and a get of value otherwise.
+// A returnMs of -1 means the operation never returned.
+type checkTestOp struct {
+	client           int32
+	key              string
+	put              *int32
+	value            int32
+	callMs, returnMs int
+}
+
+func checkTestPut(client int32, key string, value int32, callMs, returnMs int) checkTestOp {
+	return checkTestOp{client: client, key: key, put: &value, callMs: callMs, returnMs: returnMs}
+}
+
+func checkTestGet(client int32, key string, value int32, callMs, returnMs int) checkTestOp {
+	return checkTestOp{client: client, key: key, value: value, callMs: callMs, returnMs: returnMs}
+}
+
+func checkTestHistory(ops ...checkTestOp) []Operation {
+	var result []Operation
+	for _, op := range ops {
+		input := tla.MakeTLARecord([]tla.TLARecordField{
+			{Key: tla.MakeTLAString("key"), Value: tla.MakeTLAString(op.key)},
+		})
+		output := tla.MakeTLANumber(op.value)
+		if op.put != nil {
+			input = tla.MakeTLARecord([]tla.TLARecordField{
+				{Key: tla.MakeTLAString("key"), Value: tla.MakeTLAString(op.key)},
+				{Key: tla.MakeTLAString("value"), Value: tla.MakeTLANumber(*op.put)},
+			})
+			output = tla.MakeTLAString("ok")
+		}
+		operation := Operation{
+			ClientID: tla.MakeTLANumber(op.client),
+			Input:    input,
+			Call:     checkTestTime(op.callMs),
+		}
+		if op.returnMs >= 0 {
+			operation.Output = output
+			operation.Return = checkTestTime(op.returnMs)
+			operation.Returned = true
+		}
+		result = append(result, operation)
+	}
+	return result
+}
+
+var checkTestModel = KVModel(tla.MakeTLANumber(0), func(op Operation) KVOperation {
+	input := op.Input.AsFunction()
+	key, _ := input.Get(tla.MakeTLAString("key"))
+	if value, isPut := input.Get(tla.MakeTLAString("value")); isPut {
+		return KVOperation{Key: key.(tla.TLAValue), IsPut: true, Value: value.(tla.TLAValue)}
+	}
+	return KVOperation{Key: key.(tla.TLAValue), Value: op.Output}
+})
+
+func TestCheckLinearizable(t *testing.T) {
+	ops := checkTestHistory(
+		checkTestPut(0, "x", 1, 0, 10),
+		checkTestGet(1, "x", 0, 1, 3), // concurrent with the put, so may come before it
+		checkTestGet(2, "x", 1, 5, 15),
+		checkTestPut(1, "x", 2, 20, 30),
+		checkTestGet(2, "x", 2, 21, 22), // may come after the concurrent put, although it returned first
+		checkTestGet(0, "x", 2, 40, 41),
+	)
+	if err := Check(checkTestModel, ops); err != nil {
+		t.Fatalf("expected the history to be linearizable, got %v", err)
+	}
+}
+
+func TestCheckNotLinearizable(t *testing.T) {
+	ops := checkTestHistory(
+		checkTestPut(0, "x", 1, 0, 10),
+		checkTestGet(1, "x", 1, 11, 12),
+		checkTestGet(2, "x", 0, 13, 14), // stale, as the put returned before it was called
+	)
+	err := Check(checkTestModel, ops)
+	var notLinearizable *NotLinearizableError
+	if !errors.Is(err, ErrNotLinearizable) || !errors.As(err, &notLinearizable) {
+		t.Fatalf("expected the history not to be linearizable, got %v", err)
+	}
+	if len(notLinearizable.Operations) != len(ops) {
+		t.Errorf("expected the error to report all %d operations, got %d", len(ops), len(notLinearizable.Operations))
+	}
+	// the longest linearizable prefix stops right before the stale read
+	if len(notLinearizable.Longest) != 2 || !notLinearizable.Longest[0].Input.Equal(ops[0].Input) ||
+		!notLinearizable.Longest[1].Call.Equal(ops[1].Call) {
+		t.Errorf("expected the put and the first get to be linearized, got %v", notLinearizable.Longest)
+	}
+}
+
+func TestCheckOperationsThatNeverReturned(t *testing.T) {
+	tests := []struct {
+		name         string
+		ops          []Operation
+		linearizable bool
+	}{
+		{"left out", checkTestHistory(
+			checkTestPut(0, "x", 1, 0, -1),
+			checkTestGet(1, "x", 0, 10, 11),
+		), true},
+		{"taking effect late", checkTestHistory(
+			checkTestPut(0, "x", 1, 0, -1),
+			checkTestGet(1, "x", 0, 10, 11),
+			checkTestGet(1, "x", 1, 20, 21),
+		), true},
+		{"taking effect only once", checkTestHistory(
+			checkTestPut(0, "x", 1, 0, -1),
+			checkTestGet(1, "x", 1, 10, 11),
+			checkTestGet(1, "x", 0, 20, 21),
+		), false},
+		{"not before their call", checkTestHistory(
+			checkTestGet(1, "x", 1, 0, 1),
+			checkTestPut(0, "x", 1, 10, -1),
+		), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := Check(checkTestModel, test.ops)
+			if test.linearizable && err != nil {
+				t.Fatalf("expected the history to be linearizable, got %v", err)
+			}
+			if !test.linearizable && !errors.Is(err, ErrNotLinearizable) {
+				t.Fatalf("expected the history not to be linearizable, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckPartitions(t *testing.T) {
+	// each key is linearizable on its own, but the keys would not be if they were one register, which cannot hold
+	// both values at once; partitioning by key checks them independently
+	ops := checkTestHistory(
+		checkTestPut(0, "x", 1, 0, 10),
+		checkTestPut(1, "y", 2, 0, 10),
+		checkTestGet(2, "x", 1, 11, 12),
+		checkTestGet(2, "y", 2, 13, 14),
+	)
+	if err := Check(checkTestModel, ops); err != nil {
+		t.Fatalf("expected the history to be linearizable, got %v", err)
+	}
+	unpartitioned := checkTestModel
+	unpartitioned.Partition = nil
+	if err := Check(unpartitioned, ops); !errors.Is(err, ErrNotLinearizable) {
+		t.Fatalf("expected the history not to be linearizable as a single register, got %v", err)
+	}
+
+	// the error reports only the partition that is not linearizable
+	ops = append(ops, checkTestHistory(checkTestGet(3, "y", 0, 20, 21))...)
+	var notLinearizable *NotLinearizableError
+	if err := Check(checkTestModel, ops); !errors.As(err, &notLinearizable) {
+		t.Fatalf("expected the history not to be linearizable, got %v", err)
+	}
+	for _, op := range notLinearizable.Operations {
+		if key, _ := op.Input.AsFunction().Get(tla.MakeTLAString("key")); !key.(tla.TLAValue).Equal(tla.MakeTLAString("y")) {
+			t.Fatalf("expected only operations on y to be reported, got %v", formatOperation(op))
+		}
+	}
+	if len(notLinearizable.Operations) != 3 {
+		t.Fatalf("expected the 3 operations on y to be reported, got %d", len(notLinearizable.Operations))
+	}
+}
+
+func TestCheckWithTimeout(t *testing.T) {
+	// many concurrent puts, followed by a get of a value none of them wrote, leaves the checker to try every order
+	// of the puts before it can tell
+	var history []checkTestOp
+	for i := int32(1); i <= 20; i++ {
+		history = append(history, checkTestPut(i, "x", i, 0, 100))
+	}
+	history = append(history, checkTestGet(0, "x", -1, 200, 201))
+	ops := checkTestHistory(history...)
+
+	start := time.Now()
+	if err := Check(checkTestModel, ops, WithTimeout(50*time.Millisecond)); !errors.Is(err, ErrCheckTimedOut) {
+		t.Fatalf("expected the check to time out, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the check to give up soon after its timeout, but it took %v", elapsed)
+	}
+	// a timeout only applies if the check takes that long
+	if err := Check(checkTestModel, ops[len(ops)-3:], WithTimeout(time.Minute)); !errors.Is(err, ErrNotLinearizable) {
+		t.Fatalf("expected a small history to be checked in time, got %v", err)
+	}
+}
@@ -0,0 +1,247 @@
+// Package linearizability records the operations that clients submit to archetypes, through input and output
+// channel resources, and checks that the resulting history is linearizable with respect to a sequential model of
+// the system, such as a key-value store. For example:
+//
+//	history := linearizability.NewHistory()
+//	ctx := distsys.NewMPCalContext(self, AClient,
+//		distsys.EnsureArchetypeRefParam("input", history.InputMaker(self, resources.InputChannelMaker(inChan))),
+//		distsys.EnsureArchetypeRefParam("output", history.OutputMaker(self, resources.OutputChannelMaker(outChan))))
+//	// ... run the clients, then
+//	err := linearizability.Check(model, history.Operations())
+//
+// Histories may also be exported in the JSON format of Porcupine, to be checked or visualized by it.
+package linearizability
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+// Operation is one request made by a client, along with its response if it got one.
+type Operation struct {
+	ClientID tla.TLAValue
+	Input    tla.TLAValue
+	Call     time.Time // when the client's archetype committed reading the request
+	// Output and Return are only set if Returned is true: an operation that did not return may or may not have
+	// taken effect, and the checker accounts for both.
+	Output   tla.TLAValue
+	Return   time.Time // when the client's archetype committed writing the response
+	Returned bool
+}
+
+// History records operations, as seen by the resources made by its InputMaker and OutputMaker. Each request read
+// from a client's input starts an operation, and each response written to its output completes the client's oldest
+// pending operation, so clients must answer their requests in order. It is safe for concurrent use.
+type History struct {
+	lock      sync.Mutex
+	ops       []*Operation
+	pending   *immutable.Map // client -> []*Operation, the client's operations that have not returned, oldest first
+	unmatched int
+}
+
+// NewHistory creates an empty history.
+func NewHistory() *History {
+	return &History{
+		pending: immutable.NewMap(tla.TLAValueHasher{}),
+	}
+}
+
+func (h *History) getPending(client tla.TLAValue) []*Operation {
+	if pending, ok := h.pending.Get(client); ok {
+		return pending.([]*Operation)
+	}
+	return nil
+}
+
+func (h *History) call(client tla.TLAValue, inputs []tla.TLAValue, at time.Time) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	pending := h.getPending(client)
+	for _, input := range inputs {
+		op := &Operation{ClientID: client, Input: input, Call: at}
+		h.ops = append(h.ops, op)
+		pending = append(pending, op)
+	}
+	h.pending = h.pending.Set(client, pending)
+}
+
+func (h *History) ret(client tla.TLAValue, outputs []tla.TLAValue, at time.Time) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	pending := h.getPending(client)
+	for _, output := range outputs {
+		if len(pending) == 0 {
+			h.unmatched++
+			continue
+		}
+		op := pending[0]
+		pending = pending[1:]
+		op.Output = output
+		op.Return = at
+		op.Returned = true
+	}
+	h.pending = h.pending.Set(client, pending)
+}
+
+// Operations returns the operations recorded so far, in the order they were called.
+func (h *History) Operations() []Operation {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	result := make([]Operation, len(h.ops))
+	for i, op := range h.ops {
+		result[i] = *op
+	}
+	return result
+}
+
+// Unmatched returns how many responses were written by clients with no pending operation. These are not part of
+// the history, and a non-zero count suggests that a client's input and output were not both recorded.
+func (h *History) Unmatched() int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.unmatched
+}
+
+// porcupineOperation mirrors porcupine.Operation, whose times are in nanoseconds.
+type porcupineOperation struct {
+	ClientId int
+	Input    string
+	Call     int64
+	Output   string
+	Return   int64
+}
+
+// WritePorcupineJSON writes the recorded operations to w as a JSON array of Porcupine operations. Client IDs are
+// numbered in the order the clients first appear, inputs and outputs are given in TLA+ syntax, and operations that
+// did not return are given the largest possible return time.
+func (h *History) WritePorcupineJSON(w io.Writer) error {
+	ops := h.Operations()
+	clientIDs := immutable.NewMap(tla.TLAValueHasher{})
+	result := make([]porcupineOperation, len(ops))
+	for i, op := range ops {
+		id, ok := clientIDs.Get(op.ClientID)
+		if !ok {
+			id = clientIDs.Len()
+			clientIDs = clientIDs.Set(op.ClientID, id)
+		}
+		result[i] = porcupineOperation{
+			ClientId: id.(int),
+			Input:    op.Input.String(),
+			Call:     op.Call.UnixNano(),
+			Return:   math.MaxInt64,
+		}
+		if op.Returned {
+			result[i].Output = op.Output.String()
+			result[i].Return = op.Return.UnixNano()
+		}
+	}
+	return json.NewEncoder(w).Encode(result)
+}
+
+// InputMaker wraps maker, typically an input channel, so that each value the client's archetype reads from it, in a
+// critical section that commits, starts an operation. The operation's call time is when the value was read, before
+// it could have had any effect.
+func (h *History) InputMaker(client tla.TLAValue, maker distsys.ArchetypeResourceMaker) distsys.ArchetypeResourceMaker {
+	return h.wrapMaker(client, true, maker)
+}
+
+// OutputMaker wraps maker, typically an output channel, so that each value the client's archetype writes to it, in a
+// critical section that commits, completes the client's oldest pending operation. The operation's return time is
+// when the critical section commits, after the response was computed.
+func (h *History) OutputMaker(client tla.TLAValue, maker distsys.ArchetypeResourceMaker) distsys.ArchetypeResourceMaker {
+	return h.wrapMaker(client, false, maker)
+}
+
+func (h *History) wrapMaker(client tla.TLAValue, isInput bool, maker distsys.ArchetypeResourceMaker) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &historyResource{
+				history: h,
+				client:  client,
+				isInput: isInput,
+				inner:   maker.Make(),
+			}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*historyResource)
+			maker.Configure(r.inner)
+		},
+	}
+}
+
+type historyResource struct {
+	history *History
+	client  tla.TLAValue
+	isInput bool
+	inner   distsys.ArchetypeResource
+
+	values    []tla.TLAValue // read or written in the current critical section
+	firstRead time.Time
+}
+
+var _ distsys.ArchetypeResource = &historyResource{}
+
+func (res *historyResource) Abort() chan struct{} {
+	res.values = nil
+	return res.inner.Abort()
+}
+
+func (res *historyResource) PreCommit() chan error {
+	return res.inner.PreCommit()
+}
+
+func (res *historyResource) Commit() chan struct{} {
+	if len(res.values) > 0 {
+		if res.isInput {
+			res.history.call(res.client, res.values, res.firstRead)
+		} else {
+			res.history.ret(res.client, res.values, time.Now())
+		}
+		res.values = nil
+	}
+	return res.inner.Commit()
+}
+
+func (res *historyResource) ReadValue() (tla.TLAValue, error) {
+	now := time.Now()
+	value, err := res.inner.ReadValue()
+	if err == nil && res.isInput {
+		if len(res.values) == 0 {
+			res.firstRead = now
+		}
+		res.values = append(res.values, value)
+	}
+	return value, err
+}
+
+func (res *historyResource) WriteValue(value tla.TLAValue) error {
+	err := res.inner.WriteValue(value)
+	if err == nil && !res.isInput {
+		res.values = append(res.values, value)
+	}
+	return err
+}
+
+func (res *historyResource) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	subRes, err := res.inner.Index(index)
+	if err != nil {
+		return nil, err
+	}
+	return &historyResource{
+		history: res.history,
+		client:  res.client,
+		isInput: res.isInput,
+		inner:   subRes,
+	}, nil
+}
+
+func (res *historyResource) Close() error {
+	return res.inner.Close()
+}
@@ -0,0 +1,216 @@
+package distsys
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// localStateLogCompactionThreshold is how many batches a LocalStateLog may hold before it is rewritten to only
+// contain the latest value of each key.
+const localStateLogCompactionThreshold = 1024
+
+// LocalStateLog is a write-ahead log of the committed values of persistent local state variables, so that a
+// restarted node can recover them. See PersistentLocalArchetypeResourceMaker and WithPersistentLocalState.
+//
+// The values written by one critical section are logged together, as a single batch synced to disk, so a crash
+// never leaves a partially logged critical section. A log should be used by one archetype at a time.
+type LocalStateLog struct {
+	path string
+
+	lock    sync.Mutex
+	file    *os.File
+	values  map[string]tla.TLAValue
+	staged  map[string]tla.TLAValue
+	batches int
+}
+
+type localStateRecord struct {
+	Key   string
+	Value tla.TLAValue
+}
+
+// OpenLocalStateLog opens the log at path, creating it if needed, and recovers the values it holds. A partially
+// written batch at the end of the log, left by a crash, is discarded; a batch damaged anywhere else makes it fail
+// with ErrCorruptLogRecord.
+func OpenLocalStateLog(path string) (*LocalStateLog, error) {
+	l := &LocalStateLog{
+		path:   path,
+		values: make(map[string]tla.TLAValue),
+		staged: make(map[string]tla.TLAValue),
+	}
+	var err error
+	l.file, err = OpenLogFile(path, l.replay)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// replay recovers the values held by the log.
+func (l *LocalStateLog) replay(r *LogRecordReader) error {
+	for {
+		var batch []localStateRecord
+		err := r.Next(&batch)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, record := range batch {
+			l.values[record.Key] = record.Value
+		}
+		l.batches++
+	}
+}
+
+// Close closes the log's file.
+func (l *LocalStateLog) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.file.Close()
+}
+
+func (l *LocalStateLog) recovered(key string) (tla.TLAValue, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	value, ok := l.values[key]
+	return value, ok
+}
+
+func (l *LocalStateLog) stage(key string, value tla.TLAValue) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.staged[key] = value
+}
+
+func (l *LocalStateLog) unstage(key string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.staged, key)
+}
+
+// flush durably logs all staged values as one batch. It does nothing if no values are staged.
+func (l *LocalStateLog) flush() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if len(l.staged) == 0 {
+		return nil
+	}
+	batch := make([]localStateRecord, 0, len(l.staged))
+	for key, value := range l.staged {
+		batch = append(batch, localStateRecord{Key: key, Value: value})
+	}
+	err := WriteLogRecord(l.file, batch)
+	if err == nil {
+		err = l.file.Sync()
+	}
+	if err != nil {
+		return err
+	}
+	for key, value := range l.staged {
+		l.values[key] = value
+		delete(l.staged, key)
+	}
+	l.batches++
+	if l.batches > localStateLogCompactionThreshold && l.batches > 2*len(l.values) {
+		return l.compactLocked()
+	}
+	return nil
+}
+
+// compactLocked atomically replaces the log with a single batch holding the latest value of each key.
+func (l *LocalStateLog) compactLocked() error {
+	batch := make([]localStateRecord, 0, len(l.values))
+	for key, value := range l.values {
+		batch = append(batch, localStateRecord{Key: key, Value: value})
+	}
+	f, err := ReplaceLogFile(l.path, batch)
+	if err != nil {
+		return err
+	}
+	_ = l.file.Close()
+	l.file = f
+	l.batches = 1
+	return nil
+}
+
+// PersistentLocalArchetypeResource behaves like LocalArchetypeResource, but logs its committed values to a
+// LocalStateLog, and starts from the value recovered from the log, if any.
+type PersistentLocalArchetypeResource struct {
+	LocalArchetypeResource
+	log *LocalStateLog
+	key string
+}
+
+var _ ArchetypeResource = &PersistentLocalArchetypeResource{}
+
+// PersistentLocalArchetypeResourceMaker produces a resource that behaves like one made by
+// LocalArchetypeResourceMaker, except that its committed values are logged to log under key. If log holds a value
+// for key, e.g. after a restart, the resource starts with that value rather than value.
+func PersistentLocalArchetypeResourceMaker(log *LocalStateLog, key string, value tla.TLAValue) ArchetypeResourceMaker {
+	return ArchetypeResourceMakerFn(func() ArchetypeResource {
+		if recovered, ok := log.recovered(key); ok {
+			value = recovered
+		}
+		return &PersistentLocalArchetypeResource{
+			LocalArchetypeResource: LocalArchetypeResource{value: value},
+			log:                    log,
+			key:                    key,
+		}
+	})
+}
+
+func (res *PersistentLocalArchetypeResource) Abort() chan struct{} {
+	res.log.unstage(res.key)
+	return res.LocalArchetypeResource.Abort()
+}
+
+// PreCommit stages the value written in this critical section, if any. As every PreCommit completes before any
+// Commit starts, the first Commit then logs all the critical section's values in one batch.
+func (res *PersistentLocalArchetypeResource) PreCommit() chan error {
+	if res.hasOldValue {
+		res.log.stage(res.key, res.value)
+	}
+	return nil
+}
+
+func (res *PersistentLocalArchetypeResource) Commit() chan struct{} {
+	err := res.log.flush()
+	if err != nil {
+		panic(fmt.Errorf("could not log local state to %s: %w", res.log.path, err))
+	}
+	return res.LocalArchetypeResource.Commit()
+}
+
+// WithPersistentLocalState makes the archetype's local state variables, including its program counter and call
+// stack, persistent: they are logged to log, and recovered from it when the archetype is restarted with the same
+// self value. The archetype then resumes from its last committed critical section. Resources passed as archetype
+// parameters are not affected, and should be made to recover their own state if needed.
+func WithPersistentLocalState(log *LocalStateLog) MPCalContextConfigFn {
+	return func(ctx *MPCalContext) {
+		ctx.localStateLog = log
+		for _, name := range []string{".pc", ".stack"} {
+			handle := ArchetypeResourceHandle(name)
+			initial := ctx.resources[handle].(*LocalArchetypeResource).value
+			ctx.resources[handle] = PersistentLocalArchetypeResourceMaker(log, ctx.localStateKey(name), initial).Make()
+		}
+	}
+}
+
+func (ctx *MPCalContext) localStateKey(name string) string {
+	return ctx.self.String() + "/" + name
+}
+
+// localArchetypeResourceMaker returns a maker for the local state variable name, which is persistent if the context
+// was configured WithPersistentLocalState.
+func (ctx *MPCalContext) localArchetypeResourceMaker(name string, value tla.TLAValue) ArchetypeResourceMaker {
+	if ctx.localStateLog != nil {
+		return PersistentLocalArchetypeResourceMaker(ctx.localStateLog, ctx.localStateKey(name), value)
+	}
+	return LocalArchetypeResourceMaker(value)
+}
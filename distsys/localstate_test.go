@@ -0,0 +1,127 @@
+package distsys
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func openTestLocalStateLog(t *testing.T, path string) *LocalStateLog {
+	t.Helper()
+	l, err := OpenLocalStateLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = l.Close()
+	})
+	return l
+}
+
+// localStateTestCommit writes each value to the resource at the same position, and commits them together.
+func localStateTestCommit(t *testing.T, resources []ArchetypeResource, values ...int32) {
+	t.Helper()
+	for i, value := range values {
+		if err := resources[i].WriteValue(tla.MakeTLANumber(value)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, res := range resources {
+		res.PreCommit()
+	}
+	for _, res := range resources {
+		res.Commit()
+	}
+}
+
+func expectLocalStateTestValue(t *testing.T, l *LocalStateLog, key string, expected int32) {
+	t.Helper()
+	value, err := PersistentLocalArchetypeResourceMaker(l, key, tla.MakeTLANumber(0)).Make().ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !value.Equal(tla.MakeTLANumber(expected)) {
+		t.Fatalf("expected %s to be recovered as %d, got %v", key, expected, value)
+	}
+}
+
+func TestLocalStateLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+	l := openTestLocalStateLog(t, path)
+	resources := []ArchetypeResource{
+		PersistentLocalArchetypeResourceMaker(l, "a", tla.MakeTLANumber(0)).Make(),
+		PersistentLocalArchetypeResourceMaker(l, "b", tla.MakeTLANumber(0)).Make(),
+	}
+	localStateTestCommit(t, resources, 1, 2)
+	// an aborted write is not logged
+	if err := resources[0].WriteValue(tla.MakeTLANumber(3)); err != nil {
+		t.Fatal(err)
+	}
+	resources[0].PreCommit()
+	resources[0].Abort()
+	localStateTestCommit(t, resources[1:], 4)
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// after a restart, each resource resumes from its last committed value, or its initial value if it had none
+	l = openTestLocalStateLog(t, path)
+	expectLocalStateTestValue(t, l, "a", 1)
+	expectLocalStateTestValue(t, l, "b", 4)
+	expectLocalStateTestValue(t, l, "c", 0)
+	if l.batches != 2 {
+		t.Fatalf("expected the log to hold 2 batches, got %d", l.batches)
+	}
+}
+
+func TestLocalStateLogCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+	l := openTestLocalStateLog(t, path)
+	resources := []ArchetypeResource{PersistentLocalArchetypeResourceMaker(l, "a", tla.MakeTLANumber(0)).Make()}
+	for i := int32(1); i <= localStateLogCompactionThreshold+1; i++ {
+		localStateTestCommit(t, resources, i)
+	}
+	if l.batches != 1 {
+		t.Fatalf("expected the log to be compacted to a single batch, got %d", l.batches)
+	}
+	localStateTestCommit(t, resources, -1)
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	l = openTestLocalStateLog(t, path)
+	expectLocalStateTestValue(t, l, "a", -1)
+}
+
+func TestLocalStateLogCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+	l := openTestLocalStateLog(t, path)
+	resources := []ArchetypeResource{PersistentLocalArchetypeResourceMaker(l, "a", tla.MakeTLANumber(0)).Make()}
+	localStateTestCommit(t, resources, 1)
+	localStateTestCommit(t, resources, 2)
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a batch cut short by a crash is discarded, along with the critical section it belonged to
+	modifyTestLogFile(t, path, func(data []byte) []byte {
+		return data[:len(data)-1]
+	})
+	l = openTestLocalStateLog(t, path)
+	expectLocalStateTestValue(t, l, "a", 1)
+	resources = []ArchetypeResource{PersistentLocalArchetypeResourceMaker(l, "a", tla.MakeTLANumber(0)).Make()}
+	localStateTestCommit(t, resources, 3)
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// but a damaged batch followed by another is not a crash's doing
+	modifyTestLogFile(t, path, func(data []byte) []byte {
+		data[logRecordHeaderSize] ^= 0xff
+		return data
+	})
+	if _, err := OpenLocalStateLog(path); !errors.Is(err, ErrCorruptLogRecord) {
+		t.Fatalf("expected a damaged log to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+package distsys
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// MaxLogRecordSize is the size, in bytes, of the largest record WriteLogRecord writes. A larger size read back from
+// a log can only come from corruption.
+const MaxLogRecordSize = 256 << 20
+
+// logRecordHeaderSize is the size of the header framing each record: its size, then its checksum.
+const logRecordHeaderSize = 8
+
+var logRecordCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ErrCorruptLogRecord is returned when a log holds a record that was damaged after being written, rather than
+// only partially written because of a crash.
+var ErrCorruptLogRecord = errors.New("corrupt log record")
+
+// WriteLogRecord gob-encodes record, and writes it to w, framed by its size and a CRC-32 checksum, for a
+// LogRecordReader to read back. Records are written as a single Write, which the caller should sync as needed.
+func WriteLogRecord(w io.Writer, record interface{}) error {
+	var buf bytes.Buffer
+	buf.Write(make([]byte, logRecordHeaderSize))
+	err := gob.NewEncoder(&buf).Encode(record)
+	if err != nil {
+		return err
+	}
+	data := buf.Bytes()
+	size := len(data) - logRecordHeaderSize
+	if size > MaxLogRecordSize {
+		return fmt.Errorf("log record of %d bytes is larger than the maximum of %d", size, MaxLogRecordSize)
+	}
+	binary.BigEndian.PutUint32(data[0:4], uint32(size))
+	binary.BigEndian.PutUint32(data[4:8], crc32.Checksum(data[logRecordHeaderSize:], logRecordCRCTable))
+	_, err = w.Write(data)
+	return err
+}
+
+// LogRecordReader reads back, in order, the records written to a log file by WriteLogRecord. See OpenLogFile.
+type LogRecordReader struct {
+	path      string
+	reader    *bufio.Reader
+	validSize int64
+}
+
+// Next decodes the next record into record, which should be a pointer to a value of the type written. It returns
+// io.EOF at the end of the log, including when the last record was only partially written, because of a crash
+// while writing it, so that it fails its checksum or is cut short. Any other record failing its checksum, or one
+// claiming to be larger than MaxLogRecordSize, is reported as ErrCorruptLogRecord.
+func (r *LogRecordReader) Next(record interface{}) error {
+	var header [logRecordHeaderSize]byte
+	_, err := io.ReadFull(r.reader, header[:])
+	if err == io.ErrUnexpectedEOF {
+		return io.EOF
+	}
+	if err != nil {
+		return err
+	}
+	size := binary.BigEndian.Uint32(header[0:4])
+	if size > MaxLogRecordSize {
+		return fmt.Errorf("%w in %s at offset %d: size of %d bytes is larger than the maximum of %d", ErrCorruptLogRecord, r.path, r.validSize, size, MaxLogRecordSize)
+	}
+	// rather than allocating size bytes up front, only hold as much as the log actually contains
+	var data bytes.Buffer
+	n, err := io.CopyN(&data, r.reader, int64(size))
+	if err == io.EOF && n < int64(size) {
+		return io.EOF
+	}
+	if err != nil {
+		return err
+	}
+	if crc32.Checksum(data.Bytes(), logRecordCRCTable) != binary.BigEndian.Uint32(header[4:8]) {
+		if _, err := r.reader.Peek(1); err == io.EOF {
+			return io.EOF
+		}
+		return fmt.Errorf("%w in %s at offset %d: checksum mismatch", ErrCorruptLogRecord, r.path, r.validSize)
+	}
+	err = gob.NewDecoder(&data).Decode(record)
+	if err != nil {
+		return fmt.Errorf("%w in %s at offset %d: %v", ErrCorruptLogRecord, r.path, r.validSize, err)
+	}
+	r.validSize += logRecordHeaderSize + int64(size)
+	return nil
+}
+
+// OpenLogFile opens the log file at path, creating it if needed, to append records to it with WriteLogRecord. It
+// first replays the records already in the file, by calling replay with a reader at the start of the file, which
+// should read records until Next returns io.EOF. Whatever follows the records read, such as a record left partially
+// written by a crash, is then discarded. An error returned by replay is returned as is.
+func OpenLogFile(path string, replay func(r *LogRecordReader) error) (*os.File, error) {
+	validSize, err := replayLogFile(path, replay)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		return nil, err
+	}
+	err = file.Truncate(validSize)
+	if err == nil {
+		_, err = file.Seek(validSize, io.SeekStart)
+	}
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return file, nil
+}
+
+// replayLogFile replays the log at path, returning the size of the records replay read.
+func replayLogFile(path string, replay func(r *LogRecordReader) error) (int64, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	r := &LogRecordReader{path: path, reader: bufio.NewReader(f)}
+	err = replay(r)
+	if err != nil {
+		return 0, err
+	}
+	return r.validSize, nil
+}
+
+// ReplaceLogFile atomically replaces the log file at path with one holding the single record, e.g. to compact it,
+// and returns the new file, open to append to. The caller should close the file it replaces.
+func ReplaceLogFile(path string, record interface{}) (*os.File, error) {
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	err = WriteLogRecord(f, record)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return f, nil
+}
@@ -0,0 +1,146 @@
+package distsys
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// openTestLogFile opens the log at path, returning it along with the records it held, which are ints.
+func openTestLogFile(path string) (*os.File, []int, error) {
+	var records []int
+	f, err := OpenLogFile(path, func(r *LogRecordReader) error {
+		for {
+			var record int
+			err := r.Next(&record)
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+	})
+	return f, records, err
+}
+
+func expectTestLogRecords(t *testing.T, path string, expected ...int) {
+	t.Helper()
+	f, records, err := openTestLogFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != len(expected) {
+		t.Fatalf("expected records %v, got %v", expected, records)
+	}
+	for i := range records {
+		if records[i] != expected[i] {
+			t.Fatalf("expected records %v, got %v", expected, records)
+		}
+	}
+}
+
+// writeTestLogFile creates the log at path, holding records, and returns the offset of each record.
+func writeTestLogFile(t *testing.T, path string, records ...int) []int64 {
+	t.Helper()
+	f, _, err := openTestLogFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	var offsets []int64
+	for _, record := range records {
+		offset, err := f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		offsets = append(offsets, offset)
+		if err := WriteLogRecord(f, record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return offsets
+}
+
+// modifyTestLogFile applies fn to the contents of the file at path.
+func modifyTestLogFile(t *testing.T, path string, fn func(data []byte) []byte) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, fn(data), 0666); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLogFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log")
+	expectTestLogRecords(t, path)
+	writeTestLogFile(t, path, 1, 2, 3)
+	expectTestLogRecords(t, path, 1, 2, 3)
+
+	// a crash while writing 4 leaves part of it, which is discarded, so that 5 follows 3
+	offsets := writeTestLogFile(t, path, 4)
+	modifyTestLogFile(t, path, func(data []byte) []byte {
+		return data[:offsets[0]+logRecordHeaderSize+1]
+	})
+	expectTestLogRecords(t, path, 1, 2, 3)
+	writeTestLogFile(t, path, 5)
+	expectTestLogRecords(t, path, 1, 2, 3, 5)
+
+	// so is a last record that was fully sized, but not fully written
+	writeTestLogFile(t, path, 6)
+	modifyTestLogFile(t, path, func(data []byte) []byte {
+		data[len(data)-1] ^= 0xff
+		return data
+	})
+	expectTestLogRecords(t, path, 1, 2, 3, 5)
+
+	f, err := ReplaceLogFile(path, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteLogRecord(f, 8); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	expectTestLogRecords(t, path, 7, 8)
+}
+
+func TestLogFileCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log")
+	offsets := writeTestLogFile(t, path, 1, 2, 3)
+
+	// a damaged record that is not the last cannot be left by a crash, so it is reported rather than dropped along
+	// with the records following it
+	modifyTestLogFile(t, path, func(data []byte) []byte {
+		data[offsets[1]+logRecordHeaderSize] ^= 0xff
+		return data
+	})
+	if _, _, err := openTestLogFile(path); !errors.Is(err, ErrCorruptLogRecord) {
+		t.Fatalf("expected a damaged record to be reported, got %v", err)
+	}
+
+	// as is a size no record can have, which is not allocated
+	path = filepath.Join(t.TempDir(), "log")
+	offsets = writeTestLogFile(t, path, 1, 2, 3)
+	modifyTestLogFile(t, path, func(data []byte) []byte {
+		binary.BigEndian.PutUint32(data[offsets[1]:], 0xffffffff)
+		return data
+	})
+	if _, _, err := openTestLogFile(path); !errors.Is(err, ErrCorruptLogRecord) {
+		t.Fatalf("expected an oversized record to be reported, got %v", err)
+	}
+}
@@ -0,0 +1,221 @@
+package distsys
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// LogLevel is the severity of a log message.
+type LogLevel int
+
+const (
+	LogDebug LogLevel = iota
+	LogInfo
+	LogWarn
+	LogError
+)
+
+func (level LogLevel) String() string {
+	switch level {
+	case LogDebug:
+		return "DEBUG"
+	case LogInfo:
+		return "INFO"
+	case LogWarn:
+		return "WARN"
+	case LogError:
+		return "ERROR"
+	default:
+		return fmt.Sprintf("LEVEL(%d)", int(level))
+	}
+}
+
+// Logger receives structured log messages from the runtime and resources. msg is a short description of the event,
+// and keyvals is a list of alternating keys and values describing it, such as "error", err. Keys are strings. This
+// allows forwarding the messages to a structured logging library. Implementations must be safe for concurrent use.
+type Logger interface {
+	Log(level LogLevel, msg string, keyvals ...interface{})
+}
+
+// LevelLogger may be implemented by Loggers that discard messages below some level, so that the runtime can skip
+// building messages that would be discarded, such as one for every aborted critical section.
+type LevelLogger interface {
+	Logger
+	// Enabled returns whether messages at level may be logged.
+	Enabled(level LogLevel) bool
+}
+
+// LoggerEnabled returns whether logger may log messages at level: false only if logger is a LevelLogger discarding
+// them.
+func LoggerEnabled(logger Logger, level LogLevel) bool {
+	if levelLogger, ok := logger.(LevelLogger); ok {
+		return levelLogger.Enabled(level)
+	}
+	return true
+}
+
+type stdLogger struct {
+	minLevel LogLevel
+}
+
+// NewStdLogger returns a Logger printing the messages at minLevel or above with the standard log package, as
+// "LEVEL msg key=value ...".
+func NewStdLogger(minLevel LogLevel) Logger {
+	return stdLogger{minLevel: minLevel}
+}
+
+func (logger stdLogger) Enabled(level LogLevel) bool {
+	return level >= logger.minLevel
+}
+
+func (logger stdLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	if !logger.Enabled(level) {
+		return
+	}
+	var builder strings.Builder
+	builder.WriteString(level.String())
+	builder.WriteByte(' ')
+	builder.WriteString(msg)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		_, _ = fmt.Fprintf(&builder, " %v=%v", keyvals[i], keyvals[i+1])
+	}
+	log.Print(builder.String())
+}
+
+// DefaultLogger receives the messages of contexts configured without WithLogger, and of resources used outside of
+// any context. By default, it prints messages at LogInfo or above with the standard log package. It should only be
+// replaced before any context or resource is created.
+var DefaultLogger = NewStdLogger(LogInfo)
+
+func init() {
+	// DefaultLogger is looked up on each call, as it may have been replaced since
+	tla.PrintHook = func(v tla.TLAValue) {
+		DefaultLogger.Log(LogInfo, "TLA+ Print", "value", v)
+	}
+}
+
+// WithPrintHook calls hook with the values the archetype prints, using PCal's print statement or TLC's Print and
+// PrintT, instead of logging them with the context's logger.
+func WithPrintHook(hook func(v tla.TLAValue)) MPCalContextConfigFn {
+	return func(ctx *MPCalContext) {
+		ctx.printHook = hook
+	}
+}
+
+// Print implements PCal's print statement: it passes v to the hook the context was configured WithPrintHook, or else
+// logs it at LogInfo with the context's logger. Unlike tla.PrintHook, which only knows of DefaultLogger, it sends
+// each archetype's prints to its own context.
+func (iface ArchetypeInterface) Print(v tla.TLAValue) {
+	if iface.ctx.printHook != nil {
+		iface.ctx.printHook(v)
+		return
+	}
+	iface.ctx.Logger().Log(LogInfo, "TLA+ Print", "value", v)
+}
+
+// TLA_Print is TLC's Print(out, val), which prints out as ArchetypeInterface.Print does, and returns val. Compiled
+// archetypes call it, rather than tla.TLA_Print, so that what they print goes to their context.
+func TLA_Print(iface ArchetypeInterface, out, val tla.TLAValue) tla.TLAValue {
+	iface.Print(out)
+	return val
+}
+
+// TLA_PrintT is TLC's PrintT(out), which prints out as ArchetypeInterface.Print does, and returns TRUE.
+func TLA_PrintT(iface ArchetypeInterface, out tla.TLAValue) tla.TLAValue {
+	iface.Print(out)
+	return tla.TLA_TRUE
+}
+
+type keyvalsLogger struct {
+	logger  Logger
+	keyvals []interface{}
+}
+
+// LoggerWith returns a Logger adding keyvals to every message sent to logger, before the message's own.
+func LoggerWith(logger Logger, keyvals ...interface{}) Logger {
+	if inner, ok := logger.(keyvalsLogger); ok {
+		// flatten nested loggers, so each message is only copied once
+		return keyvalsLogger{
+			logger:  inner.logger,
+			keyvals: append(append([]interface{}(nil), inner.keyvals...), keyvals...),
+		}
+	}
+	return keyvalsLogger{logger: logger, keyvals: keyvals}
+}
+
+func (logger keyvalsLogger) Enabled(level LogLevel) bool {
+	return LoggerEnabled(logger.logger, level)
+}
+
+func (logger keyvalsLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	if !logger.Enabled(level) {
+		return
+	}
+	logger.logger.Log(level, msg, append(append([]interface{}(nil), logger.keyvals...), keyvals...)...)
+}
+
+// LoggingArchetypeResource may be implemented by resources that log, so that their messages go to the logger of the
+// context using them. SetLogger is called once the resource is made, with a Logger that adds the archetype's self,
+// the label of the running critical section and the resource's name to every message. Resources that make child
+// resources, like maps, should pass it on to their children, adding the child's index.
+type LoggingArchetypeResource interface {
+	SetLogger(logger Logger)
+}
+
+// WithLogger sends the log messages of the context, and of its resources, to logger instead of DefaultLogger.
+func WithLogger(logger Logger) MPCalContextConfigFn {
+	return func(ctx *MPCalContext) {
+		ctx.logger = logger
+	}
+}
+
+// contextLogger adds the archetype's self, and the label of the latest critical section if any, to each message.
+// It looks up the context's logger for every message, so that it can be handed out before WithLogger is applied.
+type contextLogger struct {
+	ctx *MPCalContext
+}
+
+func (logger contextLogger) target() Logger {
+	if logger.ctx.logger == nil {
+		return DefaultLogger
+	}
+	return logger.ctx.logger
+}
+
+func (logger contextLogger) Enabled(level LogLevel) bool {
+	return LoggerEnabled(logger.target(), level)
+}
+
+func (logger contextLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	target := logger.target()
+	// checked first, as adding the prefix allocates
+	if !LoggerEnabled(target, level) {
+		return
+	}
+	prefix := []interface{}{"self", logger.ctx.self}
+	if label, _ := logger.ctx.currentLabel.Load().(string); label != "" {
+		prefix = append(prefix, "label", label)
+	}
+	target.Log(level, msg, append(prefix, keyvals...)...)
+}
+
+// Logger returns a Logger for messages about this context, adding the archetype's self, and the label of the running
+// or most recently run critical section if any, to every message. It may be used from any goroutine.
+func (ctx *MPCalContext) Logger() Logger {
+	return contextLogger{ctx: ctx}
+}
+
+// Logger returns the context's Logger, as described in MPCalContext.Logger.
+func (iface ArchetypeInterface) Logger() Logger {
+	return iface.ctx.Logger()
+}
+
+// setResourceLogger gives res a logger for its messages, if it logs.
+func (ctx *MPCalContext) setResourceLogger(handle ArchetypeResourceHandle, res ArchetypeResource) {
+	if loggingRes, ok := res.(LoggingArchetypeResource); ok {
+		loggingRes.SetLogger(LoggerWith(ctx.Logger(), "resource", string(handle)))
+	}
+}
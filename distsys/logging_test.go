@@ -0,0 +1,250 @@
+package distsys
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// loggingTestLogger records the messages logged to it at LogInfo or above, formatted as "LEVEL msg key=value ...".
+type loggingTestLogger struct {
+	lock     sync.Mutex
+	messages []string
+}
+
+func (logger *loggingTestLogger) Enabled(level LogLevel) bool {
+	return level >= LogInfo
+}
+
+func (logger *loggingTestLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	logger.lock.Lock()
+	defer logger.lock.Unlock()
+	message := level.String() + " " + msg
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		message += fmt.Sprintf(" %v=%v", keyvals[i], keyvals[i+1])
+	}
+	logger.messages = append(logger.messages, message)
+}
+
+// newLoggingTestContext makes a context for self, whose archetype prints using PCal's print, then TLC's Print and
+// PrintT, and is done.
+func newLoggingTestContext(self int32, configFns ...MPCalContextConfigFn) *MPCalContext {
+	archetype := MPCalArchetype{
+		Name:              "APrint",
+		Label:             "APrint.print",
+		RequiredRefParams: []string{},
+		RequiredValParams: []string{},
+		JumpTable: MakeMPCalJumpTable(
+			MPCalCriticalSection{
+				Name: "APrint.print",
+				Body: func(iface ArchetypeInterface) error {
+					iface.Print(tla.MakeTLAString("print"))
+					if result := TLA_Print(iface, tla.MakeTLAString("Print"), tla.MakeTLANumber(42)); !result.Equal(tla.MakeTLANumber(42)) {
+						return fmt.Errorf("expected Print to return its second argument, got %v", result)
+					}
+					if result := TLA_PrintT(iface, tla.MakeTLAString("PrintT")); !result.Equal(tla.TLA_TRUE) {
+						return fmt.Errorf("expected PrintT to return TRUE, got %v", result)
+					}
+					return iface.Goto("APrint.Done")
+				},
+			},
+			MPCalCriticalSection{
+				Name: "APrint.Done",
+				Body: func(ArchetypeInterface) error {
+					return ErrDone
+				},
+			},
+		),
+		ProcTable: MakeMPCalProcTable(),
+		PreAmble:  func(ArchetypeInterface) {},
+	}
+	return NewMPCalContext(tla.MakeTLANumber(self), archetype, configFns...)
+}
+
+func TestPrintLogsToContext(t *testing.T) {
+	// each context's prints go to its own logger, tagged with the archetype's self and label
+	loggers := []*loggingTestLogger{{}, {}}
+	for i, logger := range loggers {
+		ctx := newLoggingTestContext(int32(i+1), WithLogger(logger))
+		if err := ctx.Run(); err != nil {
+			t.Fatal(err)
+		}
+		if err := ctx.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, logger := range loggers {
+		expected := []string{
+			fmt.Sprintf(`INFO TLA+ Print self=%d label=APrint.print value="print"`, i+1),
+			fmt.Sprintf(`INFO TLA+ Print self=%d label=APrint.print value="Print"`, i+1),
+			fmt.Sprintf(`INFO TLA+ Print self=%d label=APrint.print value="PrintT"`, i+1),
+		}
+		if fmt.Sprint(logger.messages) != fmt.Sprint(expected) {
+			t.Fatalf("expected context %d to log %q, got %q", i+1, expected, logger.messages)
+		}
+	}
+}
+
+func TestWithPrintHook(t *testing.T) {
+	logger := &loggingTestLogger{}
+	var printed []tla.TLAValue
+	ctx := newLoggingTestContext(1, WithLogger(logger), WithPrintHook(func(v tla.TLAValue) {
+		printed = append(printed, v)
+	}))
+	defer ctx.Close()
+	if err := ctx.Run(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []tla.TLAValue{tla.MakeTLAString("print"), tla.MakeTLAString("Print"), tla.MakeTLAString("PrintT")}
+	if fmt.Sprint(printed) != fmt.Sprint(expected) {
+		t.Fatalf("expected the hook to get %v, got %v", expected, printed)
+	}
+	if len(logger.messages) != 0 {
+		t.Fatalf("expected nothing to be logged, got %q", logger.messages)
+	}
+}
+
+func TestPCalPrintUsesDefaultLogger(t *testing.T) {
+	logger := &loggingTestLogger{}
+	defaultLogger := DefaultLogger
+	DefaultLogger = logger
+	defer func() {
+		DefaultLogger = defaultLogger
+	}()
+
+	// outside of any context, prints go to DefaultLogger
+	tla.MakeTLANumber(1).PCalPrint()
+	tla.TLA_PrintT(tla.MakeTLANumber(2))
+	expected := []string{"INFO TLA+ Print value=1", "INFO TLA+ Print value=2"}
+	if fmt.Sprint(logger.messages) != fmt.Sprint(expected) {
+		t.Fatalf("expected %q to be logged, got %q", expected, logger.messages)
+	}
+}
+
+// plainLoggingTestLogger is a Logger that does not implement LevelLogger, and discards everything.
+type plainLoggingTestLogger struct{}
+
+func (plainLoggingTestLogger) Log(LogLevel, string, ...interface{}) {}
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	flags, writer := log.Flags(), log.Writer()
+	log.SetFlags(0)
+	log.SetOutput(&buf)
+	defer func() {
+		log.SetFlags(flags)
+		log.SetOutput(writer)
+	}()
+
+	logger := NewStdLogger(LogWarn)
+	if LoggerEnabled(logger, LogInfo) || !LoggerEnabled(logger, LogWarn) || !LoggerEnabled(logger, LogError) {
+		t.Fatal("expected a logger with minimum level WARN to be enabled at WARN and above only")
+	}
+	logger.Log(LogInfo, "skipped")
+	logger.Log(LogWarn, "kept", "key", "value", "n", 1)
+	logger.Log(LogError, "odd", "dangling")
+	if expected := "WARN kept key=value n=1\nERROR odd\n"; buf.String() != expected {
+		t.Fatalf("expected %q to be logged, got %q", expected, buf.String())
+	}
+
+	if !LoggerEnabled(plainLoggingTestLogger{}, LogDebug) {
+		t.Fatal("expected a Logger that is not a LevelLogger to be enabled at every level")
+	}
+}
+
+func TestLoggerWith(t *testing.T) {
+	logger := &loggingTestLogger{}
+	base := LoggerWith(logger, "a", 1)
+	// siblings derived from the same logger do not see each other's keyvals
+	left, right := LoggerWith(base, "b", 2), LoggerWith(base, "c", 3)
+	left.Log(LogInfo, "left", "d", 4)
+	right.Log(LogWarn, "right")
+	base.Log(LogDebug, "dropped")
+	expected := []string{"INFO left a=1 b=2 d=4", "WARN right a=1 c=3"}
+	if fmt.Sprint(logger.messages) != fmt.Sprint(expected) {
+		t.Fatalf("expected %q to be logged, got %q", expected, logger.messages)
+	}
+	if LoggerEnabled(left, LogDebug) || !LoggerEnabled(left, LogInfo) {
+		t.Fatal("expected the derived loggers to be enabled at the levels of the logger they wrap")
+	}
+	if !LoggerEnabled(LoggerWith(plainLoggingTestLogger{}, "a", 1), LogDebug) {
+		t.Fatal("expected a logger wrapping one that is not a LevelLogger to be enabled at every level")
+	}
+}
+
+// loggingTestResource holds a value like LocalArchetypeResource, and logs each read with the logger it is given.
+type loggingTestResource struct {
+	LocalArchetypeResource
+	logger Logger
+}
+
+var _ LoggingArchetypeResource = &loggingTestResource{}
+
+func (res *loggingTestResource) SetLogger(logger Logger) {
+	res.logger = logger
+}
+
+func (res *loggingTestResource) ReadValue() (tla.TLAValue, error) {
+	res.logger.Log(LogInfo, "read")
+	return res.LocalArchetypeResource.ReadValue()
+}
+
+func TestContextLogger(t *testing.T) {
+	boom := errors.New("boom")
+	archetype := MPCalArchetype{
+		Name:              "ALog",
+		Label:             "ALog.read",
+		RequiredRefParams: []string{"ALog.res"},
+		RequiredValParams: []string{},
+		JumpTable: MakeMPCalJumpTable(
+			MPCalCriticalSection{
+				Name: "ALog.read",
+				Body: func(iface ArchetypeInterface) error {
+					res, err := iface.RequireArchetypeResourceRef("ALog.res")
+					if err != nil {
+						return err
+					}
+					if _, err := iface.Read(res, nil); err != nil {
+						return err
+					}
+					iface.Logger().Log(LogWarn, "custom", "key", "value")
+					return iface.Goto("ALog.fail")
+				},
+			},
+			MPCalCriticalSection{
+				Name: "ALog.fail",
+				Body: func(ArchetypeInterface) error {
+					return boom
+				},
+			},
+		),
+		ProcTable: MakeMPCalProcTable(),
+		PreAmble:  func(ArchetypeInterface) {},
+	}
+	logger := &loggingTestLogger{}
+	ctx := NewMPCalContext(tla.MakeTLANumber(1), archetype,
+		EnsureArchetypeRefParam("res", ArchetypeResourceMakerFn(func() ArchetypeResource {
+			return &loggingTestResource{}
+		})),
+		// the logger is only looked up when messages are logged, so it applies to resources made before it is set
+		WithLogger(logger))
+	defer ctx.Close()
+	if err := ctx.Run(); !errors.Is(err, boom) {
+		t.Fatalf("expected the archetype to fail with %v, got %v", boom, err)
+	}
+
+	// messages below LogInfo, such as the one for each aborted critical section, are dropped
+	expected := []string{
+		"INFO read self=1 label=ALog.read resource=&ALog.res",
+		"WARN custom self=1 label=ALog.read key=value",
+		"ERROR archetype failed self=1 label=ALog.fail error=boom",
+	}
+	if fmt.Sprint(logger.messages) != fmt.Sprint(expected) {
+		t.Fatalf("expected %q to be logged, got %q", expected, logger.messages)
+	}
+}
@@ -0,0 +1,44 @@
+package metrics
+
+// Backend makes the metric families that Metrics records into, e.g. by registering collectors of the Prometheus
+// client library. This package does not write Prometheus' exposition format itself; for client_golang, a small
+// adapter over a prometheus.Registerer is enough:
+//
+//	NewCounterVec:   prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labelNames),
+//	                 registered with reg.MustRegister, whose Add is vec.WithLabelValues(labelValues...).Add(delta)
+//	NewGaugeVec:     likewise with prometheus.NewGaugeVec, and Set
+//	NewHistogramVec: likewise with prometheus.NewHistogramVec, with Buckets set to buckets, and Observe
+//
+// The families can then be served by promhttp.HandlerFor, as through Metrics.StartServer.
+type Backend interface {
+	// NewCounterVec makes a family of counters, one per combination of values of labelNames.
+	NewCounterVec(name, help string, labelNames ...string) CounterVec
+	// NewGaugeVec makes a family of gauges, one per combination of values of labelNames.
+	NewGaugeVec(name, help string, labelNames ...string) GaugeVec
+	// NewHistogramVec makes a family of histograms, one per combination of values of labelNames, counting
+	// observations into buckets, given as increasing upper bounds.
+	NewHistogramVec(name, help string, buckets []float64, labelNames ...string) HistogramVec
+}
+
+// CounterVec is a family of counters made by a Backend.
+type CounterVec interface {
+	// Add adds delta, which is never negative, to the counter identified by labelValues, given in the order of
+	// the family's label names.
+	Add(delta float64, labelValues ...string)
+}
+
+// GaugeVec is a family of gauges made by a Backend.
+type GaugeVec interface {
+	// Set sets the gauge identified by labelValues to value.
+	Set(value float64, labelValues ...string)
+}
+
+// HistogramVec is a family of histograms made by a Backend.
+type HistogramVec interface {
+	// Observe adds value to the histogram identified by labelValues.
+	Observe(value float64, labelValues ...string)
+}
+
+// DefaultDurationBuckets are the upper bounds, in seconds, of the histograms of durations made by Metrics, unless
+// configured otherwise. They span 100µs to 10s.
+var DefaultDurationBuckets = []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
@@ -0,0 +1,207 @@
+// Package metrics exports measurements of running archetypes to Prometheus, through a Backend adapting the
+// Prometheus client library, so that this module does not depend on it.
+//
+// A single Metrics is meant to be shared by all archetypes of a process, e.g.:
+//
+//	m := metrics.New(backend)
+//	ctx := distsys.NewMPCalContext(self, AServer, m.ContextOption(), ...)
+//	mailboxes := resources.TCPMailboxesMaker(addrFn, resources.WithTCPMailboxesMetrics(m))
+//	server, err := metrics.StartServer(":9100", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+type config struct {
+	namespace       string
+	durationBuckets []float64
+}
+
+// Option configures a Metrics, as made by New.
+type Option func(cfg *config)
+
+// WithNamespace sets the prefix of all metric names, "pgo" by default.
+func WithNamespace(namespace string) Option {
+	return func(cfg *config) {
+		cfg.namespace = namespace
+	}
+}
+
+// WithDurationBuckets sets the bucket upper bounds, in seconds, of the histograms of durations, instead of
+// DefaultDurationBuckets.
+func WithDurationBuckets(buckets []float64) Option {
+	return func(cfg *config) {
+		cfg.durationBuckets = buckets
+	}
+}
+
+// Metrics records the measurements of archetypes and their mailboxes into Prometheus metrics:
+//   - critical sections run, by archetype (self), label and outcome: committed, aborted, done or failed, whose
+//     rate gives the throughput and abort rate of each label;
+//   - how long critical sections take, including their commit;
+//   - how long each kind of operation takes on each resource, and how many of them fail;
+//   - mailbox traffic, as reported through resources.MailboxMetrics, and the depth of local mailboxes.
+//
+// It is safe for concurrent use, by any number of archetypes and mailboxes.
+type Metrics struct {
+	criticalSections        CounterVec
+	criticalSectionDuration HistogramVec
+	resourceOpDuration      HistogramVec
+	resourceOpErrors        CounterVec
+
+	mailboxMessagesSent     CounterVec
+	mailboxMessagesReceived CounterVec
+	mailboxBytesSent        CounterVec
+	mailboxBytesReceived    CounterVec
+	mailboxCommitRetries    CounterVec
+	mailboxDialFailures     CounterVec
+	mailboxCommitLatency    HistogramVec
+	mailboxQueueDepth       GaugeVec
+}
+
+var _ distsys.ArchetypeObserver = &Metrics{}
+var _ resources.MailboxMetrics = &Metrics{}
+var _ resources.MailboxQueueDepthMetrics = &Metrics{}
+
+// New creates a Metrics, making all of its metric families through backend, which should only be given to one
+// Metrics.
+func New(backend Backend, opts ...Option) *Metrics {
+	cfg := &config{
+		namespace:       "pgo",
+		durationBuckets: DefaultDurationBuckets,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	name := func(name string) string {
+		if cfg.namespace == "" {
+			return name
+		}
+		return cfg.namespace + "_" + name
+	}
+
+	return &Metrics{
+		criticalSections: backend.NewCounterVec(name("critical_sections_total"),
+			"Critical sections run, by outcome: committed, aborted, done or failed.", "self", "label", "outcome"),
+		criticalSectionDuration: backend.NewHistogramVec(name("critical_section_duration_seconds"),
+			"Time taken to execute and commit critical sections, whatever their outcome.", cfg.durationBuckets, "self", "label"),
+		resourceOpDuration: backend.NewHistogramVec(name("resource_operation_duration_seconds"),
+			"Time taken by operations on archetype resources, by operation: read, write, precommit, commit or abort.", cfg.durationBuckets, "self", "resource", "operation"),
+		resourceOpErrors: backend.NewCounterVec(name("resource_operation_errors_total"),
+			"Operations on archetype resources that returned an error, including aborts.", "self", "resource", "operation"),
+
+		mailboxMessagesSent: backend.NewCounterVec(name("mailbox_messages_sent_total"),
+			"Messages delivered to remote mailboxes.", "mailbox"),
+		mailboxMessagesReceived: backend.NewCounterVec(name("mailbox_messages_received_total"),
+			"Messages committed into local mailboxes.", "mailbox"),
+		mailboxBytesSent: backend.NewCounterVec(name("mailbox_bytes_sent_total"),
+			"Bytes written to the network by mailboxes.", "mailbox"),
+		mailboxBytesReceived: backend.NewCounterVec(name("mailbox_bytes_received_total"),
+			"Bytes read from the network by mailboxes.", "mailbox"),
+		mailboxCommitRetries: backend.NewCounterVec(name("mailbox_commit_retries_total"),
+			"Commits to remote mailboxes that had to reconnect and resend their messages.", "mailbox"),
+		mailboxDialFailures: backend.NewCounterVec(name("mailbox_dial_failures_total"),
+			"Failed attempts to connect to remote mailboxes.", "mailbox"),
+		mailboxCommitLatency: backend.NewHistogramVec(name("mailbox_commit_latency_seconds"),
+			"Time taken to commit messages to remote mailboxes, from PreCommit to the end of Commit.", cfg.durationBuckets, "mailbox"),
+		mailboxQueueDepth: backend.NewGaugeVec(name("mailbox_queue_depth"),
+			"Messages waiting to be read from local mailboxes.", "mailbox"),
+	}
+}
+
+// ContextOption configures an MPCalContext to report its critical sections and resource operations to m.
+func (m *Metrics) ContextOption() distsys.MPCalContextConfigFn {
+	return distsys.WithArchetypeObserver(m)
+}
+
+// StartServer starts serving handler, e.g. the Prometheus client library's promhttp handler for the registry
+// behind a Metrics' Backend, at /metrics on listenAddr, in the background. It returns once the listener is open;
+// the returned server, whose Addr is the address listened on, should be closed when the process no longer needs
+// it.
+func StartServer(listenAddr string, handler http.Handler) (*http.Server, error) {
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("could not listen on address %s: %w", listenAddr, err)
+	}
+	distsys.DefaultLogger.Log(distsys.LogInfo, "serving metrics", "address", listener.Addr())
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", handler)
+	server := &http.Server{Addr: listener.Addr().String(), Handler: mux}
+	go func() {
+		err := server.Serve(listener)
+		if err != http.ErrServerClosed {
+			distsys.DefaultLogger.Log(distsys.LogError, "error serving metrics", "address", listenAddr, "error", err)
+		}
+	}()
+	return server, nil
+}
+
+func criticalSectionOutcome(err error) string {
+	switch {
+	case err == nil:
+		return "committed"
+	case errors.Is(err, distsys.ErrCriticalSectionAborted):
+		return "aborted"
+	case errors.Is(err, distsys.ErrDone):
+		return "done"
+	default:
+		return "failed"
+	}
+}
+
+func (m *Metrics) CriticalSectionStarted(self tla.TLAValue, label string) {}
+
+func (m *Metrics) CriticalSectionFinished(self tla.TLAValue, label string, err error, elapsed time.Duration) {
+	selfStr := self.String()
+	m.criticalSections.Add(1, selfStr, label, criticalSectionOutcome(err))
+	m.criticalSectionDuration.Observe(elapsed.Seconds(), selfStr, label)
+}
+
+func (m *Metrics) ResourceOperation(self tla.TLAValue, handle distsys.ArchetypeResourceHandle, op distsys.ResourceOperation, err error, elapsed time.Duration) {
+	selfStr := self.String()
+	m.resourceOpDuration.Observe(elapsed.Seconds(), selfStr, string(handle), op.String())
+	if err != nil {
+		m.resourceOpErrors.Add(1, selfStr, string(handle), op.String())
+	}
+}
+
+func (m *Metrics) MessagesSent(index tla.TLAValue, count int) {
+	m.mailboxMessagesSent.Add(float64(count), index.String())
+}
+
+func (m *Metrics) MessagesReceived(index tla.TLAValue, count int) {
+	m.mailboxMessagesReceived.Add(float64(count), index.String())
+}
+
+func (m *Metrics) BytesSent(index tla.TLAValue, n int) {
+	m.mailboxBytesSent.Add(float64(n), index.String())
+}
+
+func (m *Metrics) BytesReceived(index tla.TLAValue, n int) {
+	m.mailboxBytesReceived.Add(float64(n), index.String())
+}
+
+func (m *Metrics) CommitRetried(index tla.TLAValue) {
+	m.mailboxCommitRetries.Add(1, index.String())
+}
+
+func (m *Metrics) DialFailed(index tla.TLAValue) {
+	m.mailboxDialFailures.Add(1, index.String())
+}
+
+func (m *Metrics) CommitLatency(index tla.TLAValue, latency time.Duration) {
+	m.mailboxCommitLatency.Observe(latency.Seconds(), index.String())
+}
+
+func (m *Metrics) QueueDepth(index tla.TLAValue, depth int) {
+	m.mailboxQueueDepth.Set(float64(depth), index.String())
+}
@@ -0,0 +1,173 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// recordingBackend is a Backend keeping the value of each series in memory, as "<name>{<label values>}", with
+// histograms recording the sum and count of their observations.
+type recordingBackend struct {
+	lock    sync.Mutex
+	help    map[string]string
+	buckets map[string][]float64
+	values  map[string]float64
+}
+
+var _ Backend = &recordingBackend{}
+
+func newRecordingBackend() *recordingBackend {
+	return &recordingBackend{
+		help:    make(map[string]string),
+		buckets: make(map[string][]float64),
+		values:  make(map[string]float64),
+	}
+}
+
+type recordingVec struct {
+	backend    *recordingBackend
+	name       string
+	labelNames []string
+}
+
+func (backend *recordingBackend) newVec(name, help string, labelNames []string) *recordingVec {
+	backend.lock.Lock()
+	defer backend.lock.Unlock()
+	if _, ok := backend.help[name]; ok {
+		panic(fmt.Errorf("metric %s made twice", name))
+	}
+	backend.help[name] = help
+	return &recordingVec{backend: backend, name: name, labelNames: labelNames}
+}
+
+func (backend *recordingBackend) NewCounterVec(name, help string, labelNames ...string) CounterVec {
+	return backend.newVec(name, help, labelNames)
+}
+
+func (backend *recordingBackend) NewGaugeVec(name, help string, labelNames ...string) GaugeVec {
+	return backend.newVec(name, help, labelNames)
+}
+
+func (backend *recordingBackend) NewHistogramVec(name, help string, buckets []float64, labelNames ...string) HistogramVec {
+	backend.lock.Lock()
+	backend.buckets[name] = buckets
+	backend.lock.Unlock()
+	return backend.newVec(name, help, labelNames)
+}
+
+func (vec *recordingVec) update(suffix string, labelValues []string, fn func(value float64) float64) {
+	if len(labelValues) != len(vec.labelNames) {
+		panic(fmt.Errorf("metric %s has labels %v, but was given values %v", vec.name, vec.labelNames, labelValues))
+	}
+	key := fmt.Sprintf("%s%s{%s}", vec.name, suffix, strings.Join(labelValues, ","))
+	vec.backend.lock.Lock()
+	defer vec.backend.lock.Unlock()
+	vec.backend.values[key] = fn(vec.backend.values[key])
+}
+
+func (vec *recordingVec) Add(delta float64, labelValues ...string) {
+	vec.update("", labelValues, func(value float64) float64 { return value + delta })
+}
+
+func (vec *recordingVec) Set(value float64, labelValues ...string) {
+	vec.update("", labelValues, func(float64) float64 { return value })
+}
+
+func (vec *recordingVec) Observe(value float64, labelValues ...string) {
+	vec.update("_sum", labelValues, func(sum float64) float64 { return sum + value })
+	vec.update("_count", labelValues, func(count float64) float64 { return count + 1 })
+}
+
+func (backend *recordingBackend) expect(t *testing.T, expected map[string]float64) {
+	t.Helper()
+	backend.lock.Lock()
+	defer backend.lock.Unlock()
+	for key, value := range expected {
+		if actual := backend.values[key]; actual != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, actual)
+		}
+	}
+	if len(backend.values) != len(expected) {
+		t.Errorf("expected only %v to be recorded, got %v", expected, backend.values)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	backend := newRecordingBackend()
+	m := New(backend, WithNamespace("test"), WithDurationBuckets([]float64{1, 2}))
+	if len(backend.help) != 12 {
+		t.Fatalf("expected 12 metric families, got %d", len(backend.help))
+	}
+	if buckets := backend.buckets["test_critical_section_duration_seconds"]; len(buckets) != 2 {
+		t.Fatalf("expected the configured buckets, got %v", buckets)
+	}
+
+	self := tla.MakeTLANumber(1)
+	m.CriticalSectionFinished(self, "AServer.loop", nil, time.Second)
+	m.CriticalSectionFinished(self, "AServer.loop", distsys.ErrCriticalSectionAborted, time.Second)
+	m.CriticalSectionFinished(self, "AServer.loop", fmt.Errorf("wrapped: %w", distsys.ErrCriticalSectionAborted), time.Second)
+	m.CriticalSectionFinished(self, "AServer.Done", distsys.ErrDone, 0)
+	m.ResourceOperation(self, "AServer.net", distsys.ResourceRead, nil, time.Second)
+	m.ResourceOperation(self, "AServer.net", distsys.ResourceRead, distsys.ErrCriticalSectionAborted, time.Second)
+	index := tla.MakeTLAString("server")
+	m.MessagesSent(index, 3)
+	m.BytesSent(index, 100)
+	m.CommitRetried(index)
+	m.CommitLatency(index, 2*time.Second)
+	m.QueueDepth(index, 5)
+	m.QueueDepth(index, 2)
+
+	backend.expect(t, map[string]float64{
+		"test_critical_sections_total{1,AServer.loop,committed}":             1,
+		"test_critical_sections_total{1,AServer.loop,aborted}":               2,
+		"test_critical_sections_total{1,AServer.Done,done}":                  1,
+		"test_critical_section_duration_seconds_sum{1,AServer.loop}":         3,
+		"test_critical_section_duration_seconds_count{1,AServer.loop}":       3,
+		"test_critical_section_duration_seconds_sum{1,AServer.Done}":         0,
+		"test_critical_section_duration_seconds_count{1,AServer.Done}":       1,
+		"test_resource_operation_duration_seconds_sum{1,AServer.net,read}":   2,
+		"test_resource_operation_duration_seconds_count{1,AServer.net,read}": 2,
+		"test_resource_operation_errors_total{1,AServer.net,read}":           1,
+		"test_mailbox_messages_sent_total{\"server\"}":                       3,
+		"test_mailbox_bytes_sent_total{\"server\"}":                          100,
+		"test_mailbox_commit_retries_total{\"server\"}":                      1,
+		"test_mailbox_commit_latency_seconds_sum{\"server\"}":                2,
+		"test_mailbox_commit_latency_seconds_count{\"server\"}":              1,
+		"test_mailbox_queue_depth{\"server\"}":                               2,
+	})
+}
+
+func TestStartServer(t *testing.T) {
+	server, err := StartServer("127.0.0.1:0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "test_metric 1\n")
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	resp, err := http.Get("http://" + server.Addr + "/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != "test_metric 1\n" {
+		t.Fatalf("expected the handler's response, got %q, %v", body, err)
+	}
+	other, err := http.Get("http://" + server.Addr + "/other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = other.Body.Close()
+	if other.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected only /metrics to be served, got %s", other.Status)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/UBC-NSS/pgo/distsys/tla"
 
@@ -143,12 +145,36 @@ type MPCalContext struct {
 	iface ArchetypeInterface
 
 	constantDefns map[string]func(args ...tla.TLAValue) tla.TLAValue
+	// memoizedDefns holds the values of the definitions memoized by ArchetypeInterface.MemoizeDefinition, by name
+	memoizedDefns sync.Map
 
 	done   chan struct{}
 	events chan struct{}
 
-	lock   sync.Mutex
-	closed bool
+	budget        *executionBudgetState // nil if no ExecutionBudget was configured
+	localStateLog *LocalStateLog        // nil unless configured WithPersistentLocalState
+	observers     []ArchetypeObserver
+	tracer        ArchetypeTracer      // nil unless configured WithArchetypeTracer
+	span          ArchetypeSpan        // the span of the running critical section, if tracing
+	logger        Logger               // nil unless configured WithLogger, as DefaultLogger is used then
+	printHook     func(v tla.TLAValue) // nil unless configured WithPrintHook, as prints are logged then
+	currentLabel  atomic.Value         // the label of the running or latest critical section, as a string, for logging
+
+	flightRecorder *flightRecorder // nil if disabled WithFlightRecorderSize(0)
+	branchChooser  BranchChooser   // nil unless configured WithBranchChooser
+	recording      *Recording      // nil unless configured WithRecording
+	replay         *Recording      // nil unless configured WithReplay
+	replayErr      error           // why replaying the current critical section failed, if it did outside of an operation
+	invariants     []namedInvariant
+
+	pauseRequests  chan func()          // functions to run between critical sections, see whilePaused
+	pendingRestore *archetypeCheckpoint // set by Restore, applied when Run starts
+	runDone        chan struct{}        // closed when Run returns
+
+	lock     sync.Mutex
+	closed   bool
+	running  bool
+	stepping bool // set once Step has set up the archetype
 }
 
 type MPCalContextConfigFn func(ctx *MPCalContext)
@@ -188,6 +214,10 @@ func NewMPCalContext(self tla.TLAValue, archetype MPCalArchetype, configFns ...M
 		done:   make(chan struct{}),
 		events: make(chan struct{}, 2),
 
+		pauseRequests: make(chan func()),
+
+		flightRecorder: newFlightRecorder(DefaultFlightRecorderSize),
+
 		closed: false,
 	}
 	ctx.iface = ArchetypeInterface{ctx: ctx}
@@ -412,6 +442,7 @@ func (ctx *MPCalContext) ensureArchetypeResource(name string, maker ArchetypeRes
 	} else {
 		res := maker.Make()
 		maker.Configure(res)
+		ctx.setResourceLogger(handle, res)
 		ctx.resources[handle] = res
 	}
 	return handle
@@ -426,6 +457,7 @@ func (ctx *MPCalContext) getResourceByHandle(handle ArchetypeResourceHandle) Arc
 }
 
 func (ctx *MPCalContext) abort() {
+	start := time.Now()
 	var nonTrivialAborts []chan struct{}
 	for resHandle := range ctx.dirtyResourceHandles {
 		ch := ctx.getResourceByHandle(resHandle).Abort()
@@ -436,6 +468,9 @@ func (ctx *MPCalContext) abort() {
 	for _, ch := range nonTrivialAborts {
 		<-ch
 	}
+	if len(ctx.observers) != 0 {
+		ctx.observeResourceOperations(ResourceAbort, nil, start)
+	}
 
 	// the go compiler optimizes this to a map clear operation
 	for resHandle := range ctx.dirtyResourceHandles {
@@ -444,6 +479,7 @@ func (ctx *MPCalContext) abort() {
 }
 
 func (ctx *MPCalContext) commit() (err error) {
+	start := time.Now()
 	// dispatch all parts of the pre-commit phase asynchronously, so we only wait as long as the slowest resource
 	var nonTrivialPreCommits []chan error
 	for resHandle := range ctx.dirtyResourceHandles {
@@ -458,6 +494,10 @@ func (ctx *MPCalContext) commit() (err error) {
 			err = localErr
 		}
 	}
+	if len(ctx.observers) != 0 {
+		ctx.observeResourceOperations(ResourcePreCommit, err, start)
+		start = time.Now()
+	}
 
 	// if there was an error, stop now, and expect either (1) total crash, or (2) Abort to be called
 	if err != nil {
@@ -475,6 +515,9 @@ func (ctx *MPCalContext) commit() (err error) {
 	for _, ch := range nonTrivialCommits {
 		<-ch
 	}
+	if len(ctx.observers) != 0 {
+		ctx.observeResourceOperations(ResourceCommit, nil, start)
+	}
 
 	// the go compiler optimizes this to a map clear operation
 	for resHandle := range ctx.dirtyResourceHandles {
@@ -521,16 +564,29 @@ func (ctx *MPCalContext) Run() error {
 		ctx.lock.Unlock()
 		return ErrContextClosed
 	}
+	ctx.running = true
+	ctx.runDone = make(chan struct{})
 	ctx.lock.Unlock()
+	defer func() {
+		ctx.lock.Lock()
+		ctx.running = false
+		close(ctx.runDone)
+		ctx.lock.Unlock()
+	}()
 
 	// report start, and defer reporting completion to whenever this function returns
 	ctx.reportEvent(archetypeStarted)
 	defer ctx.reportEvent(archetypeFinished)
+	ctx.registerRunning()
+	defer ctx.unregisterRunning()
 
 	// pre-sanity checks: an archetype should be provided if we're going to try and run one
 	ctx.requireArchetype()
 	// sanity checks and other setup, done here so you can init a context, not call Run, and not get checks
 	ctx.preRun()
+	if err := ctx.applyPendingRestore(); err != nil {
+		return err
+	}
 
 	pc := ctx.iface.RequireArchetypeResource(".pc")
 	var err error
@@ -539,38 +595,101 @@ func (ctx *MPCalContext) Run() error {
 		switch err {
 		case nil: // everything is fine; carry on
 		case ErrCriticalSectionAborted:
+			ctx.Logger().Log(LogDebug, "critical section aborted")
+			start := time.Now()
 			ctx.abort()
+			if ctx.budget != nil {
+				ctx.budget.recordAbort(start)
+			}
 			err = nil
 		case ErrDone: // signals that we're done; quit successfully
+			ctx.Logger().Log(LogDebug, "archetype finished")
 			return nil
 		default:
 			// some other error; return it to caller, we probably crashed
+			ctx.Logger().Log(LogError, "archetype failed", "error", err)
+			if ctx.flightRecorder != nil && ctx.flightRecorder.dumpOnError != nil {
+				_ = ctx.DumpFlightRecords(ctx.flightRecorder.dumpOnError)
+			}
 			return err
 		}
 
 		// poll the done channel for Close calls.
 		// this should execute "regularly", since all archetype label implementations are non-blocking
 		// (except commits, which we discretely ignore; you can't cancel them, anyhow)
+		// checkpoints and inspections are also done here, between critical sections
 		select {
 		case <-ctx.done:
 			return ErrContextClosed
+		case fn := <-ctx.pauseRequests:
+			fn()
 		default: // pass
 		}
 
-		var pcVal tla.TLAValue
-		pcVal, err = ctx.iface.Read(pc, nil)
-		if err != nil {
-			continue
+		if ctx.budget != nil {
+			if !ctx.budget.await(ctx.done) {
+				return ErrContextClosed
+			}
 		}
-		pcValStr := pcVal.AsString()
+		err = ctx.runCriticalSection(pc)
+	}
+}
 
-		criticalSection := ctx.iface.getCriticalSection(pcValStr)
-		err = criticalSection.Body(ctx.iface)
-		if err != nil {
-			continue
+// runCriticalSection executes and commits the critical section indicated by the program counter pc, accounting for
+// its cost if an execution budget is configured.
+func (ctx *MPCalContext) runCriticalSection(pc ArchetypeResourceHandle) (err error) {
+	if ctx.budget != nil {
+		defer ctx.budget.record(time.Now())
+	}
+
+	pcVal, err := ctx.iface.Read(pc, nil)
+	if err != nil {
+		return err
+	}
+	pcValStr := pcVal.AsString()
+	ctx.currentLabel.Store(pcValStr)
+	if ctx.flightRecorder != nil {
+		start := time.Now()
+		defer func() { ctx.flightRecorder.record(pcValStr, start, err) }()
+	}
+	if len(ctx.observers) != 0 {
+		ctx.observeCriticalSectionStarted(pcValStr)
+		start := time.Now()
+		defer func() { ctx.observeCriticalSectionFinished(pcValStr, err, start) }()
+	}
+	if ctx.tracer != nil {
+		ctx.span = ctx.tracer.StartSpan(ctx.self, pcValStr)
+		defer func() {
+			ctx.span.End(err)
+			ctx.span = nil
+		}()
+	}
+
+	criticalSection := ctx.iface.getCriticalSection(pcValStr)
+	err = criticalSection.Body(ctx.iface)
+	if ctx.replayErr != nil {
+		err, ctx.replayErr = ctx.replayErr, nil
+	}
+	if err != nil {
+		return err
+	}
+	switch {
+	case ctx.replay != nil:
+		// only local state takes part in the commit, so it must not go ahead if the recorded commit failed
+		if err = ctx.replayCommit(); err != nil {
+			return err
 		}
 		err = ctx.commit()
+	case ctx.recording != nil:
+		err = ctx.commit()
+		ctx.recordCommit(err)
+	default:
+		err = ctx.commit()
+	}
+	if err == nil && len(ctx.invariants) != 0 {
+		err = ctx.checkInvariants(pcValStr)
 	}
+	return err
 }
 
 // Done returns a channel that blocks until the context closes. Successive
@@ -0,0 +1,92 @@
+package distsys
+
+import (
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ResourceOperation identifies an operation performed on an archetype resource, as reported to an
+// ArchetypeObserver.
+type ResourceOperation int
+
+const (
+	ResourceRead ResourceOperation = iota
+	ResourceWrite
+	ResourcePreCommit
+	ResourceCommit
+	ResourceAbort
+)
+
+func (op ResourceOperation) String() string {
+	switch op {
+	case ResourceRead:
+		return "read"
+	case ResourceWrite:
+		return "write"
+	case ResourcePreCommit:
+		return "precommit"
+	case ResourceCommit:
+		return "commit"
+	case ResourceAbort:
+		return "abort"
+	default:
+		return "unknown"
+	}
+}
+
+// ArchetypeObserver is notified of an archetype's progress, e.g. to collect metrics. Its methods are called from
+// the goroutine running the archetype, so they should return quickly. An observer may be shared by several
+// contexts, in which case it must be safe for concurrent use.
+type ArchetypeObserver interface {
+	// CriticalSectionStarted is called before the critical section label starts executing.
+	CriticalSectionStarted(self tla.TLAValue, label string)
+	// CriticalSectionFinished is called once the critical section label has committed, in which case err is nil,
+	// or failed. err is ErrCriticalSectionAborted if it is about to be aborted and retried, ErrDone if the
+	// archetype has terminated, or any other error that stops the archetype. elapsed covers execution and commit.
+	CriticalSectionFinished(self tla.TLAValue, label string, err error, elapsed time.Duration)
+	// ResourceOperation is called after each operation on one of the archetype's resources, given by the handle of
+	// the resource as passed to the archetype. Reads and writes through an index, as in res[i] := v, are reported
+	// against res. As resources pre-commit, commit and abort concurrently, those operations are reported against
+	// every resource involved, with the time taken by the whole phase.
+	ResourceOperation(self tla.TLAValue, handle ArchetypeResourceHandle, op ResourceOperation, err error, elapsed time.Duration)
+}
+
+// WithArchetypeObserver registers observer to be notified of the archetype's progress. Several observers may be
+// registered, and are called in the order they were registered.
+func WithArchetypeObserver(observer ArchetypeObserver) MPCalContextConfigFn {
+	return func(ctx *MPCalContext) {
+		ctx.observers = append(ctx.observers, observer)
+	}
+}
+
+func (ctx *MPCalContext) observeCriticalSectionStarted(label string) {
+	for _, observer := range ctx.observers {
+		observer.CriticalSectionStarted(ctx.self, label)
+	}
+}
+
+func (ctx *MPCalContext) observeCriticalSectionFinished(label string, err error, start time.Time) {
+	elapsed := time.Since(start)
+	for _, observer := range ctx.observers {
+		observer.CriticalSectionFinished(ctx.self, label, err, elapsed)
+	}
+}
+
+func (ctx *MPCalContext) observeResourceOperation(handle ArchetypeResourceHandle, op ResourceOperation, err error, start time.Time) {
+	elapsed := time.Since(start)
+	for _, observer := range ctx.observers {
+		observer.ResourceOperation(ctx.self, handle, op, err, elapsed)
+	}
+}
+
+// observeResourceOperations reports a commit protocol phase, which runs on all resources used by the critical
+// section at once, against each of those resources.
+func (ctx *MPCalContext) observeResourceOperations(op ResourceOperation, err error, start time.Time) {
+	elapsed := time.Since(start)
+	for handle := range ctx.dirtyResourceHandles {
+		for _, observer := range ctx.observers {
+			observer.ResourceOperation(ctx.self, handle, op, err, elapsed)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+module github.com/UBC-NSS/pgo/distsys/quicmailboxes
+
+go 1.24
+
+replace github.com/UBC-NSS/pgo/distsys => ../
+
+require (
+	github.com/UBC-NSS/pgo/distsys v0.0.0-00010101000000-000000000000
+	github.com/quic-go/quic-go v0.59.1
+)
+
+require (
+	github.com/benbjohnson/immutable v0.3.0 // indirect
+	go.uber.org/atomic v1.9.0 // indirect
+	go.uber.org/multierr v1.7.0 // indirect
+	golang.org/x/crypto v0.41.0 // indirect
+	golang.org/x/net v0.43.0 // indirect
+	golang.org/x/sys v0.35.0 // indirect
+)
@@ -0,0 +1,226 @@
+// Package quicmailboxes provides a QUIC transport for TCP mailboxes, built on quic-go.
+//
+// It is a separate module from distsys, so that only programs using it depend on quic-go, and on the Go version it
+// requires.
+package quicmailboxes
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/quic-go/quic-go"
+)
+
+const (
+	// nextProto is the ALPN protocol negotiated by mailbox connections, so that the transport cannot be mistaken for
+	// another protocol served over QUIC, such as HTTP/3
+	nextProto = "pgo-mailboxes"
+	// keepAlivePeriod keeps idle connections between mailboxes open, as they are typically long-lived
+	keepAlivePeriod = 10 * time.Second
+	// maxIncomingStreams bounds the mailbox connections one peer may have open to a listener at once
+	maxIncomingStreams = 1000
+	// acceptQueueSize bounds the streams accepted from peers, but not yet returned by Accept
+	acceptQueueSize = 100
+
+	errorCodeClosed quic.ApplicationErrorCode = 0
+	errorCodeCancel quic.StreamErrorCode      = 0
+)
+
+// Transport carries mailbox connections over QUIC. All mailbox connections to the same address share one QUIC
+// connection, each as one of its streams, so they are multiplexed without head-of-line blocking between them, and
+// all are encrypted with TLS 1.3. As QUIC identifies connections by ID rather than by address, a peer whose address
+// changes, e.g. by moving between networks, keeps its connections. Addresses are host:port pairs, as for
+// resources.DefaultMailboxTransport, but the port is a UDP port.
+//
+// Listeners use serverConfig, which must provide a certificate, and dialers use clientConfig; requiring client
+// certificates in serverConfig gives mutual authentication between nodes, as with resources.TLSMailboxTransport.
+// Both configurations are cloned, and set to negotiate the mailbox protocol via ALPN.
+func Transport(serverConfig, clientConfig *tls.Config) resources.MailboxTransport {
+	transport := &quicTransport{
+		conns: make(map[string]*quic.Conn),
+		quicConfig: &quic.Config{
+			KeepAlivePeriod:    keepAlivePeriod,
+			MaxIncomingStreams: maxIncomingStreams,
+		},
+	}
+	if serverConfig != nil {
+		transport.serverConfig = serverConfig.Clone()
+		transport.serverConfig.NextProtos = []string{nextProto}
+	}
+	if clientConfig != nil {
+		transport.clientConfig = clientConfig.Clone()
+		transport.clientConfig.NextProtos = []string{nextProto}
+	}
+	return transport
+}
+
+type quicTransport struct {
+	serverConfig, clientConfig *tls.Config
+	quicConfig                 *quic.Config
+
+	lock  sync.Mutex
+	conns map[string]*quic.Conn // by dialed address; shared by all the mailbox connections to that address
+}
+
+var _ resources.MailboxTransport = &quicTransport{}
+
+// Listen accepts streams from QUIC connections to addr. Unlike with TCP, closing the listener also closes every
+// connection it accepted, along with their streams, so that peers notice promptly and dial again.
+func (transport *quicTransport) Listen(addr string) (net.Listener, error) {
+	if transport.serverConfig == nil || (len(transport.serverConfig.Certificates) == 0 && transport.serverConfig.GetCertificate == nil) {
+		return nil, fmt.Errorf("cannot listen for QUIC mailbox connections on %s without a TLS certificate", addr)
+	}
+	baseListener, err := quic.ListenAddr(addr, transport.serverConfig, transport.quicConfig)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	listener := &quicListener{
+		listener: baseListener,
+		ctx:      ctx,
+		cancel:   cancel,
+		accepted: make(chan net.Conn, acceptQueueSize),
+	}
+	go listener.acceptConns()
+	return listener, nil
+}
+
+// Dial opens a stream to addr, over the existing QUIC connection to it if there is one.
+func (transport *quicTransport) Dial(addr string, timeout time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	conn, err := transport.connTo(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		// the connection may have died since it was last used; forget it, so that the next Dial replaces it
+		transport.forget(addr, conn)
+		return nil, err
+	}
+	return &quicStreamConn{Stream: stream, conn: conn}, nil
+}
+
+func (transport *quicTransport) connTo(ctx context.Context, addr string) (*quic.Conn, error) {
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+	if conn, ok := transport.conns[addr]; ok {
+		if conn.Context().Err() == nil {
+			return conn, nil
+		}
+		delete(transport.conns, addr)
+	}
+	conn, err := quic.DialAddr(ctx, addr, transport.clientConfig, transport.quicConfig)
+	if err != nil {
+		return nil, err
+	}
+	transport.conns[addr] = conn
+	return conn, nil
+}
+
+func (transport *quicTransport) forget(addr string, conn *quic.Conn) {
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+	if transport.conns[addr] == conn {
+		delete(transport.conns, addr)
+		_ = conn.CloseWithError(errorCodeClosed, "")
+	}
+}
+
+// quicListener presents the streams opened by peers, over any of their connections, as accepted net.Conns.
+type quicListener struct {
+	listener *quic.Listener
+	ctx      context.Context // cancelled when the listener is closed
+	cancel   context.CancelFunc
+	accepted chan net.Conn
+
+	closeOnce sync.Once
+}
+
+var _ net.Listener = &quicListener{}
+
+func (listener *quicListener) acceptConns() {
+	for {
+		conn, err := listener.listener.Accept(listener.ctx)
+		if err != nil {
+			return
+		}
+		go listener.acceptStreams(conn)
+	}
+}
+
+// acceptStreams accepts streams from conn until either conn or the listener is closed, then closes conn.
+func (listener *quicListener) acceptStreams(conn *quic.Conn) {
+	defer func() {
+		_ = conn.CloseWithError(errorCodeClosed, "")
+	}()
+	for {
+		stream, err := conn.AcceptStream(listener.ctx)
+		if err != nil {
+			return
+		}
+		select {
+		case listener.accepted <- &quicStreamConn{Stream: stream, conn: conn}:
+		case <-listener.ctx.Done():
+			stream.CancelRead(errorCodeCancel)
+			stream.CancelWrite(errorCodeCancel)
+			return
+		}
+	}
+}
+
+func (listener *quicListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-listener.accepted:
+		return conn, nil
+	case <-listener.ctx.Done():
+		return nil, net.ErrClosed
+	}
+}
+
+func (listener *quicListener) Close() error {
+	var err error
+	listener.closeOnce.Do(func() {
+		listener.cancel()
+		err = listener.listener.Close()
+	})
+	return err
+}
+
+func (listener *quicListener) Addr() net.Addr {
+	return listener.listener.Addr()
+}
+
+// quicStreamConn is a QUIC stream, presented as a net.Conn.
+type quicStreamConn struct {
+	*quic.Stream
+	conn *quic.Conn
+}
+
+var _ net.Conn = &quicStreamConn{}
+
+// Close closes both directions of the stream. Unlike quic.Stream's Close, which only closes the sending direction,
+// this matches what closing a TCP connection does.
+func (conn *quicStreamConn) Close() error {
+	err := conn.Stream.Close()
+	conn.Stream.CancelRead(errorCodeCancel)
+	if errors.Is(err, net.ErrClosed) {
+		err = nil
+	}
+	return err
+}
+
+func (conn *quicStreamConn) LocalAddr() net.Addr {
+	return conn.conn.LocalAddr()
+}
+
+func (conn *quicStreamConn) RemoteAddr() net.Addr {
+	return conn.conn.RemoteAddr()
+}
@@ -0,0 +1,208 @@
+package quicmailboxes
+
+import (
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const testTimeout = 5 * time.Second
+
+// testTLSConfigs returns server and client TLS configurations for 127.0.0.1, borrowed from an httptest.Server.
+func testTLSConfigs(t *testing.T) (serverConfig, clientConfig *tls.Config) {
+	t.Helper()
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+	serverConfig = &tls.Config{Certificates: server.TLS.Certificates}
+	clientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig.Clone()
+	return serverConfig, clientConfig
+}
+
+func testAccept(t *testing.T, listener net.Listener) net.Conn {
+	t.Helper()
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			accepted <- conn
+		}
+	}()
+	select {
+	case conn := <-accepted:
+		t.Cleanup(func() {
+			_ = conn.Close()
+		})
+		return conn
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for Accept")
+		return nil
+	}
+}
+
+func testExchange(t *testing.T, from, to net.Conn, msg string) {
+	t.Helper()
+	if _, err := from.Write([]byte(msg)); err != nil {
+		t.Fatal(err)
+	}
+	if err := to.SetReadDeadline(time.Now().Add(testTimeout)); err != nil {
+		t.Fatal(err)
+	}
+	received := make([]byte, len(msg))
+	if _, err := io.ReadFull(to, received); err != nil || string(received) != msg {
+		t.Fatalf("expected to receive %q, got %q, %v", msg, received, err)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	serverConfig, clientConfig := testTLSConfigs(t)
+	transport := Transport(serverConfig, clientConfig)
+	listener, err := transport.Listen("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	addr := listener.Addr().String()
+
+	// the dialing side writes first, as mailboxes do, since a stream is only announced along with its first data
+	var clients, servers []net.Conn
+	for _, msg := range []string{"first", "second"} {
+		client, err := transport.Dial(addr, testTimeout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+		if _, err := client.Write([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+		server := testAccept(t, listener)
+		received := make([]byte, len(msg))
+		if _, err := io.ReadFull(server, received); err != nil || string(received) != msg {
+			t.Fatalf("expected to receive %q, got %q, %v", msg, received, err)
+		}
+		clients, servers = append(clients, client), append(servers, server)
+	}
+	// both are streams of the same connection, and independent of each other
+	if n := len(transport.(*quicTransport).conns); n != 1 {
+		t.Fatalf("expected connections to one address to share one QUIC connection, but there are %d", n)
+	}
+	testExchange(t, servers[1], clients[1], "pong")
+	testExchange(t, servers[0], clients[0], "pong")
+
+	// closing one stream closes it in both directions, leaving the other stream be
+	if err := clients[0].Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := servers[0].SetReadDeadline(time.Now().Add(testTimeout)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := servers[0].Read(make([]byte, 1)); err != io.EOF {
+		t.Fatalf("expected EOF from a closed stream, got %v", err)
+	}
+	testExchange(t, clients[1], servers[1], "still open")
+
+	// closing the listener closes the connections it accepted, and further dials make a new one
+	if err := listener.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := listener.Accept(); !errors.Is(err, net.ErrClosed) {
+		t.Fatalf("expected Accept on a closed listener to fail with net.ErrClosed, got %v", err)
+	}
+	if err := clients[1].SetReadDeadline(time.Now().Add(testTimeout)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clients[1].Read(make([]byte, 1)); err == nil {
+		t.Fatal("expected reading from a stream of a closed listener's connection to fail")
+	}
+	if _, err := transport.Dial(addr, 100*time.Millisecond); err == nil {
+		t.Fatal("expected dialing a closed listener to fail")
+	}
+}
+
+func TestTransportRequiresCertificate(t *testing.T) {
+	_, clientConfig := testTLSConfigs(t)
+	if _, err := Transport(&tls.Config{}, clientConfig).Listen("127.0.0.1:0"); err == nil {
+		t.Fatal("expected listening without a certificate to fail")
+	}
+}
+
+func TestTransportMailboxes(t *testing.T) {
+	serverConfig, clientConfig := testTLSConfigs(t)
+	packetConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := packetConn.LocalAddr().String()
+	if err := packetConn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	makeMailboxes := func(self int32) distsys.ArchetypeResource {
+		maker := resources.TCPMailboxesMaker(func(index tla.TLAValue) (resources.TCPMailboxKind, string) {
+			kind := resources.TCPMailboxesRemote
+			if index.AsNumber() == self {
+				kind = resources.TCPMailboxesLocal
+			}
+			return kind, addr
+		}, resources.WithTCPMailboxesTransport(Transport(serverConfig, clientConfig)))
+		res := maker.Make()
+		maker.Configure(res)
+		t.Cleanup(func() {
+			_ = res.Close()
+		})
+		return res
+	}
+	index := tla.MakeTLANumber(0)
+	mailboxIndex := func(res distsys.ArchetypeResource) distsys.ArchetypeResource {
+		t.Helper()
+		mailbox, err := res.Index(index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return mailbox
+	}
+	commit := func(res distsys.ArchetypeResource) {
+		t.Helper()
+		if ch := res.PreCommit(); ch != nil {
+			if err := <-ch; err != nil {
+				t.Fatal(err)
+			}
+		}
+		if ch := res.Commit(); ch != nil {
+			<-ch
+		}
+	}
+	receiver, sender := makeMailboxes(0), makeMailboxes(1)
+	mailboxIndex(receiver) // start listening
+
+	for _, value := range []int32{1, 2} {
+		if err := mailboxIndex(sender).WriteValue(tla.MakeTLANumber(value)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	commit(sender)
+
+	var values []tla.TLAValue
+	deadline := time.Now().Add(testTimeout)
+	for len(values) < 2 && time.Now().Before(deadline) {
+		value, err := mailboxIndex(receiver).ReadValue()
+		if errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, value)
+	}
+	commit(receiver)
+	if len(values) != 2 || !values[0].Equal(tla.MakeTLANumber(1)) || !values[1].Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected to receive 1 and 2, got %v", values)
+	}
+}
@@ -0,0 +1,236 @@
+package distsys
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ErrReplayDiverged is returned when an archetype replaying a Recording does something other than what was
+// recorded, e.g. because its code or configuration changed since the recording was made.
+var ErrReplayDiverged = errors.New("replayed execution diverged from the recording")
+
+// ErrReplayFinished is returned once an archetype replaying a Recording needs an input past the end of the
+// recording, meaning that the replay has reproduced the whole recorded run.
+var ErrReplayFinished = errors.New("replay reached the end of the recording")
+
+// RecordedEventKind identifies what a RecordedEvent records.
+type RecordedEventKind int
+
+const (
+	RecordedRead   RecordedEventKind = iota // a read from a resource, and its outcome
+	RecordedWrite                           // a write to a resource, and its outcome
+	RecordedBranch                          // the branch taken by an either statement
+	RecordedCommit                          // the outcome of committing a critical section
+)
+
+func (kind RecordedEventKind) String() string {
+	switch kind {
+	case RecordedRead:
+		return "read"
+	case RecordedWrite:
+		return "write"
+	case RecordedBranch:
+		return "branch"
+	case RecordedCommit:
+		return "commit"
+	default:
+		return fmt.Sprintf("RecordedEventKind(%d)", int(kind))
+	}
+}
+
+// RecordedEvent is one nondeterministic input of an archetype, as captured in a Recording.
+type RecordedEvent struct {
+	Kind    RecordedEventKind
+	Handle  ArchetypeResourceHandle // the resource read or written
+	Indices []tla.TLAValue          // the indices the resource was read or written through
+	Value   tla.TLAValue            // the value read or written
+	Branch  int                     // the branch taken, for RecordedBranch
+	ID      string                  // the either statement, for RecordedBranch
+	Aborted bool                    // whether the operation failed with ErrCriticalSectionAborted
+	Err     string                  // the message of any other error the operation failed with
+}
+
+func (event RecordedEvent) String() string {
+	switch event.Kind {
+	case RecordedRead, RecordedWrite:
+		return fmt.Sprintf("%v %s%v = %v (aborted = %t, err = %q)", event.Kind, event.Handle, event.Indices, event.Value, event.Aborted, event.Err)
+	case RecordedBranch:
+		return fmt.Sprintf("branch %s = %d", event.ID, event.Branch)
+	default:
+		return fmt.Sprintf("%v (aborted = %t, err = %q)", event.Kind, event.Aborted, event.Err)
+	}
+}
+
+// Recording holds every nondeterministic input of one archetype's run, in order: the values it read from its
+// resources, such as the messages it received and the timers it saw fire, the branches it took, and which critical
+// sections aborted. Replaying it, with WithReplay, makes the archetype go through exactly the same steps, which
+// reproduces a failure that depends on timing. The archetype's local state needs no recording, as it follows from
+// the rest. A recording may be saved with Encode, and loaded with DecodeRecording.
+type Recording struct {
+	lock   sync.Mutex
+	Events []RecordedEvent
+	pos    int // the next event to replay
+}
+
+// Encode writes the recording to w, in a format read by DecodeRecording.
+func (rec *Recording) Encode(w io.Writer) error {
+	rec.lock.Lock()
+	defer rec.lock.Unlock()
+	return gob.NewEncoder(w).Encode(rec.Events)
+}
+
+// DecodeRecording reads a recording written by Recording.Encode.
+func DecodeRecording(r io.Reader) (*Recording, error) {
+	rec := &Recording{}
+	err := gob.NewDecoder(r).Decode(&rec.Events)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode recording: %w", err)
+	}
+	return rec, nil
+}
+
+func (rec *Recording) append(event RecordedEvent) {
+	rec.lock.Lock()
+	defer rec.lock.Unlock()
+	rec.Events = append(rec.Events, event)
+}
+
+// next returns the next event to replay, checking that it is of the expected kind.
+func (rec *Recording) next(kind RecordedEventKind) (RecordedEvent, error) {
+	rec.lock.Lock()
+	defer rec.lock.Unlock()
+	if rec.pos >= len(rec.Events) {
+		return RecordedEvent{}, ErrReplayFinished
+	}
+	event := rec.Events[rec.pos]
+	if event.Kind != kind {
+		return RecordedEvent{}, fmt.Errorf("%w: expected a %v at event %d, but the recording has %v", ErrReplayDiverged, kind, rec.pos, event)
+	}
+	rec.pos++
+	return event, nil
+}
+
+// WithRecording appends every nondeterministic input of the archetype to rec, so that its run can later be
+// replayed with WithReplay.
+func WithRecording(rec *Recording) MPCalContextConfigFn {
+	return func(ctx *MPCalContext) {
+		ctx.recording = rec
+	}
+}
+
+// WithReplay makes the archetype replay rec, as made by WithRecording. Reads from resources other than local state
+// return the recorded values instead of reaching the resources, writes to them are checked against the recording
+// and dropped, and either statements and commits have their recorded outcomes. The resources must still be
+// configured, but are left untouched, so cheap stand-ins may be used. Once the recording is exhausted, Run returns
+// ErrReplayFinished.
+func WithReplay(rec *Recording) MPCalContextConfigFn {
+	return func(ctx *MPCalContext) {
+		ctx.replay = rec
+	}
+}
+
+func recordedOutcome(err error) (aborted bool, msg string) {
+	switch {
+	case err == nil:
+		return false, ""
+	case errors.Is(err, ErrCriticalSectionAborted):
+		return true, ""
+	default:
+		return false, err.Error()
+	}
+}
+
+func (event RecordedEvent) outcome() error {
+	switch {
+	case event.Aborted:
+		return ErrCriticalSectionAborted
+	case event.Err != "":
+		return errors.New(event.Err)
+	default:
+		return nil
+	}
+}
+
+// isExternal reports whether operations on the resource with the given handle are recorded and replayed, which is
+// the case for all resources but local state.
+func (ctx *MPCalContext) isExternal(handle ArchetypeResourceHandle) bool {
+	_, isLocal := ctx.getResourceByHandle(handle).(localValuer)
+	return !isLocal
+}
+
+func indicesEqual(a, b []tla.TLAValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (ctx *MPCalContext) recordAccess(kind RecordedEventKind, handle ArchetypeResourceHandle, indices []tla.TLAValue, value tla.TLAValue, err error) {
+	aborted, msg := recordedOutcome(err)
+	ctx.recording.append(RecordedEvent{
+		Kind:    kind,
+		Handle:  handle,
+		Indices: append([]tla.TLAValue(nil), indices...),
+		Value:   value,
+		Aborted: aborted,
+		Err:     msg,
+	})
+}
+
+// replayAccess replays a read or write. For a write, value is checked against the recording.
+func (ctx *MPCalContext) replayAccess(kind RecordedEventKind, handle ArchetypeResourceHandle, indices []tla.TLAValue, value tla.TLAValue) (tla.TLAValue, error) {
+	event, err := ctx.replay.next(kind)
+	if err != nil {
+		return tla.TLAValue{}, err
+	}
+	if event.Handle != handle || !indicesEqual(event.Indices, indices) {
+		return tla.TLAValue{}, fmt.Errorf("%w: %v %s%v, but the recording has %v", ErrReplayDiverged, kind, handle, indices, event)
+	}
+	if kind == RecordedWrite && !event.Value.Equal(value) {
+		return tla.TLAValue{}, fmt.Errorf("%w: wrote %v to %s%v, but the recording has %v", ErrReplayDiverged, value, handle, indices, event)
+	}
+	return event.Value, event.outcome()
+}
+
+func (ctx *MPCalContext) recordBranch(id string, branch int) {
+	ctx.recording.append(RecordedEvent{Kind: RecordedBranch, ID: id, Branch: branch})
+}
+
+// replayBranch replays the choice of a branch. As NextFairnessCounter cannot fail, an error is kept in
+// ctx.replayErr, for the critical section to fail with once its body returns.
+func (ctx *MPCalContext) replayBranch(id string, ceiling int) int {
+	event, err := ctx.replay.next(RecordedBranch)
+	if err == nil && (event.ID != id || event.Branch < 0 || event.Branch >= ceiling) {
+		err = fmt.Errorf("%w: either statement %s with %d branches, but the recording has %v", ErrReplayDiverged, id, ceiling, event)
+	}
+	if err != nil {
+		if ctx.replayErr == nil {
+			ctx.replayErr = err
+		}
+		return 0
+	}
+	return event.Branch
+}
+
+func (ctx *MPCalContext) recordCommit(err error) {
+	aborted, msg := recordedOutcome(err)
+	ctx.recording.append(RecordedEvent{Kind: RecordedCommit, Aborted: aborted, Err: msg})
+}
+
+func (ctx *MPCalContext) replayCommit() error {
+	event, err := ctx.replay.next(RecordedCommit)
+	if err != nil {
+		return err
+	}
+	return event.outcome()
+}
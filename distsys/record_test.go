@@ -0,0 +1,228 @@
+package distsys
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// recordTestSource is a resource yielding random numbers, whose reads randomly abort.
+type recordTestSource struct {
+	ArchetypeResourceLeafMixin
+	rng *rand.Rand
+}
+
+func (res *recordTestSource) Abort() chan struct{} { return nil }
+
+func (res *recordTestSource) PreCommit() chan error { return nil }
+
+func (res *recordTestSource) Commit() chan struct{} { return nil }
+
+func (res *recordTestSource) Close() error { return nil }
+
+func (res *recordTestSource) WriteValue(tla.TLAValue) error {
+	panic("attempted to write to a record test source")
+}
+
+func (res *recordTestSource) ReadValue() (tla.TLAValue, error) {
+	if res.rng == nil {
+		panic("attempted to read from a stand-in record test source")
+	}
+	if res.rng.Intn(3) == 0 {
+		return tla.TLAValue{}, ErrCriticalSectionAborted
+	}
+	return tla.MakeTLANumber(int32(res.rng.Intn(100))), nil
+}
+
+// recordTestSink is a resource collecting the values written to it by committed critical sections.
+type recordTestSink struct {
+	ArchetypeResourceLeafMixin
+	pending   []tla.TLAValue
+	committed []tla.TLAValue
+}
+
+func (res *recordTestSink) Abort() chan struct{} {
+	res.pending = nil
+	return nil
+}
+
+func (res *recordTestSink) PreCommit() chan error { return nil }
+
+func (res *recordTestSink) Commit() chan struct{} {
+	res.committed = append(res.committed, res.pending...)
+	res.pending = nil
+	return nil
+}
+
+func (res *recordTestSink) Close() error { return nil }
+
+func (res *recordTestSink) ReadValue() (tla.TLAValue, error) {
+	panic("attempted to read from a record test sink")
+}
+
+func (res *recordTestSink) WriteValue(value tla.TLAValue) error {
+	res.pending = append(res.pending, value)
+	return nil
+}
+
+// newRecordTestContext makes a context for an archetype which, 10 times, reads a number from src, and either adds it
+// to or subtracts it from its local sum, writing the new sum to out. The subtraction is of sign times the number.
+func newRecordTestContext(src, out ArchetypeResource, sign int32, configFns ...MPCalContextConfigFn) *MPCalContext {
+	archetype := MPCalArchetype{
+		Name:              "ASum",
+		Label:             "ASum.loop",
+		RequiredRefParams: []string{"ASum.src", "ASum.out"},
+		RequiredValParams: []string{},
+		JumpTable: MakeMPCalJumpTable(
+			MPCalCriticalSection{
+				Name: "ASum.loop",
+				Body: func(iface ArchetypeInterface) error {
+					i, sum := iface.RequireArchetypeResource("ASum.i"), iface.RequireArchetypeResource("ASum.sum")
+					src, err := iface.RequireArchetypeResourceRef("ASum.src")
+					if err != nil {
+						return err
+					}
+					out, err := iface.RequireArchetypeResourceRef("ASum.out")
+					if err != nil {
+						return err
+					}
+					iRead, err := iface.Read(i, nil)
+					if err != nil {
+						return err
+					}
+					if iRead.AsNumber() >= 10 {
+						return iface.Goto("ASum.Done")
+					}
+					if err := iface.Write(i, nil, tla.MakeTLANumber(iRead.AsNumber()+1)); err != nil {
+						return err
+					}
+					value, err := iface.Read(src, nil)
+					if err != nil {
+						return err
+					}
+					sumRead, err := iface.Read(sum, nil)
+					if err != nil {
+						return err
+					}
+					if iface.NextFairnessCounter("ASum.either", 2) == 0 {
+						sumRead = tla.MakeTLANumber(sumRead.AsNumber() + value.AsNumber())
+					} else {
+						sumRead = tla.MakeTLANumber(sumRead.AsNumber() - sign*value.AsNumber())
+					}
+					if err := iface.Write(sum, nil, sumRead); err != nil {
+						return err
+					}
+					if err := iface.Write(out, nil, sumRead); err != nil {
+						return err
+					}
+					return iface.Goto("ASum.loop")
+				},
+			},
+			MPCalCriticalSection{
+				Name: "ASum.Done",
+				Body: func(ArchetypeInterface) error {
+					return ErrDone
+				},
+			},
+		),
+		ProcTable: MakeMPCalProcTable(),
+		PreAmble: func(iface ArchetypeInterface) {
+			iface.EnsureArchetypeResourceLocal("ASum.i", tla.MakeTLANumber(0))
+			iface.EnsureArchetypeResourceLocal("ASum.sum", tla.MakeTLANumber(0))
+		},
+	}
+	configFns = append([]MPCalContextConfigFn{
+		EnsureArchetypeRefParam("src", ArchetypeResourceMakerFn(func() ArchetypeResource { return src })),
+		EnsureArchetypeRefParam("out", ArchetypeResourceMakerFn(func() ArchetypeResource { return out })),
+	}, configFns...)
+	return NewMPCalContext(tla.MakeTLANumber(1), archetype, configFns...)
+}
+
+func recordTestSum(t *testing.T, ctx *MPCalContext) tla.TLAValue {
+	t.Helper()
+	state, err := ctx.Inspect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state.Locals["ASum.sum"]
+}
+
+func TestRecordReplay(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	rec := &Recording{}
+	sink := &recordTestSink{}
+	recorded := newRecordTestContext(&recordTestSource{rng: rng}, sink, 1, WithRecording(rec),
+		WithBranchChooser(func(id string, ceiling int) int {
+			return rng.Intn(ceiling)
+		}))
+	defer recorded.Close()
+	if err := recorded.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if len(sink.committed) != 10 {
+		t.Fatalf("expected 10 sums to be written, got %v", sink.committed)
+	}
+	aborts := 0
+	for _, event := range rec.Events {
+		if event.Kind == RecordedRead && event.Aborted {
+			aborts++
+		}
+	}
+	if aborts == 0 {
+		t.Fatalf("expected some reads to abort, recorded %v", rec.Events)
+	}
+
+	// the replay goes through the same steps, from a saved recording, without touching the resources
+	var buf bytes.Buffer
+	if err := rec.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := DecodeRecording(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	standIn := &recordTestSink{}
+	replayed := newRecordTestContext(&recordTestSource{}, standIn, 1, WithReplay(loaded))
+	defer replayed.Close()
+	if err := replayed.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if expected, actual := recordTestSum(t, recorded), recordTestSum(t, replayed); !actual.Equal(expected) {
+		t.Fatalf("expected the replay to end with the sum %v, got %v", expected, actual)
+	}
+	if len(standIn.committed) != 0 {
+		t.Fatalf("expected the replay not to write to resources, but it wrote %v", standIn.committed)
+	}
+
+	// a replay past the end of the recording finishes
+	truncated := &Recording{Events: rec.Events[:len(rec.Events)/2]}
+	early := newRecordTestContext(&recordTestSource{}, &recordTestSink{}, 1, WithReplay(truncated))
+	defer early.Close()
+	if err := early.Run(); !errors.Is(err, ErrReplayFinished) {
+		t.Fatalf("expected replaying half the recording to finish early, got %v", err)
+	}
+}
+
+func TestReplayDiverged(t *testing.T) {
+	// record a run that always subtracts
+	rng := rand.New(rand.NewSource(1))
+	rec := &Recording{}
+	ctx := newRecordTestContext(&recordTestSource{rng: rng}, &recordTestSink{}, 1, WithRecording(rec),
+		WithBranchChooser(func(id string, ceiling int) int {
+			return 1
+		}))
+	defer ctx.Close()
+	if err := ctx.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	// an archetype that writes other values than were recorded has diverged
+	changed := newRecordTestContext(&recordTestSource{}, &recordTestSink{}, 2, WithReplay(&Recording{Events: rec.Events}))
+	defer changed.Close()
+	if err := changed.Run(); !errors.Is(err, ErrReplayDiverged) {
+		t.Fatalf("expected the changed archetype to diverge, got %v", err)
+	}
+}
@@ -0,0 +1,222 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// Broadcasting to Mailboxes
+// -------------------------
+
+const mailboxesBroadcastKind = "pgo.MailboxesBroadcast"
+
+func init() {
+	// indices are recorded along with the accesses made through them, so they must be decodable on replay
+	tla.RegisterTLACustomKind(mailboxesBroadcastKind, func(data []byte) (tla.TLACustomValue, error) {
+		var destinations tla.TLAValue
+		err := gob.NewDecoder(bytes.NewReader(data)).Decode(&destinations)
+		if err != nil {
+			return nil, err
+		}
+		return mailboxesBroadcastIndex{destinations: destinations}, nil
+	})
+}
+
+// MailboxesBroadcastIndex returns an index through which one write to a collection of mailboxes, as made by
+// TCPMailboxesMaker or LocalMailboxesMaker, writes the same value to every mailbox in destinations, which must be a
+// set or a sequence of mailbox indices. In MPCal terms, with BroadcastTo(dests) bound to this function,
+//
+//	network[BroadcastTo(dests)] := msg;
+//
+// behaves like writing msg to network[dest] for each dest, within the same critical section, except that a
+// destination that cannot be written to does not abort the critical section. Instead, as if its messages were lost in
+// transit, it sends none of the messages written to it by the critical section, and the others are sent as usual.
+// This suits quorum broadcasts, which only need some of the writes to succeed.
+//
+// Reading from the same index returns the outcome of the latest broadcast to it in the current critical section, as
+// a function from each destination to TRUE if the value was written, or FALSE if it was not. Reading before any such
+// write returns a function from each destination to FALSE.
+func MailboxesBroadcastIndex(destinations tla.TLAValue) tla.TLAValue {
+	return tla.MakeTLACustomValue(mailboxesBroadcastIndex{destinations: destinations})
+}
+
+type mailboxesBroadcastIndex struct {
+	destinations tla.TLAValue
+}
+
+var _ tla.TLACustomValue = mailboxesBroadcastIndex{}
+
+func (index mailboxesBroadcastIndex) TLAKind() string {
+	return mailboxesBroadcastKind
+}
+
+func (index mailboxesBroadcastIndex) Equal(other tla.TLACustomValue) bool {
+	return index.destinations.Equal(other.(mailboxesBroadcastIndex).destinations)
+}
+
+func (index mailboxesBroadcastIndex) Hash() uint32 {
+	return index.destinations.Hash()
+}
+
+func (index mailboxesBroadcastIndex) String() string {
+	return fmt.Sprintf("BroadcastTo(%v)", index.destinations)
+}
+
+func (index mailboxesBroadcastIndex) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(&index.destinations)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// mailboxesBroadcastOutcome records which destinations of a broadcast were written to.
+type mailboxesBroadcastOutcome struct {
+	index   mailboxesBroadcastIndex
+	written tla.TLAValue // a function from each destination to whether it was written to
+}
+
+// mailboxesBroadcastMaker wraps a maker of an IncrementalMap of mailboxes, so that the map also accepts indices made
+// by MailboxesBroadcastIndex.
+func mailboxesBroadcastMaker(maker distsys.ArchetypeResourceMaker) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &mailboxesBroadcastMap{IncrementalMap: maker.Make().(*IncrementalMap)}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			maker.Configure(res.(*mailboxesBroadcastMap).IncrementalMap)
+		},
+	}
+}
+
+// mailboxesBroadcastMap is an IncrementalMap of mailboxes, which also remembers the outcome of the broadcasts made
+// through it in the current critical section.
+type mailboxesBroadcastMap struct {
+	*IncrementalMap
+	outcomes []mailboxesBroadcastOutcome // few broadcasts are made per critical section, so a slice will do
+}
+
+var _ distsys.ArchetypeResource = &mailboxesBroadcastMap{}
+var _ distsys.Snapshotter = &mailboxesBroadcastMap{}
+var _ distsys.LoggingArchetypeResource = &mailboxesBroadcastMap{}
+var _ distsys.StatusArchetypeResource = &mailboxesBroadcastMap{}
+
+func (res *mailboxesBroadcastMap) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	if index.IsCustom() {
+		if broadcast, ok := index.AsCustom().(mailboxesBroadcastIndex); ok {
+			return &mailboxesBroadcast{mailboxes: res, index: broadcast}, nil
+		}
+	}
+	return res.IncrementalMap.Index(index)
+}
+
+// broadcast writes value to each mailbox in index's destinations, and records the outcome of each write. A
+// destination that could not be written to is aborted, dropping every value written to it in the current critical
+// section.
+func (res *mailboxesBroadcastMap) broadcast(index mailboxesBroadcastIndex, value tla.TLAValue, traceContext *distsys.TraceContext) {
+	var written []tla.TLARecordField
+	index.destinations.Elements()(func(destination tla.TLAValue) bool {
+		mailbox, err := res.IncrementalMap.Index(destination)
+		if err == nil {
+			if carrier, ok := mailbox.(distsys.TraceContextCarrier); ok && traceContext != nil {
+				carrier.SetTraceContext(*traceContext)
+			}
+			err = mailbox.WriteValue(value)
+			if err != nil {
+				// reset the mailbox, so it does not try to commit a partial write
+				if ch := mailbox.Abort(); ch != nil {
+					<-ch
+				}
+			}
+		}
+		if err != nil {
+			res.log(distsys.LogWarn, "broadcast could not write to mailbox", "destination", destination, "error", err)
+		}
+		written = append(written, tla.TLARecordField{Key: destination, Value: tla.MakeTLABool(err == nil)})
+		return true
+	})
+	outcome := mailboxesBroadcastOutcome{index: index, written: tla.MakeTLARecord(written)}
+	for i := range res.outcomes {
+		if res.outcomes[i].index.Equal(index) {
+			res.outcomes[i] = outcome
+			return
+		}
+	}
+	res.outcomes = append(res.outcomes, outcome)
+}
+
+func (res *mailboxesBroadcastMap) log(level distsys.LogLevel, msg string, keyvals ...interface{}) {
+	if res.logger != nil {
+		res.logger.Log(level, msg, keyvals...)
+	}
+}
+
+func (res *mailboxesBroadcastMap) Abort() chan struct{} {
+	res.outcomes = nil
+	return res.IncrementalMap.Abort()
+}
+
+func (res *mailboxesBroadcastMap) Commit() chan struct{} {
+	res.outcomes = nil
+	return res.IncrementalMap.Commit()
+}
+
+// mailboxesBroadcast is the resource at a broadcast index of a mailboxesBroadcastMap.
+type mailboxesBroadcast struct {
+	distsys.ArchetypeResourceLeafMixin
+	mailboxes *mailboxesBroadcastMap
+	index     mailboxesBroadcastIndex
+
+	traceContext *distsys.TraceContext // the context of the writing critical section's span, if it is traced
+}
+
+var _ distsys.ArchetypeResource = &mailboxesBroadcast{}
+var _ distsys.TraceContextCarrier = &mailboxesBroadcast{}
+
+func (res *mailboxesBroadcast) WriteValue(value tla.TLAValue) error {
+	res.mailboxes.broadcast(res.index, value, res.traceContext)
+	return nil
+}
+
+func (res *mailboxesBroadcast) ReadValue() (tla.TLAValue, error) {
+	for _, outcome := range res.mailboxes.outcomes {
+		if outcome.index.Equal(res.index) {
+			return outcome.written, nil
+		}
+	}
+	var written []tla.TLARecordField
+	res.index.destinations.Elements()(func(destination tla.TLAValue) bool {
+		written = append(written, tla.TLARecordField{Key: destination, Value: tla.TLA_FALSE})
+		return true
+	})
+	return tla.MakeTLARecord(written), nil
+}
+
+func (res *mailboxesBroadcast) SetTraceContext(tc distsys.TraceContext) {
+	res.traceContext = &tc
+}
+
+func (res *mailboxesBroadcast) ReceivedTraceContext() (distsys.TraceContext, bool) {
+	return distsys.TraceContext{}, false
+}
+
+func (res *mailboxesBroadcast) Abort() chan struct{} {
+	return nil
+}
+
+func (res *mailboxesBroadcast) PreCommit() chan error {
+	return nil
+}
+
+func (res *mailboxesBroadcast) Commit() chan struct{} {
+	return nil
+}
+
+func (res *mailboxesBroadcast) Close() error {
+	return nil
+}
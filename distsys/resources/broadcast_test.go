@@ -0,0 +1,93 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func broadcastTestOutcome(written ...bool) tla.TLAValue {
+	var fields []tla.TLARecordField
+	for i, ok := range written {
+		fields = append(fields, tla.TLARecordField{Key: tla.MakeTLANumber(int32(i)), Value: tla.MakeTLABool(ok)})
+	}
+	return tla.MakeTLARecord(fields)
+}
+
+func expectBroadcastTestOutcome(t *testing.T, res distsys.ArchetypeResource, index tla.TLAValue, expected tla.TLAValue) {
+	t.Helper()
+	broadcast, err := res.Index(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outcome, err := broadcast.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !outcome.Equal(expected) {
+		t.Fatalf("expected the broadcast outcome %v, got %v", expected, outcome)
+	}
+}
+
+func TestTCPMailboxesBroadcast(t *testing.T) {
+	// mailbox 2 is never listened on, so writing to it fails
+	addrs := []string{freeLocalAddr(t), freeLocalAddr(t), freeLocalAddr(t)}
+	receivers := []distsys.ArchetypeResource{makeTCPMailboxesTest(t, 0, addrs), makeTCPMailboxesTest(t, 1, addrs)}
+	sender := makeTCPMailboxesTest(t, -1, addrs)
+
+	index := MailboxesBroadcastIndex(tla.MakeTLASet(tla.MakeTLANumber(0), tla.MakeTLANumber(1), tla.MakeTLANumber(2)))
+	expectBroadcastTestOutcome(t, sender, index, broadcastTestOutcome(false, false, false))
+	// values written to a destination before broadcasting to it are sent along with the broadcast value
+	if err := mailboxesTestIndex(t, sender, 1).WriteValue(tla.MakeTLANumber(1)); err != nil {
+		t.Fatal(err)
+	}
+	broadcast, err := sender.Index(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := broadcast.WriteValue(tla.MakeTLANumber(42)); err != nil {
+		t.Fatalf("expected a broadcast with a failing destination to succeed, got %v", err)
+	}
+	expectBroadcastTestOutcome(t, sender, index, broadcastTestOutcome(true, true, false))
+	mailboxesTestCommit(t, sender)
+	expectBroadcastTestOutcome(t, sender, index, broadcastTestOutcome(false, false, false))
+
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receivers[0], 0, 1), 42)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receivers[1], 1, 2), 1, 42)
+	for i, receiver := range receivers {
+		mailboxesTestCommit(t, receiver)
+		expectMailboxesTestEmpty(t, receiver, int32(i))
+	}
+}
+
+func TestLocalMailboxesBroadcast(t *testing.T) {
+	maker := LocalMailboxesMaker(NewLocalMailboxes())
+	res := maker.Make()
+	maker.Configure(res)
+
+	// destinations may also be given as a sequence
+	index := MailboxesBroadcastIndex(tla.MakeTLATuple(tla.MakeTLANumber(0), tla.MakeTLANumber(1)))
+	broadcast, err := res.Index(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := broadcast.WriteValue(tla.MakeTLANumber(7)); err != nil {
+		t.Fatal(err)
+	}
+	expectBroadcastTestOutcome(t, res, index, broadcastTestOutcome(true, true))
+	mailboxesTestCommit(t, res)
+
+	for _, i := range []int32{0, 1} {
+		expectMailboxesTestValues(t, mailboxesTestReceive(t, res, i, 1), 7)
+	}
+	mailboxesTestCommit(t, res)
+
+	// an aborted broadcast sends nothing
+	if err := broadcast.WriteValue(tla.MakeTLANumber(8)); err != nil {
+		t.Fatal(err)
+	}
+	mailboxesTestAbort(res)
+	expectMailboxesTestEmpty(t, res, 0)
+	expectMailboxesTestEmpty(t, res, 1)
+}
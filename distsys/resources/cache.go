@@ -0,0 +1,251 @@
+package resources
+
+import (
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+// ResourceCache holds values cached by CachedMapMaker. One cache may be shared by all the archetypes of a
+// process, so that they benefit from each other's reads and writes.
+type ResourceCache struct {
+	ttl time.Duration
+
+	lock sync.Mutex
+	// generation counts invalidations, so that values read before an invalidation are not cached after it
+	generation     uint64
+	allInvalidated uint64         // the generation of the last InvalidateAll
+	entries        *immutable.Map // index -> resourceCacheEntry
+}
+
+type resourceCacheEntry struct {
+	value   tla.TLAValue
+	expires time.Time // zero if the entry never expires
+	// invalidated is non-zero if the entry holds no value, but records the generation at which its index was
+	// invalidated
+	invalidated uint64
+}
+
+// NewResourceCache creates an empty cache, whose entries expire ttl after they were cached. A ttl of zero or less
+// means entries never expire, and only leave the cache when invalidated.
+func NewResourceCache(ttl time.Duration) *ResourceCache {
+	return &ResourceCache{
+		ttl:     ttl,
+		entries: immutable.NewMap(tla.TLAValueHasher{}),
+	}
+}
+
+// Invalidate removes the value at index from the cache, e.g. because it is known to have changed elsewhere. Values at
+// index read or written by critical sections which have not committed yet are not cached when they commit.
+func (cache *ResourceCache) Invalidate(index tla.TLAValue) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.generation++
+	cache.entries = cache.entries.Set(index, resourceCacheEntry{invalidated: cache.generation})
+}
+
+// InvalidateAll empties the cache.
+func (cache *ResourceCache) InvalidateAll() {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.generation++
+	cache.allInvalidated = cache.generation
+	cache.entries = immutable.NewMap(tla.TLAValueHasher{})
+}
+
+// currentGeneration returns the generation to pass to put for a value about to be read or written.
+func (cache *ResourceCache) currentGeneration() uint64 {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	return cache.generation
+}
+
+func (cache *ResourceCache) get(index tla.TLAValue) (tla.TLAValue, bool) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	e, ok := cache.entries.Get(index)
+	if !ok {
+		return tla.TLAValue{}, false
+	}
+	entry := e.(resourceCacheEntry)
+	if entry.invalidated != 0 {
+		return tla.TLAValue{}, false
+	}
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		cache.entries = cache.entries.Delete(index)
+		return tla.TLAValue{}, false
+	}
+	return entry.value, true
+}
+
+// put caches value at index, unless index was invalidated after generation, when value was read or written, since
+// value may then be stale.
+func (cache *ResourceCache) put(index tla.TLAValue, value tla.TLAValue, generation uint64) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if cache.allInvalidated > generation {
+		return
+	}
+	if e, ok := cache.entries.Get(index); ok && e.(resourceCacheEntry).invalidated > generation {
+		return
+	}
+	entry := resourceCacheEntry{value: value}
+	if cache.ttl > 0 {
+		entry.expires = time.Now().Add(cache.ttl)
+	}
+	cache.entries = cache.entries.Set(index, entry)
+}
+
+// CachedMapMaker layers cache over the map-like resource made by underlying, such as one made by SQLMapMaker,
+// ObjectStoreMaker or RedisMaker. Reads of cached values are served locally, without reaching the underlying
+// resource; other reads go to the underlying resource, and their values are cached once the critical section
+// commits. Writes go through to the underlying resource, keeping its commit semantics, and their values are
+// cached once the critical section commits.
+//
+// Reads served by the cache are not seen by the underlying resource, so they do not take part in its conflict
+// detection, and may be stale if other processes write the same values: the cache's TTL bounds how stale they can
+// be, and ResourceCache.Invalidate lets applications that learn of changes drop stale values earlier.
+func CachedMapMaker(cache *ResourceCache, underlying distsys.ArchetypeResourceMaker) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &cachedMap{
+				underlying: underlying.Make(),
+				elements:   immutable.NewMap(tla.TLAValueHasher{}),
+			}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*cachedMap)
+			r.cache = cache
+			underlying.Configure(r.underlying)
+		},
+	}
+}
+
+type cachedMap struct {
+	distsys.ArchetypeResourceMapMixin
+	cache      *ResourceCache
+	underlying distsys.ArchetypeResource
+	// elements holds a *cachedElement for each index touched by the current critical section
+	elements *immutable.Map
+}
+
+var _ distsys.ArchetypeResource = &cachedMap{}
+
+func (res *cachedMap) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	if elem, ok := res.elements.Get(index); ok {
+		return elem.(*cachedElement), nil
+	}
+	elem := &cachedElement{parent: res, index: index}
+	res.elements = res.elements.Set(index, elem)
+	return elem, nil
+}
+
+func (res *cachedMap) Abort() chan struct{} {
+	res.elements = immutable.NewMap(tla.TLAValueHasher{})
+	return res.underlying.Abort()
+}
+
+func (res *cachedMap) PreCommit() chan error {
+	return res.underlying.PreCommit()
+}
+
+func (res *cachedMap) Commit() chan struct{} {
+	elements := res.elements
+	res.elements = immutable.NewMap(tla.TLAValueHasher{})
+	fill := func() {
+		it := elements.Iterator()
+		for !it.Done() {
+			_, elem := it.Next()
+			if e := elem.(*cachedElement); e.fromUnderlying {
+				res.cache.put(e.index, e.value, e.generation)
+			}
+		}
+	}
+
+	ch := res.underlying.Commit()
+	if ch == nil {
+		fill()
+		return nil
+	}
+	doneCh := make(chan struct{}, 1)
+	go func() {
+		<-ch
+		fill()
+		doneCh <- struct{}{}
+	}()
+	return doneCh
+}
+
+func (res *cachedMap) Close() error {
+	return res.underlying.Close()
+}
+
+// cachedElement is the value at one index of a cachedMap.
+type cachedElement struct {
+	distsys.ArchetypeResourceLeafMixin
+	parent *cachedMap
+	index  tla.TLAValue
+
+	known bool
+	value tla.TLAValue
+	// fromUnderlying is set if value was read from, or written to, the underlying resource, and should be cached
+	fromUnderlying bool
+	// generation is the cache's generation before value was read from, or written to, the underlying resource
+	generation uint64
+}
+
+var _ distsys.ArchetypeResource = &cachedElement{}
+
+func (res *cachedElement) Abort() chan struct{} {
+	return nil
+}
+
+func (res *cachedElement) PreCommit() chan error {
+	return nil
+}
+
+func (res *cachedElement) Commit() chan struct{} {
+	return nil
+}
+
+func (res *cachedElement) ReadValue() (tla.TLAValue, error) {
+	if res.known {
+		return res.value, nil
+	}
+	if value, ok := res.parent.cache.get(res.index); ok {
+		res.known, res.value = true, value
+		return value, nil
+	}
+	generation := res.parent.cache.currentGeneration()
+	sub, err := res.parent.underlying.Index(res.index)
+	if err != nil {
+		return tla.TLAValue{}, err
+	}
+	value, err := sub.ReadValue()
+	if err != nil {
+		return tla.TLAValue{}, err
+	}
+	res.known, res.value, res.fromUnderlying, res.generation = true, value, true, generation
+	return value, nil
+}
+
+func (res *cachedElement) WriteValue(value tla.TLAValue) error {
+	generation := res.parent.cache.currentGeneration()
+	sub, err := res.parent.underlying.Index(res.index)
+	if err != nil {
+		return err
+	}
+	err = sub.WriteValue(value)
+	if err != nil {
+		return err
+	}
+	res.known, res.value, res.fromUnderlying, res.generation = true, value, true, generation
+	return nil
+}
+
+func (res *cachedElement) Close() error {
+	return nil
+}
@@ -0,0 +1,298 @@
+package resources
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// testStore is a store of values shared by the resources made from it, standing in for a remote store. Values are
+// keyed by the string form of their index, and are 0 until written.
+type testStore struct {
+	lock   sync.Mutex
+	values map[string]tla.TLAValue
+	reads  int
+	// failPreCommit makes every critical section accessing the store abort at pre-commit
+	failPreCommit bool
+}
+
+func newTestStore() *testStore {
+	return &testStore{values: make(map[string]tla.TLAValue)}
+}
+
+func (store *testStore) get(key string) tla.TLAValue {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	if value, ok := store.values[key]; ok {
+		return value
+	}
+	return tla.MakeTLANumber(0)
+}
+
+func (store *testStore) set(key string, value tla.TLAValue) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.values[key] = value
+}
+
+func (store *testStore) readCount() int {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	return store.reads
+}
+
+func (store *testStore) setFailPreCommit(fail bool) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.failPreCommit = fail
+}
+
+// testStoreValueMaker makes a resource for the value at key in store, buffering writes until commit.
+func testStoreValueMaker(store *testStore, key string) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		return &testStoreValue{store: store, key: key}
+	})
+}
+
+// testStoreMapMaker makes a map resource over all the values in store.
+func testStoreMapMaker(store *testStore) distsys.ArchetypeResourceMaker {
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return testStoreValueMaker(store, index.String())
+	})
+}
+
+type testStoreValue struct {
+	distsys.ArchetypeResourceLeafMixin
+	store   *testStore
+	key     string
+	written bool
+	value   tla.TLAValue
+}
+
+func (res *testStoreValue) ReadValue() (tla.TLAValue, error) {
+	if res.written {
+		return res.value, nil
+	}
+	res.store.lock.Lock()
+	res.store.reads++
+	res.store.lock.Unlock()
+	return res.store.get(res.key), nil
+}
+
+func (res *testStoreValue) WriteValue(value tla.TLAValue) error {
+	res.written, res.value = true, value
+	return nil
+}
+
+func (res *testStoreValue) Abort() chan struct{} {
+	res.written, res.value = false, tla.TLAValue{}
+	return nil
+}
+
+func (res *testStoreValue) PreCommit() chan error {
+	res.store.lock.Lock()
+	defer res.store.lock.Unlock()
+	if res.store.failPreCommit {
+		ch := make(chan error, 1)
+		ch <- distsys.ErrCriticalSectionAborted
+		return ch
+	}
+	return nil
+}
+
+func (res *testStoreValue) Commit() chan struct{} {
+	if res.written {
+		res.store.set(res.key, res.value)
+	}
+	res.written, res.value = false, tla.TLAValue{}
+	return nil
+}
+
+func (res *testStoreValue) Close() error {
+	return nil
+}
+
+func makeCachedMapTest(cache *ResourceCache, store *testStore) distsys.ArchetypeResource {
+	maker := CachedMapMaker(cache, testStoreMapMaker(store))
+	res := maker.Make()
+	maker.Configure(res)
+	return res
+}
+
+func cachedMapTestRead(t *testing.T, res distsys.ArchetypeResource, key string) tla.TLAValue {
+	t.Helper()
+	elem, err := res.Index(tla.MakeTLAString(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := elem.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+func cachedMapTestWrite(t *testing.T, res distsys.ArchetypeResource, key string, value tla.TLAValue) {
+	t.Helper()
+	elem, err := res.Index(tla.MakeTLAString(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := elem.WriteValue(value); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCachedMapHitAndMiss(t *testing.T) {
+	store := newTestStore()
+	store.set(`"x"`, tla.MakeTLANumber(1))
+	res := makeCachedMapTest(NewResourceCache(0), store)
+
+	// a miss reads the underlying store, and the value is cached once the critical section commits
+	if value := cachedMapTestRead(t, res, "x"); !value.Equal(tla.MakeTLANumber(1)) {
+		t.Fatalf("expected to read 1, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+	if reads := store.readCount(); reads != 1 {
+		t.Fatalf("expected a miss to read the store once, read it %d times", reads)
+	}
+
+	// a hit does not reach the store, even if the store changed since
+	store.set(`"x"`, tla.MakeTLANumber(2))
+	if value := cachedMapTestRead(t, res, "x"); !value.Equal(tla.MakeTLANumber(1)) {
+		t.Fatalf("expected to read the cached 1, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+	if reads := store.readCount(); reads != 1 {
+		t.Fatalf("expected a hit not to read the store, read it %d times", reads)
+	}
+
+	// writes go through to the store, and are cached once committed
+	cachedMapTestWrite(t, res, "y", tla.MakeTLANumber(3))
+	raftCounterTestCommit(t, res)
+	if value := store.get(`"y"`); !value.Equal(tla.MakeTLANumber(3)) {
+		t.Fatalf("expected the write to reach the store, found %v", value)
+	}
+	if value := cachedMapTestRead(t, res, "y"); !value.Equal(tla.MakeTLANumber(3)) {
+		t.Fatalf("expected to read the cached 3, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+	if reads := store.readCount(); reads != 1 {
+		t.Fatalf("expected a written value to be cached, read the store %d times", reads)
+	}
+}
+
+func TestCachedMapTTL(t *testing.T) {
+	store := newTestStore()
+	store.set(`"x"`, tla.MakeTLANumber(1))
+	res := makeCachedMapTest(NewResourceCache(50*time.Millisecond), store)
+
+	cachedMapTestRead(t, res, "x")
+	raftCounterTestCommit(t, res)
+	store.set(`"x"`, tla.MakeTLANumber(2))
+	if value := cachedMapTestRead(t, res, "x"); !value.Equal(tla.MakeTLANumber(1)) {
+		t.Fatalf("expected to read the cached 1 before it expires, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+
+	time.Sleep(100 * time.Millisecond)
+	if value := cachedMapTestRead(t, res, "x"); !value.Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected the cached value to expire, and read 2, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+	if reads := store.readCount(); reads != 2 {
+		t.Fatalf("expected to read the store again once the entry expired, read it %d times", reads)
+	}
+}
+
+func TestCachedMapAbortNotCached(t *testing.T) {
+	store := newTestStore()
+	store.set(`"x"`, tla.MakeTLANumber(1))
+	res := makeCachedMapTest(NewResourceCache(0), store)
+
+	// neither values read nor values written by an aborted critical section are cached
+	cachedMapTestRead(t, res, "x")
+	cachedMapTestWrite(t, res, "y", tla.MakeTLANumber(3))
+	if ch := res.Abort(); ch != nil {
+		<-ch
+	}
+	store.set(`"x"`, tla.MakeTLANumber(2))
+	if value := cachedMapTestRead(t, res, "x"); !value.Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected the read of the aborted critical section not to be cached, read %v", value)
+	}
+	if value := cachedMapTestRead(t, res, "y"); !value.Equal(tla.MakeTLANumber(0)) {
+		t.Fatalf("expected the write of the aborted critical section not to be cached, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+}
+
+func TestCachedMapInvalidate(t *testing.T) {
+	store := newTestStore()
+	store.set(`"x"`, tla.MakeTLANumber(1))
+	store.set(`"y"`, tla.MakeTLANumber(1))
+	cache := NewResourceCache(0)
+	res := makeCachedMapTest(cache, store)
+
+	cachedMapTestRead(t, res, "x")
+	cachedMapTestRead(t, res, "y")
+	raftCounterTestCommit(t, res)
+	store.set(`"x"`, tla.MakeTLANumber(2))
+	store.set(`"y"`, tla.MakeTLANumber(2))
+
+	cache.Invalidate(tla.MakeTLAString("x"))
+	if value := cachedMapTestRead(t, res, "x"); !value.Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected the invalidated value to be read anew, read %v", value)
+	}
+	if value := cachedMapTestRead(t, res, "y"); !value.Equal(tla.MakeTLANumber(1)) {
+		t.Fatalf("expected other values to stay cached, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+
+	cache.InvalidateAll()
+	if value := cachedMapTestRead(t, res, "y"); !value.Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected every value to be read anew, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+}
+
+func TestCachedMapInvalidateBeforeCommit(t *testing.T) {
+	store := newTestStore()
+	store.set(`"x"`, tla.MakeTLANumber(1))
+	store.set(`"y"`, tla.MakeTLANumber(1))
+	cache := NewResourceCache(0)
+	res := makeCachedMapTest(cache, store)
+
+	// values read before an invalidation are stale, and are not cached when their critical section commits
+	cachedMapTestRead(t, res, "x")
+	cachedMapTestRead(t, res, "y")
+	store.set(`"x"`, tla.MakeTLANumber(2))
+	cache.Invalidate(tla.MakeTLAString("x"))
+	raftCounterTestCommit(t, res)
+	if value := cachedMapTestRead(t, res, "x"); !value.Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected the value invalidated before commit not to be cached, read %v", value)
+	}
+	if value := cachedMapTestRead(t, res, "y"); !value.Equal(tla.MakeTLANumber(1)) {
+		t.Fatalf("expected the value not invalidated to be cached, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+
+	// the value read after the invalidation is cached as usual
+	store.set(`"x"`, tla.MakeTLANumber(3))
+	if value := cachedMapTestRead(t, res, "x"); !value.Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected the value read after the invalidation to be cached, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+
+	cache.Invalidate(tla.MakeTLAString("y"))
+	cachedMapTestRead(t, res, "y")
+	cache.InvalidateAll()
+	raftCounterTestCommit(t, res)
+	store.set(`"y"`, tla.MakeTLANumber(4))
+	if value := cachedMapTestRead(t, res, "y"); !value.Equal(tla.MakeTLANumber(4)) {
+		t.Fatalf("expected no value read before InvalidateAll to be cached, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+}
@@ -1,6 +1,10 @@
 package resources
 
 import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
 	"fmt"
 	"time"
 
@@ -17,11 +21,25 @@ type InputChannel struct {
 	distsys.ArchetypeResourceLeafMixin
 	channel               <-chan tla.TLAValue
 	buffer, backlogBuffer []tla.TLAValue
+	readTimeout           time.Duration
 }
 
 var _ distsys.ArchetypeResource = &InputChannel{}
+var _ distsys.Snapshotter = &InputChannel{}
 
-func InputChannelMaker(channel <-chan tla.TLAValue) distsys.ArchetypeResourceMaker {
+// InputChannelOption configures an InputChannel.
+type InputChannelOption func(res *InputChannel)
+
+// WithInputChannelReadTimeout sets how long a read waits for a value to arrive on the channel before aborting the
+// critical section. A timeout of zero or less makes reads non-blocking: they abort right away if no value is
+// available, which lets archetypes poll for work. By default, reads wait for a short time.
+func WithInputChannelReadTimeout(timeout time.Duration) InputChannelOption {
+	return func(res *InputChannel) {
+		res.readTimeout = timeout
+	}
+}
+
+func InputChannelMaker(channel <-chan tla.TLAValue, opts ...InputChannelOption) distsys.ArchetypeResourceMaker {
 	return distsys.ArchetypeResourceMakerStruct{
 		MakeFn: func() distsys.ArchetypeResource {
 			return &InputChannel{}
@@ -29,6 +47,10 @@ func InputChannelMaker(channel <-chan tla.TLAValue) distsys.ArchetypeResourceMak
 		ConfigureFn: func(res distsys.ArchetypeResource) {
 			r := res.(*InputChannel)
 			r.channel = channel
+			r.readTimeout = inputChannelReadTimout
+			for _, opt := range opts {
+				opt(r)
+			}
 		},
 	}
 }
@@ -55,11 +77,20 @@ func (res *InputChannel) ReadValue() (tla.TLAValue, error) {
 		return value, nil
 	}
 
+	if res.readTimeout <= 0 {
+		select {
+		case value := <-res.channel:
+			res.backlogBuffer = append(res.backlogBuffer, value)
+			return value, nil
+		default:
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+	}
 	select {
 	case value := <-res.channel:
 		res.backlogBuffer = append(res.backlogBuffer, value)
 		return value, nil
-	case <-time.After(inputChannelReadTimout):
+	case <-time.After(res.readTimeout):
 		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
 	}
 }
@@ -72,6 +103,27 @@ func (res *InputChannel) Close() error {
 	return nil
 }
 
+// Snapshot captures the values taken from the channel, but given back by aborted critical sections. Values still
+// in the channel are not captured.
+func (res *InputChannel) Snapshot() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(res.buffer)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (res *InputChannel) Restore(data []byte) error {
+	var buffer []tla.TLAValue
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&buffer)
+	if err != nil {
+		return err
+	}
+	res.buffer = buffer
+	return nil
+}
+
 // OutputChannel wraps a native Go channel, such that an MPCal model may write to that channel.
 type OutputChannel struct {
 	distsys.ArchetypeResourceLeafMixin
@@ -126,3 +178,130 @@ func (res *OutputChannel) WriteValue(value tla.TLAValue) error {
 func (res *OutputChannel) Close() error {
 	return nil
 }
+
+// ErrNoRequestToRespondTo is returned when an MPCal model writes more responses to a request/response channel
+// resource than it has read requests.
+var ErrNoRequestToRespondTo = errors.New("no outstanding request to respond to")
+
+// RequestResponseChannel lets Go code outside an MPCal model submit requests to the model, and await their
+// responses, as with an RPC. See RequestResponseChannelMaker.
+type RequestResponseChannel struct {
+	requests chan *requestResponseCall
+}
+
+type requestResponseCall struct {
+	request tla.TLAValue
+	reply   chan tla.TLAValue
+}
+
+// NewRequestResponseChannel creates a RequestResponseChannel, which buffers up to capacity requests that the model
+// has not read yet.
+func NewRequestResponseChannel(capacity int) *RequestResponseChannel {
+	return &RequestResponseChannel{
+		requests: make(chan *requestResponseCall, capacity),
+	}
+}
+
+// Call submits request to the model, and waits for the model's response to it. If ctx is done first, Call gives
+// up and returns ctx.Err(); the model may still read the request and respond to it, but the response is discarded.
+func (ch *RequestResponseChannel) Call(ctx context.Context, request tla.TLAValue) (tla.TLAValue, error) {
+	call := &requestResponseCall{
+		request: request,
+		reply:   make(chan tla.TLAValue, 1),
+	}
+	select {
+	case ch.requests <- call:
+	case <-ctx.Done():
+		return tla.TLAValue{}, ctx.Err()
+	}
+	select {
+	case response := <-call.reply:
+		return response, nil
+	case <-ctx.Done():
+		return tla.TLAValue{}, ctx.Err()
+	}
+}
+
+// RequestResponseChannelResource exposes a RequestResponseChannel to an MPCal model. Reading it yields the next
+// request, aborting the critical section if none arrives in time, like InputChannel. Writing it responds to the
+// earliest request read, including in previous critical sections, that has not been responded to yet. The model
+// therefore never sees correlation IDs: it only needs to respond to requests in the order it read them. Responses
+// are delivered when the critical section commits.
+type RequestResponseChannelResource struct {
+	distsys.ArchetypeResourceLeafMixin
+	channel *RequestResponseChannel
+	// buffer holds calls read then given back by an aborted critical section; backlogBuffer holds calls read by
+	// the current critical section
+	buffer, backlogBuffer []*requestResponseCall
+	// outstanding holds calls read by committed critical sections, that have not been responded to yet
+	outstanding []*requestResponseCall
+	// responses holds the values written by the current critical section
+	responses []tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &RequestResponseChannelResource{}
+
+func RequestResponseChannelMaker(channel *RequestResponseChannel) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &RequestResponseChannelResource{}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*RequestResponseChannelResource)
+			r.channel = channel
+		},
+	}
+}
+
+func (res *RequestResponseChannelResource) Abort() chan struct{} {
+	res.buffer = append(res.backlogBuffer, res.buffer...)
+	res.backlogBuffer = nil
+	res.responses = nil
+	return nil
+}
+
+func (res *RequestResponseChannelResource) PreCommit() chan error {
+	return nil
+}
+
+func (res *RequestResponseChannelResource) Commit() chan struct{} {
+	res.outstanding = append(res.outstanding, res.backlogBuffer...)
+	res.backlogBuffer = nil
+	for i, response := range res.responses {
+		// never blocks, as each reply channel has room for its one response
+		res.outstanding[i].reply <- response
+		res.outstanding[i] = nil
+	}
+	res.outstanding = res.outstanding[len(res.responses):]
+	res.responses = nil
+	return nil
+}
+
+func (res *RequestResponseChannelResource) ReadValue() (tla.TLAValue, error) {
+	if len(res.buffer) > 0 {
+		call := res.buffer[0]
+		res.buffer = res.buffer[1:]
+		res.backlogBuffer = append(res.backlogBuffer, call)
+		return call.request, nil
+	}
+
+	select {
+	case call := <-res.channel.requests:
+		res.backlogBuffer = append(res.backlogBuffer, call)
+		return call.request, nil
+	case <-time.After(inputChannelReadTimout):
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	}
+}
+
+func (res *RequestResponseChannelResource) WriteValue(value tla.TLAValue) error {
+	if len(res.responses) >= len(res.outstanding)+len(res.backlogBuffer) {
+		return ErrNoRequestToRespondTo
+	}
+	res.responses = append(res.responses, value)
+	return nil
+}
+
+func (res *RequestResponseChannelResource) Close() error {
+	return nil
+}
@@ -0,0 +1,157 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// requestResponseTestCall calls channel in the background, and returns a channel the response will be sent to.
+func requestResponseTestCall(channel *RequestResponseChannel, request tla.TLAValue) chan tla.TLAValue {
+	ch := make(chan tla.TLAValue, 1)
+	go func() {
+		response, err := channel.Call(context.Background(), request)
+		if err != nil {
+			panic(err)
+		}
+		ch <- response
+	}()
+	return ch
+}
+
+func requestResponseTestRead(t *testing.T, res distsys.ArchetypeResource, expected tla.TLAValue) {
+	t.Helper()
+	var request tla.TLAValue
+	awaitCondition(t, "a request to arrive", func() bool {
+		var err error
+		request, err = res.ReadValue()
+		if err != nil && !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatal(err)
+		}
+		return err == nil
+	})
+	if !request.Equal(expected) {
+		t.Fatalf("expected to read the request %v, read %v", expected, request)
+	}
+}
+
+func TestRequestResponseChannel(t *testing.T) {
+	channel := NewRequestResponseChannel(2)
+	maker := RequestResponseChannelMaker(channel)
+	res := maker.Make()
+	maker.Configure(res)
+
+	first := requestResponseTestCall(channel, tla.MakeTLAString("first"))
+	requestResponseTestRead(t, res, tla.MakeTLAString("first"))
+	second := requestResponseTestCall(channel, tla.MakeTLAString("second"))
+	requestResponseTestRead(t, res, tla.MakeTLAString("second"))
+
+	// an aborted critical section responds to nothing, and gives its requests back
+	if err := res.WriteValue(tla.MakeTLAString("aborted")); err != nil {
+		t.Fatal(err)
+	}
+	res.Abort()
+	requestResponseTestRead(t, res, tla.MakeTLAString("first"))
+	requestResponseTestRead(t, res, tla.MakeTLAString("second"))
+
+	// responses go to requests in the order they were read, and only once the critical section commits
+	if err := res.WriteValue(tla.MakeTLAString("to first")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case response := <-first:
+		t.Fatalf("expected no response before commit, got %v", response)
+	case <-time.After(20 * time.Millisecond):
+	}
+	res.Commit()
+	if response := <-first; !response.Equal(tla.MakeTLAString("to first")) {
+		t.Fatalf("expected the first request to get the first response, got %v", response)
+	}
+
+	// the second request was read by a committed critical section, so a later one may respond to it
+	if err := res.WriteValue(tla.MakeTLAString("to second")); err != nil {
+		t.Fatal(err)
+	}
+	if err := res.WriteValue(tla.MakeTLAString("to nobody")); !errors.Is(err, ErrNoRequestToRespondTo) {
+		t.Fatalf("expected a response beyond the requests read to fail, got %v", err)
+	}
+	res.Commit()
+	if response := <-second; !response.Equal(tla.MakeTLAString("to second")) {
+		t.Fatalf("expected the second request to get the second response, got %v", response)
+	}
+}
+
+func TestRequestResponseChannelCallCancelled(t *testing.T) {
+	channel := NewRequestResponseChannel(1)
+	maker := RequestResponseChannelMaker(channel)
+	res := maker.Make()
+	maker.Configure(res)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := channel.Call(ctx, tla.MakeTLAString("abandoned")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a call without a response to give up at its deadline, got %v", err)
+	}
+	// the model may still read and respond to the abandoned request, and its response is dropped
+	requestResponseTestRead(t, res, tla.MakeTLAString("abandoned"))
+	if err := res.WriteValue(tla.MakeTLAString("dropped")); err != nil {
+		t.Fatal(err)
+	}
+	res.Commit()
+}
+
+func TestInputChannelReadTimeout(t *testing.T) {
+	for _, timeout := range []time.Duration{50 * time.Millisecond, 0} {
+		channel := make(chan tla.TLAValue, 1)
+		maker := InputChannelMaker(channel, WithInputChannelReadTimeout(timeout))
+		res := maker.Make()
+		maker.Configure(res)
+
+		// with nothing to read, the read aborts the critical section once the timeout elapses, or right away if
+		// there is no timeout
+		start := time.Now()
+		if _, err := res.ReadValue(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatalf("expected a read timing out after %v to abort, got %v", timeout, err)
+		}
+		if elapsed := time.Since(start); elapsed < timeout {
+			t.Fatalf("expected the read to wait for %v, waited %v", timeout, elapsed)
+		}
+		res.Abort()
+
+		// a value arriving later is read by the next critical section
+		channel <- tla.MakeTLAString("late")
+		value, err := res.ReadValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !value.Equal(tla.MakeTLAString("late")) {
+			t.Fatalf("expected to read the value that arrived after the timeout, read %v", value)
+		}
+		res.Commit()
+	}
+}
+
+func TestInputChannelReadWaits(t *testing.T) {
+	channel := make(chan tla.TLAValue)
+	maker := InputChannelMaker(channel, WithInputChannelReadTimeout(5*time.Second))
+	res := maker.Make()
+	maker.Configure(res)
+
+	// a value arriving within the timeout is read by the waiting critical section
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		channel <- tla.MakeTLAString("soon")
+	}()
+	value, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !value.Equal(tla.MakeTLAString("soon")) {
+		t.Fatalf("expected to read the value sent during the wait, read %v", value)
+	}
+	res.Commit()
+}
@@ -0,0 +1,195 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ErrClockOverflow is returned when a clock reading, in the clock's unit, does not fit in a TLA+ number.
+var ErrClockOverflow = errors.New("clock reading does not fit in a TLA+ number; use a coarser unit")
+
+type clockConfig struct {
+	clock Clock
+}
+
+// ClockOption configures the resources made by WallClockMaker, MonotonicClockMaker and TimerMaker.
+type ClockOption func(cfg *clockConfig)
+
+// WithClock makes the resource read time from clock, rather than from SystemClock. With a ControlledClock, tests can
+// skew or freeze the time seen by one node.
+func WithClock(clock Clock) ClockOption {
+	return func(cfg *clockConfig) {
+		cfg.clock = clock
+	}
+}
+
+func makeClockConfig(opts []ClockOption) clockConfig {
+	cfg := clockConfig{clock: SystemClock}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+func clockReading(d time.Duration, unit time.Duration) (tla.TLAValue, error) {
+	units := int64(d / unit)
+	if units < math.MinInt32 || units > math.MaxInt32 {
+		return tla.TLAValue{}, ErrClockOverflow
+	}
+	return tla.MakeTLANumber(int32(units)), nil
+}
+
+// WallClockMaker produces a distsys.ArchetypeResourceMaker for a read-only resource giving the current wall-clock
+// time, as a number of units since the Unix epoch. As TLA+ numbers are 32-bit, unit should be coarse, e.g.
+// time.Second. Every read within the same critical section gives the same time, so that the critical section
+// observes a single instant.
+func WallClockMaker(unit time.Duration, opts ...ClockOption) distsys.ArchetypeResourceMaker {
+	source := makeClockConfig(opts).clock
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		return &clock{
+			unit: unit,
+			now: func() time.Duration {
+				return time.Duration(source.Now().UnixNano())
+			},
+		}
+	})
+}
+
+// MonotonicClockMaker produces a distsys.ArchetypeResourceMaker for a read-only resource giving the number of units
+// elapsed since the resource was created, as measured by the monotonic clock. Unlike WallClockMaker, it is not
+// affected by changes to the system clock, and so is suited to measuring durations. Reads within the same critical
+// section give the same value.
+func MonotonicClockMaker(unit time.Duration, opts ...ClockOption) distsys.ArchetypeResourceMaker {
+	source := makeClockConfig(opts).clock
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		start := source.Now()
+		return &clock{
+			unit: unit,
+			now: func() time.Duration {
+				return source.Now().Sub(start)
+			},
+		}
+	})
+}
+
+type clock struct {
+	distsys.ArchetypeResourceLeafMixin
+	unit time.Duration
+	now  func() time.Duration
+
+	cachedRead *tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &clock{}
+
+func (res *clock) Abort() chan struct{} {
+	res.cachedRead = nil
+	return nil
+}
+
+func (res *clock) PreCommit() chan error {
+	return nil
+}
+
+func (res *clock) Commit() chan struct{} {
+	res.cachedRead = nil
+	return nil
+}
+
+func (res *clock) ReadValue() (tla.TLAValue, error) {
+	if res.cachedRead == nil {
+		value, err := clockReading(res.now(), res.unit)
+		if err != nil {
+			return tla.TLAValue{}, err
+		}
+		res.cachedRead = &value
+	}
+	return *res.cachedRead, nil
+}
+
+func (res *clock) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write %v to a clock resource", value))
+}
+
+func (res *clock) Close() error {
+	return nil
+}
+
+// TimerMaker produces a distsys.ArchetypeResourceMaker for a timer resource. Writing a number n to it sets the
+// timer to expire n units after the critical section commits; writing a non-positive number stops it. Reading it
+// gives TRUE once the timer has expired, and FALSE while it is running or stopped, so a model can implement a
+// timeout with `await timer;`. Writes in aborted critical sections have no effect, and reads within the same
+// critical section give the same value.
+func TimerMaker(unit time.Duration, opts ...ClockOption) distsys.ArchetypeResourceMaker {
+	source := makeClockConfig(opts).clock
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		return &timer{unit: unit, clock: source}
+	})
+}
+
+type timer struct {
+	distsys.ArchetypeResourceLeafMixin
+	unit  time.Duration
+	clock Clock
+
+	deadline     time.Time // zero if the timer is stopped
+	writePending *time.Duration
+	cachedRead   *tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &timer{}
+
+func (res *timer) Abort() chan struct{} {
+	res.writePending = nil
+	res.cachedRead = nil
+	return nil
+}
+
+func (res *timer) PreCommit() chan error {
+	return nil
+}
+
+func (res *timer) Commit() chan struct{} {
+	if res.writePending != nil {
+		if *res.writePending > 0 {
+			res.deadline = res.clock.Now().Add(*res.writePending)
+		} else {
+			res.deadline = time.Time{}
+		}
+		res.writePending = nil
+	}
+	res.cachedRead = nil
+	return nil
+}
+
+func (res *timer) ReadValue() (tla.TLAValue, error) {
+	if res.writePending != nil {
+		// the timer is only started on commit, so cannot have expired yet
+		return tla.TLA_FALSE, nil
+	}
+	if res.cachedRead == nil {
+		expired := !res.deadline.IsZero() && !res.clock.Now().Before(res.deadline)
+		value := tla.MakeTLABool(expired)
+		res.cachedRead = &value
+	}
+	return *res.cachedRead, nil
+}
+
+func (res *timer) WriteValue(value tla.TLAValue) error {
+	num, err := value.TryAsNumber()
+	if err != nil {
+		return fmt.Errorf("attempted to set a timer: %w", err)
+	}
+	d := time.Duration(num) * res.unit
+	res.writePending = &d
+	return nil
+}
+
+func (res *timer) Close() error {
+	return nil
+}
@@ -0,0 +1,124 @@
+package resources
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// makeClockTest makes a resource reading time from a frozen ControlledClock, so that only Advance moves it.
+func makeClockTest(makeMaker func(clock Clock) distsys.ArchetypeResourceMaker) (distsys.ArchetypeResource, *ControlledClock) {
+	clock := NewControlledClock()
+	clock.Freeze()
+	maker := makeMaker(clock)
+	res := maker.Make()
+	maker.Configure(res)
+	return res, clock
+}
+
+func clockTestRead(t *testing.T, res distsys.ArchetypeResource) tla.TLAValue {
+	t.Helper()
+	value, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+func TestWallClock(t *testing.T) {
+	res, clock := makeClockTest(func(clock Clock) distsys.ArchetypeResourceMaker {
+		return WallClockMaker(time.Second, WithClock(clock))
+	})
+	start := clock.Now().Unix()
+	if value := clockTestRead(t, res); !value.Equal(tla.MakeTLANumber(int32(start))) {
+		t.Fatalf("expected to read %d seconds since the epoch, read %v", start, value)
+	}
+
+	// reads within a critical section observe a single instant
+	clock.Advance(time.Minute)
+	if value := clockTestRead(t, res); !value.Equal(tla.MakeTLANumber(int32(start))) {
+		t.Fatalf("expected to read the same time again within the critical section, read %v", value)
+	}
+	res.Commit()
+	if value := clockTestRead(t, res); !value.Equal(tla.MakeTLANumber(int32(start + 60))) {
+		t.Fatalf("expected the next critical section to read the advanced time, read %v", value)
+	}
+	res.Abort()
+
+	// readings that do not fit in a TLA+ number are an error
+	tooFine, _ := makeClockTest(func(clock Clock) distsys.ArchetypeResourceMaker {
+		return WallClockMaker(time.Nanosecond, WithClock(clock))
+	})
+	if _, err := tooFine.ReadValue(); !errors.Is(err, ErrClockOverflow) {
+		t.Fatalf("expected a reading in nanoseconds since the epoch to overflow, got %v", err)
+	}
+}
+
+func TestMonotonicClock(t *testing.T) {
+	res, clock := makeClockTest(func(clock Clock) distsys.ArchetypeResourceMaker {
+		return MonotonicClockMaker(time.Millisecond, WithClock(clock))
+	})
+	if value := clockTestRead(t, res); !value.Equal(tla.MakeTLANumber(0)) {
+		t.Fatalf("expected the clock to start at 0, read %v", value)
+	}
+	res.Commit()
+	clock.Advance(1500 * time.Millisecond)
+	if value := clockTestRead(t, res); !value.Equal(tla.MakeTLANumber(1500)) {
+		t.Fatalf("expected to read the 1500ms elapsed, read %v", value)
+	}
+	res.Commit()
+}
+
+func TestTimer(t *testing.T) {
+	res, clock := makeClockTest(func(clock Clock) distsys.ArchetypeResourceMaker {
+		return TimerMaker(time.Second, WithClock(clock))
+	})
+	if value := clockTestRead(t, res); !value.Equal(tla.TLA_FALSE) {
+		t.Fatalf("expected a stopped timer not to have expired, read %v", value)
+	}
+	res.Commit()
+
+	// a timer set by an aborted critical section is not started
+	if err := res.WriteValue(tla.MakeTLANumber(1)); err != nil {
+		t.Fatal(err)
+	}
+	res.Abort()
+	clock.Advance(time.Hour)
+	if value := clockTestRead(t, res); !value.Equal(tla.TLA_FALSE) {
+		t.Fatalf("expected a timer set by an aborted critical section not to run, read %v", value)
+	}
+	res.Commit()
+
+	// a timer starts once its critical section commits
+	if err := res.WriteValue(tla.MakeTLANumber(3)); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(time.Hour)
+	if value := clockTestRead(t, res); !value.Equal(tla.TLA_FALSE) {
+		t.Fatalf("expected a timer not to expire before it is started, read %v", value)
+	}
+	res.Commit()
+	clock.Advance(2 * time.Second)
+	if value := clockTestRead(t, res); !value.Equal(tla.TLA_FALSE) {
+		t.Fatalf("expected the timer not to expire before 3s, read %v", value)
+	}
+	res.Commit()
+	clock.Advance(time.Second)
+	if value := clockTestRead(t, res); !value.Equal(tla.TLA_TRUE) {
+		t.Fatalf("expected the timer to expire after 3s, read %v", value)
+	}
+	res.Commit()
+
+	// writing a non-positive number stops the timer
+	if err := res.WriteValue(tla.MakeTLANumber(0)); err != nil {
+		t.Fatal(err)
+	}
+	res.Commit()
+	if value := clockTestRead(t, res); !value.Equal(tla.TLA_FALSE) {
+		t.Fatalf("expected a stopped timer not to have expired, read %v", value)
+	}
+	res.Commit()
+}
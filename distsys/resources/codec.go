@@ -0,0 +1,251 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// MailboxEncoder writes a stream of values, in the style of gob.Encoder.
+type MailboxEncoder interface {
+	Encode(e interface{}) error
+}
+
+// MailboxDecoder reads a stream of values written by a matching MailboxEncoder, in the style of gob.Decoder.
+type MailboxDecoder interface {
+	Decode(e interface{}) error
+}
+
+// MailboxCodec determines the wire encoding used by mailbox resources. Each connection gets its own encoder and
+// decoder, so implementations may keep per-stream state (gob, for instance, sends type information only once).
+//
+// Everything a mailbox sends goes through its codec: protocol tags (ints), headers (structs with exported
+// fields), acknowledgements, and the tla.TLAValue payloads themselves. Both ends of a connection must use the
+// same codec.
+type MailboxCodec interface {
+	NewEncoder(w io.Writer) MailboxEncoder
+	NewDecoder(r io.Reader) MailboxDecoder
+}
+
+// DefaultMailboxMaxMessageBytes is the size of the largest message GobMailboxCodec accepts, unless configured
+// otherwise.
+const DefaultMailboxMaxMessageBytes = 64 << 20
+
+// GobMailboxCodec encodes mailbox traffic using encoding/gob. It is the default codec.
+type GobMailboxCodec struct {
+	// MaxMessageBytes is the size of the largest gob message a decoder accepts, beyond which decoding fails with an
+	// error wrapping tla.ErrTLAValueLimit, before the message is read into memory. It also bounds the size of
+	// decompressed values. If it is 0, DefaultMailboxMaxMessageBytes applies, and if it is negative, there is no
+	// limit. The values themselves are subject to the limits set by tla.SetTLAValueLimits.
+	MaxMessageBytes int
+}
+
+var _ NamedMailboxCodec = GobMailboxCodec{}
+
+func (GobMailboxCodec) Name() string {
+	return "gob"
+}
+
+func (GobMailboxCodec) NewEncoder(w io.Writer) MailboxEncoder {
+	return gob.NewEncoder(w)
+}
+
+func (codec GobMailboxCodec) NewDecoder(r io.Reader) MailboxDecoder {
+	if maxBytes := codec.maxMessageBytes(); maxBytes > 0 {
+		r = &gobMessageLimiter{r: r, maxBytes: maxBytes}
+	}
+	return gob.NewDecoder(r)
+}
+
+func (codec GobMailboxCodec) maxMessageBytes() int {
+	if codec.MaxMessageBytes == 0 {
+		return DefaultMailboxMaxMessageBytes
+	}
+	return codec.MaxMessageBytes
+}
+
+// mailboxMaxMessageBytes returns the size of the largest message codec accepts, or 0 if there is no limit. Codecs
+// other than GobMailboxCodec and JSONMailboxCodec get the default.
+func mailboxMaxMessageBytes(codec MailboxCodec) int {
+	var maxBytes int
+	switch codec := codec.(type) {
+	case GobMailboxCodec:
+		maxBytes = codec.maxMessageBytes()
+	case JSONMailboxCodec:
+		maxBytes = codec.maxMessageBytes()
+	default:
+		return DefaultMailboxMaxMessageBytes
+	}
+	if maxBytes > 0 {
+		return maxBytes
+	}
+	return 0
+}
+
+// JSONMailboxCodec encodes mailbox traffic using encoding/json, with tla.TLAValue payloads in the schema documented
+// by tla.TLAValue.MarshalJSON. It is larger and slower than GobMailboxCodec, but can be produced and consumed by
+// programs written in other languages. Like the JSON schema, it does not preserve strings that are not valid UTF-8.
+//
+// There is no MessagePack codec, since the standard library has no MessagePack implementation; one can be provided
+// by implementing MailboxCodec.
+type JSONMailboxCodec struct {
+	// MaxMessageBytes is the size of the largest JSON value a decoder accepts, beyond which decoding fails with an
+	// error wrapping tla.ErrTLAValueLimit. It has the same meaning as GobMailboxCodec.MaxMessageBytes.
+	MaxMessageBytes int
+}
+
+var _ NamedMailboxCodec = JSONMailboxCodec{}
+
+func (JSONMailboxCodec) Name() string {
+	return "json"
+}
+
+func (JSONMailboxCodec) NewEncoder(w io.Writer) MailboxEncoder {
+	return json.NewEncoder(w)
+}
+
+func (codec JSONMailboxCodec) NewDecoder(r io.Reader) MailboxDecoder {
+	maxBytes := codec.maxMessageBytes()
+	if maxBytes <= 0 {
+		return json.NewDecoder(r)
+	}
+	limiter := &jsonMessageLimiter{r: r, maxBytes: maxBytes}
+	limiter.decoder = json.NewDecoder(limiter)
+	return limiter.decoder
+}
+
+func (codec JSONMailboxCodec) maxMessageBytes() int {
+	if codec.MaxMessageBytes == 0 {
+		return DefaultMailboxMaxMessageBytes
+	}
+	return codec.MaxMessageBytes
+}
+
+// jsonMessageLimiter fails reads made by decoder once decoder holds more than maxBytes that it has not yet decoded,
+// as a json.Decoder would otherwise keep buffering a value that never ends.
+type jsonMessageLimiter struct {
+	r        io.Reader
+	maxBytes int
+	decoder  *json.Decoder
+
+	read int64 // total bytes read from r
+	err  error
+}
+
+func (l *jsonMessageLimiter) Read(p []byte) (int, error) {
+	if l.err != nil {
+		return 0, l.err
+	}
+	if pending := l.read - l.decoder.InputOffset(); pending > int64(l.maxBytes) {
+		l.err = fmt.Errorf("%w: a message of more than %d bytes is longer than the limit of %d", tla.ErrTLAValueLimit, pending, l.maxBytes)
+		return 0, l.err
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	return n, err
+}
+
+// gobMessageLimiter follows the framing of the gob stream read from r, in which each message is preceded by its
+// length, and fails as soon as it sees the length of a message longer than maxBytes, as a gob.Decoder would
+// otherwise allocate a buffer of that length, up to a gigabyte, before reading the message.
+type gobMessageLimiter struct {
+	r        io.Reader
+	maxBytes int
+
+	length    []byte // the encoded length of the next message, as far as it has been read
+	remaining uint64 // how much of the current message is still to be read
+	err       error
+}
+
+func (l *gobMessageLimiter) Read(p []byte) (int, error) {
+	if l.err != nil {
+		return 0, l.err
+	}
+	n, err := l.r.Read(p)
+	for _, b := range p[:n] {
+		if l.remaining > 0 {
+			l.remaining--
+			continue
+		}
+		l.length = append(l.length, b)
+		// a gob length is either a single byte below 128, or the negated number of big-endian bytes that follow
+		if l.length[0] >= 0x80 && len(l.length) <= int(-int8(l.length[0])) {
+			continue
+		}
+		var length uint64
+		if l.length[0] < 0x80 {
+			length = uint64(l.length[0])
+		} else {
+			for _, lb := range l.length[1:] {
+				length = length<<8 | uint64(lb)
+			}
+		}
+		l.length = l.length[:0]
+		if length > uint64(l.maxBytes) {
+			l.err = fmt.Errorf("%w: a message of %d bytes is longer than the limit of %d", tla.ErrTLAValueLimit, length, l.maxBytes)
+			return 0, l.err
+		}
+		l.remaining = length
+	}
+	return n, err
+}
+
+// CheckMailboxCodecRoundTrip sends values through codec, as a mailbox would, and checks that each is received equal
+// to itself. The values are sent in order over a single stream, so that per-stream codec state is exercised, and
+// each is also sent on its own as a compressed payload, as negotiated by WithTCPMailboxesCompression. A panic in the
+// codec is reported as an error. This is meant for testing custom codecs, and for fuzzing with
+// tla.ArbitraryTLAValue.
+func CheckMailboxCodecRoundTrip(codec MailboxCodec, values ...tla.TLAValue) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("codec panicked: %v", r)
+		}
+	}()
+
+	var buf bytes.Buffer
+	encoder := codec.NewEncoder(&buf)
+	for _, value := range values {
+		value := value // make sure encoded thing is addressable
+		if err := encoder.Encode(&value); err != nil {
+			return fmt.Errorf("could not encode %v: %w", value, err)
+		}
+	}
+	decoder := codec.NewDecoder(&buf)
+	for _, value := range values {
+		var decoded tla.TLAValue
+		if err := decoder.Decode(&decoded); err != nil {
+			return fmt.Errorf("could not decode %v: %w", value, err)
+		}
+		if !decoded.Equal(value) {
+			return fmt.Errorf("%v was decoded as %v", value, decoded)
+		}
+	}
+
+	for _, value := range values {
+		payload, err := makeTCPMailboxesPayload(codec, FlateMailboxCompressor{}, value, 0)
+		if err != nil {
+			return fmt.Errorf("could not compress %v: %w", value, err)
+		}
+		decoded, err := payload.value(codec, FlateMailboxCompressor{})
+		if err != nil {
+			return fmt.Errorf("could not decompress %v: %w", value, err)
+		}
+		if !decoded.Equal(value) {
+			return fmt.Errorf("%v was decompressed as %v", value, decoded)
+		}
+	}
+	return nil
+}
+
+// WithSelfTestMailboxCodec makes distsys.SelfTest check round-trips through codec, as CheckMailboxCodecRoundTrip
+// does. It should be given the same codec as WithMailboxCodec.
+func WithSelfTestMailboxCodec(codec MailboxCodec) distsys.SelfTestOption {
+	return distsys.WithSelfTestCodec(func(values ...tla.TLAValue) error {
+		return CheckMailboxCodecRoundTrip(codec, values...)
+	})
+}
@@ -0,0 +1,20 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func FuzzGobMailboxCodec(f *testing.F) {
+	f.Add([]byte{}, []byte{})
+	f.Add([]byte{0, 2}, []byte{3, 0})
+	f.Add([]byte{6, 2, 3, 0, 4, 0}, []byte{5, 1, 1, 'a', 2, 0})
+	f.Add([]byte{4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4}, []byte{2, 3, 0xff, 0xfe, 0xfd})
+	f.Fuzz(func(t *testing.T, first, second []byte) {
+		err := CheckMailboxCodecRoundTrip(GobMailboxCodec{}, tla.ArbitraryTLAValue(first), tla.ArbitraryTLAValue(second))
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
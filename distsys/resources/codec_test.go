@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func TestJSONMailboxCodec(t *testing.T) {
+	bigNum := new(big.Int).Lsh(big.NewInt(1), 100)
+	values := []tla.TLAValue{
+		tla.MakeTLANumber(42),
+		tla.MakeTLABigNumber(bigNum),
+		tla.MakeTLAString("héllo"),
+		tla.MakeTLABool(true),
+		tla.MakeTLASet(tla.MakeTLANumber(1), tla.MakeTLANumber(2)),
+		tla.MakeTLATuple(),
+		tla.MakeTLARecord([]tla.TLARecordField{
+			{Key: tla.MakeTLAString("type"), Value: tla.MakeTLAString("req")},
+			{Key: tla.MakeTLAString("body"), Value: tla.MakeTLATuple(tla.MakeTLANumber(3))},
+		}),
+		tla.MakeTLAFunction([]tla.TLAValue{tla.MakeTLASet(tla.MakeTLANumber(1), tla.MakeTLANumber(2))}, func(args []tla.TLAValue) tla.TLAValue {
+			return tla.MakeTLASet(args[0])
+		}),
+	}
+	if err := CheckMailboxCodecRoundTrip(JSONMailboxCodec{}, values...); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("protocol messages", func(t *testing.T) {
+		var buf bytes.Buffer
+		encoder := JSONMailboxCodec{}.NewEncoder(&buf)
+		header := tcpMailboxesHeader{Sender: "a", Incarnation: 2, Seq: 3}
+		for _, msg := range []interface{}{tcpNetworkBegin, &header, struct{}{}, false, 5 * time.Second} {
+			if err := encoder.Encode(msg); err != nil {
+				t.Fatal(err)
+			}
+		}
+		decoder := JSONMailboxCodec{}.NewDecoder(&buf)
+		var tag int
+		var decodedHeader tcpMailboxesHeader
+		var ack struct{}
+		var shouldResend bool
+		var ttl time.Duration
+		for _, msg := range []interface{}{&tag, &decodedHeader, &ack, &shouldResend, &ttl} {
+			if err := decoder.Decode(msg); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if tag != tcpNetworkBegin || decodedHeader != header || shouldResend || ttl != 5*time.Second {
+			t.Fatalf("decoded %v, %v, %v, %v", tag, decodedHeader, shouldResend, ttl)
+		}
+	})
+
+	t.Run("message limit", func(t *testing.T) {
+		var buf bytes.Buffer
+		codec := JSONMailboxCodec{MaxMessageBytes: 1024}
+		small := tla.MakeTLAString("small")
+		large := tla.MakeTLAString(strings.Repeat("x", 4096))
+		for _, value := range []tla.TLAValue{small, large} {
+			if err := codec.NewEncoder(&buf).Encode(&value); err != nil {
+				t.Fatal(err)
+			}
+		}
+		decoder := codec.NewDecoder(&buf)
+		var decoded tla.TLAValue
+		if err := decoder.Decode(&decoded); err != nil || !decoded.Equal(small) {
+			t.Fatalf("decoded %v, %v; expected %v", decoded, err, small)
+		}
+		if err := decoder.Decode(&decoded); !errors.Is(err, tla.ErrTLAValueLimit) {
+			t.Fatalf("expected an error wrapping ErrTLAValueLimit, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package resources
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// MailboxCompressor is a compression algorithm that mailbox connections can negotiate, by name, when they are
+// established. See WithTCPMailboxesCompression.
+type MailboxCompressor interface {
+	// Name identifies the algorithm during the handshake, so it must be the same in every process.
+	Name() string
+	// NewWriter returns a writer that compresses what is written to it into w, until it is closed.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+	// NewReader returns a reader that decompresses what it reads from r.
+	NewReader(r io.Reader) (io.Reader, error)
+}
+
+// FlateMailboxCompressor compresses values with DEFLATE, as implemented by compress/flate. It is the default, and
+// local mailboxes always accept it.
+type FlateMailboxCompressor struct{}
+
+var _ MailboxCompressor = FlateMailboxCompressor{}
+
+func (FlateMailboxCompressor) Name() string {
+	return "flate"
+}
+
+func (FlateMailboxCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return flate.NewWriter(w, flate.DefaultCompression)
+}
+
+func (FlateMailboxCompressor) NewReader(r io.Reader) (io.Reader, error) {
+	return flate.NewReader(r), nil
+}
+
+// tcpMailboxesPayload replaces a plain tla.TLAValue on the wire once compression has been negotiated. Data holds the
+// value as encoded by the connection's codec, and is compressed with the negotiated compressor if Compressed is set.
+type tcpMailboxesPayload struct {
+	Compressed bool
+	Data       []byte
+}
+
+func makeTCPMailboxesPayload(codec MailboxCodec, compressor MailboxCompressor, value tla.TLAValue, threshold int) (tcpMailboxesPayload, error) {
+	var buf bytes.Buffer
+	err := codec.NewEncoder(&buf).Encode(&value)
+	if err != nil {
+		return tcpMailboxesPayload{}, err
+	}
+	if buf.Len() < threshold {
+		return tcpMailboxesPayload{Data: buf.Bytes()}, nil
+	}
+
+	var compressed bytes.Buffer
+	writer, err := compressor.NewWriter(&compressed)
+	if err != nil {
+		return tcpMailboxesPayload{}, err
+	}
+	_, err = writer.Write(buf.Bytes())
+	if err != nil {
+		return tcpMailboxesPayload{}, err
+	}
+	err = writer.Close()
+	if err != nil {
+		return tcpMailboxesPayload{}, err
+	}
+	return tcpMailboxesPayload{Compressed: true, Data: compressed.Bytes()}, nil
+}
+
+func (payload tcpMailboxesPayload) value(codec MailboxCodec, compressor MailboxCompressor) (tla.TLAValue, error) {
+	data := payload.Data
+	if payload.Compressed {
+		reader, err := compressor.NewReader(bytes.NewReader(payload.Data))
+		if err != nil {
+			return tla.TLAValue{}, err
+		}
+		maxBytes := mailboxMaxMessageBytes(codec)
+		if maxBytes > 0 {
+			// read one byte more than allowed, to tell whether there is more
+			reader = io.LimitReader(reader, int64(maxBytes)+1)
+		}
+		data, err = ioutil.ReadAll(reader)
+		if err != nil {
+			return tla.TLAValue{}, err
+		}
+		if maxBytes > 0 && len(data) > maxBytes {
+			return tla.TLAValue{}, fmt.Errorf("%w: a value decompresses to more than %d bytes", tla.ErrTLAValueLimit, maxBytes)
+		}
+	}
+	var value tla.TLAValue
+	err := codec.NewDecoder(bytes.NewReader(data)).Decode(&value)
+	return value, err
+}
@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// namedMailboxCompressor behaves like FlateMailboxCompressor under another name, standing in for a compressor that
+// only some processes know about.
+type namedMailboxCompressor struct {
+	FlateMailboxCompressor
+	name string
+}
+
+func (compressor namedMailboxCompressor) Name() string {
+	return compressor.name
+}
+
+// compressionTestValue is a large, repetitive value, which compresses well.
+func compressionTestValue() tla.TLAValue {
+	var elements []tla.TLAValue
+	for i := 0; i < 1000; i++ {
+		elements = append(elements, tla.MakeTLAString("the same string, again and again"))
+	}
+	return tla.MakeTLATuple(elements...)
+}
+
+func TestMailboxCompressionPayload(t *testing.T) {
+	codec := GobMailboxCodec{}
+	small := tla.MakeTLANumber(1)
+	large := compressionTestValue()
+
+	payload, err := makeTCPMailboxesPayload(codec, FlateMailboxCompressor{}, small, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload.Compressed {
+		t.Error("expected a value below the threshold to be sent uncompressed")
+	}
+	if value, err := payload.value(codec, FlateMailboxCompressor{}); err != nil || !value.Equal(small) {
+		t.Fatalf("expected %v, got %v, %v", small, value, err)
+	}
+
+	payload, err = makeTCPMailboxesPayload(codec, FlateMailboxCompressor{}, large, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uncompressed, err := makeTCPMailboxesPayload(codec, FlateMailboxCompressor{}, large, 1<<30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !payload.Compressed || len(payload.Data) >= len(uncompressed.Data)/10 {
+		t.Errorf("expected a large value to be compressed, got %d bytes, compressed: %t", len(payload.Data), payload.Compressed)
+	}
+	if value, err := payload.value(codec, FlateMailboxCompressor{}); err != nil || !value.Equal(large) {
+		t.Fatalf("expected the large value to round-trip, got %v", err)
+	}
+
+	// a value that decompresses to more than the codec accepts is rejected, however small it was on the wire
+	if _, err := payload.value(GobMailboxCodec{MaxMessageBytes: 1024}, FlateMailboxCompressor{}); !errors.Is(err, tla.ErrTLAValueLimit) {
+		t.Fatalf("expected a value decompressing past the limit to be rejected, got %v", err)
+	}
+}
+
+func TestTCPMailboxesCompression(t *testing.T) {
+	unknown := namedMailboxCompressor{name: "unknown"}
+	known := namedMailboxCompressor{name: "known"}
+	tests := []struct {
+		name              string
+		senderOpts        []TCPMailboxesOption
+		receiverOpts      []TCPMailboxesOption
+		expectCompression string
+	}{
+		{"off", nil, nil, ""},
+		{"default", []TCPMailboxesOption{WithTCPMailboxesCompression(1024)}, nil, "flate"},
+		// the receiver picks the first compressor it knows, in the sender's order of preference
+		{"preference",
+			[]TCPMailboxesOption{WithTCPMailboxesCompression(1024, unknown, known, FlateMailboxCompressor{})},
+			[]TCPMailboxesOption{WithTCPMailboxesCompression(1024, known)},
+			"known"},
+		{"none known", []TCPMailboxesOption{WithTCPMailboxesCompression(1024, unknown)}, nil, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			addrs := []string{freeLocalAddr(t)}
+			receiver := makeTCPMailboxesTest(t, 0, addrs, test.receiverOpts...)
+			sender := makeTCPMailboxesTest(t, -1, addrs, test.senderOpts...)
+			large := compressionTestValue()
+			if err := mailboxesTestIndex(t, sender, 0).WriteValue(large); err != nil {
+				t.Fatal(err)
+			}
+			mailboxesTestSend(t, sender, 0, 1)
+
+			compression := ""
+			if compressor := mailboxesTestIndex(t, sender, 0).(*tcpMailboxesRemote).connCompressor; compressor != nil {
+				compression = compressor.Name()
+			}
+			if compression != test.expectCompression {
+				t.Errorf("expected compression %q to be negotiated, got %q", test.expectCompression, compression)
+			}
+			values := mailboxesTestReceive(t, receiver, 0, 2)
+			if !values[0].Equal(large) || !values[1].Equal(tla.MakeTLANumber(1)) {
+				t.Fatalf("expected the large value and 1 to be received, got %d values", len(values))
+			}
+		})
+	}
+}
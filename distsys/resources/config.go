@@ -0,0 +1,141 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ConfigMaker produces a distsys.ArchetypeResourceMaker for a read-only resource holding config, which should be
+// a TLA+ function, such as a record. Reading the resource gives config as a whole, and indexing it with a key gives
+// a read-only resource holding the value at that key. Indexing it with a key outside config's domain is an error.
+func ConfigMaker(config tla.TLAValue) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		return &configResource{value: config}
+	})
+}
+
+// EnvironmentConfigMaker is a ConfigMaker for the environment variables whose names start with prefix. The config
+// is a record mapping each variable's name, without prefix, to its value as a TLA+ string. The environment is read
+// when EnvironmentConfigMaker is called.
+func EnvironmentConfigMaker(prefix string) distsys.ArchetypeResourceMaker {
+	var fields []tla.TLARecordField
+	for _, entry := range os.Environ() {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || !strings.HasPrefix(parts[0], prefix) {
+			continue
+		}
+		fields = append(fields, tla.TLARecordField{
+			Key:   tla.MakeTLAString(strings.TrimPrefix(parts[0], prefix)),
+			Value: tla.MakeTLAString(parts[1]),
+		})
+	}
+	return ConfigMaker(tla.MakeTLARecord(fields))
+}
+
+// FileConfigMaker is a ConfigMaker for the JSON object in the file at path. JSON objects become records, arrays
+// become tuples, and strings, booleans and integers become the corresponding TLA+ values. Null and non-integer
+// numbers are not supported. The file is read when FileConfigMaker is called.
+func FileConfigMaker(path string) (distsys.ArchetypeResourceMaker, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var decoded map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err = decoder.Decode(&decoded)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse config file %s: %w", path, err)
+	}
+	config, err := configFromJSON(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert config file %s: %w", path, err)
+	}
+	return ConfigMaker(config), nil
+}
+
+func configFromJSON(value interface{}) (tla.TLAValue, error) {
+	switch value := value.(type) {
+	case bool:
+		return tla.MakeTLABool(value), nil
+	case string:
+		return tla.MakeTLAString(value), nil
+	case json.Number:
+		n, ok := new(big.Int).SetString(value.String(), 10)
+		if !ok {
+			return tla.TLAValue{}, fmt.Errorf("%v is not a TLA+ number", value)
+		}
+		return tla.MakeTLABigNumber(n), nil
+	case []interface{}:
+		elems := make([]tla.TLAValue, len(value))
+		for i, elem := range value {
+			var err error
+			elems[i], err = configFromJSON(elem)
+			if err != nil {
+				return tla.TLAValue{}, err
+			}
+		}
+		return tla.MakeTLATuple(elems...), nil
+	case map[string]interface{}:
+		var fields []tla.TLARecordField
+		for key, elem := range value {
+			elemValue, err := configFromJSON(elem)
+			if err != nil {
+				return tla.TLAValue{}, fmt.Errorf("%s: %w", key, err)
+			}
+			fields = append(fields, tla.TLARecordField{Key: tla.MakeTLAString(key), Value: elemValue})
+		}
+		return tla.MakeTLARecord(fields), nil
+	default:
+		return tla.TLAValue{}, fmt.Errorf("unsupported JSON value %v", value)
+	}
+}
+
+type configResource struct {
+	value tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &configResource{}
+
+func (res *configResource) Abort() chan struct{} {
+	return nil
+}
+
+func (res *configResource) PreCommit() chan error {
+	return nil
+}
+
+func (res *configResource) Commit() chan struct{} {
+	return nil
+}
+
+func (res *configResource) ReadValue() (tla.TLAValue, error) {
+	return res.value, nil
+}
+
+func (res *configResource) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write %v to a config resource", value))
+}
+
+func (res *configResource) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	if !res.value.IsFunction() {
+		return nil, fmt.Errorf("attempted to index config value %v, which is not a function", res.value)
+	}
+	value, ok := res.value.AsFunction().Get(index)
+	if !ok {
+		return nil, fmt.Errorf("config key %v not found", index)
+	}
+	return &configResource{value: value.(tla.TLAValue)}, nil
+}
+
+func (res *configResource) Close() error {
+	return nil
+}
@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func configTestRead(t *testing.T, res distsys.ArchetypeResource, keys ...tla.TLAValue) tla.TLAValue {
+	t.Helper()
+	for _, key := range keys {
+		var err error
+		res, err = res.Index(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	value, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+func TestEnvironmentConfig(t *testing.T) {
+	t.Setenv("PGO_CONFIG_TEST_NAME", "node1")
+	t.Setenv("PGO_CONFIG_TEST_PEERS", "")
+	t.Setenv("PGO_CONFIG_TESTIGNORED", "ignored")
+	maker := EnvironmentConfigMaker("PGO_CONFIG_TEST_")
+	res := maker.Make()
+	maker.Configure(res)
+
+	// changes to the environment after the maker is made are not seen
+	t.Setenv("PGO_CONFIG_TEST_NAME", "changed")
+
+	expected := tla.MakeTLARecord([]tla.TLARecordField{
+		{Key: tla.MakeTLAString("NAME"), Value: tla.MakeTLAString("node1")},
+		{Key: tla.MakeTLAString("PEERS"), Value: tla.MakeTLAString("")},
+	})
+	if value := configTestRead(t, res); !value.Equal(expected) {
+		t.Fatalf("expected the config %v, read %v", expected, value)
+	}
+	if value := configTestRead(t, res, tla.MakeTLAString("NAME")); !value.Equal(tla.MakeTLAString("node1")) {
+		t.Fatalf("expected NAME to be node1, read %v", value)
+	}
+	if _, err := res.Index(tla.MakeTLAString("IGNORED")); err == nil {
+		t.Fatalf("expected a variable without the prefix not to be in the config")
+	}
+}
+
+func TestFileConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	err := os.WriteFile(path, []byte(`{
+		"name": "node1",
+		"debug": true,
+		"peers": ["a", "b"],
+		"timeouts": {"election": 150, "huge": 9223372036854775807}
+	}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	maker, err := FileConfigMaker(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := maker.Make()
+	maker.Configure(res)
+
+	tests := []struct {
+		keys     []tla.TLAValue
+		expected tla.TLAValue
+	}{
+		{[]tla.TLAValue{tla.MakeTLAString("name")}, tla.MakeTLAString("node1")},
+		{[]tla.TLAValue{tla.MakeTLAString("debug")}, tla.TLA_TRUE},
+		{[]tla.TLAValue{tla.MakeTLAString("peers")}, tla.MakeTLATuple(tla.MakeTLAString("a"), tla.MakeTLAString("b"))},
+		{[]tla.TLAValue{tla.MakeTLAString("timeouts"), tla.MakeTLAString("election")}, tla.MakeTLANumber(150)},
+		{[]tla.TLAValue{tla.MakeTLAString("timeouts"), tla.MakeTLAString("huge")}, tla.MakeTLABigNumber(big.NewInt(math.MaxInt64))},
+	}
+	for _, test := range tests {
+		if value := configTestRead(t, res, test.keys...); !value.Equal(test.expected) {
+			t.Fatalf("expected %v at %v, read %v", test.expected, test.keys, value)
+		}
+	}
+	if _, err := res.Index(tla.MakeTLAString("missing")); err == nil {
+		t.Fatalf("expected indexing a key outside the config to fail")
+	}
+}
+
+func TestFileConfigErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := FileConfigMaker(filepath.Join(dir, "missing.json")); err == nil {
+		t.Fatalf("expected a missing config file to be an error")
+	}
+	for name, contents := range map[string]string{
+		"malformed.json": `{"name": `,
+		"null.json":      `{"name": null}`,
+		"real.json":      `{"ratio": 1.5}`,
+		"array.json":     `["not", "an", "object"]`,
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := FileConfigMaker(path); err == nil {
+			t.Fatalf("expected the config file %s to be an error", name)
+		}
+	}
+}
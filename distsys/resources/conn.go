@@ -3,9 +3,36 @@ package resources
 import (
 	"io"
 	"net"
+	"os"
+	"strings"
 	"time"
 )
 
+const unixAddressPrefix = "unix://"
+
+// splitMailboxAddress translates a mailbox address into arguments for net.Dial or net.Listen. Addresses are TCP
+// host:port pairs, unless prefixed with unix://, in which case the rest of the address is a Unix socket path.
+func splitMailboxAddress(addr string) (network, address string) {
+	if strings.HasPrefix(addr, unixAddressPrefix) {
+		return "unix", strings.TrimPrefix(addr, unixAddressPrefix)
+	}
+	return "tcp", addr
+}
+
+// listenMailbox listens on a mailbox address, as understood by splitMailboxAddress. A Unix socket left behind by a
+// previous process that crashed is removed first, as it would otherwise prevent listening.
+func listenMailbox(addr string) (net.Listener, error) {
+	network, address := splitMailboxAddress(addr)
+	if network == "unix" {
+		if info, err := os.Stat(address); err == nil && info.Mode()&os.ModeSocket != 0 {
+			if err := os.Remove(address); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return net.Listen(network, address)
+}
+
 type readWriterConnTimeout struct {
 	conn    net.Conn
 	timeout time.Duration
@@ -41,3 +68,27 @@ func (rw readWriterConnTimeout) Write(data []byte) (n int, err error) {
 	}
 	return
 }
+
+// countingReadWriter reports the number of bytes successfully read and written through it.
+type countingReadWriter struct {
+	rw              io.ReadWriter
+	onRead, onWrite func(n int)
+}
+
+var _ io.ReadWriter = countingReadWriter{}
+
+func (rw countingReadWriter) Read(data []byte) (n int, err error) {
+	n, err = rw.rw.Read(data)
+	if n > 0 {
+		rw.onRead(n)
+	}
+	return
+}
+
+func (rw countingReadWriter) Write(data []byte) (n int, err error) {
+	n, err = rw.rw.Write(data)
+	if n > 0 {
+		rw.onWrite(n)
+	}
+	return
+}
@@ -0,0 +1,146 @@
+package resources
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of time of the time-based resources: clocks, timers and failure detectors. By default they
+// use SystemClock; giving them a ControlledClock instead lets tests skew or freeze the time seen by individual
+// nodes, and check how timeout-sensitive code copes.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel that receives the time once d has elapsed, as measured by this clock.
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// SystemClock is the Clock of the system, as given by the time package.
+var SystemClock Clock = systemClock{}
+
+// ControlledClock is a Clock that follows the system clock, offset by an adjustable skew, and that can be frozen.
+// Changes to it take effect on pending After calls: advancing the clock fires the ones that become due, and
+// freezing it holds them until it is unfrozen or advanced. It is safe for concurrent use.
+type ControlledClock struct {
+	lock     sync.Mutex
+	skew     time.Duration
+	frozen   bool
+	frozenAt time.Time
+	// changed is closed and replaced whenever the clock is adjusted, waking up pending After calls
+	changed chan struct{}
+}
+
+var _ Clock = &ControlledClock{}
+
+// NewControlledClock creates a ControlledClock that reads the same as the system clock, until adjusted.
+func NewControlledClock() *ControlledClock {
+	return &ControlledClock{changed: make(chan struct{})}
+}
+
+func (c *ControlledClock) nowLocked() time.Time {
+	if c.frozen {
+		return c.frozenAt
+	}
+	return time.Now().Add(c.skew)
+}
+
+func (c *ControlledClock) notifyLocked() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+func (c *ControlledClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.nowLocked()
+}
+
+func (c *ControlledClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	deadline := c.Now().Add(d)
+	go func() {
+		for {
+			c.lock.Lock()
+			now, frozen, changed := c.nowLocked(), c.frozen, c.changed
+			c.lock.Unlock()
+			if !now.Before(deadline) {
+				ch <- now
+				return
+			}
+			var wait <-chan time.Time
+			if !frozen {
+				wait = time.After(deadline.Sub(now))
+			}
+			select {
+			case <-wait:
+			case <-changed:
+			}
+		}
+	}()
+	return ch
+}
+
+// Skew returns how far ahead of the system clock the clock reads, or behind it if negative.
+func (c *ControlledClock) Skew() time.Duration {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.nowLocked().Sub(time.Now())
+}
+
+// SetSkew makes the clock read skew ahead of the system clock, or behind it if skew is negative. If the clock is
+// frozen, it stays frozen, at the skewed time.
+func (c *ControlledClock) SetSkew(skew time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.frozen {
+		c.frozenAt = time.Now().Add(skew)
+	} else {
+		c.skew = skew
+	}
+	c.notifyLocked()
+}
+
+// Advance moves the clock forward by d, or backward if d is negative, whether it is frozen or not.
+func (c *ControlledClock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.frozen {
+		c.frozenAt = c.frozenAt.Add(d)
+	} else {
+		c.skew += d
+	}
+	c.notifyLocked()
+}
+
+// Freeze stops the clock at its current reading, until Unfreeze is called. Advance still moves it.
+func (c *ControlledClock) Freeze() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.frozen {
+		c.frozenAt = c.nowLocked()
+		c.frozen = true
+		c.notifyLocked()
+	}
+}
+
+// Unfreeze restarts a frozen clock from the reading it was frozen at, so that it keeps the skew it had then plus any
+// time it was advanced by, and loses the time it spent frozen.
+func (c *ControlledClock) Unfreeze() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.frozen {
+		c.skew = c.frozenAt.Sub(time.Now())
+		c.frozen = false
+		c.notifyLocked()
+	}
+}
@@ -0,0 +1,84 @@
+package resources
+
+import (
+	"testing"
+	"time"
+)
+
+// controlledClockTestFired checks whether ch fires within wait.
+func controlledClockTestFired(ch <-chan time.Time, wait time.Duration) bool {
+	select {
+	case <-ch:
+		return true
+	case <-time.After(wait):
+		return false
+	}
+}
+
+func TestControlledClockAdvance(t *testing.T) {
+	clock := NewControlledClock()
+	clock.Freeze()
+	start := clock.Now()
+
+	ch := clock.After(time.Hour)
+	if controlledClockTestFired(ch, 20*time.Millisecond) {
+		t.Fatalf("expected a timer not to fire before the clock reaches its deadline")
+	}
+	clock.Advance(30 * time.Minute)
+	if controlledClockTestFired(ch, 20*time.Millisecond) {
+		t.Fatalf("expected a timer not to fire halfway to its deadline")
+	}
+	clock.Advance(30 * time.Minute)
+	if !controlledClockTestFired(ch, time.Second) {
+		t.Fatalf("expected a timer to fire once the clock was advanced to its deadline")
+	}
+	if now := clock.Now(); !now.Equal(start.Add(time.Hour)) {
+		t.Fatalf("expected a frozen clock to move only by what it was advanced, read %v after %v", now, start)
+	}
+}
+
+func TestControlledClockFreeze(t *testing.T) {
+	clock := NewControlledClock()
+	ch := clock.After(50 * time.Millisecond)
+	clock.Freeze()
+
+	// a frozen clock holds timers, however long they wait in real time
+	frozenAt := clock.Now()
+	if controlledClockTestFired(ch, 100*time.Millisecond) {
+		t.Fatalf("expected a timer not to fire while the clock is frozen")
+	}
+	if now := clock.Now(); !now.Equal(frozenAt) {
+		t.Fatalf("expected a frozen clock to keep its reading, read %v then %v", frozenAt, now)
+	}
+
+	// once unfrozen, the clock resumes from where it was frozen, and the timer fires in real time
+	clock.Unfreeze()
+	if skew := clock.Skew(); skew > -100*time.Millisecond {
+		t.Fatalf("expected the clock to lose the time it spent frozen, its skew is %v", skew)
+	}
+	if !controlledClockTestFired(ch, time.Second) {
+		t.Fatalf("expected the timer to fire once the clock is unfrozen")
+	}
+}
+
+func TestControlledClockSkew(t *testing.T) {
+	clock := NewControlledClock()
+	clock.SetSkew(time.Hour)
+	if skew := clock.Skew(); skew < time.Hour-time.Second || skew > time.Hour+time.Second {
+		t.Fatalf("expected the clock to read an hour ahead, its skew is %v", skew)
+	}
+
+	// skewing the clock ahead fires timers that become due
+	ch := clock.After(time.Minute)
+	clock.SetSkew(time.Hour + time.Minute)
+	if !controlledClockTestFired(ch, time.Second) {
+		t.Fatalf("expected a timer to fire once the clock was skewed past its deadline")
+	}
+
+	// skewing it behind delays them
+	ch = clock.After(50 * time.Millisecond)
+	clock.SetSkew(0)
+	if controlledClockTestFired(ch, 100*time.Millisecond) {
+		t.Fatalf("expected a timer to be delayed by the clock going back")
+	}
+}
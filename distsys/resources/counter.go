@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// RaftCounterMaker produces a distsys.ArchetypeResourceMaker for a cluster-wide counter, named name, replicated by
+// the Raft group node belongs to. The counter starts at 0, and only ever increases, which makes it suitable for
+// generating unique IDs. It does not wrap around: past 32 bits, it reads as a big number.
+//
+// Reading yields the counter's value, plus one for each write made by the current critical section. Writing
+// increments the counter by one, whatever the value written. Increments are applied at commit, atomically: a
+// critical section that only writes does not conflict with increments committed since it started, while one that
+// reads and writes, e.g. to take the next ID, aborts and retries if another critical section incremented the counter
+// since it was read. Each ID taken this way is therefore unique.
+func RaftCounterMaker(node *RaftNode, name string) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		return &raftCounter{
+			raftSharedValue: raftSharedValue{
+				node: node,
+				// a record, so that it cannot collide with the tuple keys of shared values
+				key:     tla.MakeTLARecord([]tla.TLARecordField{{Key: tla.MakeTLAString("counter"), Value: tla.MakeTLAString(name)}}),
+				initial: tla.MakeTLANumber(0),
+			},
+		}
+	})
+}
+
+// raftCounter is a raftSharedValue whose writes are increments.
+type raftCounter struct {
+	raftSharedValue
+}
+
+var _ distsys.ArchetypeResource = &raftCounter{}
+
+func (res *raftCounter) ReadValue() (tla.TLAValue, error) {
+	if !res.hasRead {
+		_, err := res.fetch()
+		if err != nil {
+			return tla.TLAValue{}, err
+		}
+	}
+	return tla.TLA_PlusSymbol(res.value, tla.MakeTLANumber(res.increment)), nil
+}
+
+func (res *raftCounter) WriteValue(value tla.TLAValue) error {
+	res.increment++
+	res.writePending = true
+	return nil
+}
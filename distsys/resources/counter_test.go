@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func makeRaftCounterTest(node *RaftNode) distsys.ArchetypeResource {
+	maker := RaftCounterMaker(node, "ids")
+	res := maker.Make()
+	maker.Configure(res)
+	return res
+}
+
+// raftCounterTestRead reads the counter, retrying while it is locked.
+func raftCounterTestRead(t *testing.T, res distsys.ArchetypeResource) tla.TLAValue {
+	t.Helper()
+	var value tla.TLAValue
+	awaitCondition(t, "the counter to be readable", func() bool {
+		var err error
+		value, err = res.ReadValue()
+		if err != nil && !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatal(err)
+		}
+		return err == nil
+	})
+	return value
+}
+
+func raftCounterTestCommit(t *testing.T, res distsys.ArchetypeResource) {
+	t.Helper()
+	if ch := res.PreCommit(); ch != nil {
+		if err := <-ch; err != nil {
+			t.Fatalf("expected the critical section to commit, got %v", err)
+		}
+	}
+	if ch := res.Commit(); ch != nil {
+		<-ch
+	}
+}
+
+func TestRaftCounter(t *testing.T) {
+	nodes, _ := startRaftTestGroup(t, 3, noRaftTestOptions)
+	leader := awaitRaftLeader(t, nodes, 0)
+	a, b := makeRaftCounterTest(nodes[0]), makeRaftCounterTest(nodes[1])
+
+	// a takes an ID, and reads its own increments within the critical section
+	if value := raftCounterTestRead(t, a); !value.Equal(tla.MakeTLANumber(0)) {
+		t.Fatalf("expected the counter to start at 0, read %v", value)
+	}
+	for i := 0; i < 2; i++ {
+		if err := a.WriteValue(tla.TLA_TRUE); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if value := raftCounterTestRead(t, a); !value.Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected to read the critical section's own increments, read %v", value)
+	}
+	raftCounterTestCommit(t, a)
+
+	// a critical section that read the counter conflicts with increments committed since
+	if value := raftCounterTestRead(t, a); !value.Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected 2, read %v", value)
+	}
+	if err := b.WriteValue(tla.TLA_TRUE); err != nil {
+		t.Fatal(err)
+	}
+	raftCounterTestCommit(t, b)
+	if err := a.WriteValue(tla.TLA_TRUE); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-a.PreCommit(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected taking an ID that was taken since to abort, got %v", err)
+	}
+	if ch := a.Abort(); ch != nil {
+		<-ch
+	}
+
+	// while one that only writes does not
+	if err := a.WriteValue(tla.TLA_TRUE); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.WriteValue(tla.TLA_TRUE); err != nil {
+		t.Fatal(err)
+	}
+	raftCounterTestCommit(t, b)
+	raftCounterTestCommit(t, a)
+	if value := raftTestRead(t, leader, a.(*raftCounter).key); !value.Equal(tla.MakeTLANumber(5)) {
+		t.Fatalf("expected both increments to count, read %v", value)
+	}
+}
+
+func TestRaftCounterDoesNotWrap(t *testing.T) {
+	nodes, _ := startRaftTestGroup(t, 3, noRaftTestOptions)
+	leader := awaitRaftLeader(t, nodes, 0)
+	res := makeRaftCounterTest(nodes[0])
+	raftTestWrite(t, leader, res.(*raftCounter).key, tla.MakeTLANumber(math.MaxInt32))
+
+	if err := res.WriteValue(tla.TLA_TRUE); err != nil {
+		t.Fatal(err)
+	}
+	expected := tla.MakeTLABigNumber(big.NewInt(math.MaxInt32 + 1))
+	if value := raftCounterTestRead(t, res); !value.Equal(expected) {
+		t.Fatalf("expected to read %v, read %v", expected, value)
+	}
+	raftCounterTestCommit(t, res)
+	if value := raftTestRead(t, leader, res.(*raftCounter).key); !value.Equal(expected) {
+		t.Fatalf("expected the counter to be %v, read %v", expected, value)
+	}
+
+	// and keeps counting from there
+	if err := res.WriteValue(tla.TLA_TRUE); err != nil {
+		t.Fatal(err)
+	}
+	raftCounterTestCommit(t, res)
+	expected = tla.MakeTLABigNumber(big.NewInt(math.MaxInt32 + 2))
+	if value := raftCounterTestRead(t, res); !value.Equal(expected) {
+		t.Fatalf("expected to read %v, read %v", expected, value)
+	}
+}
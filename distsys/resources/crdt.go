@@ -0,0 +1,526 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/rpc"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+const (
+	crdtGossipInterval = 1 * time.Second
+	crdtGossipTimeout  = 1 * time.Second
+)
+
+// ErrCRDTInvalidWrite is returned when a value written to a CRDT resource is not a valid state for that CRDT,
+// such as a G-Counter that is decremented.
+var ErrCRDTInvalidWrite = errors.New("invalid write to CRDT resource")
+
+// CRDTKind identifies the state-based CRDT backing a resource made by CRDTMaker or CRDTMapMaker.
+type CRDTKind int
+
+const (
+	// CRDTGCounter is a grow-only counter. It reads as a number, and may only be written a number at least as large.
+	CRDTGCounter CRDTKind = iota
+	// CRDTPNCounter is a counter that may be incremented and decremented. It reads as a number, and writing a
+	// number adds the difference with the number read.
+	CRDTPNCounter
+	// CRDTORSet is an observed-remove set. It reads as a set, and writing a set adds the elements that were not in
+	// the set read, and removes the ones missing from it. Concurrent adds of an element win over removes.
+	CRDTORSet
+	// CRDTLWWRegister is a last-writer-wins register, which holds any value. Concurrent writes are ordered by wall
+	// clock time. It reads as defaultInitValue until it is first written.
+	CRDTLWWRegister
+)
+
+// CRDTState is the state of one CRDT, as exchanged between CRDTNode instances. Which fields are used depends on
+// Kind.
+type CRDTState struct {
+	Kind CRDTKind
+	// Incs and Decs map each replica to the sum of its increments and decrements, for counters
+	Incs, Decs map[string]int32
+	// Elems holds the tagged elements of an OR-Set that have not been removed, and Removed the removed tags
+	Elems   []CRDTSetElem
+	Removed map[string]bool
+	// Value, Time and Replica describe the last write of an LWW-Register, if Time is non-zero
+	Value   tla.TLAValue
+	Time    int64
+	Replica string
+}
+
+// CRDTSetElem is one add of Elem to an OR-Set, uniquely identified by Tag.
+type CRDTSetElem struct {
+	Elem tla.TLAValue
+	Tag  string
+}
+
+func newCRDTState(kind CRDTKind) *CRDTState {
+	return &CRDTState{
+		Kind:    kind,
+		Incs:    make(map[string]int32),
+		Decs:    make(map[string]int32),
+		Removed: make(map[string]bool),
+	}
+}
+
+func (state *CRDTState) read() tla.TLAValue {
+	switch state.Kind {
+	case CRDTGCounter, CRDTPNCounter:
+		var sum int32
+		for _, n := range state.Incs {
+			sum += n
+		}
+		for _, n := range state.Decs {
+			sum -= n
+		}
+		return tla.MakeTLANumber(sum)
+	case CRDTORSet:
+		elems := make([]tla.TLAValue, len(state.Elems))
+		for i, elem := range state.Elems {
+			elems[i] = elem.Elem
+		}
+		return tla.MakeTLASet(elems...)
+	case CRDTLWWRegister:
+		return state.Value
+	default:
+		panic(fmt.Errorf("unknown CRDT kind %d", state.Kind))
+	}
+}
+
+// merge returns the least upper bound of state and other, which must be of the same kind.
+func (state *CRDTState) merge(other *CRDTState) *CRDTState {
+	merged := &CRDTState{Kind: state.Kind}
+	switch state.Kind {
+	case CRDTGCounter, CRDTPNCounter:
+		merged.Incs = mergeCRDTCounts(state.Incs, other.Incs)
+		merged.Decs = mergeCRDTCounts(state.Decs, other.Decs)
+	case CRDTORSet:
+		merged.Removed = make(map[string]bool, len(state.Removed)+len(other.Removed))
+		for tag := range state.Removed {
+			merged.Removed[tag] = true
+		}
+		for tag := range other.Removed {
+			merged.Removed[tag] = true
+		}
+		seen := make(map[string]bool)
+		for _, elems := range [][]CRDTSetElem{state.Elems, other.Elems} {
+			for _, elem := range elems {
+				if !seen[elem.Tag] && !merged.Removed[elem.Tag] {
+					seen[elem.Tag] = true
+					merged.Elems = append(merged.Elems, elem)
+				}
+			}
+		}
+	case CRDTLWWRegister:
+		*merged = *state
+		if other.Time > state.Time || (other.Time == state.Time && other.Replica > state.Replica) {
+			*merged = *other
+		}
+	}
+	return merged
+}
+
+func mergeCRDTCounts(lhs, rhs map[string]int32) map[string]int32 {
+	merged := make(map[string]int32, len(lhs)+len(rhs))
+	for replica, n := range lhs {
+		merged[replica] = n
+	}
+	for replica, n := range rhs {
+		if n > merged[replica] {
+			merged[replica] = n
+		}
+	}
+	return merged
+}
+
+// update returns the state after replica changes the value from observed.read() to value. The change is applied to
+// state, which may have advanced since observed was read. makeTag generates unique OR-Set tags.
+func (state *CRDTState) update(replica string, observed *CRDTState, value tla.TLAValue, makeTag func() string) (*CRDTState, error) {
+	updated := state.merge(newCRDTState(state.Kind)) // a copy, so that state is not modified
+	switch state.Kind {
+	case CRDTGCounter, CRDTPNCounter:
+		num, err := value.TryAsNumber()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCRDTInvalidWrite, err)
+		}
+		delta := num - observed.read().AsNumber()
+		switch {
+		case delta > 0:
+			updated.Incs[replica] += delta
+		case delta < 0 && state.Kind == CRDTGCounter:
+			return nil, fmt.Errorf("%w: G-Counter cannot decrease to %v", ErrCRDTInvalidWrite, value)
+		case delta < 0:
+			updated.Decs[replica] -= delta
+		}
+	case CRDTORSet:
+		if !value.IsSet() {
+			return nil, fmt.Errorf("%w: %v is not a set", ErrCRDTInvalidWrite, value)
+		}
+		newElems := value.AsSet()
+		observedElems := observed.read().AsSet()
+		for _, elem := range observed.Elems {
+			if _, kept := newElems.Get(elem.Elem); !kept {
+				updated.Removed[elem.Tag] = true
+			}
+		}
+		it := newElems.Iterator()
+		for !it.Done() {
+			elem, _ := it.Next()
+			if _, existed := observedElems.Get(elem); !existed {
+				updated.Elems = append(updated.Elems, CRDTSetElem{Elem: elem.(tla.TLAValue), Tag: makeTag()})
+			}
+		}
+		updated = updated.merge(newCRDTState(state.Kind)) // drop the removed elements
+	case CRDTLWWRegister:
+		updated.Value = value
+		updated.Time = time.Now().UnixNano()
+		if updated.Time <= state.Time {
+			updated.Time = state.Time + 1
+		}
+		updated.Replica = replica
+	}
+	return updated, nil
+}
+
+type crdtConfig struct {
+	gossipInterval time.Duration
+}
+
+// CRDTOption configures a CRDTNode.
+type CRDTOption func(cfg *crdtConfig)
+
+// WithCRDTGossipInterval sets how often a node sends its states to one of its peers, chosen at random.
+func WithCRDTGossipInterval(interval time.Duration) CRDTOption {
+	return func(cfg *crdtConfig) {
+		cfg.gossipInterval = interval
+	}
+}
+
+// CRDTNode holds this node's copy of the CRDTs used through CRDTMaker and CRDTMapMaker, and keeps it in sync with
+// its peers by anti-entropy gossip: periodically, it sends all its states to a random peer, which merges them and
+// replies with its own. Writes are applied to the local copy only, so they never wait for, or abort because of,
+// other nodes; all copies converge once gossip has spread every write.
+//
+// States are held in memory. A restarted node starts empty and recovers the other nodes' writes by gossip;
+// it identifies itself differently on each start, so that its new writes do not conflict with its old ones.
+type CRDTNode struct {
+	ListenAddr string
+
+	resourceLogger
+
+	peerAddrs []string
+	replicaID string
+	config    crdtConfig
+	tagCount  int64
+
+	listener net.Listener
+	server   *rpc.Server
+	done     chan struct{}
+	closed   bool
+
+	lock    sync.Mutex
+	states  *immutable.Map // key -> *CRDTState
+	clients map[string]*rpc.Client
+}
+
+// NewCRDTNode creates a node which will listen on listenAddr, and gossip with the nodes at peerAddrs. It does
+// nothing until ListenAndServe is called.
+func NewCRDTNode(listenAddr string, peerAddrs []string, opts ...CRDTOption) *CRDTNode {
+	cfg := crdtConfig{
+		gossipInterval: crdtGossipInterval,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &CRDTNode{
+		ListenAddr: listenAddr,
+		peerAddrs:  peerAddrs,
+		replicaID:  fmt.Sprintf("%s/%x", listenAddr, rand.Int63()),
+		config:     cfg,
+		done:       make(chan struct{}),
+		states:     immutable.NewMap(tla.TLAValueHasher{}),
+		clients:    make(map[string]*rpc.Client),
+	}
+}
+
+// ListenAndServe starts the node's RPC server and its gossip. It blocks until an error occurs or the node closes.
+func (node *CRDTNode) ListenAndServe() error {
+	node.server = rpc.NewServer()
+	err := node.server.Register(&CRDTRPCReceiver{node: node})
+	if err != nil {
+		return err
+	}
+	node.listener, err = net.Listen("tcp", node.ListenAddr)
+	if err != nil {
+		return err
+	}
+	node.log(distsys.LogInfo, "CRDT node started listening", "address", node.ListenAddr)
+	go node.gossipLoop()
+	for {
+		conn, err := node.listener.Accept()
+		if err != nil {
+			select {
+			case <-node.done:
+				return nil
+			default:
+				return err
+			}
+		}
+		go node.server.ServeConn(conn)
+	}
+}
+
+// Close stops the node's RPC server and gossip.
+func (node *CRDTNode) Close() error {
+	var err error
+	node.lock.Lock()
+	if !node.closed {
+		node.closed = true
+		close(node.done)
+	}
+	for _, client := range node.clients {
+		err = client.Close()
+	}
+	node.clients = make(map[string]*rpc.Client)
+	node.lock.Unlock()
+	if node.listener != nil {
+		err = node.listener.Close()
+	}
+	return err
+}
+
+func (node *CRDTNode) makeTag() string {
+	return fmt.Sprintf("%s/%d", node.replicaID, atomic.AddInt64(&node.tagCount, 1))
+}
+
+// get returns the node's state for key, which is empty if the node has not seen it yet.
+func (node *CRDTNode) get(key tla.TLAValue, kind CRDTKind) *CRDTState {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	if state, ok := node.states.Get(key); ok {
+		return state.(*CRDTState)
+	}
+	return newCRDTState(kind)
+}
+
+// mergeLocked merges entries into the node's states. Entries of a kind different from the one already known are
+// dropped, as they come from a misconfigured node.
+func (node *CRDTNode) mergeLocked(entries []CRDTEntry) {
+	for _, entry := range entries {
+		state := entry.State
+		if existing, ok := node.states.Get(entry.Key); ok {
+			if existing.(*CRDTState).Kind != state.Kind {
+				node.log(distsys.LogWarn, "ignoring CRDT state of a different kind", "key", entry.Key, "kind", state.Kind, "expectedKind", existing.(*CRDTState).Kind)
+				continue
+			}
+			state = existing.(*CRDTState).merge(state)
+		}
+		node.states = node.states.Set(entry.Key, state)
+	}
+}
+
+func (node *CRDTNode) entriesLocked() []CRDTEntry {
+	entries := make([]CRDTEntry, 0, node.states.Len())
+	it := node.states.Iterator()
+	for !it.Done() {
+		key, state := it.Next()
+		entries = append(entries, CRDTEntry{Key: key.(tla.TLAValue), State: state.(*CRDTState)})
+	}
+	return entries
+}
+
+func (node *CRDTNode) gossipLoop() {
+	ticker := time.NewTicker(node.config.gossipInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-node.done:
+			return
+		case <-ticker.C:
+		}
+		if len(node.peerAddrs) == 0 {
+			continue
+		}
+		peer := node.peerAddrs[rand.Intn(len(node.peerAddrs))]
+		err := node.gossip(peer)
+		if err != nil {
+			node.log(distsys.LogWarn, "could not gossip with CRDT peer", "peer", peer, "error", err)
+		}
+	}
+}
+
+func (node *CRDTNode) gossip(peer string) error {
+	node.lock.Lock()
+	args := CRDTGossipArgs{Entries: node.entriesLocked()}
+	client, ok := node.clients[peer]
+	node.lock.Unlock()
+	if !ok {
+		conn, err := net.DialTimeout("tcp", peer, crdtGossipTimeout)
+		if err != nil {
+			return err
+		}
+		client = rpc.NewClient(conn)
+		node.lock.Lock()
+		node.clients[peer] = client
+		node.lock.Unlock()
+	}
+
+	var reply CRDTGossipReply
+	call := client.Go("CRDTRPCReceiver.Gossip", &args, &reply, nil)
+	var err error
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-time.After(crdtGossipTimeout):
+		err = fmt.Errorf("gossip timed out")
+	}
+	if err != nil {
+		node.lock.Lock()
+		if node.clients[peer] == client {
+			delete(node.clients, peer)
+		}
+		node.lock.Unlock()
+		_ = client.Close()
+		return err
+	}
+	node.lock.Lock()
+	node.mergeLocked(reply.Entries)
+	node.lock.Unlock()
+	return nil
+}
+
+type CRDTRPCReceiver struct {
+	node *CRDTNode
+}
+
+// CRDTEntry is the state of the CRDT at Key.
+type CRDTEntry struct {
+	Key   tla.TLAValue
+	State *CRDTState
+}
+
+type CRDTGossipArgs struct {
+	Entries []CRDTEntry
+}
+
+type CRDTGossipReply struct {
+	Entries []CRDTEntry
+}
+
+// Gossip merges the sender's states, and replies with the merged states.
+func (rcvr *CRDTRPCReceiver) Gossip(args CRDTGossipArgs, reply *CRDTGossipReply) error {
+	node := rcvr.node
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	node.mergeLocked(args.Entries)
+	reply.Entries = node.entriesLocked()
+	return nil
+}
+
+// CRDTMaker produces a distsys.ArchetypeResourceMaker for a CRDT of the given kind, shared by every archetype
+// using a resource with the same name on any node gossiping with node. Within a critical section, the first read
+// or write observes node's current copy. Writes are applied to node's copy when the critical section commits, as
+// a change relative to the value observed, so that concurrent changes, local or remote, are merged rather than
+// overwritten. Critical sections never abort because of a CRDT resource.
+func CRDTMaker(node *CRDTNode, name string, kind CRDTKind) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		return &crdtResource{
+			node: node,
+			key:  tla.MakeTLATuple(tla.MakeTLAString(name)),
+			kind: kind,
+		}
+	})
+}
+
+// CRDTMapMaker is like CRDTMaker, but for a map of CRDTs of the given kind.
+func CRDTMapMaker(node *CRDTNode, name string, kind CRDTKind) distsys.ArchetypeResourceMaker {
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+			return &crdtResource{
+				node: node,
+				key:  tla.MakeTLATuple(tla.MakeTLAString(name), index),
+				kind: kind,
+			}
+		})
+	})
+}
+
+type crdtResource struct {
+	distsys.ArchetypeResourceLeafMixin
+	node *CRDTNode
+	key  tla.TLAValue
+	kind CRDTKind
+
+	observed     *CRDTState
+	value        tla.TLAValue
+	writePending bool
+}
+
+var _ distsys.ArchetypeResource = &crdtResource{}
+
+func (res *crdtResource) observe() {
+	if res.observed == nil {
+		res.observed = res.node.get(res.key, res.kind)
+	}
+}
+
+func (res *crdtResource) Abort() chan struct{} {
+	res.observed = nil
+	res.value = tla.TLAValue{}
+	res.writePending = false
+	return nil
+}
+
+func (res *crdtResource) PreCommit() chan error {
+	return nil
+}
+
+func (res *crdtResource) Commit() chan struct{} {
+	if res.writePending {
+		node := res.node
+		node.lock.Lock()
+		current := res.observed
+		if state, ok := node.states.Get(res.key); ok {
+			current = state.(*CRDTState)
+		}
+		updated, err := current.update(node.replicaID, res.observed, res.value, node.makeTag)
+		if err != nil {
+			// WriteValue already checked the write against the observed state, which has the same kind
+			panic(err)
+		}
+		node.states = node.states.Set(res.key, updated)
+		node.lock.Unlock()
+	}
+	return res.Abort()
+}
+
+func (res *crdtResource) ReadValue() (tla.TLAValue, error) {
+	if res.writePending {
+		return res.value, nil
+	}
+	res.observe()
+	return res.observed.read(), nil
+}
+
+func (res *crdtResource) WriteValue(value tla.TLAValue) error {
+	res.observe()
+	_, err := res.observed.update(res.node.replicaID, res.observed, value, func() string { return "" })
+	if err != nil {
+		return err
+	}
+	res.value = value
+	res.writePending = true
+	return nil
+}
+
+func (res *crdtResource) Close() error {
+	return nil
+}
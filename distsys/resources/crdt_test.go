@@ -0,0 +1,210 @@
+package resources
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+var crdtTestKinds = []struct {
+	name string
+	kind CRDTKind
+}{
+	{"G-Counter", CRDTGCounter},
+	{"PN-Counter", CRDTPNCounter},
+	{"OR-Set", CRDTORSet},
+	{"LWW-Register", CRDTLWWRegister},
+}
+
+// randomCRDTStates returns the states of count replicas of a CRDT of the given kind, after a random history of
+// updates at each replica and merges between them.
+func randomCRDTStates(rng *rand.Rand, kind CRDTKind, count int) []*CRDTState {
+	states := make([]*CRDTState, count)
+	for i := range states {
+		states[i] = newCRDTState(kind)
+	}
+	tags := 0
+	makeTag := func() string {
+		tags++
+		return fmt.Sprintf("tag-%d", tags)
+	}
+	for step := 0; step < 20; step++ {
+		i := rng.Intn(count)
+		if rng.Intn(3) == 0 {
+			states[i] = states[i].merge(states[rng.Intn(count)])
+			continue
+		}
+		var value tla.TLAValue
+		switch kind {
+		case CRDTGCounter:
+			value = tla.MakeTLANumber(states[i].read().AsNumber() + int32(rng.Intn(5)))
+		case CRDTPNCounter:
+			value = tla.MakeTLANumber(states[i].read().AsNumber() + int32(rng.Intn(9)) - 4)
+		case CRDTORSet:
+			set := states[i].read().AsSet()
+			elem := tla.MakeTLANumber(int32(rng.Intn(4)))
+			if _, ok := set.Get(elem); ok {
+				set = set.Delete(elem)
+			} else {
+				set = set.Set(elem, true)
+			}
+			value = tla.MakeTLASetFromMap(set)
+		case CRDTLWWRegister:
+			value = tla.MakeTLANumber(int32(rng.Intn(100)))
+		}
+		updated, err := states[i].update(fmt.Sprintf("replica-%d", i), states[i], value, makeTag)
+		if err != nil {
+			panic(err)
+		}
+		states[i] = updated
+	}
+	return states
+}
+
+// crdtStatesEqual returns whether lhs and rhs are the same state, ignoring the order of OR-Set elements and
+// counters that are absent rather than zero.
+func crdtStatesEqual(lhs, rhs *CRDTState) bool {
+	if lhs.Kind != rhs.Kind {
+		return false
+	}
+	countsEqual := func(lhs, rhs map[string]int32) bool {
+		for replica, n := range lhs {
+			if rhs[replica] != n {
+				return false
+			}
+		}
+		for replica, n := range rhs {
+			if lhs[replica] != n {
+				return false
+			}
+		}
+		return true
+	}
+	switch lhs.Kind {
+	case CRDTGCounter, CRDTPNCounter:
+		return countsEqual(lhs.Incs, rhs.Incs) && countsEqual(lhs.Decs, rhs.Decs)
+	case CRDTORSet:
+		if len(lhs.Elems) != len(rhs.Elems) || len(lhs.Removed) != len(rhs.Removed) {
+			return false
+		}
+		elems := make(map[string]tla.TLAValue, len(lhs.Elems))
+		for _, elem := range lhs.Elems {
+			elems[elem.Tag] = elem.Elem
+		}
+		for _, elem := range rhs.Elems {
+			if lhsElem, ok := elems[elem.Tag]; !ok || !lhsElem.Equal(elem.Elem) {
+				return false
+			}
+		}
+		for tag := range lhs.Removed {
+			if !rhs.Removed[tag] {
+				return false
+			}
+		}
+		return true
+	case CRDTLWWRegister:
+		return lhs.Time == rhs.Time && lhs.Replica == rhs.Replica && (lhs.Time == 0 || lhs.Value.Equal(rhs.Value))
+	default:
+		panic(fmt.Errorf("unknown CRDT kind %d", lhs.Kind))
+	}
+}
+
+func TestCRDTMerge(t *testing.T) {
+	for _, kind := range crdtTestKinds {
+		kind := kind
+		t.Run(kind.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(0))
+			for round := 0; round < 200; round++ {
+				states := randomCRDTStates(rng, kind.kind, 3)
+				a, b, c := states[0], states[1], states[2]
+				if ab, ba := a.merge(b), b.merge(a); !crdtStatesEqual(ab, ba) {
+					t.Fatalf("merge is not commutative: %+v merged with %+v is %+v one way, and %+v the other", a, b, ab, ba)
+				}
+				if aa := a.merge(a); !crdtStatesEqual(aa, a) {
+					t.Fatalf("merge is not idempotent: %+v merged with itself is %+v", a, aa)
+				}
+				if left, right := a.merge(b).merge(c), a.merge(b.merge(c)); !crdtStatesEqual(left, right) {
+					t.Fatalf("merge is not associative: (a ⊔ b) ⊔ c is %+v, but a ⊔ (b ⊔ c) is %+v", left, right)
+				}
+				// merging in the other's state must not lose anything: it is an upper bound
+				if ab := a.merge(b); !crdtStatesEqual(ab.merge(a), ab) || !crdtStatesEqual(ab.merge(b), ab) {
+					t.Fatalf("%+v is not an upper bound of %+v and %+v", ab, a, b)
+				}
+				// merge must not modify its arguments
+				before := a.merge(newCRDTState(kind.kind))
+				_ = a.merge(b)
+				if !crdtStatesEqual(a, before) {
+					t.Fatalf("merge modified its receiver, from %+v to %+v", before, a)
+				}
+			}
+		})
+	}
+}
+
+func TestCRDTConcurrentUpdates(t *testing.T) {
+	noTags := func() string {
+		panic("unexpected tag")
+	}
+	update := func(t *testing.T, state *CRDTState, replica string, value tla.TLAValue, makeTag func() string) *CRDTState {
+		t.Helper()
+		updated, err := state.update(replica, state, value, makeTag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return updated
+	}
+
+	t.Run("PN-Counter", func(t *testing.T) {
+		initial := update(t, newCRDTState(CRDTPNCounter), "a", tla.MakeTLANumber(10), noTags)
+		a := update(t, initial, "a", tla.MakeTLANumber(13), noTags)
+		b := update(t, initial, "b", tla.MakeTLANumber(8), noTags)
+		// both changes count: 10 + 3 - 2
+		if value := a.merge(b).read(); !value.Equal(tla.MakeTLANumber(11)) {
+			t.Fatalf("expected 11, got %v", value)
+		}
+	})
+
+	t.Run("G-Counter", func(t *testing.T) {
+		state := update(t, newCRDTState(CRDTGCounter), "a", tla.MakeTLANumber(2), noTags)
+		if _, err := state.update("a", state, tla.MakeTLANumber(1), noTags); err == nil {
+			t.Fatal("expected decrementing a G-Counter to fail")
+		}
+	})
+
+	t.Run("OR-Set", func(t *testing.T) {
+		tags := 0
+		makeTag := func() string {
+			tags++
+			return fmt.Sprint(tags)
+		}
+		one, two := tla.MakeTLANumber(1), tla.MakeTLANumber(2)
+		// a adds then removes 1 while b, which has not seen a's add, adds 1 and 2: b's add wins
+		a := update(t, newCRDTState(CRDTORSet), "a", tla.MakeTLASet(one), makeTag)
+		a = update(t, a, "a", tla.MakeTLASet(), makeTag)
+		b := update(t, newCRDTState(CRDTORSet), "b", tla.MakeTLASet(one, two), makeTag)
+		if value := a.merge(b).read(); !value.Equal(tla.MakeTLASet(one, two)) {
+			t.Fatalf("expected {1, 2}, got %v", value)
+		}
+		// but a remove of what was observed sticks
+		a = update(t, a.merge(b), "a", tla.MakeTLASet(two), makeTag)
+		if value := a.merge(b).read(); !value.Equal(tla.MakeTLASet(two)) {
+			t.Fatalf("expected {2}, got %v", value)
+		}
+	})
+
+	t.Run("LWW-Register", func(t *testing.T) {
+		initial := newCRDTState(CRDTLWWRegister)
+		a := update(t, initial, "a", tla.MakeTLAString("a"), noTags)
+		b := update(t, a, "b", tla.MakeTLAString("b"), noTags)
+		if value := a.merge(b).read(); !value.Equal(tla.MakeTLAString("b")) {
+			t.Fatalf("expected the later write to win, got %v", value)
+		}
+		// concurrent writes with the same timestamp are ordered by replica, the same way at every replica
+		c := &CRDTState{Kind: CRDTLWWRegister, Value: tla.MakeTLAString("c"), Time: b.Time, Replica: "c"}
+		if lhs, rhs := b.merge(c).read(), c.merge(b).read(); !lhs.Equal(tla.MakeTLAString("c")) || !rhs.Equal(lhs) {
+			t.Fatalf("expected the tie to go to c both ways, got %v and %v", lhs, rhs)
+		}
+	})
+}
@@ -0,0 +1,294 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ChangeEvent records one committed write to a resource made by EventSourcedMaker.
+type ChangeEvent struct {
+	// Seq numbers the events of an EventLog, starting from 0, without gaps
+	Seq uint64
+	// Name is the name given to EventSourcedMaker, and Indices the path to the written value within the resource
+	Name    string
+	Indices []tla.TLAValue
+	Value   tla.TLAValue
+	Time    time.Time
+}
+
+// EventLog is an append-only log of ChangeEvent, backed by a file so that it survives process restarts. Every
+// critical section's events are synced to disk as one batch, before the critical section completes. The whole
+// log is also kept in memory, so that subscribers can replay it.
+type EventLog struct {
+	path string
+
+	lock   sync.Mutex
+	file   *os.File
+	events []ChangeEvent
+	// changed is closed and replaced whenever events are appended
+	changed chan struct{}
+}
+
+// OpenEventLog opens the event log at path, creating it if needed. A partially written batch at the end of the
+// log, left by a crash, is discarded. Any other damaged batch fails with distsys.ErrCorruptLogRecord, as events cannot be
+// skipped.
+func OpenEventLog(path string) (*EventLog, error) {
+	l := &EventLog{
+		path:    path,
+		changed: make(chan struct{}),
+	}
+	var err error
+	l.file, err = distsys.OpenLogFile(path, l.replay)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// replay recovers the events held by the log.
+func (l *EventLog) replay(r *distsys.LogRecordReader) error {
+	for {
+		var batch []ChangeEvent
+		err := r.Next(&batch)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, event := range batch {
+			if event.Seq != uint64(len(l.events)) {
+				return fmt.Errorf("corrupt event log %s: expected event %d, found %d", l.path, len(l.events), event.Seq)
+			}
+			l.events = append(l.events, event)
+		}
+	}
+}
+
+// append numbers events, and durably logs them as one batch.
+func (l *EventLog) append(events []ChangeEvent) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for i := range events {
+		events[i].Seq = uint64(len(l.events) + i)
+	}
+	err := distsys.WriteLogRecord(l.file, events)
+	if err == nil {
+		err = l.file.Sync()
+	}
+	if err != nil {
+		return err
+	}
+	l.events = append(l.events, events...)
+	close(l.changed)
+	l.changed = make(chan struct{})
+	return nil
+}
+
+// Len returns the number of events logged so far, which is also the Seq of the next event.
+func (l *EventLog) Len() uint64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return uint64(len(l.events))
+}
+
+// Subscribe returns a channel yielding every event from sequence number from onwards, in order: first those
+// already logged, then new ones as they are committed. Subscribing from Len() only yields new events. The channel
+// is closed once ctx is done. Slow subscribers do not hold up commits; they fall behind, and catch up from the log.
+func (l *EventLog) Subscribe(ctx context.Context, from uint64) <-chan ChangeEvent {
+	ch := make(chan ChangeEvent)
+	go func() {
+		defer close(ch)
+		next := from
+		for {
+			l.lock.Lock()
+			pending := l.events[min64(next, uint64(len(l.events))):]
+			changed := l.changed
+			l.lock.Unlock()
+
+			for _, event := range pending {
+				select {
+				case ch <- event:
+					next = event.Seq + 1
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-changed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func min64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (l *EventLog) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.file.Close()
+}
+
+// EventSourcedMaker wraps the resource made by underlying, which may be value-like or map-like, so that every
+// committed write to it is also appended to log, as a ChangeEvent tagged with name and the indices written. A
+// critical section's events are logged as one batch after the underlying resource commits, and, within it, only
+// the last value written at each index is recorded. External Go code can follow changes with EventLog.Subscribe.
+func EventSourcedMaker(log *EventLog, name string, underlying distsys.ArchetypeResourceMaker) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &eventSourcedResource{
+				underlying: underlying.Make(),
+			}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*eventSourcedResource)
+			r.log = log
+			r.name = name
+			underlying.Configure(r.underlying)
+		},
+	}
+}
+
+type eventSourcedResource struct {
+	log        *EventLog
+	name       string
+	underlying distsys.ArchetypeResource
+	// root is the resource the ctx knows about, which collects the writes made through its sub-resources; it is
+	// nil for the root itself
+	root    *eventSourcedResource
+	indices []tla.TLAValue
+
+	// pending holds the root's writes in the current critical section, in order
+	pending []ChangeEvent
+}
+
+var _ distsys.ArchetypeResource = &eventSourcedResource{}
+
+func (res *eventSourcedResource) Abort() chan struct{} {
+	if res.root != nil {
+		return nil
+	}
+	res.pending = nil
+	return res.underlying.Abort()
+}
+
+func (res *eventSourcedResource) PreCommit() chan error {
+	if res.root != nil {
+		return nil
+	}
+	return res.underlying.PreCommit()
+}
+
+func (res *eventSourcedResource) Commit() chan struct{} {
+	if res.root != nil {
+		return nil
+	}
+	events := res.latestWrites()
+	res.pending = nil
+	record := func() {
+		if len(events) == 0 {
+			return
+		}
+		err := res.log.append(events)
+		if err != nil {
+			panic(fmt.Errorf("could not append to event log %s: %w", res.log.path, err))
+		}
+	}
+
+	ch := res.underlying.Commit()
+	if ch == nil {
+		record()
+		return nil
+	}
+	doneCh := make(chan struct{}, 1)
+	go func() {
+		<-ch
+		record()
+		doneCh <- struct{}{}
+	}()
+	return doneCh
+}
+
+// latestWrites returns the pending writes, keeping only the last one at each path.
+func (res *eventSourcedResource) latestWrites() []ChangeEvent {
+	var events []ChangeEvent
+	for i, event := range res.pending {
+		path := tla.MakeTLATuple(event.Indices...)
+		overwritten := false
+		for _, later := range res.pending[i+1:] {
+			if tla.MakeTLATuple(later.Indices...).Equal(path) {
+				overwritten = true
+				break
+			}
+		}
+		if !overwritten {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (res *eventSourcedResource) ReadValue() (tla.TLAValue, error) {
+	return res.underlying.ReadValue()
+}
+
+func (res *eventSourcedResource) WriteValue(value tla.TLAValue) error {
+	err := res.underlying.WriteValue(value)
+	if err != nil {
+		return err
+	}
+	root := res
+	if res.root != nil {
+		root = res.root
+	}
+	root.pending = append(root.pending, ChangeEvent{
+		Name:    root.name,
+		Indices: res.indices,
+		Value:   value,
+		Time:    time.Now(),
+	})
+	return nil
+}
+
+func (res *eventSourcedResource) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	sub, err := res.underlying.Index(index)
+	if err != nil {
+		return nil, err
+	}
+	root := res
+	if res.root != nil {
+		root = res.root
+	}
+	indices := make([]tla.TLAValue, len(res.indices)+1)
+	copy(indices, res.indices)
+	indices[len(res.indices)] = index
+	return &eventSourcedResource{
+		log:        res.log,
+		name:       res.name,
+		underlying: sub,
+		root:       root,
+		indices:    indices,
+	}, nil
+}
+
+func (res *eventSourcedResource) Close() error {
+	if res.root != nil {
+		return nil
+	}
+	return res.underlying.Close()
+}
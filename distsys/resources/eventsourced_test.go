@@ -0,0 +1,104 @@
+package resources
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func openEventLogTest(t *testing.T, path string) *EventLog {
+	t.Helper()
+	l, err := OpenEventLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = l.Close()
+	})
+	return l
+}
+
+func makeEventSourcedTestResource(l *EventLog, name string, underlying distsys.ArchetypeResourceMaker) distsys.ArchetypeResource {
+	maker := EventSourcedMaker(l, name, underlying)
+	res := maker.Make()
+	maker.Configure(res)
+	return res
+}
+
+// expectEventLogTestEvents receives an event from ch for each of expected, expecting them to be numbered from
+// from, and to match the name, indices and value of expected, in order.
+func expectEventLogTestEvents(t *testing.T, ch <-chan ChangeEvent, from uint64, expected ...ChangeEvent) {
+	t.Helper()
+	for i, expected := range expected {
+		var event ChangeEvent
+		select {
+		case event = <-ch:
+		case <-time.After(time.Second):
+			t.Fatalf("expected event %v, but received none", expected)
+		}
+		path, expectedPath := tla.MakeTLATuple(event.Indices...), tla.MakeTLATuple(expected.Indices...)
+		if event.Seq != from+uint64(i) || event.Name != expected.Name || !path.Equal(expectedPath) || !event.Value.Equal(expected.Value) {
+			t.Fatalf("expected event %d to be %s%v := %v, got event %d, %s%v := %v", from+uint64(i), expected.Name,
+				expectedPath, expected.Value, event.Seq, event.Name, path, event.Value)
+		}
+	}
+}
+
+func TestEventSourced(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events")
+	l := openEventLogTest(t, path)
+	value := makeEventSourcedTestResource(l, "value", distsys.LocalArchetypeResourceMaker(tla.MakeTLANumber(0)))
+	table := makeEventSourcedTestResource(l, "table", IncrementalMapMaker(func(tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.LocalArchetypeResourceMaker(tla.MakeTLANumber(0))
+	}))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := l.Subscribe(ctx, 0)
+
+	// an aborted critical section logs nothing, and a committed one only its last write at each index
+	if err := value.WriteValue(tla.MakeTLANumber(1)); err != nil {
+		t.Fatal(err)
+	}
+	mailboxesTestAbort(value)
+	for _, n := range []int32{2, 3} {
+		if err := value.WriteValue(tla.MakeTLANumber(n)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mailboxesTestCommit(t, value)
+	for _, index := range []int32{1, 2, 1} {
+		if err := mailboxesTestIndex(t, table, index).WriteValue(tla.MakeTLANumber(10 * index)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mailboxesTestCommit(t, table)
+	expectEventLogTestEvents(t, events, 0,
+		ChangeEvent{Name: "value", Value: tla.MakeTLANumber(3)},
+		ChangeEvent{Name: "table", Indices: []tla.TLAValue{tla.MakeTLANumber(2)}, Value: tla.MakeTLANumber(20)},
+		ChangeEvent{Name: "table", Indices: []tla.TLAValue{tla.MakeTLANumber(1)}, Value: tla.MakeTLANumber(10)})
+	if l.Len() != 3 {
+		t.Fatalf("expected 3 events to be logged, got %d", l.Len())
+	}
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// after a restart, the log replays the same events, and numbers new ones after them
+	cancel()
+	l = openEventLogTest(t, path)
+	value = makeEventSourcedTestResource(l, "value", distsys.LocalArchetypeResourceMaker(tla.MakeTLANumber(0)))
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	events = l.Subscribe(ctx, 2)
+	if err := value.WriteValue(tla.MakeTLANumber(4)); err != nil {
+		t.Fatal(err)
+	}
+	mailboxesTestCommit(t, value)
+	expectEventLogTestEvents(t, events, 2,
+		ChangeEvent{Name: "table", Indices: []tla.TLAValue{tla.MakeTLANumber(1)}, Value: tla.MakeTLANumber(10)},
+		ChangeEvent{Name: "value", Value: tla.MakeTLANumber(4)})
+}
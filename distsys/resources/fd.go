@@ -3,7 +3,6 @@ package resources
 import (
 	"errors"
 	"fmt"
-	"log"
 	"net"
 	"net/rpc"
 	"runtime/debug"
@@ -18,6 +17,11 @@ import (
 const (
 	failureDetectorTimeout      = 1 * time.Second
 	failureDetectorPullInterval = 2 * time.Second
+	monitorReplicationInterval  = 1 * time.Second
+	monitorReplicationLeases    = 3 // replicated states expire after this many missed replication intervals
+	// monitorReplicaRetention is how long a replicated state is reported as failed once it has expired, before the
+	// monitor forgets it, and reports the archetype as unknown instead, which detectors also take as failed
+	monitorReplicaRetention = 5 * time.Minute
 )
 
 // ArchetypeState is an enum that denotes an archetype running state.
@@ -48,6 +52,20 @@ func (a ArchetypeState) String() string {
 	}
 }
 
+// MarshalText encodes the state as its name, e.g. in the JSON served by Monitor.ServeHTTP.
+func (a ArchetypeState) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// Exported names of the archetype states, for users of Monitor.Statuses.
+const (
+	ArchetypeUninitialized = uninitialized
+	ArchetypeAlive         = alive
+	ArchetypeFailed        = failed
+	ArchetypeFinished      = finished
+	ArchetypeUnknown       = unknown
+)
+
 // Monitor monitors the registered archetypes by wrapping them. Monitor provides
 // the IsAlive API, which can be queried to find out whether a specific
 // archetype is alive. At most one monitor should be defined in each OS process,
@@ -61,35 +79,295 @@ type Monitor struct {
 
 	listener net.Listener
 	server   *rpc.Server
+	security monitorSecurity
 
 	done chan struct{}
 
+	peers               []string
+	replicationInterval time.Duration
+
+	persistPath  string
+	persistGrace time.Duration
+	persistLock  sync.Mutex
+
+	metrics MonitorMetrics
+	logger  MonitorLogger
+	nodeID  string
+	clock   Clock
+
 	lock   sync.RWMutex
 	states map[tla.TLAValue]ArchetypeState
+	// times records when each of this monitor's own archetypes registered, and when its state last changed
+	times map[tla.TLAValue]monitorTimes
+	// replicas holds states replicated from peer monitors, which are only trusted until they expire
+	replicas map[tla.TLAValue]monitorReplicatedState
+	// overrides holds states forced by MarkFailed and MarkAlive, which take precedence over all others
+	overrides map[tla.TLAValue]monitorOverride
+	// changed is closed and replaced whenever any archetype's state changes, waking up pending heartbeats
+	changed chan struct{}
+	// subscribers holds the heartbeat receivers this monitor pushes to, by address; see WithFailureDetectorPushMode
+	subscribers map[string]*monitorSubscriber
+	// conns holds the connections this monitor is serving, which are closed along with it
+	conns map[net.Conn]struct{}
+}
+
+type monitorOverride struct {
+	state ArchetypeState
+	at    time.Time
+}
+
+type monitorTimes struct {
+	registeredAt, changedAt time.Time
+}
+
+type monitorReplicatedState struct {
+	state     ArchetypeState
+	expiry    time.Time
+	changedAt time.Time
+}
+
+// MonitorOption configures a Monitor.
+type MonitorOption func(m *Monitor)
+
+// WithMonitorPeers makes the monitor part of a replicated group with the monitors at peerAddrs. Every interval, the
+// monitor sends the states of the archetypes it runs to each peer, and each peer answers queries about those
+// archetypes as well as its own. A replicated state is trusted for a few intervals; if the replicating monitor
+// stops sending it, e.g. because its process crashed, the archetypes are reported as failed.
+//
+// Failure detectors can then be given the addresses of several monitors in the group, using
+// WithFailureDetectorFallbackMonitors, so that losing one monitor does not blind them.
+func WithMonitorPeers(interval time.Duration, peerAddrs ...string) MonitorOption {
+	return func(m *Monitor) {
+		m.replicationInterval = interval
+		m.peers = append(m.peers, peerAddrs...)
+	}
+}
+
+// WithMonitorClock makes the monitor use clock, rather than SystemClock, to time state changes, replicated state
+// expiry and heartbeats. With a ControlledClock, tests can e.g. freeze the monitor's clock to keep replicated states
+// from expiring, or advance it to make them expire right away.
+func WithMonitorClock(clock Clock) MonitorOption {
+	return func(m *Monitor) {
+		m.clock = clock
+	}
 }
 
 // NewMonitor creates a new Monitor and returns a pointer to it.
-func NewMonitor(listenAddr string) *Monitor {
-	return &Monitor{
-		ListenAddr: listenAddr,
-		states:     make(map[tla.TLAValue]ArchetypeState),
-		done:       make(chan struct{}),
+func NewMonitor(listenAddr string, opts ...MonitorOption) *Monitor {
+	m := &Monitor{
+		ListenAddr:          listenAddr,
+		replicationInterval: monitorReplicationInterval,
+		states:              make(map[tla.TLAValue]ArchetypeState),
+		times:               make(map[tla.TLAValue]monitorTimes),
+		replicas:            make(map[tla.TLAValue]monitorReplicatedState),
+		overrides:           make(map[tla.TLAValue]monitorOverride),
+		done:                make(chan struct{}),
+		changed:             make(chan struct{}),
+		subscribers:         make(map[string]*monitorSubscriber),
+		conns:               make(map[net.Conn]struct{}),
+		logger:              stdMonitorLogger{},
+		clock:               SystemClock,
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if m.persistPath != "" {
+		m.restore()
+	}
+	return m
 }
 
 func (m *Monitor) setState(archetypeID tla.TLAValue, state ArchetypeState) {
 	m.lock.Lock()
+	oldState, registered := m.states[archetypeID]
+	changed := !registered || oldState != state
+	if changed {
+		m.notifyLocked()
+
+		now := m.clock.Now()
+		times, ok := m.times[archetypeID]
+		if !ok {
+			times.registeredAt = now
+		}
+		times.changedAt = now
+		m.times[archetypeID] = times
+	}
 	m.states[archetypeID] = state
+	// the archetype runs here now, so a state replicated from a peer, or restored, no longer applies
+	delete(m.replicas, archetypeID)
+	m.pruneReplicasLocked()
 	m.lock.Unlock()
+	if !changed {
+		return
+	}
+
+	m.log("archetype state changed", "archetype", archetypeID, "old", oldState, "new", state)
+	if m.metrics != nil {
+		if !registered {
+			m.metrics.ArchetypeRegistered(archetypeID)
+		}
+		if state == failed {
+			m.metrics.ArchetypeFailed(archetypeID)
+		}
+	}
+	if m.persistPath != "" {
+		m.persist()
+	}
+}
+
+// notifyLocked wakes up everything waiting on a state change.
+func (m *Monitor) notifyLocked() {
+	close(m.changed)
+	m.changed = make(chan struct{})
+}
+
+// MarkFailed forces the monitor to report the archetype as failed, whatever its actual state, until ClearMark is
+// called. The archetype itself keeps running. This lets tests and chaos tools control what failure detectors see,
+// without actually killing processes.
+func (m *Monitor) MarkFailed(archetypeID tla.TLAValue) {
+	m.mark(archetypeID, failed)
+}
+
+// MarkAlive forces the monitor to report the archetype as alive, whatever its actual state, until ClearMark is
+// called. The archetype does not need to be running, or even known to the monitor.
+func (m *Monitor) MarkAlive(archetypeID tla.TLAValue) {
+	m.mark(archetypeID, alive)
+}
+
+// ClearMark undoes MarkFailed or MarkAlive, so the monitor reports the archetype's actual state again.
+func (m *Monitor) ClearMark(archetypeID tla.TLAValue) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.overrides[archetypeID]; ok {
+		delete(m.overrides, archetypeID)
+		m.notifyLocked()
+	}
+}
+
+func (m *Monitor) mark(archetypeID tla.TLAValue, state ArchetypeState) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.overrides[archetypeID] = monitorOverride{state: state, at: m.clock.Now()}
+	m.notifyLocked()
+}
+
+// getStateLocked looks up the state of an archetype, preferring forced states, then this monitor's own archetypes,
+// then replicated ones.
+func (m *Monitor) getStateLocked(archetypeID tla.TLAValue) (ArchetypeState, bool) {
+	if override, ok := m.overrides[archetypeID]; ok {
+		return override.state, true
+	}
+	if state, ok := m.states[archetypeID]; ok {
+		return state, ok
+	}
+	if replica, ok := m.replicas[archetypeID]; ok {
+		if m.clock.Now().After(replica.expiry) {
+			return failed, true
+		}
+		return replica.state, true
+	}
+	return uninitialized, false
 }
 
 func (m *Monitor) getState(archetypeID tla.TLAValue) (ArchetypeState, bool) {
 	m.lock.RLock()
-	state, ok := m.states[archetypeID]
+	state, ok := m.getStateLocked(archetypeID)
 	m.lock.RUnlock()
 	return state, ok
 }
 
+// watchState is like getState, but also returns a channel that will be closed on the next state change.
+func (m *Monitor) watchState(archetypeID tla.TLAValue) (ArchetypeState, bool, <-chan struct{}) {
+	m.lock.RLock()
+	state, ok := m.getStateLocked(archetypeID)
+	changed := m.changed
+	m.lock.RUnlock()
+	return state, ok, changed
+}
+
+// replicate sends the states of this monitor's own archetypes to its peers, every replication interval. Each peer
+// is sent to by a goroutine of its own, so that however many peers are slow or unreachable, the others are still
+// sent to every interval, and do not see their leases expire.
+func (m *Monitor) replicate() {
+	for _, peer := range m.peers {
+		go m.replicateTo(peer)
+	}
+}
+
+// replicateTo sends the states of this monitor's own archetypes to peer, every replication interval. Each round,
+// dialling included, is bounded by the interval, so that it does not delay the next one.
+func (m *Monitor) replicateTo(peer string) {
+	ticker := time.NewTicker(m.replicationInterval)
+	defer ticker.Stop()
+	var client *rpc.Client
+	closeClient := func() {
+		if client != nil {
+			_ = client.Close()
+			client = nil
+		}
+	}
+	defer closeClient()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+		}
+
+		deadline := time.Now().Add(m.replicationInterval)
+		if client == nil {
+			conn, err := m.security.dial(peer, m.replicationInterval)
+			if err != nil {
+				m.rpcError("Replicate")
+				continue
+			}
+			client = rpc.NewClient(conn)
+		}
+		call := client.Go("MonitorRPCReceiver.Replicate", m.replicateArgs(), new(bool), nil)
+		timer := time.NewTimer(time.Until(deadline))
+		select {
+		case <-call.Done:
+			if call.Error != nil {
+				m.log("could not replicate to peer", "peer", peer, "error", call.Error)
+				m.rpcError("Replicate")
+				closeClient()
+			}
+		case <-timer.C:
+			m.log("timed out replicating to peer", "peer", peer)
+			m.rpcError("Replicate")
+			closeClient()
+		case <-m.done:
+		}
+		timer.Stop()
+	}
+}
+
+// replicateArgs collects the states of this monitor's own archetypes, including forced ones, to send to its peers.
+func (m *Monitor) replicateArgs() *MonitorReplicateArgs {
+	args := &MonitorReplicateArgs{
+		Lease: time.Duration(monitorReplicationLeases) * m.replicationInterval,
+	}
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	for archetypeID := range m.states {
+		state, _ := m.getStateLocked(archetypeID)
+		args.States = append(args.States, MonitorStateEntry{ArchetypeID: archetypeID, State: state})
+	}
+	return args
+}
+
+// pruneReplicasLocked forgets replicated states that expired more than monitorReplicaRetention ago, so that
+// archetypes that have long stopped being replicated, e.g. because they were replaced under new IDs, do not
+// accumulate.
+func (m *Monitor) pruneReplicasLocked() {
+	now := m.clock.Now()
+	for archetypeID, replica := range m.replicas {
+		if now.After(replica.expiry.Add(monitorReplicaRetention)) {
+			delete(m.replicas, archetypeID)
+		}
+	}
+}
+
 // RunArchetype runs the given archetype inside the monitor. Wraps a call to ctx.Run
 func (m *Monitor) RunArchetype(ctx *distsys.MPCalContext) (err error) {
 	archetypeID := ctx.IFace().Self()
@@ -120,11 +398,14 @@ func (m *Monitor) ListenAndServe() error {
 		return err
 	}
 
-	m.listener, err = net.Listen("tcp", m.ListenAddr)
+	m.listener, err = m.security.listen(m.ListenAddr)
 	if err != nil {
 		return err
 	}
-	log.Printf("Monitor: started listening on %s", m.ListenAddr)
+	m.log("started listening", "addr", m.ListenAddr)
+	if len(m.peers) > 0 {
+		go m.replicate()
+	}
 	for {
 		conn, err := m.listener.Accept()
 		if err != nil {
@@ -135,15 +416,54 @@ func (m *Monitor) ListenAndServe() error {
 				return err
 			}
 		}
-		go m.server.ServeConn(conn)
+		go func() {
+			err := m.security.accept(conn, failureDetectorTimeout)
+			if err != nil {
+				m.log("rejecting connection", "remote", conn.RemoteAddr(), "error", err)
+				m.rpcError("Accept")
+				_ = conn.Close()
+				return
+			}
+			if !m.trackConn(conn) {
+				_ = conn.Close()
+				return
+			}
+			defer m.untrackConn(conn)
+			m.server.ServeConn(conn)
+		}()
+	}
+}
+
+// trackConn records that conn is being served, so that Close closes it. It returns false if the monitor is
+// already closed.
+func (m *Monitor) trackConn(conn net.Conn) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	select {
+	case <-m.done:
+		return false
+	default:
 	}
+	m.conns[conn] = struct{}{}
+	return true
 }
 
-// Close stops the monitor's RPC servers. It doesn't do anything with the
-// archetypes that the monitor is running.
+func (m *Monitor) untrackConn(conn net.Conn) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.conns, conn)
+}
+
+// Close stops the monitor's RPC servers, and closes the connections they are
+// serving. It doesn't do anything with the archetypes that the monitor is running.
 func (m *Monitor) Close() error {
 	var err error
+	m.lock.Lock()
 	close(m.done)
+	for conn := range m.conns {
+		_ = conn.Close()
+	}
+	m.lock.Unlock()
 	if m.listener != nil {
 		err = m.listener.Close()
 	}
@@ -157,12 +477,88 @@ type MonitorRPCReceiver struct {
 func (rcvr *MonitorRPCReceiver) IsAlive(arg tla.TLAValue, reply *ArchetypeState) error {
 	state, ok := rcvr.m.getState(arg)
 	if !ok {
+		rcvr.m.rpcError("IsAlive")
 		return errors.New("archetype not found")
 	}
+	rcvr.m.heartbeatServed(arg)
 	*reply = state
 	return nil
 }
 
+// MonitorStateEntry is the state of one archetype.
+type MonitorStateEntry struct {
+	ArchetypeID tla.TLAValue
+	State       ArchetypeState
+}
+
+// MonitorReplicateArgs carries a peer monitor's archetype states, which should be trusted for Lease.
+type MonitorReplicateArgs struct {
+	States []MonitorStateEntry
+	Lease  time.Duration
+}
+
+// Replicate records the states of archetypes run by a peer monitor. See WithMonitorPeers.
+func (rcvr *MonitorRPCReceiver) Replicate(args *MonitorReplicateArgs, reply *bool) error {
+	m := rcvr.m
+	now := m.clock.Now()
+	expiry := now.Add(args.Lease)
+	m.lock.Lock()
+	notify := false
+	for _, entry := range args.States {
+		changedAt := now
+		if old, ok := m.replicas[entry.ArchetypeID]; !ok || old.state != entry.State {
+			notify = true
+		} else {
+			changedAt = old.changedAt
+		}
+		m.replicas[entry.ArchetypeID] = monitorReplicatedState{state: entry.State, expiry: expiry, changedAt: changedAt}
+	}
+	m.pruneReplicasLocked()
+	if notify {
+		m.notifyLocked()
+	}
+	m.lock.Unlock()
+	*reply = true
+	return nil
+}
+
+// HeartbeatArgs are the arguments of a Heartbeat RPC.
+type HeartbeatArgs struct {
+	ArchetypeID tla.TLAValue
+	KnownState  ArchetypeState // the state the caller last observed
+	Interval    time.Duration  // the longest the call may wait before replying
+}
+
+// Heartbeat replies as soon as the state of the archetype differs from args.KnownState, or, failing that, after
+// args.Interval with the unchanged state. Detectors in long-poll mode keep one Heartbeat call outstanding at all
+// times, so state changes reach them immediately, and the absence of a heartbeat reveals that the monitor's process
+// is unreachable.
+func (rcvr *MonitorRPCReceiver) Heartbeat(args HeartbeatArgs, reply *ArchetypeState) error {
+	deadline := rcvr.m.clock.After(args.Interval)
+	for {
+		state, ok, changed := rcvr.m.watchState(args.ArchetypeID)
+		if ok && state != args.KnownState {
+			rcvr.m.heartbeatServed(args.ArchetypeID)
+			*reply = state
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-deadline:
+			if !ok {
+				rcvr.m.rpcError("Heartbeat")
+				return errors.New("archetype not found")
+			}
+			rcvr.m.heartbeatServed(args.ArchetypeID)
+			*reply = state
+			return nil
+		case <-rcvr.m.done:
+			rcvr.m.rpcError("Heartbeat")
+			return errors.New("monitor closed")
+		}
+	}
+}
+
 // FailureDetectorAddressMappingFn returns address of the monitor that is
 // running the archetype with the given index.
 type FailureDetectorAddressMappingFn func(tla.TLAValue) string
@@ -202,15 +598,25 @@ func FailureDetectorMaker(addressMappingFn FailureDetectorAddressMappingFn, opts
 
 type singleFailureDetector struct {
 	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
 	archetypeID tla.TLAValue
-	monitorAddr string
+	// monitorAddrs lists the monitors to query, in order of preference; monitorAddrs[addrIdx] is currently in use
+	monitorAddrs []string
+	addrIdx      int
+	failedAddrs  int // how many addresses in a row have failed to answer
 
-	timeout      time.Duration
-	pullInterval time.Duration
+	timeout          time.Duration
+	pullInterval     time.Duration
+	longPollInterval time.Duration      // if non-zero, the detector is in long-poll mode; see WithFailureDetectorLongPollMode
+	receiver         *HeartbeatReceiver // if non-nil, the detector is in push mode; see WithFailureDetectorPushMode
+	pushInterval     time.Duration
 
+	callbacks []FailureDetectorCallback
+	security  monitorSecurity
+
+	clock  Clock
 	client *rpc.Client
 	reDial bool
-	ticker *time.Ticker
 
 	lock  sync.RWMutex
 	state ArchetypeState
@@ -232,16 +638,97 @@ func WithFailureDetectorPullInterval(t time.Duration) FailureDetectorOption {
 	}
 }
 
+// WithFailureDetectorTimeoutFn is like WithFailureDetectorTimeout, but sets the timeout as a function of the index
+// of the monitored archetype. This allows e.g. archetypes reached over a WAN to be given more slack than those on
+// the LAN.
+func WithFailureDetectorTimeoutFn(timeoutFn func(index tla.TLAValue) time.Duration) FailureDetectorOption {
+	return func(fd *singleFailureDetector) {
+		fd.timeout = timeoutFn(fd.archetypeID)
+	}
+}
+
+// WithFailureDetectorPullIntervalFn is like WithFailureDetectorPullInterval, but sets the pull interval as a
+// function of the index of the monitored archetype.
+func WithFailureDetectorPullIntervalFn(intervalFn func(index tla.TLAValue) time.Duration) FailureDetectorOption {
+	return func(fd *singleFailureDetector) {
+		fd.pullInterval = intervalFn(fd.archetypeID)
+	}
+}
+
+// WithFailureDetectorLongPollMode makes the failure detector long-poll the monitor, instead of pulling the state
+// every pull interval. The detector always has one Heartbeat call waiting at the monitor, which the monitor answers
+// as soon as the archetype's state changes, or otherwise after interval; the detector then immediately makes the
+// next call. If no answer arrives within interval plus the detector's timeout, the archetype is considered failed.
+// Compared to pulling, this reduces detection latency for archetypes that crash within a running process, since
+// the change is reported without waiting for the next poll. It does not reduce the load on the monitor, which still
+// answers one Heartbeat call per detector per interval, and holds one call open per detector in between; for that,
+// see WithFailureDetectorPushMode.
+func WithFailureDetectorLongPollMode(interval time.Duration) FailureDetectorOption {
+	return func(fd *singleFailureDetector) {
+		fd.longPollInterval = interval
+	}
+}
+
+// WithFailureDetectorClock makes the failure detector use clock, rather than SystemClock, to time its pull interval
+// and its timeouts. With a ControlledClock, tests can e.g. freeze the detector's clock so that it stops polling, or
+// advance it so that a pending query times out right away.
+func WithFailureDetectorClock(clock Clock) FailureDetectorOption {
+	return func(fd *singleFailureDetector) {
+		fd.clock = clock
+	}
+}
+
+// FailureDetectorFallbackMappingFn returns the addresses of monitors that can be asked about the archetype with
+// the given index, when the monitor returned by the FailureDetectorAddressMappingFn is unavailable.
+type FailureDetectorFallbackMappingFn func(tla.TLAValue) []string
+
+// WithFailureDetectorFallbackMonitors makes the failure detector fail over to the monitors returned by fallbackFn,
+// in order, whenever the monitor it is using does not answer. The archetype is only considered failed if none of
+// them answer. The fallbacks should be peers of the primary monitor, as configured with WithMonitorPeers.
+func WithFailureDetectorFallbackMonitors(fallbackFn FailureDetectorFallbackMappingFn) FailureDetectorOption {
+	return func(fd *singleFailureDetector) {
+		fd.monitorAddrs = append(fd.monitorAddrs, fallbackFn(fd.archetypeID)...)
+	}
+}
+
+// FailureDetectorCallback is notified when a failure detector observes that the state of the archetype it
+// monitors has changed. A newState other than ArchetypeAlive means the detector now suspects the archetype.
+type FailureDetectorCallback func(archetypeID tla.TLAValue, oldState, newState ArchetypeState)
+
+// WithFailureDetectorCallback calls callback on every state change observed by the failure detectors for
+// archetypeIDs, or by every failure detector if no IDs are given. This allows application-level reactions, such as
+// alerts or triggering a leadership transfer, outside the MPCal model. Callbacks are called synchronously from the
+// detector's background goroutine, so they should return quickly.
+func WithFailureDetectorCallback(callback FailureDetectorCallback, archetypeIDs ...tla.TLAValue) FailureDetectorOption {
+	return func(fd *singleFailureDetector) {
+		if len(archetypeIDs) > 0 {
+			found := false
+			for _, archetypeID := range archetypeIDs {
+				if archetypeID.Equal(fd.archetypeID) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return
+			}
+		}
+		fd.callbacks = append(fd.callbacks, callback)
+	}
+}
+
 func singleFailureDetectorResourceMaker(archetypeID tla.TLAValue, monitorAddr string, opts ...FailureDetectorOption) distsys.ArchetypeResourceMaker {
 	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
 		fd := &singleFailureDetector{
 			archetypeID:  archetypeID,
-			monitorAddr:  monitorAddr,
+			monitorAddrs: []string{monitorAddr},
 			timeout:      failureDetectorTimeout,
 			pullInterval: failureDetectorPullInterval,
+			clock:        SystemClock,
 			client:       nil,
 			state:        uninitialized,
 			reDial:       false,
+			done:         make(chan struct{}),
 		}
 		for _, opt := range opts {
 			opt(fd)
@@ -259,13 +746,19 @@ func (res *singleFailureDetector) getState() ArchetypeState {
 
 func (res *singleFailureDetector) setState(state ArchetypeState) {
 	res.lock.Lock()
+	oldState := res.state
 	res.state = state
 	res.lock.Unlock()
+	if oldState != state {
+		for _, callback := range res.callbacks {
+			callback(res.archetypeID, oldState, state)
+		}
+	}
 }
 
 func (res *singleFailureDetector) ensureClient() error {
 	if res.client == nil || res.reDial {
-		conn, err := net.DialTimeout("tcp", res.monitorAddr, res.timeout)
+		conn, err := res.security.dial(res.monitorAddrs[res.addrIdx], res.timeout)
 		if err != nil {
 			return err
 		}
@@ -275,13 +768,118 @@ func (res *singleFailureDetector) ensureClient() error {
 	return nil
 }
 
+// failover switches to the next monitor address after a failed query. It returns true if not all the addresses
+// have failed in a row yet, in which case the failure should not be reported, and the query retried right away.
+func (res *singleFailureDetector) failover() bool {
+	if len(res.monitorAddrs) <= 1 {
+		return false
+	}
+	if res.client != nil {
+		_ = res.client.Close()
+		res.client = nil
+	}
+	res.addrIdx = (res.addrIdx + 1) % len(res.monitorAddrs)
+	res.failedAddrs++
+	if res.failedAddrs < len(res.monitorAddrs) {
+		res.log(distsys.LogInfo, "failure detector failing over", "archetype", res.archetypeID, "monitor", res.monitorAddrs[res.addrIdx])
+		return true
+	}
+	res.failedAddrs = 0
+	return false
+}
+
+// updateState records the outcome of one query to the monitor: reply is only meaningful if err is nil and
+// timedOut is false.
+func (res *singleFailureDetector) updateState(oldState, reply ArchetypeState, err error, timedOut bool) {
+	if err != nil {
+		res.setState(failed)
+		if oldState != failed {
+			res.log(distsys.LogInfo, "failure detector changed state, due to an RPC error",
+				"archetype", res.archetypeID, "oldState", oldState, "newState", failed, "error", err)
+		}
+		if err == rpc.ErrShutdown {
+			res.reDial = true
+		}
+	} else if timedOut {
+		res.setState(failed)
+		if oldState != failed {
+			res.log(distsys.LogInfo, "failure detector changed state, due to an RPC timeout",
+				"archetype", res.archetypeID, "oldState", oldState, "newState", failed)
+		}
+	} else {
+		res.failedAddrs = 0
+		res.setState(reply)
+		if oldState != reply {
+			res.log(distsys.LogInfo, "failure detector changed state, due to an RPC reply",
+				"archetype", res.archetypeID, "oldState", oldState, "newState", reply)
+		}
+	}
+}
+
+func (res *singleFailureDetector) dialFailed(oldState ArchetypeState, err error) {
+	res.setState(failed)
+	if oldState != failed {
+		res.log(distsys.LogInfo, "failure detector changed state, due to a dial error",
+			"archetype", res.archetypeID, "oldState", oldState, "newState", failed, "error", err)
+	}
+}
+
 func (res *singleFailureDetector) mainLoop() {
-	res.done = make(chan struct{})
-	res.ticker = time.NewTicker(res.pullInterval)
-	for range res.ticker.C {
+	if res.receiver != nil {
+		res.pushLoop()
+		return
+	}
+	if res.longPollInterval > 0 {
+		res.longPollLoop()
+		return
+	}
+	for {
 		select {
+		case <-res.clock.After(res.pullInterval):
 		case <-res.done:
-			break
+			return
+		}
+
+		for res.pull() {
+		}
+	}
+}
+
+// pull queries the monitor once. It returns true if the query failed, and should be retried at another monitor.
+func (res *singleFailureDetector) pull() bool {
+	oldState := res.getState()
+
+	err := res.ensureClient()
+	if err != nil {
+		if res.failover() {
+			return true
+		}
+		res.dialFailed(oldState, err)
+		return false
+	}
+
+	var reply ArchetypeState
+	call := res.client.Go("MonitorRPCReceiver.IsAlive", &res.archetypeID, &reply, nil)
+	timeout := false
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-res.clock.After(res.timeout):
+		timeout = true
+	}
+	if (err != nil || timeout) && res.failover() {
+		return true
+	}
+	res.updateState(oldState, reply, err, timeout)
+	return false
+}
+
+// longPollLoop keeps one Heartbeat call outstanding at the monitor, updating the state whenever one returns.
+func (res *singleFailureDetector) longPollLoop() {
+	for {
+		select {
+		case <-res.done:
+			return
 		default:
 		}
 
@@ -289,43 +887,44 @@ func (res *singleFailureDetector) mainLoop() {
 
 		err := res.ensureClient()
 		if err != nil {
-			res.setState(failed)
-			if oldState != failed {
-				log.Printf("fd change state: archetype = %v, old state = %v, "+
-					"new state = %v. Due to dial error: %v", res.archetypeID, oldState, failed, err)
+			if res.failover() {
+				continue
+			}
+			res.dialFailed(oldState, err)
+			select {
+			case <-res.clock.After(res.timeout):
+			case <-res.done:
+				return
 			}
 			continue
 		}
 
 		var reply ArchetypeState
-		call := res.client.Go("MonitorRPCReceiver.IsAlive", &res.archetypeID, &reply, nil)
+		args := HeartbeatArgs{
+			ArchetypeID: res.archetypeID,
+			KnownState:  oldState,
+			Interval:    res.longPollInterval,
+		}
+		call := res.client.Go("MonitorRPCReceiver.Heartbeat", &args, &reply, nil)
 		timeout := false
 		select {
 		case <-call.Done:
 			err = call.Error
-		case <-time.After(res.timeout):
+		case <-res.clock.After(res.longPollInterval + res.timeout):
 			timeout = true
+		case <-res.done:
+			return
 		}
-		if err != nil {
-			res.setState(failed)
-			if oldState != failed {
-				log.Printf("fd change state: archetype = %v, old state = %v, "+
-					"new state = %v. Due to rpc call error: %v", res.archetypeID, oldState, failed, err)
-			}
-			if err == rpc.ErrShutdown {
-				res.reDial = true
-			}
-		} else if timeout {
-			res.setState(failed)
-			if oldState != failed {
-				log.Printf("fd change state: archetype = %v, old state = %v, "+
-					"new state = %v. Due to rpc call timeout", res.archetypeID, oldState, failed)
-			}
-		} else {
-			res.setState(reply)
-			if oldState != reply {
-				log.Printf("fd change state: archetype = %v, old state = %v, "+
-					"new state = %v. Due to rpc call reply", res.archetypeID, oldState, reply)
+		if (err != nil || timeout) && res.failover() {
+			continue
+		}
+		res.updateState(oldState, reply, err, timeout)
+		if err != nil || timeout {
+			// avoid spinning against a monitor that fails calls immediately
+			select {
+			case <-res.clock.After(res.timeout):
+			case <-res.done:
+				return
 			}
 		}
 	}
@@ -346,7 +945,15 @@ func (res *singleFailureDetector) Commit() chan struct{} {
 func (res *singleFailureDetector) ReadValue() (tla.TLAValue, error) {
 	state := res.getState()
 	if state == uninitialized {
-		time.Sleep(res.pullInterval)
+		// wait for the first answer from the monitor, which comes every pull interval, or long-poll or push interval
+		// in those modes
+		if res.receiver != nil {
+			time.Sleep(res.pushInterval)
+		} else if res.longPollInterval > 0 {
+			time.Sleep(res.longPollInterval)
+		} else {
+			time.Sleep(res.pullInterval)
+		}
 		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
 	} else if state == alive {
 		return tla.TLA_FALSE, nil
@@ -364,9 +971,6 @@ func (res *singleFailureDetector) Close() error {
 	if res.done != nil {
 		res.done <- struct{}{}
 	}
-	if res.ticker != nil {
-		res.ticker.Stop()
-	}
 	if res.client != nil {
 		err = res.client.Close()
 	}
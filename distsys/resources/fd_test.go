@@ -0,0 +1,181 @@
+package resources
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const fdTestPullInterval = 20 * time.Millisecond
+
+// fdTestDetectors makes pull-mode failure detectors for ids, all querying monitor.
+func fdTestDetectors(t *testing.T, monitor *Monitor, ids []tla.TLAValue, opts ...FailureDetectorOption) []distsys.ArchetypeResource {
+	t.Helper()
+	opts = append([]FailureDetectorOption{
+		WithFailureDetectorPullInterval(fdTestPullInterval),
+		WithFailureDetectorTimeout(200 * time.Millisecond),
+	}, opts...)
+	maker := FailureDetectorMaker(func(tla.TLAValue) string {
+		return monitor.ListenAddr
+	}, opts...)
+	fds := maker.Make()
+	maker.Configure(fds)
+	t.Cleanup(func() {
+		_ = fds.Close()
+	})
+	var detectors []distsys.ArchetypeResource
+	for _, id := range ids {
+		fd, err := fds.Index(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		detectors = append(detectors, fd)
+	}
+	return detectors
+}
+
+type fdTestTransition struct {
+	archetypeID        tla.TLAValue
+	oldState, newState ArchetypeState
+}
+
+// fdTestCallbackRecorder records the transitions a FailureDetectorCallback is called with.
+type fdTestCallbackRecorder struct {
+	lock        sync.Mutex
+	transitions []fdTestTransition
+}
+
+func (recorder *fdTestCallbackRecorder) callback(archetypeID tla.TLAValue, oldState, newState ArchetypeState) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.transitions = append(recorder.transitions, fdTestTransition{archetypeID, oldState, newState})
+}
+
+func (recorder *fdTestCallbackRecorder) get() []fdTestTransition {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	return append([]fdTestTransition(nil), recorder.transitions...)
+}
+
+func TestFailureDetectorCallback(t *testing.T) {
+	monitor := NewMonitor(freeLocalAddr(t))
+	startMonitorTest(t, monitor)
+	watched, unwatched := tla.MakeTLANumber(1), tla.MakeTLANumber(2)
+	monitor.setState(watched, alive)
+	monitor.setState(unwatched, alive)
+
+	recorder := &fdTestCallbackRecorder{}
+	fdTestDetectors(t, monitor, []tla.TLAValue{watched, unwatched}, WithFailureDetectorCallback(recorder.callback, watched))
+
+	// each transition is reported once, however many times the detector then sees the same state again
+	var expected []fdTestTransition
+	for _, transition := range []struct{ from, to ArchetypeState }{{uninitialized, alive}, {alive, failed}, {failed, alive}} {
+		monitor.setState(watched, transition.to)
+		monitor.setState(unwatched, transition.to)
+		expected = append(expected, fdTestTransition{watched, transition.from, transition.to})
+		awaitCondition(t, "the callback to be called", func() bool {
+			return len(recorder.get()) >= len(expected)
+		})
+		time.Sleep(5 * fdTestPullInterval)
+		transitions := recorder.get()
+		if len(transitions) != len(expected) {
+			t.Fatalf("expected the transitions %v, got %v", expected, transitions)
+		}
+		for i := range transitions {
+			if !transitions[i].archetypeID.Equal(expected[i].archetypeID) || transitions[i].oldState != expected[i].oldState || transitions[i].newState != expected[i].newState {
+				t.Fatalf("expected the transitions %v, got %v", expected, transitions)
+			}
+		}
+	}
+}
+
+func TestFailureDetectorObservesMarks(t *testing.T) {
+	monitor := NewMonitor(freeLocalAddr(t))
+	startMonitorTest(t, monitor)
+	running, unknown := tla.MakeTLANumber(1), tla.MakeTLANumber(2)
+	monitor.setState(running, alive)
+	detectors := fdTestDetectors(t, monitor, []tla.TLAValue{running, unknown})
+
+	awaitSuspicion := func(what string, fd distsys.ArchetypeResource, suspected bool) {
+		t.Helper()
+		awaitCondition(t, what, func() bool {
+			suspects, known := fdTestSuspects(t, fd)
+			return known && suspects == suspected
+		})
+	}
+	awaitSuspicion("the running archetype to be reported alive", detectors[0], false)
+
+	// a running archetype marked failed is suspected, until the mark is cleared
+	monitor.MarkFailed(running)
+	awaitSuspicion("the archetype marked failed to be suspected", detectors[0], true)
+	monitor.ClearMark(running)
+	awaitSuspicion("the cleared archetype to be reported alive again", detectors[0], false)
+
+	// an archetype the monitor does not run can be marked alive
+	awaitSuspicion("the unknown archetype to be suspected", detectors[1], true)
+	monitor.MarkAlive(unknown)
+	awaitSuspicion("the archetype marked alive to be reported alive", detectors[1], false)
+	monitor.ClearMark(unknown)
+	awaitSuspicion("the unknown archetype to be suspected again", detectors[1], true)
+}
+
+func TestFailureDetectorTimeoutFn(t *testing.T) {
+	const fastTimeout, slowTimeout = 50 * time.Millisecond, time.Second
+	// a monitor that accepts connections, but never answers
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	fast, slow := tla.MakeTLANumber(1), tla.MakeTLANumber(2)
+	maker := FailureDetectorMaker(func(tla.TLAValue) string {
+		return listener.Addr().String()
+	}, WithFailureDetectorPullInterval(fdTestPullInterval), WithFailureDetectorTimeoutFn(func(index tla.TLAValue) time.Duration {
+		if index.Equal(fast) {
+			return fastTimeout
+		}
+		return slowTimeout
+	}))
+	fds := maker.Make()
+	maker.Configure(fds)
+	defer fds.Close()
+	start := time.Now()
+	fastFD, err := fds.Index(fast)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slowFD, err := fds.Index(slow)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the detector with the short timeout gives up on the monitor first, while the other one is still waiting
+	awaitCondition(t, "the detector with the short timeout to time out", func() bool {
+		suspects, _ := fdTestSuspects(t, fastFD)
+		return suspects
+	})
+	if _, known := fdTestSuspects(t, slowFD); known {
+		t.Fatalf("expected the detector with the long timeout to still be waiting, %v after the start", time.Since(start))
+	}
+	awaitCondition(t, "the detector with the long timeout to time out", func() bool {
+		suspects, _ := fdTestSuspects(t, slowFD)
+		return suspects
+	})
+	if elapsed := time.Since(start); elapsed < slowTimeout {
+		t.Fatalf("expected the detector with the long timeout to wait for %v, but it gave up after %v", slowTimeout, elapsed)
+	}
+}
@@ -0,0 +1,19 @@
+package resources_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/distsystest"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+)
+
+// TestFailureDetectorConformance checks the TCP failure detector against the semantics MPCal code assumes. It is in
+// an external test package, as distsystest imports resources.
+func TestFailureDetectorConformance(t *testing.T) {
+	distsystest.RunFailureDetectorConformance(t, func(t *testing.T) distsystest.FailureDetectorHarness {
+		return distsystest.NewMonitorHarness(t,
+			resources.WithFailureDetectorPullInterval(20*time.Millisecond),
+			resources.WithFailureDetectorTimeout(time.Second))
+	}, distsystest.WithDetectionBound(2*time.Second), distsystest.WithObservationPeriod(500*time.Millisecond))
+}
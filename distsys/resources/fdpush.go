@@ -0,0 +1,384 @@
+package resources
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/rpc"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// Push-based heartbeating
+// -----------------------
+//
+// In push mode, failure detectors subscribe to their monitor once, and the monitor then sends the states of the
+// subscribed archetypes to a HeartbeatReceiver in the detectors' process, every push interval and as soon as any of
+// them changes. One push carries every archetype a process has subscribed to, so the monitor handles one RPC per
+// subscribing process per interval, however many detectors each process runs, rather than one per detector.
+
+// HeartbeatReceiver receives the heartbeats that monitors push to the failure detectors of one process. See
+// WithFailureDetectorPushMode.
+type HeartbeatReceiver struct {
+	// ListenAddr is where monitors push heartbeats to; failure detectors give it to monitors when subscribing
+	ListenAddr string
+
+	listener net.Listener
+	security monitorSecurity
+	clock    Clock
+	done     chan struct{}
+
+	lock       sync.RWMutex
+	heartbeats map[tla.TLAValue]receivedHeartbeat
+	// changed is closed and replaced whenever a heartbeat reports a different state, waking up detectors
+	changed chan struct{}
+}
+
+type receivedHeartbeat struct {
+	state ArchetypeState
+	at    time.Time
+}
+
+// HeartbeatReceiverOption configures a HeartbeatReceiver.
+type HeartbeatReceiverOption func(r *HeartbeatReceiver)
+
+// WithHeartbeatReceiverTLS makes the receiver accept only TLS connections, configured by serverConfig. Monitors
+// pushing to it must be configured with a matching client configuration, via WithMonitorTLS.
+func WithHeartbeatReceiverTLS(serverConfig *tls.Config) HeartbeatReceiverOption {
+	return func(r *HeartbeatReceiver) {
+		r.security.serverTLS = serverConfig
+	}
+}
+
+// WithHeartbeatReceiverSharedSecret requires monitors pushing to the receiver to authenticate using secret, as
+// with WithMonitorSharedSecret.
+func WithHeartbeatReceiverSharedSecret(secret []byte) HeartbeatReceiverOption {
+	return func(r *HeartbeatReceiver) {
+		r.security.secret = secret
+	}
+}
+
+// WithHeartbeatReceiverClock makes the receiver use clock, rather than SystemClock, to time the heartbeats it
+// receives. It should be the same clock as the failure detectors using the receiver.
+func WithHeartbeatReceiverClock(clock Clock) HeartbeatReceiverOption {
+	return func(r *HeartbeatReceiver) {
+		r.clock = clock
+	}
+}
+
+// NewHeartbeatReceiver creates a HeartbeatReceiver, which must be started with ListenAndServe.
+func NewHeartbeatReceiver(listenAddr string, opts ...HeartbeatReceiverOption) *HeartbeatReceiver {
+	r := &HeartbeatReceiver{
+		ListenAddr: listenAddr,
+		clock:      SystemClock,
+		done:       make(chan struct{}),
+		heartbeats: make(map[tla.TLAValue]receivedHeartbeat),
+		changed:    make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// ListenAndServe accepts pushed heartbeats. It blocks until an error occurs or the receiver closes.
+func (r *HeartbeatReceiver) ListenAndServe() error {
+	server := rpc.NewServer()
+	err := server.Register(&HeartbeatReceiverRPC{r: r})
+	if err != nil {
+		return err
+	}
+	r.listener, err = r.security.listen(r.ListenAddr)
+	if err != nil {
+		return err
+	}
+	for {
+		conn, err := r.listener.Accept()
+		if err != nil {
+			select {
+			case <-r.done:
+				return nil
+			default:
+				return err
+			}
+		}
+		go func() {
+			if err := r.security.accept(conn, failureDetectorTimeout); err != nil {
+				_ = conn.Close()
+				return
+			}
+			server.ServeConn(conn)
+		}()
+	}
+}
+
+// Close stops accepting heartbeats.
+func (r *HeartbeatReceiver) Close() error {
+	var err error
+	close(r.done)
+	if r.listener != nil {
+		err = r.listener.Close()
+	}
+	return err
+}
+
+// watch returns the latest heartbeat for archetypeID, if any, and a channel that will be closed when a heartbeat
+// reports a different state for any archetype.
+func (r *HeartbeatReceiver) watch(archetypeID tla.TLAValue) (receivedHeartbeat, bool, <-chan struct{}) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	heartbeat, ok := r.heartbeats[archetypeID]
+	return heartbeat, ok, r.changed
+}
+
+// HeartbeatReceiverRPC is the RPC interface of a HeartbeatReceiver, called by monitors.
+type HeartbeatReceiverRPC struct {
+	r *HeartbeatReceiver
+}
+
+// HeartbeatPushArgs carries the states of the archetypes a process has subscribed to at a monitor.
+type HeartbeatPushArgs struct {
+	States []MonitorStateEntry
+}
+
+// Push records a heartbeat for each archetype in args.
+func (rcvr *HeartbeatReceiverRPC) Push(args HeartbeatPushArgs, reply *bool) error {
+	r := rcvr.r
+	now := r.clock.Now()
+	r.lock.Lock()
+	notify := false
+	for _, entry := range args.States {
+		if old, ok := r.heartbeats[entry.ArchetypeID]; !ok || old.state != entry.State {
+			notify = true
+		}
+		r.heartbeats[entry.ArchetypeID] = receivedHeartbeat{state: entry.State, at: now}
+	}
+	if notify {
+		close(r.changed)
+		r.changed = make(chan struct{})
+	}
+	r.lock.Unlock()
+	*reply = true
+	return nil
+}
+
+// SubscribeArgs are the arguments of a Subscribe RPC.
+type SubscribeArgs struct {
+	ArchetypeID  tla.TLAValue
+	ReceiverAddr string        // the ListenAddr of the HeartbeatReceiver to push to
+	Interval     time.Duration // the longest the monitor may go without pushing
+}
+
+// monitorSubscriber is a HeartbeatReceiver that a monitor pushes heartbeats to.
+type monitorSubscriber struct {
+	archetypeIDs []tla.TLAValue
+	interval     time.Duration
+}
+
+// Subscribe makes the monitor push the state of args.ArchetypeID to the HeartbeatReceiver at args.ReceiverAddr,
+// along with that of any other archetypes the receiver subscribed to. Subscribing again has no further effect,
+// except that the shortest interval asked for applies. The monitor drops a subscription once it has failed to push
+// to the receiver for a few intervals in a row; detectors subscribe again when they stop receiving heartbeats.
+func (rcvr *MonitorRPCReceiver) Subscribe(args SubscribeArgs, reply *bool) error {
+	m := rcvr.m
+	if args.Interval <= 0 {
+		return errors.New("heartbeat push interval must be positive")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	sub, ok := m.subscribers[args.ReceiverAddr]
+	if !ok {
+		sub = &monitorSubscriber{interval: args.Interval}
+		m.subscribers[args.ReceiverAddr] = sub
+		go m.pushHeartbeats(args.ReceiverAddr, sub)
+	}
+	if args.Interval < sub.interval {
+		sub.interval = args.Interval
+	}
+	for _, archetypeID := range sub.archetypeIDs {
+		if archetypeID.Equal(args.ArchetypeID) {
+			*reply = true
+			return nil
+		}
+	}
+	sub.archetypeIDs = append(sub.archetypeIDs, args.ArchetypeID)
+	// wake up the pusher, so that the new subscription is answered right away
+	m.notifyLocked()
+	*reply = true
+	return nil
+}
+
+// pushHeartbeats pushes the states subscribed to by the receiver at addr whenever they change, and at least every
+// interval, until the monitor closes or pushing fails for monitorReplicationLeases intervals in a row.
+func (m *Monitor) pushHeartbeats(addr string, sub *monitorSubscriber) {
+	var client *rpc.Client
+	defer func() {
+		if client != nil {
+			_ = client.Close()
+		}
+	}()
+	var lastPushed []MonitorStateEntry
+	var lastPushedAt time.Time
+	failures := 0
+	for {
+		m.lock.RLock()
+		interval := sub.interval
+		var args HeartbeatPushArgs
+		for _, archetypeID := range sub.archetypeIDs {
+			if state, ok := m.getStateLocked(archetypeID); ok {
+				args.States = append(args.States, MonitorStateEntry{ArchetypeID: archetypeID, State: state})
+			}
+		}
+		changed := m.changed
+		m.lock.RUnlock()
+
+		if !monitorStatesEqual(args.States, lastPushed) || m.clock.Now().Sub(lastPushedAt) >= interval {
+			err := m.push(&client, addr, &args, interval)
+			if err != nil {
+				m.log("could not push heartbeats", "receiver", addr, "error", err)
+				m.rpcError("Push")
+				failures++
+				if failures >= monitorReplicationLeases {
+					m.lock.Lock()
+					delete(m.subscribers, addr)
+					m.lock.Unlock()
+					return
+				}
+			} else {
+				failures = 0
+				lastPushed, lastPushedAt = args.States, m.clock.Now()
+				for _, entry := range args.States {
+					m.heartbeatServed(entry.ArchetypeID)
+				}
+			}
+		}
+
+		select {
+		case <-changed:
+		case <-m.clock.After(interval):
+		case <-m.done:
+			return
+		}
+	}
+}
+
+// push makes one Push call to the receiver at addr, dialing it first if *client is nil.
+func (m *Monitor) push(client **rpc.Client, addr string, args *HeartbeatPushArgs, timeout time.Duration) error {
+	if *client == nil {
+		conn, err := m.security.dial(addr, timeout)
+		if err != nil {
+			return err
+		}
+		*client = rpc.NewClient(conn)
+	}
+	var err error
+	call := (*client).Go("HeartbeatReceiverRPC.Push", args, new(bool), nil)
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-m.clock.After(timeout):
+		err = errors.New("timed out")
+	case <-m.done:
+		err = errors.New("monitor closed")
+	}
+	if err != nil {
+		_ = (*client).Close()
+		*client = nil
+	}
+	return err
+}
+
+func monitorStatesEqual(lhs, rhs []MonitorStateEntry) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i].State != rhs[i].State || !lhs[i].ArchetypeID.Equal(rhs[i].ArchetypeID) {
+			return false
+		}
+	}
+	return true
+}
+
+// WithFailureDetectorPushMode makes the failure detector rely on heartbeats pushed by the monitor to receiver,
+// instead of pulling the state every pull interval. The detector subscribes to the monitor, which then pushes the
+// archetype's state as soon as it changes, and at least every interval. If no heartbeat arrives within interval plus
+// the detector's timeout, the archetype is considered failed, and the detector subscribes again, failing over to
+// the next monitor if one is configured.
+//
+// Compared to pulling, state changes are reported without waiting for the next poll, and the monitor handles one
+// push per subscribing process per interval, rather than one RPC per detector per interval. All the detectors of a
+// process should share one receiver, which must be served with ListenAndServe, and reachable by the monitors at its
+// ListenAddr.
+func WithFailureDetectorPushMode(receiver *HeartbeatReceiver, interval time.Duration) FailureDetectorOption {
+	return func(fd *singleFailureDetector) {
+		fd.receiver = receiver
+		fd.pushInterval = interval
+	}
+}
+
+// subscribe asks the current monitor to push heartbeats about the detector's archetype to its receiver.
+func (res *singleFailureDetector) subscribe() error {
+	err := res.ensureClient()
+	if err != nil {
+		return err
+	}
+	args := SubscribeArgs{
+		ArchetypeID:  res.archetypeID,
+		ReceiverAddr: res.receiver.ListenAddr,
+		Interval:     res.pushInterval,
+	}
+	call := res.client.Go("MonitorRPCReceiver.Subscribe", &args, new(bool), nil)
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-res.clock.After(res.timeout):
+		err = errors.New("subscription timed out")
+	}
+	if err != nil {
+		_ = res.client.Close()
+		res.client = nil
+	}
+	return err
+}
+
+// pushLoop updates the state from the heartbeats received, subscribing whenever they stop arriving.
+func (res *singleFailureDetector) pushLoop() {
+	deadline := res.pushInterval + res.timeout
+	var subscribedAt time.Time
+	for {
+		oldState := res.getState()
+		heartbeat, ok, changed := res.receiver.watch(res.archetypeID)
+		now := res.clock.Now()
+		wait := deadline
+		if ok && now.Sub(heartbeat.at) <= deadline {
+			res.updateState(oldState, heartbeat.state, nil, false)
+			wait = deadline - now.Sub(heartbeat.at)
+		} else {
+			// heartbeats have stopped, or never started
+			if !subscribedAt.IsZero() && now.Sub(subscribedAt) >= deadline {
+				res.updateState(oldState, unknown, nil, true)
+				oldState = res.getState()
+			}
+			err := res.subscribe()
+			if err != nil {
+				if res.failover() {
+					continue
+				}
+				res.dialFailed(oldState, err)
+			} else {
+				res.log(distsys.LogDebug, "failure detector subscribed to heartbeats", "archetype", res.archetypeID, "monitor", res.monitorAddrs[res.addrIdx])
+			}
+			subscribedAt = now
+		}
+		select {
+		case <-changed:
+		case <-res.clock.After(wait):
+		case <-res.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package resources
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func startMonitorTest(t *testing.T, m *Monitor) {
+	t.Helper()
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- m.ListenAndServe()
+	}()
+	t.Cleanup(func() {
+		select {
+		case <-m.done: // closed by the test
+		default:
+			_ = m.Close()
+		}
+		if err := <-serveErr; err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func startHeartbeatReceiverTest(t *testing.T) *HeartbeatReceiver {
+	t.Helper()
+	receiver := NewHeartbeatReceiver(freeLocalAddr(t))
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- receiver.ListenAndServe()
+	}()
+	t.Cleanup(func() {
+		_ = receiver.Close()
+		if err := <-serveErr; err != nil {
+			t.Error(err)
+		}
+	})
+	return receiver
+}
+
+// fdTestSuspects reads whether fd suspects the archetype it monitors, or returns false if it does not know yet.
+func fdTestSuspects(t *testing.T, fd distsys.ArchetypeResource) (suspects, known bool) {
+	t.Helper()
+	value, err := fd.ReadValue()
+	if errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		return false, false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value.AsBool(), true
+}
+
+func TestFailureDetectorPushMode(t *testing.T) {
+	const interval = 300 * time.Millisecond
+	monitor := NewMonitor(freeLocalAddr(t))
+	startMonitorTest(t, monitor)
+	receiver := startHeartbeatReceiverTest(t)
+	ids := []tla.TLAValue{tla.MakeTLANumber(1), tla.MakeTLANumber(2)}
+	for _, id := range ids {
+		monitor.setState(id, alive)
+	}
+
+	maker := FailureDetectorMaker(func(tla.TLAValue) string {
+		return monitor.ListenAddr
+	}, WithFailureDetectorPushMode(receiver, interval), WithFailureDetectorTimeout(200*time.Millisecond))
+	fds := maker.Make()
+	maker.Configure(fds)
+	defer fds.Close()
+	var detectors []distsys.ArchetypeResource
+	for _, id := range ids {
+		fd, err := fds.Index(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		detectors = append(detectors, fd)
+	}
+	for _, fd := range detectors {
+		awaitCondition(t, "the archetype to be reported alive", func() bool {
+			suspects, known := fdTestSuspects(t, fd)
+			return known && !suspects
+		})
+	}
+
+	// both detectors share one subscription, and so one push per interval
+	monitor.lock.RLock()
+	subscribers := len(monitor.subscribers)
+	subscribed := len(monitor.subscribers[receiver.ListenAddr].archetypeIDs)
+	monitor.lock.RUnlock()
+	if subscribers != 1 || subscribed != 2 {
+		t.Fatalf("expected one subscriber, subscribed to both archetypes, got %d subscribers and %d archetypes", subscribers, subscribed)
+	}
+
+	// a state change is pushed right away, well before the next interval
+	changedAt := time.Now()
+	monitor.setState(ids[0], failed)
+	awaitCondition(t, "the failure to be pushed", func() bool {
+		suspects, _ := fdTestSuspects(t, detectors[0])
+		return suspects
+	})
+	if elapsed := time.Since(changedAt); elapsed >= interval {
+		t.Errorf("expected the failure to be pushed right away, but it took %v", elapsed)
+	}
+	if suspects, _ := fdTestSuspects(t, detectors[1]); suspects {
+		t.Fatal("expected the other archetype to remain alive")
+	}
+
+	// heartbeats keep coming while nothing changes
+	time.Sleep(3 * interval)
+	if suspects, _ := fdTestSuspects(t, detectors[1]); suspects {
+		t.Fatal("expected the archetype to remain alive between state changes")
+	}
+
+	// once the monitor is gone, heartbeats stop, and the archetype is suspected
+	if err := monitor.Close(); err != nil {
+		t.Fatal(err)
+	}
+	awaitCondition(t, "the missing heartbeats to be noticed", func() bool {
+		suspects, _ := fdTestSuspects(t, detectors[1])
+		return suspects
+	})
+}
+
+func TestFailureDetectorPushModeResubscribes(t *testing.T) {
+	const interval = 200 * time.Millisecond
+	addr := freeLocalAddr(t)
+	receiver := startHeartbeatReceiverTest(t)
+	id := tla.MakeTLANumber(1)
+
+	maker := FailureDetectorMaker(func(tla.TLAValue) string {
+		return addr
+	}, WithFailureDetectorPushMode(receiver, interval), WithFailureDetectorTimeout(100*time.Millisecond))
+	fds := maker.Make()
+	maker.Configure(fds)
+	defer fds.Close()
+	fd, err := fds.Index(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the monitor is not up yet, so subscribing fails
+	awaitCondition(t, "the archetype to be suspected", func() bool {
+		suspects, _ := fdTestSuspects(t, fd)
+		return suspects
+	})
+
+	// the detector keeps trying, and subscribes once the monitor starts
+	monitor := NewMonitor(addr)
+	monitor.setState(id, alive)
+	startMonitorTest(t, monitor)
+	awaitCondition(t, "the archetype to be reported alive", func() bool {
+		suspects, _ := fdTestSuspects(t, fd)
+		return !suspects
+	})
+}
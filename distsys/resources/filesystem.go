@@ -3,6 +3,7 @@ package resources
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path"
 
 	"github.com/UBC-NSS/pgo/distsys/tla"
@@ -14,6 +15,10 @@ import (
 // map-like resource. Each element of the map will refer to a file, with keys and values being required
 // to be string-typed, and keys being required to refer to valid paths (or create-able paths, if a
 // key is written to before it is read).
+//
+// Writes take effect when the critical section commits, and are atomic: the new contents are written and synced
+// to a temporary file, which is then renamed over the target file, so that a crash leaves either the old or the new
+// contents, never a mix of both. Missing parent directories are created.
 func FileSystemMaker(workingDirectory string) distsys.ArchetypeResourceMaker {
 	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
 		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
@@ -52,8 +57,7 @@ func (res *file) Commit() chan struct{} {
 	if res.writePending != nil {
 		doneCh := make(chan struct{})
 		go func() {
-			// FIXME: this is not atomic. see: https://github.com/natefinch/atomic and potential need for flush ops
-			err := ioutil.WriteFile(path.Join(res.workingDirectory, res.subPath), []byte(*res.writePending), 0777)
+			err := writeFileAtomically(path.Join(res.workingDirectory, res.subPath), []byte(*res.writePending))
 			if err != nil {
 				panic(fmt.Errorf("could not write file %s: %w", path.Join(res.workingDirectory, res.subPath), err))
 			}
@@ -82,8 +86,11 @@ func (res *file) ReadValue() (tla.TLAValue, error) {
 }
 
 func (res *file) WriteValue(value tla.TLAValue) error {
+	strToWrite, err := value.TryAsString()
+	if err != nil {
+		return err
+	}
 	res.cachedRead = nil
-	strToWrite := value.AsString()
 	res.writePending = &strToWrite
 	return nil
 }
@@ -91,3 +98,46 @@ func (res *file) WriteValue(value tla.TLAValue) error {
 func (res *file) Close() error {
 	return nil
 }
+
+// writeFileAtomically replaces the contents of the file at filePath with data, by renaming a synced temporary file
+// over it, then syncing the parent directory so that the rename itself is durable.
+func writeFileAtomically(filePath string, data []byte) error {
+	dir := path.Dir(filePath)
+	err := os.MkdirAll(dir, 0777)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, "."+path.Base(filePath)+".tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Chmod(0644)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	err = os.Rename(f.Name(), filePath)
+	if err != nil {
+		return err
+	}
+	dirFile, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = dirFile.Sync()
+	if closeErr := dirFile.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
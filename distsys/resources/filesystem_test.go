@@ -0,0 +1,112 @@
+package resources
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// expectFileSystemTestFiles fails the test unless the regular files in dir are exactly those in expected, with their
+// contents. Subdirectories are not checked.
+func expectFileSystemTestFiles(t *testing.T, dir string, expected map[string]string) {
+	t.Helper()
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual := make(map[string]string)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		contents, err := ioutil.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual[entry.Name()] = string(contents)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the files in %s to be %v, got %v", dir, expected, actual)
+	}
+}
+
+func fileSystemTestEnd(ch chan struct{}) {
+	if ch != nil {
+		<-ch
+	}
+}
+
+func TestFileSystem(t *testing.T) {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "a"), []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	maker := FileSystemMaker(dir)
+	res := maker.Make()
+	maker.Configure(res)
+	index := func(name string) distsys.ArchetypeResource {
+		t.Helper()
+		sub, err := res.Index(tla.MakeTLAString(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sub
+	}
+
+	// an aborted write leaves the file as it was
+	if err := index("a").WriteValue(tla.MakeTLAString("aborted")); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := index("a").ReadValue(); err != nil || !value.Equal(tla.MakeTLAString("aborted")) {
+		t.Fatalf("expected to read the pending write, got %v, %v", value, err)
+	}
+	fileSystemTestEnd(res.Abort())
+	expectFileSystemTestFiles(t, dir, map[string]string{"a": "old"})
+
+	// a committed write replaces the file, and creates missing directories, leaving no temporary files behind
+	if err := index("a").WriteValue(tla.MakeTLAString("new")); err != nil {
+		t.Fatal(err)
+	}
+	if err := index("sub/b").WriteValue(tla.MakeTLAString("b")); err != nil {
+		t.Fatal(err)
+	}
+	fileSystemTestEnd(res.Commit())
+	expectFileSystemTestFiles(t, dir, map[string]string{"a": "new"})
+	expectFileSystemTestFiles(t, filepath.Join(dir, "sub"), map[string]string{"b": "b"})
+	if value, err := index("a").ReadValue(); err != nil || !value.Equal(tla.MakeTLAString("new")) {
+		t.Fatalf("expected to read the committed write, got %v, %v", value, err)
+	}
+}
+
+func TestWriteFileAtomicallyFailure(t *testing.T) {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "other"), []byte("other"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// the target is a non-empty directory, which the temporary file cannot be renamed over once it is written
+	target := filepath.Join(dir, "target")
+	if err := os.Mkdir(target, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(target, "inner"), []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeFileAtomically(target, []byte("new")); err == nil {
+		t.Fatal("expected writing over a directory to fail")
+	}
+	// the old contents are intact, and the temporary file is gone
+	expectFileSystemTestFiles(t, target, map[string]string{"inner": "old"})
+	expectFileSystemTestFiles(t, dir, map[string]string{"other": "other"})
+
+	// whereas a successful write replaces the file in place
+	if err := writeFileAtomically(filepath.Join(dir, "other"), []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+	expectFileSystemTestFiles(t, dir, map[string]string{"other": "new"})
+}
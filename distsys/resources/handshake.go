@@ -0,0 +1,115 @@
+package resources
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// tcpMailboxesProtocolVersion identifies the mailbox wire protocol. It must be incremented whenever a change is made
+// that older nodes cannot understand.
+const tcpMailboxesProtocolVersion = 1
+
+var tcpMailboxesMagic = [4]byte{'P', 'G', 'O', 'M'}
+
+// ErrTCPMailboxesIncompatiblePeer is reported when the other end of a mailbox connection is not a mailbox, or runs
+// a wire protocol version or codec that this node cannot communicate with.
+var ErrTCPMailboxesIncompatiblePeer = errors.New("incompatible mailbox peer")
+
+// NamedMailboxCodec may be implemented by a MailboxCodec, so that mailboxes can detect peers configured with a
+// different codec, and refuse to talk to them with a clear error. Codecs without a name are not checked.
+type NamedMailboxCodec interface {
+	MailboxCodec
+	Name() string
+}
+
+// tcpMailboxesPreambleHandshake is set in the flags of a dialing side's preamble when a tcpMailboxesHandshake
+// follows it. Without it, the connection uses no optional capabilities, and the handshake is skipped.
+const tcpMailboxesPreambleHandshake uint8 = 1 << 0
+
+// tcpMailboxesPreamble opens every mailbox connection, in both directions. It is written as raw bytes, rather than
+// through the codec, so that it can be understood whatever codec each end is using:
+// 4 magic bytes, the protocol version, a byte of flags, the length of the codec name, then the codec name itself.
+type tcpMailboxesPreamble struct {
+	Version uint8
+	Flags   uint8
+	Codec   string
+}
+
+func makeTCPMailboxesPreamble(codec MailboxCodec) tcpMailboxesPreamble {
+	preamble := tcpMailboxesPreamble{Version: tcpMailboxesProtocolVersion}
+	if named, ok := codec.(NamedMailboxCodec); ok {
+		preamble.Codec = named.Name()
+	}
+	return preamble
+}
+
+func (preamble tcpMailboxesPreamble) write(w io.Writer) error {
+	if len(preamble.Codec) > 255 {
+		return fmt.Errorf("codec name %q is too long", preamble.Codec)
+	}
+	var buf bytes.Buffer
+	buf.Write(tcpMailboxesMagic[:])
+	buf.WriteByte(preamble.Version)
+	buf.WriteByte(preamble.Flags)
+	buf.WriteByte(uint8(len(preamble.Codec)))
+	buf.WriteString(preamble.Codec)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func readTCPMailboxesPreamble(r io.Reader) (tcpMailboxesPreamble, error) {
+	var head [7]byte
+	_, err := io.ReadFull(r, head[:])
+	if err != nil {
+		return tcpMailboxesPreamble{}, err
+	}
+	if !bytes.Equal(head[:4], tcpMailboxesMagic[:]) {
+		return tcpMailboxesPreamble{}, fmt.Errorf("%w: peer is not a mailbox", ErrTCPMailboxesIncompatiblePeer)
+	}
+	codec := make([]byte, head[6])
+	_, err = io.ReadFull(r, codec)
+	if err != nil {
+		return tcpMailboxesPreamble{}, err
+	}
+	return tcpMailboxesPreamble{Version: head[4], Flags: head[5], Codec: string(codec)}, nil
+}
+
+// checkCompatible returns a descriptive error if this node, with preamble local, cannot talk to a peer that sent
+// preamble remote.
+func (local tcpMailboxesPreamble) checkCompatible(remote tcpMailboxesPreamble) error {
+	if local.Version != remote.Version {
+		return fmt.Errorf("%w: peer speaks protocol version %d, but this node speaks version %d",
+			ErrTCPMailboxesIncompatiblePeer, remote.Version, local.Version)
+	}
+	if local.Codec != "" && remote.Codec != "" && local.Codec != remote.Codec {
+		return fmt.Errorf("%w: peer uses codec %q, but this node uses codec %q",
+			ErrTCPMailboxesIncompatiblePeer, remote.Codec, local.Codec)
+	}
+	return nil
+}
+
+// tcpMailboxesHandshake follows the preamble, once both ends know they can decode each other's messages, if the
+// dialing side has any optional capabilities to negotiate. The dialing side sends its proposal, and the listening
+// side replies with what it accepted.
+type tcpMailboxesHandshake struct {
+	// Compressors lists the names of the compressors proposed by the dialing side, in order of preference
+	Compressors []string
+	// Compression is the name of the compressor accepted by the listening side; an empty Compression means values
+	// are sent as-is
+	Compression string
+}
+
+// acceptHandshake decides the listening side's reply to a handshake proposal, given the compressors it knows about,
+// and returns the compressor it accepted, if any.
+func acceptHandshake(proposal tcpMailboxesHandshake, compressors []MailboxCompressor) (tcpMailboxesHandshake, MailboxCompressor) {
+	for _, name := range proposal.Compressors {
+		for _, compressor := range compressors {
+			if compressor.Name() == name {
+				return tcpMailboxesHandshake{Compression: name}, compressor
+			}
+		}
+	}
+	return tcpMailboxesHandshake{}, nil
+}
@@ -0,0 +1,86 @@
+package resources
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func TestTCPMailboxesPreamble(t *testing.T) {
+	local := makeTCPMailboxesPreamble(GobMailboxCodec{})
+	local.Flags = tcpMailboxesPreambleHandshake
+	var buf bytes.Buffer
+	if err := local.write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if preamble, err := readTCPMailboxesPreamble(&buf); err != nil || preamble != local {
+		t.Fatalf("expected to read %v, got %v, %v", local, preamble, err)
+	}
+
+	if _, err := readTCPMailboxesPreamble(bytes.NewReader([]byte("GET / HTTP/1.1\r\n"))); !errors.Is(err, ErrTCPMailboxesIncompatiblePeer) {
+		t.Fatalf("expected a peer that is not a mailbox to be rejected, got %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		remote     tcpMailboxesPreamble
+		compatible bool
+	}{
+		{"same", local, true},
+		{"other version", tcpMailboxesPreamble{Version: tcpMailboxesProtocolVersion + 1, Codec: "gob"}, false},
+		{"other codec", tcpMailboxesPreamble{Version: tcpMailboxesProtocolVersion, Codec: "json"}, false},
+		// codecs without a name are not checked
+		{"unnamed codec", tcpMailboxesPreamble{Version: tcpMailboxesProtocolVersion}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := local.checkCompatible(test.remote)
+			if test.compatible && err != nil {
+				t.Fatalf("expected the peers to be compatible, got %v", err)
+			}
+			if !test.compatible && !errors.Is(err, ErrTCPMailboxesIncompatiblePeer) {
+				t.Fatalf("expected the peers to be incompatible, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTCPMailboxesIncompatiblePeers(t *testing.T) {
+	addrs := []string{freeLocalAddr(t)}
+	receiver := makeTCPMailboxesTest(t, 0, addrs)
+
+	// a peer speaking another protocol version is told which version the mailbox speaks, before being disconnected
+	conn, err := net.DialTimeout("tcp", addrs[0], time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	if err := (tcpMailboxesPreamble{Version: tcpMailboxesProtocolVersion + 1}).write(conn); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	reply, err := readTCPMailboxesPreamble(conn)
+	if err != nil || reply.Version != tcpMailboxesProtocolVersion || reply.Codec != "gob" {
+		t.Fatalf("expected the mailbox to reply with its own preamble, got %v, %v", reply, err)
+	}
+	if _, err := conn.Read(make([]byte, 1)); err == nil {
+		t.Fatal("expected the mailbox to disconnect")
+	}
+
+	// and a sender with a different codec aborts instead of sending anything
+	sender := makeTCPMailboxesTest(t, -1, addrs, WithMailboxCodec(JSONMailboxCodec{}))
+	if err := mailboxesTestIndex(t, sender, 0).WriteValue(tla.MakeTLANumber(1)); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected writing with a different codec to abort, got %v", err)
+	}
+	mailboxesTestAbort(sender)
+	expectMailboxesTestEmpty(t, receiver, 0)
+}
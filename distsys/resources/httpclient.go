@@ -0,0 +1,380 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const (
+	httpClientTimeout      = 10 * time.Second
+	httpClientRetryBackoff = 100 * time.Millisecond
+)
+
+// ErrHTTPClientMalformedRequest is returned when a value written to an HTTP client resource is not a valid request
+// record.
+var ErrHTTPClientMalformedRequest = errors.New("HTTP request must be a record [url |-> ..., method |-> ..., headers |-> ..., body |-> ...], with only url required")
+
+var (
+	httpURLField     = tla.MakeTLAString("url")
+	httpMethodField  = tla.MakeTLAString("method")
+	httpHeadersField = tla.MakeTLAString("headers")
+	httpBodyField    = tla.MakeTLAString("body")
+	httpStatusField  = tla.MakeTLAString("status")
+	httpErrorField   = tla.MakeTLAString("error")
+)
+
+// httpIdempotentMethods are the methods that RFC 7231 defines as idempotent, which may always be retried.
+var httpIdempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+	http.MethodTrace:   true,
+}
+
+type httpClientConfig struct {
+	client         *http.Client
+	readTimeout    time.Duration
+	retries        int
+	retryBackoff   time.Duration
+	idempotencyKey string
+}
+
+// HTTPClientOption configures a resource made by HTTPClientMaker.
+type HTTPClientOption func(cfg *httpClientConfig)
+
+// WithHTTPClient sets the http.Client used to make requests. The default has a 10 second timeout.
+func WithHTTPClient(client *http.Client) HTTPClientOption {
+	return func(cfg *httpClientConfig) {
+		cfg.client = client
+	}
+}
+
+// WithHTTPClientReadTimeout sets how long a read waits for a response, before aborting the critical section.
+func WithHTTPClientReadTimeout(timeout time.Duration) HTTPClientOption {
+	return func(cfg *httpClientConfig) {
+		cfg.readTimeout = timeout
+	}
+}
+
+// WithHTTPClientRetries retries a request up to retries times, waiting backoff, doubled after each attempt, in
+// between. Requests are retried on network errors and 5xx responses, and only if their method is idempotent, or
+// they carry an idempotency key (see WithHTTPClientIdempotencyKey).
+func WithHTTPClientRetries(retries int, backoff time.Duration) HTTPClientOption {
+	return func(cfg *httpClientConfig) {
+		cfg.retries = retries
+		cfg.retryBackoff = backoff
+	}
+}
+
+// WithHTTPClientIdempotencyKey adds a header named header, such as "Idempotency-Key", to each request, holding a
+// random key that stays the same across retries. Services that support such keys perform retried requests only
+// once, which makes it safe to retry any request, including POSTs.
+func WithHTTPClientIdempotencyKey(header string) HTTPClientOption {
+	return func(cfg *httpClientConfig) {
+		cfg.idempotencyKey = header
+	}
+}
+
+// httpClientRequest is a request written to an HTTP client resource, ready to be sent.
+type httpClientRequest struct {
+	method  string
+	url     string
+	headers map[string]string
+	body    []byte
+	key     string
+}
+
+// HTTPClientMaker produces a resource that lets an archetype call external HTTP services. Writing a request record
+// [url |-> "https://...", method |-> "POST", headers |-> [Content-Type |-> "text/plain"], body |-> "..."], of which
+// only url is required, and method defaults to GET, queues that request. The requests written by a critical section
+// are only sent once it commits, in order, so aborted critical sections never reach the service.
+//
+// Reading the resource returns the response to the oldest request whose response has not been read yet, as a record
+// [status |-> 200, headers |-> [...], body |-> "...", error |-> ""], waiting for it if needed, and aborting the
+// critical section if it takes too long. If the request could not be made, status is 0 and error describes why.
+// Responses read by a critical section that aborts are read again by the next one. Header names in responses are
+// canonicalized, and only the first value of each is kept.
+func HTTPClientMaker(opts ...HTTPClientOption) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			res := &httpClientResource{
+				changed: make(chan struct{}),
+				done:    make(chan struct{}),
+			}
+			res.ctx, res.cancel = context.WithCancel(context.Background())
+			go res.sendLoop()
+			return res
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*httpClientResource)
+			cfg := &httpClientConfig{
+				client:       &http.Client{Timeout: httpClientTimeout},
+				readTimeout:  inputChannelReadTimout,
+				retryBackoff: httpClientRetryBackoff,
+			}
+			for _, opt := range opts {
+				opt(cfg)
+			}
+			r.lock.Lock()
+			r.config = cfg
+			r.lock.Unlock()
+		},
+	}
+}
+
+type httpClientResource struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+
+	// pending holds the requests written by the current critical section
+	pending   []httpClientRequest
+	readCount int
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	lock   sync.Mutex
+	config *httpClientConfig
+	// queue holds committed requests that have not been sent yet
+	queue []httpClientRequest
+	// responses holds the responses that have not been consumed yet, the first readCount of which were read by the
+	// current critical section
+	responses []tla.TLAValue
+	// changed is closed and replaced whenever queue or responses grow
+	changed chan struct{}
+}
+
+var _ distsys.ArchetypeResource = &httpClientResource{}
+
+func (res *httpClientResource) notifyLocked() {
+	close(res.changed)
+	res.changed = make(chan struct{})
+}
+
+func (res *httpClientResource) sendLoop() {
+	defer close(res.done)
+	for {
+		res.lock.Lock()
+		if len(res.queue) == 0 {
+			changed := res.changed
+			res.lock.Unlock()
+			select {
+			case <-changed:
+				continue
+			case <-res.ctx.Done():
+				return
+			}
+		}
+		request := res.queue[0]
+		res.queue = res.queue[1:]
+		config := res.config
+		res.lock.Unlock()
+
+		response := res.send(config, request)
+		res.lock.Lock()
+		res.responses = append(res.responses, response)
+		res.notifyLocked()
+		res.lock.Unlock()
+	}
+}
+
+// send makes request, retrying as configured, and returns the response record.
+func (res *httpClientResource) send(config *httpClientConfig, request httpClientRequest) tla.TLAValue {
+	retryable := httpIdempotentMethods[request.method] || request.key != ""
+	backoff := config.retryBackoff
+	for attempt := 0; ; attempt++ {
+		response, err := res.sendOnce(config, request)
+		canRetry := retryable && attempt < config.retries && res.ctx.Err() == nil
+		if err == nil && (response.status < 500 || !canRetry) {
+			return response.record
+		}
+		if err != nil && !canRetry {
+			return tla.MakeTLARecord([]tla.TLARecordField{
+				{Key: httpStatusField, Value: tla.MakeTLANumber(0)},
+				{Key: httpHeadersField, Value: tla.MakeTLARecord(nil)},
+				{Key: httpBodyField, Value: tla.MakeTLAString("")},
+				{Key: httpErrorField, Value: tla.MakeTLAString(err.Error())},
+			})
+		}
+		if err != nil {
+			res.log(distsys.LogWarn, "HTTP request failed, retrying", "method", request.method, "url", request.url, "error", err)
+		} else {
+			res.log(distsys.LogWarn, "HTTP request returned a server error, retrying", "method", request.method, "url", request.url, "status", response.status)
+		}
+		select {
+		case <-time.After(backoff):
+		case <-res.ctx.Done():
+		}
+		backoff *= 2
+	}
+}
+
+type httpClientResponse struct {
+	status int
+	record tla.TLAValue
+}
+
+func (res *httpClientResource) sendOnce(config *httpClientConfig, request httpClientRequest) (httpClientResponse, error) {
+	req, err := http.NewRequestWithContext(res.ctx, request.method, request.url, bytes.NewReader(request.body))
+	if err != nil {
+		return httpClientResponse{}, err
+	}
+	for name, value := range request.headers {
+		req.Header.Set(name, value)
+	}
+	if request.key != "" {
+		req.Header.Set(config.idempotencyKey, request.key)
+	}
+	resp, err := config.client.Do(req)
+	if err != nil {
+		return httpClientResponse{}, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return httpClientResponse{}, err
+	}
+	return httpClientResponse{
+		status: resp.StatusCode,
+		record: tla.MakeTLARecord([]tla.TLARecordField{
+			{Key: httpStatusField, Value: tla.MakeTLANumber(int32(resp.StatusCode))},
+			{Key: httpHeadersField, Value: httpHeadersToTLA(resp.Header)},
+			{Key: httpBodyField, Value: tla.MakeTLAString(string(body))},
+			{Key: httpErrorField, Value: tla.MakeTLAString("")},
+		}),
+	}, nil
+}
+
+// httpHeadersToTLA converts headers to a record mapping each name to its first value.
+func httpHeadersToTLA(headers http.Header) tla.TLAValue {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fields := make([]tla.TLARecordField, 0, len(names))
+	for _, name := range names {
+		if values := headers[name]; len(values) != 0 {
+			fields = append(fields, tla.TLARecordField{Key: tla.MakeTLAString(name), Value: tla.MakeTLAString(values[0])})
+		}
+	}
+	return tla.MakeTLARecord(fields)
+}
+
+// httpHeadersFromTLA converts a record of string header values to a map.
+func httpHeadersFromTLA(value tla.TLAValue) (map[string]string, bool) {
+	if !value.IsFunction() {
+		return nil, false
+	}
+	headers := make(map[string]string)
+	ok := true
+	value.All()(func(name, headerValue tla.TLAValue) bool {
+		ok = name.IsString() && headerValue.IsString()
+		if ok {
+			headers[name.AsString()] = headerValue.AsString()
+		}
+		return ok
+	})
+	return headers, ok
+}
+
+func (res *httpClientResource) Abort() chan struct{} {
+	res.pending = nil
+	res.readCount = 0
+	return nil
+}
+
+func (res *httpClientResource) PreCommit() chan error {
+	return nil
+}
+
+func (res *httpClientResource) Commit() chan struct{} {
+	res.lock.Lock()
+	defer res.lock.Unlock()
+	res.responses = res.responses[res.readCount:]
+	res.readCount = 0
+	if len(res.pending) != 0 {
+		res.queue = append(res.queue, res.pending...)
+		res.pending = nil
+		res.notifyLocked()
+	}
+	return nil
+}
+
+func (res *httpClientResource) ReadValue() (tla.TLAValue, error) {
+	res.lock.Lock()
+	timeout := time.After(res.config.readTimeout)
+	for res.readCount == len(res.responses) {
+		changed := res.changed
+		res.lock.Unlock()
+		select {
+		case <-changed:
+		case <-timeout:
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+		res.lock.Lock()
+	}
+	defer res.lock.Unlock()
+	value := res.responses[res.readCount]
+	res.readCount++
+	return value, nil
+}
+
+func (res *httpClientResource) WriteValue(value tla.TLAValue) error {
+	if !value.IsFunction() {
+		return fmt.Errorf("%w: %v", ErrHTTPClientMalformedRequest, value)
+	}
+	fields := value.AsFunction()
+	request := httpClientRequest{method: http.MethodGet}
+	url, ok := fields.Get(httpURLField)
+	if !ok || !url.(tla.TLAValue).IsString() {
+		return fmt.Errorf("%w: %v", ErrHTTPClientMalformedRequest, value)
+	}
+	request.url = url.(tla.TLAValue).AsString()
+	if method, ok := fields.Get(httpMethodField); ok {
+		if !method.(tla.TLAValue).IsString() {
+			return fmt.Errorf("%w: %v", ErrHTTPClientMalformedRequest, value)
+		}
+		request.method = method.(tla.TLAValue).AsString()
+	}
+	if headers, ok := fields.Get(httpHeadersField); ok {
+		request.headers, ok = httpHeadersFromTLA(headers.(tla.TLAValue))
+		if !ok {
+			return fmt.Errorf("%w: %v", ErrHTTPClientMalformedRequest, value)
+		}
+	}
+	if body, ok := fields.Get(httpBodyField); ok {
+		if !body.(tla.TLAValue).IsString() {
+			return fmt.Errorf("%w: %v", ErrHTTPClientMalformedRequest, value)
+		}
+		request.body = []byte(body.(tla.TLAValue).AsString())
+	}
+	if res.config.idempotencyKey != "" {
+		request.key = fmt.Sprintf("%016x%016x", rand.Uint64(), rand.Uint64())
+	}
+	res.pending = append(res.pending, request)
+	return nil
+}
+
+// Close cancels any request in progress, and drops the requests not sent yet.
+func (res *httpClientResource) Close() error {
+	res.cancel()
+	<-res.done
+	return nil
+}
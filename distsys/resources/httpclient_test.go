@@ -0,0 +1,228 @@
+package resources
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// httpClientTestServer answers each request with the next of its statuses, then with 200 once they run out, and
+// records the requests it received.
+type httpClientTestServer struct {
+	*httptest.Server
+	lock     sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   []string
+}
+
+func newHTTPClientTestServer(t *testing.T, statuses ...int) *httpClientTestServer {
+	server := &httpClientTestServer{statuses: statuses}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		server.lock.Lock()
+		server.requests = append(server.requests, r)
+		server.bodies = append(server.bodies, string(body))
+		status := http.StatusOK
+		if len(server.statuses) != 0 {
+			status, server.statuses = server.statuses[0], server.statuses[1:]
+		}
+		server.lock.Unlock()
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(r.Method + " " + r.URL.Path))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (server *httpClientTestServer) received() []*http.Request {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	return append([]*http.Request(nil), server.requests...)
+}
+
+func makeHTTPClientTest(t *testing.T, opts ...HTTPClientOption) distsys.ArchetypeResource {
+	maker := HTTPClientMaker(append([]HTTPClientOption{WithHTTPClientReadTimeout(5 * time.Second)}, opts...)...)
+	res := maker.Make()
+	maker.Configure(res)
+	t.Cleanup(func() {
+		_ = res.Close()
+	})
+	return res
+}
+
+func httpClientTestRequest(url, method string) tla.TLAValue {
+	return tla.MakeTLARecord([]tla.TLARecordField{
+		{Key: httpURLField, Value: tla.MakeTLAString(url)},
+		{Key: httpMethodField, Value: tla.MakeTLAString(method)},
+		{Key: httpBodyField, Value: tla.MakeTLAString("body")},
+	})
+}
+
+// httpClientTestStatus reads the next response, and returns its status.
+func httpClientTestStatus(t *testing.T, res distsys.ArchetypeResource) int32 {
+	t.Helper()
+	response, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response.ApplyFunction(httpStatusField).AsNumber()
+}
+
+func TestHTTPClientSendsOnCommitOnly(t *testing.T) {
+	server := newHTTPClientTestServer(t)
+	res := makeHTTPClientTest(t)
+
+	if err := res.WriteValue(httpClientTestRequest(server.URL+"/aborted", http.MethodPost)); err != nil {
+		t.Fatal(err)
+	}
+	if ch := res.Abort(); ch != nil {
+		<-ch
+	}
+	if err := res.WriteValue(httpClientTestRequest(server.URL+"/committed", http.MethodPost)); err != nil {
+		t.Fatal(err)
+	}
+	if requests := server.received(); len(requests) != 0 {
+		t.Fatalf("expected no request to be sent before commit, %d were", len(requests))
+	}
+	raftCounterTestCommit(t, res)
+
+	response, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedBody := tla.MakeTLAString("POST /committed")
+	if body := response.ApplyFunction(httpBodyField); !body.Equal(expectedBody) {
+		t.Fatalf("expected the response to the committed request, %v, got %v", expectedBody, response)
+	}
+	if requests := server.received(); len(requests) != 1 {
+		t.Fatalf("expected only the committed request to be sent, %d were", len(requests))
+	}
+}
+
+func TestHTTPClientRereadsAfterAbort(t *testing.T) {
+	server := newHTTPClientTestServer(t)
+	res := makeHTTPClientTest(t)
+
+	for _, path := range []string{"/a", "/b"} {
+		if err := res.WriteValue(httpClientTestRequest(server.URL+path, http.MethodGet)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	raftCounterTestCommit(t, res)
+
+	first, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ch := res.Abort(); ch != nil {
+		<-ch
+	}
+	again, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.Equal(first) {
+		t.Fatalf("expected the response read by the aborted critical section to be read again, got %v, then %v", first, again)
+	}
+	raftCounterTestCommit(t, res)
+	second, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := second.ApplyFunction(httpBodyField); !body.Equal(tla.MakeTLAString("GET /b")) {
+		t.Fatalf("expected the committed read to consume the first response, got %v", second)
+	}
+}
+
+func TestHTTPClientRetries(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		opts             []HTTPClientOption
+		expectedAttempts int
+		expectedStatus   int32
+	}{
+		{name: "idempotent method", method: http.MethodPut, expectedAttempts: 3, expectedStatus: 200},
+		{name: "non-idempotent method", method: http.MethodPost, expectedAttempts: 1, expectedStatus: 503},
+		{
+			name:             "idempotency key",
+			method:           http.MethodPost,
+			opts:             []HTTPClientOption{WithHTTPClientIdempotencyKey("Idempotency-Key")},
+			expectedAttempts: 3,
+			expectedStatus:   200,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newHTTPClientTestServer(t, http.StatusServiceUnavailable, http.StatusBadGateway)
+			opts := append([]HTTPClientOption{WithHTTPClientRetries(3, time.Millisecond)}, test.opts...)
+			res := makeHTTPClientTest(t, opts...)
+
+			if err := res.WriteValue(httpClientTestRequest(server.URL, test.method)); err != nil {
+				t.Fatal(err)
+			}
+			raftCounterTestCommit(t, res)
+			if status := httpClientTestStatus(t, res); status != test.expectedStatus {
+				t.Fatalf("expected status %d, got %d", test.expectedStatus, status)
+			}
+			requests := server.received()
+			if len(requests) != test.expectedAttempts {
+				t.Fatalf("expected %d attempts, got %d", test.expectedAttempts, len(requests))
+			}
+			server.lock.Lock()
+			defer server.lock.Unlock()
+			for _, body := range server.bodies {
+				if body != "body" {
+					t.Fatalf("expected every attempt to carry the request body, got %q", body)
+				}
+			}
+		})
+	}
+}
+
+func TestHTTPClientIdempotencyKeyKeptAcrossRetries(t *testing.T) {
+	server := newHTTPClientTestServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	res := makeHTTPClientTest(t, WithHTTPClientRetries(3, 20*time.Millisecond), WithHTTPClientIdempotencyKey("Idempotency-Key"))
+
+	for i := 0; i < 2; i++ {
+		if err := res.WriteValue(httpClientTestRequest(server.URL, http.MethodPost)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	start := time.Now()
+	raftCounterTestCommit(t, res)
+	for i := 0; i < 2; i++ {
+		if status := httpClientTestStatus(t, res); status != 200 {
+			t.Fatalf("expected status 200, got %d", status)
+		}
+	}
+	// the backoff doubles after each attempt
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Fatalf("expected retries to back off for 20ms, then 40ms, but they took %v in all", elapsed)
+	}
+
+	// the first request took three attempts, and the second one attempt
+	requests := server.received()
+	if len(requests) != 4 {
+		t.Fatalf("expected 4 attempts, got %d", len(requests))
+	}
+	key := requests[0].Header.Get("Idempotency-Key")
+	if key == "" {
+		t.Fatalf("expected requests to carry an idempotency key")
+	}
+	for _, request := range requests[1:3] {
+		if retryKey := request.Header.Get("Idempotency-Key"); retryKey != key {
+			t.Fatalf("expected retries to keep the key %s, got %s", key, retryKey)
+		}
+	}
+	if otherKey := requests[3].Header.Get("Idempotency-Key"); otherKey == key || otherKey == "" {
+		t.Fatalf("expected another request to get another key than %s, got %q", key, otherKey)
+	}
+}
@@ -0,0 +1,203 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const (
+	httpServerCapacity        = 100
+	httpServerResponseTimeout = 30 * time.Second
+	httpServerMaxBodySize     = 1 << 20
+)
+
+var (
+	httpPathField  = tla.MakeTLAString("path")
+	httpQueryField = tla.MakeTLAString("query")
+)
+
+type httpServerConfig struct {
+	capacity        int
+	responseTimeout time.Duration
+	maxBodySize     int64
+}
+
+// HTTPServerOption configures a resource made by HTTPServerMaker.
+type HTTPServerOption func(cfg *httpServerConfig)
+
+// WithHTTPServerCapacity sets how many requests may wait for the archetype to read them. Further requests wait
+// for room, until their response timeout.
+func WithHTTPServerCapacity(capacity int) HTTPServerOption {
+	return func(cfg *httpServerConfig) {
+		cfg.capacity = capacity
+	}
+}
+
+// WithHTTPServerResponseTimeout sets how long a request waits for the archetype's response, before it is answered
+// with 503 Service Unavailable.
+func WithHTTPServerResponseTimeout(timeout time.Duration) HTTPServerOption {
+	return func(cfg *httpServerConfig) {
+		cfg.responseTimeout = timeout
+	}
+}
+
+// WithHTTPServerMaxBodySize sets the largest request body accepted, in bytes. Larger requests are answered with 413
+// Request Entity Too Large, without reaching the archetype.
+func WithHTTPServerMaxBodySize(size int64) HTTPServerOption {
+	return func(cfg *httpServerConfig) {
+		cfg.maxBodySize = size
+	}
+}
+
+// HTTPServerMaker produces a resource that serves HTTP on listenAddr, fronting an archetype with a REST API. The
+// listener is started when the resource is made, and stopped when it is closed.
+//
+// Reading the resource yields the next incoming request, as a record [method |-> "POST", path |-> "/orders",
+// query |-> [...], headers |-> [...], body |-> "..."], where query and headers map each name to its first value.
+// Writing the resource responds to the earliest request read that has not been responded to yet, as with
+// RequestResponseChannelMaker, when the critical section commits. A response may be a record [status |-> 201,
+// headers |-> [...], body |-> "..."], any field of which may be omitted; a string, sent as a text/plain body; or
+// any other value, sent as JSON as described in RPCEndpoint.ServeHTTP. Requests the archetype does not respond to
+// in time are answered with 503 Service Unavailable.
+func HTTPServerMaker(listenAddr string, opts ...HTTPServerOption) distsys.ArchetypeResourceMaker {
+	cfg := &httpServerConfig{
+		capacity:        httpServerCapacity,
+		responseTimeout: httpServerResponseTimeout,
+		maxBodySize:     httpServerMaxBodySize,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		channel := NewRequestResponseChannel(cfg.capacity)
+		listener, err := net.Listen("tcp", listenAddr)
+		if err != nil {
+			panic(fmt.Errorf("could not listen on address %s: %w", listenAddr, err))
+		}
+		res := &httpServerResource{
+			RequestResponseChannelResource: RequestResponseChannelResource{channel: channel},
+			config:                         cfg,
+		}
+		res.log(distsys.LogInfo, "started listening", "address", listenAddr)
+		res.server = &http.Server{Handler: res}
+		go func() {
+			err := res.server.Serve(listener)
+			if err != http.ErrServerClosed {
+				panic(fmt.Errorf("error serving HTTP on %s: %w", listenAddr, err))
+			}
+		}()
+		return res
+	})
+}
+
+type httpServerResource struct {
+	RequestResponseChannelResource
+	resourceLogger
+	config *httpServerConfig
+	server *http.Server
+}
+
+var _ distsys.ArchetypeResource = &httpServerResource{}
+var _ http.Handler = &httpServerResource{}
+
+func (res *httpServerResource) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, res.config.maxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	request := tla.MakeTLARecord([]tla.TLARecordField{
+		{Key: httpMethodField, Value: tla.MakeTLAString(r.Method)},
+		{Key: httpPathField, Value: tla.MakeTLAString(r.URL.Path)},
+		{Key: httpQueryField, Value: httpHeadersToTLA(http.Header(r.URL.Query()))},
+		{Key: httpHeadersField, Value: httpHeadersToTLA(r.Header)},
+		{Key: httpBodyField, Value: tla.MakeTLAString(string(body))},
+	})
+
+	ctx, cancel := context.WithTimeout(r.Context(), res.config.responseTimeout)
+	defer cancel()
+	response, err := res.channel.Call(ctx, request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	writeHTTPServerResponse(w, response)
+}
+
+// writeHTTPServerResponse writes response, as described in HTTPServerMaker.
+func writeHTTPServerResponse(w http.ResponseWriter, response tla.TLAValue) {
+	status := http.StatusOK
+	var body []byte
+	switch {
+	case response.IsString():
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		body = []byte(response.AsString())
+	case response.IsFunction() && isHTTPServerResponseRecord(response):
+		fields := response.AsFunction()
+		if statusValue, ok := fields.Get(httpStatusField); ok {
+			status = int(statusValue.(tla.TLAValue).AsNumber())
+		}
+		if headersValue, ok := fields.Get(httpHeadersField); ok {
+			headers, _ := httpHeadersFromTLA(headersValue.(tla.TLAValue))
+			for name, value := range headers {
+				w.Header().Set(name, value)
+			}
+		}
+		if bodyValue, ok := fields.Get(httpBodyField); ok {
+			body = []byte(bodyValue.(tla.TLAValue).AsString())
+		}
+	default:
+		encoded, err := json.Marshal(tlaToJSON(response))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("could not encode response: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		body = encoded
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// isHTTPServerResponseRecord checks whether response is a record with only well-typed status, headers and body
+// fields, rather than arbitrary data to send as JSON.
+func isHTTPServerResponseRecord(response tla.TLAValue) bool {
+	it := response.AsFunction().Iterator()
+	if it.Done() {
+		return false
+	}
+	for !it.Done() {
+		key, value := it.Next()
+		keyValue, fieldValue := key.(tla.TLAValue), value.(tla.TLAValue)
+		switch {
+		case keyValue.Equal(httpStatusField):
+			if _, err := fieldValue.TryAsNumber(); err != nil {
+				return false
+			}
+		case keyValue.Equal(httpHeadersField):
+			if _, ok := httpHeadersFromTLA(fieldValue); !ok {
+				return false
+			}
+		case keyValue.Equal(httpBodyField):
+			if !fieldValue.IsString() {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Close stops the server, without waiting for requests in progress to be answered.
+func (res *httpServerResource) Close() error {
+	return res.server.Close()
+}
@@ -0,0 +1,191 @@
+package resources
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+type httpServerTestResponse struct {
+	status int
+	header http.Header
+	body   string
+	err    error
+}
+
+func makeHTTPServerTest(t *testing.T, opts ...HTTPServerOption) (distsys.ArchetypeResource, string) {
+	t.Helper()
+	addr := freeLocalAddr(t)
+	maker := HTTPServerMaker(addr, opts...)
+	res := maker.Make()
+	maker.Configure(res)
+	t.Cleanup(func() {
+		_ = res.Close()
+	})
+	return res, "http://" + addr
+}
+
+// httpServerTestSend sends a request in the background, and returns a channel the response will be sent to.
+func httpServerTestSend(method, url, body string) chan httpServerTestResponse {
+	ch := make(chan httpServerTestResponse, 1)
+	go func() {
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		if err != nil {
+			ch <- httpServerTestResponse{err: err}
+			return
+		}
+		req.Header.Set("X-Test", "yes")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			ch <- httpServerTestResponse{err: err}
+			return
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		respBody, err := ioutil.ReadAll(resp.Body)
+		ch <- httpServerTestResponse{status: resp.StatusCode, header: resp.Header, body: string(respBody), err: err}
+	}()
+	return ch
+}
+
+// httpServerTestRead reads the next request, waiting for it to arrive.
+func httpServerTestRead(t *testing.T, res distsys.ArchetypeResource) tla.TLAValue {
+	t.Helper()
+	var request tla.TLAValue
+	awaitCondition(t, "a request to arrive", func() bool {
+		var err error
+		request, err = res.ReadValue()
+		if err != nil && !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatal(err)
+		}
+		return err == nil
+	})
+	return request
+}
+
+func TestHTTPServerRequestResponse(t *testing.T) {
+	res, url := makeHTTPServerTest(t)
+	responseCh := httpServerTestSend(http.MethodPost, url+"/orders?id=7", "one order")
+
+	request := httpServerTestRead(t, res)
+	expectedFields := []tla.TLARecordField{
+		{Key: httpMethodField, Value: tla.MakeTLAString("POST")},
+		{Key: httpPathField, Value: tla.MakeTLAString("/orders")},
+		{Key: httpQueryField, Value: tla.MakeTLARecord([]tla.TLARecordField{{Key: tla.MakeTLAString("id"), Value: tla.MakeTLAString("7")}})},
+		{Key: httpBodyField, Value: tla.MakeTLAString("one order")},
+	}
+	for _, field := range expectedFields {
+		if value := request.ApplyFunction(field.Key); !value.Equal(field.Value) {
+			t.Fatalf("expected the request's %v to be %v, got %v", field.Key, field.Value, value)
+		}
+	}
+	if header := request.ApplyFunction(httpHeadersField).ApplyFunction(tla.MakeTLAString("X-Test")); !header.Equal(tla.MakeTLAString("yes")) {
+		t.Fatalf("expected the request's headers to include X-Test, got %v", request)
+	}
+
+	// a response written by an aborted critical section is not sent, and the request is read again
+	if err := res.WriteValue(tla.MakeTLAString("aborted")); err != nil {
+		t.Fatal(err)
+	}
+	if ch := res.Abort(); ch != nil {
+		<-ch
+	}
+	select {
+	case response := <-responseCh:
+		t.Fatalf("expected no response after an abort, got %+v", response)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if again := httpServerTestRead(t, res); !again.Equal(request) {
+		t.Fatalf("expected the request to be read again after an abort, got %v", again)
+	}
+
+	response := tla.MakeTLARecord([]tla.TLARecordField{
+		{Key: httpStatusField, Value: tla.MakeTLANumber(201)},
+		{Key: httpHeadersField, Value: tla.MakeTLARecord([]tla.TLARecordField{{Key: tla.MakeTLAString("Location"), Value: tla.MakeTLAString("/orders/7")}})},
+		{Key: httpBodyField, Value: tla.MakeTLAString("created")},
+	})
+	if err := res.WriteValue(response); err != nil {
+		t.Fatal(err)
+	}
+	raftCounterTestCommit(t, res)
+	received := <-responseCh
+	if received.err != nil {
+		t.Fatal(received.err)
+	}
+	if received.status != 201 || received.body != "created" || received.header.Get("Location") != "/orders/7" {
+		t.Fatalf("expected the committed response, got %+v", received)
+	}
+}
+
+func TestHTTPServerResponseEncodings(t *testing.T) {
+	tests := []struct {
+		name         string
+		response     tla.TLAValue
+		expectedType string
+		expectedBody string
+	}{
+		{name: "string", response: tla.MakeTLAString("hello"), expectedType: "text/plain; charset=utf-8", expectedBody: "hello"},
+		{
+			name:         "other value",
+			response:     tla.MakeTLATuple(tla.MakeTLANumber(1), tla.MakeTLAString("two")),
+			expectedType: "application/json",
+			expectedBody: `[1,"two"]`,
+		},
+	}
+	res, url := makeHTTPServerTest(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			responseCh := httpServerTestSend(http.MethodGet, url, "")
+			httpServerTestRead(t, res)
+			if err := res.WriteValue(test.response); err != nil {
+				t.Fatal(err)
+			}
+			raftCounterTestCommit(t, res)
+			received := <-responseCh
+			if received.err != nil {
+				t.Fatal(received.err)
+			}
+			if received.status != 200 || received.body != test.expectedBody || received.header.Get("Content-Type") != test.expectedType {
+				t.Fatalf("expected a %s response %s, got %+v", test.expectedType, test.expectedBody, received)
+			}
+		})
+	}
+}
+
+func TestHTTPServerRejections(t *testing.T) {
+	res, url := makeHTTPServerTest(t, WithHTTPServerResponseTimeout(50*time.Millisecond), WithHTTPServerMaxBodySize(4))
+
+	// a request the archetype never responds to times out
+	received := <-httpServerTestSend(http.MethodGet, url, "")
+	if received.err != nil {
+		t.Fatal(received.err)
+	}
+	if received.status != http.StatusServiceUnavailable {
+		t.Fatalf("expected a request without a response to be answered with 503, got %+v", received)
+	}
+
+	// a request with too large a body never reaches the archetype
+	received = <-httpServerTestSend(http.MethodPost, url, "too large")
+	if received.err != nil {
+		t.Fatal(received.err)
+	}
+	if received.status != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected a request with too large a body to be answered with 413, got %+v", received)
+	}
+	// only the request that timed out was queued, and responding to it now is harmless
+	httpServerTestRead(t, res)
+	if err := res.WriteValue(tla.MakeTLAString("late")); err != nil {
+		t.Fatal(err)
+	}
+	raftCounterTestCommit(t, res)
+	if _, err := res.ReadValue(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected no other request to be queued, got %v", err)
+	}
+}
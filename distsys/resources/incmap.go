@@ -1,6 +1,11 @@
 package resources
 
 import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"strings"
+
 	"github.com/UBC-NSS/pgo/distsys"
 	"github.com/UBC-NSS/pgo/distsys/tla"
 	"github.com/benbjohnson/immutable"
@@ -19,9 +24,13 @@ type IncrementalMap struct {
 	realizedMap  *immutable.Map
 	fillFunction FillFn
 	dirtyElems   *immutable.Map
+	logger       distsys.Logger // nil unless SetLogger was called
 }
 
 var _ distsys.ArchetypeResource = &IncrementalMap{}
+var _ distsys.Snapshotter = &IncrementalMap{}
+var _ distsys.LoggingArchetypeResource = &IncrementalMap{}
+var _ distsys.StatusArchetypeResource = &IncrementalMap{}
 
 func IncrementalMapMaker(fillFunction FillFn) distsys.ArchetypeResourceMaker {
 	return distsys.ArchetypeResourceMakerStruct{
@@ -49,6 +58,7 @@ func (res *IncrementalMap) Index(index tla.TLAValue) (distsys.ArchetypeResource,
 
 	subRes := maker.Make()
 	maker.Configure(subRes)
+	res.setElementLogger(index, subRes)
 	res.realizedMap = res.realizedMap.Set(index, subRes)
 	res.dirtyElems = res.dirtyElems.Set(index, subRes)
 	return subRes, nil
@@ -153,3 +163,93 @@ func (res *IncrementalMap) Close() error {
 	}
 	return err
 }
+
+// SetLogger passes logger on to the realized elements that log, now and as they are realized, adding their index.
+func (res *IncrementalMap) SetLogger(logger distsys.Logger) {
+	res.logger = logger
+	it := res.realizedMap.Iterator()
+	for !it.Done() {
+		index, r := it.Next()
+		res.setElementLogger(index.(tla.TLAValue), r.(distsys.ArchetypeResource))
+	}
+}
+
+func (res *IncrementalMap) setElementLogger(index tla.TLAValue, elem distsys.ArchetypeResource) {
+	if res.logger == nil {
+		return
+	}
+	if loggingElem, ok := elem.(distsys.LoggingArchetypeResource); ok {
+		loggingElem.SetLogger(distsys.LoggerWith(res.logger, "index", index))
+	}
+}
+
+// Status counts the realized elements, and lists the status of those that have one, one per line.
+func (res *IncrementalMap) Status() string {
+	var builder strings.Builder
+	_, _ = fmt.Fprintf(&builder, "%d elements realized", res.realizedMap.Len())
+	it := res.realizedMap.Iterator()
+	for !it.Done() {
+		index, r := it.Next()
+		if statusElem, ok := r.(distsys.StatusArchetypeResource); ok {
+			_, _ = fmt.Fprintf(&builder, "\n[%v] %s", index, statusElem.Status())
+		}
+	}
+	return builder.String()
+}
+
+type incrementalMapSnapshotEntry struct {
+	Index tla.TLAValue
+	Data  []byte
+}
+
+// Snapshot captures the state of every realized element that implements distsys.Snapshotter.
+func (res *IncrementalMap) Snapshot() ([]byte, error) {
+	var entries []incrementalMapSnapshotEntry
+	it := res.realizedMap.Iterator()
+	for !it.Done() {
+		index, r := it.Next()
+		snapshotter, ok := r.(distsys.Snapshotter)
+		if !ok {
+			continue
+		}
+		data, err := snapshotter.Snapshot()
+		if err != nil {
+			return nil, fmt.Errorf("could not snapshot element %v: %w", index, err)
+		}
+		entries = append(entries, incrementalMapSnapshotEntry{Index: index.(tla.TLAValue), Data: data})
+	}
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(entries)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Restore realizes each element captured by Snapshot, and restores its state.
+func (res *IncrementalMap) Restore(data []byte) error {
+	var entries []incrementalMapSnapshotEntry
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&entries)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		maker := res.fillFunction(entry.Index)
+		r, ok := res.realizedMap.Get(entry.Index)
+		if !ok {
+			r = maker.Make()
+			res.setElementLogger(entry.Index, r.(distsys.ArchetypeResource))
+			res.realizedMap = res.realizedMap.Set(entry.Index, r)
+		}
+		maker.Configure(r.(distsys.ArchetypeResource))
+		snapshotter, ok := r.(distsys.Snapshotter)
+		if !ok {
+			return fmt.Errorf("element %v cannot be restored", entry.Index)
+		}
+		err = snapshotter.Restore(entry.Data)
+		if err != nil {
+			return fmt.Errorf("could not restore element %v: %w", entry.Index, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,324 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const (
+	kafkaTimeout      = 5 * time.Second
+	kafkaRetryBackoff = 100 * time.Millisecond
+)
+
+// ErrKafkaOffsetOutOfRange is returned by a KafkaClient's Fetch when the requested offset is no longer held by the
+// partition, e.g. because retention deleted the records there.
+var ErrKafkaOffsetOutOfRange = errors.New("Kafka offset out of range")
+
+// KafkaRecord is a record fetched from a Kafka partition.
+type KafkaRecord struct {
+	Offset int64
+	Value  []byte
+}
+
+// KafkaClient is the subset of a Kafka client needed by KafkaMailboxesMaker. This package does not speak Kafka's
+// wire protocol; for franz-go, a small adapter over a kgo.Client, producing with all in-sync replicas acking and a
+// manual partitioner, and a kadm.Client wrapping it, is enough:
+//
+//	Produce:         cl.ProduceSync(ctx, records...), setting each record's Topic and Partition
+//	Fetch:           consume the partition from offset via cl.AddConsumePartitions, then cl.PollFetches(ctx),
+//	                 returning ErrKafkaOffsetOutOfRange for kerr.OffsetOutOfRange
+//	EarliestOffset:  adm.ListStartOffsets(ctx, topic)
+//	CommittedOffset: adm.FetchOffsets(ctx, group), returning -1 if the partition has none
+//	CommitOffset:    adm.CommitOffsets(ctx, group, offsets)
+type KafkaClient interface {
+	// Produce appends values to a partition, as one batch, returning once all in-sync replicas have them.
+	Produce(ctx context.Context, topic string, partition int32, values [][]byte) error
+	// Fetch returns the records of a partition from offset onwards, waiting up to maxWait for at least one to be
+	// available. It returns no records, and no error, if none became available.
+	Fetch(ctx context.Context, topic string, partition int32, offset int64, maxWait time.Duration) ([]KafkaRecord, error)
+	// EarliestOffset returns the offset of the oldest record still held by a partition.
+	EarliestOffset(ctx context.Context, topic string, partition int32) (int64, error)
+	// CommittedOffset returns the offset group has committed for a partition, or -1 if it has committed none.
+	CommittedOffset(ctx context.Context, group, topic string, partition int32) (int64, error)
+	// CommitOffset records offset as the next one group should consume from a partition.
+	CommitOffset(ctx context.Context, group, topic string, partition int32, offset int64) error
+}
+
+type kafkaConfig struct {
+	timeout     time.Duration
+	readTimeout time.Duration
+}
+
+// KafkaMailboxesOption configures the mailboxes made by KafkaMailboxesMaker.
+type KafkaMailboxesOption func(cfg *kafkaConfig)
+
+// WithKafkaTimeout sets the timeout of each request made through the KafkaClient.
+func WithKafkaTimeout(timeout time.Duration) KafkaMailboxesOption {
+	return func(cfg *kafkaConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithKafkaReadTimeout sets how long a read from an empty local mailbox waits for a record, before aborting the
+// critical section. It is passed to the KafkaClient's Fetch as maxWait.
+func WithKafkaReadTimeout(timeout time.Duration) KafkaMailboxesOption {
+	return func(cfg *kafkaConfig) {
+		cfg.readTimeout = timeout
+	}
+}
+
+// KafkaMailboxesMappingFn maps a mailbox index to the partition of the topic holding it, and whether it is to be
+// consumed by this node, as TCPMailboxesLocal, or only produced to, as TCPMailboxesRemote.
+type KafkaMailboxesMappingFn func(index tla.TLAValue) (TCPMailboxKind, int32)
+
+// KafkaMailboxesMaker produces mailboxes, like TCPMailboxesMaker, backed by the partitions of a Kafka topic, accessed
+// through client. Each index is mapped to one partition by mappingFn. Values are gob-encoded, one per record, with
+// no keys. The client is shared by all the mailboxes, and is not closed along with them.
+//
+// Writing to a remote mailbox produces the values written by a critical section, as one batch, when it commits;
+// Commit retries until the batch is acknowledged by all in-sync replicas. Reading from a local mailbox consumes the
+// partition from the offset last committed by the consumer group group, or from the earliest offset if there is
+// none, and commits the offset past the values read when the critical section commits. Aborted reads are delivered
+// again. A crash between producing or consuming and the critical section's commit may cause values to be
+// delivered twice, so the mailboxes are at-least-once, unlike TCPMailboxesMaker's.
+//
+// Only one node should consume each partition with a given group, as offsets are committed outside of Kafka's
+// group membership protocol.
+func KafkaMailboxesMaker(client KafkaClient, topic, group string, mappingFn KafkaMailboxesMappingFn, opts ...KafkaMailboxesOption) distsys.ArchetypeResourceMaker {
+	cfg := &kafkaConfig{
+		timeout:     kafkaTimeout,
+		readTimeout: inputChannelReadTimout,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		kind, partition := mappingFn(index)
+		switch kind {
+		case TCPMailboxesLocal:
+			return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+				return &kafkaMailboxLocal{
+					client:    client,
+					config:    cfg,
+					topic:     topic,
+					group:     group,
+					partition: partition,
+					position:  -1,
+				}
+			})
+		case TCPMailboxesRemote:
+			return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+				return &kafkaMailboxRemote{
+					client:    client,
+					config:    cfg,
+					topic:     topic,
+					partition: partition,
+				}
+			})
+		default:
+			panic(fmt.Errorf("invalid Kafka mailbox type %d for partition %d: expected local or remote, which are %d or %d", kind, partition, TCPMailboxesLocal, TCPMailboxesRemote))
+		}
+	})
+}
+
+type kafkaMailboxLocal struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	client    KafkaClient
+	config    *kafkaConfig
+	topic     string
+	group     string
+	partition int32
+
+	// position is the group's committed offset, or -1 before it is known
+	position int64
+	// backlog holds the records fetched from position onwards, the first readCount of which were read by the
+	// current critical section
+	backlog   []KafkaRecord
+	readCount int
+}
+
+var _ distsys.ArchetypeResource = &kafkaMailboxLocal{}
+
+func (res *kafkaMailboxLocal) Abort() chan struct{} {
+	res.readCount = 0
+	return nil
+}
+
+func (res *kafkaMailboxLocal) PreCommit() chan error {
+	return nil
+}
+
+func (res *kafkaMailboxLocal) Commit() chan struct{} {
+	if res.readCount == 0 {
+		return nil
+	}
+	doneCh := make(chan struct{})
+	go func() {
+		next := res.backlog[res.readCount-1].Offset + 1
+		for {
+			err := withKafkaTimeout(res.config, func(ctx context.Context) error {
+				return res.client.CommitOffset(ctx, res.group, res.topic, res.partition, next)
+			})
+			if err == nil {
+				break
+			}
+			res.log(distsys.LogWarn, "could not commit Kafka offset, retrying", "topic", res.topic, "partition", res.partition, "offset", next, "error", err)
+			time.Sleep(kafkaRetryBackoff)
+		}
+		res.position = next
+		res.backlog = res.backlog[res.readCount:]
+		res.readCount = 0
+		doneCh <- struct{}{}
+	}()
+	return doneCh
+}
+
+// withKafkaTimeout calls fn with a context that times out as configured by WithKafkaTimeout.
+func withKafkaTimeout(cfg *kafkaConfig, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.timeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+// initPosition finds where the group left off consuming the partition.
+func (res *kafkaMailboxLocal) initPosition() error {
+	return withKafkaTimeout(res.config, func(ctx context.Context) error {
+		offset, err := res.client.CommittedOffset(ctx, res.group, res.topic, res.partition)
+		if err != nil {
+			return err
+		}
+		if offset < 0 {
+			offset, err = res.client.EarliestOffset(ctx, res.topic, res.partition)
+			if err != nil {
+				return err
+			}
+		}
+		res.position = offset
+		return nil
+	})
+}
+
+func (res *kafkaMailboxLocal) ReadValue() (tla.TLAValue, error) {
+	if res.readCount == len(res.backlog) {
+		if res.position < 0 {
+			if err := res.initPosition(); err != nil {
+				res.log(distsys.LogWarn, "could not find Kafka offset", "topic", res.topic, "partition", res.partition, "group", res.group, "error", err)
+				return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+			}
+		}
+		next := res.position
+		if len(res.backlog) != 0 {
+			next = res.backlog[len(res.backlog)-1].Offset + 1
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), res.config.readTimeout+res.config.timeout)
+		records, err := res.client.Fetch(ctx, res.topic, res.partition, next, res.config.readTimeout)
+		cancel()
+		if errors.Is(err, ErrKafkaOffsetOutOfRange) && len(res.backlog) == 0 {
+			// the records at our position were deleted by retention; skip to the oldest remaining
+			res.log(distsys.LogWarn, "Kafka offset is out of range, restarting from the earliest offset", "topic", res.topic, "partition", res.partition, "offset", next)
+			err = withKafkaTimeout(res.config, func(ctx context.Context) error {
+				var err error
+				res.position, err = res.client.EarliestOffset(ctx, res.topic, res.partition)
+				return err
+			})
+			if err != nil {
+				res.position = -1
+			}
+		}
+		if err != nil {
+			res.log(distsys.LogWarn, "could not fetch from Kafka, aborting", "topic", res.topic, "partition", res.partition, "error", err)
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+		res.backlog = append(res.backlog, records...)
+		if res.readCount == len(res.backlog) {
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+	}
+
+	record := res.backlog[res.readCount]
+	var value tla.TLAValue
+	err := gob.NewDecoder(bytes.NewReader(record.Value)).Decode(&value)
+	if err != nil {
+		return tla.TLAValue{}, fmt.Errorf("could not decode the record at offset %d of %s/%d: %w", record.Offset, res.topic, res.partition, err)
+	}
+	res.readCount++
+	return value, nil
+}
+
+func (res *kafkaMailboxLocal) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write value %v to a local Kafka mailbox", value))
+}
+
+func (res *kafkaMailboxLocal) Close() error {
+	return nil
+}
+
+type kafkaMailboxRemote struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	client    KafkaClient
+	config    *kafkaConfig
+	topic     string
+	partition int32
+
+	buffer [][]byte
+}
+
+var _ distsys.ArchetypeResource = &kafkaMailboxRemote{}
+
+func (res *kafkaMailboxRemote) Abort() chan struct{} {
+	res.buffer = nil
+	return nil
+}
+
+func (res *kafkaMailboxRemote) PreCommit() chan error {
+	return nil
+}
+
+func (res *kafkaMailboxRemote) Commit() chan struct{} {
+	if len(res.buffer) == 0 {
+		return nil
+	}
+	doneCh := make(chan struct{})
+	go func() {
+		for {
+			err := withKafkaTimeout(res.config, func(ctx context.Context) error {
+				return res.client.Produce(ctx, res.topic, res.partition, res.buffer)
+			})
+			if err == nil {
+				break
+			}
+			res.log(distsys.LogWarn, "could not produce to Kafka, retrying", "topic", res.topic, "partition", res.partition, "error", err)
+			time.Sleep(kafkaRetryBackoff)
+		}
+		res.buffer = nil
+		doneCh <- struct{}{}
+	}()
+	return doneCh
+}
+
+func (res *kafkaMailboxRemote) ReadValue() (tla.TLAValue, error) {
+	panic(fmt.Errorf("attempted to read from a remote Kafka mailbox"))
+}
+
+func (res *kafkaMailboxRemote) WriteValue(value tla.TLAValue) error {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(&value)
+	if err != nil {
+		return err
+	}
+	res.buffer = append(res.buffer, buf.Bytes())
+	return nil
+}
+
+func (res *kafkaMailboxRemote) Close() error {
+	return nil
+}
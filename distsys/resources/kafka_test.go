@@ -0,0 +1,294 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const (
+	kafkaTestTopic = "mailboxes"
+	kafkaTestGroup = "archetypes"
+)
+
+var errKafkaTestUnavailable = errors.New("broker unavailable")
+
+// memoryKafkaClient is a KafkaClient holding one log per partition, and the offsets committed by each group, in
+// memory. The records before start have been deleted, as if by retention.
+type memoryKafkaClient struct {
+	lock      sync.Mutex
+	logs      map[int32][][]byte
+	start     map[int32]int64
+	committed map[string]int64 // group -> offset, for partition 0
+	requests  map[string]int
+	failNext  map[string]bool
+	// newRecords is closed and replaced whenever records are produced, to wake up fetches waiting for them
+	newRecords chan struct{}
+}
+
+var _ KafkaClient = &memoryKafkaClient{}
+
+func newMemoryKafkaClient() *memoryKafkaClient {
+	return &memoryKafkaClient{
+		logs:       make(map[int32][][]byte),
+		start:      make(map[int32]int64),
+		committed:  make(map[string]int64),
+		requests:   make(map[string]int),
+		failNext:   make(map[string]bool),
+		newRecords: make(chan struct{}),
+	}
+}
+
+// request counts a request to method, and fails it if failNextRequest was called for method.
+func (client *memoryKafkaClient) request(method string) error {
+	client.requests[method]++
+	if client.failNext[method] {
+		delete(client.failNext, method)
+		return errKafkaTestUnavailable
+	}
+	return nil
+}
+
+func (client *memoryKafkaClient) failNextRequest(method string) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	client.failNext[method] = true
+}
+
+func (client *memoryKafkaClient) requestCount(method string) int {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	return client.requests[method]
+}
+
+func (client *memoryKafkaClient) state() (log [][]byte, committed int64, hasCommitted bool) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	committed, hasCommitted = client.committed[kafkaTestGroup]
+	return client.logs[0], committed, hasCommitted
+}
+
+// deleteRecords deletes the records of partition 0 before offset, as retention would.
+func (client *memoryKafkaClient) deleteRecords(offset int64) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	client.start[0] = offset
+}
+
+func (client *memoryKafkaClient) Produce(ctx context.Context, topic string, partition int32, values [][]byte) error {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if err := client.request("Produce"); err != nil {
+		return err
+	}
+	client.logs[partition] = append(client.logs[partition], values...)
+	close(client.newRecords)
+	client.newRecords = make(chan struct{})
+	return nil
+}
+
+func (client *memoryKafkaClient) Fetch(ctx context.Context, topic string, partition int32, offset int64, maxWait time.Duration) ([]KafkaRecord, error) {
+	timeout := time.After(maxWait)
+	for {
+		client.lock.Lock()
+		if err := client.request("Fetch"); err != nil {
+			client.lock.Unlock()
+			return nil, err
+		}
+		log := client.logs[partition]
+		if offset < client.start[partition] || offset > int64(len(log)) {
+			client.lock.Unlock()
+			return nil, ErrKafkaOffsetOutOfRange
+		}
+		var records []KafkaRecord
+		for ; offset < int64(len(log)); offset++ {
+			records = append(records, KafkaRecord{Offset: offset, Value: log[offset]})
+		}
+		newRecords := client.newRecords
+		client.lock.Unlock()
+		if len(records) > 0 {
+			return records, nil
+		}
+		select {
+		case <-newRecords:
+		case <-timeout:
+			return nil, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (client *memoryKafkaClient) EarliestOffset(ctx context.Context, topic string, partition int32) (int64, error) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if err := client.request("EarliestOffset"); err != nil {
+		return 0, err
+	}
+	return client.start[partition], nil
+}
+
+func (client *memoryKafkaClient) CommittedOffset(ctx context.Context, group, topic string, partition int32) (int64, error) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if err := client.request("CommittedOffset"); err != nil {
+		return 0, err
+	}
+	if offset, ok := client.committed[group]; ok {
+		return offset, nil
+	}
+	return -1, nil
+}
+
+func (client *memoryKafkaClient) CommitOffset(ctx context.Context, group, topic string, partition int32, offset int64) error {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if err := client.request("CommitOffset"); err != nil {
+		return err
+	}
+	client.committed[group] = offset
+	return nil
+}
+
+const (
+	kafkaTestLocal  = 0
+	kafkaTestRemote = 1
+)
+
+// makeKafkaTestMailboxes makes the mailboxes of one process, which consumes partition 0 at kafkaTestLocal, and
+// produces to it at kafkaTestRemote.
+func makeKafkaTestMailboxes(t *testing.T, client *memoryKafkaClient) distsys.ArchetypeResource {
+	t.Helper()
+	maker := KafkaMailboxesMaker(client, kafkaTestTopic, kafkaTestGroup, func(index tla.TLAValue) (TCPMailboxKind, int32) {
+		if index.Equal(tla.MakeTLANumber(kafkaTestLocal)) {
+			return TCPMailboxesLocal, 0
+		}
+		return TCPMailboxesRemote, 0
+	}, WithKafkaTimeout(time.Second), WithKafkaReadTimeout(50*time.Millisecond))
+	res := maker.Make()
+	maker.Configure(res)
+	t.Cleanup(func() {
+		_ = res.Close()
+	})
+	return res
+}
+
+// kafkaTestSend sends values from a new process, in one critical section.
+func kafkaTestSend(t *testing.T, client *memoryKafkaClient, values ...int32) {
+	t.Helper()
+	mailboxesTestSend(t, makeKafkaTestMailboxes(t, client), kafkaTestRemote, values...)
+}
+
+func TestKafkaMailboxes(t *testing.T) {
+	client := newMemoryKafkaClient()
+	sender := makeKafkaTestMailboxes(t, client)
+	receiver := makeKafkaTestMailboxes(t, client)
+
+	// an aborted send produces nothing
+	if err := mailboxesTestIndex(t, sender, kafkaTestRemote).WriteValue(tla.MakeTLANumber(0)); err != nil {
+		t.Fatal(err)
+	}
+	mailboxesTestAbort(sender)
+	if log, _, _ := client.state(); len(log) != 0 {
+		t.Fatalf("expected an aborted critical section not to produce, but %d records were", len(log))
+	}
+
+	// a committed one produces its values as one batch
+	mailboxesTestSend(t, sender, kafkaTestRemote, 1, 2)
+	if log, _, _ := client.state(); len(log) != 2 || client.requestCount("Produce") != 1 {
+		t.Fatalf("expected the 2 values to be produced in one request, got %d records in %d requests", len(log), client.requestCount("Produce"))
+	}
+
+	// an aborted read delivers the same values again, and commits no offset
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, kafkaTestLocal, 2), 1, 2)
+	mailboxesTestAbort(receiver)
+	if _, _, hasCommitted := client.state(); hasCommitted {
+		t.Fatal("expected an aborted critical section not to commit an offset")
+	}
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, kafkaTestLocal, 1), 1)
+	mailboxesTestCommit(t, receiver)
+	if _, committed, _ := client.state(); committed != 1 {
+		t.Fatalf("expected offset 1 to be committed, got %d", committed)
+	}
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, kafkaTestLocal, 1), 2)
+	mailboxesTestCommit(t, receiver)
+	if _, committed, _ := client.state(); committed != 2 {
+		t.Fatalf("expected offset 2 to be committed, got %d", committed)
+	}
+	expectMailboxesTestEmpty(t, receiver, kafkaTestLocal)
+}
+
+func TestKafkaMailboxesRetry(t *testing.T) {
+	client := newMemoryKafkaClient()
+
+	// Commit retries producing the batch until it succeeds
+	client.failNextRequest("Produce")
+	kafkaTestSend(t, client, 1)
+	if log, _, _ := client.state(); len(log) != 1 || client.requestCount("Produce") != 2 {
+		t.Fatalf("expected the retried batch to be produced once, in 2 requests, got %d records in %d requests", len(log), client.requestCount("Produce"))
+	}
+
+	// likewise when committing the offset fails
+	receiver := makeKafkaTestMailboxes(t, client)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, kafkaTestLocal, 1), 1)
+	client.failNextRequest("CommitOffset")
+	mailboxesTestCommit(t, receiver)
+	if _, committed, _ := client.state(); committed != 1 || client.requestCount("CommitOffset") != 2 {
+		t.Fatalf("expected the retried commit of offset 1 to succeed, got %d in %d requests", committed, client.requestCount("CommitOffset"))
+	}
+
+	// while a failed fetch aborts the critical section, to be retried
+	kafkaTestSend(t, client, 2)
+	client.failNextRequest("Fetch")
+	expectMailboxesTestEmpty(t, receiver, kafkaTestLocal)
+	mailboxesTestAbort(receiver)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, kafkaTestLocal, 1), 2)
+}
+
+func TestKafkaMailboxesRedeliveryAfterCrash(t *testing.T) {
+	client := newMemoryKafkaClient()
+	kafkaTestSend(t, client, 1, 2, 3)
+
+	// a process consumes the first value, then crashes while consuming the next ones, before committing
+	first := makeKafkaTestMailboxes(t, client)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, first, kafkaTestLocal, 1), 1)
+	mailboxesTestCommit(t, first)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, first, kafkaTestLocal, 2), 2, 3)
+	if err := first.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the process that takes over resumes from the committed offset, so those values are delivered again
+	second := makeKafkaTestMailboxes(t, client)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, second, kafkaTestLocal, 2), 2, 3)
+	mailboxesTestCommit(t, second)
+
+	// and once it committed, nothing is delivered again
+	third := makeKafkaTestMailboxes(t, client)
+	kafkaTestSend(t, client, 4)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, third, kafkaTestLocal, 1), 4)
+}
+
+func TestKafkaMailboxesOffsetOutOfRange(t *testing.T) {
+	client := newMemoryKafkaClient()
+	kafkaTestSend(t, client, 1)
+	receiver := makeKafkaTestMailboxes(t, client)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, kafkaTestLocal, 1), 1)
+	mailboxesTestCommit(t, receiver)
+
+	// retention deletes records the group has yet to consume, so it skips to the oldest remaining
+	kafkaTestSend(t, client, 2, 3)
+	client.deleteRecords(2)
+	expectMailboxesTestEmpty(t, receiver, kafkaTestLocal)
+	mailboxesTestAbort(receiver)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, kafkaTestLocal, 1), 3)
+	mailboxesTestCommit(t, receiver)
+	if _, committed, _ := client.state(); committed != 3 {
+		t.Fatalf("expected offset 3 to be committed, got %d", committed)
+	}
+}
@@ -0,0 +1,226 @@
+package resources
+
+import (
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const leasedLockTTL = 5 * time.Second
+
+type leasedLockConfig struct {
+	ttl time.Duration
+}
+
+// LeasedLockOption configures a resource made by LeasedLockMaker.
+type LeasedLockOption func(cfg *leasedLockConfig)
+
+// WithLeasedLockTTL sets the lease of the lock. While the lock is held, its lease is renewed every third of the
+// TTL; if its holder crashes, the lock becomes free once the lease expires.
+func WithLeasedLockTTL(ttl time.Duration) LeasedLockOption {
+	return func(cfg *leasedLockConfig) {
+		cfg.ttl = ttl
+	}
+}
+
+// LeasedLockMaker produces a distsys.ArchetypeResourceMaker for a distributed lock, named name, whose state is
+// replicated by the Raft group node belongs to. Every archetype using the resource competes for the same lock.
+//
+// Writing TRUE acquires the lock, and writing FALSE releases it. Acquiring happens at pre-commit, and aborts the
+// critical section if another archetype holds the lock, so that an archetype awaiting the lock simply retries.
+// Releasing happens at commit. Reading yields whether this archetype holds the lock. While held, the lock's lease
+// is renewed in the background; if a renewal fails, e.g. because the group was unreachable until the lease
+// expired, the lock is considered lost, and reads yield FALSE.
+func LeasedLockMaker(node *RaftNode, name string, opts ...LeasedLockOption) distsys.ArchetypeResourceMaker {
+	cfg := leasedLockConfig{
+		ttl: leasedLockTTL,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		return &leasedLock{
+			node: node,
+			// a set, so that it cannot collide with the tuple keys of shared values
+			key:    tla.MakeTLASet(tla.MakeTLAString(name)),
+			owner:  node.newOwner(),
+			config: cfg,
+		}
+	})
+}
+
+type leasedLock struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	node   *RaftNode
+	key    tla.TLAValue
+	owner  string
+	config leasedLockConfig
+
+	// written is the value written by the current critical section, if writePending is set
+	written      bool
+	writePending bool
+	// acquired is set when the current critical section's pre-commit acquired a lock that was not held before
+	acquired bool
+
+	lock      sync.Mutex
+	held      bool
+	stopRenew chan struct{}
+}
+
+var _ distsys.ArchetypeResource = &leasedLock{}
+
+func (res *leasedLock) isHeld() bool {
+	res.lock.Lock()
+	defer res.lock.Unlock()
+	return res.held
+}
+
+// acquire takes the lock or, if it is already held by this resource, renews its lease.
+func (res *leasedLock) acquire() (bool, error) {
+	result, err := res.node.submit(RaftCommand{
+		Kind:          raftCommandPrepare,
+		Key:           res.key,
+		Owner:         res.owner,
+		ExpectVersion: -1,
+		Lease:         res.config.ttl,
+	})
+	return result.OK, err
+}
+
+func (res *leasedLock) release() {
+	_, err := res.node.submit(RaftCommand{Kind: raftCommandRelease, Key: res.key, Owner: res.owner})
+	if err != nil {
+		res.log(distsys.LogWarn, "could not release lock, it will expire", "key", res.key, "error", err)
+	}
+}
+
+// setHeld records whether the lock is held, starting or stopping the background renewal accordingly.
+func (res *leasedLock) setHeld(held bool) {
+	res.lock.Lock()
+	defer res.lock.Unlock()
+	res.held = held
+	if held && res.stopRenew == nil {
+		res.stopRenew = make(chan struct{})
+		go res.renewLoop(res.stopRenew)
+	} else if !held && res.stopRenew != nil {
+		close(res.stopRenew)
+		res.stopRenew = nil
+	}
+}
+
+func (res *leasedLock) renewLoop(stop chan struct{}) {
+	ticker := time.NewTicker(res.config.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		ok, err := res.acquire()
+		if err != nil {
+			res.log(distsys.LogWarn, "could not renew lock", "key", res.key, "error", err)
+			continue
+		}
+		if !ok {
+			res.log(distsys.LogWarn, "lost lock, as its lease expired", "key", res.key)
+			res.lock.Lock()
+			if res.stopRenew == stop {
+				res.held = false
+				res.stopRenew = nil
+			}
+			res.lock.Unlock()
+			return
+		}
+	}
+}
+
+func (res *leasedLock) reset() {
+	res.writePending = false
+	res.acquired = false
+}
+
+func (res *leasedLock) Abort() chan struct{} {
+	if !res.acquired {
+		res.reset()
+		return nil
+	}
+	ch := make(chan struct{}, 1)
+	go func() {
+		res.release()
+		res.reset()
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+func (res *leasedLock) PreCommit() chan error {
+	if !res.writePending || !res.written {
+		return nil
+	}
+	ch := make(chan error, 1)
+	go func() {
+		wasHeld := res.isHeld()
+		ok, err := res.acquire()
+		if err != nil {
+			res.log(distsys.LogWarn, "could not acquire lock, aborting", "key", res.key, "error", err)
+			ch <- distsys.ErrCriticalSectionAborted
+			return
+		}
+		if !ok {
+			ch <- distsys.ErrCriticalSectionAborted
+			return
+		}
+		res.acquired = !wasHeld
+		ch <- nil
+	}()
+	return ch
+}
+
+func (res *leasedLock) Commit() chan struct{} {
+	if !res.writePending {
+		res.reset()
+		return nil
+	}
+	if res.written {
+		res.setHeld(true)
+		res.reset()
+		return nil
+	}
+	ch := make(chan struct{}, 1)
+	go func() {
+		res.setHeld(false)
+		res.release()
+		res.reset()
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+func (res *leasedLock) ReadValue() (tla.TLAValue, error) {
+	if res.writePending {
+		return tla.MakeTLABool(res.written), nil
+	}
+	return tla.MakeTLABool(res.isHeld()), nil
+}
+
+func (res *leasedLock) WriteValue(value tla.TLAValue) error {
+	written, err := value.TryAsBool()
+	if err != nil {
+		return err
+	}
+	res.written = written
+	res.writePending = true
+	return nil
+}
+
+func (res *leasedLock) Close() error {
+	if res.isHeld() {
+		res.setHeld(false)
+		res.release()
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package resources
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const leasedLockTestTTL = 300 * time.Millisecond
+
+func makeLeasedLockTest(t *testing.T, node *RaftNode) distsys.ArchetypeResource {
+	maker := LeasedLockMaker(node, "lock", WithLeasedLockTTL(leasedLockTestTTL))
+	res := maker.Make()
+	maker.Configure(res)
+	t.Cleanup(func() {
+		_ = res.Close()
+	})
+	return res
+}
+
+// leasedLockTestWrite writes held to the lock, and tries to commit, returning the error its pre-commit failed with.
+func leasedLockTestWrite(t *testing.T, res distsys.ArchetypeResource, held bool) error {
+	t.Helper()
+	if err := res.WriteValue(tla.MakeTLABool(held)); err != nil {
+		t.Fatal(err)
+	}
+	if ch := res.PreCommit(); ch != nil {
+		if err := <-ch; err != nil {
+			if ch := res.Abort(); ch != nil {
+				<-ch
+			}
+			return err
+		}
+	}
+	if ch := res.Commit(); ch != nil {
+		<-ch
+	}
+	return nil
+}
+
+func leasedLockTestHeld(t *testing.T, res distsys.ArchetypeResource) bool {
+	t.Helper()
+	value, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value.AsBool()
+}
+
+func TestLeasedLockAcquireRelease(t *testing.T) {
+	nodes, _ := startRaftTestGroup(t, 3, noRaftTestOptions)
+	awaitRaftLeader(t, nodes, 0)
+	a, b := makeLeasedLockTest(t, nodes[0]), makeLeasedLockTest(t, nodes[1])
+
+	if leasedLockTestHeld(t, a) {
+		t.Fatalf("expected the lock not to be held before it is acquired")
+	}
+	if err := leasedLockTestWrite(t, a, true); err != nil {
+		t.Fatalf("expected a free lock to be acquired, got %v", err)
+	}
+	if !leasedLockTestHeld(t, a) {
+		t.Fatalf("expected the lock to be held once acquired")
+	}
+	// acquiring a lock already held renews it
+	if err := leasedLockTestWrite(t, a, true); err != nil {
+		t.Fatalf("expected the holder to acquire the lock again, got %v", err)
+	}
+
+	// the lock is handed off once released
+	if err := leasedLockTestWrite(t, b, true); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected acquiring a held lock to abort, got %v", err)
+	}
+	if err := leasedLockTestWrite(t, a, false); err != nil {
+		t.Fatal(err)
+	}
+	if leasedLockTestHeld(t, a) {
+		t.Fatalf("expected the lock not to be held once released")
+	}
+	if err := leasedLockTestWrite(t, b, true); err != nil {
+		t.Fatalf("expected a released lock to be acquired, got %v", err)
+	}
+	if !leasedLockTestHeld(t, b) {
+		t.Fatalf("expected the lock to be held by its new holder")
+	}
+}
+
+func TestLeasedLockAbortReleases(t *testing.T) {
+	nodes, _ := startRaftTestGroup(t, 3, noRaftTestOptions)
+	awaitRaftLeader(t, nodes, 0)
+	a, b := makeLeasedLockTest(t, nodes[0]), makeLeasedLockTest(t, nodes[1])
+
+	// a lock acquired at pre-commit is released if the critical section aborts after all
+	if err := a.WriteValue(tla.TLA_TRUE); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-a.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	if ch := a.Abort(); ch != nil {
+		<-ch
+	}
+	if leasedLockTestHeld(t, a) {
+		t.Fatalf("expected the lock not to be held after an abort")
+	}
+	if err := leasedLockTestWrite(t, b, true); err != nil {
+		t.Fatalf("expected the lock to be free after an abort, got %v", err)
+	}
+}
+
+func TestLeasedLockContention(t *testing.T) {
+	nodes, _ := startRaftTestGroup(t, 3, noRaftTestOptions)
+	awaitRaftLeader(t, nodes, 0)
+	var locks []distsys.ArchetypeResource
+	for _, node := range nodes {
+		locks = append(locks, makeLeasedLockTest(t, node), makeLeasedLockTest(t, node))
+	}
+
+	// all contenders try at once: exactly one wins
+	errs := make(chan error, len(locks))
+	for _, lock := range locks {
+		lock := lock
+		go func() {
+			if err := lock.WriteValue(tla.TLA_TRUE); err != nil {
+				errs <- err
+				return
+			}
+			err := <-lock.PreCommit()
+			if err != nil {
+				if ch := lock.Abort(); ch != nil {
+					<-ch
+				}
+			} else if ch := lock.Commit(); ch != nil {
+				<-ch
+			}
+			errs <- err
+		}()
+	}
+	winners := 0
+	for range locks {
+		err := <-errs
+		if err == nil {
+			winners++
+		} else if !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatal(err)
+		}
+	}
+	if winners != 1 {
+		t.Fatalf("expected exactly one contender to acquire the lock, %d did", winners)
+	}
+	holders := 0
+	for _, lock := range locks {
+		if leasedLockTestHeld(t, lock) {
+			holders++
+		}
+	}
+	if holders != 1 {
+		t.Fatalf("expected exactly one contender to hold the lock, %d do", holders)
+	}
+}
+
+func TestLeasedLockLeaseExpiry(t *testing.T) {
+	nodes, _ := startRaftTestGroup(t, 3, noRaftTestOptions)
+	awaitRaftLeader(t, nodes, 0)
+	a, b := makeLeasedLockTest(t, nodes[0]), makeLeasedLockTest(t, nodes[1])
+
+	if err := leasedLockTestWrite(t, a, true); err != nil {
+		t.Fatal(err)
+	}
+	// while renewed, the lease outlives its TTL
+	time.Sleep(2 * leasedLockTestTTL)
+	if err := leasedLockTestWrite(t, b, true); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected a renewed lock to stay held, got %v", err)
+	}
+
+	// a stops renewing, as if it crashed, and b gets the lock once the lease expired
+	a.(*leasedLock).setHeld(false)
+	start := time.Now()
+	awaitCondition(t, "the lease to expire", func() bool {
+		return leasedLockTestWrite(t, b, true) == nil
+	})
+	if elapsed := time.Since(start); elapsed > 5*leasedLockTestTTL {
+		t.Fatalf("expected the lock to become free soon after its TTL, took %v", elapsed)
+	}
+
+	// when a resumes renewing, it finds it lost the lock
+	a.(*leasedLock).setHeld(true)
+	awaitCondition(t, "the lost lock to be noticed", func() bool {
+		return !leasedLockTestHeld(t, a)
+	})
+	if !leasedLockTestHeld(t, b) {
+		t.Fatalf("expected the lock to stay with its new holder")
+	}
+}
@@ -0,0 +1,350 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const (
+	leaseDefaultTTL     = 5 * time.Second
+	leaseDefaultTimeout = 1 * time.Second
+)
+
+// LeaseID identifies a lease granted by a LeaseStore. NoLease means a key is not attached to any lease.
+type LeaseID int64
+
+const NoLease LeaseID = 0
+
+// LeaseStore is the subset of a key-value store with leases, such as etcd, needed for lease-based failure detection.
+// A key attached to a lease is deleted when the lease expires or is revoked. This package does not depend on any
+// store's client library; MemoryLeaseStore implements it in memory, and for etcd, a small adapter over clientv3 is
+// enough:
+//
+//	Grant:     cli.Grant(ctx, int64(ttl/time.Second)), returning resp.ID
+//	KeepAlive: cli.KeepAliveOnce(ctx, clientv3.LeaseID(lease))
+//	Revoke:    cli.Revoke(ctx, clientv3.LeaseID(lease))
+//	Put:       cli.Put(ctx, key, value, clientv3.WithLease(clientv3.LeaseID(lease)))
+//	Get:       cli.Get(ctx, key), checking len(resp.Kvs)
+type LeaseStore interface {
+	// Grant creates a lease that expires after ttl unless it is kept alive.
+	Grant(ctx context.Context, ttl time.Duration) (LeaseID, error)
+	// KeepAlive renews the lease for another ttl.
+	KeepAlive(ctx context.Context, lease LeaseID) error
+	// Revoke expires the lease immediately.
+	Revoke(ctx context.Context, lease LeaseID) error
+	// Put sets key to value, attached to lease unless it is NoLease.
+	Put(ctx context.Context, key, value string, lease LeaseID) error
+	// Get returns the value of key, and whether it exists.
+	Get(ctx context.Context, key string) (string, bool, error)
+}
+
+// ErrLeaseNotFound is returned by a MemoryLeaseStore when a lease has expired, been revoked, or was never granted.
+var ErrLeaseNotFound = errors.New("lease not found")
+
+type memoryLease struct {
+	ttl     time.Duration
+	expires time.Time
+}
+
+type memoryLeaseEntry struct {
+	value string
+	lease LeaseID
+}
+
+// MemoryLeaseStore is a LeaseStore held in memory, for archetypes sharing a process, and for tests. Leases expire
+// on the wall clock, and keys attached to them are deleted the next time the store is accessed.
+type MemoryLeaseStore struct {
+	lock      sync.Mutex
+	nextLease LeaseID
+	leases    map[LeaseID]memoryLease
+	entries   map[string]memoryLeaseEntry
+}
+
+var _ LeaseStore = &MemoryLeaseStore{}
+
+// NewMemoryLeaseStore creates an empty MemoryLeaseStore.
+func NewMemoryLeaseStore() *MemoryLeaseStore {
+	return &MemoryLeaseStore{
+		leases:  make(map[LeaseID]memoryLease),
+		entries: make(map[string]memoryLeaseEntry),
+	}
+}
+
+// expireLocked deletes the expired leases, along with their keys. It must be called with store.lock held.
+func (store *MemoryLeaseStore) expireLocked() {
+	now := time.Now()
+	for id, lease := range store.leases {
+		if now.After(lease.expires) {
+			store.revokeLocked(id)
+		}
+	}
+}
+
+func (store *MemoryLeaseStore) revokeLocked(lease LeaseID) {
+	delete(store.leases, lease)
+	for key, entry := range store.entries {
+		if entry.lease == lease {
+			delete(store.entries, key)
+		}
+	}
+}
+
+func (store *MemoryLeaseStore) Grant(ctx context.Context, ttl time.Duration) (LeaseID, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.nextLease++
+	store.leases[store.nextLease] = memoryLease{ttl: ttl, expires: time.Now().Add(ttl)}
+	return store.nextLease, nil
+}
+
+func (store *MemoryLeaseStore) KeepAlive(ctx context.Context, lease LeaseID) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.expireLocked()
+	l, ok := store.leases[lease]
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrLeaseNotFound, lease)
+	}
+	l.expires = time.Now().Add(l.ttl)
+	store.leases[lease] = l
+	return nil
+}
+
+func (store *MemoryLeaseStore) Revoke(ctx context.Context, lease LeaseID) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.expireLocked()
+	if _, ok := store.leases[lease]; !ok {
+		return fmt.Errorf("%w: %d", ErrLeaseNotFound, lease)
+	}
+	store.revokeLocked(lease)
+	return nil
+}
+
+func (store *MemoryLeaseStore) Put(ctx context.Context, key, value string, lease LeaseID) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.expireLocked()
+	if _, ok := store.leases[lease]; lease != NoLease && !ok {
+		return fmt.Errorf("%w: %d", ErrLeaseNotFound, lease)
+	}
+	store.entries[key] = memoryLeaseEntry{value: value, lease: lease}
+	return nil
+}
+
+func (store *MemoryLeaseStore) Get(ctx context.Context, key string) (string, bool, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.expireLocked()
+	entry, ok := store.entries[key]
+	return entry.value, ok, nil
+}
+
+type leaseConfig struct {
+	ttl     time.Duration
+	timeout time.Duration
+}
+
+// LeaseOption configures a LeaseRegistry or the failure detectors made by LeaseFailureDetectorMaker.
+type LeaseOption func(cfg *leaseConfig)
+
+// WithLeaseTTL sets how long an archetype's registration outlives its last keep-alive. It bounds how long a
+// crashed process goes undetected. Registrations are kept alive every third of ttl.
+func WithLeaseTTL(ttl time.Duration) LeaseOption {
+	return func(cfg *leaseConfig) {
+		cfg.ttl = ttl
+	}
+}
+
+// WithLeaseTimeout sets the timeout of each request to the LeaseStore.
+func WithLeaseTimeout(timeout time.Duration) LeaseOption {
+	return func(cfg *leaseConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+func makeLeaseConfig(opts []LeaseOption) leaseConfig {
+	cfg := leaseConfig{
+		ttl:     leaseDefaultTTL,
+		timeout: leaseDefaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+func leaseKey(prefix string, archetypeID tla.TLAValue) string {
+	return prefix + archetypeID.String()
+}
+
+// LeaseRegistry plays the role of a Monitor for deployments that already run a store with leases, such as etcd.
+// Instead of serving RPCs, it registers each archetype it runs under a key holding its state, attached to a lease
+// it keeps alive while the archetype runs. If the archetype fails, the lease is revoked; if the whole process
+// fails, the lease expires. Either way, the key disappears, which LeaseFailureDetectorMaker reports as failure.
+type LeaseRegistry struct {
+	resourceLogger
+
+	store  LeaseStore
+	prefix string
+	config leaseConfig
+}
+
+// NewLeaseRegistry creates a LeaseRegistry, which stores archetype registrations in store under keys starting with
+// prefix.
+func NewLeaseRegistry(store LeaseStore, prefix string, opts ...LeaseOption) *LeaseRegistry {
+	return &LeaseRegistry{
+		store:  store,
+		prefix: prefix,
+		config: makeLeaseConfig(opts),
+	}
+}
+
+func (r *LeaseRegistry) withTimeout(fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.timeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+// RunArchetype registers the given archetype and runs it, like Monitor.RunArchetype. Wraps a call to ctx.Run. It
+// returns an error without running the archetype if it cannot be registered.
+func (r *LeaseRegistry) RunArchetype(ctx *distsys.MPCalContext) (err error) {
+	archetypeID := ctx.IFace().Self()
+	key := leaseKey(r.prefix, archetypeID)
+
+	var lease LeaseID
+	err = r.withTimeout(func(c context.Context) error {
+		var err error
+		lease, err = r.store.Grant(c, r.config.ttl)
+		if err != nil {
+			return err
+		}
+		return r.store.Put(c, key, alive.String(), lease)
+	})
+	if err != nil {
+		return fmt.Errorf("could not register archetype %v: %w", archetypeID, err)
+	}
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r.keepAlive(archetypeID, lease, stop)
+	}()
+
+	completed := false
+	defer func() {
+		close(stop)
+		wg.Wait()
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("archetype %v recovered from panic: %s\n%s", archetypeID, rec, debug.Stack())
+		}
+		revokeErr := r.withTimeout(func(c context.Context) error {
+			if completed {
+				// detach the key from the lease, so that it survives revocation
+				putErr := r.store.Put(c, key, finished.String(), NoLease)
+				if putErr != nil {
+					return putErr
+				}
+			}
+			return r.store.Revoke(c, lease)
+		})
+		if revokeErr != nil {
+			r.log(distsys.LogWarn, "could not deregister archetype", "archetype", archetypeID, "error", revokeErr)
+		}
+	}()
+
+	err = ctx.Run()
+	completed = err == nil
+	return
+}
+
+func (r *LeaseRegistry) keepAlive(archetypeID tla.TLAValue, lease LeaseID, stop <-chan struct{}) {
+	ticker := time.NewTicker(r.config.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		err := r.withTimeout(func(c context.Context) error {
+			return r.store.KeepAlive(c, lease)
+		})
+		if err != nil {
+			r.log(distsys.LogWarn, "could not keep archetype alive", "archetype", archetypeID, "error", err)
+		}
+	}
+}
+
+// LeaseFailureDetectorMaker produces a distsys.ArchetypeResourceMaker for a collection of failure detectors backed
+// by the registrations of a LeaseRegistry using the same store and prefix. Index i of the collection reads FALSE
+// while archetype i is registered as alive, and TRUE otherwise, i.e. once it has failed or finished, its lease has
+// expired, or the store cannot be reached. Each read queries the store. It refines the same PracticalFD mapping
+// macro as FailureDetectorMaker.
+func LeaseFailureDetectorMaker(store LeaseStore, prefix string, opts ...LeaseOption) distsys.ArchetypeResourceMaker {
+	cfg := makeLeaseConfig(opts)
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+			return &leaseFailureDetector{
+				store:       store,
+				key:         leaseKey(prefix, index),
+				archetypeID: index,
+				config:      cfg,
+			}
+		})
+	})
+}
+
+type leaseFailureDetector struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	store       LeaseStore
+	key         string
+	archetypeID tla.TLAValue
+	config      leaseConfig
+}
+
+var _ distsys.ArchetypeResource = &leaseFailureDetector{}
+
+func (res *leaseFailureDetector) Abort() chan struct{} {
+	return nil
+}
+
+func (res *leaseFailureDetector) PreCommit() chan error {
+	return nil
+}
+
+func (res *leaseFailureDetector) Commit() chan struct{} {
+	return nil
+}
+
+func (res *leaseFailureDetector) ReadValue() (tla.TLAValue, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), res.config.timeout)
+	defer cancel()
+	value, ok, err := res.store.Get(ctx, res.key)
+	if err != nil {
+		res.log(distsys.LogWarn, "could not query lease store", "archetype", res.archetypeID, "error", err)
+		return tla.TLA_TRUE, nil
+	}
+	if ok && value == alive.String() {
+		return tla.TLA_FALSE, nil
+	}
+	return tla.TLA_TRUE, nil
+}
+
+func (res *leaseFailureDetector) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write value %v to a lease failure detector resource", value))
+}
+
+func (res *leaseFailureDetector) Close() error {
+	return nil
+}
@@ -0,0 +1,224 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const (
+	leaseTestPrefix = "archetypes/"
+	leaseTestTTL    = 150 * time.Millisecond
+)
+
+var errLeaseTestUnreachable = errors.New("store unreachable")
+
+// unreliableLeaseStore wraps a LeaseStore, failing keep-alives while partitioned, and reads while unreachable.
+type unreliableLeaseStore struct {
+	LeaseStore
+	partitioned, unreachable int32
+}
+
+func (store *unreliableLeaseStore) KeepAlive(ctx context.Context, lease LeaseID) error {
+	if atomic.LoadInt32(&store.partitioned) != 0 {
+		return errLeaseTestUnreachable
+	}
+	return store.LeaseStore.KeepAlive(ctx, lease)
+}
+
+func (store *unreliableLeaseStore) Get(ctx context.Context, key string) (string, bool, error) {
+	if atomic.LoadInt32(&store.unreachable) != 0 {
+		return "", false, errLeaseTestUnreachable
+	}
+	return store.LeaseStore.Get(ctx, key)
+}
+
+// runLeaseTestArchetype runs archetype self under registry, in the background. The archetype's only critical
+// section waits for an error to return: ErrDone finishes the archetype, and any other error fails it. It returns
+// the channel taking that error, and the one RunArchetype's result is sent to.
+func runLeaseTestArchetype(t *testing.T, registry *LeaseRegistry, self int32) (chan<- error, <-chan error) {
+	t.Helper()
+	outcome := make(chan error)
+	archetype := distsys.MPCalArchetype{
+		Name:              "ALease",
+		Label:             "ALease.wait",
+		RequiredRefParams: []string{},
+		RequiredValParams: []string{},
+		JumpTable: distsys.MakeMPCalJumpTable(distsys.MPCalCriticalSection{
+			Name: "ALease.wait",
+			Body: func(distsys.ArchetypeInterface) error {
+				return <-outcome
+			},
+		}),
+		ProcTable: distsys.MakeMPCalProcTable(),
+		PreAmble:  func(distsys.ArchetypeInterface) {},
+	}
+	ctx := distsys.NewMPCalContext(tla.MakeTLANumber(self), archetype)
+	result := make(chan error, 1)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		result <- registry.RunArchetype(ctx)
+	}()
+	t.Cleanup(func() {
+		select {
+		case outcome <- distsys.ErrDone:
+		case <-stopped:
+		}
+		<-stopped
+		_ = ctx.Close()
+	})
+	return outcome, result
+}
+
+func leaseTestDetector(t *testing.T, store LeaseStore, self int32) distsys.ArchetypeResource {
+	t.Helper()
+	maker := LeaseFailureDetectorMaker(store, leaseTestPrefix, WithLeaseTimeout(time.Second))
+	fds := maker.Make()
+	maker.Configure(fds)
+	t.Cleanup(func() {
+		_ = fds.Close()
+	})
+	fd, err := fds.Index(tla.MakeTLANumber(self))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+func leaseTestSuspects(t *testing.T, fd distsys.ArchetypeResource) bool {
+	t.Helper()
+	value, err := fd.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value.AsBool()
+}
+
+func TestLeaseFailureDetector(t *testing.T) {
+	store := NewMemoryLeaseStore()
+	registry := NewLeaseRegistry(store, leaseTestPrefix, WithLeaseTTL(leaseTestTTL))
+	fd := leaseTestDetector(t, store, 1)
+	if !leaseTestSuspects(t, fd) {
+		t.Fatal("expected an archetype that never registered to be suspected")
+	}
+
+	outcome, result := runLeaseTestArchetype(t, registry, 1)
+	awaitCondition(t, "the archetype to be registered", func() bool {
+		return !leaseTestSuspects(t, fd)
+	})
+	// keep-alives outlast the lease's TTL
+	time.Sleep(3 * leaseTestTTL)
+	if leaseTestSuspects(t, fd) {
+		t.Fatal("expected a running archetype to stay registered past its lease's TTL")
+	}
+
+	// once finished, the archetype is reported as such, and its key outlives the revoked lease
+	outcome <- distsys.ErrDone
+	if err := <-result; err != nil {
+		t.Fatal(err)
+	}
+	if !leaseTestSuspects(t, fd) {
+		t.Fatal("expected a finished archetype to be reported")
+	}
+	if value, ok, _ := store.Get(context.Background(), leaseKey(leaseTestPrefix, tla.MakeTLANumber(1))); !ok || value != finished.String() {
+		t.Fatalf("expected the archetype to be registered as finished, got %q, %v", value, ok)
+	}
+}
+
+func TestLeaseFailureDetectorRevoke(t *testing.T) {
+	store := NewMemoryLeaseStore()
+	registry := NewLeaseRegistry(store, leaseTestPrefix, WithLeaseTTL(time.Hour))
+	fd := leaseTestDetector(t, store, 1)
+	outcome, result := runLeaseTestArchetype(t, registry, 1)
+	awaitCondition(t, "the archetype to be registered", func() bool {
+		return !leaseTestSuspects(t, fd)
+	})
+
+	// a failing archetype revokes its lease, so it is detected right away, long before the lease would expire
+	outcome <- errors.New("assertion failed")
+	if err := <-result; err == nil {
+		t.Fatal("expected the archetype to fail")
+	}
+	if !leaseTestSuspects(t, fd) {
+		t.Fatal("expected a failed archetype to be detected as soon as its lease is revoked")
+	}
+	if _, ok, _ := store.Get(context.Background(), leaseKey(leaseTestPrefix, tla.MakeTLANumber(1))); ok {
+		t.Fatal("expected the failed archetype's key to be deleted along with its lease")
+	}
+}
+
+func TestLeaseFailureDetectorExpiry(t *testing.T) {
+	store := &unreliableLeaseStore{LeaseStore: NewMemoryLeaseStore()}
+	registry := NewLeaseRegistry(store, leaseTestPrefix, WithLeaseTTL(leaseTestTTL))
+	fd := leaseTestDetector(t, store, 1)
+	other := leaseTestDetector(t, store, 2)
+	runLeaseTestArchetype(t, registry, 1)
+	runLeaseTestArchetype(t, NewLeaseRegistry(store.LeaseStore, leaseTestPrefix, WithLeaseTTL(leaseTestTTL)), 2)
+	awaitCondition(t, "the archetypes to be registered", func() bool {
+		return !leaseTestSuspects(t, fd) && !leaseTestSuspects(t, other)
+	})
+
+	// cut off from the store, as if its process crashed, archetype 1's lease expires after its TTL
+	atomic.StoreInt32(&store.partitioned, 1)
+	partitionedAt := time.Now()
+	awaitCondition(t, "the lease to expire", func() bool {
+		return leaseTestSuspects(t, fd)
+	})
+	if elapsed := time.Since(partitionedAt); elapsed < leaseTestTTL/2 {
+		t.Fatalf("expected the lease to expire after its TTL of %v, but it took %v", leaseTestTTL, elapsed)
+	}
+	if leaseTestSuspects(t, other) {
+		t.Fatal("expected the archetype still keeping its lease alive not to be suspected")
+	}
+
+	// a detector that cannot reach the store suspects every archetype
+	atomic.StoreInt32(&store.unreachable, 1)
+	if !leaseTestSuspects(t, other) {
+		t.Fatal("expected an unreachable store to make archetypes suspected")
+	}
+}
+
+func TestMemoryLeaseStore(t *testing.T) {
+	store := NewMemoryLeaseStore()
+	ctx := context.Background()
+	lease, err := store.Grant(ctx, leaseTestTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put(ctx, "leased", "a", lease); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put(ctx, "unleased", "b", NoLease); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put(ctx, "unknown", "c", lease+1); !errors.Is(err, ErrLeaseNotFound) {
+		t.Fatalf("expected putting under an unknown lease to fail, got %v", err)
+	}
+	time.Sleep(2 * leaseTestTTL / 3)
+	if err := store.KeepAlive(ctx, lease); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(2 * leaseTestTTL / 3)
+	if _, ok, _ := store.Get(ctx, "leased"); !ok {
+		t.Fatal("expected a key to outlive its lease's TTL once kept alive")
+	}
+	time.Sleep(leaseTestTTL + leaseTestTTL/3)
+	if _, ok, _ := store.Get(ctx, "leased"); ok {
+		t.Fatal("expected a key to be deleted once its lease expired")
+	}
+	if value, ok, _ := store.Get(ctx, "unleased"); !ok || value != "b" {
+		t.Fatalf("expected a key without a lease to remain, got %q, %v", value, ok)
+	}
+	if err := store.KeepAlive(ctx, lease); !errors.Is(err, ErrLeaseNotFound) {
+		t.Fatalf("expected keeping an expired lease alive to fail, got %v", err)
+	}
+	if err := store.Revoke(ctx, lease); !errors.Is(err, ErrLeaseNotFound) {
+		t.Fatalf("expected revoking an expired lease to fail, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package resources
+
+import (
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+const (
+	localMailboxesReceiveChannelSize = 100
+	localMailboxesReadTimeout        = 20 * time.Millisecond
+)
+
+// LocalMailboxes is an in-process network of mailboxes, shared by every archetype that should be able to exchange
+// messages through it. It is intended for single-process setups, like unit tests, where routing messages via
+// localhost TCP ports is unnecessary and prone to port conflicts.
+type LocalMailboxes struct {
+	lock     sync.Mutex
+	channels *immutable.Map // index -> chan tla.TLAValue
+}
+
+// NewLocalMailboxes creates a new, empty, in-process network of mailboxes.
+func NewLocalMailboxes() *LocalMailboxes {
+	return &LocalMailboxes{
+		channels: immutable.NewMap(tla.TLAValueHasher{}),
+	}
+}
+
+func (mailboxes *LocalMailboxes) channelFor(index tla.TLAValue) chan tla.TLAValue {
+	mailboxes.lock.Lock()
+	defer mailboxes.lock.Unlock()
+	if ch, ok := mailboxes.channels.Get(index); ok {
+		return ch.(chan tla.TLAValue)
+	}
+	ch := make(chan tla.TLAValue, localMailboxesReceiveChannelSize)
+	mailboxes.channels = mailboxes.channels.Set(index, ch)
+	return ch
+}
+
+// LocalMailboxesMaker produces a distsys.ArchetypeResourceMaker for a collection of mailboxes, routed within one
+// process via the given LocalMailboxes. Every archetype sharing the same LocalMailboxes can write to any index,
+// and, like with TCPMailboxesMaker, each index should be read by exactly one archetype.
+//
+// The mailboxes refine the same LimitedBufferReliableFIFOLink mapping macro as TCPMailboxesMaker does, with
+// BUFFER_SIZE fixed to localMailboxesReceiveChannelSize, and also accept writes through MailboxesBroadcastIndex.
+func LocalMailboxesMaker(mailboxes *LocalMailboxes) distsys.ArchetypeResourceMaker {
+	return mailboxesBroadcastMaker(IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+			return &localMailbox{
+				channel: mailboxes.channelFor(index),
+			}
+		})
+	}))
+}
+
+type localMailbox struct {
+	distsys.ArchetypeResourceLeafMixin
+	channel chan tla.TLAValue
+
+	readBacklog     []tla.TLAValue
+	readsInProgress []tla.TLAValue
+	writeBuffer     []tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &localMailbox{}
+
+func (res *localMailbox) Abort() chan struct{} {
+	res.readBacklog = append(res.readsInProgress, res.readBacklog...)
+	res.readsInProgress = nil
+	res.writeBuffer = nil
+	return nil
+}
+
+func (res *localMailbox) PreCommit() chan error {
+	return nil
+}
+
+func (res *localMailbox) Commit() chan struct{} {
+	res.readsInProgress = nil
+	if len(res.writeBuffer) == 0 {
+		return nil
+	}
+
+	ch := make(chan struct{}, 1)
+	go func() {
+		for _, value := range res.writeBuffer {
+			res.channel <- value
+		}
+		res.writeBuffer = nil
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+func (res *localMailbox) ReadValue() (tla.TLAValue, error) {
+	// if a critical section previously aborted, already-read values will be here
+	if len(res.readBacklog) > 0 {
+		value := res.readBacklog[0]
+		res.readBacklog[0] = tla.TLAValue{} // ensure this TLAValue is null, otherwise it will dangle and prevent potential GC
+		res.readBacklog = res.readBacklog[1:]
+		res.readsInProgress = append(res.readsInProgress, value)
+		return value, nil
+	}
+
+	select {
+	case value := <-res.channel:
+		res.readsInProgress = append(res.readsInProgress, value)
+		return value, nil
+	case <-time.After(localMailboxesReadTimeout):
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	}
+}
+
+func (res *localMailbox) WriteValue(value tla.TLAValue) error {
+	res.writeBuffer = append(res.writeBuffer, value)
+	return nil
+}
+
+func (res *localMailbox) Close() error {
+	return nil
+}
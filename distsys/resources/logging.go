@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"sync/atomic"
+
+	"github.com/UBC-NSS/pgo/distsys"
+)
+
+// resourceLogger is embedded by resources and servers that log, so that their messages can be sent to the Logger of
+// the context using them, via distsys.LoggingArchetypeResource. Until SetLogger is called, messages go to
+// distsys.DefaultLogger. It is safe for concurrent use, since messages are often logged by background goroutines.
+type resourceLogger struct {
+	logger atomic.Value // holds a loggerHolder
+}
+
+// loggerHolder lets atomic.Value hold Loggers of different concrete types.
+type loggerHolder struct {
+	logger distsys.Logger
+}
+
+// SetLogger sends subsequent log messages to logger, instead of distsys.DefaultLogger.
+func (l *resourceLogger) SetLogger(logger distsys.Logger) {
+	l.logger.Store(loggerHolder{logger: logger})
+}
+
+// getLogger returns the logger set by SetLogger, if any. l may be nil, e.g. for helpers shared by a resource and
+// code that runs outside of any context.
+func (l *resourceLogger) getLogger() distsys.Logger {
+	if l == nil {
+		return distsys.DefaultLogger
+	}
+	if holder, ok := l.logger.Load().(loggerHolder); ok {
+		return holder.logger
+	}
+	return distsys.DefaultLogger
+}
+
+func (l *resourceLogger) log(level distsys.LogLevel, msg string, keyvals ...interface{}) {
+	l.getLogger().Log(level, msg, keyvals...)
+}
@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"sync"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+// TCPMailboxesMembership tracks which mailbox indices currently belong to a running system. It may be shared
+// between all the mailbox collections in a process, and updated at any time via Add and Remove, in order to support
+// elastic cluster membership. See WithTCPMailboxesMembership.
+type TCPMailboxesMembership struct {
+	lock    sync.RWMutex
+	members *immutable.Map // set of member indices
+}
+
+// NewTCPMailboxesMembership creates a membership containing exactly the given indices.
+func NewTCPMailboxesMembership(members ...tla.TLAValue) *TCPMailboxesMembership {
+	builder := immutable.NewMapBuilder(tla.TLAValueHasher{})
+	for _, member := range members {
+		builder.Set(member, true)
+	}
+	return &TCPMailboxesMembership{
+		members: builder.Map(),
+	}
+}
+
+// Add makes index a member, so that messages may be sent to it.
+func (membership *TCPMailboxesMembership) Add(index tla.TLAValue) {
+	membership.lock.Lock()
+	defer membership.lock.Unlock()
+	membership.members = membership.members.Set(index, true)
+}
+
+// Remove stops index from being a member. Messages to it are drained: any messages not yet delivered, including
+// ones whose commit is being retried, are discarded, as are any messages sent to it afterwards.
+func (membership *TCPMailboxesMembership) Remove(index tla.TLAValue) {
+	membership.lock.Lock()
+	defer membership.lock.Unlock()
+	membership.members = membership.members.Delete(index)
+}
+
+// IsMember reports whether index is currently a member.
+func (membership *TCPMailboxesMembership) IsMember(index tla.TLAValue) bool {
+	membership.lock.RLock()
+	defer membership.lock.RUnlock()
+	_, ok := membership.members.Get(index)
+	return ok
+}
+
+// Members returns a snapshot of the current member indices, as a TLA+ set.
+func (membership *TCPMailboxesMembership) Members() tla.TLAValue {
+	membership.lock.RLock()
+	defer membership.lock.RUnlock()
+	return tla.MakeTLASetFromMap(membership.members)
+}
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// MailboxMetrics receives measurements from mailbox resources, so that they can be exported to a monitoring system,
+// e.g. by incrementing Prometheus counters and observing histograms. Every method is given the index of the mailbox
+// concerned. Implementations must be safe for concurrent use, and should not block.
+type MailboxMetrics interface {
+	// MessagesSent is called when a commit delivering count messages to a remote mailbox completes.
+	MessagesSent(index tla.TLAValue, count int)
+	// MessagesReceived is called when count messages are committed into a local mailbox.
+	MessagesReceived(index tla.TLAValue, count int)
+	// BytesSent and BytesReceived are called with the number of bytes written to / read from the network.
+	BytesSent(index tla.TLAValue, n int)
+	BytesReceived(index tla.TLAValue, n int)
+	// CommitRetried is called each time a commit to a remote mailbox has to reconnect and resend its messages.
+	CommitRetried(index tla.TLAValue)
+	// DialFailed is called each time a connection to a remote mailbox could not be established.
+	DialFailed(index tla.TLAValue)
+	// CommitLatency is called with the time taken between the start of PreCommit and the end of Commit, for each
+	// critical section that sent messages to a remote mailbox.
+	CommitLatency(index tla.TLAValue, latency time.Duration)
+}
+
+// MailboxQueueDepthMetrics may additionally be implemented by a MailboxMetrics, to also track how many messages are
+// waiting to be read from each local mailbox.
+type MailboxQueueDepthMetrics interface {
+	// QueueDepth is called with the number of messages waiting in a local mailbox, each time a critical section
+	// that read from it commits or aborts.
+	QueueDepth(index tla.TLAValue, depth int)
+}
+
+// WithTCPMailboxesMetrics reports network activity of the mailboxes to metrics, as well as the depth of local
+// mailboxes if metrics implements MailboxQueueDepthMetrics.
+func WithTCPMailboxesMetrics(metrics MailboxMetrics) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.metrics = metrics
+	}
+}
@@ -0,0 +1,158 @@
+package resources
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"time"
+)
+
+const monitorAuthNonceSize = 32
+
+var errMonitorAuthFailed = errors.New("monitor authentication failed")
+
+// monitorSecurity secures the connections between monitors and failure detectors. With TLS, traffic is encrypted,
+// and certificates authenticate the server (and, if the server requires client certificates, the client). With a
+// shared secret, both ends of each connection prove knowledge of the secret before any RPC is exchanged.
+type monitorSecurity struct {
+	serverTLS, clientTLS *tls.Config
+	secret               []byte
+}
+
+// WithMonitorTLS makes the monitor accept only TLS connections, configured by serverConfig. clientConfig is used
+// when connecting to peers (see WithMonitorPeers), and may be nil if there are none. For mutual TLS, set
+// serverConfig.ClientAuth to tls.RequireAndVerifyClientCert, and give failure detectors client certificates.
+func WithMonitorTLS(serverConfig, clientConfig *tls.Config) MonitorOption {
+	return func(m *Monitor) {
+		m.security.serverTLS = serverConfig
+		m.security.clientTLS = clientConfig
+	}
+}
+
+// WithMonitorSharedSecret requires every connection to the monitor, and from it to its peers, to authenticate using
+// secret. Failure detectors must be configured with the same secret via WithFailureDetectorSharedSecret. The
+// secret is never sent; instead, both ends answer a random challenge with an HMAC of it, so that neither a rogue
+// client nor a rogue monitor can spoof liveness information. Without TLS, traffic is still sent in the clear.
+func WithMonitorSharedSecret(secret []byte) MonitorOption {
+	return func(m *Monitor) {
+		m.security.secret = secret
+	}
+}
+
+// WithFailureDetectorTLS makes the failure detector connect to monitors using TLS, configured by config.
+func WithFailureDetectorTLS(config *tls.Config) FailureDetectorOption {
+	return func(fd *singleFailureDetector) {
+		fd.security.clientTLS = config
+	}
+}
+
+// WithFailureDetectorSharedSecret makes the failure detector authenticate to monitors, and require monitors to
+// authenticate to it, using secret. See WithMonitorSharedSecret.
+func WithFailureDetectorSharedSecret(secret []byte) FailureDetectorOption {
+	return func(fd *singleFailureDetector) {
+		fd.security.secret = secret
+	}
+}
+
+func (sec *monitorSecurity) listen(addr string) (net.Listener, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if sec.serverTLS != nil {
+		listener = tls.NewListener(listener, sec.serverTLS)
+	}
+	return listener, nil
+}
+
+func (sec *monitorSecurity) dial(addr string, timeout time.Duration) (net.Conn, error) {
+	var conn net.Conn
+	var err error
+	if sec.clientTLS != nil {
+		conn, err = TLSMailboxTransport(DefaultMailboxTransport{}, nil, sec.clientTLS).Dial(addr, timeout)
+	} else {
+		conn, err = net.DialTimeout("tcp", addr, timeout)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if sec.secret != nil {
+		err = sec.authenticate(conn, true, timeout)
+		if err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// accept authenticates a newly accepted connection, if a shared secret is configured.
+func (sec *monitorSecurity) accept(conn net.Conn, timeout time.Duration) error {
+	if sec.secret == nil {
+		return nil
+	}
+	return sec.authenticate(conn, false, timeout)
+}
+
+func (sec *monitorSecurity) mac(role string, nonce []byte) []byte {
+	h := hmac.New(sha256.New, sec.secret)
+	h.Write([]byte(role))
+	h.Write(nonce)
+	return h.Sum(nil)
+}
+
+// authenticate runs a mutual challenge-response exchange: the client sends a nonce; the server replies with its
+// own nonce and a MAC of the client's nonce; the client checks it, and replies with a MAC of the server's nonce,
+// which the server checks.
+func (sec *monitorSecurity) authenticate(conn net.Conn, isClient bool, timeout time.Duration) error {
+	err := conn.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return err
+	}
+	ownNonce := make([]byte, monitorAuthNonceSize)
+	_, err = rand.Read(ownNonce)
+	if err != nil {
+		return err
+	}
+	peerNonce := make([]byte, monitorAuthNonceSize)
+	peerMAC := make([]byte, sha256.Size)
+
+	if isClient {
+		_, err = conn.Write(ownNonce)
+		if err == nil {
+			_, err = io.ReadFull(conn, peerNonce)
+		}
+		if err == nil {
+			_, err = io.ReadFull(conn, peerMAC)
+		}
+		if err != nil {
+			return err
+		}
+		if !hmac.Equal(peerMAC, sec.mac("server", ownNonce)) {
+			return errMonitorAuthFailed
+		}
+		_, err = conn.Write(sec.mac("client", peerNonce))
+	} else {
+		_, err = io.ReadFull(conn, peerNonce)
+		if err == nil {
+			_, err = conn.Write(append(ownNonce, sec.mac("server", peerNonce)...))
+		}
+		if err == nil {
+			_, err = io.ReadFull(conn, peerMAC)
+		}
+		if err != nil {
+			return err
+		}
+		if !hmac.Equal(peerMAC, sec.mac("client", ownNonce)) {
+			return errMonitorAuthFailed
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return conn.SetDeadline(time.Time{})
+}
@@ -0,0 +1,103 @@
+package resources
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/rpc"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// monitorAuthTestTLSConfigs returns server and client TLS configurations for 127.0.0.1, borrowed from an httptest.Server.
+func monitorAuthTestTLSConfigs(t *testing.T) (serverConfig, clientConfig *tls.Config) {
+	t.Helper()
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+	serverConfig = &tls.Config{Certificates: server.TLS.Certificates}
+	clientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig.Clone()
+	return serverConfig, clientConfig
+}
+
+// monitorAuthTestAwait waits for fd to report whether it suspects its archetype.
+func monitorAuthTestAwait(t *testing.T, what string, fd distsys.ArchetypeResource, suspected bool) {
+	t.Helper()
+	awaitCondition(t, what, func() bool {
+		suspects, known := fdTestSuspects(t, fd)
+		return known && suspects == suspected
+	})
+}
+
+func TestMonitorSharedSecret(t *testing.T) {
+	secret := []byte("secret")
+	monitor := NewMonitor(freeLocalAddr(t), WithMonitorSharedSecret(secret))
+	startMonitorTest(t, monitor)
+	id := tla.MakeTLANumber(1)
+	monitor.setState(id, alive)
+
+	trusted := fdTestDetectors(t, monitor, []tla.TLAValue{id}, WithFailureDetectorSharedSecret(secret))[0]
+	monitorAuthTestAwait(t, "a detector with the secret to see the archetype alive", trusted, false)
+	wrongSecret := fdTestDetectors(t, monitor, []tla.TLAValue{id}, WithFailureDetectorSharedSecret([]byte("guess")))[0]
+	monitorAuthTestAwait(t, "a detector with the wrong secret to be rejected", wrongSecret, true)
+	noSecret := fdTestDetectors(t, monitor, []tla.TLAValue{id})[0]
+	monitorAuthTestAwait(t, "a detector without the secret to be rejected", noSecret, true)
+
+	// a client with the wrong secret notices that the monitor cannot answer its challenge
+	wrong := monitorSecurity{secret: []byte("guess")}
+	if _, err := wrong.dial(monitor.ListenAddr, time.Second); !errors.Is(err, errMonitorAuthFailed) {
+		t.Fatalf("expected the monitor's answer to fail to authenticate, got %v", err)
+	}
+
+	// a rogue client that ignores the monitor's answer, and cannot answer the monitor's challenge, gets no RPCs
+	conn, err := net.Dial("tcp", monitor.ListenAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	challenge := make([]byte, monitorAuthNonceSize+sha256.Size)
+	if _, err := conn.Write(make([]byte, monitorAuthNonceSize)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadFull(conn, challenge); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.Write(make([]byte, sha256.Size)); err != nil {
+		t.Fatal(err)
+	}
+	var reply ArchetypeState
+	if err := rpc.NewClient(conn).Call("MonitorRPCReceiver.IsAlive", &id, &reply); err == nil {
+		t.Fatalf("expected a client failing authentication to be disconnected, but it was told %v", reply)
+	}
+}
+
+func TestMonitorTLS(t *testing.T) {
+	serverConfig, clientConfig := monitorAuthTestTLSConfigs(t)
+	clientConfig.ServerName = "127.0.0.1"
+	monitor := NewMonitor(freeLocalAddr(t), WithMonitorTLS(serverConfig, nil))
+	startMonitorTest(t, monitor)
+	id := tla.MakeTLANumber(1)
+	monitor.setState(id, alive)
+
+	trusted := fdTestDetectors(t, monitor, []tla.TLAValue{id}, WithFailureDetectorTLS(clientConfig))[0]
+	monitorAuthTestAwait(t, "a detector trusting the monitor's certificate to see the archetype alive", trusted, false)
+	plain := fdTestDetectors(t, monitor, []tla.TLAValue{id})[0]
+	monitorAuthTestAwait(t, "a detector not using TLS to be rejected", plain, true)
+
+	// a monitor whose certificate is not trusted is not believed
+	untrustedConfig := &tls.Config{ServerName: "127.0.0.1", RootCAs: x509.NewCertPool()}
+	untrusted := fdTestDetectors(t, monitor, []tla.TLAValue{id}, WithFailureDetectorTLS(untrustedConfig))[0]
+	monitorAuthTestAwait(t, "a detector not trusting the monitor's certificate to suspect the archetype", untrusted, true)
+	sec := monitorSecurity{clientTLS: untrustedConfig}
+	var certErr x509.UnknownAuthorityError
+	if _, err := sec.dial(monitor.ListenAddr, time.Second); !errors.As(err, &certErr) {
+		t.Fatalf("expected the monitor's certificate to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// MonitorMetrics receives measurements from a Monitor, so that they can be exported to a monitoring system.
+// Implementations must be safe for concurrent use, and should not block.
+type MonitorMetrics interface {
+	// ArchetypeRegistered is called when an archetype starts running under the monitor for the first time.
+	ArchetypeRegistered(archetypeID tla.TLAValue)
+	// ArchetypeFailed is called when an archetype running under the monitor fails.
+	ArchetypeFailed(archetypeID tla.TLAValue)
+	// HeartbeatServed is called each time a failure detector is told the state of an archetype, via either the
+	// IsAlive or the Heartbeat RPC. Its rate is the rate of heartbeats.
+	HeartbeatServed(archetypeID tla.TLAValue)
+	// RPCError is called each time an RPC served or sent by the monitor fails. method is the name of the RPC,
+	// e.g. "IsAlive", or "Accept" for incoming connections that could not be authenticated.
+	RPCError(method string)
+}
+
+// MonitorLogger receives a Monitor's log messages, each with a list of alternating keys and values describing it,
+// such as "archetype", archetypeID. Keys are strings. This allows forwarding the messages to a structured logging
+// library; by default, they are sent to distsys.DefaultLogger.
+type MonitorLogger interface {
+	Log(msg string, keyvals ...interface{})
+}
+
+type stdMonitorLogger struct{}
+
+func (stdMonitorLogger) Log(msg string, keyvals ...interface{}) {
+	distsys.DefaultLogger.Log(distsys.LogInfo, "Monitor: "+msg, keyvals...)
+}
+
+// WithMonitorMetrics reports the monitor's activity to metrics.
+func WithMonitorMetrics(metrics MonitorMetrics) MonitorOption {
+	return func(m *Monitor) {
+		m.metrics = metrics
+	}
+}
+
+// WithMonitorLogger sends the monitor's log messages to logger, instead of the standard log package.
+func WithMonitorLogger(logger MonitorLogger) MonitorOption {
+	return func(m *Monitor) {
+		m.logger = logger
+	}
+}
+
+// WithMonitorNodeID adds a "node" key with value nodeID to every log message of the monitor, so that the logs of
+// several nodes can be told apart once aggregated.
+func WithMonitorNodeID(nodeID string) MonitorOption {
+	return func(m *Monitor) {
+		m.nodeID = nodeID
+	}
+}
+
+func (m *Monitor) log(msg string, keyvals ...interface{}) {
+	if m.nodeID != "" {
+		keyvals = append([]interface{}{"node", m.nodeID}, keyvals...)
+	}
+	m.logger.Log(msg, keyvals...)
+}
+
+func (m *Monitor) rpcError(method string) {
+	if m.metrics != nil {
+		m.metrics.RPCError(method)
+	}
+}
+
+func (m *Monitor) heartbeatServed(archetypeID tla.TLAValue) {
+	if m.metrics != nil {
+		m.metrics.HeartbeatServed(archetypeID)
+	}
+}
@@ -0,0 +1,123 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// fakeMonitorMetrics records the calls a Monitor makes to its MonitorMetrics, as strings such as "failed 1".
+type fakeMonitorMetrics struct {
+	lock  sync.Mutex
+	calls []string
+}
+
+var _ MonitorMetrics = &fakeMonitorMetrics{}
+
+func (metrics *fakeMonitorMetrics) record(format string, args ...interface{}) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+	metrics.calls = append(metrics.calls, fmt.Sprintf(format, args...))
+}
+
+func (metrics *fakeMonitorMetrics) get() []string {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+	return append([]string(nil), metrics.calls...)
+}
+
+func (metrics *fakeMonitorMetrics) ArchetypeRegistered(archetypeID tla.TLAValue) {
+	metrics.record("registered %v", archetypeID)
+}
+
+func (metrics *fakeMonitorMetrics) ArchetypeFailed(archetypeID tla.TLAValue) {
+	metrics.record("failed %v", archetypeID)
+}
+
+func (metrics *fakeMonitorMetrics) HeartbeatServed(archetypeID tla.TLAValue) {
+	metrics.record("heartbeat %v", archetypeID)
+}
+
+func (metrics *fakeMonitorMetrics) RPCError(method string) {
+	metrics.record("error %s", method)
+}
+
+type fakeMonitorLogger struct {
+	lock     sync.Mutex
+	messages []string
+	keyvals  [][]interface{}
+}
+
+func (logger *fakeMonitorLogger) Log(msg string, keyvals ...interface{}) {
+	logger.lock.Lock()
+	defer logger.lock.Unlock()
+	logger.messages = append(logger.messages, msg)
+	logger.keyvals = append(logger.keyvals, keyvals)
+}
+
+func TestMonitorMetrics(t *testing.T) {
+	metrics := &fakeMonitorMetrics{}
+	logger := &fakeMonitorLogger{}
+	monitor := NewMonitor(freeLocalAddr(t), WithMonitorMetrics(metrics), WithMonitorLogger(logger), WithMonitorNodeID("node1"),
+		WithMonitorSharedSecret([]byte("secret")))
+	rcvr := &MonitorRPCReceiver{m: monitor}
+	one, two := tla.MakeTLANumber(1), tla.MakeTLANumber(2)
+
+	monitor.setState(one, alive)
+	monitor.setState(one, alive) // not a change, so not counted
+	monitor.setState(two, alive)
+	monitor.setState(two, failed)
+	var reply ArchetypeState
+	if err := rcvr.IsAlive(one, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if err := rcvr.Heartbeat(HeartbeatArgs{ArchetypeID: two, KnownState: alive, Interval: time.Second}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if err := rcvr.IsAlive(tla.MakeTLANumber(3), &reply); err == nil {
+		t.Fatal("expected asking about an unknown archetype to fail")
+	}
+	expected := []string{
+		"registered 1",
+		"registered 2",
+		"failed 2",
+		"heartbeat 1",
+		"heartbeat 2",
+		"error IsAlive",
+	}
+	if calls := metrics.get(); !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("expected the calls %v, got %v", expected, calls)
+	}
+
+	// connections that fail to authenticate are counted as errors of the Accept method
+	startMonitorTest(t, monitor)
+	wrong := monitorSecurity{secret: []byte("guess")}
+	awaitCondition(t, "a connection with the wrong secret to be rejected", func() bool {
+		_, err := wrong.dial(monitor.ListenAddr, time.Second)
+		return errors.Is(err, errMonitorAuthFailed)
+	})
+	awaitCondition(t, "the rejected connection to be counted", func() bool {
+		calls := metrics.get()
+		return len(calls) > len(expected) && calls[len(calls)-1] == "error Accept"
+	})
+
+	// every log message is tagged with the node ID
+	logger.lock.Lock()
+	defer logger.lock.Unlock()
+	if len(logger.messages) == 0 {
+		t.Fatal("expected the monitor to log its state changes")
+	}
+	for i, keyvals := range logger.keyvals {
+		if len(keyvals) < 2 || keyvals[0] != "node" || keyvals[1] != "node1" {
+			t.Fatalf("expected the message %q to be tagged with the node ID, got %v", logger.messages[i], keyvals)
+		}
+	}
+	if logger.messages[0] != "archetype state changed" || !reflect.DeepEqual(logger.keyvals[0][2:4], []interface{}{"archetype", one}) {
+		t.Fatalf("expected the first message to report archetype 1 registering, got %q %v", logger.messages[0], logger.keyvals[0])
+	}
+}
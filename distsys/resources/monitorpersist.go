@@ -0,0 +1,91 @@
+package resources
+
+import (
+	"encoding/gob"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// WithMonitorPersistence makes the monitor save the states of the archetypes it runs to the file at path, whenever
+// they change. When a monitor with this option is created and the file exists, the saved states are restored, and
+// reported to failure detectors for up to grace, giving the archetypes time to re-register after a restart instead
+// of being reported as failed in the meantime. Archetypes that do not re-register within grace are reported as
+// failed.
+func WithMonitorPersistence(path string, grace time.Duration) MonitorOption {
+	return func(m *Monitor) {
+		m.persistPath = path
+		m.persistGrace = grace
+	}
+}
+
+// restore loads the states saved by persist, treating them like states replicated from a peer whose lease expires
+// after the grace period. Any error is logged, and the monitor starts afresh.
+func (m *Monitor) restore() {
+	f, err := os.Open(m.persistPath)
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		m.log("could not restore state", "path", m.persistPath, "error", err)
+		return
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	var entries []MonitorStateEntry
+	err = gob.NewDecoder(f).Decode(&entries)
+	if err != nil {
+		m.log("could not restore state", "path", m.persistPath, "error", err)
+		return
+	}
+	now := m.clock.Now()
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, entry := range entries {
+		m.replicas[entry.ArchetypeID] = monitorReplicatedState{
+			state:     entry.State,
+			expiry:    now.Add(m.persistGrace),
+			changedAt: now,
+		}
+	}
+	m.log("restored state", "path", m.persistPath, "archetypes", len(entries))
+}
+
+// persist atomically replaces the saved states with the current states of this monitor's own archetypes.
+func (m *Monitor) persist() {
+	m.persistLock.Lock()
+	defer m.persistLock.Unlock()
+
+	m.lock.RLock()
+	entries := make([]MonitorStateEntry, 0, len(m.states))
+	for archetypeID, state := range m.states {
+		entries = append(entries, MonitorStateEntry{ArchetypeID: archetypeID, State: state})
+	}
+	m.lock.RUnlock()
+
+	err := func() error {
+		f, err := ioutil.TempFile(filepath.Dir(m.persistPath), filepath.Base(m.persistPath)+".tmp-")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = os.Remove(f.Name())
+		}()
+		err = gob.NewEncoder(f).Encode(entries)
+		if err == nil {
+			err = f.Sync()
+		}
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+		return os.Rename(f.Name(), m.persistPath)
+	}()
+	if err != nil {
+		m.log("could not persist state", "path", m.persistPath, "error", err)
+	}
+}
@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func monitorPersistTestState(t *testing.T, monitor *Monitor, archetypeID tla.TLAValue, expected ArchetypeState) {
+	t.Helper()
+	if state, ok := monitor.getState(archetypeID); !ok || state != expected {
+		t.Fatalf("expected archetype %v to be %v, got %v (known: %v)", archetypeID, expected, state, ok)
+	}
+}
+
+func TestMonitorPersistence(t *testing.T) {
+	const grace = time.Minute
+	dir := t.TempDir()
+	path := filepath.Join(dir, "monitor.state")
+	clock := NewControlledClock()
+	clock.Freeze()
+	reregistering, lost, crashed := tla.MakeTLANumber(1), tla.MakeTLANumber(2), tla.MakeTLANumber(3)
+
+	before := NewMonitor("", WithMonitorPersistence(path, grace), WithMonitorClock(clock))
+	before.setState(reregistering, alive)
+	before.setState(lost, alive)
+	before.setState(crashed, alive)
+	before.setState(crashed, failed)
+	if entries, err := os.ReadDir(dir); err != nil || len(entries) != 1 {
+		t.Fatalf("expected only the state file to be left behind, got %v, %v", entries, err)
+	}
+
+	// after a restart, the saved states are reported, so that detectors do not see the archetypes fail meanwhile
+	after := NewMonitor("", WithMonitorPersistence(path, grace), WithMonitorClock(clock))
+	monitorPersistTestState(t, after, reregistering, alive)
+	monitorPersistTestState(t, after, lost, alive)
+	monitorPersistTestState(t, after, crashed, failed)
+	for _, status := range after.Statuses() {
+		if !status.Replicated {
+			t.Fatalf("expected restored states to be reported as replicated until the archetypes re-register, got %+v", status)
+		}
+	}
+
+	// an archetype that re-registers is reported as usual, while the others are reported as failed after the grace
+	clock.Advance(grace / 2)
+	after.setState(reregistering, alive)
+	monitorPersistTestState(t, after, lost, alive)
+	clock.Advance(grace)
+	monitorPersistTestState(t, after, reregistering, alive)
+	monitorPersistTestState(t, after, lost, failed)
+
+	// the new monitor saves only the archetypes that re-registered with it
+	again := NewMonitor("", WithMonitorPersistence(path, grace), WithMonitorClock(clock))
+	monitorPersistTestState(t, again, reregistering, alive)
+	if state, ok := again.getState(lost); ok {
+		t.Fatalf("expected an archetype that did not re-register to be forgotten, got %v", state)
+	}
+}
+
+func TestMonitorPersistenceCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "monitor.state")
+	if err := os.WriteFile(path, []byte("not a saved state"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// a monitor that cannot restore its state starts afresh
+	monitor := NewMonitor("", WithMonitorPersistence(path, time.Minute))
+	if statuses := monitor.Statuses(); len(statuses) != 0 {
+		t.Fatalf("expected no archetypes to be restored, got %+v", statuses)
+	}
+	monitor.setState(tla.MakeTLANumber(1), alive)
+	restarted := NewMonitor("", WithMonitorPersistence(path, time.Minute))
+	monitorPersistTestState(t, restarted, tla.MakeTLANumber(1), alive)
+}
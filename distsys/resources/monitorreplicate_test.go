@@ -0,0 +1,147 @@
+package resources
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const monitorReplicateTestInterval = 50 * time.Millisecond
+
+// silentMonitorTest starts a listener that accepts connections, but never answers, and returns its address.
+func silentMonitorTest(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() {
+				_ = conn.Close()
+			})
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func TestMonitorReplicationFailover(t *testing.T) {
+	// the fallback's clock is frozen, so that the replicated state only expires when the test says so
+	clock := NewControlledClock()
+	clock.Freeze()
+	fallback := NewMonitor(freeLocalAddr(t), WithMonitorClock(clock))
+	startMonitorTest(t, fallback)
+	primary := NewMonitor(freeLocalAddr(t), WithMonitorPeers(monitorReplicateTestInterval, fallback.ListenAddr))
+	startMonitorTest(t, primary)
+	id := tla.MakeTLANumber(1)
+	primary.setState(id, alive)
+	awaitCondition(t, "the archetype's state to be replicated", func() bool {
+		state, ok := fallback.getState(id)
+		return ok && state == alive
+	})
+
+	withFallback := fdTestDetectors(t, primary, []tla.TLAValue{id}, WithFailureDetectorFallbackMonitors(func(tla.TLAValue) []string {
+		return []string{fallback.ListenAddr}
+	}))[0]
+	withoutFallback := fdTestDetectors(t, primary, []tla.TLAValue{id})[0]
+	awaitCondition(t, "the archetype to be reported alive", func() bool {
+		suspects1, known1 := fdTestSuspects(t, withFallback)
+		suspects2, known2 := fdTestSuspects(t, withoutFallback)
+		return known1 && !suspects1 && known2 && !suspects2
+	})
+
+	// once the primary is gone, only the detector that can fail over still knows the archetype is alive
+	if err := primary.Close(); err != nil {
+		t.Fatal(err)
+	}
+	awaitCondition(t, "the detector without a fallback to suspect the archetype", func() bool {
+		suspects, known := fdTestSuspects(t, withoutFallback)
+		return known && suspects
+	})
+	for i := 0; i < 10; i++ {
+		if suspects, _ := fdTestSuspects(t, withFallback); suspects {
+			t.Fatal("expected the detector with a fallback to keep reporting the archetype alive")
+		}
+		time.Sleep(fdTestPullInterval)
+	}
+
+	// without the primary to renew it, the replicated state expires, and the archetype is suspected after all
+	clock.Advance(monitorReplicationLeases*monitorReplicateTestInterval + time.Millisecond)
+	awaitCondition(t, "the detector with a fallback to suspect the archetype", func() bool {
+		suspects, known := fdTestSuspects(t, withFallback)
+		return known && suspects
+	})
+}
+
+func TestMonitorReplicationSlowPeers(t *testing.T) {
+	// peers that never answer must not delay replicating to the ones that do, past their leases
+	peer := NewMonitor(freeLocalAddr(t))
+	startMonitorTest(t, peer)
+	peers := []string{silentMonitorTest(t), silentMonitorTest(t), silentMonitorTest(t), peer.ListenAddr}
+	monitor := NewMonitor(freeLocalAddr(t), WithMonitorPeers(monitorReplicateTestInterval, peers...))
+	startMonitorTest(t, monitor)
+	id := tla.MakeTLANumber(1)
+	monitor.setState(id, alive)
+	awaitCondition(t, "the archetype's state to be replicated", func() bool {
+		state, ok := peer.getState(id)
+		return ok && state == alive
+	})
+
+	for deadline := time.Now().Add(20 * monitorReplicateTestInterval); time.Now().Before(deadline); {
+		if state, _ := peer.getState(id); state != alive {
+			t.Fatalf("expected the replicated state to stay alive, got %v", state)
+		}
+		time.Sleep(monitorReplicateTestInterval / 5)
+	}
+}
+
+func TestMonitorReplicaExpiry(t *testing.T) {
+	clock := NewControlledClock()
+	clock.Freeze()
+	monitor := NewMonitor("", WithMonitorClock(clock))
+	replicate := func(ids ...tla.TLAValue) {
+		t.Helper()
+		args := &MonitorReplicateArgs{Lease: time.Second}
+		for _, id := range ids {
+			args.States = append(args.States, MonitorStateEntry{ArchetypeID: id, State: alive})
+		}
+		var reply bool
+		if err := (&MonitorRPCReceiver{m: monitor}).Replicate(args, &reply); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stale, renewed, local := tla.MakeTLANumber(1), tla.MakeTLANumber(2), tla.MakeTLANumber(3)
+	replicate(stale, renewed, local)
+
+	// an expired replica is reported as failed for a while, then forgotten
+	clock.Advance(2 * time.Second)
+	if state, ok := monitor.getState(stale); !ok || state != failed {
+		t.Fatalf("expected the expired replica to be reported failed, got %v (known: %v)", state, ok)
+	}
+	clock.Advance(monitorReplicaRetention)
+	replicate(renewed)
+	if _, ok := monitor.getState(stale); ok {
+		t.Fatal("expected the replica expired for longer than the retention period to be forgotten")
+	}
+	if state, ok := monitor.getState(renewed); !ok || state != alive {
+		t.Fatalf("expected the renewed replica to be reported alive, got %v (known: %v)", state, ok)
+	}
+
+	// an archetype that comes to run on this monitor replaces its replica
+	monitor.setState(local, alive)
+	monitor.lock.RLock()
+	_, replicated := monitor.replicas[local]
+	monitor.lock.RUnlock()
+	if replicated {
+		t.Fatal("expected the replica of an archetype running locally to be dropped")
+	}
+}
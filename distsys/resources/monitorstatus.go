@@ -0,0 +1,104 @@
+package resources
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ArchetypeStatus describes one archetype known to a Monitor.
+type ArchetypeStatus struct {
+	ArchetypeID tla.TLAValue   `json:"-"`
+	ID          string         `json:"id"` // ArchetypeID, formatted as TLA+
+	State       ArchetypeState `json:"state"`
+	// Since is when the archetype entered its current State. For replicated archetypes, it is when this monitor
+	// learned of the state.
+	Since time.Time `json:"since"`
+	// RegisteredAt is when the archetype started running in this monitor. It is zero for replicated archetypes.
+	RegisteredAt time.Time `json:"registered_at"`
+	// Replicated is true if the archetype runs under a peer monitor, and its state was replicated to this one, or if
+	// its state was restored from disk (see WithMonitorPersistence) and it has not re-registered yet.
+	Replicated bool `json:"replicated"`
+	// Forced is true if State was forced by MarkFailed or MarkAlive. Since is then when it was forced.
+	Forced bool `json:"forced"`
+}
+
+// Statuses lists every archetype this monitor knows about, along with the same states its IsAlive RPC reports, so
+// that external orchestration can act on the same view the failure detectors use. The result is sorted by ID.
+func (m *Monitor) Statuses() []ArchetypeStatus {
+	m.lock.RLock()
+	var statuses []ArchetypeStatus
+	for archetypeID, state := range m.states {
+		times := m.times[archetypeID]
+		statuses = append(statuses, ArchetypeStatus{
+			ArchetypeID:  archetypeID,
+			ID:           archetypeID.String(),
+			State:        state,
+			Since:        times.changedAt,
+			RegisteredAt: times.registeredAt,
+		})
+	}
+	for archetypeID, replica := range m.replicas {
+		if _, ok := m.states[archetypeID]; ok {
+			continue
+		}
+		state, since := replica.state, replica.changedAt
+		if m.clock.Now().After(replica.expiry) {
+			state, since = failed, replica.expiry // the replica expired, so the archetype is reported as failed
+		}
+		statuses = append(statuses, ArchetypeStatus{
+			ArchetypeID: archetypeID,
+			ID:          archetypeID.String(),
+			State:       state,
+			Since:       since,
+			Replicated:  true,
+		})
+	}
+	for i := range statuses {
+		if override, ok := m.overrides[statuses[i].ArchetypeID]; ok {
+			statuses[i].State = override.state
+			statuses[i].Since = override.at
+			statuses[i].Forced = true
+		}
+	}
+	for archetypeID, override := range m.overrides {
+		_, isLocal := m.states[archetypeID]
+		_, isReplicated := m.replicas[archetypeID]
+		if !isLocal && !isReplicated {
+			statuses = append(statuses, ArchetypeStatus{
+				ArchetypeID: archetypeID,
+				ID:          archetypeID.String(),
+				State:       override.state,
+				Since:       override.at,
+				Forced:      true,
+			})
+		}
+	}
+	m.lock.RUnlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ID < statuses[j].ID
+	})
+	return statuses
+}
+
+// ServeHTTP serves the result of Statuses as a JSON array, allowing a Monitor to be mounted on an HTTP server,
+// e.g. http.Handle("/archetypes", monitor). Only GET requests are allowed.
+func (m *Monitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	statuses := m.Statuses()
+	if statuses == nil {
+		statuses = []ArchetypeStatus{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(statuses)
+}
+
+var _ http.Handler = &Monitor{}
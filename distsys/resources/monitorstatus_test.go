@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func TestMonitorStatuses(t *testing.T) {
+	clock := NewControlledClock()
+	clock.Freeze()
+	monitor := NewMonitor("", WithMonitorClock(clock))
+	if statuses := monitor.Statuses(); len(statuses) != 0 {
+		t.Fatalf("expected a new monitor to know no archetypes, got %+v", statuses)
+	}
+
+	registeredAt := clock.Now()
+	monitor.setState(tla.MakeTLANumber(1), alive)
+	monitor.setState(tla.MakeTLANumber(2), alive)
+	clock.Advance(time.Second)
+	failedAt := clock.Now()
+	monitor.setState(tla.MakeTLANumber(2), failed)
+	var reply bool
+	err := (&MonitorRPCReceiver{m: monitor}).Replicate(&MonitorReplicateArgs{
+		States: []MonitorStateEntry{
+			{ArchetypeID: tla.MakeTLANumber(3), State: alive},
+			{ArchetypeID: tla.MakeTLANumber(4), State: alive},
+		},
+		Lease: time.Minute,
+	}, &reply)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(time.Second)
+	markedAt := clock.Now()
+	monitor.MarkFailed(tla.MakeTLANumber(1))
+	monitor.MarkAlive(tla.MakeTLANumber(5))
+
+	expected := []ArchetypeStatus{
+		{ID: "1", State: failed, Since: markedAt, RegisteredAt: registeredAt, Forced: true},
+		{ID: "2", State: failed, Since: failedAt, RegisteredAt: registeredAt},
+		{ID: "3", State: alive, Since: failedAt, Replicated: true},
+		{ID: "4", State: alive, Since: failedAt, Replicated: true},
+		{ID: "5", State: alive, Since: markedAt, Forced: true},
+	}
+	monitorStatusTestCheck(t, monitor.Statuses(), expected)
+
+	// replicated states are reported as failed once their lease expires, as of the expiry
+	clock.Advance(time.Minute)
+	expected[2].State, expected[2].Since = failed, failedAt.Add(time.Minute)
+	expected[3].State, expected[3].Since = failed, failedAt.Add(time.Minute)
+	monitorStatusTestCheck(t, monitor.Statuses(), expected)
+
+	// clearing a mark reports the actual state again
+	monitor.ClearMark(tla.MakeTLANumber(1))
+	expected[0].State, expected[0].Since, expected[0].Forced = alive, registeredAt, false
+	monitorStatusTestCheck(t, monitor.Statuses()[:1], expected[:1])
+}
+
+func monitorStatusTestCheck(t *testing.T, statuses, expected []ArchetypeStatus) {
+	t.Helper()
+	if len(statuses) != len(expected) {
+		t.Fatalf("expected %d statuses, got %+v", len(expected), statuses)
+	}
+	for i := range statuses {
+		status := statuses[i]
+		status.ArchetypeID = tla.TLAValue{}
+		if !status.Since.Equal(expected[i].Since) || !status.RegisteredAt.Equal(expected[i].RegisteredAt) {
+			t.Fatalf("expected status %+v, got %+v", expected[i], status)
+		}
+		status.Since, status.RegisteredAt = expected[i].Since, expected[i].RegisteredAt
+		if status != expected[i] {
+			t.Fatalf("expected status %+v, got %+v", expected[i], status)
+		}
+	}
+}
+
+func TestMonitorServeHTTP(t *testing.T) {
+	monitor := NewMonitor("")
+	server := httptest.NewServer(monitor)
+	defer server.Close()
+
+	get := func() []map[string]interface{} {
+		t.Helper()
+		resp, err := http.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status 200, got %s", resp.Status)
+		}
+		if contentType := resp.Header.Get("Content-Type"); contentType != "application/json" {
+			t.Fatalf("expected a JSON response, got %s", contentType)
+		}
+		var statuses []map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&statuses); err != nil {
+			t.Fatal(err)
+		}
+		return statuses
+	}
+
+	// with no archetypes, the response is an empty array rather than null
+	if statuses := get(); statuses == nil || len(statuses) != 0 {
+		t.Fatalf("expected an empty array, got %v", statuses)
+	}
+
+	monitor.setState(tla.MakeTLAString("server"), alive)
+	monitor.setState(tla.MakeTLAString("client"), failed)
+	statuses := get()
+	if len(statuses) != 2 {
+		t.Fatalf("expected 2 statuses, got %v", statuses)
+	}
+	for i, expected := range []struct{ id, state string }{{`"client"`, "failed"}, {`"server"`, "alive"}} {
+		status := statuses[i]
+		if status["id"] != expected.id || status["state"] != expected.state || status["replicated"] != false || status["forced"] != false {
+			t.Fatalf("expected archetype %s to be reported %s, got %v", expected.id, expected.state, status)
+		}
+		for _, key := range []string{"since", "registered_at"} {
+			if _, err := time.Parse(time.RFC3339Nano, status[key].(string)); err != nil {
+				t.Fatalf("expected %s to be a timestamp, got %v", key, status[key])
+			}
+		}
+	}
+
+	resp, err := http.Post(server.URL, "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed || resp.Header.Get("Allow") != http.MethodGet {
+		t.Fatalf("expected only GET to be allowed, got %s", resp.Status)
+	}
+}
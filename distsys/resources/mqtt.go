@@ -0,0 +1,330 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const (
+	mqttTimeout      = 2 * time.Second
+	mqttRetryBackoff = 100 * time.Millisecond
+)
+
+// MQTTQoS is an MQTT quality of service level.
+type MQTTQoS byte
+
+const (
+	// MQTTAtMostOnce delivers messages without acknowledgement, so they may be lost.
+	MQTTAtMostOnce MQTTQoS = iota
+	// MQTTAtLeastOnce acknowledges each message, so that it is retransmitted until received, possibly twice.
+	MQTTAtLeastOnce
+	// MQTTExactlyOnce uses a two-step acknowledgement, so that the broker delivers each message once.
+	MQTTExactlyOnce
+)
+
+// MQTTMessage is a message received through an MQTTSubscription.
+type MQTTMessage interface {
+	// Payload returns the message's payload.
+	Payload() []byte
+	// Ack acknowledges the message to the broker, with PUBACK at QoS 1 or PUBREC at QoS 2. At QoS 0, it does
+	// nothing.
+	Ack() error
+}
+
+// MQTTSubscription delivers the messages published on a topic.
+type MQTTSubscription interface {
+	// Next waits for the next message until ctx is done, returning nil and no error if none arrived.
+	Next(ctx context.Context) (MQTTMessage, error)
+	// Unsubscribe ends the subscription. With a persistent session, the broker redelivers its unacknowledged
+	// messages to the next subscription.
+	Unsubscribe() error
+}
+
+// MQTTClient is the subset of an MQTT client needed by MQTTMailboxesMaker. This package does not speak the MQTT
+// protocol; for the Eclipse Paho client, a small adapter over a mqtt.Client, connected with SetAutoAckDisabled(true),
+// and with SetCleanSession(false) and a client ID unique to the node for a persistent session, is enough:
+//
+//	Publish:   token := c.Publish(topic, byte(qos), false, payload), then wait for the token until ctx is done
+//	Subscribe: c.Subscribe(topic, byte(qos), handler), with a handler passing each mqtt.Message to a channel that
+//	           Next receives from; the message's Ack is msg.Ack()
+type MQTTClient interface {
+	// Publish publishes payload to topic at qos, returning once the broker has acknowledged it, as far as qos
+	// allows.
+	Publish(ctx context.Context, topic string, qos MQTTQoS, payload []byte) error
+	// Subscribe subscribes to topic at qos.
+	Subscribe(ctx context.Context, topic string, qos MQTTQoS) (MQTTSubscription, error)
+}
+
+type mqttConfig struct {
+	timeout     time.Duration
+	readTimeout time.Duration
+	qos         MQTTQoS
+}
+
+// MQTTMailboxesOption configures the mailboxes made by MQTTMailboxesMaker.
+type MQTTMailboxesOption func(cfg *mqttConfig)
+
+// WithMQTTQoS sets the quality of service level used to publish and subscribe. The default is MQTTAtLeastOnce.
+func WithMQTTQoS(qos MQTTQoS) MQTTMailboxesOption {
+	return func(cfg *mqttConfig) {
+		if qos > MQTTExactlyOnce {
+			panic(fmt.Errorf("invalid MQTT QoS level %d", qos))
+		}
+		cfg.qos = qos
+	}
+}
+
+// WithMQTTTimeout sets the timeout of each request made through the MQTTClient.
+func WithMQTTTimeout(timeout time.Duration) MQTTMailboxesOption {
+	return func(cfg *mqttConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithMQTTReadTimeout sets how long a read from an empty local mailbox waits for a message, before aborting the
+// critical section.
+func WithMQTTReadTimeout(timeout time.Duration) MQTTMailboxesOption {
+	return func(cfg *mqttConfig) {
+		cfg.readTimeout = timeout
+	}
+}
+
+// MQTTMailboxesMappingFn maps a mailbox index to the MQTT topic carrying its messages, and whether it is to be
+// received from by this node, as TCPMailboxesLocal, or only sent to, as TCPMailboxesRemote.
+type MQTTMailboxesMappingFn func(index tla.TLAValue) (TCPMailboxKind, string)
+
+// MQTTMailboxesMaker produces mailboxes, like TCPMailboxesMaker, whose messages are exchanged through an MQTT
+// broker, accessed through client, on the topics given by mappingFn. Values are gob-encoded, one per message. The
+// client is shared by all the mailboxes, and is not closed along with them.
+//
+// Values written to a remote mailbox are published when the critical section commits, and Commit retries until the
+// broker acknowledges them, as far as the QoS level allows. A retry may publish a value twice. Values received by
+// a local mailbox are buffered, and returned to the buffer if the critical section reading them aborts. At QoS 1
+// and 2, a message is only acknowledged to the broker once the critical section reading it commits, so that, with a
+// persistent session, messages are not lost if the node crashes before processing them.
+//
+// Brokers may limit how many unacknowledged messages they send at once, which bounds how far a local mailbox can
+// read ahead of its commits.
+func MQTTMailboxesMaker(client MQTTClient, mappingFn MQTTMailboxesMappingFn, opts ...MQTTMailboxesOption) distsys.ArchetypeResourceMaker {
+	cfg := &mqttConfig{
+		timeout:     mqttTimeout,
+		readTimeout: inputChannelReadTimout,
+		qos:         MQTTAtLeastOnce,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		kind, topic := mappingFn(index)
+		switch kind {
+		case TCPMailboxesLocal:
+			return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+				return &mqttMailboxLocal{
+					client: client,
+					topic:  topic,
+					config: cfg,
+				}
+			})
+		case TCPMailboxesRemote:
+			return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+				return &mqttMailboxRemote{
+					client: client,
+					topic:  topic,
+					config: cfg,
+				}
+			})
+		default:
+			panic(fmt.Errorf("invalid MQTT mailbox type %d for topic %s: expected local or remote, which are %d or %d", kind, topic, TCPMailboxesLocal, TCPMailboxesRemote))
+		}
+	})
+}
+
+// mqttReceived is a value read from a local mailbox, with the message carrying it, for acknowledgement.
+type mqttReceived struct {
+	value tla.TLAValue
+	msg   MQTTMessage
+}
+
+type mqttMailboxLocal struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	client MQTTClient
+	topic  string
+	config *mqttConfig
+
+	sub MQTTSubscription
+	// broken is set when sub failed, so that it is replaced before the next critical section reads
+	broken bool
+	// backlog holds values received on sub but not yet consumed, the first readCount of which were read by the
+	// current critical section
+	backlog   []mqttReceived
+	readCount int
+}
+
+var _ distsys.ArchetypeResource = &mqttMailboxLocal{}
+
+// ensureSubscription (re)subscribes to the mailbox's topic. The backlog is dropped on resubscription, as the broker
+// redelivers whatever was not acknowledged.
+func (res *mqttMailboxLocal) ensureSubscription() error {
+	if res.sub != nil {
+		if !res.broken {
+			return nil
+		}
+		_ = res.sub.Unsubscribe()
+		res.sub = nil
+		res.broken = false
+		if res.config.qos != MQTTAtMostOnce {
+			res.backlog = nil
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), res.config.timeout)
+	defer cancel()
+	sub, err := res.client.Subscribe(ctx, res.topic, res.config.qos)
+	if err != nil {
+		return err
+	}
+	res.sub = sub
+	return nil
+}
+
+func (res *mqttMailboxLocal) Abort() chan struct{} {
+	res.readCount = 0
+	return nil
+}
+
+func (res *mqttMailboxLocal) PreCommit() chan error {
+	return nil
+}
+
+func (res *mqttMailboxLocal) Commit() chan struct{} {
+	if res.readCount == 0 {
+		return nil
+	}
+	for _, received := range res.backlog[:res.readCount] {
+		if err := received.msg.Ack(); err != nil {
+			// the broker will redeliver the messages when we resubscribe
+			res.log(distsys.LogWarn, "could not acknowledge MQTT messages", "topic", res.topic, "error", err)
+			res.broken = true
+			break
+		}
+	}
+	res.backlog = res.backlog[res.readCount:]
+	res.readCount = 0
+	return nil
+}
+
+func (res *mqttMailboxLocal) ReadValue() (tla.TLAValue, error) {
+	// only resubscribe between critical sections, so that we never read a redelivered message twice
+	if res.readCount == 0 {
+		err := res.ensureSubscription()
+		if err != nil {
+			res.log(distsys.LogWarn, "could not subscribe to MQTT topic, aborting", "topic", res.topic, "error", err)
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+	}
+	if res.readCount == len(res.backlog) {
+		if res.broken {
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), res.config.readTimeout)
+		msg, err := res.sub.Next(ctx)
+		cancel()
+		if err != nil {
+			res.log(distsys.LogWarn, "could not receive from MQTT, aborting", "topic", res.topic, "error", err)
+			res.broken = true
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+		if msg == nil {
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+		var value tla.TLAValue
+		err = gob.NewDecoder(bytes.NewReader(msg.Payload())).Decode(&value)
+		if err != nil {
+			return tla.TLAValue{}, fmt.Errorf("could not decode message on %s: %w", res.topic, err)
+		}
+		res.backlog = append(res.backlog, mqttReceived{value: value, msg: msg})
+	}
+	value := res.backlog[res.readCount].value
+	res.readCount++
+	return value, nil
+}
+
+func (res *mqttMailboxLocal) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write value %v to a local MQTT mailbox", value))
+}
+
+func (res *mqttMailboxLocal) Close() error {
+	if res.sub != nil {
+		return res.sub.Unsubscribe()
+	}
+	return nil
+}
+
+type mqttMailboxRemote struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	client MQTTClient
+	topic  string
+	config *mqttConfig
+
+	buffer [][]byte
+}
+
+var _ distsys.ArchetypeResource = &mqttMailboxRemote{}
+
+func (res *mqttMailboxRemote) Abort() chan struct{} {
+	res.buffer = nil
+	return nil
+}
+
+func (res *mqttMailboxRemote) PreCommit() chan error {
+	return nil
+}
+
+func (res *mqttMailboxRemote) Commit() chan struct{} {
+	if len(res.buffer) == 0 {
+		return nil
+	}
+	doneCh := make(chan struct{})
+	go func() {
+		sent := 0
+		for sent < len(res.buffer) {
+			ctx, cancel := context.WithTimeout(context.Background(), res.config.timeout)
+			err := res.client.Publish(ctx, res.topic, res.config.qos, res.buffer[sent])
+			cancel()
+			if err != nil {
+				res.log(distsys.LogWarn, "could not publish to MQTT broker, retrying", "topic", res.topic, "error", err)
+				time.Sleep(mqttRetryBackoff)
+				continue
+			}
+			sent++
+		}
+		res.buffer = nil
+		doneCh <- struct{}{}
+	}()
+	return doneCh
+}
+
+func (res *mqttMailboxRemote) ReadValue() (tla.TLAValue, error) {
+	panic(fmt.Errorf("attempted to read from a remote MQTT mailbox"))
+}
+
+func (res *mqttMailboxRemote) WriteValue(value tla.TLAValue) error {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(&value)
+	if err != nil {
+		return err
+	}
+	res.buffer = append(res.buffer, buf.Bytes())
+	return nil
+}
+
+func (res *mqttMailboxRemote) Close() error {
+	return nil
+}
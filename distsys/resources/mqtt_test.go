@@ -0,0 +1,289 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const mqttTestTopic = "mailbox/0"
+
+var errMQTTTestUnavailable = errors.New("broker unavailable")
+
+// memoryMQTTClient is an MQTTClient for one topic, held in memory, as if by a broker keeping a persistent session:
+// messages published at QoS 1 or 2 are kept until acknowledged, and those a subscription did not acknowledge are
+// redelivered to the next one. Messages at QoS 0 only reach the subscriptions at the time.
+type memoryMQTTClient struct {
+	lock     sync.Mutex
+	subs     map[*memoryMQTTSubscription]bool
+	failNext map[string]bool
+	// queued holds the messages not yet acknowledged, in order, and the subscription each was delivered to, if any
+	queued []*memoryMQTTMessage
+	// events holds the publishes and acknowledgements received, as "PUBLISH <qos>" or "ACK <qos>"
+	events []string
+	// published is closed and replaced whenever a message is published, to wake up subscriptions waiting for one
+	published chan struct{}
+}
+
+var _ MQTTClient = &memoryMQTTClient{}
+
+func newMemoryMQTTClient() *memoryMQTTClient {
+	return &memoryMQTTClient{
+		subs:      make(map[*memoryMQTTSubscription]bool),
+		failNext:  make(map[string]bool),
+		published: make(chan struct{}),
+	}
+}
+
+func (client *memoryMQTTClient) failNextRequest(method string) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	client.failNext[method] = true
+}
+
+func (client *memoryMQTTClient) failing(method string) bool {
+	if client.failNext[method] {
+		delete(client.failNext, method)
+		return true
+	}
+	return false
+}
+
+func (client *memoryMQTTClient) Publish(ctx context.Context, topic string, qos MQTTQoS, payload []byte) error {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if topic != mqttTestTopic {
+		return fmt.Errorf("unexpected topic %s", topic)
+	}
+	if client.failing("Publish") {
+		return errMQTTTestUnavailable
+	}
+	client.events = append(client.events, fmt.Sprintf("PUBLISH %d", qos))
+	if qos == MQTTAtMostOnce {
+		for sub := range client.subs {
+			sub.queue = append(sub.queue, &memoryMQTTMessage{client: client, qos: qos, payload: payload})
+		}
+	} else {
+		client.queued = append(client.queued, &memoryMQTTMessage{client: client, qos: qos, payload: payload})
+	}
+	close(client.published)
+	client.published = make(chan struct{})
+	return nil
+}
+
+func (client *memoryMQTTClient) Subscribe(ctx context.Context, topic string, qos MQTTQoS) (MQTTSubscription, error) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if topic != mqttTestTopic {
+		return nil, fmt.Errorf("unexpected topic %s", topic)
+	}
+	if client.failing("Subscribe") {
+		return nil, errMQTTTestUnavailable
+	}
+	sub := &memoryMQTTSubscription{client: client}
+	client.subs[sub] = true
+	return sub, nil
+}
+
+// expectEvents takes the publishes and acknowledgements received so far, expecting them to be the given ones.
+func (client *memoryMQTTClient) expectEvents(t *testing.T, expected ...string) {
+	t.Helper()
+	client.lock.Lock()
+	events := client.events
+	client.events = nil
+	client.lock.Unlock()
+	if strings.Join(events, ", ") != strings.Join(expected, ", ") {
+		t.Fatalf("expected %v, got %v", expected, events)
+	}
+}
+
+// unacknowledged returns how many messages published at QoS 1 or 2 remain unacknowledged.
+func (client *memoryMQTTClient) unacknowledged() int {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	return len(client.queued)
+}
+
+type memoryMQTTSubscription struct {
+	client *memoryMQTTClient
+	queue  []*memoryMQTTMessage // the QoS 0 messages delivered but not yet taken by Next
+}
+
+func (sub *memoryMQTTSubscription) Next(ctx context.Context) (MQTTMessage, error) {
+	client := sub.client
+	for {
+		client.lock.Lock()
+		if !client.subs[sub] {
+			client.lock.Unlock()
+			return nil, fmt.Errorf("subscription ended")
+		}
+		if client.failing("Next") {
+			client.lock.Unlock()
+			return nil, errMQTTTestUnavailable
+		}
+		var msg *memoryMQTTMessage
+		if len(sub.queue) != 0 {
+			msg = sub.queue[0]
+			sub.queue = sub.queue[1:]
+		} else {
+			for _, queued := range client.queued {
+				if queued.deliveredTo == nil {
+					queued.deliveredTo = sub
+					msg = queued
+					break
+				}
+			}
+		}
+		published := client.published
+		client.lock.Unlock()
+		if msg != nil {
+			return msg, nil
+		}
+		select {
+		case <-published:
+		case <-ctx.Done():
+			return nil, nil
+		}
+	}
+}
+
+func (sub *memoryMQTTSubscription) Unsubscribe() error {
+	client := sub.client
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	delete(client.subs, sub)
+	for _, queued := range client.queued {
+		if queued.deliveredTo == sub {
+			queued.deliveredTo = nil
+		}
+	}
+	return nil
+}
+
+type memoryMQTTMessage struct {
+	client      *memoryMQTTClient
+	qos         MQTTQoS
+	payload     []byte
+	deliveredTo *memoryMQTTSubscription
+}
+
+func (msg *memoryMQTTMessage) Payload() []byte {
+	return msg.payload
+}
+
+func (msg *memoryMQTTMessage) Ack() error {
+	if msg.qos == MQTTAtMostOnce {
+		return nil
+	}
+	client := msg.client
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if client.failing("Ack") {
+		return errMQTTTestUnavailable
+	}
+	client.events = append(client.events, fmt.Sprintf("ACK %d", msg.qos))
+	for i, queued := range client.queued {
+		if queued == msg {
+			client.queued = append(client.queued[:i], client.queued[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+const (
+	mqttTestLocal  = 0
+	mqttTestRemote = 1
+)
+
+func makeMQTTTestMailboxes(t *testing.T, client *memoryMQTTClient, qos MQTTQoS) distsys.ArchetypeResource {
+	t.Helper()
+	maker := MQTTMailboxesMaker(client, func(index tla.TLAValue) (TCPMailboxKind, string) {
+		if index.Equal(tla.MakeTLANumber(mqttTestLocal)) {
+			return TCPMailboxesLocal, mqttTestTopic
+		}
+		return TCPMailboxesRemote, mqttTestTopic
+	}, WithMQTTQoS(qos), WithMQTTTimeout(time.Second), WithMQTTReadTimeout(50*time.Millisecond))
+	res := maker.Make()
+	maker.Configure(res)
+	t.Cleanup(func() {
+		_ = res.Close()
+	})
+	return res
+}
+
+func TestMQTTMailboxes(t *testing.T) {
+	for _, qos := range []MQTTQoS{MQTTAtLeastOnce, MQTTExactlyOnce} {
+		qos := qos
+		t.Run(fmt.Sprintf("QoS %d", qos), func(t *testing.T) {
+			client := newMemoryMQTTClient()
+			sender := makeMQTTTestMailboxes(t, client, qos)
+			receiver := makeMQTTTestMailboxes(t, client, qos)
+			publish, ack := fmt.Sprintf("PUBLISH %d", qos), fmt.Sprintf("ACK %d", qos)
+
+			// an aborted send publishes nothing, and a commit retries until the broker acknowledged everything
+			if err := mailboxesTestIndex(t, sender, mqttTestRemote).WriteValue(tla.MakeTLANumber(0)); err != nil {
+				t.Fatal(err)
+			}
+			mailboxesTestAbort(sender)
+			client.failNextRequest("Publish")
+			mailboxesTestSend(t, sender, mqttTestRemote, 1, 2)
+			client.expectEvents(t, publish, publish)
+
+			// reading acknowledges nothing, nor does an abort, after which the values are read again
+			expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, mqttTestLocal, 2), 1, 2)
+			mailboxesTestAbort(receiver)
+			expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, mqttTestLocal, 1), 1)
+			// the commit acknowledges what was read
+			mailboxesTestCommit(t, receiver)
+			client.expectEvents(t, ack)
+			if unacknowledged := client.unacknowledged(); unacknowledged != 1 {
+				t.Fatalf("expected 1 message to remain unacknowledged, got %d", unacknowledged)
+			}
+
+			// the receiver crashes after reading 2, before committing, so its replacement reads 2 again
+			expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, mqttTestLocal, 1), 2)
+			if err := receiver.Close(); err != nil {
+				t.Fatal(err)
+			}
+			replacement := makeMQTTTestMailboxes(t, client, qos)
+			expectMailboxesTestValues(t, mailboxesTestReceive(t, replacement, mqttTestLocal, 1), 2)
+			mailboxesTestCommit(t, replacement)
+			client.expectEvents(t, ack)
+			if unacknowledged := client.unacknowledged(); unacknowledged != 0 {
+				t.Fatalf("expected every message to be acknowledged, but %d are not", unacknowledged)
+			}
+			expectMailboxesTestEmpty(t, replacement, mqttTestLocal)
+		})
+	}
+}
+
+func TestMQTTMailboxesBrokenSubscription(t *testing.T) {
+	client := newMemoryMQTTClient()
+	sender := makeMQTTTestMailboxes(t, client, MQTTAtLeastOnce)
+	receiver := makeMQTTTestMailboxes(t, client, MQTTAtLeastOnce)
+	mailboxesTestSend(t, sender, mqttTestRemote, 1, 2)
+
+	// the subscription fails after 1 was read, which aborts the critical section
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, mqttTestLocal, 1), 1)
+	client.failNextRequest("Next")
+	if value, err := mailboxesTestIndex(t, receiver, mqttTestLocal).ReadValue(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected a failed subscription to abort the critical section, got %v, %v", value, err)
+	}
+	mailboxesTestAbort(receiver)
+
+	// the next critical section resubscribes, dropping its backlog, and reads each value once, as redelivered
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, mqttTestLocal, 2), 1, 2)
+	mailboxesTestCommit(t, receiver)
+	expectMailboxesTestEmpty(t, receiver, mqttTestLocal)
+	if unacknowledged := client.unacknowledged(); unacknowledged != 0 {
+		t.Fatalf("expected every message to be acknowledged, but %d are not", unacknowledged)
+	}
+}
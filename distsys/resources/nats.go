@@ -0,0 +1,323 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const (
+	natsTimeout      = 2 * time.Second
+	natsRetryBackoff = 100 * time.Millisecond
+)
+
+// NATSMsg is a message received through a NATSSubscription.
+type NATSMsg interface {
+	// Data returns the message's payload.
+	Data() []byte
+	// Ack acknowledges the message, so that it is not delivered again. Without JetStream, it does nothing.
+	Ack(ctx context.Context) error
+	// InProgress restarts the message's ack wait, keeping it from being redelivered while it is still held.
+	// Without JetStream, it does nothing.
+	InProgress(ctx context.Context) error
+}
+
+// NATSSubscription delivers the messages published on a subject.
+type NATSSubscription interface {
+	// Next waits for the next message until ctx is done, returning nil and no error if none arrived.
+	Next(ctx context.Context) (NATSMsg, error)
+	// Unsubscribe ends the subscription. Under JetStream, its unacknowledged messages are redelivered to the next
+	// subscription to the same durable consumer, once their ack wait expires.
+	Unsubscribe() error
+}
+
+// NATSClient is the subset of a NATS client needed by NATSMailboxesMaker. This package does not speak the NATS
+// protocol; for nats.go, a small adapter over a *nats.Conn is enough, choosing between core NATS and JetStream:
+//
+//	Publish:   nc.Publish(subject, data) then nc.FlushWithContext(ctx), or, under JetStream,
+//	           js.Publish(subject, data, nats.MsgId(msgID), nats.Context(ctx))
+//	Subscribe: nc.SubscribeSync(subject), whose Next is sub.NextMsgWithContext(ctx), returning nil for
+//	           context.DeadlineExceeded; or, under JetStream, js.PullSubscribe(subject, durable, nats.AckExplicit())
+//	           with a durable consumer name unique to the subject, whose Next is sub.Fetch(1, nats.Context(ctx))
+type NATSClient interface {
+	// Publish publishes data to subject, returning once the server has received it, or, under JetStream, once the
+	// stream has stored it. msgID is the same each time the same message is retried, so that JetStream can discard
+	// duplicates; core NATS ignores it.
+	Publish(ctx context.Context, subject, msgID string, data []byte) error
+	// Subscribe subscribes to subject.
+	Subscribe(subject string) (NATSSubscription, error)
+}
+
+type natsConfig struct {
+	timeout     time.Duration
+	readTimeout time.Duration
+}
+
+// NATSMailboxesOption configures the mailboxes made by NATSMailboxesMaker.
+type NATSMailboxesOption func(cfg *natsConfig)
+
+// WithNATSTimeout sets the timeout of each request made through the NATSClient.
+func WithNATSTimeout(timeout time.Duration) NATSMailboxesOption {
+	return func(cfg *natsConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithNATSReadTimeout sets how long a read from an empty local mailbox waits for a message, before aborting the
+// critical section.
+func WithNATSReadTimeout(timeout time.Duration) NATSMailboxesOption {
+	return func(cfg *natsConfig) {
+		cfg.readTimeout = timeout
+	}
+}
+
+// NATSMailboxesMappingFn maps a mailbox index to the NATS subject carrying its messages, and whether it is to be
+// received from by this node, as TCPMailboxesLocal, or only sent to, as TCPMailboxesRemote.
+type NATSMailboxesMappingFn func(index tla.TLAValue) (TCPMailboxKind, string)
+
+// NATSMailboxesMaker produces mailboxes, like TCPMailboxesMaker, whose messages are exchanged through NATS, accessed
+// through client, on the subjects given by mappingFn, so that nodes only need to reach the server rather than each
+// other. Values are gob-encoded, one per message. The client is shared by all the mailboxes, and is not closed
+// along with them.
+//
+// Values written to a remote mailbox are published when the critical section commits, and Commit retries until the
+// server has received them. Values received by a local mailbox are buffered, and returned to the buffer if the
+// critical section reading them aborts; it acknowledges them as the critical section commits, and marks those it
+// still holds as in progress when one aborts. With core NATS, messages are only delivered to mailboxes that are
+// listening at the time; a client using JetStream makes delivery durable and at-least-once.
+func NATSMailboxesMaker(client NATSClient, mappingFn NATSMailboxesMappingFn, opts ...NATSMailboxesOption) distsys.ArchetypeResourceMaker {
+	cfg := &natsConfig{
+		timeout:     natsTimeout,
+		readTimeout: inputChannelReadTimout,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		kind, subject := mappingFn(index)
+		switch kind {
+		case TCPMailboxesLocal:
+			return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+				return &natsMailboxLocal{
+					client:  client,
+					subject: subject,
+					config:  cfg,
+				}
+			})
+		case TCPMailboxesRemote:
+			return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+				return &natsMailboxRemote{
+					client:  client,
+					subject: subject,
+					config:  cfg,
+				}
+			})
+		default:
+			panic(fmt.Errorf("invalid NATS mailbox type %d for subject %s: expected local or remote, which are %d or %d", kind, subject, TCPMailboxesLocal, TCPMailboxesRemote))
+		}
+	})
+}
+
+// withNATSTimeout calls fn with a context that times out as configured by WithNATSTimeout.
+func withNATSTimeout(cfg *natsConfig, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.timeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+// natsReceived is a value read from a local mailbox, along with the message carrying it, to acknowledge.
+type natsReceived struct {
+	value tla.TLAValue
+	msg   NATSMsg
+}
+
+type natsMailboxLocal struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	client  NATSClient
+	subject string
+	config  *natsConfig
+
+	sub NATSSubscription
+
+	// backlog holds values received but not yet consumed, the first readCount of which were read by the current
+	// critical section
+	backlog   []natsReceived
+	readCount int
+}
+
+var _ distsys.ArchetypeResource = &natsMailboxLocal{}
+
+func (res *natsMailboxLocal) Abort() chan struct{} {
+	// keep JetStream from redelivering what we still hold
+	for _, received := range res.backlog {
+		err := withNATSTimeout(res.config, received.msg.InProgress)
+		if err != nil {
+			res.log(distsys.LogWarn, "could not extend the ack wait of NATS messages", "subject", res.subject, "error", err)
+			break
+		}
+	}
+	res.readCount = 0
+	return nil
+}
+
+func (res *natsMailboxLocal) PreCommit() chan error {
+	return nil
+}
+
+func (res *natsMailboxLocal) Commit() chan struct{} {
+	if res.readCount == 0 {
+		return nil
+	}
+	doneCh := make(chan struct{})
+	go func() {
+		for _, received := range res.backlog[:res.readCount] {
+			err := withNATSTimeout(res.config, received.msg.Ack)
+			if err != nil {
+				// the messages will be redelivered once their ack wait expires
+				res.log(distsys.LogWarn, "could not acknowledge NATS messages", "subject", res.subject, "error", err)
+				break
+			}
+		}
+		res.backlog = res.backlog[res.readCount:]
+		res.readCount = 0
+		doneCh <- struct{}{}
+	}()
+	return doneCh
+}
+
+// receive adds one message to the backlog, waiting up to the read timeout for it.
+func (res *natsMailboxLocal) receive() (bool, error) {
+	if res.sub == nil {
+		sub, err := res.client.Subscribe(res.subject)
+		if err != nil {
+			return false, err
+		}
+		res.sub = sub
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), res.config.readTimeout)
+	msg, err := res.sub.Next(ctx)
+	cancel()
+	if err != nil {
+		// subscribe again on the next read, in case the subscription is broken
+		_ = res.sub.Unsubscribe()
+		res.sub = nil
+		return false, err
+	}
+	if msg == nil {
+		return false, nil
+	}
+	var value tla.TLAValue
+	err = gob.NewDecoder(bytes.NewReader(msg.Data())).Decode(&value)
+	if err != nil {
+		return false, fmt.Errorf("could not decode message on %s: %w", res.subject, err)
+	}
+	res.backlog = append(res.backlog, natsReceived{value: value, msg: msg})
+	return true, nil
+}
+
+func (res *natsMailboxLocal) ReadValue() (tla.TLAValue, error) {
+	if res.readCount == len(res.backlog) {
+		ok, err := res.receive()
+		if err != nil {
+			res.log(distsys.LogWarn, "could not receive from NATS, aborting", "subject", res.subject, "error", err)
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+		if !ok {
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+	}
+	value := res.backlog[res.readCount].value
+	res.readCount++
+	return value, nil
+}
+
+func (res *natsMailboxLocal) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write value %v to a local NATS mailbox", value))
+}
+
+func (res *natsMailboxLocal) Close() error {
+	if res.sub != nil {
+		return res.sub.Unsubscribe()
+	}
+	return nil
+}
+
+// natsOutgoing is a value waiting to be published by a remote mailbox, with the message ID used to deduplicate it
+// under JetStream.
+type natsOutgoing struct {
+	data  []byte
+	msgID string
+}
+
+type natsMailboxRemote struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	client  NATSClient
+	subject string
+	config  *natsConfig
+
+	buffer []natsOutgoing
+}
+
+var _ distsys.ArchetypeResource = &natsMailboxRemote{}
+
+func (res *natsMailboxRemote) Abort() chan struct{} {
+	res.buffer = nil
+	return nil
+}
+
+func (res *natsMailboxRemote) PreCommit() chan error {
+	return nil
+}
+
+func (res *natsMailboxRemote) Commit() chan struct{} {
+	if len(res.buffer) == 0 {
+		return nil
+	}
+	doneCh := make(chan struct{})
+	go func() {
+		for _, outgoing := range res.buffer {
+			for {
+				err := withNATSTimeout(res.config, func(ctx context.Context) error {
+					return res.client.Publish(ctx, res.subject, outgoing.msgID, outgoing.data)
+				})
+				if err == nil {
+					break
+				}
+				res.log(distsys.LogWarn, "could not publish to NATS, retrying", "subject", res.subject, "error", err)
+				time.Sleep(natsRetryBackoff)
+			}
+		}
+		res.buffer = nil
+		doneCh <- struct{}{}
+	}()
+	return doneCh
+}
+
+func (res *natsMailboxRemote) ReadValue() (tla.TLAValue, error) {
+	panic(fmt.Errorf("attempted to read from a remote NATS mailbox"))
+}
+
+func (res *natsMailboxRemote) WriteValue(value tla.TLAValue) error {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(&value)
+	if err != nil {
+		return err
+	}
+	res.buffer = append(res.buffer, natsOutgoing{
+		data:  buf.Bytes(),
+		msgID: fmt.Sprintf("%016x%016x", rand.Uint64(), rand.Uint64()),
+	})
+	return nil
+}
+
+func (res *natsMailboxRemote) Close() error {
+	return nil
+}
@@ -0,0 +1,319 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const natsTestSubject = "mailbox.0"
+
+var errNATSTestUnavailable = errors.New("server unavailable")
+
+// memoryNATSClient is a NATSClient for one subject, held in memory. Under core NATS, a message is delivered to the
+// subscriptions at the time it is published. Under JetStream, messages are stored in a stream, deduplicated by
+// message ID, and delivered to a single durable consumer, which redelivers the unacknowledged messages of a
+// subscription once it ends.
+type memoryNATSClient struct {
+	jetStream bool
+
+	lock     sync.Mutex
+	subs     map[*memoryNATSSubscription]bool
+	failNext map[string]bool
+	// stream holds the messages stored under JetStream, from sequence number 1
+	stream [][]byte
+	msgIDs map[string]bool
+	acked  map[int]bool
+	held   map[int]*memoryNATSSubscription // the subscription each unacknowledged, delivered message is held by
+	// acks holds every ack received under JetStream, as "<seq><kind>"
+	acks []string
+	// published is closed and replaced whenever a message is published, to wake up subscriptions waiting for one
+	published chan struct{}
+}
+
+var _ NATSClient = &memoryNATSClient{}
+
+func newMemoryNATSClient(jetStream bool) *memoryNATSClient {
+	return &memoryNATSClient{
+		jetStream: jetStream,
+		subs:      make(map[*memoryNATSSubscription]bool),
+		failNext:  make(map[string]bool),
+		msgIDs:    make(map[string]bool),
+		acked:     make(map[int]bool),
+		held:      make(map[int]*memoryNATSSubscription),
+		published: make(chan struct{}),
+	}
+}
+
+// failNextRequest fails the next call to method. "PublishLostAck" stores the next message published, but reports
+// an error as if the stream's ack was lost.
+func (client *memoryNATSClient) failNextRequest(method string) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	client.failNext[method] = true
+}
+
+func (client *memoryNATSClient) failing(method string) bool {
+	if client.failNext[method] {
+		delete(client.failNext, method)
+		return true
+	}
+	return false
+}
+
+func (client *memoryNATSClient) Publish(ctx context.Context, subject, msgID string, data []byte) error {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if subject != natsTestSubject {
+		return fmt.Errorf("unexpected subject %s", subject)
+	}
+	if client.failing("Publish") {
+		return errNATSTestUnavailable
+	}
+	if client.jetStream {
+		if !client.msgIDs[msgID] {
+			client.msgIDs[msgID] = true
+			client.stream = append(client.stream, data)
+		}
+	} else {
+		for sub := range client.subs {
+			sub.queue = append(sub.queue, data)
+		}
+	}
+	close(client.published)
+	client.published = make(chan struct{})
+	if client.failing("PublishLostAck") {
+		return errNATSTestUnavailable
+	}
+	return nil
+}
+
+func (client *memoryNATSClient) Subscribe(subject string) (NATSSubscription, error) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if subject != natsTestSubject {
+		return nil, fmt.Errorf("unexpected subject %s", subject)
+	}
+	if client.failing("Subscribe") {
+		return nil, errNATSTestUnavailable
+	}
+	sub := &memoryNATSSubscription{client: client}
+	client.subs[sub] = true
+	return sub, nil
+}
+
+func (client *memoryNATSClient) storedCount() int {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	return len(client.stream)
+}
+
+// expectAcks takes the acks received so far, expecting them to be the given ones, as "<seq><kind>".
+func (client *memoryNATSClient) expectAcks(t *testing.T, expected ...string) {
+	t.Helper()
+	client.lock.Lock()
+	acks := client.acks
+	client.acks = nil
+	client.lock.Unlock()
+	if strings.Join(acks, " ") != strings.Join(expected, " ") {
+		t.Fatalf("expected acks %v, got %v", expected, acks)
+	}
+}
+
+type memoryNATSSubscription struct {
+	client *memoryNATSClient
+	queue  [][]byte // under core NATS, the messages delivered but not yet taken by Next
+}
+
+// take returns the next message for the subscription, or nil if there is none. The client's lock must be held.
+func (sub *memoryNATSSubscription) take() NATSMsg {
+	client := sub.client
+	if !client.jetStream {
+		if len(sub.queue) == 0 {
+			return nil
+		}
+		data := sub.queue[0]
+		sub.queue = sub.queue[1:]
+		return &memoryNATSMsg{data: data}
+	}
+	for seq := 1; seq <= len(client.stream); seq++ {
+		if _, isHeld := client.held[seq]; !isHeld && !client.acked[seq] {
+			client.held[seq] = sub
+			return &memoryNATSMsg{client: client, seq: seq, data: client.stream[seq-1]}
+		}
+	}
+	return nil
+}
+
+func (sub *memoryNATSSubscription) Next(ctx context.Context) (NATSMsg, error) {
+	client := sub.client
+	for {
+		client.lock.Lock()
+		if !client.subs[sub] {
+			client.lock.Unlock()
+			return nil, fmt.Errorf("subscription ended")
+		}
+		if client.failing("Next") {
+			client.lock.Unlock()
+			return nil, errNATSTestUnavailable
+		}
+		msg := sub.take()
+		published := client.published
+		client.lock.Unlock()
+		if msg != nil {
+			return msg, nil
+		}
+		select {
+		case <-published:
+		case <-ctx.Done():
+			return nil, nil
+		}
+	}
+}
+
+func (sub *memoryNATSSubscription) Unsubscribe() error {
+	client := sub.client
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	delete(client.subs, sub)
+	for seq, holder := range client.held {
+		if holder == sub {
+			delete(client.held, seq)
+		}
+	}
+	return nil
+}
+
+type memoryNATSMsg struct {
+	client *memoryNATSClient // nil under core NATS
+	seq    int
+	data   []byte
+}
+
+func (msg *memoryNATSMsg) Data() []byte {
+	return msg.data
+}
+
+func (msg *memoryNATSMsg) ack(kind string) error {
+	if msg.client == nil {
+		return nil
+	}
+	msg.client.lock.Lock()
+	defer msg.client.lock.Unlock()
+	if msg.client.failing(kind) {
+		return errNATSTestUnavailable
+	}
+	msg.client.acks = append(msg.client.acks, fmt.Sprintf("%d%s", msg.seq, kind))
+	if kind == "+ACK" {
+		msg.client.acked[msg.seq] = true
+		delete(msg.client.held, msg.seq)
+	}
+	return nil
+}
+
+func (msg *memoryNATSMsg) Ack(ctx context.Context) error {
+	return msg.ack("+ACK")
+}
+
+func (msg *memoryNATSMsg) InProgress(ctx context.Context) error {
+	return msg.ack("+WPI")
+}
+
+const (
+	natsTestLocal  = 0
+	natsTestRemote = 1
+)
+
+func makeNATSTestMailboxes(t *testing.T, client *memoryNATSClient) distsys.ArchetypeResource {
+	t.Helper()
+	maker := NATSMailboxesMaker(client, func(index tla.TLAValue) (TCPMailboxKind, string) {
+		if index.Equal(tla.MakeTLANumber(natsTestLocal)) {
+			return TCPMailboxesLocal, natsTestSubject
+		}
+		return TCPMailboxesRemote, natsTestSubject
+	}, WithNATSTimeout(time.Second), WithNATSReadTimeout(50*time.Millisecond))
+	res := maker.Make()
+	maker.Configure(res)
+	t.Cleanup(func() {
+		_ = res.Close()
+	})
+	return res
+}
+
+func TestNATSMailboxes(t *testing.T) {
+	client := newMemoryNATSClient(false)
+	sender := makeNATSTestMailboxes(t, client)
+	receiver := makeNATSTestMailboxes(t, client)
+	// subscribe before anything is sent, as core NATS only delivers to current subscribers
+	expectMailboxesTestEmpty(t, receiver, natsTestLocal)
+	mailboxesTestAbort(receiver)
+
+	// an aborted send publishes nothing
+	if err := mailboxesTestIndex(t, sender, natsTestRemote).WriteValue(tla.MakeTLANumber(0)); err != nil {
+		t.Fatal(err)
+	}
+	mailboxesTestAbort(sender)
+	// and Commit retries publishing until it succeeds
+	client.failNextRequest("Publish")
+	mailboxesTestSend(t, sender, natsTestRemote, 1, 2)
+
+	// values read by an aborted critical section are read again
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, natsTestLocal, 2), 1, 2)
+	mailboxesTestAbort(receiver)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, natsTestLocal, 1), 1)
+	mailboxesTestCommit(t, receiver)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, natsTestLocal, 1), 2)
+	mailboxesTestCommit(t, receiver)
+	expectMailboxesTestEmpty(t, receiver, natsTestLocal)
+	mailboxesTestAbort(receiver)
+
+	// a broken subscription aborts the read, and is replaced by the next one
+	client.failNextRequest("Next")
+	expectMailboxesTestEmpty(t, receiver, natsTestLocal)
+	mailboxesTestAbort(receiver)
+	mailboxesTestSend(t, sender, natsTestRemote, 3)
+	expectMailboxesTestEmpty(t, receiver, natsTestLocal) // sent before the new subscription, so lost
+	mailboxesTestAbort(receiver)
+	mailboxesTestSend(t, sender, natsTestRemote, 4)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, natsTestLocal, 1), 4)
+}
+
+func TestNATSJetStreamMailboxes(t *testing.T) {
+	client := newMemoryNATSClient(true)
+	sender := makeNATSTestMailboxes(t, client)
+	// a message whose ack was lost is retried with the same ID, so the stream stores it once
+	client.failNextRequest("PublishLostAck")
+	mailboxesTestSend(t, sender, natsTestRemote, 1, 2, 3)
+	if stored := client.storedCount(); stored != 3 {
+		t.Fatalf("expected the stream to store 3 messages, got %d", stored)
+	}
+
+	receiver := makeNATSTestMailboxes(t, client)
+	// an abort marks the messages read as in progress, keeping them from being redelivered, and they are read again
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, natsTestLocal, 1), 1)
+	mailboxesTestAbort(receiver)
+	client.expectAcks(t, "1+WPI")
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, natsTestLocal, 2), 1, 2)
+	// the commit acknowledges what was read
+	mailboxesTestCommit(t, receiver)
+	client.expectAcks(t, "1+ACK", "2+ACK")
+
+	// the receiver crashes after reading message 3, before committing
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, natsTestLocal, 1), 3)
+	if err := receiver.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// so its replacement gets message 3, and only message 3, again
+	replacement := makeNATSTestMailboxes(t, client)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, replacement, natsTestLocal, 1), 3)
+	mailboxesTestCommit(t, replacement)
+	client.expectAcks(t, "3+ACK")
+	expectMailboxesTestEmpty(t, replacement, natsTestLocal)
+}
@@ -0,0 +1,265 @@
+package resources
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+// NetworkFault describes how messages sent from one node to another are mistreated. The zero value delivers
+// messages normally.
+type NetworkFault struct {
+	DropProbability      float64             // chance that a message is silently discarded
+	DuplicateProbability float64             // chance that a message is delivered twice
+	Delay                LatencyDistribution // if set, holds up the sending critical section before each message
+	Reorder              bool                // if set, each message is held back and delivered after the next one
+}
+
+// NetworkFaults is a shared, runtime-adjustable description of the faults affecting each ordered pair of nodes.
+// It is meant to be shared between all the NetworkFaultsMaker-wrapped mailboxes in a test, so that partitions can
+// be introduced and healed while the system runs.
+type NetworkFaults struct {
+	lock   sync.RWMutex
+	faults *immutable.Map // <from, to> tuple -> NetworkFault
+	rng    *rand.Rand
+}
+
+// NewNetworkFaults creates a NetworkFaults with no faults. Probabilistic faults draw from a source seeded with seed,
+// so that test runs can be reproduced.
+func NewNetworkFaults(seed int64) *NetworkFaults {
+	return &NetworkFaults{
+		faults: immutable.NewMap(tla.TLAValueHasher{}),
+		rng:    rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Set makes messages sent from one node to another suffer fault. It replaces any fault previously set for that
+// direction; the opposite direction is unaffected.
+func (faults *NetworkFaults) Set(from, to tla.TLAValue, fault NetworkFault) {
+	faults.lock.Lock()
+	defer faults.lock.Unlock()
+	faults.faults = faults.faults.Set(tla.MakeTLATuple(from, to), fault)
+}
+
+// Clear restores normal delivery of messages sent from one node to another.
+func (faults *NetworkFaults) Clear(from, to tla.TLAValue) {
+	faults.lock.Lock()
+	defer faults.lock.Unlock()
+	faults.faults = faults.faults.Delete(tla.MakeTLATuple(from, to))
+}
+
+// Partition drops all messages between nodes in groupA and nodes in groupB, in both directions. Messages within
+// each group are unaffected.
+func (faults *NetworkFaults) Partition(groupA, groupB []tla.TLAValue) {
+	for _, a := range groupA {
+		for _, b := range groupB {
+			faults.Set(a, b, NetworkFault{DropProbability: 1})
+			faults.Set(b, a, NetworkFault{DropProbability: 1})
+		}
+	}
+}
+
+// Heal clears all faults, including partitions.
+func (faults *NetworkFaults) Heal() {
+	faults.lock.Lock()
+	defer faults.lock.Unlock()
+	faults.faults = immutable.NewMap(tla.TLAValueHasher{})
+}
+
+func (faults *NetworkFaults) get(from, to tla.TLAValue) NetworkFault {
+	faults.lock.RLock()
+	defer faults.lock.RUnlock()
+	if fault, ok := faults.faults.Get(tla.MakeTLATuple(from, to)); ok {
+		return fault.(NetworkFault)
+	}
+	return NetworkFault{}
+}
+
+func (faults *NetworkFaults) roll(probability float64) bool {
+	if probability <= 0 {
+		return false
+	}
+	faults.lock.Lock()
+	defer faults.lock.Unlock()
+	return faults.rng.Float64() < probability
+}
+
+// NetworkFaultsMaker wraps a map of mailboxes, such as one produced by TCPMailboxesMaker or LocalMailboxesMaker,
+// so that messages written from self to each index suffer the faults currently described by faults. Reads are
+// passed through unchanged.
+//
+// This is intended for tests, so that failure scenarios can include network partitions, and not only crashes.
+func NetworkFaultsMaker(self tla.TLAValue, faults *NetworkFaults, maker distsys.ArchetypeResourceMaker) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &faultyMailboxes{
+				self:     self,
+				faults:   faults,
+				inner:    maker.Make(),
+				children: immutable.NewMap(tla.TLAValueHasher{}),
+				dirty:    immutable.NewMap(tla.TLAValueHasher{}),
+			}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*faultyMailboxes)
+			maker.Configure(r.inner)
+		},
+	}
+}
+
+type faultyMailboxes struct {
+	distsys.ArchetypeResourceMapMixin
+	self   tla.TLAValue
+	faults *NetworkFaults
+	inner  distsys.ArchetypeResource
+
+	children *immutable.Map // index -> *faultyMailbox
+	dirty    *immutable.Map // children indexed during the current critical section
+}
+
+var _ distsys.ArchetypeResource = &faultyMailboxes{}
+
+func (res *faultyMailboxes) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	innerChild, err := res.inner.Index(index)
+	if err != nil {
+		return nil, err
+	}
+	var child *faultyMailbox
+	if existing, ok := res.children.Get(index); ok {
+		child = existing.(*faultyMailbox)
+		child.inner = innerChild
+	} else {
+		child = &faultyMailbox{
+			from:   res.self,
+			to:     index,
+			faults: res.faults,
+			inner:  innerChild,
+		}
+		res.children = res.children.Set(index, child)
+	}
+	res.dirty = res.dirty.Set(index, child)
+	return child, nil
+}
+
+func (res *faultyMailboxes) forEachDirty(fn func(child *faultyMailbox)) {
+	it := res.dirty.Iterator()
+	for !it.Done() {
+		_, child := it.Next()
+		fn(child.(*faultyMailbox))
+	}
+	res.dirty = immutable.NewMap(tla.TLAValueHasher{})
+}
+
+func (res *faultyMailboxes) Abort() chan struct{} {
+	res.forEachDirty((*faultyMailbox).abort)
+	return res.inner.Abort()
+}
+
+func (res *faultyMailboxes) PreCommit() chan error {
+	return res.inner.PreCommit()
+}
+
+func (res *faultyMailboxes) Commit() chan struct{} {
+	res.forEachDirty((*faultyMailbox).commit)
+	return res.inner.Commit()
+}
+
+func (res *faultyMailboxes) Close() error {
+	return res.inner.Close()
+}
+
+// faultyMailbox applies faults to the messages written to a single mailbox. Its transactional operations are driven
+// by the enclosing faultyMailboxes.
+type faultyMailbox struct {
+	from, to tla.TLAValue
+	faults   *NetworkFaults
+	inner    distsys.ArchetypeResource
+
+	held *tla.TLAValue // a message held back for reordering
+	// state of held as of the start of the current critical section, to be restored on abort
+	hasOldHeld bool
+	oldHeld    *tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &faultyMailbox{}
+
+func (res *faultyMailbox) abort() {
+	if res.hasOldHeld {
+		res.held = res.oldHeld
+		res.hasOldHeld = false
+		res.oldHeld = nil
+	}
+}
+
+func (res *faultyMailbox) commit() {
+	res.hasOldHeld = false
+	res.oldHeld = nil
+}
+
+func (res *faultyMailbox) Abort() chan struct{} {
+	return res.inner.Abort()
+}
+
+func (res *faultyMailbox) PreCommit() chan error {
+	return res.inner.PreCommit()
+}
+
+func (res *faultyMailbox) Commit() chan struct{} {
+	return res.inner.Commit()
+}
+
+func (res *faultyMailbox) ReadValue() (tla.TLAValue, error) {
+	return res.inner.ReadValue()
+}
+
+func (res *faultyMailbox) deliver(value tla.TLAValue, fault NetworkFault) error {
+	if res.faults.roll(fault.DropProbability) {
+		return nil
+	}
+	err := res.inner.WriteValue(value)
+	if err != nil {
+		return err
+	}
+	if res.faults.roll(fault.DuplicateProbability) {
+		return res.inner.WriteValue(value)
+	}
+	return nil
+}
+
+func (res *faultyMailbox) WriteValue(value tla.TLAValue) error {
+	fault := res.faults.get(res.from, res.to)
+	if fault.Delay != nil {
+		time.Sleep(fault.Delay())
+	}
+	if !res.hasOldHeld {
+		res.oldHeld = res.held
+		res.hasOldHeld = true
+	}
+
+	if fault.Reorder && res.held == nil {
+		res.held = &value
+		return nil
+	}
+	err := res.deliver(value, fault)
+	if err != nil {
+		return err
+	}
+	if res.held != nil {
+		held := *res.held
+		res.held = nil
+		return res.deliver(held, fault)
+	}
+	return nil
+}
+
+func (res *faultyMailbox) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	return res.inner.Index(index)
+}
+
+func (res *faultyMailbox) Close() error {
+	return res.inner.Close()
+}
@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// makeNetworkFaultsTest makes the mailboxes of node self, sending through faults via mailboxes.
+func makeNetworkFaultsTest(self int32, faults *NetworkFaults, mailboxes *LocalMailboxes) distsys.ArchetypeResource {
+	maker := NetworkFaultsMaker(tla.MakeTLANumber(self), faults, LocalMailboxesMaker(mailboxes))
+	res := maker.Make()
+	maker.Configure(res)
+	return res
+}
+
+func TestNetworkFaultsPartition(t *testing.T) {
+	faults := NewNetworkFaults(1)
+	mailboxes := NewLocalMailboxes()
+	nodes := []distsys.ArchetypeResource{nil}
+	for self := int32(1); self <= 3; self++ {
+		nodes = append(nodes, makeNetworkFaultsTest(self, faults, mailboxes))
+	}
+
+	// messages across the partition are dropped in both directions, while those within each side go through
+	faults.Partition([]tla.TLAValue{tla.MakeTLANumber(1)}, []tla.TLAValue{tla.MakeTLANumber(2), tla.MakeTLANumber(3)})
+	mailboxesTestSend(t, nodes[1], 2, 1)
+	mailboxesTestSend(t, nodes[2], 1, 2)
+	mailboxesTestSend(t, nodes[3], 2, 3)
+	expectMailboxesTestEmpty(t, nodes[1], 1)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, nodes[2], 2, 1), 3)
+	mailboxesTestCommit(t, nodes[2])
+	expectMailboxesTestEmpty(t, nodes[2], 2)
+
+	// faults apply to one direction only
+	faults.Heal()
+	faults.Set(tla.MakeTLANumber(1), tla.MakeTLANumber(2), NetworkFault{DropProbability: 1})
+	mailboxesTestSend(t, nodes[1], 2, 4)
+	mailboxesTestSend(t, nodes[2], 1, 5)
+	expectMailboxesTestEmpty(t, nodes[2], 2)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, nodes[1], 1, 1), 5)
+	mailboxesTestCommit(t, nodes[1])
+
+	faults.Clear(tla.MakeTLANumber(1), tla.MakeTLANumber(2))
+	mailboxesTestSend(t, nodes[1], 2, 6)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, nodes[2], 2, 1), 6)
+	mailboxesTestCommit(t, nodes[2])
+}
+
+func TestNetworkFaultsDuplicateAndDelay(t *testing.T) {
+	faults := NewNetworkFaults(1)
+	mailboxes := NewLocalMailboxes()
+	sender := makeNetworkFaultsTest(1, faults, mailboxes)
+	receiver := makeNetworkFaultsTest(2, faults, mailboxes)
+
+	const delay = 50 * time.Millisecond
+	faults.Set(tla.MakeTLANumber(1), tla.MakeTLANumber(2), NetworkFault{
+		DuplicateProbability: 1,
+		Delay:                ConstantLatency(delay),
+	})
+	start := time.Now()
+	mailboxesTestSend(t, sender, 2, 1, 2)
+	if elapsed := time.Since(start); elapsed < 2*delay {
+		t.Fatalf("expected each write to be delayed by %v, but both took %v", delay, elapsed)
+	}
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 2, 4), 1, 1, 2, 2)
+	mailboxesTestCommit(t, receiver)
+	expectMailboxesTestEmpty(t, receiver, 2)
+}
+
+func TestNetworkFaultsReorder(t *testing.T) {
+	faults := NewNetworkFaults(1)
+	mailboxes := NewLocalMailboxes()
+	sender := makeNetworkFaultsTest(1, faults, mailboxes)
+	receiver := makeNetworkFaultsTest(2, faults, mailboxes)
+	faults.Set(tla.MakeTLANumber(1), tla.MakeTLANumber(2), NetworkFault{Reorder: true})
+
+	// a message is held back until the next one is sent, even across critical sections
+	mailboxesTestSend(t, sender, 2, 1)
+	expectMailboxesTestEmpty(t, receiver, 2)
+	mailboxesTestSend(t, sender, 2, 2)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 2, 2), 2, 1)
+	mailboxesTestCommit(t, receiver)
+
+	// a critical section that aborts after releasing the held message leaves it held
+	mailboxesTestSend(t, sender, 2, 3)
+	if err := mailboxesTestIndex(t, sender, 2).WriteValue(tla.MakeTLANumber(4)); err != nil {
+		t.Fatal(err)
+	}
+	mailboxesTestAbort(sender)
+	faults.Clear(tla.MakeTLANumber(1), tla.MakeTLANumber(2))
+	mailboxesTestSend(t, sender, 2, 5)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 2, 2), 5, 3)
+	mailboxesTestCommit(t, receiver)
+	expectMailboxesTestEmpty(t, receiver, 2)
+}
+
+func TestNetworkFaultsReproducible(t *testing.T) {
+	// send sends values 0 to 19 through a network that drops half the messages, returning those received
+	send := func(seed int64) []tla.TLAValue {
+		faults := NewNetworkFaults(seed)
+		mailboxes := NewLocalMailboxes()
+		sender := makeNetworkFaultsTest(1, faults, mailboxes)
+		receiver := makeNetworkFaultsTest(2, faults, mailboxes)
+		faults.Set(tla.MakeTLANumber(1), tla.MakeTLANumber(2), NetworkFault{DropProbability: 0.5})
+		for value := int32(0); value < 20; value++ {
+			mailboxesTestSend(t, sender, 2, value)
+		}
+		var received []tla.TLAValue
+		for {
+			value, err := mailboxesTestIndex(t, receiver, 2).ReadValue()
+			if err != nil {
+				return received
+			}
+			received = append(received, value)
+		}
+	}
+	received := send(1)
+	if len(received) == 0 || len(received) == 20 {
+		t.Fatalf("expected some messages to be dropped, received %v", received)
+	}
+	again := send(1)
+	if len(again) != len(received) {
+		t.Fatalf("expected the same seed to drop the same messages, received %v, then %v", received, again)
+	}
+	for i := range received {
+		if !received[i].Equal(again[i]) {
+			t.Fatalf("expected the same seed to drop the same messages, received %v, then %v", received, again)
+		}
+	}
+}
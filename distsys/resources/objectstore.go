@@ -0,0 +1,260 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+const objectStoreTimeout = 5 * time.Second
+
+var (
+	// ErrObjectNotFound is returned by an ObjectStore when an object does not exist.
+	ErrObjectNotFound = errors.New("object not found")
+	// ErrObjectPreconditionFailed is returned by an ObjectStore when a conditional put's condition does not hold.
+	ErrObjectPreconditionFailed = errors.New("object precondition failed")
+	// ErrObjectIndexNotString is returned when an object store resource is indexed by a value that is not a string.
+	ErrObjectIndexNotString = errors.New("object store resource must be indexed by strings")
+	// ErrObjectValueNotString is returned when a value written to an object is not a string.
+	ErrObjectValueNotString = errors.New("objects can only hold strings")
+)
+
+// ObjectCondition makes a put conditional. The zero value puts unconditionally.
+type ObjectCondition struct {
+	// IfMatch, if set, requires the object to exist with this ETag
+	IfMatch string
+	// IfNoneMatch, if set, requires the object not to exist
+	IfNoneMatch bool
+}
+
+// ObjectStore is the subset of an S3-compatible object store used by ObjectStoreMaker. This package does not speak
+// S3's HTTP API; for the AWS SDK for Go v2, a small adapter over an *s3.Client and a bucket is enough:
+//
+//	GetObject:  client.GetObject, returning ErrObjectNotFound for a *types.NoSuchKey error
+//	HeadObject: client.HeadObject, returning ErrObjectNotFound for a *types.NotFound error
+//	PutObject:  client.PutObject, with IfMatch and IfNoneMatch ("*") set from cond, returning
+//	            ErrObjectPreconditionFailed for the PreconditionFailed and ConditionalRequestConflict error codes
+type ObjectStore interface {
+	// GetObject returns the content and ETag of the object at key, or ErrObjectNotFound.
+	GetObject(ctx context.Context, key string) (data []byte, etag string, err error)
+	// HeadObject returns the ETag of the object at key, or ErrObjectNotFound.
+	HeadObject(ctx context.Context, key string) (etag string, err error)
+	// PutObject writes data to the object at key if cond holds, returning the new ETag, or
+	// ErrObjectPreconditionFailed.
+	PutObject(ctx context.Context, key string, data []byte, cond ObjectCondition) (etag string, err error)
+}
+
+type objectStoreConfig struct {
+	timeout time.Duration
+}
+
+// ObjectStoreOption configures a resource made by ObjectStoreMaker.
+type ObjectStoreOption func(cfg *objectStoreConfig)
+
+// WithObjectStoreTimeout sets the timeout of each request to the object store.
+func WithObjectStoreTimeout(timeout time.Duration) ObjectStoreOption {
+	return func(cfg *objectStoreConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// ObjectStoreMaker produces a distsys.ArchetypeResourceMaker for a map whose indices are object keys in store,
+// prefixed by prefix. Indices must be strings. Each object's content reads as a string, and missing objects read as
+// initial. Only strings can be written.
+//
+// Read-modify-write is protected by ETags: reads remember each object's ETag, and pre-commit puts each written
+// object on the condition that its ETag did not change, or that it still does not exist, aborting the critical
+// section otherwise. Objects only read are checked the same way. Blind writes are unconditional. As object stores
+// do not offer multi-object transactions, protection is per object: if a critical section writes several objects
+// and one put fails, or a pre-commit of another resource fails after this one's, the puts already made stay.
+func ObjectStoreMaker(store ObjectStore, prefix string, initial tla.TLAValue, opts ...ObjectStoreOption) distsys.ArchetypeResourceMaker {
+	cfg := objectStoreConfig{
+		timeout: objectStoreTimeout,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &objectStoreResource{
+				entries: immutable.NewMap(tla.TLAValueHasher{}),
+			}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*objectStoreResource)
+			r.store = store
+			r.prefix = prefix
+			r.initial = initial
+			r.config = cfg
+		},
+	}
+}
+
+type objectStoreResource struct {
+	distsys.ArchetypeResourceMapMixin
+	resourceLogger
+	store   ObjectStore
+	prefix  string
+	initial tla.TLAValue
+	config  objectStoreConfig
+
+	// entries holds an *objectEntry for each index touched by the current critical section
+	entries *immutable.Map
+}
+
+type objectEntry struct {
+	key          string
+	hasRead      bool
+	etag         string // empty if the object did not exist
+	value        tla.TLAValue
+	writePending bool
+}
+
+var _ distsys.ArchetypeResource = &objectStoreResource{}
+
+func (res *objectStoreResource) entry(index tla.TLAValue) *objectEntry {
+	if e, ok := res.entries.Get(index); ok {
+		return e.(*objectEntry)
+	}
+	e := &objectEntry{key: res.prefix + index.AsString()}
+	res.entries = res.entries.Set(index, e)
+	return e
+}
+
+func (res *objectStoreResource) read(index tla.TLAValue) (tla.TLAValue, error) {
+	e := res.entry(index)
+	if e.hasRead || e.writePending {
+		return e.value, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), res.config.timeout)
+	defer cancel()
+	data, etag, err := res.store.GetObject(ctx, e.key)
+	switch {
+	case errors.Is(err, ErrObjectNotFound):
+		e.etag = ""
+		e.value = res.initial
+	case err != nil:
+		res.log(distsys.LogWarn, "could not get object, aborting", "key", e.key, "error", err)
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	default:
+		e.etag = etag
+		e.value = tla.MakeTLAString(string(data))
+	}
+	e.hasRead = true
+	return e.value, nil
+}
+
+func (res *objectStoreResource) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	if !index.IsString() {
+		return nil, fmt.Errorf("%w: %v", ErrObjectIndexNotString, index)
+	}
+	return &objectKeyResource{parent: res, index: index}, nil
+}
+
+func (res *objectStoreResource) Abort() chan struct{} {
+	res.entries = immutable.NewMap(tla.TLAValueHasher{})
+	return nil
+}
+
+func (res *objectStoreResource) PreCommit() chan error {
+	if res.entries.Len() == 0 {
+		return nil
+	}
+	ch := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), res.config.timeout)
+		defer cancel()
+		it := res.entries.Iterator()
+		for !it.Done() {
+			_, entry := it.Next()
+			err := res.prepareEntry(ctx, entry.(*objectEntry))
+			if err != nil {
+				ch <- err
+				return
+			}
+		}
+		ch <- nil
+	}()
+	return ch
+}
+
+func (res *objectStoreResource) prepareEntry(ctx context.Context, e *objectEntry) error {
+	var err error
+	switch {
+	case e.writePending:
+		cond := ObjectCondition{}
+		if e.hasRead {
+			cond = ObjectCondition{IfMatch: e.etag, IfNoneMatch: e.etag == ""}
+		}
+		_, err = res.store.PutObject(ctx, e.key, []byte(e.value.AsString()), cond)
+	default:
+		var etag string
+		etag, err = res.store.HeadObject(ctx, e.key)
+		if errors.Is(err, ErrObjectNotFound) {
+			etag, err = "", nil
+		}
+		if err == nil && etag != e.etag {
+			err = ErrObjectPreconditionFailed
+		}
+	}
+	if err != nil {
+		if !errors.Is(err, ErrObjectPreconditionFailed) {
+			res.log(distsys.LogWarn, "could not commit object, aborting", "key", e.key, "error", err)
+		}
+		return distsys.ErrCriticalSectionAborted
+	}
+	return nil
+}
+
+func (res *objectStoreResource) Commit() chan struct{} {
+	res.entries = immutable.NewMap(tla.TLAValueHasher{})
+	return nil
+}
+
+func (res *objectStoreResource) Close() error {
+	return nil
+}
+
+// objectKeyResource is the object at one index of an objectStoreResource, which does all the work.
+type objectKeyResource struct {
+	distsys.ArchetypeResourceLeafMixin
+	parent *objectStoreResource
+	index  tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &objectKeyResource{}
+
+func (res *objectKeyResource) Abort() chan struct{} {
+	return nil
+}
+
+func (res *objectKeyResource) PreCommit() chan error {
+	return nil
+}
+
+func (res *objectKeyResource) Commit() chan struct{} {
+	return nil
+}
+
+func (res *objectKeyResource) ReadValue() (tla.TLAValue, error) {
+	return res.parent.read(res.index)
+}
+
+func (res *objectKeyResource) WriteValue(value tla.TLAValue) error {
+	if !value.IsString() {
+		return fmt.Errorf("%w: %v", ErrObjectValueNotString, value)
+	}
+	e := res.parent.entry(res.index)
+	e.value = value
+	e.writePending = true
+	return nil
+}
+
+func (res *objectKeyResource) Close() error {
+	return nil
+}
@@ -0,0 +1,222 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const objectStoreTestPrefix = "models/"
+
+// memoryObjectStore is an ObjectStore held in memory, whose ETags count the puts made to the store.
+type memoryObjectStore struct {
+	lock    sync.Mutex
+	objects map[string][]byte
+	etags   map[string]string
+	puts    int
+	failGet bool
+	// conds holds the condition of every put, by key
+	conds map[string][]ObjectCondition
+}
+
+var _ ObjectStore = &memoryObjectStore{}
+
+func newMemoryObjectStore() *memoryObjectStore {
+	return &memoryObjectStore{
+		objects: make(map[string][]byte),
+		etags:   make(map[string]string),
+		conds:   make(map[string][]ObjectCondition),
+	}
+}
+
+func (store *memoryObjectStore) GetObject(ctx context.Context, key string) ([]byte, string, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	if store.failGet {
+		store.failGet = false
+		return nil, "", errors.New("store unavailable")
+	}
+	data, ok := store.objects[key]
+	if !ok {
+		return nil, "", ErrObjectNotFound
+	}
+	return data, store.etags[key], nil
+}
+
+func (store *memoryObjectStore) HeadObject(ctx context.Context, key string) (string, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	if _, ok := store.objects[key]; !ok {
+		return "", ErrObjectNotFound
+	}
+	return store.etags[key], nil
+}
+
+func (store *memoryObjectStore) PutObject(ctx context.Context, key string, data []byte, cond ObjectCondition) (string, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.conds[key] = append(store.conds[key], cond)
+	etag, exists := store.etags[key]
+	if (cond.IfMatch != "" && cond.IfMatch != etag) || (cond.IfNoneMatch && exists) {
+		return "", ErrObjectPreconditionFailed
+	}
+	store.puts++
+	store.objects[key] = data
+	store.etags[key] = fmt.Sprintf(`"%d"`, store.puts)
+	return store.etags[key], nil
+}
+
+func (store *memoryObjectStore) get(key string) (string, bool) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	data, ok := store.objects[objectStoreTestPrefix+key]
+	return string(data), ok
+}
+
+func makeObjectStoreTestResource(store *memoryObjectStore) distsys.ArchetypeResource {
+	maker := ObjectStoreMaker(store, objectStoreTestPrefix, tla.MakeTLAString("initial"))
+	res := maker.Make()
+	maker.Configure(res)
+	return res
+}
+
+func objectStoreTestIndex(t *testing.T, res distsys.ArchetypeResource, key string) distsys.ArchetypeResource {
+	t.Helper()
+	object, err := res.Index(tla.MakeTLAString(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return object
+}
+
+func objectStoreTestRead(t *testing.T, res distsys.ArchetypeResource, key string) string {
+	t.Helper()
+	value, err := objectStoreTestIndex(t, res, key).ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value.AsString()
+}
+
+func objectStoreTestWrite(t *testing.T, res distsys.ArchetypeResource, key, value string) {
+	t.Helper()
+	if err := objectStoreTestIndex(t, res, key).WriteValue(tla.MakeTLAString(value)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expectObjectStoreTestAbort(t *testing.T, res distsys.ArchetypeResource) {
+	t.Helper()
+	if err := <-res.PreCommit(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected pre-commit to abort, got %v", err)
+	}
+	mailboxesTestAbort(res)
+}
+
+func TestObjectStore(t *testing.T) {
+	store := newMemoryObjectStore()
+	a := makeObjectStoreTestResource(store)
+	b := makeObjectStoreTestResource(store)
+
+	// both read x, which does not exist, and write it based on that, so only the first to commit succeeds
+	if value := objectStoreTestRead(t, a, "x"); value != "initial" {
+		t.Fatalf("expected a missing object to read as initial, read %q", value)
+	}
+	objectStoreTestWrite(t, a, "x", "a")
+	if value := objectStoreTestRead(t, a, "x"); value != "a" {
+		t.Fatalf("expected to read the pending write, read %q", value)
+	}
+	if value := objectStoreTestRead(t, b, "x"); value != "initial" {
+		t.Fatalf("expected a missing object to read as initial, read %q", value)
+	}
+	objectStoreTestWrite(t, b, "x", "b")
+	mailboxesTestCommit(t, a)
+	expectObjectStoreTestAbort(t, b)
+	if value, _ := store.get("x"); value != "a" {
+		t.Fatalf("expected x to hold a's write, got %q", value)
+	}
+
+	// b retries, reading a's write, and its write is now conditional on a's ETag
+	if value := objectStoreTestRead(t, b, "x"); value != "a" {
+		t.Fatalf("expected to read a's write, read %q", value)
+	}
+	objectStoreTestWrite(t, b, "x", "b")
+	mailboxesTestCommit(t, b)
+	if value, _ := store.get("x"); value != "b" {
+		t.Fatalf("expected x to hold b's write, got %q", value)
+	}
+	conds := store.conds[objectStoreTestPrefix+"x"]
+	if len(conds) != 3 || conds[0] != (ObjectCondition{IfNoneMatch: true}) || conds[2] != (ObjectCondition{IfMatch: `"1"`}) {
+		t.Fatalf("expected puts conditional on x not existing, then on a's ETag, got %v", conds)
+	}
+}
+
+func TestObjectStoreReadOnly(t *testing.T) {
+	store := newMemoryObjectStore()
+	a := makeObjectStoreTestResource(store)
+	b := makeObjectStoreTestResource(store)
+	objectStoreTestWrite(t, b, "x", "1")
+	mailboxesTestCommit(t, b)
+
+	// a only reads x, and y, which does not exist, and commits if neither changed
+	objectStoreTestRead(t, a, "x")
+	objectStoreTestRead(t, a, "y")
+	mailboxesTestCommit(t, a)
+
+	// but aborts if either changed since it read them
+	for _, key := range []string{"x", "y"} {
+		objectStoreTestRead(t, a, "x")
+		objectStoreTestRead(t, a, "y")
+		objectStoreTestWrite(t, b, key, "2")
+		mailboxesTestCommit(t, b)
+		expectObjectStoreTestAbort(t, a)
+	}
+	if store.puts != 3 {
+		t.Fatalf("expected only b's 3 writes to be put, got %d puts", store.puts)
+	}
+}
+
+func TestObjectStoreBlindWrite(t *testing.T) {
+	store := newMemoryObjectStore()
+	a := makeObjectStoreTestResource(store)
+	b := makeObjectStoreTestResource(store)
+	objectStoreTestRead(t, a, "x")
+	objectStoreTestWrite(t, b, "x", "b")
+	mailboxesTestCommit(t, b)
+
+	// a write without a read puts unconditionally, overwriting b's
+	mailboxesTestAbort(a)
+	objectStoreTestWrite(t, a, "x", "a")
+	mailboxesTestCommit(t, a)
+	if value, _ := store.get("x"); value != "a" {
+		t.Fatalf("expected x to hold a's write, got %q", value)
+	}
+	if conds := store.conds[objectStoreTestPrefix+"x"]; conds[1] != (ObjectCondition{}) {
+		t.Fatalf("expected the blind write to be unconditional, got %v", conds[1])
+	}
+}
+
+func TestObjectStoreErrors(t *testing.T) {
+	store := newMemoryObjectStore()
+	res := makeObjectStoreTestResource(store)
+	if _, err := res.Index(tla.MakeTLANumber(1)); !errors.Is(err, ErrObjectIndexNotString) {
+		t.Fatalf("expected a number index to be rejected, got %v", err)
+	}
+	if err := objectStoreTestIndex(t, res, "x").WriteValue(tla.MakeTLANumber(1)); !errors.Is(err, ErrObjectValueNotString) {
+		t.Fatalf("expected a number value to be rejected, got %v", err)
+	}
+	// a failed get aborts the critical section, and the next one gets again
+	store.failGet = true
+	if _, err := objectStoreTestIndex(t, res, "x").ReadValue(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected a failed get to abort, got %v", err)
+	}
+	mailboxesTestAbort(res)
+	if value := objectStoreTestRead(t, res, "x"); value != "initial" {
+		t.Fatalf("expected a missing object to read as initial, read %q", value)
+	}
+}
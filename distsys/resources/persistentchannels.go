@@ -0,0 +1,310 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// persistentQueueCompactionThreshold is how many records the log of a PersistentQueue may hold before it is
+// rewritten to only contain the values still queued.
+const persistentQueueCompactionThreshold = 1024
+
+// PersistentQueue is a FIFO queue of TLA+ values, backed by an append-only log file so that its contents survive
+// process restarts. Every change is synced to disk before it takes effect. It connects Go code and MPCal models
+// durably, like a lightweight message queue: see PersistentInputChannelMaker and PersistentOutputChannelMaker.
+//
+// Each queue should be read by at most one resource or goroutine at a time.
+type PersistentQueue struct {
+	path string
+
+	lock    sync.Mutex
+	file    *os.File
+	values  []tla.TLAValue
+	records int
+	// changed is closed and replaced whenever values are added
+	changed chan struct{}
+}
+
+// persistentQueueRecord is one entry of the log: Values were added to the queue, then Taken values were removed
+// from its head.
+type persistentQueueRecord struct {
+	Values []tla.TLAValue
+	Taken  int
+}
+
+// OpenPersistentQueue opens the queue logged at path, creating it if needed. A partially written record at the
+// end of the log, left by a crash, is discarded, and a damaged one elsewhere fails with distsys.ErrCorruptLogRecord.
+func OpenPersistentQueue(path string) (*PersistentQueue, error) {
+	q := &PersistentQueue{
+		path:    path,
+		changed: make(chan struct{}),
+	}
+	var err error
+	q.file, err = distsys.OpenLogFile(path, q.replay)
+	if err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// replay recovers the values queued in the log.
+func (q *PersistentQueue) replay(r *distsys.LogRecordReader) error {
+	for {
+		var record persistentQueueRecord
+		err := r.Next(&record)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if record.Taken > len(q.values)+len(record.Values) {
+			return fmt.Errorf("corrupt persistent queue log %s: more values taken than were queued", q.path)
+		}
+		q.values = append(q.values, record.Values...)
+		q.values = q.values[record.Taken:]
+		q.records++
+	}
+}
+
+// appendLocked durably logs record, then applies it.
+func (q *PersistentQueue) appendLocked(record persistentQueueRecord) error {
+	err := distsys.WriteLogRecord(q.file, &record)
+	if err == nil {
+		err = q.file.Sync()
+	}
+	if err != nil {
+		return err
+	}
+	q.values = append(q.values, record.Values...)
+	q.values = q.values[record.Taken:]
+	q.records++
+	if len(record.Values) > 0 {
+		close(q.changed)
+		q.changed = make(chan struct{})
+	}
+	if q.records > persistentQueueCompactionThreshold && q.records > 2*len(q.values) {
+		return q.compactLocked()
+	}
+	return nil
+}
+
+// compactLocked atomically replaces the log with a single record holding the values still queued.
+func (q *PersistentQueue) compactLocked() error {
+	f, err := distsys.ReplaceLogFile(q.path, &persistentQueueRecord{Values: q.values})
+	if err != nil {
+		return err
+	}
+	_ = q.file.Close()
+	q.file = f
+	q.records = 1
+	return nil
+}
+
+// Put durably appends values to the queue.
+func (q *PersistentQueue) Put(values ...tla.TLAValue) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.appendLocked(persistentQueueRecord{Values: values})
+}
+
+// Take durably removes the value at the head of the queue and returns it, waiting for one to be available if the
+// queue is empty. It returns ctx.Err() if ctx is done first.
+func (q *PersistentQueue) Take(ctx context.Context) (tla.TLAValue, error) {
+	for {
+		q.lock.Lock()
+		if len(q.values) > 0 {
+			value := q.values[0]
+			err := q.appendLocked(persistentQueueRecord{Taken: 1})
+			q.lock.Unlock()
+			return value, err
+		}
+		changed := q.changed
+		q.lock.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return tla.TLAValue{}, ctx.Err()
+		}
+	}
+}
+
+// Len returns the number of values in the queue.
+func (q *PersistentQueue) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.values)
+}
+
+// Close closes the queue's log file.
+func (q *PersistentQueue) Close() error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.file.Close()
+}
+
+// peek returns the value at position idx of the queue, waiting up to timeout for it to be added.
+func (q *PersistentQueue) peek(idx int, timeout time.Duration) (tla.TLAValue, bool) {
+	deadline := time.After(timeout)
+	for {
+		q.lock.Lock()
+		if idx < len(q.values) {
+			value := q.values[idx]
+			q.lock.Unlock()
+			return value, true
+		}
+		changed := q.changed
+		q.lock.Unlock()
+		select {
+		case <-changed:
+		case <-deadline:
+			return tla.TLAValue{}, false
+		}
+	}
+}
+
+// take durably removes count values from the head of the queue.
+func (q *PersistentQueue) take(count int) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.appendLocked(persistentQueueRecord{Taken: count})
+}
+
+// PersistentInputChannel is like InputChannel, but reads from a PersistentQueue. Values read are only removed from
+// the queue once the critical section reading them commits, so a crash never loses queued inputs. As with any
+// durable queue, a crash during the commit may cause the values to be read again after a restart.
+type PersistentInputChannel struct {
+	distsys.ArchetypeResourceLeafMixin
+	queue       *PersistentQueue
+	readCount   int
+	readTimeout time.Duration
+}
+
+var _ distsys.ArchetypeResource = &PersistentInputChannel{}
+
+func PersistentInputChannelMaker(queue *PersistentQueue, opts ...InputChannelOption) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &PersistentInputChannel{}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*PersistentInputChannel)
+			r.queue = queue
+			// reuse the options of InputChannel, which share its read timeout semantics
+			cfg := &InputChannel{readTimeout: inputChannelReadTimout}
+			for _, opt := range opts {
+				opt(cfg)
+			}
+			r.readTimeout = cfg.readTimeout
+		},
+	}
+}
+
+func (res *PersistentInputChannel) Abort() chan struct{} {
+	res.readCount = 0
+	return nil
+}
+
+func (res *PersistentInputChannel) PreCommit() chan error {
+	return nil
+}
+
+func (res *PersistentInputChannel) Commit() chan struct{} {
+	if res.readCount == 0 {
+		return nil
+	}
+	doneCh := make(chan struct{})
+	go func() {
+		err := res.queue.take(res.readCount)
+		if err != nil {
+			panic(fmt.Errorf("could not remove read values from persistent queue %s: %w", res.queue.path, err))
+		}
+		res.readCount = 0
+		doneCh <- struct{}{}
+	}()
+	return doneCh
+}
+
+func (res *PersistentInputChannel) ReadValue() (tla.TLAValue, error) {
+	value, ok := res.queue.peek(res.readCount, res.readTimeout)
+	if !ok {
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	}
+	res.readCount++
+	return value, nil
+}
+
+func (res *PersistentInputChannel) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write %v to a persistent input channel resource", value))
+}
+
+func (res *PersistentInputChannel) Close() error {
+	return nil
+}
+
+// PersistentOutputChannel is like OutputChannel, but writes to a PersistentQueue. The values written by a critical
+// section are durably added to the queue when it commits, and stay there until taken, even across restarts.
+type PersistentOutputChannel struct {
+	distsys.ArchetypeResourceLeafMixin
+	queue  *PersistentQueue
+	buffer []tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &PersistentOutputChannel{}
+
+func PersistentOutputChannelMaker(queue *PersistentQueue) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &PersistentOutputChannel{}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*PersistentOutputChannel)
+			r.queue = queue
+		},
+	}
+}
+
+func (res *PersistentOutputChannel) Abort() chan struct{} {
+	res.buffer = nil
+	return nil
+}
+
+func (res *PersistentOutputChannel) PreCommit() chan error {
+	return nil
+}
+
+func (res *PersistentOutputChannel) Commit() chan struct{} {
+	if len(res.buffer) == 0 {
+		return nil
+	}
+	doneCh := make(chan struct{})
+	go func() {
+		err := res.queue.Put(res.buffer...)
+		if err != nil {
+			panic(fmt.Errorf("could not write to persistent queue %s: %w", res.queue.path, err))
+		}
+		res.buffer = nil
+		doneCh <- struct{}{}
+	}()
+	return doneCh
+}
+
+func (res *PersistentOutputChannel) ReadValue() (tla.TLAValue, error) {
+	panic(fmt.Errorf("attempted to read from a persistent output channel resource"))
+}
+
+func (res *PersistentOutputChannel) WriteValue(value tla.TLAValue) error {
+	res.buffer = append(res.buffer, value)
+	return nil
+}
+
+func (res *PersistentOutputChannel) Close() error {
+	return nil
+}
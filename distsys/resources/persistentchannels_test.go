@@ -0,0 +1,147 @@
+package resources
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func openPersistentQueueTest(t *testing.T, path string) *PersistentQueue {
+	t.Helper()
+	q, err := OpenPersistentQueue(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = q.Close()
+	})
+	return q
+}
+
+func expectPersistentQueueTestValues(t *testing.T, q *PersistentQueue, expected ...int32) {
+	t.Helper()
+	var values []tla.TLAValue
+	for q.Len() > 0 {
+		value, err := q.Take(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, value)
+	}
+	expectMailboxesTestValues(t, values, expected...)
+}
+
+func TestPersistentQueue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue")
+	q := openPersistentQueueTest(t, path)
+	if err := q.Put(tla.MakeTLANumber(1), tla.MakeTLANumber(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Put(tla.MakeTLANumber(3)); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := q.Take(context.Background()); err != nil || !value.Equal(tla.MakeTLANumber(1)) {
+		t.Fatalf("expected to take 1, got %v, %v", value, err)
+	}
+	if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the queue survives a restart, without the value taken
+	q = openPersistentQueueTest(t, path)
+	expectPersistentQueueTestValues(t, q, 2, 3)
+
+	// taking from an empty queue waits for a value, or for ctx to be done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := q.Take(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected taking from an empty queue to time out, got %v", err)
+	}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = q.Put(tla.MakeTLANumber(4))
+	}()
+	if value, err := q.Take(context.Background()); err != nil || !value.Equal(tla.MakeTLANumber(4)) {
+		t.Fatalf("expected to take 4, got %v, %v", value, err)
+	}
+}
+
+func TestPersistentQueueCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue")
+	q := openPersistentQueueTest(t, path)
+	for i := int32(0); i <= persistentQueueCompactionThreshold/2; i++ {
+		if err := q.Put(tla.MakeTLANumber(i)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := q.Take(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := q.Put(tla.MakeTLANumber(-1)); err != nil {
+		t.Fatal(err)
+	}
+	if q.records > persistentQueueCompactionThreshold {
+		t.Fatalf("expected the log to have been compacted, but it holds %d records", q.records)
+	}
+	if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+	q = openPersistentQueueTest(t, path)
+	expectPersistentQueueTestValues(t, q, -1)
+}
+
+func TestPersistentChannels(t *testing.T) {
+	q := openPersistentQueueTest(t, filepath.Join(t.TempDir(), "queue"))
+	makeResource := func(maker distsys.ArchetypeResourceMaker) distsys.ArchetypeResource {
+		res := maker.Make()
+		maker.Configure(res)
+		return res
+	}
+	output := makeResource(PersistentOutputChannelMaker(q))
+	input := makeResource(PersistentInputChannelMaker(q, WithInputChannelReadTimeout(10*time.Millisecond)))
+
+	// values written by an aborted critical section are never queued
+	if err := output.WriteValue(tla.MakeTLANumber(0)); err != nil {
+		t.Fatal(err)
+	}
+	mailboxesTestAbort(output)
+	for _, value := range []int32{1, 2} {
+		if err := output.WriteValue(tla.MakeTLANumber(value)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mailboxesTestCommit(t, output)
+	if q.Len() != 2 {
+		t.Fatalf("expected 2 values to be queued, got %d", q.Len())
+	}
+
+	// values read stay queued until the critical section reading them commits
+	read := func(count int) []tla.TLAValue {
+		t.Helper()
+		var values []tla.TLAValue
+		for i := 0; i < count; i++ {
+			value, err := input.ReadValue()
+			if err != nil {
+				t.Fatal(err)
+			}
+			values = append(values, value)
+		}
+		return values
+	}
+	expectMailboxesTestValues(t, read(2), 1, 2)
+	mailboxesTestAbort(input)
+	expectMailboxesTestValues(t, read(1), 1)
+	mailboxesTestCommit(t, input)
+	if q.Len() != 1 {
+		t.Fatalf("expected 1 value to remain queued, got %d", q.Len())
+	}
+	expectMailboxesTestValues(t, read(1), 2)
+	mailboxesTestCommit(t, input)
+	if _, err := input.ReadValue(); err != distsys.ErrCriticalSectionAborted {
+		t.Fatalf("expected reading from an empty queue to abort, got %v", err)
+	}
+}
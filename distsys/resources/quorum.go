@@ -0,0 +1,340 @@
+package resources
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"go.uber.org/multierr"
+)
+
+var (
+	quorumValueField   = tla.MakeTLAString("value")
+	quorumVersionField = tla.MakeTLAString("version")
+	quorumWriterField  = tla.MakeTLAString("writer")
+)
+
+type quorumConfig struct {
+	readSize, writeSize int
+}
+
+// QuorumOption configures a resource made by QuorumMaker.
+type QuorumOption func(cfg *quorumConfig)
+
+// WithQuorumReadSize sets R, the number of replicas that must answer a read.
+func WithQuorumReadSize(r int) QuorumOption {
+	return func(cfg *quorumConfig) {
+		cfg.readSize = r
+	}
+}
+
+// WithQuorumWriteSize sets W, the number of replicas that must accept a write.
+func WithQuorumWriteSize(w int) QuorumOption {
+	return func(cfg *quorumConfig) {
+		cfg.writeSize = w
+	}
+}
+
+// QuorumMaker produces a distsys.ArchetypeResourceMaker for a value replicated across the N resources made by
+// replicas, e.g. one per replica server, accessed Dynamo-style. By default, R and W are both a majority of N; with
+// R + W > N, every read quorum intersects every write quorum, so reads see the latest committed write.
+//
+// Replicas store the value alongside a version, as a record [value |-> v, version |-> n, writer |-> w]; a replica
+// holding anything else is taken to hold that value, at version 0. Reading asks replicas in turn until R of them
+// answer, and yields the value with the highest version, breaking ties by writer. Replicas that answered with an
+// older version are repaired, by writing them the newest record along with the critical section. Writing first
+// reads, as above, then writes the value at the next version to all N replicas. At pre-commit, the critical
+// section aborts unless at least W of the replicas written, or R of those read if nothing was written, pre-commit
+// successfully; replicas that failed to are aborted rather than committed.
+func QuorumMaker(replicas []distsys.ArchetypeResourceMaker, opts ...QuorumOption) distsys.ArchetypeResourceMaker {
+	cfg := quorumConfig{
+		readSize:  len(replicas)/2 + 1,
+		writeSize: len(replicas)/2 + 1,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.readSize < 1 || cfg.readSize > len(replicas) || cfg.writeSize < 1 || cfg.writeSize > len(replicas) {
+		panic(fmt.Errorf("invalid quorum sizes R=%d, W=%d for %d replicas", cfg.readSize, cfg.writeSize, len(replicas)))
+	}
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			res := &quorumResource{
+				writer:   tla.MakeTLAString(fmt.Sprintf("%x", rand.Int63())),
+				replicas: make([]quorumReplica, len(replicas)),
+			}
+			for i, maker := range replicas {
+				res.replicas[i].res = maker.Make()
+			}
+			return res
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*quorumResource)
+			r.config = cfg
+			for i, maker := range replicas {
+				maker.Configure(r.replicas[i].res)
+			}
+		},
+	}
+}
+
+type quorumResource struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	config   quorumConfig
+	writer   tla.TLAValue
+	replicas []quorumReplica
+
+	hasRead      bool
+	latest       quorumCell
+	writePending bool
+}
+
+type quorumReplica struct {
+	res distsys.ArchetypeResource
+	// touched is set if the current critical section accessed the replica, so that it must be committed or aborted
+	touched bool
+	// failed is set if the replica could not be accessed, and will be aborted
+	failed  bool
+	written bool
+	// cell is what the replica answered to a read, if it was read
+	read bool
+	cell quorumCell
+}
+
+type quorumCell struct {
+	value tla.TLAValue
+	// version is a TLA+ integer, so that it becomes a big number rather than wrapping around
+	version tla.TLAValue
+	writer  tla.TLAValue
+}
+
+func decodeQuorumCell(value tla.TLAValue) quorumCell {
+	if value.IsFunction() {
+		fields := value.AsFunction()
+		v, hasValue := fields.Get(quorumValueField)
+		version, hasVersion := fields.Get(quorumVersionField)
+		writer, hasWriter := fields.Get(quorumWriterField)
+		if hasValue && hasVersion && hasWriter && fields.Len() == 3 {
+			if _, err := version.(tla.TLAValue).TryAsBigNumber(); err == nil {
+				return quorumCell{value: v.(tla.TLAValue), version: version.(tla.TLAValue), writer: writer.(tla.TLAValue)}
+			}
+		}
+	}
+	return quorumCell{value: value, version: tla.TLA_Zero, writer: tla.MakeTLAString("")}
+}
+
+func (cell quorumCell) encode() tla.TLAValue {
+	return tla.MakeTLARecord([]tla.TLARecordField{
+		{Key: quorumValueField, Value: cell.value},
+		{Key: quorumVersionField, Value: cell.version},
+		{Key: quorumWriterField, Value: cell.writer},
+	})
+}
+
+func (cell quorumCell) newerThan(other quorumCell) bool {
+	if !cell.version.Equal(other.version) {
+		return tla.TLA_GreaterThanSymbol(cell.version, other.version).AsBool()
+	}
+	return cell.writer.String() > other.writer.String()
+}
+
+// quorumRead reads replicas until R of them answer, and repairs those that answered with an older value.
+func (res *quorumResource) quorumRead() error {
+	answered := 0
+	for i := range res.replicas {
+		if answered == res.config.readSize {
+			break
+		}
+		replica := &res.replicas[i]
+		if replica.failed {
+			continue
+		}
+		replica.touched = true
+		value, err := replica.res.ReadValue()
+		if err != nil {
+			if err != distsys.ErrCriticalSectionAborted {
+				res.log(distsys.LogWarn, "could not read quorum replica", "replica", i, "error", err)
+			}
+			replica.failed = true
+			continue
+		}
+		replica.read, replica.cell = true, decodeQuorumCell(value)
+		if answered == 0 || replica.cell.newerThan(res.latest) {
+			res.latest = replica.cell
+		}
+		answered++
+	}
+	if answered < res.config.readSize {
+		return distsys.ErrCriticalSectionAborted
+	}
+	res.hasRead = true
+
+	for i := range res.replicas {
+		replica := &res.replicas[i]
+		if replica.read && !replica.failed && res.latest.newerThan(replica.cell) {
+			err := replica.res.WriteValue(res.latest.encode())
+			if err != nil {
+				res.log(distsys.LogWarn, "could not repair quorum replica", "replica", i, "error", err)
+				replica.failed = true
+			}
+		}
+	}
+	return nil
+}
+
+func (res *quorumResource) ReadValue() (tla.TLAValue, error) {
+	if !res.hasRead {
+		err := res.quorumRead()
+		if err != nil {
+			return tla.TLAValue{}, err
+		}
+	}
+	return res.latest.value, nil
+}
+
+func (res *quorumResource) WriteValue(value tla.TLAValue) error {
+	if !res.hasRead {
+		err := res.quorumRead()
+		if err != nil {
+			return err
+		}
+	}
+	version := res.latest.version
+	if !res.writePending {
+		version = tla.TLA_PlusSymbol(version, tla.MakeTLANumber(1))
+	}
+	res.latest = quorumCell{value: value, version: version, writer: res.writer}
+	res.writePending = true
+	for i := range res.replicas {
+		replica := &res.replicas[i]
+		if replica.failed {
+			continue
+		}
+		replica.touched = true
+		err := replica.res.WriteValue(res.latest.encode())
+		if err != nil {
+			res.log(distsys.LogWarn, "could not write quorum replica", "replica", i, "error", err)
+			replica.failed = true
+			continue
+		}
+		replica.written = true
+	}
+	return nil
+}
+
+func (res *quorumResource) reset() {
+	for i := range res.replicas {
+		res.replicas[i] = quorumReplica{res: res.replicas[i].res}
+	}
+	res.hasRead = false
+	res.latest = quorumCell{}
+	res.writePending = false
+}
+
+// finish commits or aborts each touched replica, as chosen by commit, and waits for them.
+func (res *quorumResource) finish(commit func(replica *quorumReplica) bool) chan struct{} {
+	var nonTrivialOps []chan struct{}
+	for i := range res.replicas {
+		replica := &res.replicas[i]
+		if !replica.touched {
+			continue
+		}
+		var ch chan struct{}
+		if commit(replica) {
+			ch = replica.res.Commit()
+		} else {
+			ch = replica.res.Abort()
+		}
+		if ch != nil {
+			nonTrivialOps = append(nonTrivialOps, ch)
+		}
+	}
+	res.reset()
+	if len(nonTrivialOps) == 0 {
+		return nil
+	}
+	doneCh := make(chan struct{}, 1)
+	go func() {
+		for _, ch := range nonTrivialOps {
+			<-ch
+		}
+		doneCh <- struct{}{}
+	}()
+	return doneCh
+}
+
+func (res *quorumResource) Abort() chan struct{} {
+	return res.finish(func(*quorumReplica) bool {
+		return false
+	})
+}
+
+func (res *quorumResource) PreCommit() chan error {
+	type pending struct {
+		replica *quorumReplica
+		ch      chan error
+	}
+	var preCommits []pending
+	for i := range res.replicas {
+		replica := &res.replicas[i]
+		if replica.touched && !replica.failed {
+			preCommits = append(preCommits, pending{replica: replica, ch: replica.res.PreCommit()})
+		}
+	}
+	if len(preCommits) == 0 {
+		return nil
+	}
+	doneCh := make(chan error, 1)
+	go func() {
+		succeeded := 0
+		for _, p := range preCommits {
+			var err error
+			if p.ch != nil {
+				err = <-p.ch
+			}
+			if err != nil {
+				p.replica.failed = true
+				continue
+			}
+			if (res.writePending && p.replica.written) || (!res.writePending && p.replica.read) {
+				succeeded++
+			}
+		}
+		required := res.config.readSize
+		if res.writePending {
+			required = res.config.writeSize
+		}
+		if succeeded < required {
+			doneCh <- distsys.ErrCriticalSectionAborted
+			return
+		}
+		doneCh <- nil
+	}()
+	return doneCh
+}
+
+func (res *quorumResource) Commit() chan struct{} {
+	return res.finish(func(replica *quorumReplica) bool {
+		return !replica.failed
+	})
+}
+
+// SetLogger sets the logger of the quorum, and passes it on to the replicas that log, adding their index.
+func (res *quorumResource) SetLogger(logger distsys.Logger) {
+	res.resourceLogger.SetLogger(logger)
+	for i := range res.replicas {
+		if loggingRes, ok := res.replicas[i].res.(distsys.LoggingArchetypeResource); ok {
+			loggingRes.SetLogger(distsys.LoggerWith(logger, "replica", i))
+		}
+	}
+}
+
+func (res *quorumResource) Close() error {
+	var err error
+	for _, replica := range res.replicas {
+		err = multierr.Append(err, replica.res.Close())
+	}
+	return err
+}
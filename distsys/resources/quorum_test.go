@@ -0,0 +1,153 @@
+package resources
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func quorumTestRecord(value, version tla.TLAValue, writer string) tla.TLAValue {
+	return quorumCell{value: value, version: version, writer: tla.MakeTLAString(writer)}.encode()
+}
+
+// makeQuorumTest makes a quorum over n replicas, each with its own store, holding a value under the key "v".
+func makeQuorumTest(n int, opts ...QuorumOption) (distsys.ArchetypeResource, []*testStore) {
+	var stores []*testStore
+	var replicas []distsys.ArchetypeResourceMaker
+	for i := 0; i < n; i++ {
+		store := newTestStore()
+		stores = append(stores, store)
+		replicas = append(replicas, testStoreValueMaker(store, "v"))
+	}
+	maker := QuorumMaker(replicas, opts...)
+	res := maker.Make()
+	maker.Configure(res)
+	return res, stores
+}
+
+func TestQuorumReadRepair(t *testing.T) {
+	res, stores := makeQuorumTest(3, WithQuorumReadSize(3), WithQuorumWriteSize(1))
+	stores[0].set("v", quorumTestRecord(tla.MakeTLAString("new"), tla.MakeTLANumber(2), "a"))
+	stores[1].set("v", quorumTestRecord(tla.MakeTLAString("old"), tla.MakeTLANumber(1), "a"))
+	// replicas holding a plain value hold it at version 0
+	stores[2].set("v", tla.MakeTLAString("older"))
+
+	value, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !value.Equal(tla.MakeTLAString("new")) {
+		t.Fatalf("expected to read the value with the highest version, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+
+	expected := quorumTestRecord(tla.MakeTLAString("new"), tla.MakeTLANumber(2), "a")
+	for i, store := range stores {
+		if record := store.get("v"); !record.Equal(expected) {
+			t.Fatalf("expected replica %d to hold %v after read repair, found %v", i, expected, record)
+		}
+	}
+}
+
+func TestQuorumVersionTieBrokenByWriter(t *testing.T) {
+	res, stores := makeQuorumTest(2, WithQuorumReadSize(2))
+	stores[0].set("v", quorumTestRecord(tla.MakeTLAString("from a"), tla.MakeTLANumber(1), "a"))
+	stores[1].set("v", quorumTestRecord(tla.MakeTLAString("from b"), tla.MakeTLANumber(1), "b"))
+
+	value, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !value.Equal(tla.MakeTLAString("from b")) {
+		t.Fatalf("expected the greater writer to win a version tie, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+	expected := quorumTestRecord(tla.MakeTLAString("from b"), tla.MakeTLANumber(1), "b")
+	if record := stores[0].get("v"); !record.Equal(expected) {
+		t.Fatalf("expected the losing replica to be repaired to %v, found %v", expected, record)
+	}
+}
+
+func TestQuorumWrite(t *testing.T) {
+	res, stores := makeQuorumTest(3)
+	stores[0].set("v", quorumTestRecord(tla.MakeTLAString("old"), tla.MakeTLANumber(4), "a"))
+
+	if err := res.WriteValue(tla.MakeTLAString("new")); err != nil {
+		t.Fatal(err)
+	}
+	// a second write in the same critical section replaces the first, at the same version
+	if err := res.WriteValue(tla.MakeTLAString("newer")); err != nil {
+		t.Fatal(err)
+	}
+	raftCounterTestCommit(t, res)
+	for i, store := range stores {
+		record := decodeQuorumCell(store.get("v"))
+		if !record.value.Equal(tla.MakeTLAString("newer")) || !record.version.Equal(tla.MakeTLANumber(5)) {
+			t.Fatalf("expected replica %d to hold the write at version 5, found %v", i, store.get("v"))
+		}
+	}
+}
+
+func TestQuorumWriteQuorumNotReached(t *testing.T) {
+	res, stores := makeQuorumTest(3)
+	stores[1].setFailPreCommit(true)
+
+	// one replica failing to pre-commit leaves a majority
+	if err := res.WriteValue(tla.MakeTLAString("a")); err != nil {
+		t.Fatal(err)
+	}
+	raftCounterTestCommit(t, res)
+	for _, i := range []int{0, 2} {
+		if record := decodeQuorumCell(stores[i].get("v")); !record.value.Equal(tla.MakeTLAString("a")) {
+			t.Fatalf("expected replica %d to hold the write, found %v", i, stores[i].get("v"))
+		}
+	}
+	if record := stores[1].get("v"); !record.Equal(tla.MakeTLANumber(0)) {
+		t.Fatalf("expected the replica that failed to pre-commit to be aborted, found %v", record)
+	}
+
+	// but two leave fewer than W, and the critical section aborts
+	stores[2].setFailPreCommit(true)
+	if err := res.WriteValue(tla.MakeTLAString("b")); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-res.PreCommit(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected fewer than W pre-commits to abort, got %v", err)
+	}
+	if ch := res.Abort(); ch != nil {
+		<-ch
+	}
+	if record := decodeQuorumCell(stores[0].get("v")); !record.value.Equal(tla.MakeTLAString("a")) {
+		t.Fatalf("expected the aborted write not to reach any replica, found %v", stores[0].get("v"))
+	}
+}
+
+func TestQuorumVersionOverflow(t *testing.T) {
+	res, stores := makeQuorumTest(2, WithQuorumReadSize(2), WithQuorumWriteSize(2))
+	stores[0].set("v", quorumTestRecord(tla.MakeTLAString("old"), tla.MakeTLANumber(math.MaxInt32), "a"))
+
+	if err := res.WriteValue(tla.MakeTLAString("new")); err != nil {
+		t.Fatal(err)
+	}
+	raftCounterTestCommit(t, res)
+	expectedVersion := tla.MakeTLABigNumber(big.NewInt(math.MaxInt32 + 1))
+	record := decodeQuorumCell(stores[0].get("v"))
+	if !record.version.Equal(expectedVersion) {
+		t.Fatalf("expected the version to become %v rather than wrapping, found %v", expectedVersion, record.version)
+	}
+
+	// and the big version still wins over smaller ones
+	stores[1].set("v", quorumTestRecord(tla.MakeTLAString("small"), tla.MakeTLANumber(5), "z"))
+	value, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !value.Equal(tla.MakeTLAString("new")) {
+		t.Fatalf("expected to read the write at the big version, read %v", value)
+	}
+	raftCounterTestCommit(t, res)
+}
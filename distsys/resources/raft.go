@@ -0,0 +1,1380 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/rpc"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+const (
+	raftElectionTimeout   = 300 * time.Millisecond
+	raftHeartbeatInterval = 50 * time.Millisecond
+	raftProposalTimeout   = 5 * time.Second
+	raftLockLease         = 10 * time.Second
+	raftTickInterval      = 10 * time.Millisecond
+	raftRetryWait         = 20 * time.Millisecond
+	raftLockedWait        = 20 * time.Millisecond
+
+	// raftStateCompactionThreshold is how many records the state file of a RaftNode may hold before it is rewritten
+	// as a single record.
+	raftStateCompactionThreshold = 1024
+	// raftSnapshotThreshold is how many applied log entries a RaftNode keeps before compacting them into a snapshot.
+	raftSnapshotThreshold = 1024
+)
+
+var (
+	errRaftNotLeader   = errors.New("raft node is not the leader")
+	errRaftUnavailable = errors.New("raft group did not commit the proposal in time")
+	errRaftDropped     = errors.New("raft call dropped by network faults")
+	errRaftClosed      = errors.New("raft node is closed")
+)
+
+type raftConfig struct {
+	electionTimeout   time.Duration
+	heartbeatInterval time.Duration
+	proposalTimeout   time.Duration
+	lockLease         time.Duration
+	snapshotThreshold int
+	statePath         string
+	faults            *NetworkFaults
+}
+
+// RaftOption configures a RaftNode.
+type RaftOption func(cfg *raftConfig)
+
+// WithRaftElectionTimeout sets the minimum time a node waits without hearing from a leader before starting an
+// election. The actual timeout is drawn at random between timeout and twice timeout, to avoid split votes.
+func WithRaftElectionTimeout(timeout time.Duration) RaftOption {
+	return func(cfg *raftConfig) {
+		cfg.electionTimeout = timeout
+	}
+}
+
+// WithRaftHeartbeatInterval sets how often the leader replicates its log to followers, or just asserts its
+// leadership if there is nothing new. It should be well below the election timeout.
+func WithRaftHeartbeatInterval(interval time.Duration) RaftOption {
+	return func(cfg *raftConfig) {
+		cfg.heartbeatInterval = interval
+	}
+}
+
+// WithRaftProposalTimeout sets how long a resource waits for the group to commit one of its operations, e.g.
+// while a new leader is being elected, before giving up.
+func WithRaftProposalTimeout(timeout time.Duration) RaftOption {
+	return func(cfg *raftConfig) {
+		cfg.proposalTimeout = timeout
+	}
+}
+
+// WithRaftLockLease sets how long a resource may hold the lock it takes on a shared value between the pre-commit
+// and commit of a critical section. If its node crashes in between, other nodes can use the value again once the
+// lease expires. Leases are measured by the group's clock, which only runs while the group has a leader, at the
+// pace of the leader's monotonic clock: a lease is never cut short by a wall clock being set back or forth, by clock
+// skew between nodes, or by a change of leader, though it may last longer than lease if the group has no leader.
+func WithRaftLockLease(lease time.Duration) RaftOption {
+	return func(cfg *raftConfig) {
+		cfg.lockLease = lease
+	}
+}
+
+// WithRaftSnapshotThreshold sets how many applied log entries the node keeps before compacting them into a
+// snapshot of the shared values. A follower that falls behind the compacted part of the leader's log is sent the
+// leader's snapshot instead of the entries.
+func WithRaftSnapshotThreshold(entries int) RaftOption {
+	return func(cfg *raftConfig) {
+		cfg.snapshotThreshold = entries
+	}
+}
+
+// WithRaftStatePath makes the node keep its term, vote, snapshot and log in a file at path, syncing every change to
+// disk before acting on it. A node restarted with the same ID and path then rejoins the group where it left off,
+// restoring its snapshot and replaying its log to rebuild the shared values. Without it, this state only lives in
+// memory.
+func WithRaftStatePath(path string) RaftOption {
+	return func(cfg *raftConfig) {
+		cfg.statePath = path
+	}
+}
+
+// WithRaftNetworkFaults makes the node's calls to other members suffer the faults faults describes between node
+// IDs, as TLA+ numbers: calls are dropped with the fault's DropProbability, and held up by its Delay. Like
+// NetworkFaultsMaker, this is intended for tests.
+func WithRaftNetworkFaults(faults *NetworkFaults) RaftOption {
+	return func(cfg *raftConfig) {
+		cfg.faults = faults
+	}
+}
+
+type raftRole int
+
+const (
+	raftFollower raftRole = iota
+	raftCandidate
+	raftLeader
+)
+
+type raftCommandKind int
+
+const (
+	raftCommandNoop raftCommandKind = iota
+	raftCommandRead
+	raftCommandPrepare
+	raftCommandCommit
+	raftCommandRelease
+)
+
+// RaftCommand is an operation on the replicated state machine, which maps keys to versioned, lockable values.
+type RaftCommand struct {
+	Kind  raftCommandKind
+	Key   tla.TLAValue
+	Owner string
+	// ExpectVersion is the version the owner read, or -1 if it did not read the value; Prepare fails on mismatch
+	ExpectVersion int64
+	HasValue      bool
+	Value         tla.TLAValue
+	// Increment, if non-zero, makes Commit add it to the current value, a number, instead of setting Value
+	Increment int32
+	// Time is stamped by the leader, from the group's clock, when it appends the command, so that lock expiry is
+	// deterministic
+	Time  int64
+	Lease time.Duration
+}
+
+// RaftResult is the outcome of applying a RaftCommand.
+type RaftResult struct {
+	OK      bool
+	Locked  bool // the value is locked by another owner
+	Exists  bool
+	Value   tla.TLAValue
+	Version int64
+}
+
+// RaftEntry is an entry of the replicated log.
+type RaftEntry struct {
+	Term    int
+	Command RaftCommand
+}
+
+type raftRegister struct {
+	value       tla.TLAValue
+	version     int64
+	lockOwner   string
+	lockExpires int64
+	// lastOwner is the owner of the last lock released, so that a retried Commit or Release is recognized
+	lastOwner string
+}
+
+// RaftSnapshot holds the shared values as of the log entry at Index, which is of term Term, replacing the log up to
+// there. Clock is the group's clock as of that entry.
+type RaftSnapshot struct {
+	Index     int
+	Term      int
+	Clock     int64
+	Registers []RaftSnapshotRegister
+}
+
+// RaftSnapshotRegister is one shared value of a RaftSnapshot, along with its lock.
+type RaftSnapshotRegister struct {
+	Key         tla.TLAValue
+	Value       tla.TLAValue
+	Version     int64
+	LockOwner   string
+	LockExpires int64
+	LastOwner   string
+}
+
+type raftWaiter struct {
+	term   int
+	result chan RaftResult
+}
+
+// RaftNode is a member of a Raft group (Ongaro and Ousterhout, "In Search of an Understandable Consensus
+// Algorithm"), which replicates a map of shared values between the processes of a system. It backs the resources
+// made by RaftSharedValueMaker and RaftSharedMapMaker, which give archetypes linearizable access to those values,
+// as if they were atomic registers, as long as a majority of the group is up.
+//
+// Every so often, the applied part of the log is compacted into a snapshot of the shared values; see
+// WithRaftSnapshotThreshold. Unless the node was given WithRaftStatePath, its snapshot and log are not persisted, and
+// a crashed node must not rejoin the group with its old ID, as it would have forgotten its votes.
+type RaftNode struct {
+	ID         int
+	ListenAddr string
+
+	resourceLogger
+
+	peerAddrs []string
+	config    raftConfig
+	listener  net.Listener
+	server    *rpc.Server
+	state     *raftStateFile
+	done      chan struct{}
+
+	ownerPrefix  string
+	ownerCounter int64
+
+	lock          sync.Mutex
+	role          raftRole
+	currentTerm   int
+	votedFor      int
+	leaderID      int
+	log           []RaftEntry // log[0] stands for the entry at snapshotIndex; the entry at index i is log[i-snapshotIndex]
+	commitIndex   int
+	lastApplied   int
+	nextIndex     []int
+	matchIndex    []int
+	inFlight      []bool
+	lastHeard     time.Time
+	timeout       time.Duration
+	lastHeartbeat time.Time
+	votes         int
+	registers     *immutable.Map // key -> *raftRegister
+	waiters       map[int]raftWaiter
+	clients       map[int]*rpc.Client
+	rng           *rand.Rand
+
+	// the log is compacted up to snapshotIndex, of term snapshotTerm, into the shared values and the group's clock
+	// as of that entry
+	snapshotIndex     int
+	snapshotTerm      int
+	snapshotRegisters *immutable.Map
+	snapshotClock     int64
+	// appliedClock is the latest time stamped on an applied entry, or the snapshot's clock
+	appliedClock int64
+	// while this node leads, the group's clock reads clockBase, the latest time stamped on an entry of its log when
+	// it became leader, plus the time elapsed since clockStart, then; see clockLocked
+	clockBase  int64
+	clockStart time.Time
+}
+
+// NewRaftNode creates the node with index id in a Raft group whose members listen on peerAddrs, including this
+// node, which listens on peerAddrs[id]. Every member must be given the same peerAddrs. The node does nothing until
+// ListenAndServe is called.
+func NewRaftNode(id int, peerAddrs []string, opts ...RaftOption) *RaftNode {
+	cfg := raftConfig{
+		electionTimeout:   raftElectionTimeout,
+		heartbeatInterval: raftHeartbeatInterval,
+		proposalTimeout:   raftProposalTimeout,
+		lockLease:         raftLockLease,
+		snapshotThreshold: raftSnapshotThreshold,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano() + int64(id)))
+	return &RaftNode{
+		ID:          id,
+		ListenAddr:  peerAddrs[id],
+		peerAddrs:   peerAddrs,
+		config:      cfg,
+		done:        make(chan struct{}),
+		ownerPrefix: fmt.Sprintf("%d-%x", id, rng.Int63()),
+		votedFor:    -1,
+		leaderID:    -1,
+		log:         []RaftEntry{{}},
+		nextIndex:   make([]int, len(peerAddrs)),
+		matchIndex:  make([]int, len(peerAddrs)),
+		inFlight:    make([]bool, len(peerAddrs)),
+		registers:   immutable.NewMap(tla.TLAValueHasher{}),
+		waiters:     make(map[int]raftWaiter),
+		clients:     make(map[int]*rpc.Client),
+		rng:         rng,
+
+		snapshotRegisters: immutable.NewMap(tla.TLAValueHasher{}),
+	}
+}
+
+// ListenAndServe starts the node's RPC server and its participation in the group, after restoring its state if it
+// has a state file. It blocks until an error occurs or the node closes.
+func (node *RaftNode) ListenAndServe() error {
+	if node.config.statePath != "" {
+		var record raftStateRecord
+		var err error
+		node.state, record, err = openRaftStateFile(node.config.statePath)
+		if err != nil {
+			return err
+		}
+		node.lock.Lock()
+		node.currentTerm, node.votedFor = record.Term, record.VotedFor
+		if record.Snapshot != nil {
+			node.restoreSnapshotLocked(record.Snapshot, []RaftEntry{{Term: record.Snapshot.Term}})
+		}
+		node.log = append(node.log, record.Entries...)
+		node.lock.Unlock()
+	}
+	node.server = rpc.NewServer()
+	err := node.server.Register(&RaftRPCReceiver{node: node})
+	if err != nil {
+		return err
+	}
+	node.listener, err = net.Listen("tcp", node.ListenAddr)
+	if err != nil {
+		return err
+	}
+	node.resourceLogger.log(distsys.LogInfo, "Raft node started listening", "node", node.ID, "address", node.ListenAddr)
+	node.lock.Lock()
+	node.resetElectionTimerLocked()
+	node.lock.Unlock()
+	go node.tickLoop()
+	for {
+		conn, err := node.listener.Accept()
+		if err != nil {
+			select {
+			case <-node.done:
+				return nil
+			default:
+				return err
+			}
+		}
+		go node.server.ServeConn(conn)
+	}
+}
+
+// Close stops the node's participation in the group.
+func (node *RaftNode) Close() error {
+	var err error
+	close(node.done)
+	if node.listener != nil {
+		err = node.listener.Close()
+	}
+	node.lock.Lock()
+	for _, client := range node.clients {
+		_ = client.Close()
+	}
+	node.clients = make(map[int]*rpc.Client)
+	if node.state != nil {
+		if closeErr := node.state.close(); err == nil {
+			err = closeErr
+		}
+	}
+	node.lock.Unlock()
+	return err
+}
+
+// IsLeader returns whether the node currently believes it is the group's leader.
+func (node *RaftNode) IsLeader() bool {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	return node.role == raftLeader
+}
+
+func (node *RaftNode) newOwner() string {
+	return fmt.Sprintf("%s-%d", node.ownerPrefix, atomic.AddInt64(&node.ownerCounter, 1))
+}
+
+func (node *RaftNode) resetElectionTimerLocked() {
+	node.lastHeard = time.Now()
+	node.timeout = node.config.electionTimeout + time.Duration(node.rng.Int63n(int64(node.config.electionTimeout)))
+}
+
+func (node *RaftNode) becomeFollowerLocked(term int) {
+	if term > node.currentTerm {
+		node.currentTerm = term
+		node.votedFor = -1
+		node.persistLocked(node.lastIndexLocked() + 1)
+	}
+	node.role = raftFollower
+}
+
+func (node *RaftNode) isClosed() bool {
+	select {
+	case <-node.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// persistLocked syncs the term, the vote and the log entries from index from onward to the state file, if there
+// is one. Entries the file holds from that index onward are replaced. The node must not act on its new state, e.g.
+// by answering an RPC, before it is persisted; as it cannot go on otherwise, it panics if persisting fails.
+//
+// Once the node is closed, its state is no longer persisted, and it no longer answers RPCs.
+func (node *RaftNode) persistLocked(from int) {
+	if node.state == nil || node.isClosed() {
+		return
+	}
+	err := node.state.append(raftStateRecord{
+		Term:     node.currentTerm,
+		VotedFor: node.votedFor,
+		From:     from,
+		Entries:  node.log[from-node.snapshotIndex:],
+	})
+	if err == nil && node.state.needsCompaction(len(node.log)) {
+		err = node.state.compact(node.stateRecordLocked())
+	}
+	if err != nil {
+		panic(fmt.Errorf("could not persist the state of Raft node %d: %w", node.ID, err))
+	}
+}
+
+// persistSnapshotLocked replaces the state file's contents with the node's whole state, once it has a new
+// snapshot, under the same conditions as persistLocked.
+func (node *RaftNode) persistSnapshotLocked() {
+	if node.state == nil || node.isClosed() {
+		return
+	}
+	if err := node.state.compact(node.stateRecordLocked()); err != nil {
+		panic(fmt.Errorf("could not persist the snapshot of Raft node %d: %w", node.ID, err))
+	}
+}
+
+// stateRecordLocked returns the node's whole state, as a single record of its state file.
+func (node *RaftNode) stateRecordLocked() raftStateRecord {
+	record := raftStateRecord{
+		Term:     node.currentTerm,
+		VotedFor: node.votedFor,
+		From:     node.snapshotIndex + 1,
+		Entries:  node.log[1:],
+	}
+	if node.snapshotIndex > 0 {
+		record.Snapshot = node.snapshotLocked()
+	}
+	return record
+}
+
+// lastIndexLocked returns the index of the last entry of the log.
+func (node *RaftNode) lastIndexLocked() int {
+	return node.snapshotIndex + len(node.log) - 1
+}
+
+// termAtLocked returns the term of the entry at index, which must be neither compacted nor past the end of the log.
+func (node *RaftNode) termAtLocked(index int) int {
+	return node.log[index-node.snapshotIndex].Term
+}
+
+func (node *RaftNode) lastLogLocked() (index, term int) {
+	return node.lastIndexLocked(), node.log[len(node.log)-1].Term
+}
+
+// clockLocked reads the group's clock. The node must be the leader.
+func (node *RaftNode) clockLocked() int64 {
+	return node.clockBase + int64(time.Since(node.clockStart))
+}
+
+// snapshotLocked returns the snapshot the log is compacted into.
+func (node *RaftNode) snapshotLocked() *RaftSnapshot {
+	snapshot := &RaftSnapshot{Index: node.snapshotIndex, Term: node.snapshotTerm, Clock: node.snapshotClock}
+	it := node.snapshotRegisters.Iterator()
+	for !it.Done() {
+		key, r := it.Next()
+		reg := r.(*raftRegister)
+		snapshot.Registers = append(snapshot.Registers, RaftSnapshotRegister{
+			Key:         key.(tla.TLAValue),
+			Value:       reg.value,
+			Version:     reg.version,
+			LockOwner:   reg.lockOwner,
+			LockExpires: reg.lockExpires,
+			LastOwner:   reg.lastOwner,
+		})
+	}
+	return snapshot
+}
+
+// maybeSnapshotLocked compacts the applied part of the log into a snapshot, once it holds enough entries.
+func (node *RaftNode) maybeSnapshotLocked() {
+	if node.config.snapshotThreshold <= 0 || node.lastApplied-node.snapshotIndex < node.config.snapshotThreshold {
+		return
+	}
+	term := node.termAtLocked(node.lastApplied)
+	// copied, so that the compacted entries can be freed
+	node.log = append([]RaftEntry{{Term: term}}, node.log[node.lastApplied-node.snapshotIndex+1:]...)
+	node.snapshotIndex, node.snapshotTerm = node.lastApplied, term
+	node.snapshotRegisters, node.snapshotClock = node.registers, node.appliedClock
+	node.persistSnapshotLocked()
+}
+
+// restoreSnapshotLocked makes snapshot the node's state, with log as the entries following it, sentinel included.
+// Proposals whose entries the snapshot replaces can no longer be told their results.
+func (node *RaftNode) restoreSnapshotLocked(snapshot *RaftSnapshot, log []RaftEntry) {
+	registers := immutable.NewMap(tla.TLAValueHasher{})
+	for _, reg := range snapshot.Registers {
+		registers = registers.Set(reg.Key, &raftRegister{
+			value:       reg.Value,
+			version:     reg.Version,
+			lockOwner:   reg.LockOwner,
+			lockExpires: reg.LockExpires,
+			lastOwner:   reg.LastOwner,
+		})
+	}
+	node.log = log
+	node.snapshotIndex, node.snapshotTerm = snapshot.Index, snapshot.Term
+	node.snapshotRegisters, node.snapshotClock = registers, snapshot.Clock
+	node.registers, node.appliedClock = registers, snapshot.Clock
+	if node.commitIndex < snapshot.Index {
+		node.commitIndex = snapshot.Index
+	}
+	node.lastApplied = snapshot.Index
+	for index, waiter := range node.waiters {
+		if index <= snapshot.Index {
+			delete(node.waiters, index)
+			close(waiter.result)
+		}
+	}
+}
+
+func (node *RaftNode) tickLoop() {
+	ticker := time.NewTicker(raftTickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-node.done:
+			return
+		case <-ticker.C:
+		}
+		node.lock.Lock()
+		switch node.role {
+		case raftLeader:
+			if time.Since(node.lastHeartbeat) >= node.config.heartbeatInterval {
+				node.broadcastAppendEntriesLocked()
+			}
+		default:
+			if time.Since(node.lastHeard) >= node.timeout {
+				node.startElectionLocked()
+			}
+		}
+		node.lock.Unlock()
+	}
+}
+
+func (node *RaftNode) startElectionLocked() {
+	node.role = raftCandidate
+	node.currentTerm++
+	node.votedFor = node.ID
+	node.votes = 1
+	node.leaderID = -1
+	node.persistLocked(node.lastIndexLocked() + 1)
+	node.resetElectionTimerLocked()
+	if node.votes*2 > len(node.peerAddrs) {
+		node.becomeLeaderLocked()
+		return
+	}
+	lastIndex, lastTerm := node.lastLogLocked()
+	args := RaftRequestVoteArgs{
+		Term:         node.currentTerm,
+		CandidateID:  node.ID,
+		LastLogIndex: lastIndex,
+		LastLogTerm:  lastTerm,
+	}
+	for peer := range node.peerAddrs {
+		if peer == node.ID {
+			continue
+		}
+		peer := peer
+		go func() {
+			var reply RaftRequestVoteReply
+			if node.call(peer, "RaftRPCReceiver.RequestVote", &args, &reply) != nil {
+				return
+			}
+			node.lock.Lock()
+			defer node.lock.Unlock()
+			if reply.Term > node.currentTerm {
+				node.becomeFollowerLocked(reply.Term)
+				return
+			}
+			if node.role != raftCandidate || node.currentTerm != args.Term || !reply.VoteGranted {
+				return
+			}
+			node.votes++
+			if node.votes*2 > len(node.peerAddrs) {
+				node.becomeLeaderLocked()
+			}
+		}()
+	}
+}
+
+func (node *RaftNode) becomeLeaderLocked() {
+	node.role = raftLeader
+	node.leaderID = node.ID
+	for peer := range node.peerAddrs {
+		node.nextIndex[peer] = node.lastIndexLocked() + 1
+		node.matchIndex[peer] = 0
+	}
+	// the group's clock goes on from the latest time stamped on an entry, which includes every committed one, so
+	// that it never runs backwards across leaders
+	node.clockBase, node.clockStart = node.appliedClock, time.Now()
+	for _, entry := range node.log {
+		if entry.Command.Time > node.clockBase {
+			node.clockBase = entry.Command.Time
+		}
+	}
+	// committing an entry of the new term also commits all previous entries
+	node.log = append(node.log, RaftEntry{
+		Term:    node.currentTerm,
+		Command: RaftCommand{Kind: raftCommandNoop, Time: node.clockLocked()},
+	})
+	node.persistLocked(node.lastIndexLocked())
+	node.matchIndex[node.ID] = node.lastIndexLocked()
+	node.resourceLogger.log(distsys.LogInfo, "Raft node became leader", "node", node.ID, "term", node.currentTerm)
+	node.broadcastAppendEntriesLocked()
+}
+
+func (node *RaftNode) broadcastAppendEntriesLocked() {
+	node.lastHeartbeat = time.Now()
+	if len(node.peerAddrs) == 1 {
+		node.advanceCommitIndexLocked()
+		return
+	}
+	for peer := range node.peerAddrs {
+		if peer == node.ID || node.inFlight[peer] {
+			continue
+		}
+		node.inFlight[peer] = true
+		peer := peer
+		prevIndex := node.nextIndex[peer] - 1
+		if prevIndex < node.snapshotIndex {
+			// the entries the follower needs next are compacted
+			go node.sendSnapshot(peer, RaftInstallSnapshotArgs{
+				Term:     node.currentTerm,
+				LeaderID: node.ID,
+				Snapshot: *node.snapshotLocked(),
+			})
+			continue
+		}
+		args := RaftAppendEntriesArgs{
+			Term:         node.currentTerm,
+			LeaderID:     node.ID,
+			PrevLogIndex: prevIndex,
+			PrevLogTerm:  node.termAtLocked(prevIndex),
+			Entries:      append([]RaftEntry(nil), node.log[prevIndex+1-node.snapshotIndex:]...),
+			LeaderCommit: node.commitIndex,
+		}
+		go func() {
+			var reply RaftAppendEntriesReply
+			err := node.call(peer, "RaftRPCReceiver.AppendEntries", &args, &reply)
+			node.lock.Lock()
+			defer node.lock.Unlock()
+			node.inFlight[peer] = false
+			if err != nil {
+				return
+			}
+			if reply.Term > node.currentTerm {
+				node.becomeFollowerLocked(reply.Term)
+				return
+			}
+			if node.role != raftLeader || node.currentTerm != args.Term {
+				return
+			}
+			if reply.Success {
+				match := args.PrevLogIndex + len(args.Entries)
+				if match > node.matchIndex[peer] {
+					node.matchIndex[peer] = match
+				}
+				node.nextIndex[peer] = node.matchIndex[peer] + 1
+				node.advanceCommitIndexLocked()
+			} else {
+				next := args.PrevLogIndex
+				if reply.LastLogIndex+1 < next {
+					next = reply.LastLogIndex + 1
+				}
+				if next < 1 {
+					next = 1
+				}
+				node.nextIndex[peer] = next
+			}
+		}()
+	}
+}
+
+// sendSnapshot sends the leader's snapshot to peer, in place of the entries it compacts.
+func (node *RaftNode) sendSnapshot(peer int, args RaftInstallSnapshotArgs) {
+	var reply RaftInstallSnapshotReply
+	err := node.call(peer, "RaftRPCReceiver.InstallSnapshot", &args, &reply)
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	node.inFlight[peer] = false
+	if err != nil {
+		return
+	}
+	if reply.Term > node.currentTerm {
+		node.becomeFollowerLocked(reply.Term)
+		return
+	}
+	if node.role != raftLeader || node.currentTerm != args.Term {
+		return
+	}
+	if args.Snapshot.Index > node.matchIndex[peer] {
+		node.matchIndex[peer] = args.Snapshot.Index
+	}
+	node.nextIndex[peer] = node.matchIndex[peer] + 1
+	node.advanceCommitIndexLocked()
+}
+
+// advanceCommitIndexLocked commits the latest entry of the current term replicated on a majority of the group.
+func (node *RaftNode) advanceCommitIndexLocked() {
+	node.matchIndex[node.ID] = node.lastIndexLocked()
+	for n := node.lastIndexLocked(); n > node.commitIndex; n-- {
+		if node.termAtLocked(n) != node.currentTerm {
+			break
+		}
+		count := 0
+		for _, match := range node.matchIndex {
+			if match >= n {
+				count++
+			}
+		}
+		if count*2 > len(node.peerAddrs) {
+			node.commitIndex = n
+			node.applyLocked()
+			return
+		}
+	}
+}
+
+func (node *RaftNode) applyLocked() {
+	for node.lastApplied < node.commitIndex {
+		node.lastApplied++
+		entry := node.log[node.lastApplied-node.snapshotIndex]
+		if entry.Command.Time > node.appliedClock {
+			node.appliedClock = entry.Command.Time
+		}
+		result := node.applyCommandLocked(entry.Command)
+		if waiter, ok := node.waiters[node.lastApplied]; ok {
+			delete(node.waiters, node.lastApplied)
+			if waiter.term == entry.Term {
+				waiter.result <- result
+			} else {
+				close(waiter.result) // the proposal was overwritten by another leader
+			}
+		}
+	}
+	node.maybeSnapshotLocked()
+}
+
+func (node *RaftNode) applyCommandLocked(cmd RaftCommand) RaftResult {
+	if cmd.Kind == raftCommandNoop {
+		return RaftResult{OK: true}
+	}
+	reg := &raftRegister{}
+	exists := false
+	if r, ok := node.registers.Get(cmd.Key); ok {
+		reg, exists = r.(*raftRegister), true
+	}
+	lockedByOther := reg.lockOwner != "" && reg.lockOwner != cmd.Owner && reg.lockExpires > cmd.Time
+	result := RaftResult{Exists: exists, Value: reg.value, Version: reg.version, Locked: lockedByOther}
+	switch cmd.Kind {
+	case raftCommandRead:
+		result.OK = !lockedByOther
+	case raftCommandPrepare:
+		if lockedByOther || (cmd.ExpectVersion >= 0 && cmd.ExpectVersion != reg.version) {
+			return result
+		}
+		updated := *reg
+		updated.lockOwner = cmd.Owner
+		updated.lockExpires = cmd.Time + int64(cmd.Lease)
+		node.registers = node.registers.Set(cmd.Key, &updated)
+		result.OK = true
+	case raftCommandCommit, raftCommandRelease:
+		if reg.lockOwner != cmd.Owner {
+			result.OK = reg.lastOwner == cmd.Owner
+			return result
+		}
+		updated := *reg
+		updated.lockOwner = ""
+		updated.lastOwner = cmd.Owner
+		switch {
+		case cmd.Kind == raftCommandCommit && cmd.Increment != 0:
+			current := tla.TLA_Zero
+			if _, err := reg.value.TryAsBigNumber(); err == nil {
+				current = reg.value
+			}
+			// promoted to a big number past 32 bits, rather than wrapping around, which would repeat IDs
+			updated.value = tla.TLA_PlusSymbol(current, tla.MakeTLANumber(cmd.Increment))
+			updated.version++
+		case cmd.Kind == raftCommandCommit && cmd.HasValue:
+			updated.value = cmd.Value
+			updated.version++
+		}
+		node.registers = node.registers.Set(cmd.Key, &updated)
+		result.OK = true
+		result.Value, result.Version = updated.value, updated.version
+	}
+	return result
+}
+
+// propose appends cmd to the log if this node is the leader, and waits for it to be applied.
+func (node *RaftNode) propose(cmd RaftCommand) (RaftResult, error) {
+	node.lock.Lock()
+	if node.isClosed() {
+		node.lock.Unlock()
+		return RaftResult{}, errRaftClosed
+	}
+	if node.role != raftLeader {
+		node.lock.Unlock()
+		return RaftResult{}, errRaftNotLeader
+	}
+	cmd.Time = node.clockLocked()
+	node.log = append(node.log, RaftEntry{Term: node.currentTerm, Command: cmd})
+	index := node.lastIndexLocked()
+	node.persistLocked(index)
+	waiter := raftWaiter{term: node.currentTerm, result: make(chan RaftResult, 1)}
+	node.waiters[index] = waiter
+	node.broadcastAppendEntriesLocked()
+	node.lock.Unlock()
+
+	select {
+	case result, ok := <-waiter.result:
+		if !ok {
+			return RaftResult{}, errRaftNotLeader
+		}
+		return result, nil
+	case <-time.After(node.config.proposalTimeout):
+		node.lock.Lock()
+		delete(node.waiters, index)
+		node.lock.Unlock()
+		return RaftResult{}, errRaftUnavailable
+	case <-node.done:
+		return RaftResult{}, errRaftUnavailable
+	}
+}
+
+// submit has cmd applied by the group, forwarding it to the leader if needed, and retrying across leader changes
+// until the proposal timeout.
+func (node *RaftNode) submit(cmd RaftCommand) (RaftResult, error) {
+	deadline := time.Now().Add(node.config.proposalTimeout)
+	for time.Now().Before(deadline) {
+		node.lock.Lock()
+		role, leaderID := node.role, node.leaderID
+		node.lock.Unlock()
+
+		var result RaftResult
+		var err error
+		switch {
+		case role == raftLeader:
+			result, err = node.propose(cmd)
+		case leaderID >= 0:
+			err = node.call(leaderID, "RaftRPCReceiver.Propose", &cmd, &result)
+		default:
+			err = errRaftNotLeader
+		}
+		if err == nil {
+			return result, nil
+		}
+		select {
+		case <-time.After(raftRetryWait):
+		case <-node.done:
+			return RaftResult{}, errRaftUnavailable
+		}
+	}
+	return RaftResult{}, errRaftUnavailable
+}
+
+func (node *RaftNode) call(peer int, method string, args interface{}, reply interface{}) error {
+	if faults := node.config.faults; faults != nil {
+		fault := faults.get(tla.MakeTLANumber(int32(node.ID)), tla.MakeTLANumber(int32(peer)))
+		if fault.Delay != nil {
+			time.Sleep(fault.Delay())
+		}
+		if faults.roll(fault.DropProbability) {
+			return errRaftDropped
+		}
+	}
+	node.lock.Lock()
+	client, ok := node.clients[peer]
+	node.lock.Unlock()
+	if !ok {
+		conn, err := net.DialTimeout("tcp", node.peerAddrs[peer], node.config.electionTimeout)
+		if err != nil {
+			return err
+		}
+		client = rpc.NewClient(conn)
+		node.lock.Lock()
+		if existing, ok := node.clients[peer]; ok {
+			_ = client.Close()
+			client = existing
+		} else {
+			node.clients[peer] = client
+		}
+		node.lock.Unlock()
+	}
+	timeout := node.config.electionTimeout
+	if method == "RaftRPCReceiver.Propose" {
+		timeout = node.config.proposalTimeout
+	}
+	call := client.Go(method, args, reply, nil)
+	var err error
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-time.After(timeout):
+		err = errors.New("raft call timed out")
+	}
+	if _, isServerErr := err.(rpc.ServerError); err != nil && !isServerErr {
+		node.lock.Lock()
+		if node.clients[peer] == client {
+			delete(node.clients, peer)
+		}
+		node.lock.Unlock()
+		_ = client.Close()
+	}
+	return err
+}
+
+type RaftRPCReceiver struct {
+	node *RaftNode
+}
+
+// RaftRequestVoteArgs are the arguments of a RequestVote RPC.
+type RaftRequestVoteArgs struct {
+	Term         int
+	CandidateID  int
+	LastLogIndex int
+	LastLogTerm  int
+}
+
+// RaftRequestVoteReply is the reply to a RequestVote RPC.
+type RaftRequestVoteReply struct {
+	Term        int
+	VoteGranted bool
+}
+
+// RequestVote grants the candidate this node's vote for args.Term, unless it already voted for another candidate,
+// or its log is more up to date than the candidate's.
+func (rcvr *RaftRPCReceiver) RequestVote(args RaftRequestVoteArgs, reply *RaftRequestVoteReply) error {
+	node := rcvr.node
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	if node.isClosed() {
+		return errRaftClosed
+	}
+	if args.Term > node.currentTerm {
+		node.becomeFollowerLocked(args.Term)
+	}
+	reply.Term = node.currentTerm
+	if args.Term < node.currentTerm || (node.votedFor != -1 && node.votedFor != args.CandidateID) {
+		return nil
+	}
+	lastIndex, lastTerm := node.lastLogLocked()
+	if args.LastLogTerm < lastTerm || (args.LastLogTerm == lastTerm && args.LastLogIndex < lastIndex) {
+		return nil
+	}
+	node.votedFor = args.CandidateID
+	node.persistLocked(node.lastIndexLocked() + 1)
+	node.resetElectionTimerLocked()
+	reply.VoteGranted = true
+	return nil
+}
+
+// RaftAppendEntriesArgs are the arguments of an AppendEntries RPC.
+type RaftAppendEntriesArgs struct {
+	Term         int
+	LeaderID     int
+	PrevLogIndex int
+	PrevLogTerm  int
+	Entries      []RaftEntry
+	LeaderCommit int
+}
+
+// RaftAppendEntriesReply is the reply to an AppendEntries RPC. LastLogIndex helps the leader find where the logs
+// diverge when Success is false.
+type RaftAppendEntriesReply struct {
+	Term         int
+	Success      bool
+	LastLogIndex int
+}
+
+// AppendEntries replicates the leader's log entries following args.PrevLogIndex, if this node's log matches the
+// leader's up to there.
+func (rcvr *RaftRPCReceiver) AppendEntries(args RaftAppendEntriesArgs, reply *RaftAppendEntriesReply) error {
+	node := rcvr.node
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	if node.isClosed() {
+		return errRaftClosed
+	}
+	reply.Term = node.currentTerm
+	reply.LastLogIndex = node.lastIndexLocked()
+	if args.Term < node.currentTerm {
+		return nil
+	}
+	node.becomeFollowerLocked(args.Term)
+	node.leaderID = args.LeaderID
+	node.resetElectionTimerLocked()
+	reply.Term = node.currentTerm
+
+	// entries up to the snapshot are committed, so they match the leader's
+	prevIndex, prevTerm, entries := args.PrevLogIndex, args.PrevLogTerm, args.Entries
+	if prevIndex < node.snapshotIndex {
+		skipped := node.snapshotIndex - prevIndex
+		if skipped > len(entries) {
+			skipped = len(entries)
+		}
+		prevIndex, prevTerm, entries = node.snapshotIndex, node.snapshotTerm, entries[skipped:]
+	}
+	if prevIndex > node.lastIndexLocked() || node.termAtLocked(prevIndex) != prevTerm {
+		return nil
+	}
+	firstChanged := -1
+	for i, entry := range entries {
+		index := prevIndex + 1 + i
+		if index <= node.lastIndexLocked() {
+			if node.termAtLocked(index) == entry.Term {
+				continue
+			}
+			node.log = node.log[:index-node.snapshotIndex]
+		}
+		if firstChanged < 0 {
+			firstChanged = index
+		}
+		node.log = append(node.log, entry)
+	}
+	if firstChanged >= 0 {
+		node.persistLocked(firstChanged)
+	}
+	// only entries up to lastNew are known to match the leader's log; a stale or reordered call may report a commit
+	// index past them, and must not move the commit index backwards either
+	lastNew := args.PrevLogIndex + len(args.Entries)
+	commit := args.LeaderCommit
+	if lastNew < commit {
+		commit = lastNew
+	}
+	if commit > node.commitIndex {
+		node.commitIndex = commit
+		node.applyLocked()
+	}
+	reply.Success = true
+	reply.LastLogIndex = node.lastIndexLocked()
+	return nil
+}
+
+// RaftInstallSnapshotArgs are the arguments of an InstallSnapshot RPC.
+type RaftInstallSnapshotArgs struct {
+	Term     int
+	LeaderID int
+	Snapshot RaftSnapshot
+}
+
+// RaftInstallSnapshotReply is the reply to an InstallSnapshot RPC.
+type RaftInstallSnapshotReply struct {
+	Term int
+}
+
+// InstallSnapshot replaces this node's state up to the end of the leader's snapshot with the snapshot, keeping the
+// log entries that follow it, if the log matches the leader's there.
+func (rcvr *RaftRPCReceiver) InstallSnapshot(args RaftInstallSnapshotArgs, reply *RaftInstallSnapshotReply) error {
+	node := rcvr.node
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	if node.isClosed() {
+		return errRaftClosed
+	}
+	reply.Term = node.currentTerm
+	if args.Term < node.currentTerm {
+		return nil
+	}
+	node.becomeFollowerLocked(args.Term)
+	node.leaderID = args.LeaderID
+	node.resetElectionTimerLocked()
+	reply.Term = node.currentTerm
+
+	snapshot := &args.Snapshot
+	if snapshot.Index <= node.lastApplied {
+		return nil // this node has applied everything the snapshot holds already
+	}
+	log := []RaftEntry{{Term: snapshot.Term}}
+	if snapshot.Index <= node.lastIndexLocked() && node.termAtLocked(snapshot.Index) == snapshot.Term {
+		log = append(log, node.log[snapshot.Index-node.snapshotIndex+1:]...)
+	}
+	node.restoreSnapshotLocked(snapshot, log)
+	node.persistSnapshotLocked()
+	node.applyLocked()
+	return nil
+}
+
+// Propose applies a command on behalf of a follower. It fails unless this node is the leader.
+func (rcvr *RaftRPCReceiver) Propose(cmd RaftCommand, reply *RaftResult) error {
+	result, err := rcvr.node.propose(cmd)
+	if err != nil {
+		return err
+	}
+	*reply = result
+	return nil
+}
+
+// RaftSharedValueMaker produces a distsys.ArchetypeResourceMaker for a single value shared by every archetype
+// using a resource with the same name, on any node of node's group. The value starts as initial.
+//
+// Within a critical section, the first read fetches the value through the group's log, so it is linearizable.
+// At pre-commit, the resource locks the value, checking that no other critical section wrote it since it was
+// read; if one did, the critical section aborts and retries. The write, if any, is applied at commit, releasing the
+// lock. Critical sections touching several shared values thus behave atomically with respect to each other. Reads
+// of a value locked by another critical section abort, and retry later.
+func RaftSharedValueMaker(node *RaftNode, name string, initial tla.TLAValue) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		return &raftSharedValue{
+			node:    node,
+			key:     tla.MakeTLATuple(tla.MakeTLAString(name)),
+			initial: initial,
+		}
+	})
+}
+
+// RaftSharedMapMaker is like RaftSharedValueMaker, but for a map of shared values, each of which starts as initial.
+func RaftSharedMapMaker(node *RaftNode, name string, initial tla.TLAValue) distsys.ArchetypeResourceMaker {
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+			return &raftSharedValue{
+				node:    node,
+				key:     tla.MakeTLATuple(tla.MakeTLAString(name), index),
+				initial: initial,
+			}
+		})
+	})
+}
+
+type raftSharedValue struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	node    *RaftNode
+	key     tla.TLAValue
+	initial tla.TLAValue
+
+	hasRead      bool
+	readVersion  int64
+	value        tla.TLAValue
+	writePending bool
+	// increment is added to the value at commit, instead of writing it, when non-zero; see RaftCounterMaker
+	increment int32
+	owner     string // non-empty while this resource holds the value's lock
+}
+
+var _ distsys.ArchetypeResource = &raftSharedValue{}
+
+func (res *raftSharedValue) reset() {
+	res.hasRead = false
+	res.value = tla.TLAValue{}
+	res.writePending = false
+	res.increment = 0
+	res.owner = ""
+}
+
+func (res *raftSharedValue) Abort() chan struct{} {
+	if res.owner == "" {
+		res.reset()
+		return nil
+	}
+	ch := make(chan struct{}, 1)
+	go func() {
+		_, err := res.node.submit(RaftCommand{Kind: raftCommandRelease, Key: res.key, Owner: res.owner})
+		if err != nil {
+			res.log(distsys.LogWarn, "could not release Raft lock, it will expire", "key", res.key, "error", err)
+		}
+		res.reset()
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+func (res *raftSharedValue) PreCommit() chan error {
+	if !res.hasRead && !res.writePending {
+		return nil
+	}
+	expectVersion := int64(-1)
+	if res.hasRead {
+		expectVersion = res.readVersion
+	}
+	return res.prepare(expectVersion)
+}
+
+// prepare locks the value, checking that its version is expectVersion, unless expectVersion is -1.
+func (res *raftSharedValue) prepare(expectVersion int64) chan error {
+	ch := make(chan error, 1)
+	go func() {
+		owner := res.node.newOwner()
+		result, err := res.node.submit(RaftCommand{
+			Kind:          raftCommandPrepare,
+			Key:           res.key,
+			Owner:         owner,
+			ExpectVersion: expectVersion,
+			Lease:         res.node.config.lockLease,
+		})
+		if err != nil {
+			res.log(distsys.LogWarn, "could not acquire Raft lock, aborting", "key", res.key, "error", err)
+			ch <- distsys.ErrCriticalSectionAborted
+			return
+		}
+		if !result.OK {
+			ch <- distsys.ErrCriticalSectionAborted
+			return
+		}
+		res.owner = owner
+		ch <- nil
+	}()
+	return ch
+}
+
+func (res *raftSharedValue) Commit() chan struct{} {
+	if res.owner == "" {
+		res.reset()
+		return nil
+	}
+	ch := make(chan struct{}, 1)
+	go func() {
+		cmd := RaftCommand{Kind: raftCommandCommit, Key: res.key, Owner: res.owner}
+		switch {
+		case res.increment != 0:
+			cmd.Increment = res.increment
+		case res.writePending:
+			cmd.HasValue = true
+			cmd.Value = res.value
+		}
+		for {
+			result, err := res.node.submit(cmd)
+			if err == nil {
+				if !result.OK {
+					panic(fmt.Errorf("lock on shared value %v expired before commit; increase the lock lease", res.key))
+				}
+				break
+			}
+			res.log(distsys.LogWarn, "could not commit to Raft, retrying", "key", res.key, "error", err)
+		}
+		res.reset()
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+func (res *raftSharedValue) ReadValue() (tla.TLAValue, error) {
+	if res.writePending || res.hasRead {
+		return res.value, nil
+	}
+	return res.fetch()
+}
+
+// fetch reads the value through the log, so that the read is linearizable.
+func (res *raftSharedValue) fetch() (tla.TLAValue, error) {
+	result, err := res.node.submit(RaftCommand{Kind: raftCommandRead, Key: res.key})
+	if err != nil {
+		res.log(distsys.LogWarn, "could not read from Raft, aborting", "key", res.key, "error", err)
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	}
+	if result.Locked {
+		time.Sleep(raftLockedWait)
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	}
+	res.hasRead = true
+	res.readVersion = result.Version
+	if result.Exists {
+		res.value = result.Value
+	} else {
+		res.value = res.initial
+	}
+	return res.value, nil
+}
+
+func (res *raftSharedValue) WriteValue(value tla.TLAValue) error {
+	res.value = value
+	res.writePending = true
+	return nil
+}
+
+func (res *raftSharedValue) Close() error {
+	return nil
+}
+
+// raftStateRecord is one record of a Raft node's state file: the term and vote became Term and VotedFor, and the log
+// entries from index From onward were replaced by Entries. If Snapshot is set, it replaced the log up to its index
+// first.
+type raftStateRecord struct {
+	Term     int
+	VotedFor int
+	Snapshot *RaftSnapshot
+	From     int
+	Entries  []RaftEntry
+}
+
+// raftStateFile is the append-only file a RaftNode keeps its state in; see WithRaftStatePath.
+type raftStateFile struct {
+	path    string
+	file    *os.File
+	records int
+}
+
+// openRaftStateFile opens the state file at path, creating it if needed, and returns the state it holds as a single
+// record. A partially written record at the end of the file, left by a crash, is discarded: it cannot have been
+// acted upon.
+func openRaftStateFile(path string) (*raftStateFile, raftStateRecord, error) {
+	state := &raftStateFile{path: path}
+	record := raftStateRecord{VotedFor: -1, From: 1}
+	var err error
+	state.file, err = distsys.OpenLogFile(path, func(r *distsys.LogRecordReader) error {
+		return state.replay(r, &record)
+	})
+	if err != nil {
+		return nil, raftStateRecord{}, err
+	}
+	return state, record, nil
+}
+
+// replay applies the records in the file to result.
+func (state *raftStateFile) replay(r *distsys.LogRecordReader, result *raftStateRecord) error {
+	for {
+		var record raftStateRecord
+		err := r.Next(&record)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if record.Snapshot != nil {
+			result.Snapshot, result.Entries = record.Snapshot, nil
+		}
+		first := 1 // the index of result.Entries[0]
+		if result.Snapshot != nil {
+			first = result.Snapshot.Index + 1
+		}
+		if record.From < first || record.From > first+len(result.Entries) {
+			return fmt.Errorf("corrupt Raft state file %s: entries replaced from index %d, outside of the log", state.path, record.From)
+		}
+		result.Term, result.VotedFor = record.Term, record.VotedFor
+		result.Entries = append(result.Entries[:record.From-first], record.Entries...)
+		result.From = first
+		state.records++
+	}
+}
+
+// append durably logs record.
+func (state *raftStateFile) append(record raftStateRecord) error {
+	err := distsys.WriteLogRecord(state.file, &record)
+	if err == nil {
+		err = state.file.Sync()
+	}
+	if err != nil {
+		return err
+	}
+	state.records++
+	return nil
+}
+
+// needsCompaction returns whether the file holds enough records to be worth compacting, for a log of logLen
+// entries.
+func (state *raftStateFile) needsCompaction(logLen int) bool {
+	return state.records > raftStateCompactionThreshold && state.records > 2*logLen
+}
+
+// compact atomically replaces the file with the single record.
+func (state *raftStateFile) compact(record raftStateRecord) error {
+	f, err := distsys.ReplaceLogFile(state.path, &record)
+	if err != nil {
+		return err
+	}
+	_ = state.file.Close()
+	state.file = f
+	state.records = 1
+	return nil
+}
+
+func (state *raftStateFile) close() error {
+	return state.file.Close()
+}
@@ -0,0 +1,529 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+var raftTestOptions = []RaftOption{
+	WithRaftElectionTimeout(100 * time.Millisecond),
+	WithRaftHeartbeatInterval(20 * time.Millisecond),
+	WithRaftProposalTimeout(2 * time.Second),
+}
+
+// startRaftTestNode starts node, closing it when the test ends unless the test closed it already.
+func startRaftTestNode(t *testing.T, node *RaftNode) {
+	t.Helper()
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- node.ListenAndServe()
+	}()
+	t.Cleanup(func() {
+		if !node.isClosed() {
+			_ = node.Close()
+		}
+		if err := <-serveErr; err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+// startRaftTestGroup starts a group of size nodes, each given the options returned by opts for its ID.
+func startRaftTestGroup(t *testing.T, size int, opts func(id int) []RaftOption) (nodes []*RaftNode, addrs []string) {
+	t.Helper()
+	for i := 0; i < size; i++ {
+		addrs = append(addrs, freeLocalAddr(t))
+	}
+	for id := range addrs {
+		node := NewRaftNode(id, addrs, append(raftTestOptions, opts(id)...)...)
+		startRaftTestNode(t, node)
+		nodes = append(nodes, node)
+	}
+	return nodes, addrs
+}
+
+func noRaftTestOptions(int) []RaftOption {
+	return nil
+}
+
+// awaitRaftLeader waits for one of nodes to be the leader of a term at least minTerm, and checks that no other of
+// nodes leads the same term.
+func awaitRaftLeader(t *testing.T, nodes []*RaftNode, minTerm int) *RaftNode {
+	t.Helper()
+	var leader *RaftNode
+	awaitCondition(t, "a leader to be elected", func() bool {
+		leaders := make(map[int]int)
+		leader = nil
+		for _, node := range nodes {
+			node.lock.Lock()
+			if node.role == raftLeader && node.currentTerm >= minTerm {
+				leaders[node.currentTerm]++
+				leader = node
+			}
+			node.lock.Unlock()
+		}
+		for term, count := range leaders {
+			if count > 1 {
+				t.Fatalf("%d nodes lead term %d", count, term)
+			}
+		}
+		return leader != nil
+	})
+	return leader
+}
+
+func raftTerm(node *RaftNode) int {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	return node.currentTerm
+}
+
+// raftTestWrite writes value to key through node, as a critical section would.
+func raftTestWrite(t *testing.T, node *RaftNode, key, value tla.TLAValue) {
+	t.Helper()
+	owner := node.newOwner()
+	result, err := node.submit(RaftCommand{Kind: raftCommandPrepare, Key: key, Owner: owner, ExpectVersion: -1, Lease: time.Minute})
+	if err != nil || !result.OK {
+		t.Fatalf("could not lock %v: %+v, %v", key, result, err)
+	}
+	result, err = node.submit(RaftCommand{Kind: raftCommandCommit, Key: key, Owner: owner, HasValue: true, Value: value})
+	if err != nil || !result.OK {
+		t.Fatalf("could not write %v: %+v, %v", key, result, err)
+	}
+}
+
+// raftTestRead reads key through node's log.
+func raftTestRead(t *testing.T, node *RaftNode, key tla.TLAValue) tla.TLAValue {
+	t.Helper()
+	result, err := node.submit(RaftCommand{Kind: raftCommandRead, Key: key})
+	if err != nil || !result.OK {
+		t.Fatalf("could not read %v: %+v, %v", key, result, err)
+	}
+	return result.Value
+}
+
+func TestRaftElection(t *testing.T) {
+	nodes, _ := startRaftTestGroup(t, 3, noRaftTestOptions)
+	key := tla.MakeTLAString("x")
+	leader := awaitRaftLeader(t, nodes, 0)
+	raftTestWrite(t, leader, key, tla.MakeTLANumber(1))
+
+	// the leader crashes: the others elect a new one for a later term, which still has the write
+	term := raftTerm(leader)
+	if err := leader.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var survivors []*RaftNode
+	for _, node := range nodes {
+		if node != leader {
+			survivors = append(survivors, node)
+		}
+	}
+	newLeader := awaitRaftLeader(t, survivors, term+1)
+	var follower *RaftNode
+	for _, node := range survivors {
+		if node != newLeader {
+			follower = node
+		}
+	}
+	// going through the follower also checks that it forwards proposals to the new leader
+	if value := raftTestRead(t, follower, key); !value.Equal(tla.MakeTLANumber(1)) {
+		t.Fatalf("expected the new leader to have kept the write of 1, read %v", value)
+	}
+	raftTestWrite(t, follower, key, tla.MakeTLANumber(2))
+	if value := raftTestRead(t, newLeader, key); !value.Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected to read 2, read %v", value)
+	}
+}
+
+func TestRaftPartitionAndRejoin(t *testing.T) {
+	faults := NewNetworkFaults(0)
+	nodes, _ := startRaftTestGroup(t, 3, func(int) []RaftOption {
+		return []RaftOption{WithRaftNetworkFaults(faults), WithRaftProposalTimeout(500 * time.Millisecond)}
+	})
+	key := tla.MakeTLAString("x")
+	oldLeader := awaitRaftLeader(t, nodes, 0)
+	raftTestWrite(t, oldLeader, key, tla.MakeTLANumber(1))
+
+	var majority []*RaftNode
+	var majorityIDs []tla.TLAValue
+	for _, node := range nodes {
+		if node != oldLeader {
+			majority = append(majority, node)
+			majorityIDs = append(majorityIDs, tla.MakeTLANumber(int32(node.ID)))
+		}
+	}
+	faults.Partition([]tla.TLAValue{tla.MakeTLANumber(int32(oldLeader.ID))}, majorityIDs)
+
+	// the isolated leader still takes a proposal, but cannot commit it: it must not win over what the majority does
+	oldTerm := raftTerm(oldLeader)
+	oldLeader.lock.Lock()
+	divergentIndex := len(oldLeader.log)
+	oldLeader.lock.Unlock()
+	staleResult := make(chan error, 1)
+	go func() {
+		_, err := oldLeader.propose(RaftCommand{
+			Kind: raftCommandPrepare, Key: key, Owner: "stale", ExpectVersion: -1, Lease: time.Hour,
+		})
+		staleResult <- err
+	}()
+
+	newLeader := awaitRaftLeader(t, majority, oldTerm+1)
+	raftTestWrite(t, newLeader, key, tla.MakeTLANumber(2))
+	if err := <-staleResult; !errors.Is(err, errRaftUnavailable) {
+		t.Fatalf("expected the isolated leader's proposal to time out, got %v", err)
+	}
+	if !oldLeader.IsLeader() {
+		t.Fatal("expected the isolated leader not to notice the new leader while partitioned")
+	}
+
+	faults.Heal()
+	awaitCondition(t, "the old leader to rejoin as a follower", func() bool {
+		oldLeader.lock.Lock()
+		defer oldLeader.lock.Unlock()
+		return oldLeader.role == raftFollower && oldLeader.currentTerm > oldTerm
+	})
+	// the old leader's divergent entry is overwritten, and the logs agree up to the commit index
+	awaitCondition(t, "the logs to converge", func() bool {
+		newLeader.lock.Lock()
+		leaderLog, leaderCommit := append([]RaftEntry(nil), newLeader.log...), newLeader.commitIndex
+		newLeader.lock.Unlock()
+		oldLeader.lock.Lock()
+		defer oldLeader.lock.Unlock()
+		if oldLeader.commitIndex < leaderCommit {
+			return false
+		}
+		for i := 1; i <= leaderCommit; i++ {
+			entry, leaderEntry := oldLeader.log[i], leaderLog[i]
+			if entry.Term != leaderEntry.Term || entry.Command.Kind != leaderEntry.Command.Kind || entry.Command.Owner != leaderEntry.Command.Owner {
+				t.Fatalf("logs differ at committed index %d: %+v and %+v", i, entry, leaderEntry)
+			}
+		}
+		return true
+	})
+	oldLeader.lock.Lock()
+	divergentTerm := oldLeader.log[divergentIndex].Term
+	oldLeader.lock.Unlock()
+	if divergentTerm == oldTerm {
+		t.Fatalf("expected the divergent entry at index %d to be replaced", divergentIndex)
+	}
+	// the stale lock was never applied, so the group can go on writing, through the old leader too
+	raftTestWrite(t, oldLeader, key, tla.MakeTLANumber(3))
+	if value := raftTestRead(t, newLeader, key); !value.Equal(tla.MakeTLANumber(3)) {
+		t.Fatalf("expected to read 3, read %v", value)
+	}
+}
+
+func TestRaftCommitIndexIsMonotonic(t *testing.T) {
+	node := NewRaftNode(1, []string{"127.0.0.1:0", "127.0.0.1:0"})
+	rcvr := &RaftRPCReceiver{node: node}
+	var reply RaftAppendEntriesReply
+	err := rcvr.AppendEntries(RaftAppendEntriesArgs{
+		Term:         1,
+		Entries:      []RaftEntry{{Term: 1}, {Term: 1}, {Term: 1}, {Term: 1}},
+		LeaderCommit: 3,
+	}, &reply)
+	if err != nil || !reply.Success || node.commitIndex != 3 {
+		t.Fatalf("expected the entries to be committed up to 3, got commit index %d, %+v, %v", node.commitIndex, reply, err)
+	}
+
+	// a delayed heartbeat, checking the log only up to index 1, but carrying a later commit index
+	err = rcvr.AppendEntries(RaftAppendEntriesArgs{
+		Term:         1,
+		PrevLogIndex: 1,
+		PrevLogTerm:  1,
+		LeaderCommit: 4,
+	}, &reply)
+	if err != nil || !reply.Success {
+		t.Fatalf("expected the heartbeat to succeed, got %+v, %v", reply, err)
+	}
+	if node.commitIndex != 3 || node.lastApplied != 3 {
+		t.Fatalf("expected the commit index to stay at 3, got %d (applied %d)", node.commitIndex, node.lastApplied)
+	}
+}
+
+func TestRaftLockLeaseExpiry(t *testing.T) {
+	const lease = 500 * time.Millisecond
+	nodes, _ := startRaftTestGroup(t, 3, func(int) []RaftOption {
+		return []RaftOption{WithRaftLockLease(lease)}
+	})
+	leader := awaitRaftLeader(t, nodes, 0)
+	var crashing, other *RaftNode
+	for _, node := range nodes {
+		if node != leader {
+			if crashing == nil {
+				crashing = node
+			} else {
+				other = node
+			}
+		}
+	}
+	key := tla.MakeTLATuple(tla.MakeTLAString("x"))
+	raftTestWrite(t, leader, key, tla.MakeTLANumber(1))
+
+	// a critical section on crashing's process locks the value, then its process crashes before the commit
+	res := &raftSharedValue{node: crashing, key: key, initial: tla.MakeTLANumber(0)}
+	if _, err := res.ReadValue(); err != nil {
+		t.Fatal(err)
+	}
+	if err := res.WriteValue(tla.MakeTLANumber(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-res.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	lockedAt := time.Now()
+	if err := crashing.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// other processes cannot use the value until the lease expires, and then see that the write never happened
+	blocked := &raftSharedValue{node: other, key: key, initial: tla.MakeTLANumber(0)}
+	var value tla.TLAValue
+	awaitCondition(t, "the lock to expire", func() bool {
+		var err error
+		value, err = blocked.ReadValue()
+		if err != nil && !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatal(err)
+		}
+		return err == nil
+	})
+	if elapsed := time.Since(lockedAt); elapsed < lease/2 {
+		t.Fatalf("the value was readable %v after it was locked, well before the lease of %v expired", elapsed, lease)
+	}
+	if !value.Equal(tla.MakeTLANumber(1)) {
+		t.Fatalf("expected the uncommitted write to be lost, read %v", value)
+	}
+	if err := blocked.WriteValue(tla.MakeTLANumber(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-blocked.PreCommit(); err != nil {
+		t.Fatalf("expected the expired lock to be taken over, got %v", err)
+	}
+	<-blocked.Commit()
+	if value := raftTestRead(t, leader, key); !value.Equal(tla.MakeTLANumber(3)) {
+		t.Fatalf("expected to read 3, read %v", value)
+	}
+}
+
+func TestRaftRestart(t *testing.T) {
+	dir := t.TempDir()
+	statePath := func(id int) string {
+		return filepath.Join(dir, fmt.Sprintf("raft-%d", id))
+	}
+	nodes, addrs := startRaftTestGroup(t, 3, func(id int) []RaftOption {
+		return []RaftOption{WithRaftStatePath(statePath(id))}
+	})
+	key := tla.MakeTLAString("x")
+	leader := awaitRaftLeader(t, nodes, 0)
+	raftTestWrite(t, leader, key, tla.MakeTLANumber(1))
+	raftTestWrite(t, leader, key, tla.MakeTLANumber(2))
+
+	// the whole group crashes, then restarts from its state files
+	terms := make([]int, len(nodes))
+	logLengths := make([]int, len(nodes))
+	for i, node := range nodes {
+		if err := node.Close(); err != nil {
+			t.Fatal(err)
+		}
+		node.lock.Lock()
+		terms[i], logLengths[i] = node.currentTerm, len(node.log)
+		node.lock.Unlock()
+	}
+	var restarted []*RaftNode
+	for id := range addrs {
+		node := NewRaftNode(id, addrs, append(raftTestOptions, WithRaftStatePath(statePath(id)))...)
+		startRaftTestNode(t, node)
+		restarted = append(restarted, node)
+	}
+	for i, node := range restarted {
+		node := node
+		awaitCondition(t, "the node to restore its state", func() bool {
+			node.lock.Lock()
+			defer node.lock.Unlock()
+			return node.currentTerm >= terms[i] && len(node.log) >= logLengths[i]
+		})
+	}
+	leader = awaitRaftLeader(t, restarted, 0)
+	if value := raftTestRead(t, leader, key); !value.Equal(tla.MakeTLANumber(2)) {
+		t.Fatalf("expected the committed write of 2 to survive the restart, read %v", value)
+	}
+}
+
+// raftTestRegister returns the value node's state machine holds for key, whether or not it is the leader.
+func raftTestRegister(node *RaftNode, key tla.TLAValue) (tla.TLAValue, bool) {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	reg, ok := node.registers.Get(key)
+	if !ok {
+		return tla.TLAValue{}, false
+	}
+	return reg.(*raftRegister).value, true
+}
+
+func TestRaftSnapshotCatchUp(t *testing.T) {
+	const threshold, writes = 8, 20
+	faults := NewNetworkFaults(0)
+	nodes, _ := startRaftTestGroup(t, 3, func(int) []RaftOption {
+		return []RaftOption{WithRaftNetworkFaults(faults), WithRaftSnapshotThreshold(threshold)}
+	})
+	leader := awaitRaftLeader(t, nodes, 0)
+	var lagging *RaftNode
+	var othersIDs []tla.TLAValue
+	for _, node := range nodes {
+		if node != leader && lagging == nil {
+			lagging = node
+		} else {
+			othersIDs = append(othersIDs, tla.MakeTLANumber(int32(node.ID)))
+		}
+	}
+
+	// while one follower is cut off, the others go on, and compact their logs past what it has
+	faults.Partition([]tla.TLAValue{tla.MakeTLANumber(int32(lagging.ID))}, othersIDs)
+	for i := 0; i < writes; i++ {
+		raftTestWrite(t, leader, tla.MakeTLANumber(int32(i)), tla.MakeTLANumber(int32(i)))
+	}
+	leader.lock.Lock()
+	snapshotIndex, logLen := leader.snapshotIndex, len(leader.log)
+	leader.lock.Unlock()
+	if snapshotIndex == 0 || logLen > threshold+1 {
+		t.Fatalf("expected the leader's log to be compacted, got a snapshot at %d and %d entries", snapshotIndex, logLen)
+	}
+
+	// once the partition heals, the leader sends the follower its snapshot, then the entries that follow it
+	faults.Heal()
+	awaitCondition(t, "the lagging follower to catch up", func() bool {
+		leader.lock.Lock()
+		commitIndex := leader.commitIndex
+		leader.lock.Unlock()
+		lagging.lock.Lock()
+		defer lagging.lock.Unlock()
+		return lagging.snapshotIndex > 0 && lagging.lastApplied >= commitIndex
+	})
+	for i := 0; i < writes; i++ {
+		key := tla.MakeTLANumber(int32(i))
+		if value, ok := raftTestRegister(lagging, key); !ok || !value.Equal(key) {
+			t.Fatalf("expected the lagging follower to hold %v for %v, got %v", key, key, value)
+		}
+	}
+
+	// the leader crashes: whichever node takes over, from its snapshot and log, still has every write
+	term := raftTerm(leader)
+	if err := leader.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var survivors []*RaftNode
+	for _, node := range nodes {
+		if node != leader {
+			survivors = append(survivors, node)
+		}
+	}
+	newLeader := awaitRaftLeader(t, survivors, term+1)
+	for i := 0; i < writes; i++ {
+		key := tla.MakeTLANumber(int32(i))
+		if value := raftTestRead(t, newLeader, key); !value.Equal(key) {
+			t.Fatalf("expected to read %v for %v, read %v", key, key, value)
+		}
+	}
+	raftTestWrite(t, newLeader, tla.MakeTLANumber(0), tla.MakeTLANumber(writes))
+	if value := raftTestRead(t, lagging, tla.MakeTLANumber(0)); !value.Equal(tla.MakeTLANumber(writes)) {
+		t.Fatalf("expected to read %d, read %v", writes, value)
+	}
+}
+
+func TestRaftSnapshotRestart(t *testing.T) {
+	const threshold, writes = 4, 10
+	dir := t.TempDir()
+	options := func(id int) []RaftOption {
+		return []RaftOption{
+			WithRaftStatePath(filepath.Join(dir, fmt.Sprintf("raft-%d", id))),
+			WithRaftSnapshotThreshold(threshold),
+		}
+	}
+	nodes, addrs := startRaftTestGroup(t, 3, options)
+	leader := awaitRaftLeader(t, nodes, 0)
+	for i := 0; i < writes; i++ {
+		raftTestWrite(t, leader, tla.MakeTLANumber(int32(i)), tla.MakeTLANumber(int32(i)))
+	}
+	for _, node := range nodes {
+		if err := node.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	leader.lock.Lock()
+	snapshotIndex := leader.snapshotIndex
+	leader.lock.Unlock()
+
+	// the restarted leader restores its snapshot before anything else
+	var restarted []*RaftNode
+	for id := range addrs {
+		node := NewRaftNode(id, addrs, append(raftTestOptions, options(id)...)...)
+		startRaftTestNode(t, node)
+		restarted = append(restarted, node)
+	}
+	restartedLeader := restarted[leader.ID]
+	awaitCondition(t, "the node to restore its snapshot", func() bool {
+		restartedLeader.lock.Lock()
+		defer restartedLeader.lock.Unlock()
+		return restartedLeader.snapshotIndex >= snapshotIndex && restartedLeader.lastApplied >= snapshotIndex
+	})
+	leader = awaitRaftLeader(t, restarted, 0)
+	for i := 0; i < writes; i++ {
+		key := tla.MakeTLANumber(int32(i))
+		if value := raftTestRead(t, leader, key); !value.Equal(key) {
+			t.Fatalf("expected the write of %v to %v to survive the restart, read %v", key, key, value)
+		}
+	}
+}
+
+func TestRaftClockAcrossLeaderChange(t *testing.T) {
+	const lease = 500 * time.Millisecond
+	nodes, _ := startRaftTestGroup(t, 3, noRaftTestOptions)
+	leader := awaitRaftLeader(t, nodes, 0)
+	key := tla.MakeTLATuple(tla.MakeTLAString("x"))
+
+	// the leader locks the value on behalf of a critical section, then crashes
+	result, err := leader.submit(RaftCommand{
+		Kind: raftCommandPrepare, Key: key, Owner: "crashed", ExpectVersion: -1, Lease: lease,
+	})
+	if err != nil || !result.OK {
+		t.Fatalf("could not lock %v: %+v, %v", key, result, err)
+	}
+	lockedAt := time.Now()
+	term := raftTerm(leader)
+	if err := leader.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var survivors []*RaftNode
+	for _, node := range nodes {
+		if node != leader {
+			survivors = append(survivors, node)
+		}
+	}
+	newLeader := awaitRaftLeader(t, survivors, term+1)
+
+	// the new leader's clock goes on from the old one's, so the lock is held for the whole lease, but no longer
+	awaitCondition(t, "the lock to expire", func() bool {
+		result, err := newLeader.submit(RaftCommand{Kind: raftCommandRead, Key: key})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return !result.Locked
+	})
+	if elapsed := time.Since(lockedAt); elapsed < lease {
+		t.Fatalf("the lock expired %v after it was taken, before its lease of %v", elapsed, lease)
+	}
+	newLeader.lock.Lock()
+	defer newLeader.lock.Unlock()
+	for i := 1; i < len(newLeader.log); i++ {
+		if newLeader.log[i].Command.Time < newLeader.log[i-1].Command.Time {
+			t.Fatalf("the group's clock ran backwards, from entry %+v to %+v", newLeader.log[i-1], newLeader.log[i])
+		}
+	}
+}
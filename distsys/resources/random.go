@@ -0,0 +1,187 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ErrRandomReplayExhausted is returned when a random value resource replaying a RandomRecording needs more draws
+// than were recorded, which means the replayed execution has diverged from the recorded one.
+var ErrRandomReplayExhausted = errors.New("random value replay ran out of recorded draws")
+
+// RandomRecording holds the draws made by a random value resource, in order. Draws may be saved, e.g. with
+// encoding/json, when a test fails, then loaded to replay the same choices. It is safe for concurrent use.
+type RandomRecording struct {
+	lock  sync.Mutex
+	Draws []int64
+	pos   int
+}
+
+type randomSource struct {
+	lock      sync.Mutex
+	rng       *rand.Rand
+	recording *RandomRecording
+	replay    *RandomRecording
+}
+
+// RandomValueOption configures a random value resource.
+type RandomValueOption func(src *randomSource)
+
+// WithRandomSeed seeds the resource's PRNG, so that its draws are the same in every run. By default, the seed is
+// derived from the current time.
+func WithRandomSeed(seed int64) RandomValueOption {
+	return func(src *randomSource) {
+		src.rng = rand.New(rand.NewSource(seed))
+	}
+}
+
+// WithRandomRecording appends every draw made by the resource to recording.
+func WithRandomRecording(recording *RandomRecording) RandomValueOption {
+	return func(src *randomSource) {
+		src.recording = recording
+	}
+}
+
+// WithRandomReplay makes the resource return the draws in recording, in order, instead of drawing from its PRNG.
+func WithRandomReplay(recording *RandomRecording) RandomValueOption {
+	return func(src *randomSource) {
+		src.replay = recording
+	}
+}
+
+// draw returns a number in [0, n).
+func (src *randomSource) draw(n int64) (int64, error) {
+	var result int64
+	if src.replay != nil {
+		src.replay.lock.Lock()
+		if src.replay.pos >= len(src.replay.Draws) {
+			src.replay.lock.Unlock()
+			return 0, ErrRandomReplayExhausted
+		}
+		result = src.replay.Draws[src.replay.pos]
+		src.replay.pos++
+		src.replay.lock.Unlock()
+		if result < 0 || result >= n {
+			return 0, fmt.Errorf("replayed draw %d is out of range [0, %d); the execution has diverged", result, n)
+		}
+	} else {
+		src.lock.Lock()
+		result = src.rng.Int63n(n)
+		src.lock.Unlock()
+	}
+	if src.recording != nil {
+		src.recording.lock.Lock()
+		src.recording.Draws = append(src.recording.Draws, result)
+		src.recording.lock.Unlock()
+	}
+	return result, nil
+}
+
+// RandomValueMaker produces a distsys.ArchetypeResourceMaker for a read-only map resource making random choices.
+// Reading index n, a positive number, gives a number drawn uniformly from 0..n-1; reading index S, a non-empty
+// set, gives an element of S drawn uniformly. Each read makes a new draw, except that the reads repeated after an
+// abort give, in order, the values read by the aborted critical section, so that retrying a critical section does not
+// change its choices, and replaying a recording does not depend on where critical sections aborted.
+//
+// All resources produced by the same maker share one PRNG, so for runs to be reproducible, each archetype should be
+// given its own maker, seeded with WithRandomSeed, or replaying a recording made with WithRandomRecording.
+func RandomValueMaker(opts ...RandomValueOption) distsys.ArchetypeResourceMaker {
+	src := &randomSource{}
+	for _, opt := range opts {
+		opt(src)
+	}
+	if src.rng == nil && src.replay == nil {
+		src.rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+			return &randomValue{
+				source: src,
+				index:  index,
+			}
+		})
+	})
+}
+
+type randomValue struct {
+	distsys.ArchetypeResourceLeafMixin
+	source *randomSource
+	index  tla.TLAValue
+	// buffer holds values read then given back by an aborted critical section; backlogBuffer holds values read by
+	// the current critical section
+	buffer, backlogBuffer []tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &randomValue{}
+
+func (res *randomValue) Abort() chan struct{} {
+	res.buffer = append(res.backlogBuffer, res.buffer...)
+	res.backlogBuffer = nil
+	return nil
+}
+
+func (res *randomValue) PreCommit() chan error {
+	return nil
+}
+
+func (res *randomValue) Commit() chan struct{} {
+	res.backlogBuffer = nil
+	return nil
+}
+
+func (res *randomValue) ReadValue() (tla.TLAValue, error) {
+	if len(res.buffer) > 0 {
+		value := res.buffer[0]
+		res.buffer = res.buffer[1:]
+		res.backlogBuffer = append(res.backlogBuffer, value)
+		return value, nil
+	}
+	value, err := res.drawValue()
+	if err != nil {
+		return tla.TLAValue{}, err
+	}
+	res.backlogBuffer = append(res.backlogBuffer, value)
+	return value, nil
+}
+
+// drawValue makes a new draw, as described in RandomValueMaker.
+func (res *randomValue) drawValue() (tla.TLAValue, error) {
+	bound, boundErr := res.index.TryAsNumber()
+	switch {
+	case boundErr == nil && bound > 0:
+		n, err := res.source.draw(int64(bound))
+		if err != nil {
+			return tla.TLAValue{}, err
+		}
+		return tla.MakeTLANumber(int32(n)), nil
+	case res.index.IsSet() && res.index.AsSet().Len() > 0:
+		set := res.index.AsSet()
+		n, err := res.source.draw(int64(set.Len()))
+		if err != nil {
+			return tla.TLAValue{}, err
+		}
+		it := set.Iterator()
+		for i := int64(0); ; i++ {
+			elem, _ := it.Next()
+			if i == n {
+				return elem.(tla.TLAValue), nil
+			}
+		}
+	default:
+		return tla.TLAValue{}, fmt.Errorf("cannot draw a random value from %v, which is neither a positive number nor a non-empty set", res.index)
+	}
+}
+
+func (res *randomValue) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write %v to a random value resource", value))
+}
+
+func (res *randomValue) Close() error {
+	return nil
+}
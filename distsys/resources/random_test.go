@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func makeRandomValueTest(opts ...RandomValueOption) distsys.ArchetypeResource {
+	maker := RandomValueMaker(opts...)
+	res := maker.Make()
+	maker.Configure(res)
+	return res
+}
+
+func randomValueTestRead(t *testing.T, res distsys.ArchetypeResource, index tla.TLAValue) tla.TLAValue {
+	t.Helper()
+	elem, err := res.Index(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := elem.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+var randomValueTestIndices = []tla.TLAValue{
+	tla.MakeTLANumber(1000),
+	tla.MakeTLASet(tla.MakeTLAString("a"), tla.MakeTLAString("b"), tla.MakeTLAString("c")),
+	tla.MakeTLANumber(1000),
+}
+
+// randomValueTestRun reads each of randomValueTestIndices, in one critical section per read, and returns the values
+// read. If abortFirst is set, each critical section is first aborted once.
+func randomValueTestRun(t *testing.T, res distsys.ArchetypeResource, abortFirst bool) []tla.TLAValue {
+	t.Helper()
+	var values []tla.TLAValue
+	for _, index := range randomValueTestIndices {
+		if abortFirst {
+			randomValueTestRead(t, res, index)
+			res.Abort()
+		}
+		values = append(values, randomValueTestRead(t, res, index))
+		res.Commit()
+	}
+	return values
+}
+
+func randomValueTestEqual(a, b []tla.TLAValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRandomValueSeed(t *testing.T) {
+	first := randomValueTestRun(t, makeRandomValueTest(WithRandomSeed(42)), false)
+	second := randomValueTestRun(t, makeRandomValueTest(WithRandomSeed(42)), false)
+	if !randomValueTestEqual(first, second) {
+		t.Fatalf("expected the same seed to give the same draws, got %v and %v", first, second)
+	}
+	for i, value := range first {
+		index := randomValueTestIndices[i]
+		if index.IsSet() && !tla.TLA_InSymbol(value, index).AsBool() || !index.IsSet() && (value.AsNumber() < 0 || value.AsNumber() >= index.AsNumber()) {
+			t.Fatalf("expected a draw from %v, got %v", index, value)
+		}
+	}
+}
+
+func TestRandomValueAbortRereads(t *testing.T) {
+	recording := &RandomRecording{}
+	res := makeRandomValueTest(WithRandomRecording(recording))
+	index := tla.MakeTLANumber(1 << 30)
+
+	first, second := randomValueTestRead(t, res, index), randomValueTestRead(t, res, index)
+	res.Abort()
+	if again := randomValueTestRead(t, res, index); !again.Equal(first) {
+		t.Fatalf("expected the first read after an abort to give %v again, got %v", first, again)
+	}
+	if again := randomValueTestRead(t, res, index); !again.Equal(second) {
+		t.Fatalf("expected the second read after an abort to give %v again, got %v", second, again)
+	}
+	res.Commit()
+	if len(recording.Draws) != 2 {
+		t.Fatalf("expected reads repeated after an abort not to draw again, but %d draws were made", len(recording.Draws))
+	}
+
+	// once committed, reads draw anew
+	randomValueTestRead(t, res, index)
+	res.Commit()
+	if len(recording.Draws) != 3 {
+		t.Fatalf("expected a read after a commit to draw anew, but %d draws were made", len(recording.Draws))
+	}
+}
+
+func TestRandomValueRecordReplay(t *testing.T) {
+	recording := &RandomRecording{}
+	recorded := randomValueTestRun(t, makeRandomValueTest(WithRandomRecording(recording)), true)
+	if len(recording.Draws) != len(randomValueTestIndices) {
+		t.Fatalf("expected one draw per critical section, recorded %v", recording.Draws)
+	}
+
+	// replaying gives the recorded values, whether or not the critical sections abort as they did when recording
+	for _, abortFirst := range []bool{true, false} {
+		replay := &RandomRecording{Draws: recording.Draws}
+		replayed := randomValueTestRun(t, makeRandomValueTest(WithRandomReplay(replay)), abortFirst)
+		if !randomValueTestEqual(recorded, replayed) {
+			t.Fatalf("expected the replay to give %v, got %v", recorded, replayed)
+		}
+	}
+
+	// a replay needing more draws than recorded has diverged
+	replay := &RandomRecording{Draws: recording.Draws}
+	res := makeRandomValueTest(WithRandomReplay(replay))
+	randomValueTestRun(t, res, false)
+	elem, err := res.Index(tla.MakeTLANumber(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := elem.ReadValue(); !errors.Is(err, ErrRandomReplayExhausted) {
+		t.Fatalf("expected a replay with no draws left to fail, got %v", err)
+	}
+}
@@ -0,0 +1,404 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+const (
+	redisTimeout      = 1 * time.Second
+	redisLockLease    = 10 * time.Second
+	redisLockedWait   = 20 * time.Millisecond
+	redisRetryBackoff = 100 * time.Millisecond
+)
+
+// ErrRedisLockExpired is returned by a resource made by RedisMaker once a critical section's locks expired before
+// it could commit, in which case its writes were not made.
+var ErrRedisLockExpired = errors.New("Redis lock lease expired before commit")
+
+// redisLockScript locks the keys touched by a critical section for the given owner, ARGV[1], for ARGV[2]
+// milliseconds, if none of them is locked and the keys read still hold the values read. KEYS are pairs of a lock and
+// the key it guards; for the pair at KEYS[i], ARGV[i+2] is "0" if the key was not read, "1" if it was read and had
+// no value, and "2" if it was read and had the value ARGV[i+3]. It returns 1 if it took the locks, and 0, changing
+// nothing, otherwise.
+const redisLockScript = `for i = 1, #KEYS, 2 do
+	if redis.call("exists", KEYS[i]) == 1 then return 0 end
+	local value = redis.call("get", KEYS[i + 1])
+	if ARGV[i + 2] == "1" and value then return 0 end
+	if ARGV[i + 2] == "2" and value ~= ARGV[i + 3] then return 0 end
+end
+for i = 1, #KEYS, 2 do
+	redis.call("set", KEYS[i], ARGV[1], "px", ARGV[2])
+end
+return 1`
+
+// redisUnlockScript deletes the locks in KEYS that are still held by the given owner, ARGV[1].
+const redisUnlockScript = `for i = 1, #KEYS do
+	if redis.call("get", KEYS[i]) == ARGV[1] then redis.call("del", KEYS[i]) end
+end
+return 1`
+
+// redisCommitScript commits a critical section, if the given owner, ARGV[1], still holds all of its locks. KEYS are
+// pairs of a lock and the key it guards; for the pair at KEYS[i], ARGV[i+1] is "1" if the key was written, and
+// ARGV[i+2] is its new value. It returns 1 if it committed, and 0, changing nothing, if any lock was lost.
+const redisCommitScript = `for i = 1, #KEYS, 2 do
+	if redis.call("get", KEYS[i]) ~= ARGV[1] then return 0 end
+end
+for i = 1, #KEYS, 2 do
+	if ARGV[i + 1] == "1" then redis.call("set", KEYS[i + 1], ARGV[i + 2]) end
+	redis.call("del", KEYS[i])
+end
+return 1`
+
+// RedisClient is the subset of a Redis client needed by RedisMaker. This package does not speak Redis's wire
+// protocol; for go-redis, a small adapter over a redis.UniversalClient is enough:
+//
+//	Get:  client.MGet(ctx, keys...).Result(), converting each string to a []byte, and keeping each nil
+//	Eval: client.Eval(ctx, script, keys, args...).Result()
+//
+// Authentication, database selection and connection pooling are left to the client.
+type RedisClient interface {
+	// Get returns the value of each of keys, or nil for those that have none.
+	Get(ctx context.Context, keys ...string) ([][]byte, error)
+	// Eval runs a Lua script, which Redis runs atomically, with the given KEYS and ARGV, returning its reply, with
+	// integers as int64s.
+	Eval(ctx context.Context, script string, keys []string, args ...string) (interface{}, error)
+}
+
+type redisConfig struct {
+	timeout   time.Duration
+	lockLease time.Duration
+}
+
+// RedisOption configures a resource made by RedisMaker.
+type RedisOption func(cfg *redisConfig)
+
+// WithRedisTimeout sets the timeout of each command sent through the RedisClient.
+func WithRedisTimeout(timeout time.Duration) RedisOption {
+	return func(cfg *redisConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithRedisLockLease sets how long keys stay locked between a critical section's pre-commit and commit. If the
+// archetype crashes in between, the keys become usable again once the lease expires.
+func WithRedisLockLease(lease time.Duration) RedisOption {
+	return func(cfg *redisConfig) {
+		cfg.lockLease = lease
+	}
+}
+
+// RedisMaker produces a distsys.ArchetypeResourceMaker for a map stored in Redis, accessed through client. The value
+// at index i is stored, gob-encoded, at the key prefix + i.String(), and reads as initial until it is first written.
+// The client is shared by all the resources made, and is not closed along with them.
+//
+// The map is shared with every archetype, in any process, using the same Redis and prefix, and critical sections
+// touching it are serializable. Concurrency control is optimistic, as with WATCH and MULTI/EXEC, but done by
+// scripts, which Redis runs atomically, so that the client needs no per-connection state. Reads remember the values
+// they read, and abort if another critical section has the keys locked. Pre-commit locks every key the critical
+// section touched, aborting the critical section if any is locked, or if a key read has changed since. Commit writes
+// the new values and releases the locks. Locks are separate keys, suffixed with ":lock", which expire after a lease
+// (see WithRedisLockLease). If a lease expired between pre-commit and commit, another critical section may have
+// taken the lock and written the key since, so commit writes nothing, and the resource fails every later operation
+// with ErrRedisLockExpired, rather than go on as if the critical section had committed.
+func RedisMaker(client RedisClient, prefix string, initial tla.TLAValue, opts ...RedisOption) distsys.ArchetypeResourceMaker {
+	cfg := redisConfig{
+		timeout:   redisTimeout,
+		lockLease: redisLockLease,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &redisResource{
+				entries: immutable.NewMap(tla.TLAValueHasher{}),
+			}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*redisResource)
+			r.client = client
+			r.prefix = prefix
+			r.initial = initial
+			r.config = cfg
+		},
+	}
+}
+
+type redisResource struct {
+	distsys.ArchetypeResourceMapMixin
+	resourceLogger
+	client  RedisClient
+	prefix  string
+	initial tla.TLAValue
+	config  redisConfig
+
+	// entries holds a *redisEntry for each index touched by the current critical section
+	entries *immutable.Map
+	// owner identifies the current critical section's locks, once pre-commit took them
+	owner string
+	// err is set once a commit failed, and returned by every later operation
+	err error
+}
+
+type redisEntry struct {
+	key     string
+	hasRead bool
+	// readData is the encoded value read, or nil if the key had none
+	readData     []byte
+	value        tla.TLAValue
+	writePending bool
+}
+
+var _ distsys.ArchetypeResource = &redisResource{}
+
+// withRedisTimeout calls fn with a context that times out as configured by WithRedisTimeout.
+func (res *redisResource) withRedisTimeout(fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), res.config.timeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+func (res *redisResource) get(keys ...string) ([][]byte, error) {
+	var values [][]byte
+	err := res.withRedisTimeout(func(ctx context.Context) error {
+		var err error
+		values, err = res.client.Get(ctx, keys...)
+		if err == nil && len(values) != len(keys) {
+			err = fmt.Errorf("expected %d values from Redis, got %d", len(keys), len(values))
+		}
+		return err
+	})
+	return values, err
+}
+
+// eval runs script, reporting whether it returned 1.
+func (res *redisResource) eval(script string, keys []string, args ...string) (bool, error) {
+	var reply interface{}
+	err := res.withRedisTimeout(func(ctx context.Context) error {
+		var err error
+		reply, err = res.client.Eval(ctx, script, keys, args...)
+		return err
+	})
+	return reply == int64(1), err
+}
+
+func (res *redisResource) entry(index tla.TLAValue) *redisEntry {
+	if e, ok := res.entries.Get(index); ok {
+		return e.(*redisEntry)
+	}
+	e := &redisEntry{key: res.prefix + index.String()}
+	res.entries = res.entries.Set(index, e)
+	return e
+}
+
+func (res *redisResource) forEachEntry(fn func(e *redisEntry)) {
+	it := res.entries.Iterator()
+	for !it.Done() {
+		_, e := it.Next()
+		fn(e.(*redisEntry))
+	}
+}
+
+func (res *redisResource) read(index tla.TLAValue) (tla.TLAValue, error) {
+	e := res.entry(index)
+	if e.hasRead || e.writePending {
+		return e.value, nil
+	}
+	values, err := res.get(e.key, e.key+":lock")
+	if err != nil {
+		res.log(distsys.LogWarn, "could not read Redis key, aborting", "key", e.key, "error", err)
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	}
+	if values[1] != nil {
+		time.Sleep(redisLockedWait)
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	}
+	e.value = res.initial
+	if values[0] != nil {
+		err = e.value.GobDecode(values[0])
+		if err != nil {
+			return tla.TLAValue{}, fmt.Errorf("could not decode redis key %s: %w", e.key, err)
+		}
+	}
+	e.readData = values[0]
+	e.hasRead = true
+	return e.value, nil
+}
+
+func (res *redisResource) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	if res.err != nil {
+		return nil, res.err
+	}
+	return &redisKeyResource{parent: res, index: index}, nil
+}
+
+func (res *redisResource) reset() {
+	res.entries = immutable.NewMap(tla.TLAValueHasher{})
+	res.owner = ""
+}
+
+func (res *redisResource) Abort() chan struct{} {
+	if res.owner == "" {
+		res.reset()
+		return nil
+	}
+	ch := make(chan struct{}, 1)
+	go func() {
+		var locks []string
+		res.forEachEntry(func(e *redisEntry) {
+			locks = append(locks, e.key+":lock")
+		})
+		if _, err := res.eval(redisUnlockScript, locks, res.owner); err != nil {
+			res.log(distsys.LogWarn, "could not release Redis locks, they will expire", "prefix", res.prefix, "error", err)
+		}
+		res.reset()
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+func (res *redisResource) PreCommit() chan error {
+	if res.err != nil {
+		ch := make(chan error, 1)
+		ch <- res.err
+		return ch
+	}
+	if res.entries.Len() == 0 {
+		return nil
+	}
+	ch := make(chan error, 1)
+	go func() {
+		ch <- res.lockEntries()
+	}()
+	return ch
+}
+
+func (res *redisResource) lockEntries() error {
+	owner := fmt.Sprintf("%x", rand.Int63())
+	var keys []string
+	args := []string{owner, strconv.FormatInt(res.config.lockLease.Milliseconds(), 10)}
+	res.forEachEntry(func(e *redisEntry) {
+		keys = append(keys, e.key+":lock", e.key)
+		switch {
+		case !e.hasRead:
+			args = append(args, "0", "")
+		case e.readData == nil:
+			args = append(args, "1", "")
+		default:
+			args = append(args, "2", string(e.readData))
+		}
+	})
+	locked, err := res.eval(redisLockScript, keys, args...)
+	if err != nil {
+		res.log(distsys.LogWarn, "could not lock Redis keys, aborting", "prefix", res.prefix, "error", err)
+		return distsys.ErrCriticalSectionAborted
+	}
+	if !locked {
+		// a key was locked, or changed since it was read
+		return distsys.ErrCriticalSectionAborted
+	}
+	res.owner = owner
+	return nil
+}
+
+func (res *redisResource) Commit() chan struct{} {
+	if res.owner == "" {
+		res.reset()
+		return nil
+	}
+	ch := make(chan struct{}, 1)
+	go func() {
+		// an error may come after the script ran, in which case retrying finds the locks already released
+		outcomeUnknown := false
+		for {
+			committed, err := res.commitEntries()
+			if err == nil {
+				if !committed {
+					if outcomeUnknown {
+						res.log(distsys.LogWarn, "Redis locks were released before commit, assuming an earlier attempt committed", "prefix", res.prefix)
+					} else {
+						res.err = fmt.Errorf("could not commit to Redis keys with prefix %s, increase the lock lease: %w", res.prefix, ErrRedisLockExpired)
+						res.log(distsys.LogError, "Redis locks expired before commit, nothing was written", "prefix", res.prefix)
+					}
+				}
+				break
+			}
+			outcomeUnknown = true
+			res.log(distsys.LogWarn, "could not commit to Redis, retrying", "error", err)
+			time.Sleep(redisRetryBackoff)
+		}
+		res.reset()
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+// commitEntries writes the pending values and releases the locks, reporting false, having written nothing, if any
+// of the locks is no longer held by res.owner.
+func (res *redisResource) commitEntries() (bool, error) {
+	var keys []string
+	args := []string{res.owner}
+	var err error
+	res.forEachEntry(func(e *redisEntry) {
+		written, data := "0", []byte{}
+		if err == nil && e.writePending {
+			written = "1"
+			data, err = e.value.GobEncode()
+		}
+		keys = append(keys, e.key+":lock", e.key)
+		args = append(args, written, string(data))
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.eval(redisCommitScript, keys, args...)
+}
+
+func (res *redisResource) Close() error {
+	return nil
+}
+
+// redisKeyResource is the value at one index of a redisResource, which does all the work.
+type redisKeyResource struct {
+	distsys.ArchetypeResourceLeafMixin
+	parent *redisResource
+	index  tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &redisKeyResource{}
+
+func (res *redisKeyResource) Abort() chan struct{} {
+	return nil
+}
+
+func (res *redisKeyResource) PreCommit() chan error {
+	return nil
+}
+
+func (res *redisKeyResource) Commit() chan struct{} {
+	return nil
+}
+
+func (res *redisKeyResource) ReadValue() (tla.TLAValue, error) {
+	return res.parent.read(res.index)
+}
+
+func (res *redisKeyResource) WriteValue(value tla.TLAValue) error {
+	e := res.parent.entry(res.index)
+	e.value = value
+	e.writePending = true
+	return nil
+}
+
+func (res *redisKeyResource) Close() error {
+	return nil
+}
@@ -0,0 +1,334 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// fakeRedisClient stores strings with expiry, and runs the scripts RedisMaker uses, one at a time, so they are
+// trivially atomic.
+type fakeRedisClient struct {
+	lock   sync.Mutex
+	values map[string]fakeRedisValue
+	// lockRefusals counts the calls to the lock script that took no locks
+	lockRefusals int
+	// lostReplies is the number of upcoming scripts whose replies are lost, after they ran
+	lostReplies int
+}
+
+type fakeRedisValue struct {
+	data    string
+	expires time.Time
+}
+
+var _ RedisClient = &fakeRedisClient{}
+
+func newFakeRedisClient() *fakeRedisClient {
+	return &fakeRedisClient{values: make(map[string]fakeRedisValue)}
+}
+
+func (client *fakeRedisClient) getLocked(key string) (string, bool) {
+	value, ok := client.values[key]
+	if ok && !value.expires.IsZero() && time.Now().After(value.expires) {
+		delete(client.values, key)
+		return "", false
+	}
+	return value.data, ok
+}
+
+func (client *fakeRedisClient) Get(_ context.Context, keys ...string) ([][]byte, error) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		if data, ok := client.getLocked(key); ok {
+			values[i] = []byte(data)
+		}
+	}
+	return values, nil
+}
+
+func (client *fakeRedisClient) Eval(_ context.Context, script string, keys []string, args ...string) (interface{}, error) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	var reply int64
+	switch script {
+	case redisLockScript:
+		reply = client.lockLocked(keys, args)
+	case redisUnlockScript:
+		for _, key := range keys {
+			if data, ok := client.getLocked(key); ok && data == args[0] {
+				delete(client.values, key)
+			}
+		}
+		reply = 1
+	case redisCommitScript:
+		reply = client.commitLocked(keys, args)
+	default:
+		return nil, errors.New("unknown script")
+	}
+	if client.lostReplies > 0 {
+		client.lostReplies--
+		return nil, errors.New("connection reset")
+	}
+	return reply, nil
+}
+
+// lockLocked runs redisLockScript.
+func (client *fakeRedisClient) lockLocked(keys, args []string) int64 {
+	for i := 0; i < len(keys); i += 2 {
+		_, locked := client.getLocked(keys[i])
+		data, ok := client.getLocked(keys[i+1])
+		if locked || (args[i+2] == "1" && ok) || (args[i+2] == "2" && (!ok || data != args[i+3])) {
+			client.lockRefusals++
+			return 0
+		}
+	}
+	millis, err := strconv.Atoi(args[1])
+	if err != nil {
+		panic(err)
+	}
+	for i := 0; i < len(keys); i += 2 {
+		client.values[keys[i]] = fakeRedisValue{data: args[0], expires: time.Now().Add(time.Duration(millis) * time.Millisecond)}
+	}
+	return 1
+}
+
+// commitLocked runs redisCommitScript.
+func (client *fakeRedisClient) commitLocked(keys, args []string) int64 {
+	owner, argv := args[0], args[1:]
+	for i := 0; i < len(keys); i += 2 {
+		if data, ok := client.getLocked(keys[i]); !ok || data != owner {
+			return 0
+		}
+	}
+	for i := 0; i < len(keys); i += 2 {
+		if argv[i] == "1" {
+			client.values[keys[i+1]] = fakeRedisValue{data: argv[i+1]}
+		}
+		delete(client.values, keys[i])
+	}
+	return 1
+}
+
+// redisTestKey is the value of key and whether its lock is held, as stored by the fake client.
+func (client *fakeRedisClient) redisTestKey(t *testing.T, key string) (tla.TLAValue, bool) {
+	t.Helper()
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	data, ok := client.getLocked(key)
+	if !ok {
+		t.Fatalf("key %s was never written", key)
+	}
+	var value tla.TLAValue
+	if err := value.GobDecode([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	_, locked := client.getLocked(key + ":lock")
+	return value, locked
+}
+
+func makeRedisTestResource(t *testing.T, client *fakeRedisClient, opts ...RedisOption) distsys.ArchetypeResource {
+	t.Helper()
+	maker := RedisMaker(client, "test:", tla.MakeTLANumber(0), opts...)
+	res := maker.Make()
+	maker.Configure(res)
+	t.Cleanup(func() {
+		_ = res.Close()
+	})
+	return res
+}
+
+// redisTestIncrement runs a critical section adding one to the value at index, returning whether it committed.
+func redisTestIncrement(t *testing.T, res distsys.ArchetypeResource, index tla.TLAValue) bool {
+	t.Helper()
+	entry, err := res.Index(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := entry.ReadValue()
+	if err == nil {
+		err = entry.WriteValue(tla.MakeTLANumber(value.AsNumber() + 1))
+	}
+	if err == nil {
+		err = <-res.PreCommit()
+	}
+	if errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		if ch := res.Abort(); ch != nil {
+			<-ch
+		}
+		return false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ch := res.Commit(); ch != nil {
+		<-ch
+	}
+	return true
+}
+
+func TestRedisConflictAborts(t *testing.T) {
+	client := newFakeRedisClient()
+	a, b := makeRedisTestResource(t, client), makeRedisTestResource(t, client)
+	index := tla.MakeTLANumber(1)
+	key := "test:" + index.String()
+
+	aEntry, err := a.Index(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := aEntry.ReadValue()
+	if err != nil || !value.Equal(tla.MakeTLANumber(0)) {
+		t.Fatalf("expected to read the initial value 0, got %v, %v", value, err)
+	}
+	// b changes the value before a's critical section ends
+	if !redisTestIncrement(t, b, index) {
+		t.Fatal("expected the critical section without conflicts to commit")
+	}
+	// so a's pre-commit must fail to lock, and a's critical section abort
+	if err := aEntry.WriteValue(tla.MakeTLANumber(100)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-a.PreCommit(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected the pre-commit to abort, got %v", err)
+	}
+	client.lock.Lock()
+	lockRefusals := client.lockRefusals
+	client.lock.Unlock()
+	if lockRefusals != 1 {
+		t.Fatalf("expected the abort to come from the lock script seeing the change, but it refused %d times", lockRefusals)
+	}
+	if ch := a.Abort(); ch != nil {
+		<-ch
+	}
+	if value, locked := client.redisTestKey(t, key); !value.Equal(tla.MakeTLANumber(1)) || locked {
+		t.Fatalf("expected b's write of 1 to stand, unlocked, got %v (locked: %v)", value, locked)
+	}
+
+	// a's retry sees b's write, and commits
+	if !redisTestIncrement(t, a, index) {
+		t.Fatal("expected the retried critical section to commit")
+	}
+	if value, locked := client.redisTestKey(t, key); !value.Equal(tla.MakeTLANumber(2)) || locked {
+		t.Fatalf("expected 2, unlocked, got %v (locked: %v)", value, locked)
+	}
+}
+
+func TestRedisLockedKeyAborts(t *testing.T) {
+	client := newFakeRedisClient()
+	a, b := makeRedisTestResource(t, client), makeRedisTestResource(t, client)
+	index := tla.MakeTLANumber(1)
+	key := "test:" + index.String()
+
+	aEntry, err := a.Index(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := aEntry.WriteValue(tla.MakeTLANumber(5)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-a.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	// while a holds the lock, b can neither read nor write the value
+	bEntry, err := b.Index(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bEntry.ReadValue(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected reading a locked key to abort, got %v", err)
+	}
+	if ch := b.Abort(); ch != nil {
+		<-ch
+	}
+	if err := bEntry.WriteValue(tla.MakeTLANumber(100)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-b.PreCommit(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected writing a locked key to abort, got %v", err)
+	}
+	if ch := b.Abort(); ch != nil {
+		<-ch
+	}
+
+	<-a.Commit()
+	if !redisTestIncrement(t, b, index) {
+		t.Fatal("expected the retried critical section to commit")
+	}
+	if value, locked := client.redisTestKey(t, key); !value.Equal(tla.MakeTLANumber(6)) || locked {
+		t.Fatalf("expected 6, unlocked, got %v (locked: %v)", value, locked)
+	}
+}
+
+func TestRedisExpiredLockFails(t *testing.T) {
+	client := newFakeRedisClient()
+	const lease = 50 * time.Millisecond
+	a, b := makeRedisTestResource(t, client, WithRedisLockLease(lease)), makeRedisTestResource(t, client)
+	index := tla.MakeTLANumber(1)
+	key := "test:" + index.String()
+
+	aEntry, err := a.Index(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := aEntry.WriteValue(tla.MakeTLANumber(5)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-a.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	// a stalls past its lease, so b can take the lock and commit in the meantime
+	time.Sleep(2 * lease)
+	if !redisTestIncrement(t, b, index) {
+		t.Fatal("expected the critical section to commit once the lease expired")
+	}
+
+	// a's commit must notice, leave b's write be, and fail a from then on
+	<-a.Commit()
+	if value, locked := client.redisTestKey(t, key); !value.Equal(tla.MakeTLANumber(1)) || locked {
+		t.Fatalf("expected b's write of 1 to stand, unlocked, got %v (locked: %v)", value, locked)
+	}
+	if _, err := a.Index(index); !errors.Is(err, ErrRedisLockExpired) {
+		t.Fatalf("expected indexing after the failed commit to fail, got %v", err)
+	}
+	if err := <-a.PreCommit(); !errors.Is(err, ErrRedisLockExpired) {
+		t.Fatalf("expected pre-commit after the failed commit to fail, got %v", err)
+	}
+}
+
+func TestRedisCommitLostReply(t *testing.T) {
+	client := newFakeRedisClient()
+	res := makeRedisTestResource(t, client)
+	index := tla.MakeTLANumber(1)
+	key := "test:" + index.String()
+
+	entry, err := res.Index(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entry.WriteValue(tla.MakeTLANumber(5)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-res.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	// the commit script runs, but its reply is lost, so the retry finds the locks released by the first attempt
+	client.lock.Lock()
+	client.lostReplies = 1
+	client.lock.Unlock()
+	<-res.Commit()
+	if value, locked := client.redisTestKey(t, key); !value.Equal(tla.MakeTLANumber(5)) || locked {
+		t.Fatalf("expected 5, unlocked, got %v (locked: %v)", value, locked)
+	}
+	if !redisTestIncrement(t, res, index) {
+		t.Fatal("expected the resource to remain usable")
+	}
+}
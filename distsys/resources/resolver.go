@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// DNSSRVResolver produces a TCPMailboxesResolver backed by DNS SRV records. For each mailbox index, nameFn gives
+// the domain name to query, and the records for _service._proto.name are looked up, as in net.LookupSRV.
+// The highest-priority target is used.
+func DNSSRVResolver(service, proto string, nameFn func(index tla.TLAValue) string) TCPMailboxesResolver {
+	return TCPMailboxesResolverFn(func(index tla.TLAValue) (string, error) {
+		name := nameFn(index)
+		_, records, err := net.LookupSRV(service, proto, name)
+		if err != nil {
+			return "", err
+		}
+		if len(records) == 0 {
+			return "", fmt.Errorf("no SRV records found for _%s._%s.%s", service, proto, name)
+		}
+		// net.LookupSRV sorts records by priority, and randomizes by weight within a priority
+		return net.JoinHostPort(records[0].Target, strconv.Itoa(int(records[0].Port))), nil
+	})
+}
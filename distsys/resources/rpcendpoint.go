@@ -0,0 +1,127 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// RPCEndpoint exposes an archetype parameter as an RPC endpoint, so that an archetype can be embedded behind an
+// existing service handler without a mailbox protocol. Each invocation, whether an in-process Call or an HTTP
+// request, becomes one read of the parameter by the archetype, and the archetype's next write to the parameter is
+// the reply. Replies are matched to invocations in the order the archetype read them, as with
+// RequestResponseChannelMaker, which the endpoint is built on.
+type RPCEndpoint struct {
+	*RequestResponseChannel
+}
+
+var _ http.Handler = &RPCEndpoint{}
+
+// NewRPCEndpoint creates an RPCEndpoint, which buffers up to capacity invocations that the archetype has not read
+// yet.
+func NewRPCEndpoint(capacity int) *RPCEndpoint {
+	return &RPCEndpoint{
+		RequestResponseChannel: NewRequestResponseChannel(capacity),
+	}
+}
+
+// Maker returns the distsys.ArchetypeResourceMaker to pass as the archetype parameter served by the endpoint.
+func (endpoint *RPCEndpoint) Maker() distsys.ArchetypeResourceMaker {
+	return RequestResponseChannelMaker(endpoint.RequestResponseChannel)
+}
+
+// ServeHTTP handles an HTTP invocation. The request body must be a JSON value, which is converted as described in
+// FileConfigMaker. The reply is written back as JSON: records with string keys become objects, tuples and sets
+// become arrays, and other functions become arrays of [key, value] pairs. If the request is cancelled before the
+// archetype replies, the invocation is abandoned.
+func (endpoint *RPCEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	request, err := DecodeRPCJSON(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := endpoint.Call(r.Context(), request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	encoded, err := EncodeRPCJSON(response)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(encoded)
+}
+
+// DecodeRPCJSON converts a JSON request, as accepted by RPCEndpoint.ServeHTTP, to a TLA+ value. It is exported for
+// other frontends that forward JSON requests to archetypes.
+func DecodeRPCJSON(data []byte) (tla.TLAValue, error) {
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err := decoder.Decode(&decoded)
+	if err != nil {
+		return tla.TLAValue{}, fmt.Errorf("could not parse request: %w", err)
+	}
+	request, err := configFromJSON(decoded)
+	if err != nil {
+		return tla.TLAValue{}, fmt.Errorf("could not convert request: %w", err)
+	}
+	return request, nil
+}
+
+// EncodeRPCJSON converts a TLA+ value to JSON, as RPCEndpoint.ServeHTTP writes replies.
+func EncodeRPCJSON(value tla.TLAValue) ([]byte, error) {
+	return json.Marshal(tlaToJSON(value))
+}
+
+func tlaToJSON(value tla.TLAValue) interface{} {
+	switch {
+	case value.IsBool():
+		return value.AsBool()
+	case value.IsNumber():
+		return json.Number(value.String())
+	case value.IsString():
+		return value.AsString()
+	case value.IsTuple() || value.IsSet():
+		elems := []interface{}{}
+		value.Elements()(func(elem tla.TLAValue) bool {
+			elems = append(elems, tlaToJSON(elem))
+			return true
+		})
+		return elems
+	case value.IsFunction():
+		fields := make(map[string]interface{})
+		var pairs []interface{}
+		value.All()(func(key, elem tla.TLAValue) bool {
+			if key.IsString() {
+				fields[key.AsString()] = tlaToJSON(elem)
+			}
+			pairs = append(pairs, []interface{}{tlaToJSON(key), tlaToJSON(elem)})
+			return true
+		})
+		if len(fields) == len(pairs) {
+			return fields
+		}
+		return pairs
+	default:
+		return value.String()
+	}
+}
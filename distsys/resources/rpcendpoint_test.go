@@ -0,0 +1,151 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func makeRPCEndpointTest(endpoint *RPCEndpoint) distsys.ArchetypeResource {
+	maker := endpoint.Maker()
+	res := maker.Make()
+	maker.Configure(res)
+	return res
+}
+
+// rpcEndpointTestServe answers each invocation with the sum of the numbers it holds, in one critical section per
+// invocation, until stop is closed.
+func rpcEndpointTestServe(t *testing.T, res distsys.ArchetypeResource, stop chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		request, err := res.ReadValue()
+		if errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			continue
+		} else if err != nil {
+			t.Error(err)
+			return
+		}
+		sum := tla.MakeTLANumber(0)
+		request.Elements()(func(elem tla.TLAValue) bool {
+			sum = tla.TLA_PlusSymbol(sum, elem)
+			return true
+		})
+		if err := res.WriteValue(sum); err != nil {
+			t.Error(err)
+			return
+		}
+		res.Commit()
+	}
+}
+
+func TestRPCEndpointCall(t *testing.T) {
+	endpoint := NewRPCEndpoint(1)
+	res := makeRPCEndpointTest(endpoint)
+	stop := make(chan struct{})
+	defer close(stop)
+	go rpcEndpointTestServe(t, res, stop)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	response, err := endpoint.Call(ctx, tla.MakeTLATuple(tla.MakeTLANumber(1), tla.MakeTLANumber(2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !response.Equal(tla.MakeTLANumber(3)) {
+		t.Fatalf("expected the reply 3, got %v", response)
+	}
+}
+
+func TestRPCEndpointServeHTTP(t *testing.T) {
+	endpoint := NewRPCEndpoint(1)
+	res := makeRPCEndpointTest(endpoint)
+	stop := make(chan struct{})
+	defer close(stop)
+	go rpcEndpointTestServe(t, res, stop)
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	resp, err := http.Post(server.URL, "application/json", strings.NewReader(`[1, 2, 39]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || string(body) != "42" || resp.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("expected the JSON reply 42, got %d %q", resp.StatusCode, body)
+	}
+
+	// only POSTs with JSON bodies are invocations
+	resp, err = http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("expected a GET to be rejected, got %d", resp.StatusCode)
+	}
+	resp, err = http.Post(server.URL, "application/json", strings.NewReader(`[1,`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected malformed JSON to be rejected, got %d", resp.StatusCode)
+	}
+}
+
+func TestRPCEndpointBackpressure(t *testing.T) {
+	endpoint := NewRPCEndpoint(1)
+	res := makeRPCEndpointTest(endpoint)
+
+	// with nobody reading, the first invocation waits in the buffer, and the second cannot even be submitted
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	defer cancelFirst()
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := endpoint.Call(firstCtx, tla.MakeTLATuple(tla.MakeTLANumber(1)))
+		firstErr <- err
+	}()
+	awaitCondition(t, "the first invocation to be buffered", func() bool {
+		return len(endpoint.requests) == 1
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := endpoint.Call(ctx, tla.MakeTLATuple(tla.MakeTLANumber(2))); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected an invocation beyond capacity to wait until its deadline, got %v", err)
+	}
+
+	// once the archetype reads the first invocation, there is room again, and the first reply is delivered
+	request, err := res.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !request.Equal(tla.MakeTLATuple(tla.MakeTLANumber(1))) {
+		t.Fatalf("expected the buffered invocation to be read, got %v", request)
+	}
+	if err := res.WriteValue(tla.MakeTLAString("done")); err != nil {
+		t.Fatal(err)
+	}
+	res.Commit()
+	if err := <-firstErr; err != nil {
+		t.Fatalf("expected the first invocation to be answered, got %v", err)
+	}
+	if len(endpoint.requests) != 0 {
+		t.Fatalf("expected the invocation that timed out not to be buffered")
+	}
+}
@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// LatencyDistribution returns how long a single resource operation should be delayed. It is called once per
+// operation, and may be called concurrently.
+type LatencyDistribution func() time.Duration
+
+// ConstantLatency delays every operation by exactly d.
+func ConstantLatency(d time.Duration) LatencyDistribution {
+	return func() time.Duration {
+		return d
+	}
+}
+
+// UniformLatency delays every operation by a duration drawn uniformly from [min, max).
+func UniformLatency(min, max time.Duration) LatencyDistribution {
+	if max <= min {
+		return ConstantLatency(min)
+	}
+	return func() time.Duration {
+		return min + time.Duration(rand.Int63n(int64(max-min)))
+	}
+}
+
+// NormalLatency delays every operation by a duration drawn from a normal distribution with the given mean and
+// standard deviation (the jitter). Negative draws are clamped to zero.
+func NormalLatency(mean, stdDev time.Duration) LatencyDistribution {
+	return func() time.Duration {
+		d := time.Duration(rand.NormFloat64()*float64(stdDev)) + mean
+		if d < 0 {
+			return 0
+		}
+		return d
+	}
+}
+
+type slowResourceConfig struct {
+	readLatency, writeLatency, preCommitLatency, commitLatency LatencyDistribution
+}
+
+// SlowResourceOption configures which operations of a resource wrapped by SlowResourceMaker are delayed.
+type SlowResourceOption func(cfg *slowResourceConfig)
+
+// WithSlowReads delays every ReadValue by a duration drawn from dist.
+func WithSlowReads(dist LatencyDistribution) SlowResourceOption {
+	return func(cfg *slowResourceConfig) {
+		cfg.readLatency = dist
+	}
+}
+
+// WithSlowWrites delays every WriteValue by a duration drawn from dist.
+func WithSlowWrites(dist LatencyDistribution) SlowResourceOption {
+	return func(cfg *slowResourceConfig) {
+		cfg.writeLatency = dist
+	}
+}
+
+// WithSlowPreCommits delays the outcome of every PreCommit by a duration drawn from dist.
+func WithSlowPreCommits(dist LatencyDistribution) SlowResourceOption {
+	return func(cfg *slowResourceConfig) {
+		cfg.preCommitLatency = dist
+	}
+}
+
+// WithSlowCommits delays the completion of every Commit by a duration drawn from dist.
+func WithSlowCommits(dist LatencyDistribution) SlowResourceOption {
+	return func(cfg *slowResourceConfig) {
+		cfg.commitLatency = dist
+	}
+}
+
+// SlowResourceMaker wraps the resource produced by maker, injecting latency and jitter into its operations, as
+// configured by opts. Operations with no configured distribution are passed through undelayed. Map-like resources
+// are supported: sub-resources obtained via Index are wrapped in the same way. If the wrapped resource implements
+// distsys.Snapshotter or distsys.TraceContextCarrier, so does the wrapper, which forwards them undelayed.
+//
+// This is intended for soak testing, to check that timeouts, retries and failure detector settings behave well
+// against backends that are degraded but still alive.
+func SlowResourceMaker(maker distsys.ArchetypeResourceMaker, opts ...SlowResourceOption) distsys.ArchetypeResourceMaker {
+	cfg := &slowResourceConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return wrapSlowResource(cfg, maker.Make())
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(interface{ base() *slowResource }).base()
+			maker.Configure(r.inner)
+		},
+	}
+}
+
+type slowResource struct {
+	config *slowResourceConfig
+	inner  distsys.ArchetypeResource
+}
+
+var _ distsys.ArchetypeResource = &slowResource{}
+
+// slowSnapshotter, slowCarrier and slowSnapshotterCarrier wrap an inner resource that implements
+// distsys.Snapshotter, distsys.TraceContextCarrier, or both, so that the context still finds those interfaces.
+type slowSnapshotter struct {
+	*slowResource
+	distsys.Snapshotter
+}
+
+type slowCarrier struct {
+	*slowResource
+	distsys.TraceContextCarrier
+}
+
+type slowSnapshotterCarrier struct {
+	*slowResource
+	distsys.Snapshotter
+	distsys.TraceContextCarrier
+}
+
+// wrapSlowResource wraps inner in a slowResource, implementing the optional interfaces inner implements.
+func wrapSlowResource(config *slowResourceConfig, inner distsys.ArchetypeResource) distsys.ArchetypeResource {
+	res := &slowResource{
+		config: config,
+		inner:  inner,
+	}
+	snapshotter, isSnapshotter := inner.(distsys.Snapshotter)
+	carrier, isCarrier := inner.(distsys.TraceContextCarrier)
+	switch {
+	case isSnapshotter && isCarrier:
+		return &slowSnapshotterCarrier{slowResource: res, Snapshotter: snapshotter, TraceContextCarrier: carrier}
+	case isSnapshotter:
+		return &slowSnapshotter{slowResource: res, Snapshotter: snapshotter}
+	case isCarrier:
+		return &slowCarrier{slowResource: res, TraceContextCarrier: carrier}
+	default:
+		return res
+	}
+}
+
+func (res *slowResource) base() *slowResource {
+	return res
+}
+
+func sleepFor(dist LatencyDistribution) {
+	if dist != nil {
+		time.Sleep(dist())
+	}
+}
+
+func (res *slowResource) Abort() chan struct{} {
+	return res.inner.Abort()
+}
+
+func (res *slowResource) PreCommit() chan error {
+	if res.config.preCommitLatency == nil {
+		return res.inner.PreCommit()
+	}
+	innerCh := res.inner.PreCommit()
+	ch := make(chan error, 1)
+	go func() {
+		sleepFor(res.config.preCommitLatency)
+		var err error
+		if innerCh != nil {
+			err = <-innerCh
+		}
+		ch <- err
+	}()
+	return ch
+}
+
+func (res *slowResource) Commit() chan struct{} {
+	if res.config.commitLatency == nil {
+		return res.inner.Commit()
+	}
+	innerCh := res.inner.Commit()
+	ch := make(chan struct{}, 1)
+	go func() {
+		sleepFor(res.config.commitLatency)
+		if innerCh != nil {
+			<-innerCh
+		}
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+func (res *slowResource) ReadValue() (tla.TLAValue, error) {
+	sleepFor(res.config.readLatency)
+	return res.inner.ReadValue()
+}
+
+func (res *slowResource) WriteValue(value tla.TLAValue) error {
+	sleepFor(res.config.writeLatency)
+	return res.inner.WriteValue(value)
+}
+
+func (res *slowResource) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	subRes, err := res.inner.Index(index)
+	if err != nil {
+		return nil, err
+	}
+	return wrapSlowResource(res.config, subRes), nil
+}
+
+func (res *slowResource) Close() error {
+	return res.inner.Close()
+}
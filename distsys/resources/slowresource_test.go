@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// carrierTestResource is a local resource that carries the trace context of the last write, to be received by the
+// next read.
+type carrierTestResource struct {
+	distsys.LocalArchetypeResource
+	traceContext distsys.TraceContext
+	hasContext   bool
+}
+
+var _ distsys.TraceContextCarrier = &carrierTestResource{}
+
+func (res *carrierTestResource) SetTraceContext(tc distsys.TraceContext) {
+	res.traceContext, res.hasContext = tc, true
+}
+
+func (res *carrierTestResource) ReceivedTraceContext() (distsys.TraceContext, bool) {
+	return res.traceContext, res.hasContext
+}
+
+func makeSlowTestResource(maker distsys.ArchetypeResourceMaker, opts ...SlowResourceOption) distsys.ArchetypeResource {
+	slowMaker := SlowResourceMaker(maker, opts...)
+	res := slowMaker.Make()
+	slowMaker.Configure(res)
+	return res
+}
+
+func TestSlowResource(t *testing.T) {
+	res := makeSlowTestResource(distsys.LocalArchetypeResourceMaker(tla.MakeTLANumber(1)), WithSlowReads(ConstantLatency(20*time.Millisecond)))
+	start := time.Now()
+	if value, err := res.ReadValue(); err != nil || !value.Equal(tla.MakeTLANumber(1)) {
+		t.Fatalf("expected to read 1, got %v, %v", value, err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Fatalf("expected the read to be delayed by 20ms, but it took %v", elapsed)
+	}
+
+	// the wrapped resource's state is still included in checkpoints
+	snapshotter, ok := res.(distsys.Snapshotter)
+	if !ok {
+		t.Fatal("expected a wrapped local resource to implement Snapshotter")
+	}
+	snapshot, err := snapshotter.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := res.WriteValue(tla.MakeTLANumber(2)); err != nil {
+		t.Fatal(err)
+	}
+	mailboxesTestCommit(t, res)
+	if err := snapshotter.Restore(snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := res.ReadValue(); err != nil || !value.Equal(tla.MakeTLANumber(1)) {
+		t.Fatalf("expected to read 1 once restored, got %v, %v", value, err)
+	}
+
+	// but a wrapper does not claim interfaces the wrapped resource lacks
+	plain := makeSlowTestResource(OutputChannelMaker(make(chan tla.TLAValue)))
+	if _, ok := plain.(distsys.Snapshotter); ok {
+		t.Error("expected a wrapped output channel not to implement Snapshotter")
+	}
+	if _, ok := plain.(distsys.TraceContextCarrier); ok {
+		t.Error("expected a wrapped output channel not to implement TraceContextCarrier")
+	}
+}
+
+func TestSlowResourceTraceContext(t *testing.T) {
+	res := makeSlowTestResource(IncrementalMapMaker(func(tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+			return &carrierTestResource{}
+		})
+	}), WithSlowWrites(ConstantLatency(time.Millisecond)))
+	sub, err := res.Index(tla.MakeTLANumber(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// trace context is propagated through sub-resources, which are wrapped too
+	carrier, ok := sub.(distsys.TraceContextCarrier)
+	if !ok {
+		t.Fatal("expected a wrapped sub-resource that carries trace context to implement TraceContextCarrier")
+	}
+	if _, ok := sub.(distsys.Snapshotter); !ok {
+		t.Fatal("expected a wrapped sub-resource that can be snapshotted to implement Snapshotter")
+	}
+	tc := distsys.TraceContext{TraceID: [16]byte{1}, SpanID: [8]byte{2}, Sampled: true}
+	carrier.SetTraceContext(tc)
+	if err := sub.WriteValue(tla.MakeTLANumber(1)); err != nil {
+		t.Fatal(err)
+	}
+	if received, ok := carrier.ReceivedTraceContext(); !ok || received != tc {
+		t.Fatalf("expected to receive trace context %v, got %v, %v", tc, received, ok)
+	}
+}
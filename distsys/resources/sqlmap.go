@@ -0,0 +1,355 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+const sqlTimeout = 5 * time.Second
+
+type sqlConfig struct {
+	timeout     time.Duration
+	placeholder func(n int) string
+}
+
+// SQLOption configures a resource made by SQLMapMaker.
+type SQLOption func(cfg *sqlConfig)
+
+// WithSQLTimeout sets the timeout of each query, and of each critical section's transaction.
+func WithSQLTimeout(timeout time.Duration) SQLOption {
+	return func(cfg *sqlConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithSQLNumberedPlaceholders makes queries use numbered placeholders ($1, $2, ...), as PostgreSQL drivers
+// require, instead of the default ?.
+func WithSQLNumberedPlaceholders() SQLOption {
+	return func(cfg *sqlConfig) {
+		cfg.placeholder = func(n int) string {
+			return "$" + strconv.Itoa(n)
+		}
+	}
+}
+
+// EnsureSQLMapTable creates the table used by SQLMapMaker, if it does not exist already. The table has a map_key
+// column, holding the hex SHA-256 hash of each index's String() form, so that indices of any length fit in the
+// primary key, a map_index column, holding the String() form itself, a map_value column, holding the gob-encoded
+// value in base64, or nothing if the index was read but never written, and a version column, incremented on each
+// write.
+func EnsureSQLMapTable(ctx context.Context, db *sql.DB, table string) error {
+	_, err := db.ExecContext(ctx, fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (map_key CHAR(64) PRIMARY KEY, map_index TEXT NOT NULL, map_value TEXT NOT NULL, version BIGINT NOT NULL)", table))
+	return err
+}
+
+// SQLMapMaker produces a distsys.ArchetypeResourceMaker for a map persisted in table, which must have the schema
+// created by EnsureSQLMapTable, in db. Values read as initial until they are first written. The table name is
+// used as is in queries, so it must not come from an untrusted source.
+//
+// Each critical section's writes are done in one database transaction, aligned with the critical section: reads
+// remember the version of each row they read, pre-commit opens the transaction and, for each row touched, checks
+// that its version did not change and writes the new value, if any, and commit commits the transaction. If a row
+// changed, or the database reports an error, pre-commit rolls back and aborts the critical section. As
+// pre-commit's updates lock the rows they touch until the transaction ends, commit is not expected to fail; if it
+// does, the archetype's state can no longer be trusted, and commit panics.
+//
+// A row cannot be locked before it exists, so reading an index that has no row inserts an empty one at pre-commit,
+// which reads as initial. This insert conflicts with any other inserting the same index, so that a critical section
+// relying on an index being absent aborts if it is written concurrently.
+func SQLMapMaker(db *sql.DB, table string, initial tla.TLAValue, opts ...SQLOption) distsys.ArchetypeResourceMaker {
+	cfg := sqlConfig{
+		timeout: sqlTimeout,
+		placeholder: func(int) string {
+			return "?"
+		},
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &sqlMapResource{
+				entries: immutable.NewMap(tla.TLAValueHasher{}),
+			}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*sqlMapResource)
+			r.db = db
+			r.table = table
+			r.initial = initial
+			r.config = cfg
+		},
+	}
+}
+
+type sqlMapResource struct {
+	distsys.ArchetypeResourceMapMixin
+	resourceLogger
+	db      *sql.DB
+	table   string
+	initial tla.TLAValue
+	config  sqlConfig
+
+	// entries holds a *sqlEntry for each index touched by the current critical section
+	entries *immutable.Map
+	tx      *sql.Tx
+	cancel  context.CancelFunc
+}
+
+type sqlEntry struct {
+	key          string // the hash of index, as stored in map_key
+	index        string // index's String() form, as stored in map_index
+	hasRead      bool
+	version      int64 // 0 if the row did not exist
+	value        tla.TLAValue
+	writePending bool
+}
+
+var _ distsys.ArchetypeResource = &sqlMapResource{}
+
+func (res *sqlMapResource) entry(index tla.TLAValue) *sqlEntry {
+	if e, ok := res.entries.Get(index); ok {
+		return e.(*sqlEntry)
+	}
+	e := &sqlEntry{index: index.String()}
+	hash := sha256.Sum256([]byte(e.index))
+	e.key = hex.EncodeToString(hash[:])
+	res.entries = res.entries.Set(index, e)
+	return e
+}
+
+func (res *sqlMapResource) read(index tla.TLAValue) (tla.TLAValue, error) {
+	e := res.entry(index)
+	if e.hasRead || e.writePending {
+		return e.value, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), res.config.timeout)
+	defer cancel()
+	var encoded string
+	row := res.db.QueryRowContext(ctx,
+		fmt.Sprintf("SELECT map_value, version FROM %s WHERE map_key = %s", res.table, res.config.placeholder(1)), e.key)
+	err := row.Scan(&encoded, &e.version)
+	switch {
+	case err == sql.ErrNoRows:
+		e.version = 0
+		e.value = res.initial
+	case err != nil:
+		res.log(distsys.LogWarn, "could not read SQL row, aborting", "table", res.table, "index", e.index, "error", err)
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	case encoded == "":
+		// a row inserted by a read, which was never written
+		e.value = res.initial
+	default:
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		if err == nil {
+			err = e.value.GobDecode(data)
+		}
+		if err != nil {
+			return tla.TLAValue{}, fmt.Errorf("could not decode %s from %s: %w", e.index, res.table, err)
+		}
+	}
+	e.hasRead = true
+	return e.value, nil
+}
+
+func (res *sqlMapResource) Index(index tla.TLAValue) (distsys.ArchetypeResource, error) {
+	return &sqlKeyResource{parent: res, index: index}, nil
+}
+
+func (res *sqlMapResource) reset() {
+	res.entries = immutable.NewMap(tla.TLAValueHasher{})
+	res.tx = nil
+	if res.cancel != nil {
+		res.cancel()
+		res.cancel = nil
+	}
+}
+
+func (res *sqlMapResource) Abort() chan struct{} {
+	if res.tx != nil {
+		_ = res.tx.Rollback()
+	}
+	res.reset()
+	return nil
+}
+
+func (res *sqlMapResource) PreCommit() chan error {
+	if res.entries.Len() == 0 {
+		return nil
+	}
+	ch := make(chan error, 1)
+	go func() {
+		ch <- res.prepare()
+	}()
+	return ch
+}
+
+// prepare opens the critical section's transaction, and applies its reads' checks and its writes.
+func (res *sqlMapResource) prepare() error {
+	ctx, cancel := context.WithTimeout(context.Background(), res.config.timeout)
+	tx, err := res.db.BeginTx(ctx, nil)
+	if err != nil {
+		cancel()
+		res.log(distsys.LogWarn, "could not begin SQL transaction, aborting", "table", res.table, "error", err)
+		return distsys.ErrCriticalSectionAborted
+	}
+	res.tx, res.cancel = tx, cancel
+
+	p := res.config.placeholder
+	it := res.entries.Iterator()
+	for !it.Done() {
+		_, entry := it.Next()
+		e := entry.(*sqlEntry)
+		ok, err := res.prepareEntry(ctx, tx, p, e)
+		if err != nil {
+			res.log(distsys.LogWarn, "could not prepare SQL row, aborting", "table", res.table, "index", e.index, "error", err)
+			return distsys.ErrCriticalSectionAborted
+		}
+		if !ok {
+			return distsys.ErrCriticalSectionAborted
+		}
+	}
+	return nil
+}
+
+// prepareEntry checks and writes one row, returning false if it changed since it was read.
+func (res *sqlMapResource) prepareEntry(ctx context.Context, tx *sql.Tx, p func(int) string, e *sqlEntry) (bool, error) {
+	var result sql.Result
+	var err error
+	switch {
+	case !e.writePending:
+		// lock the row, checking it did not change. The version is bumped even so, as some databases do not count
+		// rows left unchanged as affected. A row that did not exist must still not exist, which inserting an empty
+		// one both checks and locks in
+		if e.version == 0 {
+			result, err = res.insertEncoded(ctx, tx, p, e, "")
+			break
+		}
+		result, err = tx.ExecContext(ctx,
+			fmt.Sprintf("UPDATE %s SET version = version + 1 WHERE map_key = %s AND version = %s", res.table, p(1), p(2)),
+			e.key, e.version)
+	case e.hasRead && e.version == 0:
+		result, err = res.insert(ctx, tx, p, e)
+	case e.hasRead:
+		var encoded string
+		encoded, err = encodeSQLValue(e.value)
+		if err != nil {
+			return false, err
+		}
+		result, err = tx.ExecContext(ctx,
+			fmt.Sprintf("UPDATE %s SET map_value = %s, version = version + 1 WHERE map_key = %s AND version = %s", res.table, p(1), p(2), p(3)),
+			encoded, e.key, e.version)
+	default:
+		// a blind write: update the row if it exists, or insert it
+		var encoded string
+		encoded, err = encodeSQLValue(e.value)
+		if err != nil {
+			return false, err
+		}
+		result, err = tx.ExecContext(ctx,
+			fmt.Sprintf("UPDATE %s SET map_value = %s, version = version + 1 WHERE map_key = %s", res.table, p(1), p(2)),
+			encoded, e.key)
+		if err == nil {
+			var n int64
+			n, err = result.RowsAffected()
+			if err == nil && n == 0 {
+				result, err = res.insert(ctx, tx, p, e)
+			}
+		}
+	}
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n == 1, err
+}
+
+func (res *sqlMapResource) insert(ctx context.Context, tx *sql.Tx, p func(int) string, e *sqlEntry) (sql.Result, error) {
+	encoded, err := encodeSQLValue(e.value)
+	if err != nil {
+		return nil, err
+	}
+	return res.insertEncoded(ctx, tx, p, e, encoded)
+}
+
+func (res *sqlMapResource) insertEncoded(ctx context.Context, tx *sql.Tx, p func(int) string, e *sqlEntry, encoded string) (sql.Result, error) {
+	return tx.ExecContext(ctx,
+		fmt.Sprintf("INSERT INTO %s (map_key, map_index, map_value, version) VALUES (%s, %s, %s, 1)", res.table, p(1), p(2), p(3)),
+		e.key, e.index, encoded)
+}
+
+func encodeSQLValue(value tla.TLAValue) (string, error) {
+	data, err := value.GobEncode()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+func (res *sqlMapResource) Commit() chan struct{} {
+	if res.tx == nil {
+		res.reset()
+		return nil
+	}
+	err := res.tx.Commit()
+	if err != nil {
+		panic(fmt.Errorf("could not commit transaction on %s: %w", res.table, err))
+	}
+	res.reset()
+	return nil
+}
+
+func (res *sqlMapResource) Close() error {
+	if res.tx != nil {
+		_ = res.tx.Rollback()
+	}
+	res.reset()
+	return nil
+}
+
+// sqlKeyResource is the value at one index of a sqlMapResource, which does all the work.
+type sqlKeyResource struct {
+	distsys.ArchetypeResourceLeafMixin
+	parent *sqlMapResource
+	index  tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &sqlKeyResource{}
+
+func (res *sqlKeyResource) Abort() chan struct{} {
+	return nil
+}
+
+func (res *sqlKeyResource) PreCommit() chan error {
+	return nil
+}
+
+func (res *sqlKeyResource) Commit() chan struct{} {
+	return nil
+}
+
+func (res *sqlKeyResource) ReadValue() (tla.TLAValue, error) {
+	return res.parent.read(res.index)
+}
+
+func (res *sqlKeyResource) WriteValue(value tla.TLAValue) error {
+	e := res.parent.entry(res.index)
+	e.value = value
+	e.writePending = true
+	return nil
+}
+
+func (res *sqlKeyResource) Close() error {
+	return nil
+}
@@ -0,0 +1,374 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const sqlTestTable = "pgo_map"
+
+var (
+	errSQLTestLocked       = errors.New("row locked by another transaction")
+	errSQLTestDuplicateKey = errors.New("duplicate key")
+)
+
+// memorySQLDatabase is a database/sql driver serving one table with SQLMapMaker's schema, held in memory. It
+// understands only the queries SQLMapMaker makes. Transactions see their own writes, which others only see once
+// committed, and lock the rows they insert or update until they end; rather than waiting for a lock, a conflicting
+// statement fails at once, as with NOWAIT.
+type memorySQLDatabase struct {
+	lock  sync.Mutex
+	rows  map[string]memorySQLRow
+	locks map[string]*memorySQLTx
+}
+
+type memorySQLRow struct {
+	index, value string
+	version      int64
+}
+
+var _ driver.Connector = &memorySQLDatabase{}
+
+func newMemorySQLDatabase(t *testing.T) (*memorySQLDatabase, *sql.DB) {
+	t.Helper()
+	database := &memorySQLDatabase{
+		rows:  make(map[string]memorySQLRow),
+		locks: make(map[string]*memorySQLTx),
+	}
+	db := sql.OpenDB(database)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	if err := EnsureSQLMapTable(context.Background(), db, sqlTestTable); err != nil {
+		t.Fatal(err)
+	}
+	return database, db
+}
+
+func (database *memorySQLDatabase) Connect(context.Context) (driver.Conn, error) {
+	return &memorySQLConn{database: database}, nil
+}
+
+func (database *memorySQLDatabase) Driver() driver.Driver {
+	return memorySQLDriver{}
+}
+
+// row returns the committed row of the string index.
+func (database *memorySQLDatabase) row(index string) (memorySQLRow, bool) {
+	database.lock.Lock()
+	defer database.lock.Unlock()
+	for _, row := range database.rows {
+		if row.index == tla.MakeTLAString(index).String() {
+			return row, true
+		}
+	}
+	return memorySQLRow{}, false
+}
+
+type memorySQLDriver struct{}
+
+func (memorySQLDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("use sql.OpenDB")
+}
+
+type memorySQLConn struct {
+	database *memorySQLDatabase
+	tx       *memorySQLTx
+}
+
+func (conn *memorySQLConn) Prepare(query string) (driver.Stmt, error) {
+	return &memorySQLStmt{conn: conn, query: query}, nil
+}
+
+func (conn *memorySQLConn) Close() error {
+	if conn.tx != nil {
+		return conn.tx.Rollback()
+	}
+	return nil
+}
+
+func (conn *memorySQLConn) Begin() (driver.Tx, error) {
+	conn.tx = &memorySQLTx{conn: conn, pending: make(map[string]memorySQLRow)}
+	return conn.tx, nil
+}
+
+type memorySQLTx struct {
+	conn    *memorySQLConn
+	pending map[string]memorySQLRow
+}
+
+func (tx *memorySQLTx) end(commit bool) {
+	database := tx.conn.database
+	database.lock.Lock()
+	defer database.lock.Unlock()
+	for key, owner := range database.locks {
+		if owner == tx {
+			delete(database.locks, key)
+		}
+	}
+	if commit {
+		for key, row := range tx.pending {
+			database.rows[key] = row
+		}
+	}
+	tx.conn.tx = nil
+}
+
+func (tx *memorySQLTx) Commit() error {
+	tx.end(true)
+	return nil
+}
+
+func (tx *memorySQLTx) Rollback() error {
+	tx.end(false)
+	return nil
+}
+
+type memorySQLStmt struct {
+	conn  *memorySQLConn
+	query string
+}
+
+var (
+	sqlTestPlaceholder = regexp.MustCompile(`\$[0-9]+`)
+	sqlTestQueries     = map[string]string{
+		"create":      "CREATE TABLE IF NOT EXISTS pgo_map ",
+		"select":      "SELECT map_value, version FROM pgo_map WHERE map_key = ?",
+		"lock":        "UPDATE pgo_map SET version = version + 1 WHERE map_key = ? AND version = ?",
+		"update":      "UPDATE pgo_map SET map_value = ?, version = version + 1 WHERE map_key = ? AND version = ?",
+		"blindUpdate": "UPDATE pgo_map SET map_value = ?, version = version + 1 WHERE map_key = ?",
+		"insert":      "INSERT INTO pgo_map (map_key, map_index, map_value, version) VALUES (?, ?, ?, 1)",
+	}
+)
+
+// kind returns which of SQLMapMaker's queries the statement is.
+func (stmt *memorySQLStmt) kind() (string, error) {
+	query := sqlTestPlaceholder.ReplaceAllString(stmt.query, "?")
+	for kind, prefix := range sqlTestQueries {
+		if query == prefix || (kind == "create" && strings.HasPrefix(query, prefix)) {
+			return kind, nil
+		}
+	}
+	return "", fmt.Errorf("unexpected query %q", stmt.query)
+}
+
+func (stmt *memorySQLStmt) Close() error {
+	return nil
+}
+
+func (stmt *memorySQLStmt) NumInput() int {
+	return -1
+}
+
+func (stmt *memorySQLStmt) Exec(args []driver.Value) (driver.Result, error) {
+	kind, err := stmt.kind()
+	if err != nil {
+		return nil, err
+	}
+	if kind == "create" {
+		return driver.RowsAffected(0), nil
+	}
+	tx := stmt.conn.tx
+	if tx == nil {
+		return nil, fmt.Errorf("unexpected query %q outside of a transaction", stmt.query)
+	}
+	database := stmt.conn.database
+	database.lock.Lock()
+	defer database.lock.Unlock()
+
+	var key string
+	switch kind {
+	case "update", "blindUpdate":
+		key = args[1].(string)
+	default:
+		key = args[0].(string)
+	}
+	if owner, ok := database.locks[key]; ok && owner != tx {
+		if kind == "insert" {
+			return nil, errSQLTestDuplicateKey
+		}
+		return nil, errSQLTestLocked
+	}
+	row, exists := tx.pending[key]
+	if !exists {
+		row, exists = database.rows[key]
+	}
+	database.locks[key] = tx
+
+	switch kind {
+	case "insert":
+		if exists {
+			return nil, errSQLTestDuplicateKey
+		}
+		tx.pending[key] = memorySQLRow{index: args[1].(string), value: args[2].(string), version: 1}
+		return driver.RowsAffected(1), nil
+	case "lock", "update":
+		if !exists || row.version != args[len(args)-1].(int64) {
+			return driver.RowsAffected(0), nil
+		}
+	case "blindUpdate":
+		if !exists {
+			return driver.RowsAffected(0), nil
+		}
+	default:
+		return nil, fmt.Errorf("unexpected query %q", stmt.query)
+	}
+	if kind != "lock" {
+		row.value = args[0].(string)
+	}
+	row.version++
+	tx.pending[key] = row
+	return driver.RowsAffected(1), nil
+}
+
+func (stmt *memorySQLStmt) Query(args []driver.Value) (driver.Rows, error) {
+	kind, err := stmt.kind()
+	if err != nil {
+		return nil, err
+	}
+	if kind != "select" || stmt.conn.tx != nil {
+		return nil, fmt.Errorf("unexpected query %q", stmt.query)
+	}
+	database := stmt.conn.database
+	database.lock.Lock()
+	defer database.lock.Unlock()
+	rows := &memorySQLRows{}
+	if row, ok := database.rows[args[0].(string)]; ok {
+		rows.values = [][]driver.Value{{row.value, row.version}}
+	}
+	return rows, nil
+}
+
+type memorySQLRows struct {
+	values [][]driver.Value
+}
+
+func (rows *memorySQLRows) Columns() []string {
+	return []string{"map_value", "version"}
+}
+
+func (rows *memorySQLRows) Close() error {
+	return nil
+}
+
+func (rows *memorySQLRows) Next(dest []driver.Value) error {
+	if len(rows.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, rows.values[0])
+	rows.values = rows.values[1:]
+	return nil
+}
+
+func makeSQLMapTestResource(db *sql.DB, opts ...SQLOption) distsys.ArchetypeResource {
+	maker := SQLMapMaker(db, sqlTestTable, tla.MakeTLAString("initial"), opts...)
+	res := maker.Make()
+	maker.Configure(res)
+	return res
+}
+
+func TestSQLMap(t *testing.T) {
+	_, db := newMemorySQLDatabase(t)
+	a := makeSQLMapTestResource(db)
+	b := makeSQLMapTestResource(db)
+
+	objectStoreTestWrite(t, a, "x", "1")
+	mailboxesTestCommit(t, a)
+	if value := objectStoreTestRead(t, b, "x"); value != "1" {
+		t.Fatalf("expected to read a's write, read %q", value)
+	}
+	mailboxesTestCommit(t, b)
+
+	// both read x and write it based on that, so only the first to commit succeeds
+	objectStoreTestRead(t, a, "x")
+	objectStoreTestWrite(t, a, "x", "2")
+	objectStoreTestRead(t, b, "x")
+	objectStoreTestWrite(t, b, "x", "3")
+	mailboxesTestCommit(t, a)
+	expectObjectStoreTestAbort(t, b)
+	if value := objectStoreTestRead(t, b, "x"); value != "2" {
+		t.Fatalf("expected to read a's write, read %q", value)
+	}
+	mailboxesTestCommit(t, b)
+
+	// a critical section that only reads x aborts if x is being written by another, which locked it first
+	objectStoreTestRead(t, a, "x")
+	objectStoreTestWrite(t, b, "x", "4")
+	if err := <-b.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	expectObjectStoreTestAbort(t, a)
+	b.Commit()
+	if value := objectStoreTestRead(t, a, "x"); value != "4" {
+		t.Fatalf("expected to read b's write, read %q", value)
+	}
+	mailboxesTestCommit(t, a)
+}
+
+func TestSQLMapMissingRow(t *testing.T) {
+	database, db := newMemorySQLDatabase(t)
+	a := makeSQLMapTestResource(db)
+	b := makeSQLMapTestResource(db)
+
+	// a relies on y not existing, which b's write, committed first, makes false
+	if value := objectStoreTestRead(t, a, "y"); value != "initial" {
+		t.Fatalf("expected a missing row to read as initial, read %q", value)
+	}
+	objectStoreTestWrite(t, b, "y", "b")
+	mailboxesTestCommit(t, b)
+	expectObjectStoreTestAbort(t, a)
+
+	// a relies on z not existing, and locks that in at pre-commit, so b's write, committed later, aborts
+	objectStoreTestRead(t, a, "z")
+	if err := <-a.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	objectStoreTestWrite(t, b, "z", "b")
+	expectObjectStoreTestAbort(t, b)
+	a.Commit()
+	if row, ok := database.row("z"); !ok || row.value != "" {
+		t.Fatalf("expected reading z to insert an empty row, got %v, %v", row, ok)
+	}
+
+	// the empty row reads as initial, and is written like any other
+	if value := objectStoreTestRead(t, a, "z"); value != "initial" {
+		t.Fatalf("expected an empty row to read as initial, read %q", value)
+	}
+	objectStoreTestWrite(t, b, "z", "b")
+	mailboxesTestCommit(t, b)
+	expectObjectStoreTestAbort(t, a)
+	if value := objectStoreTestRead(t, a, "z"); value != "b" {
+		t.Fatalf("expected to read b's write, read %q", value)
+	}
+	mailboxesTestCommit(t, a)
+}
+
+func TestSQLMapLongIndex(t *testing.T) {
+	database, db := newMemorySQLDatabase(t)
+	a := makeSQLMapTestResource(db, WithSQLNumberedPlaceholders())
+	b := makeSQLMapTestResource(db, WithSQLNumberedPlaceholders())
+
+	index := strings.Repeat("k", 1000)
+	objectStoreTestWrite(t, a, index, "a")
+	mailboxesTestCommit(t, a)
+	if value := objectStoreTestRead(t, b, index); value != "a" {
+		t.Fatalf("expected to read a's write, read %q", value)
+	}
+	mailboxesTestCommit(t, b)
+	for key, row := range database.rows {
+		if len(key) != 64 || row.index != tla.MakeTLAString(index).String() {
+			t.Fatalf("expected the row to be keyed by a hash of its index, got key %q for index %q", key, row.index)
+		}
+	}
+}
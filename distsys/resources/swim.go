@@ -0,0 +1,571 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"net/rpc"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+const (
+	swimProtocolPeriod    = 1 * time.Second
+	swimProbeTimeout      = 300 * time.Millisecond
+	swimIndirectProbes    = 3
+	swimSuspicionTimeout  = 5 * time.Second
+	swimMaxPiggyback      = 8
+	swimRetransmitMult    = 3
+	swimUnknownMemberWait = 100 * time.Millisecond
+)
+
+// SWIMMemberState is the state of a member of a SWIM group, as seen by one node.
+type SWIMMemberState int
+
+const (
+	SWIMAlive SWIMMemberState = iota
+	// SWIMSuspect means a member failed to answer direct and indirect probes. It becomes SWIMFailed unless it
+	// refutes the suspicion within the suspicion timeout.
+	SWIMSuspect
+	SWIMFailed
+)
+
+func (s SWIMMemberState) String() string {
+	switch s {
+	case SWIMAlive:
+		return "alive"
+	case SWIMSuspect:
+		return "suspect"
+	case SWIMFailed:
+		return "failed"
+	default:
+		return "none"
+	}
+}
+
+// SWIMUpdate is a piece of membership information, disseminated by piggybacking on probe traffic.
+type SWIMUpdate struct {
+	ID          tla.TLAValue
+	Addr        string
+	State       SWIMMemberState
+	Incarnation int32
+}
+
+type swimMember struct {
+	addr        string
+	state       SWIMMemberState
+	incarnation int32
+	suspectedAt time.Time
+}
+
+type swimBroadcast struct {
+	update    SWIMUpdate
+	remaining int
+}
+
+type swimConfig struct {
+	protocolPeriod   time.Duration
+	probeTimeout     time.Duration
+	indirectProbes   int
+	suspicionTimeout time.Duration
+}
+
+// SWIMOption configures a SWIMNode.
+type SWIMOption func(cfg *swimConfig)
+
+// WithSWIMProtocolPeriod sets how often a node probes one of its peers.
+func WithSWIMProtocolPeriod(period time.Duration) SWIMOption {
+	return func(cfg *swimConfig) {
+		cfg.protocolPeriod = period
+	}
+}
+
+// WithSWIMProbeTimeout sets how long a node waits for a peer to acknowledge a direct probe, before asking other
+// peers to probe it indirectly.
+func WithSWIMProbeTimeout(timeout time.Duration) SWIMOption {
+	return func(cfg *swimConfig) {
+		cfg.probeTimeout = timeout
+	}
+}
+
+// WithSWIMIndirectProbes sets how many peers are asked to probe a peer that did not acknowledge a direct probe.
+func WithSWIMIndirectProbes(k int) SWIMOption {
+	return func(cfg *swimConfig) {
+		cfg.indirectProbes = k
+	}
+}
+
+// WithSWIMSuspicionTimeout sets how long a suspected peer has to refute the suspicion before it is declared failed.
+func WithSWIMSuspicionTimeout(timeout time.Duration) SWIMOption {
+	return func(cfg *swimConfig) {
+		cfg.suspicionTimeout = timeout
+	}
+}
+
+// SWIMNode is a member of a decentralized failure detection group, following the SWIM protocol (Das et al.,
+// "SWIM: Scalable Weakly-consistent Infection-style Process Group Membership Protocol"). Each protocol period, a node
+// probes one peer; if the peer does not answer, several other peers are asked to probe it indirectly, and if that
+// also fails the peer is suspected. Suspicions, refutations, and failures are gossiped by piggybacking on probes.
+//
+// Unlike Monitor, there is no central address to query, so there is no single point of failure, and the load of
+// failure detection is spread evenly across the group. At most one SWIMNode should run in each OS process, and its
+// ID should be the index used to refer to that process's archetype in the failure detector resource.
+type SWIMNode struct {
+	ID         tla.TLAValue
+	ListenAddr string
+
+	resourceLogger
+
+	config   swimConfig
+	listener net.Listener
+	server   *rpc.Server
+	done     chan struct{}
+
+	lock        sync.Mutex
+	incarnation int32
+	members     *immutable.Map // ID -> *swimMember, excluding this node
+	broadcasts  []swimBroadcast
+	probeOrder  []tla.TLAValue
+	rng         *rand.Rand
+}
+
+// NewSWIMNode creates a SWIM node identified by id, which will listen on listenAddr. The node does nothing until
+// ListenAndServe is called.
+func NewSWIMNode(id tla.TLAValue, listenAddr string, opts ...SWIMOption) *SWIMNode {
+	cfg := swimConfig{
+		protocolPeriod:   swimProtocolPeriod,
+		probeTimeout:     swimProbeTimeout,
+		indirectProbes:   swimIndirectProbes,
+		suspicionTimeout: swimSuspicionTimeout,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &SWIMNode{
+		ID:         id,
+		ListenAddr: listenAddr,
+		config:     cfg,
+		done:       make(chan struct{}),
+		members:    immutable.NewMap(tla.TLAValueHasher{}),
+		rng:        rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// ListenAndServe starts answering probes from peers, and probing them in turn. It blocks until an error occurs or
+// the node closes.
+func (node *SWIMNode) ListenAndServe() error {
+	node.server = rpc.NewServer()
+	err := node.server.Register(&SWIMRPCReceiver{node: node})
+	if err != nil {
+		return err
+	}
+	node.listener, err = net.Listen("tcp", node.ListenAddr)
+	if err != nil {
+		return err
+	}
+	node.log(distsys.LogInfo, "SWIM node started listening", "node", node.ID, "address", node.ListenAddr)
+	go node.probeLoop()
+	for {
+		conn, err := node.listener.Accept()
+		if err != nil {
+			select {
+			case <-node.done:
+				return nil
+			default:
+				return err
+			}
+		}
+		go node.server.ServeConn(conn)
+	}
+}
+
+// Join contacts the nodes at seedAddrs, and learns the group's membership from them. It succeeds if at least one
+// seed answered. A node that does not join will still be joined by any peer that learns its address.
+func (node *SWIMNode) Join(seedAddrs ...string) error {
+	var lastErr error
+	joined := false
+	for _, addr := range seedAddrs {
+		var reply SWIMMessage
+		err := swimCall(addr, "SWIMRPCReceiver.Join", SWIMMessage{Updates: []SWIMUpdate{node.selfUpdate()}},
+			&reply, node.config.probeTimeout)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		node.merge(reply.Updates)
+		joined = true
+	}
+	if !joined && lastErr != nil {
+		return fmt.Errorf("could not join any SWIM seed: %w", lastErr)
+	}
+	return nil
+}
+
+// MemberState returns the state of the member with the given ID, as currently seen by this node. This node always
+// considers itself alive. The boolean result is false if the member is not known.
+func (node *SWIMNode) MemberState(id tla.TLAValue) (SWIMMemberState, bool) {
+	if id.Equal(node.ID) {
+		return SWIMAlive, true
+	}
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	member, ok := node.members.Get(id)
+	if !ok {
+		return 0, false
+	}
+	return member.(*swimMember).state, true
+}
+
+// Close stops the node. Peers will eventually consider it failed.
+func (node *SWIMNode) Close() error {
+	var err error
+	close(node.done)
+	if node.listener != nil {
+		err = node.listener.Close()
+	}
+	return err
+}
+
+func (node *SWIMNode) selfUpdate() SWIMUpdate {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	return SWIMUpdate{ID: node.ID, Addr: node.ListenAddr, State: SWIMAlive, Incarnation: node.incarnation}
+}
+
+// enqueueLocked schedules update for dissemination, replacing any older update about the same member.
+func (node *SWIMNode) enqueueLocked(update SWIMUpdate) {
+	retransmits := swimRetransmitMult * int(math.Ceil(math.Log2(float64(node.members.Len()+2))))
+	for i := range node.broadcasts {
+		if node.broadcasts[i].update.ID.Equal(update.ID) {
+			node.broadcasts[i] = swimBroadcast{update: update, remaining: retransmits}
+			return
+		}
+	}
+	node.broadcasts = append(node.broadcasts, swimBroadcast{update: update, remaining: retransmits})
+}
+
+// gossip picks the updates to piggyback on an outgoing message. This node's own liveness is always included, so
+// that peers learn about it.
+func (node *SWIMNode) gossip() []SWIMUpdate {
+	updates := []SWIMUpdate{node.selfUpdate()}
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	kept := node.broadcasts[:0]
+	for _, broadcast := range node.broadcasts {
+		if len(updates) < swimMaxPiggyback {
+			updates = append(updates, broadcast.update)
+			broadcast.remaining--
+		}
+		if broadcast.remaining > 0 {
+			kept = append(kept, broadcast)
+		}
+	}
+	node.broadcasts = kept
+	return updates
+}
+
+// merge applies updates received from a peer, following SWIM's precedence rules: higher incarnations win, and at
+// equal incarnations failed beats suspect, which beats alive.
+func (node *SWIMNode) merge(updates []SWIMUpdate) {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	for _, update := range updates {
+		if update.ID.Equal(node.ID) {
+			if update.State != SWIMAlive && update.Incarnation >= node.incarnation {
+				// refute the suspicion by announcing a newer incarnation
+				node.incarnation = update.Incarnation + 1
+				node.enqueueLocked(SWIMUpdate{ID: node.ID, Addr: node.ListenAddr, State: SWIMAlive, Incarnation: node.incarnation})
+			}
+			continue
+		}
+		var member *swimMember
+		if existing, ok := node.members.Get(update.ID); ok {
+			member = existing.(*swimMember)
+			if update.Incarnation < member.incarnation ||
+				(update.Incarnation == member.incarnation && update.State <= member.state) {
+				continue
+			}
+		} else {
+			member = &swimMember{}
+			node.members = node.members.Set(update.ID, member)
+		}
+		if member.state != update.State {
+			node.log(distsys.LogInfo, "SWIM member changed state", "node", node.ID, "member", update.ID, "state", update.State, "incarnation", update.Incarnation)
+		}
+		member.addr = update.Addr
+		member.state = update.State
+		member.incarnation = update.Incarnation
+		if update.State == SWIMSuspect {
+			member.suspectedAt = time.Now()
+		}
+		node.enqueueLocked(update)
+	}
+}
+
+// setStateLocked changes the state of a member based on this node's own observations, and gossips the change.
+func (node *SWIMNode) setStateLocked(id tla.TLAValue, member *swimMember, state SWIMMemberState) {
+	node.log(distsys.LogInfo, "SWIM member changed state", "node", node.ID, "member", id, "state", state, "incarnation", member.incarnation)
+	member.state = state
+	if state == SWIMSuspect {
+		member.suspectedAt = time.Now()
+	}
+	node.enqueueLocked(SWIMUpdate{ID: id, Addr: member.addr, State: state, Incarnation: member.incarnation})
+}
+
+// nextTarget picks the next member to probe, visiting members in a random order that is reshuffled after each
+// round, as described in the SWIM paper.
+func (node *SWIMNode) nextTarget() (tla.TLAValue, string, bool) {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	for attempts := 0; attempts < 2; attempts++ {
+		for len(node.probeOrder) > 0 {
+			id := node.probeOrder[0]
+			node.probeOrder = node.probeOrder[1:]
+			if member, ok := node.members.Get(id); ok && member.(*swimMember).state != SWIMFailed {
+				return id, member.(*swimMember).addr, true
+			}
+		}
+		it := node.members.Iterator()
+		for !it.Done() {
+			id, _ := it.Next()
+			node.probeOrder = append(node.probeOrder, id.(tla.TLAValue))
+		}
+		node.rng.Shuffle(len(node.probeOrder), func(i, j int) {
+			node.probeOrder[i], node.probeOrder[j] = node.probeOrder[j], node.probeOrder[i]
+		})
+	}
+	return tla.TLAValue{}, "", false
+}
+
+// helpers picks up to k live members other than target, to probe target indirectly.
+func (node *SWIMNode) helpers(target tla.TLAValue, k int) []string {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	var candidates []string
+	it := node.members.Iterator()
+	for !it.Done() {
+		id, member := it.Next()
+		if !id.(tla.TLAValue).Equal(target) && member.(*swimMember).state == SWIMAlive {
+			candidates = append(candidates, member.(*swimMember).addr)
+		}
+	}
+	node.rng.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > k {
+		candidates = candidates[:k]
+	}
+	return candidates
+}
+
+func (node *SWIMNode) ping(addr string, timeout time.Duration) bool {
+	var reply SWIMMessage
+	err := swimCall(addr, "SWIMRPCReceiver.Ping", SWIMMessage{Updates: node.gossip()}, &reply, timeout)
+	if err != nil {
+		return false
+	}
+	node.merge(reply.Updates)
+	return true
+}
+
+func (node *SWIMNode) probe() {
+	target, addr, ok := node.nextTarget()
+	if !ok {
+		return
+	}
+	acked := node.ping(addr, node.config.probeTimeout)
+	if !acked {
+		helpers := node.helpers(target, node.config.indirectProbes)
+		acks := make(chan bool, len(helpers))
+		for _, helper := range helpers {
+			helper := helper
+			go func() {
+				var reply SWIMPingReqReply
+				args := SWIMPingReqArgs{TargetAddr: addr, Updates: node.gossip()}
+				err := swimCall(helper, "SWIMRPCReceiver.PingReq", args, &reply, node.config.protocolPeriod-node.config.probeTimeout)
+				if err != nil {
+					acks <- false
+					return
+				}
+				node.merge(reply.Updates)
+				acks <- reply.Ack
+			}()
+		}
+		for range helpers {
+			if <-acks {
+				acked = true
+				break
+			}
+		}
+	}
+
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	if member, ok := node.members.Get(target); ok {
+		// on success there is nothing to do: if the member is suspected, it will refute the suspicion itself
+		member := member.(*swimMember)
+		if !acked && member.state == SWIMAlive {
+			node.setStateLocked(target, member, SWIMSuspect)
+		}
+	}
+}
+
+func (node *SWIMNode) expireSuspicions() {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	now := time.Now()
+	it := node.members.Iterator()
+	for !it.Done() {
+		id, member := it.Next()
+		m := member.(*swimMember)
+		if m.state == SWIMSuspect && now.Sub(m.suspectedAt) >= node.config.suspicionTimeout {
+			node.setStateLocked(id.(tla.TLAValue), m, SWIMFailed)
+		}
+	}
+}
+
+func (node *SWIMNode) probeLoop() {
+	ticker := time.NewTicker(node.config.protocolPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-node.done:
+			return
+		case <-ticker.C:
+		}
+		node.expireSuspicions()
+		node.probe()
+	}
+}
+
+// swimCall makes a single RPC to addr, giving up after timeout.
+func swimCall(addr string, method string, args interface{}, reply interface{}, timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return err
+	}
+	client := rpc.NewClient(conn)
+	defer func() {
+		_ = client.Close()
+	}()
+	call := client.Go(method, args, reply, nil)
+	select {
+	case <-call.Done:
+		return call.Error
+	case <-time.After(timeout):
+		return errors.New("SWIM call timed out")
+	}
+}
+
+// SWIMMessage carries piggybacked membership updates, in both directions of the Ping and Join RPCs.
+type SWIMMessage struct {
+	Updates []SWIMUpdate
+}
+
+// SWIMPingReqArgs asks the receiver to probe TargetAddr on the sender's behalf.
+type SWIMPingReqArgs struct {
+	TargetAddr string
+	Updates    []SWIMUpdate
+}
+
+// SWIMPingReqReply reports whether an indirect probe was acknowledged.
+type SWIMPingReqReply struct {
+	Ack     bool
+	Updates []SWIMUpdate
+}
+
+type SWIMRPCReceiver struct {
+	node *SWIMNode
+}
+
+func (rcvr *SWIMRPCReceiver) Ping(args SWIMMessage, reply *SWIMMessage) error {
+	rcvr.node.merge(args.Updates)
+	reply.Updates = rcvr.node.gossip()
+	return nil
+}
+
+func (rcvr *SWIMRPCReceiver) PingReq(args SWIMPingReqArgs, reply *SWIMPingReqReply) error {
+	rcvr.node.merge(args.Updates)
+	reply.Ack = rcvr.node.ping(args.TargetAddr, rcvr.node.config.probeTimeout)
+	reply.Updates = rcvr.node.gossip()
+	return nil
+}
+
+// Join replies with this node's entire view of the membership, so that a new node does not have to wait for it to
+// be gossiped.
+func (rcvr *SWIMRPCReceiver) Join(args SWIMMessage, reply *SWIMMessage) error {
+	node := rcvr.node
+	node.merge(args.Updates)
+	reply.Updates = []SWIMUpdate{node.selfUpdate()}
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	it := node.members.Iterator()
+	for !it.Done() {
+		id, member := it.Next()
+		m := member.(*swimMember)
+		reply.Updates = append(reply.Updates, SWIMUpdate{ID: id.(tla.TLAValue), Addr: m.addr, State: m.state, Incarnation: m.incarnation})
+	}
+	return nil
+}
+
+// SWIMFailureDetectorMaker produces a distsys.ArchetypeResourceMaker for a collection of failure detectors backed
+// by node's view of its SWIM group, rather than by a central Monitor. Index i of the collection reads TRUE once the
+// member with ID i has been declared failed, and FALSE while it is alive or merely suspected. It refines the same
+// PracticalFD mapping macro as FailureDetectorMaker. Reading a member that node has not heard of yet aborts the
+// critical section, giving the membership time to propagate.
+func SWIMFailureDetectorMaker(node *SWIMNode) distsys.ArchetypeResourceMaker {
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+			return &swimFailureDetector{
+				node:        node,
+				archetypeID: index,
+			}
+		})
+	})
+}
+
+type swimFailureDetector struct {
+	distsys.ArchetypeResourceLeafMixin
+	node        *SWIMNode
+	archetypeID tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &swimFailureDetector{}
+
+func (res *swimFailureDetector) Abort() chan struct{} {
+	return nil
+}
+
+func (res *swimFailureDetector) PreCommit() chan error {
+	return nil
+}
+
+func (res *swimFailureDetector) Commit() chan struct{} {
+	return nil
+}
+
+func (res *swimFailureDetector) ReadValue() (tla.TLAValue, error) {
+	state, ok := res.node.MemberState(res.archetypeID)
+	if !ok {
+		time.Sleep(swimUnknownMemberWait)
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	}
+	if state == SWIMFailed {
+		return tla.TLA_TRUE, nil
+	}
+	return tla.TLA_FALSE, nil
+}
+
+func (res *swimFailureDetector) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write value %v to a SWIM failure detector resource", value))
+}
+
+func (res *swimFailureDetector) Close() error {
+	return nil
+}
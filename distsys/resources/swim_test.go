@@ -0,0 +1,162 @@
+package resources
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// freeLocalAddr returns a loopback address that was free a moment ago, for nodes that must know their own address
+// before they start listening.
+func freeLocalAddr(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	if err := listener.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
+
+// startSWIMTestGroup starts one SWIM node per ID, all joined to the first.
+func startSWIMTestGroup(t *testing.T, ids []int32, opts ...SWIMOption) []*SWIMNode {
+	t.Helper()
+	var nodes []*SWIMNode
+	for _, id := range ids {
+		node := NewSWIMNode(tla.MakeTLANumber(id), freeLocalAddr(t), opts...)
+		serveErr := make(chan error, 1)
+		go func() {
+			serveErr <- node.ListenAndServe()
+		}()
+		t.Cleanup(func() {
+			select {
+			case <-node.done: // closed by the test
+			default:
+				_ = node.Close()
+			}
+			if err := <-serveErr; err != nil {
+				t.Error(err)
+			}
+		})
+		nodes = append(nodes, node)
+	}
+	for _, node := range nodes[1:] {
+		awaitCondition(t, "seed to start listening", func() bool {
+			return node.Join(nodes[0].ListenAddr) == nil
+		})
+	}
+	for _, node := range nodes {
+		for _, peer := range nodes {
+			node, peer := node, peer
+			awaitCondition(t, "membership to propagate", func() bool {
+				state, ok := node.MemberState(peer.ID)
+				return ok && state == SWIMAlive
+			})
+		}
+	}
+	return nodes
+}
+
+// awaitCondition polls cond until it holds, failing the test if it takes too long.
+func awaitCondition(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSWIMMerge(t *testing.T) {
+	node := NewSWIMNode(tla.MakeTLANumber(1), "127.0.0.1:0")
+	peer := tla.MakeTLANumber(2)
+	steps := []struct {
+		update   SWIMUpdate
+		expected SWIMMemberState
+	}{
+		{SWIMUpdate{ID: peer, State: SWIMAlive, Incarnation: 1}, SWIMAlive},
+		{SWIMUpdate{ID: peer, State: SWIMSuspect, Incarnation: 0}, SWIMAlive}, // stale incarnation
+		{SWIMUpdate{ID: peer, State: SWIMSuspect, Incarnation: 1}, SWIMSuspect},
+		{SWIMUpdate{ID: peer, State: SWIMAlive, Incarnation: 1}, SWIMSuspect}, // suspect beats alive
+		{SWIMUpdate{ID: peer, State: SWIMAlive, Incarnation: 2}, SWIMAlive},   // refuted
+		{SWIMUpdate{ID: peer, State: SWIMFailed, Incarnation: 2}, SWIMFailed},
+		{SWIMUpdate{ID: peer, State: SWIMSuspect, Incarnation: 2}, SWIMFailed}, // failed beats suspect
+	}
+	for i, step := range steps {
+		node.merge([]SWIMUpdate{step.update})
+		state, ok := node.MemberState(peer)
+		if !ok || state != step.expected {
+			t.Fatalf("after step %d, merging %+v, expected %v, got %v (known: %v)", i, step.update, step.expected, state, ok)
+		}
+	}
+
+	// suspicions of the node itself are refuted with a higher incarnation
+	node.merge([]SWIMUpdate{{ID: node.ID, State: SWIMSuspect, Incarnation: 0}})
+	if self := node.selfUpdate(); self.Incarnation != 1 || self.State != SWIMAlive {
+		t.Fatalf("expected the node to refute the suspicion at incarnation 1, got %+v", self)
+	}
+}
+
+func TestSWIMSuspectThenFailed(t *testing.T) {
+	const suspicionTimeout = 500 * time.Millisecond
+	nodes := startSWIMTestGroup(t, []int32{1, 2, 3},
+		WithSWIMProtocolPeriod(20*time.Millisecond),
+		WithSWIMProbeTimeout(10*time.Millisecond),
+		WithSWIMSuspicionTimeout(suspicionTimeout))
+	observer, bystander, victim := nodes[0], nodes[1], nodes[2]
+
+	if err := victim.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var suspectedAt time.Time
+	awaitCondition(t, "the closed node to be suspected", func() bool {
+		state, _ := observer.MemberState(victim.ID)
+		if state == SWIMFailed {
+			t.Fatal("the closed node was declared failed without first being suspected")
+		}
+		suspectedAt = time.Now()
+		return state == SWIMSuspect
+	})
+	awaitCondition(t, "the suspected node to be declared failed", func() bool {
+		state, _ := observer.MemberState(victim.ID)
+		return state == SWIMFailed
+	})
+	if elapsed := time.Since(suspectedAt); elapsed < suspicionTimeout/2 {
+		t.Fatalf("the suspected node was declared failed after %v, well before the suspicion timeout of %v", elapsed, suspicionTimeout)
+	}
+	awaitCondition(t, "the failure to be gossiped", func() bool {
+		state, _ := bystander.MemberState(victim.ID)
+		return state == SWIMFailed
+	})
+	if state, _ := observer.MemberState(bystander.ID); state != SWIMAlive {
+		t.Fatalf("expected the remaining node to stay alive, but it is %v", state)
+	}
+}
+
+func TestSWIMRefuteSuspicion(t *testing.T) {
+	nodes := startSWIMTestGroup(t, []int32{1, 2},
+		WithSWIMProtocolPeriod(20*time.Millisecond),
+		WithSWIMProbeTimeout(10*time.Millisecond),
+		WithSWIMSuspicionTimeout(time.Hour))
+	observer, suspected := nodes[0], nodes[1]
+
+	// inject a false suspicion, as if a probe had been lost
+	observer.merge([]SWIMUpdate{{ID: suspected.ID, Addr: suspected.ListenAddr, State: SWIMSuspect}})
+	if state, _ := observer.MemberState(suspected.ID); state != SWIMSuspect {
+		t.Fatalf("expected the injected suspicion to take effect, got %v", state)
+	}
+	awaitCondition(t, "the suspected node to refute the suspicion", func() bool {
+		state, _ := observer.MemberState(suspected.ID)
+		return state == SWIMAlive
+	})
+	if self := suspected.selfUpdate(); self.Incarnation == 0 {
+		t.Fatal("expected the refutation to use a newer incarnation")
+	}
+}
@@ -1,9 +1,8 @@
 package resources
 
 import (
-	"encoding/gob"
 	"fmt"
-	"log"
+	"io"
 	"net"
 	"sync"
 	"time"
@@ -38,11 +37,329 @@ const (
 
 // TCPMailboxesAddressMappingFn is responsible for translating the index, as in network[index] from distsys.TLAValue to a pair of
 // TCPMailboxKind and address string, where the address string would be appropriate to pass to net.Listen("tcp", ...)
-// or net.Dial("tcp", ...). It should return TCPMailboxesLocal if this node is to be the only listener, and it should
-// return TCPMailboxesRemote if the mailbox is remote and should be dialed. This could potentially allow unusual setups
-// where a single process "owns" more than one mailbox.
+// or net.Dial("tcp", ...). Alternatively, an address of the form unix:///path/to/socket refers to a Unix domain
+// socket, which avoids TCP overhead and port management for archetypes co-located on one host. It should return
+// TCPMailboxesLocal if this node is to be the only listener, and it should return TCPMailboxesRemote if the mailbox is
+// remote and should be dialed. This could potentially allow unusual setups where a single process "owns" more than one
+// mailbox.
 type TCPMailboxesAddressMappingFn func(tla.TLAValue) (TCPMailboxKind, string)
 
+// TCPMailboxesOption configures a collection of TCP mailboxes, as produced by TCPMailboxesMaker.
+type TCPMailboxesOption func(cfg *tcpMailboxesConfig)
+
+type tcpMailboxesConfig struct {
+	senderID    string
+	incarnation int32
+	ttlFn       TCPMailboxesTTLFn
+	codec       MailboxCodec
+
+	compressors          []MailboxCompressor // proposed by remote mailboxes, in order of preference
+	compressionThreshold int
+
+	resolver   TCPMailboxesResolver
+	membership *TCPMailboxesMembership
+
+	delivery    TCPMailboxesDelivery
+	deduplicate bool
+
+	sendTimeoutFn    func(index tla.TLAValue) time.Duration
+	breakerThreshold int
+	breakerCooldown  time.Duration
+
+	metrics MailboxMetrics
+
+	receiveFilters []TCPMailboxesReceiveFilter
+
+	bindFn TCPMailboxesBindFn
+
+	transport MailboxTransport
+
+	priorityFn TCPMailboxesPriorityFn
+}
+
+// wrapForMetrics counts the bytes passing through rw, if metrics are configured.
+func (cfg *tcpMailboxesConfig) wrapForMetrics(index tla.TLAValue, rw io.ReadWriter) io.ReadWriter {
+	if cfg.metrics == nil {
+		return rw
+	}
+	return countingReadWriter{
+		rw: rw,
+		onRead: func(n int) {
+			cfg.metrics.BytesReceived(index, n)
+		},
+		onWrite: func(n int) {
+			cfg.metrics.BytesSent(index, n)
+		},
+	}
+}
+
+func (cfg *tcpMailboxesConfig) sendTimeout(index tla.TLAValue) time.Duration {
+	if cfg.sendTimeoutFn != nil {
+		return cfg.sendTimeoutFn(index)
+	}
+	return tcpMailboxesTCPTimeout
+}
+
+// acceptedCompressors lists the compressors a local mailbox accepts: any that were configured, and DEFLATE.
+func (cfg *tcpMailboxesConfig) acceptedCompressors() []MailboxCompressor {
+	return append(cfg.compressors[:len(cfg.compressors):len(cfg.compressors)], FlateMailboxCompressor{})
+}
+
+func (cfg *tcpMailboxesConfig) isMember(index tla.TLAValue) bool {
+	return cfg.membership == nil || cfg.membership.IsMember(index)
+}
+
+func makeTCPMailboxesConfig(opts []TCPMailboxesOption) *tcpMailboxesConfig {
+	cfg := &tcpMailboxesConfig{
+		codec:     GobMailboxCodec{},
+		transport: DefaultMailboxTransport{},
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithTCPMailboxesIncarnation stamps every message sent from this process's remote mailboxes with the sender's
+// identity and incarnation number. The incarnation should be a restart counter that strictly increases every time
+// the sender restarts (e.g. persisted to disk, or derived from a durable source).
+//
+// Local mailboxes remember the highest incarnation they have seen per sender, and drop messages from any older
+// incarnation. This fences off traffic that was in-flight before a crash, which the crash model of an MPCal spec
+// usually assumes is lost along with the crashed process.
+func WithTCPMailboxesIncarnation(senderID tla.TLAValue, incarnation int32) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.senderID = senderID.String()
+		cfg.incarnation = incarnation
+	}
+}
+
+// WithMailboxCodec sets the wire encoding used by the mailboxes. All processes exchanging messages must agree on
+// the codec. The default is GobMailboxCodec.
+func WithMailboxCodec(codec MailboxCodec) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.codec = codec
+	}
+}
+
+// WithTCPMailboxesCompression enables compression of values sent through this process's remote mailboxes.
+// Compression is negotiated once per connection, when it is established: the compressors are proposed in order of
+// preference, and the receiving mailbox picks the first one it knows, or none. If no compressors are given, DEFLATE
+// (FlateMailboxCompressor) is used. The standard library provides neither snappy nor zstd, but either can be used by
+// implementing MailboxCompressor, and passing it to this option in every process. Local mailboxes accept the
+// compressors given here, as well as DEFLATE, which they accept even without this option.
+//
+// Values whose encoding is smaller than threshold bytes are sent uncompressed, as compressing them is rarely worth
+// the CPU time.
+func WithTCPMailboxesCompression(threshold int, compressors ...MailboxCompressor) TCPMailboxesOption {
+	if len(compressors) == 0 {
+		compressors = []MailboxCompressor{FlateMailboxCompressor{}}
+	}
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.compressors = compressors
+		cfg.compressionThreshold = threshold
+	}
+}
+
+// TCPMailboxesResolver dynamically resolves the address of a remote mailbox, e.g. via DNS SRV records, Consul, or
+// etcd. See WithTCPMailboxesResolver.
+type TCPMailboxesResolver interface {
+	Resolve(index tla.TLAValue) (string, error)
+}
+
+// TCPMailboxesResolverFn adapts a plain function to the TCPMailboxesResolver interface.
+type TCPMailboxesResolverFn func(index tla.TLAValue) (string, error)
+
+var _ TCPMailboxesResolver = TCPMailboxesResolverFn(nil)
+
+func (fn TCPMailboxesResolverFn) Resolve(index tla.TLAValue) (string, error) {
+	return fn(index)
+}
+
+// WithTCPMailboxesResolver makes remote mailboxes query resolver for their peer's address every time they need
+// to establish a connection: initially, and again after any connection failure. This allows nodes to move hosts
+// without restarting every peer. The address returned by the TCPMailboxesAddressMappingFn is still used to decide
+// which mailboxes are local, and as a fallback whenever resolution fails.
+func WithTCPMailboxesResolver(resolver TCPMailboxesResolver) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.resolver = resolver
+	}
+}
+
+// WithTCPMailboxesMembership restricts sending to the mailbox indices that are members of membership. Writes to a
+// non-member are dropped, as if the message was lost in transit, and commits that are stuck retrying delivery to a
+// member that is removed give up. Without this option, every index is considered a member.
+func WithTCPMailboxesMembership(membership *TCPMailboxesMembership) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.membership = membership
+	}
+}
+
+// TCPMailboxesDelivery selects what happens to a critical section's messages when the connection breaks during
+// Commit, after the receiver has already agreed to accept them.
+type TCPMailboxesDelivery int
+
+const (
+	// TCPMailboxesAtLeastOnce reconnects and resends until the commit is acknowledged. If the receiver had already
+	// delivered the messages before the connection broke, they will be delivered twice, unless deduplication is
+	// enabled via WithTCPMailboxesDeduplication. This is the default.
+	TCPMailboxesAtLeastOnce TCPMailboxesDelivery = iota
+	// TCPMailboxesAtMostOnce gives up on the messages instead of resending them, so they may be lost.
+	TCPMailboxesAtMostOnce
+)
+
+// WithTCPMailboxesDelivery sets the delivery guarantee of remote mailboxes. See TCPMailboxesDelivery.
+func WithTCPMailboxesDelivery(delivery TCPMailboxesDelivery) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.delivery = delivery
+	}
+}
+
+// WithTCPMailboxesDeduplication numbers each critical section's worth of messages sent from a remote mailbox, and
+// makes the receiving local mailbox deliver each sequence number at most once, and only in increasing order. Combined
+// with at-least-once delivery, this gives exactly-once, FIFO delivery per sender-receiver pair, even across
+// reconnections. Since receivers track sequence numbers per sender, this option requires WithTCPMailboxesIncarnation,
+// and each sender must use a distinct sender ID. Each remote mailbox numbers its own transactions, so within a
+// process, only one remote mailbox at a time may send to a given mailbox under a given sender ID: if another
+// collection of mailboxes, e.g. one made for a second archetype, needs one too, creating it panics, rather than let
+// the receiver drop one sender's messages as duplicates of the other's. Closing the mailboxes releases their claim.
+func WithTCPMailboxesDeduplication() TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.deduplicate = true
+	}
+}
+
+// WithTCPMailboxesSendTimeout sets the timeout used when dialing, writing to, and awaiting replies from each remote
+// mailbox, as a function of the mailbox's index. This allows e.g. WAN peers to be given more slack than LAN peers.
+func WithTCPMailboxesSendTimeout(timeoutFn func(index tla.TLAValue) time.Duration) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.sendTimeoutFn = timeoutFn
+	}
+}
+
+// WithTCPMailboxesCircuitBreaker makes each remote mailbox stop trying to reach its peer after threshold consecutive
+// network failures. While the circuit is open, writes to the mailbox immediately abort the critical section, rather
+// than waiting on a dial timeout. After cooldown, one attempt is let through; if it succeeds the circuit closes,
+// otherwise it stays open for another cooldown. Commits are never fast-failed, since they must complete.
+func WithTCPMailboxesCircuitBreaker(threshold int, cooldown time.Duration) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.breakerThreshold = threshold
+		cfg.breakerCooldown = cooldown
+	}
+}
+
+// TCPMailboxesReceiveFilter inspects a value that has just been committed into the local mailbox at index. The
+// sender is the ID given to WithTCPMailboxesIncarnation by the sending process, formatted by its String method (so
+// a TLA+ string keeps its quotes), or "" if it has none. Returning a non-nil error rejects the value: it is logged
+// and dropped, and will never be read by the archetype.
+type TCPMailboxesReceiveFilter func(index tla.TLAValue, sender string, value tla.TLAValue) error
+
+// WithTCPMailboxesReceiveFilter installs filter on all local mailboxes, e.g. for schema validation, checking
+// authentication tokens, or rate limiting, without modifying generated code. If the option is given more than once,
+// all the filters are applied in order, and a value must be accepted by each of them.
+func WithTCPMailboxesReceiveFilter(filter TCPMailboxesReceiveFilter) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.receiveFilters = append(cfg.receiveFilters, filter)
+	}
+}
+
+// TCPMailboxesBindFn decides which address a local mailbox should listen on, given the mailbox's index and the
+// address advertised for it by the TCPMailboxesAddressMappingFn (which is what peers dial). An error is reported
+// the same way as failing to listen on the address.
+type TCPMailboxesBindFn func(index tla.TLAValue, advertisedAddr string) (string, error)
+
+// WithTCPMailboxesBindAddress separates the address local mailboxes listen on from the address advertised to peers.
+// This is needed for NAT or container deployments, where a node must e.g. bind 0.0.0.0 while being reachable at
+// some external address. See BindAllInterfaces for the common case.
+func WithTCPMailboxesBindAddress(bindFn TCPMailboxesBindFn) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.bindFn = bindFn
+	}
+}
+
+// BindAllInterfaces is a TCPMailboxesBindFn that listens on all interfaces, using the port of the advertised address.
+func BindAllInterfaces(_ tla.TLAValue, advertisedAddr string) (string, error) {
+	_, port, err := net.SplitHostPort(advertisedAddr)
+	if err != nil {
+		return "", fmt.Errorf("could not find port in advertised address %s: %w", advertisedAddr, err)
+	}
+	return net.JoinHostPort("0.0.0.0", port), nil
+}
+
+// TCPMailboxesTTLFn decides the time-to-live of a value about to be sent through a remote mailbox. A TTL of zero
+// or less means the value never expires. Inspecting the value allows both per-message-type TTLs (by looking at a type
+// tag) and per-send TTLs (by looking at a field carried in the message itself).
+type TCPMailboxesTTLFn func(value tla.TLAValue) time.Duration
+
+// WithTCPMailboxesMessageTTL attaches a TTL, as decided by ttlFn, to every value sent through this process's remote
+// mailboxes. A value's TTL starts counting when it is written; if the value has to be resent during Commit, only
+// what remains of its TTL is sent along with it. The receiving mailbox silently discards the value if the TTL has
+// elapsed by the time the archetype tries to read it, including when it is re-read after an aborted critical section.
+// This prevents long-queued requests from being processed after they have become meaningless, e.g. after the client
+// that sent them has timed out.
+func WithTCPMailboxesMessageTTL(ttlFn TCPMailboxesTTLFn) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.ttlFn = ttlFn
+	}
+}
+
+// TCPMailboxesPriorityFn tags a value received by a local mailbox as high priority, e.g. by looking at a message
+// type field, so that heartbeats or leadership transfers can be told apart from bulk data.
+type TCPMailboxesPriorityFn func(value tla.TLAValue) bool
+
+// WithTCPMailboxesPriority gives local mailboxes a second, priority lane. Values for which isPriority returns true
+// are queued in that lane, and are always read before any value waiting in the ordinary lane, so that control-plane
+// messages are not stuck behind large queues of data messages. Values within each lane remain in FIFO order, but
+// a priority value may overtake ordinary values sent before it.
+func WithTCPMailboxesPriority(isPriority TCPMailboxesPriorityFn) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.priorityFn = isPriority
+	}
+}
+
+// tcpMailboxesMessage is a received value, along with the deadline after which it should be discarded.
+// A zero expiry means the message does not expire.
+type tcpMailboxesMessage struct {
+	value        tla.TLAValue
+	expiry       time.Time
+	traceContext distsys.TraceContext // the context of the sending critical section's span, if it was traced
+	priority     bool                 // whether the message was queued in the priority lane
+}
+
+func (msg tcpMailboxesMessage) isExpired(now time.Time) bool {
+	return !msg.expiry.IsZero() && now.After(msg.expiry)
+}
+
+// tcpMailboxesDeadline is the deadline of a value written to a remote mailbox. It is kept in the resend buffer in
+// place of the value's TTL, so that a resent value carries only what remains of its TTL. The zero value means the
+// value does not expire.
+type tcpMailboxesDeadline time.Time
+
+// remainingTTL returns the TTL to send after a value, as of now. Zero means the value never expires, and since
+// remaining time can't be zero for a value that does expire, a value whose deadline has passed is sent with a
+// negative TTL, so that the receiver discards it.
+func (deadline tcpMailboxesDeadline) remainingTTL(now time.Time) time.Duration {
+	if time.Time(deadline).IsZero() {
+		return 0
+	}
+	remaining := time.Time(deadline).Sub(now)
+	if remaining == 0 {
+		remaining = -1
+	}
+	return remaining
+}
+
+// tcpMailboxesHeader is sent immediately after tcpNetworkBegin, and identifies the sender of the values that follow.
+// An empty Sender means the sender did not configure an incarnation, and fencing does not apply.
+// A zero Seq means the transaction is not sequenced, and deduplication does not apply.
+// An empty Traceparent means the sending critical section was not traced.
+type tcpMailboxesHeader struct {
+	Sender      string
+	Incarnation int32
+	Seq         uint64
+	Traceparent string
+}
+
 // TCPMailboxesMaker produces a distsys.ArchetypeResourceMaker for a collection of TCP mailboxes.
 // Each individual mailbox will match the following mapping macro, assuming exactly one process "reads" from it:
 //
@@ -75,53 +392,88 @@ type TCPMailboxesAddressMappingFn func(tla.TLAValue) (TCPMailboxKind, string)
 // Note also that this protocol is not live, with respect to Commit. All other ops will recover from timeouts via aborts,
 // which will not be visible and will not take infinitely long. Commit is the exception, as it _must complete_ for semantics
 // to be preserved, or it would be possible to observe partial effects of critical sections.
-func TCPMailboxesMaker(addressMappingFn TCPMailboxesAddressMappingFn) distsys.ArchetypeResourceMaker {
-	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+//
+// See TCPMailboxesOption for the available configuration options, and MailboxesBroadcastIndex for writing the same
+// value to several mailboxes at once.
+func TCPMailboxesMaker(addressMappingFn TCPMailboxesAddressMappingFn, opts ...TCPMailboxesOption) distsys.ArchetypeResourceMaker {
+	cfg := makeTCPMailboxesConfig(opts)
+	return mailboxesBroadcastMaker(IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
 		typ, addr := addressMappingFn(index)
 		switch typ {
 		case TCPMailboxesLocal:
-			return tcpMailboxesLocalMaker(addr)
+			return tcpMailboxesLocalMaker(index, addr, cfg)
 		case TCPMailboxesRemote:
-			return tcpMailboxesRemoteMaker(addr)
+			return tcpMailboxesRemoteMaker(index, addr, cfg)
 		default:
 			panic(fmt.Errorf("invalid TCP mailbox type %d for address %s: expected local or remote, which are %d or %d", typ, addr, TCPMailboxesLocal, TCPMailboxesRemote))
 		}
-	})
+	}))
 }
 
 type tcpMailboxesLocal struct {
 	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	index      tla.TLAValue
 	listenAddr string
-	msgChannel chan tla.TLAValue
+	msgChannel chan tcpMailboxesMessage
 	listener   net.Listener
+	config     *tcpMailboxesConfig
 
-	readBacklog     []tla.TLAValue
-	readsInProgress []tla.TLAValue
+	// priorityChannel holds values tagged by the config's priorityFn; it is nil if there is no priorityFn
+	priorityChannel chan tcpMailboxesMessage
+
+	readBacklog     []tcpMailboxesMessage
+	readsInProgress []tcpMailboxesMessage
 
 	wg   sync.WaitGroup // contains the number of responded pre-commits that we haven't responded to their commits yet.
 	done chan struct{}
 
+	// abandonedPreCommits counts the responded pre-commits whose connections dropped before their commits arrived,
+	// which senders will commit by resending on a new connection
+	abandonedPreCommitsLock sync.Mutex
+	abandonedPreCommits     int
+
 	lock    sync.RWMutex
 	closing bool
+
+	sendersLock sync.Mutex
+	senders     map[string]tcpMailboxesSenderState
 }
 
 var _ distsys.ArchetypeResource = &tcpMailboxesLocal{}
+var _ distsys.TraceContextCarrier = &tcpMailboxesLocal{}
+var _ distsys.StatusArchetypeResource = &tcpMailboxesLocal{}
 
-func tcpMailboxesLocalMaker(listenAddr string) distsys.ArchetypeResourceMaker {
+func tcpMailboxesLocalMaker(index tla.TLAValue, advertisedAddr string, cfg *tcpMailboxesConfig) distsys.ArchetypeResourceMaker {
 	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
-		msgChannel := make(chan tla.TLAValue, tcpMailboxesReceiveChannelSize)
-		listener, err := net.Listen("tcp", listenAddr)
+		msgChannel := make(chan tcpMailboxesMessage, tcpMailboxesReceiveChannelSize)
+		listenAddr := advertisedAddr
+		var err error
+		if cfg.bindFn != nil {
+			listenAddr, err = cfg.bindFn(index, advertisedAddr)
+			if err != nil {
+				panic(fmt.Errorf("could not decide where to listen for mailbox advertised at %s: %w", advertisedAddr, err))
+			}
+		}
+		listener, err := cfg.transport.Listen(listenAddr)
 		if err != nil {
 			panic(fmt.Errorf("could not listen on address %s: %w", listenAddr, err))
 		}
-		log.Printf("started listening on: %s", listenAddr)
 		res := &tcpMailboxesLocal{
+			index:      index,
 			listenAddr: listenAddr,
 			msgChannel: msgChannel,
 			listener:   listener,
+			config:     cfg,
 			done:       make(chan struct{}),
 			closing:    false,
+
+			senders: make(map[string]tcpMailboxesSenderState),
+		}
+		if cfg.priorityFn != nil {
+			res.priorityChannel = make(chan tcpMailboxesMessage, tcpMailboxesReceiveChannelSize)
 		}
+		res.log(distsys.LogInfo, "started listening", "address", listenAddr)
 		go res.listen()
 
 		return res
@@ -143,25 +495,118 @@ func (res *tcpMailboxesLocal) listen() {
 	}
 }
 
+// tcpMailboxesSenderState is what a local mailbox remembers about each sender that stamps its messages.
+type tcpMailboxesSenderState struct {
+	incarnation int32
+	lastSeq     uint64
+}
+
+// shouldDrop records the sender information given in header, and reports whether the transaction it describes
+// should be dropped, either because it belongs to an older incarnation than one that has already been seen, or
+// because it has a sequence number that was already delivered.
+func (res *tcpMailboxesLocal) shouldDrop(header tcpMailboxesHeader) (bool, string) {
+	if header.Sender == "" {
+		return false, ""
+	}
+	res.sendersLock.Lock()
+	defer res.sendersLock.Unlock()
+	state, ok := res.senders[header.Sender]
+	switch {
+	case !ok || header.Incarnation > state.incarnation:
+		state = tcpMailboxesSenderState{incarnation: header.Incarnation}
+	case header.Incarnation < state.incarnation:
+		return true, fmt.Sprintf("stale incarnation %d", header.Incarnation)
+	case header.Seq != 0 && header.Seq <= state.lastSeq:
+		return true, fmt.Sprintf("duplicate or out-of-order sequence number %d (last delivered %d)", header.Seq, state.lastSeq)
+	}
+	if header.Seq != 0 {
+		state.lastSeq = header.Seq
+	}
+	res.senders[header.Sender] = state
+	return false, ""
+}
+
+// filter applies any configured receive filters to buffer, returning only the accepted messages.
+func (res *tcpMailboxesLocal) filter(sender string, buffer []tcpMailboxesMessage) []tcpMailboxesMessage {
+	if len(res.config.receiveFilters) == 0 {
+		return buffer
+	}
+	accepted := buffer[:0]
+	for _, msg := range buffer {
+		var err error
+		for _, filter := range res.config.receiveFilters {
+			err = filter(res.index, sender, msg.value)
+			if err != nil {
+				break
+			}
+		}
+		if err != nil {
+			res.log(distsys.LogWarn, "rejected message", "mailbox", res.index, "sender", sender, "value", msg.value, "error", err)
+			continue
+		}
+		accepted = append(accepted, msg)
+	}
+	return accepted
+}
+
 func (res *tcpMailboxesLocal) handleConn(conn net.Conn) {
 	defer func() {
 		err := conn.Close()
 		if err != nil {
-			log.Printf("error closing connection: %v", err)
+			res.log(distsys.LogWarn, "error closing connection", "error", err)
 		}
 	}()
 
 	var err error
-	encoder := gob.NewEncoder(conn)
-	decoder := gob.NewDecoder(conn)
-	var localBuffer []tla.TLAValue
+	countedConn := res.config.wrapForMetrics(res.index, conn)
+	encoder := res.config.codec.NewEncoder(countedConn)
+	decoder := res.config.codec.NewDecoder(countedConn)
+	var localBuffer []tcpMailboxesMessage
+	var header tcpMailboxesHeader
 	hasBegun := false
+	// a sender resending a transaction after a network error skips the pre-commit, which it already got a reply to
+	// on an earlier connection
+	hasPreCommitted := false
+	defer func() {
+		if hasPreCommitted {
+			res.abandonedPreCommitsLock.Lock()
+			res.abandonedPreCommits++
+			res.abandonedPreCommitsLock.Unlock()
+		}
+	}()
+
+	// before anything else, check that the other end speaks our protocol, then agree on how values will be sent
+	var handshake tcpMailboxesHandshake
+	var compressor MailboxCompressor
+	var remotePreamble tcpMailboxesPreamble
+	localPreamble := makeTCPMailboxesPreamble(res.config.codec)
+	err = conn.SetReadDeadline(time.Now().Add(tcpMailboxesTCPTimeout))
+	if err == nil {
+		remotePreamble, err = readTCPMailboxesPreamble(countedConn)
+	}
+	if err == nil {
+		// reply even if incompatible, so the dialing side can report why
+		err = localPreamble.write(countedConn)
+	}
+	if err == nil {
+		err = localPreamble.checkCompatible(remotePreamble)
+	}
+	if err == nil && remotePreamble.Flags&tcpMailboxesPreambleHandshake != 0 {
+		err = decoder.Decode(&handshake)
+		if err == nil {
+			handshake, compressor = acceptHandshake(handshake, res.config.acceptedCompressors())
+			err = encoder.Encode(&handshake)
+		}
+	}
+	if err == nil {
+		err = conn.SetReadDeadline(time.Time{})
+	}
 	for {
 		if err != nil {
 			select {
 			case <-res.done:
 			default:
-				log.Printf("network error during handleConn, dropping connection: %s", err)
+				res.log(distsys.LogWarn, "network error while receiving, dropping connection", "error", err)
 			}
 			return
 		}
@@ -182,23 +627,48 @@ func (res *tcpMailboxesLocal) handleConn(conn net.Conn) {
 		switch tag {
 		case tcpNetworkBegin:
 			localBuffer = nil
+			header = tcpMailboxesHeader{}
+			err = decoder.Decode(&header)
+			if err != nil {
+				continue
+			}
 			hasBegun = true
 		case tcpNetworkValue:
 			if !hasBegun {
 				panic("a correct TCP mailbox exchange must always start with tcpMailboxBegin")
 			}
 			var value tla.TLAValue
+			var ttl time.Duration
 			handle := func() bool {
 				res.lock.RLock()
 				defer res.lock.RUnlock()
 				if res.closing {
 					return true
 				}
-				err = decoder.Decode(&value)
+				if compressor != nil {
+					var payload tcpMailboxesPayload
+					err = decoder.Decode(&payload)
+					if err != nil {
+						return true
+					}
+					value, err = payload.value(res.config.codec, compressor)
+				} else {
+					err = decoder.Decode(&value)
+				}
 				if err != nil {
 					return true
 				}
-				localBuffer = append(localBuffer, value)
+				err = decoder.Decode(&ttl)
+				if err != nil {
+					return true
+				}
+				msg := tcpMailboxesMessage{value: value}
+				msg.traceContext, _ = distsys.ParseTraceparent(header.Traceparent)
+				if ttl != 0 {
+					// ttl is what remained of the value's TTL when it was sent; a negative ttl has already elapsed
+					msg.expiry = time.Now().Add(ttl)
+				}
+				localBuffer = append(localBuffer, msg)
 				return false
 			}
 			doContinue := handle()
@@ -220,6 +690,7 @@ func (res *tcpMailboxesLocal) handleConn(conn net.Conn) {
 					return true
 				}
 				res.wg.Add(1)
+				hasPreCommitted = true
 				return false
 			}
 			doContinue := handle()
@@ -240,9 +711,34 @@ func (res *tcpMailboxesLocal) handleConn(conn net.Conn) {
 			if err != nil {
 				continue
 			}
-			res.wg.Done()
+			if hasPreCommitted {
+				res.wg.Done()
+				hasPreCommitted = false
+			} else {
+				// a resent transaction commits a pre-commit from a dropped connection, unless that connection
+				// received the commit too, and only the reply to it was lost
+				res.abandonedPreCommitsLock.Lock()
+				if res.abandonedPreCommits > 0 {
+					res.abandonedPreCommits--
+					res.wg.Done()
+				}
+				res.abandonedPreCommitsLock.Unlock()
+			}
+			if drop, reason := res.shouldDrop(header); drop {
+				res.log(distsys.LogWarn, "dropping messages", "count", len(localBuffer), "sender", header.Sender, "reason", reason)
+				localBuffer = nil
+			}
+			localBuffer = res.filter(header.Sender, localBuffer)
 			for _, elem := range localBuffer {
-				res.msgChannel <- elem
+				if res.priorityChannel != nil && res.config.priorityFn(elem.value) {
+					elem.priority = true
+					res.priorityChannel <- elem
+				} else {
+					res.msgChannel <- elem
+				}
+			}
+			if res.config.metrics != nil && len(localBuffer) > 0 {
+				res.config.metrics.MessagesReceived(res.index, len(localBuffer))
 			}
 			localBuffer = nil
 			hasBegun = false
@@ -253,6 +749,7 @@ func (res *tcpMailboxesLocal) handleConn(conn net.Conn) {
 func (res *tcpMailboxesLocal) Abort() chan struct{} {
 	res.readBacklog = append(res.readsInProgress, res.readBacklog...)
 	res.readsInProgress = nil
+	res.reportQueueDepth()
 	return nil
 }
 
@@ -262,35 +759,116 @@ func (res *tcpMailboxesLocal) PreCommit() chan error {
 
 func (res *tcpMailboxesLocal) Commit() chan struct{} {
 	res.readsInProgress = nil
+	res.reportQueueDepth()
 	return nil
 }
 
+// queueDepth counts the messages waiting to be read, including any read by an aborted critical section.
+func (res *tcpMailboxesLocal) queueDepth() int {
+	return len(res.readBacklog) + len(res.msgChannel) + len(res.priorityChannel)
+}
+
+func (res *tcpMailboxesLocal) Status() string {
+	res.sendersLock.Lock()
+	senders := len(res.senders)
+	res.sendersLock.Unlock()
+	return fmt.Sprintf("local, listening on %s, %d messages queued, %d senders", res.listenAddr, res.queueDepth(), senders)
+}
+
+func (res *tcpMailboxesLocal) reportQueueDepth() {
+	if depthMetrics, ok := res.config.metrics.(MailboxQueueDepthMetrics); ok {
+		depthMetrics.QueueDepth(res.index, res.queueDepth())
+	}
+}
+
+// takeBacklog removes and returns the first message in the read backlog that is in the priority lane if priority is
+// true, or in the ordinary lane otherwise, discarding any expired messages it comes across.
+func (res *tcpMailboxesLocal) takeBacklog(priority bool) (tcpMailboxesMessage, bool) {
+	now := time.Now()
+	for i := 0; i < len(res.readBacklog); {
+		msg := res.readBacklog[i]
+		expired := msg.isExpired(now) // values can expire while waiting to be re-read, too
+		if !expired && msg.priority != priority {
+			i++
+			continue
+		}
+		copy(res.readBacklog[i:], res.readBacklog[i+1:])
+		res.readBacklog[len(res.readBacklog)-1] = tcpMailboxesMessage{} // ensure this TLAValue is null, otherwise it will dangle and prevent potential GC
+		res.readBacklog = res.readBacklog[:len(res.readBacklog)-1]
+		if !expired {
+			return msg, true
+		}
+	}
+	return tcpMailboxesMessage{}, false
+}
+
 func (res *tcpMailboxesLocal) ReadValue() (tla.TLAValue, error) {
-	// if a critical section previously aborted, already-read values will be here
-	if len(res.readBacklog) > 0 {
-		value := res.readBacklog[0]
-		res.readBacklog[0] = tla.TLAValue{} // ensure this TLAValue is null, otherwise it will dangle and prevent potential GC
-		res.readBacklog = res.readBacklog[1:]
-		res.readsInProgress = append(res.readsInProgress, value)
-		return value, nil
+	read := func(msg tcpMailboxesMessage) (tla.TLAValue, error) {
+		res.readsInProgress = append(res.readsInProgress, msg)
+		return msg.value, nil
+	}
+
+	// if a critical section previously aborted, already-read values will be in the backlog, but those in the
+	// ordinary lane must not be read before priority values that have arrived since
+	if msg, ok := res.takeBacklog(true); ok {
+		return read(msg)
+	}
+drainPriority:
+	for {
+		// receiving from a nil priorityChannel falls through to the default case
+		select {
+		case msg := <-res.priorityChannel:
+			if !msg.isExpired(time.Now()) {
+				return read(msg)
+			}
+		default:
+			break drainPriority
+		}
+	}
+	if msg, ok := res.takeBacklog(false); ok {
+		return read(msg)
 	}
 
 	// otherwise, either pull a notification + atomically read a value from the buffer, or time out
-	select {
-	case msg := <-res.msgChannel:
-		res.readsInProgress = append(res.readsInProgress, msg)
-		return msg, nil
-	case <-time.After(tcpMailboxesReadTimeout):
-		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	timeout := time.After(tcpMailboxesReadTimeout)
+	for {
+		var msg tcpMailboxesMessage
+		// drain the priority lane first; receiving from a nil priorityChannel falls through to the default case
+		select {
+		case msg = <-res.priorityChannel:
+		default:
+			select {
+			case msg = <-res.priorityChannel:
+			case msg = <-res.msgChannel:
+			case <-timeout:
+				return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+			}
+		}
+		if msg.isExpired(time.Now()) {
+			continue // drop expired messages, and try again with what's left of the timeout
+		}
+		return read(msg)
 	}
 }
 
+// ReceivedTraceContext returns the trace context of the critical section that sent the value last read.
+func (res *tcpMailboxesLocal) ReceivedTraceContext() (distsys.TraceContext, bool) {
+	msg := res.readsInProgress[len(res.readsInProgress)-1]
+	return msg.traceContext, msg.traceContext.IsValid()
+}
+
+func (res *tcpMailboxesLocal) SetTraceContext(tc distsys.TraceContext) {}
+
 func (res *tcpMailboxesLocal) WriteValue(value tla.TLAValue) error {
 	panic(fmt.Errorf("attempted to write value %v to a local mailbox archetype resource", value))
 }
 
 func (res *tcpMailboxesLocal) Close() error {
 	res.lock.Lock()
+	if res.closing {
+		res.lock.Unlock()
+		return nil
+	}
 	res.closing = true
 	res.lock.Unlock()
 
@@ -308,40 +886,185 @@ func (res *tcpMailboxesLocal) Close() error {
 
 type tcpMailboxesRemote struct {
 	distsys.ArchetypeResourceLeafMixin
-	dialAddr string
+	resourceLogger
+	index       tla.TLAValue
+	mappingAddr string // the address given by the TCPMailboxesAddressMappingFn
+	dialAddr    string // the address last dialed, which differs from mappingAddr if it was resolved
+	config      *tcpMailboxesConfig
 
 	inCriticalSection bool
 	conn              net.Conn
-	connEncoder       *gob.Encoder
-	connDecoder       *gob.Decoder
+	connEncoder       MailboxEncoder
+	connDecoder       MailboxDecoder
+	connCompressor    MailboxCompressor // the compressor negotiated for conn, or nil if values are sent as-is
 
 	resendBuffer []interface{}
+	seq          uint64 // sequence number of the latest transaction, if deduplication is enabled
+	sequencer    *tcpMailboxesSequencer
+
+	consecutiveFailures int
+	circuitOpenUntil    time.Time
+
+	pendingValues  int       // number of values written in the current critical section
+	preCommitStart time.Time // when PreCommit was called, for measuring commit latency
+
+	traceContext distsys.TraceContext // the context of the writing critical section's span, if it is traced
+}
+
+// recordFailure notes a network failure, opening the circuit if the configured threshold is reached.
+func (res *tcpMailboxesRemote) recordFailure() {
+	if res.config.breakerThreshold <= 0 {
+		return
+	}
+	res.consecutiveFailures++
+	if res.consecutiveFailures >= res.config.breakerThreshold {
+		if res.circuitOpenUntil.IsZero() {
+			res.log(distsys.LogWarn, "circuit opened", "mailbox", res.index, "address", res.dialAddr, "failures", res.consecutiveFailures)
+		}
+		res.circuitOpenUntil = time.Now().Add(res.config.breakerCooldown)
+	}
+}
+
+func (res *tcpMailboxesRemote) recordSuccess() {
+	if !res.circuitOpenUntil.IsZero() {
+		res.log(distsys.LogInfo, "circuit closed", "mailbox", res.index, "address", res.dialAddr)
+	}
+	res.consecutiveFailures = 0
+	res.circuitOpenUntil = time.Time{}
+}
+
+// isCircuitOpen reports whether sending to this mailbox should currently fail fast.
+func (res *tcpMailboxesRemote) isCircuitOpen() bool {
+	return !res.circuitOpenUntil.IsZero() && time.Now().Before(res.circuitOpenUntil)
 }
 
 var _ distsys.ArchetypeResource = &tcpMailboxesRemote{}
+var _ distsys.TraceContextCarrier = &tcpMailboxesRemote{}
+var _ distsys.StatusArchetypeResource = &tcpMailboxesRemote{}
 
-func tcpMailboxesRemoteMaker(dialAddr string) distsys.ArchetypeResourceMaker {
+func (res *tcpMailboxesRemote) Status() string {
+	switch {
+	case res.isCircuitOpen():
+		return fmt.Sprintf("remote at %s, circuit open until %s after %d failures", res.dialAddr, res.circuitOpenUntil.Format(time.RFC3339), res.consecutiveFailures)
+	case res.conn != nil:
+		return fmt.Sprintf("remote at %s, connected", res.dialAddr)
+	default:
+		return fmt.Sprintf("remote at %s, not connected", res.dialAddr)
+	}
+}
+
+// tcpMailboxesSequencer identifies the remote mailbox numbering the transactions sent to one mailbox under one
+// sender ID. Receivers track sequence numbers per sender ID, so two remote mailboxes numbering their transactions
+// independently would have each other's dropped as duplicates.
+type tcpMailboxesSequencer struct {
+	senderID string
+	mailbox  string
+}
+
+var (
+	tcpMailboxesSequencersLock sync.Mutex
+	tcpMailboxesSequencers     = make(map[tcpMailboxesSequencer]bool)
+)
+
+func claimTCPMailboxesSequencer(sequencer tcpMailboxesSequencer) {
+	tcpMailboxesSequencersLock.Lock()
+	defer tcpMailboxesSequencersLock.Unlock()
+	if tcpMailboxesSequencers[sequencer] {
+		panic(fmt.Errorf("another remote mailbox in this process already sends deduplicated messages to mailbox %s as sender %s; give each a distinct sender ID",
+			sequencer.mailbox, sequencer.senderID))
+	}
+	tcpMailboxesSequencers[sequencer] = true
+}
+
+func releaseTCPMailboxesSequencer(sequencer tcpMailboxesSequencer) {
+	tcpMailboxesSequencersLock.Lock()
+	defer tcpMailboxesSequencersLock.Unlock()
+	delete(tcpMailboxesSequencers, sequencer)
+}
+
+func tcpMailboxesRemoteMaker(index tla.TLAValue, dialAddr string, cfg *tcpMailboxesConfig) distsys.ArchetypeResourceMaker {
 	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
-		return &tcpMailboxesRemote{
-			dialAddr: dialAddr,
+		res := &tcpMailboxesRemote{
+			index:       index,
+			mappingAddr: dialAddr,
+			dialAddr:    dialAddr,
+			config:      cfg,
+		}
+		if cfg.deduplicate && cfg.senderID != "" {
+			res.sequencer = &tcpMailboxesSequencer{senderID: cfg.senderID, mailbox: index.String()}
+			claimTCPMailboxesSequencer(*res.sequencer)
 		}
+		return res
 	})
 }
 
 func (res *tcpMailboxesRemote) ensureConnection() error {
 	if res.conn == nil {
+		if res.config.resolver != nil {
+			addr, err := res.config.resolver.Resolve(res.index)
+			if err != nil {
+				res.log(distsys.LogWarn, "failed to resolve mailbox address, falling back to static address", "mailbox", res.index, "address", res.mappingAddr, "error", err)
+				res.dialAddr = res.mappingAddr
+			} else {
+				res.dialAddr = addr
+			}
+		}
+
 		var err error
-		res.conn, err = net.DialTimeout("tcp", res.dialAddr, tcpMailboxesTCPTimeout)
+		timeout := res.config.sendTimeout(res.index)
+		res.conn, err = res.config.transport.Dial(res.dialAddr, timeout)
 		if err != nil {
+			res.recordFailure()
+			if res.config.metrics != nil {
+				res.config.metrics.DialFailed(res.index)
+			}
 			res.conn, res.connEncoder, res.connDecoder = nil, nil, nil
-			log.Printf("failed to dial %s, aborting after %v: %v", res.dialAddr, tcpMailboxesConnectionDroppedRetryDelay, err)
+			res.log(distsys.LogWarn, "failed to dial, aborting", "address", res.dialAddr, "delay", tcpMailboxesConnectionDroppedRetryDelay, "error", err)
 			time.Sleep(tcpMailboxesConnectionDroppedRetryDelay)
 			return distsys.ErrCriticalSectionAborted
 		}
 		// res.conn is wrapped; don't try to use it directly, or you might miss resetting the deadline!
-		wrappedReaderWriter := makeReadWriterConnTimeout(res.conn, tcpMailboxesTCPTimeout)
-		res.connEncoder = gob.NewEncoder(wrappedReaderWriter)
-		res.connDecoder = gob.NewDecoder(wrappedReaderWriter)
+		wrappedReaderWriter := res.config.wrapForMetrics(res.index, makeReadWriterConnTimeout(res.conn, timeout))
+		res.connEncoder = res.config.codec.NewEncoder(wrappedReaderWriter)
+		res.connDecoder = res.config.codec.NewDecoder(wrappedReaderWriter)
+
+		localPreamble := makeTCPMailboxesPreamble(res.config.codec)
+		var remotePreamble tcpMailboxesPreamble
+		var handshake tcpMailboxesHandshake
+		for _, compressor := range res.config.compressors {
+			handshake.Compressors = append(handshake.Compressors, compressor.Name())
+		}
+		if len(handshake.Compressors) > 0 {
+			localPreamble.Flags |= tcpMailboxesPreambleHandshake
+		}
+		err = localPreamble.write(wrappedReaderWriter)
+		if err == nil {
+			remotePreamble, err = readTCPMailboxesPreamble(wrappedReaderWriter)
+		}
+		if err == nil {
+			err = localPreamble.checkCompatible(remotePreamble)
+		}
+		if err == nil && localPreamble.Flags&tcpMailboxesPreambleHandshake != 0 {
+			err = res.connEncoder.Encode(&handshake)
+			if err == nil {
+				err = res.connDecoder.Decode(&handshake)
+			}
+		}
+		if err != nil {
+			res.recordFailure()
+			res.log(distsys.LogWarn, "handshake failed, aborting", "address", res.dialAddr, "error", err)
+			if err := res.conn.Close(); err != nil {
+				res.log(distsys.LogWarn, "error closing connection", "error", err)
+			}
+			res.conn, res.connEncoder, res.connDecoder = nil, nil, nil
+			return distsys.ErrCriticalSectionAborted
+		}
+		res.connCompressor = nil
+		for _, compressor := range res.config.compressors {
+			if compressor.Name() == handshake.Compression {
+				res.connCompressor = compressor
+			}
+		}
 	}
 	return nil
 }
@@ -350,6 +1073,7 @@ func (res *tcpMailboxesRemote) Abort() chan struct{} {
 	// nothing to do; the remote end tolerates just starting over with no explanation
 	res.inCriticalSection = false // but note to ourselves that we are starting over, so we re-send the begin record
 	res.resendBuffer = nil
+	res.pendingValues = 0
 	return nil
 }
 
@@ -357,15 +1081,17 @@ func (res *tcpMailboxesRemote) PreCommit() chan error {
 	if !res.inCriticalSection {
 		return nil
 	}
+	res.preCommitStart = time.Now()
 
 	ch := make(chan error, 1)
 	go func() {
 		var err error
 		handleError := func() {
-			log.Printf("network error while performing pre-commit handshake, aborting: %v", err)
+			res.log(distsys.LogWarn, "network error during pre-commit, aborting", "address", res.dialAddr, "error", err)
+			res.recordFailure()
 			// close the connection to close the allocated file descriptors
 			if err := res.conn.Close(); err != nil {
-				log.Printf("error in closing conn: %s", err)
+				res.log(distsys.LogWarn, "error closing connection", "error", err)
 			}
 			res.conn = nil
 			ch <- distsys.ErrCriticalSectionAborted
@@ -385,6 +1111,7 @@ func (res *tcpMailboxesRemote) PreCommit() chan error {
 			handleError()
 			return
 		}
+		res.recordSuccess()
 		ch <- nil
 	}()
 	return ch
@@ -408,7 +1135,11 @@ func (res *tcpMailboxesRemote) resend() error {
 		return err
 	}
 
+	now := time.Now()
 	for _, msg := range res.resendBuffer {
+		if deadline, ok := msg.(tcpMailboxesDeadline); ok {
+			msg = deadline.remainingTTL(now)
+		}
 		err = res.connEncoder.Encode(msg)
 		if err != nil {
 			return err
@@ -427,13 +1158,36 @@ func (res *tcpMailboxesRemote) Commit() chan struct{} {
 		var err error
 		for {
 			if err != nil {
-				log.Printf("network error during commit: %s", err)
+				res.log(distsys.LogWarn, "network error during commit", "address", res.dialAddr, "error", err)
+				dropReason := ""
+				if !res.config.isMember(res.index) {
+					dropReason = "mailbox was removed from membership"
+				} else if res.config.delivery == TCPMailboxesAtMostOnce {
+					dropReason = "delivery is at-most-once"
+				}
+				if dropReason != "" {
+					res.log(distsys.LogWarn, "dropping undelivered messages", "mailbox", res.index, "reason", dropReason)
+					if res.conn != nil {
+						if err := res.conn.Close(); err != nil {
+							res.log(distsys.LogWarn, "error closing connection", "error", err)
+						}
+						res.conn = nil
+					}
+					res.inCriticalSection = false
+					res.resendBuffer = nil
+					res.pendingValues = 0
+					ch <- struct{}{}
+					return
+				}
 				if res.conn != nil {
 					if err := res.conn.Close(); err != nil {
-						log.Printf("error in closing conn: %s", err)
+						res.log(distsys.LogWarn, "error closing connection", "error", err)
 					}
 					res.conn = nil
 				}
+				if res.config.metrics != nil {
+					res.config.metrics.CommitRetried(res.index)
+				}
 				err = res.resend()
 				if err != nil {
 					continue
@@ -455,8 +1209,13 @@ func (res *tcpMailboxesRemote) Commit() chan struct{} {
 			if shouldResend {
 				panic("shouldResent must be false since we don't support crash-recovery model right now.")
 			}
+			if res.config.metrics != nil {
+				res.config.metrics.MessagesSent(res.index, res.pendingValues)
+				res.config.metrics.CommitLatency(res.index, time.Since(res.preCommitStart))
+			}
 			res.inCriticalSection = false
 			res.resendBuffer = nil
+			res.pendingValues = 0
 			ch <- struct{}{}
 			return
 		}
@@ -468,18 +1227,36 @@ func (res *tcpMailboxesRemote) ReadValue() (tla.TLAValue, error) {
 	panic(fmt.Errorf("attempted to read from a remote mailbox archetype resource"))
 }
 
+// SetTraceContext records the context of the writing critical section's span, to be sent along with its values.
+func (res *tcpMailboxesRemote) SetTraceContext(tc distsys.TraceContext) {
+	res.traceContext = tc
+}
+
+func (res *tcpMailboxesRemote) ReceivedTraceContext() (distsys.TraceContext, bool) {
+	return distsys.TraceContext{}, false
+}
+
 func (res *tcpMailboxesRemote) WriteValue(value tla.TLAValue) error {
 	var err error
 	handleError := func() error {
-		log.Printf("network error during remote value write, aborting: %v", err)
+		res.log(distsys.LogWarn, "network error during write, aborting", "address", res.dialAddr, "error", err)
+		res.recordFailure()
 		// close the connection to close the allocated file descriptors
 		if err := res.conn.Close(); err != nil {
-			log.Printf("error in closing conn: %s", err)
+			res.log(distsys.LogWarn, "error closing connection", "error", err)
 		}
 		res.conn = nil
 		return distsys.ErrCriticalSectionAborted
 	}
 
+	if !res.config.isMember(res.index) {
+		res.log(distsys.LogWarn, "dropping value written to a mailbox that is not a member", "mailbox", res.index, "value", value)
+		return nil
+	}
+	if res.isCircuitOpen() {
+		return distsys.ErrCriticalSectionAborted
+	}
+
 	// Note that we should send all the data in only *one* connection. If we got
 	// an error anytime, we should abort the critical section.
 	err = res.ensureConnection()
@@ -493,21 +1270,65 @@ func (res *tcpMailboxesRemote) WriteValue(value tla.TLAValue) error {
 			return handleError()
 		}
 		res.resendBuffer = append(res.resendBuffer, tcpNetworkBegin)
+		header := tcpMailboxesHeader{
+			Sender:      res.config.senderID,
+			Incarnation: res.config.incarnation,
+			Traceparent: res.traceContext.Traceparent(),
+		}
+		if res.config.deduplicate {
+			res.seq++
+			header.Seq = res.seq
+		}
+		err = res.connEncoder.Encode(&header)
+		if err != nil {
+			return handleError()
+		}
+		res.resendBuffer = append(res.resendBuffer, &header)
 	}
 	err = res.connEncoder.Encode(tcpNetworkValue)
 	if err != nil {
 		return handleError()
 	}
 	res.resendBuffer = append(res.resendBuffer, tcpNetworkValue)
-	err = res.connEncoder.Encode(&value)
+	if res.connCompressor != nil {
+		var payload tcpMailboxesPayload
+		payload, err = makeTCPMailboxesPayload(res.config.codec, res.connCompressor, value, res.config.compressionThreshold)
+		if err != nil {
+			return handleError()
+		}
+		err = res.connEncoder.Encode(&payload)
+		if err != nil {
+			return handleError()
+		}
+		res.resendBuffer = append(res.resendBuffer, &payload)
+	} else {
+		err = res.connEncoder.Encode(&value)
+		if err != nil {
+			return handleError()
+		}
+		res.resendBuffer = append(res.resendBuffer, &value)
+	}
+	res.pendingValues++
+	var deadline tcpMailboxesDeadline
+	now := time.Now()
+	if res.config.ttlFn != nil {
+		if ttl := res.config.ttlFn(value); ttl > 0 {
+			deadline = tcpMailboxesDeadline(now.Add(ttl))
+		}
+	}
+	err = res.connEncoder.Encode(deadline.remainingTTL(now))
 	if err != nil {
 		return handleError()
 	}
-	res.resendBuffer = append(res.resendBuffer, &value)
+	res.resendBuffer = append(res.resendBuffer, deadline)
 	return nil
 }
 
 func (res *tcpMailboxesRemote) Close() error {
+	if res.sequencer != nil {
+		releaseTCPMailboxesSequencer(*res.sequencer)
+		res.sequencer = nil
+	}
 	var err error
 	if res.conn != nil {
 		err = res.conn.Close()
@@ -551,7 +1372,7 @@ func (res *tcpMailboxesLocalLength) Commit() chan struct{} {
 }
 
 func (res *tcpMailboxesLocalLength) ReadValue() (tla.TLAValue, error) {
-	return tla.MakeTLANumber(int32(len(res.mailbox.readBacklog) + len(res.mailbox.msgChannel))), nil
+	return tla.MakeTLANumber(int32(res.mailbox.queueDepth())), nil
 }
 
 func (res *tcpMailboxesLocalLength) WriteValue(value tla.TLAValue) error {
@@ -0,0 +1,968 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// makeTCPMailboxesTest makes the TCP mailboxes of the process owning mailbox self, where mailbox i is at addrs[i].
+// The process's own mailbox starts listening right away.
+func makeTCPMailboxesTest(t *testing.T, self int32, addrs []string, opts ...TCPMailboxesOption) distsys.ArchetypeResource {
+	t.Helper()
+	maker := TCPMailboxesMaker(func(index tla.TLAValue) (TCPMailboxKind, string) {
+		kind := TCPMailboxesRemote
+		if index.AsNumber() == self {
+			kind = TCPMailboxesLocal
+		}
+		return kind, addrs[index.AsNumber()]
+	}, opts...)
+	res := maker.Make()
+	maker.Configure(res)
+	t.Cleanup(func() {
+		_ = res.Close()
+	})
+	if self >= 0 {
+		mailboxesTestIndex(t, res, self)
+	}
+	return res
+}
+
+func mailboxesTestIndex(t *testing.T, res distsys.ArchetypeResource, index int32) distsys.ArchetypeResource {
+	t.Helper()
+	mailbox, err := res.Index(tla.MakeTLANumber(index))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mailbox
+}
+
+func mailboxesTestCommit(t *testing.T, res distsys.ArchetypeResource) {
+	t.Helper()
+	if ch := res.PreCommit(); ch != nil {
+		if err := <-ch; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ch := res.Commit(); ch != nil {
+		<-ch
+	}
+}
+
+func mailboxesTestAbort(res distsys.ArchetypeResource) {
+	if ch := res.Abort(); ch != nil {
+		<-ch
+	}
+}
+
+// mailboxesTestSend writes values to mailbox index in one critical section, and commits it.
+func mailboxesTestSend(t *testing.T, res distsys.ArchetypeResource, index int32, values ...int32) {
+	t.Helper()
+	for _, value := range values {
+		if err := mailboxesTestIndex(t, res, index).WriteValue(tla.MakeTLANumber(value)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mailboxesTestCommit(t, res)
+}
+
+// mailboxesTestReceive reads count values from mailbox index in one critical section, which it leaves open.
+func mailboxesTestReceive(t *testing.T, res distsys.ArchetypeResource, index int32, count int) []tla.TLAValue {
+	t.Helper()
+	var values []tla.TLAValue
+	awaitCondition(t, "values to arrive", func() bool {
+		value, err := mailboxesTestIndex(t, res, index).ReadValue()
+		if errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			return false // nothing to read yet; a real critical section would abort and retry
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, value)
+		return len(values) == count
+	})
+	return values
+}
+
+// expectMailboxesTestEmpty checks that reading from mailbox index finds nothing to read.
+func expectMailboxesTestEmpty(t *testing.T, res distsys.ArchetypeResource, index int32) {
+	t.Helper()
+	value, err := mailboxesTestIndex(t, res, index).ReadValue()
+	if !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected the mailbox to be empty, but read %v, %v", value, err)
+	}
+}
+
+func expectMailboxesTestValues(t *testing.T, values []tla.TLAValue, expected ...int32) {
+	t.Helper()
+	if len(values) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, values)
+	}
+	for i, value := range values {
+		if !value.Equal(tla.MakeTLANumber(expected[i])) {
+			t.Fatalf("expected %v, got %v", expected, values)
+		}
+	}
+}
+
+func TestTCPMailboxesIncarnationFencing(t *testing.T) {
+	addrs := []string{freeLocalAddr(t)}
+	receiver := makeTCPMailboxesTest(t, 0, addrs)
+	sender := func(incarnation int32, opts ...TCPMailboxesOption) distsys.ArchetypeResource {
+		opts = append(opts, WithTCPMailboxesIncarnation(tla.MakeTLAString("sender"), incarnation))
+		return makeTCPMailboxesTest(t, -1, addrs, opts...)
+	}
+	// each receives the value sent, and checks that nothing else, such as a value that should have been dropped, was
+	// delivered along with it
+	expectReceived := func(value int32) {
+		t.Helper()
+		expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 1), value)
+		mailboxesTestCommit(t, receiver)
+		expectMailboxesTestEmpty(t, receiver, 0)
+	}
+
+	second := sender(2)
+	mailboxesTestSend(t, second, 0, 1)
+	expectReceived(1)
+
+	// once the second incarnation was seen, the first is fenced off: its values are dropped at commit, although
+	// the sender's commit goes through as usual
+	first := sender(1)
+	mailboxesTestSend(t, first, 0, 2)
+	mailboxesTestSend(t, second, 0, 3)
+	expectReceived(3)
+
+	// and the same goes for the second, once a third shows up
+	third := sender(3)
+	mailboxesTestSend(t, third, 0, 4)
+	expectReceived(4)
+	mailboxesTestSend(t, second, 0, 5)
+	mailboxesTestSend(t, third, 0, 6)
+	expectReceived(6)
+
+	// a newer incarnation starts over, forgetting the sequence numbers of the older one
+	for _, res := range []distsys.ArchetypeResource{first, second, third} {
+		if err := res.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fourth := sender(4, WithTCPMailboxesDeduplication())
+	for value := int32(7); value <= 9; value++ {
+		mailboxesTestSend(t, fourth, 0, value)
+		expectReceived(value)
+	}
+	// a restarted sender no longer has its older incarnation's mailboxes around
+	if err := fourth.Close(); err != nil {
+		t.Fatal(err)
+	}
+	fifth := sender(5, WithTCPMailboxesDeduplication())
+	mailboxesTestSend(t, fifth, 0, 10)
+	expectReceived(10)
+}
+
+// lossyMailboxTransport connects mailboxes like DefaultMailboxTransport, but once armed, it loses the next reply a
+// dialing mailbox reads, after the other end sent it, by breaking the connection. It also counts dial attempts.
+type lossyMailboxTransport struct {
+	DefaultMailboxTransport
+	loseNextReply int32
+	dials         int32
+}
+
+func (transport *lossyMailboxTransport) Dial(addr string, timeout time.Duration) (net.Conn, error) {
+	atomic.AddInt32(&transport.dials, 1)
+	conn, err := transport.DefaultMailboxTransport.Dial(addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return lossyConn{Conn: conn, transport: transport}, nil
+}
+
+type lossyConn struct {
+	net.Conn
+	transport *lossyMailboxTransport
+}
+
+func (conn lossyConn) Read(b []byte) (int, error) {
+	n, err := conn.Conn.Read(b)
+	if err == nil && atomic.CompareAndSwapInt32(&conn.transport.loseNextReply, 1, 0) {
+		_ = conn.Conn.Close()
+		return 0, fmt.Errorf("lost a reply of %d bytes", n)
+	}
+	return n, err
+}
+
+func TestTCPMailboxesLostCommitAck(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []TCPMailboxesOption
+		expected []int32
+	}{
+		// the receiver delivered the values before the ack to their commit was lost, so resending delivers them again
+		{"at least once", nil, []int32{1, 1, 2}},
+		// unless it recognizes the resent transaction
+		{"exactly once", []TCPMailboxesOption{WithTCPMailboxesDeduplication()}, []int32{1, 2}},
+		// or the sender gives up on them, which it does whether or not they were delivered
+		{"at most once", []TCPMailboxesOption{WithTCPMailboxesDelivery(TCPMailboxesAtMostOnce)}, []int32{1, 2}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			addrs := []string{freeLocalAddr(t)}
+			receiver := makeTCPMailboxesTest(t, 0, addrs)
+			transport := &lossyMailboxTransport{}
+			opts := append([]TCPMailboxesOption{
+				WithTCPMailboxesIncarnation(tla.MakeTLAString("sender"), 1),
+				WithTCPMailboxesTransport(transport),
+			}, test.opts...)
+			sender := makeTCPMailboxesTest(t, -1, addrs, opts...)
+
+			if err := mailboxesTestIndex(t, sender, 0).WriteValue(tla.MakeTLANumber(1)); err != nil {
+				t.Fatal(err)
+			}
+			if err := <-sender.PreCommit(); err != nil {
+				t.Fatal(err)
+			}
+			atomic.StoreInt32(&transport.loseNextReply, 1)
+			<-sender.Commit()
+			if atomic.LoadInt32(&transport.loseNextReply) != 0 {
+				t.Fatal("expected the commit's ack to be lost")
+			}
+			mailboxesTestSend(t, sender, 0, 2)
+
+			expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, len(test.expected)), test.expected...)
+			mailboxesTestCommit(t, receiver)
+			expectMailboxesTestEmpty(t, receiver, 0)
+		})
+	}
+}
+
+// pipeMailboxTransport connects mailboxes in-process, over net.Pipe, given any addresses. It counts dials.
+type pipeMailboxTransport struct {
+	lock      sync.Mutex
+	listeners map[string]*pipeListener
+	dials     int32
+}
+
+func (transport *pipeMailboxTransport) Listen(addr string) (net.Listener, error) {
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+	if _, ok := transport.listeners[addr]; ok {
+		return nil, fmt.Errorf("%s is already in use", addr)
+	}
+	if transport.listeners == nil {
+		transport.listeners = make(map[string]*pipeListener)
+	}
+	listener := &pipeListener{
+		transport: transport,
+		addr:      addr,
+		conns:     make(chan net.Conn),
+		closed:    make(chan struct{}),
+	}
+	transport.listeners[addr] = listener
+	return listener, nil
+}
+
+func (transport *pipeMailboxTransport) Dial(addr string, timeout time.Duration) (net.Conn, error) {
+	atomic.AddInt32(&transport.dials, 1)
+	transport.lock.Lock()
+	listener, ok := transport.listeners[addr]
+	transport.lock.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("nothing listens on %s", addr)
+	}
+	client, server := net.Pipe()
+	select {
+	case listener.conns <- server:
+		return client, nil
+	case <-listener.closed:
+		return nil, fmt.Errorf("nothing listens on %s", addr)
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("timed out dialing %s", addr)
+	}
+}
+
+type pipeListener struct {
+	transport *pipeMailboxTransport
+	addr      string
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (listener *pipeListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-listener.conns:
+		return conn, nil
+	case <-listener.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (listener *pipeListener) Close() error {
+	listener.closeOnce.Do(func() {
+		close(listener.closed)
+		listener.transport.lock.Lock()
+		delete(listener.transport.listeners, listener.addr)
+		listener.transport.lock.Unlock()
+	})
+	return nil
+}
+
+func (listener *pipeListener) Addr() net.Addr {
+	return pipeAddr(listener.addr)
+}
+
+type pipeAddr string
+
+func (addr pipeAddr) Network() string {
+	return "pipe"
+}
+
+func (addr pipeAddr) String() string {
+	return string(addr)
+}
+
+func TestTCPMailboxesCustomTransport(t *testing.T) {
+	// the addresses mean nothing to the operating system; only the transport interprets them
+	transport := &pipeMailboxTransport{}
+	addrs := []string{"receiver", "sender"}
+	receiver := makeTCPMailboxesTest(t, 0, addrs, WithTCPMailboxesTransport(transport))
+	sender := makeTCPMailboxesTest(t, 1, addrs, WithTCPMailboxesTransport(transport))
+
+	mailboxesTestSend(t, sender, 0, 1, 2)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 2), 1, 2)
+	mailboxesTestCommit(t, receiver)
+	// and back, over a connection dialed the other way
+	mailboxesTestSend(t, receiver, 1, 3)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, sender, 1, 1), 3)
+	mailboxesTestCommit(t, sender)
+	if dials := atomic.LoadInt32(&transport.dials); dials != 2 {
+		t.Fatalf("expected each mailbox to dial the other once, via the transport, but it dialed %d times", dials)
+	}
+
+	if err := receiver.Close(); err != nil {
+		t.Fatal(err)
+	}
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+	if _, ok := transport.listeners["receiver"]; ok {
+		t.Fatal("expected closing the mailboxes to close their listener")
+	}
+}
+
+func TestTCPMailboxesDeduplicationRequiresDistinctSenders(t *testing.T) {
+	addrs := []string{freeLocalAddr(t), freeLocalAddr(t)}
+	opts := []TCPMailboxesOption{
+		WithTCPMailboxesIncarnation(tla.MakeTLAString("sender"), 1),
+		WithTCPMailboxesDeduplication(),
+	}
+	first := makeTCPMailboxesTest(t, -1, addrs, opts...)
+	mailboxesTestIndex(t, first, 0)
+
+	// a second collection of mailboxes would number its own transactions to mailbox 0 under the same sender ID, so
+	// the receiver would drop some of them as duplicates
+	second := makeTCPMailboxesTest(t, -1, addrs, opts...)
+	indexPanic := func(index int32) (recovered interface{}) {
+		defer func() {
+			recovered = recover()
+		}()
+		mailboxesTestIndex(t, second, index)
+		return nil
+	}
+	if recovered := indexPanic(0); recovered == nil {
+		t.Fatal("expected a second remote mailbox sending to mailbox 0 as the same sender to be rejected")
+	}
+	// sending to a different mailbox is fine
+	if recovered := indexPanic(1); recovered != nil {
+		t.Fatalf("expected a remote mailbox sending to mailbox 1 to be allowed, got %v", recovered)
+	}
+	// as is sending to mailbox 0, once the first one is closed
+	if err := first.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if recovered := indexPanic(0); recovered != nil {
+		t.Fatalf("expected a remote mailbox sending to mailbox 0 to be allowed once the first is closed, got %v", recovered)
+	}
+}
+
+func TestTCPMailboxesCircuitBreaker(t *testing.T) {
+	const cooldown = 300 * time.Millisecond
+	addrs := []string{freeLocalAddr(t)}
+	transport := &lossyMailboxTransport{}
+	sender := makeTCPMailboxesTest(t, -1, addrs,
+		WithTCPMailboxesTransport(transport),
+		WithTCPMailboxesCircuitBreaker(2, cooldown))
+	// write tries to write to mailbox 0, which nothing listens at yet, reporting whether it dialed and how long it took
+	write := func() (bool, time.Duration) {
+		t.Helper()
+		dials := atomic.LoadInt32(&transport.dials)
+		start := time.Now()
+		err := mailboxesTestIndex(t, sender, 0).WriteValue(tla.MakeTLANumber(1))
+		elapsed := time.Since(start)
+		if !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatalf("expected writing to an unreachable mailbox to abort, got %v", err)
+		}
+		mailboxesTestAbort(sender)
+		return atomic.LoadInt32(&transport.dials) != dials, elapsed
+	}
+	expectOpen := func() {
+		t.Helper()
+		// a failed dial waits before aborting, while an open circuit aborts right away
+		if dialed, elapsed := write(); dialed || elapsed >= tcpMailboxesConnectionDroppedRetryDelay {
+			t.Fatalf("expected an open circuit to fail fast, but the write dialed: %t, and took %v", dialed, elapsed)
+		}
+	}
+
+	// the circuit opens after the second consecutive failure
+	for i := 0; i < 2; i++ {
+		if dialed, _ := write(); !dialed {
+			t.Fatalf("expected failure %d to dial", i+1)
+		}
+	}
+	expectOpen()
+	expectOpen()
+
+	// after the cooldown, one attempt is let through, which fails, opening the circuit again
+	time.Sleep(cooldown)
+	if dialed, _ := write(); !dialed {
+		t.Fatal("expected a half-open circuit to let an attempt through")
+	}
+	expectOpen()
+
+	// and once the mailbox can be reached, the next attempt closes the circuit
+	receiver := makeTCPMailboxesTest(t, 0, addrs)
+	time.Sleep(cooldown)
+	mailboxesTestSend(t, sender, 0, 1)
+	mailboxesTestSend(t, sender, 0, 2)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 2), 1, 2)
+	if status := mailboxesTestIndex(t, sender, 0).(distsys.StatusArchetypeResource).Status(); status != fmt.Sprintf("remote at %s, connected", addrs[0]) {
+		t.Fatalf("expected the circuit to be closed, got status %q", status)
+	}
+}
+
+func TestTCPMailboxesPriority(t *testing.T) {
+	addrs := []string{freeLocalAddr(t)}
+	receiver := makeTCPMailboxesTest(t, 0, addrs, WithTCPMailboxesPriority(func(value tla.TLAValue) bool {
+		return value.AsNumber() >= 100
+	}))
+	sender := makeTCPMailboxesTest(t, -1, addrs)
+	local := mailboxesTestIndex(t, receiver, 0).(*tcpMailboxesLocal)
+	// send sends values, and waits for the receiver to queue them, so that they are all there to be read
+	send := func(values ...int32) {
+		t.Helper()
+		depth := local.queueDepth()
+		mailboxesTestSend(t, sender, 0, values...)
+		awaitCondition(t, "values to be queued", func() bool {
+			return local.queueDepth() == depth+len(values)
+		})
+	}
+
+	// a priority value overtakes ordinary values sent before it
+	send(1, 2)
+	send(100)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 3), 100, 1, 2)
+
+	// values read by an aborted critical section are read again in the same order, but ordinary values among them
+	// are still overtaken by priority values that arrived since
+	mailboxesTestAbort(receiver)
+	send(101)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 4), 100, 101, 1, 2)
+	mailboxesTestAbort(receiver)
+	send(3)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 5), 100, 101, 1, 2, 3)
+	mailboxesTestCommit(t, receiver)
+	expectMailboxesTestEmpty(t, receiver, 0)
+}
+
+func TestTCPMailboxesMessageTTL(t *testing.T) {
+	const ttl = 400 * time.Millisecond
+	// values of 100 or more expire
+	ttlOpt := WithTCPMailboxesMessageTTL(func(value tla.TLAValue) time.Duration {
+		if value.AsNumber() >= 100 {
+			return ttl
+		}
+		return 0
+	})
+	addrs := []string{freeLocalAddr(t)}
+	receiver := makeTCPMailboxesTest(t, 0, addrs)
+	transport := &lossyMailboxTransport{}
+	sender := makeTCPMailboxesTest(t, -1, addrs, ttlOpt, WithTCPMailboxesTransport(transport))
+
+	// a value that expires before it is read is never read
+	mailboxesTestSend(t, sender, 0, 100, 1)
+	time.Sleep(ttl)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 1), 1)
+	mailboxesTestCommit(t, receiver)
+	expectMailboxesTestEmpty(t, receiver, 0)
+
+	// and neither is one that expires before a critical section that read it is retried
+	mailboxesTestSend(t, sender, 0, 101)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 1), 101)
+	mailboxesTestAbort(receiver)
+	time.Sleep(ttl)
+	expectMailboxesTestEmpty(t, receiver, 0)
+	mailboxesTestAbort(receiver)
+
+	// a value resent after a network error carries only what remains of its TTL: here, the receiver delivered the
+	// value before the ack to its commit was lost, and the copy resent halfway through the TTL expires along with it
+	if err := mailboxesTestIndex(t, sender, 0).WriteValue(tla.MakeTLANumber(102)); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	if err := <-sender.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(ttl / 2)
+	atomic.StoreInt32(&transport.loseNextReply, 1)
+	<-sender.Commit()
+	if atomic.LoadInt32(&transport.loseNextReply) != 0 {
+		t.Fatal("expected the commit's ack to be lost")
+	}
+	time.Sleep(ttl - time.Since(start) + ttl/4)
+	expectMailboxesTestEmpty(t, receiver, 0)
+
+	// a deadline that has passed is sent as a negative TTL, since zero means the value never expires
+	now := time.Now()
+	if remaining := tcpMailboxesDeadline(now.Add(-time.Second)).remainingTTL(now); remaining >= 0 {
+		t.Fatalf("expected a passed deadline to give a negative TTL, got %v", remaining)
+	}
+	if remaining := tcpMailboxesDeadline(now).remainingTTL(now); remaining >= 0 {
+		t.Fatalf("expected a deadline of now to give a negative TTL, got %v", remaining)
+	}
+	if remaining := tcpMailboxesDeadline(time.Time{}).remainingTTL(now); remaining != 0 {
+		t.Fatalf("expected no deadline to give no TTL, got %v", remaining)
+	}
+}
+
+// mailboxesTestSendEventually sends value to mailbox index, retrying critical sections that abort, e.g. while the
+// sender reconnects.
+func mailboxesTestSendEventually(t *testing.T, res distsys.ArchetypeResource, index int32, value int32) {
+	t.Helper()
+	awaitCondition(t, "the value to be sent", func() bool {
+		if err := mailboxesTestIndex(t, res, index).WriteValue(tla.MakeTLANumber(value)); err != nil {
+			mailboxesTestAbort(res)
+			return false
+		}
+		if err := <-res.PreCommit(); err != nil {
+			mailboxesTestAbort(res)
+			return false
+		}
+		<-res.Commit()
+		return true
+	})
+}
+
+func TestTCPMailboxesResolver(t *testing.T) {
+	mappingAddr, firstAddr, secondAddr := freeLocalAddr(t), freeLocalAddr(t), freeLocalAddr(t)
+	var resolved atomic.Value
+	resolved.Store(firstAddr)
+	resolver := TCPMailboxesResolverFn(func(index tla.TLAValue) (string, error) {
+		if addr := resolved.Load().(string); addr != "" {
+			return addr, nil
+		}
+		return "", errors.New("no address")
+	})
+	sender := makeTCPMailboxesTest(t, -1, []string{mappingAddr}, WithTCPMailboxesResolver(resolver))
+	// receive starts a receiver for mailbox 0 at addr, and checks that value arrives there
+	receive := func(addr string, value int32) distsys.ArchetypeResource {
+		t.Helper()
+		receiver := makeTCPMailboxesTest(t, 0, []string{addr})
+		mailboxesTestSendEventually(t, sender, 0, value)
+		expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 1), value)
+		mailboxesTestCommit(t, receiver)
+		return receiver
+	}
+
+	// the resolved address is used instead of the one given by the address mapping
+	first := receive(firstAddr, 1)
+
+	// once the mailbox moves, the sender finds it after the connection to its old address fails
+	if err := first.Close(); err != nil {
+		t.Fatal(err)
+	}
+	resolved.Store(secondAddr)
+	second := receive(secondAddr, 2)
+
+	// and when resolution fails, it falls back to the address mapping
+	if err := second.Close(); err != nil {
+		t.Fatal(err)
+	}
+	resolved.Store("")
+	receive(mappingAddr, 3)
+}
+
+func TestTCPMailboxesMembership(t *testing.T) {
+	addrs := []string{freeLocalAddr(t)}
+	receiver := makeTCPMailboxesTest(t, 0, addrs)
+	membership := NewTCPMailboxesMembership(tla.MakeTLANumber(0))
+	transport := &lossyMailboxTransport{}
+	sender := makeTCPMailboxesTest(t, -1, addrs, WithTCPMailboxesMembership(membership), WithTCPMailboxesTransport(transport))
+	if members := membership.Members(); !members.Equal(tla.MakeTLASet(tla.MakeTLANumber(0))) {
+		t.Fatalf("expected the members to be {0}, got %v", members)
+	}
+
+	mailboxesTestSend(t, sender, 0, 1)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 1), 1)
+	mailboxesTestCommit(t, receiver)
+
+	// messages to a removed mailbox are dropped
+	membership.Remove(tla.MakeTLANumber(0))
+	if membership.IsMember(tla.MakeTLANumber(0)) {
+		t.Fatal("expected 0 not to be a member once removed")
+	}
+	mailboxesTestSend(t, sender, 0, 2)
+	expectMailboxesTestEmpty(t, receiver, 0)
+	mailboxesTestAbort(receiver)
+
+	// and a commit that fails once the mailbox was removed gives up rather than retrying; here, only the ack to the
+	// commit is lost, so the value was delivered nonetheless
+	membership.Add(tla.MakeTLANumber(0))
+	if err := mailboxesTestIndex(t, sender, 0).WriteValue(tla.MakeTLANumber(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-sender.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	membership.Remove(tla.MakeTLANumber(0))
+	dials := atomic.LoadInt32(&transport.dials)
+	atomic.StoreInt32(&transport.loseNextReply, 1)
+	<-sender.Commit()
+	if atomic.LoadInt32(&transport.dials) != dials {
+		t.Fatal("expected the commit to give up without reconnecting")
+	}
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 1), 3)
+	mailboxesTestCommit(t, receiver)
+
+	// a mailbox added back receives messages again
+	membership.Add(tla.MakeTLANumber(0))
+	mailboxesTestSend(t, sender, 0, 4)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 1), 4)
+	mailboxesTestCommit(t, receiver)
+	expectMailboxesTestEmpty(t, receiver, 0)
+}
+
+// recordingMailboxMetrics totals the measurements reported for each mailbox index, by method name.
+type recordingMailboxMetrics struct {
+	lock   sync.Mutex
+	totals map[string]int
+}
+
+var _ MailboxMetrics = &recordingMailboxMetrics{}
+var _ MailboxQueueDepthMetrics = &recordingMailboxMetrics{}
+
+func (metrics *recordingMailboxMetrics) record(method string, index tla.TLAValue, n int) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+	if metrics.totals == nil {
+		metrics.totals = make(map[string]int)
+	}
+	metrics.totals[fmt.Sprintf("%s %v", method, index)] += n
+}
+
+func (metrics *recordingMailboxMetrics) total(method string, index int32) int {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+	return metrics.totals[fmt.Sprintf("%s %v", method, tla.MakeTLANumber(index))]
+}
+
+func (metrics *recordingMailboxMetrics) MessagesSent(index tla.TLAValue, count int) {
+	metrics.record("MessagesSent", index, count)
+}
+
+func (metrics *recordingMailboxMetrics) MessagesReceived(index tla.TLAValue, count int) {
+	metrics.record("MessagesReceived", index, count)
+}
+
+func (metrics *recordingMailboxMetrics) BytesSent(index tla.TLAValue, n int) {
+	metrics.record("BytesSent", index, n)
+}
+
+func (metrics *recordingMailboxMetrics) BytesReceived(index tla.TLAValue, n int) {
+	metrics.record("BytesReceived", index, n)
+}
+
+func (metrics *recordingMailboxMetrics) CommitRetried(index tla.TLAValue) {
+	metrics.record("CommitRetried", index, 1)
+}
+
+func (metrics *recordingMailboxMetrics) DialFailed(index tla.TLAValue) {
+	metrics.record("DialFailed", index, 1)
+}
+
+func (metrics *recordingMailboxMetrics) CommitLatency(index tla.TLAValue, latency time.Duration) {
+	metrics.record("CommitLatency", index, 1)
+}
+
+func (metrics *recordingMailboxMetrics) QueueDepth(index tla.TLAValue, depth int) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+	if metrics.totals == nil {
+		metrics.totals = make(map[string]int)
+	}
+	metrics.totals[fmt.Sprintf("QueueDepth %v", index)] = depth
+}
+
+func TestTCPMailboxesMetrics(t *testing.T) {
+	addrs := []string{freeLocalAddr(t), freeLocalAddr(t)}
+	receiverMetrics, senderMetrics := &recordingMailboxMetrics{}, &recordingMailboxMetrics{}
+	receiver := makeTCPMailboxesTest(t, 0, addrs, WithTCPMailboxesMetrics(receiverMetrics))
+	transport := &lossyMailboxTransport{}
+	sender := makeTCPMailboxesTest(t, -1, addrs, WithTCPMailboxesMetrics(senderMetrics), WithTCPMailboxesTransport(transport))
+
+	mailboxesTestSend(t, sender, 0, 1, 2)
+	mailboxesTestSend(t, sender, 0, 3)
+	awaitCondition(t, "the values to be received", func() bool {
+		return receiverMetrics.total("MessagesReceived", 0) == 3
+	})
+	if sent := senderMetrics.total("MessagesSent", 0); sent != 3 {
+		t.Errorf("expected 3 messages to be sent, got %d", sent)
+	}
+	if latencies := senderMetrics.total("CommitLatency", 0); latencies != 2 {
+		t.Errorf("expected the latency of 2 commits, got %d", latencies)
+	}
+	for _, metrics := range []*recordingMailboxMetrics{senderMetrics, receiverMetrics} {
+		if metrics.total("BytesSent", 0) == 0 || metrics.total("BytesReceived", 0) == 0 {
+			t.Errorf("expected bytes to be sent and received, got %d and %d", metrics.total("BytesSent", 0), metrics.total("BytesReceived", 0))
+		}
+	}
+
+	// the queue depth is reported as critical sections reading from the mailbox end
+	mailboxesTestReceive(t, receiver, 0, 1)
+	mailboxesTestAbort(receiver)
+	if depth := receiverMetrics.total("QueueDepth", 0); depth != 3 {
+		t.Errorf("expected a queue depth of 3, got %d", depth)
+	}
+	mailboxesTestReceive(t, receiver, 0, 2)
+	mailboxesTestCommit(t, receiver)
+	if depth := receiverMetrics.total("QueueDepth", 0); depth != 1 {
+		t.Errorf("expected a queue depth of 1, got %d", depth)
+	}
+
+	// a commit whose ack is lost is retried
+	if err := mailboxesTestIndex(t, sender, 0).WriteValue(tla.MakeTLANumber(4)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-sender.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	atomic.StoreInt32(&transport.loseNextReply, 1)
+	<-sender.Commit()
+	if retries := senderMetrics.total("CommitRetried", 0); retries != 1 {
+		t.Errorf("expected 1 commit to be retried, got %d", retries)
+	}
+
+	// nothing listens at mailbox 1
+	if err := mailboxesTestIndex(t, sender, 1).WriteValue(tla.MakeTLANumber(5)); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected writing to an unreachable mailbox to abort, got %v", err)
+	}
+	mailboxesTestAbort(sender)
+	if failures := senderMetrics.total("DialFailed", 1); failures != 1 {
+		t.Errorf("expected 1 failed dial, got %d", failures)
+	}
+}
+
+func TestTCPMailboxesReceiveFilter(t *testing.T) {
+	addrs := []string{freeLocalAddr(t)}
+	var secondFilterCalls int32
+	receiver := makeTCPMailboxesTest(t, 0, addrs,
+		WithTCPMailboxesReceiveFilter(func(index tla.TLAValue, sender string, value tla.TLAValue) error {
+			if value.AsNumber()%2 != 0 {
+				return errors.New("odd")
+			}
+			return nil
+		}),
+		WithTCPMailboxesReceiveFilter(func(index tla.TLAValue, sender string, value tla.TLAValue) error {
+			atomic.AddInt32(&secondFilterCalls, 1)
+			if !index.Equal(tla.MakeTLANumber(0)) {
+				t.Errorf("expected the filter to be given mailbox 0, got %v", index)
+			}
+			if sender != tla.MakeTLAString("trusted").String() {
+				return fmt.Errorf("unknown sender %s", sender)
+			}
+			return nil
+		}))
+	trusted := makeTCPMailboxesTest(t, -1, addrs, WithTCPMailboxesIncarnation(tla.MakeTLAString("trusted"), 1))
+	anonymous := makeTCPMailboxesTest(t, -1, addrs)
+
+	// values must be accepted by every filter, and those rejected are dropped individually
+	mailboxesTestSend(t, anonymous, 0, 2)
+	mailboxesTestSend(t, trusted, 0, 1, 4, 5, 6)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 2), 4, 6)
+	mailboxesTestCommit(t, receiver)
+	expectMailboxesTestEmpty(t, receiver, 0)
+
+	// filters are applied in order, so values the first one rejects never reach the second
+	if calls := atomic.LoadInt32(&secondFilterCalls); calls != 3 {
+		t.Fatalf("expected the second filter to see the 3 even values, saw %d", calls)
+	}
+}
+
+func TestTCPMailboxesBindAddress(t *testing.T) {
+	_, port, err := net.SplitHostPort(freeLocalAddr(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the receiver is advertised at an external address it cannot listen on, as if behind NAT, and binds all
+	// interfaces instead, where the sender reaches it via loopback
+	advertisedAddr := net.JoinHostPort("mailbox.invalid", port)
+	receiver := makeTCPMailboxesTest(t, 0, []string{advertisedAddr}, WithTCPMailboxesBindAddress(BindAllInterfaces))
+	sender := makeTCPMailboxesTest(t, -1, []string{net.JoinHostPort("127.0.0.1", port)})
+	mailboxesTestSend(t, sender, 0, 1)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 1), 1)
+	mailboxesTestCommit(t, receiver)
+	if listenAddr := mailboxesTestIndex(t, receiver, 0).(*tcpMailboxesLocal).listenAddr; listenAddr != net.JoinHostPort("0.0.0.0", port) {
+		t.Fatalf("expected the mailbox to listen on all interfaces, got %s", listenAddr)
+	}
+
+	if _, err := BindAllInterfaces(tla.MakeTLANumber(0), "mailbox.invalid"); err == nil {
+		t.Fatal("expected an advertised address without a port to be rejected")
+	}
+	// a bind function's error is reported like failing to listen
+	bindErr := errors.New("no address to bind")
+	func() {
+		defer func() {
+			if err, ok := recover().(error); !ok || !errors.Is(err, bindErr) {
+				t.Fatalf("expected making the mailbox to fail with %v, got %v", bindErr, err)
+			}
+		}()
+		makeTCPMailboxesTest(t, 0, []string{advertisedAddr}, WithTCPMailboxesBindAddress(func(tla.TLAValue, string) (string, error) {
+			return "", bindErr
+		}))
+	}()
+}
+
+func TestTCPMailboxesUnixSockets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mailbox.sock")
+	// a socket left behind by a crashed process is removed before listening
+	stale, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	if err := stale.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	addrs := []string{unixAddressPrefix + path}
+	receiver := makeTCPMailboxesTest(t, 0, addrs)
+	sender := makeTCPMailboxesTest(t, -1, addrs)
+	mailboxesTestSend(t, sender, 0, 1, 2)
+	expectMailboxesTestValues(t, mailboxesTestReceive(t, receiver, 0, 2), 1, 2)
+	mailboxesTestCommit(t, receiver)
+
+	// but any other file is not
+	other := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(other, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := listenMailbox(unixAddressPrefix + other); err == nil {
+		t.Fatal("expected listening over a file that is not a socket to fail")
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Fatalf("expected the file to be left alone, got %v", err)
+	}
+}
+
+// localMailboxesTestArchetype makes an archetype named name whose only critical section runs body on its mailboxes,
+// the parameter net, then goes to Done.
+func localMailboxesTestArchetype(name string, body func(iface distsys.ArchetypeInterface, net distsys.ArchetypeResourceHandle) error) distsys.MPCalArchetype {
+	return distsys.MPCalArchetype{
+		Name:              name,
+		Label:             name + ".run",
+		RequiredRefParams: []string{name + ".net"},
+		RequiredValParams: []string{},
+		JumpTable: distsys.MakeMPCalJumpTable(
+			distsys.MPCalCriticalSection{
+				Name: name + ".run",
+				Body: func(iface distsys.ArchetypeInterface) error {
+					net, err := iface.RequireArchetypeResourceRef(name + ".net")
+					if err != nil {
+						return err
+					}
+					if err := body(iface, net); err != nil {
+						return err
+					}
+					return iface.Goto(name + ".Done")
+				},
+			},
+			distsys.MPCalCriticalSection{
+				Name: name + ".Done",
+				Body: func(distsys.ArchetypeInterface) error {
+					return distsys.ErrDone
+				},
+			},
+		),
+		ProcTable: distsys.MakeMPCalProcTable(),
+		PreAmble:  func(distsys.ArchetypeInterface) {},
+	}
+}
+
+func TestLocalMailboxes(t *testing.T) {
+	// archetypes sharing one LocalMailboxes exchange messages through it, without listening on any port
+	mailboxes := NewLocalMailboxes()
+	sent := []tla.TLAValue{tla.MakeTLAString("a"), tla.MakeTLAString("b")}
+	send := localMailboxesTestArchetype("ASend", func(iface distsys.ArchetypeInterface, net distsys.ArchetypeResourceHandle) error {
+		for _, value := range sent {
+			if err := iface.Write(net, []tla.TLAValue{tla.MakeTLANumber(2)}, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	received := make(chan tla.TLAValue, len(sent))
+	receive := localMailboxesTestArchetype("AReceive", func(iface distsys.ArchetypeInterface, net distsys.ArchetypeResourceHandle) error {
+		var values []tla.TLAValue
+		for range sent {
+			value, err := iface.Read(net, []tla.TLAValue{iface.Self()})
+			if err != nil {
+				return err
+			}
+			values = append(values, value)
+		}
+		// the critical section may still abort and be retried, so only report what it read once it is done
+		for _, value := range values {
+			received <- value
+		}
+		return nil
+	})
+
+	contexts := []*distsys.MPCalContext{
+		distsys.NewMPCalContext(tla.MakeTLANumber(2), receive,
+			distsys.EnsureArchetypeRefParam("net", LocalMailboxesMaker(mailboxes))),
+		distsys.NewMPCalContext(tla.MakeTLANumber(1), send,
+			distsys.EnsureArchetypeRefParam("net", LocalMailboxesMaker(mailboxes))),
+	}
+	results := make(chan error, len(contexts))
+	for _, ctx := range contexts {
+		ctx := ctx
+		go func() {
+			results <- ctx.Run()
+		}()
+		t.Cleanup(func() {
+			_ = ctx.Close()
+		})
+	}
+	for range contexts {
+		select {
+		case err := <-results:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for the archetypes to finish")
+		}
+	}
+	for _, value := range sent {
+		if v := <-received; !v.Equal(value) {
+			t.Fatalf("expected to receive %v, received %v", value, v)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"crypto/tls"
+	"net"
+	"time"
+)
+
+// MailboxTransport establishes the connections that mailboxes exchange messages over. Mailboxes implement their
+// framing (via a MailboxCodec) and two-phase commit protocol on top of the returned connections, so a custom
+// transport, e.g. one adding TLS, going through a proxy, or simulating a network in-process, only has to provide
+// reliable, ordered byte streams.
+//
+// Sending and receiving individual messages is deliberately not part of this interface: it is done by the
+// MailboxEncoder and MailboxDecoder that the configured MailboxCodec wraps around each connection, and the protocol
+// preamble, compression and byte metrics all work at the level of those byte streams. To change how messages are
+// represented on the wire, use WithMailboxCodec instead.
+//
+// Connections must support deadlines, which mailboxes use to implement their timeouts.
+//
+// Transports built on libraries outside the standard library plug in the same way, by presenting each of their
+// streams as a net.Conn, as the QUIC transport in the separate quicmailboxes module does, and as the gRPC transport
+// in the separate grpcresources module does, carrying all connections to an address as streams of one TLS connection.
+type MailboxTransport interface {
+	// Listen starts accepting connections at addr, as given by the mailbox addressing function.
+	Listen(addr string) (net.Listener, error)
+	// Dial connects to a listener at addr, giving up after timeout.
+	Dial(addr string, timeout time.Duration) (net.Conn, error)
+}
+
+// WithTCPMailboxesTransport makes the mailboxes communicate via transport. All processes exchanging messages must
+// use compatible transports. The default is DefaultMailboxTransport.
+func WithTCPMailboxesTransport(transport MailboxTransport) TCPMailboxesOption {
+	return func(cfg *tcpMailboxesConfig) {
+		cfg.transport = transport
+	}
+}
+
+// DefaultMailboxTransport connects mailboxes over TCP, given host:port addresses, or over Unix domain sockets, given
+// addresses of the form unix:///path/to/socket.
+type DefaultMailboxTransport struct{}
+
+var _ MailboxTransport = DefaultMailboxTransport{}
+
+func (DefaultMailboxTransport) Listen(addr string) (net.Listener, error) {
+	return listenMailbox(addr)
+}
+
+func (DefaultMailboxTransport) Dial(addr string, timeout time.Duration) (net.Conn, error) {
+	network, address := splitMailboxAddress(addr)
+	return net.DialTimeout(network, address, timeout)
+}
+
+// TLSMailboxTransport wraps base, securing all its connections with TLS. Listeners use serverConfig, and dialers
+// use clientConfig; requiring client certificates in serverConfig gives mutual authentication between nodes.
+func TLSMailboxTransport(base MailboxTransport, serverConfig, clientConfig *tls.Config) MailboxTransport {
+	return tlsMailboxTransport{
+		base:         base,
+		serverConfig: serverConfig,
+		clientConfig: clientConfig,
+	}
+}
+
+type tlsMailboxTransport struct {
+	base                       MailboxTransport
+	serverConfig, clientConfig *tls.Config
+}
+
+func (transport tlsMailboxTransport) Listen(addr string) (net.Listener, error) {
+	listener, err := transport.base.Listen(addr)
+	if err != nil {
+		return nil, err
+	}
+	return tls.NewListener(listener, transport.serverConfig), nil
+}
+
+func (transport tlsMailboxTransport) Dial(addr string, timeout time.Duration) (net.Conn, error) {
+	conn, err := transport.base.Dial(addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	tlsConn := tls.Client(conn, transport.clientConfig)
+	if err := tlsConn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := tlsConn.SetDeadline(time.Time{}); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
@@ -0,0 +1,507 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/rpc"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+const (
+	twoPCTimeout       = 1 * time.Second
+	twoPCLockLease     = 10 * time.Second
+	twoPCLockedWait    = 20 * time.Millisecond
+	twoPCCommitRetries = 5
+)
+
+var errTwoPCTimeout = errors.New("two-phase commit call timed out")
+
+// TwoPCReplica holds one copy of the values shared through TwoPCSharedValueMaker and TwoPCSharedMapMaker, and
+// takes part in the two-phase commits that update them. Each node of a system that should hold a copy runs one
+// replica; the set of replicas used by a resource is given by the addresses passed to its maker.
+type TwoPCReplica struct {
+	ListenAddr string
+
+	resourceLogger
+
+	listener net.Listener
+	server   *rpc.Server
+	done     chan struct{}
+
+	lock    sync.Mutex
+	records *immutable.Map // key -> *twoPCRecord
+}
+
+type twoPCRecord struct {
+	value       tla.TLAValue
+	version     int64
+	lockOwner   string
+	lockExpires time.Time
+	// lastOwner is the owner of the last lock released, so that a retried Commit or Abort is recognized
+	lastOwner string
+}
+
+// NewTwoPCReplica creates a replica which will listen on listenAddr. It does nothing until ListenAndServe is
+// called.
+func NewTwoPCReplica(listenAddr string) *TwoPCReplica {
+	return &TwoPCReplica{
+		ListenAddr: listenAddr,
+		done:       make(chan struct{}),
+		records:    immutable.NewMap(tla.TLAValueHasher{}),
+	}
+}
+
+// ListenAndServe starts the replica's RPC server and serves the incoming connections. It blocks until an error
+// occurs or the replica closes.
+func (replica *TwoPCReplica) ListenAndServe() error {
+	replica.server = rpc.NewServer()
+	err := replica.server.Register(&TwoPCRPCReceiver{replica: replica})
+	if err != nil {
+		return err
+	}
+	replica.listener, err = net.Listen("tcp", replica.ListenAddr)
+	if err != nil {
+		return err
+	}
+	replica.log(distsys.LogInfo, "2PC replica started listening", "address", replica.ListenAddr)
+	for {
+		conn, err := replica.listener.Accept()
+		if err != nil {
+			select {
+			case <-replica.done:
+				return nil
+			default:
+				return err
+			}
+		}
+		go replica.server.ServeConn(conn)
+	}
+}
+
+// Close stops the replica's RPC server.
+func (replica *TwoPCReplica) Close() error {
+	var err error
+	close(replica.done)
+	if replica.listener != nil {
+		err = replica.listener.Close()
+	}
+	return err
+}
+
+func (replica *TwoPCReplica) getLocked(key tla.TLAValue) *twoPCRecord {
+	if r, ok := replica.records.Get(key); ok {
+		return r.(*twoPCRecord)
+	}
+	return &twoPCRecord{}
+}
+
+func (record *twoPCRecord) lockedByOther(owner string) bool {
+	return record.lockOwner != "" && record.lockOwner != owner && time.Now().Before(record.lockExpires)
+}
+
+type TwoPCRPCReceiver struct {
+	replica *TwoPCReplica
+}
+
+// TwoPCReadReply is the state of a value at one replica. Version 0 means the value was never written.
+type TwoPCReadReply struct {
+	Value   tla.TLAValue
+	Version int64
+	Locked  bool
+}
+
+// Read returns the replica's copy of the value at key.
+func (rcvr *TwoPCRPCReceiver) Read(key tla.TLAValue, reply *TwoPCReadReply) error {
+	replica := rcvr.replica
+	replica.lock.Lock()
+	defer replica.lock.Unlock()
+	record := replica.getLocked(key)
+	*reply = TwoPCReadReply{Value: record.value, Version: record.version, Locked: record.lockedByOther("")}
+	return nil
+}
+
+// TwoPCPrepareArgs asks a replica to lock the value at Key for Owner, for at most Lease.
+type TwoPCPrepareArgs struct {
+	Key   tla.TLAValue
+	Owner string
+	// ExpectVersion is the latest version the owner read, or -1 if it did not read the value. Preparing fails if
+	// the replica holds a later version, i.e. if the value was written since it was read.
+	ExpectVersion int64
+	Lease         time.Duration
+}
+
+// TwoPCPrepareReply is a replica's vote in the first phase.
+type TwoPCPrepareReply struct {
+	OK      bool
+	Version int64
+}
+
+// Prepare locks the value at args.Key, voting to commit, unless it is locked by another owner or was written
+// since args.ExpectVersion.
+func (rcvr *TwoPCRPCReceiver) Prepare(args TwoPCPrepareArgs, reply *TwoPCPrepareReply) error {
+	replica := rcvr.replica
+	replica.lock.Lock()
+	defer replica.lock.Unlock()
+	record := replica.getLocked(args.Key)
+	reply.Version = record.version
+	if record.lockedByOther(args.Owner) || (args.ExpectVersion >= 0 && record.version > args.ExpectVersion) {
+		return nil
+	}
+	updated := *record
+	updated.lockOwner = args.Owner
+	updated.lockExpires = time.Now().Add(args.Lease)
+	replica.records = replica.records.Set(args.Key, &updated)
+	reply.OK = true
+	return nil
+}
+
+// TwoPCCommitArgs ends Owner's transaction on the value at Key. If HasValue is set, the value becomes Value, at
+// Version.
+type TwoPCCommitArgs struct {
+	Key      tla.TLAValue
+	Owner    string
+	HasValue bool
+	Value    tla.TLAValue
+	Version  int64
+}
+
+// Commit applies args, and releases the lock taken by Prepare. It is also used to abort, by omitting the value.
+// It fails if the lock was lost, e.g. because its lease expired.
+func (rcvr *TwoPCRPCReceiver) Commit(args TwoPCCommitArgs, reply *bool) error {
+	replica := rcvr.replica
+	replica.lock.Lock()
+	defer replica.lock.Unlock()
+	record := replica.getLocked(args.Key)
+	if record.lockOwner != args.Owner {
+		if record.lastOwner == args.Owner {
+			*reply = true // a retry of a commit that already succeeded
+			return nil
+		}
+		return fmt.Errorf("lock on %v is not held by %s", args.Key, args.Owner)
+	}
+	updated := *record
+	updated.lockOwner = ""
+	updated.lastOwner = args.Owner
+	if args.HasValue {
+		updated.value = args.Value
+		updated.version = args.Version
+	}
+	replica.records = replica.records.Set(args.Key, &updated)
+	*reply = true
+	return nil
+}
+
+type twoPCConfig struct {
+	timeout   time.Duration
+	lockLease time.Duration
+}
+
+// TwoPCOption configures a two-phase commit shared resource.
+type TwoPCOption func(cfg *twoPCConfig)
+
+// WithTwoPCTimeout sets the timeout of each call to a replica.
+func WithTwoPCTimeout(timeout time.Duration) TwoPCOption {
+	return func(cfg *twoPCConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithTwoPCLockLease sets how long replicas keep a value locked between the two phases. If the coordinating
+// archetype crashes in between, the value becomes usable again once the lease expires.
+func WithTwoPCLockLease(lease time.Duration) TwoPCOption {
+	return func(cfg *twoPCConfig) {
+		cfg.lockLease = lease
+	}
+}
+
+// twoPCCoordinator holds the connections used by every resource made by one maker.
+type twoPCCoordinator struct {
+	replicaAddrs []string
+	config       twoPCConfig
+
+	ownerPrefix  string
+	ownerCounter int64
+
+	lock    sync.Mutex
+	clients map[string]*rpc.Client
+}
+
+func newTwoPCCoordinator(replicaAddrs []string, opts []TwoPCOption) *twoPCCoordinator {
+	cfg := twoPCConfig{
+		timeout:   twoPCTimeout,
+		lockLease: twoPCLockLease,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &twoPCCoordinator{
+		replicaAddrs: replicaAddrs,
+		config:       cfg,
+		ownerPrefix:  fmt.Sprintf("%x", rand.Int63()),
+		clients:      make(map[string]*rpc.Client),
+	}
+}
+
+func (coord *twoPCCoordinator) newOwner() string {
+	return fmt.Sprintf("%s-%d", coord.ownerPrefix, atomic.AddInt64(&coord.ownerCounter, 1))
+}
+
+func (coord *twoPCCoordinator) call(addr string, method string, args interface{}, reply interface{}) error {
+	coord.lock.Lock()
+	client, ok := coord.clients[addr]
+	coord.lock.Unlock()
+	if !ok {
+		conn, err := net.DialTimeout("tcp", addr, coord.config.timeout)
+		if err != nil {
+			return err
+		}
+		client = rpc.NewClient(conn)
+		coord.lock.Lock()
+		if existing, ok := coord.clients[addr]; ok {
+			_ = client.Close()
+			client = existing
+		} else {
+			coord.clients[addr] = client
+		}
+		coord.lock.Unlock()
+	}
+	call := client.Go(method, args, reply, nil)
+	var err error
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-time.After(coord.config.timeout):
+		err = errTwoPCTimeout
+	}
+	if _, isServerErr := err.(rpc.ServerError); err != nil && !isServerErr {
+		coord.lock.Lock()
+		if coord.clients[addr] == client {
+			delete(coord.clients, addr)
+		}
+		coord.lock.Unlock()
+		_ = client.Close()
+	}
+	return err
+}
+
+// callAll calls method on every replica concurrently, with the arguments and reply given by mkCall for each, and
+// returns the first error.
+func (coord *twoPCCoordinator) callAll(method string, mkCall func(i int) (args interface{}, reply interface{})) error {
+	errs := make([]error, len(coord.replicaAddrs))
+	var wg sync.WaitGroup
+	for i, addr := range coord.replicaAddrs {
+		i, addr := i, addr
+		args, reply := mkCall(i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = coord.call(addr, method, args, reply)
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TwoPCSharedValueMaker produces a distsys.ArchetypeResourceMaker for a single value shared by every archetype
+// using a resource with the same name and replicas. Every replica at replicaAddrs holds a copy of the value, which
+// starts as initial, and must be reachable for the value to be used.
+//
+// Within a critical section, the first read fetches the value from every replica, keeping the latest version. The
+// critical section's pre-commit is the first phase of a two-phase commit: each replica locks the value, and votes
+// to commit unless it was written since it was read. If any replica votes no, or cannot be reached, the critical
+// section aborts and retries. Otherwise, the commit is the second phase, writing the new value, if any, to every
+// replica and releasing the locks. Critical sections touching several shared values thus behave atomically with
+// respect to each other. Reads of a value locked by another critical section abort, and retry later.
+func TwoPCSharedValueMaker(replicaAddrs []string, name string, initial tla.TLAValue, opts ...TwoPCOption) distsys.ArchetypeResourceMaker {
+	coord := newTwoPCCoordinator(replicaAddrs, opts)
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		return &twoPCSharedValue{
+			coord:   coord,
+			key:     tla.MakeTLATuple(tla.MakeTLAString(name)),
+			initial: initial,
+		}
+	})
+}
+
+// TwoPCSharedMapMaker is like TwoPCSharedValueMaker, but for a map of shared values, each of which starts as
+// initial.
+func TwoPCSharedMapMaker(replicaAddrs []string, name string, initial tla.TLAValue, opts ...TwoPCOption) distsys.ArchetypeResourceMaker {
+	coord := newTwoPCCoordinator(replicaAddrs, opts)
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+			return &twoPCSharedValue{
+				coord:   coord,
+				key:     tla.MakeTLATuple(tla.MakeTLAString(name), index),
+				initial: initial,
+			}
+		})
+	})
+}
+
+type twoPCSharedValue struct {
+	distsys.ArchetypeResourceLeafMixin
+	resourceLogger
+	coord   *twoPCCoordinator
+	key     tla.TLAValue
+	initial tla.TLAValue
+
+	hasRead      bool
+	readVersion  int64
+	value        tla.TLAValue
+	writePending bool
+	owner        string // non-empty once the first phase has started
+	newVersion   int64
+}
+
+var _ distsys.ArchetypeResource = &twoPCSharedValue{}
+
+func (res *twoPCSharedValue) reset() {
+	res.hasRead = false
+	res.value = tla.TLAValue{}
+	res.writePending = false
+	res.owner = ""
+}
+
+// finish runs the second phase, retrying calls to replicas that fail. A replica that misses the new value is
+// brought up to date by the next write, and is ignored by reads meanwhile, as its version is older.
+func (res *twoPCSharedValue) finish(apply bool) {
+	args := TwoPCCommitArgs{Key: res.key, Owner: res.owner}
+	if apply && res.writePending {
+		args.HasValue = true
+		args.Value = res.value
+		args.Version = res.newVersion
+	}
+	var err error
+	for attempt := 0; attempt < twoPCCommitRetries; attempt++ {
+		err = res.coord.callAll("TwoPCRPCReceiver.Commit", func(int) (interface{}, interface{}) {
+			return &args, new(bool)
+		})
+		if err == nil {
+			return
+		}
+	}
+	res.log(distsys.LogWarn, "could not complete 2PC transaction at every replica", "key", res.key, "error", err)
+}
+
+func (res *twoPCSharedValue) Abort() chan struct{} {
+	if res.owner == "" {
+		res.reset()
+		return nil
+	}
+	ch := make(chan struct{}, 1)
+	go func() {
+		res.finish(false)
+		res.reset()
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+func (res *twoPCSharedValue) PreCommit() chan error {
+	if !res.hasRead && !res.writePending {
+		return nil
+	}
+	ch := make(chan error, 1)
+	go func() {
+		res.owner = res.coord.newOwner()
+		args := TwoPCPrepareArgs{
+			Key:           res.key,
+			Owner:         res.owner,
+			ExpectVersion: -1,
+			Lease:         res.coord.config.lockLease,
+		}
+		if res.hasRead {
+			args.ExpectVersion = res.readVersion
+		}
+		replies := make([]TwoPCPrepareReply, len(res.coord.replicaAddrs))
+		err := res.coord.callAll("TwoPCRPCReceiver.Prepare", func(i int) (interface{}, interface{}) {
+			return &args, &replies[i]
+		})
+		if err != nil {
+			res.log(distsys.LogWarn, "could not prepare 2PC transaction, aborting", "key", res.key, "error", err)
+			ch <- distsys.ErrCriticalSectionAborted
+			return
+		}
+		res.newVersion = 0
+		for _, reply := range replies {
+			if !reply.OK {
+				ch <- distsys.ErrCriticalSectionAborted
+				return
+			}
+			if reply.Version >= res.newVersion {
+				res.newVersion = reply.Version + 1
+			}
+		}
+		ch <- nil
+	}()
+	return ch
+}
+
+func (res *twoPCSharedValue) Commit() chan struct{} {
+	if res.owner == "" {
+		res.reset()
+		return nil
+	}
+	ch := make(chan struct{}, 1)
+	go func() {
+		res.finish(true)
+		res.reset()
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+func (res *twoPCSharedValue) ReadValue() (tla.TLAValue, error) {
+	if res.writePending || res.hasRead {
+		return res.value, nil
+	}
+	replies := make([]TwoPCReadReply, len(res.coord.replicaAddrs))
+	err := res.coord.callAll("TwoPCRPCReceiver.Read", func(i int) (interface{}, interface{}) {
+		return &res.key, &replies[i]
+	})
+	if err != nil {
+		res.log(distsys.LogWarn, "could not read from 2PC replicas, aborting", "key", res.key, "error", err)
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	}
+	latest := TwoPCReadReply{Version: -1}
+	for _, reply := range replies {
+		if reply.Locked {
+			time.Sleep(twoPCLockedWait)
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+		if reply.Version > latest.Version {
+			latest = reply
+		}
+	}
+	res.hasRead = true
+	res.readVersion = latest.Version
+	if latest.Version > 0 {
+		res.value = latest.Value
+	} else {
+		res.value = res.initial
+	}
+	return res.value, nil
+}
+
+func (res *twoPCSharedValue) WriteValue(value tla.TLAValue) error {
+	res.value = value
+	res.writePending = true
+	return nil
+}
+
+func (res *twoPCSharedValue) Close() error {
+	return nil
+}
@@ -0,0 +1,343 @@
+package resources
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// startTwoPCTestReplica starts a replica listening on addr, closing it when the test ends unless the test closed it
+// already.
+func startTwoPCTestReplica(t *testing.T, addr string) *TwoPCReplica {
+	t.Helper()
+	replica := NewTwoPCReplica(addr)
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- replica.ListenAndServe()
+	}()
+	t.Cleanup(func() {
+		select {
+		case <-replica.done: // closed by the test
+		default:
+			_ = replica.Close()
+		}
+		if err := <-serveErr; err != nil {
+			t.Error(err)
+		}
+	})
+	awaitCondition(t, "the replica to start listening", func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			_ = conn.Close()
+		}
+		return err == nil
+	})
+	return replica
+}
+
+func startTwoPCTestReplicas(t *testing.T, count int) (replicas []*TwoPCReplica, addrs []string) {
+	t.Helper()
+	for i := 0; i < count; i++ {
+		addr := freeLocalAddr(t)
+		replicas = append(replicas, startTwoPCTestReplica(t, addr))
+		addrs = append(addrs, addr)
+	}
+	return replicas, addrs
+}
+
+// severableProxy forwards connections to a target address, and can sever those it forwarded so far, as if the
+// network had failed.
+type severableProxy struct {
+	listener net.Listener
+	target   string
+
+	lock  sync.Mutex
+	conns []net.Conn
+}
+
+func startSeverableProxy(t *testing.T, target string) *severableProxy {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy := &severableProxy{listener: listener, target: target}
+	t.Cleanup(func() {
+		_ = listener.Close()
+		proxy.sever()
+	})
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			upstream, err := net.Dial("tcp", target)
+			if err != nil {
+				_ = conn.Close()
+				continue
+			}
+			proxy.lock.Lock()
+			proxy.conns = append(proxy.conns, conn, upstream)
+			proxy.lock.Unlock()
+			go func() {
+				_, _ = io.Copy(upstream, conn)
+				_ = upstream.Close()
+			}()
+			go func() {
+				_, _ = io.Copy(conn, upstream)
+				_ = conn.Close()
+			}()
+		}
+	}()
+	return proxy
+}
+
+func (proxy *severableProxy) addr() string {
+	return proxy.listener.Addr().String()
+}
+
+func (proxy *severableProxy) sever() {
+	proxy.lock.Lock()
+	defer proxy.lock.Unlock()
+	for _, conn := range proxy.conns {
+		_ = conn.Close()
+	}
+	proxy.conns = nil
+}
+
+func newTwoPCTestValue(maker distsys.ArchetypeResourceMaker) *twoPCSharedValue {
+	return maker.Make().(*twoPCSharedValue)
+}
+
+// twoPCTestIncrement runs a critical section adding one to res, returning whether it committed.
+func twoPCTestIncrement(t *testing.T, res *twoPCSharedValue) bool {
+	t.Helper()
+	value, err := res.ReadValue()
+	if errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		return false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := res.WriteValue(tla.MakeTLANumber(value.AsNumber() + 1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-res.PreCommit(); err != nil {
+		if !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatal(err)
+		}
+		if ch := res.Abort(); ch != nil {
+			<-ch
+		}
+		return false
+	}
+	if ch := res.Commit(); ch != nil {
+		<-ch
+	}
+	return true
+}
+
+// twoPCTestRead reads the value at key from every replica directly, checking that they agree.
+func twoPCTestRead(t *testing.T, replicas []*TwoPCReplica, key tla.TLAValue) TwoPCReadReply {
+	t.Helper()
+	var first TwoPCReadReply
+	for i, replica := range replicas {
+		var reply TwoPCReadReply
+		if err := (&TwoPCRPCReceiver{replica: replica}).Read(key, &reply); err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			first = reply
+		} else if reply.Version != first.Version || !reply.Value.Equal(first.Value) {
+			t.Fatalf("replicas disagree on %v: %+v and %+v", key, first, reply)
+		}
+	}
+	return first
+}
+
+func TestTwoPCConflictingCriticalSections(t *testing.T) {
+	replicas, addrs := startTwoPCTestReplicas(t, 3)
+	maker := TwoPCSharedValueMaker(addrs, "x", tla.MakeTLANumber(0))
+	key := tla.MakeTLATuple(tla.MakeTLAString("x"))
+	a, b := newTwoPCTestValue(maker), newTwoPCTestValue(maker)
+
+	t.Run("written since read", func(t *testing.T) {
+		if _, err := a.ReadValue(); err != nil {
+			t.Fatal(err)
+		}
+		if !twoPCTestIncrement(t, b) {
+			t.Fatal("expected the critical section without conflicts to commit")
+		}
+		// a read the value before b wrote it, so a must abort
+		if err := a.WriteValue(tla.MakeTLANumber(100)); err != nil {
+			t.Fatal(err)
+		}
+		if err := <-a.PreCommit(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatalf("expected the stale critical section to abort, got %v", err)
+		}
+		<-a.Abort()
+		// and its retry sees b's write
+		if !twoPCTestIncrement(t, a) {
+			t.Fatal("expected the retried critical section to commit")
+		}
+		if reply := twoPCTestRead(t, replicas, key); !reply.Value.Equal(tla.MakeTLANumber(2)) || reply.Locked {
+			t.Fatalf("expected 2, unlocked, got %+v", reply)
+		}
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		if _, err := a.ReadValue(); err != nil {
+			t.Fatal(err)
+		}
+		if err := a.WriteValue(tla.MakeTLANumber(3)); err != nil {
+			t.Fatal(err)
+		}
+		if err := <-a.PreCommit(); err != nil {
+			t.Fatal(err)
+		}
+		// while a holds the locks, b can neither read nor blindly write the value
+		if _, err := b.ReadValue(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatalf("expected reading a locked value to abort, got %v", err)
+		}
+		if err := b.WriteValue(tla.MakeTLANumber(100)); err != nil {
+			t.Fatal(err)
+		}
+		if err := <-b.PreCommit(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+			t.Fatalf("expected preparing a locked value to abort, got %v", err)
+		}
+		// b's abort must not release a's locks
+		<-b.Abort()
+		if reply := twoPCTestRead(t, replicas, key); !reply.Locked {
+			t.Fatal("expected the aborted critical section to leave the other's locks alone")
+		}
+		<-a.Commit()
+		if !twoPCTestIncrement(t, b) {
+			t.Fatal("expected the retried critical section to commit")
+		}
+		if reply := twoPCTestRead(t, replicas, key); !reply.Value.Equal(tla.MakeTLANumber(4)) {
+			t.Fatalf("expected 4, got %+v", reply)
+		}
+	})
+
+	t.Run("concurrent increments", func(t *testing.T) {
+		const workers, increments = 4, 10
+		before := twoPCTestRead(t, replicas, key).Value.AsNumber()
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			res := newTwoPCTestValue(maker)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for done := 0; done < increments; {
+					if twoPCTestIncrement(t, res) {
+						done++
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		if after := twoPCTestRead(t, replicas, key).Value.AsNumber(); after != before+workers*increments {
+			t.Fatalf("expected %d increments from %d, got %d", workers*increments, before, after)
+		}
+	})
+}
+
+func TestTwoPCReplicaDown(t *testing.T) {
+	replicas, addrs := startTwoPCTestReplicas(t, 3)
+	maker := TwoPCSharedValueMaker(addrs, "x", tla.MakeTLANumber(0), WithTwoPCTimeout(200*time.Millisecond))
+	key := tla.MakeTLATuple(tla.MakeTLAString("x"))
+	if !twoPCTestIncrement(t, newTwoPCTestValue(maker)) {
+		t.Fatal("expected the critical section to commit with every replica up")
+	}
+
+	// a replica crashes, and another coordinator, which has no connection to it yet, tries to use the value
+	down := replicas[2]
+	if err := down.Close(); err != nil {
+		t.Fatal(err)
+	}
+	other := newTwoPCTestValue(TwoPCSharedValueMaker(addrs, "x", tla.MakeTLANumber(0), WithTwoPCTimeout(200*time.Millisecond)))
+	if _, err := other.ReadValue(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected reading with a replica down to abort, got %v", err)
+	}
+	if err := other.WriteValue(tla.MakeTLANumber(100)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-other.PreCommit(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected preparing with a replica down to abort, got %v", err)
+	}
+	<-other.Abort()
+	// the replicas that were up voted yes, then were told to abort, so nothing changed and nothing stays locked
+	if reply := twoPCTestRead(t, replicas[:2], key); !reply.Value.Equal(tla.MakeTLANumber(1)) || reply.Locked {
+		t.Fatalf("expected 1, unlocked, got %+v", reply)
+	}
+
+	// once the replica is back, critical sections go through again
+	replicas[2] = startTwoPCTestReplica(t, addrs[2])
+	awaitCondition(t, "a critical section to commit", func() bool {
+		return twoPCTestIncrement(t, other)
+	})
+	// the restarted replica lost its copy, but the read used the latest version, and the write brought it up to date
+	if reply := twoPCTestRead(t, replicas, key); !reply.Value.Equal(tla.MakeTLANumber(2)) || reply.Version != 2 {
+		t.Fatalf("expected version 2 of 2, got %+v", reply)
+	}
+}
+
+func TestTwoPCLockLeaseExpiry(t *testing.T) {
+	const lease = 300 * time.Millisecond
+	replicas, addrs := startTwoPCTestReplicas(t, 3)
+	key := tla.MakeTLATuple(tla.MakeTLAString("x"))
+	crashing := newTwoPCTestValue(TwoPCSharedValueMaker(addrs, "x", tla.MakeTLANumber(0), WithTwoPCLockLease(lease)))
+	other := newTwoPCTestValue(TwoPCSharedValueMaker(addrs, "x", tla.MakeTLANumber(0), WithTwoPCLockLease(lease)))
+
+	// the coordinator crashes between the two phases, leaving the value locked at every replica
+	if err := crashing.WriteValue(tla.MakeTLANumber(100)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-crashing.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	lockedAt := time.Now()
+	if reply := twoPCTestRead(t, replicas, key); !reply.Locked {
+		t.Fatal("expected the value to be locked after the first phase")
+	}
+
+	awaitCondition(t, "the locks to expire", func() bool {
+		return twoPCTestIncrement(t, other)
+	})
+	if elapsed := time.Since(lockedAt); elapsed < lease/2 {
+		t.Fatalf("the value was usable %v after it was locked, well before the lease of %v expired", elapsed, lease)
+	}
+	// the crashed coordinator's write never happened
+	if reply := twoPCTestRead(t, replicas, key); !reply.Value.Equal(tla.MakeTLANumber(1)) || reply.Locked {
+		t.Fatalf("expected 1, unlocked, got %+v", reply)
+	}
+}
+
+func TestTwoPCFinishRetriesMissedCommit(t *testing.T) {
+	replicas, addrs := startTwoPCTestReplicas(t, 3)
+	proxy := startSeverableProxy(t, addrs[1])
+	addrs[1] = proxy.addr()
+	res := newTwoPCTestValue(TwoPCSharedValueMaker(addrs, "x", tla.MakeTLANumber(0)))
+	key := tla.MakeTLATuple(tla.MakeTLAString("x"))
+
+	if err := res.WriteValue(tla.MakeTLANumber(7)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-res.PreCommit(); err != nil {
+		t.Fatal(err)
+	}
+	// the connection to one replica breaks between the phases, so the first commit call to it fails
+	proxy.sever()
+	<-res.Commit()
+	// finish must have retried over a new connection: every replica has the value, and none is still locked
+	if reply := twoPCTestRead(t, replicas, key); !reply.Value.Equal(tla.MakeTLANumber(7)) || reply.Version != 1 || reply.Locked {
+		t.Fatalf("expected version 1 of 7, unlocked, at every replica, got %+v", reply)
+	}
+}
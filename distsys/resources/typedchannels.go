@@ -0,0 +1,395 @@
+package resources
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// TLAConverter converts Go values of type T to and from TLA+ values, for the typed channel adapters.
+type TLAConverter[T any] struct {
+	ToTLA   func(value T) (tla.TLAValue, error)
+	FromTLA func(value tla.TLAValue) (T, error)
+}
+
+// ReflectTLAConverter returns a TLAConverter that converts values based on their Go type, using reflection:
+//   - bool converts to a TLA+ boolean, and integer types to a TLA+ number; converting back fails if the number does
+//     not fit in the Go type;
+//   - string converts to a TLA+ string;
+//   - slices and arrays convert to tuples; []byte converts to a byte string, which is a tuple of numbers from 0
+//     to 255, stored compactly;
+//   - maps convert to functions from their keys to their values;
+//   - structs convert to records, with one string key per exported field, named by the field's `tla` tag if it
+//     has one, or else by the field's name. A tag of "-" skips the field;
+//   - pointers convert as the value they point to;
+//   - types implementing tla.TLACustomValue convert to custom values, and back if the custom value is of the
+//     same type;
+//   - tla.TLAValue is left as-is.
+func ReflectTLAConverter[T any]() TLAConverter[T] {
+	return TLAConverter[T]{
+		ToTLA: func(value T) (tla.TLAValue, error) {
+			return reflectToTLA(reflect.ValueOf(&value).Elem())
+		},
+		FromTLA: func(value tla.TLAValue) (T, error) {
+			var result T
+			err := reflectFromTLA(value, reflect.ValueOf(&result).Elem())
+			return result, err
+		},
+	}
+}
+
+var tlaValueType = reflect.TypeOf(tla.TLAValue{})
+var tlaCustomValueType = reflect.TypeOf((*tla.TLACustomValue)(nil)).Elem()
+
+func reflectFieldName(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" {
+		return "", false // unexported
+	}
+	name := field.Tag.Get("tla")
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+func reflectToTLA(v reflect.Value) (tla.TLAValue, error) {
+	if v.Type() == tlaValueType {
+		return v.Interface().(tla.TLAValue), nil
+	}
+	if v.Type().Implements(tlaCustomValueType) && !(v.Kind() == reflect.Ptr && v.IsNil()) {
+		return tla.MakeTLACustomValue(v.Interface().(tla.TLACustomValue)), nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return tla.MakeTLABool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return tla.MakeTLABigNumber(big.NewInt(v.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return tla.MakeTLABigNumber(new(big.Int).SetUint64(v.Uint())), nil
+	case reflect.String:
+		return tla.MakeTLAString(v.String()), nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return tla.MakeTLABytes(v.Bytes()), nil
+		}
+		elems := make([]tla.TLAValue, v.Len())
+		for i := range elems {
+			elem, err := reflectToTLA(v.Index(i))
+			if err != nil {
+				return tla.TLAValue{}, err
+			}
+			elems[i] = elem
+		}
+		return tla.MakeTLATuple(elems...), nil
+	case reflect.Map:
+		var fields []tla.TLARecordField
+		it := v.MapRange()
+		for it.Next() {
+			key, err := reflectToTLA(it.Key())
+			if err != nil {
+				return tla.TLAValue{}, err
+			}
+			value, err := reflectToTLA(it.Value())
+			if err != nil {
+				return tla.TLAValue{}, err
+			}
+			fields = append(fields, tla.TLARecordField{Key: key, Value: value})
+		}
+		return tla.MakeTLARecord(fields), nil
+	case reflect.Struct:
+		var fields []tla.TLARecordField
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := reflectFieldName(v.Type().Field(i))
+			if !ok {
+				continue
+			}
+			value, err := reflectToTLA(v.Field(i))
+			if err != nil {
+				return tla.TLAValue{}, fmt.Errorf("field %s: %w", name, err)
+			}
+			fields = append(fields, tla.TLARecordField{Key: tla.MakeTLAString(name), Value: value})
+		}
+		return tla.MakeTLARecord(fields), nil
+	case reflect.Ptr:
+		if v.IsNil() {
+			return tla.TLAValue{}, fmt.Errorf("cannot convert a nil %v to a TLA+ value", v.Type())
+		}
+		return reflectToTLA(v.Elem())
+	default:
+		return tla.TLAValue{}, fmt.Errorf("cannot convert values of type %v to TLA+ values", v.Type())
+	}
+}
+
+func reflectFromTLA(value tla.TLAValue, v reflect.Value) error {
+	mismatch := func() error {
+		return fmt.Errorf("cannot convert TLA+ value %v to %v", value, v.Type())
+	}
+	if v.Type() == tlaValueType {
+		v.Set(reflect.ValueOf(value))
+		return nil
+	}
+	if v.Type().Implements(tlaCustomValueType) {
+		if !value.IsCustom() || !reflect.TypeOf(value.AsCustom()).AssignableTo(v.Type()) {
+			return mismatch()
+		}
+		v.Set(reflect.ValueOf(value.AsCustom()))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if !value.IsBool() {
+			return mismatch()
+		}
+		v.SetBool(value.AsBool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !value.IsNumber() || !value.AsBigNumber().IsInt64() || v.OverflowInt(value.AsBigNumber().Int64()) {
+			return mismatch()
+		}
+		v.SetInt(value.AsBigNumber().Int64())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !value.IsNumber() || !value.AsBigNumber().IsUint64() || v.OverflowUint(value.AsBigNumber().Uint64()) {
+			return mismatch()
+		}
+		v.SetUint(value.AsBigNumber().Uint64())
+	case reflect.String:
+		if !value.IsString() {
+			return mismatch()
+		}
+		v.SetString(value.AsString())
+	case reflect.Slice, reflect.Array:
+		if !value.IsTuple() {
+			return mismatch()
+		}
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			data, err := value.TryAsBytes()
+			if err != nil {
+				return mismatch()
+			}
+			v.SetBytes(data)
+			return nil
+		}
+		tuple := value.AsTuple()
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(v.Type(), tuple.Len(), tuple.Len()))
+		} else if v.Len() != tuple.Len() {
+			return mismatch()
+		}
+		for i := 0; i < tuple.Len(); i++ {
+			err := reflectFromTLA(tuple.Get(i).(tla.TLAValue), v.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if value.IsTuple() && value.AsTuple().Len() == 0 {
+			v.Set(reflect.MakeMap(v.Type())) // the empty function is indistinguishable from the empty tuple
+			return nil
+		}
+		if !value.IsFunction() {
+			return mismatch()
+		}
+		fn := value.AsFunction()
+		v.Set(reflect.MakeMapWithSize(v.Type(), fn.Len()))
+		it := fn.Iterator()
+		for !it.Done() {
+			key, elem := it.Next()
+			goKey := reflect.New(v.Type().Key()).Elem()
+			err := reflectFromTLA(key.(tla.TLAValue), goKey)
+			if err != nil {
+				return err
+			}
+			goElem := reflect.New(v.Type().Elem()).Elem()
+			err = reflectFromTLA(elem.(tla.TLAValue), goElem)
+			if err != nil {
+				return err
+			}
+			v.SetMapIndex(goKey, goElem)
+		}
+	case reflect.Struct:
+		if !value.IsFunction() {
+			return mismatch()
+		}
+		fn := value.AsFunction()
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := reflectFieldName(v.Type().Field(i))
+			if !ok {
+				continue
+			}
+			elem, ok := fn.Get(tla.MakeTLAString(name))
+			if !ok {
+				return fmt.Errorf("cannot convert TLA+ value %v to %v: missing field %s", value, v.Type(), name)
+			}
+			err := reflectFromTLA(elem.(tla.TLAValue), v.Field(i))
+			if err != nil {
+				return fmt.Errorf("field %s: %w", name, err)
+			}
+		}
+	case reflect.Ptr:
+		elem := reflect.New(v.Type().Elem())
+		err := reflectFromTLA(value, elem.Elem())
+		if err != nil {
+			return err
+		}
+		v.Set(elem)
+	default:
+		return mismatch()
+	}
+	return nil
+}
+
+// TypedInputChannel is like InputChannel, but reads Go values of type T from the channel, converting them to TLA+
+// values as they are read.
+type TypedInputChannel[T any] struct {
+	distsys.ArchetypeResourceLeafMixin
+	channel               <-chan T
+	converter             TLAConverter[T]
+	buffer, backlogBuffer []tla.TLAValue
+	readTimeout           time.Duration
+}
+
+var _ distsys.ArchetypeResource = &TypedInputChannel[int]{}
+
+// TypedInputChannelMaker is like InputChannelMaker, but for a channel of Go values of type T, which converter turns
+// into TLA+ values. It accepts the same options.
+func TypedInputChannelMaker[T any](channel <-chan T, converter TLAConverter[T], opts ...InputChannelOption) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &TypedInputChannel[T]{}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*TypedInputChannel[T])
+			r.channel = channel
+			r.converter = converter
+			// reuse the options of InputChannel, which share its read timeout semantics
+			cfg := &InputChannel{readTimeout: inputChannelReadTimout}
+			for _, opt := range opts {
+				opt(cfg)
+			}
+			r.readTimeout = cfg.readTimeout
+		},
+	}
+}
+
+func (res *TypedInputChannel[T]) Abort() chan struct{} {
+	res.buffer = append(res.backlogBuffer, res.buffer...)
+	res.backlogBuffer = nil
+	return nil
+}
+
+func (res *TypedInputChannel[T]) PreCommit() chan error {
+	return nil
+}
+
+func (res *TypedInputChannel[T]) Commit() chan struct{} {
+	res.backlogBuffer = nil
+	return nil
+}
+
+func (res *TypedInputChannel[T]) ReadValue() (tla.TLAValue, error) {
+	if len(res.buffer) > 0 {
+		value := res.buffer[0]
+		res.buffer = res.buffer[1:]
+		res.backlogBuffer = append(res.backlogBuffer, value)
+		return value, nil
+	}
+
+	var goValue T
+	if res.readTimeout <= 0 {
+		select {
+		case goValue = <-res.channel:
+		default:
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+	} else {
+		select {
+		case goValue = <-res.channel:
+		case <-time.After(res.readTimeout):
+			return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+		}
+	}
+	value, err := res.converter.ToTLA(goValue)
+	if err != nil {
+		return tla.TLAValue{}, fmt.Errorf("could not convert %v read from a typed input channel: %w", goValue, err)
+	}
+	res.backlogBuffer = append(res.backlogBuffer, value)
+	return value, nil
+}
+
+func (res *TypedInputChannel[T]) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write %v to a typed input channel resource", value))
+}
+
+func (res *TypedInputChannel[T]) Close() error {
+	return nil
+}
+
+// TypedOutputChannel is like OutputChannel, but converts the TLA+ values written to it into Go values of type T,
+// before sending them to the channel.
+type TypedOutputChannel[T any] struct {
+	distsys.ArchetypeResourceLeafMixin
+	channel   chan<- T
+	converter TLAConverter[T]
+	buffer    []T
+}
+
+var _ distsys.ArchetypeResource = &TypedOutputChannel[int]{}
+
+// TypedOutputChannelMaker is like OutputChannelMaker, but for a channel of Go values of type T, which converter
+// produces from the TLA+ values written. A value that cannot be converted causes an error when written.
+func TypedOutputChannelMaker[T any](channel chan<- T, converter TLAConverter[T]) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerStruct{
+		MakeFn: func() distsys.ArchetypeResource {
+			return &TypedOutputChannel[T]{}
+		},
+		ConfigureFn: func(res distsys.ArchetypeResource) {
+			r := res.(*TypedOutputChannel[T])
+			r.channel = channel
+			r.converter = converter
+		},
+	}
+}
+
+func (res *TypedOutputChannel[T]) Abort() chan struct{} {
+	res.buffer = nil
+	return nil
+}
+
+func (res *TypedOutputChannel[T]) PreCommit() chan error {
+	return nil
+}
+
+func (res *TypedOutputChannel[T]) Commit() chan struct{} {
+	ch := make(chan struct{})
+	go func() {
+		for _, value := range res.buffer {
+			res.channel <- value
+		}
+		res.buffer = nil
+		ch <- struct{}{}
+	}()
+	return ch
+}
+
+func (res *TypedOutputChannel[T]) ReadValue() (tla.TLAValue, error) {
+	panic(fmt.Errorf("attempted to read from a typed output channel resource"))
+}
+
+func (res *TypedOutputChannel[T]) WriteValue(value tla.TLAValue) error {
+	goValue, err := res.converter.FromTLA(value)
+	if err != nil {
+		return fmt.Errorf("could not convert %v written to a typed output channel: %w", value, err)
+	}
+	res.buffer = append(res.buffer, goValue)
+	return nil
+}
+
+func (res *TypedOutputChannel[T]) Close() error {
+	return nil
+}
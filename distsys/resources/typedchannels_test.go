@@ -0,0 +1,217 @@
+package resources
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+type typedChannelTestInner struct {
+	Flag bool
+}
+
+type typedChannelTestStruct struct {
+	Name    string `tla:"name"`
+	Count   int
+	Tags    []string
+	Inner   *typedChannelTestInner
+	Skipped string `tla:"-"`
+	hidden  int
+}
+
+// typedChannelTestRoundTrip converts value to TLA+, checks the result is expected, and converts it back.
+func typedChannelTestRoundTrip[T any](t *testing.T, value T, expected tla.TLAValue) {
+	t.Helper()
+	converter := ReflectTLAConverter[T]()
+	converted, err := converter.ToTLA(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !converted.Equal(expected) {
+		t.Fatalf("expected %#v to convert to %v, got %v", value, expected, converted)
+	}
+	back, err := converter.FromTLA(converted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back, value) {
+		t.Fatalf("expected %v to convert back to %#v, got %#v", converted, value, back)
+	}
+}
+
+func TestReflectTLAConverter(t *testing.T) {
+	t.Run("scalars", func(t *testing.T) {
+		typedChannelTestRoundTrip(t, true, tla.TLA_TRUE)
+		typedChannelTestRoundTrip(t, int8(-3), tla.MakeTLANumber(-3))
+		typedChannelTestRoundTrip(t, int64(math.MaxInt64), tla.MakeTLABigNumber(big.NewInt(math.MaxInt64)))
+		typedChannelTestRoundTrip(t, uint64(math.MaxUint64), tla.MakeTLABigNumber(new(big.Int).SetUint64(math.MaxUint64)))
+		typedChannelTestRoundTrip(t, "hi", tla.MakeTLAString("hi"))
+		typedChannelTestRoundTrip(t, tla.MakeTLASet(tla.MakeTLANumber(1)), tla.MakeTLASet(tla.MakeTLANumber(1)))
+	})
+	t.Run("struct", func(t *testing.T) {
+		value := typedChannelTestStruct{
+			Name:  "a",
+			Count: 2,
+			Tags:  []string{"x", "y"},
+			Inner: &typedChannelTestInner{Flag: true},
+		}
+		typedChannelTestRoundTrip(t, value, tla.MakeTLARecord([]tla.TLARecordField{
+			{Key: tla.MakeTLAString("name"), Value: tla.MakeTLAString("a")},
+			{Key: tla.MakeTLAString("Count"), Value: tla.MakeTLANumber(2)},
+			{Key: tla.MakeTLAString("Tags"), Value: tla.MakeTLATuple(tla.MakeTLAString("x"), tla.MakeTLAString("y"))},
+			{Key: tla.MakeTLAString("Inner"), Value: tla.MakeTLARecord([]tla.TLARecordField{
+				{Key: tla.MakeTLAString("Flag"), Value: tla.TLA_TRUE},
+			})},
+		}))
+	})
+	t.Run("slices and arrays", func(t *testing.T) {
+		typedChannelTestRoundTrip(t, []int{1, 2}, tla.MakeTLATuple(tla.MakeTLANumber(1), tla.MakeTLANumber(2)))
+		typedChannelTestRoundTrip(t, [2]bool{true, false}, tla.MakeTLATuple(tla.TLA_TRUE, tla.TLA_FALSE))
+		typedChannelTestRoundTrip(t, []byte{0, 255}, tla.MakeTLABytes([]byte{0, 255}))
+		typedChannelTestRoundTrip(t, [][]int{{1}, {}}, tla.MakeTLATuple(tla.MakeTLATuple(tla.MakeTLANumber(1)), tla.MakeTLATuple()))
+	})
+	t.Run("maps", func(t *testing.T) {
+		typedChannelTestRoundTrip(t, map[int]string{1: "one", 2: "two"}, tla.MakeTLARecord([]tla.TLARecordField{
+			{Key: tla.MakeTLANumber(1), Value: tla.MakeTLAString("one")},
+			{Key: tla.MakeTLANumber(2), Value: tla.MakeTLAString("two")},
+		}))
+		typedChannelTestRoundTrip(t, map[string]int{}, tla.MakeTLARecord(nil))
+	})
+}
+
+func TestReflectTLAConverterErrors(t *testing.T) {
+	toTLA := []struct {
+		name string
+		fn   func() error
+	}{
+		{"float", func() error {
+			_, err := ReflectTLAConverter[float64]().ToTLA(1.5)
+			return err
+		}},
+		{"channel", func() error {
+			_, err := ReflectTLAConverter[chan int]().ToTLA(make(chan int))
+			return err
+		}},
+		{"func in a struct", func() error {
+			_, err := ReflectTLAConverter[struct{ F func() }]().ToTLA(struct{ F func() }{})
+			return err
+		}},
+		{"nil pointer", func() error {
+			_, err := ReflectTLAConverter[*int]().ToTLA(nil)
+			return err
+		}},
+		{"interface", func() error {
+			_, err := ReflectTLAConverter[interface{}]().ToTLA(1)
+			return err
+		}},
+		{"map with unsupported keys", func() error {
+			_, err := ReflectTLAConverter[map[float32]int]().ToTLA(map[float32]int{1: 1})
+			return err
+		}},
+	}
+	for _, test := range toTLA {
+		t.Run("to TLA+ "+test.name, func(t *testing.T) {
+			if err := test.fn(); err == nil {
+				t.Fatalf("expected converting a %s to fail", test.name)
+			}
+		})
+	}
+
+	fromTLA := []struct {
+		name string
+		fn   func() error
+	}{
+		{"float", func() error {
+			_, err := ReflectTLAConverter[float64]().FromTLA(tla.MakeTLANumber(1))
+			return err
+		}},
+		{"overflowing int8", func() error {
+			_, err := ReflectTLAConverter[int8]().FromTLA(tla.MakeTLANumber(128))
+			return err
+		}},
+		{"negative uint", func() error {
+			_, err := ReflectTLAConverter[uint]().FromTLA(tla.MakeTLANumber(-1))
+			return err
+		}},
+		{"string from number", func() error {
+			_, err := ReflectTLAConverter[string]().FromTLA(tla.MakeTLANumber(1))
+			return err
+		}},
+		{"array of the wrong length", func() error {
+			_, err := ReflectTLAConverter[[2]int]().FromTLA(tla.MakeTLATuple(tla.MakeTLANumber(1)))
+			return err
+		}},
+		{"bytes out of range", func() error {
+			_, err := ReflectTLAConverter[[]byte]().FromTLA(tla.MakeTLATuple(tla.MakeTLANumber(256)))
+			return err
+		}},
+		{"struct missing a field", func() error {
+			_, err := ReflectTLAConverter[typedChannelTestInner]().FromTLA(tla.MakeTLARecord([]tla.TLARecordField{
+				{Key: tla.MakeTLAString("Other"), Value: tla.TLA_TRUE},
+			}))
+			return err
+		}},
+		{"struct from set", func() error {
+			_, err := ReflectTLAConverter[typedChannelTestInner]().FromTLA(tla.MakeTLASet())
+			return err
+		}},
+		{"map with mismatched values", func() error {
+			_, err := ReflectTLAConverter[map[string]int]().FromTLA(tla.MakeTLARecord([]tla.TLARecordField{
+				{Key: tla.MakeTLAString("a"), Value: tla.MakeTLAString("b")},
+			}))
+			return err
+		}},
+		{"interface", func() error {
+			_, err := ReflectTLAConverter[interface{}]().FromTLA(tla.MakeTLANumber(1))
+			return err
+		}},
+		{"channel", func() error {
+			_, err := ReflectTLAConverter[chan int]().FromTLA(tla.MakeTLANumber(1))
+			return err
+		}},
+	}
+	for _, test := range fromTLA {
+		t.Run("from TLA+ "+test.name, func(t *testing.T) {
+			if err := test.fn(); err == nil {
+				t.Fatalf("expected converting to a %s to fail", test.name)
+			}
+		})
+	}
+}
+
+func TestTypedChannels(t *testing.T) {
+	in := make(chan typedChannelTestInner, 1)
+	inMaker := TypedInputChannelMaker(in, ReflectTLAConverter[typedChannelTestInner]())
+	inRes := inMaker.Make()
+	inMaker.Configure(inRes)
+	out := make(chan typedChannelTestInner, 1)
+	outMaker := TypedOutputChannelMaker(out, ReflectTLAConverter[typedChannelTestInner]())
+	outRes := outMaker.Make()
+	outMaker.Configure(outRes)
+
+	in <- typedChannelTestInner{Flag: true}
+	value, err := inRes.ReadValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// values given back by an aborted critical section are read again
+	inRes.Abort()
+	if again, err := inRes.ReadValue(); err != nil || !again.Equal(value) {
+		t.Fatalf("expected to read %v again after an abort, got %v, %v", value, again, err)
+	}
+	inRes.Commit()
+
+	if err := outRes.WriteValue(value); err != nil {
+		t.Fatal(err)
+	}
+	<-outRes.Commit()
+	if received := <-out; !received.Flag {
+		t.Fatalf("expected the value read to be written back unchanged, got %+v", received)
+	}
+	if err := outRes.WriteValue(tla.MakeTLAString("not a record")); err == nil {
+		t.Fatalf("expected writing a value that cannot be converted to fail")
+	}
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ErrVersionedWriteMalformed is returned when a value written to a versioned key-value resource is not a record
+// with value and version fields.
+var ErrVersionedWriteMalformed = errors.New("versioned write must be a record [value |-> ..., version |-> ...]")
+
+var (
+	versionedValueField   = tla.MakeTLAString("value")
+	versionedVersionField = tla.MakeTLAString("version")
+)
+
+// RaftVersionedKVMaker produces a distsys.ArchetypeResourceMaker for a map of versioned values, replicated by the
+// Raft group node belongs to, with explicit compare-and-swap semantics. Each value starts as initial, at version 0.
+//
+// Reading a key yields a record [value |-> v, version |-> n]. Writing a key takes a record of the same shape,
+// where n is the version the write expects to replace: at pre-commit, if the key's version is not n anymore, the
+// critical section aborts. Otherwise, the value is set at commit, and its version becomes n+1. Unlike with
+// RaftSharedMapMaker, a key that was only read is not checked at pre-commit, so a model controls which of its
+// accesses are checked for conflicts. A read may still abort, like any read of a shared value, if the key is locked
+// by another critical section that is committing; retrying it waits for that commit to finish. Both makers share
+// their values, given the same node and name.
+func RaftVersionedKVMaker(node *RaftNode, name string, initial tla.TLAValue) distsys.ArchetypeResourceMaker {
+	return IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+			return &raftVersionedValue{
+				raftSharedValue: raftSharedValue{
+					node:    node,
+					key:     tla.MakeTLATuple(tla.MakeTLAString(name), index),
+					initial: initial,
+				},
+			}
+		})
+	})
+}
+
+// raftVersionedValue is a raftSharedValue whose version is visible to, and checked against, the model's writes.
+type raftVersionedValue struct {
+	raftSharedValue
+	expectVersion int64
+}
+
+var _ distsys.ArchetypeResource = &raftVersionedValue{}
+
+func (res *raftVersionedValue) PreCommit() chan error {
+	if !res.writePending {
+		return nil
+	}
+	return res.prepare(res.expectVersion)
+}
+
+func (res *raftVersionedValue) ReadValue() (tla.TLAValue, error) {
+	version := res.readVersion
+	switch {
+	case res.writePending:
+		version = res.expectVersion + 1
+	case !res.hasRead:
+		_, err := res.fetch()
+		if err != nil {
+			return tla.TLAValue{}, err
+		}
+		version = res.readVersion
+	}
+	return tla.MakeTLARecord([]tla.TLARecordField{
+		{Key: versionedValueField, Value: res.value},
+		{Key: versionedVersionField, Value: tla.MakeTLABigNumber(big.NewInt(version))},
+	}), nil
+}
+
+func (res *raftVersionedValue) WriteValue(value tla.TLAValue) error {
+	if !value.IsFunction() {
+		return fmt.Errorf("%w: %v", ErrVersionedWriteMalformed, value)
+	}
+	fields := value.AsFunction()
+	newValue, hasValue := fields.Get(versionedValueField)
+	version, hasVersion := fields.Get(versionedVersionField)
+	if !hasValue || !hasVersion {
+		return fmt.Errorf("%w: %v", ErrVersionedWriteMalformed, value)
+	}
+	expectVersion, err := version.(tla.TLAValue).TryAsBigNumber()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrVersionedWriteMalformed, err)
+	}
+	if !expectVersion.IsInt64() || expectVersion.Sign() < 0 {
+		return fmt.Errorf("%w: version %v out of range", ErrVersionedWriteMalformed, expectVersion)
+	}
+	res.value = newValue.(tla.TLAValue)
+	res.expectVersion = expectVersion.Int64()
+	res.writePending = true
+	return nil
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func versionedKVTestRecord(value tla.TLAValue, version tla.TLAValue) tla.TLAValue {
+	return tla.MakeTLARecord([]tla.TLARecordField{
+		{Key: versionedValueField, Value: value},
+		{Key: versionedVersionField, Value: version},
+	})
+}
+
+func versionedKVTestIndex(t *testing.T, res distsys.ArchetypeResource) distsys.ArchetypeResource {
+	t.Helper()
+	entry, err := res.Index(tla.MakeTLAString("x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entry
+}
+
+func TestRaftVersionedKVCompareAndSwap(t *testing.T) {
+	nodes, _ := startRaftTestGroup(t, 3, noRaftTestOptions)
+	awaitRaftLeader(t, nodes, 0)
+	var kvs []distsys.ArchetypeResource
+	for _, node := range nodes[:2] {
+		maker := RaftVersionedKVMaker(node, "kv", tla.MakeTLANumber(0))
+		res := maker.Make()
+		maker.Configure(res)
+		kvs = append(kvs, res)
+	}
+	// the entries are indexed anew in each critical section, as generated code does
+	a := func() distsys.ArchetypeResource { return versionedKVTestIndex(t, kvs[0]) }
+	b := func() distsys.ArchetypeResource { return versionedKVTestIndex(t, kvs[1]) }
+
+	initial := versionedKVTestRecord(tla.MakeTLANumber(0), tla.MakeTLANumber(0))
+	if value := raftCounterTestRead(t, a()); !value.Equal(initial) {
+		t.Fatalf("expected to read %v, read %v", initial, value)
+	}
+	// b swaps in a new value, based on version 0
+	if err := b().WriteValue(versionedKVTestRecord(tla.MakeTLANumber(10), tla.MakeTLANumber(0))); err != nil {
+		t.Fatal(err)
+	}
+	if value := raftCounterTestRead(t, b()); !value.Equal(versionedKVTestRecord(tla.MakeTLANumber(10), tla.MakeTLANumber(1))) {
+		t.Fatalf("expected to read the pending write at version 1, read %v", value)
+	}
+	raftCounterTestCommit(t, kvs[1])
+
+	// a only read the old version, so its critical section is not checked, and commits
+	raftCounterTestCommit(t, kvs[0])
+
+	// but a write based on the old version aborts at pre-commit, without changing the value
+	if err := a().WriteValue(versionedKVTestRecord(tla.MakeTLANumber(20), tla.MakeTLANumber(0))); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-kvs[0].PreCommit(); !errors.Is(err, distsys.ErrCriticalSectionAborted) {
+		t.Fatalf("expected a write expecting a replaced version to abort, got %v", err)
+	}
+	if ch := kvs[0].Abort(); ch != nil {
+		<-ch
+	}
+	current := versionedKVTestRecord(tla.MakeTLANumber(10), tla.MakeTLANumber(1))
+	if value := raftCounterTestRead(t, a()); !value.Equal(current) {
+		t.Fatalf("expected to read %v, read %v", current, value)
+	}
+	if err := a().WriteValue(versionedKVTestRecord(tla.MakeTLANumber(20), tla.MakeTLANumber(1))); err != nil {
+		t.Fatal(err)
+	}
+	raftCounterTestCommit(t, kvs[0])
+	if value := raftCounterTestRead(t, b()); !value.Equal(versionedKVTestRecord(tla.MakeTLANumber(20), tla.MakeTLANumber(2))) {
+		t.Fatalf("expected the write based on the current version to commit, read %v", value)
+	}
+}
+
+func TestRaftVersionedKVLargeVersions(t *testing.T) {
+	// versions are int64 in the log, and must not be truncated on their way to and from the model
+	version := int64(1) << 40
+	res := &raftVersionedValue{raftSharedValue: raftSharedValue{hasRead: true, readVersion: version, value: tla.MakeTLANumber(1)}}
+	bigVersion := tla.MakeTLABigNumber(big.NewInt(version))
+	value, err := res.ReadValue()
+	if err != nil || !value.Equal(versionedKVTestRecord(tla.MakeTLANumber(1), bigVersion)) {
+		t.Fatalf("expected to read version %v, read %v, %v", bigVersion, value, err)
+	}
+	if err := res.WriteValue(versionedKVTestRecord(tla.MakeTLANumber(2), bigVersion)); err != nil {
+		t.Fatal(err)
+	}
+	if res.expectVersion != version {
+		t.Fatalf("expected the write to expect version %d, got %d", version, res.expectVersion)
+	}
+	if err := res.WriteValue(versionedKVTestRecord(tla.MakeTLANumber(2), tla.MakeTLANumber(-1))); !errors.Is(err, ErrVersionedWriteMalformed) {
+		t.Fatalf("expected a negative version to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,324 @@
+package resources
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	webSocketHandshakeTimeout = 1 * time.Second
+	webSocketMaxFrameSize     = 16 << 20
+	webSocketAcceptGUID       = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+	webSocketOpContinuation = 0x0
+	webSocketOpText         = 0x1
+	webSocketOpBinary       = 0x2
+	webSocketOpClose        = 0x8
+	webSocketOpPing         = 0x9
+	webSocketOpPong         = 0xa
+)
+
+// WebSocketMailboxTransport carries mailbox connections over WebSockets (RFC 6455), so that archetypes compiled with
+// GOOS=js and running in a browser can exchange messages with server-side archetypes. Browsers cannot listen for
+// connections, so browser peers may only dial; server-side peers listen and dial as usual.
+//
+// Addresses are either host:port, in which case the URL ws://host:port/Path is used, or full ws:// or wss:// URLs.
+// Listening with TLS (wss://) is not supported directly; terminate TLS in front of the listener instead.
+type WebSocketMailboxTransport struct {
+	Path string // the HTTP path WebSocket connections are made to; "/" if empty
+}
+
+var _ MailboxTransport = WebSocketMailboxTransport{}
+
+func (transport WebSocketMailboxTransport) path() string {
+	if transport.Path == "" {
+		return "/"
+	}
+	return transport.Path
+}
+
+// url builds the WebSocket URL to dial for a mailbox address.
+func (transport WebSocketMailboxTransport) url(addr string) string {
+	if strings.HasPrefix(addr, "ws://") || strings.HasPrefix(addr, "wss://") {
+		return addr
+	}
+	return "ws://" + addr + transport.path()
+}
+
+// Listen accepts WebSocket connections on addr. Handshakes are performed by an HTTP server in the background, so a
+// slow or misbehaving client cannot hold up other connections.
+func (transport WebSocketMailboxTransport) Listen(addr string) (net.Listener, error) {
+	path := transport.path()
+	if strings.HasPrefix(addr, "ws://") {
+		u, err := url.Parse(addr)
+		if err != nil {
+			return nil, err
+		}
+		addr = u.Host
+		if u.Path != "" {
+			path = u.Path
+		}
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	wsListener := &webSocketListener{
+		Listener: listener,
+		acceptor: newWebSocketAcceptor(path),
+	}
+	wsListener.server = &http.Server{
+		Handler:           wsListener.acceptor,
+		ReadHeaderTimeout: webSocketHandshakeTimeout,
+	}
+	go func() {
+		_ = wsListener.server.Serve(listener)
+		wsListener.acceptor.close()
+	}()
+	return wsListener, nil
+}
+
+type webSocketListener struct {
+	net.Listener
+	acceptor *webSocketAcceptor
+	server   *http.Server
+}
+
+func (listener *webSocketListener) Accept() (net.Conn, error) {
+	return listener.acceptor.accept()
+}
+
+func (listener *webSocketListener) Close() error {
+	listener.acceptor.close()
+	// connections that have been upgraded are hijacked, and so are not closed by the server
+	return listener.server.Close()
+}
+
+// webSocketAcceptor is an http.Handler that performs the server side of the opening handshake for requests to path,
+// and queues the resulting connections for accept.
+type webSocketAcceptor struct {
+	path string
+
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ http.Handler = &webSocketAcceptor{}
+
+func newWebSocketAcceptor(path string) *webSocketAcceptor {
+	return &webSocketAcceptor{
+		path:   path,
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+func (acceptor *webSocketAcceptor) accept() (net.Conn, error) {
+	select {
+	case conn := <-acceptor.conns:
+		return conn, nil
+	case <-acceptor.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (acceptor *webSocketAcceptor) close() {
+	acceptor.closeOnce.Do(func() {
+		close(acceptor.closed)
+	})
+}
+
+func webSocketAcceptKey(key string) string {
+	hash := sha1.Sum([]byte(key + webSocketAcceptGUID))
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+func headerContainsToken(header http.Header, name, token string) bool {
+	for _, value := range header[http.CanonicalHeaderKey(name)] {
+		for _, part := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(part), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (acceptor *webSocketAcceptor) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	key := req.Header.Get("Sec-WebSocket-Key")
+	if req.Method != http.MethodGet || req.URL.Path != acceptor.path || key == "" ||
+		!headerContainsToken(req.Header, "Upgrade", "websocket") ||
+		!headerContainsToken(req.Header, "Connection", "upgrade") {
+		http.Error(w, fmt.Sprintf("invalid WebSocket handshake for %s %s", req.Method, req.URL), http.StatusBadRequest)
+		return
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "WebSocket connections are not supported by this server", http.StatusInternalServerError)
+		return
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, err = fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n",
+		webSocketAcceptKey(key))
+	if err == nil {
+		err = rw.Flush()
+	}
+	if err == nil {
+		// the server may have set deadlines for reading the request
+		err = conn.SetDeadline(time.Time{})
+	}
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	wsConn := &webSocketConn{Conn: conn, reader: rw.Reader}
+	select {
+	case acceptor.conns <- wsConn:
+	case <-acceptor.closed:
+		_ = wsConn.Close()
+	}
+}
+
+// webSocketConn presents the payloads of the binary messages exchanged over a WebSocket as a byte stream. Message
+// boundaries carry no meaning: each Write is sent as one message, and messages are read back-to-back.
+// Deadlines are those of the underlying connection.
+type webSocketConn struct {
+	net.Conn
+	reader *bufio.Reader
+	client bool // clients must mask the frames they send
+
+	writeLock sync.Mutex
+	pending   []byte // unread payload of the most recent data frame
+}
+
+func (conn *webSocketConn) Read(data []byte) (int, error) {
+	for len(conn.pending) == 0 {
+		err := conn.readFrame()
+		if err != nil {
+			return 0, err
+		}
+	}
+	n := copy(data, conn.pending)
+	conn.pending = conn.pending[n:]
+	return n, nil
+}
+
+func (conn *webSocketConn) readFrame() error {
+	var header [2]byte
+	_, err := io.ReadFull(conn.reader, header[:])
+	if err != nil {
+		return err
+	}
+	opcode := header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		_, err = io.ReadFull(conn.reader, ext[:])
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		_, err = io.ReadFull(conn.reader, ext[:])
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if err != nil {
+		return err
+	}
+	if length > webSocketMaxFrameSize {
+		return fmt.Errorf("WebSocket frame of %d bytes exceeds limit of %d bytes", length, webSocketMaxFrameSize)
+	}
+	var mask [4]byte
+	if masked {
+		_, err = io.ReadFull(conn.reader, mask[:])
+		if err != nil {
+			return err
+		}
+	}
+	payload := make([]byte, length)
+	_, err = io.ReadFull(conn.reader, payload)
+	if err != nil {
+		return err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+
+	switch opcode {
+	case webSocketOpContinuation, webSocketOpText, webSocketOpBinary:
+		conn.pending = payload
+		return nil
+	case webSocketOpClose:
+		_ = conn.writeFrame(webSocketOpClose, nil)
+		return io.EOF
+	case webSocketOpPing:
+		return conn.writeFrame(webSocketOpPong, payload)
+	case webSocketOpPong:
+		return nil
+	default:
+		return fmt.Errorf("unsupported WebSocket opcode %#x", opcode)
+	}
+}
+
+func (conn *webSocketConn) Write(data []byte) (int, error) {
+	err := conn.writeFrame(webSocketOpBinary, data)
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func (conn *webSocketConn) writeFrame(opcode byte, payload []byte) error {
+	conn.writeLock.Lock()
+	defer conn.writeLock.Unlock()
+
+	frame := make([]byte, 0, 14+len(payload))
+	frame = append(frame, 0x80|opcode)
+	var maskBit byte
+	if conn.client {
+		maskBit = 0x80
+	}
+	switch {
+	case len(payload) < 126:
+		frame = append(frame, maskBit|byte(len(payload)))
+	case len(payload) <= 0xffff:
+		frame = append(frame, maskBit|126, 0, 0)
+		binary.BigEndian.PutUint16(frame[2:], uint16(len(payload)))
+	default:
+		frame = append(frame, maskBit|127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(frame[2:], uint64(len(payload)))
+	}
+	if conn.client {
+		var mask [4]byte
+		_, err := rand.Read(mask[:])
+		if err != nil {
+			return err
+		}
+		frame = append(frame, mask[:]...)
+		for i, b := range payload {
+			frame = append(frame, b^mask[i%4])
+		}
+	} else {
+		frame = append(frame, payload...)
+	}
+	_, err := conn.Conn.Write(frame)
+	return err
+}
@@ -0,0 +1,94 @@
+//go:build !js
+// +build !js
+
+package resources
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+var errWebSocketHandshake = errors.New("WebSocket handshake rejected")
+
+// Dial opens a WebSocket connection to the mailbox at addr.
+func (transport WebSocketMailboxTransport) Dial(addr string, timeout time.Duration) (net.Conn, error) {
+	u, err := url.Parse(transport.url(addr))
+	if err != nil {
+		return nil, err
+	}
+	host := u.Host
+	if u.Port() == "" {
+		if u.Scheme == "wss" {
+			host = net.JoinHostPort(u.Hostname(), "443")
+		} else {
+			host = net.JoinHostPort(u.Hostname(), "80")
+		}
+	}
+	conn, err := net.DialTimeout("tcp", host, timeout)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "wss" {
+		conn = tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+	}
+	wsConn, err := dialWebSocket(conn, u, timeout)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return wsConn, nil
+}
+
+// dialWebSocket performs the client side of the opening handshake on conn.
+func dialWebSocket(conn net.Conn, u *url.URL, timeout time.Duration) (net.Conn, error) {
+	err := conn.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return nil, err
+	}
+	var nonce [16]byte
+	_, err = rand.Read(nonce[:])
+	if err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce[:])
+	req := &http.Request{
+		Method:     http.MethodGet,
+		URL:        u,
+		Host:       u.Host,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Upgrade":               {"websocket"},
+			"Connection":            {"Upgrade"},
+			"Sec-WebSocket-Key":     {key},
+			"Sec-WebSocket-Version": {"13"},
+		},
+	}
+	err = req.Write(conn)
+	if err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols ||
+		resp.Header.Get("Sec-WebSocket-Accept") != webSocketAcceptKey(key) {
+		return nil, fmt.Errorf("%w by %s: %s", errWebSocketHandshake, u, resp.Status)
+	}
+	err = conn.SetDeadline(time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	return &webSocketConn{Conn: conn, reader: reader, client: true}, nil
+}
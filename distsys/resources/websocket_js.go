@@ -0,0 +1,146 @@
+//go:build js && wasm
+// +build js,wasm
+
+package resources
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"syscall/js"
+	"time"
+)
+
+// Dial opens a WebSocket connection to the mailbox at addr using the browser's WebSocket API. The browser handles
+// framing, so the connection is bridged through a net.Pipe, which also provides the deadlines mailboxes rely on.
+func (transport WebSocketMailboxTransport) Dial(addr string, timeout time.Duration) (net.Conn, error) {
+	url := transport.url(addr)
+	ws := js.Global().Get("WebSocket").New(url)
+	ws.Set("binaryType", "arraybuffer")
+
+	queue := newWebSocketJSQueue()
+	opened := make(chan struct{})
+	var openOnce sync.Once
+	onOpen := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		openOnce.Do(func() { close(opened) })
+		return nil
+	})
+	onMessage := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		array := js.Global().Get("Uint8Array").New(args[0].Get("data"))
+		data := make([]byte, array.Get("length").Int())
+		js.CopyBytesToGo(data, array)
+		queue.push(data)
+		return nil
+	})
+	onClose := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		queue.close()
+		return nil
+	})
+	ws.Set("onopen", onOpen)
+	ws.Set("onmessage", onMessage)
+	ws.Set("onclose", onClose)
+	ws.Set("onerror", onClose)
+	release := func() {
+		ws.Set("onopen", js.Null())
+		ws.Set("onmessage", js.Null())
+		ws.Set("onclose", js.Null())
+		ws.Set("onerror", js.Null())
+		onOpen.Release()
+		onMessage.Release()
+		onClose.Release()
+	}
+
+	select {
+	case <-opened:
+	case <-queue.closed:
+		release()
+		return nil, fmt.Errorf("could not open WebSocket to %s", url)
+	case <-time.After(timeout):
+		ws.Call("close")
+		release()
+		return nil, fmt.Errorf("timed out opening WebSocket to %s", url)
+	}
+
+	local, remote := net.Pipe()
+	// incoming messages: browser -> pipe
+	go func() {
+		defer func() {
+			_ = remote.Close()
+			release()
+		}()
+		for {
+			data, ok := queue.pop()
+			if !ok {
+				return
+			}
+			_, err := remote.Write(data)
+			if err != nil {
+				ws.Call("close")
+				return
+			}
+		}
+	}()
+	// outgoing writes: pipe -> browser
+	go func() {
+		buf := make([]byte, 4096)
+		for {
+			n, err := remote.Read(buf)
+			if err != nil {
+				ws.Call("close")
+				return
+			}
+			array := js.Global().Get("Uint8Array").New(n)
+			js.CopyBytesToJS(array, buf[:n])
+			ws.Call("send", array)
+		}
+	}()
+	return local, nil
+}
+
+// webSocketJSQueue buffers incoming messages without blocking, as blocking inside a JavaScript callback would
+// deadlock the Go runtime.
+type webSocketJSQueue struct {
+	lock     sync.Mutex
+	cond     *sync.Cond
+	messages [][]byte
+	closed   chan struct{}
+	isClosed bool
+}
+
+func newWebSocketJSQueue() *webSocketJSQueue {
+	queue := &webSocketJSQueue{closed: make(chan struct{})}
+	queue.cond = sync.NewCond(&queue.lock)
+	return queue
+}
+
+func (queue *webSocketJSQueue) push(data []byte) {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+	queue.messages = append(queue.messages, data)
+	queue.cond.Signal()
+}
+
+func (queue *webSocketJSQueue) close() {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+	if !queue.isClosed {
+		queue.isClosed = true
+		close(queue.closed)
+		queue.cond.Broadcast()
+	}
+}
+
+// pop returns the next message, blocking until one arrives. Once the queue is closed and drained, it returns false.
+func (queue *webSocketJSQueue) pop() ([]byte, bool) {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+	for len(queue.messages) == 0 && !queue.isClosed {
+		queue.cond.Wait()
+	}
+	if len(queue.messages) == 0 {
+		return nil, false
+	}
+	data := queue.messages[0]
+	queue.messages = queue.messages[1:]
+	return data, true
+}
@@ -0,0 +1,192 @@
+//go:build !js
+// +build !js
+
+package resources
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const webSocketTestTimeout = 5 * time.Second
+
+// dialWebSocketTest opens a WebSocket through transport to an httptest.Server serving path, and returns both ends.
+func dialWebSocketTest(t *testing.T, transport WebSocketMailboxTransport, path string) (client, server net.Conn) {
+	t.Helper()
+	acceptor := newWebSocketAcceptor(path)
+	httpServer := httptest.NewServer(acceptor)
+	t.Cleanup(func() {
+		acceptor.close()
+		httpServer.Close()
+	})
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := acceptor.accept()
+		if err == nil {
+			accepted <- conn
+		}
+	}()
+	client, err := transport.Dial(httpServer.Listener.Addr().String(), webSocketTestTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	select {
+	case server = <-accepted:
+	case <-time.After(webSocketTestTimeout):
+		t.Fatal("timed out waiting for the server side of the connection")
+	}
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+	return client, server
+}
+
+// writeRawWebSocketFrame writes a single frame to conn as a client would, masked, with the given FIN bit and opcode.
+func writeRawWebSocketFrame(t *testing.T, conn net.Conn, fin bool, opcode byte, payload []byte) {
+	t.Helper()
+	if len(payload) >= 126 {
+		t.Fatal("writeRawWebSocketFrame only supports short payloads")
+	}
+	first := opcode
+	if fin {
+		first |= 0x80
+	}
+	mask := [4]byte{0x12, 0x34, 0x56, 0x78}
+	frame := []byte{first, 0x80 | byte(len(payload))}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	if _, err := conn.Write(frame); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWebSocketMailboxTransport(t *testing.T) {
+	transport := WebSocketMailboxTransport{Path: "/mailbox"}
+
+	t.Run("round trip", func(t *testing.T) {
+		client, server := dialWebSocketTest(t, transport, "/mailbox")
+		// cover each of the three payload length encodings, in both directions
+		for _, size := range []int{5, 300, 70000} {
+			data := bytes.Repeat([]byte{byte(size)}, size)
+			for _, pair := range [][2]net.Conn{{client, server}, {server, client}} {
+				from, to := pair[0], pair[1]
+				go func() {
+					_, _ = from.Write(data)
+				}()
+				received := make([]byte, size)
+				if err := to.SetReadDeadline(time.Now().Add(webSocketTestTimeout)); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := io.ReadFull(to, received); err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(received, data) {
+					t.Fatalf("sent %d bytes, but received different bytes", size)
+				}
+			}
+		}
+	})
+
+	t.Run("fragmentation and control frames", func(t *testing.T) {
+		client, server := dialWebSocketTest(t, transport, "/mailbox")
+		rawClient := client.(*webSocketConn).Conn
+		writeRawWebSocketFrame(t, rawClient, false, webSocketOpBinary, []byte("hello, "))
+		writeRawWebSocketFrame(t, rawClient, true, webSocketOpPing, []byte("ping"))
+		writeRawWebSocketFrame(t, rawClient, true, webSocketOpContinuation, []byte("world"))
+		writeRawWebSocketFrame(t, rawClient, true, webSocketOpClose, nil)
+
+		if err := server.SetReadDeadline(time.Now().Add(webSocketTestTimeout)); err != nil {
+			t.Fatal(err)
+		}
+		received, err := io.ReadAll(server)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(received) != "hello, world" {
+			t.Fatalf("expected the fragments to be joined as %q, got %q", "hello, world", received)
+		}
+
+		// the server must have answered the ping, then the close; unmasked, as servers must not mask
+		expected := append([]byte{0x80 | webSocketOpPong, 4}, "ping"...)
+		expected = append(expected, 0x80|webSocketOpClose, 0)
+		reply := make([]byte, len(expected))
+		if err := rawClient.SetReadDeadline(time.Now().Add(webSocketTestTimeout)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.ReadFull(client.(*webSocketConn).reader, reply); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(reply, expected) {
+			t.Fatalf("expected the server to reply %x, got %x", expected, reply)
+		}
+	})
+
+	t.Run("wrong path", func(t *testing.T) {
+		acceptor := newWebSocketAcceptor("/mailbox")
+		httpServer := httptest.NewServer(acceptor)
+		defer httpServer.Close()
+		_, err := WebSocketMailboxTransport{Path: "/elsewhere"}.Dial(httpServer.Listener.Addr().String(), webSocketTestTimeout)
+		if !errors.Is(err, errWebSocketHandshake) || !strings.Contains(err.Error(), "400") {
+			t.Fatalf("expected the handshake to be rejected with 400 Bad Request, got %v", err)
+		}
+	})
+
+	t.Run("listen", func(t *testing.T) {
+		listener, err := transport.Listen("127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		accepted := make(chan net.Conn, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err == nil {
+				accepted <- conn
+			}
+		}()
+		client, err := transport.Dial(listener.Addr().String(), webSocketTestTimeout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+		var server net.Conn
+		select {
+		case server = <-accepted:
+		case <-time.After(webSocketTestTimeout):
+			t.Fatal("timed out waiting for Accept")
+		}
+		defer server.Close()
+		if _, err := client.Write([]byte("ping")); err != nil {
+			t.Fatal(err)
+		}
+		received := make([]byte, 4)
+		if _, err := io.ReadFull(server, received); err != nil || string(received) != "ping" {
+			t.Fatalf("received %q, %v", received, err)
+		}
+
+		if err := listener.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := listener.Accept(); !errors.Is(err, net.ErrClosed) {
+			t.Fatalf("expected Accept on a closed listener to fail with net.ErrClosed, got %v", err)
+		}
+		// connections already accepted survive the listener
+		if _, err := server.Write([]byte("pong")); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.ReadFull(client, received); err != nil || string(received) != "pong" {
+			t.Fatalf("received %q, %v", received, err)
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package distsys
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const (
+	selfTestDialTimeout  = 1 * time.Second
+	selfTestClockSleep   = 10 * time.Millisecond
+	selfTestMaxClockSlop = 1 * time.Second
+)
+
+// SelfTestCheck is the outcome of one check performed by SelfTest.
+type SelfTestCheck struct {
+	Name     string        `json:"name"`
+	Passed   bool          `json:"passed"`
+	Detail   string        `json:"detail,omitempty"` // on failure, describes what went wrong
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// SelfTestReport is the machine-readable result of SelfTest. It is tagged for encoding/json.
+type SelfTestReport struct {
+	Passed bool            `json:"passed"` // true iff every check passed
+	Checks []SelfTestCheck `json:"checks"`
+}
+
+type selfTestConfig struct {
+	stateDir    string
+	backends    []string
+	dialTimeout time.Duration
+	roundTrip   func(values ...tla.TLAValue) error
+}
+
+// SelfTestOption configures which environment-dependent checks SelfTest performs.
+type SelfTestOption func(cfg *selfTestConfig)
+
+// WithSelfTestStateDir makes SelfTest check that dir is writable, and that written data can be fsync-ed.
+func WithSelfTestStateDir(dir string) SelfTestOption {
+	return func(cfg *selfTestConfig) {
+		cfg.stateDir = dir
+	}
+}
+
+// WithSelfTestBackends makes SelfTest check that each of addrs accepts TCP connections.
+func WithSelfTestBackends(addrs ...string) SelfTestOption {
+	return func(cfg *selfTestConfig) {
+		cfg.backends = append(cfg.backends, addrs...)
+	}
+}
+
+// WithSelfTestCodec makes SelfTest check values' round-trips using roundTrip, which should pass the values through
+// the wire encoding that the node is configured with, and return an error unless each decodes equal to itself.
+// Without this option, the values are checked using encoding/gob, which is the default mailbox codec.
+// See resources.WithSelfTestMailboxCodec.
+func WithSelfTestCodec(roundTrip func(values ...tla.TLAValue) error) SelfTestOption {
+	return func(cfg *selfTestConfig) {
+		cfg.roundTrip = roundTrip
+	}
+}
+
+// WithSelfTestDialTimeout sets how long SelfTest waits when connecting to each backend.
+func WithSelfTestDialTimeout(timeout time.Duration) SelfTestOption {
+	return func(cfg *selfTestConfig) {
+		cfg.dialTimeout = timeout
+	}
+}
+
+// SelfTest checks that the runtime environment is fit for running archetypes, and reports the outcome of each
+// check. It always checks that TLA+ values survive an encode/decode round-trip, and that the clock behaves sanely.
+// Disk and backend connectivity checks are performed if configured via opts.
+//
+// It is intended to be run at node startup, before joining a cluster, so that a misconfigured node fails early
+// and visibly rather than misbehaving while running.
+func SelfTest(opts ...SelfTestOption) SelfTestReport {
+	cfg := &selfTestConfig{
+		dialTimeout: selfTestDialTimeout,
+		roundTrip:   selfTestGobRoundTrip,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	report := SelfTestReport{Passed: true}
+	runCheck := func(name string, check func() error) {
+		start := time.Now()
+		err := check()
+		result := SelfTestCheck{
+			Name:     name,
+			Passed:   err == nil,
+			Duration: time.Since(start),
+		}
+		if err != nil {
+			result.Detail = err.Error()
+			report.Passed = false
+		}
+		report.Checks = append(report.Checks, result)
+	}
+
+	runCheck("codec", func() error {
+		return selfTestCodec(cfg.roundTrip)
+	})
+	runCheck("clock", selfTestClock)
+	if cfg.stateDir != "" {
+		runCheck("disk", func() error {
+			return selfTestDisk(cfg.stateDir)
+		})
+	}
+	for _, addr := range cfg.backends {
+		addr := addr
+		runCheck("backend "+addr, func() error {
+			conn, err := net.DialTimeout("tcp", addr, cfg.dialTimeout)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		})
+	}
+	return report
+}
+
+func selfTestCodec(roundTrip func(values ...tla.TLAValue) error) error {
+	return roundTrip(
+		tla.TLA_TRUE,
+		tla.MakeTLANumber(-42),
+		tla.MakeTLAString("self-test"),
+		tla.MakeTLASet(),
+		tla.MakeTLASet(tla.MakeTLANumber(1), tla.MakeTLANumber(2)),
+		tla.MakeTLATuple(tla.MakeTLAString("a"), tla.MakeTLATuple()),
+		tla.MakeTLARecord([]tla.TLARecordField{
+			{Key: tla.MakeTLAString("nested"), Value: tla.MakeTLASet(tla.MakeTLATuple(tla.TLA_FALSE))},
+		}),
+	)
+}
+
+func selfTestGobRoundTrip(samples ...tla.TLAValue) error {
+	for _, sample := range samples {
+		var buf bytes.Buffer
+		err := gob.NewEncoder(&buf).Encode(&sample)
+		if err != nil {
+			return fmt.Errorf("could not encode %v: %w", sample, err)
+		}
+		var decoded tla.TLAValue
+		err = gob.NewDecoder(&buf).Decode(&decoded)
+		if err != nil {
+			return fmt.Errorf("could not decode %v: %w", sample, err)
+		}
+		if !decoded.Equal(sample) {
+			return fmt.Errorf("round-trip of %v produced %v", sample, decoded)
+		}
+	}
+	return nil
+}
+
+func selfTestClock() error {
+	wallBefore := time.Now().Round(0) // strip the monotonic reading, so Sub compares wall clocks
+	monoBefore := time.Now()
+	time.Sleep(selfTestClockSleep)
+	monoElapsed := time.Since(monoBefore)
+	wallElapsed := time.Now().Round(0).Sub(wallBefore)
+
+	if monoElapsed < selfTestClockSleep {
+		return fmt.Errorf("monotonic clock advanced %v during a sleep of %v", monoElapsed, selfTestClockSleep)
+	}
+	if diff := wallElapsed - monoElapsed; diff > selfTestMaxClockSlop || diff < -selfTestMaxClockSlop {
+		return fmt.Errorf("wall clock advanced %v while monotonic clock advanced %v", wallElapsed, monoElapsed)
+	}
+	return nil
+}
+
+func selfTestDisk(dir string) error {
+	f, err := ioutil.TempFile(dir, ".selftest-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	_, err = f.Write([]byte("self-test"))
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
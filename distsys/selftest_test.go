@@ -0,0 +1,73 @@
+package distsys
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func TestSelfTest(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+	backend := listener.Addr().String()
+
+	// a healthy environment passes every check asked for
+	stateDir := t.TempDir()
+	report := SelfTest(WithSelfTestStateDir(stateDir), WithSelfTestBackends(backend))
+	if !report.Passed {
+		t.Fatalf("expected the self-test to pass, got %+v", report)
+	}
+	expected := []string{"codec", "clock", "disk", "backend " + backend}
+	if len(report.Checks) != len(expected) {
+		t.Fatalf("expected the checks %v, got %+v", expected, report.Checks)
+	}
+	for i, check := range report.Checks {
+		if check.Name != expected[i] || !check.Passed || check.Detail != "" {
+			t.Fatalf("expected check %s to pass, got %+v", expected[i], check)
+		}
+	}
+	if entries, err := ioutil.ReadDir(stateDir); err != nil || len(entries) != 0 {
+		t.Fatalf("expected the disk check to clean up after itself, got %v, %v", entries, err)
+	}
+
+	// one failing check fails the report, and says why
+	if err := listener.Close(); err != nil {
+		t.Fatal(err)
+	}
+	report = SelfTest(
+		WithSelfTestStateDir(filepath.Join(t.TempDir(), "missing")),
+		WithSelfTestBackends(backend),
+		WithSelfTestDialTimeout(100*time.Millisecond),
+		WithSelfTestCodec(func(...tla.TLAValue) error {
+			return errors.New("values differ")
+		}))
+	if report.Passed {
+		t.Fatalf("expected the self-test to fail, got %+v", report)
+	}
+	passed := map[string]bool{"codec": false, "clock": true, "disk": false, "backend " + backend: false}
+	for _, check := range report.Checks {
+		if expected, ok := passed[check.Name]; !ok || check.Passed != expected || check.Passed != (check.Detail == "") {
+			t.Fatalf("expected check %s to have passed = %v, got %+v", check.Name, expected, check)
+		}
+	}
+	if report.Checks[0].Detail != "values differ" {
+		t.Fatalf("expected the codec error as detail, got %q", report.Checks[0].Detail)
+	}
+}
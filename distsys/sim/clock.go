@@ -0,0 +1,67 @@
+package sim
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ClockMaker produces a distsys.ArchetypeResourceMaker for a read-only resource giving the simulation's virtual
+// time, as seen by the archetype with the given self, as a number of units since the simulation started. Like
+// resources.MonotonicClockMaker, every read within the same critical section gives the same time. Its readings are
+// shifted by the skew set with SetClockSkew, and so may be negative.
+func (s *Simulation) ClockMaker(self tla.TLAValue, unit time.Duration) distsys.ArchetypeResourceMaker {
+	return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+		return &clock{sim: s, self: self, unit: unit}
+	})
+}
+
+type clock struct {
+	distsys.ArchetypeResourceLeafMixin
+	sim  *Simulation
+	self tla.TLAValue
+	unit time.Duration
+
+	cachedRead *tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &clock{}
+
+func (res *clock) Abort() chan struct{} {
+	res.cachedRead = nil
+	return nil
+}
+
+func (res *clock) PreCommit() chan error {
+	return nil
+}
+
+func (res *clock) Commit() chan struct{} {
+	res.cachedRead = nil
+	return nil
+}
+
+func (res *clock) ReadValue() (tla.TLAValue, error) {
+	if res.cachedRead == nil {
+		elapsed := res.sim.now.Sub(res.sim.config.startTime) + res.sim.getNode(res.self).skew
+		units := int64(elapsed / res.unit)
+		if units < math.MinInt32 || units > math.MaxInt32 {
+			return tla.TLAValue{}, resources.ErrClockOverflow
+		}
+		value := tla.MakeTLANumber(int32(units))
+		res.cachedRead = &value
+	}
+	return *res.cachedRead, nil
+}
+
+func (res *clock) WriteValue(value tla.TLAValue) error {
+	panic(fmt.Errorf("attempted to write %v to a simulated clock resource", value))
+}
+
+func (res *clock) Close() error {
+	return nil
+}
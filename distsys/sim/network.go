@@ -0,0 +1,312 @@
+package sim
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"github.com/benbjohnson/immutable"
+)
+
+// Message is a message in the simulated network.
+type Message struct {
+	ID        uint64 // unique within the simulation, in the order messages were sent
+	From, To  tla.TLAValue
+	Value     tla.TLAValue
+	SentAt    time.Time
+	DeliverAt time.Time
+}
+
+// Fault describes how messages sent from one archetype to a mailbox are mistreated. The zero value delivers
+// messages normally.
+type Fault struct {
+	DropProbability      float64 // chance that a message is silently discarded
+	DuplicateProbability float64 // chance that a message is delivered twice
+	// MinDelay and MaxDelay, if MaxDelay is set, replace the simulation's range of message delays.
+	MinDelay, MaxDelay time.Duration
+	// Reorder lets messages overtake each other. By default, like with TCP mailboxes, messages between the same
+	// sender and mailbox are delivered in the order they were sent, whatever their delays.
+	Reorder bool
+}
+
+// Network is the simulated network of a Simulation. Messages written to its mailboxes are sent when the writing
+// critical section commits, and are delivered to the destination mailbox after a random delay in virtual time,
+// subject to the Fault set for their link. Delivery can also be driven by hand, with InFlight, DeliverNow and Drop.
+type Network struct {
+	sim *Simulation
+
+	nextID    uint64
+	inFlight  []*Message     // ordered by DeliverAt, then ID
+	inboxes   *immutable.Map // mailbox index -> *[]Message, delivered and not yet read
+	faults    *immutable.Map // <from, to> tuple -> Fault
+	lastSent  *immutable.Map // <from, to> tuple -> time.Time, the latest DeliverAt on that link
+	delivered int
+	dropped   int
+}
+
+func newNetwork(sim *Simulation) *Network {
+	return &Network{
+		sim:      sim,
+		inboxes:  immutable.NewMap(tla.TLAValueHasher{}),
+		faults:   immutable.NewMap(tla.TLAValueHasher{}),
+		lastSent: immutable.NewMap(tla.TLAValueHasher{}),
+	}
+}
+
+// SetFault makes messages sent by the archetype from, to the mailbox indexed by to, suffer fault. It replaces any fault
+// previously set for that link; the opposite direction is unaffected.
+func (network *Network) SetFault(from, to tla.TLAValue, fault Fault) {
+	network.faults = network.faults.Set(tla.MakeTLATuple(from, to), fault)
+}
+
+// ClearFault restores normal delivery of messages sent from one archetype to a mailbox.
+func (network *Network) ClearFault(from, to tla.TLAValue) {
+	network.faults = network.faults.Delete(tla.MakeTLATuple(from, to))
+}
+
+// Partition drops all messages between the members of groupA and those of groupB, in both directions. This assumes
+// that each archetype reads the mailbox indexed by its own self, as is the convention.
+func (network *Network) Partition(groupA, groupB []tla.TLAValue) {
+	for _, a := range groupA {
+		for _, b := range groupB {
+			network.SetFault(a, b, Fault{DropProbability: 1})
+			network.SetFault(b, a, Fault{DropProbability: 1})
+		}
+	}
+}
+
+// Heal clears all faults, including partitions. Messages already dropped stay lost.
+func (network *Network) Heal() {
+	network.faults = immutable.NewMap(tla.TLAValueHasher{})
+}
+
+func (network *Network) getFault(from, to tla.TLAValue) Fault {
+	if fault, ok := network.faults.Get(tla.MakeTLATuple(from, to)); ok {
+		return fault.(Fault)
+	}
+	return Fault{}
+}
+
+func (network *Network) roll(probability float64) bool {
+	return probability > 0 && network.sim.rng.Float64() < probability
+}
+
+// send puts value in flight from the archetype from to the mailbox indexed by to, according to the link's fault.
+func (network *Network) send(from, to tla.TLAValue, value tla.TLAValue) {
+	fault := network.getFault(from, to)
+	if network.roll(fault.DropProbability) {
+		network.nextID++
+		network.dropped++
+		return
+	}
+	copies := 1
+	if network.roll(fault.DuplicateProbability) {
+		copies = 2
+	}
+	minDelay, maxDelay := network.sim.config.minDelay, network.sim.config.maxDelay
+	if fault.MaxDelay > 0 {
+		minDelay, maxDelay = fault.MinDelay, fault.MaxDelay
+	}
+	link := tla.MakeTLATuple(from, to)
+	for i := 0; i < copies; i++ {
+		delay := minDelay
+		if maxDelay > minDelay {
+			delay += time.Duration(network.sim.rng.Int63n(int64(maxDelay - minDelay + 1)))
+		}
+		deliverAt := network.sim.now.Add(delay)
+		if !fault.Reorder {
+			if last, ok := network.lastSent.Get(link); ok && deliverAt.Before(last.(time.Time)) {
+				deliverAt = last.(time.Time)
+			}
+			network.lastSent = network.lastSent.Set(link, deliverAt)
+		}
+		network.nextID++
+		network.enqueue(&Message{
+			ID:        network.nextID,
+			From:      from,
+			To:        to,
+			Value:     value,
+			SentAt:    network.sim.now,
+			DeliverAt: deliverAt,
+		})
+	}
+}
+
+func (network *Network) enqueue(msg *Message) {
+	i := sort.Search(len(network.inFlight), func(i int) bool {
+		other := network.inFlight[i]
+		return other.DeliverAt.After(msg.DeliverAt) || (other.DeliverAt.Equal(msg.DeliverAt) && other.ID > msg.ID)
+	})
+	network.inFlight = append(network.inFlight, nil)
+	copy(network.inFlight[i+1:], network.inFlight[i:])
+	network.inFlight[i] = msg
+}
+
+func (network *Network) inbox(index tla.TLAValue) *[]Message {
+	if inbox, ok := network.inboxes.Get(index); ok {
+		return inbox.(*[]Message)
+	}
+	inbox := &[]Message{}
+	network.inboxes = network.inboxes.Set(index, inbox)
+	return inbox
+}
+
+func (network *Network) deliver(msg *Message) {
+	inbox := network.inbox(msg.To)
+	*inbox = append(*inbox, *msg)
+	network.delivered++
+}
+
+// nextDelivery returns when the next message in flight is due, if there is one.
+func (network *Network) nextDelivery() (time.Time, bool) {
+	if len(network.inFlight) == 0 {
+		return time.Time{}, false
+	}
+	return network.inFlight[0].DeliverAt, true
+}
+
+// deliverDue delivers the messages due by the current virtual time, and reports whether there were any.
+func (network *Network) deliverDue() bool {
+	count := 0
+	for count < len(network.inFlight) && !network.inFlight[count].DeliverAt.After(network.sim.now) {
+		network.deliver(network.inFlight[count])
+		count++
+	}
+	network.inFlight = network.inFlight[count:]
+	return count > 0
+}
+
+// InFlight returns the messages sent and not yet delivered, in the order they are due.
+func (network *Network) InFlight() []Message {
+	result := make([]Message, len(network.inFlight))
+	for i, msg := range network.inFlight {
+		result[i] = *msg
+	}
+	return result
+}
+
+func (network *Network) removeInFlight(id uint64) (*Message, error) {
+	for i, msg := range network.inFlight {
+		if msg.ID == id {
+			network.inFlight = append(network.inFlight[:i], network.inFlight[i+1:]...)
+			return msg, nil
+		}
+	}
+	return nil, fmt.Errorf("no message with ID %d is in flight", id)
+}
+
+// DeliverNow delivers the message in flight with the given ID right away, ahead of its schedule.
+func (network *Network) DeliverNow(id uint64) error {
+	msg, err := network.removeInFlight(id)
+	if err != nil {
+		return err
+	}
+	network.deliver(msg)
+	network.sim.unblockAll()
+	return nil
+}
+
+// Drop discards the message in flight with the given ID.
+func (network *Network) Drop(id uint64) error {
+	_, err := network.removeInFlight(id)
+	if err != nil {
+		return err
+	}
+	network.dropped++
+	return nil
+}
+
+// Pending returns how many messages have been delivered to the mailbox at index, and not yet read.
+func (network *Network) Pending(index tla.TLAValue) int {
+	return len(*network.inbox(index))
+}
+
+// Delivered returns how many messages have been delivered so far.
+func (network *Network) Delivered() int {
+	return network.delivered
+}
+
+// Dropped returns how many messages were lost to faults or dropped by hand.
+func (network *Network) Dropped() int {
+	return network.dropped
+}
+
+// MailboxesMaker produces a distsys.ArchetypeResourceMaker for a map of mailboxes on the simulated network, for use
+// by the archetype with the given self. Like TCP mailboxes, any archetype may write to any index, and each index
+// should be read by a single archetype. A read from an empty mailbox aborts the critical section, so that the
+// simulation can run other archetypes until a message arrives.
+func (network *Network) MailboxesMaker(self tla.TLAValue) distsys.ArchetypeResourceMaker {
+	return resources.IncrementalMapMaker(func(index tla.TLAValue) distsys.ArchetypeResourceMaker {
+		return distsys.ArchetypeResourceMakerFn(func() distsys.ArchetypeResource {
+			return &mailbox{
+				network: network,
+				self:    self,
+				index:   index,
+			}
+		})
+	})
+}
+
+type mailbox struct {
+	distsys.ArchetypeResourceLeafMixin
+	network *Network
+	self    tla.TLAValue
+	index   tla.TLAValue
+
+	readsInProgress []Message
+	writesPending   []tla.TLAValue
+}
+
+var _ distsys.ArchetypeResource = &mailbox{}
+var _ distsys.StatusArchetypeResource = &mailbox{}
+
+func (res *mailbox) Abort() chan struct{} {
+	if len(res.readsInProgress) > 0 {
+		// put the messages back, ahead of any that arrived since
+		inbox := res.network.inbox(res.index)
+		*inbox = append(res.readsInProgress, *inbox...)
+		res.readsInProgress = nil
+	}
+	res.writesPending = nil
+	return nil
+}
+
+func (res *mailbox) PreCommit() chan error {
+	return nil
+}
+
+func (res *mailbox) Commit() chan struct{} {
+	for _, value := range res.writesPending {
+		res.network.send(res.self, res.index, value)
+	}
+	res.writesPending = nil
+	res.readsInProgress = nil
+	return nil
+}
+
+func (res *mailbox) ReadValue() (tla.TLAValue, error) {
+	inbox := res.network.inbox(res.index)
+	if len(*inbox) == 0 {
+		return tla.TLAValue{}, distsys.ErrCriticalSectionAborted
+	}
+	msg := (*inbox)[0]
+	*inbox = (*inbox)[1:]
+	res.readsInProgress = append(res.readsInProgress, msg)
+	return msg.Value, nil
+}
+
+func (res *mailbox) WriteValue(value tla.TLAValue) error {
+	res.writesPending = append(res.writesPending, value)
+	return nil
+}
+
+func (res *mailbox) Close() error {
+	return nil
+}
+
+func (res *mailbox) Status() string {
+	return fmt.Sprintf("simulated, %d messages pending", len(*res.network.inbox(res.index)))
+}
@@ -0,0 +1,344 @@
+// Package sim runs archetypes in a deterministic simulation: a single goroutine steps them one critical section at
+// a time, in an order drawn from a seeded source of randomness, exchanging messages over a simulated network, and
+// reading a virtual clock. There are no sockets, no sleeps and no races, so a protocol can be exercised through many
+// more interleavings and failure scenarios than with real mailboxes, and any failing run can be reproduced exactly
+// from its seed. For example:
+//
+//	s := sim.New(seed)
+//	for _, self := range servers {
+//		s.AddArchetype(self, AServer,
+//			distsys.EnsureArchetypeRefParam("net", s.Network().MailboxesMaker(self)),
+//			distsys.EnsureArchetypeRefParam("clock", s.ClockMaker(self, time.Millisecond)))
+//	}
+//	err := s.Run(100000)
+//
+// Archetypes should only use resources provided by the simulation, or purely local ones: a resource that blocks, or
+// that depends on real time, breaks determinism.
+package sim
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+	"go.uber.org/multierr"
+)
+
+// ErrDeadlock is returned by Simulation.Run when no archetype can make progress: every archetype that has not
+// terminated keeps aborting, no message is in flight, and letting virtual time pass has not helped.
+var ErrDeadlock = errors.New("simulation deadlocked: every archetype is blocked and no message is in flight")
+
+const (
+	defaultStepDuration = time.Millisecond
+	defaultMinDelay     = time.Millisecond
+	defaultMaxDelay     = 10 * time.Millisecond
+	defaultMaxIdleSteps = 10000
+)
+
+// NodeStatus is the state of an archetype in a simulation.
+type NodeStatus int
+
+const (
+	NodeRunning NodeStatus = iota
+	NodeDone               // the archetype terminated normally
+	NodeFailed             // the archetype returned an error, which stopped the simulation
+	NodeCrashed            // the archetype was stopped by Crash
+)
+
+func (status NodeStatus) String() string {
+	switch status {
+	case NodeRunning:
+		return "running"
+	case NodeDone:
+		return "done"
+	case NodeFailed:
+		return "failed"
+	case NodeCrashed:
+		return "crashed"
+	default:
+		return fmt.Sprintf("NodeStatus(%d)", int(status))
+	}
+}
+
+type config struct {
+	startTime    time.Time
+	stepDuration time.Duration
+	minDelay     time.Duration
+	maxDelay     time.Duration
+	maxIdleSteps int
+	stepHooks    []func(s *Simulation) error
+}
+
+// Option configures a Simulation, as made by New.
+type Option func(cfg *config)
+
+// WithStartTime sets the virtual time at which the simulation starts, 2000-01-01 UTC by default.
+func WithStartTime(start time.Time) Option {
+	return func(cfg *config) {
+		cfg.startTime = start
+	}
+}
+
+// WithStepDuration sets how much virtual time each critical section takes, one millisecond by default.
+func WithStepDuration(d time.Duration) Option {
+	return func(cfg *config) {
+		cfg.stepDuration = d
+	}
+}
+
+// WithMessageDelay sets the range of virtual time, [min, max], that messages take to be delivered, unless a Fault
+// says otherwise. It is [1ms, 10ms] by default.
+func WithMessageDelay(min, max time.Duration) Option {
+	return func(cfg *config) {
+		cfg.minDelay = min
+		cfg.maxDelay = max
+	}
+}
+
+// WithMaxIdleSteps sets how many times in a row virtual time may be advanced, while every archetype is blocked and
+// no message is in flight, before Run gives up with ErrDeadlock. This bounds how long archetypes may wait on
+// timeouts.
+func WithMaxIdleSteps(n int) Option {
+	return func(cfg *config) {
+		cfg.maxIdleSteps = n
+	}
+}
+
+// WithStepHook calls hook after every step of the simulation, e.g. to check invariants, or to inject faults at
+// chosen points. If hook returns an error, Run stops and returns it.
+func WithStepHook(hook func(s *Simulation) error) Option {
+	return func(cfg *config) {
+		cfg.stepHooks = append(cfg.stepHooks, hook)
+	}
+}
+
+type node struct {
+	self    tla.TLAValue
+	ctx     *distsys.MPCalContext
+	status  NodeStatus
+	err     error
+	blocked bool // set when the node aborts, until something that may unblock it happens
+	skew    time.Duration
+}
+
+// Simulation runs archetypes deterministically, as described in the package documentation. It is not safe for
+// concurrent use: it should be driven, and inspected, from a single goroutine.
+type Simulation struct {
+	seed    int64
+	rng     *rand.Rand
+	config  config
+	now     time.Time
+	steps   int
+	nodes   []*node
+	network *Network
+}
+
+// New creates an empty simulation, whose choices are all drawn from a source seeded with seed.
+func New(seed int64, opts ...Option) *Simulation {
+	cfg := config{
+		startTime:    time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		stepDuration: defaultStepDuration,
+		minDelay:     defaultMinDelay,
+		maxDelay:     defaultMaxDelay,
+		maxIdleSteps: defaultMaxIdleSteps,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	s := &Simulation{
+		seed:   seed,
+		rng:    rand.New(rand.NewSource(seed)),
+		config: cfg,
+		now:    cfg.startTime,
+	}
+	s.network = newNetwork(s)
+	return s
+}
+
+// Seed returns the seed the simulation was created with.
+func (s *Simulation) Seed() int64 {
+	return s.seed
+}
+
+// Now returns the current virtual time.
+func (s *Simulation) Now() time.Time {
+	return s.now
+}
+
+// Steps returns how many critical sections have been run so far, including aborted ones.
+func (s *Simulation) Steps() int {
+	return s.steps
+}
+
+// Network returns the simulated network shared by the archetypes.
+func (s *Simulation) Network() *Network {
+	return s.network
+}
+
+// Rand returns the simulation's source of randomness, for hooks that make random decisions, such as which fault
+// to inject. Drawing from it keeps the whole run reproducible from the seed.
+func (s *Simulation) Rand() *rand.Rand {
+	return s.rng
+}
+
+// AddArchetype creates a context for archetype, with the given self and configuration, to be run by the
+// simulation. The branches of either statements are picked at random, from the simulation's seed.
+func (s *Simulation) AddArchetype(self tla.TLAValue, archetype distsys.MPCalArchetype, configFns ...distsys.MPCalContextConfigFn) *distsys.MPCalContext {
+	for _, n := range s.nodes {
+		if n.self.Equal(self) {
+			panic(fmt.Errorf("an archetype with self %v was already added to the simulation", self))
+		}
+	}
+	branchRng := rand.New(rand.NewSource(s.rng.Int63()))
+	configFns = append([]distsys.MPCalContextConfigFn{
+		distsys.WithBranchChooser(func(id string, ceiling int) int {
+			return branchRng.Intn(ceiling)
+		}),
+	}, configFns...)
+	ctx := distsys.NewMPCalContext(self, archetype, configFns...)
+	s.nodes = append(s.nodes, &node{self: self, ctx: ctx})
+	return ctx
+}
+
+func (s *Simulation) getNode(self tla.TLAValue) *node {
+	for _, n := range s.nodes {
+		if n.self.Equal(self) {
+			return n
+		}
+	}
+	panic(fmt.Errorf("no archetype with self %v in the simulation", self))
+}
+
+// Context returns the context of the archetype with the given self.
+func (s *Simulation) Context(self tla.TLAValue) *distsys.MPCalContext {
+	return s.getNode(self).ctx
+}
+
+// Selves returns the selves of all archetypes in the simulation, in the order they were added.
+func (s *Simulation) Selves() []tla.TLAValue {
+	selves := make([]tla.TLAValue, len(s.nodes))
+	for i, n := range s.nodes {
+		selves[i] = n.self
+	}
+	return selves
+}
+
+// Status returns the status of the archetype with the given self.
+func (s *Simulation) Status(self tla.TLAValue) NodeStatus {
+	return s.getNode(self).status
+}
+
+// Crash stops running the archetype with the given self, as if its node had crashed. Messages sent to it are still
+// delivered to its mailboxes, but nothing reads them.
+func (s *Simulation) Crash(self tla.TLAValue) {
+	n := s.getNode(self)
+	if n.status == NodeRunning {
+		n.status = NodeCrashed
+	}
+}
+
+// SetClockSkew makes the clocks of the archetype with the given self, made by ClockMaker, read skew ahead of the
+// simulation's virtual time, or behind it if skew is negative.
+func (s *Simulation) SetClockSkew(self tla.TLAValue, skew time.Duration) {
+	s.getNode(self).skew = skew
+}
+
+// Done reports whether every archetype has terminated, crashed or failed.
+func (s *Simulation) Done() bool {
+	for _, n := range s.nodes {
+		if n.status == NodeRunning {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Simulation) unblockAll() {
+	for _, n := range s.nodes {
+		n.blocked = false
+	}
+}
+
+// advanceTo moves virtual time forward to t, delivering the messages due by then.
+func (s *Simulation) advanceTo(t time.Time) {
+	if t.After(s.now) {
+		s.now = t
+	}
+	if s.network.deliverDue() {
+		s.unblockAll()
+	}
+}
+
+// Run steps the archetypes until they have all terminated, maxSteps critical sections have run, or an archetype
+// fails. At each step, an archetype that is not blocked is picked at random, and runs its next critical section.
+// An archetype that aborts is considered blocked, e.g. waiting for a message, until a message is delivered or
+// virtual time passes.
+//
+// Run returns nil if all archetypes terminated or maxSteps was reached, ErrDeadlock if no progress can be made, and
+// otherwise the error that stopped an archetype or that a step hook returned. It may be called again to continue
+// the simulation.
+func (s *Simulation) Run(maxSteps int) error {
+	idleSteps := 0
+	for taken := 0; taken < maxSteps; {
+		var runnable []*node
+		for _, n := range s.nodes {
+			if n.status == NodeRunning && !n.blocked {
+				runnable = append(runnable, n)
+			}
+		}
+		if len(runnable) == 0 {
+			if s.Done() {
+				return nil
+			}
+			if next, ok := s.network.nextDelivery(); ok {
+				s.advanceTo(next)
+			} else {
+				idleSteps++
+				if idleSteps > s.config.maxIdleSteps {
+					return ErrDeadlock
+				}
+				s.advanceTo(s.now.Add(s.config.stepDuration))
+			}
+			// time has passed, which may unblock archetypes waiting on clocks
+			s.unblockAll()
+			continue
+		}
+
+		n := runnable[s.rng.Intn(len(runnable))]
+		s.advanceTo(s.now.Add(s.config.stepDuration))
+		err := n.ctx.Step()
+		s.steps++
+		taken++
+		switch err {
+		case nil:
+			idleSteps = 0
+		case distsys.ErrCriticalSectionAborted:
+			n.blocked = true
+		case distsys.ErrDone:
+			idleSteps = 0
+			n.status = NodeDone
+		default:
+			n.status = NodeFailed
+			n.err = err
+			return fmt.Errorf("archetype %v failed at step %d: %w", n.self, s.steps, err)
+		}
+		for _, hook := range s.config.stepHooks {
+			if err := hook(s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close closes the contexts of all archetypes, and so their resources.
+func (s *Simulation) Close() error {
+	var err error
+	for _, n := range s.nodes {
+		err = multierr.Append(err, n.ctx.Close())
+	}
+	return err
+}
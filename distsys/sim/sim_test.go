@@ -0,0 +1,213 @@
+package sim
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const simTestRounds = 5
+
+var (
+	simTestPinger = tla.MakeTLANumber(1)
+	simTestPonger = tla.MakeTLANumber(2)
+)
+
+// the archetypes below are written as PGo would generate them for:
+//
+//	archetype APinger(ref net[_]) variables i = 0; {
+//	ping: while (i < ROUNDS) { net[PONGER] := i;
+//	pong:   i := i + 1; assert net[self] = i - 1; }
+//	}
+//	archetype APonger(ref net[_]) variables n = 0; {
+//	pong: while (n < ROUNDS) { net[PINGER] := net[self]; n := n + 1; }
+//	}
+//
+// The pinger increments i before reading its reply, so that each time the read aborts, the increment must be rolled
+// back for the assertion to hold.
+
+var simTestJumpTable = distsys.MakeMPCalJumpTable(
+	distsys.MPCalCriticalSection{
+		Name: "APinger.ping",
+		Body: func(iface distsys.ArchetypeInterface) error {
+			i := iface.RequireArchetypeResource("APinger.i")
+			net, err := iface.RequireArchetypeResourceRef("APinger.net")
+			if err != nil {
+				return err
+			}
+			iRead, err := iface.Read(i, nil)
+			if err != nil {
+				return err
+			}
+			if iRead.AsNumber() >= simTestRounds {
+				return iface.Goto("APinger.Done")
+			}
+			err = iface.Write(net, []tla.TLAValue{simTestPonger}, iRead)
+			if err != nil {
+				return err
+			}
+			return iface.Goto("APinger.pong")
+		},
+	},
+	distsys.MPCalCriticalSection{
+		Name: "APinger.pong",
+		Body: func(iface distsys.ArchetypeInterface) error {
+			i := iface.RequireArchetypeResource("APinger.i")
+			net, err := iface.RequireArchetypeResourceRef("APinger.net")
+			if err != nil {
+				return err
+			}
+			iRead, err := iface.Read(i, nil)
+			if err != nil {
+				return err
+			}
+			err = iface.Write(i, nil, tla.TLA_PlusSymbol(iRead, tla.MakeTLANumber(1)))
+			if err != nil {
+				return err
+			}
+			reply, err := iface.Read(net, []tla.TLAValue{iface.Self()})
+			if err != nil {
+				return err
+			}
+			if !reply.Equal(iRead) {
+				return fmt.Errorf("expected the reply %v, got %v", iRead, reply)
+			}
+			return iface.Goto("APinger.ping")
+		},
+	},
+	distsys.MPCalCriticalSection{
+		Name: "APinger.Done",
+		Body: func(distsys.ArchetypeInterface) error {
+			return distsys.ErrDone
+		},
+	},
+	distsys.MPCalCriticalSection{
+		Name: "APonger.pong",
+		Body: func(iface distsys.ArchetypeInterface) error {
+			n := iface.RequireArchetypeResource("APonger.n")
+			net, err := iface.RequireArchetypeResourceRef("APonger.net")
+			if err != nil {
+				return err
+			}
+			nRead, err := iface.Read(n, nil)
+			if err != nil {
+				return err
+			}
+			if nRead.AsNumber() >= simTestRounds {
+				return iface.Goto("APonger.Done")
+			}
+			request, err := iface.Read(net, []tla.TLAValue{iface.Self()})
+			if err != nil {
+				return err
+			}
+			err = iface.Write(net, []tla.TLAValue{simTestPinger}, request)
+			if err != nil {
+				return err
+			}
+			err = iface.Write(n, nil, tla.TLA_PlusSymbol(nRead, tla.MakeTLANumber(1)))
+			if err != nil {
+				return err
+			}
+			return iface.Goto("APonger.pong")
+		},
+	},
+	distsys.MPCalCriticalSection{
+		Name: "APonger.Done",
+		Body: func(distsys.ArchetypeInterface) error {
+			return distsys.ErrDone
+		},
+	},
+)
+
+var simTestAPinger = distsys.MPCalArchetype{
+	Name:              "APinger",
+	Label:             "APinger.ping",
+	RequiredRefParams: []string{"APinger.net"},
+	RequiredValParams: []string{},
+	JumpTable:         simTestJumpTable,
+	ProcTable:         distsys.MakeMPCalProcTable(),
+	PreAmble: func(iface distsys.ArchetypeInterface) {
+		iface.EnsureArchetypeResourceLocal("APinger.i", tla.MakeTLANumber(0))
+	},
+}
+
+var simTestAPonger = distsys.MPCalArchetype{
+	Name:              "APonger",
+	Label:             "APonger.pong",
+	RequiredRefParams: []string{"APonger.net"},
+	RequiredValParams: []string{},
+	JumpTable:         simTestJumpTable,
+	ProcTable:         distsys.MakeMPCalProcTable(),
+	PreAmble: func(iface distsys.ArchetypeInterface) {
+		iface.EnsureArchetypeResourceLocal("APonger.n", tla.MakeTLANumber(0))
+	},
+}
+
+// runSimTest runs the pinger and ponger with seed, returning a trace of the simulation after each step, giving the
+// virtual time and how many messages were delivered, along with Run's error.
+func runSimTest(t *testing.T, seed int64, setup func(s *Simulation)) ([]string, error) {
+	t.Helper()
+	var trace []string
+	s := New(seed, WithMaxIdleSteps(100), WithStepHook(func(s *Simulation) error {
+		trace = append(trace, fmt.Sprintf("%d: %v, %d delivered", s.Steps(), s.Now().Sub(s.config.startTime), s.Network().Delivered()))
+		return nil
+	}))
+	defer func() {
+		if err := s.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	s.AddArchetype(simTestPinger, simTestAPinger,
+		distsys.EnsureArchetypeRefParam("net", s.Network().MailboxesMaker(simTestPinger)))
+	s.AddArchetype(simTestPonger, simTestAPonger,
+		distsys.EnsureArchetypeRefParam("net", s.Network().MailboxesMaker(simTestPonger)))
+	if setup != nil {
+		setup(s)
+	}
+	err := s.Run(10000)
+	if err == nil {
+		for _, self := range s.Selves() {
+			if status := s.Status(self); status != NodeDone {
+				t.Fatalf("expected archetype %v to be done, but it is %v", self, status)
+			}
+		}
+		if delivered := s.Network().Delivered(); delivered != 2*simTestRounds {
+			t.Fatalf("expected %d messages to be delivered, got %d", 2*simTestRounds, delivered)
+		}
+	}
+	return trace, err
+}
+
+func TestSimulation(t *testing.T) {
+	traces := make(map[int64][]string)
+	for _, seed := range []int64{1, 2} {
+		trace, err := runSimTest(t, seed, nil)
+		if err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+		traces[seed] = trace
+	}
+	if reflect.DeepEqual(traces[1], traces[2]) {
+		t.Error("expected different seeds to run the archetypes differently")
+	}
+
+	// the same seed reproduces the same run exactly
+	trace, _ := runSimTest(t, 1, nil)
+	if !reflect.DeepEqual(trace, traces[1]) {
+		t.Fatalf("expected seed 1 to reproduce its trace\n%v\ngot\n%v", traces[1], trace)
+	}
+}
+
+func TestSimulationDeadlock(t *testing.T) {
+	// cut off from the ponger, the pinger waits for a reply forever
+	_, err := runSimTest(t, 1, func(s *Simulation) {
+		s.Network().Partition([]tla.TLAValue{simTestPinger}, []tla.TLAValue{simTestPonger})
+	})
+	if !errors.Is(err, ErrDeadlock) {
+		t.Fatalf("expected the simulation to deadlock, got %v", err)
+	}
+}
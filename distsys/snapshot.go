@@ -0,0 +1,173 @@
+package distsys
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// ErrCheckpointWhileRunning is returned by MPCalContext.Restore if the archetype is already running.
+var ErrCheckpointWhileRunning = errors.New("cannot restore a checkpoint into a running archetype")
+
+// Snapshotter is an optional interface for stateful resources, which lets MPCalContext include their state in an
+// archetype's checkpoints. Both methods are only called between critical sections, so the state they deal with is
+// the committed state.
+type Snapshotter interface {
+	// Snapshot returns an encoding of the resource's state.
+	Snapshot() ([]byte, error)
+	// Restore replaces the resource's state with one encoded by Snapshot.
+	Restore(data []byte) error
+}
+
+// archetypeCheckpoint is what MPCalContext.Checkpoint encodes.
+type archetypeCheckpoint struct {
+	Resources        map[ArchetypeResourceHandle][]byte
+	FairnessCounters map[string]int
+}
+
+// Checkpoint captures the archetype's state: that of every resource implementing Snapshotter, including its local
+// state variables, program counter and call stack, as well as its fairness counters. Resources not implementing
+// Snapshotter, such as those backed by external systems, are skipped, and are expected to keep their own state.
+//
+// If the archetype is running, the checkpoint is taken between two critical sections, and Checkpoint blocks until
+// then. If the context is closed, it returns ErrContextClosed.
+func (ctx *MPCalContext) Checkpoint() ([]byte, error) {
+	ctx.requireArchetype()
+	var data []byte
+	var err error
+	pauseErr := ctx.whilePaused(func() {
+		data, err = ctx.checkpoint()
+	})
+	if pauseErr != nil {
+		return nil, pauseErr
+	}
+	return data, err
+}
+
+// whilePaused calls fn between two critical sections, from the goroutine running the archetype, and blocks until it
+// returns. If the archetype is not running, fn is called right away. If the context is closed, fn is not called and
+// ErrContextClosed is returned.
+func (ctx *MPCalContext) whilePaused(fn func()) error {
+	for {
+		ctx.lock.Lock()
+		if ctx.closed {
+			ctx.lock.Unlock()
+			return ErrContextClosed
+		}
+		if !ctx.running {
+			fn()
+			ctx.lock.Unlock()
+			return nil
+		}
+		runDone := ctx.runDone
+		ctx.lock.Unlock()
+
+		fnDone := make(chan struct{})
+		select {
+		case ctx.pauseRequests <- func() { fn(); close(fnDone) }:
+			<-fnDone
+			return nil
+		case <-runDone:
+			// the archetype stopped, e.g. because the context closed, before reaching the next critical section
+		}
+	}
+}
+
+func (ctx *MPCalContext) checkpoint() ([]byte, error) {
+	cp := archetypeCheckpoint{
+		Resources:        make(map[ArchetypeResourceHandle][]byte),
+		FairnessCounters: ctx.fairnessCounters,
+	}
+	for handle, res := range ctx.resources {
+		snapshotter, ok := res.(Snapshotter)
+		if !ok {
+			continue
+		}
+		data, err := snapshotter.Snapshot()
+		if err != nil {
+			return nil, fmt.Errorf("could not snapshot resource %s: %w", handle, err)
+		}
+		cp.Resources[handle] = data
+	}
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(&cp)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Restore arranges for the archetype to start from a checkpoint produced by Checkpoint, possibly in another
+// process. It must be called before Run, and the checkpoint is applied once Run has set up the archetype's local
+// state, so that Run returns an error if the checkpoint holds state for a resource the context lacks.
+func (ctx *MPCalContext) Restore(data []byte) error {
+	ctx.requireArchetype()
+	var cp archetypeCheckpoint
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&cp)
+	if err != nil {
+		return fmt.Errorf("could not decode checkpoint: %w", err)
+	}
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	if ctx.running {
+		return ErrCheckpointWhileRunning
+	}
+	ctx.pendingRestore = &cp
+	return nil
+}
+
+// applyPendingRestore applies the checkpoint given to Restore, if any.
+func (ctx *MPCalContext) applyPendingRestore() error {
+	cp := ctx.pendingRestore
+	if cp == nil {
+		return nil
+	}
+	ctx.pendingRestore = nil
+	for handle, data := range cp.Resources {
+		res, ok := ctx.resources[handle]
+		if !ok {
+			return fmt.Errorf("checkpoint holds state for unknown resource %s", handle)
+		}
+		snapshotter, ok := res.(Snapshotter)
+		if !ok {
+			return fmt.Errorf("checkpoint holds state for resource %s, which cannot be restored", handle)
+		}
+		err := snapshotter.Restore(data)
+		if err != nil {
+			return fmt.Errorf("could not restore resource %s: %w", handle, err)
+		}
+	}
+	for id, counter := range cp.FairnessCounters {
+		ctx.fairnessCounters[id] = counter
+	}
+	return nil
+}
+
+var _ Snapshotter = &LocalArchetypeResource{}
+
+func (res *LocalArchetypeResource) Snapshot() ([]byte, error) {
+	return res.value.GobEncode()
+}
+
+func (res *LocalArchetypeResource) Restore(data []byte) error {
+	var value tla.TLAValue
+	err := value.GobDecode(data)
+	if err != nil {
+		return err
+	}
+	res.value = value
+	return nil
+}
+
+// Restore also logs the restored value, so that it survives a restart.
+func (res *PersistentLocalArchetypeResource) Restore(data []byte) error {
+	err := res.LocalArchetypeResource.Restore(data)
+	if err != nil {
+		return err
+	}
+	res.log.stage(res.key, res.value)
+	return res.log.flush()
+}
@@ -0,0 +1,161 @@
+package distsys
+
+import (
+	"testing"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// newSnapshotTestContext makes a context for an archetype which counts to 5 in its local variable count, writing
+// 10 times the count to its ref param out at each step.
+func newSnapshotTestContext(configFns ...MPCalContextConfigFn) *MPCalContext {
+	archetype := MPCalArchetype{
+		Name:              "ACount",
+		Label:             "ACount.loop",
+		RequiredRefParams: []string{"ACount.out"},
+		RequiredValParams: []string{},
+		JumpTable: MakeMPCalJumpTable(
+			MPCalCriticalSection{
+				Name: "ACount.loop",
+				Body: func(iface ArchetypeInterface) error {
+					count := iface.RequireArchetypeResource("ACount.count")
+					out, err := iface.RequireArchetypeResourceRef("ACount.out")
+					if err != nil {
+						return err
+					}
+					value, err := iface.Read(count, nil)
+					if err != nil {
+						return err
+					}
+					value = tla.TLA_PlusSymbol(value, tla.MakeTLANumber(1))
+					if err := iface.Write(count, nil, value); err != nil {
+						return err
+					}
+					if err := iface.Write(out, nil, tla.MakeTLANumber(value.AsNumber()*10)); err != nil {
+						return err
+					}
+					if value.AsNumber() >= 5 {
+						return iface.Goto("ACount.Done")
+					}
+					return iface.Goto("ACount.loop")
+				},
+			},
+			MPCalCriticalSection{
+				Name: "ACount.Done",
+				Body: func(ArchetypeInterface) error {
+					return ErrDone
+				},
+			},
+		),
+		ProcTable: MakeMPCalProcTable(),
+		PreAmble: func(iface ArchetypeInterface) {
+			iface.EnsureArchetypeResourceLocal("ACount.count", tla.MakeTLANumber(0))
+		},
+	}
+	configFns = append([]MPCalContextConfigFn{
+		EnsureArchetypeRefParam("out", LocalArchetypeResourceMaker(tla.MakeTLANumber(0))),
+	}, configFns...)
+	return NewMPCalContext(tla.MakeTLANumber(1), archetype, configFns...)
+}
+
+func snapshotTestStep(t *testing.T, ctx *MPCalContext, steps int) {
+	t.Helper()
+	for i := 0; i < steps; i++ {
+		if err := ctx.Step(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func snapshotTestLocals(t *testing.T, ctx *MPCalContext) map[ArchetypeResourceHandle]tla.TLAValue {
+	t.Helper()
+	state, err := ctx.Inspect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state.Locals
+}
+
+func expectSnapshotTestLocals(t *testing.T, locals, expected map[ArchetypeResourceHandle]tla.TLAValue) {
+	t.Helper()
+	if len(locals) != len(expected) {
+		t.Fatalf("expected the state %v, got %v", expected, locals)
+	}
+	for handle, value := range expected {
+		if actual, ok := locals[handle]; !ok || !actual.Equal(value) {
+			t.Fatalf("expected %s to be %v, got %v", handle, value, actual)
+		}
+	}
+}
+
+func TestCheckpointRestore(t *testing.T) {
+	original := newSnapshotTestContext()
+	defer original.Close()
+	snapshotTestStep(t, original, 3)
+	checkpoint, err := original.Checkpoint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkpointed := snapshotTestLocals(t, original)
+	if count := checkpointed["ACount.count"]; !count.Equal(tla.MakeTLANumber(3)) {
+		t.Fatalf("expected to checkpoint after 3 steps, but count is %v", count)
+	}
+
+	// the state restored in a new context picks up where the checkpoint left off, however the original went on
+	snapshotTestStep(t, original, 1)
+	restored := newSnapshotTestContext()
+	defer restored.Close()
+	if err := restored.Restore(checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	snapshotTestStep(t, restored, 1)
+	expectSnapshotTestLocals(t, snapshotTestLocals(t, restored), snapshotTestLocals(t, original))
+
+	// and both run to the same end
+	snapshotTestStep(t, original, 1)
+	snapshotTestStep(t, restored, 1)
+	expected := map[ArchetypeResourceHandle]tla.TLAValue{
+		".pc":          tla.MakeTLAString("ACount.Done"),
+		".stack":       tla.MakeTLATuple(),
+		"ACount.count": tla.MakeTLANumber(5),
+		"ACount.out":   tla.MakeTLAString("&ACount.out"),
+		"&ACount.out":  tla.MakeTLANumber(50),
+	}
+	for _, ctx := range []*MPCalContext{original, restored} {
+		expectSnapshotTestLocals(t, snapshotTestLocals(t, ctx), expected)
+		if err := ctx.Step(); err != ErrDone {
+			t.Fatalf("expected the archetype to be done, got %v", err)
+		}
+	}
+}
+
+// snapshotTestOpaqueResource hides whether the resource it wraps implements Snapshotter.
+type snapshotTestOpaqueResource struct {
+	ArchetypeResource
+}
+
+func TestRestoreUnsupportedResource(t *testing.T) {
+	original := newSnapshotTestContext()
+	defer original.Close()
+	snapshotTestStep(t, original, 1)
+	checkpoint, err := original.Checkpoint()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a context whose resource cannot take the checkpointed state refuses to start from the checkpoint
+	other := NewMPCalContext(tla.MakeTLANumber(1), original.archetype,
+		EnsureArchetypeRefParam("out", ArchetypeResourceMakerFn(func() ArchetypeResource {
+			return snapshotTestOpaqueResource{LocalArchetypeResourceMaker(tla.MakeTLANumber(0)).Make()}
+		})))
+	defer other.Close()
+	if err := other.Restore(checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Step(); err == nil {
+		t.Fatal("expected restoring state for a resource that cannot be restored to fail")
+	}
+	if err := other.Restore([]byte("not a checkpoint")); err == nil {
+		t.Fatal("expected restoring a malformed checkpoint to fail")
+	}
+}
@@ -0,0 +1,60 @@
+package distsys
+
+// Step executes the archetype's next critical section, as Run would, and returns once it has committed or aborted.
+// This gives the caller control over when each critical section runs, e.g. to interleave several archetypes
+// deterministically in a single goroutine, as a simulator would.
+//
+// Step returns nil if the critical section committed, and ErrCriticalSectionAborted if it aborted, in which case it
+// has already been rolled back, and the next Step will retry it. Once the archetype has terminated, Step returns
+// ErrDone. Any other error means the archetype failed, as described for Run.
+//
+// The first call to Step sets up the archetype, as Run does. A context must either be stepped or Run, not both, and
+// Step must not be called concurrently with itself or with Checkpoint and Inspect.
+func (ctx *MPCalContext) Step() error {
+	ctx.lock.Lock()
+	if ctx.closed {
+		ctx.lock.Unlock()
+		return ErrContextClosed
+	}
+	if ctx.running {
+		ctx.lock.Unlock()
+		panic("cannot Step an archetype that is being Run")
+	}
+	ctx.lock.Unlock()
+
+	if !ctx.stepping {
+		ctx.requireArchetype()
+		ctx.preRun()
+		if err := ctx.applyPendingRestore(); err != nil {
+			return err
+		}
+		ctx.stepping = true
+	}
+
+	err := ctx.runCriticalSection(ctx.iface.RequireArchetypeResource(".pc"))
+	switch err {
+	case nil, ErrDone:
+	case ErrCriticalSectionAborted:
+		ctx.Logger().Log(LogDebug, "critical section aborted")
+		ctx.abort()
+	default:
+		ctx.Logger().Log(LogError, "archetype failed", "error", err)
+		if ctx.flightRecorder != nil && ctx.flightRecorder.dumpOnError != nil {
+			_ = ctx.DumpFlightRecords(ctx.flightRecorder.dumpOnError)
+		}
+	}
+	return err
+}
+
+// BranchChooser picks which of the ceiling branches of the either statement identified by id to try next, as a
+// number in [0, ceiling).
+type BranchChooser func(id string, ceiling int) int
+
+// WithBranchChooser makes chooser decide which branch of each either statement the archetype tries, instead of
+// cycling through them in order. Given a seeded source of randomness, this lets a simulation explore different
+// interleavings reproducibly.
+func WithBranchChooser(chooser BranchChooser) MPCalContextConfigFn {
+	return func(ctx *MPCalContext) {
+		ctx.branchChooser = chooser
+	}
+}
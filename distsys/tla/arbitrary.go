@@ -0,0 +1,119 @@
+package tla
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+)
+
+// arbitraryMaxDepth bounds the nesting of values built by ArbitraryTLAValue, deep enough to exercise recursive
+// encodings, while keeping values small.
+const arbitraryMaxDepth = 8
+
+// ArbitraryTLAValue deterministically builds a TLAValue from data, in such a way that every byte string gives a
+// valid value, and small changes to data give similar values. It is meant for fuzzing code that handles values,
+// e.g. codecs: fuzzers generate data, and reach edge cases such as empty and deeply nested collections, functions
+// with collection keys, extreme numbers and strings that are not valid UTF-8.
+func ArbitraryTLAValue(data []byte) TLAValue {
+	gen := arbitraryGenerator{data: data}
+	return gen.value(0)
+}
+
+type arbitraryGenerator struct {
+	data []byte
+}
+
+// byte consumes one byte of data, or gives 0 once data runs out, which ends collections and so all values.
+func (gen *arbitraryGenerator) byte() byte {
+	if len(gen.data) == 0 {
+		return 0
+	}
+	b := gen.data[0]
+	gen.data = gen.data[1:]
+	return b
+}
+
+func (gen *arbitraryGenerator) bytes(n int) []byte {
+	if n > len(gen.data) {
+		n = len(gen.data)
+	}
+	b := gen.data[:n]
+	gen.data = gen.data[n:]
+	return b
+}
+
+func (gen *arbitraryGenerator) number() TLAValue {
+	switch gen.byte() % 6 {
+	case 0:
+		return MakeTLANumber(int32(int8(gen.byte())))
+	case 1:
+		return MakeTLANumber(math.MaxInt32)
+	case 2:
+		return MakeTLANumber(math.MinInt32)
+	case 4:
+		// a number that does not fit in 32 bits, unless it is small enough to be demoted
+		num := new(big.Int).SetBytes(gen.bytes(int(gen.byte() % 32)))
+		if gen.byte()%2 == 1 {
+			num.Neg(num)
+		}
+		return MakeTLABigNumber(num)
+	case 5:
+		// a real number, which is an integer if the denominator divides the numerator
+		denom := int64(gen.byte()) + 1
+		return MakeTLAReal(big.NewRat(int64(int8(gen.byte())), denom))
+	default:
+		var buf [4]byte
+		copy(buf[:], gen.bytes(4))
+		return MakeTLANumber(int32(binary.LittleEndian.Uint32(buf[:])))
+	}
+}
+
+func (gen *arbitraryGenerator) values(depth int) []TLAValue {
+	var result []TLAValue
+	for n := gen.byte() % 8; n > 0; n-- {
+		result = append(result, gen.value(depth+1))
+	}
+	return result
+}
+
+func (gen *arbitraryGenerator) value(depth int) TLAValue {
+	kind := gen.byte() % 8
+	if depth >= arbitraryMaxDepth && kind >= 3 {
+		kind %= 3
+	}
+	switch kind {
+	case 0:
+		return gen.number()
+	case 1:
+		return MakeTLABool(gen.byte()%2 == 1)
+	case 2:
+		return MakeTLAString(string(gen.bytes(int(gen.byte() % 32))))
+	case 3:
+		return MakeTLASet(gen.values(depth)...)
+	case 4:
+		return MakeTLATuple(gen.values(depth)...)
+	case 5:
+		// a record, i.e. a function with string keys
+		var fields []TLARecordField
+		for n := gen.byte() % 8; n > 0; n-- {
+			fields = append(fields, TLARecordField{
+				Key:   MakeTLAString(string(gen.bytes(int(gen.byte() % 8)))),
+				Value: gen.value(depth + 1),
+			})
+		}
+		return MakeTLARecord(fields)
+	case 6:
+		// a byte string, which should behave like the tuple of its bytes
+		return MakeTLABytes(gen.bytes(int(gen.byte() % 32)))
+	default:
+		// a function with arbitrary keys
+		var fields []TLARecordField
+		for n := gen.byte() % 8; n > 0; n-- {
+			fields = append(fields, TLARecordField{
+				Key:   gen.value(depth + 1),
+				Value: gen.value(depth + 1),
+			})
+		}
+		return MakeTLARecord(fields)
+	}
+}
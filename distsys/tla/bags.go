@@ -0,0 +1,175 @@
+package tla
+
+import (
+	"math"
+
+	"github.com/benbjohnson/immutable"
+)
+
+// this file contains definitions of the operators of the TLA+ Bags module. As in TLA+, a bag (multiset) is a
+// function from its elements to the positive number of copies of each, so bags can also be used with DOMAIN and
+// function application, and compare equal to functions with the same mapping.
+
+var TLA_EmptyBag = MakeTLARecord(nil)
+
+var tlaOne = MakeTLANumber(1)
+
+func TLA_IsABag(v TLAValue) TLAValue {
+	if !v.IsFunction() {
+		return TLA_FALSE
+	}
+	it := v.AsFunction().Iterator()
+	for !it.Done() {
+		_, count := it.Next()
+		countV := count.(TLAValue)
+		if !countV.IsNumber() || !TLA_GreaterThanSymbol(countV, TLA_Zero).AsBool() {
+			return TLA_FALSE
+		}
+	}
+	return TLA_TRUE
+}
+
+func TLA_BagToSet(v TLAValue) TLAValue {
+	return TLA_DomainSymbol(v)
+}
+
+func TLA_SetToBag(v TLAValue) TLAValue {
+	builder := immutable.NewMapBuilder(TLAValueHasher{})
+	forEachElement(v, func(elem TLAValue) bool {
+		builder.Set(elem, tlaOne)
+		return true
+	})
+	return TLAValue{&tlaValueFunction{Map: builder.Map()}}
+}
+
+func TLA_BagIn(elem, bag TLAValue) TLAValue {
+	_, ok := bag.AsFunction().Get(elem)
+	return MakeTLABool(ok)
+}
+
+func TLA_CopiesIn(elem, bag TLAValue) TLAValue {
+	count, ok := bag.AsFunction().Get(elem)
+	if !ok {
+		return TLA_Zero
+	}
+	return count.(TLAValue)
+}
+
+// TLA_OPlusSymbol is B1 (+) B2, the bag with the copies of both B1 and B2.
+func TLA_OPlusSymbol(lhs, rhs TLAValue) TLAValue {
+	lhsBag, rhsBag := lhs.AsFunction(), rhs.AsFunction()
+	// add the smaller bag to the larger one, sharing its structure
+	if lhsBag.Len() < rhsBag.Len() {
+		lhsBag, rhsBag = rhsBag, lhsBag
+	}
+	it := rhsBag.Iterator()
+	for !it.Done() {
+		elem, count := it.Next()
+		if lhsCount, ok := lhsBag.Get(elem); ok {
+			count = TLA_PlusSymbol(lhsCount.(TLAValue), count.(TLAValue))
+		}
+		lhsBag = lhsBag.Set(elem, count)
+	}
+	return TLAValue{&tlaValueFunction{Map: lhsBag}}
+}
+
+// TLA_OMinusSymbol is B1 (-) B2, the bag B1 without the copies in B2. Elements with no copies left are removed.
+func TLA_OMinusSymbol(lhs, rhs TLAValue) TLAValue {
+	lhsBag, rhsBag := lhs.AsFunction(), rhs.AsFunction()
+	it := rhsBag.Iterator()
+	for !it.Done() {
+		elem, count := it.Next()
+		if lhsCount, ok := lhsBag.Get(elem); ok {
+			remaining := TLA_MinusSymbol(lhsCount.(TLAValue), count.(TLAValue))
+			if TLA_GreaterThanSymbol(remaining, TLA_Zero).AsBool() {
+				lhsBag = lhsBag.Set(elem, remaining)
+			} else {
+				lhsBag = lhsBag.Delete(elem)
+			}
+		}
+	}
+	return TLAValue{&tlaValueFunction{Map: lhsBag}}
+}
+
+// TLA_BagUnion is the bag with the copies of all the bags in the set v.
+func TLA_BagUnion(v TLAValue) TLAValue {
+	result := TLA_EmptyBag
+	forEachElement(v, func(bag TLAValue) bool {
+		result = TLA_OPlusSymbol(result, bag)
+		return true
+	})
+	return result
+}
+
+// TLA_SquareSubsetOrEqualSymbol is B1 \sqsubseteq B2, which holds if B2 has at least as many copies of each element
+// as B1.
+func TLA_SquareSubsetOrEqualSymbol(lhs, rhs TLAValue) TLAValue {
+	lhsBag, rhsBag := lhs.AsFunction(), rhs.AsFunction()
+	it := lhsBag.Iterator()
+	for !it.Done() {
+		elem, count := it.Next()
+		rhsCount, ok := rhsBag.Get(elem)
+		if !ok || TLA_GreaterThanSymbol(count.(TLAValue), rhsCount.(TLAValue)).AsBool() {
+			return TLA_FALSE
+		}
+	}
+	return TLA_TRUE
+}
+
+// TLA_SubBag is the set of all bags B such that B \sqsubseteq v.
+func TLA_SubBag(v TLAValue) TLAValue {
+	var entries []TLARecordField
+	it := v.AsFunction().Iterator()
+	for !it.Done() {
+		elem, count := it.Next()
+		entries = append(entries, TLARecordField{Key: elem.(TLAValue), Value: count.(TLAValue)})
+	}
+	// each element may be included from 0 up to all of its copies
+	sizes := make([]int, len(entries))
+	for i, entry := range entries {
+		sizes[i] = math.MaxInt
+		if count, ok := entry.Value.data.(tlaValueNumber); ok {
+			sizes[i] = int(count) + 1
+		}
+	}
+	requireMaterializable(sizes...)
+
+	builder := immutable.NewMapBuilder(TLAValueHasher{})
+	var helper func(idx int, acc *immutable.Map)
+	helper = func(idx int, acc *immutable.Map) {
+		if idx == len(entries) {
+			builder.Set(TLAValue{&tlaValueFunction{Map: acc}}, true)
+			return
+		}
+		// leave the element out, or include from 1 up to all of its copies
+		helper(idx+1, acc)
+		entry := entries[idx]
+		for i := tlaOne; !TLA_GreaterThanSymbol(i, entry.Value).AsBool(); i = TLA_PlusSymbol(i, tlaOne) {
+			helper(idx+1, acc.Set(entry.Key, i))
+		}
+	}
+	helper(0, immutable.NewMap(TLAValueHasher{}))
+	return TLAValue{&tlaValueSet{Map: builder.Map()}}
+}
+
+// TLA_BagOfAll is the bag with a copy of fn(e) for each copy of e in v. Unlike the other operators, it takes fn as
+// a Go function, like TLASetRefinement does.
+func TLA_BagOfAll(fn func(elem TLAValue) TLAValue, v TLAValue) TLAValue {
+	result := TLA_EmptyBag
+	it := v.AsFunction().Iterator()
+	for !it.Done() {
+		elem, count := it.Next()
+		result = TLA_OPlusSymbol(result, TLA_ColonGreaterThanSymbol(fn(elem.(TLAValue)), count.(TLAValue)))
+	}
+	return result
+}
+
+func TLA_BagCardinality(v TLAValue) TLAValue {
+	result := TLA_Zero
+	it := v.AsFunction().Iterator()
+	for !it.Done() {
+		_, count := it.Next()
+		result = TLA_PlusSymbol(result, count.(TLAValue))
+	}
+	return result
+}
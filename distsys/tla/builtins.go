@@ -6,106 +6,80 @@ import "github.com/benbjohnson/immutable"
 // built-in syntax (not the ones that require using `EXTENDS`)
 
 func TLAQuantifiedUniversal(setVals []TLAValue, pred func([]TLAValue) bool) TLAValue {
-	var sets []*immutable.Map
-	for _, val := range setVals {
-		sets = append(sets, val.AsSet())
-	}
-
-	predArgs := make([]TLAValue, len(sets))
-
-	var helper func(idx int) bool
-	helper = func(idx int) bool {
-		if idx == len(sets) {
-			return pred(predArgs)
-		}
-
-		it := sets[idx].Iterator()
-		for !it.Done() {
-			elem, _ := it.Next()
-			predArgs[idx] = elem.(TLAValue)
-			if !helper(idx + 1) {
-				return false
-			}
-		}
-		return true
-	}
-
-	return MakeTLABool(helper(0))
+	return MakeTLABool(forEachCombination(setVals, pred))
 }
 
 func TLAQuantifiedExistential(setVals []TLAValue, pred func([]TLAValue) bool) TLAValue {
-	var sets []*immutable.Map
-	for _, val := range setVals {
-		sets = append(sets, val.AsSet())
-	}
-
-	predArgs := make([]TLAValue, len(sets))
+	return MakeTLABool(!forEachCombination(setVals, func(args []TLAValue) bool {
+		return !pred(args)
+	}))
+}
 
-	var helper func(idx int) bool
-	helper = func(idx int) bool {
-		if idx == len(sets) {
-			return pred(predArgs)
+// TLASetRefinement is {elem \in setVal : pred(elem)}. Unless setVal is small, the result is lazy: quantifying over
+// it, or filtering or mapping it further, streams the elements of setVal without materializing the result, and pred
+// is only called as the elements are needed. Any other use materializes the result, calling pred on every element:
+// testing membership, comparing or hashing it, as when it is used as a map key, printing it, or encoding it, as when
+// a resource holding it commits or sends it. A panic raised by pred, e.g. because of a type error, surfaces at that
+// point, which may be after the critical section that built the set. A small setVal, of at most 64 elements, is
+// filtered right away, so that pred's panics surface where the set is built.
+func TLASetRefinement(setVal TLAValue, pred func(TLAValue) bool) TLAValue {
+	requireSet(setVal)
+	return forceIfSmall(makeTLALazySet(func(yield func(elem TLAValue) bool) bool {
+		return forEachElement(setVal, func(elem TLAValue) bool {
+			return !pred(elem) || yield(elem)
+		})
+	}, func() *immutable.Map {
+		if !isMaterialized(setVal) {
+			return nil
 		}
-
-		it := sets[idx].Iterator()
+		// a refinement often keeps most of a set, in which case deleting the rest shares most of it
+		set := setVal.AsSet()
+		var kept, rejected []TLAValue
+		it := set.Iterator()
 		for !it.Done() {
 			elem, _ := it.Next()
-			predArgs[idx] = elem.(TLAValue)
-			if helper(idx + 1) {
-				return true
+			if pred(elem.(TLAValue)) {
+				kept = append(kept, elem.(TLAValue))
+			} else {
+				rejected = append(rejected, elem.(TLAValue))
 			}
 		}
-		return false
-	}
-
-	return MakeTLABool(helper(0))
-}
-
-func TLASetRefinement(setVal TLAValue, pred func(TLAValue) bool) TLAValue {
-	set := setVal.AsSet()
-	builder := immutable.NewMapBuilder(TLAValueHasher{})
-	it := set.Iterator()
-	for !it.Done() {
-		elem, _ := it.Next()
-		if pred(elem.(TLAValue)) {
+		if len(rejected) < len(kept) {
+			for _, elem := range rejected {
+				set = set.Delete(elem)
+			}
+			return set
+		}
+		builder := immutable.NewMapBuilder(TLAValueHasher{})
+		for _, elem := range kept {
 			builder.Set(elem, true)
 		}
-	}
-	return TLAValue{&tlaValueSet{builder.Map()}}
+		return builder.Map()
+	}), setVal)
 }
 
+// TLASetComprehension is {body(args) : args \in setVals}. Like that of TLASetRefinement, the result is lazy unless
+// setVals have at most 64 combinations of elements, and body is only called as the elements are needed, so that its
+// panics surface wherever the result is first used in a way that materializes it.
 func TLASetComprehension(setVals []TLAValue, body func([]TLAValue) TLAValue) TLAValue {
-	var sets []*immutable.Map
 	for _, val := range setVals {
-		sets = append(sets, val.AsSet())
+		requireSet(val)
 	}
-
-	builder := immutable.NewMapBuilder(TLAValueHasher{})
-	bodyArgs := make([]TLAValue, len(sets))
-
-	var helper func(idx int)
-	helper = func(idx int) {
-		if idx == len(sets) {
-			builder.Set(body(bodyArgs), true)
-		} else {
-			it := sets[idx].Iterator()
-			for !it.Done() {
-				elem, _ := it.Next()
-				bodyArgs[idx] = elem.(TLAValue)
-				helper(idx + 1)
-			}
-		}
-	}
-
-	helper(0)
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return forceIfSmall(makeTLALazySet(func(yield func(elem TLAValue) bool) bool {
+		return forEachCombination(setVals, func(args []TLAValue) bool {
+			return yield(body(args))
+		})
+	}, nil), setVals...)
 }
 
 func TLACrossProduct(vs ...TLAValue) TLAValue {
 	var sets []*immutable.Map
+	var sizes []int
 	for _, v := range vs {
 		sets = append(sets, v.AsSet())
+		sizes = append(sizes, sets[len(sets)-1].Len())
 	}
+	requireMaterializable(sizes...)
 
 	builder := immutable.NewMapBuilder(TLAValueHasher{})
 
@@ -119,13 +93,13 @@ func TLACrossProduct(vs ...TLAValue) TLAValue {
 				helper(tuple.Append(elem), idx+1)
 			}
 		} else {
-			builder.Set(tuple, true)
+			builder.Set(TLAValue{&tlaValueTuple{List: tuple}}, true)
 		}
 	}
 
 	helper(immutable.NewList(), 0)
 
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return TLAValue{&tlaValueSet{Map: builder.Map()}}
 }
 
 type TLAFunctionSubstitutionRecord struct {
@@ -133,17 +107,26 @@ type TLAFunctionSubstitutionRecord struct {
 	Value func(anchor TLAValue) TLAValue
 }
 
+// TLAFunctionSubstitution is [source EXCEPT ![k1][k2]... = v, ...]. Each substitution may update a value nested in
+// functions and tuples any number of levels down, where the keys of a tuple are its 1-based indices. Only the
+// functions and tuples on the path to each updated value are copied, and every key must already be present.
 func TLAFunctionSubstitution(source TLAValue, substitutions []TLAFunctionSubstitutionRecord) TLAValue {
 	var keysHelper func(source TLAValue, keys []TLAValue, value func(anchor TLAValue) TLAValue) TLAValue
 	keysHelper = func(source TLAValue, keys []TLAValue, value func(anchor TLAValue) TLAValue) TLAValue {
 		if len(keys) == 0 {
 			return value(source)
+		} else if source.IsTuple() {
+			tuple := source.AsTuple()
+			idx := int(keys[0].AsNumber())
+			require(idx >= 1 && idx <= tuple.Len(), "invalid index during tuple substitution")
+			tupleList := tuple.Set(idx-1, keysHelper(tuple.Get(idx-1).(TLAValue), keys[1:], value))
+			return TLAValue{&tlaValueTuple{List: tupleList}}
 		} else {
 			sourceFn := source.AsFunction()
 			val, keyOk := sourceFn.Get(keys[0])
 			require(keyOk, "invalid key during function substitution")
 			sourceFn = sourceFn.Set(keys[0], keysHelper(val.(TLAValue), keys[1:], value))
-			return TLAValue{&tlaValueFunction{sourceFn}}
+			return TLAValue{&tlaValueFunction{Map: sourceFn}}
 		}
 	}
 	for _, substitution := range substitutions {
@@ -152,7 +135,36 @@ func TLAFunctionSubstitution(source TLAValue, substitutions []TLAFunctionSubstit
 	return source
 }
 
+// TLAFunctionUpdate is [source EXCEPT ![keys[0]][keys[1]]... = value], a shorthand for TLAFunctionSubstitution with a
+// single substitution that does not refer to the old value.
+func TLAFunctionUpdate(source TLAValue, keys []TLAValue, value TLAValue) TLAValue {
+	return TLAFunctionSubstitution(source, []TLAFunctionSubstitutionRecord{{
+		Keys:  keys,
+		Value: func(TLAValue) TLAValue { return value },
+	}})
+}
+
+// TLAFunctionRange is the set of the values of the function or tuple v, that is {v[x] : x \in DOMAIN v}.
+func TLAFunctionRange(v TLAValue) TLAValue {
+	builder := immutable.NewMapBuilder(TLAValueHasher{})
+	if v.IsTuple() {
+		it := v.AsTuple().Iterator()
+		for !it.Done() {
+			_, elem := it.Next()
+			builder.Set(elem, true)
+		}
+	} else {
+		it := v.AsFunction().Iterator()
+		for !it.Done() {
+			_, value := it.Next()
+			builder.Set(value, true)
+		}
+	}
+	return TLAValue{&tlaValueSet{Map: builder.Map()}}
+}
+
 func TLAChoose(setVal TLAValue, pred func(value TLAValue) bool) TLAValue {
+	// a lazy set is materialized, rather than streamed, so that the choice depends only on the elements of the set
 	set := setVal.AsSet()
 	it := set.Iterator()
 	for !it.Done() {
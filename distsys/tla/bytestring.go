@@ -0,0 +1,158 @@
+package tla
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/benbjohnson/immutable"
+)
+
+// tlaValueBytes is a sequence of numbers from 0 to 255, as made by MakeTLABytes, represented by a []byte, so that
+// protocols can move payloads through a model without the overhead of a tuple of numbers, which takes dozens of
+// bytes per element. It is equal to the tuple of its numbers, and Len, Head, Tail, SubSeq, \o, Append of a number
+// from 0 to 255, indexing and DOMAIN all use the []byte directly; Tail and SubSeq share it rather than copy it. Other
+// operators, as well as EXCEPT, materialize the tuple once, after which it behaves like any other tuple.
+type tlaValueBytes struct {
+	hashCache
+	data []byte // never modified, as it may be shared with other byte strings
+
+	lock sync.Mutex
+	list *immutable.List // nil until the tuple is materialized
+}
+
+var _ tlaValueImpl = &tlaValueBytes{}
+
+// MakeTLABytes makes the sequence of the bytes of data, as numbers. It does not retain data.
+func MakeTLABytes(data []byte) TLAValue {
+	return makeTLABytesNoCopy(append([]byte(nil), data...))
+}
+
+func makeTLABytesNoCopy(data []byte) TLAValue {
+	return TLAValue{&tlaValueBytes{data: data}}
+}
+
+// TryAsBytes returns the sequence of numbers from 0 to 255 v as a []byte, whether it was made by MakeTLABytes or
+// not. The result is a copy, which the caller may modify.
+func (v TLAValue) TryAsBytes() ([]byte, error) {
+	switch data := v.data.(type) {
+	case *tlaValueBytes:
+		return append([]byte(nil), data.data...), nil
+	case *tlaValueTuple:
+		result := make([]byte, 0, data.Len())
+		it := data.Iterator()
+		for !it.Done() {
+			_, elem := it.Next()
+			num, ok := elem.(TLAValue).data.(tlaValueNumber)
+			if !ok || num < 0 || num > 255 {
+				return nil, v.kindError("a sequence of bytes")
+			}
+			result = append(result, byte(num))
+		}
+		return result, nil
+	default:
+		return nil, v.kindError("a sequence of bytes")
+	}
+}
+
+func (v TLAValue) AsBytes() []byte {
+	data, err := v.TryAsBytes()
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// asByte returns v as a byte, if it is a number from 0 to 255.
+func asByte(v TLAValue) (byte, bool) {
+	num, ok := v.data.(tlaValueNumber)
+	return byte(num), ok && num >= 0 && num <= 255
+}
+
+func (v *tlaValueBytes) force() *immutable.List {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if v.list == nil {
+		builder := immutable.NewListBuilder()
+		for _, b := range v.data {
+			builder.Append(MakeTLANumber(int32(b)))
+		}
+		v.list = builder.List()
+	}
+	return v.list
+}
+
+// byteHashes are the hashes of the numbers from 0 to 255.
+var byteHashes = func() (result [256]uint32) {
+	for i := range result {
+		result[i] = MakeTLANumber(int32(i)).Hash()
+	}
+	return
+}()
+
+func (v *tlaValueBytes) Hash() uint32 {
+	// the same as the hash of the tuple
+	return v.cachedHash(func() uint32 {
+		h := fnv.New32()
+		var buf [4]byte
+		for _, b := range v.data {
+			binary.LittleEndian.PutUint32(buf[:], byteHashes[b])
+			_, _ = h.Write(buf[:])
+		}
+		return h.Sum32()
+	})
+}
+
+func (v *tlaValueBytes) Equal(other TLAValue) bool {
+	switch otherData := other.data.(type) {
+	case *tlaValueBytes:
+		return bytes.Equal(v.data, otherData.data)
+	case *tlaValueTuple:
+		if otherData.Len() != len(v.data) || v.Hash() != otherData.Hash() {
+			return false
+		}
+		it := otherData.Iterator()
+		for !it.Done() {
+			i, elem := it.Next()
+			if b, ok := asByte(elem.(TLAValue)); !ok || b != v.data[i] {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func (v *tlaValueBytes) String() string {
+	builder := strings.Builder{}
+	builder.WriteString("<<")
+	for i, b := range v.data {
+		if i != 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(strconv.Itoa(int(b)))
+	}
+	builder.WriteString(">>")
+	return builder.String()
+}
+
+func (v *tlaValueBytes) GobEncode() ([]byte, error) {
+	return v.data, nil
+}
+
+func (v *tlaValueBytes) GobDecode(input []byte) error {
+	v.data = append([]byte(nil), input...)
+	return nil
+}
+
+// seqLen returns the length of the sequence v, without materializing it if it is a byte string.
+func seqLen(v TLAValue) int {
+	if bs, ok := v.data.(*tlaValueBytes); ok {
+		return len(bs.data)
+	}
+	return v.AsTuple().Len()
+}
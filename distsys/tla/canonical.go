@@ -0,0 +1,405 @@
+package tla
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// ErrCanonicalEncoding is wrapped by the errors returned by DecodeCanonical on invalid input.
+var ErrCanonicalEncoding = errors.New("invalid canonical TLA+ value encoding")
+
+// the tags that start the canonical encoding of each kind of value
+const (
+	canonicalUndefined byte = iota
+	canonicalFalse
+	canonicalTrue
+	canonicalInteger
+	canonicalReal
+	canonicalString
+	canonicalTuple
+	canonicalSet
+	canonicalFunction
+	canonicalCustom
+)
+
+// EncodeCanonical encodes v such that values are equal if and only if their encodings are, regardless of how they
+// were built or of the iteration order of sets and functions. The encoding is compact, and will not change across
+// versions, so it can be hashed, used to address content, or used as a storage key. DecodeCanonical decodes it.
+//
+// Each value is encoded as a tag byte followed by its contents, where lengths and counts are unsigned varints:
+//   - the undefined value, FALSE and TRUE are just tags;
+//   - an integer is a sign byte, 0 for non-negative or 1 for negative, then the length of its magnitude, then its
+//     magnitude, big-endian, without leading zeros, so that 0 has an empty magnitude;
+//   - a real number that is not an integer is its numerator, encoded as an integer without the tag, then its
+//     denominator, likewise, in lowest terms;
+//   - a string is its length and bytes;
+//   - a tuple, including a byte string, is its length and elements;
+//   - a set is its size and the encodings of its elements, sorted bytewise;
+//   - a function, including a record, is its size, then each key followed by its value, sorted by the encoding of
+//     the key;
+//   - a custom value is its kind, as a string without the tag, then the result of its MarshalBinary method, also as
+//     a string without the tag. Custom values are only canonically encoded if that result is the same for all equal
+//     values.
+func EncodeCanonical(v TLAValue) ([]byte, error) {
+	return appendCanonical(nil, v)
+}
+
+func appendUvarint(buf []byte, n uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	return append(buf, tmp[:binary.PutUvarint(tmp[:], n)]...)
+}
+
+func appendCanonicalInteger(buf []byte, num *big.Int) []byte {
+	if num.Sign() < 0 {
+		buf = append(buf, 1)
+	} else {
+		buf = append(buf, 0)
+	}
+	magnitude := new(big.Int).Abs(num).Bytes()
+	buf = appendUvarint(buf, uint64(len(magnitude)))
+	return append(buf, magnitude...)
+}
+
+func appendCanonicalBytes(buf []byte, data []byte) []byte {
+	buf = appendUvarint(buf, uint64(len(data)))
+	return append(buf, data...)
+}
+
+// canonicalByteNumbers are the encodings of the numbers from 0 to 255, the elements of byte strings.
+var canonicalByteNumbers = func() (result [256][]byte) {
+	for i := range result {
+		result[i] = appendCanonicalInteger([]byte{canonicalInteger}, big.NewInt(int64(i)))
+	}
+	return
+}()
+
+func appendCanonical(buf []byte, v TLAValue) ([]byte, error) {
+	var err error
+	switch data := v.data.(type) {
+	case nil:
+		return append(buf, canonicalUndefined), nil
+	case tlaValueBool:
+		if data {
+			return append(buf, canonicalTrue), nil
+		}
+		return append(buf, canonicalFalse), nil
+	case tlaValueNumber, *tlaValueBigNumber:
+		return appendCanonicalInteger(append(buf, canonicalInteger), v.AsBigNumber()), nil
+	case *tlaValueReal:
+		buf = appendCanonicalInteger(append(buf, canonicalReal), data.value.Num())
+		return appendCanonicalInteger(buf, data.value.Denom()), nil
+	case tlaValueString:
+		return appendCanonicalBytes(append(buf, canonicalString), []byte(data)), nil
+	case *tlaValueBytes:
+		// the same as the tuple of its numbers
+		buf = appendUvarint(append(buf, canonicalTuple), uint64(len(data.data)))
+		for _, b := range data.data {
+			buf = append(buf, canonicalByteNumbers[b]...)
+		}
+		return buf, nil
+	case *tlaValueTuple:
+		buf = appendUvarint(append(buf, canonicalTuple), uint64(data.Len()))
+		it := data.Iterator()
+		for !it.Done() {
+			_, elem := it.Next()
+			buf, err = appendCanonical(buf, elem.(TLAValue))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case *tlaValueSet, *tlaValueLazySet, *tlaValueInterval:
+		var elems [][]byte
+		forEachElement(v, func(elem TLAValue) bool {
+			var encoded []byte
+			encoded, err = appendCanonical(nil, elem)
+			elems = append(elems, encoded)
+			return err == nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(elems, func(i, j int) bool {
+			return bytes.Compare(elems[i], elems[j]) < 0
+		})
+		// the elements of a lazy set may be generated more than once
+		deduplicated := elems[:0]
+		for i, elem := range elems {
+			if i == 0 || !bytes.Equal(elem, elems[i-1]) {
+				deduplicated = append(deduplicated, elem)
+			}
+		}
+		elems = deduplicated
+		buf = appendUvarint(append(buf, canonicalSet), uint64(len(elems)))
+		for _, elem := range elems {
+			buf = append(buf, elem...)
+		}
+		return buf, nil
+	case *tlaValueFunction:
+		type pair struct {
+			key, value []byte
+		}
+		pairs := make([]pair, 0, data.Len())
+		it := data.Iterator()
+		for !it.Done() {
+			key, value := it.Next()
+			var p pair
+			p.key, err = appendCanonical(nil, key.(TLAValue))
+			if err != nil {
+				return nil, err
+			}
+			p.value, err = appendCanonical(nil, value.(TLAValue))
+			if err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, p)
+		}
+		sort.Slice(pairs, func(i, j int) bool {
+			return bytes.Compare(pairs[i].key, pairs[j].key) < 0
+		})
+		buf = appendUvarint(append(buf, canonicalFunction), uint64(len(pairs)))
+		for _, p := range pairs {
+			buf = append(append(buf, p.key...), p.value...)
+		}
+		return buf, nil
+	case *tlaValueCustom:
+		marshaled, err := data.value.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		buf = appendCanonicalBytes(append(buf, canonicalCustom), []byte(data.value.TLAKind()))
+		return appendCanonicalBytes(buf, marshaled), nil
+	default:
+		return nil, fmt.Errorf("%w: cannot encode %v canonically", ErrTLAType, v)
+	}
+}
+
+// DecodeCanonical decodes a value encoded by EncodeCanonical. It rejects any input that EncodeCanonical could not
+// have produced, such as sets whose elements are out of order, so that each value has exactly one encoding, as well
+// as values beyond the limits set by SetTLAValueLimits.
+func DecodeCanonical(data []byte) (TLAValue, error) {
+	d := canonicalDecoder{data: data, budget: newValueBudget()}
+	v, err := d.value()
+	if err != nil {
+		return TLAValue{}, err
+	}
+	if d.pos != len(d.data) {
+		return TLAValue{}, d.errorf("%d bytes after the value", len(d.data)-d.pos)
+	}
+	return v, nil
+}
+
+type canonicalDecoder struct {
+	data   []byte
+	pos    int
+	depth  int // how deeply the value being decoded is nested
+	budget *valueBudget
+}
+
+func (d *canonicalDecoder) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w at offset %d: %s", ErrCanonicalEncoding, d.pos, fmt.Sprintf(format, args...))
+}
+
+func (d *canonicalDecoder) byte() (byte, error) {
+	if d.pos == len(d.data) {
+		return 0, d.errorf("unexpected end of input")
+	}
+	b := d.data[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *canonicalDecoder) uvarint() (uint64, error) {
+	n, size := binary.Uvarint(d.data[d.pos:])
+	if size <= 0 {
+		return 0, d.errorf("invalid length")
+	}
+	// a varint with redundant trailing zero groups would be another encoding of the same number
+	if size > 1 && d.data[d.pos+size-1] == 0 {
+		return 0, d.errorf("non-minimal length")
+	}
+	d.pos += size
+	return n, nil
+}
+
+func (d *canonicalDecoder) bytes() ([]byte, error) {
+	n, err := d.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(d.data)-d.pos) {
+		return nil, d.errorf("length %d is past the end of the input", n)
+	}
+	result := d.data[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return result, nil
+}
+
+// count reads the number of elements of a collection, each of which takes at least one byte, so that a corrupt
+// count cannot cause a huge allocation. Each element is made of perValue values.
+func (d *canonicalDecoder) count(perValue int) (int, error) {
+	n, err := d.uvarint()
+	if err != nil {
+		return 0, err
+	}
+	if n > uint64(len(d.data)-d.pos) {
+		return 0, d.errorf("%d elements cannot fit in the rest of the input", n)
+	}
+	err = d.budget.expect(int(n) * perValue)
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+func (d *canonicalDecoder) integer() (*big.Int, error) {
+	sign, err := d.byte()
+	if err != nil {
+		return nil, err
+	}
+	magnitude, err := d.bytes()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case sign > 1:
+		return nil, d.errorf("invalid sign %d", sign)
+	case len(magnitude) != 0 && magnitude[0] == 0:
+		return nil, d.errorf("integer with leading zeros")
+	case len(magnitude) == 0 && sign == 1:
+		return nil, d.errorf("negative zero")
+	}
+	num := new(big.Int).SetBytes(magnitude)
+	if sign == 1 {
+		num.Neg(num)
+	}
+	return num, nil
+}
+
+// element decodes the next element of a collection, and checks that its encoding comes strictly after prev's, if
+// it is not nil.
+func (d *canonicalDecoder) element(prev []byte) (TLAValue, []byte, error) {
+	start := d.pos
+	elem, err := d.value()
+	if err != nil {
+		return TLAValue{}, nil, err
+	}
+	encoded := d.data[start:d.pos]
+	if prev != nil && bytes.Compare(prev, encoded) >= 0 {
+		return TLAValue{}, nil, d.errorf("elements are out of order or duplicated")
+	}
+	return elem, encoded, nil
+}
+
+func (d *canonicalDecoder) value() (TLAValue, error) {
+	err := d.budget.enter(d.depth)
+	if err != nil {
+		return TLAValue{}, err
+	}
+	d.depth++
+	defer func() {
+		d.depth--
+	}()
+	tag, err := d.byte()
+	if err != nil {
+		return TLAValue{}, err
+	}
+	switch tag {
+	case canonicalUndefined:
+		return TLAValue{}, nil
+	case canonicalFalse:
+		return TLA_FALSE, nil
+	case canonicalTrue:
+		return TLA_TRUE, nil
+	case canonicalInteger:
+		num, err := d.integer()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		return MakeTLABigNumber(num), nil
+	case canonicalReal:
+		num, err := d.integer()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		denom, err := d.integer()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		if denom.Cmp(big.NewInt(1)) <= 0 {
+			return TLAValue{}, d.errorf("denominator %v is not greater than 1", denom)
+		}
+		if new(big.Int).GCD(nil, nil, new(big.Int).Abs(num), denom).Cmp(big.NewInt(1)) != 0 {
+			return TLAValue{}, d.errorf("%v / %v is not in lowest terms", num, denom)
+		}
+		return MakeTLAReal(new(big.Rat).SetFrac(num, denom)), nil
+	case canonicalString:
+		str, err := d.bytes()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		return MakeTLAString(string(str)), nil
+	case canonicalTuple:
+		n, err := d.count(1)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		elems := make([]TLAValue, n)
+		for i := range elems {
+			elems[i], err = d.value()
+			if err != nil {
+				return TLAValue{}, err
+			}
+		}
+		return MakeTLATuple(elems...), nil
+	case canonicalSet:
+		n, err := d.count(1)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		elems := make([]TLAValue, n)
+		var prev []byte
+		for i := range elems {
+			elems[i], prev, err = d.element(prev)
+			if err != nil {
+				return TLAValue{}, err
+			}
+		}
+		return MakeTLASet(elems...), nil
+	case canonicalFunction:
+		n, err := d.count(2)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		fields := make([]TLARecordField, n)
+		var prev []byte
+		for i := range fields {
+			fields[i].Key, prev, err = d.element(prev)
+			if err != nil {
+				return TLAValue{}, err
+			}
+			fields[i].Value, err = d.value()
+			if err != nil {
+				return TLAValue{}, err
+			}
+		}
+		return MakeTLARecord(fields), nil
+	case canonicalCustom:
+		kind, err := d.bytes()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		marshaled, err := d.bytes()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		return unmarshalTLACustomValue(string(kind), append([]byte(nil), marshaled...))
+	default:
+		d.pos--
+		return TLAValue{}, d.errorf("invalid tag %d", tag)
+	}
+}
@@ -0,0 +1,137 @@
+package tla
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"hash/fnv"
+	"sync"
+)
+
+// TLACustomValue is implemented by Go values that applications embed in TLAValues, with MakeTLACustomValue, so that
+// resources can pass native data such as UUIDs, byte blobs or keys through a model, which treats them like model
+// values: opaque, and comparable only for equality.
+//
+// Each kind of custom value must be registered with RegisterTLACustomKind before values of that kind are decoded,
+// on every node that may receive them.
+type TLACustomValue interface {
+	// TLAKind returns the name the kind of the value was registered with. Values of different kinds are never equal.
+	TLAKind() string
+	// Equal reports whether the value is equal to other, which is of the same kind.
+	Equal(other TLACustomValue) bool
+	// Hash returns a hash of the value, which must be the same for equal values.
+	Hash() uint32
+	String() string
+	// MarshalBinary encodes the value, to be decoded by the function it was registered with.
+	MarshalBinary() ([]byte, error)
+}
+
+var tlaCustomKinds = struct {
+	lock       sync.RWMutex
+	unmarshals map[string]func(data []byte) (TLACustomValue, error)
+}{unmarshals: make(map[string]func(data []byte) (TLACustomValue, error))}
+
+// RegisterTLACustomKind registers the kind of custom values named kind, and unmarshal, which decodes the encoding of a
+// value of that kind made by its MarshalBinary method. It panics if kind is already registered.
+func RegisterTLACustomKind(kind string, unmarshal func(data []byte) (TLACustomValue, error)) {
+	tlaCustomKinds.lock.Lock()
+	defer tlaCustomKinds.lock.Unlock()
+	if _, ok := tlaCustomKinds.unmarshals[kind]; ok {
+		panic(fmt.Errorf("custom TLA+ value kind %q is already registered", kind))
+	}
+	tlaCustomKinds.unmarshals[kind] = unmarshal
+}
+
+func unmarshalTLACustomValue(kind string, data []byte) (TLAValue, error) {
+	tlaCustomKinds.lock.RLock()
+	unmarshal, ok := tlaCustomKinds.unmarshals[kind]
+	tlaCustomKinds.lock.RUnlock()
+	if !ok {
+		return TLAValue{}, fmt.Errorf("%w: custom value of unregistered kind %q", ErrTLAType, kind)
+	}
+	value, err := unmarshal(data)
+	if err != nil {
+		return TLAValue{}, fmt.Errorf("could not decode custom value of kind %q: %w", kind, err)
+	}
+	if value.TLAKind() != kind {
+		return TLAValue{}, fmt.Errorf("%w: custom value of kind %q was decoded as kind %q", ErrTLAType, kind, value.TLAKind())
+	}
+	return MakeTLACustomValue(value), nil
+}
+
+type tlaValueCustom struct {
+	value TLACustomValue
+}
+
+var _ tlaValueImpl = &tlaValueCustom{}
+
+func MakeTLACustomValue(value TLACustomValue) TLAValue {
+	return TLAValue{&tlaValueCustom{value: value}}
+}
+
+func (v TLAValue) IsCustom() bool {
+	_, ok := v.data.(*tlaValueCustom)
+	return ok
+}
+
+func (v TLAValue) TryAsCustom() (TLACustomValue, error) {
+	switch data := v.data.(type) {
+	case *tlaValueCustom:
+		return data.value, nil
+	default:
+		return nil, v.kindError("a custom value")
+	}
+}
+
+func (v TLAValue) AsCustom() TLACustomValue {
+	value, err := v.TryAsCustom()
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func (v *tlaValueCustom) Hash() uint32 {
+	// mix in the kind, so that values of different kinds with the same hash do not collide
+	h := fnv.New32()
+	_, _ = h.Write([]byte(v.value.TLAKind()))
+	return h.Sum32() ^ v.value.Hash()
+}
+
+func (v *tlaValueCustom) Equal(other TLAValue) bool {
+	otherCustom, ok := other.data.(*tlaValueCustom)
+	return ok && v.value.TLAKind() == otherCustom.value.TLAKind() && v.value.Equal(otherCustom.value)
+}
+
+func (v *tlaValueCustom) String() string {
+	return v.value.String()
+}
+
+type tlaValueCustomEncoding struct {
+	Kind string `json:"kind"`
+	Data []byte `json:"data"`
+}
+
+func (v *tlaValueCustom) GobEncode() ([]byte, error) {
+	data, err := v.value.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	err = gob.NewEncoder(&buf).Encode(tlaValueCustomEncoding{Kind: v.value.TLAKind(), Data: data})
+	return buf.Bytes(), err
+}
+
+func (v *tlaValueCustom) GobDecode(input []byte) error {
+	var encoding tlaValueCustomEncoding
+	err := gob.NewDecoder(bytes.NewReader(input)).Decode(&encoding)
+	if err != nil {
+		return err
+	}
+	decoded, err := unmarshalTLACustomValue(encoding.Kind, encoding.Data)
+	if err != nil {
+		return err
+	}
+	*v = *decoded.data.(*tlaValueCustom)
+	return nil
+}
@@ -0,0 +1,156 @@
+package tla
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/benbjohnson/immutable"
+)
+
+// TLADiffKind is the kind of a TLADifference.
+type TLADiffKind int
+
+const (
+	// TLADiffChanged means that the values A and B at Path differ, in a way that is not broken down any further,
+	// e.g. because they are different numbers, or values of different kinds.
+	TLADiffChanged TLADiffKind = iota
+	// TLADiffOnlyInA means that the key at the end of Path, of a function or tuple, is only in a, where its value
+	// is A.
+	TLADiffOnlyInA
+	// TLADiffOnlyInB is like TLADiffOnlyInA, for a key that is only in b, where its value is B.
+	TLADiffOnlyInB
+	// TLADiffElementOnlyInA means that A is an element of the set at Path in a, but not in b.
+	TLADiffElementOnlyInA
+	// TLADiffElementOnlyInB means that B is an element of the set at Path in b, but not in a.
+	TLADiffElementOnlyInB
+)
+
+// TLADifference is one of the differences between two values found by Diff.
+type TLADifference struct {
+	Kind TLADiffKind
+	// Path is the keys of the functions and the 1-based indices of the tuples that lead from the values compared to
+	// where they differ. It is empty if the values differ as a whole.
+	Path []TLAValue
+	// A and B are the values that differ, from a and b respectively. Only one of them is set if the difference is
+	// on one side only.
+	A, B TLAValue
+}
+
+func (d TLADifference) String() string {
+	var builder strings.Builder
+	for _, key := range d.Path {
+		if key.IsString() && isFormattableIdentifier(key.AsString()) {
+			builder.WriteString(".")
+			builder.WriteString(key.AsString())
+		} else {
+			builder.WriteString("[")
+			formatTo(&builder, key)
+			builder.WriteString("]")
+		}
+	}
+	if len(d.Path) != 0 {
+		builder.WriteString(": ")
+	}
+	switch d.Kind {
+	case TLADiffChanged:
+		_, _ = fmt.Fprintf(&builder, "%s in a, %s in b", Format(d.A), Format(d.B))
+	case TLADiffOnlyInA:
+		_, _ = fmt.Fprintf(&builder, "%s in a, missing in b", Format(d.A))
+	case TLADiffOnlyInB:
+		_, _ = fmt.Fprintf(&builder, "missing in a, %s in b", Format(d.B))
+	case TLADiffElementOnlyInA:
+		_, _ = fmt.Fprintf(&builder, "element %s only in a", Format(d.A))
+	case TLADiffElementOnlyInB:
+		_, _ = fmt.Fprintf(&builder, "element %s only in b", Format(d.B))
+	}
+	return builder.String()
+}
+
+// TLADiff is the list of differences between two values found by Diff.
+type TLADiff []TLADifference
+
+// String describes each difference on a line of its own.
+func (diff TLADiff) String() string {
+	lines := make([]string, len(diff))
+	for i, d := range diff {
+		lines[i] = d.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Diff describes where a and b differ, for instance to explain why a test's result is not what it expected. It
+// returns nil if they are equal. Functions, including records, and tuples are compared key by key, so that each
+// missing key and differing value is described in turn, and sets are compared element by element. The elements of
+// sets are not compared any further, as there is no telling which element of one set should be compared with which
+// element of the other. Differences are listed in the order of Format.
+func Diff(a, b TLAValue) TLADiff {
+	var diff TLADiff
+	diffTo(&diff, nil, a, b)
+	return diff
+}
+
+func diffTo(diff *TLADiff, path []TLAValue, a, b TLAValue) {
+	if a.Equal(b) {
+		return
+	}
+	// each difference gets its own path, which appending to path must not overwrite
+	keyPath := func(key TLAValue) []TLAValue {
+		return append(path[:len(path):len(path)], key)
+	}
+	switch {
+	case a.IsTuple() && b.IsTuple():
+		aTuple, bTuple := a.AsTuple(), b.AsTuple()
+		for i := 0; i < aTuple.Len() || i < bTuple.Len(); i++ {
+			key := MakeTLANumber(int32(i + 1))
+			switch {
+			case i >= bTuple.Len():
+				*diff = append(*diff, TLADifference{Kind: TLADiffOnlyInA, Path: keyPath(key), A: aTuple.Get(i).(TLAValue)})
+			case i >= aTuple.Len():
+				*diff = append(*diff, TLADifference{Kind: TLADiffOnlyInB, Path: keyPath(key), B: bTuple.Get(i).(TLAValue)})
+			default:
+				diffTo(diff, keyPath(key), aTuple.Get(i).(TLAValue), bTuple.Get(i).(TLAValue))
+			}
+		}
+	case a.IsFunction() && b.IsFunction():
+		aFn, bFn := a.AsFunction(), b.AsFunction()
+		keys := immutable.NewMapBuilder(TLAValueHasher{})
+		for _, fn := range []*immutable.Map{aFn, bFn} {
+			it := fn.Iterator()
+			for !it.Done() {
+				key, _ := it.Next()
+				keys.Set(key, true)
+			}
+		}
+		for _, key := range sortedForFormat(keys.Map()) {
+			aValue, inA := aFn.Get(key.value)
+			bValue, inB := bFn.Get(key.value)
+			switch {
+			case !inB:
+				*diff = append(*diff, TLADifference{Kind: TLADiffOnlyInA, Path: keyPath(key.value), A: aValue.(TLAValue)})
+			case !inA:
+				*diff = append(*diff, TLADifference{Kind: TLADiffOnlyInB, Path: keyPath(key.value), B: bValue.(TLAValue)})
+			default:
+				diffTo(diff, keyPath(key.value), aValue.(TLAValue), bValue.(TLAValue))
+			}
+		}
+	case a.IsSet() && b.IsSet():
+		elems := immutable.NewMapBuilder(TLAValueHasher{})
+		for _, set := range []TLAValue{a, b} {
+			forEachElement(set, func(elem TLAValue) bool {
+				elems.Set(elem, true)
+				return true
+			})
+		}
+		for _, elem := range sortedForFormat(elems.Map()) {
+			inA, inB := setContains(a, elem.value), setContains(b, elem.value)
+			switch {
+			case !inB:
+				*diff = append(*diff, TLADifference{Kind: TLADiffElementOnlyInA, Path: path, A: elem.value})
+			case !inA:
+				*diff = append(*diff, TLADifference{Kind: TLADiffElementOnlyInB, Path: path, B: elem.value})
+			}
+		}
+	default:
+		*diff = append(*diff, TLADifference{Kind: TLADiffChanged, Path: path, A: a, B: b})
+	}
+}
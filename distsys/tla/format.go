@@ -0,0 +1,553 @@
+package tla
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/benbjohnson/immutable"
+)
+
+// ErrTLAParse is wrapped by the errors returned by Parse.
+var ErrTLAParse = errors.New("TLA+ parse error")
+
+// Format returns v in TLA+ concrete syntax, which Parse reads back. The result is canonical: equal values are
+// formatted identically, with the elements of sets and the keys of functions in a fixed order, so that it can be
+// compared verbatim, e.g. in test expectations. Sets are always written out in full, including intervals.
+//
+// Non-empty functions whose keys are all identifiers are formatted as records, [a |-> 1, b |-> 2], other non-empty
+// functions as (k1 :> v1 @@ k2 :> v2), and the empty function as [x \in {} |-> x]. Real numbers that are not
+// integers are formatted as fractions in lowest terms, (-3 / 4). The undefined value is formatted as
+// defaultInitValue, and custom values by their String method, so they cannot be parsed back.
+func Format(v TLAValue) string {
+	var builder strings.Builder
+	formatTo(&builder, v)
+	return builder.String()
+}
+
+func formatTo(builder *strings.Builder, v TLAValue) {
+	switch {
+	case v.data == nil:
+		builder.WriteString("defaultInitValue")
+	case v.IsBool():
+		if v.AsBool() {
+			builder.WriteString("TRUE")
+		} else {
+			builder.WriteString("FALSE")
+		}
+	case v.IsNumber():
+		builder.WriteString(v.AsBigNumber().String())
+	case v.IsReal():
+		num := v.AsReal()
+		_, _ = fmt.Fprintf(builder, "(%s / %s)", num.Num(), num.Denom())
+	case v.IsString():
+		formatString(builder, v.AsString())
+	case v.IsSet():
+		builder.WriteString("{")
+		for i, elem := range sortedForFormat(v.AsSet()) {
+			if i != 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(elem.formatted)
+		}
+		builder.WriteString("}")
+	case v.IsTuple():
+		builder.WriteString("<<")
+		it := v.AsTuple().Iterator()
+		for !it.Done() {
+			i, elem := it.Next()
+			if i != 0 {
+				builder.WriteString(", ")
+			}
+			formatTo(builder, elem.(TLAValue))
+		}
+		builder.WriteString(">>")
+	case v.IsFunction():
+		fn := v.AsFunction()
+		if fn.Len() == 0 {
+			builder.WriteString(`[x \in {} |-> x]`)
+			return
+		}
+		isRecord := true
+		it := fn.Iterator()
+		for !it.Done() {
+			key, _ := it.Next()
+			keyV := key.(TLAValue)
+			isRecord = isRecord && keyV.IsString() && isFormattableIdentifier(keyV.AsString())
+		}
+		keys := sortedForFormat(fn)
+		if isRecord {
+			builder.WriteString("[")
+			for i, key := range keys {
+				if i != 0 {
+					builder.WriteString(", ")
+				}
+				builder.WriteString(key.value.AsString())
+				builder.WriteString(" |-> ")
+				value, _ := fn.Get(key.value)
+				formatTo(builder, value.(TLAValue))
+			}
+			builder.WriteString("]")
+		} else {
+			builder.WriteString("(")
+			for i, key := range keys {
+				if i != 0 {
+					builder.WriteString(" @@ ")
+				}
+				builder.WriteString(key.formatted)
+				builder.WriteString(" :> ")
+				value, _ := fn.Get(key.value)
+				formatTo(builder, value.(TLAValue))
+			}
+			builder.WriteString(")")
+		}
+	default:
+		builder.WriteString(v.String())
+	}
+}
+
+func formatString(builder *strings.Builder, str string) {
+	builder.WriteByte('"')
+	for i := 0; i < len(str); i++ {
+		switch c := str[i]; c {
+		case '"', '\\':
+			builder.WriteByte('\\')
+			builder.WriteByte(c)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\t':
+			builder.WriteString(`\t`)
+		case '\r':
+			builder.WriteString(`\r`)
+		case '\f':
+			builder.WriteString(`\f`)
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	builder.WriteByte('"')
+}
+
+// tlaReservedWords cannot be used as record fields.
+var tlaReservedWords = map[string]bool{
+	"ASSUME": true, "ASSUMPTION": true, "AXIOM": true, "BOOLEAN": true, "CASE": true, "CHOOSE": true,
+	"CONSTANT": true, "CONSTANTS": true, "DOMAIN": true, "ELSE": true, "ENABLED": true, "EXCEPT": true,
+	"EXTENDS": true, "FALSE": true, "IF": true, "IN": true, "INSTANCE": true, "LAMBDA": true, "LET": true,
+	"LOCAL": true, "MODULE": true, "OTHER": true, "STRING": true, "SUBSET": true, "THEN": true, "THEOREM": true,
+	"TRUE": true, "UNCHANGED": true, "UNION": true, "VARIABLE": true, "VARIABLES": true, "WITH": true,
+	"defaultInitValue": true,
+}
+
+func isFormattableIdentifier(str string) bool {
+	if str == "" || tlaReservedWords[str] || strings.HasPrefix(str, "WF_") || strings.HasPrefix(str, "SF_") {
+		return false
+	}
+	for i, c := range str {
+		switch {
+		case c > unicode.MaxASCII:
+			return false
+		case unicode.IsLetter(c) || c == '_':
+		case unicode.IsDigit(c) && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+type formattedValue struct {
+	value     TLAValue
+	formatted string
+}
+
+// formatRank orders the kinds of values in sets and function domains: booleans, then numbers, strings, tuples, sets
+// and functions.
+func formatRank(v TLAValue) int {
+	switch {
+	case v.IsBool():
+		return 0
+	case v.IsReal():
+		return 1
+	case v.IsString():
+		return 2
+	case v.IsTuple():
+		return 3
+	case v.IsSet():
+		return 4
+	case v.IsFunction():
+		return 5
+	default:
+		return 6
+	}
+}
+
+// sortedForFormat formats the keys of a set or function, in canonical order. Values of the same kind are ordered
+// naturally if they are booleans, numbers or strings, or else by their formatting.
+func sortedForFormat(m *immutable.Map) []formattedValue {
+	var result []formattedValue
+	it := m.Iterator()
+	for !it.Done() {
+		key, _ := it.Next()
+		result = append(result, formattedValue{value: key.(TLAValue), formatted: Format(key.(TLAValue))})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		lhs, rhs := result[i].value, result[j].value
+		lhsRank, rhsRank := formatRank(lhs), formatRank(rhs)
+		switch {
+		case lhsRank != rhsRank:
+			return lhsRank < rhsRank
+		case lhs.IsBool():
+			return !lhs.AsBool() && rhs.AsBool()
+		case lhs.IsReal():
+			return lhs.AsReal().Cmp(rhs.AsReal()) < 0
+		case lhs.IsString():
+			return lhs.AsString() < rhs.AsString()
+		default:
+			return result[i].formatted < result[j].formatted
+		}
+	})
+	return result
+}
+
+// Parse reads a value in the TLA+ concrete syntax produced by Format. As well as Format's output, it accepts any
+// layout of whitespace, elements of sets and fields of records in any order, decimal real numbers such as 0.75,
+// intervals a .. b, and any parenthesization. Errors wrap ErrTLAParse, except that input nested more deeply than
+// the limits set by SetTLAValueLimits allow fails with an error wrapping ErrTLAValueLimit.
+func Parse(input string) (TLAValue, error) {
+	p := tlaParser{input: input, budget: newValueBudget()}
+	result, err := p.parseExpr()
+	if err != nil {
+		return TLAValue{}, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.input) {
+		return TLAValue{}, p.errorf("unexpected %q after the value", p.rest())
+	}
+	return result, nil
+}
+
+type tlaParser struct {
+	input  string
+	pos    int
+	depth  int // how deeply the expression being parsed is nested
+	budget *valueBudget
+}
+
+func (p *tlaParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w at offset %d: %s", ErrTLAParse, p.pos, fmt.Sprintf(format, args...))
+}
+
+// rest returns a short prefix of the remaining input, for error messages.
+func (p *tlaParser) rest() string {
+	rest := p.input[p.pos:]
+	if len(rest) > 16 {
+		rest = rest[:16] + "..."
+	}
+	return rest
+}
+
+func (p *tlaParser) skipSpace() {
+	for p.pos < len(p.input) && strings.IndexByte(" \t\r\n", p.input[p.pos]) != -1 {
+		p.pos++
+	}
+}
+
+// accept skips token if it is next, and reports whether it was.
+func (p *tlaParser) accept(token string) bool {
+	p.skipSpace()
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *tlaParser) expect(token string) error {
+	if !p.accept(token) {
+		if p.pos == len(p.input) {
+			return p.errorf("expected %q, but the input ended", token)
+		}
+		return p.errorf("expected %q, but found %q", token, p.rest())
+	}
+	return nil
+}
+
+func (p *tlaParser) identifier() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := rune(p.input[p.pos])
+		if c > unicode.MaxASCII || !(unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_') {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+// parseExpr parses f1 @@ f2 @@ ..., where each operand is parsed by parsePair.
+func (p *tlaParser) parseExpr() (TLAValue, error) {
+	err := p.budget.enter(p.depth)
+	if err != nil {
+		return TLAValue{}, err
+	}
+	p.depth++
+	defer func() {
+		p.depth--
+	}()
+	result, err := p.parsePair()
+	if err != nil {
+		return TLAValue{}, err
+	}
+	for p.accept("@@") {
+		start := p.pos
+		rhs, err := p.parsePair()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		if !(result.IsFunction() || result.IsTuple()) || !(rhs.IsFunction() || rhs.IsTuple()) {
+			p.pos = start
+			return TLAValue{}, p.errorf("the operands of @@ must be functions, but got %v and %v", result, rhs)
+		}
+		result = TLA_DoubleAtSignSymbol(result, rhs)
+	}
+	return result, nil
+}
+
+// parsePair parses k :> v, or just k.
+func (p *tlaParser) parsePair() (TLAValue, error) {
+	key, err := p.parseTerm()
+	if err != nil || !p.accept(":>") {
+		return key, err
+	}
+	value, err := p.parseTerm()
+	if err != nil {
+		return TLAValue{}, err
+	}
+	return TLA_ColonGreaterThanSymbol(key, value), nil
+}
+
+// parseTerm parses a / b and a .. b, or just a.
+func (p *tlaParser) parseTerm() (TLAValue, error) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return TLAValue{}, err
+	}
+	start := p.pos
+	switch {
+	case p.accept(".."):
+		rhs, err := p.parseUnary()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		if !lhs.IsNumber() || !rhs.IsNumber() {
+			p.pos = start
+			return TLAValue{}, p.errorf("the bounds of an interval must be integers, but got %v and %v", lhs, rhs)
+		}
+		return TLA_DotDotSymbol(lhs, rhs), nil
+	case p.accept("/"):
+		rhs, err := p.parseUnary()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		if !lhs.IsReal() || !rhs.IsReal() || rhs.Equal(TLA_Zero) {
+			p.pos = start
+			return TLAValue{}, p.errorf("cannot divide %v by %v", lhs, rhs)
+		}
+		return TLA_SlashSymbol(lhs, rhs), nil
+	default:
+		return lhs, nil
+	}
+}
+
+func (p *tlaParser) parseUnary() (TLAValue, error) {
+	start := p.pos
+	if p.accept("-") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		if !operand.IsReal() {
+			p.pos = start
+			return TLAValue{}, p.errorf("cannot negate %v", operand)
+		}
+		return TLA_NegationSymbol(operand), nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *tlaParser) parsePrimary() (TLAValue, error) {
+	p.skipSpace()
+	if p.pos == len(p.input) {
+		return TLAValue{}, p.errorf("expected a value, but the input ended")
+	}
+	switch c := p.input[p.pos]; {
+	case c == '"':
+		return p.parseString()
+	case c >= '0' && c <= '9':
+		return p.parseNumber()
+	case p.accept("("):
+		result, err := p.parseExpr()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		return result, p.expect(")")
+	case p.accept("{"):
+		elems, err := p.parseList("}")
+		if err != nil {
+			return TLAValue{}, err
+		}
+		return MakeTLASet(elems...), nil
+	case p.accept("<<"):
+		elems, err := p.parseList(">>")
+		if err != nil {
+			return TLAValue{}, err
+		}
+		return MakeTLATuple(elems...), nil
+	case p.accept("["):
+		return p.parseRecord()
+	default:
+		start := p.pos
+		switch ident := p.identifier(); ident {
+		case "TRUE":
+			return TLA_TRUE, nil
+		case "FALSE":
+			return TLA_FALSE, nil
+		case "defaultInitValue":
+			return TLAValue{}, nil
+		default:
+			p.pos = start
+			return TLAValue{}, p.errorf("expected a value, but found %q", p.rest())
+		}
+	}
+}
+
+// parseList parses a possibly empty, comma-separated list of values, followed by end.
+func (p *tlaParser) parseList(end string) ([]TLAValue, error) {
+	var elems []TLAValue
+	if p.accept(end) {
+		return elems, nil
+	}
+	for {
+		elem, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+		if p.accept(end) {
+			return elems, nil
+		}
+		err = p.expect(",")
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseRecord parses [a |-> 1, b |-> 2] or [x \in {} |-> x], after the opening bracket.
+func (p *tlaParser) parseRecord() (TLAValue, error) {
+	var fields []TLARecordField
+	for {
+		start := p.pos
+		name := p.identifier()
+		if name == "" {
+			return TLAValue{}, p.errorf("expected a record field, but found %q", p.rest())
+		}
+		if len(fields) == 0 && p.accept(`\in`) {
+			// the empty function; its body is never evaluated, so it can be anything
+			if err := p.expect("{"); err != nil {
+				return TLAValue{}, err
+			}
+			if err := p.expect("}"); err != nil {
+				return TLAValue{}, err
+			}
+			if err := p.expect("|->"); err != nil {
+				return TLAValue{}, err
+			}
+			if body := p.identifier(); body == "" {
+				return TLAValue{}, p.errorf("expected the body of the empty function, but found %q", p.rest())
+			}
+			return MakeTLARecord(nil), p.expect("]")
+		}
+		if err := p.expect("|->"); err != nil {
+			return TLAValue{}, err
+		}
+		value, err := p.parseExpr()
+		if err != nil {
+			return TLAValue{}, err
+		}
+		for _, field := range fields {
+			if field.Key.AsString() == name {
+				p.pos = start
+				return TLAValue{}, p.errorf("duplicate record field %s", name)
+			}
+		}
+		fields = append(fields, TLARecordField{Key: MakeTLAString(name), Value: value})
+		if p.accept("]") {
+			return MakeTLARecord(fields), nil
+		}
+		if err := p.expect(","); err != nil {
+			return TLAValue{}, err
+		}
+	}
+}
+
+func (p *tlaParser) parseNumber() (TLAValue, error) {
+	start := p.pos
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+	// a decimal point must be followed by a digit, so as not to mistake an interval 1..2 for one
+	if p.pos+1 < len(p.input) && p.input[p.pos] == '.' && p.input[p.pos+1] >= '0' && p.input[p.pos+1] <= '9' {
+		p.pos++
+		for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+			p.pos++
+		}
+		num, _ := new(big.Rat).SetString(p.input[start:p.pos])
+		return MakeTLAReal(num), nil
+	}
+	num, _ := new(big.Int).SetString(p.input[start:p.pos], 10)
+	return MakeTLABigNumber(num), nil
+}
+
+func (p *tlaParser) parseString() (TLAValue, error) {
+	start := p.pos
+	p.pos++ // the opening quote
+	var builder strings.Builder
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return MakeTLAString(builder.String()), nil
+		case '\\':
+			if p.pos == len(p.input) {
+				break
+			}
+			escaped := p.input[p.pos]
+			p.pos++
+			switch escaped {
+			case '"', '\\':
+				builder.WriteByte(escaped)
+			case 'n':
+				builder.WriteByte('\n')
+			case 't':
+				builder.WriteByte('\t')
+			case 'r':
+				builder.WriteByte('\r')
+			case 'f':
+				builder.WriteByte('\f')
+			default:
+				p.pos -= 2
+				return TLAValue{}, p.errorf("invalid escape sequence \\%c", escaped)
+			}
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	p.pos = start
+	return TLAValue{}, p.errorf("unterminated string")
+}
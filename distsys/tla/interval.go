@@ -0,0 +1,200 @@
+package tla
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+
+	"github.com/benbjohnson/immutable"
+)
+
+// tlaValueInterval is the set of integers from..to, as made by TLA_DotDotSymbol, with from <= to. It is represented
+// by its bounds, so that membership, cardinality, quantification and sending it to other nodes take no more memory
+// than a pair of numbers, however large the interval. Operators that need the elements as an *immutable.Map, such
+// as union, materialize it once, after which it behaves like any other set.
+type tlaValueInterval struct {
+	hashCache
+	from, to int32
+
+	lock sync.Mutex
+	set  *immutable.Map // nil until the interval is materialized
+}
+
+var _ tlaValueImpl = &tlaValueInterval{}
+
+func makeTLAInterval(from, to int32) TLAValue {
+	if from > to {
+		return MakeTLASet()
+	}
+	return TLAValue{&tlaValueInterval{from: from, to: to}}
+}
+
+func (v *tlaValueInterval) len() int {
+	return int(int64(v.to) - int64(v.from) + 1)
+}
+
+func (v *tlaValueInterval) contains(elem TLAValue) bool {
+	num, ok := elem.data.(tlaValueNumber)
+	return ok && int32(num) >= v.from && int32(num) <= v.to
+}
+
+// forEach calls yield with each element in ascending order, until yield returns false, and reports whether it
+// reached the end of the interval.
+func (v *tlaValueInterval) forEach(yield func(elem TLAValue) bool) bool {
+	for i := int64(v.from); i <= int64(v.to); i++ {
+		if !yield(MakeTLANumber(int32(i))) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *tlaValueInterval) force() *immutable.Map {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if v.set == nil {
+		requireMaterializable(v.len())
+		builder := immutable.NewMapBuilder(TLAValueHasher{})
+		v.forEach(func(elem TLAValue) bool {
+			builder.Set(elem, true)
+			return true
+		})
+		v.set = builder.Map()
+	}
+	return v.set
+}
+
+func (v *tlaValueInterval) Hash() uint32 {
+	// the same as the hash of the materialized set, but computed in closed form, as the interval may be too large to
+	// enumerate
+	return v.cachedHash(func() uint32 {
+		// the sum of g^n over from..to, a geometric series
+		first := setHashNumberTerm(v.from)
+		ratioPow := setHashPow(setHashGenerator, uint64(int64(v.len())%(setHashModulus-1)))
+		sum := first * ((ratioPow + setHashModulus - 1) % setHashModulus) % setHashModulus
+		sum = sum * setHashGeneratorMinusOneInverse % setHashModulus
+		return finishSetHash(0, sum)
+	})
+}
+
+// Sets hash the elements that are numbers n by summing g^n modulo a prime, rather than XORing their hashes like
+// other elements, so that the hash of an interval has a closed form. Like XOR, the sum does not depend on the order
+// of the elements.
+const (
+	setHashModulus   = 1<<31 - 1 // prime
+	setHashGenerator = 7         // a primitive root modulo setHashModulus
+	// the inverse of setHashGenerator - 1 modulo setHashModulus, as 6 * 1789569706 = 5 * setHashModulus + 1
+	setHashGeneratorMinusOneInverse = 1789569706
+)
+
+// setHashPow returns base^exp modulo setHashModulus.
+func setHashPow(base, exp uint64) uint64 {
+	result := uint64(1)
+	base %= setHashModulus
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 != 0 {
+			result = result * base % setHashModulus
+		}
+		base = base * base % setHashModulus
+	}
+	return result
+}
+
+// setHashNumberTerm returns what the number n adds to the hash of a set containing it: g^n modulo setHashModulus,
+// where a negative n is reduced modulo the order of g.
+func setHashNumberTerm(n int32) uint64 {
+	exp := int64(n) % (setHashModulus - 1)
+	if exp < 0 {
+		exp += setHashModulus - 1
+	}
+	return setHashPow(setHashGenerator, uint64(exp))
+}
+
+// finishSetHash combines the XOR of the hashes of a set's elements other than numbers with the sum of the terms of
+// its numbers.
+func finishSetHash(xor uint32, sum uint64) uint32 {
+	h := fnv.New32()
+	err := binary.Write(h, binary.LittleEndian, [2]uint32{xor, uint32(sum)})
+	if err != nil {
+		panic(err)
+	}
+	return h.Sum32()
+}
+
+func (v *tlaValueInterval) Equal(other TLAValue) bool {
+	if otherInterval, ok := other.data.(*tlaValueInterval); ok {
+		return v.from == otherInterval.from && v.to == otherInterval.to
+	}
+	if !other.IsSet() {
+		return false
+	}
+	otherSet := other.AsSet()
+	if otherSet.Len() != v.len() {
+		return false
+	}
+	// as the sizes are the same, other is equal if all its elements are in the interval
+	it := otherSet.Iterator()
+	for !it.Done() {
+		elem, _ := it.Next()
+		if !v.contains(elem.(TLAValue)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *tlaValueInterval) String() string {
+	builder := strings.Builder{}
+	builder.WriteString("{")
+	first := true
+	v.forEach(func(elem TLAValue) bool {
+		if first {
+			first = false
+		} else {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(elem.String())
+		return true
+	})
+	builder.WriteString("}")
+	return builder.String()
+}
+
+func (v *tlaValueInterval) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	err := binary.Write(&buf, binary.LittleEndian, [2]int32{v.from, v.to})
+	return buf.Bytes(), err
+}
+
+func (v *tlaValueInterval) GobDecode(input []byte) error {
+	var bounds [2]int32
+	err := binary.Read(bytes.NewReader(input), binary.LittleEndian, &bounds)
+	if err != nil {
+		return err
+	}
+	if bounds[0] > bounds[1] {
+		return fmt.Errorf("%w: an interval must not be empty", ErrTLAType)
+	}
+	v.from, v.to = bounds[0], bounds[1]
+	return nil
+}
+
+// setLen returns the number of elements of the set v, without materializing it if it is an interval.
+func setLen(v TLAValue) int {
+	if interval, ok := v.data.(*tlaValueInterval); ok {
+		return interval.len()
+	}
+	return v.AsSet().Len()
+}
+
+// setContains reports whether the set v contains elem, without materializing it if it is an interval.
+func setContains(v TLAValue, elem TLAValue) bool {
+	if interval, ok := v.data.(*tlaValueInterval); ok {
+		return interval.contains(elem)
+	}
+	_, ok := v.AsSet().Get(elem)
+	return ok
+}
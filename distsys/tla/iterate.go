@@ -0,0 +1,82 @@
+package tla
+
+// Elements returns an iterator over the elements of v, which must be a set or a sequence. The iterator calls yield
+// with each element in turn, until yield returns false, without copying them into a slice: the elements of sets
+// once each, in no particular order, except that intervals are iterated in ascending order without materializing
+// them, and the elements of sequences in order. It panics if v is neither a set nor a sequence.
+//
+// Iterators have the signature of the range-over-func iterators of Go 1.23, so from Go 1.23 on they can be ranged
+// over, as in `for elem := range v.Elements() { ... }`. With earlier versions, call them with the body of the loop:
+//
+//	v.Elements()(func(elem TLAValue) bool {
+//		...
+//		return true // or false, to stop
+//	})
+func (v TLAValue) Elements() func(yield func(elem TLAValue) bool) {
+	switch data := v.data.(type) {
+	case *tlaValueInterval:
+		return func(yield func(elem TLAValue) bool) {
+			data.forEach(yield)
+		}
+	case *tlaValueBytes:
+		return func(yield func(elem TLAValue) bool) {
+			for _, b := range data.data {
+				if !yield(MakeTLANumber(int32(b))) {
+					return
+				}
+			}
+		}
+	case *tlaValueTuple:
+		return func(yield func(elem TLAValue) bool) {
+			it := data.Iterator()
+			for !it.Done() {
+				_, elem := it.Next()
+				if !yield(elem.(TLAValue)) {
+					return
+				}
+			}
+		}
+	}
+	if !v.IsSet() {
+		panic(v.kindError("a set or a sequence"))
+	}
+	return func(yield func(elem TLAValue) bool) {
+		// lazy sets are materialized, as they may generate the same element more than once
+		it := v.AsSet().Iterator()
+		for !it.Done() {
+			elem, _ := it.Next()
+			if !yield(elem.(TLAValue)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the keys and values of v, which must be a function, including a record, or a
+// sequence, whose keys are its 1-based indices. Functions are iterated in no particular order, and sequences in
+// order. It panics if v is neither a function nor a sequence. See Elements for how to use iterators.
+func (v TLAValue) All() func(yield func(key, value TLAValue) bool) {
+	if v.IsTuple() {
+		elems := v.Elements()
+		return func(yield func(key, value TLAValue) bool) {
+			var idx int32
+			elems(func(elem TLAValue) bool {
+				idx++
+				return yield(MakeTLANumber(idx), elem)
+			})
+		}
+	}
+	fn, err := v.TryAsFunction()
+	if err != nil {
+		panic(v.kindError("a function or a sequence"))
+	}
+	return func(yield func(key, value TLAValue) bool) {
+		it := fn.Iterator()
+		for !it.Done() {
+			key, value := it.Next()
+			if !yield(key.(TLAValue), value.(TLAValue)) {
+				return
+			}
+		}
+	}
+}
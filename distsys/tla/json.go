@@ -0,0 +1,328 @@
+package tla
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+var _ json.Marshaler = TLAValue{}
+var _ json.Unmarshaler = &TLAValue{}
+
+type jsonFunctionPair struct {
+	Key   json.RawMessage `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalJSON encodes v as JSON, using the following schema, which is stable across versions so that values can be
+// exchanged with programs written in other languages, and logged readably. Each value is an object with exactly one
+// member, whose name gives the kind of the value:
+//
+//	{"number": 42}
+//	{"real": "-3/4"}
+//	{"string": "foo"}
+//	{"bool": true}
+//	{"set": [<value>, ...]}
+//	{"tuple": [<value>, ...]}
+//	{"record": {"<key>": <value>, ...}}
+//	{"function": [{"key": <value>, "value": <value>}, ...]}
+//	{"custom": {"kind": "<kind>", "data": "<base64>"}}
+//
+// Numbers are integers of any size. Real numbers that are not integers are given as fractions in lowest terms; any
+// fraction or decimal, e.g. "6/8" or "0.75", is accepted when decoding. A non-empty function whose keys are all
+// strings is encoded as a record, and any other function, including the empty one, as a function; either form is
+// accepted when decoding. Custom values are given by their kind and the base64 encoding of their MarshalBinary
+// method's result, and can only be decoded if their kind is registered. The elements of sets, the fields of records
+// and the pairs of functions are sorted, by their encoding, so that equal values are encoded identically. The zero
+// TLAValue is encoded as null. Strings are encoded as JSON strings, so strings that are not valid UTF-8 do not
+// survive a round trip.
+func (v TLAValue) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	err := v.appendJSON(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (v TLAValue) appendJSON(buf *bytes.Buffer) error {
+	writeMember := func(kind string, value interface{}) error {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(buf, `{"%s":`, kind)
+		buf.Write(encoded)
+		buf.WriteByte('}')
+		return nil
+	}
+	// encodes each element of a collection on its own, to sort them
+	encodeAll := func(values []TLAValue) ([]json.RawMessage, error) {
+		result := make([]json.RawMessage, len(values))
+		for i, value := range values {
+			var elemBuf bytes.Buffer
+			err := value.appendJSON(&elemBuf)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = elemBuf.Bytes()
+		}
+		return result, nil
+	}
+
+	switch {
+	case v.data == nil:
+		buf.WriteString("null")
+		return nil
+	case v.IsNumber():
+		// numbers of any size are written out in full
+		return writeMember("number", json.Number(v.String()))
+	case v.IsReal():
+		return writeMember("real", v.AsReal().String())
+	case v.IsString():
+		return writeMember("string", v.AsString())
+	case v.IsBool():
+		return writeMember("bool", v.AsBool())
+	case v.IsSet():
+		var elems []TLAValue
+		it := v.AsSet().Iterator()
+		for !it.Done() {
+			elem, _ := it.Next()
+			elems = append(elems, elem.(TLAValue))
+		}
+		encoded, err := encodeAll(elems)
+		if err != nil {
+			return err
+		}
+		sort.Slice(encoded, func(i, j int) bool {
+			return bytes.Compare(encoded[i], encoded[j]) < 0
+		})
+		return writeMember("set", encoded)
+	case v.IsTuple():
+		var elems []TLAValue
+		it := v.AsTuple().Iterator()
+		for !it.Done() {
+			_, elem := it.Next()
+			elems = append(elems, elem.(TLAValue))
+		}
+		encoded, err := encodeAll(elems)
+		if err != nil {
+			return err
+		}
+		return writeMember("tuple", encoded)
+	case v.IsFunction():
+		fn := v.AsFunction()
+		var keys, values []TLAValue
+		isRecord := fn.Len() != 0
+		it := fn.Iterator()
+		for !it.Done() {
+			key, value := it.Next()
+			keys = append(keys, key.(TLAValue))
+			values = append(values, value.(TLAValue))
+			isRecord = isRecord && key.(TLAValue).IsString()
+		}
+		encodedValues, err := encodeAll(values)
+		if err != nil {
+			return err
+		}
+		if isRecord {
+			// encoding/json sorts map keys
+			fields := make(map[string]json.RawMessage, len(keys))
+			for i, key := range keys {
+				fields[key.AsString()] = encodedValues[i]
+			}
+			return writeMember("record", fields)
+		}
+		encodedKeys, err := encodeAll(keys)
+		if err != nil {
+			return err
+		}
+		pairs := make([]jsonFunctionPair, len(keys))
+		for i := range pairs {
+			pairs[i] = jsonFunctionPair{Key: encodedKeys[i], Value: encodedValues[i]}
+		}
+		sort.Slice(pairs, func(i, j int) bool {
+			return bytes.Compare(pairs[i].Key, pairs[j].Key) < 0
+		})
+		return writeMember("function", pairs)
+	case v.IsCustom():
+		custom := v.AsCustom()
+		data, err := custom.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		return writeMember("custom", tlaValueCustomEncoding{Kind: custom.TLAKind(), Data: data})
+	default:
+		return fmt.Errorf("%w: cannot encode %v as JSON", ErrTLAType, v)
+	}
+}
+
+// UnmarshalJSON decodes a value encoded by MarshalJSON into v. It fails on input that does not match the schema,
+// such as objects with no or several members, numbers that are not integers, and functions that map the same
+// key more than once.
+// The limits set by SetTLAValueLimits apply.
+func (v *TLAValue) UnmarshalJSON(input []byte) error {
+	value, err := unmarshalJSONValue(input, 0, newValueBudget())
+	if err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
+
+// unmarshalJSONValue decodes the value nested depth levels down in the value being decoded. The values nested in it
+// are decoded by recursive calls, rather than by json.Unmarshal calling back into UnmarshalJSON, so that the depth
+// is known.
+func unmarshalJSONValue(input []byte, depth int, budget *valueBudget) (TLAValue, error) {
+	err := budget.enter(depth)
+	if err != nil {
+		return TLAValue{}, err
+	}
+	if isJSONNull(input) {
+		return TLAValue{}, nil
+	}
+	var members map[string]json.RawMessage
+	err = json.Unmarshal(input, &members)
+	if err != nil {
+		return TLAValue{}, err
+	}
+	if len(members) != 1 {
+		return TLAValue{}, fmt.Errorf("%w: a JSON-encoded TLA+ value must have exactly one member, but %s has %d", ErrTLAType, input, len(members))
+	}
+	var value TLAValue
+	for kind, member := range members {
+		value, err = unmarshalJSONMember(kind, member, depth, budget)
+		if err != nil {
+			return TLAValue{}, fmt.Errorf("%s: %w", kind, err)
+		}
+	}
+	return value, nil
+}
+
+// isJSONNull reports whether input, which is missing if nil, is null.
+func isJSONNull(input json.RawMessage) bool {
+	return input == nil || bytes.Equal(bytes.TrimSpace(input), []byte("null"))
+}
+
+// unmarshalJSONElements decodes the values in elems, which are nested in a value at depth.
+func unmarshalJSONElements(elems []json.RawMessage, depth int, budget *valueBudget) ([]TLAValue, error) {
+	err := budget.expect(len(elems))
+	if err != nil {
+		return nil, err
+	}
+	values := make([]TLAValue, len(elems))
+	for i, elem := range elems {
+		values[i], err = unmarshalJSONValue(elem, depth+1, budget)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func unmarshalJSONMember(kind string, member json.RawMessage, depth int, budget *valueBudget) (TLAValue, error) {
+	switch kind {
+	case "number":
+		var number json.Number
+		err := json.Unmarshal(member, &number)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		num, ok := new(big.Int).SetString(string(number), 10)
+		if !ok {
+			return TLAValue{}, fmt.Errorf("%w: %s is not an integer", ErrTLAType, number)
+		}
+		return MakeTLABigNumber(num), nil
+	case "real":
+		var str string
+		err := json.Unmarshal(member, &str)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		num, ok := new(big.Rat).SetString(str)
+		if !ok {
+			return TLAValue{}, fmt.Errorf("%w: %q is not a real number", ErrTLAType, str)
+		}
+		return MakeTLAReal(num), nil
+	case "string":
+		var str string
+		err := json.Unmarshal(member, &str)
+		return MakeTLAString(str), err
+	case "bool":
+		var b bool
+		err := json.Unmarshal(member, &b)
+		return MakeTLABool(b), err
+	case "set", "tuple":
+		var encodedElems []json.RawMessage
+		err := json.Unmarshal(member, &encodedElems)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		elems, err := unmarshalJSONElements(encodedElems, depth, budget)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		if kind == "set" {
+			return MakeTLASet(elems...), nil
+		}
+		return MakeTLATuple(elems...), nil
+	case "record":
+		var fields map[string]json.RawMessage
+		err := json.Unmarshal(member, &fields)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		err = budget.expect(2 * len(fields))
+		if err != nil {
+			return TLAValue{}, err
+		}
+		pairs := make([]TLARecordField, 0, len(fields))
+		for key, encodedValue := range fields {
+			// the keys, which are strings, count towards the limits too
+			budget.values++
+			value, err := unmarshalJSONValue(encodedValue, depth+1, budget)
+			if err != nil {
+				return TLAValue{}, err
+			}
+			pairs = append(pairs, TLARecordField{Key: MakeTLAString(key), Value: value})
+		}
+		return MakeTLARecord(pairs), nil
+	case "function":
+		var pairs []jsonFunctionPair
+		err := json.Unmarshal(member, &pairs)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		encodedElems := make([]json.RawMessage, 0, 2*len(pairs))
+		for i, pair := range pairs {
+			if isJSONNull(pair.Key) || isJSONNull(pair.Value) {
+				return TLAValue{}, fmt.Errorf("%w: function pair %d must have both a key and a value", ErrTLAType, i)
+			}
+			encodedElems = append(encodedElems, pair.Key, pair.Value)
+		}
+		elems, err := unmarshalJSONElements(encodedElems, depth, budget)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		fields := make([]TLARecordField, len(pairs))
+		for i := range fields {
+			fields[i] = TLARecordField{Key: elems[2*i], Value: elems[2*i+1]}
+		}
+		fn := MakeTLARecord(fields)
+		if fn.AsFunction().Len() != len(fields) {
+			return TLAValue{}, fmt.Errorf("%w: function maps the same key more than once", ErrTLAType)
+		}
+		return fn, nil
+	case "custom":
+		var encoding tlaValueCustomEncoding
+		err := json.Unmarshal(member, &encoding)
+		if err != nil {
+			return TLAValue{}, err
+		}
+		return unmarshalTLACustomValue(encoding.Kind, encoding.Data)
+	default:
+		return TLAValue{}, fmt.Errorf("%w: unknown kind of value %q", ErrTLAType, kind)
+	}
+}
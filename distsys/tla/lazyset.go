@@ -0,0 +1,183 @@
+package tla
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/benbjohnson/immutable"
+)
+
+// tlaValueLazySet is a set whose elements are only computed when needed, as made by TLASetComprehension and
+// TLASetRefinement. Generated code often builds such sets only to quantify over them, or to filter or map them
+// further, in which case the elements can be streamed from the sets they are defined in terms of, without
+// materializing any intermediate set, and a quantifier can stop as soon as its result is known. Any other use, such
+// as a membership test, materializes the set once, after which it behaves like any other set.
+type tlaValueLazySet struct {
+	hashCache
+	lock sync.Mutex
+	// generate calls yield with each element of the set, possibly more than once, until yield returns false. It
+	// reports whether it reached the end of the set.
+	generate func(yield func(elem TLAValue) bool) bool
+	// materialize, if not nil, computes the set more efficiently than from generate, or returns nil if it cannot
+	materialize func() *immutable.Map
+	// set is nil until the set is materialized
+	set *immutable.Map
+}
+
+var _ tlaValueImpl = &tlaValueLazySet{}
+
+func makeTLALazySet(generate func(yield func(elem TLAValue) bool) bool, materialize func() *immutable.Map) TLAValue {
+	return TLAValue{&tlaValueLazySet{generate: generate, materialize: materialize}}
+}
+
+// lazySetEagerLimit is how many combinations of elements of the sets a set comprehension or refinement is defined in
+// terms of there may be for it to be computed right away, rather than lazily. Laziness gains little on such small
+// sets, and computing them where they are built means that a panic raised by the Go function they take surfaces
+// there, in the critical section building the set.
+const lazySetEagerLimit = 64
+
+// forceIfSmall materializes the lazy set v right away if inputs, the sets it is defined in terms of, have at most
+// lazySetEagerLimit combinations of elements, as far as can be known without computing them, and returns v.
+func forceIfSmall(v TLAValue, inputs ...TLAValue) TLAValue {
+	combinations := 1
+	for _, input := range inputs {
+		size, ok := knownSize(input)
+		if !ok || size > lazySetEagerLimit {
+			return v
+		}
+		combinations *= size
+		if combinations > lazySetEagerLimit {
+			return v
+		}
+	}
+	v.data.(*tlaValueLazySet).force()
+	return v
+}
+
+// knownSize returns the number of elements of the set v, if it is known without computing them.
+func knownSize(v TLAValue) (int, bool) {
+	switch data := v.data.(type) {
+	case *tlaValueLazySet:
+		data.lock.Lock()
+		defer data.lock.Unlock()
+		if data.set == nil {
+			return 0, false
+		}
+		return data.set.Len(), true
+	case *tlaValueInterval:
+		return data.len(), true
+	default:
+		return v.AsSet().Len(), true
+	}
+}
+
+func (v *tlaValueLazySet) force() *immutable.Map {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if v.set == nil {
+		if v.materialize != nil {
+			v.set = v.materialize()
+		}
+		if v.set == nil {
+			builder := immutable.NewMapBuilder(TLAValueHasher{})
+			v.generate(func(elem TLAValue) bool {
+				builder.Set(elem, true)
+				requireMaterializable(builder.Len())
+				return true
+			})
+			v.set = builder.Map()
+		}
+		// the closures may hold on to a lot, which is no longer needed
+		v.generate, v.materialize = nil, nil
+	}
+	return v.set
+}
+
+func (v *tlaValueLazySet) Hash() uint32 {
+	return v.cachedHash(func() uint32 {
+		return (&tlaValueSet{Map: v.force()}).Hash()
+	})
+}
+
+func (v *tlaValueLazySet) Equal(other TLAValue) bool {
+	return (&tlaValueSet{Map: v.force()}).Equal(other)
+}
+
+func (v *tlaValueLazySet) String() string {
+	return (&tlaValueSet{Map: v.force()}).String()
+}
+
+// isMaterialized reports whether the elements of the set v are already computed, so that it can be iterated cheaply.
+func isMaterialized(v TLAValue) bool {
+	if lazy, ok := v.data.(*tlaValueLazySet); ok {
+		lazy.lock.Lock()
+		defer lazy.lock.Unlock()
+		return lazy.set != nil
+	}
+	if interval, ok := v.data.(*tlaValueInterval); ok {
+		interval.lock.Lock()
+		defer interval.lock.Unlock()
+		return interval.set != nil
+	}
+	return true
+}
+
+// requireSet panics if v is not a set, like AsSet would, but without materializing a lazy set.
+func requireSet(v TLAValue) {
+	if !v.IsSet() {
+		panic(fmt.Errorf("%w: %v is not a set", ErrTLAType, v))
+	}
+}
+
+// forEachCombination calls yield with each combination of elements of setVals, one from each set in order, until
+// yield returns false, and reports whether it went through all of them. The first set is streamed, as by
+// forEachElement, while the others, which are iterated once per element of the first, are materialized, unless
+// they are intervals. The slice passed to yield is reused between calls.
+func forEachCombination(setVals []TLAValue, yield func(args []TLAValue) bool) bool {
+	if len(setVals) == 0 {
+		return yield(nil)
+	}
+	for _, val := range setVals[1:] {
+		if _, ok := val.data.(*tlaValueInterval); !ok {
+			_ = val.AsSet()
+		}
+	}
+	args := make([]TLAValue, len(setVals))
+
+	var helper func(idx int) bool
+	helper = func(idx int) bool {
+		if idx == len(args) {
+			return yield(args)
+		}
+		return forEachElement(setVals[idx], func(elem TLAValue) bool {
+			args[idx] = elem
+			return helper(idx + 1)
+		})
+	}
+	return helper(0)
+}
+
+// forEachElement calls yield with each element of the set v, until yield returns false, and reports whether it
+// reached the end of the set. Elements of a lazy set that has not been materialized are streamed, without
+// materializing it, and may be repeated. Intervals are iterated in ascending order, without materializing them.
+func forEachElement(v TLAValue, yield func(elem TLAValue) bool) bool {
+	if lazy, ok := v.data.(*tlaValueLazySet); ok {
+		lazy.lock.Lock()
+		generate := lazy.generate
+		lazy.lock.Unlock()
+		if generate != nil {
+			return generate(yield)
+		}
+	}
+	if interval, ok := v.data.(*tlaValueInterval); ok {
+		return interval.forEach(yield)
+	}
+	it := v.AsSet().Iterator()
+	for !it.Done() {
+		elem, _ := it.Next()
+		if !yield(elem.(TLAValue)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+package tla
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrTLAValueLimit is wrapped by the errors reported for values that exceed the limits set by SetTLAValueLimits.
+var ErrTLAValueLimit = errors.New("TLA+ value exceeds limits")
+
+// TLAValueLimits bounds the values that GobDecode, UnmarshalJSON, DecodeCanonical and Parse accept, and the sets that
+// operators materialize, so that a pathologically large or deeply nested value, such as one sent by a malicious
+// peer, fails with an error wrapping ErrTLAValueLimit, rather than exhausting the memory or the stack of the
+// process. A limit of 0 means no limit.
+type TLAValueLimits struct {
+	// MaxDepth is how deeply sets, tuples and functions may be nested in a decoded value.
+	MaxDepth int
+	// MaxValues is how many values a decoded value may contain, counting itself and each element, key and value
+	// nested in it, as well as how many elements an operator may materialize in a single set.
+	MaxValues int
+}
+
+// DefaultTLAValueLimits are the limits in effect until SetTLAValueLimits is called. They are far beyond what a
+// model would normally need.
+var DefaultTLAValueLimits = TLAValueLimits{
+	MaxDepth:  1000,
+	MaxValues: 1 << 24,
+}
+
+var tlaValueLimits atomic.Value
+
+func init() {
+	tlaValueLimits.Store(DefaultTLAValueLimits)
+}
+
+// SetTLAValueLimits sets the limits for the whole process. They cannot be set per call, as GobDecode and
+// UnmarshalJSON are called by encoding packages that have no way to pass them along.
+func SetTLAValueLimits(limits TLAValueLimits) {
+	tlaValueLimits.Store(limits)
+}
+
+func GetTLAValueLimits() TLAValueLimits {
+	return tlaValueLimits.Load().(TLAValueLimits)
+}
+
+// valueBudget counts the values decoded so far, against the limits in effect when decoding started.
+type valueBudget struct {
+	limits TLAValueLimits
+	values int
+}
+
+func newValueBudget() *valueBudget {
+	return &valueBudget{limits: GetTLAValueLimits()}
+}
+
+// enter accounts for a value nested depth levels down in the value being decoded, which is at depth 0.
+func (b *valueBudget) enter(depth int) error {
+	if b.limits.MaxDepth > 0 && depth > b.limits.MaxDepth {
+		return fmt.Errorf("%w: values are nested more than %d levels deep", ErrTLAValueLimit, b.limits.MaxDepth)
+	}
+	b.values++
+	return b.expect(0)
+}
+
+// expect checks that n more values would fit, so that a collection of too many elements can be rejected before its
+// elements are decoded.
+func (b *valueBudget) expect(n int) error {
+	if b.limits.MaxValues > 0 && n > b.limits.MaxValues-b.values {
+		return fmt.Errorf("%w: more than %d values", ErrTLAValueLimit, b.limits.MaxValues)
+	}
+	return nil
+}
+
+// requireMaterializable panics if a set with the product of sizes elements is larger than the limits allow, before
+// an operator spends the time and memory to build it.
+func requireMaterializable(sizes ...int) {
+	maxValues := GetTLAValueLimits().MaxValues
+	if maxValues <= 0 {
+		return
+	}
+	total := 1
+	for _, size := range sizes {
+		if size == 0 {
+			return
+		}
+		if total > maxValues/size {
+			total = maxValues + 1
+		} else {
+			total *= size
+		}
+	}
+	if total > maxValues {
+		panic(fmt.Errorf("%w: a set of more than %d elements", ErrTLAValueLimit, maxValues))
+	}
+}
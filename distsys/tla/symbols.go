@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/benbjohnson/immutable"
 	"math"
+	"math/big"
+	"sort"
 )
 
 // this file contains definitions of all PGo's supported TLA+ symbols (that would usually be evaluated by TLC)
@@ -12,11 +14,86 @@ import (
 
 var TLA_defaultInitValue = TLAValue{}
 
+// PrintHook is called with the values printed by TLA_Print, TLA_PrintT and PCalPrint. By default it prints them to
+// standard output; the distsys package replaces it so that they go to its DefaultLogger.
+var PrintHook = func(v TLAValue) {
+	fmt.Println(v)
+}
+
+// TLA_Print is Print(out, val), which prints out and returns val.
+func TLA_Print(out, val TLAValue) TLAValue {
+	PrintHook(out)
+	return val
+}
+
+func TLA_PrintT(out TLAValue) TLAValue {
+	PrintHook(out)
+	return TLA_TRUE
+}
+
 func TLA_Assert(cond, msg TLAValue) TLAValue {
-	require(cond.AsBool(), fmt.Sprintf("TLA+ assertion: %s", msg.AsString()))
+	if !cond.AsBool() {
+		// as in TLC, the message can be any value, though it is usually a string
+		text := msg.String()
+		if msg.IsString() {
+			text = msg.AsString()
+		}
+		require(false, fmt.Sprintf("TLA+ assertion: %s", text))
+	}
 	return TLA_TRUE
 }
 
+// TLA_SortSeq is SortSeq(s, Op), the sequence s sorted by Op, which holds if its first argument should come before
+// its second, as with <. Like TLA_SelectSeq, it takes Op as a Go function. Equal elements keep their order.
+func TLA_SortSeq(v TLAValue, less func(lhs, rhs TLAValue) bool) TLAValue {
+	tuple := v.AsTuple()
+	elems := make([]TLAValue, 0, tuple.Len())
+	it := tuple.Iterator()
+	for !it.Done() {
+		_, elem := it.Next()
+		elems = append(elems, elem.(TLAValue))
+	}
+	sort.SliceStable(elems, func(i, j int) bool {
+		return less(elems[i], elems[j])
+	})
+	return MakeTLATuple(elems...)
+}
+
+// TLA_Permutations is Permutations(S), the set of all functions that map S onto itself. There are n! of them for a
+// set of n elements, so the result is lazy, like that of TLASetRefinement, and quantifying over it generates each
+// permutation in turn rather than all of them at once.
+func TLA_Permutations(v TLAValue) TLAValue {
+	requireSet(v)
+	return makeTLALazySet(func(yield func(elem TLAValue) bool) bool {
+		var elems []TLAValue
+		forEachElement(v, func(elem TLAValue) bool {
+			elems = append(elems, elem)
+			return true
+		})
+		used := make([]bool, len(elems))
+		// chooses the image of each element in order, among those not yet used
+		var helper func(idx int, acc *immutable.Map) bool
+		helper = func(idx int, acc *immutable.Map) bool {
+			if idx == len(elems) {
+				return yield(TLAValue{&tlaValueFunction{Map: acc}})
+			}
+			for i := range elems {
+				if used[i] {
+					continue
+				}
+				used[i] = true
+				ok := helper(idx+1, acc.Set(elems[idx], elems[i]))
+				used[i] = false
+				if !ok {
+					return false
+				}
+			}
+			return true
+		}
+		return helper(0, immutable.NewMap(TLAValueHasher{}))
+	}, nil)
+}
+
 // eq checks
 
 func TLA_EqualsSymbol(lhs, rhs TLAValue) TLAValue {
@@ -57,79 +134,185 @@ func TLA_EquivSymbol(lhs, rhs TLAValue) TLAValue {
 
 var TLA_Zero = MakeTLANumber(0)
 
+// arithmetic is done on int64 when both operands fit in 32 bits, which cannot overflow, on big.Rat when either is a
+// real number that is not an integer, and on big.Int otherwise. Either way, results are promoted to, or demoted
+// from, big numbers and reals as needed.
+
+func smallNumbers(lhs, rhs TLAValue) (int64, int64, bool) {
+	lhsNum, lhsOk := lhs.data.(tlaValueNumber)
+	rhsNum, rhsOk := rhs.data.(tlaValueNumber)
+	return int64(lhsNum), int64(rhsNum), lhsOk && rhsOk
+}
+
+func anyReal(lhs, rhs TLAValue) bool {
+	_, lhsReal := lhs.data.(*tlaValueReal)
+	_, rhsReal := rhs.data.(*tlaValueReal)
+	return lhsReal || rhsReal
+}
+
+func compareNumbers(lhs, rhs TLAValue) int {
+	if lhsNum, rhsNum, ok := smallNumbers(lhs, rhs); ok {
+		switch {
+		case lhsNum < rhsNum:
+			return -1
+		case lhsNum > rhsNum:
+			return 1
+		default:
+			return 0
+		}
+	}
+	if anyReal(lhs, rhs) {
+		return lhs.AsReal().Cmp(rhs.AsReal())
+	}
+	return lhs.AsBigNumber().Cmp(rhs.AsBigNumber())
+}
+
 func TLA_PlusSymbol(lhs, rhs TLAValue) TLAValue {
-	return MakeTLANumber(lhs.AsNumber() + rhs.AsNumber())
+	if lhsNum, rhsNum, ok := smallNumbers(lhs, rhs); ok {
+		return makeTLANumber64(lhsNum + rhsNum)
+	}
+	if anyReal(lhs, rhs) {
+		return MakeTLAReal(new(big.Rat).Add(lhs.AsReal(), rhs.AsReal()))
+	}
+	return MakeTLABigNumber(new(big.Int).Add(lhs.AsBigNumber(), rhs.AsBigNumber()))
 }
 
 func TLA_MinusSymbol(lhs, rhs TLAValue) TLAValue {
-	return MakeTLANumber(lhs.AsNumber() - rhs.AsNumber())
+	if lhsNum, rhsNum, ok := smallNumbers(lhs, rhs); ok {
+		return makeTLANumber64(lhsNum - rhsNum)
+	}
+	if anyReal(lhs, rhs) {
+		return MakeTLAReal(new(big.Rat).Sub(lhs.AsReal(), rhs.AsReal()))
+	}
+	return MakeTLABigNumber(new(big.Int).Sub(lhs.AsBigNumber(), rhs.AsBigNumber()))
 }
 
 func TLA_AsteriskSymbol(lhs, rhs TLAValue) TLAValue {
-	return MakeTLANumber(lhs.AsNumber() * rhs.AsNumber())
+	if lhsNum, rhsNum, ok := smallNumbers(lhs, rhs); ok {
+		return makeTLANumber64(lhsNum * rhsNum)
+	}
+	if anyReal(lhs, rhs) {
+		return MakeTLAReal(new(big.Rat).Mul(lhs.AsReal(), rhs.AsReal()))
+	}
+	return MakeTLABigNumber(new(big.Int).Mul(lhs.AsBigNumber(), rhs.AsBigNumber()))
 }
 
 func TLA_SuperscriptSymbol(lhs, rhs TLAValue) TLAValue {
-	return MakeTLANumber(int32(math.Pow(float64(lhs.AsNumber()), float64(rhs.AsNumber()))))
+	exponent := rhs.AsNumber()
+	if exponent >= 0 && !anyReal(lhs, rhs) {
+		return MakeTLABigNumber(new(big.Int).Exp(lhs.AsBigNumber(), big.NewInt(int64(exponent)), nil))
+	}
+	// raise the numerator and denominator separately, and invert the result for negative exponents
+	base := lhs.AsReal()
+	require(exponent >= 0 || base.Sign() != 0, "0 cannot be raised to a negative power")
+	absExponent := big.NewInt(int64(exponent))
+	absExponent.Abs(absExponent)
+	result := new(big.Rat).SetFrac(
+		new(big.Int).Exp(base.Num(), absExponent, nil),
+		new(big.Int).Exp(base.Denom(), absExponent, nil))
+	if exponent < 0 {
+		result.Inv(result)
+	}
+	return MakeTLAReal(result)
 }
 
 func TLA_LessThanOrEqualSymbol(lhs, rhs TLAValue) TLAValue {
-	return MakeTLABool(lhs.AsNumber() <= rhs.AsNumber())
+	return MakeTLABool(compareNumbers(lhs, rhs) <= 0)
 }
 
 func TLA_GreaterThanOrEqualSymbol(lhs, rhs TLAValue) TLAValue {
-	return MakeTLABool(lhs.AsNumber() >= rhs.AsNumber())
+	return MakeTLABool(compareNumbers(lhs, rhs) >= 0)
 }
 
 func TLA_LessThanSymbol(lhs, rhs TLAValue) TLAValue {
-	return MakeTLABool(lhs.AsNumber() < rhs.AsNumber())
+	return MakeTLABool(compareNumbers(lhs, rhs) < 0)
 }
 
 func TLA_GreaterThanSymbol(lhs, rhs TLAValue) TLAValue {
-	return MakeTLABool(lhs.AsNumber() > rhs.AsNumber())
+	return MakeTLABool(compareNumbers(lhs, rhs) > 0)
 }
 
 func TLA_DotDotSymbol(lhs, rhs TLAValue) TLAValue {
-	from, to := lhs.AsNumber(), rhs.AsNumber()
+	if lhsNum, rhsNum, ok := smallNumbers(lhs, rhs); ok {
+		return makeTLAInterval(int32(lhsNum), int32(rhsNum))
+	}
+	// bounds beyond 32 bits are rare enough that their intervals are materialized, but only once the number of
+	// elements, which may itself not fit in an int, is known to be within the limits
 	builder := immutable.NewMapBuilder(TLAValueHasher{})
-	for i := from; i <= to; i++ {
-		builder.Set(MakeTLANumber(i), true)
+	if compareNumbers(lhs, rhs) <= 0 {
+		from, to := lhs.AsBigNumber(), rhs.AsBigNumber()
+		one := big.NewInt(1)
+		span := new(big.Int).Sub(to, from)
+		span.Add(span, one)
+		size := math.MaxInt
+		if span.IsInt64() && span.Int64() < math.MaxInt {
+			size = int(span.Int64())
+		}
+		requireMaterializable(size)
+		for i := from; i.Cmp(to) <= 0; i.Add(i, one) {
+			builder.Set(MakeTLABigNumber(i), true)
+		}
 	}
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return TLAValue{&tlaValueSet{Map: builder.Map()}}
 }
 
 func TLA_DivSymbol(lhs, rhs TLAValue) TLAValue {
-	rhsNum := rhs.AsNumber()
-	require(rhsNum != 0, "divisor must not be 0")
-	return MakeTLANumber(lhs.AsNumber() / rhsNum)
+	if lhsNum, rhsNum, ok := smallNumbers(lhs, rhs); ok {
+		require(rhsNum != 0, "divisor must not be 0")
+		return makeTLANumber64(lhsNum / rhsNum)
+	}
+	rhsNum := rhs.AsBigNumber()
+	require(rhsNum.Sign() != 0, "divisor must not be 0")
+	return MakeTLABigNumber(new(big.Int).Quo(lhs.AsBigNumber(), rhsNum))
 }
 
 func TLA_PercentSymbol(lhs, rhs TLAValue) TLAValue {
-	rhsNum := rhs.AsNumber()
-	require(rhsNum != 0, "divisor must not be 0")
-	return MakeTLANumber(lhs.AsNumber() % rhsNum)
+	if lhsNum, rhsNum, ok := smallNumbers(lhs, rhs); ok {
+		require(rhsNum != 0, "divisor must not be 0")
+		return makeTLANumber64(lhsNum % rhsNum)
+	}
+	rhsNum := rhs.AsBigNumber()
+	require(rhsNum.Sign() != 0, "divisor must not be 0")
+	return MakeTLABigNumber(new(big.Int).Rem(lhs.AsBigNumber(), rhsNum))
+}
+
+func TLA_SlashSymbol(lhs, rhs TLAValue) TLAValue {
+	rhsNum := rhs.AsReal()
+	require(rhsNum.Sign() != 0, "divisor must not be 0")
+	return MakeTLAReal(rhsNum.Quo(lhs.AsReal(), rhsNum))
 }
 
 func TLA_NegationSymbol(v TLAValue) TLAValue {
-	return MakeTLANumber(-v.AsNumber())
+	if num, ok := v.data.(tlaValueNumber); ok {
+		return makeTLANumber64(-int64(num))
+	}
+	if _, ok := v.data.(*tlaValueReal); ok {
+		return MakeTLAReal(new(big.Rat).Neg(v.AsReal()))
+	}
+	return MakeTLABigNumber(new(big.Int).Neg(v.AsBigNumber()))
 }
 
 // set-related
 
 func TLA_InSymbol(lhs, rhs TLAValue) TLAValue {
-	set := rhs.AsSet()
-	_, ok := set.Get(lhs)
-	return MakeTLABool(ok)
+	return MakeTLABool(setContains(rhs, lhs))
 }
 
 func TLA_NotInSymbol(lhs, rhs TLAValue) TLAValue {
-	set := rhs.AsSet()
-	_, ok := set.Get(lhs)
-	return MakeTLABool(!ok)
+	return MakeTLABool(!setContains(rhs, lhs))
 }
 
+// sets, functions and tuples are persistent: Set, Delete and Append return a modified copy of a map or list that
+// shares all but O(log n) of its structure with the original. The operators below take advantage of this by
+// modifying the larger operand, so that e.g. adding one element to a large set allocates little, rather than
+// rebuilding the result element by element.
+
 func TLA_IntersectSymbol(lhs, rhs TLAValue) TLAValue {
 	lhsSet, rhsSet := lhs.AsSet(), rhs.AsSet()
+	// the result is a subset of the smaller operand, so only its elements need checking
+	if lhsSet.Len() > rhsSet.Len() {
+		lhsSet, rhsSet = rhsSet, lhsSet
+	}
 	builder := immutable.NewMapBuilder(TLAValueHasher{})
 	it := lhsSet.Iterator()
 	for !it.Done() {
@@ -138,40 +321,45 @@ func TLA_IntersectSymbol(lhs, rhs TLAValue) TLAValue {
 			builder.Set(elem, true)
 		}
 	}
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return TLAValue{&tlaValueSet{Map: builder.Map()}}
 }
 
 func TLA_UnionSymbol(lhs, rhs TLAValue) TLAValue {
 	lhsSet, rhsSet := lhs.AsSet(), rhs.AsSet()
-	builder := immutable.NewMapBuilder(TLAValueHasher{})
-	it := lhsSet.Iterator()
-	for !it.Done() {
-		v, _ := it.Next()
-		builder.Set(v, true)
+	if lhsSet.Len() < rhsSet.Len() {
+		lhsSet, rhsSet = rhsSet, lhsSet
 	}
-	it = rhsSet.Iterator()
+	it := rhsSet.Iterator()
 	for !it.Done() {
 		v, _ := it.Next()
-		builder.Set(v, true)
+		if _, ok := lhsSet.Get(v); !ok {
+			lhsSet = lhsSet.Set(v, true)
+		}
 	}
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return TLAValue{&tlaValueSet{Map: lhsSet}}
 }
 
 func TLA_SubsetOrEqualSymbol(lhs, rhs TLAValue) TLAValue {
-	lhsSet, rhsSet := lhs.AsSet(), rhs.AsSet()
-	it := lhsSet.Iterator()
-	for !it.Done() {
-		elem, _ := it.Next()
-		_, ok := rhsSet.Get(elem)
-		if !ok {
-			return TLA_FALSE
-		}
+	requireSet(lhs)
+	if setLen(lhs) > setLen(rhs) {
+		return TLA_FALSE
 	}
-	return TLA_TRUE
+	return MakeTLABool(forEachElement(lhs, func(elem TLAValue) bool {
+		return setContains(rhs, elem)
+	}))
 }
 
 func TLA_BackslashSymbol(lhs, rhs TLAValue) TLAValue {
 	lhsSet, rhsSet := lhs.AsSet(), rhs.AsSet()
+	if rhsSet.Len() < lhsSet.Len() {
+		// removing the few elements of rhs from lhs shares most of lhs
+		it := rhsSet.Iterator()
+		for !it.Done() {
+			elem, _ := it.Next()
+			lhsSet = lhsSet.Delete(elem)
+		}
+		return TLAValue{&tlaValueSet{Map: lhsSet}}
+	}
 	builder := immutable.NewMapBuilder(TLAValueHasher{})
 	it := lhsSet.Iterator()
 	for !it.Done() {
@@ -180,7 +368,7 @@ func TLA_BackslashSymbol(lhs, rhs TLAValue) TLAValue {
 			builder.Set(elem, true)
 		}
 	}
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return TLAValue{&tlaValueSet{Map: builder.Map()}}
 }
 
 func TLA_PrefixSubsetSymbol(v TLAValue) TLAValue {
@@ -194,36 +382,58 @@ func TLA_PrefixSubsetSymbol(v TLAValue) TLAValue {
 		shrinkingSet = shrinkingSet.Delete(elem)
 	}
 	builder.Set(shrinkingSet, true) // add the empty set
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return TLAValue{&tlaValueSet{Map: builder.Map()}}
 }
 
 func TLA_PrefixUnionSymbol(v TLAValue) TLAValue {
-	setOfSets := v.AsSet()
-	builder := immutable.NewMapBuilder(TLAValueHasher{})
-	it := setOfSets.Iterator()
+	var sets []*immutable.Map
+	largest := -1
+	it := v.AsSet().Iterator()
 	for !it.Done() {
 		elem, _ := it.Next()
 		set := elem.(TLAValue).AsSet()
+		if largest == -1 || set.Len() > sets[largest].Len() {
+			largest = len(sets)
+		}
+		sets = append(sets, set)
+	}
+	if largest == -1 {
+		return MakeTLASet()
+	}
+	// add the elements of the other sets to the largest one, sharing its structure
+	result := sets[largest]
+	for i, set := range sets {
+		if i == largest {
+			continue
+		}
 		innerIt := set.Iterator()
 		for !innerIt.Done() {
-			elem, _ := it.Next()
-			builder.Set(elem, true)
+			elem, _ := innerIt.Next()
+			if _, ok := result.Get(elem); !ok {
+				result = result.Set(elem, true)
+			}
 		}
 	}
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return TLAValue{&tlaValueSet{Map: result}}
 }
 
 func TLA_IsFiniteSet(v TLAValue) TLAValue {
-	_ = v.AsSet() // it should at least _be_ a set, even if we're sure it's finite
+	requireSet(v) // it should at least _be_ a set, even if we're sure it's finite
 	return TLA_TRUE
 }
 
+// TLA_Cardinality is the number of elements of any kind of set. Only intervals are counted without materializing
+// them, as the elements of a lazy set may not all be distinct.
 func TLA_Cardinality(v TLAValue) TLAValue {
-	return MakeTLANumber(int32(v.AsSet().Len()))
+	return makeTLANumber64(int64(setLen(v)))
 }
 
 // sequence / tuple-related
 
+// sequences are tuples, which are persistent vectors: indexing, Head, Append and prepending take O(log32 n) time,
+// which is effectively constant, as do Tail and SubSeq, which share the elements they keep with their argument
+// rather than copying them.
+
 func TLA_Seq(v TLAValue) TLAValue {
 	set := v.AsSet()
 	// move all the elements onto a slice for easier handling
@@ -233,6 +443,12 @@ func TLA_Seq(v TLAValue) TLAValue {
 		elem, _ := it.Next()
 		elems = append(elems, elem.(TLAValue))
 	}
+	// there are n! permutations of n elements
+	factors := make([]int, len(elems))
+	for i := range factors {
+		factors[i] = i + 1
+	}
+	requireMaterializable(factors...)
 
 	// prepare to build a set of tuples
 	builder := immutable.NewMapBuilder(TLAValueHasher{})
@@ -264,49 +480,100 @@ func TLA_Seq(v TLAValue) TLAValue {
 		generatePermutations(len(elems))
 	}
 
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return TLAValue{&tlaValueSet{Map: builder.Map()}}
 }
 
 func TLA_Len(v TLAValue) TLAValue {
-	return MakeTLANumber(int32(v.AsTuple().Len()))
+	return MakeTLANumber(int32(seqLen(v)))
 }
 
 func TLA_OSymbol(lhs, rhs TLAValue) TLAValue {
+	lhsBytes, lhsIsBytes := lhs.data.(*tlaValueBytes)
+	rhsBytes, rhsIsBytes := rhs.data.(*tlaValueBytes)
+	if lhsIsBytes && rhsIsBytes {
+		return makeTLABytesNoCopy(append(append(make([]byte, 0, len(lhsBytes.data)+len(rhsBytes.data)), lhsBytes.data...), rhsBytes.data...))
+	}
 	lhsTuple, rhsTuple := lhs.AsTuple(), rhs.AsTuple()
+	if lhsTuple.Len() < rhsTuple.Len() {
+		// prepend the shorter lhs onto rhs, back to front
+		it := lhsTuple.Iterator()
+		it.Last()
+		for !it.Done() {
+			_, elem := it.Prev()
+			rhsTuple = rhsTuple.Prepend(elem)
+		}
+		return TLAValue{&tlaValueTuple{List: rhsTuple}}
+	}
 	it := rhsTuple.Iterator()
 	for !it.Done() {
 		_, elem := it.Next()
 		lhsTuple = lhsTuple.Append(elem)
 	}
-	return TLAValue{&tlaValueTuple{lhsTuple}}
+	return TLAValue{&tlaValueTuple{List: lhsTuple}}
 }
 
 func TLA_Append(lhs, rhs TLAValue) TLAValue {
-	return TLAValue{&tlaValueTuple{lhs.AsTuple().Append(rhs)}}
+	if lhsBytes, ok := lhs.data.(*tlaValueBytes); ok {
+		// this copies the bytes, so building up a long byte string one byte at a time takes quadratic time
+		if b, ok := asByte(rhs); ok {
+			return makeTLABytesNoCopy(append(append(make([]byte, 0, len(lhsBytes.data)+1), lhsBytes.data...), b))
+		}
+	}
+	return TLAValue{&tlaValueTuple{List: lhs.AsTuple().Append(rhs)}}
 }
 
 func TLA_Head(v TLAValue) TLAValue {
+	if bs, ok := v.data.(*tlaValueBytes); ok {
+		require(len(bs.data) > 0, "to call Head, tuple must not be empty")
+		return MakeTLANumber(int32(bs.data[0]))
+	}
 	tuple := v.AsTuple()
 	require(tuple.Len() > 0, "to call Head, tuple must not be empty")
 	return tuple.Get(0).(TLAValue)
 }
 
 func TLA_Tail(v TLAValue) TLAValue {
+	if bs, ok := v.data.(*tlaValueBytes); ok {
+		require(len(bs.data) > 0, "to call Tail, tuple must not be empty")
+		return makeTLABytesNoCopy(bs.data[1:])
+	}
 	tuple := v.AsTuple()
 	require(tuple.Len() > 0, "to call Tail, tuple must not be empty")
-	return TLAValue{&tlaValueTuple{tuple.Slice(1, tuple.Len())}}
+	return TLAValue{&tlaValueTuple{List: tuple.Slice(1, tuple.Len())}}
 }
 
 func TLA_SubSeq(v, m, n TLAValue) TLAValue {
-	tuple := v.AsTuple()
 	from, to := int(m.AsNumber()), int(n.AsNumber())
-	require(from <= to && from >= 1 && to <= tuple.Len(), "to call SubSeq, from and to indices must be in-bounds")
-	return TLAValue{&tlaValueTuple{tuple.Slice(from-1, to)}}
+	if from > to {
+		// as in TLA+, the subsequence from m to n is empty if m > n, whatever the indices
+		return MakeTLATuple()
+	}
+	require(from >= 1 && to <= seqLen(v), "to call SubSeq, from and to indices must be in-bounds")
+	if bs, ok := v.data.(*tlaValueBytes); ok {
+		return makeTLABytesNoCopy(bs.data[from-1 : to])
+	}
+	return TLAValue{&tlaValueTuple{List: v.AsTuple().Slice(from-1, to)}}
 }
 
-// TODO: TLA_SelectSeq, uses predicate
-func TLA_SelectSeq(a, b TLAValue) TLAValue {
-	panic("implement me")
+// TLA_SelectSeq is the subsequence of the elements of v for which test holds, in order. Unlike the other operators,
+// it takes its test as a Go function, like TLASetRefinement does.
+func TLA_SelectSeq(v TLAValue, test func(elem TLAValue) bool) TLAValue {
+	tuple := v.AsTuple()
+	builder := immutable.NewListBuilder()
+	kept := 0
+	it := tuple.Iterator()
+	for !it.Done() {
+		_, elem := it.Next()
+		if test(elem.(TLAValue)) {
+			builder.Append(elem)
+			kept++
+		}
+	}
+	if kept == tuple.Len() {
+		// nothing was left out, so share the original
+		return v
+	}
+	return TLAValue{&tlaValueTuple{List: builder.List()}}
 }
 
 // function-related
@@ -314,20 +581,80 @@ func TLA_SelectSeq(a, b TLAValue) TLAValue {
 func TLA_ColonGreaterThanSymbol(lhs, rhs TLAValue) TLAValue {
 	builder := immutable.NewMapBuilder(TLAValueHasher{})
 	builder.Set(lhs, rhs)
-	return TLAValue{&tlaValueFunction{builder.Map()}}
+	return TLAValue{&tlaValueFunction{Map: builder.Map()}}
+}
+
+// functionMap returns the mapping of the function v, where a tuple maps its indices to its elements.
+func functionMap(v TLAValue) *immutable.Map {
+	if v.IsTuple() {
+		tuple := v.AsTuple()
+		builder := immutable.NewMapBuilder(TLAValueHasher{})
+		it := tuple.Iterator()
+		for !it.Done() {
+			idx, elem := it.Next()
+			builder.Set(MakeTLANumber(int32(idx+1)), elem)
+		}
+		return builder.Map()
+	}
+	return v.AsFunction()
 }
 
+// TLA_DoubleAtSignSymbol is f @@ g, which maps each key of either function to its value in f if it has one, or in g
+// otherwise. If the keys of the result are 1..n, the result is a tuple.
 func TLA_DoubleAtSignSymbol(lhs, rhs TLAValue) TLAValue {
-	lhsFn, rhsFh := lhs.AsFunction(), rhs.AsFunction()
-	it := rhsFh.Iterator()
+	if lhs.IsTuple() && rhs.IsTuple() {
+		// the result is lhs, followed by whatever of rhs is past its end
+		lhsTuple, rhsTuple := lhs.AsTuple(), rhs.AsTuple()
+		if lhsTuple.Len() >= rhsTuple.Len() {
+			return lhs
+		}
+		it := lhsTuple.Iterator()
+		for !it.Done() {
+			idx, elem := it.Next()
+			rhsTuple = rhsTuple.Set(idx, elem)
+		}
+		return TLAValue{&tlaValueTuple{List: rhsTuple}}
+	}
+	lhsFn, rhsFn := functionMap(lhs), functionMap(rhs)
+	// add the smaller function to the larger one, sharing its structure, but never override a key of lhs
+	if lhsFn.Len() >= rhsFn.Len() {
+		it := rhsFn.Iterator()
+		for !it.Done() {
+			key, value := it.Next()
+			if _, ok := lhsFn.Get(key); !ok {
+				lhsFn = lhsFn.Set(key, value)
+			}
+		}
+		return functionOrTuple(lhsFn)
+	}
+	it := lhsFn.Iterator()
 	for !it.Done() {
 		key, value := it.Next()
-		lhsFn = lhsFn.Set(key, value)
+		rhsFn = rhsFn.Set(key, value)
 	}
-	return TLAValue{&tlaValueFunction{lhsFn}}
+	return functionOrTuple(rhsFn)
+}
+
+// functionOrTuple returns the function with the mapping fn, as a tuple if its domain is 1..n for some n > 0.
+func functionOrTuple(fn *immutable.Map) TLAValue {
+	if fn.Len() == 0 {
+		return TLAValue{&tlaValueFunction{Map: fn}}
+	}
+	builder := immutable.NewListBuilder()
+	for i := 1; i <= fn.Len(); i++ {
+		elem, ok := fn.Get(MakeTLANumber(int32(i)))
+		if !ok {
+			return TLAValue{&tlaValueFunction{Map: fn}}
+		}
+		builder.Append(elem)
+	}
+	return TLAValue{&tlaValueTuple{List: builder.List()}}
 }
 
 func TLA_DomainSymbol(v TLAValue) TLAValue {
+	if v.IsTuple() {
+		return makeTLAInterval(1, int32(seqLen(v)))
+	}
 	fn := v.AsFunction()
 	builder := immutable.NewMapBuilder(TLAValueHasher{})
 	it := fn.Iterator()
@@ -335,5 +662,5 @@ func TLA_DomainSymbol(v TLAValue) TLAValue {
 		domainElem, _ := it.Next()
 		builder.Set(domainElem, true)
 	}
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return TLAValue{&tlaValueSet{Map: builder.Map()}}
 }
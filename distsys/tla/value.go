@@ -8,8 +8,11 @@ import (
 	"fmt"
 	"hash/fnv"
 	"io"
+	"math"
+	"math/big"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/benbjohnson/immutable"
 )
@@ -19,10 +22,15 @@ var ErrTLAType = errors.New("TLA+ type error")
 func init() {
 	gob.Register(tlaValueBool(false))
 	gob.Register(tlaValueNumber(0))
+	gob.Register(&tlaValueBigNumber{})
+	gob.Register(&tlaValueReal{})
 	gob.Register(tlaValueString(""))
 	gob.Register(&tlaValueSet{})
+	gob.Register(&tlaValueInterval{})
 	gob.Register(&tlaValueTuple{})
+	gob.Register(&tlaValueBytes{})
 	gob.Register(&tlaValueFunction{})
+	gob.Register(&tlaValueCustom{})
 }
 
 type TLAValue struct {
@@ -59,16 +67,115 @@ func (v TLAValue) String() string {
 	}
 }
 
+// GobDecode decodes a value encoded by GobEncode, within the limits set by SetTLAValueLimits.
 func (v *TLAValue) GobDecode(input []byte) error {
-	buf := bytes.NewBuffer(input)
-	decoder := gob.NewDecoder(buf)
-	return decoder.Decode(&v.data)
+	value, err := decodeGob(input, 0, newValueBudget())
+	if err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
+
+// gobElement is an element, key or value of a set, tuple or function, as encoded by TLAValue.GobEncode. The
+// GobDecode methods of sets, tuples and functions leave their elements encoded, in their gobElements field, rather
+// than have gob call back into TLAValue.GobDecode for each of them, so that decodeGob can decode them itself,
+// keeping track of how deeply values are nested and how many were decoded, which would be lost across such calls.
+type gobElement []byte
+
+func (e *gobElement) GobDecode(input []byte) error {
+	*e = append(gobElement(nil), input...)
+	return nil
+}
+
+func decodeGob(input []byte, depth int, budget *valueBudget) (TLAValue, error) {
+	err := budget.enter(depth)
+	if err != nil {
+		return TLAValue{}, err
+	}
+	var data tlaValueImpl
+	err = gob.NewDecoder(bytes.NewReader(input)).Decode(&data)
+	if err != nil {
+		return TLAValue{}, err
+	}
+	var elems []gobElement
+	switch data := data.(type) {
+	case *tlaValueSet:
+		elems, data.gobElements = data.gobElements, nil
+	case *tlaValueTuple:
+		elems, data.gobElements = data.gobElements, nil
+	case *tlaValueFunction:
+		elems, data.gobElements = data.gobElements, nil
+	case *tlaValueBigNumber:
+		// the encoder may not have normalized the number, e.g. if it was another version; as numbers of different
+		// representations are not equal, put it in the one form MakeTLABigNumber gives
+		return MakeTLABigNumber(data.value), nil
+	case *tlaValueReal:
+		// likewise, reduce the fraction, and demote it to an integer if it is one
+		return MakeTLAReal(new(big.Rat).SetFrac(data.value.Num(), data.value.Denom())), nil
+	default:
+		return TLAValue{data}, nil
+	}
+	err = budget.expect(len(elems))
+	if err != nil {
+		return TLAValue{}, err
+	}
+	values := make([]TLAValue, len(elems))
+	for i, elem := range elems {
+		values[i], err = decodeGob(elem, depth+1, budget)
+		if err != nil {
+			return TLAValue{}, err
+		}
+	}
+	switch data := data.(type) {
+	case *tlaValueSet:
+		builder := immutable.NewMapBuilder(TLAValueHasher{})
+		for _, elem := range values {
+			builder.Set(elem, true)
+		}
+		data.Map = builder.Map()
+	case *tlaValueTuple:
+		builder := immutable.NewListBuilder()
+		for _, elem := range values {
+			builder.Append(elem)
+		}
+		data.List = builder.List()
+	case *tlaValueFunction:
+		// the keys and values alternate
+		builder := immutable.NewMapBuilder(TLAValueHasher{})
+		for i := 0; i < len(values); i += 2 {
+			builder.Set(values[i], values[i+1])
+		}
+		data.Map = builder.Map()
+	}
+	return TLAValue{data}, nil
+}
+
+// decodeGobElements reads a stream of encoded values, as written by the GobEncode methods of sets and tuples.
+func decodeGobElements(input []byte) ([]gobElement, error) {
+	decoder := gob.NewDecoder(bytes.NewReader(input))
+	var elems []gobElement
+	for {
+		var elem gobElement
+		err := decoder.Decode(&elem)
+		if errors.Is(err, io.EOF) {
+			return elems, nil
+		} else if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+	}
 }
 
 func (v *TLAValue) GobEncode() ([]byte, error) {
 	var buf bytes.Buffer
 	encoder := gob.NewEncoder(&buf)
-	err := encoder.Encode(&v.data)
+	data := v.data
+	if lazy, ok := data.(*tlaValueLazySet); ok {
+		// lazy sets cannot be sent, so send their elements
+		data = &tlaValueSet{Map: lazy.force()}
+	}
+	err := encoder.Encode(&data)
 	return buf.Bytes(), err
 }
 
@@ -87,9 +194,20 @@ func (v TLAValue) IsBool() bool {
 	}
 }
 
+// IsNumber reports whether v is an integer, whether or not it fits in 32 bits.
 func (v TLAValue) IsNumber() bool {
 	switch v.data.(type) {
-	case tlaValueNumber:
+	case tlaValueNumber, *tlaValueBigNumber:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsReal reports whether v is a real number, as made by division with TLA_SlashSymbol. Integers are real numbers too.
+func (v TLAValue) IsReal() bool {
+	switch v.data.(type) {
+	case tlaValueNumber, *tlaValueBigNumber, *tlaValueReal:
 		return true
 	default:
 		return false
@@ -107,7 +225,7 @@ func (v TLAValue) IsString() bool {
 
 func (v TLAValue) IsSet() bool {
 	switch v.data.(type) {
-	case *tlaValueSet:
+	case *tlaValueSet, *tlaValueLazySet, *tlaValueInterval:
 		return true
 	default:
 		return false
@@ -116,7 +234,7 @@ func (v TLAValue) IsSet() bool {
 
 func (v TLAValue) IsTuple() bool {
 	switch v.data.(type) {
-	case *tlaValueTuple:
+	case *tlaValueTuple, *tlaValueBytes:
 		return true
 	default:
 		return false
@@ -132,60 +250,200 @@ func (v TLAValue) IsFunction() bool {
 	}
 }
 
-func (v TLAValue) AsBool() bool {
+// kindName describes the kind of v, for error messages.
+func (v TLAValue) kindName() string {
+	switch {
+	case v.data == nil:
+		return "the undefined value"
+	case v.IsBool():
+		return "a boolean"
+	case v.IsNumber():
+		return "an integer"
+	case v.IsReal():
+		return "a real number"
+	case v.IsString():
+		return "a string"
+	case v.IsSet():
+		return "a set"
+	case v.IsTuple():
+		return "a tuple"
+	case v.IsFunction():
+		return "a function"
+	case v.IsCustom():
+		return fmt.Sprintf("a custom value of kind %q", v.AsCustom().TLAKind())
+	default:
+		return fmt.Sprintf("a value of type %T", v.data)
+	}
+}
+
+// maxPreviewLen is the length beyond which values are cut short in the messages of type errors.
+const maxPreviewLen = 64
+
+// kindError is the error returned by the TryAs accessors when v is not of the expected kind.
+func (v TLAValue) kindError(expected string) error {
+	preview := v.String()
+	if len(preview) > maxPreviewLen {
+		preview = preview[:maxPreviewLen] + "..."
+	}
+	return fmt.Errorf("%w: expected %s, but got %s: %s", ErrTLAType, expected, v.kindName(), preview)
+}
+
+// TryAsBool is like AsBool, but returns an error wrapping ErrTLAType rather than panicking if v is not a boolean.
+// The same goes for the other TryAs accessors, which allow resources to reject values of the wrong kind written by
+// generated code, rather than crash.
+func (v TLAValue) TryAsBool() (bool, error) {
 	switch data := v.data.(type) {
 	case tlaValueBool:
-		return bool(data)
+		return bool(data), nil
 	default:
-		panic(fmt.Errorf("%w: %v is not a boolean", ErrTLAType, v))
+		return false, v.kindError("a boolean")
+	}
+}
+
+func (v TLAValue) AsBool() bool {
+	b, err := v.TryAsBool()
+	if err != nil {
+		panic(err)
 	}
+	return b
 }
 
+func (v TLAValue) TryAsNumber() (int32, error) {
+	switch data := v.data.(type) {
+	case tlaValueNumber:
+		return int32(data), nil
+	case *tlaValueBigNumber:
+		return 0, v.kindError("an integer that fits in 32 bits")
+	default:
+		return 0, v.kindError("an integer")
+	}
+}
+
+// AsNumber returns the integer v, which must fit in 32 bits; use AsBigNumber for integers that may not.
 func (v TLAValue) AsNumber() int32 {
+	num, err := v.TryAsNumber()
+	if err != nil {
+		panic(err)
+	}
+	return num
+}
+
+func (v TLAValue) TryAsBigNumber() (*big.Int, error) {
 	switch data := v.data.(type) {
 	case tlaValueNumber:
-		return int32(data)
+		return big.NewInt(int64(data)), nil
+	case *tlaValueBigNumber:
+		return new(big.Int).Set(data.value), nil
 	default:
-		panic(fmt.Errorf("%w: %v is not a number", ErrTLAType, v))
+		return nil, v.kindError("an integer")
 	}
 }
 
-func (v TLAValue) AsString() string {
+// AsBigNumber returns the integer v, of any size. The result is a copy, which the caller may modify.
+func (v TLAValue) AsBigNumber() *big.Int {
+	num, err := v.TryAsBigNumber()
+	if err != nil {
+		panic(err)
+	}
+	return num
+}
+
+func (v TLAValue) TryAsReal() (*big.Rat, error) {
+	switch data := v.data.(type) {
+	case tlaValueNumber:
+		return big.NewRat(int64(data), 1), nil
+	case *tlaValueBigNumber:
+		return new(big.Rat).SetInt(data.value), nil
+	case *tlaValueReal:
+		return new(big.Rat).Set(data.value), nil
+	default:
+		return nil, v.kindError("a real number")
+	}
+}
+
+// AsReal returns the real number v, which may be an integer. The result is a copy, which the caller may modify.
+func (v TLAValue) AsReal() *big.Rat {
+	num, err := v.TryAsReal()
+	if err != nil {
+		panic(err)
+	}
+	return num
+}
+
+func (v TLAValue) TryAsString() (string, error) {
 	switch data := v.data.(type) {
 	case tlaValueString:
-		return string(data)
+		return string(data), nil
 	default:
-		panic(fmt.Errorf("%w: %v is not a string", ErrTLAType, v))
+		return "", v.kindError("a string")
 	}
 }
 
-func (v TLAValue) AsSet() *immutable.Map {
+func (v TLAValue) AsString() string {
+	str, err := v.TryAsString()
+	if err != nil {
+		panic(err)
+	}
+	return str
+}
+
+func (v TLAValue) TryAsSet() (*immutable.Map, error) {
 	switch data := v.data.(type) {
 	case *tlaValueSet:
-		return data.Map
+		return data.Map, nil
+	case *tlaValueLazySet:
+		return data.force(), nil
+	case *tlaValueInterval:
+		return data.force(), nil
 	default:
-		panic(fmt.Errorf("%w: %v is not a set", ErrTLAType, v))
+		return nil, v.kindError("a set")
 	}
 }
 
-func (v TLAValue) AsTuple() *immutable.List {
+func (v TLAValue) AsSet() *immutable.Map {
+	set, err := v.TryAsSet()
+	if err != nil {
+		panic(err)
+	}
+	return set
+}
+
+func (v TLAValue) TryAsTuple() (*immutable.List, error) {
 	switch data := v.data.(type) {
 	case *tlaValueTuple:
-		return data.List
+		return data.List, nil
+	case *tlaValueBytes:
+		return data.force(), nil
 	default:
-		panic(fmt.Errorf("%w: %v is not a tuple", ErrTLAType, v))
+		return nil, v.kindError("a tuple")
 	}
 }
 
-func (v TLAValue) AsFunction() *immutable.Map {
+func (v TLAValue) AsTuple() *immutable.List {
+	tuple, err := v.TryAsTuple()
+	if err != nil {
+		panic(err)
+	}
+	return tuple
+}
+
+func (v TLAValue) TryAsFunction() (*immutable.Map, error) {
 	switch data := v.data.(type) {
 	case *tlaValueFunction:
-		return data.Map
+		return data.Map, nil
 	default:
-		panic(fmt.Errorf("%w: %v is not a function", ErrTLAType, v))
+		return nil, v.kindError("a function")
 	}
 }
 
+func (v TLAValue) AsFunction() *immutable.Map {
+	fn, err := v.TryAsFunction()
+	if err != nil {
+		panic(err)
+	}
+	return fn
+}
+
 func (v TLAValue) SelectElement() TLAValue {
 	set := v.AsSet()
 	it := set.Iterator()
@@ -203,6 +461,10 @@ func (v TLAValue) ApplyFunction(argument TLAValue) TLAValue {
 		idx := int(argument.AsNumber())
 		require(idx >= 1 && idx <= data.Len(), "tuple indices must be in range; note that tuples are 1-indexed in TLA+")
 		return data.Get(idx - 1).(TLAValue)
+	case *tlaValueBytes:
+		idx := int(argument.AsNumber())
+		require(idx >= 1 && idx <= len(data.data), "tuple indices must be in range; note that tuples are 1-indexed in TLA+")
+		return MakeTLANumber(int32(data.data[idx-1]))
 	case *tlaValueFunction:
 		value, ok := data.Get(argument)
 		if !ok {
@@ -214,8 +476,10 @@ func (v TLAValue) ApplyFunction(argument TLAValue) TLAValue {
 	}
 }
 
+// PCalPrint implements PCal's print statement, printing v with PrintHook. Compiled archetypes print through
+// distsys.ArchetypeInterface.Print instead, so that the value goes to their context's logger.
 func (v TLAValue) PCalPrint() {
-	fmt.Println(v)
+	PrintHook(v)
 }
 
 type TLAValueHasher struct{}
@@ -287,13 +551,128 @@ func (v tlaValueNumber) Hash() uint32 {
 }
 
 func (v tlaValueNumber) Equal(other TLAValue) bool {
-	return other.IsNumber() && int32(v) == other.AsNumber()
+	// numbers that fit in 32 bits are never represented by a tlaValueBigNumber, so they cannot be equal to one
+	otherNum, ok := other.data.(tlaValueNumber)
+	return ok && v == otherNum
 }
 
 func (v tlaValueNumber) String() string {
 	return strconv.FormatInt(int64(v), 10)
 }
 
+// tlaValueBigNumber is an integer that does not fit in 32 bits. Arithmetic on numbers promotes its result to a
+// tlaValueBigNumber on overflow, and demotes it back to a tlaValueNumber when it fits again, so that every integer
+// has exactly one representation.
+type tlaValueBigNumber struct {
+	value *big.Int
+}
+
+var _ tlaValueImpl = &tlaValueBigNumber{}
+
+// MakeTLABigNumber makes a TLA+ integer of any size. It does not retain num.
+func MakeTLABigNumber(num *big.Int) TLAValue {
+	if num.IsInt64() {
+		return makeTLANumber64(num.Int64())
+	}
+	return TLAValue{&tlaValueBigNumber{new(big.Int).Set(num)}}
+}
+
+// makeTLANumber64 makes a TLA+ integer from num, which need not fit in 32 bits.
+func makeTLANumber64(num int64) TLAValue {
+	if num < math.MinInt32 || num > math.MaxInt32 {
+		return TLAValue{&tlaValueBigNumber{big.NewInt(num)}}
+	}
+	return MakeTLANumber(int32(num))
+}
+
+func (v *tlaValueBigNumber) Hash() uint32 {
+	h := fnv.New32()
+	err := binary.Write(h, binary.LittleEndian, int8(v.value.Sign()))
+	if err != nil {
+		panic(err)
+	}
+	_, err = h.Write(v.value.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return h.Sum32()
+}
+
+func (v *tlaValueBigNumber) Equal(other TLAValue) bool {
+	otherNum, ok := other.data.(*tlaValueBigNumber)
+	return ok && v.value.Cmp(otherNum.value) == 0
+}
+
+func (v *tlaValueBigNumber) String() string {
+	return v.value.String()
+}
+
+func (v *tlaValueBigNumber) GobEncode() ([]byte, error) {
+	return v.value.GobEncode()
+}
+
+func (v *tlaValueBigNumber) GobDecode(input []byte) error {
+	v.value = new(big.Int)
+	return v.value.GobDecode(input)
+}
+
+// tlaValueReal is a real number that is not an integer, represented exactly as a fraction. Arithmetic on reals
+// demotes integral results to integers, so that, as in TLA+, 4 / 2 = 2, and every real number has exactly one
+// representation.
+type tlaValueReal struct {
+	value *big.Rat
+}
+
+var _ tlaValueImpl = &tlaValueReal{}
+
+// MakeTLAReal makes a TLA+ real number, which is an integer if num is. It does not retain num.
+func MakeTLAReal(num *big.Rat) TLAValue {
+	if num.IsInt() {
+		return MakeTLABigNumber(num.Num())
+	}
+	return TLAValue{&tlaValueReal{new(big.Rat).Set(num)}}
+}
+
+func (v *tlaValueReal) Hash() uint32 {
+	h := fnv.New32()
+	err := binary.Write(h, binary.LittleEndian, int8(v.value.Sign()))
+	if err != nil {
+		panic(err)
+	}
+	// the fraction is always in lowest terms, so equal numbers have the same numerator and denominator
+	_, err = h.Write(v.value.Num().Bytes())
+	if err != nil {
+		panic(err)
+	}
+	_, err = h.Write([]byte{'/'})
+	if err != nil {
+		panic(err)
+	}
+	_, err = h.Write(v.value.Denom().Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return h.Sum32()
+}
+
+func (v *tlaValueReal) Equal(other TLAValue) bool {
+	otherNum, ok := other.data.(*tlaValueReal)
+	return ok && v.value.Cmp(otherNum.value) == 0
+}
+
+func (v *tlaValueReal) String() string {
+	return fmt.Sprintf("(%s / %s)", v.value.Num(), v.value.Denom())
+}
+
+func (v *tlaValueReal) GobEncode() ([]byte, error) {
+	return v.value.GobEncode()
+}
+
+func (v *tlaValueReal) GobDecode(input []byte) error {
+	v.value = new(big.Rat)
+	return v.value.GobDecode(input)
+}
+
 type tlaValueString string
 
 var _ tlaValueImpl = tlaValueString("")
@@ -320,8 +699,26 @@ func (v tlaValueString) String() string {
 	return strconv.Quote(string(v))
 }
 
+// hashCache caches the hash of an immutable composite value, so that it is computed at most once, however often the
+// value is compared or used as a key. Values are shared between goroutines, so it is accessed atomically. It must
+// come first in the struct that embeds it, for the 64-bit alignment that atomic access needs on 32-bit platforms.
+type hashCache struct {
+	hashPlusOne uint64 // the hash plus one, or 0 if not computed yet
+}
+
+func (c *hashCache) cachedHash(compute func() uint32) uint32 {
+	if hash := atomic.LoadUint64(&c.hashPlusOne); hash != 0 {
+		return uint32(hash - 1)
+	}
+	hash := compute()
+	atomic.StoreUint64(&c.hashPlusOne, uint64(hash)+1)
+	return hash
+}
+
 type tlaValueSet struct {
+	hashCache
 	*immutable.Map
+	gobElements []gobElement // only set while being decoded; see gobElement
 }
 
 var _ tlaValueImpl = new(tlaValueSet)
@@ -331,56 +728,61 @@ func MakeTLASet(members ...TLAValue) TLAValue {
 	for _, member := range members {
 		builder.Set(member, true)
 	}
-	return TLAValue{&tlaValueSet{builder.Map()}}
+	return TLAValue{&tlaValueSet{Map: builder.Map()}}
 }
 
 func MakeTLASetFromMap(m *immutable.Map) TLAValue {
-	return TLAValue{&tlaValueSet{m}}
+	return TLAValue{&tlaValueSet{Map: m}}
 }
 
 func (v *tlaValueSet) Hash() uint32 {
-	var hash uint32 = 0
-	it := v.Iterator()
-	for !it.Done() {
-		key, _ := it.Next()
-		keyV := key.(TLAValue)
-		// use XOR combination, so that all the set members are hashed out of order
-		hash ^= keyV.Hash()
-	}
-	h := fnv.New32()
-	err := binary.Write(h, binary.LittleEndian, hash)
-	if err != nil {
-		panic(err)
-	}
-	return h.Sum32()
+	return v.cachedHash(func() uint32 {
+		var hash uint32 = 0
+		var sum uint64 = 0
+		it := v.Iterator()
+		for !it.Done() {
+			key, _ := it.Next()
+			keyV := key.(TLAValue)
+			// use XOR combination, or a sum for numbers, so that all the set members are hashed out of order; see
+			// tlaValueInterval.Hash for why numbers differ
+			if num, ok := keyV.data.(tlaValueNumber); ok {
+				sum = (sum + setHashNumberTerm(int32(num))) % setHashModulus
+			} else {
+				hash ^= keyV.Hash()
+			}
+		}
+		return finishSetHash(hash, sum)
+	})
 }
 
 func (v *tlaValueSet) Equal(other TLAValue) bool {
 	if !other.IsSet() {
 		return false
 	}
+	if interval, ok := other.data.(*tlaValueInterval); ok {
+		return interval.Equal(TLAValue{v})
+	}
+	if otherSet, ok := other.data.(*tlaValueSet); ok && otherSet.Map == v.Map {
+		return true
+	}
 	oC := other.AsSet()
 	if v.Len() != oC.Len() {
 		return false
-	} else {
-		it := v.Iterator()
-		for !it.Done() {
-			k, _ := it.Next()
-			_, ok := oC.Get(k)
-			if !ok {
-				return false
-			}
-		}
-		it = oC.Iterator()
-		for !it.Done() {
-			k, _ := it.Next()
-			_, ok := v.Get(k)
-			if !ok {
-				return false
-			}
+	}
+	// hashes are cached, so that comparing values that differ only costs this much the first time
+	if v.Hash() != other.Hash() {
+		return false
+	}
+	// the sets have the same size, so they are equal if one contains all the elements of the other
+	it := v.Iterator()
+	for !it.Done() {
+		k, _ := it.Next()
+		_, ok := oC.Get(k)
+		if !ok {
+			return false
 		}
-		return true
 	}
+	return true
 }
 
 func (v *tlaValueSet) String() string {
@@ -417,26 +819,18 @@ func (v *tlaValueSet) GobEncode() ([]byte, error) {
 }
 
 func (v *tlaValueSet) GobDecode(input []byte) error {
-	buf := bytes.NewBuffer(input)
-	decoder := gob.NewDecoder(buf)
-	builder := immutable.NewMapBuilder(TLAValueHasher{})
-	for {
-		var elem TLAValue
-		err := decoder.Decode(&elem)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				v.Map = builder.Map()
-				return nil
-			} else {
-				return err
-			}
-		}
-		builder.Set(elem, true)
+	elems, err := decodeGobElements(input)
+	if err != nil {
+		return err
 	}
+	v.gobElements = elems
+	return nil
 }
 
 type tlaValueTuple struct {
+	hashCache
 	*immutable.List
+	gobElements []gobElement // only set while being decoded; see gobElement
 }
 
 var _ tlaValueImpl = new(tlaValueTuple)
@@ -446,34 +840,43 @@ func MakeTLATuple(members ...TLAValue) TLAValue {
 	for _, member := range members {
 		builder.Append(member)
 	}
-	return TLAValue{&tlaValueTuple{builder.List()}}
+	return TLAValue{&tlaValueTuple{List: builder.List()}}
 }
 
 func MakeTLATupleFromList(list *immutable.List) TLAValue {
-	return TLAValue{&tlaValueTuple{list}}
+	return TLAValue{&tlaValueTuple{List: list}}
 }
 
 func (v *tlaValueTuple) Hash() uint32 {
-	h := fnv.New32()
-	it := v.Iterator()
-	for !it.Done() {
-		_, member := it.Next()
-		memberV := member.(TLAValue)
-		err := binary.Write(h, binary.LittleEndian, memberV.Hash())
-		if err != nil {
-			panic(err)
+	return v.cachedHash(func() uint32 {
+		h := fnv.New32()
+		it := v.Iterator()
+		for !it.Done() {
+			_, member := it.Next()
+			memberV := member.(TLAValue)
+			err := binary.Write(h, binary.LittleEndian, memberV.Hash())
+			if err != nil {
+				panic(err)
+			}
 		}
-	}
-	return h.Sum32()
+		return h.Sum32()
+	})
 }
 
 func (v *tlaValueTuple) Equal(other TLAValue) bool {
+	if otherBytes, ok := other.data.(*tlaValueBytes); ok {
+		// without materializing the byte string
+		return otherBytes.Equal(TLAValue{v})
+	}
 	if !other.IsTuple() {
 		return false
 	}
 
 	otherTuple := other.AsTuple()
-	if v.Len() != otherTuple.Len() {
+	if otherTuple == v.List {
+		return true
+	}
+	if v.Len() != otherTuple.Len() || v.Hash() != other.Hash() {
 		return false
 	}
 	it1, it2 := v.Iterator(), otherTuple.Iterator()
@@ -521,26 +924,18 @@ func (v *tlaValueTuple) GobEncode() ([]byte, error) {
 }
 
 func (v *tlaValueTuple) GobDecode(input []byte) error {
-	buf := bytes.NewBuffer(input)
-	decoder := gob.NewDecoder(buf)
-	builder := immutable.NewListBuilder()
-	for {
-		var elem TLAValue
-		err := decoder.Decode(&elem)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				v.List = builder.List()
-				return nil
-			} else {
-				return err
-			}
-		}
-		builder.Append(elem)
+	elems, err := decodeGobElements(input)
+	if err != nil {
+		return err
 	}
+	v.gobElements = elems
+	return nil
 }
 
 type tlaValueFunction struct {
+	hashCache
 	*immutable.Map
+	gobElements []gobElement // only set while being decoded, with keys and values alternating; see gobElement
 }
 
 type TLARecordField struct {
@@ -588,7 +983,7 @@ func MakeTLAFunction(setVals []TLAValue, body func([]TLAValue) TLAValue) TLAValu
 	}
 	helper(0)
 
-	return TLAValue{&tlaValueFunction{builder.Map()}}
+	return TLAValue{&tlaValueFunction{Map: builder.Map()}}
 }
 
 func MakeTLARecord(pairs []TLARecordField) TLAValue {
@@ -596,17 +991,22 @@ func MakeTLARecord(pairs []TLARecordField) TLAValue {
 	for _, pair := range pairs {
 		builder.Set(pair.Key, pair.Value)
 	}
-	return TLAValue{&tlaValueFunction{builder.Map()}}
+	return TLAValue{&tlaValueFunction{Map: builder.Map()}}
 }
 
 func MakeTLARecordFromMap(m *immutable.Map) TLAValue {
-	return TLAValue{&tlaValueFunction{m}}
+	return TLAValue{&tlaValueFunction{Map: m}}
 }
 
 func MakeTLARecordSet(pairs []TLARecordField) TLAValue {
+	sizes := make([]int, len(pairs))
+	for i, pair := range pairs {
+		sizes[i] = setLen(pair.Value)
+	}
+	requireMaterializable(sizes...)
 	recordSet := immutable.NewMap(TLAValueHasher{})
 	// start with a set of one empty map
-	recordSet = recordSet.Set(TLAValue{&tlaValueFunction{immutable.NewMap(TLAValueHasher{})}}, true)
+	recordSet = recordSet.Set(TLAValue{&tlaValueFunction{Map: immutable.NewMap(TLAValueHasher{})}}, true)
 	for _, pair := range pairs {
 		fieldValueSet := pair.Value.AsSet()
 		builder := immutable.NewMapBuilder(TLAValueHasher{})
@@ -618,12 +1018,12 @@ func MakeTLARecordSet(pairs []TLARecordField) TLAValue {
 			valIt := fieldValueSet.Iterator()
 			for !valIt.Done() {
 				val, _ := valIt.Next()
-				builder.Set(TLAValue{&tlaValueFunction{accFn.Set(pair.Key, val)}}, true)
+				builder.Set(TLAValue{&tlaValueFunction{Map: accFn.Set(pair.Key, val)}}, true)
 			}
 		}
 		recordSet = builder.Map()
 	}
-	return TLAValue{&tlaValueSet{recordSet}}
+	return TLAValue{&tlaValueSet{Map: recordSet}}
 }
 
 func MakeTLAFunctionSet(from, to TLAValue) TLAValue {
@@ -641,18 +1041,20 @@ func MakeTLAFunctionSet(from, to TLAValue) TLAValue {
 }
 
 func (v *tlaValueFunction) Hash() uint32 {
-	var hash uint32
-	it := v.Iterator()
-	for !it.Done() {
-		key, value := it.Next()
-		hash ^= TLARecordField{Key: key.(TLAValue), Value: value.(TLAValue)}.Hash()
-	}
-	h := fnv.New32()
-	err := binary.Write(h, binary.LittleEndian, hash)
-	if err != nil {
-		panic(err)
-	}
-	return h.Sum32()
+	return v.cachedHash(func() uint32 {
+		var hash uint32
+		it := v.Iterator()
+		for !it.Done() {
+			key, value := it.Next()
+			hash ^= TLARecordField{Key: key.(TLAValue), Value: value.(TLAValue)}.Hash()
+		}
+		h := fnv.New32()
+		err := binary.Write(h, binary.LittleEndian, hash)
+		if err != nil {
+			panic(err)
+		}
+		return h.Sum32()
+	})
 }
 
 func (v *tlaValueFunction) Equal(other TLAValue) bool {
@@ -661,7 +1063,10 @@ func (v *tlaValueFunction) Equal(other TLAValue) bool {
 	}
 
 	otherFunction := other.AsFunction()
-	if v.Len() != otherFunction.Len() {
+	if otherFunction == v.Map {
+		return true
+	}
+	if v.Len() != otherFunction.Len() || v.Hash() != other.Hash() {
 		return false
 	}
 	it := v.Iterator()
@@ -721,20 +1126,21 @@ func (v *tlaValueFunction) GobEncode() ([]byte, error) {
 }
 
 func (v *tlaValueFunction) GobDecode(input []byte) error {
-	buf := bytes.NewBuffer(input)
-	decoder := gob.NewDecoder(buf)
-	builder := immutable.NewMapBuilder(TLAValueHasher{})
+	decoder := gob.NewDecoder(bytes.NewReader(input))
+	var elems []gobElement
 	for {
-		var field TLARecordField
+		// decodes a TLARecordField, as encoded by GobEncode
+		var field struct {
+			Key, Value gobElement
+		}
 		err := decoder.Decode(&field)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				v.Map = builder.Map()
-				return nil
-			} else {
-				return err
-			}
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
 		}
-		builder.Set(field.Key, field.Value)
+		elems = append(elems, field.Key, field.Value)
 	}
+	v.gobElements = elems
+	return nil
 }
@@ -0,0 +1,59 @@
+package tla
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func FuzzTLAValueGob(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 1})                            // MaxInt32
+	f.Add([]byte{3, 0})                            // {}
+	f.Add([]byte{7, 2, 3, 0, 4, 0})                // a function from {} to <<>>
+	f.Add([]byte{4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4}) // nested tuples
+	f.Add([]byte{2, 3, 0xff, 0xfe, 0xfd})          // a string that is not valid UTF-8
+	f.Add([]byte{6, 3, 1, 2, 3})                   // a byte string
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value := ArbitraryTLAValue(data)
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(&value); err != nil {
+			t.Fatalf("could not encode %v: %v", value, err)
+		}
+		var decoded TLAValue
+		if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+			t.Fatalf("could not decode %v: %v", value, err)
+		}
+		if !decoded.Equal(value) {
+			t.Fatalf("%v was decoded as %v", value, decoded)
+		}
+		if decoded.Hash() != value.Hash() {
+			t.Fatalf("%v has hash %d, but was decoded with hash %d", value, value.Hash(), decoded.Hash())
+		}
+		if decoded.String() != value.String() {
+			t.Fatalf("%v was decoded as %v, which prints differently", value, decoded)
+		}
+	})
+}
+
+func FuzzTLAValueFormat(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{3, 0})                   // {}
+	f.Add([]byte{7, 2, 3, 0, 4, 0})       // a function from {} to <<>>
+	f.Add([]byte{2, 3, '"', '\\', '\n'})  // a string that needs escaping
+	f.Add([]byte{2, 3, 0xff, 0xfe, 0xfd}) // a string that is not valid UTF-8
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value := ArbitraryTLAValue(data)
+		formatted := Format(value)
+		parsed, err := Parse(formatted)
+		if err != nil {
+			t.Fatalf("could not parse %s, the formatting of %v: %v", formatted, value, err)
+		}
+		if !parsed.Equal(value) {
+			t.Fatalf("%v was formatted as %s, which was parsed as %v", value, formatted, parsed)
+		}
+		if reformatted := Format(parsed); reformatted != formatted {
+			t.Fatalf("%v was formatted as %s, but after parsing as %s", value, formatted, reformatted)
+		}
+	})
+}
@@ -1,6 +1,19 @@
 package tla
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -35,6 +48,69 @@ func TestTLAModel(t *testing.T) {
 			},
 			ExpectedResult: "[x \\in {} |-> x]",
 		},
+		{
+			Name: "2147483647 + 1",
+			Operation: func() TLAValue {
+				return TLA_PlusSymbol(MakeTLANumber(math.MaxInt32), MakeTLANumber(1))
+			},
+			ExpectedResult: "2147483648",
+		},
+		{
+			Name: "2^40 - 2^40 + 1 = 1",
+			Operation: func() TLAValue {
+				big := TLA_SuperscriptSymbol(MakeTLANumber(2), MakeTLANumber(40))
+				return TLA_EqualsSymbol(TLA_PlusSymbol(TLA_MinusSymbol(big, big), MakeTLANumber(1)), MakeTLANumber(1))
+			},
+			ExpectedResult: "TRUE",
+		},
+		{
+			Name: "-2147483648 \\div -1 > 2147483647",
+			Operation: func() TLAValue {
+				return TLA_GreaterThanSymbol(TLA_DivSymbol(MakeTLANumber(math.MinInt32), MakeTLANumber(-1)), MakeTLANumber(math.MaxInt32))
+			},
+			ExpectedResult: "TRUE",
+		},
+		{
+			Name: "6 / 8",
+			Operation: func() TLAValue {
+				return TLA_SlashSymbol(MakeTLANumber(6), MakeTLANumber(8))
+			},
+			ExpectedResult: "(3 / 4)",
+		},
+		{
+			Name: "1 / 3 + 2 / 3 = 1",
+			Operation: func() TLAValue {
+				return TLA_EqualsSymbol(TLA_PlusSymbol(
+					TLA_SlashSymbol(MakeTLANumber(1), MakeTLANumber(3)),
+					TLA_SlashSymbol(MakeTLANumber(2), MakeTLANumber(3))), MakeTLANumber(1))
+			},
+			ExpectedResult: "TRUE",
+		},
+		{
+			Name: "2^-2 < 1 / 3",
+			Operation: func() TLAValue {
+				return TLA_LessThanSymbol(
+					TLA_SuperscriptSymbol(MakeTLANumber(2), MakeTLANumber(-2)),
+					TLA_SlashSymbol(MakeTLANumber(1), MakeTLANumber(3)))
+			},
+			ExpectedResult: "TRUE",
+		},
+		{
+			Name: "SubSeq(<<1, 2>>, 3, 2)",
+			Operation: func() TLAValue {
+				return TLA_SubSeq(MakeTLATuple(MakeTLANumber(1), MakeTLANumber(2)), MakeTLANumber(3), MakeTLANumber(2))
+			},
+			ExpectedResult: "<<>>",
+		},
+		{
+			Name: "SelectSeq(<<1, 2, 3, 4>>, IsEven)",
+			Operation: func() TLAValue {
+				return TLA_SelectSeq(MakeTLATuple(MakeTLANumber(1), MakeTLANumber(2), MakeTLANumber(3), MakeTLANumber(4)), func(elem TLAValue) bool {
+					return TLA_PercentSymbol(elem, MakeTLANumber(2)).Equal(TLA_Zero)
+				})
+			},
+			ExpectedResult: "<<2, 4>>",
+		},
 		{
 			Name: "1 .. 3",
 			Operation: func() TLAValue {
@@ -42,6 +118,97 @@ func TestTLAModel(t *testing.T) {
 			},
 			ExpectedResult: "{1, 2, 3, 4}",
 		},
+		{
+			Name: "BagCardinality(SetToBag({1, 2}) (+) SetToBag({2}))",
+			Operation: func() TLAValue {
+				return TLA_BagCardinality(TLA_OPlusSymbol(
+					TLA_SetToBag(MakeTLASet(MakeTLANumber(1), MakeTLANumber(2))),
+					TLA_SetToBag(MakeTLASet(MakeTLANumber(2)))))
+			},
+			ExpectedResult: "3",
+		},
+		{
+			Name: "CopiesIn(2, (SetToBag({1, 2}) (+) SetToBag({2})) (-) SetToBag({1}))",
+			Operation: func() TLAValue {
+				bag := TLA_OMinusSymbol(TLA_OPlusSymbol(
+					TLA_SetToBag(MakeTLASet(MakeTLANumber(1), MakeTLANumber(2))),
+					TLA_SetToBag(MakeTLASet(MakeTLANumber(2)))),
+					TLA_SetToBag(MakeTLASet(MakeTLANumber(1))))
+				return MakeTLATuple(TLA_CopiesIn(MakeTLANumber(2), bag), TLA_BagToSet(bag))
+			},
+			ExpectedResult: "<<2, {2}>>",
+		},
+		{
+			Name: "Cardinality(Permutations(1 .. 4))",
+			Operation: func() TLAValue {
+				return TLA_Cardinality(TLA_Permutations(TLA_DotDotSymbol(MakeTLANumber(1), MakeTLANumber(4))))
+			},
+			ExpectedResult: "24",
+		},
+		{
+			Name: "SortSeq(<<3, 1, 2>>, <)",
+			Operation: func() TLAValue {
+				return TLA_SortSeq(MakeTLATuple(MakeTLANumber(3), MakeTLANumber(1), MakeTLANumber(2)), func(lhs, rhs TLAValue) bool {
+					return TLA_LessThanSymbol(lhs, rhs).AsBool()
+				})
+			},
+			ExpectedResult: "<<1, 2, 3>>",
+		},
+		{
+			Name: "(1 :> \"a\") @@ <<\"b\", \"c\">>",
+			Operation: func() TLAValue {
+				return TLA_DoubleAtSignSymbol(
+					TLA_ColonGreaterThanSymbol(MakeTLANumber(1), MakeTLAString("a")),
+					MakeTLATuple(MakeTLAString("b"), MakeTLAString("c")))
+			},
+			ExpectedResult: "<<\"a\", \"c\">>",
+		},
+		{
+			Name: "<<1, 2>> @@ <<3, 4, 5>>",
+			Operation: func() TLAValue {
+				return TLA_DoubleAtSignSymbol(
+					MakeTLATuple(MakeTLANumber(1), MakeTLANumber(2)),
+					MakeTLATuple(MakeTLANumber(3), MakeTLANumber(4), MakeTLANumber(5)))
+			},
+			ExpectedResult: "<<1, 2, 5>>",
+		},
+		{
+			Name: "<<1, 2, 3>> @@ <<4>>",
+			Operation: func() TLAValue {
+				return TLA_DoubleAtSignSymbol(
+					MakeTLATuple(MakeTLANumber(1), MakeTLANumber(2), MakeTLANumber(3)),
+					MakeTLATuple(MakeTLANumber(4)))
+			},
+			ExpectedResult: "<<1, 2, 3>>",
+		},
+		{
+			Name: "(3 :> \"a\") @@ <<\"b\">>",
+			Operation: func() TLAValue {
+				return TLA_DoubleAtSignSymbol(
+					TLA_ColonGreaterThanSymbol(MakeTLANumber(3), MakeTLAString("a")),
+					MakeTLATuple(MakeTLAString("b")))
+			},
+			ExpectedResult: "((3) :> (\"a\") @@ (1) :> (\"b\"))",
+		},
+		{
+			Name: "[<<<<1>>, 2>> EXCEPT ![1][1] = 3]",
+			Operation: func() TLAValue {
+				return TLAFunctionUpdate(
+					MakeTLATuple(MakeTLATuple(MakeTLANumber(1)), MakeTLANumber(2)),
+					[]TLAValue{MakeTLANumber(1), MakeTLANumber(1)},
+					MakeTLANumber(3))
+			},
+			ExpectedResult: "<<<<3>>, 2>>",
+		},
+		{
+			Name: "SubSeq(bytes(\"abc\") \\o bytes(\"de\"), 2, 4) = <<98, 99, 100>>",
+			Operation: func() TLAValue {
+				joined := TLA_OSymbol(MakeTLABytes([]byte("abc")), MakeTLABytes([]byte("de")))
+				return TLA_EqualsSymbol(TLA_SubSeq(joined, MakeTLANumber(2), MakeTLANumber(4)),
+					MakeTLATuple(MakeTLANumber(98), MakeTLANumber(99), MakeTLANumber(100)))
+			},
+			ExpectedResult: "TRUE",
+		},
 	}
 
 	for _, test := range tests {
@@ -54,3 +221,748 @@ func TestTLAModel(t *testing.T) {
 		})
 	}
 }
+
+func TestTLAValueJSON(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Value    TLAValue
+		Expected string
+	}{
+		{"number", MakeTLANumber(-42), `{"number":-42}`},
+		{"big number", TLA_AsteriskSymbol(MakeTLANumber(math.MaxInt32), MakeTLANumber(4)), `{"number":8589934588}`},
+		{"real", TLA_SlashSymbol(MakeTLANumber(-6), MakeTLANumber(8)), `{"real":"-3/4"}`},
+		{"string", MakeTLAString("foo"), `{"string":"foo"}`},
+		{"bool", TLA_TRUE, `{"bool":true}`},
+		{"zero", TLAValue{}, `null`},
+		{"set", MakeTLASet(MakeTLANumber(2), MakeTLANumber(1)), `{"set":[{"number":1},{"number":2}]}`},
+		{"tuple", MakeTLATuple(MakeTLANumber(2), MakeTLANumber(1)), `{"tuple":[{"number":2},{"number":1}]}`},
+		{"record", MakeTLARecord([]TLARecordField{
+			{Key: MakeTLAString("b"), Value: TLA_FALSE},
+			{Key: MakeTLAString("a"), Value: MakeTLATuple()},
+		}), `{"record":{"a":{"tuple":[]},"b":{"bool":false}}}`},
+		{"function", MakeTLARecord([]TLARecordField{
+			{Key: MakeTLANumber(1), Value: MakeTLAString("x")},
+		}), `{"function":[{"key":{"number":1},"value":{"string":"x"}}]}`},
+		{"empty function", MakeTLARecord(nil), `{"function":[]}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			encoded, err := json.Marshal(test.Value)
+			if err != nil {
+				t.Fatalf("could not encode %v: %v", test.Value, err)
+			}
+			if string(encoded) != test.Expected {
+				t.Errorf("%v was encoded as %s, expected %s", test.Value, encoded, test.Expected)
+			}
+			var decoded TLAValue
+			if err := json.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatalf("could not decode %s: %v", encoded, err)
+			}
+			if !decoded.Equal(test.Value) {
+				t.Errorf("%s was decoded as %v, expected %v", encoded, decoded, test.Value)
+			}
+		})
+	}
+
+	for _, invalid := range []string{`{}`, `{"number":1,"bool":true}`, `{"number":1.5}`, `{"color":"red"}`,
+		`{"function":[{"key":{"number":1},"value":{"number":1}},{"key":{"number":1},"value":{"number":2}}]}`} {
+		var decoded TLAValue
+		if err := json.Unmarshal([]byte(invalid), &decoded); err == nil {
+			t.Errorf("%s was decoded as %v, expected an error", invalid, decoded)
+		}
+	}
+}
+
+func TestTLAValueTryAs(t *testing.T) {
+	if num, err := MakeTLANumber(42).TryAsNumber(); err != nil || num != 42 {
+		t.Errorf("TryAsNumber of 42 returned %d, %v", num, err)
+	}
+
+	_, err := MakeTLAString("foo").TryAsNumber()
+	if !errors.Is(err, ErrTLAType) {
+		t.Fatalf("TryAsNumber of a string returned %v, expected a type error", err)
+	}
+	expected := `TLA+ type error: expected an integer, but got a string: "foo"`
+	if err.Error() != expected {
+		t.Errorf("TryAsNumber of a string returned %q, expected %q", err.Error(), expected)
+	}
+
+	_, err = TLA_AsteriskSymbol(MakeTLANumber(math.MaxInt32), MakeTLANumber(2)).TryAsNumber()
+	if !errors.Is(err, ErrTLAType) {
+		t.Errorf("TryAsNumber of a big number returned %v, expected a type error", err)
+	}
+
+	_, err = TLA_DotDotSymbol(MakeTLANumber(1), MakeTLANumber(1000)).TryAsTuple()
+	if !errors.Is(err, ErrTLAType) || len(err.Error()) > 200 {
+		t.Errorf("TryAsTuple of a large set returned %v, expected a short type error", err)
+	}
+}
+
+func TestTLAValueFormat(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Value    TLAValue
+		Expected string
+	}{
+		{"number", MakeTLANumber(-42), "-42"},
+		{"real", TLA_SlashSymbol(MakeTLANumber(-6), MakeTLANumber(8)), "(-3 / 4)"},
+		{"string", MakeTLAString("a \"b\"\n"), `"a \"b\"\n"`},
+		{"set", MakeTLASet(MakeTLAString("a"), MakeTLANumber(10), MakeTLANumber(2), TLA_FALSE), `{FALSE, 2, 10, "a"}`},
+		{"interval", TLA_DotDotSymbol(MakeTLANumber(1), MakeTLANumber(3)), "{1, 2, 3}"},
+		{"tuple", MakeTLATuple(TLA_TRUE, MakeTLATuple()), "<<TRUE, <<>>>>"},
+		{"record", MakeTLARecord([]TLARecordField{
+			{Key: MakeTLAString("b"), Value: MakeTLANumber(2)},
+			{Key: MakeTLAString("a"), Value: MakeTLANumber(1)},
+		}), "[a |-> 1, b |-> 2]"},
+		{"function", MakeTLARecord([]TLARecordField{
+			{Key: MakeTLAString("not an identifier"), Value: MakeTLANumber(2)},
+			{Key: MakeTLANumber(1), Value: MakeTLANumber(1)},
+		}), `(1 :> 1 @@ "not an identifier" :> 2)`},
+		{"empty function", MakeTLARecord(nil), `[x \in {} |-> x]`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			formatted := Format(test.Value)
+			if formatted != test.Expected {
+				t.Errorf("%v was formatted as %s, expected %s", test.Value, formatted, test.Expected)
+			}
+			parsed, err := Parse(formatted)
+			if err != nil {
+				t.Fatalf("could not parse %s: %v", formatted, err)
+			}
+			if !parsed.Equal(test.Value) {
+				t.Errorf("%s was parsed as %v, expected %v", formatted, parsed, test.Value)
+			}
+		})
+	}
+
+	for input, expected := range map[string]string{
+		" 1..3 ":                   "{1, 2, 3}",
+		"{3, 1 .. 2}":              "{3, {1, 2}}",
+		"[b |-> 0.75, a |-> <<>>]": "[a |-> <<>>, b |-> (3 / 4)]",
+		"(1 :> 2) @@ (1 :> 3)":     "<<2>>",
+		"(2 :> 2) @@ (3 :> 3)":     "(2 :> 2 @@ 3 :> 3)",
+	} {
+		parsed, err := Parse(input)
+		if err != nil {
+			t.Errorf("could not parse %s: %v", input, err)
+		} else if Format(parsed) != expected {
+			t.Errorf("%s was parsed as %s, expected %s", input, Format(parsed), expected)
+		}
+	}
+
+	for _, invalid := range []string{"", "{1, 2", "[a |-> 1, a |-> 2]", `"\q"`, "1 / 0", "TRUE .. 2", "foo", "1 2"} {
+		if parsed, err := Parse(invalid); !errors.Is(err, ErrTLAParse) {
+			t.Errorf("%s was parsed as %v, expected a parse error", invalid, parsed)
+		}
+	}
+}
+
+// testBlob is a custom value holding bytes.
+type testBlob []byte
+
+func (b testBlob) TLAKind() string {
+	return "testBlob"
+}
+
+func (b testBlob) Equal(other TLACustomValue) bool {
+	return bytes.Equal(b, other.(testBlob))
+}
+
+func (b testBlob) Hash() uint32 {
+	h := fnv.New32()
+	_, _ = h.Write(b)
+	return h.Sum32()
+}
+
+func (b testBlob) String() string {
+	return fmt.Sprintf("blob(%x)", []byte(b))
+}
+
+func (b testBlob) MarshalBinary() ([]byte, error) {
+	return b, nil
+}
+
+func init() {
+	RegisterTLACustomKind("testBlob", func(data []byte) (TLACustomValue, error) {
+		return testBlob(data), nil
+	})
+}
+
+func TestTLAValueCustom(t *testing.T) {
+	blob := MakeTLACustomValue(testBlob{1, 2, 3})
+	set := MakeTLASet(blob, MakeTLACustomValue(testBlob{1, 2, 3}), MakeTLACustomValue(testBlob{4}), MakeTLANumber(1))
+	if set.AsSet().Len() != 3 {
+		t.Errorf("%v should have 3 elements", set)
+	}
+	if !TLA_InSymbol(MakeTLACustomValue(testBlob{4}), set).AsBool() {
+		t.Errorf("%v should contain blob(04)", set)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&set); err != nil {
+		t.Fatalf("could not encode %v: %v", set, err)
+	}
+	var decoded TLAValue
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("could not decode %v: %v", set, err)
+	}
+	if !decoded.Equal(set) {
+		t.Errorf("%v was decoded as %v", set, decoded)
+	}
+
+	encoded, err := json.Marshal(blob)
+	if err != nil {
+		t.Fatalf("could not encode %v as JSON: %v", blob, err)
+	}
+	if expected := `{"custom":{"kind":"testBlob","data":"AQID"}}`; string(encoded) != expected {
+		t.Errorf("%v was encoded as %s, expected %s", blob, encoded, expected)
+	}
+	if err := json.Unmarshal(encoded, &decoded); err != nil || !decoded.Equal(blob) {
+		t.Errorf("%s was decoded as %v, %v", encoded, decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`{"custom":{"kind":"unknown","data":""}}`), &decoded); err == nil {
+		t.Errorf("a custom value of an unregistered kind was decoded as %v", decoded)
+	}
+}
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestTLAValueCanonical(t *testing.T) {
+	const goldenPath = "testdata/canonical.golden"
+	golden, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var updated strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(string(golden), "\n"), "\n") {
+		if strings.HasPrefix(line, "#") {
+			updated.WriteString(line + "\n")
+			continue
+		}
+		expectedHex, formatted, _ := strings.Cut(line, " ")
+		value, err := Parse(formatted)
+		if err != nil {
+			t.Fatalf("could not parse %s: %v", formatted, err)
+		}
+		encoded, err := EncodeCanonical(value)
+		if err != nil {
+			t.Fatalf("could not encode %v: %v", value, err)
+		}
+		_, _ = fmt.Fprintf(&updated, "%x %s\n", encoded, formatted)
+		if *updateGolden {
+			continue
+		}
+
+		if hex.EncodeToString(encoded) != expectedHex {
+			t.Errorf("%s was encoded as %x, expected %s", formatted, encoded, expectedHex)
+		}
+		decoded, err := DecodeCanonical(encoded)
+		if err != nil {
+			t.Errorf("could not decode %x: %v", encoded, err)
+		} else if !decoded.Equal(value) {
+			t.Errorf("%x was decoded as %v, expected %v", encoded, decoded, value)
+		}
+	}
+	if *updateGolden {
+		if err := os.WriteFile(goldenPath, []byte(updated.String()), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// values built differently, or of different representations, must be encoded identically
+	for _, pair := range [][2]TLAValue{
+		{TLA_DotDotSymbol(MakeTLANumber(1), MakeTLANumber(3)), MakeTLASet(MakeTLANumber(3), MakeTLANumber(2), MakeTLANumber(1))},
+		{MakeTLABytes([]byte{1, 2}), MakeTLATuple(MakeTLANumber(1), MakeTLANumber(2))},
+		{TLA_MinusSymbol(TLA_PlusSymbol(MakeTLANumber(math.MaxInt32), MakeTLANumber(1)), MakeTLANumber(1)), MakeTLANumber(math.MaxInt32)},
+	} {
+		lhs, _ := EncodeCanonical(pair[0])
+		rhs, _ := EncodeCanonical(pair[1])
+		if !bytes.Equal(lhs, rhs) {
+			t.Errorf("%v and %v were encoded differently, as %x and %x", pair[0], pair[1], lhs, rhs)
+		}
+	}
+
+	for _, invalid := range []string{"", "0a", "0300", "030001", "03000100", "030100", "0702030001030001", "06800000"} {
+		data, _ := hex.DecodeString(invalid)
+		if decoded, err := DecodeCanonical(data); !errors.Is(err, ErrCanonicalEncoding) {
+			t.Errorf("%s was decoded as %v, expected an error", invalid, decoded)
+		}
+	}
+}
+
+func TestTLAValueLimits(t *testing.T) {
+	defer SetTLAValueLimits(GetTLAValueLimits())
+	SetTLAValueLimits(TLAValueLimits{MaxDepth: 3, MaxValues: 10})
+
+	nested := func(depth int) TLAValue {
+		value := MakeTLANumber(0)
+		for i := 0; i < depth; i++ {
+			value = MakeTLATuple(value)
+		}
+		return value
+	}
+	numbers := func(n int) TLAValue {
+		var elems []TLAValue
+		for i := 0; i < n; i++ {
+			elems = append(elems, MakeTLANumber(int32(i)))
+		}
+		return MakeTLASet(elems...)
+	}
+	decoders := map[string]func(value TLAValue) (TLAValue, error){
+		"gob": func(value TLAValue) (TLAValue, error) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(&value); err != nil {
+				return TLAValue{}, err
+			}
+			var decoded TLAValue
+			err := gob.NewDecoder(&buf).Decode(&decoded)
+			return decoded, err
+		},
+		"JSON": func(value TLAValue) (TLAValue, error) {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return TLAValue{}, err
+			}
+			var decoded TLAValue
+			err = json.Unmarshal(encoded, &decoded)
+			return decoded, err
+		},
+		"canonical": func(value TLAValue) (TLAValue, error) {
+			encoded, err := EncodeCanonical(value)
+			if err != nil {
+				return TLAValue{}, err
+			}
+			return DecodeCanonical(encoded)
+		},
+		"Parse": func(value TLAValue) (TLAValue, error) {
+			return Parse(Format(value))
+		},
+	}
+	for name, decode := range decoders {
+		t.Run(name, func(t *testing.T) {
+			for _, value := range []TLAValue{nested(3), numbers(9)} {
+				if decoded, err := decode(value); err != nil || !decoded.Equal(value) {
+					t.Errorf("%v was decoded as %v, %v", value, decoded, err)
+				}
+			}
+			for _, value := range []TLAValue{nested(4), numbers(10)} {
+				if name == "Parse" && value.IsSet() {
+					continue // Parse only limits the depth
+				}
+				if decoded, err := decode(value); !errors.Is(err, ErrTLAValueLimit) {
+					t.Errorf("%v was decoded as %v, %v, expected it to exceed the limits", value, decoded, err)
+				}
+			}
+		})
+	}
+
+	func() {
+		defer func() {
+			if err, ok := recover().(error); !ok || !errors.Is(err, ErrTLAValueLimit) {
+				t.Errorf("expected the cross product to exceed the limits, but got %v", err)
+			}
+		}()
+		TLACrossProduct(numbers(4), numbers(3))
+	}()
+	if product := TLACrossProduct(numbers(5), numbers(2)); TLA_Cardinality(product).AsNumber() != 10 {
+		t.Errorf("expected 10 elements in %v", product)
+	}
+}
+
+func TestTLAValueLimitsBigInterval(t *testing.T) {
+	defer SetTLAValueLimits(GetTLAValueLimits())
+	SetTLAValueLimits(TLAValueLimits{MaxValues: 1000})
+
+	bigNumber := func(s string) TLAValue {
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			t.Fatalf("invalid number %s", s)
+		}
+		return MakeTLABigNumber(n)
+	}
+	for _, bounds := range [][2]TLAValue{
+		{MakeTLANumber(0), bigNumber("2147488648")},                               // 0 .. 2^31+5000
+		{bigNumber("-100000000000000000000"), bigNumber("100000000000000000000")}, // more elements than fit in an int
+	} {
+		func() {
+			defer func() {
+				if err, ok := recover().(error); !ok || !errors.Is(err, ErrTLAValueLimit) {
+					t.Errorf("expected %v .. %v to exceed the limits, but got %v", bounds[0], bounds[1], err)
+				}
+			}()
+			TLA_DotDotSymbol(bounds[0], bounds[1])
+		}()
+	}
+
+	interval := TLA_DotDotSymbol(bigNumber("2147483640"), bigNumber("2147484639"))
+	if n := TLA_Cardinality(interval).AsNumber(); n != 1000 {
+		t.Errorf("expected 1000 elements in an interval within the limits, got %d", n)
+	}
+	if empty := TLA_DotDotSymbol(bigNumber("2147483648"), MakeTLANumber(0)); TLA_Cardinality(empty).AsNumber() != 0 {
+		t.Errorf("expected an empty interval, got %v", empty)
+	}
+}
+
+func TestTLAValueDiff(t *testing.T) {
+	tests := []struct {
+		Name     string
+		A, B     string
+		Expected string
+	}{
+		{"equal", `[a |-> {1, 2}]`, `[a |-> {2, 1}]`, ``},
+		{"scalar", `1`, `"x"`, `1 in a, "x" in b`},
+		{"records", `[body |-> 1, gone |-> TRUE]`, `[body |-> 2, new |-> FALSE]`,
+			".body: 1 in a, 2 in b\n.gone: TRUE in a, missing in b\n.new: missing in a, FALSE in b"},
+		{"nested", `[x |-> {1, 2}, y |-> <<1, 2, 3>>]`, `[x |-> {2, 3}, y |-> <<1, 5>>]`,
+			".x: element 1 only in a\n.x: element 3 only in b\n.y[2]: 2 in a, 5 in b\n.y[3]: 3 in a, missing in b"},
+		{"function", `(1 :> <<"a">>)`, `(1 :> <<"b">>)`, `[1][1]: "a" in a, "b" in b`},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			a, err := Parse(test.A)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := Parse(test.B)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := Diff(a, b).String(); diff != test.Expected {
+				t.Errorf("the diff of %v and %v was:\n%s\nexpected:\n%s", a, b, diff, test.Expected)
+			}
+		})
+	}
+}
+
+func TestTLAValueIterators(t *testing.T) {
+	collect := func(v TLAValue) []TLAValue {
+		var elems []TLAValue
+		v.Elements()(func(elem TLAValue) bool {
+			elems = append(elems, elem)
+			return len(elems) < 3
+		})
+		return elems
+	}
+	for _, test := range []struct {
+		Value    TLAValue
+		Expected TLAValue
+	}{
+		{TLA_DotDotSymbol(MakeTLANumber(1), MakeTLANumber(100)), MakeTLATuple(MakeTLANumber(1), MakeTLANumber(2), MakeTLANumber(3))},
+		{MakeTLABytes([]byte{7, 8}), MakeTLATuple(MakeTLANumber(7), MakeTLANumber(8))},
+		{MakeTLATuple(MakeTLAString("a"), TLA_TRUE), MakeTLATuple(MakeTLAString("a"), TLA_TRUE)},
+		{MakeTLASet(MakeTLANumber(5)), MakeTLATuple(MakeTLANumber(5))},
+		{TLASetComprehension([]TLAValue{MakeTLASet(MakeTLANumber(1), MakeTLANumber(-1))}, func(args []TLAValue) TLAValue {
+			return TLA_AsteriskSymbol(args[0], args[0])
+		}), MakeTLATuple(MakeTLANumber(1))},
+	} {
+		if elems := MakeTLATuple(collect(test.Value)...); !elems.Equal(test.Expected) {
+			t.Errorf("the elements of %v were %v, expected %v", test.Value, elems, test.Expected)
+		}
+	}
+
+	record := MakeTLARecord([]TLARecordField{
+		{Key: MakeTLAString("a"), Value: MakeTLANumber(1)},
+		{Key: MakeTLAString("b"), Value: MakeTLANumber(2)},
+	})
+	for _, v := range []TLAValue{record, MakeTLATuple(MakeTLANumber(1), MakeTLANumber(2))} {
+		var fields []TLARecordField
+		v.All()(func(key, value TLAValue) bool {
+			fields = append(fields, TLARecordField{Key: key, Value: value})
+			return true
+		})
+		if rebuilt := MakeTLARecord(fields); len(fields) != 2 || !TLA_DomainSymbol(v).Equal(TLA_DomainSymbol(rebuilt)) ||
+			!TLAFunctionRange(v).Equal(TLAFunctionRange(rebuilt)) {
+			t.Errorf("the pairs of %v were %v", v, fields)
+		}
+	}
+
+	defer func() {
+		if err, ok := recover().(error); !ok || !errors.Is(err, ErrTLAType) {
+			t.Errorf("expected a type error when iterating over a number, but got %v", err)
+		}
+	}()
+	MakeTLANumber(1).Elements()
+}
+
+func TestTLAValueIntervalHash(t *testing.T) {
+	materialize := func(from, to int32, extra ...TLAValue) TLAValue {
+		elems := extra
+		for i := int64(from); i <= int64(to); i++ {
+			elems = append(elems, MakeTLANumber(int32(i)))
+		}
+		return MakeTLASet(elems...)
+	}
+	for _, bounds := range [][2]int32{{1, 10}, {-5, 5}, {0, 0}, {3, 2}, {math.MinInt32, math.MinInt32 + 8}, {math.MaxInt32 - 8, math.MaxInt32}} {
+		interval := TLA_DotDotSymbol(MakeTLANumber(bounds[0]), MakeTLANumber(bounds[1]))
+		if set := materialize(bounds[0], bounds[1]); !interval.Equal(set) || interval.Hash() != set.Hash() {
+			t.Errorf("%v was hashed as %d, but its elements as %d", interval, interval.Hash(), set.Hash())
+		}
+		// the union with other elements is materialized, and must not depend on how it was built
+		extra := MakeTLASet(MakeTLAString("a"), TLA_TRUE)
+		union := TLA_UnionSymbol(interval, extra)
+		if set := materialize(bounds[0], bounds[1], MakeTLAString("a"), TLA_TRUE); !union.Equal(set) || union.Hash() != set.Hash() {
+			t.Errorf("%v was hashed as %d, but its elements as %d", union, union.Hash(), set.Hash())
+		}
+	}
+
+	// intervals too large to enumerate are hashed without doing so
+	huge := TLA_DotDotSymbol(MakeTLANumber(1), MakeTLANumber(math.MaxInt32))
+	full := TLA_DotDotSymbol(MakeTLANumber(math.MinInt32), MakeTLANumber(math.MaxInt32))
+	if huge.Hash() == full.Hash() {
+		t.Errorf("%v and %v were hashed the same", huge, full)
+	}
+}
+
+func TestTLAValueLazySetForcing(t *testing.T) {
+	var calls int
+	isEven := func(elem TLAValue) bool {
+		calls++
+		return elem.AsNumber()%2 == 0
+	}
+	expectCalls := func(expected int) {
+		t.Helper()
+		if calls != expected {
+			t.Fatalf("expected the predicate to have been called %d times, but it was called %d times", expected, calls)
+		}
+	}
+	panics := func(fn func()) (panicked bool) {
+		defer func() {
+			panicked = recover() != nil
+		}()
+		fn()
+		return false
+	}
+	notABool := func(TLAValue) bool {
+		return MakeTLANumber(1).AsBool()
+	}
+
+	// a small set is filtered right away, so a failing predicate panics where the set is built
+	small := TLASetRefinement(TLA_DotDotSymbol(MakeTLANumber(1), MakeTLANumber(lazySetEagerLimit)), isEven)
+	expectCalls(lazySetEagerLimit)
+	if !TLA_InSymbol(MakeTLANumber(2), small).AsBool() {
+		t.Fatalf("expected 2 to be in %v", small)
+	}
+	expectCalls(lazySetEagerLimit)
+	if !panics(func() { TLASetRefinement(MakeTLASet(MakeTLANumber(1)), notABool) }) {
+		t.Fatal("expected refining a small set with a failing predicate to panic")
+	}
+	if !panics(func() {
+		TLASetComprehension([]TLAValue{MakeTLASet(MakeTLANumber(1))}, func(args []TLAValue) TLAValue {
+			return MakeTLABool(notABool(args[0]))
+		})
+	}) {
+		t.Fatal("expected a comprehension over a small set with a failing body to panic")
+	}
+
+	// a larger one is lazy: quantifying over it only goes as far as needed, and testing membership materializes it,
+	// once
+	calls = 0
+	large := TLASetRefinement(TLA_DotDotSymbol(MakeTLANumber(1), MakeTLANumber(lazySetEagerLimit+1)), isEven)
+	expectCalls(0)
+	if !TLAQuantifiedExistential([]TLAValue{large}, func(args []TLAValue) bool { return true }).AsBool() {
+		t.Fatalf("expected %v not to be empty", large)
+	}
+	expectCalls(2)
+	if TLA_InSymbol(MakeTLANumber(3), large).AsBool() {
+		t.Fatalf("expected 3 not to be in %v", large)
+	}
+	expectCalls(2 + lazySetEagerLimit + 1)
+	TLA_InSymbol(MakeTLANumber(4), large)
+	expectCalls(2 + lazySetEagerLimit + 1)
+
+	// so a failing predicate only panics once the set is materialized, here by comparing it
+	failing := TLASetRefinement(TLA_DotDotSymbol(MakeTLANumber(1), MakeTLANumber(lazySetEagerLimit+1)), notABool)
+	if !panics(func() { failing.Equal(MakeTLASet()) }) {
+		t.Fatal("expected materializing a set refined by a failing predicate to panic")
+	}
+
+	// the limit applies to the combinations of elements of a comprehension's sets
+	pair := func(args []TLAValue) TLAValue {
+		calls++
+		return MakeTLATuple(args...)
+	}
+	for _, test := range []struct {
+		size          int32
+		expectedCalls int
+	}{
+		{8, 64},
+		{9, 0},
+	} {
+		calls = 0
+		elems := TLA_DotDotSymbol(MakeTLANumber(1), MakeTLANumber(test.size))
+		TLASetComprehension([]TLAValue{elems, elems}, pair)
+		expectCalls(test.expectedCalls)
+	}
+}
+
+func TestTLAValueGobConcurrent(t *testing.T) {
+	value := MakeTLASet(
+		MakeTLATuple(MakeTLANumber(1), MakeTLARecord([]TLARecordField{{Key: MakeTLAString("a"), Value: MakeTLASet()}})),
+		MakeTLATuple(MakeTLAString("b"), MakeTLASet(MakeTLATuple(), MakeTLANumber(2))))
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&value); err != nil {
+		t.Fatal(err)
+	}
+	encoded := buf.Bytes()
+
+	// each decoding keeps its own state, in the values it decodes, so decodings do not interfere
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				var decoded TLAValue
+				if err := gob.NewDecoder(bytes.NewReader(encoded)).Decode(&decoded); err != nil || !decoded.Equal(value) {
+					t.Errorf("%v was decoded as %v, %v", value, decoded, err)
+					return
+				}
+				if set := decoded.data.(*tlaValueSet); set.gobElements != nil {
+					t.Errorf("expected the encoded elements of %v to be dropped once decoded", decoded)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTLAValueGobNormalizesNumbers(t *testing.T) {
+	// a fraction as big.Rat.GobDecode leaves it, unreduced
+	unreduced := new(big.Rat)
+	if err := unreduced.GobDecode([]byte{1 << 1, 0, 0, 0, 1, 9, 6}); err != nil {
+		t.Fatal(err)
+	}
+	huge := new(big.Int).Lsh(big.NewInt(1), 100)
+	for _, tc := range []struct {
+		encoded, expected TLAValue
+	}{
+		// numbers an encoder did not normalize
+		{TLAValue{&tlaValueBigNumber{big.NewInt(42)}}, MakeTLANumber(42)},
+		{TLAValue{&tlaValueBigNumber{big.NewInt(math.MinInt32)}}, MakeTLANumber(math.MinInt32)},
+		{TLAValue{&tlaValueReal{big.NewRat(-6, 1)}}, MakeTLANumber(-6)},
+		{TLAValue{&tlaValueReal{new(big.Rat).SetFrac(huge, big.NewInt(1))}}, MakeTLABigNumber(huge)},
+		{TLAValue{&tlaValueReal{unreduced}}, MakeTLAReal(big.NewRat(3, 2))},
+		{MakeTLASet(TLAValue{&tlaValueBigNumber{big.NewInt(1)}}, MakeTLANumber(1)), MakeTLASet(MakeTLANumber(1))},
+		// numbers that were normalized already stay the same
+		{MakeTLABigNumber(huge), MakeTLABigNumber(huge)},
+		{MakeTLAReal(big.NewRat(-1, 3)), MakeTLAReal(big.NewRat(-1, 3))},
+	} {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(&tc.encoded); err != nil {
+			t.Fatalf("could not encode %v: %v", tc.encoded, err)
+		}
+		var decoded TLAValue
+		if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+			t.Fatalf("could not decode %v: %v", tc.encoded, err)
+		}
+		if !decoded.Equal(tc.expected) || decoded.Hash() != tc.expected.Hash() {
+			t.Errorf("%v was decoded as %v (%T), expected %v (%T)", tc.encoded, decoded, decoded.data, tc.expected, tc.expected.data)
+		}
+	}
+}
+
+func TestTLAValueStructuralSharing(t *testing.T) {
+	numbers := func(from, to int32) []TLAValue {
+		var elems []TLAValue
+		for i := from; i <= to; i++ {
+			elems = append(elems, MakeTLANumber(i))
+		}
+		return elems
+	}
+	bigSet, bigTuple := MakeTLASet(numbers(1, 1000)...), MakeTLATuple(numbers(1, 1000)...)
+	one, small := MakeTLASet(MakeTLANumber(0)), MakeTLASet(MakeTLANumber(0), MakeTLANumber(1))
+	pair := MakeTLATuple(MakeTLANumber(-1), MakeTLANumber(0))
+
+	for _, test := range []struct {
+		Name     string
+		Op       func() TLAValue
+		Expected TLAValue
+	}{
+		{"large \\union small", func() TLAValue { return TLA_UnionSymbol(bigSet, small) }, MakeTLASet(numbers(0, 1000)...)},
+		{"small \\union large", func() TLAValue { return TLA_UnionSymbol(one, bigSet) }, MakeTLASet(numbers(0, 1000)...)},
+		{"large \\ small", func() TLAValue { return TLA_BackslashSymbol(bigSet, small) }, MakeTLASet(numbers(2, 1000)...)},
+		{"large \\o small", func() TLAValue { return TLA_OSymbol(bigTuple, pair) }, MakeTLATuple(append(numbers(1, 1000), numbers(-1, 0)...)...)},
+		{"small \\o large", func() TLAValue { return TLA_OSymbol(pair, bigTuple) }, MakeTLATuple(numbers(-1, 1000)...)},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			if result := test.Op(); !result.Equal(test.Expected) {
+				t.Fatalf("expected %v, got %v", test.Expected, result)
+			}
+			// the result shares the large operand's structure, rather than copying its 1000 elements
+			if allocs := testing.AllocsPerRun(10, func() { test.Op() }); allocs > 100 {
+				t.Errorf("expected the result to share the large operand's structure, but building it took %v allocations", allocs)
+			}
+		})
+	}
+
+	// the operands themselves are left unchanged
+	if !bigSet.Equal(MakeTLASet(numbers(1, 1000)...)) || !bigTuple.Equal(MakeTLATuple(numbers(1, 1000)...)) ||
+		!small.Equal(MakeTLASet(MakeTLANumber(0), MakeTLANumber(1))) || !pair.Equal(MakeTLATuple(MakeTLANumber(-1), MakeTLANumber(0))) {
+		t.Errorf("expected the operands to be left unchanged, got %v, %v, %v and %v", bigSet, bigTuple, small, pair)
+	}
+}
+
+func TestTLAValueHashCaching(t *testing.T) {
+	record := func(a, b TLAValue) TLAValue {
+		return MakeTLARecord([]TLARecordField{
+			{Key: MakeTLAString("a"), Value: a},
+			{Key: MakeTLAString("b"), Value: b},
+		})
+	}
+	tuple := MakeTLATuple(MakeTLANumber(1), MakeTLANumber(2))
+	nested := record(tuple, MakeTLASet(MakeTLANumber(3)))
+
+	for _, test := range []struct {
+		Name          string
+		Source        TLAValue
+		Derive        func(source TLAValue) TLAValue
+		Expected      TLAValue
+		EqualToSource bool
+	}{
+		{"Append", tuple, func(source TLAValue) TLAValue {
+			return TLA_Append(source, MakeTLANumber(3))
+		}, MakeTLATuple(MakeTLANumber(1), MakeTLANumber(2), MakeTLANumber(3)), false},
+		{"tuple update", tuple, func(source TLAValue) TLAValue {
+			return TLAFunctionUpdate(source, []TLAValue{MakeTLANumber(2)}, MakeTLANumber(5))
+		}, MakeTLATuple(MakeTLANumber(1), MakeTLANumber(5)), false},
+		{"record update", nested, func(source TLAValue) TLAValue {
+			return TLAFunctionUpdate(source, []TLAValue{MakeTLAString("b")}, MakeTLASet())
+		}, record(tuple, MakeTLASet()), false},
+		{"nested update", nested, func(source TLAValue) TLAValue {
+			return TLAFunctionUpdate(source, []TLAValue{MakeTLAString("a"), MakeTLANumber(1)}, MakeTLANumber(7))
+		}, record(MakeTLATuple(MakeTLANumber(7), MakeTLANumber(2)), MakeTLASet(MakeTLANumber(3))), false},
+		{"set update", MakeTLASet(MakeTLANumber(1)), func(source TLAValue) TLAValue {
+			return MakeTLASetFromMap(source.AsSet().Set(MakeTLANumber(2), true))
+		}, MakeTLASet(MakeTLANumber(1), MakeTLANumber(2)), false},
+		{"@@", tuple, func(source TLAValue) TLAValue {
+			return TLA_DoubleAtSignSymbol(source, MakeTLATuple(MakeTLANumber(0), MakeTLANumber(0), MakeTLANumber(3)))
+		}, MakeTLATuple(MakeTLANumber(1), MakeTLANumber(2), MakeTLANumber(3)), false},
+		{"update to the same value", tuple, func(source TLAValue) TLAValue {
+			return TLAFunctionUpdate(source, []TLAValue{MakeTLANumber(1)}, MakeTLANumber(1))
+		}, tuple, true},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			// cache the hashes of the source, and of everything in it, before deriving a value from it
+			sourceHash := test.Source.Hash()
+			derived := test.Derive(test.Source)
+			if derived.Hash() != test.Expected.Hash() || !derived.Equal(test.Expected) || !test.Expected.Equal(derived) {
+				t.Fatalf("expected %v, hashed as %d, got %v, hashed as %d", test.Expected, test.Expected.Hash(), derived, derived.Hash())
+			}
+			if test.Source.Hash() != sourceHash {
+				t.Errorf("the hash of %v changed from %d to %d", test.Source, sourceHash, test.Source.Hash())
+			}
+			if derived.Equal(test.Source) != test.EqualToSource || test.Source.Equal(derived) != test.EqualToSource {
+				t.Errorf("expected %v and %v to be equal: %v", derived, test.Source, test.EqualToSource)
+			}
+			// as set elements, values are found by hash
+			if !TLA_InSymbol(derived, MakeTLASet(test.Expected)).AsBool() {
+				t.Errorf("expected to find %v in a set of an equal value", derived)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package distsys
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// TraceContext identifies a span of a distributed trace, as propagated between processes in a W3C traceparent header
+// (see https://www.w3.org/TR/trace-context/). The zero value is not a valid context, and stands for no trace.
+type TraceContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Sampled bool
+}
+
+// IsValid checks whether tc identifies a span, i.e. whether neither of its IDs is all zeros.
+func (tc TraceContext) IsValid() bool {
+	return tc.TraceID != [16]byte{} && tc.SpanID != [8]byte{}
+}
+
+// Traceparent formats tc as a traceparent header value, such as
+// "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01". It returns "" if tc is not valid.
+func (tc TraceContext) Traceparent() string {
+	if !tc.IsValid() {
+		return ""
+	}
+	flags := "00"
+	if tc.Sampled {
+		flags = "01"
+	}
+	return "00-" + hex.EncodeToString(tc.TraceID[:]) + "-" + hex.EncodeToString(tc.SpanID[:]) + "-" + flags
+}
+
+// ParseTraceparent parses a traceparent header value, as produced by TraceContext.Traceparent. It returns false if
+// header is malformed, or does not identify a span.
+func ParseTraceparent(header string) (TraceContext, bool) {
+	var tc TraceContext
+	parts := strings.Split(header, "-")
+	// later versions may append fields, but always keep these four first
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return tc, false
+	}
+	if len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return tc, false
+	}
+	if _, err := hex.Decode(tc.TraceID[:], []byte(parts[1])); err != nil {
+		return tc, false
+	}
+	if _, err := hex.Decode(tc.SpanID[:], []byte(parts[2])); err != nil {
+		return tc, false
+	}
+	var flags [1]byte
+	if _, err := hex.Decode(flags[:], []byte(parts[3])); err != nil {
+		return tc, false
+	}
+	tc.Sampled = flags[0]&1 != 0
+	return tc, tc.IsValid()
+}
+
+// ArchetypeTracer starts a span for each critical section an archetype runs, e.g. to export them to a distributed
+// tracing system. See the tracing package for an implementation.
+type ArchetypeTracer interface {
+	// StartSpan is called before the critical section label starts executing.
+	StartSpan(self tla.TLAValue, label string) ArchetypeSpan
+}
+
+// ArchetypeSpan is the span of one critical section, as started by an ArchetypeTracer. Its methods are only called
+// from the goroutine running the archetype.
+type ArchetypeSpan interface {
+	// TraceContext returns the context identifying the span, which is attached to values the critical section
+	// writes to resources that implement TraceContextCarrier.
+	TraceContext() TraceContext
+	// Received is called with the trace context carried by a value the critical section read, such as a message
+	// sent by another archetype's critical section. Typically, the first one becomes the span's parent, so that
+	// traces follow messages from archetype to archetype.
+	Received(remote TraceContext)
+	// End is called once the critical section has committed or failed, with err as described in
+	// ArchetypeObserver.CriticalSectionFinished.
+	End(err error)
+}
+
+// TraceContextCarrier may be implemented by resources that propagate trace context alongside their values, such as
+// mailboxes, so that traces can span several archetypes.
+type TraceContextCarrier interface {
+	// SetTraceContext is called before each write to the resource, with the context of the writing critical
+	// section's span.
+	SetTraceContext(tc TraceContext)
+	// ReceivedTraceContext is called after each successful read from the resource, and returns the trace context
+	// carried by the value read, if any.
+	ReceivedTraceContext() (TraceContext, bool)
+}
+
+// WithArchetypeTracer traces each critical section the archetype runs using tracer.
+func WithArchetypeTracer(tracer ArchetypeTracer) MPCalContextConfigFn {
+	return func(ctx *MPCalContext) {
+		ctx.tracer = tracer
+	}
+}
+
+// traceWrite attaches the current span's context to res, before a value is written to it.
+func (ctx *MPCalContext) traceWrite(res ArchetypeResource) {
+	if carrier, ok := res.(TraceContextCarrier); ok {
+		carrier.SetTraceContext(ctx.span.TraceContext())
+	}
+}
+
+// traceRead passes on any trace context carried by the value just read from res to the current span.
+func (ctx *MPCalContext) traceRead(res ArchetypeResource) {
+	if carrier, ok := res.(TraceContextCarrier); ok {
+		if remote, ok := carrier.ReceivedTraceContext(); ok {
+			ctx.span.Received(remote)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+// Package tracing records a span for each critical section that archetypes run, and exports them to a distributed
+// tracing system such as Jaeger or Grafana Tempo, through a SpanExporter adapting the OpenTelemetry SDK, so that
+// this module does not depend on it.
+//
+// Spans are linked across archetypes through TCP mailboxes, which carry the context of the sending critical section
+// to the receiving one: a critical section that reads a message becomes a child of the critical section that sent
+// it, so a request can be followed end to end through a compiled protocol. For example:
+//
+//	tracer := tracing.NewTracer(exporter)
+//	defer tracer.Close()
+//	ctx := distsys.NewMPCalContext(self, AServer, distsys.WithArchetypeTracer(tracer), ...)
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+const (
+	tracerBatchSize     = 512
+	tracerQueueSize     = 4096
+	tracerFlushInterval = 5 * time.Second
+)
+
+// Span is a finished span, as passed to a SpanExporter. Each critical section run is one span, named after its
+// label.
+type Span struct {
+	TraceContext distsys.TraceContext
+	Parent       distsys.TraceContext   // the invalid TraceContext if the span is the root of its trace
+	Links        []distsys.TraceContext // other spans that sent values the critical section read
+	Name         string
+	Start, End   time.Time
+	Attributes   map[string]string
+	// Error describes why the critical section failed, or is empty if it committed, aborted or reached Done.
+	Error string
+}
+
+// SpanExporter sends finished spans to a tracing system. This package does not speak OTLP itself; a small adapter
+// over an exporter of the OpenTelemetry SDK, such as otlptracehttp's, is enough: it converts each Span to a
+// tracetest.SpanStub, with its SpanContext, Parent and Links made by trace.NewSpanContext, its Attributes as
+// attribute.String values, and an error status if Error is set, and exports their Snapshots, under a resource
+// naming the service.
+type SpanExporter interface {
+	// ExportSpans exports a batch of spans. It is never called concurrently by a Tracer.
+	ExportSpans(spans []Span) error
+}
+
+type tracerConfig struct {
+	sampleRatio   float64
+	batchSize     int
+	flushInterval time.Duration
+}
+
+// TracerOption configures a Tracer, as made by NewTracer.
+type TracerOption func(cfg *tracerConfig)
+
+// WithSampleRatio sets the fraction of traces that are recorded, between 0 and 1; all of them are by default.
+// Critical sections that continue a trace started elsewhere follow the sampling decision made there.
+func WithSampleRatio(ratio float64) TracerOption {
+	return func(cfg *tracerConfig) {
+		cfg.sampleRatio = ratio
+	}
+}
+
+// WithBatchSize sets how many spans are exported at most in one call to the exporter.
+func WithBatchSize(size int) TracerOption {
+	return func(cfg *tracerConfig) {
+		cfg.batchSize = size
+	}
+}
+
+// WithFlushInterval sets how long finished spans may wait before they are exported, if there are not enough of them
+// to fill a batch.
+func WithFlushInterval(interval time.Duration) TracerOption {
+	return func(cfg *tracerConfig) {
+		cfg.flushInterval = interval
+	}
+}
+
+// Tracer is a distsys.ArchetypeTracer, which exports the spans of critical sections in batches, in the background.
+// It may be shared by all archetypes of a process. If spans finish faster than they can be exported, the excess is
+// dropped rather than slowing archetypes down.
+type Tracer struct {
+	exporter SpanExporter
+	config   tracerConfig
+
+	queue chan Span
+	flush chan chan struct{}
+	done  chan struct{}
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+var _ distsys.ArchetypeTracer = &Tracer{}
+
+// NewTracer creates a Tracer exporting to exporter, and starts its background export loop. It should be closed once
+// no archetype uses it anymore.
+func NewTracer(exporter SpanExporter, opts ...TracerOption) *Tracer {
+	cfg := tracerConfig{
+		sampleRatio:   1,
+		batchSize:     tracerBatchSize,
+		flushInterval: tracerFlushInterval,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	tracer := &Tracer{
+		exporter: exporter,
+		config:   cfg,
+		queue:    make(chan Span, tracerQueueSize),
+		flush:    make(chan chan struct{}),
+		done:     make(chan struct{}),
+		closed:   make(chan struct{}),
+	}
+	go tracer.exportLoop()
+	return tracer
+}
+
+func (tracer *Tracer) exportLoop() {
+	defer close(tracer.closed)
+	ticker := time.NewTicker(tracer.config.flushInterval)
+	defer ticker.Stop()
+
+	var batch []Span
+	export := func() {
+		if len(batch) == 0 {
+			return
+		}
+		err := tracer.exporter.ExportSpans(batch)
+		if err != nil {
+			distsys.DefaultLogger.Log(distsys.LogWarn, "could not export spans", "count", len(batch), "error", err)
+		}
+		batch = nil
+	}
+	// drain moves all queued spans into batches, exporting each one that fills up
+	drain := func() {
+		for {
+			select {
+			case span := <-tracer.queue:
+				batch = append(batch, span)
+				if len(batch) >= tracer.config.batchSize {
+					export()
+				}
+			default:
+				return
+			}
+		}
+	}
+	for {
+		select {
+		case span := <-tracer.queue:
+			batch = append(batch, span)
+			if len(batch) >= tracer.config.batchSize {
+				export()
+			}
+		case <-ticker.C:
+			export()
+		case reply := <-tracer.flush:
+			drain()
+			export()
+			close(reply)
+		case <-tracer.done:
+			drain()
+			export()
+			return
+		}
+	}
+}
+
+// Flush exports all spans that have finished so far, and waits until that is done.
+func (tracer *Tracer) Flush() {
+	reply := make(chan struct{})
+	select {
+	case tracer.flush <- reply:
+		<-reply
+	case <-tracer.closed:
+	}
+}
+
+// Close exports all remaining spans, and stops the export loop.
+func (tracer *Tracer) Close() error {
+	tracer.closeOnce.Do(func() {
+		close(tracer.done)
+	})
+	<-tracer.closed
+	return nil
+}
+
+func (tracer *Tracer) record(span Span) {
+	select {
+	case tracer.queue <- span:
+	default:
+		distsys.DefaultLogger.Log(distsys.LogWarn, "span export queue is full, dropping span", "span", span.Name)
+	}
+}
+
+// shouldSample decides whether to record a new trace, consistently for a given trace ID.
+func (tracer *Tracer) shouldSample(traceID [16]byte) bool {
+	switch {
+	case tracer.config.sampleRatio >= 1:
+		return true
+	case tracer.config.sampleRatio <= 0:
+		return false
+	default:
+		bound := uint64(tracer.config.sampleRatio * (1 << 63))
+		return binary.BigEndian.Uint64(traceID[8:])>>1 < bound
+	}
+}
+
+func (tracer *Tracer) StartSpan(self tla.TLAValue, label string) distsys.ArchetypeSpan {
+	span := &archetypeSpan{
+		tracer: tracer,
+		span: Span{
+			Name:  label,
+			Start: time.Now(),
+			Attributes: map[string]string{
+				"pgo.self":  self.String(),
+				"pgo.label": label,
+			},
+		},
+	}
+	_, _ = rand.Read(span.span.TraceContext.TraceID[:])
+	_, _ = rand.Read(span.span.TraceContext.SpanID[:])
+	span.span.TraceContext.Sampled = tracer.shouldSample(span.span.TraceContext.TraceID)
+	return span
+}
+
+type archetypeSpan struct {
+	tracer *Tracer
+	span   Span
+	// propagated is set once the span's context has been handed out, after which it can no longer join another trace
+	propagated bool
+}
+
+var _ distsys.ArchetypeSpan = &archetypeSpan{}
+
+func (span *archetypeSpan) TraceContext() distsys.TraceContext {
+	span.propagated = true
+	return span.span.TraceContext
+}
+
+func (span *archetypeSpan) Received(remote distsys.TraceContext) {
+	if remote == span.span.Parent {
+		return
+	}
+	if !span.span.Parent.IsValid() && !span.propagated {
+		// continue the sender's trace, following its sampling decision
+		span.span.Parent = remote
+		span.span.TraceContext.TraceID = remote.TraceID
+		span.span.TraceContext.Sampled = remote.Sampled
+		return
+	}
+	for _, link := range span.span.Links {
+		if link == remote {
+			return
+		}
+	}
+	span.span.Links = append(span.span.Links, remote)
+}
+
+func (span *archetypeSpan) End(err error) {
+	if !span.span.TraceContext.Sampled {
+		return
+	}
+	span.span.End = time.Now()
+	switch {
+	case err == nil:
+		span.span.Attributes["pgo.outcome"] = "committed"
+	case errors.Is(err, distsys.ErrCriticalSectionAborted):
+		span.span.Attributes["pgo.outcome"] = "aborted"
+	case errors.Is(err, distsys.ErrDone):
+		span.span.Attributes["pgo.outcome"] = "done"
+	default:
+		span.span.Attributes["pgo.outcome"] = "failed"
+		span.span.Error = err.Error()
+	}
+	span.tracer.record(span.span)
+}
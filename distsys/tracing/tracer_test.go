@@ -0,0 +1,157 @@
+package tracing
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+// recordingExporter is a SpanExporter keeping the batches it is given.
+type recordingExporter struct {
+	lock    sync.Mutex
+	batches [][]Span
+}
+
+var _ SpanExporter = &recordingExporter{}
+
+func (exporter *recordingExporter) ExportSpans(spans []Span) error {
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+	exporter.batches = append(exporter.batches, spans)
+	return nil
+}
+
+func (exporter *recordingExporter) spans() []Span {
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+	var spans []Span
+	for _, batch := range exporter.batches {
+		spans = append(spans, batch...)
+	}
+	return spans
+}
+
+func TestTracerPropagation(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter)
+	defer tracer.Close()
+	self := tla.MakeTLANumber(1)
+
+	// the client sends a request, which the server reads, along with a message from another trace
+	client := tracer.StartSpan(self, "AClient.request")
+	request := client.TraceContext()
+	client.End(nil)
+	other := tracer.StartSpan(self, "AOther.send")
+	unrelated := other.TraceContext()
+	other.End(nil)
+	server := tracer.StartSpan(self, "AServer.handle")
+	server.Received(request)
+	server.Received(unrelated)
+	server.Received(unrelated)
+	server.End(distsys.ErrCriticalSectionAborted)
+	failed := tracer.StartSpan(self, "AServer.fail")
+	failed.End(errors.New("assertion failed"))
+	tracer.Flush()
+
+	spans := exporter.spans()
+	if len(spans) != 4 {
+		t.Fatalf("expected 4 spans, got %d", len(spans))
+	}
+	// the server's span joins the client's trace, as its child, and links to the other one
+	serverSpan := spans[2]
+	if serverSpan.Parent != request || serverSpan.TraceContext.TraceID != request.TraceID ||
+		serverSpan.TraceContext.SpanID == request.SpanID {
+		t.Fatalf("expected the server's span to be a child of %v, got %+v", request, serverSpan)
+	}
+	if len(serverSpan.Links) != 1 || serverSpan.Links[0] != unrelated {
+		t.Fatalf("expected the server's span to link to %v once, got %v", unrelated, serverSpan.Links)
+	}
+	if spans[0].Parent.IsValid() || spans[0].TraceContext.TraceID == unrelated.TraceID {
+		t.Fatalf("expected the client's span to start its own trace, got %+v", spans[0])
+	}
+	for i, expected := range []string{"committed", "committed", "aborted", "failed"} {
+		if outcome := spans[i].Attributes["pgo.outcome"]; outcome != expected {
+			t.Errorf("expected span %s to be %s, got %s", spans[i].Name, expected, outcome)
+		}
+	}
+	if spans[3].Error != "assertion failed" || spans[2].Error != "" {
+		t.Errorf("expected only the failed span to have an error, got %q and %q", spans[2].Error, spans[3].Error)
+	}
+	if spans[0].Attributes["pgo.self"] != "1" || spans[0].Attributes["pgo.label"] != "AClient.request" {
+		t.Errorf("expected the span to be attributed to its archetype and label, got %v", spans[0].Attributes)
+	}
+}
+
+func TestTracerPropagatedSpanKeepsItsTrace(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter)
+	defer tracer.Close()
+	self := tla.MakeTLANumber(1)
+
+	// once a span's context was handed out, e.g. in a message it sent, it can no longer join another trace
+	sender := tracer.StartSpan(self, "ASender.send")
+	remote := sender.TraceContext()
+	span := tracer.StartSpan(self, "AServer.handle")
+	sent := span.TraceContext()
+	span.Received(remote)
+	span.End(nil)
+	sender.End(nil)
+	tracer.Flush()
+
+	spans := exporter.spans()
+	if len(spans) != 2 || spans[0].TraceContext != sent || spans[0].Parent.IsValid() {
+		t.Fatalf("expected the span to keep its context %v, got %+v", sent, spans)
+	}
+	if len(spans[0].Links) != 1 || spans[0].Links[0] != remote {
+		t.Fatalf("expected the span to link to %v, got %v", remote, spans[0].Links)
+	}
+}
+
+func TestTracerSampling(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter, WithSampleRatio(0))
+	self := tla.MakeTLANumber(1)
+
+	unsampled := tracer.StartSpan(self, "A.unsampled")
+	unsampled.End(nil)
+	// a span continuing a sampled trace is recorded, following the decision made where the trace started
+	continued := tracer.StartSpan(self, "A.continued")
+	continued.Received(distsys.TraceContext{TraceID: [16]byte{1}, SpanID: [8]byte{1}, Sampled: true})
+	continued.End(nil)
+	if err := tracer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := exporter.spans()
+	if len(spans) != 1 || spans[0].Name != "A.continued" {
+		t.Fatalf("expected only the continued span to be recorded, got %+v", spans)
+	}
+}
+
+func TestTracerBatches(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter, WithBatchSize(2), WithFlushInterval(time.Hour))
+	self := tla.MakeTLANumber(1)
+	for i := 0; i < 5; i++ {
+		tracer.StartSpan(self, "A.loop").End(nil)
+	}
+	// Close exports what remains of the last batch
+	if err := tracer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	tracer.Flush() // after Close, returns immediately
+
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+	var sizes []int
+	for _, batch := range exporter.batches {
+		sizes = append(sizes, len(batch))
+	}
+	if len(sizes) != 3 || sizes[0] != 2 || sizes[1] != 2 || sizes[2] != 1 {
+		t.Fatalf("expected batches of 2, 2 and 1 spans, got %v", sizes)
+	}
+}
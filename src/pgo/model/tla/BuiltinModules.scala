@@ -134,7 +134,7 @@ object BuiltinModules {
     symOp(TLASymbol.OPlusSymbol)
     symOp(TLASymbol.OMinusSymbol)
     alphaOp("BagUnion", 1)
-    symOp(TLASymbol.SquareSupersetOrEqualSymbol)
+    symOp(TLASymbol.SquareSubsetOrEqualSymbol)
     alphaOp("SubBag", 1)
     alphaOp("BagOfAll", 2)
     alphaOp("BagCardinality", 1)
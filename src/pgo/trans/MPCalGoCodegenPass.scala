@@ -37,27 +37,12 @@ object MPCalGoCodegenPass {
     BuiltinModules.Intrinsics.memberSym(TLASymbol.SequencingSymbol),
     BuiltinModules.Intrinsics.memberSym(TLASymbol.PlusArrowSymbol),
 
-    BuiltinModules.TLC.memberAlpha("Print"),
-    BuiltinModules.TLC.memberAlpha("PrintT"),
     BuiltinModules.TLC.memberAlpha("JavaTime"),
-    BuiltinModules.TLC.memberAlpha("Permutations"),
     BuiltinModules.TLC.memberAlpha("SortSeq"),
 
     BuiltinModules.Sequences.memberAlpha("SelectSeq"),
 
-    BuiltinModules.Bags.memberAlpha("IsABag"),
-    BuiltinModules.Bags.memberAlpha("BagToSet"),
-    BuiltinModules.Bags.memberAlpha("SetToBag"),
-    BuiltinModules.Bags.memberAlpha("BagIn"),
-    BuiltinModules.Bags.memberAlpha("EmptyBag"),
-    BuiltinModules.Bags.memberAlpha("CopiesIn"),
-    BuiltinModules.Bags.memberSym(TLASymbol.OPlusSymbol),
-    BuiltinModules.Bags.memberSym(TLASymbol.OMinusSymbol),
-    BuiltinModules.Bags.memberAlpha("BagUnion"),
-    BuiltinModules.Bags.memberSym(TLASymbol.SquareSupersetOrEqualSymbol),
-    BuiltinModules.Bags.memberAlpha("SubBag"),
     BuiltinModules.Bags.memberAlpha("BagOfAll"),
-    BuiltinModules.Bags.memberAlpha("BagCardinality"),
 
     BuiltinModules.Peano.memberAlpha("PeanoAxioms"),
     BuiltinModules.Peano.memberAlpha("Succ"),
@@ -68,14 +53,22 @@ object MPCalGoCodegenPass {
     BuiltinModules.ProtoReals.memberAlpha("Real"),
     BuiltinModules.ProtoReals.memberAlpha("Infinity"),
     BuiltinModules.ProtoReals.memberAlpha("MinusInfinity"),
-    BuiltinModules.ProtoReals.memberSym(TLASymbol.SlashSymbol),
     BuiltinModules.ProtoReals.memberAlpha("Int"),
 
     BuiltinModules.Reals.memberAlpha("Real"),
-    BuiltinModules.Reals.memberSym(TLASymbol.SlashSymbol),
     BuiltinModules.Reals.memberAlpha("Infinity"),
   ).to(ById.setFactory)
 
+  /**
+   * Built-ins whose Go implementations need the archetype's context, and so are called as
+   * distsys.TLA_Name(iface, args...) rather than tla.TLA_Name(args...): TLC's Print and PrintT print through
+   * the context's logger.
+   */
+  lazy val contextDependentOperators: Set[ById[TLABuiltinOperator]] = View(
+    BuiltinModules.TLC.memberAlpha("Print"),
+    BuiltinModules.TLC.memberAlpha("PrintT"),
+  ).to(ById.setFactory)
+
   private val TLAValue = "tla.TLAValue"
   private val ArchetypeResourceHandle = "distsys.ArchetypeResourceHandle"
   val goKeywords: List[String] =
@@ -120,7 +113,7 @@ object MPCalGoCodegenPass {
    * becomes something like
    * `
    * tmp := ctx.Read(...)
-   * (tmp + 1).PCalPrint()
+   * iface.Print(tmp + 1)
    * `
    */
   def readExpr(expr: TLAExpression, hint: String = "resourceRead")(fn: Description=>Description)(implicit ctx: GoCodegenContext): Description = {
@@ -349,7 +342,7 @@ object MPCalGoCodegenPass {
             case PCalMacroCall(_, _) => !!!
             case PCalPrint(value) =>
               readExpr(value, hint = "toPrint") { value =>
-                d"\n$value.PCalPrint()"
+                d"\n${ctx.iface}.Print($value)"
               }
             case PCalSkip() =>
               d"\n// skip"
@@ -725,6 +718,7 @@ object MPCalGoCodegenPass {
 
   @throws[PGoError]
   def apply(tlaModule: TLAModule, mpcalBlock: MPCalBlock, packageName: Option[String]): Description = {
+    val goPackageName: String = packageName.getOrElse(mpcalBlock.name.id.toLowerCase(Locale.ROOT))
     locally {
       val errors = mutable.ListBuffer[UnsupportedOperationsError.UnsupportedOperationError]()
 
@@ -792,6 +786,37 @@ object MPCalGoCodegenPass {
       case TLAConstantDeclaration(constants) => constants
     }.flatten.toList
 
+    // definitions without arguments whose values depend only on constants, and so may be memoized per context.
+    // Any reference to a variable, directly or via another definition, rules a definition out; this includes those
+    // in define blocks, which may refer to archetype parameters and process-local state.
+    // Constant operators (declarations with arguments) rule a definition out too, since they are arbitrary Go funcs
+    // which need not be pure.
+    val constantTLAUnits: Set[ById[RefersTo.HasReferences]] = locally {
+      val constantDeclIds = constantDecls.view.collect {
+        case decl@TLAOpDecl(TLAOpDecl.NamedVariant(_, 0)) => ById(decl)
+      }.toSet[ById[RefersTo.HasReferences]]
+      tlaUnits.foldLeft(Set.empty[ById[RefersTo.HasReferences]]) {
+        case (acc, defn@TLAOperatorDefinition(_, Nil, body, _)) =>
+          val localDefns = mutable.HashSet.empty[ById[RefersTo.HasReferences]]
+          body.visit(Visitable.BottomUpFirstStrategy) {
+            case local: RefersTo.HasReferences => localDefns += ById(local)
+          }
+          var dependsOnlyOnConstants = true
+          body.visit(Visitable.BottomUpFirstStrategy) {
+            case ref: RefersTo[RefersTo.HasReferences] @unchecked =>
+              if(!ref.hasRefersTo) {
+                dependsOnlyOnConstants = false
+              } else ref.refersTo match {
+                case _: TLABuiltinOperator =>
+                case target if localDefns(ById(target)) || constantDeclIds(ById(target)) || acc(ById(target)) =>
+                case _ => dependsOnlyOnConstants = false
+              }
+          }
+          if(dependsOnlyOnConstants) acc + ById(defn) else acc
+        case (acc, _) => acc
+      }
+    }
+
     val errName = nameCleaner.cleanName("err")
     val ifaceName = nameCleaner.cleanName("iface")
     val jumpTableName = nameCleaner.cleanName("jumpTable")
@@ -806,6 +831,8 @@ object MPCalGoCodegenPass {
       } ++ mpcalBlock.archetypes.view.map { arch =>
         ById(arch) -> IndependentCallableBinding(nameCleaner.cleanName(toGoPublicName(arch.name.id)))
       } ++ tlaExtDefnNames.map {
+        case defnId -> name if contextDependentOperators(defnId) =>
+          defnId -> DependentCallableBinding(s"distsys.${name.stripPrefix("tla.")}")
         case defnId -> name => defnId -> IndependentCallableBinding(name)
       } ++ constantDecls.view.map {
         case decl@TLAOpDecl(variant) =>
@@ -820,7 +847,7 @@ object MPCalGoCodegenPass {
       }).toMap
     )
 
-    d"package ${packageName.getOrElse(mpcalBlock.name.id.toLowerCase(Locale.ROOT)): String}\n" +
+    d"package $goPackageName\n" +
       d"\nimport (${
         (d"""\n"github.com/UBC-NSS/pgo/distsys"""" +
           d"""\n"github.com/UBC-NSS/pgo/distsys/tla"""" +
@@ -856,7 +883,17 @@ object MPCalGoCodegenPass {
               }
           }.flattenDescriptions}) $TLAValue {${
             implicit val ctx: GoCodegenContext = origCtx.copy(bindings = origCtx.bindings ++ argNames)
-            d"\nreturn ${translateExpr(body)}".indented
+            body match {
+              // a definition that depends only on constants has its value computed once per context, unless it
+              // is a literal, which is as cheap to build as to look up
+              case _: TLANumber | _: TLAString => d"\nreturn ${translateExpr(body)}".indented
+              case _ if constantTLAUnits(ById(defn)) =>
+                val memoName = s"${goPackageName}.${tlaUnitNames(ById(defn))}"
+                d"\nreturn ${ctx.iface}.MemoizeDefinition(${mkGoString(memoName)}, func(${ctx.iface} distsys.ArchetypeInterface) $TLAValue {${
+                  d"\nreturn ${translateExpr(body)}".indented
+                }\n})".indented
+              case _ => d"\nreturn ${translateExpr(body)}".indented
+            }
           }\n}"
       }.flattenDescriptions +
       d"\n\nvar $procTableName = distsys.MakeMPCalProcTable(${
@@ -173,7 +173,7 @@ object TLAExprInterpreter {
       BuiltinModules.Bags.memberSym(TLASymbol.OPlusSymbol) -> { _ => throw Unsupported() },
       BuiltinModules.Bags.memberSym(TLASymbol.OMinusSymbol) -> { _ => throw Unsupported() },
       BuiltinModules.Bags.memberAlpha("BagUnion") -> { case List(_) => throw Unsupported() },
-      BuiltinModules.Bags.memberSym(TLASymbol.SquareSupersetOrEqualSymbol) -> { _ => throw Unsupported() },
+      BuiltinModules.Bags.memberSym(TLASymbol.SquareSubsetOrEqualSymbol) -> { _ => throw Unsupported() },
       BuiltinModules.Bags.memberAlpha("SubBag") -> { case List(_) => throw Unsupported() },
       BuiltinModules.Bags.memberAlpha("BagOfAll") -> { case List(_, _) => throw Unsupported() },
       BuiltinModules.Bags.memberAlpha("BagCardinality") -> { case List(_) => throw Unsupported() },
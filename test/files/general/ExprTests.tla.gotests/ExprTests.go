@@ -11,2265 +11,2271 @@ var _ = distsys.ErrContextClosed
 var _ = tla.TLAValue{} // same, for tla
 
 func Test1(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLASetComprehension([]tla.TLAValue{tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLASet(tla.MakeTLANumber(1), tla.MakeTLANumber(2), tla.MakeTLANumber(3))}, func(args0 []tla.TLAValue) tla.TLAValue {
-		var y tla.TLAValue = args0[0]
-		_ = y
-		return y
-	})}, func(args []tla.TLAValue) tla.TLAValue {
-		var x tla.TLAValue = args[0]
-		_ = x
-		return x
+	return iface.MemoizeDefinition("exprtests.Test1", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLASetComprehension([]tla.TLAValue{tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLASet(tla.MakeTLANumber(1), tla.MakeTLANumber(2), tla.MakeTLANumber(3))}, func(args0 []tla.TLAValue) tla.TLAValue {
+			var y tla.TLAValue = args0[0]
+			_ = y
+			return y
+		})}, func(args []tla.TLAValue) tla.TLAValue {
+			var x tla.TLAValue = args[0]
+			_ = x
+			return x
+		})
 	})
 }
 func Test2(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLAQuantifiedUniversal([]tla.TLAValue{tla.MakeTLAString("W\"!<WY=K0NjGp1&3=D} ;IY]VxaL1f5uHL~b(|Mnvp_WBN4kG,Vs1H 3ZLD\"3mnaLj8$XEDQ#iT"), tla.MakeTLARecord([]tla.TLARecordField{
-		{tla.MakeTLAString("rjg96CqaSMuCjtyr450h6DL1e02XHUTSveCKybqCudAztsOjRmkIEFEkhaCX9AEfDDCOqmuEg0Xtb5AWWeMNM2fXfObxeWMG7AcDR5KDDHmtkGN8Xd"), tla.TLA_Zero},
-		{tla.MakeTLAString("cSOk29itvxzRN2lPY"), tla.MakeTLATuple()},
-		{tla.MakeTLAString("cfZGl3Wdyxt6gGxpst0G2YzBErEDXQwzSSWERs9MLYB4BpT7ne6vVOh8FuGMFN9KLaHIP7vJe7ItfvqjAIKw8K8LdrCZt"), tla.MakeTLAString("nybf*j`.#ogke@%3kx7zwaX;~nx68X6qVB=\"Dj__7mkIq=25>YVw#\\#]8?t=]ndqUQnWNDhr([Q2Zd6;")},
-		{tla.MakeTLAString("fHeAZdw1vCnj3xfH9h22hrUcMclAc21eFx"), tla.MakeTLATuple()},
-		{tla.MakeTLAString("kPKP6DMtdXB26DOcOq"), tla.TLA_Zero},
-		{tla.MakeTLAString("kOufhkQQBNihpnqM5EG2ave4gvDLXzM4eM3AF0s1vozipbR3jl8f25jIZF2l1DrHNVNGzYyh"), tla.TLA_Zero},
-		{tla.MakeTLAString("pes31jiQYk7kh9MbAqDybYqzToYtXL24"), tla.MakeTLANumber(105)},
-	}), tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLANumber(66), tla.MakeTLANumber(99), tla.TLA_Zero, tla.TLA_Zero, tla.TLA_Zero, tla.TLA_Zero}, func(args3 []tla.TLAValue) tla.TLAValue {
-		var nocBmpMYH5HkfAmS1Hxov1sNAmNpre32Go2PPwiq2V tla.TLAValue = args3[0]
-		_ = nocBmpMYH5HkfAmS1Hxov1sNAmNpre32Go2PPwiq2V
-		var j8KL43er tla.TLAValue = args3[1]
-		_ = j8KL43er
-		var uOnSBu75CcElcoR2ZLguWLx24i2 tla.TLAValue = args3[2]
-		_ = uOnSBu75CcElcoR2ZLguWLx24i2
-		var ciyA3piCZqGxtypqDZ7yUA6ZigMVm0gahHBAmufYN8QSkFcoxD5mhB0H1o5fgF4J0oOpzQgazH89oz tla.TLAValue = args3[3]
-		_ = ciyA3piCZqGxtypqDZ7yUA6ZigMVm0gahHBAmufYN8QSkFcoxD5mhB0H1o5fgF4J0oOpzQgazH89oz
-		var n4vC1IUc6EoStkodAFXDtaZxD3jWmDWSa177v15UbOG2B0yKlCWueKjkDEczLz2NF3SdvY tla.TLAValue = args3[4]
-		_ = n4vC1IUc6EoStkodAFXDtaZxD3jWmDWSa177v15UbOG2B0yKlCWueKjkDEczLz2NF3SdvY
-		var nG28Jo6ZPmaioDORB3rtTQC3MA6TXOlrBAGlocmnn91fd4VcPEWpWFRO7JUXcTbk3FQn3dCQcqJ tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(1))
-		_ = nG28Jo6ZPmaioDORB3rtTQC3MA6TXOlrBAGlocmnn91fd4VcPEWpWFRO7JUXcTbk3FQn3dCQcqJ
-		var xBKLJo9UXTx2yGO87kKwLZp3EJKjMt8xOS9vvpwT1NdMzGj1EGCi0KqLedT1CH81ojtIhh3obPGrPxeWEY1EHqhj tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(2))
-		_ = xBKLJo9UXTx2yGO87kKwLZp3EJKjMt8xOS9vvpwT1NdMzGj1EGCi0KqLedT1CH81ojtIhh3obPGrPxeWEY1EHqhj
-		var i6RrPVsaJZp34W9RKfsUL6y8a2YLkAZH1n1ZGwEfiLNAHirEv tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(3))
-		_ = i6RrPVsaJZp34W9RKfsUL6y8a2YLkAZH1n1ZGwEfiLNAHirEv
-		var dVlDesP9YxJFSIssi1mU9dRmbKMn6bTfAnumnKi9ajEgz1NuxRdxwryFIHa3OfUo1MrozlD4uSviullnZ08ri859pa4F5KfNb8gR6fdhjtBoWJlWNkW tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(4))
-		_ = dVlDesP9YxJFSIssi1mU9dRmbKMn6bTfAnumnKi9ajEgz1NuxRdxwryFIHa3OfUo1MrozlD4uSviullnZ08ri859pa4F5KfNb8gR6fdhjtBoWJlWNkW
-		var vL5YlZ5RucAAv5PRhEbOI9VZskTUrKJ4CBKFD375A24fBQ9Zkar3kcIAP2Zi3nG9Wc9lk tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(5))
-		_ = vL5YlZ5RucAAv5PRhEbOI9VZskTUrKJ4CBKFD375A24fBQ9Zkar3kcIAP2Zi3nG9Wc9lk
-		var jDdLltQvIaww86GJ9RLit4bbP2TJjZwCFi tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(6))
-		_ = jDdLltQvIaww86GJ9RLit4bbP2TJjZwCFi
-		var c1 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(7))
-		_ = c1
-		var gu9EXjPotxXhvLLIhjtCDzeQCP1lCJ7RzjSKWwESuD27E2vhrcreT1bWQAr8Gy01cR2R0amcoUxJHjGzo2AyzZCmi2T9SwC37Nf tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(8))
-		_ = gu9EXjPotxXhvLLIhjtCDzeQCP1lCJ7RzjSKWwESuD27E2vhrcreT1bWQAr8Gy01cR2R0amcoUxJHjGzo2AyzZCmi2T9SwC37Nf
-		var m3xijwGkSO5cbxLH25trtyfWt6DqP1Z4BzoZ3WcgSqIYyn2dDt7ZVs78ZxwY1FsqNxLwi3dMbpfEmt5q8OTZWvi tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(9))
-		_ = m3xijwGkSO5cbxLH25trtyfWt6DqP1Z4BzoZ3WcgSqIYyn2dDt7ZVs78ZxwY1FsqNxLwi3dMbpfEmt5q8OTZWvi
-		var w0Dnh9ChXjWb8kwo2yccyYqOEY3g4xUC1BVGmq9Ef4wToN tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(10))
-		_ = w0Dnh9ChXjWb8kwo2yccyYqOEY3g4xUC1BVGmq9Ef4wToN
-		var arHtAVg7ZC84ZQTk37sxLduCtyW4EZdFarK2NgVFYjUAqYK4Lg5svlEuubqZupNbMxg4CxlsMsRbPbx1Zgn7hI tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(11))
-		_ = arHtAVg7ZC84ZQTk37sxLduCtyW4EZdFarK2NgVFYjUAqYK4Lg5svlEuubqZupNbMxg4CxlsMsRbPbx1Zgn7hI
-		var v32wFKoCXnEHyXEvNj3vCN2HDlHtHTKsZuHvKK6M5Lj4lGKAAAoNIgshzYHaNJcaEIuIKA3tJxbzdSO9HLdt4OBdiVyEYgtoO0Pdt490mNtbsZXLS tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(12))
-		_ = v32wFKoCXnEHyXEvNj3vCN2HDlHtHTKsZuHvKK6M5Lj4lGKAAAoNIgshzYHaNJcaEIuIKA3tJxbzdSO9HLdt4OBdiVyEYgtoO0Pdt490mNtbsZXLS
-		var ymOlbwXnWTvlyPZhi5hXUr1Q8F tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(13))
-		_ = ymOlbwXnWTvlyPZhi5hXUr1Q8F
-		var pzJGbF7qPxCbwDH tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(14))
-		_ = pzJGbF7qPxCbwDH
-		var u1LIxPKqyyXmP7KEdyFd6fh1U9IPW1yfA1BXLBIBaS tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(15))
-		_ = u1LIxPKqyyXmP7KEdyFd6fh1U9IPW1yfA1BXLBIBaS
-		var b tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(16))
-		_ = b
-		var w tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(17))
-		_ = w
-		var uHBr tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(18))
-		_ = uHBr
-		var tKBu3irs5Z1iD0osrHAlL3DC3SaZYsftheu01mEju6FMuMA2SDLe5Bgv6Ftk2yTPvsyryXT6PUwuY3JbA787nHWzK5oFEho6Dru tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(19))
-		_ = tKBu3irs5Z1iD0osrHAlL3DC3SaZYsftheu01mEju6FMuMA2SDLe5Bgv6Ftk2yTPvsyryXT6PUwuY3JbA787nHWzK5oFEho6Dru
-		var wEe1wi4JAYXEZw9A tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(20))
-		_ = wEe1wi4JAYXEZw9A
-		var fSc09GVpEzR3NEnv9gDKuWbvxZk1DhFPpgcR5wrelxE52YmuI tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(21))
-		_ = fSc09GVpEzR3NEnv9gDKuWbvxZk1DhFPpgcR5wrelxE52YmuI
-		var cKJMd4bNQHvk0LNOuxxosJkDpCUCGdPlGken4VkXbbW10XvjagfiuFvUxUSh9GOO7HQqtbKQm50ttSxJroiEPqeAvsqTc7GmJARuTcGAJuP0OQ5S9vlasi tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(22))
-		_ = cKJMd4bNQHvk0LNOuxxosJkDpCUCGdPlGken4VkXbbW10XvjagfiuFvUxUSh9GOO7HQqtbKQm50ttSxJroiEPqeAvsqTc7GmJARuTcGAJuP0OQ5S9vlasi
-		var dabbf4pW9iZ4o9PNEHXEwJVICgUUWGH1di tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(23))
-		_ = dabbf4pW9iZ4o9PNEHXEwJVICgUUWGH1di
-		var oCLDsZ0pCbYzH1WtiKo3Q25bzO6AFfzEWU4V5nmcCR7Wg7H3tz0mIUYN tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(24))
-		_ = oCLDsZ0pCbYzH1WtiKo3Q25bzO6AFfzEWU4V5nmcCR7Wg7H3tz0mIUYN
-		var vQRcHh7prXCSmo7645kktQGhhzw5sU0EKA0dPj6IGYmm1gcJKZCF1C59jwIEMYkjknYtrcnzmdZRdNzEKQjYkWaXARfqTuQhN3UvuZPsDf064 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(25))
-		_ = vQRcHh7prXCSmo7645kktQGhhzw5sU0EKA0dPj6IGYmm1gcJKZCF1C59jwIEMYkjknYtrcnzmdZRdNzEKQjYkWaXARfqTuQhN3UvuZPsDf064
-		var v5NBESJZR67FQzEdXo tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(26))
-		_ = v5NBESJZR67FQzEdXo
-		var mPmT0xvvir9qcuagEB1FjKePcHSzFin3eSyj5pskFDh8gkwgQ4UNSpwZObSgan7dvaHmmEhuf1W9XqBzVuG3WDZ6umhIzsp3uquqfx tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(27))
-		_ = mPmT0xvvir9qcuagEB1FjKePcHSzFin3eSyj5pskFDh8gkwgQ4UNSpwZObSgan7dvaHmmEhuf1W9XqBzVuG3WDZ6umhIzsp3uquqfx
-		var gajCKIRYCfEvkywyxqQtnZN2X1KUCvA2hToDYPpNc5pOusuzLZIxRhqjeBINPqE3dMhjleIMHi8twhfLrgy tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(28))
-		_ = gajCKIRYCfEvkywyxqQtnZN2X1KUCvA2hToDYPpNc5pOusuzLZIxRhqjeBINPqE3dMhjleIMHi8twhfLrgy
-		var tVrPMl8sA8IosBJShd8LqkdVuCeGLSWg5PgHkDbSq6SCUnbg5DMAv9oLfb76sTvo7PR2G7McNtJjTgJ3QinYDOXGuZIe8tcMho tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(29))
-		_ = tVrPMl8sA8IosBJShd8LqkdVuCeGLSWg5PgHkDbSq6SCUnbg5DMAv9oLfb76sTvo7PR2G7McNtJjTgJ3QinYDOXGuZIe8tcMho
-		var cUrxulKuQoIhZ9NgsDb5TH2eR4aZh452ydMqQerlucMtphQVZHq9LT7jOiaLSHGKuSrLekPafmxhi1tq02Bc2YVYzl0IswOZJPWee0yy1TF6XujIvnOUH tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(30))
-		_ = cUrxulKuQoIhZ9NgsDb5TH2eR4aZh452ydMqQerlucMtphQVZHq9LT7jOiaLSHGKuSrLekPafmxhi1tq02Bc2YVYzl0IswOZJPWee0yy1TF6XujIvnOUH
-		var oY7kvPVenvblJViuFV4oOckuE2PHkOElURKpg3pV9wzdakL8wyGR5ppr16f8W7jvvKcK2obG9OZNg8FxPqpT tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(31))
-		_ = oY7kvPVenvblJViuFV4oOckuE2PHkOElURKpg3pV9wzdakL8wyGR5ppr16f8W7jvvKcK2obG9OZNg8FxPqpT
-		var kygf0KxSTLZM0WIYsStdDMhVWjBOEc tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(32))
-		_ = kygf0KxSTLZM0WIYsStdDMhVWjBOEc
-		var dVpTt5jrKlWu0I6Hq6H80zTmUxwUAJYO4dKwf6DQfbTQWe9fGr8TtnynL58wFnRcfuVdaQJ6yRM tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(33))
-		_ = dVpTt5jrKlWu0I6Hq6H80zTmUxwUAJYO4dKwf6DQfbTQWe9fGr8TtnynL58wFnRcfuVdaQJ6yRM
-		var dAod1 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(34))
-		_ = dAod1
-		var kgoXTBI4KqvOyUWXaIwWyd6yIIPp5SQe51pyZdLfvIe2MkKoI5OE1McSiYFuc4hHjnn2UREQjPE tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(35))
-		_ = kgoXTBI4KqvOyUWXaIwWyd6yIIPp5SQe51pyZdLfvIe2MkKoI5OE1McSiYFuc4hHjnn2UREQjPE
-		var jEKHjJEhnyPrT0ICMbND4ZDuST7YpZmchzVcdgZTsbRuoLUO tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(36))
-		_ = jEKHjJEhnyPrT0ICMbND4ZDuST7YpZmchzVcdgZTsbRuoLUO
-		var fCYu6JnCdhV5dcnEHZqdxqxb1NvkWaW3a7CSwZOVuwwMP7xaxWQFTXVO5JA tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(37))
-		_ = fCYu6JnCdhV5dcnEHZqdxqxb1NvkWaW3a7CSwZOVuwwMP7xaxWQFTXVO5JA
-		var eLgDSIT360uKHiKfIXuvvt80krKy3E9CB tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(38))
-		_ = eLgDSIT360uKHiKfIXuvvt80krKy3E9CB
-		var rRne27EVaaUYkyaACWgTLRzkUZBHz27jIJAAr9fWGycUhL7GkbDNTCGmZk7c8cH8TMH4BRU56iZVykwQNYvVYzJyl5W8Rq85Lo0X0sThIZ894Z5 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(39))
-		_ = rRne27EVaaUYkyaACWgTLRzkUZBHz27jIJAAr9fWGycUhL7GkbDNTCGmZk7c8cH8TMH4BRU56iZVykwQNYvVYzJyl5W8Rq85Lo0X0sThIZ894Z5
-		var z767kHpudwA927klR1QW86dnn2HLK0WnSJ8xeBYvEGIUKdIekF82nkMXwlSNbA392CEmc0OIO0150CDQjUSCPkSsVTFyu6zVcjToo tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(40))
-		_ = z767kHpudwA927klR1QW86dnn2HLK0WnSJ8xeBYvEGIUKdIekF82nkMXwlSNbA392CEmc0OIO0150CDQjUSCPkSsVTFyu6zVcjToo
-		var w7jnhD4IjE4lXlmTNY7h8QxxKuCCUVcC72vq1ZebZBFcyhXMEC2tL2jBHvMg7FsGMf0DqWQ tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(41))
-		_ = w7jnhD4IjE4lXlmTNY7h8QxxKuCCUVcC72vq1ZebZBFcyhXMEC2tL2jBHvMg7FsGMf0DqWQ
-		var rIGa13FV0ZR5AdCTnWlDOXjT tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(42))
-		_ = rIGa13FV0ZR5AdCTnWlDOXjT
-		var bo8LMTlo6KHZQuTJpU00AHCVdVHTzdSQ2F3iC0QJX6wbHs0iZc4bcQwsw4ltSneeHqFPQA5sPrxwp2P tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(43))
-		_ = bo8LMTlo6KHZQuTJpU00AHCVdVHTzdSQ2F3iC0QJX6wbHs0iZc4bcQwsw4ltSneeHqFPQA5sPrxwp2P
-		var tu44YUABDodKj1b40ebx0tUcGO29nOdXBAh69zcrHWXduPkyHnvXqWvorVJ1N87iPojCHUYJ5ik2FZh63NDp6sEcI6RZeZeHEBKjzWoUtEIbOBPJa6v tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(44))
-		_ = tu44YUABDodKj1b40ebx0tUcGO29nOdXBAh69zcrHWXduPkyHnvXqWvorVJ1N87iPojCHUYJ5ik2FZh63NDp6sEcI6RZeZeHEBKjzWoUtEIbOBPJa6v
-		var s1oqnYwqyEn1UiHJIR2wPc2ujLz58hTDNQR2b1re6AhBWW5kzH9z7s tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(45))
-		_ = s1oqnYwqyEn1UiHJIR2wPc2ujLz58hTDNQR2b1re6AhBWW5kzH9z7s
-		var wZliaUx98doRU7rNu6fbT2mk815GbGmEYSQuuUjk35kZlZJATuI3xyfQWguOR6q1sImmNRfrXp2FGh01LINAXJ3cPV3rXDLjZobznGHUdPAQhzj6RyLYVp tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(46))
-		_ = wZliaUx98doRU7rNu6fbT2mk815GbGmEYSQuuUjk35kZlZJATuI3xyfQWguOR6q1sImmNRfrXp2FGh01LINAXJ3cPV3rXDLjZobznGHUdPAQhzj6RyLYVp
-		var iX8k4PuCzNziDOGiuwRX7fX9z6BEXhkCke2svL5oljwqETLc3Rvyao7TAi3LKF6MtxUq37S42lfGOcrwYNCcUpVINAu077PqQh tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(47))
-		_ = iX8k4PuCzNziDOGiuwRX7fX9z6BEXhkCke2svL5oljwqETLc3Rvyao7TAi3LKF6MtxUq37S42lfGOcrwYNCcUpVINAu077PqQh
-		var sD9Xav0QEOwlydXcltrIAOUOIBhVXVjDFmIlxe5HfxJuw7a3NJDjnnuHAO9VHmkR2wzKvX8aBDrVmcFl tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(48))
-		_ = sD9Xav0QEOwlydXcltrIAOUOIBhVXVjDFmIlxe5HfxJuw7a3NJDjnnuHAO9VHmkR2wzKvX8aBDrVmcFl
-		var w9EjUpyHHRBcb31bvL2gDTM6aN6ENy2r tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(49))
-		_ = w9EjUpyHHRBcb31bvL2gDTM6aN6ENy2r
-		var pZ51Eey23PSGICwafccEh3nv8a7UIK2iNS1RfoaCrJ tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(50))
-		_ = pZ51Eey23PSGICwafccEh3nv8a7UIK2iNS1RfoaCrJ
-		var cb8FIw3u7G8UiFsOGqB2r8X0E tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(51))
-		_ = cb8FIw3u7G8UiFsOGqB2r8X0E
-		var cqnsx3vgQ5yUZiT0NfGWch99Sz2t0fwcKrJoMmph1ptrJjfIqLsFQfSlkcJ7JFt0Fls0zLEkgETuOjE62kZOyWOHfXEaViXGtktc3WcE tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(52))
-		_ = cqnsx3vgQ5yUZiT0NfGWch99Sz2t0fwcKrJoMmph1ptrJjfIqLsFQfSlkcJ7JFt0Fls0zLEkgETuOjE62kZOyWOHfXEaViXGtktc3WcE
-		var cjQHmCGaCBlQwj86IWfLZT4S8TV9 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(53))
-		_ = cjQHmCGaCBlQwj86IWfLZT4S8TV9
-		var kmKS4wSYkuaPVwT7gQvCnrlUq3Aq86a3fJFLhCwyumZiBSCTbDw5eC0Gbxnu2W9lTfK2EPrd3rJppEskL4LWq8ChHO3ZRI1XMh4z5ibLx tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(54))
-		_ = kmKS4wSYkuaPVwT7gQvCnrlUq3Aq86a3fJFLhCwyumZiBSCTbDw5eC0Gbxnu2W9lTfK2EPrd3rJppEskL4LWq8ChHO3ZRI1XMh4z5ibLx
-		var q1w6LUdsyk2L6Xj4Zu1pXbqTWJ28bOS65 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(55))
-		_ = q1w6LUdsyk2L6Xj4Zu1pXbqTWJ28bOS65
-		var j0HejTVIA0AU8kGoqhD3ujGPiKNTT7ez9mA10vbjezgwcCFOTI67WopqH3JWjPjB2vgnp2hnwoD5UKmEmEb58OvRP6ikVoNuYBR tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(56))
-		_ = j0HejTVIA0AU8kGoqhD3ujGPiKNTT7ez9mA10vbjezgwcCFOTI67WopqH3JWjPjB2vgnp2hnwoD5UKmEmEb58OvRP6ikVoNuYBR
-		var b1vQTWqj8hvGIg4NNe9K46MWC2R7O8YKVMKFGWCX1GWgGfBD26aGpxmuUU6RJrUHHvVfcOfUHZi2IJvTBmJsQpjgUldOHnhaRTfPp80VP0gNHb9gaX2n5 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(57))
-		_ = b1vQTWqj8hvGIg4NNe9K46MWC2R7O8YKVMKFGWCX1GWgGfBD26aGpxmuUU6RJrUHHvVfcOfUHZi2IJvTBmJsQpjgUldOHnhaRTfPp80VP0gNHb9gaX2n5
-		var eojcqQlbou3xtqcgn67uUd0vFebHtwD53Ko508UycnBiq5lbw6Fg4jcvv37F5uEi7KoxubM4irzxKq tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(58))
-		_ = eojcqQlbou3xtqcgn67uUd0vFebHtwD53Ko508UycnBiq5lbw6Fg4jcvv37F5uEi7KoxubM4irzxKq
-		var zH tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(59))
-		_ = zH
-		var yXTIbTDjWUn6gCVk2lQGSZr3TCdqbtiHw4edqxs6DLzkJMdFyeYs1jl7Ls6yXVKXlKM7bbGw1v7022fiJIzpF6Rf tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(60))
-		_ = yXTIbTDjWUn6gCVk2lQGSZr3TCdqbtiHw4edqxs6DLzkJMdFyeYs1jl7Ls6yXVKXlKM7bbGw1v7022fiJIzpF6Rf
-		return dVpTt5jrKlWu0I6Hq6H80zTmUxwUAJYO4dKwf6DQfbTQWe9fGr8TtnynL58wFnRcfuVdaQJ6yRM
-	}), tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLANumber(68), tla.TLA_Len(tla.TLA_Zero), tla.TLA_Tail(tla.MakeTLATuple())}, func(args4 []tla.TLAValue) tla.TLAValue {
-		var nMDLcmFYG6N5X5qUG4Q4ELPxCZRP1ByOFD8cIbWHPjvMaPtf5Q7BBDw71oHBnalYi6KgmUCNtO0j7r3nAkq7Wn3ClMSz44nnJXpgNIZZydK tla.TLAValue = args4[0]
-		_ = nMDLcmFYG6N5X5qUG4Q4ELPxCZRP1ByOFD8cIbWHPjvMaPtf5Q7BBDw71oHBnalYi6KgmUCNtO0j7r3nAkq7Wn3ClMSz44nnJXpgNIZZydK
-		var vvmBdcUQcmzi3aF14n2S3Oie2iBRkC6Pq5dh91BNivvWOU8pQ8lX0buK5SxjDEHFi tla.TLAValue = args4[1]
-		_ = vvmBdcUQcmzi3aF14n2S3Oie2iBRkC6Pq5dh91BNivvWOU8pQ8lX0buK5SxjDEHFi
-		var zWSbRBDcKvPAzjLcOPdpDMdxHB07sO7kEq9Sk9MydQzf7b5IguRfwHTQeQwDS8uwsPPE77kph4VdYlWi6GlfISwJgzUF5mDwt tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(1))
-		_ = zWSbRBDcKvPAzjLcOPdpDMdxHB07sO7kEq9Sk9MydQzf7b5IguRfwHTQeQwDS8uwsPPE77kph4VdYlWi6GlfISwJgzUF5mDwt
-		var tM4biPtMAZBAnwcHTRZchOiUQbHTr5KKiEMSYGuaTRcR2D7H7gRNHU1jtt7MufPg0LIhai5q4fS4 tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(2))
-		_ = tM4biPtMAZBAnwcHTRZchOiUQbHTr5KKiEMSYGuaTRcR2D7H7gRNHU1jtt7MufPg0LIhai5q4fS4
-		var wcjXTUfGXsyPRN2pceinloBdDEEHrHSn1maNU4p0sXLC7L8XZVx23CVShjmVQFhJGTdbd1S4Yez3THebEY41dE9hSjqL tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(3))
-		_ = wcjXTUfGXsyPRN2pceinloBdDEEHrHSn1maNU4p0sXLC7L8XZVx23CVShjmVQFhJGTdbd1S4Yez3THebEY41dE9hSjqL
-		var av02TZ tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(4))
-		_ = av02TZ
-		var wT99Pz2tTM7YcBTcUsZXIkd6iERmCmjIeM6olKGr1W2o3qbpooN80wnA37x2Yu03QomrJCPixVKWEQ8CCTkNXiBEu8hYnGcporfbymNh tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(5))
-		_ = wT99Pz2tTM7YcBTcUsZXIkd6iERmCmjIeM6olKGr1W2o3qbpooN80wnA37x2Yu03QomrJCPixVKWEQ8CCTkNXiBEu8hYnGcporfbymNh
-		var dmUGiLMfUfbLQetiOQLGDsJfywJllYN9HZWT0mRxzdB6Oi2PH5mLqQQkSgh7K tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(6))
-		_ = dmUGiLMfUfbLQetiOQLGDsJfywJllYN9HZWT0mRxzdB6Oi2PH5mLqQQkSgh7K
-		var xXGfSvXoSeT9bS tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(7))
-		_ = xXGfSvXoSeT9bS
-		var sWJBRBh7HLygHJkCRastp tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(8))
-		_ = sWJBRBh7HLygHJkCRastp
-		var jA5vUa3xHA tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(9))
-		_ = jA5vUa3xHA
-		var aq tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(10))
-		_ = aq
-		var f tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(11))
-		_ = f
-		var prStzVZ7XpWWbKe tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(12))
-		_ = prStzVZ7XpWWbKe
-		var eIRzlCgxVKnkew9eZcLbvOl9EuW5fcCfFhRQHoXFluPH6xZWIYMDKcLFXNsb9JrHulGXYSJnGaTC20T7ONoHzHyyrf6uS tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(13))
-		_ = eIRzlCgxVKnkew9eZcLbvOl9EuW5fcCfFhRQHoXFluPH6xZWIYMDKcLFXNsb9JrHulGXYSJnGaTC20T7ONoHzHyyrf6uS
-		var nVjmRIA1A0qvjMvMcWtEazsaHzES6Y6avOavkfPu9vivjs3nLb1xNhDASadHLLwDOpirr tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(14))
-		_ = nVjmRIA1A0qvjMvMcWtEazsaHzES6Y6avOavkfPu9vivjs3nLb1xNhDASadHLLwDOpirr
-		var i8VaAM97sRQIskPqOb8F9qgrC2xbfIFajVEpFV1swyX4HY4itr8dqiu7CtOm4mkLHGYpfcINUDEo tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(15))
-		_ = i8VaAM97sRQIskPqOb8F9qgrC2xbfIFajVEpFV1swyX4HY4itr8dqiu7CtOm4mkLHGYpfcINUDEo
-		var hRv4iQGc1JVFMKCTUQzsbwHCqzQQBxhBenCzVtigvQEu53lXN6VbVCZjnphAv5ivPE0eQkvDLvE9UG085C7FGFX3Az5rx0L5jw4WYA tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(16))
-		_ = hRv4iQGc1JVFMKCTUQzsbwHCqzQQBxhBenCzVtigvQEu53lXN6VbVCZjnphAv5ivPE0eQkvDLvE9UG085C7FGFX3Az5rx0L5jw4WYA
-		var twOadTdWqFwx7Njr0GZjFFKPMe tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(17))
-		_ = twOadTdWqFwx7Njr0GZjFFKPMe
-		var d2jxAQesE8O168 tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(18))
-		_ = d2jxAQesE8O168
-		var lkwXOmWqOIJ6Ql8OIq3bTcbIQskJSBVZDSFBlgPVo2bsCFMTna3KlDhloRIADgpYDkhsH1eVxsLeomYGENKO2tPvZ885GYMGU4ySwDAW671GD7XWkHH1z0 tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(19))
-		_ = lkwXOmWqOIJ6Ql8OIq3bTcbIQskJSBVZDSFBlgPVo2bsCFMTna3KlDhloRIADgpYDkhsH1eVxsLeomYGENKO2tPvZ885GYMGU4ySwDAW671GD7XWkHH1z0
-		var vtzcOt0qehTOCbja7hiqi9V4Hs2tIvgee tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(20))
-		_ = vtzcOt0qehTOCbja7hiqi9V4Hs2tIvgee
-		var sck5QnM1QBAJZiiQTl1cWPDvK6T924jcFs0W0rCUSPvBJpzWw6dKuw9GSx7qYhOMktuyxHMl7cQ2WOCzwdAfmapTHCN8ULAWdHYB3RhcDMwT tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(21))
-		_ = sck5QnM1QBAJZiiQTl1cWPDvK6T924jcFs0W0rCUSPvBJpzWw6dKuw9GSx7qYhOMktuyxHMl7cQ2WOCzwdAfmapTHCN8ULAWdHYB3RhcDMwT
-		var cBDTNj2CdkpUB6FQj6Eh4f0y25yUZZz6nyHTD0HKxV tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(22))
-		_ = cBDTNj2CdkpUB6FQj6Eh4f0y25yUZZz6nyHTD0HKxV
-		var abkEO30MYO2pVxZCTm tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(23))
-		_ = abkEO30MYO2pVxZCTm
-		var hXmoGfFLEVzH440IsaAa tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(24))
-		_ = hXmoGfFLEVzH440IsaAa
-		var tLJy44X8hgEaFaijSMIP0cBZHKwCTwNFcfKVbc3KOspZa47YLjw7whIRfeRgcbF9VVbOt0B6eFiLfmErFSGKkc tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(25))
-		_ = tLJy44X8hgEaFaijSMIP0cBZHKwCTwNFcfKVbc3KOspZa47YLjw7whIRfeRgcbF9VVbOt0B6eFiLfmErFSGKkc
-		var ffsgS88PJbkGRGRPyvshSsbCSuCvdKmCzWvj5jjtec70RMylBnbam3raHiWhiBRwHpznDps6UZ4ToyM tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(26))
-		_ = ffsgS88PJbkGRGRPyvshSsbCSuCvdKmCzWvj5jjtec70RMylBnbam3raHiWhiBRwHpznDps6UZ4ToyM
-		var kp5z5odj84ZCfq8YO8EddxCGN6Cdd9Fy4vFsTNHEfb4uzqFO0t1KFdX6jC2rPZrw7iWCXC0I7WopTbDMpDJ tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(27))
-		_ = kp5z5odj84ZCfq8YO8EddxCGN6Cdd9Fy4vFsTNHEfb4uzqFO0t1KFdX6jC2rPZrw7iWCXC0I7WopTbDMpDJ
-		var fW3AJLNnZhCFZobuhEplMFQJBJyFWuvxdVgPRqGF7XSSyhcElsQAe84TIlEJ0YsI99KvebNeRKfwyZYkghsxWRZMkbimKER tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(28))
-		_ = fW3AJLNnZhCFZobuhEplMFQJBJyFWuvxdVgPRqGF7XSSyhcElsQAe84TIlEJ0YsI99KvebNeRKfwyZYkghsxWRZMkbimKER
-		var safYYZEZdjiPMwpqGelf0JtCZ3gxbKWgp7gROzmTkMMI3S26yi7jtplo1700qqjKIn tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(29))
-		_ = safYYZEZdjiPMwpqGelf0JtCZ3gxbKWgp7gROzmTkMMI3S26yi7jtplo1700qqjKIn
-		var iPZqmoYuMPJZgulzB8meSpcZb4bnsiiOATvyn0pWKol2pHA29qCvVLVa0ANtPDr0Su0MMv403 tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(30))
-		_ = iPZqmoYuMPJZgulzB8meSpcZb4bnsiiOATvyn0pWKol2pHA29qCvVLVa0ANtPDr0Su0MMv403
-		var d5zv5RIsAzs2ahTNTGSgUqEWeRuUBQn8rnA4zCPPNJHaOr2qnst0RBA1EVhNnqmAhBpVn0TgFfAMGzRwQYM5McLHK54fGQKKXHrlV0NYbeFClB2r7hj tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(31))
-		_ = d5zv5RIsAzs2ahTNTGSgUqEWeRuUBQn8rnA4zCPPNJHaOr2qnst0RBA1EVhNnqmAhBpVn0TgFfAMGzRwQYM5McLHK54fGQKKXHrlV0NYbeFClB2r7hj
-		var idl0BkyjmqQR2ogEUbauQslCMUFgmpkeB9fK7BGsFYgj88jn9Yk8fFmyiigqq8XYPsvLIfmyfKINd2fEt7qli5qKMzymBZ47bMn tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(32))
-		_ = idl0BkyjmqQR2ogEUbauQslCMUFgmpkeB9fK7BGsFYgj88jn9Yk8fFmyiigqq8XYPsvLIfmyfKINd2fEt7qli5qKMzymBZ47bMn
-		var zwHA6J50eAqpOn4Fbddd4o1jJh0epr1YLcR0CiYxo6SdhySdmqPWuPvwLlJP3hRDl84EWzZs6J tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(33))
-		_ = zwHA6J50eAqpOn4Fbddd4o1jJh0epr1YLcR0CiYxo6SdhySdmqPWuPvwLlJP3hRDl84EWzZs6J
-		var v7vKCElgaxOtASA7f2MQ6nwPhPF3U319cVK1ptI4xk7zi5pqyYHeZW860mWbRNQbIyjs1VioacJv01D3NGeFZg tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(34))
-		_ = v7vKCElgaxOtASA7f2MQ6nwPhPF3U319cVK1ptI4xk7zi5pqyYHeZW860mWbRNQbIyjs1VioacJv01D3NGeFZg
-		var fRwBpdsAaVfacRTkB9lOL8vQnYPZf8xbyuI66WTf1UzHB9uL5NNEyFCgHcUu7q7bdxShZV454X5Wr7EILJU2RDqiNsMdHpN tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(35))
-		_ = fRwBpdsAaVfacRTkB9lOL8vQnYPZf8xbyuI66WTf1UzHB9uL5NNEyFCgHcUu7q7bdxShZV454X5Wr7EILJU2RDqiNsMdHpN
-		var asB9JRbcFvI1JMj7JMqkM0cZFcKmLjCaPsMz8pCrMyhWHev65Gp0dkWdxf87hDN7rfYo9xvLhcvmC6RxVdfFCI1GPDewEJ02VqOnnGCvKUBVJImZY tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(36))
-		_ = asB9JRbcFvI1JMj7JMqkM0cZFcKmLjCaPsMz8pCrMyhWHev65Gp0dkWdxf87hDN7rfYo9xvLhcvmC6RxVdfFCI1GPDewEJ02VqOnnGCvKUBVJImZY
-		var k4uPFdT09BGyWRWYEu7pX tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(37))
-		_ = k4uPFdT09BGyWRWYEu7pX
-		var h5pW5RegOA0yWovd7W4YjksfYlGpW4I0U4RyJpQ7U698zO5JaejMotDyOkAHYxSKdDylSDT2vX3v4TQ8vmi3WztCuxArTodhQIvcL tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(38))
-		_ = h5pW5RegOA0yWovd7W4YjksfYlGpW4I0U4RyJpQ7U698zO5JaejMotDyOkAHYxSKdDylSDT2vX3v4TQ8vmi3WztCuxArTodhQIvcL
-		var up0Vdghc938Mr6hIH3j0VGUmvFxGw4sm9f2Dq tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(39))
-		_ = up0Vdghc938Mr6hIH3j0VGUmvFxGw4sm9f2Dq
-		var kKrE0nlxzpu1lHtP7uXyLbghdH3rmMWbOEAY7Cqkn4xchUmVC tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(40))
-		_ = kKrE0nlxzpu1lHtP7uXyLbghdH3rmMWbOEAY7Cqkn4xchUmVC
-		return tla.MakeTLASet(zWSbRBDcKvPAzjLcOPdpDMdxHB07sO7kEq9Sk9MydQzf7b5IguRfwHTQeQwDS8uwsPPE77kph4VdYlWi6GlfISwJgzUF5mDwt)
-	}), tla.TLA_Zero, tla.TLA_Len(tla.TLA_Zero).ApplyFunction(tla.MakeTLATuple(tla.TLA_Zero, tla.TLA_Head(tla.TLA_Zero), tla.TLA_Cardinality(tla.MakeTLATuple()))), tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLATuple()}, func(args5 []tla.TLAValue) tla.TLAValue {
-		var jFSnew60k9UkNMbOwRMQkAjaDOsCVlPPzNCyFhfU0fyWovez0zXZDVqaX3EHHxW8P9RjGDPX8z8k8OPG6zvn tla.TLAValue = args5[0]
-		_ = jFSnew60k9UkNMbOwRMQkAjaDOsCVlPPzNCyFhfU0fyWovez0zXZDVqaX3EHHxW8P9RjGDPX8z8k8OPG6zvn
-		return tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLASet()}, func(args6 []tla.TLAValue) tla.TLAValue {
-			var bJdvTIPKLRX2S7AzBaJhHn6qzYpgdcuCSHMXowo tla.TLAValue = args6[0]
-			_ = bJdvTIPKLRX2S7AzBaJhHn6qzYpgdcuCSHMXowo
-			return jFSnew60k9UkNMbOwRMQkAjaDOsCVlPPzNCyFhfU0fyWovez0zXZDVqaX3EHHxW8P9RjGDPX8z8k8OPG6zvn
+	return iface.MemoizeDefinition("exprtests.Test2", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLAQuantifiedUniversal([]tla.TLAValue{tla.MakeTLAString("W\"!<WY=K0NjGp1&3=D} ;IY]VxaL1f5uHL~b(|Mnvp_WBN4kG,Vs1H 3ZLD\"3mnaLj8$XEDQ#iT"), tla.MakeTLARecord([]tla.TLARecordField{
+			{tla.MakeTLAString("rjg96CqaSMuCjtyr450h6DL1e02XHUTSveCKybqCudAztsOjRmkIEFEkhaCX9AEfDDCOqmuEg0Xtb5AWWeMNM2fXfObxeWMG7AcDR5KDDHmtkGN8Xd"), tla.TLA_Zero},
+			{tla.MakeTLAString("cSOk29itvxzRN2lPY"), tla.MakeTLATuple()},
+			{tla.MakeTLAString("cfZGl3Wdyxt6gGxpst0G2YzBErEDXQwzSSWERs9MLYB4BpT7ne6vVOh8FuGMFN9KLaHIP7vJe7ItfvqjAIKw8K8LdrCZt"), tla.MakeTLAString("nybf*j`.#ogke@%3kx7zwaX;~nx68X6qVB=\"Dj__7mkIq=25>YVw#\\#]8?t=]ndqUQnWNDhr([Q2Zd6;")},
+			{tla.MakeTLAString("fHeAZdw1vCnj3xfH9h22hrUcMclAc21eFx"), tla.MakeTLATuple()},
+			{tla.MakeTLAString("kPKP6DMtdXB26DOcOq"), tla.TLA_Zero},
+			{tla.MakeTLAString("kOufhkQQBNihpnqM5EG2ave4gvDLXzM4eM3AF0s1vozipbR3jl8f25jIZF2l1DrHNVNGzYyh"), tla.TLA_Zero},
+			{tla.MakeTLAString("pes31jiQYk7kh9MbAqDybYqzToYtXL24"), tla.MakeTLANumber(105)},
+		}), tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLANumber(66), tla.MakeTLANumber(99), tla.TLA_Zero, tla.TLA_Zero, tla.TLA_Zero, tla.TLA_Zero}, func(args3 []tla.TLAValue) tla.TLAValue {
+			var nocBmpMYH5HkfAmS1Hxov1sNAmNpre32Go2PPwiq2V tla.TLAValue = args3[0]
+			_ = nocBmpMYH5HkfAmS1Hxov1sNAmNpre32Go2PPwiq2V
+			var j8KL43er tla.TLAValue = args3[1]
+			_ = j8KL43er
+			var uOnSBu75CcElcoR2ZLguWLx24i2 tla.TLAValue = args3[2]
+			_ = uOnSBu75CcElcoR2ZLguWLx24i2
+			var ciyA3piCZqGxtypqDZ7yUA6ZigMVm0gahHBAmufYN8QSkFcoxD5mhB0H1o5fgF4J0oOpzQgazH89oz tla.TLAValue = args3[3]
+			_ = ciyA3piCZqGxtypqDZ7yUA6ZigMVm0gahHBAmufYN8QSkFcoxD5mhB0H1o5fgF4J0oOpzQgazH89oz
+			var n4vC1IUc6EoStkodAFXDtaZxD3jWmDWSa177v15UbOG2B0yKlCWueKjkDEczLz2NF3SdvY tla.TLAValue = args3[4]
+			_ = n4vC1IUc6EoStkodAFXDtaZxD3jWmDWSa177v15UbOG2B0yKlCWueKjkDEczLz2NF3SdvY
+			var nG28Jo6ZPmaioDORB3rtTQC3MA6TXOlrBAGlocmnn91fd4VcPEWpWFRO7JUXcTbk3FQn3dCQcqJ tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(1))
+			_ = nG28Jo6ZPmaioDORB3rtTQC3MA6TXOlrBAGlocmnn91fd4VcPEWpWFRO7JUXcTbk3FQn3dCQcqJ
+			var xBKLJo9UXTx2yGO87kKwLZp3EJKjMt8xOS9vvpwT1NdMzGj1EGCi0KqLedT1CH81ojtIhh3obPGrPxeWEY1EHqhj tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(2))
+			_ = xBKLJo9UXTx2yGO87kKwLZp3EJKjMt8xOS9vvpwT1NdMzGj1EGCi0KqLedT1CH81ojtIhh3obPGrPxeWEY1EHqhj
+			var i6RrPVsaJZp34W9RKfsUL6y8a2YLkAZH1n1ZGwEfiLNAHirEv tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(3))
+			_ = i6RrPVsaJZp34W9RKfsUL6y8a2YLkAZH1n1ZGwEfiLNAHirEv
+			var dVlDesP9YxJFSIssi1mU9dRmbKMn6bTfAnumnKi9ajEgz1NuxRdxwryFIHa3OfUo1MrozlD4uSviullnZ08ri859pa4F5KfNb8gR6fdhjtBoWJlWNkW tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(4))
+			_ = dVlDesP9YxJFSIssi1mU9dRmbKMn6bTfAnumnKi9ajEgz1NuxRdxwryFIHa3OfUo1MrozlD4uSviullnZ08ri859pa4F5KfNb8gR6fdhjtBoWJlWNkW
+			var vL5YlZ5RucAAv5PRhEbOI9VZskTUrKJ4CBKFD375A24fBQ9Zkar3kcIAP2Zi3nG9Wc9lk tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(5))
+			_ = vL5YlZ5RucAAv5PRhEbOI9VZskTUrKJ4CBKFD375A24fBQ9Zkar3kcIAP2Zi3nG9Wc9lk
+			var jDdLltQvIaww86GJ9RLit4bbP2TJjZwCFi tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(6))
+			_ = jDdLltQvIaww86GJ9RLit4bbP2TJjZwCFi
+			var c1 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(7))
+			_ = c1
+			var gu9EXjPotxXhvLLIhjtCDzeQCP1lCJ7RzjSKWwESuD27E2vhrcreT1bWQAr8Gy01cR2R0amcoUxJHjGzo2AyzZCmi2T9SwC37Nf tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(8))
+			_ = gu9EXjPotxXhvLLIhjtCDzeQCP1lCJ7RzjSKWwESuD27E2vhrcreT1bWQAr8Gy01cR2R0amcoUxJHjGzo2AyzZCmi2T9SwC37Nf
+			var m3xijwGkSO5cbxLH25trtyfWt6DqP1Z4BzoZ3WcgSqIYyn2dDt7ZVs78ZxwY1FsqNxLwi3dMbpfEmt5q8OTZWvi tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(9))
+			_ = m3xijwGkSO5cbxLH25trtyfWt6DqP1Z4BzoZ3WcgSqIYyn2dDt7ZVs78ZxwY1FsqNxLwi3dMbpfEmt5q8OTZWvi
+			var w0Dnh9ChXjWb8kwo2yccyYqOEY3g4xUC1BVGmq9Ef4wToN tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(10))
+			_ = w0Dnh9ChXjWb8kwo2yccyYqOEY3g4xUC1BVGmq9Ef4wToN
+			var arHtAVg7ZC84ZQTk37sxLduCtyW4EZdFarK2NgVFYjUAqYK4Lg5svlEuubqZupNbMxg4CxlsMsRbPbx1Zgn7hI tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(11))
+			_ = arHtAVg7ZC84ZQTk37sxLduCtyW4EZdFarK2NgVFYjUAqYK4Lg5svlEuubqZupNbMxg4CxlsMsRbPbx1Zgn7hI
+			var v32wFKoCXnEHyXEvNj3vCN2HDlHtHTKsZuHvKK6M5Lj4lGKAAAoNIgshzYHaNJcaEIuIKA3tJxbzdSO9HLdt4OBdiVyEYgtoO0Pdt490mNtbsZXLS tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(12))
+			_ = v32wFKoCXnEHyXEvNj3vCN2HDlHtHTKsZuHvKK6M5Lj4lGKAAAoNIgshzYHaNJcaEIuIKA3tJxbzdSO9HLdt4OBdiVyEYgtoO0Pdt490mNtbsZXLS
+			var ymOlbwXnWTvlyPZhi5hXUr1Q8F tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(13))
+			_ = ymOlbwXnWTvlyPZhi5hXUr1Q8F
+			var pzJGbF7qPxCbwDH tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(14))
+			_ = pzJGbF7qPxCbwDH
+			var u1LIxPKqyyXmP7KEdyFd6fh1U9IPW1yfA1BXLBIBaS tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(15))
+			_ = u1LIxPKqyyXmP7KEdyFd6fh1U9IPW1yfA1BXLBIBaS
+			var b tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(16))
+			_ = b
+			var w tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(17))
+			_ = w
+			var uHBr tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(18))
+			_ = uHBr
+			var tKBu3irs5Z1iD0osrHAlL3DC3SaZYsftheu01mEju6FMuMA2SDLe5Bgv6Ftk2yTPvsyryXT6PUwuY3JbA787nHWzK5oFEho6Dru tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(19))
+			_ = tKBu3irs5Z1iD0osrHAlL3DC3SaZYsftheu01mEju6FMuMA2SDLe5Bgv6Ftk2yTPvsyryXT6PUwuY3JbA787nHWzK5oFEho6Dru
+			var wEe1wi4JAYXEZw9A tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(20))
+			_ = wEe1wi4JAYXEZw9A
+			var fSc09GVpEzR3NEnv9gDKuWbvxZk1DhFPpgcR5wrelxE52YmuI tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(21))
+			_ = fSc09GVpEzR3NEnv9gDKuWbvxZk1DhFPpgcR5wrelxE52YmuI
+			var cKJMd4bNQHvk0LNOuxxosJkDpCUCGdPlGken4VkXbbW10XvjagfiuFvUxUSh9GOO7HQqtbKQm50ttSxJroiEPqeAvsqTc7GmJARuTcGAJuP0OQ5S9vlasi tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(22))
+			_ = cKJMd4bNQHvk0LNOuxxosJkDpCUCGdPlGken4VkXbbW10XvjagfiuFvUxUSh9GOO7HQqtbKQm50ttSxJroiEPqeAvsqTc7GmJARuTcGAJuP0OQ5S9vlasi
+			var dabbf4pW9iZ4o9PNEHXEwJVICgUUWGH1di tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(23))
+			_ = dabbf4pW9iZ4o9PNEHXEwJVICgUUWGH1di
+			var oCLDsZ0pCbYzH1WtiKo3Q25bzO6AFfzEWU4V5nmcCR7Wg7H3tz0mIUYN tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(24))
+			_ = oCLDsZ0pCbYzH1WtiKo3Q25bzO6AFfzEWU4V5nmcCR7Wg7H3tz0mIUYN
+			var vQRcHh7prXCSmo7645kktQGhhzw5sU0EKA0dPj6IGYmm1gcJKZCF1C59jwIEMYkjknYtrcnzmdZRdNzEKQjYkWaXARfqTuQhN3UvuZPsDf064 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(25))
+			_ = vQRcHh7prXCSmo7645kktQGhhzw5sU0EKA0dPj6IGYmm1gcJKZCF1C59jwIEMYkjknYtrcnzmdZRdNzEKQjYkWaXARfqTuQhN3UvuZPsDf064
+			var v5NBESJZR67FQzEdXo tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(26))
+			_ = v5NBESJZR67FQzEdXo
+			var mPmT0xvvir9qcuagEB1FjKePcHSzFin3eSyj5pskFDh8gkwgQ4UNSpwZObSgan7dvaHmmEhuf1W9XqBzVuG3WDZ6umhIzsp3uquqfx tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(27))
+			_ = mPmT0xvvir9qcuagEB1FjKePcHSzFin3eSyj5pskFDh8gkwgQ4UNSpwZObSgan7dvaHmmEhuf1W9XqBzVuG3WDZ6umhIzsp3uquqfx
+			var gajCKIRYCfEvkywyxqQtnZN2X1KUCvA2hToDYPpNc5pOusuzLZIxRhqjeBINPqE3dMhjleIMHi8twhfLrgy tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(28))
+			_ = gajCKIRYCfEvkywyxqQtnZN2X1KUCvA2hToDYPpNc5pOusuzLZIxRhqjeBINPqE3dMhjleIMHi8twhfLrgy
+			var tVrPMl8sA8IosBJShd8LqkdVuCeGLSWg5PgHkDbSq6SCUnbg5DMAv9oLfb76sTvo7PR2G7McNtJjTgJ3QinYDOXGuZIe8tcMho tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(29))
+			_ = tVrPMl8sA8IosBJShd8LqkdVuCeGLSWg5PgHkDbSq6SCUnbg5DMAv9oLfb76sTvo7PR2G7McNtJjTgJ3QinYDOXGuZIe8tcMho
+			var cUrxulKuQoIhZ9NgsDb5TH2eR4aZh452ydMqQerlucMtphQVZHq9LT7jOiaLSHGKuSrLekPafmxhi1tq02Bc2YVYzl0IswOZJPWee0yy1TF6XujIvnOUH tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(30))
+			_ = cUrxulKuQoIhZ9NgsDb5TH2eR4aZh452ydMqQerlucMtphQVZHq9LT7jOiaLSHGKuSrLekPafmxhi1tq02Bc2YVYzl0IswOZJPWee0yy1TF6XujIvnOUH
+			var oY7kvPVenvblJViuFV4oOckuE2PHkOElURKpg3pV9wzdakL8wyGR5ppr16f8W7jvvKcK2obG9OZNg8FxPqpT tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(31))
+			_ = oY7kvPVenvblJViuFV4oOckuE2PHkOElURKpg3pV9wzdakL8wyGR5ppr16f8W7jvvKcK2obG9OZNg8FxPqpT
+			var kygf0KxSTLZM0WIYsStdDMhVWjBOEc tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(32))
+			_ = kygf0KxSTLZM0WIYsStdDMhVWjBOEc
+			var dVpTt5jrKlWu0I6Hq6H80zTmUxwUAJYO4dKwf6DQfbTQWe9fGr8TtnynL58wFnRcfuVdaQJ6yRM tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(33))
+			_ = dVpTt5jrKlWu0I6Hq6H80zTmUxwUAJYO4dKwf6DQfbTQWe9fGr8TtnynL58wFnRcfuVdaQJ6yRM
+			var dAod1 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(34))
+			_ = dAod1
+			var kgoXTBI4KqvOyUWXaIwWyd6yIIPp5SQe51pyZdLfvIe2MkKoI5OE1McSiYFuc4hHjnn2UREQjPE tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(35))
+			_ = kgoXTBI4KqvOyUWXaIwWyd6yIIPp5SQe51pyZdLfvIe2MkKoI5OE1McSiYFuc4hHjnn2UREQjPE
+			var jEKHjJEhnyPrT0ICMbND4ZDuST7YpZmchzVcdgZTsbRuoLUO tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(36))
+			_ = jEKHjJEhnyPrT0ICMbND4ZDuST7YpZmchzVcdgZTsbRuoLUO
+			var fCYu6JnCdhV5dcnEHZqdxqxb1NvkWaW3a7CSwZOVuwwMP7xaxWQFTXVO5JA tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(37))
+			_ = fCYu6JnCdhV5dcnEHZqdxqxb1NvkWaW3a7CSwZOVuwwMP7xaxWQFTXVO5JA
+			var eLgDSIT360uKHiKfIXuvvt80krKy3E9CB tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(38))
+			_ = eLgDSIT360uKHiKfIXuvvt80krKy3E9CB
+			var rRne27EVaaUYkyaACWgTLRzkUZBHz27jIJAAr9fWGycUhL7GkbDNTCGmZk7c8cH8TMH4BRU56iZVykwQNYvVYzJyl5W8Rq85Lo0X0sThIZ894Z5 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(39))
+			_ = rRne27EVaaUYkyaACWgTLRzkUZBHz27jIJAAr9fWGycUhL7GkbDNTCGmZk7c8cH8TMH4BRU56iZVykwQNYvVYzJyl5W8Rq85Lo0X0sThIZ894Z5
+			var z767kHpudwA927klR1QW86dnn2HLK0WnSJ8xeBYvEGIUKdIekF82nkMXwlSNbA392CEmc0OIO0150CDQjUSCPkSsVTFyu6zVcjToo tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(40))
+			_ = z767kHpudwA927klR1QW86dnn2HLK0WnSJ8xeBYvEGIUKdIekF82nkMXwlSNbA392CEmc0OIO0150CDQjUSCPkSsVTFyu6zVcjToo
+			var w7jnhD4IjE4lXlmTNY7h8QxxKuCCUVcC72vq1ZebZBFcyhXMEC2tL2jBHvMg7FsGMf0DqWQ tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(41))
+			_ = w7jnhD4IjE4lXlmTNY7h8QxxKuCCUVcC72vq1ZebZBFcyhXMEC2tL2jBHvMg7FsGMf0DqWQ
+			var rIGa13FV0ZR5AdCTnWlDOXjT tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(42))
+			_ = rIGa13FV0ZR5AdCTnWlDOXjT
+			var bo8LMTlo6KHZQuTJpU00AHCVdVHTzdSQ2F3iC0QJX6wbHs0iZc4bcQwsw4ltSneeHqFPQA5sPrxwp2P tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(43))
+			_ = bo8LMTlo6KHZQuTJpU00AHCVdVHTzdSQ2F3iC0QJX6wbHs0iZc4bcQwsw4ltSneeHqFPQA5sPrxwp2P
+			var tu44YUABDodKj1b40ebx0tUcGO29nOdXBAh69zcrHWXduPkyHnvXqWvorVJ1N87iPojCHUYJ5ik2FZh63NDp6sEcI6RZeZeHEBKjzWoUtEIbOBPJa6v tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(44))
+			_ = tu44YUABDodKj1b40ebx0tUcGO29nOdXBAh69zcrHWXduPkyHnvXqWvorVJ1N87iPojCHUYJ5ik2FZh63NDp6sEcI6RZeZeHEBKjzWoUtEIbOBPJa6v
+			var s1oqnYwqyEn1UiHJIR2wPc2ujLz58hTDNQR2b1re6AhBWW5kzH9z7s tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(45))
+			_ = s1oqnYwqyEn1UiHJIR2wPc2ujLz58hTDNQR2b1re6AhBWW5kzH9z7s
+			var wZliaUx98doRU7rNu6fbT2mk815GbGmEYSQuuUjk35kZlZJATuI3xyfQWguOR6q1sImmNRfrXp2FGh01LINAXJ3cPV3rXDLjZobznGHUdPAQhzj6RyLYVp tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(46))
+			_ = wZliaUx98doRU7rNu6fbT2mk815GbGmEYSQuuUjk35kZlZJATuI3xyfQWguOR6q1sImmNRfrXp2FGh01LINAXJ3cPV3rXDLjZobznGHUdPAQhzj6RyLYVp
+			var iX8k4PuCzNziDOGiuwRX7fX9z6BEXhkCke2svL5oljwqETLc3Rvyao7TAi3LKF6MtxUq37S42lfGOcrwYNCcUpVINAu077PqQh tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(47))
+			_ = iX8k4PuCzNziDOGiuwRX7fX9z6BEXhkCke2svL5oljwqETLc3Rvyao7TAi3LKF6MtxUq37S42lfGOcrwYNCcUpVINAu077PqQh
+			var sD9Xav0QEOwlydXcltrIAOUOIBhVXVjDFmIlxe5HfxJuw7a3NJDjnnuHAO9VHmkR2wzKvX8aBDrVmcFl tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(48))
+			_ = sD9Xav0QEOwlydXcltrIAOUOIBhVXVjDFmIlxe5HfxJuw7a3NJDjnnuHAO9VHmkR2wzKvX8aBDrVmcFl
+			var w9EjUpyHHRBcb31bvL2gDTM6aN6ENy2r tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(49))
+			_ = w9EjUpyHHRBcb31bvL2gDTM6aN6ENy2r
+			var pZ51Eey23PSGICwafccEh3nv8a7UIK2iNS1RfoaCrJ tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(50))
+			_ = pZ51Eey23PSGICwafccEh3nv8a7UIK2iNS1RfoaCrJ
+			var cb8FIw3u7G8UiFsOGqB2r8X0E tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(51))
+			_ = cb8FIw3u7G8UiFsOGqB2r8X0E
+			var cqnsx3vgQ5yUZiT0NfGWch99Sz2t0fwcKrJoMmph1ptrJjfIqLsFQfSlkcJ7JFt0Fls0zLEkgETuOjE62kZOyWOHfXEaViXGtktc3WcE tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(52))
+			_ = cqnsx3vgQ5yUZiT0NfGWch99Sz2t0fwcKrJoMmph1ptrJjfIqLsFQfSlkcJ7JFt0Fls0zLEkgETuOjE62kZOyWOHfXEaViXGtktc3WcE
+			var cjQHmCGaCBlQwj86IWfLZT4S8TV9 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(53))
+			_ = cjQHmCGaCBlQwj86IWfLZT4S8TV9
+			var kmKS4wSYkuaPVwT7gQvCnrlUq3Aq86a3fJFLhCwyumZiBSCTbDw5eC0Gbxnu2W9lTfK2EPrd3rJppEskL4LWq8ChHO3ZRI1XMh4z5ibLx tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(54))
+			_ = kmKS4wSYkuaPVwT7gQvCnrlUq3Aq86a3fJFLhCwyumZiBSCTbDw5eC0Gbxnu2W9lTfK2EPrd3rJppEskL4LWq8ChHO3ZRI1XMh4z5ibLx
+			var q1w6LUdsyk2L6Xj4Zu1pXbqTWJ28bOS65 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(55))
+			_ = q1w6LUdsyk2L6Xj4Zu1pXbqTWJ28bOS65
+			var j0HejTVIA0AU8kGoqhD3ujGPiKNTT7ez9mA10vbjezgwcCFOTI67WopqH3JWjPjB2vgnp2hnwoD5UKmEmEb58OvRP6ikVoNuYBR tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(56))
+			_ = j0HejTVIA0AU8kGoqhD3ujGPiKNTT7ez9mA10vbjezgwcCFOTI67WopqH3JWjPjB2vgnp2hnwoD5UKmEmEb58OvRP6ikVoNuYBR
+			var b1vQTWqj8hvGIg4NNe9K46MWC2R7O8YKVMKFGWCX1GWgGfBD26aGpxmuUU6RJrUHHvVfcOfUHZi2IJvTBmJsQpjgUldOHnhaRTfPp80VP0gNHb9gaX2n5 tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(57))
+			_ = b1vQTWqj8hvGIg4NNe9K46MWC2R7O8YKVMKFGWCX1GWgGfBD26aGpxmuUU6RJrUHHvVfcOfUHZi2IJvTBmJsQpjgUldOHnhaRTfPp80VP0gNHb9gaX2n5
+			var eojcqQlbou3xtqcgn67uUd0vFebHtwD53Ko508UycnBiq5lbw6Fg4jcvv37F5uEi7KoxubM4irzxKq tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(58))
+			_ = eojcqQlbou3xtqcgn67uUd0vFebHtwD53Ko508UycnBiq5lbw6Fg4jcvv37F5uEi7KoxubM4irzxKq
+			var zH tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(59))
+			_ = zH
+			var yXTIbTDjWUn6gCVk2lQGSZr3TCdqbtiHw4edqxs6DLzkJMdFyeYs1jl7Ls6yXVKXlKM7bbGw1v7022fiJIzpF6Rf tla.TLAValue = args3[5].ApplyFunction(tla.MakeTLANumber(60))
+			_ = yXTIbTDjWUn6gCVk2lQGSZr3TCdqbtiHw4edqxs6DLzkJMdFyeYs1jl7Ls6yXVKXlKM7bbGw1v7022fiJIzpF6Rf
+			return dVpTt5jrKlWu0I6Hq6H80zTmUxwUAJYO4dKwf6DQfbTQWe9fGr8TtnynL58wFnRcfuVdaQJ6yRM
+		}), tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLANumber(68), tla.TLA_Len(tla.TLA_Zero), tla.TLA_Tail(tla.MakeTLATuple())}, func(args4 []tla.TLAValue) tla.TLAValue {
+			var nMDLcmFYG6N5X5qUG4Q4ELPxCZRP1ByOFD8cIbWHPjvMaPtf5Q7BBDw71oHBnalYi6KgmUCNtO0j7r3nAkq7Wn3ClMSz44nnJXpgNIZZydK tla.TLAValue = args4[0]
+			_ = nMDLcmFYG6N5X5qUG4Q4ELPxCZRP1ByOFD8cIbWHPjvMaPtf5Q7BBDw71oHBnalYi6KgmUCNtO0j7r3nAkq7Wn3ClMSz44nnJXpgNIZZydK
+			var vvmBdcUQcmzi3aF14n2S3Oie2iBRkC6Pq5dh91BNivvWOU8pQ8lX0buK5SxjDEHFi tla.TLAValue = args4[1]
+			_ = vvmBdcUQcmzi3aF14n2S3Oie2iBRkC6Pq5dh91BNivvWOU8pQ8lX0buK5SxjDEHFi
+			var zWSbRBDcKvPAzjLcOPdpDMdxHB07sO7kEq9Sk9MydQzf7b5IguRfwHTQeQwDS8uwsPPE77kph4VdYlWi6GlfISwJgzUF5mDwt tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(1))
+			_ = zWSbRBDcKvPAzjLcOPdpDMdxHB07sO7kEq9Sk9MydQzf7b5IguRfwHTQeQwDS8uwsPPE77kph4VdYlWi6GlfISwJgzUF5mDwt
+			var tM4biPtMAZBAnwcHTRZchOiUQbHTr5KKiEMSYGuaTRcR2D7H7gRNHU1jtt7MufPg0LIhai5q4fS4 tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(2))
+			_ = tM4biPtMAZBAnwcHTRZchOiUQbHTr5KKiEMSYGuaTRcR2D7H7gRNHU1jtt7MufPg0LIhai5q4fS4
+			var wcjXTUfGXsyPRN2pceinloBdDEEHrHSn1maNU4p0sXLC7L8XZVx23CVShjmVQFhJGTdbd1S4Yez3THebEY41dE9hSjqL tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(3))
+			_ = wcjXTUfGXsyPRN2pceinloBdDEEHrHSn1maNU4p0sXLC7L8XZVx23CVShjmVQFhJGTdbd1S4Yez3THebEY41dE9hSjqL
+			var av02TZ tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(4))
+			_ = av02TZ
+			var wT99Pz2tTM7YcBTcUsZXIkd6iERmCmjIeM6olKGr1W2o3qbpooN80wnA37x2Yu03QomrJCPixVKWEQ8CCTkNXiBEu8hYnGcporfbymNh tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(5))
+			_ = wT99Pz2tTM7YcBTcUsZXIkd6iERmCmjIeM6olKGr1W2o3qbpooN80wnA37x2Yu03QomrJCPixVKWEQ8CCTkNXiBEu8hYnGcporfbymNh
+			var dmUGiLMfUfbLQetiOQLGDsJfywJllYN9HZWT0mRxzdB6Oi2PH5mLqQQkSgh7K tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(6))
+			_ = dmUGiLMfUfbLQetiOQLGDsJfywJllYN9HZWT0mRxzdB6Oi2PH5mLqQQkSgh7K
+			var xXGfSvXoSeT9bS tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(7))
+			_ = xXGfSvXoSeT9bS
+			var sWJBRBh7HLygHJkCRastp tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(8))
+			_ = sWJBRBh7HLygHJkCRastp
+			var jA5vUa3xHA tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(9))
+			_ = jA5vUa3xHA
+			var aq tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(10))
+			_ = aq
+			var f tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(11))
+			_ = f
+			var prStzVZ7XpWWbKe tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(12))
+			_ = prStzVZ7XpWWbKe
+			var eIRzlCgxVKnkew9eZcLbvOl9EuW5fcCfFhRQHoXFluPH6xZWIYMDKcLFXNsb9JrHulGXYSJnGaTC20T7ONoHzHyyrf6uS tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(13))
+			_ = eIRzlCgxVKnkew9eZcLbvOl9EuW5fcCfFhRQHoXFluPH6xZWIYMDKcLFXNsb9JrHulGXYSJnGaTC20T7ONoHzHyyrf6uS
+			var nVjmRIA1A0qvjMvMcWtEazsaHzES6Y6avOavkfPu9vivjs3nLb1xNhDASadHLLwDOpirr tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(14))
+			_ = nVjmRIA1A0qvjMvMcWtEazsaHzES6Y6avOavkfPu9vivjs3nLb1xNhDASadHLLwDOpirr
+			var i8VaAM97sRQIskPqOb8F9qgrC2xbfIFajVEpFV1swyX4HY4itr8dqiu7CtOm4mkLHGYpfcINUDEo tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(15))
+			_ = i8VaAM97sRQIskPqOb8F9qgrC2xbfIFajVEpFV1swyX4HY4itr8dqiu7CtOm4mkLHGYpfcINUDEo
+			var hRv4iQGc1JVFMKCTUQzsbwHCqzQQBxhBenCzVtigvQEu53lXN6VbVCZjnphAv5ivPE0eQkvDLvE9UG085C7FGFX3Az5rx0L5jw4WYA tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(16))
+			_ = hRv4iQGc1JVFMKCTUQzsbwHCqzQQBxhBenCzVtigvQEu53lXN6VbVCZjnphAv5ivPE0eQkvDLvE9UG085C7FGFX3Az5rx0L5jw4WYA
+			var twOadTdWqFwx7Njr0GZjFFKPMe tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(17))
+			_ = twOadTdWqFwx7Njr0GZjFFKPMe
+			var d2jxAQesE8O168 tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(18))
+			_ = d2jxAQesE8O168
+			var lkwXOmWqOIJ6Ql8OIq3bTcbIQskJSBVZDSFBlgPVo2bsCFMTna3KlDhloRIADgpYDkhsH1eVxsLeomYGENKO2tPvZ885GYMGU4ySwDAW671GD7XWkHH1z0 tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(19))
+			_ = lkwXOmWqOIJ6Ql8OIq3bTcbIQskJSBVZDSFBlgPVo2bsCFMTna3KlDhloRIADgpYDkhsH1eVxsLeomYGENKO2tPvZ885GYMGU4ySwDAW671GD7XWkHH1z0
+			var vtzcOt0qehTOCbja7hiqi9V4Hs2tIvgee tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(20))
+			_ = vtzcOt0qehTOCbja7hiqi9V4Hs2tIvgee
+			var sck5QnM1QBAJZiiQTl1cWPDvK6T924jcFs0W0rCUSPvBJpzWw6dKuw9GSx7qYhOMktuyxHMl7cQ2WOCzwdAfmapTHCN8ULAWdHYB3RhcDMwT tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(21))
+			_ = sck5QnM1QBAJZiiQTl1cWPDvK6T924jcFs0W0rCUSPvBJpzWw6dKuw9GSx7qYhOMktuyxHMl7cQ2WOCzwdAfmapTHCN8ULAWdHYB3RhcDMwT
+			var cBDTNj2CdkpUB6FQj6Eh4f0y25yUZZz6nyHTD0HKxV tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(22))
+			_ = cBDTNj2CdkpUB6FQj6Eh4f0y25yUZZz6nyHTD0HKxV
+			var abkEO30MYO2pVxZCTm tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(23))
+			_ = abkEO30MYO2pVxZCTm
+			var hXmoGfFLEVzH440IsaAa tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(24))
+			_ = hXmoGfFLEVzH440IsaAa
+			var tLJy44X8hgEaFaijSMIP0cBZHKwCTwNFcfKVbc3KOspZa47YLjw7whIRfeRgcbF9VVbOt0B6eFiLfmErFSGKkc tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(25))
+			_ = tLJy44X8hgEaFaijSMIP0cBZHKwCTwNFcfKVbc3KOspZa47YLjw7whIRfeRgcbF9VVbOt0B6eFiLfmErFSGKkc
+			var ffsgS88PJbkGRGRPyvshSsbCSuCvdKmCzWvj5jjtec70RMylBnbam3raHiWhiBRwHpznDps6UZ4ToyM tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(26))
+			_ = ffsgS88PJbkGRGRPyvshSsbCSuCvdKmCzWvj5jjtec70RMylBnbam3raHiWhiBRwHpznDps6UZ4ToyM
+			var kp5z5odj84ZCfq8YO8EddxCGN6Cdd9Fy4vFsTNHEfb4uzqFO0t1KFdX6jC2rPZrw7iWCXC0I7WopTbDMpDJ tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(27))
+			_ = kp5z5odj84ZCfq8YO8EddxCGN6Cdd9Fy4vFsTNHEfb4uzqFO0t1KFdX6jC2rPZrw7iWCXC0I7WopTbDMpDJ
+			var fW3AJLNnZhCFZobuhEplMFQJBJyFWuvxdVgPRqGF7XSSyhcElsQAe84TIlEJ0YsI99KvebNeRKfwyZYkghsxWRZMkbimKER tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(28))
+			_ = fW3AJLNnZhCFZobuhEplMFQJBJyFWuvxdVgPRqGF7XSSyhcElsQAe84TIlEJ0YsI99KvebNeRKfwyZYkghsxWRZMkbimKER
+			var safYYZEZdjiPMwpqGelf0JtCZ3gxbKWgp7gROzmTkMMI3S26yi7jtplo1700qqjKIn tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(29))
+			_ = safYYZEZdjiPMwpqGelf0JtCZ3gxbKWgp7gROzmTkMMI3S26yi7jtplo1700qqjKIn
+			var iPZqmoYuMPJZgulzB8meSpcZb4bnsiiOATvyn0pWKol2pHA29qCvVLVa0ANtPDr0Su0MMv403 tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(30))
+			_ = iPZqmoYuMPJZgulzB8meSpcZb4bnsiiOATvyn0pWKol2pHA29qCvVLVa0ANtPDr0Su0MMv403
+			var d5zv5RIsAzs2ahTNTGSgUqEWeRuUBQn8rnA4zCPPNJHaOr2qnst0RBA1EVhNnqmAhBpVn0TgFfAMGzRwQYM5McLHK54fGQKKXHrlV0NYbeFClB2r7hj tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(31))
+			_ = d5zv5RIsAzs2ahTNTGSgUqEWeRuUBQn8rnA4zCPPNJHaOr2qnst0RBA1EVhNnqmAhBpVn0TgFfAMGzRwQYM5McLHK54fGQKKXHrlV0NYbeFClB2r7hj
+			var idl0BkyjmqQR2ogEUbauQslCMUFgmpkeB9fK7BGsFYgj88jn9Yk8fFmyiigqq8XYPsvLIfmyfKINd2fEt7qli5qKMzymBZ47bMn tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(32))
+			_ = idl0BkyjmqQR2ogEUbauQslCMUFgmpkeB9fK7BGsFYgj88jn9Yk8fFmyiigqq8XYPsvLIfmyfKINd2fEt7qli5qKMzymBZ47bMn
+			var zwHA6J50eAqpOn4Fbddd4o1jJh0epr1YLcR0CiYxo6SdhySdmqPWuPvwLlJP3hRDl84EWzZs6J tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(33))
+			_ = zwHA6J50eAqpOn4Fbddd4o1jJh0epr1YLcR0CiYxo6SdhySdmqPWuPvwLlJP3hRDl84EWzZs6J
+			var v7vKCElgaxOtASA7f2MQ6nwPhPF3U319cVK1ptI4xk7zi5pqyYHeZW860mWbRNQbIyjs1VioacJv01D3NGeFZg tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(34))
+			_ = v7vKCElgaxOtASA7f2MQ6nwPhPF3U319cVK1ptI4xk7zi5pqyYHeZW860mWbRNQbIyjs1VioacJv01D3NGeFZg
+			var fRwBpdsAaVfacRTkB9lOL8vQnYPZf8xbyuI66WTf1UzHB9uL5NNEyFCgHcUu7q7bdxShZV454X5Wr7EILJU2RDqiNsMdHpN tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(35))
+			_ = fRwBpdsAaVfacRTkB9lOL8vQnYPZf8xbyuI66WTf1UzHB9uL5NNEyFCgHcUu7q7bdxShZV454X5Wr7EILJU2RDqiNsMdHpN
+			var asB9JRbcFvI1JMj7JMqkM0cZFcKmLjCaPsMz8pCrMyhWHev65Gp0dkWdxf87hDN7rfYo9xvLhcvmC6RxVdfFCI1GPDewEJ02VqOnnGCvKUBVJImZY tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(36))
+			_ = asB9JRbcFvI1JMj7JMqkM0cZFcKmLjCaPsMz8pCrMyhWHev65Gp0dkWdxf87hDN7rfYo9xvLhcvmC6RxVdfFCI1GPDewEJ02VqOnnGCvKUBVJImZY
+			var k4uPFdT09BGyWRWYEu7pX tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(37))
+			_ = k4uPFdT09BGyWRWYEu7pX
+			var h5pW5RegOA0yWovd7W4YjksfYlGpW4I0U4RyJpQ7U698zO5JaejMotDyOkAHYxSKdDylSDT2vX3v4TQ8vmi3WztCuxArTodhQIvcL tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(38))
+			_ = h5pW5RegOA0yWovd7W4YjksfYlGpW4I0U4RyJpQ7U698zO5JaejMotDyOkAHYxSKdDylSDT2vX3v4TQ8vmi3WztCuxArTodhQIvcL
+			var up0Vdghc938Mr6hIH3j0VGUmvFxGw4sm9f2Dq tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(39))
+			_ = up0Vdghc938Mr6hIH3j0VGUmvFxGw4sm9f2Dq
+			var kKrE0nlxzpu1lHtP7uXyLbghdH3rmMWbOEAY7Cqkn4xchUmVC tla.TLAValue = args4[2].ApplyFunction(tla.MakeTLANumber(40))
+			_ = kKrE0nlxzpu1lHtP7uXyLbghdH3rmMWbOEAY7Cqkn4xchUmVC
+			return tla.MakeTLASet(zWSbRBDcKvPAzjLcOPdpDMdxHB07sO7kEq9Sk9MydQzf7b5IguRfwHTQeQwDS8uwsPPE77kph4VdYlWi6GlfISwJgzUF5mDwt)
+		}), tla.TLA_Zero, tla.TLA_Len(tla.TLA_Zero).ApplyFunction(tla.MakeTLATuple(tla.TLA_Zero, tla.TLA_Head(tla.TLA_Zero), tla.TLA_Cardinality(tla.MakeTLATuple()))), tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLATuple()}, func(args5 []tla.TLAValue) tla.TLAValue {
+			var jFSnew60k9UkNMbOwRMQkAjaDOsCVlPPzNCyFhfU0fyWovez0zXZDVqaX3EHHxW8P9RjGDPX8z8k8OPG6zvn tla.TLAValue = args5[0]
+			_ = jFSnew60k9UkNMbOwRMQkAjaDOsCVlPPzNCyFhfU0fyWovez0zXZDVqaX3EHHxW8P9RjGDPX8z8k8OPG6zvn
+			return tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLASet()}, func(args6 []tla.TLAValue) tla.TLAValue {
+				var bJdvTIPKLRX2S7AzBaJhHn6qzYpgdcuCSHMXowo tla.TLAValue = args6[0]
+				_ = bJdvTIPKLRX2S7AzBaJhHn6qzYpgdcuCSHMXowo
+				return jFSnew60k9UkNMbOwRMQkAjaDOsCVlPPzNCyFhfU0fyWovez0zXZDVqaX3EHHxW8P9RjGDPX8z8k8OPG6zvn
+			})
+		}), func() tla.TLAValue {
+			switch {
+			case tla.MakeTLATuple(tla.MakeTLAString("|AP8[H3v] 0TXS4l;{MD>jLmGb~(,$M3&I+03G/|yp\\lCm\"gW{Y,|Jp`i$ICm_%\").WjP_Q/lA5")).AsBool():
+				return tla.TLA_Zero
+			case tla.MakeTLAString("~ l").AsBool():
+				return tla.MakeTLANumber(34)
+			case tla.TLA_Zero.ApplyFunction(tla.MakeTLAString("tpFXgtDC996txdhqxvc6uTZ7LGguVCIqPWhb4GPq0vrjsU6VJ36ivYQrBcr6eLu6EeizwwVG4duDRq6")).AsBool():
+				return tla.TLA_Cardinality(tla.TLA_Zero)
+			default:
+				panic("no cases matched for TLA+ case expression!")
+			}
+		}(), tla.MakeTLASet(tla.MakeTLANumber(103), tla.MakeTLATuple(tla.MakeTLASet()), tla.TLA_Tail(tla.MakeTLAString("ao>Q^45R*)&S.P;@h>(g&QFHhB2]CIa,O)R}&icu)|<rcbCRZ!s}W9F:`9s.Xl\\Z{A=%jK1Z%)\"Y@MrS]M*^\"i)oNf<X)$h06WH,E$)AV2)I=@e{4zwo"))), tla.MakeTLARecord([]tla.TLARecordField{
+			{tla.MakeTLAString("eSmDnVka2T5vfCr2D6NAeJaHIIDOlNw2EtW2rEOQXQPKS5kkyWOloH5TdOSGJ8Bz44giGFHo7gIWf8OEXbvNC4Tf8ygMzR3dqbcqv"), tla.MakeTLANumber(69)},
+			{tla.MakeTLAString("izEEDYZa31aMoAX0ZZSPtnzZiSJasTS5zXqdJAympTsARWZkLDJD"), tla.MakeTLANumber(113)},
+			{tla.MakeTLAString("dyEu1EeADkIvzDaPjLTdpJIcifTMU16PgoOR7843Gu7mpKqkARv0JE2u1zPr2eQ4vy0VjJul5uqeh7XlPAPV9enWRCf8t0B0xY0IzPaYhk71Y"), tla.MakeTLATuple()},
+			{tla.MakeTLAString("ihxh4hux8hT1KYXTuUzKlwPYkKAA"), tla.MakeTLASet()},
+			{tla.MakeTLAString("ztbbVgCSX1bb5RMzZV4BCFgYkbdQlRyqG57NRyYuAN37oz96UHjVkC7HEElI4leshq4qEfyyLaNoJgFjFiy1Ucxd5jJpteH1vEaWkHa6yFo0Zzk"), tla.TLA_Zero},
+			{tla.MakeTLAString("zjWP4mQbVQ2lc9Ah2qo2sENITxJ9Fk"), tla.MakeTLASet()},
+			{tla.MakeTLAString("v4uawbLQboCq5rPaF4O4P9LhHxvqZlwWboXHoHD4oeoKTh3DhpLroR9zLte1WE3VZl5QuYZf71I5yofGfhfrcAeWZq4MopRwyEv"), tla.MakeTLATuple()},
+		}), func() tla.TLAValue {
+			dMBYoW4IrtFxgIWrYPBUK7U82G2P3eAqe8GTlNeOFFlAJ6oEJT := func(nxVAq5SHVzmvyyLtL6KTp81XDMb1M5gm1tUZ5X3tO6tVeixPGbirwW0b3WSixN9B00CFRj5 tla.TLAValue, a6O9WgcDePw3so7SKk8CrZcBbOAQw21QJa3wAo83WKEVOM0q1MxaUg1 tla.TLAValue, iYzkTIpshRIClbgtt7PCsvhU1p9zHHuWkDkQVQpZYHwqetUCJHB9hzSspxoSd4tazL2qGLqiGljQPkkk1abCepxIxQJMsHHegjnI3ORMkzNW tla.TLAValue, eibKgFT2bFMHNqSkBKHeq6rDs6QWjrBuaUppNNFWfxfGm6ybgx1y6SRQgPWrTaWtoh8IWCJ7CpHI3sl tla.TLAValue, ybPgPsNbI2tuE2K1iA1LrW4eXPRY05LyWpBCpRHC8q8iS62OXS5add7In tla.TLAValue, uqDvk2oF1CmjHdAze6afrN9c6Y0V9mZmsAdaeSgayd15MXEfOsru6LNlEsfLBwtgaQfH3CL18EoRux3NHDZ tla.TLAValue, vdIscEuUCezJghNA3FcZvKPOuwXLU77vygCZ0btzt4EZt90EMW9p0Alq3rISBmthV7NJiWDtasETDIaFyY4fTyJcrbJZ4nnLLL6hlo1r546GIlFHKhA4mf tla.TLAValue, yVo6R1IjAptaDLwUA1gBn7YfjaF7ZaAf96NxQxdB0z tla.TLAValue, pnoaUybvqpbFApkcV39Z6t35wHInxdO1geVF30nzdltg4tzSpvt8eq4z3MMOfMBaiyhRg5ybcnhTyA9zEopw3yI4Z5EjlABh9vADzC8 tla.TLAValue, eTfg0HxBkXQFsmHMM7tzNzFG tla.TLAValue, sIwkrxLi5Ntu7Cp9N0qYRzetOgu5Q3WJkgiou6rcWtw1FCa0K204YXW7c2cc5QJ8eQlYmRQEB9ZX0D30PR6hrduOxltushIle85WUU7bhAWVR1 tla.TLAValue, cIAnUzlzJJKbDePXcOGU5EHKL9nSXf1 tla.TLAValue, cOK tla.TLAValue, sf3IfXkK2Ol7Y64A7mgUGWM8S3ccH2 tla.TLAValue, jJb4v29omLKKv5It5RN2x2IFq8HV6Z9HseNGKEWmGMBIkmnDEwUI7QvAxfRH tla.TLAValue, rsLln4MGBLeC81BgBMGSXX tla.TLAValue, rZCJJMsv1jAxmyQjMLhsdmH8WMPJ3uctBaF0WdMTLBDSW3HlmBe1n1q9jZVLtpkSRwAnc42l8lLGMKZLQKRY5QAdfUsrDlVIM4JAPdiPsAHxXggP21tB tla.TLAValue, nUPa2waBp3rkfbbmB0YennfJw33fwZ6nPJ3cdH26J12SZZcOYyVvYjbZi5XORCxTdbP9yCAqwA07HjhaHboBNu tla.TLAValue, aOXhTn tla.TLAValue, iJAYyXf8zHNTmbKp3UW5K4B0ALrIWjnZwPYa5eQUPDWP9X0EN1xRAeEW1QltgZANH9Y8cl93VWXWPx1B1CeGtJ0Zc0ra3XJcB4a5dGaEEHoVCB9Bsbp tla.TLAValue, vZAUwcaw94oKZdAS6NhOEpQjadeImLnBdLABlHcxct1G36MLDBMPzbh tla.TLAValue, zDJhnifz1 tla.TLAValue, pTnQLAuZpwUaqY55cJuvHq1t330FccrKxkEcnudLOjKgqVjJXw9oj tla.TLAValue, hRZGwOfVOqCN3NjIwRUIKyhwawc4xTc9ckcVLWqL tla.TLAValue, itF2FYh99DkOnXp5ZOxbXJhYWRf6Kt5TcbKiMnt8KtN0xPcFwqVhWorFOK1ZkGuUpVvLDl8CtcCzOCJ2ScITCo8tB13EOI8dzwR tla.TLAValue, t2u9 tla.TLAValue, dsXbyTPuEzKcBmNO3vUoDy8byFgyYwP6vmR8ScQVJvNNE7etXukR97tdfJm tla.TLAValue, xIqUs2QwtR7b7gzlFAiA0nPagsRcXRIrzd4XwPuSc4iY4gcFOBkk6HhYPbXpD82i6wZA0qPxSDujwkgD5UgOb79C8n327qYeZB3B tla.TLAValue, jrQMqgDsjm6qLHAjB5pC7RfvvjGzAKPtxxyypA tla.TLAValue, sjl7d9GZhoCh5nEdbcXF44yFcu9W2BJsCvdIUruGMwUpUfXl6IhhRcqUlNPlX4ZSMmhnM9puS tla.TLAValue, iFSImI2CjxTF tla.TLAValue, kDb0r3tV81qr6H4SmFFabkD8DHWDz912PeZxQ7YRh9FjBCwY6eXETGHk1Flvk8zD5JpOPfpDTIezK8Q7uUrfbbKuW4GDDiAI24DnKiJeX6hYL tla.TLAValue, dBtIva3EV4kBUnhWYYKtDcY68OOM3 tla.TLAValue, gU6JaM23335s9fdd71Iu23H6letgfaEm7RMjPRIJV0EjNOrROJCV6juQ1ZutwHbIHmuiU9JKTbyNlwGrxSgvwbIcctrzzvyj6MagRIpvB4Ue tla.TLAValue, pf1ouwjozkq46dVUev9IMmjvOCNeQSlMXvY tla.TLAValue, lEwUC4BNAyX tla.TLAValue, mu2pHEkSxMpJG4IFjyzfSg0FLj42MK5bnIGesapT8TI2cKhWicQcedPAjtR tla.TLAValue, c10 tla.TLAValue, d4J4XlhEfuUcKHbFjd0I2EiqomIciQBQ1oCZtXOsXYMexyBzM tla.TLAValue, mb6VcvGiNqAddoL1U27vHSbT15kiRVI0Ccg8bjGYk4N tla.TLAValue, v32tgD43e2RMYs3l17XRA0oqcqjgppgbGCXy6kpz2DX9B4SZJWFDSafw28keLFVVr8gz85aSukay tla.TLAValue, od8zAG tla.TLAValue, agI3fF6yeEgwn4mHyIS6BzL8ZmZYxIycJlZbOrdWs0PuDp6EIp3OEJ4AZeMwbAaWG8C478nr96ufy32O5B9ya3RNgsiRol8 tla.TLAValue, u5OX7jli7pUvdFlQ5GIWp8Y2V4w1Qq6JuSZNXFX58tCXaGAXNw5yAnpgjN7YI9OxgsMaqOal0gviOTsLnltWWVtP8 tla.TLAValue, mzoT46CbhESG2XuO97YqjAIOTKzWRFV13URZM9bhApen3u tla.TLAValue, euHzTurEOVp5ibMG93Z5n5QIz6Vvd3yxatMiXyCUL1TpOljdnVUascnrfOSIM1d1kkwCN7PsN2She47ixp tla.TLAValue, cy1SKKvhNyXaU0a7ucbPfvQvwiWIfyoRR9dniXhyujpGiHWwnxpzVNviq2UbpGMSS6qtMtcfp2Ydfred22sowNl9R tla.TLAValue, b7awbjj0YYXhTT9cfZAlagT4rkOR4UTbkJ9XcvpT tla.TLAValue, wFylCz1pj9BXgQIj0iRfz9hzLaZdnzFFwvDUjuuRJZt0dPDMtEUWaLUWP2S0cIPjJLSEm4JW5esqfCFrhOSnlVK tla.TLAValue, woGgx9rhmhU3Fv5V7YfItdR6Ya4Nn61TZXuHgxRKhWraD82DjSckWU9eh8uVG tla.TLAValue, van0P1WdGWs0Y4xmIgEo62SjbzMz3KqKj1gaz1Oz2DuZk4Vh0WfhQxUV8lFTdzweshJusd3Wq0pdzmopk5HZtj tla.TLAValue, fJYgdSPVe8ZGrsDsj5URkQ4Po tla.TLAValue, dN6swQflYr2n tla.TLAValue, q2ca764vfjW3dsAunTwWLjNJMOzvDa15EUsScuh0pgXRFYGB7n5KHqLPRYU6Uv5zW2kM9xyB8Uc7tT7Dz5QlGBGW4wE6UkiSZ6DUFQ0KkXgv76x tla.TLAValue, bphFSsnrvrUTp20JF0EW16OkghBZ4yipad2qK tla.TLAValue, cSC2XESOcKEYMXaT6Sz2BvHjMCxzJELA1Q9abTKT4LjQSclaxwgn9ZbdD8i3HYBCkwAmLbIcuzbmYEzJT tla.TLAValue, fiW9J4QVDbOOCcDiC tla.TLAValue, cV3KndmxEb2jiUtpTbOCzpd tla.TLAValue, yCLJWfpVGdOnNkUQOtJNxI tla.TLAValue) tla.TLAValue {
+				return cy1SKKvhNyXaU0a7ucbPfvQvwiWIfyoRR9dniXhyujpGiHWwnxpzVNviq2UbpGMSS6qtMtcfp2Ydfred22sowNl9R
+			}
+			_ = dMBYoW4IrtFxgIWrYPBUK7U82G2P3eAqe8GTlNeOFFlAJ6oEJT
+			pfutib02oMBk9NA := func(yqdAEKdWrmQatJbiBbNJGV4dVZvwou9CawoI5 tla.TLAValue, yPLRs5NZFyGM19iZaybEfKGN67EhwEQyCPbjWQZILFN65PjILdMxRcd0OltdhEVSjutjg8uBghJUNRlO0yI1Bb5Z9DPoT1d tla.TLAValue, npOQdK86ZvrzGID2IYr9pY0illf8rz4MjpchsjYnmGgRoVtWEnCFA0iMo4T3eHtNvMgUd1aAAinQIInp3ZISWlizOHEv tla.TLAValue, f0TtYSKuVdTGPYsQOEMteXQvyJhifPDPjTBlQj46U5H2jUt9wvVR1dp3aRTPqwG8CA0zLuaDxMtKjiD2dV800Geyu2gVkNBzZrmTgYXHPX1kqM tla.TLAValue, soLhGyWKqbO7txR4jRH4eAHZyygs9FY2pZ8wGb1MGhe7TrfMxiGbZvexCSQGBkx5HrrpzJYxPfJOnx1qCXj4FP9tYNu tla.TLAValue, yrgcu41SkT7K tla.TLAValue, vZ1UpiwtSAw tla.TLAValue, jQjIvW31GnROQeexcXJQ80yp6ZNlyjfCAXkIVdoUFz5m6hQTJ2YDopUO4ExrulFicvg1qiTTZ09zztaAatIuKEwoZx1Rhl2SAZXgbmbNFDQwc tla.TLAValue, aTnI591CPvPFMtGM0DSFosqwH19JgWkw6TOAnlLrnzVTvA6GXl6Z7YZslowQ4ggbwiOdmsrbQoA7y26EGj5UThwFCuYClOa1Qk9YBSUgCj tla.TLAValue, petq1FnADEuTu9jd7L0cRMEQEGA tla.TLAValue, sCsvwtZfuozhXImI2Qe5nOtioKFhV0toIhWugF1FTkDnOM628v6BcsqgbNgPgwTms tla.TLAValue, om8AZLqChj5QWTzzuE3Klnu54DYow7CaOgsksEWJbx6rIIn5QIYNfyv0Cg0yqI tla.TLAValue, sKddX6S4cSfFmEkAfQQ4pnGOsrWyTVp6ohov2ZjNh0m8ddQ3kkuT3V73L9J8pwjLbBaOTclW1sqbCaLOD94UqlQwqfqB1ybAPNidBHJLVtCXV7RtZunR tla.TLAValue, dTB55b5g2ndHTltMFu8sA6wQO3olGlC763PxW62YsF7SGSfQPrGZtsPsR3d8p9W0rfg0rNre7Vrz tla.TLAValue, k tla.TLAValue, pspwuKWUAQfUbXxngJ3Pp7oqrvCJGZdA6ZpbX95Gt4R31sDeMmOmkzXDF5Om6aeyBaIIU8Sd0nAgUQnf3970QQNJcAwx tla.TLAValue, zROWKKR57TTf4dEuXEAJiX6SQ9ErYYVNfKBpRYozrqAUueR tla.TLAValue, dlqWvq30vbvbYfBQdWCStgeHLJOk5YktXyAFKEZNlH3zJkk1XXnxG0RgieZPspBK3qDYIkYlGRNetdU tla.TLAValue, vEUiw8Q3wDBUhfkwu85oGaomRgGEeA8L66dibMQUAP1vLgR tla.TLAValue, bex3Qs4BmJHBRbUmBOpCNiyEbmD2Odz4XFWO7dHoJpLtrpyX5y06A9aj1if4pGtp3ypR tla.TLAValue, ov8Dx4HxQI4OGfCgF64Za0TLvm7uUySHtxTY74GOmmpGXQekidK tla.TLAValue, akX5jEznhGZAVgX6PHCPhJGk2nhgVTgZifC2RIOSbGepu0x0F8ObstfajuRj6wqK67xm67txh1iRpy4GI8pDU5heY7yhBxAofExnVVQjD tla.TLAValue, wXqzibnzdeZGlKOUZ0nB1vk2EtFdhBkkQmVxIu7m2eCZGV3UVICCsV8lvEDr9APBsdu4mfEw9VDdR tla.TLAValue) tla.TLAValue {
+				return dTB55b5g2ndHTltMFu8sA6wQO3olGlC763PxW62YsF7SGSfQPrGZtsPsR3d8p9W0rfg0rNre7Vrz
+			}
+			_ = pfutib02oMBk9NA
+			neUJpNvUgPe72LyeUCuIqvKpwvWW1RsckR7JbhK36dduupC2UXn9Dp4xa5ss4jbuOM6aN0ioEhvOnCNNczcf2892nd1lXr6zxe3kQaCDGgnLfiCb1A54f := func(dD5ZfhEn9lMy6hIQ63MgxLX9SLe9RPt tla.TLAValue, rpJZqPgaJka0TP8CucacnCDjYjWCP0rv2zonLwMNfdKtHZISwCaY1o6x tla.TLAValue, tV9nuf6o6CC9hCTmATorYSzU1AbJCuMlAfHT1TtwQkoQba tla.TLAValue, eMUJ35P0rwLqvjo3peYH3jph3OaRaojx4omEFt tla.TLAValue, p1TaO7BYWoE1lwn tla.TLAValue, lNU4xQWfgivqKFBroYAcgsUDRhut81WjH4ZXtuvBmD7xEQiX tla.TLAValue, b9y9qBsXFMo9tcgGgK52Max3oVCkIXf1Wi tla.TLAValue, xzlnQAIh2CxHeGGccHITKBdh9YGLfskDJYoruY6mniorWiSEdLYGe9kprlD7TvBvTN1wqC0dI1dV4oej07XJwNFUM tla.TLAValue, zQojcf8mAmjhlsYNQtRSHEQDGBXEJFemNFJi4MqP0vu1ODY5uUx8jNhHcgxSwGxJXELWxcsZ445R8l4U07gHrPYWMwy33rmKfLShWylo3iAqqDIps5y9Og tla.TLAValue, fulq67nBveb8AL3sI1xlgkvDS6UDy5HV91Y4t0DytPjc3QbppAtizrmflLJk6CN2l144OGC4k9l6A1zbqTVuKSJKYtoyCIFfmw4be6E tla.TLAValue, cFt8tyq89IFSpGebi2NJEKCmJyLKWNgjsy5rnlASUvkODy9ydSmXjxfXdJdPTJHqii0FccvBuNizbJpW0bdoOKr7QaRCHXxCu2yBsm1EZncCMroRI7d tla.TLAValue, nk tla.TLAValue, b0 tla.TLAValue, lgE0x0q5EJR5ZrQ tla.TLAValue, hk4QcmCfEaV7ynEE65eh8PBEFod3VdG3uc5edimMzdMTf1AAVYSv6cCLWTWqEfEzhixBsZTS0xAlcB3OsLEdMnbLDd2bd2agL4zuItrKeGYj tla.TLAValue, vWQ6gw57aLsFDgPWbcKGHbg83 tla.TLAValue, srpy4ICi5ffG54wava8hMsJmTP3kNX9KFGjYCO1hGWXP2HNRVqmR78ngBhn6i47E4v5Xe7rxhLcgj3S1sMCK7uktoCJNJX tla.TLAValue, eiaL4Y4ITF6mZcyoGdIJRgv5brQpLVigkwsbF7ZqIcX0xjHGN1B32KyslDg8FF12SNdc6P64W tla.TLAValue, yAKiq3OZKALyVMM7u8q172vb3R3cpnLSpOkdyyHW2rAQxwT8BQltZSEzjw4CaqNFQYXbgFkJeWyvdMYyHNNEXwH34ARqUD2GWkOzVDfr4eS6vL tla.TLAValue, g243CGvyoC3q8ne0IiEs8GZ2kTu6qiksuXKAjiP8aDhLWVWXFyJ tla.TLAValue, uviiueAso6qFDAKcAO8wS685lBm2vlFpKlQ7kWrSBdmjXsFN4UuYNkpHWdGuGyMTOpd8 tla.TLAValue, qAhiscJ9mgAzK44ja tla.TLAValue, hG5VnplX93p3NIizc4VLAAFMoQOtyDhjrPfYgjJ tla.TLAValue, gBMWmxaRYzda9R3DadTlBxtDqtc2oly8G8tmXDQ8feYccWakExMKXFx22vnSDX5zxIsNmIX1OzK1sR1kjiVRDA7zycVR9l3p4 tla.TLAValue, ldiKoihUaHjqHQEKVnAnq5q2C0Rd4U2sABLtL48KvTGG6NOISIfbxVputvRiRkHLnaNQHnH5pBiQ2Mr tla.TLAValue, vmc7p9sqDSLNNroyrQlIP tla.TLAValue, diFkx6gTeKJkXFhVWtZ0zWYA6CTm0iXSsoaOcOcg8UjK0Hw0RbkwufGYq9EhYig4iAvHQtRqRiCo7IgPFVOoFlxuHydgkAV tla.TLAValue, xsrJ0hs1Ke tla.TLAValue, aP9UfZ2zZEj9UUSK4oxi1LmbJ6PXE8gvU6q3GS04q5VelSnO4TAVpH3Dka8Vj9224XWyXX9dhJyJKVcAQF1 tla.TLAValue, mRAkfC3RBXzPnSa8ZujLPQPTW37kfUKnemy7IZuYpAKByr0gKio9SM8CNRaIvZOJ63ohlyBO6Dj3whzWEDV1DNoHksJwK4lUq1PwWPQEkSkH tla.TLAValue, v29hQOP4auFwWKQtcJmnxZRzGJwyhHnUS0a4vZ30gR1lPUeG0ZH1t0wfNAYNVN41y2qV5aSCQvY8vFi7S3TEsTCklGLhTGWNqHt0O4cYQYOX tla.TLAValue, p83nDSVkXu1cQDig5M5QVP4unsNheBIMSGNu tla.TLAValue, sAeZUPte26396OpJlBpmI0LQE21lOtx5s3t8nCC0YvHHB0CNqCxi0lJiwoEVaN tla.TLAValue, g4aq2R tla.TLAValue, ns25X4CXQFuglOEPP tla.TLAValue, zO6zdF15imNgx8fxuwZNG3p22LNMQWWjBfGMcDPKLQb9tCCs1DKDDbNjqAgipqmZJwqPve0NlsmvCQepmhtZroKqO tla.TLAValue, ccrlQKb9dTYwhvJmvl4uWJH2I70XwyocP1l3tkGTHX0Y28gm4UZgj7xJGFYs3XMD9AxWEUV66SVsHK tla.TLAValue, yrortUPsQPz3 tla.TLAValue, vrgY2fCsfzc0y tla.TLAValue, atADMjtPdHVP tla.TLAValue, opNmvwtrSNJ4RuT6uRHRSinguWgbzqkMsqQ5PW4k61HUy8toxpm3yo7OmM6FD5gRVIEUwGvz9wgCqgLy6ZPMmVyRP7XRUNP4D9CIFRtHe tla.TLAValue, gRV96fKPGEWe6yKFHxpuaPq tla.TLAValue, xDJiZCAbwMbVfbl7WcbMeruamFMGo6B2HMhB8N3KRrvic7qc49iGJb0bt5jCL062D1DLivTmY5TbCyP9Wb29pn7VOb8xdbKjXTY tla.TLAValue, dXXEkdWvl2 tla.TLAValue, gNOgqr8aJX4zP9l0UaPS6VagarrGqLU6i4JYxxjrzHs7mYmgJF2UuNcsLXJywdPURIQ2xCQnH9wy6Y9VSq1gC0J50pAn04v tla.TLAValue, pchYX3ZcpKwp tla.TLAValue, gHzB tla.TLAValue, wOFfsItrf6nLp7zy7bU7ADoa02aW3yFektlj8rpn8a4CRo9BFMXI5nnjixsR2S1 tla.TLAValue, qmpBaDd0wwAwM26ojdOhI7b2Ue5SUX7IuWhpDaEyxk4vAZTjdUv7jAipAEZd41SjSjvlL5TUt9DlST6ASlDN3ObplY2rp0F3 tla.TLAValue, vPA9 tla.TLAValue, dXNBv1xjF8ZdpX9C5ZY59h tla.TLAValue, hXQEKzRGitGm2rkfhPfIdX7NNi8fC9eUKHbhKQc0bCYivC0xaHYo4yW9Z2yoPdwib39HnBYNhBDRJTDRTaCCmHUEDffrdt0hDlxNOVQ tla.TLAValue, m0XmdKuUpvl1bxpzt3eNaLIlYDGcVkOcEU tla.TLAValue, zgiCcx0Ii2UjTDawJHXK8ztW9TxnEs4BqGMIL0KGRQaZG25eHlZnVVD tla.TLAValue, oGOiwYg4Ni0FnbwxphNIY tla.TLAValue, cwECL7xY8iScG2ySRm0ti8l2SM98hhZH6aJ3n0PQlTb6CrOU12M tla.TLAValue, lx8mkvXnvNTddobS7yISY81hUkWXF2snOu4LxOpUVQdxNdAZWzaTZidvQ4UBQRrrRjcg2 tla.TLAValue, ptUB2ALkx6BCK tla.TLAValue, cUmKmyddS6PXGo2GUNkzZTN7T8aUYA0TyjV6gg8oQ8km8N tla.TLAValue, rvcG9Wifo9QGqWkQA5WvYZTzYufmDdQBlhPBoANWGrgHdzdN2lO0phAeCeu8SZdsOzaR968R37lkJpZsbmUgtb4i7FPQtIFgLwfKlSeWx358mVm tla.TLAValue, rwovOOJgVwMF19p tla.TLAValue, a7S0yryJFshXqvdklI0kKojQNDdCjzZ8njL6OTEEJoDUELF3hXybX2H2j34fqApjlTLas7RndLmWeG6qHlGhOyzCgtsiZ0Ac47CQHla tla.TLAValue, zMYCpG8uLegaVSIotRhQZx9PUKAk9VScJbUaj3SIoQmgSdtj9V2ZCbZwo1VcBwjscjYOE tla.TLAValue, cTVZ9beGFz5WuA0xss0ZcVMok7s3r15KQjkTmwkdfiHoYBzsQm tla.TLAValue, vKR tla.TLAValue, hvGdY7ny33CZqb970HPMtRgqxyb38sWpNP3mqaFM3CQIF0MzGSbmSEHFblwNDXjlMjbmd6oojyTEkWwbG8ev426SvUvPZ9A tla.TLAValue, m3pIRkjqdB9DhTQmsqsPA9jjo9dtLe5mvTUlTIQQYTPzRraJUf3LhrMwlEr4tDz2kKvxYyzWUPJ8EjiiHKNKCXEwEZoxVeJf772cnNx3WWuCx tla.TLAValue, r51OBn836H4CGwaJbpKGSPoz2Po91zoZIvhLGK3o9k9cC7nDxL65U0g1z1ZH6SRsJ tla.TLAValue, sidyALRX9OvYQ64hbL3cEsPe0uJU54P tla.TLAValue, ga tla.TLAValue, pb7Hf9j3EU0fbPaQCJQBMELlAYwXQxoYkOWXxaA9r4NGEO6SWvJyMkzPaibJ7IdF2SoiamwTX23EVkG7LbBVAM4VhhrTULwtCWWSSLFVgKl tla.TLAValue, rdW5Fk2Xx9e69ZaE5r2a5LVVkO28ktahTi37iiNWVfM7OmTwDZXnoapZ2zZ23rbUFfBgi5Ci858X10XQUAsdKLQS9Rhb0D46jH tla.TLAValue, u0umaKghoGC1G6mnpX9aEDFLa9OyEPh1KfTJ2XtKcmX2gKfcN1yMKgAiPgrX5Y97NLxnkI50K7fh4yh tla.TLAValue, tTmkofWz52M2axQpt6G4OMLgBcYOQdI3KBdWdFrGq4Yda58ua tla.TLAValue, uphJqGX9ZtsAfGdpAd4mdG0SrI6BNfG39y4KCNb1pJugfMZaOEzfodJY tla.TLAValue, alki5J0u0ZkgrMwbrPRnhcNyFwEkMNzj1pZdybyIUA9DwZtyEtDMem5uM7NxfYZroUPGAxgjDAditFdK8Kcw6TBPwZ3dQQmsU tla.TLAValue, naXIPTVHPd9 tla.TLAValue, uyqvkdRlrbdXkbb1ABUeKJrZpArqCmDs3O3oPlX tla.TLAValue, jj8GIOzvZwvVFjltDA5TlBEtc0thYFrEsT6AYhuTR1DCfRjSYsVExVFXH3kludlk tla.TLAValue, ieZaTIVxTgxp4XntAsQOYQiSvSiSzvhtep7YT7aG3eKqH3MfzAo4WcjBx65PerpVd tla.TLAValue, whwF81Q8dhsvJdCQotqTLGbBiGuprfUBKRivefPWwIc1Xv8qA8HKIjqNcwarJ2ywKAXiPeFuF1fGcby1Ur tla.TLAValue, k0 tla.TLAValue, moKtttvRNzgiambEv0ElsCn6SOnGFRtwdcwAG29VbIQADaZAhM037grEV80IiS9NXggAW1g6VMQ0omx9XrAvJ7PcqBFkKi188lEes6kJq3cx1rdArLgM tla.TLAValue, jM0j1vnW9Ph4mrAgkNml08uYIFLEOMyaTss5EIhHKPvtaTTtrkI tla.TLAValue, gSSsoo0WfLIj4c4pSfB7SH7FnRPf5BbXG3Mc6P6keRNHhbSQgnV9k7fqiumr11djFHVEAudmOEZ2l8D8hwZVsgHpXKH8CmUp09rXlTnZegeJ2yJiGg tla.TLAValue, cEgq0WGJ4n9DXPcG1DQITQYPNFnmefNHJ0gdAnOehKZ2p6GyG6dQg tla.TLAValue, xGBke tla.TLAValue, fFZ3jGvpoJ6qs0TpDOjWxZ6mt4OEwL85kU5gEJqg5PFLEcgUZ tla.TLAValue, bwhE3 tla.TLAValue, vNawQMPnoqjbFKmoVzXFFj4MwASHGDlEsKnciZSThPfc6iBHAuT6Q2YrKatxfj tla.TLAValue, lJ3A33n8Mq8N1x8r2aN10aY5KsOvYQ3zHasBY7b8E7MeEBZdKNOrAKHFoEXfv8VsRcmUOjUq tla.TLAValue, g33CYBTGPqF92hNZUIGRp6UD5aECTMSEJd6FpHJQxOY7CtKloNalX6iyEznlLIfKye109jwqbAtkEYzgh4ocEtcY6NhlUUgdK7sVR8ywttsjkKYWnxXId tla.TLAValue, xgfnyJIytCnNTK tla.TLAValue, ocEx4eG5KiMIDuAKa3dejESCdVvgKPoXAmkyA5hbCRXMuX7rENyPIo tla.TLAValue, q6MTUPxEAeyXKMifVbWAq5yiwxg0WcPqJPO92LkmCdDHggelkCkd9dNLT9aFBaZ3YONXBk9EWDNlzIlMGTojmRcGiV6RYIwJ6NAGeVNFTgs4WBf3Wl tla.TLAValue, eYaJ0EQqIPYRLHBvqxhVnw5MCrO2yFLWx51zWw5Of9y tla.TLAValue, qC0lAOIYQE3zbfIiluDtQbX9kAzSxirqj9YNSH8C1JHkAErAIy1H4XSevvZAiDD8PELJG7VHi tla.TLAValue, eP5qEMgP2Xnk30GtLMzD5LEEp8xhqXaLKSqNIhCtj8mQINTnYfrdZIBiIiu tla.TLAValue, q2lslxSqzKtgsPRsGzKgsKKJtrcbcVRgCCy tla.TLAValue, pPVCCskU8t4Fbz6INc3sZRwlTvGTC6zWoY5tU2DcNbdF2Ici93tTq3JATIBcPCuHtvaBsdXUaJz tla.TLAValue, yUzmfhluvJPwrDp1VLRCY5ie1uvw9DGG7E0fYsp3r3cQOGc03HgWouEucwYBQ08HaXYsn tla.TLAValue, fqEDJDLNtlexAS4PhM6sKqSqS1YZOMhojD9DFqH8Us6zkaEBVKv3ohCj5AHpC54qu5Yzi0604JQRIY8 tla.TLAValue, iDA6ycv5MPxc3hgQ16SRcpMd8GrKYzB6WxeGF9k tla.TLAValue, y4DZIxYVUiiATh6NltzAHxY0VsH6M3MCkL3n3eon1lxXVFUqYDky0qGGaL1ksz5XBUXbD6d79dcxf2aVIOiZuQiC6Aru3KsXRLwaBH tla.TLAValue, qAPu tla.TLAValue, u0 tla.TLAValue, bOIsQ8d8GVM tla.TLAValue) tla.TLAValue {
+				return aP9UfZ2zZEj9UUSK4oxi1LmbJ6PXE8gvU6q3GS04q5VelSnO4TAVpH3Dka8Vj9224XWyXX9dhJyJKVcAQF1
+			}
+			_ = neUJpNvUgPe72LyeUCuIqvKpwvWW1RsckR7JbhK36dduupC2UXn9Dp4xa5ss4jbuOM6aN0ioEhvOnCNNczcf2892nd1lXr6zxe3kQaCDGgnLfiCb1A54f
+			ukBmRCtcxU4Z3Yksh0YjLv37MqG99dzPRYxTVv8OCeIwGKh9SwfPSVAXDxWm4T := func(nyb2Il2gW8dHLUWW4p0IPl7lRCwDCI tla.TLAValue, q71PuuOLcWFWM12bsnpvqgv4m7uQpM5SbNYxFzyUcheNfOrOdgOv7ZC8TSURQVM4CxsuHzya3uFEVJ65Rne6lO tla.TLAValue, jGueCxB tla.TLAValue, jDsrI935UaJyVhce tla.TLAValue, m8pHde1ec1gY tla.TLAValue, iotRSKGrRcs4opYC21UM9ERt4bwPjxjwD18a9TYB4EJsQtpFPuybb8xGLgWJDg5Z0eNeGMNC tla.TLAValue, rsBUK9zYElS2cQsqX2vNWgNm1qAloPP4RPtciMXDX45EhzTPMNp6RZhLlzFovaVdHdO0EViwTttInwqNRjPa1Q3CyeY0ZD5GVAGgHorv7n6AT6 tla.TLAValue, aCbVK4bc44RJSB12Mbba4KhlNUd2AoJSGb2vUKIp1S29aIRGhxh02jYga tla.TLAValue, dR0tnBY9KUovDwO19VKuTnmSIBpDGgC7u721Hv tla.TLAValue, x1lnZT49wU1tTxyMcVQYceJ5gmsIwMromJTw8YiWNjDZn1is8psWwz7nvSZrFz50dHVgwJXdAemzpgMZzAsmt tla.TLAValue, ehKs6sNuZhfo60rG2sg3R1QE5CVTcWG2N7K5WtJBizlRZLVtd tla.TLAValue, sR7JVAjAVdvlxD456IJ3NvuT0CmjliP tla.TLAValue, kdUl2CTj tla.TLAValue, y7TkhjqDzL6ubH tla.TLAValue, o6thVMlWBmtwYbrcrEiBB8WQfeKvMhyAeCv1P87Aya tla.TLAValue, u5J9mWoWXYLh5wmdR6YjKcUJtt9qCeQZDAl tla.TLAValue, jDJPvWrhjydEEYKuKlywSj3ULE10h2dDfhGpjTlGPj7ImhAEMVwXqE24JKmYSzZ7Mp2ftKU5KWoVhxRRGFDKETVR9k0Eavmp tla.TLAValue, natnlvtTyMOYXqw5u7fHGg0iELBvSL70dxn6PDm8j3SbDjWSkggBG4DFQvO0yCEXBBfAe3bzn0PIem1NIWvi1 tla.TLAValue, lRXB1iqx08OAZGcFwFyuApGbCzQM8qvAq4SC7nOST4awVvYYChJKet tla.TLAValue, zlm90l5Tu12iJlCg5vGwjGfieiFxrqp27XXNJsCaWlwnrl4wMWIrzdD4u8oJ6i5lXO9e tla.TLAValue) tla.TLAValue {
+				return lRXB1iqx08OAZGcFwFyuApGbCzQM8qvAq4SC7nOST4awVvYYChJKet
+			}
+			_ = ukBmRCtcxU4Z3Yksh0YjLv37MqG99dzPRYxTVv8OCeIwGKh9SwfPSVAXDxWm4T
+			bxqRuwNj67l1Tw0tG4FnJoCpbcwAITjSRFP1I7Gz9BYYEOtYCbQNVLKoEIx0CSFwIyMkChMguq0JB4zT4HXTZdyPn0hn6D4EmtYKzcNEuKBXWJ := func(uQKOCatlf16egTz9aQedQmOycWBubYLr0FK6oPRqXKV3dbBmO08OiqIS6fYMbg3da7N42C7TZsObO5CaU1CA9051loKrLv4lqizxYz7XN8s8 tla.TLAValue, aSBKtbpBn3rK2oORAyA6MoTROaa77w7IwzLtDcsgLax5OF1Og6b17CUWWGPW8Rg2wBSQDrrkqQqZbqgQbmnNB3icV1KjqtR6XXRZDe tla.TLAValue, cvWtM6M0aZ9GrzoKN4gllrlyIjtOUWUKBQiGXYT7DFyFzcRB0 tla.TLAValue, lHiIHLn7Dmi2N0yX06JfZlhPboAMDmDkX6nKXZSHwvuzdAwMzrRjjaaIGcNZoDr tla.TLAValue, q3GxRQPGNOiCkdTjH3XOLCIEHSCR4qK593q75ytYrCnibjD tla.TLAValue, aC6bZpF0eo7Nc40PnIq9BnapFYjDthyAe7T8V9XI7ePR3VIuihpwqEJuWO7Sga1Ar2EtAYmgzOekIDFnWGhAIDMCh66OYflzfGtwLkUFuvw tla.TLAValue, aIThXsUSsufSEXBuWRynAC1amycmWnjFNzk2YZoQdw tla.TLAValue, azy3TQcyTDxmalOQ8hQ1R tla.TLAValue, x2vzC1BV0ccqEb0JQH4JNj1lxdAJqAS5JqYM3n6MfsAgoxBuMeWfVivojVbSebpV2Isyle3x7bQSiIBLdehUUpT7WcRtrxwTbeBM tla.TLAValue, dycm4g tla.TLAValue, e6fp7MZDbcL40Dzxi19tsqc0NOFOMptZXKok3cNaw39jz1Y5olxY tla.TLAValue, fvpGUtlF4jbJlaEYIEf0KKErG9yMSULSamF3p6uX0ekan7FkYJ1GfGY38FnvaI1xCmJ8 tla.TLAValue, tJZWvhtv5t3w4YOudUqIqxcButdvxdk7QrSkfXrRqxVm0PDDbkDGjxXusW1zEUOz8vtC33GLKmLiaHqvodkyGwzNQqMDN9G7YlWvHFkqz tla.TLAValue, d9CiJ67ihdcsq1zGpto tla.TLAValue, l1 tla.TLAValue, kfrmqCShcCimYColT2QdFsygTpY1UvNUpV1F0OowzVmgYWEGVThFFpDzf2kmV2bkY7iGzh3wjpLozJIKcFM8I7jjzR6xT65S2N8DLzSvyNlfmE3fgRF04 tla.TLAValue, kiEBQpQU1jo6xyK8efcGcW0jAoRCr5r4wnEavbUsAdlxFvgolAab8QdiIp1Gn992bqEFPvfvm34BvkOQO6 tla.TLAValue, iKFAEtFY7hy4ljNTleW62hcjlpteNLqZpH5UB3uQ5AYqimTymiXDGyjxbjepAcESn8IHZ6bUyxrMKTlGHw8vtAMSzjCVEk7 tla.TLAValue, xgAmcY408twaQxSDXaB8ExSZhkDoIOUqrEDbuUPW6KriB9WX4oItCZGLuCw7zRBWKv6auaHR tla.TLAValue, hk4VkY4ZiUu63GVigOsSjHmYOyH7djLQNJH tla.TLAValue, o5IzQoYsUrfVa4i30vvpTktVYGMTG03IMKqD2O8tzsSPuBM3f tla.TLAValue, q08mHqx7dfTfgggiLK2aNOcXIE2Qu1aXdk0xNvjGzhAfytfEqhn7PI8dZbzgmKhCTuLHcZsTNglm1ZRGzkYO tla.TLAValue, aJ6DcsWlrdtzTbSTp2pOl1Hbhlato7S9I4f6veppCSYWAiGQuamaV2aG5nVfKUHl3wyOpJZqGTgP7wJJVtvg1a3N tla.TLAValue, qGugRgMtXAsyiug2 tla.TLAValue, jfMuGqGfx3uqKFk5SD2sEJ275K88pOCqxvsQhMMO891qzSPrX tla.TLAValue, jtY6HpnYNk6doqATtd tla.TLAValue, iN7xhv1MzuzYOiCT4Sh7VEShwfS6sa2FuSTedejWWSnBGSArKrArNYfPvncTWyAvaLlVSyF1QuOnxdjlfimPKqXVCidOfv tla.TLAValue, fsovO0g0buqgPfCT0iUyGA9qkdqp0xiHMAIG49G55vlE09nKWbLyZtvKsrDMVdanfzutwMxLqAmTYzcrqnEllcQqBl4BvccoSuTAO8 tla.TLAValue, yNtOMcEdkEmNHxHRNr4rjaNgiwfw1GDkwQD6XPeqiRyGlBjcQL7YYe4S5Zio834Tr5wv3WEroBdFzeiBT3q5qvcwkjZS80CYMh0GYdA1 tla.TLAValue, eUKe2HagRA189MJo06zexEUWOxxG42bmGx6udVAj5e9DmDbWOWIR99Gy9cksjYRqdTDipT87p7Xg1O0422N2qKqmcIGLqn tla.TLAValue, b8ddK4eqk8erPJfE3PuBCt6R1nR8z7NaX3af8RpBE8BmAREE4Dm5TkAjRAok0lqrmVEt31Z2eIU tla.TLAValue, jlw8fsBEtqRnuqaouK2iwD tla.TLAValue, u0HfGRjE5jId7Jhdx9DjpuZyYrWocemqVPN2AduRPIFnNOINUlVrveUoHJYa2BwoL9qWNMkvPO00sIMUJNP tla.TLAValue, zZrD2GEI3btaWmmFMhTWt6njd56i9O2AhKTWC8sBzgZYFJjbN8JG8qvCWGfOx8sHyBtJVWKg11uGBZxp tla.TLAValue, rJANcC7QzWMHjQUaXUF2mXXhnc16yDhM1fHMS9ySiXRK3R0AnaDWDuff tla.TLAValue, a4kMZiT0UviUzwO7ZTaBAipAVX3NmEFSECOZuvdMwni2OUgKP0tjPxcLFcMVpe3GxGsUwIRp04H9GPhywpx tla.TLAValue, d1ZxaiQ7KROXHTKbugNHaWJwzfqFqpnZvqF0uVRvL6qe4NDOlicOjmSIRG3aCAQNDVjqA8T7hcKt6L748YJjXpsT tla.TLAValue, dkh9dftAKQCsEu tla.TLAValue, uBHkN6sQPuvNQ2R4SxtqVtCWabcO6Cw9u9 tla.TLAValue, tSr2ZDgbgVIWcGa tla.TLAValue, sfH8ZI89OKbTq2 tla.TLAValue, r tla.TLAValue, dW1tf5x24MAFXIZwyZL14oxKVN28EhJkpIYSmr64ETHGdMn0GSx7udq2c tla.TLAValue, t1Vj1Y9RtOoB93Ckt6kan7wmWcLVaxgOOyiX5N6USZkFreiIb9ZabpDGYMtLJwTStnXx24GHIU15QqTnsAOaqSeOoV7Trv6sk2L tla.TLAValue, n2LQazbNy71xCM5yt9hndpmiEGboqRJ15GZ29b2B tla.TLAValue, ynKpefQii8aRVNTmUfBP9dP9B2QRq1pU5SyFa7Ypq7CfIV7ZrouSG2JWHiA0c7BAy tla.TLAValue, ocsxTpiMPrCwBoKLmnJnVTDAIygjTtQq3HK4ZLnetcZVv6Ego8thDXL6MGaWRUdlSzqMeTjId4DsWdnomE26RGdNObdgArTJTMu64ewiS tla.TLAValue, xHPS0KAxYP4ARqQi6DAEH58yXjI5cyycLKcsZyxy4Kj2IWjcTDW0cA tla.TLAValue, wxIfXMQKmfhuwZ1AkGH2joSj8Js4E tla.TLAValue, mXLeZojiw7j0DmWYcbEzGgKD3XxcD3bElIEzpvo2zc124j7eNKJm35WBANvQfL tla.TLAValue, aw4BXjBAl0R2ui tla.TLAValue, ou9g1IqqeQfEnYj tla.TLAValue, k4WP6HSX60Pe7oJUbyiD tla.TLAValue, hXBgjNmFO64gshs3 tla.TLAValue, dBesg6m9CJunJHw5V9DVeTONVdkh6zQ4qDLYyMIV94vYM85zh4ykeswLKbyj0YSa tla.TLAValue, raR1ezEVYkGNdZ8dfrwfWa9s6WF tla.TLAValue, erPhH0uA9ar74awWPit8BRDHHVvWm9PAN5YQ2r tla.TLAValue, zXgx1ZIcnmfAt8eeR6AoYrUZxMN3PDKsBFjHRPMCUM4kSQHAPzNFMwHA7kulY5mmd1IqmmZrbtgqJ1go9 tla.TLAValue, wX4DbY5Cjz74DH2EBMTqEEmAgPmORwLzyzvrgzK61cM1FkFWNOuXRRjxGvySbh47uekGKC51CZH0K6PnSYnf tla.TLAValue, pHQbaz8L2o4vtwfkHITGp3hLmBsEzUfnnREIVVb7w0QPiLtnO5wQOvOCUsnefuaS92hqEfEovByLvfZSALyW53iZ0B tla.TLAValue, vN0oAukCuH1tidxYDNv3tpho9bGH3dSN73iLyPT4LBhKLcweYencnOhr0IVE2WqOLpNqmSXM9e9EmuyntNAvXX1zAXw8 tla.TLAValue, zwwrnscjMRSo4ybtRdBfaCWo5p tla.TLAValue, u9NLz8UiJfrpRokx6Klej3lJwzxIXELFLovaChsZCbaF7sRLeAjVOdqXk6wPOqM0b2hsfLzYigVIw2IeHxumWrhdxz30Db7itIInwdLJENUAR4 tla.TLAValue, onQcOt48AFx2g3vcKr7JeP16DTWGKYuPbOPe0fPztj1pyHS5P4VKSrUkX9DuGqNISiGnqoDfMWtPqJ695WBCVZuqsy87ZWLKreaaiJJlL2mQEvTKGcq tla.TLAValue, nzSv46djtF8jRAb0x1tkPL0pJAgZeGMg8mjp0HfO79wuKKHL17c4Qouz528vcFr7U7U7qSK2EHn41dubI tla.TLAValue, haqvardC6HFV9aBiVpOkhAZOCsnl0AdN7O5jSmdwo4FOHk8GRwsYhBL8Vojo2YZubGmtsEVBuL8OgqDX7go36puITdRih4 tla.TLAValue, tdDITA9zAIMITHRr8Tu7k1MKQvapl1SjqGBfkNpAq7C4WPXgALuD22gJlCQEkDwFLZDM3B tla.TLAValue, gtcDnKpcoxXxmgSEaDbvuU0S3JFm tla.TLAValue) tla.TLAValue {
+				return xgAmcY408twaQxSDXaB8ExSZhkDoIOUqrEDbuUPW6KriB9WX4oItCZGLuCw7zRBWKv6auaHR
+			}
+			_ = bxqRuwNj67l1Tw0tG4FnJoCpbcwAITjSRFP1I7Gz9BYYEOtYCbQNVLKoEIx0CSFwIyMkChMguq0JB4zT4HXTZdyPn0hn6D4EmtYKzcNEuKBXWJ
+			oGNcam7no50VFS9bNVSLFrVV9tFOTIhTwd9VlrW1xT := func(am8bWyy6KnGD7pbjuRLHR01nW tla.TLAValue, i0s8KBm44MBD7jP973D5Eu4zNKCiXaPohAQsXTb4CMmE4z7uccxlcgtrtLf6tVytqIhsXxkf tla.TLAValue, kwC5NvLfjhrgra7nBauJjJdcPCGZMVFBHk9of51zPNdpV0LNTaraqDEhs9qKYY8Gngi5uy9gknn tla.TLAValue, ehW25vry3ut0fMujKtUZrmrmPZ7SGzViNd7FExfqWnXySATsZ0eFyWdwwbSx5a8XFouh1UrVdF3otRcwQsbBWkjICbhdFUD0zy6VKEfDFJ4qJ8rlBpk tla.TLAValue, vcYhmXYbqt6NOh tla.TLAValue, fjjQWlZST5w8UQJSGYmMExwrE4KiHMs2vva86I7hMGFIXjZMt6Pr6inLcbQaI2fDvv3wzAj tla.TLAValue, iGP7JEUvz0e5rYJkVm4vSagOFQ98H9ywKRqYsAca8p4yX5XVkKKphJUGP4n tla.TLAValue, cStGYyv8V tla.TLAValue, l1CWGSnDAFA9JP69nGrlo2f5ohVH7g6O4yTqWxznzWqFPNBGhTDvk1nDQDU398teHkao4sK2n38qz7zl tla.TLAValue, aiV1iGsofLK7qe1lQxjSZe55J51Q0s4z tla.TLAValue, mb2f4sCh9wj7JdY2V3cEmrIv99amnXuMutObxCvZ5aqlj72 tla.TLAValue, p05MeTxF9stYUJ2JXqTIioRDLc4 tla.TLAValue, cZHjp2fGGJxnXXb2t9XjqSQprA5WQtkCwSETFbkGseDOjuuZRbJo6VRiLEn tla.TLAValue, zyaZUPLlnqpNKeTj3tokZX4dDrnkdjnPvHdgCamm8YLu0WH8IWxsYZnE7tsnbXfHudC7VRYjNvOinB9oyHyaIRlCVKAF2M7dkDCbYxQKSKTU1q9SGZ tla.TLAValue, uJh6jOSh5uGYgEEzpgdPGVh4TXRlrRvsDzgqdchE5JJdHJTfqOLbxIlSoJf tla.TLAValue, vXSvT2saRKtTIpFQ9Lb15wveUQM82wTqNV7k2ilR3nd0leLNv8KIm3nmq51aDmkfPmTHRBaNAbZLxFouVAd4ywUHeHEA3tA8mYYCCDpcJNhzIsPo tla.TLAValue, koSPqSVrdMCYlCkJixR4xlBZmf47UdD0qwxD36fauTxnkYeonmYd3VgUmL695pjXrOsE5puEJtJn tla.TLAValue, toHkXVSgsIWfecbbl4rfUKKsNhId3D tla.TLAValue, vLzSLfHX7fGw3l tla.TLAValue) tla.TLAValue {
+				return l1CWGSnDAFA9JP69nGrlo2f5ohVH7g6O4yTqWxznzWqFPNBGhTDvk1nDQDU398teHkao4sK2n38qz7zl
+			}
+			_ = oGNcam7no50VFS9bNVSLFrVV9tFOTIhTwd9VlrW1xT
+			return tla.MakeTLATuple()
+		}(), tla.MakeTLAFunction([]tla.TLAValue{tla.TLA_Seq(tla.MakeTLASet()), tla.MakeTLAString(";nT!E/a\"~A'^[uvN51;+TePI5&t$^^Daj.]7eDe:_41h;{8(jzvkM`TLwt8")}, func(args7 []tla.TLAValue) tla.TLAValue {
+			var bV2U08WxrbJW4YyisaWLdDTlnnQZN48YPIYI1 tla.TLAValue = args7[0]
+			_ = bV2U08WxrbJW4YyisaWLdDTlnnQZN48YPIYI1
+			var mC1XOAZ2fxiYvCGmEOU6F1qyEyTa2vFsCsEcdP tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(1))
+			_ = mC1XOAZ2fxiYvCGmEOU6F1qyEyTa2vFsCsEcdP
+			var f80LwKCPunKotdxCDYdLXKuLjszpV2LDDtq6fNxN2tS9uLEPjY tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(2))
+			_ = f80LwKCPunKotdxCDYdLXKuLjszpV2LDDtq6fNxN2tS9uLEPjY
+			var fEjxZzM3uZfPeYizw20dmH5PPB04fnDZb0oQHHwQh1B23qR6ZTR6FukrkWqP9N4M6 tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(3))
+			_ = fEjxZzM3uZfPeYizw20dmH5PPB04fnDZb0oQHHwQh1B23qR6ZTR6FukrkWqP9N4M6
+			var nMlWCkBrWQaJh3XG0G1Jv0g83HFtBkYC1PrCdKtFSNAFPxhrosXkrilQ2WnzXUHPORkeg4XrOWnu8QKr7CIKRAJremILMIJH9rwBmadehVJFIDOKOUyW tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(4))
+			_ = nMlWCkBrWQaJh3XG0G1Jv0g83HFtBkYC1PrCdKtFSNAFPxhrosXkrilQ2WnzXUHPORkeg4XrOWnu8QKr7CIKRAJremILMIJH9rwBmadehVJFIDOKOUyW
+			var c1ALluekNXYDYfxjMO3JUJQsQ3HLsDofZkQ9xq tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(5))
+			_ = c1ALluekNXYDYfxjMO3JUJQsQ3HLsDofZkQ9xq
+			var fetKOoH8deASth6qqVbT3gztGvFl6W9QE1b0SCSnsQ1O4dCfGRv8IXLoSYfyD21sU6dgCGGUPMGrR9ohsMuTTmpgllkICLkNwQN7 tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(6))
+			_ = fetKOoH8deASth6qqVbT3gztGvFl6W9QE1b0SCSnsQ1O4dCfGRv8IXLoSYfyD21sU6dgCGGUPMGrR9ohsMuTTmpgllkICLkNwQN7
+			var uoHaLhgnG3FYlPpkUDvKIgeCVm5k6QXddIiIFBhWhutUKd5EvynwY8LYqcLeage7udmiMFkxX2ADYueb4lSBki3a8fVAmM8JNirDcrzlGkp3GGGV519qG tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(7))
+			_ = uoHaLhgnG3FYlPpkUDvKIgeCVm5k6QXddIiIFBhWhutUKd5EvynwY8LYqcLeage7udmiMFkxX2ADYueb4lSBki3a8fVAmM8JNirDcrzlGkp3GGGV519qG
+			var kInHvY3c tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(8))
+			_ = kInHvY3c
+			var i7yjwoHNZDTEpJ3bxv3LuKT7YiWHxRPLcd9jSw535YbQLCvR5scxUxIVmtgeVXxA0IXwMfYF tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(9))
+			_ = i7yjwoHNZDTEpJ3bxv3LuKT7YiWHxRPLcd9jSw535YbQLCvR5scxUxIVmtgeVXxA0IXwMfYF
+			var vtmO38WAV1lS5a1relzaRaJ9gjBs79Tk7VKvQPVur0At1ugDjNk7IAj5hoYVbQpFchd tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(10))
+			_ = vtmO38WAV1lS5a1relzaRaJ9gjBs79Tk7VKvQPVur0At1ugDjNk7IAj5hoYVbQpFchd
+			var yQ5FOTP9AKXWbU0CsGNh8ErHtwc87RtguU4O tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(11))
+			_ = yQ5FOTP9AKXWbU0CsGNh8ErHtwc87RtguU4O
+			var tZH2fPnkiORKOdPGNpv3g6JPn89cFGo89Pn230iC5APeDPa6drc84Xcsgnu6d2AWyvLFB7qQ2Cnc9mX7p1eWzfmYRfRmltJPR0CBjlF6kXLxsJu8l tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(12))
+			_ = tZH2fPnkiORKOdPGNpv3g6JPn89cFGo89Pn230iC5APeDPa6drc84Xcsgnu6d2AWyvLFB7qQ2Cnc9mX7p1eWzfmYRfRmltJPR0CBjlF6kXLxsJu8l
+			var pfGhBg4NpTWBTQ7oas6xt00hwsM4V6vdZdVKUXSgQ6yDUTv0j5mxzXy tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(13))
+			_ = pfGhBg4NpTWBTQ7oas6xt00hwsM4V6vdZdVKUXSgQ6yDUTv0j5mxzXy
+			var f10r3ZLaRylKTlCR5Ky4O0CBkZek5VlXD6QmoggJrPT9FkQIQoxumjaFtk5qgV tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(14))
+			_ = f10r3ZLaRylKTlCR5Ky4O0CBkZek5VlXD6QmoggJrPT9FkQIQoxumjaFtk5qgV
+			var eQixxodNKGtuy6dAXx98mSGhsqUmm7J2HDYM0 tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(15))
+			_ = eQixxodNKGtuy6dAXx98mSGhsqUmm7J2HDYM0
+			var dU4wU8dYG3ZJaenKuEDXtfr0yU5E2zHv9DJdQXhwf1eFYJYeOx1bdtV1Q78NfyKQVCfSQmPA3PytUt6U2aNwbeLXYc8Jky tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(16))
+			_ = dU4wU8dYG3ZJaenKuEDXtfr0yU5E2zHv9DJdQXhwf1eFYJYeOx1bdtV1Q78NfyKQVCfSQmPA3PytUt6U2aNwbeLXYc8Jky
+			var kqgz4sCHkaoQTZyPYYiNyUkUwekRjuKRqP8VfRaai6B4ckuRIB1ETByAn7YKmrlFlyPkUhfHOYqtiqRT0WrRnCYBKYjtUfJxr7DhzVqXtuq3DPfWgM9lyG tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(17))
+			_ = kqgz4sCHkaoQTZyPYYiNyUkUwekRjuKRqP8VfRaai6B4ckuRIB1ETByAn7YKmrlFlyPkUhfHOYqtiqRT0WrRnCYBKYjtUfJxr7DhzVqXtuq3DPfWgM9lyG
+			var wnhdRCUKyiPBNyJW1Ez3hrKnIBZqJVZTMAbpOixvQ5RP0oIudvkAgB6Egkb8IVLFO0Sv3SREHolTRYzut0ihOJnKq26cS3wtVuLoMnvHA tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(18))
+			_ = wnhdRCUKyiPBNyJW1Ez3hrKnIBZqJVZTMAbpOixvQ5RP0oIudvkAgB6Egkb8IVLFO0Sv3SREHolTRYzut0ihOJnKq26cS3wtVuLoMnvHA
+			var vKCBeTLFMor2MFAiO6kax65w6C16aemUdbeG1B7U4kqDTTuLDmyptjklcZUOEieKnUtqQiqDlOsYqIxmeD0VKjuk50wPxsdD tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(19))
+			_ = vKCBeTLFMor2MFAiO6kax65w6C16aemUdbeG1B7U4kqDTTuLDmyptjklcZUOEieKnUtqQiqDlOsYqIxmeD0VKjuk50wPxsdD
+			var tnD4 tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(20))
+			_ = tnD4
+			return tla.TLA_Head(tla.MakeTLASet())
+		}), tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLAString("Or4uYZ'{+a*`I/tHTU'@Fw=}G/B/d%h):%R.FE[0}o-\\ -8'jRgIskN&SKdu\\,\")9%r;op>bex)&O5eqhS/xtaJG,nV#QWjd-c_f:)d"), tla.TLA_Zero, tla.MakeTLANumber(55), tla.MakeTLAString(">&cXJ)Blo@-}ot<1`,WaQ4UI%BWVB)psW,;X"), tla.MakeTLATuple(), tla.MakeTLANumber(68)}, func(args8 []tla.TLAValue) tla.TLAValue {
+			var kO0o8ujUrtEZSKXpafhXQv9EHVxF45b4MkRvqWCB76Ex6z1DdtwByAbWNZyQ6UfhvyVKTxjOmES5Jlx2nCIGaQB7KsdUw00Ozvmm7CtuhSt4GEGL1Hvf tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(1))
+			_ = kO0o8ujUrtEZSKXpafhXQv9EHVxF45b4MkRvqWCB76Ex6z1DdtwByAbWNZyQ6UfhvyVKTxjOmES5Jlx2nCIGaQB7KsdUw00Ozvmm7CtuhSt4GEGL1Hvf
+			var ldBDvTLTWC5zA7k tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(2))
+			_ = ldBDvTLTWC5zA7k
+			var pIjp2lddQViuziUJ5PzpXdPBwEdaMP3AszU3BjFhemaQDBE8gbMv7jwixPTpChTqWqpLiQkwJIpjVWg0uYQco8dGQ66ukUDtw2usxlCN tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(3))
+			_ = pIjp2lddQViuziUJ5PzpXdPBwEdaMP3AszU3BjFhemaQDBE8gbMv7jwixPTpChTqWqpLiQkwJIpjVWg0uYQco8dGQ66ukUDtw2usxlCN
+			var b3UtW0rVZtZyhgTLrtyDEsKDHKBXcplhnMNq3Nb1rDEzEvD14GQ4xrCpHbtMYYxQ1Ls5FLheAaQQA4SUmAULszAeG03r4PK6CQjDJCg3DFtNo8od2pNJ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(4))
+			_ = b3UtW0rVZtZyhgTLrtyDEsKDHKBXcplhnMNq3Nb1rDEzEvD14GQ4xrCpHbtMYYxQ1Ls5FLheAaQQA4SUmAULszAeG03r4PK6CQjDJCg3DFtNo8od2pNJ
+			var vMEv8m7FSuBnidiaNz8mtxx8tdtU8b4F202sYMtG7 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(5))
+			_ = vMEv8m7FSuBnidiaNz8mtxx8tdtU8b4F202sYMtG7
+			var iQZO0T24G3qnDyj6vVBLHvMjgcaZOitVbHtyt8AL22DS1mkpWey2XwbBX0m4gXJAFsgTGNKYMaSYvPCyoqjXWZarUEJGvR642x2FUMJ7Fhw7FZE tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(6))
+			_ = iQZO0T24G3qnDyj6vVBLHvMjgcaZOitVbHtyt8AL22DS1mkpWey2XwbBX0m4gXJAFsgTGNKYMaSYvPCyoqjXWZarUEJGvR642x2FUMJ7Fhw7FZE
+			var yjoZf3KwI7SqmHeLeKhvHU2gxANVgfse8azWiGYPxfpsacwcrxnmKoofmI4CPGNsUieyRGYAl40afhPAIU0RnOMHMJGEU5I57NclzPbplZgtzUQMENaJM tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(7))
+			_ = yjoZf3KwI7SqmHeLeKhvHU2gxANVgfse8azWiGYPxfpsacwcrxnmKoofmI4CPGNsUieyRGYAl40afhPAIU0RnOMHMJGEU5I57NclzPbplZgtzUQMENaJM
+			var eNvWBf98yGDwLOTxgh0hmlAS8J133kVrL3yTs3jQRUSBgiEVgFQTBe1CpaoJcFQrUtfWssk21W8DheW tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(8))
+			_ = eNvWBf98yGDwLOTxgh0hmlAS8J133kVrL3yTs3jQRUSBgiEVgFQTBe1CpaoJcFQrUtfWssk21W8DheW
+			var iksItfTdWlf91wMH1QVzekiPxgtyt6iMonMoOdWHikwWzrfV44cfCCkkhKGDNWrBG7Evu1EXKXzZ8him06EYYXs34IrJhQBWRav62X tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(9))
+			_ = iksItfTdWlf91wMH1QVzekiPxgtyt6iMonMoOdWHikwWzrfV44cfCCkkhKGDNWrBG7Evu1EXKXzZ8him06EYYXs34IrJhQBWRav62X
+			var tAnobkLMVODeyXE3dJMbTOex55Rh9KfBmbCPIDIWqOYlIXBGwW3ca3CcdndqOXK6sY1spZMtel0Gbb8u3rlTnvMkXYTxpFaRWoSgRtb8QUxf tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(10))
+			_ = tAnobkLMVODeyXE3dJMbTOex55Rh9KfBmbCPIDIWqOYlIXBGwW3ca3CcdndqOXK6sY1spZMtel0Gbb8u3rlTnvMkXYTxpFaRWoSgRtb8QUxf
+			var jOEN2cA1B9hSAANKYwLKzvrXDS3cKD8RG8U7NqXf0tx6RmGrEw tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(11))
+			_ = jOEN2cA1B9hSAANKYwLKzvrXDS3cKD8RG8U7NqXf0tx6RmGrEw
+			var pRguamU3idbV23h2ampbT0nXDhmJsIb0aAKe tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(12))
+			_ = pRguamU3idbV23h2ampbT0nXDhmJsIb0aAKe
+			var uxBIVI2d1IQe4i2tn1vBKtj1KH5nJ21S8EvSaDMTuc69EAf66jFR9 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(13))
+			_ = uxBIVI2d1IQe4i2tn1vBKtj1KH5nJ21S8EvSaDMTuc69EAf66jFR9
+			var jQHDNDhlLsdZ6J49JzRNaf0SEGWYTT5E tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(14))
+			_ = jQHDNDhlLsdZ6J49JzRNaf0SEGWYTT5E
+			var k1 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(15))
+			_ = k1
+			var elsvkEBOPBXA4p1xJhWv8mancOIbcsb6KDz23GUGjYXc0PAfRmRrwrDtvL0N8nfvOsPpkpVcbhLxUTm0MtNzxM87VN tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(16))
+			_ = elsvkEBOPBXA4p1xJhWv8mancOIbcsb6KDz23GUGjYXc0PAfRmRrwrDtvL0N8nfvOsPpkpVcbhLxUTm0MtNzxM87VN
+			var v4Q4ZQRr9aVm04y0FsPupkMfhtT24dxkdpm2adALoTcNijFUX8nVYifww4oNk2N6HWmQvM1DQ6Q8w8YdlPVAu7ZHZggGbgeZiER6KWeo tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(17))
+			_ = v4Q4ZQRr9aVm04y0FsPupkMfhtT24dxkdpm2adALoTcNijFUX8nVYifww4oNk2N6HWmQvM1DQ6Q8w8YdlPVAu7ZHZggGbgeZiER6KWeo
+			var btDGdQbYfLXDkiFZWKaWJrq2srlGJyXOycVmwj8HSe5sCDaiKoyl2GsCCP0z tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(18))
+			_ = btDGdQbYfLXDkiFZWKaWJrq2srlGJyXOycVmwj8HSe5sCDaiKoyl2GsCCP0z
+			var bdff tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(19))
+			_ = bdff
+			var gr1BdNF8dvif2l04cmJpyn0JuhNixTANCDazjty8OlIIV6vnMiGaNaJVDzOOK2R8 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(20))
+			_ = gr1BdNF8dvif2l04cmJpyn0JuhNixTANCDazjty8OlIIV6vnMiGaNaJVDzOOK2R8
+			var oocQSJVdSzSSWgyLvYdc8A8zXnByxCQoGPGTgVZ9XfJ208eAeVRtBHRGZZsmi54FFnbIZjDgQT1ZVoC0WeqP103CHQCJc61uTMix6zomMp8U3imFU tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(21))
+			_ = oocQSJVdSzSSWgyLvYdc8A8zXnByxCQoGPGTgVZ9XfJ208eAeVRtBHRGZZsmi54FFnbIZjDgQT1ZVoC0WeqP103CHQCJc61uTMix6zomMp8U3imFU
+			var c2 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(22))
+			_ = c2
+			var qrr tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(23))
+			_ = qrr
+			var m7PmBz36K8FT7NEoRfbVJafSPHcGUUTEugg2KG5cShS0PKCfSV3TeELEM6HXSPw26OFWBUxcruG6bBXGB0DVHJ8mxhSHjmfwjqlm5OCrHZeHowM tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(24))
+			_ = m7PmBz36K8FT7NEoRfbVJafSPHcGUUTEugg2KG5cShS0PKCfSV3TeELEM6HXSPw26OFWBUxcruG6bBXGB0DVHJ8mxhSHjmfwjqlm5OCrHZeHowM
+			var kuxw1iiItainvT7Q73 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(25))
+			_ = kuxw1iiItainvT7Q73
+			var zP4rMlAi020Bus8HM9BUQtojksqW3RB9KbvZDeYyZfV8m1Loxk4vHoO8heE10i3J6p5dvwHVkieGsRobkIZFAgP60APg2qlt tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(26))
+			_ = zP4rMlAi020Bus8HM9BUQtojksqW3RB9KbvZDeYyZfV8m1Loxk4vHoO8heE10i3J6p5dvwHVkieGsRobkIZFAgP60APg2qlt
+			var zI1VBWlKdoAGmVgFskli6szD28WnwtXeokxMYN4dzS0HpVolIzAi3nahgzxc4fAiE3XlJHoAL6BlxVtAg3jJxUTs028QDByWr tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(27))
+			_ = zI1VBWlKdoAGmVgFskli6szD28WnwtXeokxMYN4dzS0HpVolIzAi3nahgzxc4fAiE3XlJHoAL6BlxVtAg3jJxUTs028QDByWr
+			var rt0AnnGwvsNCWKPxj83FjDQ6CDywAE1wyhckI3zMq tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(28))
+			_ = rt0AnnGwvsNCWKPxj83FjDQ6CDywAE1wyhckI3zMq
+			var i15aBySROLrwkWWU2MBe12EPcBazmfwa7z0lC6GoTcV2nWp0gjshpwxa0 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(29))
+			_ = i15aBySROLrwkWWU2MBe12EPcBazmfwa7z0lC6GoTcV2nWp0gjshpwxa0
+			var gTJBp8b6o2 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(30))
+			_ = gTJBp8b6o2
+			var ouDjMggVqFIWA2mvO7XDYWpSgjfmm6egFAyIhBciUKWA7k5NzBHA08G44Q2ebH2MdisKeQF3rLX9sT4 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(31))
+			_ = ouDjMggVqFIWA2mvO7XDYWpSgjfmm6egFAyIhBciUKWA7k5NzBHA08G44Q2ebH2MdisKeQF3rLX9sT4
+			var fCXNrvL7LQsetTuC8NDnP7AvN9zUT2meIa6oAFmMKymeRuXz1z tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(32))
+			_ = fCXNrvL7LQsetTuC8NDnP7AvN9zUT2meIa6oAFmMKymeRuXz1z
+			var cB75zWu4bFfZ7bReB2tz6nChMeLrnshf8iSo tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(33))
+			_ = cB75zWu4bFfZ7bReB2tz6nChMeLrnshf8iSo
+			var mJBsPwrfnDYQEtcf6aIJ75CLMgUUAWQ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(34))
+			_ = mJBsPwrfnDYQEtcf6aIJ75CLMgUUAWQ
+			var tThaic86zb50xcaa9En6nMcgKmsQOVAlGlg6NODp9aD3HK7LgYtusnmagu8LeD8Gdtjk5X2liu1YhZj63oUZgXvWao tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(35))
+			_ = tThaic86zb50xcaa9En6nMcgKmsQOVAlGlg6NODp9aD3HK7LgYtusnmagu8LeD8Gdtjk5X2liu1YhZj63oUZgXvWao
+			var vxQgtx9LyM78cX4eJ3 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(36))
+			_ = vxQgtx9LyM78cX4eJ3
+			var uUgriaeOjiNpG tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(37))
+			_ = uUgriaeOjiNpG
+			var dIJkiFIHC tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(38))
+			_ = dIJkiFIHC
+			var hUUqU1AJ5jhhGNR tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(39))
+			_ = hUUqU1AJ5jhhGNR
+			var mWDgHVMnBakuYYQpYbmsPhvA78t9CbU9L6mcbTVbPAmSaS tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(40))
+			_ = mWDgHVMnBakuYYQpYbmsPhvA78t9CbU9L6mcbTVbPAmSaS
+			var ckDkPibv4YdeL3NlpUQAmKVhrZJ9s0jgha3Gzk8rge1zGSJdoSE5RYrUxELp9qWkZAskRba7F90zdC tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(41))
+			_ = ckDkPibv4YdeL3NlpUQAmKVhrZJ9s0jgha3Gzk8rge1zGSJdoSE5RYrUxELp9qWkZAskRba7F90zdC
+			var vXNPVUvU0frMbkTYztO2jUV tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(42))
+			_ = vXNPVUvU0frMbkTYztO2jUV
+			var gna2KuPcjDqObxBhR2WLN9TIc0H2vkV8zdLGHOkP98CevOd8RUIzS59NNGs11VrIhfOBND3EWryGW6cJmamT3AsZtI6SXiyPICIMPZEgh8iT3ILToFc2R tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(43))
+			_ = gna2KuPcjDqObxBhR2WLN9TIc0H2vkV8zdLGHOkP98CevOd8RUIzS59NNGs11VrIhfOBND3EWryGW6cJmamT3AsZtI6SXiyPICIMPZEgh8iT3ILToFc2R
+			var unimZH tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(44))
+			_ = unimZH
+			var cCp4BttUPhWK7Y4eFkMJkHi7ebwI0dVqHB0aEZ6EwFeBFsRgCRrlG8rVOBRLfN392YMDxVtoav tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(45))
+			_ = cCp4BttUPhWK7Y4eFkMJkHi7ebwI0dVqHB0aEZ6EwFeBFsRgCRrlG8rVOBRLfN392YMDxVtoav
+			var nzhSngOM1p0V7P47VNCDGCeAwrp tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(46))
+			_ = nzhSngOM1p0V7P47VNCDGCeAwrp
+			var jul4HViEqCSVrXKX9IixqvO85FGc0peEExAYlte1Ak61bXri0i17VO555DOkZFdYSBncchZ8aaHW tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(47))
+			_ = jul4HViEqCSVrXKX9IixqvO85FGc0peEExAYlte1Ak61bXri0i17VO555DOkZFdYSBncchZ8aaHW
+			var fdzTFEcXfD0QPG5oHRsxZcbMEcZwzmypXVZmXXlTtNnzolk3zrorAQjLAuXN6lK1siSP6LJ5tbKGTnKH8LZfyNVns8m26B9OivjaOXlqxBrtUcZMnx5XD tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(48))
+			_ = fdzTFEcXfD0QPG5oHRsxZcbMEcZwzmypXVZmXXlTtNnzolk3zrorAQjLAuXN6lK1siSP6LJ5tbKGTnKH8LZfyNVns8m26B9OivjaOXlqxBrtUcZMnx5XD
+			var ypfYTnFuWzTzgSoEOSURZGzFGjn2mcMVDH tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(49))
+			_ = ypfYTnFuWzTzgSoEOSURZGzFGjn2mcMVDH
+			var vfuiSoDYtUOHweqrpS3nlpZSq5xP1bqaxgqyzw8uDFafP6hiv7RrhtPaltrGQSiBgSpHYCCel91N0Vbqdny tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(50))
+			_ = vfuiSoDYtUOHweqrpS3nlpZSq5xP1bqaxgqyzw8uDFafP6hiv7RrhtPaltrGQSiBgSpHYCCel91N0Vbqdny
+			var nFl7jZeuLq3f2wNnL695EXG1ibiivJ5REpy tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(51))
+			_ = nFl7jZeuLq3f2wNnL695EXG1ibiivJ5REpy
+			var bTz3sME7uzvqvSRG4aVh05UtyP9SnTDt5TNHyCFBLMzDrWPGhK0lY9B3O71YXk9bYFSyxAahbMqXdgBaHyW45H1B6AnCUpVkBYfOh4YmgW6uSfKl2nI tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(52))
+			_ = bTz3sME7uzvqvSRG4aVh05UtyP9SnTDt5TNHyCFBLMzDrWPGhK0lY9B3O71YXk9bYFSyxAahbMqXdgBaHyW45H1B6AnCUpVkBYfOh4YmgW6uSfKl2nI
+			var egZacxcdnwHTXFjQv0mKAT tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(53))
+			_ = egZacxcdnwHTXFjQv0mKAT
+			var fXDIsjR2eM0AUQjupHODeoOzPmWHg80fnpFfrREZdPnevlz tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(54))
+			_ = fXDIsjR2eM0AUQjupHODeoOzPmWHg80fnpFfrREZdPnevlz
+			var f9ve6VS9Y8lFGuQkUIYyc3cG6E1o9w0sJANSJ8QqAhT1X1Tm5CBglXjLrbQZOWJpazH3hdj tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(55))
+			_ = f9ve6VS9Y8lFGuQkUIYyc3cG6E1o9w0sJANSJ8QqAhT1X1Tm5CBglXjLrbQZOWJpazH3hdj
+			var jQgnsNXmg8WQzWTP7 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(56))
+			_ = jQgnsNXmg8WQzWTP7
+			var yI1M54USa0JkoDwEuhrLKJfohgstZnMek58ONSDqVAVuq5MHjasjmDg0vLjnAJsiXgV8kr4b39CXdYQ4CUBxsgJoDALhshwje9EfJh37rH1rHnFp tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(57))
+			_ = yI1M54USa0JkoDwEuhrLKJfohgstZnMek58ONSDqVAVuq5MHjasjmDg0vLjnAJsiXgV8kr4b39CXdYQ4CUBxsgJoDALhshwje9EfJh37rH1rHnFp
+			var femfYGpvUQxUXPaBbAc4V7BkEd71Z tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(58))
+			_ = femfYGpvUQxUXPaBbAc4V7BkEd71Z
+			var slG1TW9kzeXjOFmw5mP9JRRXZx6 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(59))
+			_ = slG1TW9kzeXjOFmw5mP9JRRXZx6
+			var ikwVTkV4ft7K53XiRwWiMyTlFIiRJlObVi0cCN9HPPy tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(60))
+			_ = ikwVTkV4ft7K53XiRwWiMyTlFIiRJlObVi0cCN9HPPy
+			var nRJa6VIjG0iSk3umJGlA0YKb5LeDRF3S4LYCAromZgLDtfOd6BYVOrV3WpQ5XxAiP4ahlc1fqZ6kbnkdR5hvNUdkqVgF9hTK3 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(61))
+			_ = nRJa6VIjG0iSk3umJGlA0YKb5LeDRF3S4LYCAromZgLDtfOd6BYVOrV3WpQ5XxAiP4ahlc1fqZ6kbnkdR5hvNUdkqVgF9hTK3
+			var rS01pIEqFI4nECM tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(62))
+			_ = rS01pIEqFI4nECM
+			var x9lTCQJ46gsItDkq3s7puzIT3dhl396BxvZevpJZqgL tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(63))
+			_ = x9lTCQJ46gsItDkq3s7puzIT3dhl396BxvZevpJZqgL
+			var wX2of tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(64))
+			_ = wX2of
+			var yVZ2kwpKr8WTnHQBA0Zajs0WhlTLCpWvL8jieQwi2LAfioPwt1ttRZNWO41mGYeyQyRFUMN0bSCLrr6 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(65))
+			_ = yVZ2kwpKr8WTnHQBA0Zajs0WhlTLCpWvL8jieQwi2LAfioPwt1ttRZNWO41mGYeyQyRFUMN0bSCLrr6
+			var dqByoNgSuCNNKVW1jvrrpBMbogTZ2G32Mtl1ns88U2c6Se0OyI3l tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(66))
+			_ = dqByoNgSuCNNKVW1jvrrpBMbogTZ2G32Mtl1ns88U2c6Se0OyI3l
+			var eilbyb6SsTvkG tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(67))
+			_ = eilbyb6SsTvkG
+			var aqiWNd2h0ebqXWYuCMYoRZOdpi15kQGjEX7S4rY0xceblCGZyFjmJzynrHa86dQRT9cyBQTYetu8Mp92ZkSwI3HdD6Tfuv tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(68))
+			_ = aqiWNd2h0ebqXWYuCMYoRZOdpi15kQGjEX7S4rY0xceblCGZyFjmJzynrHa86dQRT9cyBQTYetu8Mp92ZkSwI3HdD6Tfuv
+			var t70LxjlzZS2MKJk9NaKo7LXgYuOqnCVTquv4UhRMbVY3z tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(69))
+			_ = t70LxjlzZS2MKJk9NaKo7LXgYuOqnCVTquv4UhRMbVY3z
+			var oNhdMH1vNIChcsmWBwhaM tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(70))
+			_ = oNhdMH1vNIChcsmWBwhaM
+			var hzEGhhnRYrI7mGjXnj51FB8Jxqy0Rpl3fxYLk3XGF0BTUYCZGbRldmelbE5csc7GplMminJV4dGAX4O6N03p tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(71))
+			_ = hzEGhhnRYrI7mGjXnj51FB8Jxqy0Rpl3fxYLk3XGF0BTUYCZGbRldmelbE5csc7GplMminJV4dGAX4O6N03p
+			var t5eghclsFuE6CjDCubUJpc14DcmhxPhy tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(72))
+			_ = t5eghclsFuE6CjDCubUJpc14DcmhxPhy
+			var hmTiNkMN3KJVoMdbGecjOzv3bShhuKI5QhIIS62cEzsDWirN1PSygSoaQIZczHnKFKZLGiGZ6YQvBzSGitDGyltQuaI4EEh4CnobOUQ9g tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(73))
+			_ = hmTiNkMN3KJVoMdbGecjOzv3bShhuKI5QhIIS62cEzsDWirN1PSygSoaQIZczHnKFKZLGiGZ6YQvBzSGitDGyltQuaI4EEh4CnobOUQ9g
+			var iXCyDBBJHfbBYbpLCyPfYjcYRehMfJPPCcMiCrrQQhy1fvRnuevlgz0YN3AIhu tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(74))
+			_ = iXCyDBBJHfbBYbpLCyPfYjcYRehMfJPPCcMiCrrQQhy1fvRnuevlgz0YN3AIhu
+			var qYQ8rPoy84TlMSkhJzrStSm5z22vyJ25BPF6JGByWzyJlglMlohLRxls tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(75))
+			_ = qYQ8rPoy84TlMSkhJzrStSm5z22vyJ25BPF6JGByWzyJlglMlohLRxls
+			var zUOc5RkBnUYKK5U3B1CACgrYf4uavpr tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(76))
+			_ = zUOc5RkBnUYKK5U3B1CACgrYf4uavpr
+			var rqSr9elqVrUOXVzYwnxEhEaR048QEo2cn5m1tvf1uyG4grj53R6MRC6XomMZw9AibztCglmgiAAZe5EDkL1AO tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(77))
+			_ = rqSr9elqVrUOXVzYwnxEhEaR048QEo2cn5m1tvf1uyG4grj53R6MRC6XomMZw9AibztCglmgiAAZe5EDkL1AO
+			var a tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(78))
+			_ = a
+			var ddpP8QrFBXM75EHZBxbFDOM tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(79))
+			_ = ddpP8QrFBXM75EHZBxbFDOM
+			var mpHjrXsJUThOqZGyvuClPaOnRU4OKremovq3UxqEVPkw4Zsd80mymZzx8TJBzan1KzXRrmjebKj9pJ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(80))
+			_ = mpHjrXsJUThOqZGyvuClPaOnRU4OKremovq3UxqEVPkw4Zsd80mymZzx8TJBzan1KzXRrmjebKj9pJ
+			var b4UmPxr40v6HFQr6bG7iY4YKjRuqWAwIA33XYKGpuGZEsJ8reAk9RWKjg0Y7dxA2v6uf6zgHSoehRwYJ1j4jQ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(81))
+			_ = b4UmPxr40v6HFQr6bG7iY4YKjRuqWAwIA33XYKGpuGZEsJ8reAk9RWKjg0Y7dxA2v6uf6zgHSoehRwYJ1j4jQ
+			var j3hi4BuSA72oSUC9zYnE9kKzFBZvBS7WJOi8raRB3b7xG9BxkfhuXwTMhLQd tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(82))
+			_ = j3hi4BuSA72oSUC9zYnE9kKzFBZvBS7WJOi8raRB3b7xG9BxkfhuXwTMhLQd
+			var gauJumtCTW4D3DKeaTcvTeLkrIddKZnghAuq2hknmysixjmTpQSmSGPoyKwaDLKjGfAF4wMV0JlYOMxGqdNo6GIwt8LRY1omSVXiBA8hNWbkA tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(83))
+			_ = gauJumtCTW4D3DKeaTcvTeLkrIddKZnghAuq2hknmysixjmTpQSmSGPoyKwaDLKjGfAF4wMV0JlYOMxGqdNo6GIwt8LRY1omSVXiBA8hNWbkA
+			var qWNNS2K1xhyu5oyFyGU5kWdP2gK8Z2PA9gJN1hQwSn7zkqYFQ8J8 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(84))
+			_ = qWNNS2K1xhyu5oyFyGU5kWdP2gK8Z2PA9gJN1hQwSn7zkqYFQ8J8
+			var mlKKyFJSUsJhVVh3xOMqRAapsOk4IjX9oRlORM7M9J1NeuKlkvsunu48fQHmlau61pRMeBYcUJgozKjWpbkG3dQ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(85))
+			_ = mlKKyFJSUsJhVVh3xOMqRAapsOk4IjX9oRlORM7M9J1NeuKlkvsunu48fQHmlau61pRMeBYcUJgozKjWpbkG3dQ
+			var kcArELuTjGw0bL9h9XLmLPqW7Teo6le9eYBLzUraYVYvJg0 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(86))
+			_ = kcArELuTjGw0bL9h9XLmLPqW7Teo6le9eYBLzUraYVYvJg0
+			var djgwfpKUIraZ7Ix2wm3vMZnGhkqrve07n6P79DEpuFvGSXQ255jNVFYNSynFrWgr3jAjIIT tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(87))
+			_ = djgwfpKUIraZ7Ix2wm3vMZnGhkqrve07n6P79DEpuFvGSXQ255jNVFYNSynFrWgr3jAjIIT
+			var p2s2nyv7cNCYbijxHjEsWoJn01g92V263GLCOuZHFEZyj6aKO7DJypSazbnJ3yiLYNrr3ZQOQdq6pCMNvG84ljHizSvdfqt3s8cxBft9QAeuJMNS6w1UU tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(88))
+			_ = p2s2nyv7cNCYbijxHjEsWoJn01g92V263GLCOuZHFEZyj6aKO7DJypSazbnJ3yiLYNrr3ZQOQdq6pCMNvG84ljHizSvdfqt3s8cxBft9QAeuJMNS6w1UU
+			var jQZqVEZPh14ngahWR6bJ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(89))
+			_ = jQZqVEZPh14ngahWR6bJ
+			var vU54xC5FxTB6cG1Nag5lsTPaeGIUhhqDShwkO8fHer4iNCwJ7na8 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(90))
+			_ = vU54xC5FxTB6cG1Nag5lsTPaeGIUhhqDShwkO8fHer4iNCwJ7na8
+			var gbXqnnPs1dudzBCWUFdVAlN70yKS50OZayTTtMOf30cfYsUJBppwvOEyzetOp9NFzR6H27sPufjk8R tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(91))
+			_ = gbXqnnPs1dudzBCWUFdVAlN70yKS50OZayTTtMOf30cfYsUJBppwvOEyzetOp9NFzR6H27sPufjk8R
+			var pa9fCpVXPR8wRMoPrpentu7s7fs tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(92))
+			_ = pa9fCpVXPR8wRMoPrpentu7s7fs
+			var tNWcHI4ukF8QG5xNRvFm tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(93))
+			_ = tNWcHI4ukF8QG5xNRvFm
+			var dhInHXJhVwccgOdwTXVLGIIljRDyP7eGziKx2DOC2dMNBwVCw6Etdho8SEdV3lNHpBcVWt3zrVB tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(94))
+			_ = dhInHXJhVwccgOdwTXVLGIIljRDyP7eGziKx2DOC2dMNBwVCw6Etdho8SEdV3lNHpBcVWt3zrVB
+			var bHg9fmrcSY1un4rNZHEi4i4IQq5V5Quxcm0QOUlIojlDDJvRX4QN8FBBcxrPBzUME6A1JJsjahpPanCqHP9KxTtZDiLFZAUUrYDguy8M4m3YcwQkO8Yj8 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(95))
+			_ = bHg9fmrcSY1un4rNZHEi4i4IQq5V5Quxcm0QOUlIojlDDJvRX4QN8FBBcxrPBzUME6A1JJsjahpPanCqHP9KxTtZDiLFZAUUrYDguy8M4m3YcwQkO8Yj8
+			var sHxuNtsEoXD9ldJSqGWa5J9x414RoC0CERcKyQTobMr2PO3WS2V0WLKHn7YL tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(96))
+			_ = sHxuNtsEoXD9ldJSqGWa5J9x414RoC0CERcKyQTobMr2PO3WS2V0WLKHn7YL
+			var qs7zFQE2EK3XeQjsriWsExAGrC18p0XX6bMbKNFbMgMqP tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(97))
+			_ = qs7zFQE2EK3XeQjsriWsExAGrC18p0XX6bMbKNFbMgMqP
+			var uzR6cdmOMb3oKB332RJmoMAbkJT8W5dBkq83FQif64Zx tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(98))
+			_ = uzR6cdmOMb3oKB332RJmoMAbkJT8W5dBkq83FQif64Zx
+			var wanyIzeRPINssefDuxC6oZSqTXdtlAVQ63f1VOK4KIgEDlnT68IuB5hJS7fyCoc7HkaRKhkaPYv7heY1pxdWZin8jMp tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(99))
+			_ = wanyIzeRPINssefDuxC6oZSqTXdtlAVQ63f1VOK4KIgEDlnT68IuB5hJS7fyCoc7HkaRKhkaPYv7heY1pxdWZin8jMp
+			var bhPhVYyD9iBbZ3SjuZEA7XAnmQGgru2Y8mqSqbhgcf2yZIiqTN3vikA4FZOEU7mAe5SVp0cZH tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(100))
+			_ = bhPhVYyD9iBbZ3SjuZEA7XAnmQGgru2Y8mqSqbhgcf2yZIiqTN3vikA4FZOEU7mAe5SVp0cZH
+			var n4UO2hD40hwjB1i5TQ6NvpSbv99v4ZfeSJLM5ajG5cbUfewjv9Jzse7ssXXf9fbOJmZ5m4Z tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(101))
+			_ = n4UO2hD40hwjB1i5TQ6NvpSbv99v4ZfeSJLM5ajG5cbUfewjv9Jzse7ssXXf9fbOJmZ5m4Z
+			var n38jwcbXgULpJV21mZUYWedX8DxT4x tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(102))
+			_ = n38jwcbXgULpJV21mZUYWedX8DxT4x
+			var e52VdhC9irySRc6baYWJWqctknfa2SocXH6s3WXD68yxyDsthJl3OxCJd8JM9ZumFjsjwOG3Kdy tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(103))
+			_ = e52VdhC9irySRc6baYWJWqctknfa2SocXH6s3WXD68yxyDsthJl3OxCJd8JM9ZumFjsjwOG3Kdy
+			var nllszb9hx7o2DpH9VgtKXbbbConb6qZkVE51FT tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(104))
+			_ = nllszb9hx7o2DpH9VgtKXbbbConb6qZkVE51FT
+			var oyarDkIO1XeNWlRTWPoDsk1rDzx2KhOGK4xnI3CjESXu tla.TLAValue = args8[1]
+			_ = oyarDkIO1XeNWlRTWPoDsk1rDzx2KhOGK4xnI3CjESXu
+			var lAPrrMqrU4ntxPtOdkQigX04o9A1UhGZEbuFmCpVZXsEtPOamE6NNhWjuNSx4u9QGUQi1eGldztPwD9aM6xX3 tla.TLAValue = args8[2]
+			_ = lAPrrMqrU4ntxPtOdkQigX04o9A1UhGZEbuFmCpVZXsEtPOamE6NNhWjuNSx4u9QGUQi1eGldztPwD9aM6xX3
+			var au1cdpoEAC9qpKhX95muLa7UHtd7Tc5ClX8RPdqrx1VnwB73UWLmobCcRUJ00MykuKUNvxqaBeCpgLJazYJJbWr tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(1))
+			_ = au1cdpoEAC9qpKhX95muLa7UHtd7Tc5ClX8RPdqrx1VnwB73UWLmobCcRUJ00MykuKUNvxqaBeCpgLJazYJJbWr
+			var hzLz47gO3PXjsufbylWIR4mTHq6vCNacxkbhBUoa0nuwsT5E0n5U6PWFkkZirl8ZEixM99Ewdj05HvHwxslZDN8t585781qX3z4hXVOiRp8FFp tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(2))
+			_ = hzLz47gO3PXjsufbylWIR4mTHq6vCNacxkbhBUoa0nuwsT5E0n5U6PWFkkZirl8ZEixM99Ewdj05HvHwxslZDN8t585781qX3z4hXVOiRp8FFp
+			var aU4LYhjGn4d5XsRLPIvK1FIAX8qwM7MFxxS0GcitvGAqZBZLoYBvMXuuBG42WI6pmGhYUiIlr4i24KUBKY5jTmtsWblOCQiDYpqoN9FGwB1RqHEi27 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(3))
+			_ = aU4LYhjGn4d5XsRLPIvK1FIAX8qwM7MFxxS0GcitvGAqZBZLoYBvMXuuBG42WI6pmGhYUiIlr4i24KUBKY5jTmtsWblOCQiDYpqoN9FGwB1RqHEi27
+			var uHl2tqdxD22AMbjUnwvSeSNc9Nv9tmlqWYHct9ZAB51iJCSOrr4FTfoAjoxk0y9uqsv tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(4))
+			_ = uHl2tqdxD22AMbjUnwvSeSNc9Nv9tmlqWYHct9ZAB51iJCSOrr4FTfoAjoxk0y9uqsv
+			var oguxOV5fEaapPZjnfKLbtbeZv1eTdeiMpNhIJvQHkSZCLsLXSHoWa4DY2cwSpkVCXRO7qzQNz9f tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(5))
+			_ = oguxOV5fEaapPZjnfKLbtbeZv1eTdeiMpNhIJvQHkSZCLsLXSHoWa4DY2cwSpkVCXRO7qzQNz9f
+			var hbUt0AZXXz6LYtOO tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(6))
+			_ = hbUt0AZXXz6LYtOO
+			var lgSJuN1uQn6SwALwXUaWvaa8Zy9DNKHfl3HuugQfYRp tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(7))
+			_ = lgSJuN1uQn6SwALwXUaWvaa8Zy9DNKHfl3HuugQfYRp
+			var bd303Ubr6ck750RJxkGKLfD0mIyg6lNIErBRyc4gPUKk8QHXPlFz5gEAcDERgyVwPGAb0tfNnIQkLRLK3ZQJYhdIPnAPeCT5b2L tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(8))
+			_ = bd303Ubr6ck750RJxkGKLfD0mIyg6lNIErBRyc4gPUKk8QHXPlFz5gEAcDERgyVwPGAb0tfNnIQkLRLK3ZQJYhdIPnAPeCT5b2L
+			var ex6IHT0FvHtvyWDlLMD2sMcgH2S7HkcG7tRQEwoUhHUqNALJkiy8mT3M4zLKrTYPYtAGp0UpNuHzbt9SvmywcdGad0u109AEuZVMiEDvFdAZ3elZI tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(9))
+			_ = ex6IHT0FvHtvyWDlLMD2sMcgH2S7HkcG7tRQEwoUhHUqNALJkiy8mT3M4zLKrTYPYtAGp0UpNuHzbt9SvmywcdGad0u109AEuZVMiEDvFdAZ3elZI
+			var mpQxvepo9RZldQx6ztwSteOnGnkDpRRYxuUcT22pJpoj2URIhiYoxFhHdMb667D3OlpFad00IUo7YnDZ2ksaKtDSjBxVkWyx9w5yUs0fr tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(10))
+			_ = mpQxvepo9RZldQx6ztwSteOnGnkDpRRYxuUcT22pJpoj2URIhiYoxFhHdMb667D3OlpFad00IUo7YnDZ2ksaKtDSjBxVkWyx9w5yUs0fr
+			var pN0k58Og1pFVER11g9ZjuYo6GM tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(11))
+			_ = pN0k58Og1pFVER11g9ZjuYo6GM
+			var c5Xdkp5vWkWtDWMFi8S2JRBrztq8TuLtRJ7HFYf5c9jKH4kphxkGnf6LjIoSii24syOzTl8Or2WiXQBOsGSqlQzIuodyPW22KBNDNR2IO7u tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(12))
+			_ = c5Xdkp5vWkWtDWMFi8S2JRBrztq8TuLtRJ7HFYf5c9jKH4kphxkGnf6LjIoSii24syOzTl8Or2WiXQBOsGSqlQzIuodyPW22KBNDNR2IO7u
+			var a5q0T6qoZGsD tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(13))
+			_ = a5q0T6qoZGsD
+			var x5agC7yGKNdin6gg9mjdKORWIMAtNVUPA2McZGoND7O0c3bvExJ tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(14))
+			_ = x5agC7yGKNdin6gg9mjdKORWIMAtNVUPA2McZGoND7O0c3bvExJ
+			var qa5L9ldSLlxfhlJUykYTQwrtgjc5e3qNd0iYIzEbJLm1Z4eSpNmWr0OI8oYNJ tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(15))
+			_ = qa5L9ldSLlxfhlJUykYTQwrtgjc5e3qNd0iYIzEbJLm1Z4eSpNmWr0OI8oYNJ
+			var pvNAMZqvzbiLS05Iv1yiNpYlOLbDjkk0pdXHdIP tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(16))
+			_ = pvNAMZqvzbiLS05Iv1yiNpYlOLbDjkk0pdXHdIP
+			var qxbM0ySqkdzWhn6gaROcgBgxFwsaY7adHaF3zhWU3qvyZMiXDYvhlbWBjZSc6nCHr8DLrsWqkB1ZScZHg8LjT4uJqajwOdLZpq4pEzU6a6Gc tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(17))
+			_ = qxbM0ySqkdzWhn6gaROcgBgxFwsaY7adHaF3zhWU3qvyZMiXDYvhlbWBjZSc6nCHr8DLrsWqkB1ZScZHg8LjT4uJqajwOdLZpq4pEzU6a6Gc
+			var jbOiq5HMFD8cif9XiUh6hnhIT8YiZRNR5GZw9OypWmdXVYJepnHvBmXomux8psyO3wxhnQIbsScWDSENdLfGEnqt4FFPG tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(18))
+			_ = jbOiq5HMFD8cif9XiUh6hnhIT8YiZRNR5GZw9OypWmdXVYJepnHvBmXomux8psyO3wxhnQIbsScWDSENdLfGEnqt4FFPG
+			var t9NNl tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(19))
+			_ = t9NNl
+			var fVzrKexwJierBG3hrZ23gwGhpFJayfb9AVRY9yctyCs1v0eevdU0Tf9sr4PWtDNzC0ShzgWJIAnkD7doIj8WWJIJjln54rx8ePxMH tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(20))
+			_ = fVzrKexwJierBG3hrZ23gwGhpFJayfb9AVRY9yctyCs1v0eevdU0Tf9sr4PWtDNzC0ShzgWJIAnkD7doIj8WWJIJjln54rx8ePxMH
+			var rbYYWIdW8cBhrHYa8Sh4a7WZBIVYLMEMbwictyRa2N44lrijZvcB6 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(21))
+			_ = rbYYWIdW8cBhrHYa8Sh4a7WZBIVYLMEMbwictyRa2N44lrijZvcB6
+			var szsIaKkIkQks2UP6nNmno2w4LjWVnEbO8g1E0Lftg2JPAvdruTprjbguf2bty tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(22))
+			_ = szsIaKkIkQks2UP6nNmno2w4LjWVnEbO8g1E0Lftg2JPAvdruTprjbguf2bty
+			var nfpgB7M0Rilxy58mrAFWru6fZsVLMuymfeOYonnLiXiYVZnwAlK1ciofegFm5M60pk2v6APjYkVibb1tF0 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(23))
+			_ = nfpgB7M0Rilxy58mrAFWru6fZsVLMuymfeOYonnLiXiYVZnwAlK1ciofegFm5M60pk2v6APjYkVibb1tF0
+			var fmfnfeJBnNeOfhWLWmzlliBBK tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(24))
+			_ = fmfnfeJBnNeOfhWLWmzlliBBK
+			var hd00g2nvPGSTW5Mz999GjUaURy2EkwkQ6xvPfWX1W3UNEfmgWOmg3lgpEmVCQi5CzR3asdf9QossCtYY tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(25))
+			_ = hd00g2nvPGSTW5Mz999GjUaURy2EkwkQ6xvPfWX1W3UNEfmgWOmg3lgpEmVCQi5CzR3asdf9QossCtYY
+			var euxA2lDDj2qWZiYTlc1W9QmDMx1g5vx7LxD8h4c8yccTuQb9HAHbgny0QFA88870OURf0URMPbf tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(26))
+			_ = euxA2lDDj2qWZiYTlc1W9QmDMx1g5vx7LxD8h4c8yccTuQb9HAHbgny0QFA88870OURf0URMPbf
+			var xN9TPhSUmZPSl4KWKLpK9QkP6LSFcM9dGokhZrkB32B99q065eQuCNs tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(27))
+			_ = xN9TPhSUmZPSl4KWKLpK9QkP6LSFcM9dGokhZrkB32B99q065eQuCNs
+			var hmNUnDWYdh70I8cTr tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(28))
+			_ = hmNUnDWYdh70I8cTr
+			var oeIqhndp6tenU6QiiB8CUyJSPXjZjQ7Iyg0UKN9OarWDDfdFHKmETPfeVvwvouBxX tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(29))
+			_ = oeIqhndp6tenU6QiiB8CUyJSPXjZjQ7Iyg0UKN9OarWDDfdFHKmETPfeVvwvouBxX
+			var vzc6KpuDfomthXJ2IhgCi tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(30))
+			_ = vzc6KpuDfomthXJ2IhgCi
+			var dWU7d2r0QU74gm7LRVanCRdMemPqnHnO4dI06kxq3sVNkgjp tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(31))
+			_ = dWU7d2r0QU74gm7LRVanCRdMemPqnHnO4dI06kxq3sVNkgjp
+			var b95EGSgn8LhXHgWBXEjm7l2S0lz8uFa2Tz5wEdR4GlXgxiklVgi5pJ7zOxpIRfgrbaPIWGNjjM9e5i7Ddi5sYrUi8iZGlleLgqBEGvYyNCYhD tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(32))
+			_ = b95EGSgn8LhXHgWBXEjm7l2S0lz8uFa2Tz5wEdR4GlXgxiklVgi5pJ7zOxpIRfgrbaPIWGNjjM9e5i7Ddi5sYrUi8iZGlleLgqBEGvYyNCYhD
+			var aoNi5aMtn1gZFOQydE8PHVHEUL16pLenad3darEb4fkWCx7ullptyfy8luXS3RmT3fQu tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(33))
+			_ = aoNi5aMtn1gZFOQydE8PHVHEUL16pLenad3darEb4fkWCx7ullptyfy8luXS3RmT3fQu
+			var pFCOBMSUVeGJ2jEAb0rOPnAC9cJMtkPFuFgef8yyN5sGXeM1BhKXjlvYCBpJYYeGwHbsnK3PSXQn4nlO74xoGSw1Oh tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(34))
+			_ = pFCOBMSUVeGJ2jEAb0rOPnAC9cJMtkPFuFgef8yyN5sGXeM1BhKXjlvYCBpJYYeGwHbsnK3PSXQn4nlO74xoGSw1Oh
+			var cXhaD65hxsJtJPq5qlZElRs96ZAjMkhy9YmiNA2zXg1F9FYTcVccJPRuNhN0VDQcQ9ziyK21RtjEan0MSPAuRX9eZEGau1GSQyo3nJjsllxE tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(35))
+			_ = cXhaD65hxsJtJPq5qlZElRs96ZAjMkhy9YmiNA2zXg1F9FYTcVccJPRuNhN0VDQcQ9ziyK21RtjEan0MSPAuRX9eZEGau1GSQyo3nJjsllxE
+			var bW2468OtxPktGLK4MVXJzYaPqN0OLpxcOkCOsA8X2mL1jfOjgFei7h0ULoVFzxyUV3W5IyY6mvsmTGkjZPQlV15N4Wp613fGA3ZYtbEoH1j2M tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(36))
+			_ = bW2468OtxPktGLK4MVXJzYaPqN0OLpxcOkCOsA8X2mL1jfOjgFei7h0ULoVFzxyUV3W5IyY6mvsmTGkjZPQlV15N4Wp613fGA3ZYtbEoH1j2M
+			var wQVnYM9Z8c4CweQoHvFBVAggPszqqY4XQrgiIt6vstYAaLO6KVlIZY5xBWvI2GRs3YKCuYZ4u6B7k9MIXO4TcvYJh3qYXd324LZT9BRXCcmHMWlh tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(37))
+			_ = wQVnYM9Z8c4CweQoHvFBVAggPszqqY4XQrgiIt6vstYAaLO6KVlIZY5xBWvI2GRs3YKCuYZ4u6B7k9MIXO4TcvYJh3qYXd324LZT9BRXCcmHMWlh
+			var tYV6nVFRhrhf5fAA1M21yImOpdfAqFtobbpJJAUA0AJrAGv90DU7j87Up68NxVL2F2jisMWFfdgBKRzemF9Hl7HEpEXyrpsvp6khqV0NGayJ5QpmpykI tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(38))
+			_ = tYV6nVFRhrhf5fAA1M21yImOpdfAqFtobbpJJAUA0AJrAGv90DU7j87Up68NxVL2F2jisMWFfdgBKRzemF9Hl7HEpEXyrpsvp6khqV0NGayJ5QpmpykI
+			var ruhYsMuvLQfrnoa0mltlCEArVUQXeeKJA26ixaF9QoTfXuwC7ISPYThs8GpoIM5cL0Sy8M9klWTYbZ8SbGyFjM tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(39))
+			_ = ruhYsMuvLQfrnoa0mltlCEArVUQXeeKJA26ixaF9QoTfXuwC7ISPYThs8GpoIM5cL0Sy8M9klWTYbZ8SbGyFjM
+			var x3RlxtzukPGmwZJPSUlaAi tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(40))
+			_ = x3RlxtzukPGmwZJPSUlaAi
+			var oHlbHqPxYDpIj5g tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(41))
+			_ = oHlbHqPxYDpIj5g
+			var hTThjtHRV95wozaOIvq4tthTyiw9kMpSv27JBcvfy8AYIOiOVj83x08xCuq5FSm tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(42))
+			_ = hTThjtHRV95wozaOIvq4tthTyiw9kMpSv27JBcvfy8AYIOiOVj83x08xCuq5FSm
+			var xpb9kfTJuMJbPksb9kT3XvDlzNA16f063WS96oU1SA6UY8I9V21gDGW7ErDpecGIxIqTjKAlRPZJ2b6B5sLfm9iDyjYUA9HAJXzmwnZa2e4Uu tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(43))
+			_ = xpb9kfTJuMJbPksb9kT3XvDlzNA16f063WS96oU1SA6UY8I9V21gDGW7ErDpecGIxIqTjKAlRPZJ2b6B5sLfm9iDyjYUA9HAJXzmwnZa2e4Uu
+			var tqUcmTzXE3Fz7dBRQ9NGCSSle85yvc0bt7fbiKLkEI5iY6iQ0 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(44))
+			_ = tqUcmTzXE3Fz7dBRQ9NGCSSle85yvc0bt7fbiKLkEI5iY6iQ0
+			var iO2zm2YrS6dQSq5auWwaMgTUICDYC4QpF7L89l3NI9MNDej4SfAHgbsoN0YbxMGd2nqo2xuWReMIhXU3DWseoO6R tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(45))
+			_ = iO2zm2YrS6dQSq5auWwaMgTUICDYC4QpF7L89l3NI9MNDej4SfAHgbsoN0YbxMGd2nqo2xuWReMIhXU3DWseoO6R
+			var xuOYHgPWe3HahKfxUK1AgvlT0P31iNMf4c1ljBJ27MCl3lcy7POJ2 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(46))
+			_ = xuOYHgPWe3HahKfxUK1AgvlT0P31iNMf4c1ljBJ27MCl3lcy7POJ2
+			var mTi4I31HoXGf0jefw3zQmQY01GpYftoHZnDN tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(47))
+			_ = mTi4I31HoXGf0jefw3zQmQY01GpYftoHZnDN
+			var l6n46iVh1ybtF5TgV1N1Tds tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(48))
+			_ = l6n46iVh1ybtF5TgV1N1Tds
+			var gvHMDcRUkO0hrJ9xA29NHUz7DxdxTpWMc3aN8zkFziGh77Zn2EPNulzkvaayt2EAtLBnVHyLMxqxGzkvhI4b4q50EVcN tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(49))
+			_ = gvHMDcRUkO0hrJ9xA29NHUz7DxdxTpWMc3aN8zkFziGh77Zn2EPNulzkvaayt2EAtLBnVHyLMxqxGzkvhI4b4q50EVcN
+			var aRypQCmRaaZJwspACFjZEfGvhNPPmdgKBpxPeZ8w7QnaguWpL tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(50))
+			_ = aRypQCmRaaZJwspACFjZEfGvhNPPmdgKBpxPeZ8w7QnaguWpL
+			var c3 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(51))
+			_ = c3
+			var zV5AYeIhWyXMVf7atiSDikFU2ExF1GMMVnyofEuOaHsVTlfAD tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(52))
+			_ = zV5AYeIhWyXMVf7atiSDikFU2ExF1GMMVnyofEuOaHsVTlfAD
+			var coB5hQkxVjx57nec1adAag4F64VJm4SUg6aG5WvGPF1glkmeSCA8H6BiIkDSbrSz7PyDDDjMGItuLXnQASXzDbfj8fXcRd8uWKkoQD tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(53))
+			_ = coB5hQkxVjx57nec1adAag4F64VJm4SUg6aG5WvGPF1glkmeSCA8H6BiIkDSbrSz7PyDDDjMGItuLXnQASXzDbfj8fXcRd8uWKkoQD
+			var oPYzOm2t0FUGe9PmrVWcS7yfQyQNad4sC2SkfPRecH tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(54))
+			_ = oPYzOm2t0FUGe9PmrVWcS7yfQyQNad4sC2SkfPRecH
+			var ssV3KBNB2gZWZJYcmG5HDN1zz8du54zyIRSncFti1rT6jaDzT tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(55))
+			_ = ssV3KBNB2gZWZJYcmG5HDN1zz8du54zyIRSncFti1rT6jaDzT
+			var u6huSF0Ic9nxwLrUuk7M tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(56))
+			_ = u6huSF0Ic9nxwLrUuk7M
+			var zzxYCGcM1GxKfVkCPJTRTFnRpesl6SHRdErcTuXrPbjuzef7OYOkvgN tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(57))
+			_ = zzxYCGcM1GxKfVkCPJTRTFnRpesl6SHRdErcTuXrPbjuzef7OYOkvgN
+			var wArDmuwkH5AI6uMKHTYD2UTkSWvnMed tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(58))
+			_ = wArDmuwkH5AI6uMKHTYD2UTkSWvnMed
+			var oZqiIRaDJ6he5iya2HKw8Z0biC5qyr0TOOrpDP29mTi8OCK6ah9UoN0TpYYrxveSYTzOf0QQr5br7mTOmqDikHfIo7Bb6rDDV tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(59))
+			_ = oZqiIRaDJ6he5iya2HKw8Z0biC5qyr0TOOrpDP29mTi8OCK6ah9UoN0TpYYrxveSYTzOf0QQr5br7mTOmqDikHfIo7Bb6rDDV
+			var fwwceNk1mOejco6Xz85jHol7NovhmD2wy7fZchBoB6KWyQuNYV8n7rqs85GWqPQ9SrGwI tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(60))
+			_ = fwwceNk1mOejco6Xz85jHol7NovhmD2wy7fZchBoB6KWyQuNYV8n7rqs85GWqPQ9SrGwI
+			var g4htEEA2wh2U9wJoiLA5DATskRAbuj6Gb05gM4voIUqN1nOTr68ay8RzNEHuZUGVFnTKa0Nxw9YI9SeoQylB tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(61))
+			_ = g4htEEA2wh2U9wJoiLA5DATskRAbuj6Gb05gM4voIUqN1nOTr68ay8RzNEHuZUGVFnTKa0Nxw9YI9SeoQylB
+			var csAir1tWqElRZGz7r5STeer tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(62))
+			_ = csAir1tWqElRZGz7r5STeer
+			var kBzGPNkjLRYoCtggPUYD1yG2GhP3MO tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(63))
+			_ = kBzGPNkjLRYoCtggPUYD1yG2GhP3MO
+			var pptW9yQU7hmSA9JC8GGDfvwaLWG5I8IYP9PFf2jOY7H7EyEdY1Nlt6E tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(64))
+			_ = pptW9yQU7hmSA9JC8GGDfvwaLWG5I8IYP9PFf2jOY7H7EyEdY1Nlt6E
+			var ynbXCelNrwTY65CpQJIlgq1A2XDtiALVD6TrWR1VlsvUMcPQb5e924lQ3 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(65))
+			_ = ynbXCelNrwTY65CpQJIlgq1A2XDtiALVD6TrWR1VlsvUMcPQb5e924lQ3
+			var iEPaaa63QPMjE1NIlGTtN1GqgSR4Hu365kqi6omVfUPMoojXkmiP1YwGAfTHK70V4IQRrfxHf3UZy6jmmeart2irbIIaPgHPZBK tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(66))
+			_ = iEPaaa63QPMjE1NIlGTtN1GqgSR4Hu365kqi6omVfUPMoojXkmiP1YwGAfTHK70V4IQRrfxHf3UZy6jmmeart2irbIIaPgHPZBK
+			var bOZqBs0VirPBezxCopGvk73IvlxVrzHII4YYSK5RZpmY tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(67))
+			_ = bOZqBs0VirPBezxCopGvk73IvlxVrzHII4YYSK5RZpmY
+			var thlGZFI9F7D8alAGPYfelIjpHjP tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(68))
+			_ = thlGZFI9F7D8alAGPYfelIjpHjP
+			var uFVon832WvRB612oHBxrqdG1xJSE7e9oMIK0OPHSbkEWzZSOqCg8WbMpG9ta7iOSaHs1z1cmM03rSqbqUjMjNDpIaA4vO7UcA5UsJFJ6Gn26FaHLYBVlmA tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(69))
+			_ = uFVon832WvRB612oHBxrqdG1xJSE7e9oMIK0OPHSbkEWzZSOqCg8WbMpG9ta7iOSaHs1z1cmM03rSqbqUjMjNDpIaA4vO7UcA5UsJFJ6Gn26FaHLYBVlmA
+			var pL25wa2HlFiZjpUjv00 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(70))
+			_ = pL25wa2HlFiZjpUjv00
+			var jxT5r6zS8pIPHkWM1qcSje tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(71))
+			_ = jxT5r6zS8pIPHkWM1qcSje
+			var mz4NalYEJpSDSylMyMWsA4KMiAT6NxI4GqTk8KqhAuBqx4MfDyVEw1j2l9JfB7WDeC9Tt7w2AKXsaTRG15uoaoF3Nsk tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(72))
+			_ = mz4NalYEJpSDSylMyMWsA4KMiAT6NxI4GqTk8KqhAuBqx4MfDyVEw1j2l9JfB7WDeC9Tt7w2AKXsaTRG15uoaoF3Nsk
+			var hhjpozL5792SYbOhatWEA7wbfMHgq07cBpgeq2Uv5cuz8lL4nJ2MmfNosM4dPvO tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(73))
+			_ = hhjpozL5792SYbOhatWEA7wbfMHgq07cBpgeq2Uv5cuz8lL4nJ2MmfNosM4dPvO
+			var tX1XLTTnft460ppN tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(74))
+			_ = tX1XLTTnft460ppN
+			var e tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(75))
+			_ = e
+			var t9aMaFibK1tlkCbGxyUcSIQ8ur2fcdGXCVQlUZ3ByHRtNJMusam5kFGTu tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(76))
+			_ = t9aMaFibK1tlkCbGxyUcSIQ8ur2fcdGXCVQlUZ3ByHRtNJMusam5kFGTu
+			var sHHNQe8qgrlR7B9uRDru3D tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(77))
+			_ = sHHNQe8qgrlR7B9uRDru3D
+			var noBLCLFjLNfYpNVney3NI5ELR9NiCoG1NC95WUgXNql2C3uf3nm3AfjrtRd3EzJCnTMF6CazWMhrT1VEAGa0ZjrkZU9hnKeP tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(78))
+			_ = noBLCLFjLNfYpNVney3NI5ELR9NiCoG1NC95WUgXNql2C3uf3nm3AfjrtRd3EzJCnTMF6CazWMhrT1VEAGa0ZjrkZU9hnKeP
+			var i0yLReUUPcRXM2nHgrFWdb3tu4tbfNF9utSUn5urbOKktRgAIGfisfy9wni3V2QVpDGlrSwDqK73XPOhYZuLEJ tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(79))
+			_ = i0yLReUUPcRXM2nHgrFWdb3tu4tbfNF9utSUn5urbOKktRgAIGfisfy9wni3V2QVpDGlrSwDqK73XPOhYZuLEJ
+			var v7WDS7D0Asy4gggWVjoDCg1TYfKZZ6xPTLV6tbKMhBOhxq5qqoBlDhRQLTcLDYdNlk tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(80))
+			_ = v7WDS7D0Asy4gggWVjoDCg1TYfKZZ6xPTLV6tbKMhBOhxq5qqoBlDhRQLTcLDYdNlk
+			var oR3RvtjYHGIlTqE5iuwri3poF5QMU9uv5oAnF6bp7tafQJyvCC4kOkjTjvil1iPS tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(81))
+			_ = oR3RvtjYHGIlTqE5iuwri3poF5QMU9uv5oAnF6bp7tafQJyvCC4kOkjTjvil1iPS
+			var pHcnP0YYWa0i5MobPSWsGCPZKqMaCQV0sGoRMpXRqdTJgzMoYVYm5yqcTi0H0lFjo0Ny7OzlBWDtBmM7oX tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(82))
+			_ = pHcnP0YYWa0i5MobPSWsGCPZKqMaCQV0sGoRMpXRqdTJgzMoYVYm5yqcTi0H0lFjo0Ny7OzlBWDtBmM7oX
+			var tM6lLFnDkCLrBYxLRIcH9nFLmErv5pxlvFlSWOE4I3zDK5OIWIxs1DOp4JP6i64WwdL6uU5gTgfSIHK0Yty8MsfmOK4k58J8ViVB4yvkh320FGkYDojT tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(83))
+			_ = tM6lLFnDkCLrBYxLRIcH9nFLmErv5pxlvFlSWOE4I3zDK5OIWIxs1DOp4JP6i64WwdL6uU5gTgfSIHK0Yty8MsfmOK4k58J8ViVB4yvkh320FGkYDojT
+			var lP52N22FlaSMD8t5esKZIHHkp5rUgjqwL7DR59zBIF5uUklPD2dhpTRhanQa1Vm8MHQRlrmnXsUn2oAb1mPLQLjeMRYZwy tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(84))
+			_ = lP52N22FlaSMD8t5esKZIHHkp5rUgjqwL7DR59zBIF5uUklPD2dhpTRhanQa1Vm8MHQRlrmnXsUn2oAb1mPLQLjeMRYZwy
+			var xDvXYYCkOwNg57 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(85))
+			_ = xDvXYYCkOwNg57
+			var akl5Dks3ctrKj3h7YukzwDX0SLOTZrW tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(86))
+			_ = akl5Dks3ctrKj3h7YukzwDX0SLOTZrW
+			var d4wNzZGpOMK tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(87))
+			_ = d4wNzZGpOMK
+			var ha8qMdi8HkZFnIaCdfkKX7Inj tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(88))
+			_ = ha8qMdi8HkZFnIaCdfkKX7Inj
+			var sN1WU5MRkGQnF9tdlSqVAxs6LmVvtPK7RvwZ2O6CIwFNYCJqipTcqtl3dl2JRw8Y tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(89))
+			_ = sN1WU5MRkGQnF9tdlSqVAxs6LmVvtPK7RvwZ2O6CIwFNYCJqipTcqtl3dl2JRw8Y
+			var hekB4NOymzpTl2en8ijXe2QGIcwTYOaY8IVWGjrOCVj6Gt0mEmA62waj3GEg tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(90))
+			_ = hekB4NOymzpTl2en8ijXe2QGIcwTYOaY8IVWGjrOCVj6Gt0mEmA62waj3GEg
+			var cAfltU1qEbnZT7VGxOO8J4N5EK4gbe0FtHzg37bfquF8RByYx4Wp1z0W8yVtUEMhFmEXYBKFjMDnnmt8ScsdU3iJrxQ tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(91))
+			_ = cAfltU1qEbnZT7VGxOO8J4N5EK4gbe0FtHzg37bfquF8RByYx4Wp1z0W8yVtUEMhFmEXYBKFjMDnnmt8ScsdU3iJrxQ
+			var hY4W2ZRqgGUZ6xKw28xYEC3HEdVpc2eSL2klMfmWD34CujHUvNSTKd2 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(92))
+			_ = hY4W2ZRqgGUZ6xKw28xYEC3HEdVpc2eSL2klMfmWD34CujHUvNSTKd2
+			var t6HUBcO1CSZiUjI tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(93))
+			_ = t6HUBcO1CSZiUjI
+			var vw4CkbUUyRtxOdyh2QRI792vnv2XjqooFRcxMiOh4Y5luzfz9c3621vC5whx3hALYBU tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(94))
+			_ = vw4CkbUUyRtxOdyh2QRI792vnv2XjqooFRcxMiOh4Y5luzfz9c3621vC5whx3hALYBU
+			var acwEWlB2MhKTsdnEGevebAQdgPgWIlVkuQO0oCv3pavCT4jFYN9FuR530Id5EQqfjJEtkYvjFf32Ac74jbNdz3PgYnzQw81kd53CmPcgCemsg3yil tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(95))
+			_ = acwEWlB2MhKTsdnEGevebAQdgPgWIlVkuQO0oCv3pavCT4jFYN9FuR530Id5EQqfjJEtkYvjFf32Ac74jbNdz3PgYnzQw81kd53CmPcgCemsg3yil
+			var gzIkXtbo3H7JgHPYIJKdNE58lMdJhIgROEDfur3rnH1Xpqtqtr7xZiwgkX9dMR7hmL4e5UAKKLHX4198TWEICTdjSEFRaGVVuhzMb5qvNF9eE tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(96))
+			_ = gzIkXtbo3H7JgHPYIJKdNE58lMdJhIgROEDfur3rnH1Xpqtqtr7xZiwgkX9dMR7hmL4e5UAKKLHX4198TWEICTdjSEFRaGVVuhzMb5qvNF9eE
+			var jhVt4A4uYHqLSfA0jfJZegQEqo20wgvNOU9IEFjoAtBmVVf5ghevcVqSdCP5alx tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(97))
+			_ = jhVt4A4uYHqLSfA0jfJZegQEqo20wgvNOU9IEFjoAtBmVVf5ghevcVqSdCP5alx
+			var oN4oa21whYFm80wv7FqqllOleE60lWjJQuvuqeZeBaIUeOfnRQD3BsSEC6PJhQEKgZmcA39r5r0hyJl2V tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(98))
+			_ = oN4oa21whYFm80wv7FqqllOleE60lWjJQuvuqeZeBaIUeOfnRQD3BsSEC6PJhQEKgZmcA39r5r0hyJl2V
+			var m tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(99))
+			_ = m
+			var mVuGApmAkeqeaONPjiYXjYwIHpliIoz0ngSmGKsBicgoX4ZNYacxVj tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(100))
+			_ = mVuGApmAkeqeaONPjiYXjYwIHpliIoz0ngSmGKsBicgoX4ZNYacxVj
+			var t1l8xFbevbYDYD7b3msZzmGqC76gb0ciBRtssFZDCDHRytV tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(101))
+			_ = t1l8xFbevbYDYD7b3msZzmGqC76gb0ciBRtssFZDCDHRytV
+			var aKOuhdDAK0wxIER tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(102))
+			_ = aKOuhdDAK0wxIER
+			var fgz19tsiZKI2BwG4G08tVrys9iVigGhAdXkeE tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(103))
+			_ = fgz19tsiZKI2BwG4G08tVrys9iVigGhAdXkeE
+			var u42m6 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(104))
+			_ = u42m6
+			var bnJICnFGUBv7h tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(105))
+			_ = bnJICnFGUBv7h
+			var te6GUSAlDbXqoxNqQC6N8F2OOCMQAbJcW2zpzWyKEFyJo3ekpopBWn0TNOcBm7xKrpF3UPXqeff74t8Y6bScZYwqzHWRyS4qE tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(106))
+			_ = te6GUSAlDbXqoxNqQC6N8F2OOCMQAbJcW2zpzWyKEFyJo3ekpopBWn0TNOcBm7xKrpF3UPXqeff74t8Y6bScZYwqzHWRyS4qE
+			var yhxjl4qcuu7hXEyhva4blPVE3QtwsY2r1uhR3lpdO5UAhgqVxbQUhkPGDtbqcb0qip7kEArJP6V95ckFVPmQYMzxcgbFs tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(107))
+			_ = yhxjl4qcuu7hXEyhva4blPVE3QtwsY2r1uhR3lpdO5UAhgqVxbQUhkPGDtbqcb0qip7kEArJP6V95ckFVPmQYMzxcgbFs
+			var pY1D4u6EHqfl970yXrE3DKXnEN9xxtLBuPqVQnl tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(108))
+			_ = pY1D4u6EHqfl970yXrE3DKXnEN9xxtLBuPqVQnl
+			var ekAU4zDRWUqnzAOKcRLmN6tDgXaBtXffZjrc7lqmI82QgSygqhMYjaHGDN8TZ21bIMJzZuPSTEd55MVHCLId5czQGBr tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(109))
+			_ = ekAU4zDRWUqnzAOKcRLmN6tDgXaBtXffZjrc7lqmI82QgSygqhMYjaHGDN8TZ21bIMJzZuPSTEd55MVHCLId5czQGBr
+			var lhHqXinEAJ7m6zgTdg4xjBLTz448zkE3IUwdlz9LCLoqBomJlPU5rE1KKncXigFmHWm6TsGYzqgpcemODayBxJp8UmsyirHrP tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(110))
+			_ = lhHqXinEAJ7m6zgTdg4xjBLTz448zkE3IUwdlz9LCLoqBomJlPU5rE1KKncXigFmHWm6TsGYzqgpcemODayBxJp8UmsyirHrP
+			var sTP979YCAaiYZ0X8hHYXziqQg0fG7ufxcWoQX3PvhZiQJTRrj34T tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(111))
+			_ = sTP979YCAaiYZ0X8hHYXziqQg0fG7ufxcWoQX3PvhZiQJTRrj34T
+			var iVr tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(112))
+			_ = iVr
+			var fGuFtW5imhaTKaFAoHHnoAjGPd3cd tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(113))
+			_ = fGuFtW5imhaTKaFAoHHnoAjGPd3cd
+			var iQAoyH7xQTmTyvutz99yiDkdW17wy0p0UkM9tClnyfCwFVfXyZ8ykSxTJyny6sHOQdSQHtGspLE8w0AbGXnkMjf8Qs9YrtR86vFr8tDcyB tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(114))
+			_ = iQAoyH7xQTmTyvutz99yiDkdW17wy0p0UkM9tClnyfCwFVfXyZ8ykSxTJyny6sHOQdSQHtGspLE8w0AbGXnkMjf8Qs9YrtR86vFr8tDcyB
+			var nghbg6dp54IHdkATnyFDqhyD7RkJD3VsvpJ0RvZdxkgbTwxHcyPyNtsoVo3bWvBNhPnfi76ZDX5EYF8tSPhNL tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(1))
+			_ = nghbg6dp54IHdkATnyFDqhyD7RkJD3VsvpJ0RvZdxkgbTwxHcyPyNtsoVo3bWvBNhPnfi76ZDX5EYF8tSPhNL
+			var uYElYRh8Us7CWxUYIHrj1Bsv39GpxaB7ZSlyjII5LYSBN3yk tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(2))
+			_ = uYElYRh8Us7CWxUYIHrj1Bsv39GpxaB7ZSlyjII5LYSBN3yk
+			var p0J2xoHWWuEMwzXyG4rHW3cggeKWUGxwOd4mfF9HWLPo5RJSD1tpgNOKVnyCCQwxiGpZvx54iJOHwIKPqjDPRLhooUyzX tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(3))
+			_ = p0J2xoHWWuEMwzXyG4rHW3cggeKWUGxwOd4mfF9HWLPo5RJSD1tpgNOKVnyCCQwxiGpZvx54iJOHwIKPqjDPRLhooUyzX
+			var rIL3VaKXsqSYTq8wMjaYx9oUSwGAuRio3Jt15eHc tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(4))
+			_ = rIL3VaKXsqSYTq8wMjaYx9oUSwGAuRio3Jt15eHc
+			var eLeKq8B2Z8zMAmAdahUhPNJXC16ETOprUXBA5uUJBD tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(5))
+			_ = eLeKq8B2Z8zMAmAdahUhPNJXC16ETOprUXBA5uUJBD
+			var maZXYK2ecLSflBdcV8v1ORf57gXHtyTY0E4JtRbR65zvF7eplLC tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(6))
+			_ = maZXYK2ecLSflBdcV8v1ORf57gXHtyTY0E4JtRbR65zvF7eplLC
+			var jZ471eWPzrlu0iwqFDduMfnr9874RZ90MSKI6qxTbRdMw1sTkEk1yk0eKQ3iW0PywyQ0 tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(7))
+			_ = jZ471eWPzrlu0iwqFDduMfnr9874RZ90MSKI6qxTbRdMw1sTkEk1yk0eKQ3iW0PywyQ0
+			var cNQBsEl4sxU77NuhXKQQqzyC4aude0OMooGjgmABdhhEHO2s9zOXjoQygLdhGtJOkP8mpfx92fOZuHdDauujOh9A tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(8))
+			_ = cNQBsEl4sxU77NuhXKQQqzyC4aude0OMooGjgmABdhhEHO2s9zOXjoQygLdhGtJOkP8mpfx92fOZuHdDauujOh9A
+			var pTlz1MQxM41mkVXq8hVhRGsjXHYZUpFJRmMKhuGhP5Cm5EwJaYWSxWd8GggE6hPheTo9VZr2vR9uNd8PO8 tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(9))
+			_ = pTlz1MQxM41mkVXq8hVhRGsjXHYZUpFJRmMKhuGhP5Cm5EwJaYWSxWd8GggE6hPheTo9VZr2vR9uNd8PO8
+			var opiETaFb5nJEva1Yh9gC4ZdcROtY2kzZp tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(10))
+			_ = opiETaFb5nJEva1Yh9gC4ZdcROtY2kzZp
+			var b1 tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(11))
+			_ = b1
+			var jx0S0Lc5eCgFyUjhMEqQLPwxJ9lGatpMKa1tYXgXylcpzDTg1NMZOKNBnz95dluZSZbCgP9VsANj5OJPEb12daTeTDBWr0 tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(12))
+			_ = jx0S0Lc5eCgFyUjhMEqQLPwxJ9lGatpMKa1tYXgXylcpzDTg1NMZOKNBnz95dluZSZbCgP9VsANj5OJPEb12daTeTDBWr0
+			var eRDj66Urr0kHo5IUL07zojWbwmh7yHQuLAdvBMRi7ckUr4R8Dd9x74qw9aMgZ4RY tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(13))
+			_ = eRDj66Urr0kHo5IUL07zojWbwmh7yHQuLAdvBMRi7ckUr4R8Dd9x74qw9aMgZ4RY
+			var jHCJuRpvLlP2XWPna4KJXtBUDmBLPBPI tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(1))
+			_ = jHCJuRpvLlP2XWPna4KJXtBUDmBLPBPI
+			var sjkow0o6ArFQDmkpwTbgsZG6ygpdxi8akbjEZeHPpnRHHBDrFjUH9sajamY4KI0hnh8Kk6k tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(2))
+			_ = sjkow0o6ArFQDmkpwTbgsZG6ygpdxi8akbjEZeHPpnRHHBDrFjUH9sajamY4KI0hnh8Kk6k
+			var rIDP2bqRc3A0xjwpbq13TNCKutRyb2MGl9c8oi3I2GZ8ybNDXI8zyCibj9Z8lTZG1bWMEVLJoyU4a13mYuU9Ho4Nhb1Ld5WB tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(3))
+			_ = rIDP2bqRc3A0xjwpbq13TNCKutRyb2MGl9c8oi3I2GZ8ybNDXI8zyCibj9Z8lTZG1bWMEVLJoyU4a13mYuU9Ho4Nhb1Ld5WB
+			var ifzK1T19yxR8Q2OkB8w5TS tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(4))
+			_ = ifzK1T19yxR8Q2OkB8w5TS
+			var xfdqXpiAaoeiKF1z1WD5CBPhPZT374WFKWTAf tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(5))
+			_ = xfdqXpiAaoeiKF1z1WD5CBPhPZT374WFKWTAf
+			var dEuIljNP2b4VhbQMKlrCL tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(6))
+			_ = dEuIljNP2b4VhbQMKlrCL
+			var lGa5ZpUL70V1rZklDRIwA3UoMV7Lpm6Il0lPJDav2SPN4MBds4cXXkJlkhVLWciMup4Kq5aiz4gwVV19aHgT7XCDFTsG3tNOy3wd4Sl tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(7))
+			_ = lGa5ZpUL70V1rZklDRIwA3UoMV7Lpm6Il0lPJDav2SPN4MBds4cXXkJlkhVLWciMup4Kq5aiz4gwVV19aHgT7XCDFTsG3tNOy3wd4Sl
+			var l0xNLcyud4OX0CzdQdeSkCfYrzprRjch9IbHun9QZ6pHFRT tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(8))
+			_ = l0xNLcyud4OX0CzdQdeSkCfYrzprRjch9IbHun9QZ6pHFRT
+			var sjnc9Z57XD1wlcG0o29WZyY3abg26rZqRS2nQ2LhiKAr1vEejFmzEmHnvFVjDFUm6q tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(9))
+			_ = sjnc9Z57XD1wlcG0o29WZyY3abg26rZqRS2nQ2LhiKAr1vEejFmzEmHnvFVjDFUm6q
+			var m7kWa1xpnuL7GChtKEKpvUXwU8X93EAPnzZQKtzshJWzjaF2yfhbrmNF6lrixclpc6ySm5XTKBc92pFj34nCtyYKB3jxFUJOpbq51YKEPM6lz tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(10))
+			_ = m7kWa1xpnuL7GChtKEKpvUXwU8X93EAPnzZQKtzshJWzjaF2yfhbrmNF6lrixclpc6ySm5XTKBc92pFj34nCtyYKB3jxFUJOpbq51YKEPM6lz
+			var i0vZZrp0EHBoUP7xCqepbg8Tef0wEjk2XZYqVlZgRE9SRI4rw8AHDolApbenP tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(11))
+			_ = i0vZZrp0EHBoUP7xCqepbg8Tef0wEjk2XZYqVlZgRE9SRI4rw8AHDolApbenP
+			var mZRCbbqhph8PV9bp6GWiXIOzMUBagM tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(12))
+			_ = mZRCbbqhph8PV9bp6GWiXIOzMUBagM
+			var d35vqopBq91c9r4k4vVn3zN tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(13))
+			_ = d35vqopBq91c9r4k4vVn3zN
+			var nsCGq7i4LGAIn9CqSEvJbUaVg6mDAoIBY4ENvkL4tjnkp6QUXe3fDd8oMGSczvoC6U2tsQP7ubGmCNHFOJcfF9HF tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(14))
+			_ = nsCGq7i4LGAIn9CqSEvJbUaVg6mDAoIBY4ENvkL4tjnkp6QUXe3fDd8oMGSczvoC6U2tsQP7ubGmCNHFOJcfF9HF
+			var pCby2iPey6XbkoZNW38ml5P96K9z64cRxnsqBUUbh9Yt6rq09u3O8fLlPdLkSi5owlAEfaYOjyeaM2kZsfRwLUIiixCMEYBXv tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(15))
+			_ = pCby2iPey6XbkoZNW38ml5P96K9z64cRxnsqBUUbh9Yt6rq09u3O8fLlPdLkSi5owlAEfaYOjyeaM2kZsfRwLUIiixCMEYBXv
+			var eWwzs4iwMxN8g0XVp7y3m62L4KAWyMcJlixJhb2kp07Y61TpGwzCUTr4466jnFC4Fakg12ysTq3vt8L7O3OE1kl8gi7Mjm tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(16))
+			_ = eWwzs4iwMxN8g0XVp7y3m62L4KAWyMcJlixJhb2kp07Y61TpGwzCUTr4466jnFC4Fakg12ysTq3vt8L7O3OE1kl8gi7Mjm
+			var uYVxpXjcFvXHEeObGcHL0NhWchmpTzQI05TvoTIwGoxPNozlxZvfW4cd5IAhVpSJKy tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(17))
+			_ = uYVxpXjcFvXHEeObGcHL0NhWchmpTzQI05TvoTIwGoxPNozlxZvfW4cd5IAhVpSJKy
+			var pAfpgBx4lPajChoPENrTCbSk9scUZiEp2kDupsoiZdsBcB94rwvfRZOFMA tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(18))
+			_ = pAfpgBx4lPajChoPENrTCbSk9scUZiEp2kDupsoiZdsBcB94rwvfRZOFMA
+			var xCo2eUJXSgqC1q2zYnJYZ6KwpLYi7uTKdxDBR941JgQDcXjfeZl tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(19))
+			_ = xCo2eUJXSgqC1q2zYnJYZ6KwpLYi7uTKdxDBR941JgQDcXjfeZl
+			var vDL1OiV tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(20))
+			_ = vDL1OiV
+			var mbhhmiDsTEHMLWvv93GyovUTmnPoPlIy2s56iHib8Dn9QlqJiWrdmCmkaIXcBsJQwOIm2ThUX4tPOzqeJCxdwmmkVajC9v3T tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(21))
+			_ = mbhhmiDsTEHMLWvv93GyovUTmnPoPlIy2s56iHib8Dn9QlqJiWrdmCmkaIXcBsJQwOIm2ThUX4tPOzqeJCxdwmmkVajC9v3T
+			var jFY2R54QeauyHVWpnemZ1CN9G2pILgeY2sxuFxqdBigN9UQCmkjeczbFKkMJSU3Tdq6m011oQW tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(22))
+			_ = jFY2R54QeauyHVWpnemZ1CN9G2pILgeY2sxuFxqdBigN9UQCmkjeczbFKkMJSU3Tdq6m011oQW
+			var zoGRR00PrajULFuD72JL8ZgKHO0aJWlJfMtlY tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(23))
+			_ = zoGRR00PrajULFuD72JL8ZgKHO0aJWlJfMtlY
+			var yy5du8I9blKTUxSxhPPqufIzKW7OxC9kf59ETSd tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(24))
+			_ = yy5du8I9blKTUxSxhPPqufIzKW7OxC9kf59ETSd
+			var s7LwiU06 tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(25))
+			_ = s7LwiU06
+			var dm tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(26))
+			_ = dm
+			var q7G7iIUDhARKfIyeRtXTQpySmg8vuzfkhzGEcWAuE32eSQIV5GZKwSLODQTw3p1Iz0vnwltb2gXQmIWk5upw tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(27))
+			_ = q7G7iIUDhARKfIyeRtXTQpySmg8vuzfkhzGEcWAuE32eSQIV5GZKwSLODQTw3p1Iz0vnwltb2gXQmIWk5upw
+			var b7woXaQt5UKYKw3M2anyqRJwHpB2Rw8jB1tBiLvDG5bqajEMPhihdYh2KxFkmBNI tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(28))
+			_ = b7woXaQt5UKYKw3M2anyqRJwHpB2Rw8jB1tBiLvDG5bqajEMPhihdYh2KxFkmBNI
+			var qGMfQzAU2fN6vCyQs18zRjX1pxjPiLksC tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(29))
+			_ = qGMfQzAU2fN6vCyQs18zRjX1pxjPiLksC
+			var udHap4MoGBwMbWe3wfkwHtySrtHxLY7fUmyjYtOczUnO4wn09ksrx tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(30))
+			_ = udHap4MoGBwMbWe3wfkwHtySrtHxLY7fUmyjYtOczUnO4wn09ksrx
+			return qxbM0ySqkdzWhn6gaROcgBgxFwsaY7adHaF3zhWU3qvyZMiXDYvhlbWBjZSc6nCHr8DLrsWqkB1ZScZHg8LjT4uJqajwOdLZpq4pEzU6a6Gc
+		}), tla.MakeTLASet(tla.MakeTLAString("w5:3;Z'1<OF377`;e7=u:'~4.`#h8L|?"), tla.TLA_NegationSymbol(tla.MakeTLARecordSet([]tla.TLARecordField{
+			{tla.MakeTLAString("bIomrUoR"), tla.TLA_Zero},
+		})))}, func(args1 []tla.TLAValue) bool {
+			var o0nT5iaxQ0CGr tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(1))
+			_ = o0nT5iaxQ0CGr
+			var a1FO5HvdyoLWp tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(2))
+			_ = a1FO5HvdyoLWp
+			var uXXYnplomNiSbQyMwj71XmbnXjoqCn7xaeTFp3Y0nzrExBsLGbUwrUpo0J9RVYgX6fWdxm8Awun9t8IfyExFH8hVcZQbKQF tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(3))
+			_ = uXXYnplomNiSbQyMwj71XmbnXjoqCn7xaeTFp3Y0nzrExBsLGbUwrUpo0J9RVYgX6fWdxm8Awun9t8IfyExFH8hVcZQbKQF
+			var bYS4kgzZ4nOkgmotsOdGI tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(4))
+			_ = bYS4kgzZ4nOkgmotsOdGI
+			var eRNekFDZ2hFOjF1mOSbQdtgKf4uATvC11401awr5iaVqflx tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(5))
+			_ = eRNekFDZ2hFOjF1mOSbQdtgKf4uATvC11401awr5iaVqflx
+			var y3IVYD3rstSzgTRUSRywWo87sY tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(6))
+			_ = y3IVYD3rstSzgTRUSRywWo87sY
+			var rv7FErcM1Ds7ASPvuKALqKp1pSCTYmAPkNHHc82cm8BtU3Jzkd5KJpJv3NvxBZ7xe81MfPb09Do4ysrf9c1Jd0khQy tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(7))
+			_ = rv7FErcM1Ds7ASPvuKALqKp1pSCTYmAPkNHHc82cm8BtU3Jzkd5KJpJv3NvxBZ7xe81MfPb09Do4ysrf9c1Jd0khQy
+			var u8ACUTegZQwWN6S0BtW0nCaWyf1MM9sD8AZ7kZ4JQ3 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(8))
+			_ = u8ACUTegZQwWN6S0BtW0nCaWyf1MM9sD8AZ7kZ4JQ3
+			var bWr1vUOkonrb3tl4FpMfCqrq17bzS8yHO8ezMPwI8JHFToHhbFYg2Bo29VhAl4okZ3E2tdbAPafGEs9XQIaXCisjluhFOKXJ tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(9))
+			_ = bWr1vUOkonrb3tl4FpMfCqrq17bzS8yHO8ezMPwI8JHFToHhbFYg2Bo29VhAl4okZ3E2tdbAPafGEs9XQIaXCisjluhFOKXJ
+			var anqLfjgzbrCMXYRJxAXXkPO5OvOiC6Wu8j0 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(10))
+			_ = anqLfjgzbrCMXYRJxAXXkPO5OvOiC6Wu8j0
+			var fxIAZeemBplRLwdQ93dWhFqWhqDu tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(11))
+			_ = fxIAZeemBplRLwdQ93dWhFqWhqDu
+			var yw8m0iZw5MrZwUc9VYfhH9Z8S0zHTLIBHO2QvhuPbF18SqKXdOlFoEIHcz1bqiaEm9VBGKC1546WRwKPoBIjToq0ZDWTcMPw3ACt677DPBJLv tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(12))
+			_ = yw8m0iZw5MrZwUc9VYfhH9Z8S0zHTLIBHO2QvhuPbF18SqKXdOlFoEIHcz1bqiaEm9VBGKC1546WRwKPoBIjToq0ZDWTcMPw3ACt677DPBJLv
+			var nfmF2C4O0nKx1tnuC5oj4yUD9B3MOl01HidhOWzjK0QIGaGStCmskWJFoAKUofP tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(13))
+			_ = nfmF2C4O0nKx1tnuC5oj4yUD9B3MOl01HidhOWzjK0QIGaGStCmskWJFoAKUofP
+			var ut9t7O8avTWOkmc3UnpCqSq tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(14))
+			_ = ut9t7O8avTWOkmc3UnpCqSq
+			var dfpyaWZLUhq2ceW0gvIq4v5isU1jdrDa4hWBgQiF1r2R8uj3N1dYxt9L6zPUH935Rv9WNrDAwElgKeHhcIsHCjEynvhUN7eeQd5obWJLiabzUGIC tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(15))
+			_ = dfpyaWZLUhq2ceW0gvIq4v5isU1jdrDa4hWBgQiF1r2R8uj3N1dYxt9L6zPUH935Rv9WNrDAwElgKeHhcIsHCjEynvhUN7eeQd5obWJLiabzUGIC
+			var utW6miQ1zMgzcDXclCo5d3MSBcxMTsk tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(16))
+			_ = utW6miQ1zMgzcDXclCo5d3MSBcxMTsk
+			var bFtRNuQMtk2fC0WxOCr6AfSmf8plsWY8IpZNUTpKivVZ8zAvU7kE1088gAlKS4CN4YRn7jtCeqemEKctduaM9qVure0WC8VYzGfzEUy4ORF5 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(17))
+			_ = bFtRNuQMtk2fC0WxOCr6AfSmf8plsWY8IpZNUTpKivVZ8zAvU7kE1088gAlKS4CN4YRn7jtCeqemEKctduaM9qVure0WC8VYzGfzEUy4ORF5
+			var iNPnZqZGk3kdG6ja0lJrRMPkrKzlrs3QwSPbmzmneJUNgkUdUXmV9tGqGjq tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(18))
+			_ = iNPnZqZGk3kdG6ja0lJrRMPkrKzlrs3QwSPbmzmneJUNgkUdUXmV9tGqGjq
+			var ytNWqLDRimBiRxTurtIGZkrJiCoNkrRWoqr733yZLDf7h9ouxLJ6U25aXlJoYn1HMvNsF7VBux3PmIWWu3JAX8qWFs5LaQOcyi tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(19))
+			_ = ytNWqLDRimBiRxTurtIGZkrJiCoNkrRWoqr733yZLDf7h9ouxLJ6U25aXlJoYn1HMvNsF7VBux3PmIWWu3JAX8qWFs5LaQOcyi
+			var b9EB03SEP4AYw7Rg1vnalvtiLJ9CzGUGHRsthkyWNOBUf3GjUr6pjgtHeG1Y7RttL1JD0in5KDQvJj05pVr5Y6g14AWr tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(20))
+			_ = b9EB03SEP4AYw7Rg1vnalvtiLJ9CzGUGHRsthkyWNOBUf3GjUr6pjgtHeG1Y7RttL1JD0in5KDQvJj05pVr5Y6g14AWr
+			var quMjqujYWsLRZyV1FQQQZK960gsSk3LrpKrYnEzqPHJnJOcWSQkXYVtokuixa35ksIn0tiQW6OghKr0FAtafO7WlFIsHysFECoWg9iQ tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(21))
+			_ = quMjqujYWsLRZyV1FQQQZK960gsSk3LrpKrYnEzqPHJnJOcWSQkXYVtokuixa35ksIn0tiQW6OghKr0FAtafO7WlFIsHysFECoWg9iQ
+			var f0Dxz1BR1eZHEIo9p84reNf9wryYJ4W0CeGSMpNeiNdt1GtrI2Xj3LuP44PQdxAZ9ZADqaaZZAuA120o tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(22))
+			_ = f0Dxz1BR1eZHEIo9p84reNf9wryYJ4W0CeGSMpNeiNdt1GtrI2Xj3LuP44PQdxAZ9ZADqaaZZAuA120o
+			var xPVKxOhU8hY2dNrzlsAUMCcKMNoA21ooC0IIAO6lLa1 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(23))
+			_ = xPVKxOhU8hY2dNrzlsAUMCcKMNoA21ooC0IIAO6lLa1
+			var fi4AzNL5brxCQkWdOnKlZ7b8TNNA1XiKfChWu0jnsTEDBSKprQg0IOyxO4TFjsJ6WPzVy tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(24))
+			_ = fi4AzNL5brxCQkWdOnKlZ7b8TNNA1XiKfChWu0jnsTEDBSKprQg0IOyxO4TFjsJ6WPzVy
+			var wfnvSs9SSS5CmZtsSaipMtZ2SsMMZoWP1Ki4dOEcOgoolLpwxMlDAbOBwpeEPGQEUebCwORUkx8S2pAZtPdjkj558jlgw6GCN20N20KSIEg tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(25))
+			_ = wfnvSs9SSS5CmZtsSaipMtZ2SsMMZoWP1Ki4dOEcOgoolLpwxMlDAbOBwpeEPGQEUebCwORUkx8S2pAZtPdjkj558jlgw6GCN20N20KSIEg
+			var z7WCvf55SPTgNnAopaQ tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(26))
+			_ = z7WCvf55SPTgNnAopaQ
+			var uCP7CBRbK0oHUYOBZarsmc7kXFwxz3TfJaJc1lld2oeToHRWzMd87Ay3AR2rvltrfkUZJwhcgzadXoOhOIvfzHub42HcusniVd1PaG tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(27))
+			_ = uCP7CBRbK0oHUYOBZarsmc7kXFwxz3TfJaJc1lld2oeToHRWzMd87Ay3AR2rvltrfkUZJwhcgzadXoOhOIvfzHub42HcusniVd1PaG
+			var utuVgQy0vnqxIwLuyl0n1QhGGA9QHD5OV5CkQKVLUqKrjW5cGlrD8xUEqf0RVjfyIzpyNFbNYG3SH9Gh tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(28))
+			_ = utuVgQy0vnqxIwLuyl0n1QhGGA9QHD5OV5CkQKVLUqKrjW5cGlrD8xUEqf0RVjfyIzpyNFbNYG3SH9Gh
+			var akW3VdCrNO4EoUwJ0uE0kRFSg1JbmUMDNAmelfxdwKHDAPVTSFCRmyutl71odcQI3sQEjWI2JsG1sFTmdoIPjAkuH74qAoRdKJDHNVwRP44Mukgb tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(29))
+			_ = akW3VdCrNO4EoUwJ0uE0kRFSg1JbmUMDNAmelfxdwKHDAPVTSFCRmyutl71odcQI3sQEjWI2JsG1sFTmdoIPjAkuH74qAoRdKJDHNVwRP44Mukgb
+			var j tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(30))
+			_ = j
+			var sFOFeZ8d1CXFvB3mgm7vq9tmgA7x8rFZzCAuAd6rGd1 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(31))
+			_ = sFOFeZ8d1CXFvB3mgm7vq9tmgA7x8rFZzCAuAd6rGd1
+			var mw2mtHgUPBPU tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(32))
+			_ = mw2mtHgUPBPU
+			var sUzbLovWwkSoSywZ9TWkywxpamyWKK7jxsG tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(33))
+			_ = sUzbLovWwkSoSywZ9TWkywxpamyWKK7jxsG
+			var tTTG8NBpo1siq6y4En4TOL5I5APT9QHcsxrAScgWJF9q2QxNp2BXdhtC2OF4SgLYcUTu tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(34))
+			_ = tTTG8NBpo1siq6y4En4TOL5I5APT9QHcsxrAScgWJF9q2QxNp2BXdhtC2OF4SgLYcUTu
+			var edEtfdJxr6Zf3gFBTvwf8BBzgUeKMj5ViZjmoVffuNDDlHXmtTx9ltlq2C5QUL9KYpy8uzCTIjTzNS3qE tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(35))
+			_ = edEtfdJxr6Zf3gFBTvwf8BBzgUeKMj5ViZjmoVffuNDDlHXmtTx9ltlq2C5QUL9KYpy8uzCTIjTzNS3qE
+			var aHFd5VD9O1lMiAYYwt65Fo1EQN tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(36))
+			_ = aHFd5VD9O1lMiAYYwt65Fo1EQN
+			var tdZ4ydcHZRtLdKOhxrn2SVKdkvMFAeCCN tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(37))
+			_ = tdZ4ydcHZRtLdKOhxrn2SVKdkvMFAeCCN
+			var pPgoT6lo9XOVnwJGZ0KP5B60qCzaVI4yC6iNVnrbFJLAxiiyi tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(38))
+			_ = pPgoT6lo9XOVnwJGZ0KP5B60qCzaVI4yC6iNVnrbFJLAxiiyi
+			var zZ3EZEUkUCbJvEzvtX4SK3ZnHYqRvf2TeBWbzhoUH8OG5AYE6bXMS2pnqSrf tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(39))
+			_ = zZ3EZEUkUCbJvEzvtX4SK3ZnHYqRvf2TeBWbzhoUH8OG5AYE6bXMS2pnqSrf
+			var soR tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(40))
+			_ = soR
+			var y5RMfJ7wdJygDDQ0BV3NMN6YBPohRMqzk0Wng8d44eyThvmVieA2XRiWpSRmEiBWzEsLpUVMi7No6gqi33JI7gSNU4D1ajJH tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(41))
+			_ = y5RMfJ7wdJygDDQ0BV3NMN6YBPohRMqzk0Wng8d44eyThvmVieA2XRiWpSRmEiBWzEsLpUVMi7No6gqi33JI7gSNU4D1ajJH
+			var uip3AI0fr61lgoRz0RTr0vks6GIpPkrMgKlcTZndmxNGw2mpX tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(42))
+			_ = uip3AI0fr61lgoRz0RTr0vks6GIpPkrMgKlcTZndmxNGw2mpX
+			var gw0UHAZGKMWqqTJkpbfHAY0fbEa1JNsADyRUks70OBYQaK775WVBR3mGZwLYuyQ8f6wcfBjhVVSWSrKJ68INhuxjMqFzCV9V6JFVztXYknG73KKPjhxL tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(43))
+			_ = gw0UHAZGKMWqqTJkpbfHAY0fbEa1JNsADyRUks70OBYQaK775WVBR3mGZwLYuyQ8f6wcfBjhVVSWSrKJ68INhuxjMqFzCV9V6JFVztXYknG73KKPjhxL
+			var xSUCIL57 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(44))
+			_ = xSUCIL57
+			var wVeDj6P7lyM1XCxTdGU5saFETV2rA6wUHl7D0MOE5wCFu5y7aYH2QexPhkxcDiZaZ9GCV8iFaoL5CmtR7RbpzgEV9vuLAa5iy692jxZQBZfi4sLa tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(45))
+			_ = wVeDj6P7lyM1XCxTdGU5saFETV2rA6wUHl7D0MOE5wCFu5y7aYH2QexPhkxcDiZaZ9GCV8iFaoL5CmtR7RbpzgEV9vuLAa5iy692jxZQBZfi4sLa
+			var kYKhasNW40uMZouSSolAmJvRnUXJblvJoX4R7Y6rRIF9 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(46))
+			_ = kYKhasNW40uMZouSSolAmJvRnUXJblvJoX4R7Y6rRIF9
+			var djJlNxroMSUMDwpGSJukNAdQna6bTtn3cY8l7bIy tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(47))
+			_ = djJlNxroMSUMDwpGSJukNAdQna6bTtn3cY8l7bIy
+			var drqx6dKduJQ8 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(48))
+			_ = drqx6dKduJQ8
+			var bwYXcW5DSIFxFHriKE8MOOPoIcGEStZAYWdqjBb0GZ2rR8PcSJh4OUp8GXsSSiDpYR6hevDuWbzcYDSLCLNahJJ3a8XTqLP7lnj1eoTWO6hqaUPbnpBEPz tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(49))
+			_ = bwYXcW5DSIFxFHriKE8MOOPoIcGEStZAYWdqjBb0GZ2rR8PcSJh4OUp8GXsSSiDpYR6hevDuWbzcYDSLCLNahJJ3a8XTqLP7lnj1eoTWO6hqaUPbnpBEPz
+			var jA6DXEnIIgyvkcEeoMahu7Q46arA5d7ovgwxN6myQFF8w0r3o9hzme7QBUEhvLhVZSblEB4G1xDUXpPyGlaNJwC5GyvwEt922wHqmeoJ2 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(50))
+			_ = jA6DXEnIIgyvkcEeoMahu7Q46arA5d7ovgwxN6myQFF8w0r3o9hzme7QBUEhvLhVZSblEB4G1xDUXpPyGlaNJwC5GyvwEt922wHqmeoJ2
+			var qRdovI01GePhYFxf3wRNp36TRcxQlJaYTcTs42znV0QJKtqJgo60Jq9SpxdBKJYgWttjH1asJMDRKGZEzjOknZq5rasUYLvK5Z46eG4qdD8KA9aIg10u tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(51))
+			_ = qRdovI01GePhYFxf3wRNp36TRcxQlJaYTcTs42znV0QJKtqJgo60Jq9SpxdBKJYgWttjH1asJMDRKGZEzjOknZq5rasUYLvK5Z46eG4qdD8KA9aIg10u
+			var fE6iVYI tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(52))
+			_ = fE6iVYI
+			var v5tJF9JOdcleYAjDytdP7HyA3oJXuiOiYjQyowuhEPx2aCy9eHAfIr0k03ZORJoEBaw1rPRoB67NaF8jSKql6U1GqoTC3bkGGOL1Y tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(53))
+			_ = v5tJF9JOdcleYAjDytdP7HyA3oJXuiOiYjQyowuhEPx2aCy9eHAfIr0k03ZORJoEBaw1rPRoB67NaF8jSKql6U1GqoTC3bkGGOL1Y
+			var qYs2vIkhT8Z3FvQwaQKRAh0mbBye9eYY4IUngBjk8d13QezAVGpXvkMpy6uVDrsqxV3 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(1))
+			_ = qYs2vIkhT8Z3FvQwaQKRAh0mbBye9eYY4IUngBjk8d13QezAVGpXvkMpy6uVDrsqxV3
+			var cXigOXzsJ6onTDjH0xpa91KcgUkbyXW7spQFUJFIMw6BxQA2Gh tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(2))
+			_ = cXigOXzsJ6onTDjH0xpa91KcgUkbyXW7spQFUJFIMw6BxQA2Gh
+			var tKUse1q5VKFc7ve6s2Thq1qelXwdNHqZ1pZkRNOkujjyUc tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(3))
+			_ = tKUse1q5VKFc7ve6s2Thq1qelXwdNHqZ1pZkRNOkujjyUc
+			var dThYebRbgUccDhFALwld5ofDj94ZvvUVOdR1W8rLoVYRqm6byibOCvn8nPyOdcdG4b6ZyfT9X8n8l9Zq5FFdHmHGq9MBgD3tV5iuUWn6mscM9Eq1 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(4))
+			_ = dThYebRbgUccDhFALwld5ofDj94ZvvUVOdR1W8rLoVYRqm6byibOCvn8nPyOdcdG4b6ZyfT9X8n8l9Zq5FFdHmHGq9MBgD3tV5iuUWn6mscM9Eq1
+			var bX2vu7a5IevzZocUz4USvOuAVxLHZb0wJQMLjjtr7OQahd41gWeW7ZryG60injznDh3uzNkZ1Ngi2bwHIzs5k8g4PlO9S tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(5))
+			_ = bX2vu7a5IevzZocUz4USvOuAVxLHZb0wJQMLjjtr7OQahd41gWeW7ZryG60injznDh3uzNkZ1Ngi2bwHIzs5k8g4PlO9S
+			var l9PQOsz92pRSKC41UDrDd2NUutmnV45jtK6CdrGP tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(6))
+			_ = l9PQOsz92pRSKC41UDrDd2NUutmnV45jtK6CdrGP
+			var kFp9oClxBIiX1r8XxUlzcPTm1WjDQXtnqQquJUjt0JYkX7bkCoHINp9E6fhUYa5SjEPAW0XgigtDMtoe tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(7))
+			_ = kFp9oClxBIiX1r8XxUlzcPTm1WjDQXtnqQquJUjt0JYkX7bkCoHINp9E6fhUYa5SjEPAW0XgigtDMtoe
+			var qOF2B7i1tUMet7gl tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(8))
+			_ = qOF2B7i1tUMet7gl
+			var uoXcHoxBeGknbqAeW5OItqYksUaYYFwLgmxNVuuNUhhocQkCzEZX tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(9))
+			_ = uoXcHoxBeGknbqAeW5OItqYksUaYYFwLgmxNVuuNUhhocQkCzEZX
+			var yYJlfXjAHuaHgDEwpshBIAJaVnb2WYdTfTIzH4yu67e4FKQ3PMI21DBFLWr0vL tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(10))
+			_ = yYJlfXjAHuaHgDEwpshBIAJaVnb2WYdTfTIzH4yu67e4FKQ3PMI21DBFLWr0vL
+			var t9QSq5lqH9oyzGxMtgX0FJzpMUqPjxMH7rHAnQYzB4LcsP2nUWRHJdwK2ZUeF3s8ONbq5Yv4fkp8tU5pTMH9nBllpTuNzhTJN9ItikYcny4MqnJg7z tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(11))
+			_ = t9QSq5lqH9oyzGxMtgX0FJzpMUqPjxMH7rHAnQYzB4LcsP2nUWRHJdwK2ZUeF3s8ONbq5Yv4fkp8tU5pTMH9nBllpTuNzhTJN9ItikYcny4MqnJg7z
+			var pciPKP8G7tBFMGgGcGTzme7M8L6F tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(12))
+			_ = pciPKP8G7tBFMGgGcGTzme7M8L6F
+			var yPj5H7YUP17cIAEjOZyZajUzR8o4TSyA4R87 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(13))
+			_ = yPj5H7YUP17cIAEjOZyZajUzR8o4TSyA4R87
+			var qvnHpUR7kEdjNmmJzpLjnG4cCDnT8VoMuJyFjUgcq17HvIk67Par0hu0EtMQo9t tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(14))
+			_ = qvnHpUR7kEdjNmmJzpLjnG4cCDnT8VoMuJyFjUgcq17HvIk67Par0hu0EtMQo9t
+			var fiQ7nIZB3XUCfXNjy8sWW6kN tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(15))
+			_ = fiQ7nIZB3XUCfXNjy8sWW6kN
+			var ljR3tFlFqnopZUFowzy5HGQ4M7Rgo2o2zhshq5fbF4niMx2eSdcf8q85KAh1kgRscREBgkZmoxKIY3c9QsjphrivKdwtAawPCAvvv tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(16))
+			_ = ljR3tFlFqnopZUFowzy5HGQ4M7Rgo2o2zhshq5fbF4niMx2eSdcf8q85KAh1kgRscREBgkZmoxKIY3c9QsjphrivKdwtAawPCAvvv
+			var pBHZWQeL0iuW7ilpuNW3TLTYWWA1uleSO1e3fZ97OZtW3nJEw4K0XUJonrlHyvzApOf0aMvCWviNjYQEqnhMVLKH03olEwjBk7xK8JksJRpqXIs8l tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(17))
+			_ = pBHZWQeL0iuW7ilpuNW3TLTYWWA1uleSO1e3fZ97OZtW3nJEw4K0XUJonrlHyvzApOf0aMvCWviNjYQEqnhMVLKH03olEwjBk7xK8JksJRpqXIs8l
+			var rEdNhyE35SeEZAKcQMFoaAICaMUf3yevCQOHY9UBpuCpv3yA7wQRZQrXTkkZqLqEas6yHyJ8B6Oe1v61IL2 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(18))
+			_ = rEdNhyE35SeEZAKcQMFoaAICaMUf3yevCQOHY9UBpuCpv3yA7wQRZQrXTkkZqLqEas6yHyJ8B6Oe1v61IL2
+			var uQILEXvISXLHYQRyNGS1sfju9A3ZRPbUpnahevm4ikMSe1cQU8fmyI0YmVX2KCprGchBTynMN tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(19))
+			_ = uQILEXvISXLHYQRyNGS1sfju9A3ZRPbUpnahevm4ikMSe1cQU8fmyI0YmVX2KCprGchBTynMN
+			var aS3znIiOYr4XP9fO5JNK7oXV8oay7sVkOQZQS5yiA6LTXrygDRCrl2oYItFZSGIyr3WRNVQoqmOIMtkNCc5FpnWDdGsgzXBBoS1y3LOyj0SQAG9znznTN tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(20))
+			_ = aS3znIiOYr4XP9fO5JNK7oXV8oay7sVkOQZQS5yiA6LTXrygDRCrl2oYItFZSGIyr3WRNVQoqmOIMtkNCc5FpnWDdGsgzXBBoS1y3LOyj0SQAG9znznTN
+			var h98v4RmuBEdTElq9in2ms9d06FISjUnVSRVwSdp55G23p2RUSxIFX6IBYiEEa0 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(21))
+			_ = h98v4RmuBEdTElq9in2ms9d06FISjUnVSRVwSdp55G23p2RUSxIFX6IBYiEEa0
+			var rj00YIZZEaTNEk7f4WfiQsQbYDgio0j tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(22))
+			_ = rj00YIZZEaTNEk7f4WfiQsQbYDgio0j
+			var hllU8bWZN9UCEr96xx5m49reoWGkymIODFB2jj62HI2P7572L86eI1YrrYcXeZfWGO8Z3yfT2ZFVEk1KRAQvwc tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(23))
+			_ = hllU8bWZN9UCEr96xx5m49reoWGkymIODFB2jj62HI2P7572L86eI1YrrYcXeZfWGO8Z3yfT2ZFVEk1KRAQvwc
+			var o8gjCvY399mo3k5WjNMjVq6iprRvOewc9qVDx3Yb6Lpu9HEwGDdXBjjwph8OW5x7UMh2B7OpTddyRi9KjeMDI1AvQPmjgT0S tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(24))
+			_ = o8gjCvY399mo3k5WjNMjVq6iprRvOewc9qVDx3Yb6Lpu9HEwGDdXBjjwph8OW5x7UMh2B7OpTddyRi9KjeMDI1AvQPmjgT0S
+			var klL65eTT7xHxIhRZb7SU8J77peG6NsXY8d8gFZPJK7j4htT5vNSaRyGGOPKnW6M5s2oLrZdRtK7xixk6veMP4QROUl5JsIWQRollboKWVLXFRe tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(25))
+			_ = klL65eTT7xHxIhRZb7SU8J77peG6NsXY8d8gFZPJK7j4htT5vNSaRyGGOPKnW6M5s2oLrZdRtK7xixk6veMP4QROUl5JsIWQRollboKWVLXFRe
+			var tccUGSi7jBtegUiaHUpq8Q8AOZk3qw0DqslHVucrHRyHeDtZTLlan18QdJFR tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(26))
+			_ = tccUGSi7jBtegUiaHUpq8Q8AOZk3qw0DqslHVucrHRyHeDtZTLlan18QdJFR
+			var vB6EJjKguxqIDwTZaUQ4uwZ3O8Irg75e8Hoi6HWE31vJ484wNHkhTPZsvlwLqbvpOman4CgN2sU0WrgAYlREbTObEPa6dSJM6tkQ8lVnykbsReALP9fvC0 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(27))
+			_ = vB6EJjKguxqIDwTZaUQ4uwZ3O8Irg75e8Hoi6HWE31vJ484wNHkhTPZsvlwLqbvpOman4CgN2sU0WrgAYlREbTObEPa6dSJM6tkQ8lVnykbsReALP9fvC0
+			var gUPOB0XsHOwSVFSEOLEOowv6mslRbwuc70SU5VIDuJb4LU5JY2lefPw1mL4zlgNo tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(28))
+			_ = gUPOB0XsHOwSVFSEOLEOowv6mslRbwuc70SU5VIDuJb4LU5JY2lefPw1mL4zlgNo
+			var r00tRqtunlUwYYikSFIAvFnS7tkpR3ZhENv1a0W2pJ2AUkjShOMGm9KBpBwX2r0Qp67ySY3fyav8JLrmjU tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(29))
+			_ = r00tRqtunlUwYYikSFIAvFnS7tkpR3ZhENv1a0W2pJ2AUkjShOMGm9KBpBwX2r0Qp67ySY3fyav8JLrmjU
+			var n tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(30))
+			_ = n
+			var h tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(31))
+			_ = h
+			var e0sQykAXllMBO2F7Zcr tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(32))
+			_ = e0sQykAXllMBO2F7Zcr
+			var iB77i0aoGELURf3G tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(33))
+			_ = iB77i0aoGELURf3G
+			var xUnFhbMjFQHevb0OHm0i6i9BRcS739FBQ3K5LAmkrzE32UiDT tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(34))
+			_ = xUnFhbMjFQHevb0OHm0i6i9BRcS739FBQ3K5LAmkrzE32UiDT
+			var dOepdjh3yW6ITWsVVMqO8uTNs695fC6nmHEGbu7MK2NWVD4FcY tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(35))
+			_ = dOepdjh3yW6ITWsVVMqO8uTNs695fC6nmHEGbu7MK2NWVD4FcY
+			var bUGlUz6QcsP45a4CM3opnTtyAQ10vp8uvmsJFSdi5H7hyEJwyvfm9we4eraoMQuWAtJKnNPSbn75I3iGk01T2o8X5HMc1Jj4lFy5OSyzOmqoBwQxxLu tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(36))
+			_ = bUGlUz6QcsP45a4CM3opnTtyAQ10vp8uvmsJFSdi5H7hyEJwyvfm9we4eraoMQuWAtJKnNPSbn75I3iGk01T2o8X5HMc1Jj4lFy5OSyzOmqoBwQxxLu
+			var ebet2d8z4 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(37))
+			_ = ebet2d8z4
+			var kK42gj0HAwXrLo6Z3h3vuF9kklza9oNuZZitCZOVEyI2Agw5O0ShfvZMauLaqO8zo3410EmnY tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(38))
+			_ = kK42gj0HAwXrLo6Z3h3vuF9kklza9oNuZZitCZOVEyI2Agw5O0ShfvZMauLaqO8zo3410EmnY
+			var t89kNw8SXEmEv7KQTW6G9FDiMLhH8Jyq2Icjf5uftL6oyjAKJ6oPznxJvPu20Ex5b8PgRYrz9wBjI3HA2Rcd8MyM7k8dihEtsImgSKVpyj4jMJULNeGUi tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(39))
+			_ = t89kNw8SXEmEv7KQTW6G9FDiMLhH8Jyq2Icjf5uftL6oyjAKJ6oPznxJvPu20Ex5b8PgRYrz9wBjI3HA2Rcd8MyM7k8dihEtsImgSKVpyj4jMJULNeGUi
+			var jVtDWPvvMlpRfAIFN tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(40))
+			_ = jVtDWPvvMlpRfAIFN
+			var rjDblwG5w06VwxVF2FhnwzCq9hHfxcPGVwTx tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(41))
+			_ = rjDblwG5w06VwxVF2FhnwzCq9hHfxcPGVwTx
+			var a5poG6VJGOje64gV0o32KNLomfvdGx3rPIJC3GJ3qt7s27Iw8xPw6TvJvGHB19iTVuu5JiNrx9SRBRvoGanmW6eUQQUrvrJA7GTw1pxv tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(42))
+			_ = a5poG6VJGOje64gV0o32KNLomfvdGx3rPIJC3GJ3qt7s27Iw8xPw6TvJvGHB19iTVuu5JiNrx9SRBRvoGanmW6eUQQUrvrJA7GTw1pxv
+			var yOYNtXMlTaAaH7PATciJgfRAhiAlbeEtxgyT9NwtG5a0R4vTySlcdj0JNzArgg2xMpFFB4qoV8QUCPed4W9XTGrtIEpnSCGjjJegRw1DbcJh7q7IVI tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(43))
+			_ = yOYNtXMlTaAaH7PATciJgfRAhiAlbeEtxgyT9NwtG5a0R4vTySlcdj0JNzArgg2xMpFFB4qoV8QUCPed4W9XTGrtIEpnSCGjjJegRw1DbcJh7q7IVI
+			var ppJgmVeFxLLqOseXHwxX7VFY5ak0aBSFDc6acefzO7 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(44))
+			_ = ppJgmVeFxLLqOseXHwxX7VFY5ak0aBSFDc6acefzO7
+			var p11NmRi6fkzNJnfKAv2sSb1gguICzCObCpRgPJo2eBgBjQYBMd5ABmKmAQrLjxpYgeII15u2xIfC4rrzLcVw0NciiutagUaugvj83AmGQiq8cvcyt tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(45))
+			_ = p11NmRi6fkzNJnfKAv2sSb1gguICzCObCpRgPJo2eBgBjQYBMd5ABmKmAQrLjxpYgeII15u2xIfC4rrzLcVw0NciiutagUaugvj83AmGQiq8cvcyt
+			var aCqFcgU8u8pZF8YqRxvPB0KB4N151xs36qWBxNvU2ZPrWLdadCN5tJWLXaeEN0xPX9k17O2pORhvSXnOmCPNxe5CCRqK3HJ58SbC6WbQkH7wlP tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(46))
+			_ = aCqFcgU8u8pZF8YqRxvPB0KB4N151xs36qWBxNvU2ZPrWLdadCN5tJWLXaeEN0xPX9k17O2pORhvSXnOmCPNxe5CCRqK3HJ58SbC6WbQkH7wlP
+			var cTIEG1eCnodk4KfNawiNeoxObe37JMs7bHQIQTQNPK20lYtVKc4SRVj4nULZxffcc6FsQfQWEZ3i1bjUTAGBbryJw9HMh5KNlO tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(47))
+			_ = cTIEG1eCnodk4KfNawiNeoxObe37JMs7bHQIQTQNPK20lYtVKc4SRVj4nULZxffcc6FsQfQWEZ3i1bjUTAGBbryJw9HMh5KNlO
+			var kbyqFcHzmKIdkfS tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(48))
+			_ = kbyqFcHzmKIdkfS
+			var qZULqzNCf4c33keIT6QSXtLw2oczBYFqZurDwzXUjhqcsTKr4Wzz5e2WWUtliYkFMS8RyzfzF0jPHEs66rzf2J2OCHh1X tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(49))
+			_ = qZULqzNCf4c33keIT6QSXtLw2oczBYFqZurDwzXUjhqcsTKr4Wzz5e2WWUtliYkFMS8RyzfzF0jPHEs66rzf2J2OCHh1X
+			var uNlhHuC0KVGQI2eQvDquEWPtZtz1alPf7PY2YXBfk tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(50))
+			_ = uNlhHuC0KVGQI2eQvDquEWPtZtz1alPf7PY2YXBfk
+			var tRM3vBR7bpQD7xBwkdX71LMVFvzNY78Wddk93FvTIv6MJNKxKEdC5Au tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(51))
+			_ = tRM3vBR7bpQD7xBwkdX71LMVFvzNY78Wddk93FvTIv6MJNKxKEdC5Au
+			var icF7usaAqXMhWDA9ejQId0ZD0M1xCw9iep0SU1aSDIf3zqnGMaRhxjkWlcnx8NSh6Plecg6rOYDtUqTBmJAZCoEMv6xtUIe0k9It8aYACvBef tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(52))
+			_ = icF7usaAqXMhWDA9ejQId0ZD0M1xCw9iep0SU1aSDIf3zqnGMaRhxjkWlcnx8NSh6Plecg6rOYDtUqTBmJAZCoEMv6xtUIe0k9It8aYACvBef
+			var j0 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(53))
+			_ = j0
+			var rHhxcqYY6k95ChqdhWd4sTse8mY3eMCTSJPcZzGX4Tz2okQI5BYD tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(54))
+			_ = rHhxcqYY6k95ChqdhWd4sTse8mY3eMCTSJPcZzGX4Tz2okQI5BYD
+			var b1UmXHzKlpk9H2wePnDyToZOR4mytyjxuL7BJLIJjrYc6PN7gvf678kdikjfRud8EYRMnPNWtFZj3O9qfpPQWjchG tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(55))
+			_ = b1UmXHzKlpk9H2wePnDyToZOR4mytyjxuL7BJLIJjrYc6PN7gvf678kdikjfRud8EYRMnPNWtFZj3O9qfpPQWjchG
+			var kOg5rWM2E5mItuiMpa2ZYWyErb70zhVsSfpG61mMlX0EClWtIClUoqONIYQfzd0cLMYhBWcWhOubaI tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(56))
+			_ = kOg5rWM2E5mItuiMpa2ZYWyErb70zhVsSfpG61mMlX0EClWtIClUoqONIYQfzd0cLMYhBWcWhOubaI
+			var dXNyAvBGR4G35ac1ciJH tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(57))
+			_ = dXNyAvBGR4G35ac1ciJH
+			var i4HZuRtO0viK2jDOqmmyg9UEKLfMiI23Lzmajt7AnsA3Ykxib3eEUSzSNKYpHM0s1EmsHXcv6SpF6KQkwj7p5nGnUzwE2CD28vpG6MbZUewL tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(58))
+			_ = i4HZuRtO0viK2jDOqmmyg9UEKLfMiI23Lzmajt7AnsA3Ykxib3eEUSzSNKYpHM0s1EmsHXcv6SpF6KQkwj7p5nGnUzwE2CD28vpG6MbZUewL
+			var bsBwDAWVVEx5wW8KfQ86TtbEXRSoHPXe6JiwijfaXdonD32urz63Qr3EMrmhPfvKcGZi0wioGOal4MC4ubda tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(59))
+			_ = bsBwDAWVVEx5wW8KfQ86TtbEXRSoHPXe6JiwijfaXdonD32urz63Qr3EMrmhPfvKcGZi0wioGOal4MC4ubda
+			var yqaBcm6xT7VYm4JWC5Yhtm5E83fZ4a3il4vjKqyOWkHkR0tLz5CBZIfKqGqd5tRfoJdt9ylxLwukA9Bu7P91kiwAKD tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(60))
+			_ = yqaBcm6xT7VYm4JWC5Yhtm5E83fZ4a3il4vjKqyOWkHkR0tLz5CBZIfKqGqd5tRfoJdt9ylxLwukA9Bu7P91kiwAKD
+			var o57HdczedfTs5ptNuPejaeUF1OVUUhBx2WuXeolu5tcbov4juxoz61myFvqfTTr58RS9UapJr0cJ7p4a1 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(61))
+			_ = o57HdczedfTs5ptNuPejaeUF1OVUUhBx2WuXeolu5tcbov4juxoz61myFvqfTTr58RS9UapJr0cJ7p4a1
+			var qtRY3DeNGVpVfbuEGnoQVCGfBz0h4cp1J tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(62))
+			_ = qtRY3DeNGVpVfbuEGnoQVCGfBz0h4cp1J
+			var aM1o6nSAiuCEBSVZey6o6Fe0XUkL5oPGcrupSGtvm tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(63))
+			_ = aM1o6nSAiuCEBSVZey6o6Fe0XUkL5oPGcrupSGtvm
+			var tw8etp1ZIvGFcwbZz1B7EdpNMvBDyyxEUYWM71N7Ju2 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(64))
+			_ = tw8etp1ZIvGFcwbZz1B7EdpNMvBDyyxEUYWM71N7Ju2
+			var s1S61l2YRzbxQxij48HsUAiuOF7vtOOfPEF7GpCe8lMlxUbw5uqzBKc93hTimLmobiuGZIbq8LvQ5kbT6U tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(65))
+			_ = s1S61l2YRzbxQxij48HsUAiuOF7vtOOfPEF7GpCe8lMlxUbw5uqzBKc93hTimLmobiuGZIbq8LvQ5kbT6U
+			var cWf8d4eg3wz8 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(66))
+			_ = cWf8d4eg3wz8
+			var vihCVmtwI298kjYp1SCAyEMLJRi3qOp8MjSs0KqR7fJh7bOrH6g8BOvd3YWArf6dtwoDg83z3KEDWR8vV5lBigEEHtZKMDV4XWAGXIl7QNih5AeL tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(67))
+			_ = vihCVmtwI298kjYp1SCAyEMLJRi3qOp8MjSs0KqR7fJh7bOrH6g8BOvd3YWArf6dtwoDg83z3KEDWR8vV5lBigEEHtZKMDV4XWAGXIl7QNih5AeL
+			var ig6WV4gyDyTrFKUaRUrvCQNLq5D6bL2UFVCYimobKZ7agGiKhSVCV2zlHoAwgfIK tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(68))
+			_ = ig6WV4gyDyTrFKUaRUrvCQNLq5D6bL2UFVCYimobKZ7agGiKhSVCV2zlHoAwgfIK
+			var tzfucOGiRbZcyIylI7yuPi8l2IIZ3JQVMjBOe7mtbw tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(69))
+			_ = tzfucOGiRbZcyIylI7yuPi8l2IIZ3JQVMjBOe7mtbw
+			var ytALmA8QFfn7iXrWaLdf61QBTImHiQxFUnR2BaGu3tdKQ4KX9tYCYLLaal8aeUrkq4mvCgtObPJyGFs tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(70))
+			_ = ytALmA8QFfn7iXrWaLdf61QBTImHiQxFUnR2BaGu3tdKQ4KX9tYCYLLaal8aeUrkq4mvCgtObPJyGFs
+			var yvT43K0Rn9qOqjBQvgviJwcLOYolNYeqx2xhfPWan9GzwbiNiuKyQhqvRdDmnF9PzsDYG5lngbA tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(71))
+			_ = yvT43K0Rn9qOqjBQvgviJwcLOYolNYeqx2xhfPWan9GzwbiNiuKyQhqvRdDmnF9PzsDYG5lngbA
+			var yPiktwBcdC2bJAOvQ5bbfzCbrRkZCVHmK7XoEiY tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(72))
+			_ = yPiktwBcdC2bJAOvQ5bbfzCbrRkZCVHmK7XoEiY
+			var cQSLqZJuFxSG4yQ6aL3Ho tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(73))
+			_ = cQSLqZJuFxSG4yQ6aL3Ho
+			var mR5lq0HdPsAdE5sB89Jyp9Cdbo tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(74))
+			_ = mR5lq0HdPsAdE5sB89Jyp9Cdbo
+			var v9O7IOMjaHz3wyHxQml0PZu4S6eitcdAz6dVdGt5ZUgmbFzjczIU4bnnkwr8UwcSrfkeyNHYfEpXVvLejBxStLlwTJNSEqNvq5wZKbHcB tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(75))
+			_ = v9O7IOMjaHz3wyHxQml0PZu4S6eitcdAz6dVdGt5ZUgmbFzjczIU4bnnkwr8UwcSrfkeyNHYfEpXVvLejBxStLlwTJNSEqNvq5wZKbHcB
+			var yV87iaojrbY11bPi0c2PG0t2BF3qMg8o4W3u3cLkwqcgVLY6QcfCvaeoFhZ9dwzytUnieRRJpAzHjZjfrmdPoSt3vf7VjvAIVDBMKN33rzgrKAuz tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(76))
+			_ = yV87iaojrbY11bPi0c2PG0t2BF3qMg8o4W3u3cLkwqcgVLY6QcfCvaeoFhZ9dwzytUnieRRJpAzHjZjfrmdPoSt3vf7VjvAIVDBMKN33rzgrKAuz
+			var mSi8IsHSaMWarceetBet tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(77))
+			_ = mSi8IsHSaMWarceetBet
+			var wCFmcSiPisOUXeg7KB5ffUJLtzteY6Lmsj5K1uYdKEgTpqiwJ57xCTQYul5VxxlWKpXQoouVnXg2jUHHbgqGGlfO tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(78))
+			_ = wCFmcSiPisOUXeg7KB5ffUJLtzteY6Lmsj5K1uYdKEgTpqiwJ57xCTQYul5VxxlWKpXQoouVnXg2jUHHbgqGGlfO
+			var i4kgpIQgxMdqOongGt87U4gt tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(79))
+			_ = i4kgpIQgxMdqOongGt87U4gt
+			var kg2P2Efa1zAXmgnI2HMy7khopf1mJwH6IGd33D4tZSVMqOPfYO0AAOahNtX tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(80))
+			_ = kg2P2Efa1zAXmgnI2HMy7khopf1mJwH6IGd33D4tZSVMqOPfYO0AAOahNtX
+			var sifOxHC2nNOYLvme62VTjZT0UspDpTPPBvv3xXTSuITFpXCG9VKbnaB0EwiQzwnZBKKUljOyShNretBNGgHWfvj87XZhcK5ageG5ax tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(81))
+			_ = sifOxHC2nNOYLvme62VTjZT0UspDpTPPBvv3xXTSuITFpXCG9VKbnaB0EwiQzwnZBKKUljOyShNretBNGgHWfvj87XZhcK5ageG5ax
+			var q8XJJUa1aVm6W2I9Im58IKTwKQ7Drc4blR5S3lgzl6WZuYu0Arn309QqAK3lGqRPxJxhT tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(82))
+			_ = q8XJJUa1aVm6W2I9Im58IKTwKQ7Drc4blR5S3lgzl6WZuYu0Arn309QqAK3lGqRPxJxhT
+			var khVrD8zteXdl4vWqnyHznKF7nnOTIl05xnhiBgcoA7gWXLR9 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(83))
+			_ = khVrD8zteXdl4vWqnyHznKF7nnOTIl05xnhiBgcoA7gWXLR9
+			var tnfoOloDphHZbt17VgpvwcNehsglU5QVvBkHGqSMs3AkQNSjZv7 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(84))
+			_ = tnfoOloDphHZbt17VgpvwcNehsglU5QVvBkHGqSMs3AkQNSjZv7
+			var mbfwuTpS6XAYc7ERqqofjUuUTf7vbGVAgQSSgGv3Hiva2scON5KnyaTicWGcYSRvVilF tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(85))
+			_ = mbfwuTpS6XAYc7ERqqofjUuUTf7vbGVAgQSSgGv3Hiva2scON5KnyaTicWGcYSRvVilF
+			var cs4WB1zxD9P2Hnn8CCBWRukFZdkBJkv4S3OSV5cOI52Vzj2SiNRxUPjDXgrR2l7frhbwYfFgwG7NmQME3jJa4oAMC tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(86))
+			_ = cs4WB1zxD9P2Hnn8CCBWRukFZdkBJkv4S3OSV5cOI52Vzj2SiNRxUPjDXgrR2l7frhbwYfFgwG7NmQME3jJa4oAMC
+			var yKGU5AHpOBTJLP tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(87))
+			_ = yKGU5AHpOBTJLP
+			var ijI6RZEl4pKMLoGOedwERZAjZQaqkJWt1dmQ1tSQqw7NO5sdWUaNRylqzn7vuAnZPz2osmozprLMhTkg4HqcCmg5Iu0ePsIgeEfXe0Up tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(88))
+			_ = ijI6RZEl4pKMLoGOedwERZAjZQaqkJWt1dmQ1tSQqw7NO5sdWUaNRylqzn7vuAnZPz2osmozprLMhTkg4HqcCmg5Iu0ePsIgeEfXe0Up
+			var okpYMLykH1RJnjo02jqy6qbpbUk7oVr0ifR8w0mr33Ns tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(89))
+			_ = okpYMLykH1RJnjo02jqy6qbpbUk7oVr0ifR8w0mr33Ns
+			var oGZqnloVYnsN5RnGnIXS6bwV5RulgDjF79ZOPcSts5pKLsOcr3E1aLIhdoRM8xp2f4lSQJC4VEbOpWJ6Rz7ClRiatQS6P4NUktYT89ijVVAqXwqwo3I tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(90))
+			_ = oGZqnloVYnsN5RnGnIXS6bwV5RulgDjF79ZOPcSts5pKLsOcr3E1aLIhdoRM8xp2f4lSQJC4VEbOpWJ6Rz7ClRiatQS6P4NUktYT89ijVVAqXwqwo3I
+			var sNwFRxefnEUM8HUCDjTha8 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(91))
+			_ = sNwFRxefnEUM8HUCDjTha8
+			var s1yp9BlabCABm0gxixsNUk7 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(92))
+			_ = s1yp9BlabCABm0gxixsNUk7
+			var rzwl787albEt8Lr2hO5JoBpy3GG7t5WcZErM18RPVl3Y5oYHPRlKqrNkmivisQy5 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(93))
+			_ = rzwl787albEt8Lr2hO5JoBpy3GG7t5WcZErM18RPVl3Y5oYHPRlKqrNkmivisQy5
+			var xagBCy46wPb5s6r3IaUKUL1ORk2AnQWqX tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(94))
+			_ = xagBCy46wPb5s6r3IaUKUL1ORk2AnQWqX
+			var sc4mX89IKqrXlfKvVQuQd6Piqky8z3t9YFHzcVM6i4w7P tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(95))
+			_ = sc4mX89IKqrXlfKvVQuQd6Piqky8z3t9YFHzcVM6i4w7P
+			var zQZVtw3HUcnXJLzoJpzpZhqnypaWcKwm8scYk4VN4r8fzQOrChN8lFdHmAgk2g3AiUFwl8s4kdXOJzsuTIfFEjEiHVcHYBkawPNfL7 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(96))
+			_ = zQZVtw3HUcnXJLzoJpzpZhqnypaWcKwm8scYk4VN4r8fzQOrChN8lFdHmAgk2g3AiUFwl8s4kdXOJzsuTIfFEjEiHVcHYBkawPNfL7
+			var rEPD1cQuYUmWVany6skCwycvJfttN0pUFCMmcnaiCkzDqL9U7WIaZm6cTe0bmzmrGU7w3b2C4HtMj14l8rR tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(97))
+			_ = rEPD1cQuYUmWVany6skCwycvJfttN0pUFCMmcnaiCkzDqL9U7WIaZm6cTe0bmzmrGU7w3b2C4HtMj14l8rR
+			var vNuNzUH6gCqsyBicChtqwpGzwIctfB5Eo1vO3x6MuFwsT13jOTOLQmQtslTVotib2mm348vExZExlqPLO1DEyRUXaKy4865YLtMZNQFR1pP6l tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(98))
+			_ = vNuNzUH6gCqsyBicChtqwpGzwIctfB5Eo1vO3x6MuFwsT13jOTOLQmQtslTVotib2mm348vExZExlqPLO1DEyRUXaKy4865YLtMZNQFR1pP6l
+			var g9dxjJezebaDpYNrr28w6llCYuj3CjmHToSEZqvPtvin9r8v1MpikgIWxEysMKgSxTSBFWGU8mToPUsk tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(1))
+			_ = g9dxjJezebaDpYNrr28w6llCYuj3CjmHToSEZqvPtvin9r8v1MpikgIWxEysMKgSxTSBFWGU8mToPUsk
+			var l tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(2))
+			_ = l
+			var cKhN3iR7fDgQSGZgSMSiQ2ggTd3L1SHsceyjjJhnSV87xbOyNU4NzF0CnEdzbvgW9DSB5dCYsGJFD3qN51wvLGsF tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(3))
+			_ = cKhN3iR7fDgQSGZgSMSiQ2ggTd3L1SHsceyjjJhnSV87xbOyNU4NzF0CnEdzbvgW9DSB5dCYsGJFD3qN51wvLGsF
+			var k8c1KOOka4RRg5TBw tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(4))
+			_ = k8c1KOOka4RRg5TBw
+			var y4uGKna8xdgfDzMyI tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(5))
+			_ = y4uGKna8xdgfDzMyI
+			var uVIECX0F3wZf6xCEQ1Cp4DaZ1uAv522Q1zWDHmOjdWAx06AoRDXTjiZJoG9NpLwnsHb7WxQPY0474KClNORLfPME89nll tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(6))
+			_ = uVIECX0F3wZf6xCEQ1Cp4DaZ1uAv522Q1zWDHmOjdWAx06AoRDXTjiZJoG9NpLwnsHb7WxQPY0474KClNORLfPME89nll
+			var fUknjeOOnaWx3sIfmVfYiqRzfrSHiRwiHL6d1mGN7RBv5pmyyYoAfzcn9FkfPxgce4AffaukNe9XfmgLR5yGoYKc41tDVGehUWprtYrbl7fm6qEcOJ8s tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(7))
+			_ = fUknjeOOnaWx3sIfmVfYiqRzfrSHiRwiHL6d1mGN7RBv5pmyyYoAfzcn9FkfPxgce4AffaukNe9XfmgLR5yGoYKc41tDVGehUWprtYrbl7fm6qEcOJ8s
+			var migj7iG0I6GuUTlQKTQM1Rwi tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(8))
+			_ = migj7iG0I6GuUTlQKTQM1Rwi
+			var iGHhVrKWWnlrJbyPVE9LiaLlEamURRAYJiJpwIPn0i5XULAHIHVMH5z2NXcBld4RWouyx728U07dfQMVke tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(9))
+			_ = iGHhVrKWWnlrJbyPVE9LiaLlEamURRAYJiJpwIPn0i5XULAHIHVMH5z2NXcBld4RWouyx728U07dfQMVke
+			var fv69pjleEPVZtnrxqA2rKcBKiIabNlGfygfmwmYMz1zzcPNHBX7MECy18ghSrVVRyZwbcPlOoOdxNywKxhfkTItdoGwtQrEHMBhHyQs9pf tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(10))
+			_ = fv69pjleEPVZtnrxqA2rKcBKiIabNlGfygfmwmYMz1zzcPNHBX7MECy18ghSrVVRyZwbcPlOoOdxNywKxhfkTItdoGwtQrEHMBhHyQs9pf
+			var od4BtGrfKf0GR987y2cxEwXtNGxEYALQZaJmM4 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(11))
+			_ = od4BtGrfKf0GR987y2cxEwXtNGxEYALQZaJmM4
+			var rZGnmALbeC75Agvh7J8v4Hv5SMlZQzjqqpKpoZ2QViCntl5vKg2rAY5ZKbIllVwQtPJd tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(12))
+			_ = rZGnmALbeC75Agvh7J8v4Hv5SMlZQzjqqpKpoZ2QViCntl5vKg2rAY5ZKbIllVwQtPJd
+			var gJjqx5sJfjzNiLoyjIb1EBwSawNCaTsOl8aNGAOmxfonY5da1gqvNgrkIoGSyoAxtnT5vJwfSP7fYi8tRNuYDC9sB7Ql4 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(13))
+			_ = gJjqx5sJfjzNiLoyjIb1EBwSawNCaTsOl8aNGAOmxfonY5da1gqvNgrkIoGSyoAxtnT5vJwfSP7fYi8tRNuYDC9sB7Ql4
+			var i21pI9mfgY9dIH94015PhFHkaQR9Rc5lwTHEjRDc68s tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(14))
+			_ = i21pI9mfgY9dIH94015PhFHkaQR9Rc5lwTHEjRDc68s
+			var ld5jTtZYYG7ltitFE6XDxBsgsA00EjvIqcSA98TQ5JbLCeE1qSZiHVtcGJqrqC7nEfgEs7O7ys915IsyB tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(15))
+			_ = ld5jTtZYYG7ltitFE6XDxBsgsA00EjvIqcSA98TQ5JbLCeE1qSZiHVtcGJqrqC7nEfgEs7O7ys915IsyB
+			var fU4ZhdwnrDTJJMqhfHUhSc5Y3mszaJKxmX8Z69FVsJu6jfrSB0hY0JriPxsDRDO tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(16))
+			_ = fU4ZhdwnrDTJJMqhfHUhSc5Y3mszaJKxmX8Z69FVsJu6jfrSB0hY0JriPxsDRDO
+			var oXwS0QFnTLOvrWlM9aEggYaDnyym0zwlOSg6CdChWvWB1PkbyNSmBoUCJ5YEsflM2krQmr5ztzfgVo7nxQxYS7B9z tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(17))
+			_ = oXwS0QFnTLOvrWlM9aEggYaDnyym0zwlOSg6CdChWvWB1PkbyNSmBoUCJ5YEsflM2krQmr5ztzfgVo7nxQxYS7B9z
+			var iCZihesgSv1Kj1l1vrNQGfeTkJqCYnV1wJDgO2MoHa0wPdYsL tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(18))
+			_ = iCZihesgSv1Kj1l1vrNQGfeTkJqCYnV1wJDgO2MoHa0wPdYsL
+			var wILtvLK0lB49PkUR3L9w0l84bmLTt3ycM7tnqEPeeLbNOZGvGJpmrnoAg1uoL2Mj1r82QA tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(19))
+			_ = wILtvLK0lB49PkUR3L9w0l84bmLTt3ycM7tnqEPeeLbNOZGvGJpmrnoAg1uoL2Mj1r82QA
+			var daU1rD4XvM11Dh0Z1E3f21Sdm34L8fRrIA6xTtgm3xc2QinpI6D7YWEWx tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(20))
+			_ = daU1rD4XvM11Dh0Z1E3f21Sdm34L8fRrIA6xTtgm3xc2QinpI6D7YWEWx
+			var i4Ik0cO9nzF1J1YXWZWVCdP5wFrp8GPul4kchDfR5Bq04tMrIVNjEaqdt7IGPiclnZ2yPuWtK3I3WZzyAfIBM9QN0P7RwsLrLIjR12CMX tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(21))
+			_ = i4Ik0cO9nzF1J1YXWZWVCdP5wFrp8GPul4kchDfR5Bq04tMrIVNjEaqdt7IGPiclnZ2yPuWtK3I3WZzyAfIBM9QN0P7RwsLrLIjR12CMX
+			var uekcvaFwFZEcXj894jrOExqXhp01mcqMAckwEliCDZri1mUXUweapZTs3J tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(22))
+			_ = uekcvaFwFZEcXj894jrOExqXhp01mcqMAckwEliCDZri1mUXUweapZTs3J
+			var hRNCAzpQDvLdhaR7qaP8Zti8Mv14YZM tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(23))
+			_ = hRNCAzpQDvLdhaR7qaP8Zti8Mv14YZM
+			var i tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(24))
+			_ = i
+			var l0 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(25))
+			_ = l0
+			var gt4dpXECGpwdUtRF6HBlT96bQvURftglBYs tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(26))
+			_ = gt4dpXECGpwdUtRF6HBlT96bQvURftglBYs
+			var uBTusjHaRPTcj4gNswGXwxW76CzsY tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(27))
+			_ = uBTusjHaRPTcj4gNswGXwxW76CzsY
+			var lhzAooIW4yPfvZw2gWRctvamtCQaN70QC8p4mI8Ws4SXzM4rZM9GpKYeBfFl9Rrty5qc0l45hFEHT3cIrNEPW1LTToVbaL60IIFiPuva5z tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(28))
+			_ = lhzAooIW4yPfvZw2gWRctvamtCQaN70QC8p4mI8Ws4SXzM4rZM9GpKYeBfFl9Rrty5qc0l45hFEHT3cIrNEPW1LTToVbaL60IIFiPuva5z
+			var mpBtq07NUwOGF1kEHEeyeNuem426dtdDsK1BdPgNtdsEoVSeWm5B1bcOPqL0Za0TLM2nCRl9t7StPa1m97q tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(29))
+			_ = mpBtq07NUwOGF1kEHEeyeNuem426dtdDsK1BdPgNtdsEoVSeWm5B1bcOPqL0Za0TLM2nCRl9t7StPa1m97q
+			var dKw0Nto1qc7vasYEsCjILWcGDc1eeBNqj6Uh0zaZqGVAFm5UFotT4Ee526A7AtK7Gf5KeePcNgvdpBuw tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(30))
+			_ = dKw0Nto1qc7vasYEsCjILWcGDc1eeBNqj6Uh0zaZqGVAFm5UFotT4Ee526A7AtK7Gf5KeePcNgvdpBuw
+			var oCCrpH8fqsnCIWMhBB0jhYslkuBzU7PMJVfB2PLE6CUQCuyRsSnCRJf00dds0OwEJJDIq5Dg tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(31))
+			_ = oCCrpH8fqsnCIWMhBB0jhYslkuBzU7PMJVfB2PLE6CUQCuyRsSnCRJf00dds0OwEJJDIq5Dg
+			var bsoAmv5EnoN4SwF0J9LTzeX1ZkIoCEss8DGBmOpYbidS8di45UP3afUVmYR0 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(32))
+			_ = bsoAmv5EnoN4SwF0J9LTzeX1ZkIoCEss8DGBmOpYbidS8di45UP3afUVmYR0
+			var iqLrhBYxbMXsx4BApRiSr8iknniyuRgEzOn403urwDxafqwWrdrU tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(33))
+			_ = iqLrhBYxbMXsx4BApRiSr8iknniyuRgEzOn403urwDxafqwWrdrU
+			var mhfYEAubS5CatCK4k1kVr0Z60wCQF5C0ChSwDZoasv4WvXLNfvYjymB4PDVMqpL58f5mQOVvQsPtqIFXWkQpAtoohF0FmDyP6By5nql tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(34))
+			_ = mhfYEAubS5CatCK4k1kVr0Z60wCQF5C0ChSwDZoasv4WvXLNfvYjymB4PDVMqpL58f5mQOVvQsPtqIFXWkQpAtoohF0FmDyP6By5nql
+			var vwalgzPsH5Ozvoj4qfq1MaxRw59AiJdUmVZk9JPJ6zeej8s3FiKSjvf5EpGdmaOtY0HObL2EmXEcotBp tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(35))
+			_ = vwalgzPsH5Ozvoj4qfq1MaxRw59AiJdUmVZk9JPJ6zeej8s3FiKSjvf5EpGdmaOtY0HObL2EmXEcotBp
+			var k5 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(36))
+			_ = k5
+			var cIkqeFgSJ2MP tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(37))
+			_ = cIkqeFgSJ2MP
+			var gd25ASMG4bDqQujRTuJzy1HP4w58z60aUpsn7clURfefjdlYqgO1tJz33NDPhqGSrpN8kMhJUouta9 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(38))
+			_ = gd25ASMG4bDqQujRTuJzy1HP4w58z60aUpsn7clURfefjdlYqgO1tJz33NDPhqGSrpN8kMhJUouta9
+			var m9L8zjo0aGswN6WW2XgEFcvnjr6ngLpwPNhfacEb9w7XSXmZAzCFRgmM1N9HkYo0pQD1qHccHVdkQc5mZdgZJd6bJRgZYjtdB1DVTfAAX tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(39))
+			_ = m9L8zjo0aGswN6WW2XgEFcvnjr6ngLpwPNhfacEb9w7XSXmZAzCFRgmM1N9HkYo0pQD1qHccHVdkQc5mZdgZJd6bJRgZYjtdB1DVTfAAX
+			var mLM69dzknrXJVFwKGv8745F8zwRcA1LGr2HnoieWZjn0rPtWxpmSkZcdHMpys3q1l8J21hY8T25moQbUa0bopwSKN2NTZxOrLwHgbt7yXlODrnKL11j tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(40))
+			_ = mLM69dzknrXJVFwKGv8745F8zwRcA1LGr2HnoieWZjn0rPtWxpmSkZcdHMpys3q1l8J21hY8T25moQbUa0bopwSKN2NTZxOrLwHgbt7yXlODrnKL11j
+			var bJE6TGoXVZWMFrsHQC5IyYDgjhtGUuKs1U29AY2BJDkOCZKnpytnPocfBH tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(41))
+			_ = bJE6TGoXVZWMFrsHQC5IyYDgjhtGUuKs1U29AY2BJDkOCZKnpytnPocfBH
+			var y435lNoPv1sluztSYOBOc6Pjndhm9nORNBJlqDlM2SthpKpmwmtPYsr0hAN6RFtpfQkzNp7z8q tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(42))
+			_ = y435lNoPv1sluztSYOBOc6Pjndhm9nORNBJlqDlM2SthpKpmwmtPYsr0hAN6RFtpfQkzNp7z8q
+			var u0GUNrcxqAuzn tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(43))
+			_ = u0GUNrcxqAuzn
+			var hEUpe3bWYezaRaxqIscT tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(44))
+			_ = hEUpe3bWYezaRaxqIscT
+			var fkdAl0MFUeKEF9fByaikUUJlS6oMPyPG0SslERcyRmIClHsmBbJr8n0UrrrKbHgl8C04zZTqiB4pRd193mPztXJrneb6 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(45))
+			_ = fkdAl0MFUeKEF9fByaikUUJlS6oMPyPG0SslERcyRmIClHsmBbJr8n0UrrrKbHgl8C04zZTqiB4pRd193mPztXJrneb6
+			var tokB1hY8lGS9nPu1u0xT4TW5sEwZHbLznfMeMovhz011W7B5NmMsOj63XkGsYx tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(46))
+			_ = tokB1hY8lGS9nPu1u0xT4TW5sEwZHbLznfMeMovhz011W7B5NmMsOj63XkGsYx
+			var nZsJwMxtxC0sWzFQvmXDUyLh9CXD8ITyS4ecSZKFJHbHGkcJFrWSkMBfljapC3dnQCJjxIsxRoPe7Z0PQegW tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(47))
+			_ = nZsJwMxtxC0sWzFQvmXDUyLh9CXD8ITyS4ecSZKFJHbHGkcJFrWSkMBfljapC3dnQCJjxIsxRoPe7Z0PQegW
+			var g7Ohcx5hVjQ8wN tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(48))
+			_ = g7Ohcx5hVjQ8wN
+			var oe3eUfkcUK tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(49))
+			_ = oe3eUfkcUK
+			var hKpvO6G53irm43eluip tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(50))
+			_ = hKpvO6G53irm43eluip
+			var lCdNAfkuc8JxmsLmDSiCsRujrc3Dl0SPaRX5kv tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(51))
+			_ = lCdNAfkuc8JxmsLmDSiCsRujrc3Dl0SPaRX5kv
+			var avRfQVUQPT3eKQtMnGXN83nxrMPiVJ9buZsZdP9B1oZ2gx5sfDNKV tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(52))
+			_ = avRfQVUQPT3eKQtMnGXN83nxrMPiVJ9buZsZdP9B1oZ2gx5sfDNKV
+			var ppZhoZlryewU2MtOrGNrD0AbmblQkKhUBT3RrK6akJejk80fbs9QVgh5Y0NIOiZWHwCxQjKaq6A6RYNXF tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(53))
+			_ = ppZhoZlryewU2MtOrGNrD0AbmblQkKhUBT3RrK6akJejk80fbs9QVgh5Y0NIOiZWHwCxQjKaq6A6RYNXF
+			var npK9y4L16wQtf46IIxON7LOb7ow5Tye tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(54))
+			_ = npK9y4L16wQtf46IIxON7LOb7ow5Tye
+			var aKZOljceAct7mW8H7MlQyBgpVhTReXKOOuLZS9fcfXR00p0RVBLwU9zFQlmsPQnGpX tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(55))
+			_ = aKZOljceAct7mW8H7MlQyBgpVhTReXKOOuLZS9fcfXR00p0RVBLwU9zFQlmsPQnGpX
+			var wAFalERGHrTcVAN01Vi3SFDIFentDeBDNNegEscBZiWAhzNsq3mqOlbvKUuo6T6O9SmUmWaKN7e5htkb0h179EMcJTYUvKpAVAOsLhK1D tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(56))
+			_ = wAFalERGHrTcVAN01Vi3SFDIFentDeBDNNegEscBZiWAhzNsq3mqOlbvKUuo6T6O9SmUmWaKN7e5htkb0h179EMcJTYUvKpAVAOsLhK1D
+			var uOxzAnKeHASwe0Ck6FQSPv tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(57))
+			_ = uOxzAnKeHASwe0Ck6FQSPv
+			var hOwCBUjqZCDEkSgoFaH4F6UygS53ZWBrOjHqBFjbZgWguiEOoWtMQzrxed400ykKkLUW tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(58))
+			_ = hOwCBUjqZCDEkSgoFaH4F6UygS53ZWBrOjHqBFjbZgWguiEOoWtMQzrxed400ykKkLUW
+			var z8jOUHbR4d42VYtR076qjlJcf6EPRBnqbDwHv0c5VfLUQPl3RSzbiCH8o9BRbnn7FFmMMJ2fv2PTuyVem1W8cxvZInSCvaVndfkTrAVHn7qovdYwJ2Rk tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(59))
+			_ = z8jOUHbR4d42VYtR076qjlJcf6EPRBnqbDwHv0c5VfLUQPl3RSzbiCH8o9BRbnn7FFmMMJ2fv2PTuyVem1W8cxvZInSCvaVndfkTrAVHn7qovdYwJ2Rk
+			var e4TrHDH1SHmB5Alqm6OS77JAdzCVPZ tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(60))
+			_ = e4TrHDH1SHmB5Alqm6OS77JAdzCVPZ
+			var gBxNMxfOckUDavLpx7r003iEVCq3MxDu2YuZupWK5ihe9KXYpPFIwbeMNFEtucSLxwqKqcPRUgCqEUxxt tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(61))
+			_ = gBxNMxfOckUDavLpx7r003iEVCq3MxDu2YuZupWK5ihe9KXYpPFIwbeMNFEtucSLxwqKqcPRUgCqEUxxt
+			var xOfJQy5JIG59Tdn484vj8jdNe8jBu0ilWchXlxtHjluXeQK44T0ixOYQ6SVXaCdWiQhlykh1wBNPOJS8 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(62))
+			_ = xOfJQy5JIG59Tdn484vj8jdNe8jBu0ilWchXlxtHjluXeQK44T0ixOYQ6SVXaCdWiQhlykh1wBNPOJS8
+			var uj01lf5daBwCxyu3vFiccGcrAmcH0DkM9sEl2WCiHARi1wtzH0mLHVFh tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(63))
+			_ = uj01lf5daBwCxyu3vFiccGcrAmcH0DkM9sEl2WCiHARi1wtzH0mLHVFh
+			var bg96JRkgXC7ztyPUL1HhmUlAUYdQ tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(64))
+			_ = bg96JRkgXC7ztyPUL1HhmUlAUYdQ
+			var czgFBrnDEVAYgkRzwjdGuhMgHHCgjHFRUU6blcTjPrGIEqnoPWHC9ejJ1L3fZy4rA9RZknrI6cV9EKNR9f3l3jLxFsQbMDpw88ftbtb tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(65))
+			_ = czgFBrnDEVAYgkRzwjdGuhMgHHCgjHFRUU6blcTjPrGIEqnoPWHC9ejJ1L3fZy4rA9RZknrI6cV9EKNR9f3l3jLxFsQbMDpw88ftbtb
+			var lTmYKh4mERXJykE3Cyk tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(66))
+			_ = lTmYKh4mERXJykE3Cyk
+			var kQ161taWV7gyPnNFNiTJCwMjIq26CprqYHrT0TNwEQdVy8F9PxpO6dzBLCKYcM535QDMOdV66tigeFoq7rZL8Z7HU5jJ2XN2LX0gjzoH7 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(67))
+			_ = kQ161taWV7gyPnNFNiTJCwMjIq26CprqYHrT0TNwEQdVy8F9PxpO6dzBLCKYcM535QDMOdV66tigeFoq7rZL8Z7HU5jJ2XN2LX0gjzoH7
+			var aLL36SW1kuGkB4DXXO5I9ZRYK3qRkmGNWTu7YYoSFvY tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(68))
+			_ = aLL36SW1kuGkB4DXXO5I9ZRYK3qRkmGNWTu7YYoSFvY
+			var zDWASlZ6HmEWk9nJW6iZWexymVLLQ2rG7xDFJo4FkzTQkAr2cj2I6AHN7KZr2DxBCMntU5tq8Vb3Tr04R0y198DVlqU tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(69))
+			_ = zDWASlZ6HmEWk9nJW6iZWexymVLLQ2rG7xDFJo4FkzTQkAr2cj2I6AHN7KZr2DxBCMntU5tq8Vb3Tr04R0y198DVlqU
+			var pKyZGPESFkquVLXSJuhqKroq1pKj8erMuVOcTdg5FMVj9zAlOxXgL4w0DcELMWjsgSLbP9o5I0wWtnyBNv9wlAFIMqfKBTrB tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(70))
+			_ = pKyZGPESFkquVLXSJuhqKroq1pKj8erMuVOcTdg5FMVj9zAlOxXgL4w0DcELMWjsgSLbP9o5I0wWtnyBNv9wlAFIMqfKBTrB
+			var t29bgk2ZJQu4GeZbgjlcBaX9Ge1ngNoIaZeDClhh7An1Huf1l7hiTag1Bv7G0sqPsNd5gabU tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(71))
+			_ = t29bgk2ZJQu4GeZbgjlcBaX9Ge1ngNoIaZeDClhh7An1Huf1l7hiTag1Bv7G0sqPsNd5gabU
+			var ywh2uwztKjqcc7DYm8qLFRn0VhXRbZh8oaTbwfFxiR25amKkK8F9E6OP2p35 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(72))
+			_ = ywh2uwztKjqcc7DYm8qLFRn0VhXRbZh8oaTbwfFxiR25amKkK8F9E6OP2p35
+			var gepH5RpkNPeeKo17rEbYqthXISOOQPM6FQbuQSznVUsp2ZSbYFCs2eBMXKbVXuumUwaFJBYJMqR38HMsY0ecc5oS95c9WS45YV tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(73))
+			_ = gepH5RpkNPeeKo17rEbYqthXISOOQPM6FQbuQSznVUsp2ZSbYFCs2eBMXKbVXuumUwaFJBYJMqR38HMsY0ecc5oS95c9WS45YV
+			var iUIVUm1UeBpmSrlciuYAWx1zVM0GyND0FgHMD tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(74))
+			_ = iUIVUm1UeBpmSrlciuYAWx1zVM0GyND0FgHMD
+			var hDoU0uLv6 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(75))
+			_ = hDoU0uLv6
+			var eQfKWPOdbXmS0m2b tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(76))
+			_ = eQfKWPOdbXmS0m2b
+			var qeR797nItpBL5EjEbetJp69Y1PTAbRLtnQRlQPdsoayhRPaDitAjuaKkAxxZfy7rH6J tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(77))
+			_ = qeR797nItpBL5EjEbetJp69Y1PTAbRLtnQRlQPdsoayhRPaDitAjuaKkAxxZfy7rH6J
+			var l1PVzgusG8RkkXAMalbuBN4mqrigJIPcEdVuUK4xaH6GJVoyex23ukdaSw7DTepfgoyKawtLShEyM tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(78))
+			_ = l1PVzgusG8RkkXAMalbuBN4mqrigJIPcEdVuUK4xaH6GJVoyex23ukdaSw7DTepfgoyKawtLShEyM
+			var c tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(79))
+			_ = c
+			var r4Q58pfJyAafhnkbPcmOOS6VbQx1cHwXUeTcuyxRz5XrYpa93Z tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(80))
+			_ = r4Q58pfJyAafhnkbPcmOOS6VbQx1cHwXUeTcuyxRz5XrYpa93Z
+			var zQR5IS9GtzrubxmuLEROGH5emJRdufpf1ihWJMIKs tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(81))
+			_ = zQR5IS9GtzrubxmuLEROGH5emJRdufpf1ihWJMIKs
+			var eAYeTQyXNwDpF5VEOE8KHHNgYZkfbOUhZdKpGVW9I9UIcuzOKmzMhnh90stJDcsrYgZVVsfwuY2n6wfuwcu3 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(82))
+			_ = eAYeTQyXNwDpF5VEOE8KHHNgYZkfbOUhZdKpGVW9I9UIcuzOKmzMhnh90stJDcsrYgZVVsfwuY2n6wfuwcu3
+			var vFn3LNOVpbiq7tuhEJSNIJjveux705iDCJdBjKjxQVws2xFjKnS7b4mAqPXo2KDch6PsGCH0UewUSFvXVGgTFFC0wvWFCw1Vs6owSc9hnZ2Un0BuGHwj tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(83))
+			_ = vFn3LNOVpbiq7tuhEJSNIJjveux705iDCJdBjKjxQVws2xFjKnS7b4mAqPXo2KDch6PsGCH0UewUSFvXVGgTFFC0wvWFCw1Vs6owSc9hnZ2Un0BuGHwj
+			var yuFLCL6aaVmEW4bmbpGjUNLYiuXucuxJZL1pBsMZI1te5gRnMS1FMOyYfkHCwITT tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(84))
+			_ = yuFLCL6aaVmEW4bmbpGjUNLYiuXucuxJZL1pBsMZI1te5gRnMS1FMOyYfkHCwITT
+			var m8LjdcDBbhN12WEjOdZxR0PHq6Ge3cUpfK3LpfIL4J1oZmyzsKDK9f5G6FuQUIkCSi33CYSnHfzwnf4w tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(85))
+			_ = m8LjdcDBbhN12WEjOdZxR0PHq6Ge3cUpfK3LpfIL4J1oZmyzsKDK9f5G6FuQUIkCSi33CYSnHfzwnf4w
+			var gaqh00kjevWJPN3WYAe2ypzagLBB36AUxBfSXpu4q5aI2Nb0iBxkVJlGtkdE6HIoFI0hEvtepz0wBwLNp3 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(86))
+			_ = gaqh00kjevWJPN3WYAe2ypzagLBB36AUxBfSXpu4q5aI2Nb0iBxkVJlGtkdE6HIoFI0hEvtepz0wBwLNp3
+			var tZ55I2zjTaavm9Cn4UOEYMsu6hOq3nbPpaIZEAKAjtiNJvEdLlZzA8Cg6A1X9jmWWtkRkpudhne9TDeTsTeIEIPdSa5ZIdgth2cv319igdN tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(87))
+			_ = tZ55I2zjTaavm9Cn4UOEYMsu6hOq3nbPpaIZEAKAjtiNJvEdLlZzA8Cg6A1X9jmWWtkRkpudhne9TDeTsTeIEIPdSa5ZIdgth2cv319igdN
+			var pF tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(88))
+			_ = pF
+			var sf5 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(89))
+			_ = sf5
+			var wjhEQPRWAesuTYj9dZS tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(90))
+			_ = wjhEQPRWAesuTYj9dZS
+			var l6OZkLfPrAuBHFrSb1vtohYtSEZ7vOHn0ffS9uEDtcjuFZacNRwp690DCqg7KxfppJqFxZrPqm55l30194sRBHHAT tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(91))
+			_ = l6OZkLfPrAuBHFrSb1vtohYtSEZ7vOHn0ffS9uEDtcjuFZacNRwp690DCqg7KxfppJqFxZrPqm55l30194sRBHHAT
+			var rROpgx2EiqCNLxWihN62orN5k1NgzKbzdPHRrffNCPb9Msucn8aLfI5koNa8jMOYBBkEVnbQ tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(92))
+			_ = rROpgx2EiqCNLxWihN62orN5k1NgzKbzdPHRrffNCPb9Msucn8aLfI5koNa8jMOYBBkEVnbQ
+			var mct6JBgDp10C8S3JaA6u0V4ylbKDam31oEi4p5KO5XL3AI4xGCT7JYj6ULFawsrY84rmey8N3GP25Agy2Cwjwh1VExM2 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(93))
+			_ = mct6JBgDp10C8S3JaA6u0V4ylbKDam31oEi4p5KO5XL3AI4xGCT7JYj6ULFawsrY84rmey8N3GP25Agy2Cwjwh1VExM2
+			var zMfMHuoCZJqr9M4EFEoA6zeHvV8Qcmq3OEZatL2Bs8EJooKxO tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(94))
+			_ = zMfMHuoCZJqr9M4EFEoA6zeHvV8Qcmq3OEZatL2Bs8EJooKxO
+			var y7RreCQzwT2rdCkbl5NQhPYiYq8Xqwl8QlPJXBrE9TS4tYIWmXmJx9iWl0scuReRpyq2I2hSjgpyzGrixQ6TUXVMH1g4cryGl3ITIhtl tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(95))
+			_ = y7RreCQzwT2rdCkbl5NQhPYiYq8Xqwl8QlPJXBrE9TS4tYIWmXmJx9iWl0scuReRpyq2I2hSjgpyzGrixQ6TUXVMH1g4cryGl3ITIhtl
+			var ydWAMLx2MOT5MVTV4DoLy4m630MxBh8OzY27IXNa1UwzilN9ABwtkToLjGTMbiFUNXwOxBlp3LCAIzc3syTXB tla.TLAValue = args1[3]
+			_ = ydWAMLx2MOT5MVTV4DoLy4m630MxBh8OzY27IXNa1UwzilN9ABwtkToLjGTMbiFUNXwOxBlp3LCAIzc3syTXB
+			var etowK7EFoQUX59yBqoM8xi95lPHpb8CKaWYZGFxKRxvVmmjp1zNKReMj2HrSki1acfNsCMuaJKmBMp tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(1))
+			_ = etowK7EFoQUX59yBqoM8xi95lPHpb8CKaWYZGFxKRxvVmmjp1zNKReMj2HrSki1acfNsCMuaJKmBMp
+			var a284jbPbjVqF0X2UtJwmmVg1SH8p tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(2))
+			_ = a284jbPbjVqF0X2UtJwmmVg1SH8p
+			var iyabbQGHL3A2cofYgYDt8ozmCnXHg7SnWOLkzHp7H02SPsQ89 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(3))
+			_ = iyabbQGHL3A2cofYgYDt8ozmCnXHg7SnWOLkzHp7H02SPsQ89
+			var jyYnukIyxNzevc tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(4))
+			_ = jyYnukIyxNzevc
+			var gc7uZIc4dkcjHD4yMwUjh1X5NPGOeQI3M1omOaHpNWeTQrEp9VvisChoWylFaRVVBevgxw tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(5))
+			_ = gc7uZIc4dkcjHD4yMwUjh1X5NPGOeQI3M1omOaHpNWeTQrEp9VvisChoWylFaRVVBevgxw
+			var gUYwQyGuLX8Pgswn5OGTlo3xsHwsjx2oxuauVhHQvpEch tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(6))
+			_ = gUYwQyGuLX8Pgswn5OGTlo3xsHwsjx2oxuauVhHQvpEch
+			var nc7gNHdXkYpdKm5uY tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(7))
+			_ = nc7gNHdXkYpdKm5uY
+			var kKa0FOAnjnOrXmqpw5mzjiGjspYPvCI3puDlt1jTnz0hhp6eg73Xe9igS9tmIDWBiLcPUA9cZz9cSSqKDCG5DRShCWvfozxKiwTRbTHl93hm tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(8))
+			_ = kKa0FOAnjnOrXmqpw5mzjiGjspYPvCI3puDlt1jTnz0hhp6eg73Xe9igS9tmIDWBiLcPUA9cZz9cSSqKDCG5DRShCWvfozxKiwTRbTHl93hm
+			var rc5DaAuTaan tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(9))
+			_ = rc5DaAuTaan
+			var gSRc0p3YqpK5aKeQWCmRTo7k8eWHkG6Sj5hDc92IfyWAYScvcvVVoCysyt0DEcpBrLYPeRadjFUUky tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(10))
+			_ = gSRc0p3YqpK5aKeQWCmRTo7k8eWHkG6Sj5hDc92IfyWAYScvcvVVoCysyt0DEcpBrLYPeRadjFUUky
+			var wZZlJc0Y tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(11))
+			_ = wZZlJc0Y
+			var gDWU3Yaf5TpHjG16tqZ7Y4XBE9jOmuexTW9QTpXgxBtQtgMXDNLvJ8ouhyo5jTaciR93j0Uy1GSQGA7H68DWEhB8whiA tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(12))
+			_ = gDWU3Yaf5TpHjG16tqZ7Y4XBE9jOmuexTW9QTpXgxBtQtgMXDNLvJ8ouhyo5jTaciR93j0Uy1GSQGA7H68DWEhB8whiA
+			var kpNeEfCmQUFFhVOIKorlbs3VVmV5VtVKjo7GytUjHAClhKO6YsR7BcQ2q tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(13))
+			_ = kpNeEfCmQUFFhVOIKorlbs3VVmV5VtVKjo7GytUjHAClhKO6YsR7BcQ2q
+			var qk43zXiG6c1ynj6IN2RnEt9NrmHi7EgpE1k2xLX1YDLDBJ tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(14))
+			_ = qk43zXiG6c1ynj6IN2RnEt9NrmHi7EgpE1k2xLX1YDLDBJ
+			var ttTPqW49W9sPsq9eTaVglySJNPQcooadMmaqJlunPt1oNgeCYprWMXzH3LGXg243CSkqR8iMLQ4PQqEjagSLoILZVfus4yxYILWZgqXwpAgeQMbn tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(15))
+			_ = ttTPqW49W9sPsq9eTaVglySJNPQcooadMmaqJlunPt1oNgeCYprWMXzH3LGXg243CSkqR8iMLQ4PQqEjagSLoILZVfus4yxYILWZgqXwpAgeQMbn
+			var bLxaB8d6aVKDXuWJr4XzmtR8jYhIrV92vDW9aUsrqsAw4Z6n8352eB7TjeNcZHNnqIfMjPteXrjMZoKbRKBvDomajju tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(16))
+			_ = bLxaB8d6aVKDXuWJr4XzmtR8jYhIrV92vDW9aUsrqsAw4Z6n8352eB7TjeNcZHNnqIfMjPteXrjMZoKbRKBvDomajju
+			var cdfnWfOSyFE18Q5XNQwl5NMMwDXu2ju96Wz9xvDwgMWIotzEYJktrsg54SdkCFMfU01g8y9Pmv1xCpXdZXQB tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(17))
+			_ = cdfnWfOSyFE18Q5XNQwl5NMMwDXu2ju96Wz9xvDwgMWIotzEYJktrsg54SdkCFMfU01g8y9Pmv1xCpXdZXQB
+			var zP5iGEufFFM3CF9z2lpsxoGMC5stqTQHR4y5BPbITV1 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(18))
+			_ = zP5iGEufFFM3CF9z2lpsxoGMC5stqTQHR4y5BPbITV1
+			var hI tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(19))
+			_ = hI
+			var pFpHArc9p5ZIND2njiWMsGm0OvnOtHHZRByIHBxk tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(20))
+			_ = pFpHArc9p5ZIND2njiWMsGm0OvnOtHHZRByIHBxk
+			var cY79colCh1o9bcp435NIcbUct8 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(21))
+			_ = cY79colCh1o9bcp435NIcbUct8
+			var bfN44OtHoWkOhR2F00McwRMlUEsdYGyDDAMvLrzlaSDnfSAaqzO2OY2osJ9vqodMZRIcla5bOqUZ6PrBGDq86Psk tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(22))
+			_ = bfN44OtHoWkOhR2F00McwRMlUEsdYGyDDAMvLrzlaSDnfSAaqzO2OY2osJ9vqodMZRIcla5bOqUZ6PrBGDq86Psk
+			var mTVb3JafsZNJpol3pq8UXmCsBJFOgpbggMC2944raVCtsOb tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(23))
+			_ = mTVb3JafsZNJpol3pq8UXmCsBJFOgpbggMC2944raVCtsOb
+			var tSKI6eYDoE5joNQ3Y6KakTccVsRIeKNwEsWv4yP8TZUbkWd5sVcEVDX6ihRqFT7ZYzvdrSI0koJ7527iwk8Qj1i8TLq193qqcIPNtGrfls7xL4uY4 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(24))
+			_ = tSKI6eYDoE5joNQ3Y6KakTccVsRIeKNwEsWv4yP8TZUbkWd5sVcEVDX6ihRqFT7ZYzvdrSI0koJ7527iwk8Qj1i8TLq193qqcIPNtGrfls7xL4uY4
+			var z6egaVrC5vB2VWsxvCK2Gr82zmP71Pul2X8kxfBAzwdkzlEROGpIBAuEBa3KBwxvSFvYyE4oMKpZUAEpZXTPbV0Pgv tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(25))
+			_ = z6egaVrC5vB2VWsxvCK2Gr82zmP71Pul2X8kxfBAzwdkzlEROGpIBAuEBa3KBwxvSFvYyE4oMKpZUAEpZXTPbV0Pgv
+			var gu8MDnkov0JG5jYPIqtFE tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(26))
+			_ = gu8MDnkov0JG5jYPIqtFE
+			var q5xyWgJ00O99QHe9Fuz19PSzzjh0WMc0Ah5Zsdw40YSy7ki5ifLPnOaXRsDU1G4l4gCubvc78lPqhbqS3brYZYogz3KYRoOolMobW tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(27))
+			_ = q5xyWgJ00O99QHe9Fuz19PSzzjh0WMc0Ah5Zsdw40YSy7ki5ifLPnOaXRsDU1G4l4gCubvc78lPqhbqS3brYZYogz3KYRoOolMobW
+			var fRo9FMKcd6jzIQzPpJvmsLw6jeXmbREp4D tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(28))
+			_ = fRo9FMKcd6jzIQzPpJvmsLw6jeXmbREp4D
+			var pN3P tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(29))
+			_ = pN3P
+			var lC2jzwqrQxR7I0O42dhDQfDZP8QpyXEhKD8WBH4GUtSiLVndQQWiziLGdA2hScIRkXFrCbFtBgT0 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(30))
+			_ = lC2jzwqrQxR7I0O42dhDQfDZP8QpyXEhKD8WBH4GUtSiLVndQQWiziLGdA2hScIRkXFrCbFtBgT0
+			var leLRf5Ch6vAYJug1vzfRvaGEb9DpnXdKSbn69czrhsDRDgNB7TfMRX213AdtHkhnJ4dMLupvEw3dpEShS tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(31))
+			_ = leLRf5Ch6vAYJug1vzfRvaGEb9DpnXdKSbn69czrhsDRDgNB7TfMRX213AdtHkhnJ4dMLupvEw3dpEShS
+			var ptVm7bj9pNQv7K5kMh0F9AzdPZmZwsjkzEZhH5IkqS5Pfc4qz0sjsopB00AlY7xcl0JD022mayWJIozyb7i18Q0N0zwkQDFyUjXEnJZDg6 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(32))
+			_ = ptVm7bj9pNQv7K5kMh0F9AzdPZmZwsjkzEZhH5IkqS5Pfc4qz0sjsopB00AlY7xcl0JD022mayWJIozyb7i18Q0N0zwkQDFyUjXEnJZDg6
+			var fN4Fe0WJn43vUwG975r6I2jc8th1BpIFzN0t8aZrFs01bVuazR84TTauGkZUw1nEVqQzWtrxQwVXGhD9yHOJTUVus1Q0opNIGUJSZ6pUIo2AUCGe tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(33))
+			_ = fN4Fe0WJn43vUwG975r6I2jc8th1BpIFzN0t8aZrFs01bVuazR84TTauGkZUw1nEVqQzWtrxQwVXGhD9yHOJTUVus1Q0opNIGUJSZ6pUIo2AUCGe
+			var u tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(34))
+			_ = u
+			var yx0qRx7KwCSspcqGOhxVFZiQ41v8QpTI5vpQXBCxpOVllOkKQ4zav8G23KWLQAPt6PFLuzi tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(35))
+			_ = yx0qRx7KwCSspcqGOhxVFZiQ41v8QpTI5vpQXBCxpOVllOkKQ4zav8G23KWLQAPt6PFLuzi
+			var ykyhfTOp9JJ6LKf7iDYHiwcicY1Hk4yR64rrSta7VB7OjqEsXDubmvm42pqtKnPuXLM1R38RuGEX0OAnYeqxbfpSYRbmrKbBmt tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(36))
+			_ = ykyhfTOp9JJ6LKf7iDYHiwcicY1Hk4yR64rrSta7VB7OjqEsXDubmvm42pqtKnPuXLM1R38RuGEX0OAnYeqxbfpSYRbmrKbBmt
+			var jzRCQOkfoelnlGMWFBWD0lDMjB9wXh81Ec8VH66u8XFVQWmjlo8M6PTjeYRU7mNua tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(37))
+			_ = jzRCQOkfoelnlGMWFBWD0lDMjB9wXh81Ec8VH66u8XFVQWmjlo8M6PTjeYRU7mNua
+			var g540XiUzWuW tla.TLAValue = args1[5]
+			_ = g540XiUzWuW
+			var aYGs4t9TNazIlSTuMV2UZJE2Su42dELDJebUslriCHsCdePddP6kT4herkEEZkaBrLcr19VzcoQUqUxLDj7aM9GlCyqIWhrn1gQ12Wcafcg tla.TLAValue = args1[6]
+			_ = aYGs4t9TNazIlSTuMV2UZJE2Su42dELDJebUslriCHsCdePddP6kT4herkEEZkaBrLcr19VzcoQUqUxLDj7aM9GlCyqIWhrn1gQ12Wcafcg
+			var qvEBcszWN2nDUY1XVCrYsYFk8gOLE76XaPswHtQNF9Wf5L5hSOy7zlHGM8C85CJb tla.TLAValue = args1[7]
+			_ = qvEBcszWN2nDUY1XVCrYsYFk8gOLE76XaPswHtQNF9Wf5L5hSOy7zlHGM8C85CJb
+			var h3eVDpYOYfPEu4Uwhv2oV0zWfhNx8FQsgDrNkvE69UO0lBNdoAH5vCSe tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(1))
+			_ = h3eVDpYOYfPEu4Uwhv2oV0zWfhNx8FQsgDrNkvE69UO0lBNdoAH5vCSe
+			var t1QTK tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(2))
+			_ = t1QTK
+			var w84fPMrBuganXXVvLMtj tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(3))
+			_ = w84fPMrBuganXXVvLMtj
+			var rIzkGpiYy tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(4))
+			_ = rIzkGpiYy
+			var xoBTPIlIinSdHROOaCElKNzITkNhRPihyCCen3xwPLS2C tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(5))
+			_ = xoBTPIlIinSdHROOaCElKNzITkNhRPihyCCen3xwPLS2C
+			var mZHrcqrncSs2AMOV1UW5gS0ihqlvwsHKJiZpGxNkRMRTckDyFNFxBfH15bZLu9jGudmWKhHIQiGiG2POUchzl57W3EddAii8IMlkcGdktelu6nDzn33 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(6))
+			_ = mZHrcqrncSs2AMOV1UW5gS0ihqlvwsHKJiZpGxNkRMRTckDyFNFxBfH15bZLu9jGudmWKhHIQiGiG2POUchzl57W3EddAii8IMlkcGdktelu6nDzn33
+			var rlx7mFbcDMWHiryCJX7Q2XKsPXAlh0yPUfAA5b1N1HqL4PTrTZInaUqzMSexEm3iAilnPspoxsh5i9RVN4xN2TTHquRN1zkb4 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(7))
+			_ = rlx7mFbcDMWHiryCJX7Q2XKsPXAlh0yPUfAA5b1N1HqL4PTrTZInaUqzMSexEm3iAilnPspoxsh5i9RVN4xN2TTHquRN1zkb4
+			var m15lJDoqUAh8v7CsbTs3Wp tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(8))
+			_ = m15lJDoqUAh8v7CsbTs3Wp
+			var eOb6vevL81U tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(9))
+			_ = eOb6vevL81U
+			var hhJEaLY08Po16pXZswc7xw6l9UwQVwPn7bMvpc tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(10))
+			_ = hhJEaLY08Po16pXZswc7xw6l9UwQVwPn7bMvpc
+			var d18ashyGFyk9ia2yYMBEwYSV58ePxfIaztz3YhDg3gaBjp2G9tfUhtrObBNRXaSgjNnk3hB7pyOJ3qu0KMxzt9ZmHhscHn6KQXQWgWMqPuXARjWui0nx1 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(11))
+			_ = d18ashyGFyk9ia2yYMBEwYSV58ePxfIaztz3YhDg3gaBjp2G9tfUhtrObBNRXaSgjNnk3hB7pyOJ3qu0KMxzt9ZmHhscHn6KQXQWgWMqPuXARjWui0nx1
+			var zMNPvYJfm1cx1PCToyuBVV22dkoULajysp1XaJgmYxZcoIrFMyvfu6o6DugoUvktV tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(12))
+			_ = zMNPvYJfm1cx1PCToyuBVV22dkoULajysp1XaJgmYxZcoIrFMyvfu6o6DugoUvktV
+			var gRk00ax6tUAdm1b0w7QRt8L1UzEv tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(13))
+			_ = gRk00ax6tUAdm1b0w7QRt8L1UzEv
+			var vGqMXN0wkJ3AYAPG5KrhvsM586VwgWMfFvnwNwBmfPDmsaFmfXFyAwNuDT2i062k81JdpY6UZWmzrobu7 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(14))
+			_ = vGqMXN0wkJ3AYAPG5KrhvsM586VwgWMfFvnwNwBmfPDmsaFmfXFyAwNuDT2i062k81JdpY6UZWmzrobu7
+			var hmPP4RqXjv3PcM9L tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(15))
+			_ = hmPP4RqXjv3PcM9L
+			var p9BfAdDJta7j5vBu8GwE6v1KKzyg8LkDRBzKu3Th2olbGn6XxRZtsuXKw07F7C89gI9YkqB3M5E6ZZim5a3Eax3WdkROAOnQerx3yHwkJ tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(16))
+			_ = p9BfAdDJta7j5vBu8GwE6v1KKzyg8LkDRBzKu3Th2olbGn6XxRZtsuXKw07F7C89gI9YkqB3M5E6ZZim5a3Eax3WdkROAOnQerx3yHwkJ
+			var oLaDcBJTmTFAlGnwnA6knxTSMaN56l33Ev5hdiegDcn9aXcTPqIsbQU41r4veAanTQlUpeHo53xgaYfPMSVrk tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(17))
+			_ = oLaDcBJTmTFAlGnwnA6knxTSMaN56l33Ev5hdiegDcn9aXcTPqIsbQU41r4veAanTQlUpeHo53xgaYfPMSVrk
+			var ucSNWORVhygfRHKpn4EZ8fElwtzIuW2OApmJpIRLweHEUldjTlvEmB1qPxxjISGYdb tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(18))
+			_ = ucSNWORVhygfRHKpn4EZ8fElwtzIuW2OApmJpIRLweHEUldjTlvEmB1qPxxjISGYdb
+			var iL683B2m9GrO3u34uIAoRw15QQrKBZvTIm55Y tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(19))
+			_ = iL683B2m9GrO3u34uIAoRw15QQrKBZvTIm55Y
+			var mpP4hQJIVPMxCnewQANWCRsuwFPeq7XS tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(20))
+			_ = mpP4hQJIVPMxCnewQANWCRsuwFPeq7XS
+			var ins8blVUi3KO8anCtC3SvgYVqpHuHJ9uEaGaba1spCHti0mKebNfGulWGeIXs4R23MEFiINeQwQS64gSqJcblCd9doyBaQl5UfDHag3qDyTbF9OlKU tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(21))
+			_ = ins8blVUi3KO8anCtC3SvgYVqpHuHJ9uEaGaba1spCHti0mKebNfGulWGeIXs4R23MEFiINeQwQS64gSqJcblCd9doyBaQl5UfDHag3qDyTbF9OlKU
+			var eA5UggaJ3Yx3abzGfcG3ZZF6Y20NEyzjcPOxmvmGcchlkyA2Ch7smnK8omH9skKigTrgJRLsrojZAiKclu5iiQG5cBXj8wuUGSWSqLQ3fuvMZ2jgetpR tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(22))
+			_ = eA5UggaJ3Yx3abzGfcG3ZZF6Y20NEyzjcPOxmvmGcchlkyA2Ch7smnK8omH9skKigTrgJRLsrojZAiKclu5iiQG5cBXj8wuUGSWSqLQ3fuvMZ2jgetpR
+			var p10h4BGcoB tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(23))
+			_ = p10h4BGcoB
+			var gd8MthOgmLZ7OjY6IH7EOevgbEndCnHzCdQjRhvQJEwaPOtJsqh27djWnUtz1nUuNlt8RuG7xsF8ZvpZORalipTZcPseoiSEZ95jjKY2Ie tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(24))
+			_ = gd8MthOgmLZ7OjY6IH7EOevgbEndCnHzCdQjRhvQJEwaPOtJsqh27djWnUtz1nUuNlt8RuG7xsF8ZvpZORalipTZcPseoiSEZ95jjKY2Ie
+			var xHfBzjxJXztcx5YuayReuvyV5yQrePdTonughA5ZWkLq7nMQppIYyP1eYEaM9po3CdJw9qh0rJcyxdi54G4T5lXRVR2dDWIGwxfv tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(25))
+			_ = xHfBzjxJXztcx5YuayReuvyV5yQrePdTonughA5ZWkLq7nMQppIYyP1eYEaM9po3CdJw9qh0rJcyxdi54G4T5lXRVR2dDWIGwxfv
+			var q0tZNVYHRhVAso8zs6F2vKqynheOS tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(26))
+			_ = q0tZNVYHRhVAso8zs6F2vKqynheOS
+			var wyzr7I9kPR2eEemMHXGTWM4aiQjafWoFU67icaZ3djKFtY tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(27))
+			_ = wyzr7I9kPR2eEemMHXGTWM4aiQjafWoFU67icaZ3djKFtY
+			var hINowSsf8KIQkJh0mMmxzXB8QZd6RCSTyLm5Eba4clhn7pnU87NSXxjuKNVax4a9j6LUpgADam82QwidLSuFJT tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(28))
+			_ = hINowSsf8KIQkJh0mMmxzXB8QZd6RCSTyLm5Eba4clhn7pnU87NSXxjuKNVax4a9j6LUpgADam82QwidLSuFJT
+			var n7q7WqSNJijtwPrpV12Yp41f2Rwfrh6w359smpTQfjtX9vrjrvA07Mbv9J8Qu10m53R3zOxFDxvLkKFvl tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(29))
+			_ = n7q7WqSNJijtwPrpV12Yp41f2Rwfrh6w359smpTQfjtX9vrjrvA07Mbv9J8Qu10m53R3zOxFDxvLkKFvl
+			var f8MC8TIHp28cDEZjkWnMaEBEClVsMk20W6EmqKwY2tXsWLZv6FhZ5NmY7hstbJaTDnOowsBWNOMBp9TFNQ5LT1HqjZRjW0pyvfNZshOER tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(30))
+			_ = f8MC8TIHp28cDEZjkWnMaEBEClVsMk20W6EmqKwY2tXsWLZv6FhZ5NmY7hstbJaTDnOowsBWNOMBp9TFNQ5LT1HqjZRjW0pyvfNZshOER
+			var mQ7Xw9sjbTpcM7EC62YahcXERqH88vg8iSLebHbamQexM9ZClCz7rUqHQzR2sMdzmX3pXlzBFDDCXxW7o3YKf tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(31))
+			_ = mQ7Xw9sjbTpcM7EC62YahcXERqH88vg8iSLebHbamQexM9ZClCz7rUqHQzR2sMdzmX3pXlzBFDDCXxW7o3YKf
+			var wdOPDSYTrzfKgTw9FR4fHF0YfrWS47ockurHMQWKNmhCAn3H6MqRlq6NFzYUDMa8Ykf9IeVbI4XwjfjWw tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(32))
+			_ = wdOPDSYTrzfKgTw9FR4fHF0YfrWS47ockurHMQWKNmhCAn3H6MqRlq6NFzYUDMa8Ykf9IeVbI4XwjfjWw
+			var yxVcTiHXLl72oQ3JhJXkIRPuECC0xasg3ZfavcnUGGjiIxJKUWaarEGVHgQuHsIVHWiIoALs tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(33))
+			_ = yxVcTiHXLl72oQ3JhJXkIRPuECC0xasg3ZfavcnUGGjiIxJKUWaarEGVHgQuHsIVHWiIoALs
+			var aula1nESBOVd5gtPGPPMJ2OsrbYw5AhLtJhjCzGK2c4F6wYnFrAVpw1BSqKsTiDJtDEzKi68D0dfD7s6HTU74PRgvlN7vdUzcoK tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(34))
+			_ = aula1nESBOVd5gtPGPPMJ2OsrbYw5AhLtJhjCzGK2c4F6wYnFrAVpw1BSqKsTiDJtDEzKi68D0dfD7s6HTU74PRgvlN7vdUzcoK
+			var uqPDtPMFGvNAUyqhW6ju3Cx5YsOXrFW30p8fSLQkX1LRrt2qmSx tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(35))
+			_ = uqPDtPMFGvNAUyqhW6ju3Cx5YsOXrFW30p8fSLQkX1LRrt2qmSx
+			var ikA8zqABKwdOzA7mEXO5qb4MxJX7pFNthLpKQpBwg66bjDRf3J6uybAyIXjYF2 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(36))
+			_ = ikA8zqABKwdOzA7mEXO5qb4MxJX7pFNthLpKQpBwg66bjDRf3J6uybAyIXjYF2
+			var stAnRH2OXCG0ioTrC0ZJqMfzTMUt5yc6GenUjgt6FIoLViffJj0tgCSTYNWz3GQX8U4xl57eH3hgp4bMmctt4bCcd1WbbtXFhiI1bI5PeyJo tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(37))
+			_ = stAnRH2OXCG0ioTrC0ZJqMfzTMUt5yc6GenUjgt6FIoLViffJj0tgCSTYNWz3GQX8U4xl57eH3hgp4bMmctt4bCcd1WbbtXFhiI1bI5PeyJo
+			var iRGDAtLyvp6Md0oLl5cNrVFOuisIM8yidqHF1c8bmhxfEmTr08USlkdOu06BqiFj4tFluLO9HQbzmR17pjG8T7TsIWryTQ2wmKzGEspd0TRRJP3sz64 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(38))
+			_ = iRGDAtLyvp6Md0oLl5cNrVFOuisIM8yidqHF1c8bmhxfEmTr08USlkdOu06BqiFj4tFluLO9HQbzmR17pjG8T7TsIWryTQ2wmKzGEspd0TRRJP3sz64
+			var t8cbNVkDolkUg9dbWuAS2SexNQ1sUTqri8SJhn72JI95PTAEWEOXmSaoZqXX7m1Ld9vnRlgKOE2xl3qe5PDIJrvKew5F tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(39))
+			_ = t8cbNVkDolkUg9dbWuAS2SexNQ1sUTqri8SJhn72JI95PTAEWEOXmSaoZqXX7m1Ld9vnRlgKOE2xl3qe5PDIJrvKew5F
+			var iIwoThkOieWB6OiZisAXnkWqEAnX7fRewORrYSG3XfgOoJsinufiA4y332X0KJ4JZF72fDx7F7jfeAEZqbiSSdHAJovgiHC7itT52KV1nMtBabZhHN tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(40))
+			_ = iIwoThkOieWB6OiZisAXnkWqEAnX7fRewORrYSG3XfgOoJsinufiA4y332X0KJ4JZF72fDx7F7jfeAEZqbiSSdHAJovgiHC7itT52KV1nMtBabZhHN
+			var hA1qX30I4P9t0OnT7oEvKiuO7L3G84vV2hFEQv1ZEoNictCnScNw8KokcyF8TvQ0mE tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(41))
+			_ = hA1qX30I4P9t0OnT7oEvKiuO7L3G84vV2hFEQv1ZEoNictCnScNw8KokcyF8TvQ0mE
+			var emrTERlQAH3oV9oYXudCUeDgEZMt6kUJ11fyAseG1hC3sicpBsTiycEmSWF5Z6G3RE2 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(42))
+			_ = emrTERlQAH3oV9oYXudCUeDgEZMt6kUJ11fyAseG1hC3sicpBsTiycEmSWF5Z6G3RE2
+			var fijjCjv5z9691cRJJq6MfTy46rX97RrYjqjbjOf44ComM tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(43))
+			_ = fijjCjv5z9691cRJJq6MfTy46rX97RrYjqjbjOf44ComM
+			var v2Iy0SxiELhDNug49ER6Esbp6qXa1tgD1UJV1iejLCaprBqtU6oSGjiOprhLIfA6xTBCNEjuwJnqoSgFZkzybwzGYx3i tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(44))
+			_ = v2Iy0SxiELhDNug49ER6Esbp6qXa1tgD1UJV1iejLCaprBqtU6oSGjiOprhLIfA6xTBCNEjuwJnqoSgFZkzybwzGYx3i
+			var rqNra75eWr7q4pJ0rg2UI3IUo0XvrM1Bu5AEocttlHOLhjtVIvAG4b4B9gpQF7VwcGaO7opgMyAjMPA3hRdvKdWxjIl0Zsv02CoCSEgo6yQFht956oPF tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(45))
+			_ = rqNra75eWr7q4pJ0rg2UI3IUo0XvrM1Bu5AEocttlHOLhjtVIvAG4b4B9gpQF7VwcGaO7opgMyAjMPA3hRdvKdWxjIl0Zsv02CoCSEgo6yQFht956oPF
+			var eX4JH3ImJeq8Z6YyDfevUYywQa4s1Ghu tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(46))
+			_ = eX4JH3ImJeq8Z6YyDfevUYywQa4s1Ghu
+			var uUSpvtA tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(47))
+			_ = uUSpvtA
+			var amAm22z3MgN3OeB86tFuQ6buVYn9P5i tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(48))
+			_ = amAm22z3MgN3OeB86tFuQ6buVYn9P5i
+			var yFxIyFCElUVv0ZZZHWWmPCwaSc4qzJO64oBFBPNJKUvHTNDiqhaYv43JzC8xMyrLDrom6QRrHDOfMuXAVGG tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(49))
+			_ = yFxIyFCElUVv0ZZZHWWmPCwaSc4qzJO64oBFBPNJKUvHTNDiqhaYv43JzC8xMyrLDrom6QRrHDOfMuXAVGG
+			var tVwg55F0kCdzGoYRUoBLZUZRJRtrzpW2sHs3Q6QWPuY tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(50))
+			_ = tVwg55F0kCdzGoYRUoBLZUZRJRtrzpW2sHs3Q6QWPuY
+			var hTRYDwP87ZFQW7563Yzdg2KvcMAfYo4hotUl6S35gEp85Qp9jMuXU4F2JG1r tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(51))
+			_ = hTRYDwP87ZFQW7563Yzdg2KvcMAfYo4hotUl6S35gEp85Qp9jMuXU4F2JG1r
+			var aqqxCscVkHYyTEGoT7AC6RjmRK6b7TKrcvATRrSn0GINIQNOFGAwnXRmvccGp7umbpNOP2cUadlcdmmvoYIFJerJaFAkADDiW6pdngRE9I tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(52))
+			_ = aqqxCscVkHYyTEGoT7AC6RjmRK6b7TKrcvATRrSn0GINIQNOFGAwnXRmvccGp7umbpNOP2cUadlcdmmvoYIFJerJaFAkADDiW6pdngRE9I
+			var ikyVMhosvYlAtsybOWmYykzeofSZ2c2A8iucHrN7rDBke2Lvs93STQrMUzUt6I tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(53))
+			_ = ikyVMhosvYlAtsybOWmYykzeofSZ2c2A8iucHrN7rDBke2Lvs93STQrMUzUt6I
+			var oHZWS2wwae9QE9p595HGbB41GTPhl tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(54))
+			_ = oHZWS2wwae9QE9p595HGbB41GTPhl
+			var rNrjLfORxO2mLlyGCNdSUX2py6sLxw9pdHpcz6JA6WHdINtkCh7fxAlPl5fvPvsRoIrLtLL0c6crJ1IxSdYYynKeqwojXdFMlMsvTpTsobKeeHmX5 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(55))
+			_ = rNrjLfORxO2mLlyGCNdSUX2py6sLxw9pdHpcz6JA6WHdINtkCh7fxAlPl5fvPvsRoIrLtLL0c6crJ1IxSdYYynKeqwojXdFMlMsvTpTsobKeeHmX5
+			var oWO0T1AwaZqJ7ggl2TycDqIzvep9DqVWLPEdKnxzcRIs4va7nYqsPw0ZM4VoCPkSx4Djai4HBiWzptoW5NBqZNrcBnt3XblOsdRntN5 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(56))
+			_ = oWO0T1AwaZqJ7ggl2TycDqIzvep9DqVWLPEdKnxzcRIs4va7nYqsPw0ZM4VoCPkSx4Djai4HBiWzptoW5NBqZNrcBnt3XblOsdRntN5
+			var uuyx34pOqEXHtPRQVm5q4FQCtAGkINPFGuDjg2YWkcrTUsyW4eONwTwYhoEdRwKfd tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(57))
+			_ = uuyx34pOqEXHtPRQVm5q4FQCtAGkINPFGuDjg2YWkcrTUsyW4eONwTwYhoEdRwKfd
+			var i5pdkjoQEmYyAKaccMaT5Y1BbcWEU7hQFdA8D tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(58))
+			_ = i5pdkjoQEmYyAKaccMaT5Y1BbcWEU7hQFdA8D
+			var u4TFyZmNLRu0jMq9kRj5ByuIyaavjaXC tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(59))
+			_ = u4TFyZmNLRu0jMq9kRj5ByuIyaavjaXC
+			var bCnd3lgbn8AEYrz35Olj4mrfMaUA0rMMMJNLlWinfF7xDZT9eQU2hi8c2wby32i02n6 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(60))
+			_ = bCnd3lgbn8AEYrz35Olj4mrfMaUA0rMMMJNLlWinfF7xDZT9eQU2hi8c2wby32i02n6
+			var l5a00qJjCkAUhJbbGXfx tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(61))
+			_ = l5a00qJjCkAUhJbbGXfx
+			var whk5pbbXrl8EyktJfL4uH9OjPtgPDkGie2vGhtitYIhrPMrfWNC1aTbjM0jGvxT0PFRN tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(62))
+			_ = whk5pbbXrl8EyktJfL4uH9OjPtgPDkGie2vGhtitYIhrPMrfWNC1aTbjM0jGvxT0PFRN
+			var vkicNB7VCEXLYqZy8rM8zn3kBDPcraPv tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(63))
+			_ = vkicNB7VCEXLYqZy8rM8zn3kBDPcraPv
+			var vXaFAhLFk01GkX23lxfLzHmoUBEPOaGqmi9nagFcxSInh0WRGRWWgW2B5DICc2qAkY tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(64))
+			_ = vXaFAhLFk01GkX23lxfLzHmoUBEPOaGqmi9nagFcxSInh0WRGRWWgW2B5DICc2qAkY
+			var ug6HFyKbt9wHl5PuLs5fPf2cgXIgDOVT5ZytO6vy1aI8XYQmiWfLwrYBucJXLd tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(65))
+			_ = ug6HFyKbt9wHl5PuLs5fPf2cgXIgDOVT5ZytO6vy1aI8XYQmiWfLwrYBucJXLd
+			var moR8Z3AoE9eRiTrwEqLfeA2v851cvJqmyDosu5r0i5WQBhXfOZofS3gJEnoZdICB2CCP8ukB8COzK7tmUJKXsPcFBcmkOQWLX8KUCpizTD tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(66))
+			_ = moR8Z3AoE9eRiTrwEqLfeA2v851cvJqmyDosu5r0i5WQBhXfOZofS3gJEnoZdICB2CCP8ukB8COzK7tmUJKXsPcFBcmkOQWLX8KUCpizTD
+			var lWqoslAyOe7ZbNPnWBngrgWGxeknwngY4XocKrdbicBzkqMk9SxC0 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(67))
+			_ = lWqoslAyOe7ZbNPnWBngrgWGxeknwngY4XocKrdbicBzkqMk9SxC0
+			var fDZFG5YJL1axDp67CRoJmVHCMA3yYgOE0y06zPHwN9uvyAAhMyBXmoPuh19En4oSL8V6A7a4nCt5qFHme4oO3iXW tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(68))
+			_ = fDZFG5YJL1axDp67CRoJmVHCMA3yYgOE0y06zPHwN9uvyAAhMyBXmoPuh19En4oSL8V6A7a4nCt5qFHme4oO3iXW
+			var iBxgZiS1rbq71UK6IMipVYB5cP0d57cZ4iMqmO6kiNNLaOZBVBXnM3P7jGe0Gu2B tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(69))
+			_ = iBxgZiS1rbq71UK6IMipVYB5cP0d57cZ4iMqmO6kiNNLaOZBVBXnM3P7jGe0Gu2B
+			var klTVkZrEM0oSSpxtOuRrXc9VYtd24XL3vmvOH975eAFX tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(70))
+			_ = klTVkZrEM0oSSpxtOuRrXc9VYtd24XL3vmvOH975eAFX
+			var oCovnHJeKvWaoxLRBc7cLvPOoIkUlkuw7HiTbmTGezyTo6hIra2H1Chv91wdSV32UQT1V tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(71))
+			_ = oCovnHJeKvWaoxLRBc7cLvPOoIkUlkuw7HiTbmTGezyTo6hIra2H1Chv91wdSV32UQT1V
+			var yjjjqdnUl3YMMlHu2yntutqjPMQDv4cVVqze2q7oUid9KMJZaR7aVbdlq26WcXh3ZktjggjvEJRqXl4IvWFfGDYfvWyWuwojYTBcMMpMZ7 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(72))
+			_ = yjjjqdnUl3YMMlHu2yntutqjPMQDv4cVVqze2q7oUid9KMJZaR7aVbdlq26WcXh3ZktjggjvEJRqXl4IvWFfGDYfvWyWuwojYTBcMMpMZ7
+			var rUIeBO4PxP1pyorqVHawVRRnsesBLJ0YIHn7fCRsiXCOT tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(73))
+			_ = rUIeBO4PxP1pyorqVHawVRRnsesBLJ0YIHn7fCRsiXCOT
+			var vh8mQA382qTy9CVlIARC96Xy19uJpT62YDsMtgsN5FgKySieD9BUg006FgKKEkmJwadKGjt4GeD6G7R9UlVnfCG78FBQrQ149xJnYlEui5L tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(74))
+			_ = vh8mQA382qTy9CVlIARC96Xy19uJpT62YDsMtgsN5FgKySieD9BUg006FgKKEkmJwadKGjt4GeD6G7R9UlVnfCG78FBQrQ149xJnYlEui5L
+			var gdR9kpCGH2jL945wF4kUBkjDqTXJ6w1HWXLbbBLA3b8EntiT2gqUtu0vMHVv3fcfxyC0Fw3rokPPDZu64FLpi2lqSx6DU7KHRiwP1ASNE5s4LUYm0kij tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(75))
+			_ = gdR9kpCGH2jL945wF4kUBkjDqTXJ6w1HWXLbbBLA3b8EntiT2gqUtu0vMHVv3fcfxyC0Fw3rokPPDZu64FLpi2lqSx6DU7KHRiwP1ASNE5s4LUYm0kij
+			var cd6WKGw8Hw03xfROLA1IIb9BL1ObPKy1oQVQ1hcsq5KLO6Zxvm0j6LYpO0sXeMkHWlu8pfB0pOQqC8j8qacAsXPKHP0hzwsbbkjrH2YzAK tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(76))
+			_ = cd6WKGw8Hw03xfROLA1IIb9BL1ObPKy1oQVQ1hcsq5KLO6Zxvm0j6LYpO0sXeMkHWlu8pfB0pOQqC8j8qacAsXPKHP0hzwsbbkjrH2YzAK
+			var dbM1TkwpRPc0PgWiaVEXB1tel47umanCFzybpcfmVQvr9XTtONAD9aQx743VciZpEpcgqs3wOTpvBx7hSzrYLz tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(77))
+			_ = dbM1TkwpRPc0PgWiaVEXB1tel47umanCFzybpcfmVQvr9XTtONAD9aQx743VciZpEpcgqs3wOTpvBx7hSzrYLz
+			var jRL2MVQT6WnZXT4qY1nuotAa8YDXkW tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(78))
+			_ = jRL2MVQT6WnZXT4qY1nuotAa8YDXkW
+			var sYuXWqFveyFtOFyAEI3JyV4PzEg7uFAPR1AaaBBzn9aA76x4vNpLnZ3wOuQhIusHBwQZyq9BJq4wIWWsqt8BdwiIaBp0JT tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(79))
+			_ = sYuXWqFveyFtOFyAEI3JyV4PzEg7uFAPR1AaaBBzn9aA76x4vNpLnZ3wOuQhIusHBwQZyq9BJq4wIWWsqt8BdwiIaBp0JT
+			var gFGwu6fi0A4zAoz5i6YEzv6 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(80))
+			_ = gFGwu6fi0A4zAoz5i6YEzv6
+			var swOt2qi0E1rLgTxgU4KDtMlpBWR3M4eqFvkD0BAA1XvYhek92SBYxyv8UwA5Vy6e0n0cs6SXV8c5lZzB9 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(81))
+			_ = swOt2qi0E1rLgTxgU4KDtMlpBWR3M4eqFvkD0BAA1XvYhek92SBYxyv8UwA5Vy6e0n0cs6SXV8c5lZzB9
+			var uvdQCyNEyoGGHcZxJzsIQ53tDYozQsg0NO4wFu2NN3JollurhhbUoTsz3cL tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(82))
+			_ = uvdQCyNEyoGGHcZxJzsIQ53tDYozQsg0NO4wFu2NN3JollurhhbUoTsz3cL
+			var lLQxPtGr4WtU5ofH0 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(83))
+			_ = lLQxPtGr4WtU5ofH0
+			var lRr9cePefrg21fkGJg8dH2OtioaXHT35VyYJHFPtIc1jgcPJwVJvS8kQPR7Nsj3SkZdtXlm tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(84))
+			_ = lRr9cePefrg21fkGJg8dH2OtioaXHT35VyYJHFPtIc1jgcPJwVJvS8kQPR7Nsj3SkZdtXlm
+			var fmkrGQGy0GYAp7uQbt6Tlyvxdvk2gUECy7scMvzPxMz9R2MkeBO tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(85))
+			_ = fmkrGQGy0GYAp7uQbt6Tlyvxdvk2gUECy7scMvzPxMz9R2MkeBO
+			var rAcqRbdabaLQ3A2Q1sjMasaUtyMzXkZ6iwzn97HMGgS39ELQY023rPiYJ1ydURjAj tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(86))
+			_ = rAcqRbdabaLQ3A2Q1sjMasaUtyMzXkZ6iwzn97HMGgS39ELQY023rPiYJ1ydURjAj
+			var bYNTxT6JlQpVaZSTwpz2zzis24SZd91K7fjoxaNAdmmwunHK46gABJY3lDsXe tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(87))
+			_ = bYNTxT6JlQpVaZSTwpz2zzis24SZd91K7fjoxaNAdmmwunHK46gABJY3lDsXe
+			var gLsn8tgsdyQG1eQb8vvFgM7iM47pEE5pM264jxo3wmXJIjircWHKViHv4Lilw9o9IzKMt tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(88))
+			_ = gLsn8tgsdyQG1eQb8vvFgM7iM47pEE5pM264jxo3wmXJIjircWHKViHv4Lilw9o9IzKMt
+			var xYStFbWb0P07CjV3kNi12Xwm88Z9IgMXZYeLGJ7y7m8qeqgMrXo5oH6k08vKgH tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(89))
+			_ = xYStFbWb0P07CjV3kNi12Xwm88Z9IgMXZYeLGJ7y7m8qeqgMrXo5oH6k08vKgH
+			var kQPlBCYdjVDruBURZihFVCbjDJlF2NOMcTdR7APGD4dIY7ejSYZKCoB0k1HyvYm8P62KHgCxSXN21LPZXAo2FYK1qvXzUSirAbtieFA6 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(90))
+			_ = kQPlBCYdjVDruBURZihFVCbjDJlF2NOMcTdR7APGD4dIY7ejSYZKCoB0k1HyvYm8P62KHgCxSXN21LPZXAo2FYK1qvXzUSirAbtieFA6
+			var xkty3141qL91g2tPyGPRcSL9OL5ij82ko9dkJtOelzkIGgCZhEZXmvn tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(91))
+			_ = xkty3141qL91g2tPyGPRcSL9OL5ij82ko9dkJtOelzkIGgCZhEZXmvn
+			var aavJ4HsMryy871Gq tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(92))
+			_ = aavJ4HsMryy871Gq
+			var c2m6u7Zv49W0P5NRrnUVkAIjnRb1jWc2Vt9qfZy58QxIla8Ik1ucwPHORcQ9VbitS01y0ZMx6NnFpq5dQLZ tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(93))
+			_ = c2m6u7Zv49W0P5NRrnUVkAIjnRb1jWc2Vt9qfZy58QxIla8Ik1ucwPHORcQ9VbitS01y0ZMx6NnFpq5dQLZ
+			var xZirE4BsNw6pEvk0z5q7XsTSdYz6Hj75NurpA9xSolCkUdkx3ruVqQKAeHmIaksrWEQBzW2DAsuT8lwSA6PtYc0hzOBTf8pNCkNAQt tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(94))
+			_ = xZirE4BsNw6pEvk0z5q7XsTSdYz6Hj75NurpA9xSolCkUdkx3ruVqQKAeHmIaksrWEQBzW2DAsuT8lwSA6PtYc0hzOBTf8pNCkNAQt
+			var mZxXvgf1HMjOr5hVjtWnsD9tnObCPQq9ZH tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(95))
+			_ = mZxXvgf1HMjOr5hVjtWnsD9tnObCPQq9ZH
+			var nLo3pYo61mbUlziHU8lMAhK9cYnvTXX4Hn1NNzuKI7T68Qk tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(96))
+			_ = nLo3pYo61mbUlziHU8lMAhK9cYnvTXX4Hn1NNzuKI7T68Qk
+			var mmcY3EnEPtq7zUi56lBf9r1yOgCJC10HoMEU6oWVLDzH tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(97))
+			_ = mmcY3EnEPtq7zUi56lBf9r1yOgCJC10HoMEU6oWVLDzH
+			var advsjMU0ozmbKV8ICxJsgpJmWSvFpWyVG9jJAviuTP9BvIRcJqVZXy8stV0MVy6cyNbVKlJ8CmzC0zHJ6YHiKyJ tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(98))
+			_ = advsjMU0ozmbKV8ICxJsgpJmWSvFpWyVG9jJAviuTP9BvIRcJqVZXy8stV0MVy6cyNbVKlJ8CmzC0zHJ6YHiKyJ
+			var fOG2xUTp tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(99))
+			_ = fOG2xUTp
+			var gViVRGnWamBkkT6kyCckMiDCFP8zfADlQ5CfUmeeZ4HriBRjPK5aS54sbrngczZzu7rJcUJ1QdF1hPgw4bOWJQeiA9S6gt tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(100))
+			_ = gViVRGnWamBkkT6kyCckMiDCFP8zfADlQ5CfUmeeZ4HriBRjPK5aS54sbrngczZzu7rJcUJ1QdF1hPgw4bOWJQeiA9S6gt
+			var jCMELIWgOPVtYpCiLoligcNKYM2J4pCNiv5dAPSr4MHZ4aVnUUUMQfz059tqJbMBEZ7vonfVo tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(101))
+			_ = jCMELIWgOPVtYpCiLoligcNKYM2J4pCNiv5dAPSr4MHZ4aVnUUUMQfz059tqJbMBEZ7vonfVo
+			var a8j6jcVYaWWB4ia7c51xTfXWsGEnTtz tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(102))
+			_ = a8j6jcVYaWWB4ia7c51xTfXWsGEnTtz
+			var dEPzrqpjIzC2YP9HlCbOJ5jY7n28ywVJdOLx5UxWxZKx0bwWs0zPOyi7q9j4IZ08tqOmiKaP72tFH4R0EBxpPhefK8fB0G324w1QDghsD2Z tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(103))
+			_ = dEPzrqpjIzC2YP9HlCbOJ5jY7n28ywVJdOLx5UxWxZKx0bwWs0zPOyi7q9j4IZ08tqOmiKaP72tFH4R0EBxpPhefK8fB0G324w1QDghsD2Z
+			var gZYU2nUuXcbdNfMsKtX5OOQ6a tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(104))
+			_ = gZYU2nUuXcbdNfMsKtX5OOQ6a
+			var v tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(105))
+			_ = v
+			var bebyNgc2YIAOawioJV5JakVRVmSU4lyAN tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(106))
+			_ = bebyNgc2YIAOawioJV5JakVRVmSU4lyAN
+			var azxmyMuR1B4RuUOrySet6019Vo6oLvO2x2ldWLuC tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(107))
+			_ = azxmyMuR1B4RuUOrySet6019Vo6oLvO2x2ldWLuC
+			var b0caZBAxMtl4jaZHBNd tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(108))
+			_ = b0caZBAxMtl4jaZHBNd
+			var aXxRiFCwKxGfz890qLVt0RDm1D2UCfbScKRxr3jBLXTLhmFnJbrMn8yJe tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(109))
+			_ = aXxRiFCwKxGfz890qLVt0RDm1D2UCfbScKRxr3jBLXTLhmFnJbrMn8yJe
+			var dv14Jv4a20Ii3B5e7qOTkpxUDsElDMbXTfU93QguIr09FFWO9p1WsrBcl5NuoP63BCt36cGmiV6mF tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(110))
+			_ = dv14Jv4a20Ii3B5e7qOTkpxUDsElDMbXTfU93QguIr09FFWO9p1WsrBcl5NuoP63BCt36cGmiV6mF
+			var vCCAhl4mwt18HbSoKusSlsnSWTjgK6Zprow2qswmC0WSq08sEZbWOu2 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(111))
+			_ = vCCAhl4mwt18HbSoKusSlsnSWTjgK6Zprow2qswmC0WSq08sEZbWOu2
+			var f6wXazlo9oOSxMAFPfg tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(112))
+			_ = f6wXazlo9oOSxMAFPfg
+			var pF6WYVukdXD7y0HVwHrSiGNHrsaoqfNEbnAgy21QYc1qhk67gPxz19SGIwbuYcj6zV6Ln2j6GPVkd tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(113))
+			_ = pF6WYVukdXD7y0HVwHrSiGNHrsaoqfNEbnAgy21QYc1qhk67gPxz19SGIwbuYcj6zV6Ln2j6GPVkd
+			var x03mXGOHv1wZKOeseJlgQCnnvKOyHtLDmLMkieChxwltOerhLXsTIh77OG7rC5TH6VomwMX2 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(114))
+			_ = x03mXGOHv1wZKOeseJlgQCnnvKOyHtLDmLMkieChxwltOerhLXsTIh77OG7rC5TH6VomwMX2
+			var q58754DHbnaOtVpOimLyN8Mk9MrjNHHUIM9Ag8KdSBgFeWYjE0RqlkKTKtQucyBNRgcfm64mFyjkLb8nxiwhR9i8YYblAozjtrojX4 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(115))
+			_ = q58754DHbnaOtVpOimLyN8Mk9MrjNHHUIM9Ag8KdSBgFeWYjE0RqlkKTKtQucyBNRgcfm64mFyjkLb8nxiwhR9i8YYblAozjtrojX4
+			var eBDMLXipSnFroWeGQ61Dy tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(116))
+			_ = eBDMLXipSnFroWeGQ61Dy
+			var plOPwJgoxgzqviM tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(1))
+			_ = plOPwJgoxgzqviM
+			var lLkVG6RwjNFpwF4gdo0C5znBgQkOp3Nohr1oMEx8OGQnJOlOr18K9elqol57tkTMSC3pDu9s5x4O24009fmK8elu78ZwXjM6Pz7x tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(2))
+			_ = lLkVG6RwjNFpwF4gdo0C5znBgQkOp3Nohr1oMEx8OGQnJOlOr18K9elqol57tkTMSC3pDu9s5x4O24009fmK8elu78ZwXjM6Pz7x
+			var iVXJdV0Ed5wcpLkYJqRVud tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(3))
+			_ = iVXJdV0Ed5wcpLkYJqRVud
+			var vz4ce5hvEQm4FNzxgbWPoKAVy9mhkLlzOiHDjYd1fXChs9i2byToV8csMq9gT48kdGNCBIofOng1yDgvDI3wCIwZb3oK0yKLMEAEKJWoEJRxSLWp tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(4))
+			_ = vz4ce5hvEQm4FNzxgbWPoKAVy9mhkLlzOiHDjYd1fXChs9i2byToV8csMq9gT48kdGNCBIofOng1yDgvDI3wCIwZb3oK0yKLMEAEKJWoEJRxSLWp
+			var sMJVH3FDAzFuS9dWB465RE6kGmrzWzVBJTqesUvJo9glyqVqhmxNDMr6aSXmljGWOmUTy32XN20Gc9aWnkwPUCzoNutMCGfq04rUd85D5e tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(5))
+			_ = sMJVH3FDAzFuS9dWB465RE6kGmrzWzVBJTqesUvJo9glyqVqhmxNDMr6aSXmljGWOmUTy32XN20Gc9aWnkwPUCzoNutMCGfq04rUd85D5e
+			var kGES tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(6))
+			_ = kGES
+			var sXLbXHZOUP tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(7))
+			_ = sXLbXHZOUP
+			var oeh19d7jQwP7BZxarAJ29bDClVWCgq7SpGTVfCizILGJs tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(8))
+			_ = oeh19d7jQwP7BZxarAJ29bDClVWCgq7SpGTVfCizILGJs
+			var vSBvTtba1KW3YBUBneycVdup8iMxQ tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(9))
+			_ = vSBvTtba1KW3YBUBneycVdup8iMxQ
+			var fUi tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(10))
+			_ = fUi
+			var pR1AzaFJyDPqOMnPfpSMFw6gfD tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(11))
+			_ = pR1AzaFJyDPqOMnPfpSMFw6gfD
+			var yeh1K9tafuypMUrhaRwoHpTe0QpWQtpevewOJgSBPa8NfCGiiMu2oBYK7SJm7kjL9xcSX4L7FJ tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(12))
+			_ = yeh1K9tafuypMUrhaRwoHpTe0QpWQtpevewOJgSBPa8NfCGiiMu2oBYK7SJm7kjL9xcSX4L7FJ
+			var uXjHU9sOcIL1WCQWNklUAGfT05EmeiZ tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(13))
+			_ = uXjHU9sOcIL1WCQWNklUAGfT05EmeiZ
+			var iHXQEcu9qyXkANXzQY33fquAjcAzpCLD5R79imzBPkdJejT0NZrUt4BwZzUf tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(14))
+			_ = iHXQEcu9qyXkANXzQY33fquAjcAzpCLD5R79imzBPkdJejT0NZrUt4BwZzUf
+			var xczoE9RgkQhliGysk8MlSGdnFo6IyvBVuWrGvKC5QkmRNZe1ckc1fot9rAHUMncHLhmM37MRQVYXZ9J7kXh1ufoF1ifnn5uDFkK8 tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(15))
+			_ = xczoE9RgkQhliGysk8MlSGdnFo6IyvBVuWrGvKC5QkmRNZe1ckc1fot9rAHUMncHLhmM37MRQVYXZ9J7kXh1ufoF1ifnn5uDFkK8
+			var j2u3hPxe8 tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(16))
+			_ = j2u3hPxe8
+			var nVSG tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(17))
+			_ = nVSG
+			var mUtBZLlPlN2iq0S21J7JAVd5ZJXDtXIkjvbLtoJjzmsxdmmSxgMPldLR26O0q7IbFK8V6MyQ41sYGqAh5dzICZveUElz4TuY tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(18))
+			_ = mUtBZLlPlN2iq0S21J7JAVd5ZJXDtXIkjvbLtoJjzmsxdmmSxgMPldLR26O0q7IbFK8V6MyQ41sYGqAh5dzICZveUElz4TuY
+			var obGVcTR5BDbXbr5T48aNhBVmCslmxsrdrbyVLsTEnzAl4aEfyvlc0rrSZ73ADPso9SxtB2Yw7oGcEh8c tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(19))
+			_ = obGVcTR5BDbXbr5T48aNhBVmCslmxsrdrbyVLsTEnzAl4aEfyvlc0rrSZ73ADPso9SxtB2Yw7oGcEh8c
+			var f32hH6nVAC73T24FsLInh tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(20))
+			_ = f32hH6nVAC73T24FsLInh
+			var mYyEU6DX7CUyMmyx6lkcNFnOUgpEO4JxukW85KgXJeHj8wYFOZDD tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(21))
+			_ = mYyEU6DX7CUyMmyx6lkcNFnOUgpEO4JxukW85KgXJeHj8wYFOZDD
+			var fPBKRZq2cGeVVHuRrQxG6wFLcJhYxuww1Wofflgo7p43KXF2ZbwdBXLCpCZPI5k9fP9hnyV6VG tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(22))
+			_ = fPBKRZq2cGeVVHuRrQxG6wFLcJhYxuww1Wofflgo7p43KXF2ZbwdBXLCpCZPI5k9fP9hnyV6VG
+			var zHERvF8WhFFQIh0bMkCQbTDGXQrNcpF tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(23))
+			_ = zHERvF8WhFFQIh0bMkCQbTDGXQrNcpF
+			var xpoZoeDqfxqWlWSqZJKcFeUTg6T6YuaH6LtWBX94R13HWf0bwUo0oRfCd8rseYdxyf7axPMXqhThhAPcY tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(24))
+			_ = xpoZoeDqfxqWlWSqZJKcFeUTg6T6YuaH6LtWBX94R13HWf0bwUo0oRfCd8rseYdxyf7axPMXqhThhAPcY
+			var vXVcZtRTP97AS3sUkh5nhwhG4qorJbd0PhGbY6JVHXnGeqDq7OOoM1wyrk2WSbSyXvnXW5NePe9g5yNfm tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(25))
+			_ = vXVcZtRTP97AS3sUkh5nhwhG4qorJbd0PhGbY6JVHXnGeqDq7OOoM1wyrk2WSbSyXvnXW5NePe9g5yNfm
+			var iflGuSHYoDyxSbGLP0dyyXTrcVMjnDaB0QHyDIlWqh987O7pFNwGE5UmPYy tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(26))
+			_ = iflGuSHYoDyxSbGLP0dyyXTrcVMjnDaB0QHyDIlWqh987O7pFNwGE5UmPYy
+			var i0 tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(27))
+			_ = i0
+			var uVzaWqUSraecWRih8zblPmhHjQTnzwcqtoIQd86kUOdsoMRl5HQIomJI20KFqu5zCI2HHe8D tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(28))
+			_ = uVzaWqUSraecWRih8zblPmhHjQTnzwcqtoIQd86kUOdsoMRl5HQIomJI20KFqu5zCI2HHe8D
+			var pywFuNPjvDN2sHaKBsMQN32bi7mTaw4oN tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(29))
+			_ = pywFuNPjvDN2sHaKBsMQN32bi7mTaw4oN
+			var ofPC4qKuNVhfXd8GbSKqjaev2AuFCVJ1MCKcCmKhlqze9B1mkJtOkda0ST2GhkXpUSfInWN tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(30))
+			_ = ofPC4qKuNVhfXd8GbSKqjaev2AuFCVJ1MCKcCmKhlqze9B1mkJtOkda0ST2GhkXpUSfInWN
+			var gmou6mQtTJaztFADIerbU7wI58pnAmBfYEJMPYfsa5lbp9buZJ7qGn7qkOJ tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(1))
+			_ = gmou6mQtTJaztFADIerbU7wI58pnAmBfYEJMPYfsa5lbp9buZJ7qGn7qkOJ
+			var kJuOB1nGooxmSrS1gx0CHaU9pMV9R4eWM7MnXcB6mEn9WHVqvf38MiaKPOHxwpQA6nxVuyb tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(2))
+			_ = kJuOB1nGooxmSrS1gx0CHaU9pMV9R4eWM7MnXcB6mEn9WHVqvf38MiaKPOHxwpQA6nxVuyb
+			var rmNohfART2OpXi9rXJo4nuo4SISw8TfjfB1YjQs tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(3))
+			_ = rmNohfART2OpXi9rXJo4nuo4SISw8TfjfB1YjQs
+			var r22Q1xRs2J6LMWmbKNqsEo4m7oWmRkMMSNTOvCAUPOB4RNUelgLi6wcyySIQrVubZjQwC tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(4))
+			_ = r22Q1xRs2J6LMWmbKNqsEo4m7oWmRkMMSNTOvCAUPOB4RNUelgLi6wcyySIQrVubZjQwC
+			var ltla6EoPxZ6Z0QpN6LB53zWEKvdbyVWyTZ8FjdxDzhwMs3x7z4RvjboHSZjIZFc8k695LpyrPb2yEI7goDENyQCTi2bl7fBL2uOrTXF64TE tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(5))
+			_ = ltla6EoPxZ6Z0QpN6LB53zWEKvdbyVWyTZ8FjdxDzhwMs3x7z4RvjboHSZjIZFc8k695LpyrPb2yEI7goDENyQCTi2bl7fBL2uOrTXF64TE
+			var bSrm0STdcqVvjoowxFrEzL68P1HvvB2UZTqOH5rWyNYPxxrn9VBLPL8O5bZmDCdgL86ArBRFLYH1OfK9SUaPltfwbdtSXgwtFR tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(6))
+			_ = bSrm0STdcqVvjoowxFrEzL68P1HvvB2UZTqOH5rWyNYPxxrn9VBLPL8O5bZmDCdgL86ArBRFLYH1OfK9SUaPltfwbdtSXgwtFR
+			var kIQquHIkvGZhTwffna3AyquDyK3CVURCWl28j0MKeLY9IkC tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(7))
+			_ = kIQquHIkvGZhTwffna3AyquDyK3CVURCWl28j0MKeLY9IkC
+			var qQVNkcJkoi4VnqQRUv2cTxoSjMKyWv8sRH4jVPzHjnMQ3U9SB9wU0IVSNsNloIkFUSttwSM tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(8))
+			_ = qQVNkcJkoi4VnqQRUv2cTxoSjMKyWv8sRH4jVPzHjnMQ3U9SB9wU0IVSNsNloIkFUSttwSM
+			var gSapU9sycL2vu74K0nFmmgiTgxxxtWq92c0ho tla.TLAValue = args1[11]
+			_ = gSapU9sycL2vu74K0nFmmgiTgxxxtWq92c0ho
+			var bvYyhkNRnO7sD3ykShl4v9gzq8dllwOxieK6UiYo0PjrsQE2OkRRY8PmHxeljmW2MS9QoioYTokIg6QgP2IdLjfqEGPweJq tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(1))
+			_ = bvYyhkNRnO7sD3ykShl4v9gzq8dllwOxieK6UiYo0PjrsQE2OkRRY8PmHxeljmW2MS9QoioYTokIg6QgP2IdLjfqEGPweJq
+			var k2Uw0xZjKZnCb6xsWmjJsM8LRBJp8kBUXk2G4TntmKi5FZ9flYzrZPAbynbt3srNf16jRGk76ZkQAb tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(2))
+			_ = k2Uw0xZjKZnCb6xsWmjJsM8LRBJp8kBUXk2G4TntmKi5FZ9flYzrZPAbynbt3srNf16jRGk76ZkQAb
+			var iYjzvUHPRlDN8baqJ7qYCHF998vTPHri6nK24Uog0LSasfdO9aFDTk3fXuCl41xeMOm2VQft tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(3))
+			_ = iYjzvUHPRlDN8baqJ7qYCHF998vTPHri6nK24Uog0LSasfdO9aFDTk3fXuCl41xeMOm2VQft
+			var lxEwfeKiQlnT6sNRSWWQ5UXPa39yX5pq tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(4))
+			_ = lxEwfeKiQlnT6sNRSWWQ5UXPa39yX5pq
+			var uTBOegzf41xwPV9PP4Jyej8A4q8GFeUm044NwlkPT5BL3eI1AIfiH8e tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(5))
+			_ = uTBOegzf41xwPV9PP4Jyej8A4q8GFeUm044NwlkPT5BL3eI1AIfiH8e
+			var rBwkXLpv3CehpIQtDRGBUXY4ue823T9acVqNOzFmJSVbDcCN9Fwf1BMLsyAfKrdM2JXyo5EuxImSiFleETRHE2CzE79xAm37HP70BZiaX71CkukP4jH tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(6))
+			_ = rBwkXLpv3CehpIQtDRGBUXY4ue823T9acVqNOzFmJSVbDcCN9Fwf1BMLsyAfKrdM2JXyo5EuxImSiFleETRHE2CzE79xAm37HP70BZiaX71CkukP4jH
+			var sP2QwPQXG6byK83Fz9Odl8SocpG3uAWL8G tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(7))
+			_ = sP2QwPQXG6byK83Fz9Odl8SocpG3uAWL8G
+			var rt0TIrSzEUK963rZ8M5FmZ6K28lmSqHfpUe5u1WKOwrtDrJ tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(8))
+			_ = rt0TIrSzEUK963rZ8M5FmZ6K28lmSqHfpUe5u1WKOwrtDrJ
+			var mRhBltodqGZZZh77e7Jw7Nbn0xeSPlu tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(9))
+			_ = mRhBltodqGZZZh77e7Jw7Nbn0xeSPlu
+			var ohzDWoSvkHgPUyZ1eszr64gW5riSVHY2qQrPA2NXy1BFDyAjg5k tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(10))
+			_ = ohzDWoSvkHgPUyZ1eszr64gW5riSVHY2qQrPA2NXy1BFDyAjg5k
+			var aFQmRxI2ZxC6NGDyFkAU0M97MF3umRnyAjlUd0b5WO7Ky0U9b2dZFj8f3EAM69sZ1lCi5rjRyLkKWgK00qs2cD67ZKX4gzYihXfcac6A7E0 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(11))
+			_ = aFQmRxI2ZxC6NGDyFkAU0M97MF3umRnyAjlUd0b5WO7Ky0U9b2dZFj8f3EAM69sZ1lCi5rjRyLkKWgK00qs2cD67ZKX4gzYihXfcac6A7E0
+			var lLUlCMhiTb3othEt5flbY5BSFDrNWhUhCxAgpmpSBEjmALYYGmNJQYBOts9uEFpYESv tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(12))
+			_ = lLUlCMhiTb3othEt5flbY5BSFDrNWhUhCxAgpmpSBEjmALYYGmNJQYBOts9uEFpYESv
+			var mRUb6rEz2QsRUJEASApew7UfpGOAfuImB9mjWGQTJ1ZfKNkHrL0w3NdvBY723ewRd tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(13))
+			_ = mRUb6rEz2QsRUJEASApew7UfpGOAfuImB9mjWGQTJ1ZfKNkHrL0w3NdvBY723ewRd
+			var kJNVZ92UakTXx62mDIx2gdSy3KOm9KRcLn2kMtwBmLN6umDSPa7baIsWYa1VI19hBW5X6 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(14))
+			_ = kJNVZ92UakTXx62mDIx2gdSy3KOm9KRcLn2kMtwBmLN6umDSPa7baIsWYa1VI19hBW5X6
+			var orxu7P4V5TxZBv1J6sHoDjqjTKhXBjw8jyqMj2IylosKW39s tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(15))
+			_ = orxu7P4V5TxZBv1J6sHoDjqjTKhXBjw8jyqMj2IylosKW39s
+			var m155yah3c7 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(16))
+			_ = m155yah3c7
+			var aBUBA8tg75t2l0lDZd6xg8dU2MLrdeGfyB1EqOayAua36rja tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(17))
+			_ = aBUBA8tg75t2l0lDZd6xg8dU2MLrdeGfyB1EqOayAua36rja
+			var neY0NeiszULJ5hzRwMfs7uRWeKbUJrg05xI7uDXxso3zqMNwYVBWXvSffIQ8A8f54wC4MixM9WyX2apY tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(18))
+			_ = neY0NeiszULJ5hzRwMfs7uRWeKbUJrg05xI7uDXxso3zqMNwYVBWXvSffIQ8A8f54wC4MixM9WyX2apY
+			var dXY738Eucw9bEjbGrpMLwA9IZPS7UjsccwIWzqT3rQhC8Z7VVlQYRKGNMISxpSC2gtGcrKWjITz5FOTsFcl tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(19))
+			_ = dXY738Eucw9bEjbGrpMLwA9IZPS7UjsccwIWzqT3rQhC8Z7VVlQYRKGNMISxpSC2gtGcrKWjITz5FOTsFcl
+			var yT tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(20))
+			_ = yT
+			var k7O2lJMu9pkamRfx4DxPujYEUFfHxsFqhXkqhmEuOTaCBUy8GUIodHF1Lo7MeM8gEbSFZr2AzKqeGpNMi7YjHyfY0ucGxr tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(21))
+			_ = k7O2lJMu9pkamRfx4DxPujYEUFfHxsFqhXkqhmEuOTaCBUy8GUIodHF1Lo7MeM8gEbSFZr2AzKqeGpNMi7YjHyfY0ucGxr
+			var ulM41lZXFsrBTdYMn8UxgL7lowyB7taU3EkcR tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(22))
+			_ = ulM41lZXFsrBTdYMn8UxgL7lowyB7taU3EkcR
+			var izzqlsyk8dIhBy05n0XmRv182nnPp7OU4h4dFKcnP0zhqzTh9xXp4B8y5IGDJE1cIVPavcp0thGd6BsxtEBxQbs7rmdGmlDMr9Xki8UOFzNu tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(23))
+			_ = izzqlsyk8dIhBy05n0XmRv182nnPp7OU4h4dFKcnP0zhqzTh9xXp4B8y5IGDJE1cIVPavcp0thGd6BsxtEBxQbs7rmdGmlDMr9Xki8UOFzNu
+			var o27shTHLzkzoXz4xC5X8DMfkjY1OKXYY1W0Y tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(24))
+			_ = o27shTHLzkzoXz4xC5X8DMfkjY1OKXYY1W0Y
+			var v8wpCzOhZsHGKA0aktqnqZFq0ZexC13UtVdJGCu0273ehLqCA3jXNzmzcPyavqOPoYND tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(25))
+			_ = v8wpCzOhZsHGKA0aktqnqZFq0ZexC13UtVdJGCu0273ehLqCA3jXNzmzcPyavqOPoYND
+			var o9fFyJwuHr9NUP1C0Eq4nRaLLerbPeKeIxeBVAC9lXO1lDmTPPCAxWNPSqcrnJIlrYiXhCdLFgBV9Ie6jBWuqdHxjB3oT9o5R8buRAbQN tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(26))
+			_ = o9fFyJwuHr9NUP1C0Eq4nRaLLerbPeKeIxeBVAC9lXO1lDmTPPCAxWNPSqcrnJIlrYiXhCdLFgBV9Ie6jBWuqdHxjB3oT9o5R8buRAbQN
+			var jeUZQyxTU6 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(27))
+			_ = jeUZQyxTU6
+			var gH01VkUUXyOsLbkcDRBwKJT tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(28))
+			_ = gH01VkUUXyOsLbkcDRBwKJT
+			var eQPEPPdsMigsFNwJJGFbEk8AhRu9WePGlE8mttATvJI5AtiYR5re5cbQhPLgNAsTe1fFuBVv4AKKnW2EjhCtc00MRmkiFgZ tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(29))
+			_ = eQPEPPdsMigsFNwJJGFbEk8AhRu9WePGlE8mttATvJI5AtiYR5re5cbQhPLgNAsTe1fFuBVv4AKKnW2EjhCtc00MRmkiFgZ
+			var muUlbfVkJDAqjVsAlLDWGFCKeqSmbslGLH7zEq5tLrpMog2mjF3kDSwpaW0zvvwgOz8Gjib tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(30))
+			_ = muUlbfVkJDAqjVsAlLDWGFCKeqSmbslGLH7zEq5tLrpMog2mjF3kDSwpaW0zvvwgOz8Gjib
+			var rrNTKQ6Fxx6iLydRgUhb5PgmHQtH tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(31))
+			_ = rrNTKQ6Fxx6iLydRgUhb5PgmHQtH
+			var cP tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(32))
+			_ = cP
+			var pTQwNxDhQif tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(33))
+			_ = pTQwNxDhQif
+			var ecqIED6q tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(34))
+			_ = ecqIED6q
+			var aPGK5QsuCVFDbVbQoqQAep0phsirJfvGgaVL5RdXBsmZ7K9DHS4J8i8rkCiCCtycDXg9cwlsnSnZEc8O9x5PoINS7XFTjwwikHNRqN3bKhBeEsQ8u tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(35))
+			_ = aPGK5QsuCVFDbVbQoqQAep0phsirJfvGgaVL5RdXBsmZ7K9DHS4J8i8rkCiCCtycDXg9cwlsnSnZEc8O9x5PoINS7XFTjwwikHNRqN3bKhBeEsQ8u
+			var a5HdjxlOX9xsqHmFu tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(36))
+			_ = a5HdjxlOX9xsqHmFu
+			var b2nrPB939ZkCdCuvaCbD4Ft0dMPzT tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(37))
+			_ = b2nrPB939ZkCdCuvaCbD4Ft0dMPzT
+			var gydXGdwgPVRYmefrdw9CSQksgXaA8Smn6fBYfaF tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(38))
+			_ = gydXGdwgPVRYmefrdw9CSQksgXaA8Smn6fBYfaF
+			var gEgqOEDVYhIK30iMCf7m166iPHSL4Oyf6Sv tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(39))
+			_ = gEgqOEDVYhIK30iMCf7m166iPHSL4Oyf6Sv
+			var xUjERhSDgWgNE8JRq19waoV5OYwqC tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(40))
+			_ = xUjERhSDgWgNE8JRq19waoV5OYwqC
+			var lEXfIIPZHRNU127yUgJItv3Pvdt2ytA tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(41))
+			_ = lEXfIIPZHRNU127yUgJItv3Pvdt2ytA
+			var jPWpNkqpYYgau8yz3lXsqdEY4N1Ch46JQ4qJXmAOfpYebZmw9Y3krHNzfUskRNki tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(42))
+			_ = jPWpNkqpYYgau8yz3lXsqdEY4N1Ch46JQ4qJXmAOfpYebZmw9Y3krHNzfUskRNki
+			var j1 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(43))
+			_ = j1
+			var xsb tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(44))
+			_ = xsb
+			var xL5o3KBxHW0rby34PX6vVVrgT3zk8X3krRYsTrS4IMt4Dxou8bp7IAL8Nm8YzpHJ6lq tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(45))
+			_ = xL5o3KBxHW0rby34PX6vVVrgT3zk8X3krRYsTrS4IMt4Dxou8bp7IAL8Nm8YzpHJ6lq
+			var yE8KuYUviy1aBAnlIucJOnEtI5jwaZDGtwGbEV1sMmQyq7SUjGyaIyCxzQYSmJly3EykLC47r05E3r94YpXdIqEUjitt9CMCBxRnwBskHYipOMt4g tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(46))
+			_ = yE8KuYUviy1aBAnlIucJOnEtI5jwaZDGtwGbEV1sMmQyq7SUjGyaIyCxzQYSmJly3EykLC47r05E3r94YpXdIqEUjitt9CMCBxRnwBskHYipOMt4g
+			var enpWJSqk9ycQdBqfOQyxYw7iNk5spsnLCwuz5UKCsmefqQlRBC9VG0KDiS30eCdDPtIDdMHEN752 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(47))
+			_ = enpWJSqk9ycQdBqfOQyxYw7iNk5spsnLCwuz5UKCsmefqQlRBC9VG0KDiS30eCdDPtIDdMHEN752
+			var qwZWzqf4fj1pSMWMGD2Pe4CgnFaT16EqANZpINuv1UKXiR9q85xXFEiz7 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(48))
+			_ = qwZWzqf4fj1pSMWMGD2Pe4CgnFaT16EqANZpINuv1UKXiR9q85xXFEiz7
+			var le4JPbTdZx949PcxPq620UWarmRbcOqIUkHGTBy3HUcHmF2x14CmykEpb2YXT2jpPpkhi652xNNtOI2imD tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(49))
+			_ = le4JPbTdZx949PcxPq620UWarmRbcOqIUkHGTBy3HUcHmF2x14CmykEpb2YXT2jpPpkhi652xNNtOI2imD
+			var nuJjACVmXEEYLB2fG0nyz57FInY27ou tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(50))
+			_ = nuJjACVmXEEYLB2fG0nyz57FInY27ou
+			var fh2JnsyKDCae4wvGFFMHgqA9O0T0gMOhOZgFKTSLMjQFxTPDpfxOchdWatPi tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(51))
+			_ = fh2JnsyKDCae4wvGFFMHgqA9O0T0gMOhOZgFKTSLMjQFxTPDpfxOchdWatPi
+			var oRFG8JIB68yJ34Cv0xQgDmavBkAPoOSUkp1 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(52))
+			_ = oRFG8JIB68yJ34Cv0xQgDmavBkAPoOSUkp1
+			var rFGzyRYGXLwv2eePcn3WsJK5FHrNzWomwuesn8UBVc9wwOS48Nk8gL1kEyOAuIMUw2sbrN5RlMYUO6jgJzhHFRfB tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(53))
+			_ = rFGzyRYGXLwv2eePcn3WsJK5FHrNzWomwuesn8UBVc9wwOS48Nk8gL1kEyOAuIMUw2sbrN5RlMYUO6jgJzhHFRfB
+			var ksRtfRFspNe8Ib3SjZVCyOZfiL5 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(54))
+			_ = ksRtfRFspNe8Ib3SjZVCyOZfiL5
+			var cRRbaEpPC9hsYxhSSW8f2l9jkIVYUeZL6RT3A7F0V4rLzrru2YyXeHoaZOJ4 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(55))
+			_ = cRRbaEpPC9hsYxhSSW8f2l9jkIVYUeZL6RT3A7F0V4rLzrru2YyXeHoaZOJ4
+			var j8tmAzZJCrt3oQqKOrci4VyxbeGWfIvnQy56J405KdhGhxx8fYPgY1519xnsdrJOjtTmJdIbJiZ74Q8CmLGdoQeNWwueuTMZAag3jXNCD tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(56))
+			_ = j8tmAzZJCrt3oQqKOrci4VyxbeGWfIvnQy56J405KdhGhxx8fYPgY1519xnsdrJOjtTmJdIbJiZ74Q8CmLGdoQeNWwueuTMZAag3jXNCD
+			var pJGGYp98boWSNR4UY1QCkoM9fybjW4O2ZnsfpBfaGsNgUCEaSQnu3JyJ7mmsrlLl2XmgNul1NLikwvaPEVaTgYuSAfe tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(57))
+			_ = pJGGYp98boWSNR4UY1QCkoM9fybjW4O2ZnsfpBfaGsNgUCEaSQnu3JyJ7mmsrlLl2XmgNul1NLikwvaPEVaTgYuSAfe
+			var nzk0V4ohDBOGMI7FjfR1IVVI3 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(58))
+			_ = nzk0V4ohDBOGMI7FjfR1IVVI3
+			var jNrf6oSOwViQqvBNHLxgMelIQPjL0U3ZRduMHDw0VcwbcMELqTPfqo4KudSPCMHCoWPhE0wQncFJQGuTdYQVGtFitSFgbMc8DxlR3r7nUD7CFTheC tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(59))
+			_ = jNrf6oSOwViQqvBNHLxgMelIQPjL0U3ZRduMHDw0VcwbcMELqTPfqo4KudSPCMHCoWPhE0wQncFJQGuTdYQVGtFitSFgbMc8DxlR3r7nUD7CFTheC
+			var bAX6W1qmvIh tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(60))
+			_ = bAX6W1qmvIh
+			var wHug8VC tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(61))
+			_ = wHug8VC
+			var zlTvgIZI65SG5XQxMxGGv13z4QnKZ1TKIrieQz6viYG3WJIoFfjDeK6jayZK4CimYBDFl5pqul5kSXlWDdYhR0K tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(62))
+			_ = zlTvgIZI65SG5XQxMxGGv13z4QnKZ1TKIrieQz6viYG3WJIoFfjDeK6jayZK4CimYBDFl5pqul5kSXlWDdYhR0K
+			var j2 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(63))
+			_ = j2
+			var xzp12kCzErlpHusVMbj19XCH2mqVEXv4pYRcNMn0tqwgssaBBnGPsnbndDgGmtQj8tOoLpQNvskvRxjOW1mPxQHw6K5oGNY79qb6gKIf tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(64))
+			_ = xzp12kCzErlpHusVMbj19XCH2mqVEXv4pYRcNMn0tqwgssaBBnGPsnbndDgGmtQj8tOoLpQNvskvRxjOW1mPxQHw6K5oGNY79qb6gKIf
+			var o64walz4ovuPNEjD59k2R371ggAQBPMHcXlPFC536z9n3wLoW6Tk72qUu3w4eaReckZ tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(65))
+			_ = o64walz4ovuPNEjD59k2R371ggAQBPMHcXlPFC536z9n3wLoW6Tk72qUu3w4eaReckZ
+			var ufNlMXrsLWRxokYNotZGObHjz0e0nLWRHl5ZL5eYuCT6wQhzrAZG1i6P tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(66))
+			_ = ufNlMXrsLWRxokYNotZGObHjz0e0nLWRHl5ZL5eYuCT6wQhzrAZG1i6P
+			var e0ByBZeHU0jroLESJIvzUKBaU4f7ejew8RIAfJvAJZs4YMTWPeAP4LjLHmDjpo4ygRTr2ohTP4eLRU24Kiv3ZX9nIIMP18KITB7Vlylc tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(67))
+			_ = e0ByBZeHU0jroLESJIvzUKBaU4f7ejew8RIAfJvAJZs4YMTWPeAP4LjLHmDjpo4ygRTr2ohTP4eLRU24Kiv3ZX9nIIMP18KITB7Vlylc
+			var kFKDWDANI9dq6kfizGOd4U0h1imDJFmcPJWBOVJkj7SdIyDD tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(68))
+			_ = kFKDWDANI9dq6kfizGOd4U0h1imDJFmcPJWBOVJkj7SdIyDD
+			var mJhoVpxId6c3cfCUwwhvNRjJUMiFIKXjcXsW3ItMsgBugQ6PS23X9bGOCuZAfcSDKwV0dPSodkKeAyt4VYAYY tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(69))
+			_ = mJhoVpxId6c3cfCUwwhvNRjJUMiFIKXjcXsW3ItMsgBugQ6PS23X9bGOCuZAfcSDKwV0dPSodkKeAyt4VYAYY
+			var wxW3v3LCWQ2k9bsfL2cGOZ23LlO3Q1rIZtjyJv2lKaAFNLdmjfhbctyq20NQFQ1qqjk2uj5xcceY29HChRhFwuV0d9FiiSoqpuG tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(70))
+			_ = wxW3v3LCWQ2k9bsfL2cGOZ23LlO3Q1rIZtjyJv2lKaAFNLdmjfhbctyq20NQFQ1qqjk2uj5xcceY29HChRhFwuV0d9FiiSoqpuG
+			var o4IH tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(71))
+			_ = o4IH
+			var jqVv9a7RJ34hwtjbCfI4h24D6 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(72))
+			_ = jqVv9a7RJ34hwtjbCfI4h24D6
+			var vPJOj3BlqOuTBf0Wh6fnYKOXxPSFwdY4TPjS9vOD8F5XumXcWgwXZ7iZnOb0UThvYjB6EP4POoJ80F4wbASRs7P9M9jIZ90mKmcSdObml5KDWmn8 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(73))
+			_ = vPJOj3BlqOuTBf0Wh6fnYKOXxPSFwdY4TPjS9vOD8F5XumXcWgwXZ7iZnOb0UThvYjB6EP4POoJ80F4wbASRs7P9M9jIZ90mKmcSdObml5KDWmn8
+			var xR7M tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(74))
+			_ = xR7M
+			var klVFkg6hwFeiBoenhzlVR76U5aDHycoY1SKKC tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(75))
+			_ = klVFkg6hwFeiBoenhzlVR76U5aDHycoY1SKKC
+			var k86ihwoOt5vYFd4bpirurV3tUFseZuxgxqUlSi6N8a9QMrpmkXTDJHZ0PG2KGxYYHtK4s tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(76))
+			_ = k86ihwoOt5vYFd4bpirurV3tUFseZuxgxqUlSi6N8a9QMrpmkXTDJHZ0PG2KGxYYHtK4s
+			var nw5sdPfTaR4fEgMRbAd3opwxdBSlWYPSRWkDozBuanVCJpmgVvDxB4sj2wNdBpyRdAOMHzrc0UDNAjnkergYzPJaNICR8gOw6pfDzu tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(77))
+			_ = nw5sdPfTaR4fEgMRbAd3opwxdBSlWYPSRWkDozBuanVCJpmgVvDxB4sj2wNdBpyRdAOMHzrc0UDNAjnkergYzPJaNICR8gOw6pfDzu
+			var njyLNAgKPJeFUPIbbFRhZetMfiziosTwos9CieLJor8k19DVQEy5gHZlFcVnnN2etoO2BYZrMsDjCKqfmjWpmPCvGdN1IH tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(78))
+			_ = njyLNAgKPJeFUPIbbFRhZetMfiziosTwos9CieLJor8k19DVQEy5gHZlFcVnnN2etoO2BYZrMsDjCKqfmjWpmPCvGdN1IH
+			var hZJVCmJyb1bLSJN91540Er1xbQ5CHjcRkBXKrGuf7ltpGIj tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(79))
+			_ = hZJVCmJyb1bLSJN91540Er1xbQ5CHjcRkBXKrGuf7ltpGIj
+			var cXkXltSAIi7cabM1kPkpa tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(80))
+			_ = cXkXltSAIi7cabM1kPkpa
+			var hSxEM3oq4kPoM tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(81))
+			_ = hSxEM3oq4kPoM
+			var mrGvpReT4LRhjCB1nZJSqUdJ6y3Jw20R3XzZSDPnNs tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(82))
+			_ = mrGvpReT4LRhjCB1nZJSqUdJ6y3Jw20R3XzZSDPnNs
+			var ucLPgjqENVqzxeG56EAeKCXtg3S2jp0iNu0qHm2Y7IODe5wFamwwXV9gOoSpyfTA8qbLhxvw9WBswaC7RV4wemDiAGvhf tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(83))
+			_ = ucLPgjqENVqzxeG56EAeKCXtg3S2jp0iNu0qHm2Y7IODe5wFamwwXV9gOoSpyfTA8qbLhxvw9WBswaC7RV4wemDiAGvhf
+			var kfiPtri61kEDKocnKxDonQ6rhdJEa6hOy8e9HvNMttsXYiZccYSG1p9yE3ZP1eSfEqLNRkAsZkWnqzMmh2KD tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(84))
+			_ = kfiPtri61kEDKocnKxDonQ6rhdJEa6hOy8e9HvNMttsXYiZccYSG1p9yE3ZP1eSfEqLNRkAsZkWnqzMmh2KD
+			var dAOyF08iPkcA7TXa4KL76xrc6afchsxyrRFTkH5L1v7ui9fo2svnBSfiQIg1ogKcJv3wB8 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(85))
+			_ = dAOyF08iPkcA7TXa4KL76xrc6afchsxyrRFTkH5L1v7ui9fo2svnBSfiQIg1ogKcJv3wB8
+			var sFCZTEmld24ijkCJiGvYmNZu0rHziX8WJutAvNBppXZASoHfkgoToBYsLZY5uRYUrKOmFRWgPc47O8AM8QJTRjaiQUQ tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(86))
+			_ = sFCZTEmld24ijkCJiGvYmNZu0rHziX8WJutAvNBppXZASoHfkgoToBYsLZY5uRYUrKOmFRWgPc47O8AM8QJTRjaiQUQ
+			var gX8i8BVvIXM0B4iC1ZbCqNOdILmSNwbnymoRPdP7jtOsOJ0tyc4NBVSir55YeC3j9kGHNs6pvYeyYnUgtuISTszR tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(87))
+			_ = gX8i8BVvIXM0B4iC1ZbCqNOdILmSNwbnymoRPdP7jtOsOJ0tyc4NBVSir55YeC3j9kGHNs6pvYeyYnUgtuISTszR
+			var pv3StZMJMxbRov4WRXa8RMWH tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(88))
+			_ = pv3StZMJMxbRov4WRXa8RMWH
+			var mstkgMDlZN4ne0gStWEwGCum5F1p2pjY0E61IrDFPOtf7CLwr0LefhsbUzo tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(89))
+			_ = mstkgMDlZN4ne0gStWEwGCum5F1p2pjY0E61IrDFPOtf7CLwr0LefhsbUzo
+			var gCpRzwdZO6lzQmoptm3Bj71Z55qLuhIznvg050DloYGZFfJRICfc5AHLbzqEipkGl9OK7DQZxjs4pQjz6blgnHU62I3lK77 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(90))
+			_ = gCpRzwdZO6lzQmoptm3Bj71Z55qLuhIznvg050DloYGZFfJRICfc5AHLbzqEipkGl9OK7DQZxjs4pQjz6blgnHU62I3lK77
+			var c9XEV3uxOuAs6nY0iGtGil96iEDQFK91D49IT4OcEiIhAFpfA8ZUGtjlo6IJw75knBnz2z38o2AsLVk tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(91))
+			_ = c9XEV3uxOuAs6nY0iGtGil96iEDQFK91D49IT4OcEiIhAFpfA8ZUGtjlo6IJw75knBnz2z38o2AsLVk
+			var opgDUOIfE2VO7XSSPrTkGZZQx4Q05i1KqjRjfCMT1hnVghMfOpTVSUfO8cOGFGIkhw tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(92))
+			_ = opgDUOIfE2VO7XSSPrTkGZZQx4Q05i1KqjRjfCMT1hnVghMfOpTVSUfO8cOGFGIkhw
+			var hcZ2qdb0RbSGYpDe6cqtH3bidIoxlbkZys0sit9OsSR00y86Kn7ygXQ3XtZDkAPkybdhjoG2HTR2ONAyMCz2hmy8ETPd84myed8IL1hXVQYyc8baltw tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(93))
+			_ = hcZ2qdb0RbSGYpDe6cqtH3bidIoxlbkZys0sit9OsSR00y86Kn7ygXQ3XtZDkAPkybdhjoG2HTR2ONAyMCz2hmy8ETPd84myed8IL1hXVQYyc8baltw
+			var lJLU0whHwG7M2VDlltaDKeoK1LUFQXzKa8uaIWP7q tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(94))
+			_ = lJLU0whHwG7M2VDlltaDKeoK1LUFQXzKa8uaIWP7q
+			var mKotOtJbj1v7ACck5i683vUlY tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(95))
+			_ = mKotOtJbj1v7ACck5i683vUlY
+			var f8FerpPpvVx9MMkWzCPTDTuHTYdIgpO2mcb8SJJFRoo6DrVmr9MPdwFWO4DUFYF6L2lp7vY3RgJuBCEHbcRwltB2NSEmn0RsHtvn4Dchoua9Zh tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(96))
+			_ = f8FerpPpvVx9MMkWzCPTDTuHTYdIgpO2mcb8SJJFRoo6DrVmr9MPdwFWO4DUFYF6L2lp7vY3RgJuBCEHbcRwltB2NSEmn0RsHtvn4Dchoua9Zh
+			var g7kcaa2D8avf tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(97))
+			_ = g7kcaa2D8avf
+			var m7dp8qmLYjlHNFW66mjrhFvyUXNhI7gyTJ4UeEe8iLuF tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(98))
+			_ = m7dp8qmLYjlHNFW66mjrhFvyUXNhI7gyTJ4UeEe8iLuF
+			var noJHAyUU59Bof5aFLlccFMFEVr4dazghvm1wX tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(99))
+			_ = noJHAyUU59Bof5aFLlccFMFEVr4dazghvm1wX
+			var j1vSC2M3EdDlaE tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(100))
+			_ = j1vSC2M3EdDlaE
+			var t49VKmVI3285NY6OdXDOsqjKMjshi21mwRUHVwaX7HsTjAUD8txzKPIoakubQtMI6GC1Au1UbWdKQO16BEIX7KfQvvg0OgvXFTaKSwZHREYDP48fW tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(101))
+			_ = t49VKmVI3285NY6OdXDOsqjKMjshi21mwRUHVwaX7HsTjAUD8txzKPIoakubQtMI6GC1Au1UbWdKQO16BEIX7KfQvvg0OgvXFTaKSwZHREYDP48fW
+			var rHjgqP1tLMrySxAYUHPM8tRniUaMsg0lqzV43X2GlA9sogHhzG3dMGLqDnY3A7dsJcDenG93hVk0NrkBE1rhU0T53fNWDGtXycy3baFdAq03BaHWnH1 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(102))
+			_ = rHjgqP1tLMrySxAYUHPM8tRniUaMsg0lqzV43X2GlA9sogHhzG3dMGLqDnY3A7dsJcDenG93hVk0NrkBE1rhU0T53fNWDGtXycy3baFdAq03BaHWnH1
+			var hQGTMECi2cQYoi7hHqzN9HRs8ChEVuDofSOQmGobNKAl6bvVbWtqzJzgWoswHByKvtuPpQg2yZJn1PgZkW1w0BN6KOcgMQkBObtgEcAMeeumP2eqlD tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(103))
+			_ = hQGTMECi2cQYoi7hHqzN9HRs8ChEVuDofSOQmGobNKAl6bvVbWtqzJzgWoswHByKvtuPpQg2yZJn1PgZkW1w0BN6KOcgMQkBObtgEcAMeeumP2eqlD
+			var v0 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(104))
+			_ = v0
+			var yv2HeKeX9gnjesExtlMsGGiOI5LSUziePjPJB1XjR61n7zgGtRQUg0bHY805LvJ7DG tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(105))
+			_ = yv2HeKeX9gnjesExtlMsGGiOI5LSUziePjPJB1XjR61n7zgGtRQUg0bHY805LvJ7DG
+			var nImSKYiD3G9E9ePNjSRvUD2EJRwj0oLZC3wJDYQOuEFw28VOHdKjEMhlLCt2uEdLfYIVO6v76rOG1Efqif5jRtSz1DpCGoPW tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(106))
+			_ = nImSKYiD3G9E9ePNjSRvUD2EJRwj0oLZC3wJDYQOuEFw28VOHdKjEMhlLCt2uEdLfYIVO6v76rOG1Efqif5jRtSz1DpCGoPW
+			var lXLJErC1IWunsxYA6jxGgXitZHoxYHm38FPIbHSiz2LGBhETFCTyTGEiNfbwn6GIhGLLEQI tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(107))
+			_ = lXLJErC1IWunsxYA6jxGgXitZHoxYHm38FPIbHSiz2LGBhETFCTyTGEiNfbwn6GIhGLLEQI
+			var nrk37ZYFObeGv0nT tla.TLAValue = args1[13]
+			_ = nrk37ZYFObeGv0nT
+			return tla.MakeTLAFunction([]tla.TLAValue{f8FerpPpvVx9MMkWzCPTDTuHTYdIgpO2mcb8SJJFRoo6DrVmr9MPdwFWO4DUFYF6L2lp7vY3RgJuBCEHbcRwltB2NSEmn0RsHtvn4Dchoua9Zh, uTBOegzf41xwPV9PP4Jyej8A4q8GFeUm044NwlkPT5BL3eI1AIfiH8e}, func(args2 []tla.TLAValue) tla.TLAValue {
+				var mZtSfNxblZ8ymqCZtiCL0pg5eeMr8Gxe6BL5CqQVhVpgafjFiJEEfDdQMVcwPFLDeExoalXvZwQqJXlZ4 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(1))
+				_ = mZtSfNxblZ8ymqCZtiCL0pg5eeMr8Gxe6BL5CqQVhVpgafjFiJEEfDdQMVcwPFLDeExoalXvZwQqJXlZ4
+				var iE3ATnGX0LUt6HIWHz8QFLbctA8yjE8JvFzYhwy tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(2))
+				_ = iE3ATnGX0LUt6HIWHz8QFLbctA8yjE8JvFzYhwy
+				var sT78rgH2Vk19RTStefeZH3BZrLwYzwXQWSrVJBUtdcYXhblQ4TVIzoOmm4lH08xpnl78QY66TPezs48hqIDNObpwnBP tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(3))
+				_ = sT78rgH2Vk19RTStefeZH3BZrLwYzwXQWSrVJBUtdcYXhblQ4TVIzoOmm4lH08xpnl78QY66TPezs48hqIDNObpwnBP
+				var cxnGYmjLDiibwY33tVQl36TinOHQrqOVJPJRLHbJzELF5nWg1gY9gyDQuZmeWL8or9fnfgr tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(4))
+				_ = cxnGYmjLDiibwY33tVQl36TinOHQrqOVJPJRLHbJzELF5nWg1gY9gyDQuZmeWL8or9fnfgr
+				var zVzVV85qEeVUBxGax9WnyKXsVApkX1qBuZmfYOGcFgzrtEDyWQ0TKMh6g8x9uP0wFGwQ0bZL4iFMNed7g tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(5))
+				_ = zVzVV85qEeVUBxGax9WnyKXsVApkX1qBuZmfYOGcFgzrtEDyWQ0TKMh6g8x9uP0wFGwQ0bZL4iFMNed7g
+				var heWJqUEkz0uIs9exvOsz7s8xkUTSnEfVaUa tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(6))
+				_ = heWJqUEkz0uIs9exvOsz7s8xkUTSnEfVaUa
+				var qM5bkycjpAVPL6Mfwq9RxpE7xJdRWmK5sG5kE1qj tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(7))
+				_ = qM5bkycjpAVPL6Mfwq9RxpE7xJdRWmK5sG5kE1qj
+				var h1z0y1mFNeaRJnJlM3lztF5OSbbN2elpuC2y5a55nWul46m1FX0gOesVTcroL9FSk69RY6ZH0d9JwjXbf7p3 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(8))
+				_ = h1z0y1mFNeaRJnJlM3lztF5OSbbN2elpuC2y5a55nWul46m1FX0gOesVTcroL9FSk69RY6ZH0d9JwjXbf7p3
+				var dLojbdZgVn80iPafVLHN1VrS9SEs3jqvFGZyfQdTmSB4U4YtAbxcPYgFXdIIr8JIo4QRRr tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(9))
+				_ = dLojbdZgVn80iPafVLHN1VrS9SEs3jqvFGZyfQdTmSB4U4YtAbxcPYgFXdIIr8JIo4QRRr
+				var yAzp tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(10))
+				_ = yAzp
+				var amWR5FXuGvcd2Kdy6mrSd8z6Ku8zAqjhZbYbahEN tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(11))
+				_ = amWR5FXuGvcd2Kdy6mrSd8z6Ku8zAqjhZbYbahEN
+				var pUfTEIqBFaPjxcHk27r85Vrn5gjdFrk3HCaVaKtuksHDAgoG1f tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(12))
+				_ = pUfTEIqBFaPjxcHk27r85Vrn5gjdFrk3HCaVaKtuksHDAgoG1f
+				var sh6q1OmFDYqSdSbRQPzURrHzpC10gTiV8V1AmcK7SyEj52PdzIgKzOBlLjWWizGMNh tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(13))
+				_ = sh6q1OmFDYqSdSbRQPzURrHzpC10gTiV8V1AmcK7SyEj52PdzIgKzOBlLjWWizGMNh
+				var usbi7YZDVS0PrxkdtElOF1QGdM6 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(14))
+				_ = usbi7YZDVS0PrxkdtElOF1QGdM6
+				var svaK5d2j4gvI tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(15))
+				_ = svaK5d2j4gvI
+				var nMQXwpLlDT04C866cTLJFcGctg9hm5AFVp8ABuTgJ7c48PY6mLZFra8eGrBMI tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(16))
+				_ = nMQXwpLlDT04C866cTLJFcGctg9hm5AFVp8ABuTgJ7c48PY6mLZFra8eGrBMI
+				var uso2hpfkwUh2so60PqgbIRFO tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(17))
+				_ = uso2hpfkwUh2so60PqgbIRFO
+				var fg1zwKqdGty0mRzCSCUVzIvKw58RF8kfdQ8wPJbI82VEBK6m0dqx5DPuArRgfGVUMPq tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(18))
+				_ = fg1zwKqdGty0mRzCSCUVzIvKw58RF8kfdQ8wPJbI82VEBK6m0dqx5DPuArRgfGVUMPq
+				var eZWtrMs tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(19))
+				_ = eZWtrMs
+				var uRrzLtORVIVwQ2tP tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(20))
+				_ = uRrzLtORVIVwQ2tP
+				var yuJpweijg8Up5Iw6VAvVzU6SQAxuv1fB7ZOFluTo tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(21))
+				_ = yuJpweijg8Up5Iw6VAvVzU6SQAxuv1fB7ZOFluTo
+				var ySGSSIA8lhpRSr6V7LETu tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(22))
+				_ = ySGSSIA8lhpRSr6V7LETu
+				var jmV8QIECpsPKuaEtaWTxmIBEE3sF7GViLzk9UNEHxcwZVSZ4YK8mGFo8nAIw5VSBWuGqNxJAhYjBHJjVXh9jtjaivOambONfmBXrN tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(23))
+				_ = jmV8QIECpsPKuaEtaWTxmIBEE3sF7GViLzk9UNEHxcwZVSZ4YK8mGFo8nAIw5VSBWuGqNxJAhYjBHJjVXh9jtjaivOambONfmBXrN
+				var qly79Eos4URs7r0MFKK5I64XBHIIsVWHORstUGTMlVrIKSrdg7KR9QuNJD3GXtocGJCqq0AelVmmwmGD3uaXYa7jjon8JRYrTRyvzj67v tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(24))
+				_ = qly79Eos4URs7r0MFKK5I64XBHIIsVWHORstUGTMlVrIKSrdg7KR9QuNJD3GXtocGJCqq0AelVmmwmGD3uaXYa7jjon8JRYrTRyvzj67v
+				var qqOmyvM5zemEp801ZVyS5M tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(25))
+				_ = qqOmyvM5zemEp801ZVyS5M
+				var h4WIh9btI8plOFX8POSDeBtflWUlVxE9uaiU79TZfL8xb2ixeZ1qtg5XtXsAaas6qJNkKzrYWjJ4 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(26))
+				_ = h4WIh9btI8plOFX8POSDeBtflWUlVxE9uaiU79TZfL8xb2ixeZ1qtg5XtXsAaas6qJNkKzrYWjJ4
+				var jDxrXDib2hUYGBM7ErKYkjD9Sq tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(27))
+				_ = jDxrXDib2hUYGBM7ErKYkjD9Sq
+				var mnSAQdexeMQlEYr9bK2kC6w65ZWTzyY9L0ObTq7IKhxqiN6sab tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(28))
+				_ = mnSAQdexeMQlEYr9bK2kC6w65ZWTzyY9L0ObTq7IKhxqiN6sab
+				var kyNlfXVsQKPwD0Dg4pK2lZw7PNMF5KxmuirB0F28x1ECx1ICFVLCVDovB6qgQLUiullYH tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(29))
+				_ = kyNlfXVsQKPwD0Dg4pK2lZw7PNMF5KxmuirB0F28x1ECx1ICFVLCVDovB6qgQLUiullYH
+				var nOFQkWKDGXA6fbA9RpVUyM4IiCYxbRctzZ0fmYT20z4EjBL4VWvKRKWuKzClFZ tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(30))
+				_ = nOFQkWKDGXA6fbA9RpVUyM4IiCYxbRctzZ0fmYT20z4EjBL4VWvKRKWuKzClFZ
+				var mCvINybh4uYZyCKtL256l77x6tm8afLqxrDjfTcO42kuUuaRvo1IEMwpuOYu8PRkMji tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(31))
+				_ = mCvINybh4uYZyCKtL256l77x6tm8afLqxrDjfTcO42kuUuaRvo1IEMwpuOYu8PRkMji
+				var cLLdBigq31Pp0lPiMRGcHj0x0sZnex4LLasTFBf9cbvy3TQCuY4xaOoZeFn7sU7FLjmdkGEvWs7AZbs14npozLQWbR34Gn3NgFD3 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(32))
+				_ = cLLdBigq31Pp0lPiMRGcHj0x0sZnex4LLasTFBf9cbvy3TQCuY4xaOoZeFn7sU7FLjmdkGEvWs7AZbs14npozLQWbR34Gn3NgFD3
+				var wiJdDTioo91pSKjIrblpq5p8tkUZeDmxNsqFc6SEcg6XdksLwFRXxTYkU7WoomcSR0d5Wa4QyMa8VPgUM6xiAeB tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(33))
+				_ = wiJdDTioo91pSKjIrblpq5p8tkUZeDmxNsqFc6SEcg6XdksLwFRXxTYkU7WoomcSR0d5Wa4QyMa8VPgUM6xiAeB
+				var pNu tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(34))
+				_ = pNu
+				var mcE05KKvCJ42P0CTgUjNbMZNetE3f0Ksq97aYRUdYY4jlIaVJsz3kF5OzMawj tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(35))
+				_ = mcE05KKvCJ42P0CTgUjNbMZNetE3f0Ksq97aYRUdYY4jlIaVJsz3kF5OzMawj
+				var fW1vtRiNt7NtFq33mpLErpMIJzCgtZuE0a9kVqaGy3oQWv9UefCASSLkbPRycJd0LeHYLfkzwoFCLl4P3fu2N4Evufw tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(36))
+				_ = fW1vtRiNt7NtFq33mpLErpMIJzCgtZuE0a9kVqaGy3oQWv9UefCASSLkbPRycJd0LeHYLfkzwoFCLl4P3fu2N4Evufw
+				var aauQUmIPywkxvMC4DLmTk2NzIiM8aw1A4pH8h0vEqrgNob tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(37))
+				_ = aauQUmIPywkxvMC4DLmTk2NzIiM8aw1A4pH8h0vEqrgNob
+				var pXXcnzldaGNBC3NDCu4D28mLWR5Xs4G7 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(38))
+				_ = pXXcnzldaGNBC3NDCu4D28mLWR5Xs4G7
+				var wMj8vHTd tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(39))
+				_ = wMj8vHTd
+				var bcE4M5BTm3OaDFWGT4Y7iZqww0lLfyxpZNP8KIC3WKegwPru885snHCtrcsMrhPWE tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(40))
+				_ = bcE4M5BTm3OaDFWGT4Y7iZqww0lLfyxpZNP8KIC3WKegwPru885snHCtrcsMrhPWE
+				var pjwP7Sgg70Uz3FisZjfmM1rEFIalBpJ3gCZTFwBwN8vbpEiETHXVwXjtYE tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(41))
+				_ = pjwP7Sgg70Uz3FisZjfmM1rEFIalBpJ3gCZTFwBwN8vbpEiETHXVwXjtYE
+				var yHPQCpTmARRYaB76vStJUvxpZKp5OaCKmfXOm1JK6TeyZnD0 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(42))
+				_ = yHPQCpTmARRYaB76vStJUvxpZKp5OaCKmfXOm1JK6TeyZnD0
+				var i2Xea4P8xwKsdTR0FZxYEEES5aY2OGiPauCMCAlvLeeZk1ve6ChQWbJYB12hhIqUzcninU2XOtAohqESZj2R tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(43))
+				_ = i2Xea4P8xwKsdTR0FZxYEEES5aY2OGiPauCMCAlvLeeZk1ve6ChQWbJYB12hhIqUzcninU2XOtAohqESZj2R
+				var iHj0i7nf5MdtS4CAsm5uG8lqVRm9zVsdKPxwDfdpGJ1IVNzBhR tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(44))
+				_ = iHj0i7nf5MdtS4CAsm5uG8lqVRm9zVsdKPxwDfdpGJ1IVNzBhR
+				var n9O4kXlPCgAg8JVyeqHzveK3joXUVhytJRlMgCSpc6Lg4CPl4XzT9H8 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(45))
+				_ = n9O4kXlPCgAg8JVyeqHzveK3joXUVhytJRlMgCSpc6Lg4CPl4XzT9H8
+				var wahlO2gFJWlqm1sQ5aZASuAcE6e8Ag tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(46))
+				_ = wahlO2gFJWlqm1sQ5aZASuAcE6e8Ag
+				var xmETexUCtvYL105o7DRTTx tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(47))
+				_ = xmETexUCtvYL105o7DRTTx
+				var lqk5gtZp tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(48))
+				_ = lqk5gtZp
+				var dp4mrNuv8js7RCMmbAqk2ZUvTOB78UvvVJMChY5MU tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(49))
+				_ = dp4mrNuv8js7RCMmbAqk2ZUvTOB78UvvVJMChY5MU
+				var a8XkCDA1O96c5XMRY35c8N2Er6Tx30wA tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(50))
+				_ = a8XkCDA1O96c5XMRY35c8N2Er6Tx30wA
+				var nreOTNKGKNLMNUUL6LOjS4STGJLiaLoNDO4 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(51))
+				_ = nreOTNKGKNLMNUUL6LOjS4STGJLiaLoNDO4
+				var lUdg86QAWNbJB9bUDSeWQ17gM5gwVvMsChU8V1d3JZl55wJrSLXLcro1cEgnHv9fPO6hsMvklL1EK9iAzevBHQwfnqDeg28EW4zm40tMayCouY1LL tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(52))
+				_ = lUdg86QAWNbJB9bUDSeWQ17gM5gwVvMsChU8V1d3JZl55wJrSLXLcro1cEgnHv9fPO6hsMvklL1EK9iAzevBHQwfnqDeg28EW4zm40tMayCouY1LL
+				var igUgakCNaa4rAGVRMhS9w8jNFhiRm7q4E tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(53))
+				_ = igUgakCNaa4rAGVRMhS9w8jNFhiRm7q4E
+				var qu35tFhq91sFYUvXnWM18tP8dC330nMkDSrmn6vEamBhvRCj0VMtqn5FMvgzT tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(54))
+				_ = qu35tFhq91sFYUvXnWM18tP8dC330nMkDSrmn6vEamBhvRCj0VMtqn5FMvgzT
+				var tvYQ1QGA9gFeujn5DKUvWWSpXfjAbj1054BIiZooEBHSAeIOKbe3Yhop9R8HQknOdogOPG34WFEqUicycwwoDVXQ tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(55))
+				_ = tvYQ1QGA9gFeujn5DKUvWWSpXfjAbj1054BIiZooEBHSAeIOKbe3Yhop9R8HQknOdogOPG34WFEqUicycwwoDVXQ
+				var ruS72EFiy5M2NRMqqgvSh6eACs7xK2TnRUDhOKkfYcNiiB51FMmIj2gm5LtkTt2MGXPF tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(56))
+				_ = ruS72EFiy5M2NRMqqgvSh6eACs7xK2TnRUDhOKkfYcNiiB51FMmIj2gm5LtkTt2MGXPF
+				var uNv0oI8pTmAIRVSFT9ytJsruawSJUp1VkzXo74wkWxxFnMntJkqkzJJ0FIeurArBlnqJ tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(57))
+				_ = uNv0oI8pTmAIRVSFT9ytJsruawSJUp1VkzXo74wkWxxFnMntJkqkzJJ0FIeurArBlnqJ
+				var rwJ44yOUg3oQVnRVl5Sa8fx30CL7m67M0k5dPs21aWKoJ13LkkNSFODJRGPBTCMPG9q4DjJOulCYXh5PBsPzuV6CjoTPUv5LxDc tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(58))
+				_ = rwJ44yOUg3oQVnRVl5Sa8fx30CL7m67M0k5dPs21aWKoJ13LkkNSFODJRGPBTCMPG9q4DjJOulCYXh5PBsPzuV6CjoTPUv5LxDc
+				var zhiH5ySYmhQGBpcQsoz6VCd8DOirOMOH7iAPYb tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(59))
+				_ = zhiH5ySYmhQGBpcQsoz6VCd8DOirOMOH7iAPYb
+				var xG1km3unFEVb4nqTdRDYaDgggBKmlJy4kz0tMqpWuSbkZ4RB8x4HjTh6esD5SUTxjlkowlQZFxgNha tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(60))
+				_ = xG1km3unFEVb4nqTdRDYaDgggBKmlJy4kz0tMqpWuSbkZ4RB8x4HjTh6esD5SUTxjlkowlQZFxgNha
+				var a9kpCMFZfRPSlNmQXnyd2bpq17xcM8 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(61))
+				_ = a9kpCMFZfRPSlNmQXnyd2bpq17xcM8
+				var t4e9AnPaR217FhJDE7uvFRZj6WDQzhi7xKcepRDofXRumk03F7KE2M7zzorxMDN9jv6ZGoYdX9Fwgxm tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(62))
+				_ = t4e9AnPaR217FhJDE7uvFRZj6WDQzhi7xKcepRDofXRumk03F7KE2M7zzorxMDN9jv6ZGoYdX9Fwgxm
+				var bcg8WuxRA2aqUz1lDOIfAppp7baNeEM tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(63))
+				_ = bcg8WuxRA2aqUz1lDOIfAppp7baNeEM
+				var hvG993zXD8x8QzLZaHRLlOorkxKejT tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(64))
+				_ = hvG993zXD8x8QzLZaHRLlOorkxKejT
+				var hIPCBKVnpjZ4I43tC03iT8NoZCPD4PzdrsTKF51bvFzOxabBL9N tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(65))
+				_ = hIPCBKVnpjZ4I43tC03iT8NoZCPD4PzdrsTKF51bvFzOxabBL9N
+				var u9o1zqPEAhnP4WxUGyvCVII6kfpnxvZFAt0Tjw5g9B9RusP3tgDzk2dH4Pan tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(66))
+				_ = u9o1zqPEAhnP4WxUGyvCVII6kfpnxvZFAt0Tjw5g9B9RusP3tgDzk2dH4Pan
+				var w2p4smZAJe tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(67))
+				_ = w2p4smZAJe
+				var f5EhlQOvdSn0NtQjC2EJSgHiuHehGqLxop6P4wpYVRRUBud2IbuG8ddefn519a4lPmxIW2SXheyDHjrB5m89zI2ncEN4mOSuCBOwYCc3D tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(68))
+				_ = f5EhlQOvdSn0NtQjC2EJSgHiuHehGqLxop6P4wpYVRRUBud2IbuG8ddefn519a4lPmxIW2SXheyDHjrB5m89zI2ncEN4mOSuCBOwYCc3D
+				var xmEcXy1rEr1Z0HfD8reE9XV8LQmuPspH4X4rOnKRzdwyxMv7VrKKdhEZYYIgbt7b1fRzG tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(69))
+				_ = xmEcXy1rEr1Z0HfD8reE9XV8LQmuPspH4X4rOnKRzdwyxMv7VrKKdhEZYYIgbt7b1fRzG
+				var vvXJ tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(70))
+				_ = vvXJ
+				var jbcl2U4KV0ogw2kwRdXxgvuNUnlksstw1u7xwgmz13ENpV9VLzMfIU1fF3rAKlts5i3AbSmdmUmL tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(71))
+				_ = jbcl2U4KV0ogw2kwRdXxgvuNUnlksstw1u7xwgmz13ENpV9VLzMfIU1fF3rAKlts5i3AbSmdmUmL
+				var wMFngDE8vsP3a8vG29U8dCN8ipjrV4EWrB tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(72))
+				_ = wMFngDE8vsP3a8vG29U8dCN8ipjrV4EWrB
+				var wUPBcuS8tlnXxSUjow7IaT82Q887516HmKudjqhV695R9axbknFQgGvAgZx63ztpCgaHla tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(73))
+				_ = wUPBcuS8tlnXxSUjow7IaT82Q887516HmKudjqhV695R9axbknFQgGvAgZx63ztpCgaHla
+				var c0 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(74))
+				_ = c0
+				var t8hc3IByhWt3z0U3MKkSG tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(75))
+				_ = t8hc3IByhWt3z0U3MKkSG
+				var unuNrbGOPSiztTLlBI5ADst7vZIrhy3Jt7FBHtIcnHOSfkeAvD1ERWGtg7A0a2TqvUMVJbLLc8EiiN8FknEdqwfEXuBjmE5q1DSgPD9BsfV2zhZd tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(1))
+				_ = unuNrbGOPSiztTLlBI5ADst7vZIrhy3Jt7FBHtIcnHOSfkeAvD1ERWGtg7A0a2TqvUMVJbLLc8EiiN8FknEdqwfEXuBjmE5q1DSgPD9BsfV2zhZd
+				var bpjkupxScuzMNi5RirnzOwja8Da3d0JnOQa26qdbA49yqwNwRhnEEizPTdSZPFwHTrElxuIoqZ tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(2))
+				_ = bpjkupxScuzMNi5RirnzOwja8Da3d0JnOQa26qdbA49yqwNwRhnEEizPTdSZPFwHTrElxuIoqZ
+				var fx7udD9tTCt70NsIvlUimAVfuglohMaDsc tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(3))
+				_ = fx7udD9tTCt70NsIvlUimAVfuglohMaDsc
+				var lIQ7TCDNgCpQAY6pFlFfJo1wiLZk0QyaJDoks8 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(4))
+				_ = lIQ7TCDNgCpQAY6pFlFfJo1wiLZk0QyaJDoks8
+				var n3JftUIqQ8s631NOXJge6qzVLgllarp tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(5))
+				_ = n3JftUIqQ8s631NOXJge6qzVLgllarp
+				var eiMinFn7LreZSWVyj tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(6))
+				_ = eiMinFn7LreZSWVyj
+				var hWX3HlghatRFZ9DdP2pQMNvQK8VyrTpvXJXwuT90JKhFGadSeU92rO4yj5FOijegVv3IO9DrHKSgR tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(7))
+				_ = hWX3HlghatRFZ9DdP2pQMNvQK8VyrTpvXJXwuT90JKhFGadSeU92rO4yj5FOijegVv3IO9DrHKSgR
+				var sS3sksPjRXiK2Q30jlYvsWiQDoWJVwdJJjdmCfuqqXZVUDm4ikbnwszGgUlowvcRe78IjXFHo tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(8))
+				_ = sS3sksPjRXiK2Q30jlYvsWiQDoWJVwdJJjdmCfuqqXZVUDm4ikbnwszGgUlowvcRe78IjXFHo
+				var tYYtXgvZLLHJYs0izWi0oTA5l tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(9))
+				_ = tYYtXgvZLLHJYs0izWi0oTA5l
+				var z tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(10))
+				_ = z
+				var qC52 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(11))
+				_ = qC52
+				var aXwO6VfcY5N5bWdGn tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(12))
+				_ = aXwO6VfcY5N5bWdGn
+				var bi7Kvr4HjzhgfKuwPeaKnYktTX75CRLBiPsLvefadFphH04xPp3tvPCKgTyeyrAkgqOVIul89GCB0i tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(13))
+				_ = bi7Kvr4HjzhgfKuwPeaKnYktTX75CRLBiPsLvefadFphH04xPp3tvPCKgTyeyrAkgqOVIul89GCB0i
+				var iqbuw5x8LIplCV6nALMePYwDu4ffV2NxjdBsbHh3I2MTz8RAtt7YRUSgm9oF9XUx0DVlOoIWTS0pYx3vytpYHikwNXH2UH2qzs7HtYmJKCh tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(14))
+				_ = iqbuw5x8LIplCV6nALMePYwDu4ffV2NxjdBsbHh3I2MTz8RAtt7YRUSgm9oF9XUx0DVlOoIWTS0pYx3vytpYHikwNXH2UH2qzs7HtYmJKCh
+				var vrv4ZHh7BEmju6zOLl9rkHfXJ9wVe8OhXSgCv0w4oUdXjeZtNNGGMQ1dSCzG3PxuV tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(15))
+				_ = vrv4ZHh7BEmju6zOLl9rkHfXJ9wVe8OhXSgCv0w4oUdXjeZtNNGGMQ1dSCzG3PxuV
+				var mm3XszPMlZybr5mValgDcTxawlzQAX53whkmCIiHwGXwyYhDRV0cFOJzjruJK4F6iVz5hOACxgLjT tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(16))
+				_ = mm3XszPMlZybr5mValgDcTxawlzQAX53whkmCIiHwGXwyYhDRV0cFOJzjruJK4F6iVz5hOACxgLjT
+				var qI2QLowp6Sd5YKuWk5ode5hR1T54kzSCrVr tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(17))
+				_ = qI2QLowp6Sd5YKuWk5ode5hR1T54kzSCrVr
+				var t tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(18))
+				_ = t
+				var ek5tO3xhSDsRSMuvEKYmLpemNj23mO9Rt7BIXPW9H2TwpdkFr6XQSnZ5ZQQY3HjYfLVXj9ONP8k3UT tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(19))
+				_ = ek5tO3xhSDsRSMuvEKYmLpemNj23mO9Rt7BIXPW9H2TwpdkFr6XQSnZ5ZQQY3HjYfLVXj9ONP8k3UT
+				var cry0M4HWueevVv tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(20))
+				_ = cry0M4HWueevVv
+				var g3mG9rA6Gvl6S3yXMfcGGiSJf9elIalibmIYEiS4TgZsBu7tOPKFPeExKgu tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(21))
+				_ = g3mG9rA6Gvl6S3yXMfcGGiSJf9elIalibmIYEiS4TgZsBu7tOPKFPeExKgu
+				var ekxkPGGtn5Ed4puZnFxyHN0 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(22))
+				_ = ekxkPGGtn5Ed4puZnFxyHN0
+				var slnKxnfTTp6VXEUpTrnLgMSUHqtzqQIRk3HH8p1sGLeupKa tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(23))
+				_ = slnKxnfTTp6VXEUpTrnLgMSUHqtzqQIRk3HH8p1sGLeupKa
+				var iwHlump7GkjI0F6qYema1yGoqxERkQ9G2oAEW2O6ZWxO9LVLH2PDIwQvobNNVaONkAKROBIStwT tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(24))
+				_ = iwHlump7GkjI0F6qYema1yGoqxERkQ9G2oAEW2O6ZWxO9LVLH2PDIwQvobNNVaONkAKROBIStwT
+				var g3ww3q2V2 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(25))
+				_ = g3ww3q2V2
+				var yEhz9OL6lgKR11i tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(26))
+				_ = yEhz9OL6lgKR11i
+				var dJAVvb3bV3rIWj1aB8OORjWxewXW0y2Luwe4nw4aiVj4gP7UWhpOrr5vIMqFI5Ommbc1QS06ib4QaAbNsIPh16 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(27))
+				_ = dJAVvb3bV3rIWj1aB8OORjWxewXW0y2Luwe4nw4aiVj4gP7UWhpOrr5vIMqFI5Ommbc1QS06ib4QaAbNsIPh16
+				var x8IcqczfKvtW5rxh75YEW2S8bSZGfeRNZVetgavPEjyGQoD2qNRiszM0lZr1zbWs tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(28))
+				_ = x8IcqczfKvtW5rxh75YEW2S8bSZGfeRNZVetgavPEjyGQoD2qNRiszM0lZr1zbWs
+				var zhKU4oWixtT3jMk1qtyDzoUx3Ft9UpZ8jCBXKER9yufV7AFaQJIe8pb62dJQ0RwyxBRcc5uiyaEmNc8BssF9XhVzygP1ZlX5Hkhe tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(29))
+				_ = zhKU4oWixtT3jMk1qtyDzoUx3Ft9UpZ8jCBXKER9yufV7AFaQJIe8pb62dJQ0RwyxBRcc5uiyaEmNc8BssF9XhVzygP1ZlX5Hkhe
+				var kMi3VGoX3z4gAzS45MOhhYgQGlwJAAGQgQgo7hNUV0Bv5bM6GAFDo197GhDPz34SrFkXStiv3uiti2mYlpL2QuVbpzNY4epDXaAz7G1cjXzLLaszic tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(30))
+				_ = kMi3VGoX3z4gAzS45MOhhYgQGlwJAAGQgQgo7hNUV0Bv5bM6GAFDo197GhDPz34SrFkXStiv3uiti2mYlpL2QuVbpzNY4epDXaAz7G1cjXzLLaszic
+				var xDRScvHqAJSzV tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(31))
+				_ = xDRScvHqAJSzV
+				var iT19uS3tHHhlJ4SkcxtQwGiGfiF7yRMxSYj60PtGa0kgMVnsUu7vLWxr9TmMaiU tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(32))
+				_ = iT19uS3tHHhlJ4SkcxtQwGiGfiF7yRMxSYj60PtGa0kgMVnsUu7vLWxr9TmMaiU
+				var jQobGKhyZJXpzLUcgFOd9V6gQBFfjXAv4ggRVNgJwoKYoDrq1QUsvwmHcrrad2KEuhF2v7DLwB0p8pTnHJoYs tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(33))
+				_ = jQobGKhyZJXpzLUcgFOd9V6gQBFfjXAv4ggRVNgJwoKYoDrq1QUsvwmHcrrad2KEuhF2v7DLwB0p8pTnHJoYs
+				var ruSfHcV8Sb74YTg1h5cNtrWODNXuRy240IlNoRebl2DEWnOU3To6BM0cHhrwNlVi84WCQ15CkyroKVLh8GvqS88VvmI6LDqK6N0gPBbLLX0rhjn tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(34))
+				_ = ruSfHcV8Sb74YTg1h5cNtrWODNXuRy240IlNoRebl2DEWnOU3To6BM0cHhrwNlVi84WCQ15CkyroKVLh8GvqS88VvmI6LDqK6N0gPBbLLX0rhjn
+				var xO56IiBDHy5gXSStUMMQ64fQuLP6DYyAgMRAByycLSOVXZi5EoSDYUJsMJqbraIFfIdq tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(35))
+				_ = xO56IiBDHy5gXSStUMMQ64fQuLP6DYyAgMRAByycLSOVXZi5EoSDYUJsMJqbraIFfIdq
+				var liFOYjgnVLS0wdizj5yCC6ot3O0MWApEie8i91sodBsJvLASWo4Lemb18ONKBm3Wx28FosBmKpvjY9z54OBN tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(36))
+				_ = liFOYjgnVLS0wdizj5yCC6ot3O0MWApEie8i91sodBsJvLASWo4Lemb18ONKBm3Wx28FosBmKpvjY9z54OBN
+				var oabuzz9SZfXKIEGx4XV87HNl0Jjv6lXqw6wHTbWFpHZZ tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(37))
+				_ = oabuzz9SZfXKIEGx4XV87HNl0Jjv6lXqw6wHTbWFpHZZ
+				var yw2tjhuJ2G3NwM5Ypw9dU tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(38))
+				_ = yw2tjhuJ2G3NwM5Ypw9dU
+				var wsRjPTyirR5IhGvsORmv7FazT8Ut01xRPR8X9sKGu7tuTiJ4MDeYp4tqLf1TZhbn2ce1W9iDxuBwhmZtNZ4RF8e5IUmDuymNlEZeLxWfPsjvkUwn48O tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(39))
+				_ = wsRjPTyirR5IhGvsORmv7FazT8Ut01xRPR8X9sKGu7tuTiJ4MDeYp4tqLf1TZhbn2ce1W9iDxuBwhmZtNZ4RF8e5IUmDuymNlEZeLxWfPsjvkUwn48O
+				var h0knYxytsXqdKWLilF9mBrov8eiRy57pJ5g871whGgAkdXS tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(40))
+				_ = h0knYxytsXqdKWLilF9mBrov8eiRy57pJ5g871whGgAkdXS
+				var mBhXFWOySq4Sm0yoQ0MNHruYG9QTyQA7kPwn6CDs3bhfoXEr5x2gEOCrDUPWSibHJDYV0Me3KTpw tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(41))
+				_ = mBhXFWOySq4Sm0yoQ0MNHruYG9QTyQA7kPwn6CDs3bhfoXEr5x2gEOCrDUPWSibHJDYV0Me3KTpw
+				var jHOH1KfVAo5pam2hUYMO6xHroHQI2IBioqHxLjnaRmIq5wC3PuZLrZo7nKwNjGZsdL0pBSgAR tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(42))
+				_ = jHOH1KfVAo5pam2hUYMO6xHroHQI2IBioqHxLjnaRmIq5wC3PuZLrZo7nKwNjGZsdL0pBSgAR
+				var zEPWTcdw5CzYp3kIKMljBhdAeCu5h8yC8lNy65MrYq9nrEzAUKCCZgfwxZvqZ0fBN7dsnF3ROy3obfqtfLrw4A823mtp1yNMXxT6ocw tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(43))
+				_ = zEPWTcdw5CzYp3kIKMljBhdAeCu5h8yC8lNy65MrYq9nrEzAUKCCZgfwxZvqZ0fBN7dsnF3ROy3obfqtfLrw4A823mtp1yNMXxT6ocw
+				var ueSzsg8ywWD tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(44))
+				_ = ueSzsg8ywWD
+				var rPeE2NqI65ohRShrtM8 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(45))
+				_ = rPeE2NqI65ohRShrtM8
+				var gxhnhtmakjWCoC1Ueq67yBpz1NuMcsNPKL8qlSthvuPRch1OJi tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(46))
+				_ = gxhnhtmakjWCoC1Ueq67yBpz1NuMcsNPKL8qlSthvuPRch1OJi
+				var fsOSeY9hUHs4xwfBMrpO tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(47))
+				_ = fsOSeY9hUHs4xwfBMrpO
+				var fRgcEqD8sqPjZeiml5ICpYee9cKy9S6t1n8E16Bwh7UdAxfxHbVciO0Y1UmTyiQqRlqpqhfDAijB2jClkHxllWpuvRxZEJ3kpKm tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(48))
+				_ = fRgcEqD8sqPjZeiml5ICpYee9cKy9S6t1n8E16Bwh7UdAxfxHbVciO0Y1UmTyiQqRlqpqhfDAijB2jClkHxllWpuvRxZEJ3kpKm
+				var rSJ16lwMX9kmuSs0eDJNVctg5oCOY82IaIe9VNIxjSRostTZ tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(49))
+				_ = rSJ16lwMX9kmuSs0eDJNVctg5oCOY82IaIe9VNIxjSRostTZ
+				var f6qttWxfqGAfa0hLBmI2adMaiH4qsx4IRBofFfVMcxRaiA7CtI6ggwIZElNAdj7nh7kNdhor0Mpn60DQiS97O545Xvbyh3JiEhUeDiZov48 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(50))
+				_ = f6qttWxfqGAfa0hLBmI2adMaiH4qsx4IRBofFfVMcxRaiA7CtI6ggwIZElNAdj7nh7kNdhor0Mpn60DQiS97O545Xvbyh3JiEhUeDiZov48
+				var muZL2HL32cNAk1pV1PhIO9 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(51))
+				_ = muZL2HL32cNAk1pV1PhIO9
+				var elXTyvCU4yRphjJR5i647PsGKn4axfqx4pNxHuHFs5DKpmyLUjbTKdZrkHzyoEvWyKww7MhOQq0ayr2R tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(52))
+				_ = elXTyvCU4yRphjJR5i647PsGKn4axfqx4pNxHuHFs5DKpmyLUjbTKdZrkHzyoEvWyKww7MhOQq0ayr2R
+				var glUfJbFh1nf tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(53))
+				_ = glUfJbFh1nf
+				var adlKQYD1o777vwl1triPE tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(54))
+				_ = adlKQYD1o777vwl1triPE
+				var gUu1cZQFCLww4BGv tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(55))
+				_ = gUu1cZQFCLww4BGv
+				var ml7J7gAGkUZLq tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(56))
+				_ = ml7J7gAGkUZLq
+				var aIYc7YAdPMPVzI1rmFcLVa96UBn3fk418rd0 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(57))
+				_ = aIYc7YAdPMPVzI1rmFcLVa96UBn3fk418rd0
+				var z7RQ20 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(58))
+				_ = z7RQ20
+				var boYPP6BaFugv3EAZD0mbVTcKGlQQiHGr6SDXIjX63FLPEjGfB5W0OahL1E0OqWkwBthvy709 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(59))
+				_ = boYPP6BaFugv3EAZD0mbVTcKGlQQiHGr6SDXIjX63FLPEjGfB5W0OahL1E0OqWkwBthvy709
+				var d45KC0cEQKyEPEmtfyRMJuDI7Uk5WQv5w0qMTPyu5Sm7RCvcj0tthCrAR5OXPyT11QITva4LkphPn tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(60))
+				_ = d45KC0cEQKyEPEmtfyRMJuDI7Uk5WQv5w0qMTPyu5Sm7RCvcj0tthCrAR5OXPyT11QITva4LkphPn
+				var e5wa8Lt9M7tsAcVO03SqV6FUwyZ tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(61))
+				_ = e5wa8Lt9M7tsAcVO03SqV6FUwyZ
+				var tvuxndBl8COUTxUtpJAvObaYDpKW5QsDZtg9ZwzR4aZSIPRAozgrEqunDv7lLYG5BE6ozDlLdc9L5xaZgTDLAFLxEBWJkCPXFTl8O2BVZgon1oQx2xrV tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(62))
+				_ = tvuxndBl8COUTxUtpJAvObaYDpKW5QsDZtg9ZwzR4aZSIPRAozgrEqunDv7lLYG5BE6ozDlLdc9L5xaZgTDLAFLxEBWJkCPXFTl8O2BVZgon1oQx2xrV
+				var t99FHST41usuOsf8c5SKjxZwmO5EvzMUGrGn29g2L8t2RW7mqHJiiUX54tc tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(63))
+				_ = t99FHST41usuOsf8c5SKjxZwmO5EvzMUGrGn29g2L8t2RW7mqHJiiUX54tc
+				var sb3pgyFeZtScwxNmuUvvkWeUETO5WF5p6YiOXLp3euiOn14O3kJZbijpMwqN75DRKk tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(64))
+				_ = sb3pgyFeZtScwxNmuUvvkWeUETO5WF5p6YiOXLp3euiOn14O3kJZbijpMwqN75DRKk
+				var oGhjM7Ek7rLGKCJsIJfxyLbFZxvFAZiiEQ451c94EqNBaZH3VaYUldakwLyst tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(65))
+				_ = oGhjM7Ek7rLGKCJsIJfxyLbFZxvFAZiiEQ451c94EqNBaZH3VaYUldakwLyst
+				var bwzgiEErLmS8X0QDSB1 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(66))
+				_ = bwzgiEErLmS8X0QDSB1
+				var uCW4faqdue4pjr3FGVsXkbHnwTPtOeQzsCbxM29v7vE tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(67))
+				_ = uCW4faqdue4pjr3FGVsXkbHnwTPtOeQzsCbxM29v7vE
+				return kKa0FOAnjnOrXmqpw5mzjiGjspYPvCI3puDlt1jTnz0hhp6eg73Xe9igS9tmIDWBiLcPUA9cZz9cSSqKDCG5DRShCWvfozxKiwTRbTHl93hm
+			}).AsBool()
 		})
-	}), func() tla.TLAValue {
-		switch {
-		case tla.MakeTLATuple(tla.MakeTLAString("|AP8[H3v] 0TXS4l;{MD>jLmGb~(,$M3&I+03G/|yp\\lCm\"gW{Y,|Jp`i$ICm_%\").WjP_Q/lA5")).AsBool():
-			return tla.TLA_Zero
-		case tla.MakeTLAString("~ l").AsBool():
-			return tla.MakeTLANumber(34)
-		case tla.TLA_Zero.ApplyFunction(tla.MakeTLAString("tpFXgtDC996txdhqxvc6uTZ7LGguVCIqPWhb4GPq0vrjsU6VJ36ivYQrBcr6eLu6EeizwwVG4duDRq6")).AsBool():
-			return tla.TLA_Cardinality(tla.TLA_Zero)
-		default:
-			panic("no cases matched for TLA+ case expression!")
-		}
-	}(), tla.MakeTLASet(tla.MakeTLANumber(103), tla.MakeTLATuple(tla.MakeTLASet()), tla.TLA_Tail(tla.MakeTLAString("ao>Q^45R*)&S.P;@h>(g&QFHhB2]CIa,O)R}&icu)|<rcbCRZ!s}W9F:`9s.Xl\\Z{A=%jK1Z%)\"Y@MrS]M*^\"i)oNf<X)$h06WH,E$)AV2)I=@e{4zwo"))), tla.MakeTLARecord([]tla.TLARecordField{
-		{tla.MakeTLAString("eSmDnVka2T5vfCr2D6NAeJaHIIDOlNw2EtW2rEOQXQPKS5kkyWOloH5TdOSGJ8Bz44giGFHo7gIWf8OEXbvNC4Tf8ygMzR3dqbcqv"), tla.MakeTLANumber(69)},
-		{tla.MakeTLAString("izEEDYZa31aMoAX0ZZSPtnzZiSJasTS5zXqdJAympTsARWZkLDJD"), tla.MakeTLANumber(113)},
-		{tla.MakeTLAString("dyEu1EeADkIvzDaPjLTdpJIcifTMU16PgoOR7843Gu7mpKqkARv0JE2u1zPr2eQ4vy0VjJul5uqeh7XlPAPV9enWRCf8t0B0xY0IzPaYhk71Y"), tla.MakeTLATuple()},
-		{tla.MakeTLAString("ihxh4hux8hT1KYXTuUzKlwPYkKAA"), tla.MakeTLASet()},
-		{tla.MakeTLAString("ztbbVgCSX1bb5RMzZV4BCFgYkbdQlRyqG57NRyYuAN37oz96UHjVkC7HEElI4leshq4qEfyyLaNoJgFjFiy1Ucxd5jJpteH1vEaWkHa6yFo0Zzk"), tla.TLA_Zero},
-		{tla.MakeTLAString("zjWP4mQbVQ2lc9Ah2qo2sENITxJ9Fk"), tla.MakeTLASet()},
-		{tla.MakeTLAString("v4uawbLQboCq5rPaF4O4P9LhHxvqZlwWboXHoHD4oeoKTh3DhpLroR9zLte1WE3VZl5QuYZf71I5yofGfhfrcAeWZq4MopRwyEv"), tla.MakeTLATuple()},
-	}), func() tla.TLAValue {
-		dMBYoW4IrtFxgIWrYPBUK7U82G2P3eAqe8GTlNeOFFlAJ6oEJT := func(nxVAq5SHVzmvyyLtL6KTp81XDMb1M5gm1tUZ5X3tO6tVeixPGbirwW0b3WSixN9B00CFRj5 tla.TLAValue, a6O9WgcDePw3so7SKk8CrZcBbOAQw21QJa3wAo83WKEVOM0q1MxaUg1 tla.TLAValue, iYzkTIpshRIClbgtt7PCsvhU1p9zHHuWkDkQVQpZYHwqetUCJHB9hzSspxoSd4tazL2qGLqiGljQPkkk1abCepxIxQJMsHHegjnI3ORMkzNW tla.TLAValue, eibKgFT2bFMHNqSkBKHeq6rDs6QWjrBuaUppNNFWfxfGm6ybgx1y6SRQgPWrTaWtoh8IWCJ7CpHI3sl tla.TLAValue, ybPgPsNbI2tuE2K1iA1LrW4eXPRY05LyWpBCpRHC8q8iS62OXS5add7In tla.TLAValue, uqDvk2oF1CmjHdAze6afrN9c6Y0V9mZmsAdaeSgayd15MXEfOsru6LNlEsfLBwtgaQfH3CL18EoRux3NHDZ tla.TLAValue, vdIscEuUCezJghNA3FcZvKPOuwXLU77vygCZ0btzt4EZt90EMW9p0Alq3rISBmthV7NJiWDtasETDIaFyY4fTyJcrbJZ4nnLLL6hlo1r546GIlFHKhA4mf tla.TLAValue, yVo6R1IjAptaDLwUA1gBn7YfjaF7ZaAf96NxQxdB0z tla.TLAValue, pnoaUybvqpbFApkcV39Z6t35wHInxdO1geVF30nzdltg4tzSpvt8eq4z3MMOfMBaiyhRg5ybcnhTyA9zEopw3yI4Z5EjlABh9vADzC8 tla.TLAValue, eTfg0HxBkXQFsmHMM7tzNzFG tla.TLAValue, sIwkrxLi5Ntu7Cp9N0qYRzetOgu5Q3WJkgiou6rcWtw1FCa0K204YXW7c2cc5QJ8eQlYmRQEB9ZX0D30PR6hrduOxltushIle85WUU7bhAWVR1 tla.TLAValue, cIAnUzlzJJKbDePXcOGU5EHKL9nSXf1 tla.TLAValue, cOK tla.TLAValue, sf3IfXkK2Ol7Y64A7mgUGWM8S3ccH2 tla.TLAValue, jJb4v29omLKKv5It5RN2x2IFq8HV6Z9HseNGKEWmGMBIkmnDEwUI7QvAxfRH tla.TLAValue, rsLln4MGBLeC81BgBMGSXX tla.TLAValue, rZCJJMsv1jAxmyQjMLhsdmH8WMPJ3uctBaF0WdMTLBDSW3HlmBe1n1q9jZVLtpkSRwAnc42l8lLGMKZLQKRY5QAdfUsrDlVIM4JAPdiPsAHxXggP21tB tla.TLAValue, nUPa2waBp3rkfbbmB0YennfJw33fwZ6nPJ3cdH26J12SZZcOYyVvYjbZi5XORCxTdbP9yCAqwA07HjhaHboBNu tla.TLAValue, aOXhTn tla.TLAValue, iJAYyXf8zHNTmbKp3UW5K4B0ALrIWjnZwPYa5eQUPDWP9X0EN1xRAeEW1QltgZANH9Y8cl93VWXWPx1B1CeGtJ0Zc0ra3XJcB4a5dGaEEHoVCB9Bsbp tla.TLAValue, vZAUwcaw94oKZdAS6NhOEpQjadeImLnBdLABlHcxct1G36MLDBMPzbh tla.TLAValue, zDJhnifz1 tla.TLAValue, pTnQLAuZpwUaqY55cJuvHq1t330FccrKxkEcnudLOjKgqVjJXw9oj tla.TLAValue, hRZGwOfVOqCN3NjIwRUIKyhwawc4xTc9ckcVLWqL tla.TLAValue, itF2FYh99DkOnXp5ZOxbXJhYWRf6Kt5TcbKiMnt8KtN0xPcFwqVhWorFOK1ZkGuUpVvLDl8CtcCzOCJ2ScITCo8tB13EOI8dzwR tla.TLAValue, t2u9 tla.TLAValue, dsXbyTPuEzKcBmNO3vUoDy8byFgyYwP6vmR8ScQVJvNNE7etXukR97tdfJm tla.TLAValue, xIqUs2QwtR7b7gzlFAiA0nPagsRcXRIrzd4XwPuSc4iY4gcFOBkk6HhYPbXpD82i6wZA0qPxSDujwkgD5UgOb79C8n327qYeZB3B tla.TLAValue, jrQMqgDsjm6qLHAjB5pC7RfvvjGzAKPtxxyypA tla.TLAValue, sjl7d9GZhoCh5nEdbcXF44yFcu9W2BJsCvdIUruGMwUpUfXl6IhhRcqUlNPlX4ZSMmhnM9puS tla.TLAValue, iFSImI2CjxTF tla.TLAValue, kDb0r3tV81qr6H4SmFFabkD8DHWDz912PeZxQ7YRh9FjBCwY6eXETGHk1Flvk8zD5JpOPfpDTIezK8Q7uUrfbbKuW4GDDiAI24DnKiJeX6hYL tla.TLAValue, dBtIva3EV4kBUnhWYYKtDcY68OOM3 tla.TLAValue, gU6JaM23335s9fdd71Iu23H6letgfaEm7RMjPRIJV0EjNOrROJCV6juQ1ZutwHbIHmuiU9JKTbyNlwGrxSgvwbIcctrzzvyj6MagRIpvB4Ue tla.TLAValue, pf1ouwjozkq46dVUev9IMmjvOCNeQSlMXvY tla.TLAValue, lEwUC4BNAyX tla.TLAValue, mu2pHEkSxMpJG4IFjyzfSg0FLj42MK5bnIGesapT8TI2cKhWicQcedPAjtR tla.TLAValue, c10 tla.TLAValue, d4J4XlhEfuUcKHbFjd0I2EiqomIciQBQ1oCZtXOsXYMexyBzM tla.TLAValue, mb6VcvGiNqAddoL1U27vHSbT15kiRVI0Ccg8bjGYk4N tla.TLAValue, v32tgD43e2RMYs3l17XRA0oqcqjgppgbGCXy6kpz2DX9B4SZJWFDSafw28keLFVVr8gz85aSukay tla.TLAValue, od8zAG tla.TLAValue, agI3fF6yeEgwn4mHyIS6BzL8ZmZYxIycJlZbOrdWs0PuDp6EIp3OEJ4AZeMwbAaWG8C478nr96ufy32O5B9ya3RNgsiRol8 tla.TLAValue, u5OX7jli7pUvdFlQ5GIWp8Y2V4w1Qq6JuSZNXFX58tCXaGAXNw5yAnpgjN7YI9OxgsMaqOal0gviOTsLnltWWVtP8 tla.TLAValue, mzoT46CbhESG2XuO97YqjAIOTKzWRFV13URZM9bhApen3u tla.TLAValue, euHzTurEOVp5ibMG93Z5n5QIz6Vvd3yxatMiXyCUL1TpOljdnVUascnrfOSIM1d1kkwCN7PsN2She47ixp tla.TLAValue, cy1SKKvhNyXaU0a7ucbPfvQvwiWIfyoRR9dniXhyujpGiHWwnxpzVNviq2UbpGMSS6qtMtcfp2Ydfred22sowNl9R tla.TLAValue, b7awbjj0YYXhTT9cfZAlagT4rkOR4UTbkJ9XcvpT tla.TLAValue, wFylCz1pj9BXgQIj0iRfz9hzLaZdnzFFwvDUjuuRJZt0dPDMtEUWaLUWP2S0cIPjJLSEm4JW5esqfCFrhOSnlVK tla.TLAValue, woGgx9rhmhU3Fv5V7YfItdR6Ya4Nn61TZXuHgxRKhWraD82DjSckWU9eh8uVG tla.TLAValue, van0P1WdGWs0Y4xmIgEo62SjbzMz3KqKj1gaz1Oz2DuZk4Vh0WfhQxUV8lFTdzweshJusd3Wq0pdzmopk5HZtj tla.TLAValue, fJYgdSPVe8ZGrsDsj5URkQ4Po tla.TLAValue, dN6swQflYr2n tla.TLAValue, q2ca764vfjW3dsAunTwWLjNJMOzvDa15EUsScuh0pgXRFYGB7n5KHqLPRYU6Uv5zW2kM9xyB8Uc7tT7Dz5QlGBGW4wE6UkiSZ6DUFQ0KkXgv76x tla.TLAValue, bphFSsnrvrUTp20JF0EW16OkghBZ4yipad2qK tla.TLAValue, cSC2XESOcKEYMXaT6Sz2BvHjMCxzJELA1Q9abTKT4LjQSclaxwgn9ZbdD8i3HYBCkwAmLbIcuzbmYEzJT tla.TLAValue, fiW9J4QVDbOOCcDiC tla.TLAValue, cV3KndmxEb2jiUtpTbOCzpd tla.TLAValue, yCLJWfpVGdOnNkUQOtJNxI tla.TLAValue) tla.TLAValue {
-			return cy1SKKvhNyXaU0a7ucbPfvQvwiWIfyoRR9dniXhyujpGiHWwnxpzVNviq2UbpGMSS6qtMtcfp2Ydfred22sowNl9R
-		}
-		_ = dMBYoW4IrtFxgIWrYPBUK7U82G2P3eAqe8GTlNeOFFlAJ6oEJT
-		pfutib02oMBk9NA := func(yqdAEKdWrmQatJbiBbNJGV4dVZvwou9CawoI5 tla.TLAValue, yPLRs5NZFyGM19iZaybEfKGN67EhwEQyCPbjWQZILFN65PjILdMxRcd0OltdhEVSjutjg8uBghJUNRlO0yI1Bb5Z9DPoT1d tla.TLAValue, npOQdK86ZvrzGID2IYr9pY0illf8rz4MjpchsjYnmGgRoVtWEnCFA0iMo4T3eHtNvMgUd1aAAinQIInp3ZISWlizOHEv tla.TLAValue, f0TtYSKuVdTGPYsQOEMteXQvyJhifPDPjTBlQj46U5H2jUt9wvVR1dp3aRTPqwG8CA0zLuaDxMtKjiD2dV800Geyu2gVkNBzZrmTgYXHPX1kqM tla.TLAValue, soLhGyWKqbO7txR4jRH4eAHZyygs9FY2pZ8wGb1MGhe7TrfMxiGbZvexCSQGBkx5HrrpzJYxPfJOnx1qCXj4FP9tYNu tla.TLAValue, yrgcu41SkT7K tla.TLAValue, vZ1UpiwtSAw tla.TLAValue, jQjIvW31GnROQeexcXJQ80yp6ZNlyjfCAXkIVdoUFz5m6hQTJ2YDopUO4ExrulFicvg1qiTTZ09zztaAatIuKEwoZx1Rhl2SAZXgbmbNFDQwc tla.TLAValue, aTnI591CPvPFMtGM0DSFosqwH19JgWkw6TOAnlLrnzVTvA6GXl6Z7YZslowQ4ggbwiOdmsrbQoA7y26EGj5UThwFCuYClOa1Qk9YBSUgCj tla.TLAValue, petq1FnADEuTu9jd7L0cRMEQEGA tla.TLAValue, sCsvwtZfuozhXImI2Qe5nOtioKFhV0toIhWugF1FTkDnOM628v6BcsqgbNgPgwTms tla.TLAValue, om8AZLqChj5QWTzzuE3Klnu54DYow7CaOgsksEWJbx6rIIn5QIYNfyv0Cg0yqI tla.TLAValue, sKddX6S4cSfFmEkAfQQ4pnGOsrWyTVp6ohov2ZjNh0m8ddQ3kkuT3V73L9J8pwjLbBaOTclW1sqbCaLOD94UqlQwqfqB1ybAPNidBHJLVtCXV7RtZunR tla.TLAValue, dTB55b5g2ndHTltMFu8sA6wQO3olGlC763PxW62YsF7SGSfQPrGZtsPsR3d8p9W0rfg0rNre7Vrz tla.TLAValue, k tla.TLAValue, pspwuKWUAQfUbXxngJ3Pp7oqrvCJGZdA6ZpbX95Gt4R31sDeMmOmkzXDF5Om6aeyBaIIU8Sd0nAgUQnf3970QQNJcAwx tla.TLAValue, zROWKKR57TTf4dEuXEAJiX6SQ9ErYYVNfKBpRYozrqAUueR tla.TLAValue, dlqWvq30vbvbYfBQdWCStgeHLJOk5YktXyAFKEZNlH3zJkk1XXnxG0RgieZPspBK3qDYIkYlGRNetdU tla.TLAValue, vEUiw8Q3wDBUhfkwu85oGaomRgGEeA8L66dibMQUAP1vLgR tla.TLAValue, bex3Qs4BmJHBRbUmBOpCNiyEbmD2Odz4XFWO7dHoJpLtrpyX5y06A9aj1if4pGtp3ypR tla.TLAValue, ov8Dx4HxQI4OGfCgF64Za0TLvm7uUySHtxTY74GOmmpGXQekidK tla.TLAValue, akX5jEznhGZAVgX6PHCPhJGk2nhgVTgZifC2RIOSbGepu0x0F8ObstfajuRj6wqK67xm67txh1iRpy4GI8pDU5heY7yhBxAofExnVVQjD tla.TLAValue, wXqzibnzdeZGlKOUZ0nB1vk2EtFdhBkkQmVxIu7m2eCZGV3UVICCsV8lvEDr9APBsdu4mfEw9VDdR tla.TLAValue) tla.TLAValue {
-			return dTB55b5g2ndHTltMFu8sA6wQO3olGlC763PxW62YsF7SGSfQPrGZtsPsR3d8p9W0rfg0rNre7Vrz
-		}
-		_ = pfutib02oMBk9NA
-		neUJpNvUgPe72LyeUCuIqvKpwvWW1RsckR7JbhK36dduupC2UXn9Dp4xa5ss4jbuOM6aN0ioEhvOnCNNczcf2892nd1lXr6zxe3kQaCDGgnLfiCb1A54f := func(dD5ZfhEn9lMy6hIQ63MgxLX9SLe9RPt tla.TLAValue, rpJZqPgaJka0TP8CucacnCDjYjWCP0rv2zonLwMNfdKtHZISwCaY1o6x tla.TLAValue, tV9nuf6o6CC9hCTmATorYSzU1AbJCuMlAfHT1TtwQkoQba tla.TLAValue, eMUJ35P0rwLqvjo3peYH3jph3OaRaojx4omEFt tla.TLAValue, p1TaO7BYWoE1lwn tla.TLAValue, lNU4xQWfgivqKFBroYAcgsUDRhut81WjH4ZXtuvBmD7xEQiX tla.TLAValue, b9y9qBsXFMo9tcgGgK52Max3oVCkIXf1Wi tla.TLAValue, xzlnQAIh2CxHeGGccHITKBdh9YGLfskDJYoruY6mniorWiSEdLYGe9kprlD7TvBvTN1wqC0dI1dV4oej07XJwNFUM tla.TLAValue, zQojcf8mAmjhlsYNQtRSHEQDGBXEJFemNFJi4MqP0vu1ODY5uUx8jNhHcgxSwGxJXELWxcsZ445R8l4U07gHrPYWMwy33rmKfLShWylo3iAqqDIps5y9Og tla.TLAValue, fulq67nBveb8AL3sI1xlgkvDS6UDy5HV91Y4t0DytPjc3QbppAtizrmflLJk6CN2l144OGC4k9l6A1zbqTVuKSJKYtoyCIFfmw4be6E tla.TLAValue, cFt8tyq89IFSpGebi2NJEKCmJyLKWNgjsy5rnlASUvkODy9ydSmXjxfXdJdPTJHqii0FccvBuNizbJpW0bdoOKr7QaRCHXxCu2yBsm1EZncCMroRI7d tla.TLAValue, nk tla.TLAValue, b0 tla.TLAValue, lgE0x0q5EJR5ZrQ tla.TLAValue, hk4QcmCfEaV7ynEE65eh8PBEFod3VdG3uc5edimMzdMTf1AAVYSv6cCLWTWqEfEzhixBsZTS0xAlcB3OsLEdMnbLDd2bd2agL4zuItrKeGYj tla.TLAValue, vWQ6gw57aLsFDgPWbcKGHbg83 tla.TLAValue, srpy4ICi5ffG54wava8hMsJmTP3kNX9KFGjYCO1hGWXP2HNRVqmR78ngBhn6i47E4v5Xe7rxhLcgj3S1sMCK7uktoCJNJX tla.TLAValue, eiaL4Y4ITF6mZcyoGdIJRgv5brQpLVigkwsbF7ZqIcX0xjHGN1B32KyslDg8FF12SNdc6P64W tla.TLAValue, yAKiq3OZKALyVMM7u8q172vb3R3cpnLSpOkdyyHW2rAQxwT8BQltZSEzjw4CaqNFQYXbgFkJeWyvdMYyHNNEXwH34ARqUD2GWkOzVDfr4eS6vL tla.TLAValue, g243CGvyoC3q8ne0IiEs8GZ2kTu6qiksuXKAjiP8aDhLWVWXFyJ tla.TLAValue, uviiueAso6qFDAKcAO8wS685lBm2vlFpKlQ7kWrSBdmjXsFN4UuYNkpHWdGuGyMTOpd8 tla.TLAValue, qAhiscJ9mgAzK44ja tla.TLAValue, hG5VnplX93p3NIizc4VLAAFMoQOtyDhjrPfYgjJ tla.TLAValue, gBMWmxaRYzda9R3DadTlBxtDqtc2oly8G8tmXDQ8feYccWakExMKXFx22vnSDX5zxIsNmIX1OzK1sR1kjiVRDA7zycVR9l3p4 tla.TLAValue, ldiKoihUaHjqHQEKVnAnq5q2C0Rd4U2sABLtL48KvTGG6NOISIfbxVputvRiRkHLnaNQHnH5pBiQ2Mr tla.TLAValue, vmc7p9sqDSLNNroyrQlIP tla.TLAValue, diFkx6gTeKJkXFhVWtZ0zWYA6CTm0iXSsoaOcOcg8UjK0Hw0RbkwufGYq9EhYig4iAvHQtRqRiCo7IgPFVOoFlxuHydgkAV tla.TLAValue, xsrJ0hs1Ke tla.TLAValue, aP9UfZ2zZEj9UUSK4oxi1LmbJ6PXE8gvU6q3GS04q5VelSnO4TAVpH3Dka8Vj9224XWyXX9dhJyJKVcAQF1 tla.TLAValue, mRAkfC3RBXzPnSa8ZujLPQPTW37kfUKnemy7IZuYpAKByr0gKio9SM8CNRaIvZOJ63ohlyBO6Dj3whzWEDV1DNoHksJwK4lUq1PwWPQEkSkH tla.TLAValue, v29hQOP4auFwWKQtcJmnxZRzGJwyhHnUS0a4vZ30gR1lPUeG0ZH1t0wfNAYNVN41y2qV5aSCQvY8vFi7S3TEsTCklGLhTGWNqHt0O4cYQYOX tla.TLAValue, p83nDSVkXu1cQDig5M5QVP4unsNheBIMSGNu tla.TLAValue, sAeZUPte26396OpJlBpmI0LQE21lOtx5s3t8nCC0YvHHB0CNqCxi0lJiwoEVaN tla.TLAValue, g4aq2R tla.TLAValue, ns25X4CXQFuglOEPP tla.TLAValue, zO6zdF15imNgx8fxuwZNG3p22LNMQWWjBfGMcDPKLQb9tCCs1DKDDbNjqAgipqmZJwqPve0NlsmvCQepmhtZroKqO tla.TLAValue, ccrlQKb9dTYwhvJmvl4uWJH2I70XwyocP1l3tkGTHX0Y28gm4UZgj7xJGFYs3XMD9AxWEUV66SVsHK tla.TLAValue, yrortUPsQPz3 tla.TLAValue, vrgY2fCsfzc0y tla.TLAValue, atADMjtPdHVP tla.TLAValue, opNmvwtrSNJ4RuT6uRHRSinguWgbzqkMsqQ5PW4k61HUy8toxpm3yo7OmM6FD5gRVIEUwGvz9wgCqgLy6ZPMmVyRP7XRUNP4D9CIFRtHe tla.TLAValue, gRV96fKPGEWe6yKFHxpuaPq tla.TLAValue, xDJiZCAbwMbVfbl7WcbMeruamFMGo6B2HMhB8N3KRrvic7qc49iGJb0bt5jCL062D1DLivTmY5TbCyP9Wb29pn7VOb8xdbKjXTY tla.TLAValue, dXXEkdWvl2 tla.TLAValue, gNOgqr8aJX4zP9l0UaPS6VagarrGqLU6i4JYxxjrzHs7mYmgJF2UuNcsLXJywdPURIQ2xCQnH9wy6Y9VSq1gC0J50pAn04v tla.TLAValue, pchYX3ZcpKwp tla.TLAValue, gHzB tla.TLAValue, wOFfsItrf6nLp7zy7bU7ADoa02aW3yFektlj8rpn8a4CRo9BFMXI5nnjixsR2S1 tla.TLAValue, qmpBaDd0wwAwM26ojdOhI7b2Ue5SUX7IuWhpDaEyxk4vAZTjdUv7jAipAEZd41SjSjvlL5TUt9DlST6ASlDN3ObplY2rp0F3 tla.TLAValue, vPA9 tla.TLAValue, dXNBv1xjF8ZdpX9C5ZY59h tla.TLAValue, hXQEKzRGitGm2rkfhPfIdX7NNi8fC9eUKHbhKQc0bCYivC0xaHYo4yW9Z2yoPdwib39HnBYNhBDRJTDRTaCCmHUEDffrdt0hDlxNOVQ tla.TLAValue, m0XmdKuUpvl1bxpzt3eNaLIlYDGcVkOcEU tla.TLAValue, zgiCcx0Ii2UjTDawJHXK8ztW9TxnEs4BqGMIL0KGRQaZG25eHlZnVVD tla.TLAValue, oGOiwYg4Ni0FnbwxphNIY tla.TLAValue, cwECL7xY8iScG2ySRm0ti8l2SM98hhZH6aJ3n0PQlTb6CrOU12M tla.TLAValue, lx8mkvXnvNTddobS7yISY81hUkWXF2snOu4LxOpUVQdxNdAZWzaTZidvQ4UBQRrrRjcg2 tla.TLAValue, ptUB2ALkx6BCK tla.TLAValue, cUmKmyddS6PXGo2GUNkzZTN7T8aUYA0TyjV6gg8oQ8km8N tla.TLAValue, rvcG9Wifo9QGqWkQA5WvYZTzYufmDdQBlhPBoANWGrgHdzdN2lO0phAeCeu8SZdsOzaR968R37lkJpZsbmUgtb4i7FPQtIFgLwfKlSeWx358mVm tla.TLAValue, rwovOOJgVwMF19p tla.TLAValue, a7S0yryJFshXqvdklI0kKojQNDdCjzZ8njL6OTEEJoDUELF3hXybX2H2j34fqApjlTLas7RndLmWeG6qHlGhOyzCgtsiZ0Ac47CQHla tla.TLAValue, zMYCpG8uLegaVSIotRhQZx9PUKAk9VScJbUaj3SIoQmgSdtj9V2ZCbZwo1VcBwjscjYOE tla.TLAValue, cTVZ9beGFz5WuA0xss0ZcVMok7s3r15KQjkTmwkdfiHoYBzsQm tla.TLAValue, vKR tla.TLAValue, hvGdY7ny33CZqb970HPMtRgqxyb38sWpNP3mqaFM3CQIF0MzGSbmSEHFblwNDXjlMjbmd6oojyTEkWwbG8ev426SvUvPZ9A tla.TLAValue, m3pIRkjqdB9DhTQmsqsPA9jjo9dtLe5mvTUlTIQQYTPzRraJUf3LhrMwlEr4tDz2kKvxYyzWUPJ8EjiiHKNKCXEwEZoxVeJf772cnNx3WWuCx tla.TLAValue, r51OBn836H4CGwaJbpKGSPoz2Po91zoZIvhLGK3o9k9cC7nDxL65U0g1z1ZH6SRsJ tla.TLAValue, sidyALRX9OvYQ64hbL3cEsPe0uJU54P tla.TLAValue, ga tla.TLAValue, pb7Hf9j3EU0fbPaQCJQBMELlAYwXQxoYkOWXxaA9r4NGEO6SWvJyMkzPaibJ7IdF2SoiamwTX23EVkG7LbBVAM4VhhrTULwtCWWSSLFVgKl tla.TLAValue, rdW5Fk2Xx9e69ZaE5r2a5LVVkO28ktahTi37iiNWVfM7OmTwDZXnoapZ2zZ23rbUFfBgi5Ci858X10XQUAsdKLQS9Rhb0D46jH tla.TLAValue, u0umaKghoGC1G6mnpX9aEDFLa9OyEPh1KfTJ2XtKcmX2gKfcN1yMKgAiPgrX5Y97NLxnkI50K7fh4yh tla.TLAValue, tTmkofWz52M2axQpt6G4OMLgBcYOQdI3KBdWdFrGq4Yda58ua tla.TLAValue, uphJqGX9ZtsAfGdpAd4mdG0SrI6BNfG39y4KCNb1pJugfMZaOEzfodJY tla.TLAValue, alki5J0u0ZkgrMwbrPRnhcNyFwEkMNzj1pZdybyIUA9DwZtyEtDMem5uM7NxfYZroUPGAxgjDAditFdK8Kcw6TBPwZ3dQQmsU tla.TLAValue, naXIPTVHPd9 tla.TLAValue, uyqvkdRlrbdXkbb1ABUeKJrZpArqCmDs3O3oPlX tla.TLAValue, jj8GIOzvZwvVFjltDA5TlBEtc0thYFrEsT6AYhuTR1DCfRjSYsVExVFXH3kludlk tla.TLAValue, ieZaTIVxTgxp4XntAsQOYQiSvSiSzvhtep7YT7aG3eKqH3MfzAo4WcjBx65PerpVd tla.TLAValue, whwF81Q8dhsvJdCQotqTLGbBiGuprfUBKRivefPWwIc1Xv8qA8HKIjqNcwarJ2ywKAXiPeFuF1fGcby1Ur tla.TLAValue, k0 tla.TLAValue, moKtttvRNzgiambEv0ElsCn6SOnGFRtwdcwAG29VbIQADaZAhM037grEV80IiS9NXggAW1g6VMQ0omx9XrAvJ7PcqBFkKi188lEes6kJq3cx1rdArLgM tla.TLAValue, jM0j1vnW9Ph4mrAgkNml08uYIFLEOMyaTss5EIhHKPvtaTTtrkI tla.TLAValue, gSSsoo0WfLIj4c4pSfB7SH7FnRPf5BbXG3Mc6P6keRNHhbSQgnV9k7fqiumr11djFHVEAudmOEZ2l8D8hwZVsgHpXKH8CmUp09rXlTnZegeJ2yJiGg tla.TLAValue, cEgq0WGJ4n9DXPcG1DQITQYPNFnmefNHJ0gdAnOehKZ2p6GyG6dQg tla.TLAValue, xGBke tla.TLAValue, fFZ3jGvpoJ6qs0TpDOjWxZ6mt4OEwL85kU5gEJqg5PFLEcgUZ tla.TLAValue, bwhE3 tla.TLAValue, vNawQMPnoqjbFKmoVzXFFj4MwASHGDlEsKnciZSThPfc6iBHAuT6Q2YrKatxfj tla.TLAValue, lJ3A33n8Mq8N1x8r2aN10aY5KsOvYQ3zHasBY7b8E7MeEBZdKNOrAKHFoEXfv8VsRcmUOjUq tla.TLAValue, g33CYBTGPqF92hNZUIGRp6UD5aECTMSEJd6FpHJQxOY7CtKloNalX6iyEznlLIfKye109jwqbAtkEYzgh4ocEtcY6NhlUUgdK7sVR8ywttsjkKYWnxXId tla.TLAValue, xgfnyJIytCnNTK tla.TLAValue, ocEx4eG5KiMIDuAKa3dejESCdVvgKPoXAmkyA5hbCRXMuX7rENyPIo tla.TLAValue, q6MTUPxEAeyXKMifVbWAq5yiwxg0WcPqJPO92LkmCdDHggelkCkd9dNLT9aFBaZ3YONXBk9EWDNlzIlMGTojmRcGiV6RYIwJ6NAGeVNFTgs4WBf3Wl tla.TLAValue, eYaJ0EQqIPYRLHBvqxhVnw5MCrO2yFLWx51zWw5Of9y tla.TLAValue, qC0lAOIYQE3zbfIiluDtQbX9kAzSxirqj9YNSH8C1JHkAErAIy1H4XSevvZAiDD8PELJG7VHi tla.TLAValue, eP5qEMgP2Xnk30GtLMzD5LEEp8xhqXaLKSqNIhCtj8mQINTnYfrdZIBiIiu tla.TLAValue, q2lslxSqzKtgsPRsGzKgsKKJtrcbcVRgCCy tla.TLAValue, pPVCCskU8t4Fbz6INc3sZRwlTvGTC6zWoY5tU2DcNbdF2Ici93tTq3JATIBcPCuHtvaBsdXUaJz tla.TLAValue, yUzmfhluvJPwrDp1VLRCY5ie1uvw9DGG7E0fYsp3r3cQOGc03HgWouEucwYBQ08HaXYsn tla.TLAValue, fqEDJDLNtlexAS4PhM6sKqSqS1YZOMhojD9DFqH8Us6zkaEBVKv3ohCj5AHpC54qu5Yzi0604JQRIY8 tla.TLAValue, iDA6ycv5MPxc3hgQ16SRcpMd8GrKYzB6WxeGF9k tla.TLAValue, y4DZIxYVUiiATh6NltzAHxY0VsH6M3MCkL3n3eon1lxXVFUqYDky0qGGaL1ksz5XBUXbD6d79dcxf2aVIOiZuQiC6Aru3KsXRLwaBH tla.TLAValue, qAPu tla.TLAValue, u0 tla.TLAValue, bOIsQ8d8GVM tla.TLAValue) tla.TLAValue {
-			return aP9UfZ2zZEj9UUSK4oxi1LmbJ6PXE8gvU6q3GS04q5VelSnO4TAVpH3Dka8Vj9224XWyXX9dhJyJKVcAQF1
-		}
-		_ = neUJpNvUgPe72LyeUCuIqvKpwvWW1RsckR7JbhK36dduupC2UXn9Dp4xa5ss4jbuOM6aN0ioEhvOnCNNczcf2892nd1lXr6zxe3kQaCDGgnLfiCb1A54f
-		ukBmRCtcxU4Z3Yksh0YjLv37MqG99dzPRYxTVv8OCeIwGKh9SwfPSVAXDxWm4T := func(nyb2Il2gW8dHLUWW4p0IPl7lRCwDCI tla.TLAValue, q71PuuOLcWFWM12bsnpvqgv4m7uQpM5SbNYxFzyUcheNfOrOdgOv7ZC8TSURQVM4CxsuHzya3uFEVJ65Rne6lO tla.TLAValue, jGueCxB tla.TLAValue, jDsrI935UaJyVhce tla.TLAValue, m8pHde1ec1gY tla.TLAValue, iotRSKGrRcs4opYC21UM9ERt4bwPjxjwD18a9TYB4EJsQtpFPuybb8xGLgWJDg5Z0eNeGMNC tla.TLAValue, rsBUK9zYElS2cQsqX2vNWgNm1qAloPP4RPtciMXDX45EhzTPMNp6RZhLlzFovaVdHdO0EViwTttInwqNRjPa1Q3CyeY0ZD5GVAGgHorv7n6AT6 tla.TLAValue, aCbVK4bc44RJSB12Mbba4KhlNUd2AoJSGb2vUKIp1S29aIRGhxh02jYga tla.TLAValue, dR0tnBY9KUovDwO19VKuTnmSIBpDGgC7u721Hv tla.TLAValue, x1lnZT49wU1tTxyMcVQYceJ5gmsIwMromJTw8YiWNjDZn1is8psWwz7nvSZrFz50dHVgwJXdAemzpgMZzAsmt tla.TLAValue, ehKs6sNuZhfo60rG2sg3R1QE5CVTcWG2N7K5WtJBizlRZLVtd tla.TLAValue, sR7JVAjAVdvlxD456IJ3NvuT0CmjliP tla.TLAValue, kdUl2CTj tla.TLAValue, y7TkhjqDzL6ubH tla.TLAValue, o6thVMlWBmtwYbrcrEiBB8WQfeKvMhyAeCv1P87Aya tla.TLAValue, u5J9mWoWXYLh5wmdR6YjKcUJtt9qCeQZDAl tla.TLAValue, jDJPvWrhjydEEYKuKlywSj3ULE10h2dDfhGpjTlGPj7ImhAEMVwXqE24JKmYSzZ7Mp2ftKU5KWoVhxRRGFDKETVR9k0Eavmp tla.TLAValue, natnlvtTyMOYXqw5u7fHGg0iELBvSL70dxn6PDm8j3SbDjWSkggBG4DFQvO0yCEXBBfAe3bzn0PIem1NIWvi1 tla.TLAValue, lRXB1iqx08OAZGcFwFyuApGbCzQM8qvAq4SC7nOST4awVvYYChJKet tla.TLAValue, zlm90l5Tu12iJlCg5vGwjGfieiFxrqp27XXNJsCaWlwnrl4wMWIrzdD4u8oJ6i5lXO9e tla.TLAValue) tla.TLAValue {
-			return lRXB1iqx08OAZGcFwFyuApGbCzQM8qvAq4SC7nOST4awVvYYChJKet
-		}
-		_ = ukBmRCtcxU4Z3Yksh0YjLv37MqG99dzPRYxTVv8OCeIwGKh9SwfPSVAXDxWm4T
-		bxqRuwNj67l1Tw0tG4FnJoCpbcwAITjSRFP1I7Gz9BYYEOtYCbQNVLKoEIx0CSFwIyMkChMguq0JB4zT4HXTZdyPn0hn6D4EmtYKzcNEuKBXWJ := func(uQKOCatlf16egTz9aQedQmOycWBubYLr0FK6oPRqXKV3dbBmO08OiqIS6fYMbg3da7N42C7TZsObO5CaU1CA9051loKrLv4lqizxYz7XN8s8 tla.TLAValue, aSBKtbpBn3rK2oORAyA6MoTROaa77w7IwzLtDcsgLax5OF1Og6b17CUWWGPW8Rg2wBSQDrrkqQqZbqgQbmnNB3icV1KjqtR6XXRZDe tla.TLAValue, cvWtM6M0aZ9GrzoKN4gllrlyIjtOUWUKBQiGXYT7DFyFzcRB0 tla.TLAValue, lHiIHLn7Dmi2N0yX06JfZlhPboAMDmDkX6nKXZSHwvuzdAwMzrRjjaaIGcNZoDr tla.TLAValue, q3GxRQPGNOiCkdTjH3XOLCIEHSCR4qK593q75ytYrCnibjD tla.TLAValue, aC6bZpF0eo7Nc40PnIq9BnapFYjDthyAe7T8V9XI7ePR3VIuihpwqEJuWO7Sga1Ar2EtAYmgzOekIDFnWGhAIDMCh66OYflzfGtwLkUFuvw tla.TLAValue, aIThXsUSsufSEXBuWRynAC1amycmWnjFNzk2YZoQdw tla.TLAValue, azy3TQcyTDxmalOQ8hQ1R tla.TLAValue, x2vzC1BV0ccqEb0JQH4JNj1lxdAJqAS5JqYM3n6MfsAgoxBuMeWfVivojVbSebpV2Isyle3x7bQSiIBLdehUUpT7WcRtrxwTbeBM tla.TLAValue, dycm4g tla.TLAValue, e6fp7MZDbcL40Dzxi19tsqc0NOFOMptZXKok3cNaw39jz1Y5olxY tla.TLAValue, fvpGUtlF4jbJlaEYIEf0KKErG9yMSULSamF3p6uX0ekan7FkYJ1GfGY38FnvaI1xCmJ8 tla.TLAValue, tJZWvhtv5t3w4YOudUqIqxcButdvxdk7QrSkfXrRqxVm0PDDbkDGjxXusW1zEUOz8vtC33GLKmLiaHqvodkyGwzNQqMDN9G7YlWvHFkqz tla.TLAValue, d9CiJ67ihdcsq1zGpto tla.TLAValue, l1 tla.TLAValue, kfrmqCShcCimYColT2QdFsygTpY1UvNUpV1F0OowzVmgYWEGVThFFpDzf2kmV2bkY7iGzh3wjpLozJIKcFM8I7jjzR6xT65S2N8DLzSvyNlfmE3fgRF04 tla.TLAValue, kiEBQpQU1jo6xyK8efcGcW0jAoRCr5r4wnEavbUsAdlxFvgolAab8QdiIp1Gn992bqEFPvfvm34BvkOQO6 tla.TLAValue, iKFAEtFY7hy4ljNTleW62hcjlpteNLqZpH5UB3uQ5AYqimTymiXDGyjxbjepAcESn8IHZ6bUyxrMKTlGHw8vtAMSzjCVEk7 tla.TLAValue, xgAmcY408twaQxSDXaB8ExSZhkDoIOUqrEDbuUPW6KriB9WX4oItCZGLuCw7zRBWKv6auaHR tla.TLAValue, hk4VkY4ZiUu63GVigOsSjHmYOyH7djLQNJH tla.TLAValue, o5IzQoYsUrfVa4i30vvpTktVYGMTG03IMKqD2O8tzsSPuBM3f tla.TLAValue, q08mHqx7dfTfgggiLK2aNOcXIE2Qu1aXdk0xNvjGzhAfytfEqhn7PI8dZbzgmKhCTuLHcZsTNglm1ZRGzkYO tla.TLAValue, aJ6DcsWlrdtzTbSTp2pOl1Hbhlato7S9I4f6veppCSYWAiGQuamaV2aG5nVfKUHl3wyOpJZqGTgP7wJJVtvg1a3N tla.TLAValue, qGugRgMtXAsyiug2 tla.TLAValue, jfMuGqGfx3uqKFk5SD2sEJ275K88pOCqxvsQhMMO891qzSPrX tla.TLAValue, jtY6HpnYNk6doqATtd tla.TLAValue, iN7xhv1MzuzYOiCT4Sh7VEShwfS6sa2FuSTedejWWSnBGSArKrArNYfPvncTWyAvaLlVSyF1QuOnxdjlfimPKqXVCidOfv tla.TLAValue, fsovO0g0buqgPfCT0iUyGA9qkdqp0xiHMAIG49G55vlE09nKWbLyZtvKsrDMVdanfzutwMxLqAmTYzcrqnEllcQqBl4BvccoSuTAO8 tla.TLAValue, yNtOMcEdkEmNHxHRNr4rjaNgiwfw1GDkwQD6XPeqiRyGlBjcQL7YYe4S5Zio834Tr5wv3WEroBdFzeiBT3q5qvcwkjZS80CYMh0GYdA1 tla.TLAValue, eUKe2HagRA189MJo06zexEUWOxxG42bmGx6udVAj5e9DmDbWOWIR99Gy9cksjYRqdTDipT87p7Xg1O0422N2qKqmcIGLqn tla.TLAValue, b8ddK4eqk8erPJfE3PuBCt6R1nR8z7NaX3af8RpBE8BmAREE4Dm5TkAjRAok0lqrmVEt31Z2eIU tla.TLAValue, jlw8fsBEtqRnuqaouK2iwD tla.TLAValue, u0HfGRjE5jId7Jhdx9DjpuZyYrWocemqVPN2AduRPIFnNOINUlVrveUoHJYa2BwoL9qWNMkvPO00sIMUJNP tla.TLAValue, zZrD2GEI3btaWmmFMhTWt6njd56i9O2AhKTWC8sBzgZYFJjbN8JG8qvCWGfOx8sHyBtJVWKg11uGBZxp tla.TLAValue, rJANcC7QzWMHjQUaXUF2mXXhnc16yDhM1fHMS9ySiXRK3R0AnaDWDuff tla.TLAValue, a4kMZiT0UviUzwO7ZTaBAipAVX3NmEFSECOZuvdMwni2OUgKP0tjPxcLFcMVpe3GxGsUwIRp04H9GPhywpx tla.TLAValue, d1ZxaiQ7KROXHTKbugNHaWJwzfqFqpnZvqF0uVRvL6qe4NDOlicOjmSIRG3aCAQNDVjqA8T7hcKt6L748YJjXpsT tla.TLAValue, dkh9dftAKQCsEu tla.TLAValue, uBHkN6sQPuvNQ2R4SxtqVtCWabcO6Cw9u9 tla.TLAValue, tSr2ZDgbgVIWcGa tla.TLAValue, sfH8ZI89OKbTq2 tla.TLAValue, r tla.TLAValue, dW1tf5x24MAFXIZwyZL14oxKVN28EhJkpIYSmr64ETHGdMn0GSx7udq2c tla.TLAValue, t1Vj1Y9RtOoB93Ckt6kan7wmWcLVaxgOOyiX5N6USZkFreiIb9ZabpDGYMtLJwTStnXx24GHIU15QqTnsAOaqSeOoV7Trv6sk2L tla.TLAValue, n2LQazbNy71xCM5yt9hndpmiEGboqRJ15GZ29b2B tla.TLAValue, ynKpefQii8aRVNTmUfBP9dP9B2QRq1pU5SyFa7Ypq7CfIV7ZrouSG2JWHiA0c7BAy tla.TLAValue, ocsxTpiMPrCwBoKLmnJnVTDAIygjTtQq3HK4ZLnetcZVv6Ego8thDXL6MGaWRUdlSzqMeTjId4DsWdnomE26RGdNObdgArTJTMu64ewiS tla.TLAValue, xHPS0KAxYP4ARqQi6DAEH58yXjI5cyycLKcsZyxy4Kj2IWjcTDW0cA tla.TLAValue, wxIfXMQKmfhuwZ1AkGH2joSj8Js4E tla.TLAValue, mXLeZojiw7j0DmWYcbEzGgKD3XxcD3bElIEzpvo2zc124j7eNKJm35WBANvQfL tla.TLAValue, aw4BXjBAl0R2ui tla.TLAValue, ou9g1IqqeQfEnYj tla.TLAValue, k4WP6HSX60Pe7oJUbyiD tla.TLAValue, hXBgjNmFO64gshs3 tla.TLAValue, dBesg6m9CJunJHw5V9DVeTONVdkh6zQ4qDLYyMIV94vYM85zh4ykeswLKbyj0YSa tla.TLAValue, raR1ezEVYkGNdZ8dfrwfWa9s6WF tla.TLAValue, erPhH0uA9ar74awWPit8BRDHHVvWm9PAN5YQ2r tla.TLAValue, zXgx1ZIcnmfAt8eeR6AoYrUZxMN3PDKsBFjHRPMCUM4kSQHAPzNFMwHA7kulY5mmd1IqmmZrbtgqJ1go9 tla.TLAValue, wX4DbY5Cjz74DH2EBMTqEEmAgPmORwLzyzvrgzK61cM1FkFWNOuXRRjxGvySbh47uekGKC51CZH0K6PnSYnf tla.TLAValue, pHQbaz8L2o4vtwfkHITGp3hLmBsEzUfnnREIVVb7w0QPiLtnO5wQOvOCUsnefuaS92hqEfEovByLvfZSALyW53iZ0B tla.TLAValue, vN0oAukCuH1tidxYDNv3tpho9bGH3dSN73iLyPT4LBhKLcweYencnOhr0IVE2WqOLpNqmSXM9e9EmuyntNAvXX1zAXw8 tla.TLAValue, zwwrnscjMRSo4ybtRdBfaCWo5p tla.TLAValue, u9NLz8UiJfrpRokx6Klej3lJwzxIXELFLovaChsZCbaF7sRLeAjVOdqXk6wPOqM0b2hsfLzYigVIw2IeHxumWrhdxz30Db7itIInwdLJENUAR4 tla.TLAValue, onQcOt48AFx2g3vcKr7JeP16DTWGKYuPbOPe0fPztj1pyHS5P4VKSrUkX9DuGqNISiGnqoDfMWtPqJ695WBCVZuqsy87ZWLKreaaiJJlL2mQEvTKGcq tla.TLAValue, nzSv46djtF8jRAb0x1tkPL0pJAgZeGMg8mjp0HfO79wuKKHL17c4Qouz528vcFr7U7U7qSK2EHn41dubI tla.TLAValue, haqvardC6HFV9aBiVpOkhAZOCsnl0AdN7O5jSmdwo4FOHk8GRwsYhBL8Vojo2YZubGmtsEVBuL8OgqDX7go36puITdRih4 tla.TLAValue, tdDITA9zAIMITHRr8Tu7k1MKQvapl1SjqGBfkNpAq7C4WPXgALuD22gJlCQEkDwFLZDM3B tla.TLAValue, gtcDnKpcoxXxmgSEaDbvuU0S3JFm tla.TLAValue) tla.TLAValue {
-			return xgAmcY408twaQxSDXaB8ExSZhkDoIOUqrEDbuUPW6KriB9WX4oItCZGLuCw7zRBWKv6auaHR
-		}
-		_ = bxqRuwNj67l1Tw0tG4FnJoCpbcwAITjSRFP1I7Gz9BYYEOtYCbQNVLKoEIx0CSFwIyMkChMguq0JB4zT4HXTZdyPn0hn6D4EmtYKzcNEuKBXWJ
-		oGNcam7no50VFS9bNVSLFrVV9tFOTIhTwd9VlrW1xT := func(am8bWyy6KnGD7pbjuRLHR01nW tla.TLAValue, i0s8KBm44MBD7jP973D5Eu4zNKCiXaPohAQsXTb4CMmE4z7uccxlcgtrtLf6tVytqIhsXxkf tla.TLAValue, kwC5NvLfjhrgra7nBauJjJdcPCGZMVFBHk9of51zPNdpV0LNTaraqDEhs9qKYY8Gngi5uy9gknn tla.TLAValue, ehW25vry3ut0fMujKtUZrmrmPZ7SGzViNd7FExfqWnXySATsZ0eFyWdwwbSx5a8XFouh1UrVdF3otRcwQsbBWkjICbhdFUD0zy6VKEfDFJ4qJ8rlBpk tla.TLAValue, vcYhmXYbqt6NOh tla.TLAValue, fjjQWlZST5w8UQJSGYmMExwrE4KiHMs2vva86I7hMGFIXjZMt6Pr6inLcbQaI2fDvv3wzAj tla.TLAValue, iGP7JEUvz0e5rYJkVm4vSagOFQ98H9ywKRqYsAca8p4yX5XVkKKphJUGP4n tla.TLAValue, cStGYyv8V tla.TLAValue, l1CWGSnDAFA9JP69nGrlo2f5ohVH7g6O4yTqWxznzWqFPNBGhTDvk1nDQDU398teHkao4sK2n38qz7zl tla.TLAValue, aiV1iGsofLK7qe1lQxjSZe55J51Q0s4z tla.TLAValue, mb2f4sCh9wj7JdY2V3cEmrIv99amnXuMutObxCvZ5aqlj72 tla.TLAValue, p05MeTxF9stYUJ2JXqTIioRDLc4 tla.TLAValue, cZHjp2fGGJxnXXb2t9XjqSQprA5WQtkCwSETFbkGseDOjuuZRbJo6VRiLEn tla.TLAValue, zyaZUPLlnqpNKeTj3tokZX4dDrnkdjnPvHdgCamm8YLu0WH8IWxsYZnE7tsnbXfHudC7VRYjNvOinB9oyHyaIRlCVKAF2M7dkDCbYxQKSKTU1q9SGZ tla.TLAValue, uJh6jOSh5uGYgEEzpgdPGVh4TXRlrRvsDzgqdchE5JJdHJTfqOLbxIlSoJf tla.TLAValue, vXSvT2saRKtTIpFQ9Lb15wveUQM82wTqNV7k2ilR3nd0leLNv8KIm3nmq51aDmkfPmTHRBaNAbZLxFouVAd4ywUHeHEA3tA8mYYCCDpcJNhzIsPo tla.TLAValue, koSPqSVrdMCYlCkJixR4xlBZmf47UdD0qwxD36fauTxnkYeonmYd3VgUmL695pjXrOsE5puEJtJn tla.TLAValue, toHkXVSgsIWfecbbl4rfUKKsNhId3D tla.TLAValue, vLzSLfHX7fGw3l tla.TLAValue) tla.TLAValue {
-			return l1CWGSnDAFA9JP69nGrlo2f5ohVH7g6O4yTqWxznzWqFPNBGhTDvk1nDQDU398teHkao4sK2n38qz7zl
-		}
-		_ = oGNcam7no50VFS9bNVSLFrVV9tFOTIhTwd9VlrW1xT
-		return tla.MakeTLATuple()
-	}(), tla.MakeTLAFunction([]tla.TLAValue{tla.TLA_Seq(tla.MakeTLASet()), tla.MakeTLAString(";nT!E/a\"~A'^[uvN51;+TePI5&t$^^Daj.]7eDe:_41h;{8(jzvkM`TLwt8")}, func(args7 []tla.TLAValue) tla.TLAValue {
-		var bV2U08WxrbJW4YyisaWLdDTlnnQZN48YPIYI1 tla.TLAValue = args7[0]
-		_ = bV2U08WxrbJW4YyisaWLdDTlnnQZN48YPIYI1
-		var mC1XOAZ2fxiYvCGmEOU6F1qyEyTa2vFsCsEcdP tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(1))
-		_ = mC1XOAZ2fxiYvCGmEOU6F1qyEyTa2vFsCsEcdP
-		var f80LwKCPunKotdxCDYdLXKuLjszpV2LDDtq6fNxN2tS9uLEPjY tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(2))
-		_ = f80LwKCPunKotdxCDYdLXKuLjszpV2LDDtq6fNxN2tS9uLEPjY
-		var fEjxZzM3uZfPeYizw20dmH5PPB04fnDZb0oQHHwQh1B23qR6ZTR6FukrkWqP9N4M6 tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(3))
-		_ = fEjxZzM3uZfPeYizw20dmH5PPB04fnDZb0oQHHwQh1B23qR6ZTR6FukrkWqP9N4M6
-		var nMlWCkBrWQaJh3XG0G1Jv0g83HFtBkYC1PrCdKtFSNAFPxhrosXkrilQ2WnzXUHPORkeg4XrOWnu8QKr7CIKRAJremILMIJH9rwBmadehVJFIDOKOUyW tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(4))
-		_ = nMlWCkBrWQaJh3XG0G1Jv0g83HFtBkYC1PrCdKtFSNAFPxhrosXkrilQ2WnzXUHPORkeg4XrOWnu8QKr7CIKRAJremILMIJH9rwBmadehVJFIDOKOUyW
-		var c1ALluekNXYDYfxjMO3JUJQsQ3HLsDofZkQ9xq tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(5))
-		_ = c1ALluekNXYDYfxjMO3JUJQsQ3HLsDofZkQ9xq
-		var fetKOoH8deASth6qqVbT3gztGvFl6W9QE1b0SCSnsQ1O4dCfGRv8IXLoSYfyD21sU6dgCGGUPMGrR9ohsMuTTmpgllkICLkNwQN7 tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(6))
-		_ = fetKOoH8deASth6qqVbT3gztGvFl6W9QE1b0SCSnsQ1O4dCfGRv8IXLoSYfyD21sU6dgCGGUPMGrR9ohsMuTTmpgllkICLkNwQN7
-		var uoHaLhgnG3FYlPpkUDvKIgeCVm5k6QXddIiIFBhWhutUKd5EvynwY8LYqcLeage7udmiMFkxX2ADYueb4lSBki3a8fVAmM8JNirDcrzlGkp3GGGV519qG tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(7))
-		_ = uoHaLhgnG3FYlPpkUDvKIgeCVm5k6QXddIiIFBhWhutUKd5EvynwY8LYqcLeage7udmiMFkxX2ADYueb4lSBki3a8fVAmM8JNirDcrzlGkp3GGGV519qG
-		var kInHvY3c tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(8))
-		_ = kInHvY3c
-		var i7yjwoHNZDTEpJ3bxv3LuKT7YiWHxRPLcd9jSw535YbQLCvR5scxUxIVmtgeVXxA0IXwMfYF tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(9))
-		_ = i7yjwoHNZDTEpJ3bxv3LuKT7YiWHxRPLcd9jSw535YbQLCvR5scxUxIVmtgeVXxA0IXwMfYF
-		var vtmO38WAV1lS5a1relzaRaJ9gjBs79Tk7VKvQPVur0At1ugDjNk7IAj5hoYVbQpFchd tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(10))
-		_ = vtmO38WAV1lS5a1relzaRaJ9gjBs79Tk7VKvQPVur0At1ugDjNk7IAj5hoYVbQpFchd
-		var yQ5FOTP9AKXWbU0CsGNh8ErHtwc87RtguU4O tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(11))
-		_ = yQ5FOTP9AKXWbU0CsGNh8ErHtwc87RtguU4O
-		var tZH2fPnkiORKOdPGNpv3g6JPn89cFGo89Pn230iC5APeDPa6drc84Xcsgnu6d2AWyvLFB7qQ2Cnc9mX7p1eWzfmYRfRmltJPR0CBjlF6kXLxsJu8l tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(12))
-		_ = tZH2fPnkiORKOdPGNpv3g6JPn89cFGo89Pn230iC5APeDPa6drc84Xcsgnu6d2AWyvLFB7qQ2Cnc9mX7p1eWzfmYRfRmltJPR0CBjlF6kXLxsJu8l
-		var pfGhBg4NpTWBTQ7oas6xt00hwsM4V6vdZdVKUXSgQ6yDUTv0j5mxzXy tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(13))
-		_ = pfGhBg4NpTWBTQ7oas6xt00hwsM4V6vdZdVKUXSgQ6yDUTv0j5mxzXy
-		var f10r3ZLaRylKTlCR5Ky4O0CBkZek5VlXD6QmoggJrPT9FkQIQoxumjaFtk5qgV tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(14))
-		_ = f10r3ZLaRylKTlCR5Ky4O0CBkZek5VlXD6QmoggJrPT9FkQIQoxumjaFtk5qgV
-		var eQixxodNKGtuy6dAXx98mSGhsqUmm7J2HDYM0 tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(15))
-		_ = eQixxodNKGtuy6dAXx98mSGhsqUmm7J2HDYM0
-		var dU4wU8dYG3ZJaenKuEDXtfr0yU5E2zHv9DJdQXhwf1eFYJYeOx1bdtV1Q78NfyKQVCfSQmPA3PytUt6U2aNwbeLXYc8Jky tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(16))
-		_ = dU4wU8dYG3ZJaenKuEDXtfr0yU5E2zHv9DJdQXhwf1eFYJYeOx1bdtV1Q78NfyKQVCfSQmPA3PytUt6U2aNwbeLXYc8Jky
-		var kqgz4sCHkaoQTZyPYYiNyUkUwekRjuKRqP8VfRaai6B4ckuRIB1ETByAn7YKmrlFlyPkUhfHOYqtiqRT0WrRnCYBKYjtUfJxr7DhzVqXtuq3DPfWgM9lyG tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(17))
-		_ = kqgz4sCHkaoQTZyPYYiNyUkUwekRjuKRqP8VfRaai6B4ckuRIB1ETByAn7YKmrlFlyPkUhfHOYqtiqRT0WrRnCYBKYjtUfJxr7DhzVqXtuq3DPfWgM9lyG
-		var wnhdRCUKyiPBNyJW1Ez3hrKnIBZqJVZTMAbpOixvQ5RP0oIudvkAgB6Egkb8IVLFO0Sv3SREHolTRYzut0ihOJnKq26cS3wtVuLoMnvHA tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(18))
-		_ = wnhdRCUKyiPBNyJW1Ez3hrKnIBZqJVZTMAbpOixvQ5RP0oIudvkAgB6Egkb8IVLFO0Sv3SREHolTRYzut0ihOJnKq26cS3wtVuLoMnvHA
-		var vKCBeTLFMor2MFAiO6kax65w6C16aemUdbeG1B7U4kqDTTuLDmyptjklcZUOEieKnUtqQiqDlOsYqIxmeD0VKjuk50wPxsdD tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(19))
-		_ = vKCBeTLFMor2MFAiO6kax65w6C16aemUdbeG1B7U4kqDTTuLDmyptjklcZUOEieKnUtqQiqDlOsYqIxmeD0VKjuk50wPxsdD
-		var tnD4 tla.TLAValue = args7[1].ApplyFunction(tla.MakeTLANumber(20))
-		_ = tnD4
-		return tla.TLA_Head(tla.MakeTLASet())
-	}), tla.TLASetComprehension([]tla.TLAValue{tla.MakeTLAString("Or4uYZ'{+a*`I/tHTU'@Fw=}G/B/d%h):%R.FE[0}o-\\ -8'jRgIskN&SKdu\\,\")9%r;op>bex)&O5eqhS/xtaJG,nV#QWjd-c_f:)d"), tla.TLA_Zero, tla.MakeTLANumber(55), tla.MakeTLAString(">&cXJ)Blo@-}ot<1`,WaQ4UI%BWVB)psW,;X"), tla.MakeTLATuple(), tla.MakeTLANumber(68)}, func(args8 []tla.TLAValue) tla.TLAValue {
-		var kO0o8ujUrtEZSKXpafhXQv9EHVxF45b4MkRvqWCB76Ex6z1DdtwByAbWNZyQ6UfhvyVKTxjOmES5Jlx2nCIGaQB7KsdUw00Ozvmm7CtuhSt4GEGL1Hvf tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(1))
-		_ = kO0o8ujUrtEZSKXpafhXQv9EHVxF45b4MkRvqWCB76Ex6z1DdtwByAbWNZyQ6UfhvyVKTxjOmES5Jlx2nCIGaQB7KsdUw00Ozvmm7CtuhSt4GEGL1Hvf
-		var ldBDvTLTWC5zA7k tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(2))
-		_ = ldBDvTLTWC5zA7k
-		var pIjp2lddQViuziUJ5PzpXdPBwEdaMP3AszU3BjFhemaQDBE8gbMv7jwixPTpChTqWqpLiQkwJIpjVWg0uYQco8dGQ66ukUDtw2usxlCN tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(3))
-		_ = pIjp2lddQViuziUJ5PzpXdPBwEdaMP3AszU3BjFhemaQDBE8gbMv7jwixPTpChTqWqpLiQkwJIpjVWg0uYQco8dGQ66ukUDtw2usxlCN
-		var b3UtW0rVZtZyhgTLrtyDEsKDHKBXcplhnMNq3Nb1rDEzEvD14GQ4xrCpHbtMYYxQ1Ls5FLheAaQQA4SUmAULszAeG03r4PK6CQjDJCg3DFtNo8od2pNJ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(4))
-		_ = b3UtW0rVZtZyhgTLrtyDEsKDHKBXcplhnMNq3Nb1rDEzEvD14GQ4xrCpHbtMYYxQ1Ls5FLheAaQQA4SUmAULszAeG03r4PK6CQjDJCg3DFtNo8od2pNJ
-		var vMEv8m7FSuBnidiaNz8mtxx8tdtU8b4F202sYMtG7 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(5))
-		_ = vMEv8m7FSuBnidiaNz8mtxx8tdtU8b4F202sYMtG7
-		var iQZO0T24G3qnDyj6vVBLHvMjgcaZOitVbHtyt8AL22DS1mkpWey2XwbBX0m4gXJAFsgTGNKYMaSYvPCyoqjXWZarUEJGvR642x2FUMJ7Fhw7FZE tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(6))
-		_ = iQZO0T24G3qnDyj6vVBLHvMjgcaZOitVbHtyt8AL22DS1mkpWey2XwbBX0m4gXJAFsgTGNKYMaSYvPCyoqjXWZarUEJGvR642x2FUMJ7Fhw7FZE
-		var yjoZf3KwI7SqmHeLeKhvHU2gxANVgfse8azWiGYPxfpsacwcrxnmKoofmI4CPGNsUieyRGYAl40afhPAIU0RnOMHMJGEU5I57NclzPbplZgtzUQMENaJM tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(7))
-		_ = yjoZf3KwI7SqmHeLeKhvHU2gxANVgfse8azWiGYPxfpsacwcrxnmKoofmI4CPGNsUieyRGYAl40afhPAIU0RnOMHMJGEU5I57NclzPbplZgtzUQMENaJM
-		var eNvWBf98yGDwLOTxgh0hmlAS8J133kVrL3yTs3jQRUSBgiEVgFQTBe1CpaoJcFQrUtfWssk21W8DheW tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(8))
-		_ = eNvWBf98yGDwLOTxgh0hmlAS8J133kVrL3yTs3jQRUSBgiEVgFQTBe1CpaoJcFQrUtfWssk21W8DheW
-		var iksItfTdWlf91wMH1QVzekiPxgtyt6iMonMoOdWHikwWzrfV44cfCCkkhKGDNWrBG7Evu1EXKXzZ8him06EYYXs34IrJhQBWRav62X tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(9))
-		_ = iksItfTdWlf91wMH1QVzekiPxgtyt6iMonMoOdWHikwWzrfV44cfCCkkhKGDNWrBG7Evu1EXKXzZ8him06EYYXs34IrJhQBWRav62X
-		var tAnobkLMVODeyXE3dJMbTOex55Rh9KfBmbCPIDIWqOYlIXBGwW3ca3CcdndqOXK6sY1spZMtel0Gbb8u3rlTnvMkXYTxpFaRWoSgRtb8QUxf tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(10))
-		_ = tAnobkLMVODeyXE3dJMbTOex55Rh9KfBmbCPIDIWqOYlIXBGwW3ca3CcdndqOXK6sY1spZMtel0Gbb8u3rlTnvMkXYTxpFaRWoSgRtb8QUxf
-		var jOEN2cA1B9hSAANKYwLKzvrXDS3cKD8RG8U7NqXf0tx6RmGrEw tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(11))
-		_ = jOEN2cA1B9hSAANKYwLKzvrXDS3cKD8RG8U7NqXf0tx6RmGrEw
-		var pRguamU3idbV23h2ampbT0nXDhmJsIb0aAKe tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(12))
-		_ = pRguamU3idbV23h2ampbT0nXDhmJsIb0aAKe
-		var uxBIVI2d1IQe4i2tn1vBKtj1KH5nJ21S8EvSaDMTuc69EAf66jFR9 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(13))
-		_ = uxBIVI2d1IQe4i2tn1vBKtj1KH5nJ21S8EvSaDMTuc69EAf66jFR9
-		var jQHDNDhlLsdZ6J49JzRNaf0SEGWYTT5E tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(14))
-		_ = jQHDNDhlLsdZ6J49JzRNaf0SEGWYTT5E
-		var k1 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(15))
-		_ = k1
-		var elsvkEBOPBXA4p1xJhWv8mancOIbcsb6KDz23GUGjYXc0PAfRmRrwrDtvL0N8nfvOsPpkpVcbhLxUTm0MtNzxM87VN tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(16))
-		_ = elsvkEBOPBXA4p1xJhWv8mancOIbcsb6KDz23GUGjYXc0PAfRmRrwrDtvL0N8nfvOsPpkpVcbhLxUTm0MtNzxM87VN
-		var v4Q4ZQRr9aVm04y0FsPupkMfhtT24dxkdpm2adALoTcNijFUX8nVYifww4oNk2N6HWmQvM1DQ6Q8w8YdlPVAu7ZHZggGbgeZiER6KWeo tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(17))
-		_ = v4Q4ZQRr9aVm04y0FsPupkMfhtT24dxkdpm2adALoTcNijFUX8nVYifww4oNk2N6HWmQvM1DQ6Q8w8YdlPVAu7ZHZggGbgeZiER6KWeo
-		var btDGdQbYfLXDkiFZWKaWJrq2srlGJyXOycVmwj8HSe5sCDaiKoyl2GsCCP0z tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(18))
-		_ = btDGdQbYfLXDkiFZWKaWJrq2srlGJyXOycVmwj8HSe5sCDaiKoyl2GsCCP0z
-		var bdff tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(19))
-		_ = bdff
-		var gr1BdNF8dvif2l04cmJpyn0JuhNixTANCDazjty8OlIIV6vnMiGaNaJVDzOOK2R8 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(20))
-		_ = gr1BdNF8dvif2l04cmJpyn0JuhNixTANCDazjty8OlIIV6vnMiGaNaJVDzOOK2R8
-		var oocQSJVdSzSSWgyLvYdc8A8zXnByxCQoGPGTgVZ9XfJ208eAeVRtBHRGZZsmi54FFnbIZjDgQT1ZVoC0WeqP103CHQCJc61uTMix6zomMp8U3imFU tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(21))
-		_ = oocQSJVdSzSSWgyLvYdc8A8zXnByxCQoGPGTgVZ9XfJ208eAeVRtBHRGZZsmi54FFnbIZjDgQT1ZVoC0WeqP103CHQCJc61uTMix6zomMp8U3imFU
-		var c2 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(22))
-		_ = c2
-		var qrr tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(23))
-		_ = qrr
-		var m7PmBz36K8FT7NEoRfbVJafSPHcGUUTEugg2KG5cShS0PKCfSV3TeELEM6HXSPw26OFWBUxcruG6bBXGB0DVHJ8mxhSHjmfwjqlm5OCrHZeHowM tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(24))
-		_ = m7PmBz36K8FT7NEoRfbVJafSPHcGUUTEugg2KG5cShS0PKCfSV3TeELEM6HXSPw26OFWBUxcruG6bBXGB0DVHJ8mxhSHjmfwjqlm5OCrHZeHowM
-		var kuxw1iiItainvT7Q73 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(25))
-		_ = kuxw1iiItainvT7Q73
-		var zP4rMlAi020Bus8HM9BUQtojksqW3RB9KbvZDeYyZfV8m1Loxk4vHoO8heE10i3J6p5dvwHVkieGsRobkIZFAgP60APg2qlt tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(26))
-		_ = zP4rMlAi020Bus8HM9BUQtojksqW3RB9KbvZDeYyZfV8m1Loxk4vHoO8heE10i3J6p5dvwHVkieGsRobkIZFAgP60APg2qlt
-		var zI1VBWlKdoAGmVgFskli6szD28WnwtXeokxMYN4dzS0HpVolIzAi3nahgzxc4fAiE3XlJHoAL6BlxVtAg3jJxUTs028QDByWr tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(27))
-		_ = zI1VBWlKdoAGmVgFskli6szD28WnwtXeokxMYN4dzS0HpVolIzAi3nahgzxc4fAiE3XlJHoAL6BlxVtAg3jJxUTs028QDByWr
-		var rt0AnnGwvsNCWKPxj83FjDQ6CDywAE1wyhckI3zMq tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(28))
-		_ = rt0AnnGwvsNCWKPxj83FjDQ6CDywAE1wyhckI3zMq
-		var i15aBySROLrwkWWU2MBe12EPcBazmfwa7z0lC6GoTcV2nWp0gjshpwxa0 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(29))
-		_ = i15aBySROLrwkWWU2MBe12EPcBazmfwa7z0lC6GoTcV2nWp0gjshpwxa0
-		var gTJBp8b6o2 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(30))
-		_ = gTJBp8b6o2
-		var ouDjMggVqFIWA2mvO7XDYWpSgjfmm6egFAyIhBciUKWA7k5NzBHA08G44Q2ebH2MdisKeQF3rLX9sT4 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(31))
-		_ = ouDjMggVqFIWA2mvO7XDYWpSgjfmm6egFAyIhBciUKWA7k5NzBHA08G44Q2ebH2MdisKeQF3rLX9sT4
-		var fCXNrvL7LQsetTuC8NDnP7AvN9zUT2meIa6oAFmMKymeRuXz1z tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(32))
-		_ = fCXNrvL7LQsetTuC8NDnP7AvN9zUT2meIa6oAFmMKymeRuXz1z
-		var cB75zWu4bFfZ7bReB2tz6nChMeLrnshf8iSo tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(33))
-		_ = cB75zWu4bFfZ7bReB2tz6nChMeLrnshf8iSo
-		var mJBsPwrfnDYQEtcf6aIJ75CLMgUUAWQ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(34))
-		_ = mJBsPwrfnDYQEtcf6aIJ75CLMgUUAWQ
-		var tThaic86zb50xcaa9En6nMcgKmsQOVAlGlg6NODp9aD3HK7LgYtusnmagu8LeD8Gdtjk5X2liu1YhZj63oUZgXvWao tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(35))
-		_ = tThaic86zb50xcaa9En6nMcgKmsQOVAlGlg6NODp9aD3HK7LgYtusnmagu8LeD8Gdtjk5X2liu1YhZj63oUZgXvWao
-		var vxQgtx9LyM78cX4eJ3 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(36))
-		_ = vxQgtx9LyM78cX4eJ3
-		var uUgriaeOjiNpG tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(37))
-		_ = uUgriaeOjiNpG
-		var dIJkiFIHC tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(38))
-		_ = dIJkiFIHC
-		var hUUqU1AJ5jhhGNR tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(39))
-		_ = hUUqU1AJ5jhhGNR
-		var mWDgHVMnBakuYYQpYbmsPhvA78t9CbU9L6mcbTVbPAmSaS tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(40))
-		_ = mWDgHVMnBakuYYQpYbmsPhvA78t9CbU9L6mcbTVbPAmSaS
-		var ckDkPibv4YdeL3NlpUQAmKVhrZJ9s0jgha3Gzk8rge1zGSJdoSE5RYrUxELp9qWkZAskRba7F90zdC tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(41))
-		_ = ckDkPibv4YdeL3NlpUQAmKVhrZJ9s0jgha3Gzk8rge1zGSJdoSE5RYrUxELp9qWkZAskRba7F90zdC
-		var vXNPVUvU0frMbkTYztO2jUV tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(42))
-		_ = vXNPVUvU0frMbkTYztO2jUV
-		var gna2KuPcjDqObxBhR2WLN9TIc0H2vkV8zdLGHOkP98CevOd8RUIzS59NNGs11VrIhfOBND3EWryGW6cJmamT3AsZtI6SXiyPICIMPZEgh8iT3ILToFc2R tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(43))
-		_ = gna2KuPcjDqObxBhR2WLN9TIc0H2vkV8zdLGHOkP98CevOd8RUIzS59NNGs11VrIhfOBND3EWryGW6cJmamT3AsZtI6SXiyPICIMPZEgh8iT3ILToFc2R
-		var unimZH tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(44))
-		_ = unimZH
-		var cCp4BttUPhWK7Y4eFkMJkHi7ebwI0dVqHB0aEZ6EwFeBFsRgCRrlG8rVOBRLfN392YMDxVtoav tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(45))
-		_ = cCp4BttUPhWK7Y4eFkMJkHi7ebwI0dVqHB0aEZ6EwFeBFsRgCRrlG8rVOBRLfN392YMDxVtoav
-		var nzhSngOM1p0V7P47VNCDGCeAwrp tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(46))
-		_ = nzhSngOM1p0V7P47VNCDGCeAwrp
-		var jul4HViEqCSVrXKX9IixqvO85FGc0peEExAYlte1Ak61bXri0i17VO555DOkZFdYSBncchZ8aaHW tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(47))
-		_ = jul4HViEqCSVrXKX9IixqvO85FGc0peEExAYlte1Ak61bXri0i17VO555DOkZFdYSBncchZ8aaHW
-		var fdzTFEcXfD0QPG5oHRsxZcbMEcZwzmypXVZmXXlTtNnzolk3zrorAQjLAuXN6lK1siSP6LJ5tbKGTnKH8LZfyNVns8m26B9OivjaOXlqxBrtUcZMnx5XD tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(48))
-		_ = fdzTFEcXfD0QPG5oHRsxZcbMEcZwzmypXVZmXXlTtNnzolk3zrorAQjLAuXN6lK1siSP6LJ5tbKGTnKH8LZfyNVns8m26B9OivjaOXlqxBrtUcZMnx5XD
-		var ypfYTnFuWzTzgSoEOSURZGzFGjn2mcMVDH tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(49))
-		_ = ypfYTnFuWzTzgSoEOSURZGzFGjn2mcMVDH
-		var vfuiSoDYtUOHweqrpS3nlpZSq5xP1bqaxgqyzw8uDFafP6hiv7RrhtPaltrGQSiBgSpHYCCel91N0Vbqdny tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(50))
-		_ = vfuiSoDYtUOHweqrpS3nlpZSq5xP1bqaxgqyzw8uDFafP6hiv7RrhtPaltrGQSiBgSpHYCCel91N0Vbqdny
-		var nFl7jZeuLq3f2wNnL695EXG1ibiivJ5REpy tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(51))
-		_ = nFl7jZeuLq3f2wNnL695EXG1ibiivJ5REpy
-		var bTz3sME7uzvqvSRG4aVh05UtyP9SnTDt5TNHyCFBLMzDrWPGhK0lY9B3O71YXk9bYFSyxAahbMqXdgBaHyW45H1B6AnCUpVkBYfOh4YmgW6uSfKl2nI tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(52))
-		_ = bTz3sME7uzvqvSRG4aVh05UtyP9SnTDt5TNHyCFBLMzDrWPGhK0lY9B3O71YXk9bYFSyxAahbMqXdgBaHyW45H1B6AnCUpVkBYfOh4YmgW6uSfKl2nI
-		var egZacxcdnwHTXFjQv0mKAT tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(53))
-		_ = egZacxcdnwHTXFjQv0mKAT
-		var fXDIsjR2eM0AUQjupHODeoOzPmWHg80fnpFfrREZdPnevlz tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(54))
-		_ = fXDIsjR2eM0AUQjupHODeoOzPmWHg80fnpFfrREZdPnevlz
-		var f9ve6VS9Y8lFGuQkUIYyc3cG6E1o9w0sJANSJ8QqAhT1X1Tm5CBglXjLrbQZOWJpazH3hdj tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(55))
-		_ = f9ve6VS9Y8lFGuQkUIYyc3cG6E1o9w0sJANSJ8QqAhT1X1Tm5CBglXjLrbQZOWJpazH3hdj
-		var jQgnsNXmg8WQzWTP7 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(56))
-		_ = jQgnsNXmg8WQzWTP7
-		var yI1M54USa0JkoDwEuhrLKJfohgstZnMek58ONSDqVAVuq5MHjasjmDg0vLjnAJsiXgV8kr4b39CXdYQ4CUBxsgJoDALhshwje9EfJh37rH1rHnFp tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(57))
-		_ = yI1M54USa0JkoDwEuhrLKJfohgstZnMek58ONSDqVAVuq5MHjasjmDg0vLjnAJsiXgV8kr4b39CXdYQ4CUBxsgJoDALhshwje9EfJh37rH1rHnFp
-		var femfYGpvUQxUXPaBbAc4V7BkEd71Z tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(58))
-		_ = femfYGpvUQxUXPaBbAc4V7BkEd71Z
-		var slG1TW9kzeXjOFmw5mP9JRRXZx6 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(59))
-		_ = slG1TW9kzeXjOFmw5mP9JRRXZx6
-		var ikwVTkV4ft7K53XiRwWiMyTlFIiRJlObVi0cCN9HPPy tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(60))
-		_ = ikwVTkV4ft7K53XiRwWiMyTlFIiRJlObVi0cCN9HPPy
-		var nRJa6VIjG0iSk3umJGlA0YKb5LeDRF3S4LYCAromZgLDtfOd6BYVOrV3WpQ5XxAiP4ahlc1fqZ6kbnkdR5hvNUdkqVgF9hTK3 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(61))
-		_ = nRJa6VIjG0iSk3umJGlA0YKb5LeDRF3S4LYCAromZgLDtfOd6BYVOrV3WpQ5XxAiP4ahlc1fqZ6kbnkdR5hvNUdkqVgF9hTK3
-		var rS01pIEqFI4nECM tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(62))
-		_ = rS01pIEqFI4nECM
-		var x9lTCQJ46gsItDkq3s7puzIT3dhl396BxvZevpJZqgL tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(63))
-		_ = x9lTCQJ46gsItDkq3s7puzIT3dhl396BxvZevpJZqgL
-		var wX2of tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(64))
-		_ = wX2of
-		var yVZ2kwpKr8WTnHQBA0Zajs0WhlTLCpWvL8jieQwi2LAfioPwt1ttRZNWO41mGYeyQyRFUMN0bSCLrr6 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(65))
-		_ = yVZ2kwpKr8WTnHQBA0Zajs0WhlTLCpWvL8jieQwi2LAfioPwt1ttRZNWO41mGYeyQyRFUMN0bSCLrr6
-		var dqByoNgSuCNNKVW1jvrrpBMbogTZ2G32Mtl1ns88U2c6Se0OyI3l tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(66))
-		_ = dqByoNgSuCNNKVW1jvrrpBMbogTZ2G32Mtl1ns88U2c6Se0OyI3l
-		var eilbyb6SsTvkG tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(67))
-		_ = eilbyb6SsTvkG
-		var aqiWNd2h0ebqXWYuCMYoRZOdpi15kQGjEX7S4rY0xceblCGZyFjmJzynrHa86dQRT9cyBQTYetu8Mp92ZkSwI3HdD6Tfuv tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(68))
-		_ = aqiWNd2h0ebqXWYuCMYoRZOdpi15kQGjEX7S4rY0xceblCGZyFjmJzynrHa86dQRT9cyBQTYetu8Mp92ZkSwI3HdD6Tfuv
-		var t70LxjlzZS2MKJk9NaKo7LXgYuOqnCVTquv4UhRMbVY3z tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(69))
-		_ = t70LxjlzZS2MKJk9NaKo7LXgYuOqnCVTquv4UhRMbVY3z
-		var oNhdMH1vNIChcsmWBwhaM tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(70))
-		_ = oNhdMH1vNIChcsmWBwhaM
-		var hzEGhhnRYrI7mGjXnj51FB8Jxqy0Rpl3fxYLk3XGF0BTUYCZGbRldmelbE5csc7GplMminJV4dGAX4O6N03p tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(71))
-		_ = hzEGhhnRYrI7mGjXnj51FB8Jxqy0Rpl3fxYLk3XGF0BTUYCZGbRldmelbE5csc7GplMminJV4dGAX4O6N03p
-		var t5eghclsFuE6CjDCubUJpc14DcmhxPhy tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(72))
-		_ = t5eghclsFuE6CjDCubUJpc14DcmhxPhy
-		var hmTiNkMN3KJVoMdbGecjOzv3bShhuKI5QhIIS62cEzsDWirN1PSygSoaQIZczHnKFKZLGiGZ6YQvBzSGitDGyltQuaI4EEh4CnobOUQ9g tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(73))
-		_ = hmTiNkMN3KJVoMdbGecjOzv3bShhuKI5QhIIS62cEzsDWirN1PSygSoaQIZczHnKFKZLGiGZ6YQvBzSGitDGyltQuaI4EEh4CnobOUQ9g
-		var iXCyDBBJHfbBYbpLCyPfYjcYRehMfJPPCcMiCrrQQhy1fvRnuevlgz0YN3AIhu tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(74))
-		_ = iXCyDBBJHfbBYbpLCyPfYjcYRehMfJPPCcMiCrrQQhy1fvRnuevlgz0YN3AIhu
-		var qYQ8rPoy84TlMSkhJzrStSm5z22vyJ25BPF6JGByWzyJlglMlohLRxls tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(75))
-		_ = qYQ8rPoy84TlMSkhJzrStSm5z22vyJ25BPF6JGByWzyJlglMlohLRxls
-		var zUOc5RkBnUYKK5U3B1CACgrYf4uavpr tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(76))
-		_ = zUOc5RkBnUYKK5U3B1CACgrYf4uavpr
-		var rqSr9elqVrUOXVzYwnxEhEaR048QEo2cn5m1tvf1uyG4grj53R6MRC6XomMZw9AibztCglmgiAAZe5EDkL1AO tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(77))
-		_ = rqSr9elqVrUOXVzYwnxEhEaR048QEo2cn5m1tvf1uyG4grj53R6MRC6XomMZw9AibztCglmgiAAZe5EDkL1AO
-		var a tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(78))
-		_ = a
-		var ddpP8QrFBXM75EHZBxbFDOM tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(79))
-		_ = ddpP8QrFBXM75EHZBxbFDOM
-		var mpHjrXsJUThOqZGyvuClPaOnRU4OKremovq3UxqEVPkw4Zsd80mymZzx8TJBzan1KzXRrmjebKj9pJ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(80))
-		_ = mpHjrXsJUThOqZGyvuClPaOnRU4OKremovq3UxqEVPkw4Zsd80mymZzx8TJBzan1KzXRrmjebKj9pJ
-		var b4UmPxr40v6HFQr6bG7iY4YKjRuqWAwIA33XYKGpuGZEsJ8reAk9RWKjg0Y7dxA2v6uf6zgHSoehRwYJ1j4jQ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(81))
-		_ = b4UmPxr40v6HFQr6bG7iY4YKjRuqWAwIA33XYKGpuGZEsJ8reAk9RWKjg0Y7dxA2v6uf6zgHSoehRwYJ1j4jQ
-		var j3hi4BuSA72oSUC9zYnE9kKzFBZvBS7WJOi8raRB3b7xG9BxkfhuXwTMhLQd tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(82))
-		_ = j3hi4BuSA72oSUC9zYnE9kKzFBZvBS7WJOi8raRB3b7xG9BxkfhuXwTMhLQd
-		var gauJumtCTW4D3DKeaTcvTeLkrIddKZnghAuq2hknmysixjmTpQSmSGPoyKwaDLKjGfAF4wMV0JlYOMxGqdNo6GIwt8LRY1omSVXiBA8hNWbkA tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(83))
-		_ = gauJumtCTW4D3DKeaTcvTeLkrIddKZnghAuq2hknmysixjmTpQSmSGPoyKwaDLKjGfAF4wMV0JlYOMxGqdNo6GIwt8LRY1omSVXiBA8hNWbkA
-		var qWNNS2K1xhyu5oyFyGU5kWdP2gK8Z2PA9gJN1hQwSn7zkqYFQ8J8 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(84))
-		_ = qWNNS2K1xhyu5oyFyGU5kWdP2gK8Z2PA9gJN1hQwSn7zkqYFQ8J8
-		var mlKKyFJSUsJhVVh3xOMqRAapsOk4IjX9oRlORM7M9J1NeuKlkvsunu48fQHmlau61pRMeBYcUJgozKjWpbkG3dQ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(85))
-		_ = mlKKyFJSUsJhVVh3xOMqRAapsOk4IjX9oRlORM7M9J1NeuKlkvsunu48fQHmlau61pRMeBYcUJgozKjWpbkG3dQ
-		var kcArELuTjGw0bL9h9XLmLPqW7Teo6le9eYBLzUraYVYvJg0 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(86))
-		_ = kcArELuTjGw0bL9h9XLmLPqW7Teo6le9eYBLzUraYVYvJg0
-		var djgwfpKUIraZ7Ix2wm3vMZnGhkqrve07n6P79DEpuFvGSXQ255jNVFYNSynFrWgr3jAjIIT tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(87))
-		_ = djgwfpKUIraZ7Ix2wm3vMZnGhkqrve07n6P79DEpuFvGSXQ255jNVFYNSynFrWgr3jAjIIT
-		var p2s2nyv7cNCYbijxHjEsWoJn01g92V263GLCOuZHFEZyj6aKO7DJypSazbnJ3yiLYNrr3ZQOQdq6pCMNvG84ljHizSvdfqt3s8cxBft9QAeuJMNS6w1UU tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(88))
-		_ = p2s2nyv7cNCYbijxHjEsWoJn01g92V263GLCOuZHFEZyj6aKO7DJypSazbnJ3yiLYNrr3ZQOQdq6pCMNvG84ljHizSvdfqt3s8cxBft9QAeuJMNS6w1UU
-		var jQZqVEZPh14ngahWR6bJ tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(89))
-		_ = jQZqVEZPh14ngahWR6bJ
-		var vU54xC5FxTB6cG1Nag5lsTPaeGIUhhqDShwkO8fHer4iNCwJ7na8 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(90))
-		_ = vU54xC5FxTB6cG1Nag5lsTPaeGIUhhqDShwkO8fHer4iNCwJ7na8
-		var gbXqnnPs1dudzBCWUFdVAlN70yKS50OZayTTtMOf30cfYsUJBppwvOEyzetOp9NFzR6H27sPufjk8R tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(91))
-		_ = gbXqnnPs1dudzBCWUFdVAlN70yKS50OZayTTtMOf30cfYsUJBppwvOEyzetOp9NFzR6H27sPufjk8R
-		var pa9fCpVXPR8wRMoPrpentu7s7fs tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(92))
-		_ = pa9fCpVXPR8wRMoPrpentu7s7fs
-		var tNWcHI4ukF8QG5xNRvFm tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(93))
-		_ = tNWcHI4ukF8QG5xNRvFm
-		var dhInHXJhVwccgOdwTXVLGIIljRDyP7eGziKx2DOC2dMNBwVCw6Etdho8SEdV3lNHpBcVWt3zrVB tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(94))
-		_ = dhInHXJhVwccgOdwTXVLGIIljRDyP7eGziKx2DOC2dMNBwVCw6Etdho8SEdV3lNHpBcVWt3zrVB
-		var bHg9fmrcSY1un4rNZHEi4i4IQq5V5Quxcm0QOUlIojlDDJvRX4QN8FBBcxrPBzUME6A1JJsjahpPanCqHP9KxTtZDiLFZAUUrYDguy8M4m3YcwQkO8Yj8 tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(95))
-		_ = bHg9fmrcSY1un4rNZHEi4i4IQq5V5Quxcm0QOUlIojlDDJvRX4QN8FBBcxrPBzUME6A1JJsjahpPanCqHP9KxTtZDiLFZAUUrYDguy8M4m3YcwQkO8Yj8
-		var sHxuNtsEoXD9ldJSqGWa5J9x414RoC0CERcKyQTobMr2PO3WS2V0WLKHn7YL tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(96))
-		_ = sHxuNtsEoXD9ldJSqGWa5J9x414RoC0CERcKyQTobMr2PO3WS2V0WLKHn7YL
-		var qs7zFQE2EK3XeQjsriWsExAGrC18p0XX6bMbKNFbMgMqP tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(97))
-		_ = qs7zFQE2EK3XeQjsriWsExAGrC18p0XX6bMbKNFbMgMqP
-		var uzR6cdmOMb3oKB332RJmoMAbkJT8W5dBkq83FQif64Zx tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(98))
-		_ = uzR6cdmOMb3oKB332RJmoMAbkJT8W5dBkq83FQif64Zx
-		var wanyIzeRPINssefDuxC6oZSqTXdtlAVQ63f1VOK4KIgEDlnT68IuB5hJS7fyCoc7HkaRKhkaPYv7heY1pxdWZin8jMp tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(99))
-		_ = wanyIzeRPINssefDuxC6oZSqTXdtlAVQ63f1VOK4KIgEDlnT68IuB5hJS7fyCoc7HkaRKhkaPYv7heY1pxdWZin8jMp
-		var bhPhVYyD9iBbZ3SjuZEA7XAnmQGgru2Y8mqSqbhgcf2yZIiqTN3vikA4FZOEU7mAe5SVp0cZH tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(100))
-		_ = bhPhVYyD9iBbZ3SjuZEA7XAnmQGgru2Y8mqSqbhgcf2yZIiqTN3vikA4FZOEU7mAe5SVp0cZH
-		var n4UO2hD40hwjB1i5TQ6NvpSbv99v4ZfeSJLM5ajG5cbUfewjv9Jzse7ssXXf9fbOJmZ5m4Z tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(101))
-		_ = n4UO2hD40hwjB1i5TQ6NvpSbv99v4ZfeSJLM5ajG5cbUfewjv9Jzse7ssXXf9fbOJmZ5m4Z
-		var n38jwcbXgULpJV21mZUYWedX8DxT4x tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(102))
-		_ = n38jwcbXgULpJV21mZUYWedX8DxT4x
-		var e52VdhC9irySRc6baYWJWqctknfa2SocXH6s3WXD68yxyDsthJl3OxCJd8JM9ZumFjsjwOG3Kdy tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(103))
-		_ = e52VdhC9irySRc6baYWJWqctknfa2SocXH6s3WXD68yxyDsthJl3OxCJd8JM9ZumFjsjwOG3Kdy
-		var nllszb9hx7o2DpH9VgtKXbbbConb6qZkVE51FT tla.TLAValue = args8[0].ApplyFunction(tla.MakeTLANumber(104))
-		_ = nllszb9hx7o2DpH9VgtKXbbbConb6qZkVE51FT
-		var oyarDkIO1XeNWlRTWPoDsk1rDzx2KhOGK4xnI3CjESXu tla.TLAValue = args8[1]
-		_ = oyarDkIO1XeNWlRTWPoDsk1rDzx2KhOGK4xnI3CjESXu
-		var lAPrrMqrU4ntxPtOdkQigX04o9A1UhGZEbuFmCpVZXsEtPOamE6NNhWjuNSx4u9QGUQi1eGldztPwD9aM6xX3 tla.TLAValue = args8[2]
-		_ = lAPrrMqrU4ntxPtOdkQigX04o9A1UhGZEbuFmCpVZXsEtPOamE6NNhWjuNSx4u9QGUQi1eGldztPwD9aM6xX3
-		var au1cdpoEAC9qpKhX95muLa7UHtd7Tc5ClX8RPdqrx1VnwB73UWLmobCcRUJ00MykuKUNvxqaBeCpgLJazYJJbWr tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(1))
-		_ = au1cdpoEAC9qpKhX95muLa7UHtd7Tc5ClX8RPdqrx1VnwB73UWLmobCcRUJ00MykuKUNvxqaBeCpgLJazYJJbWr
-		var hzLz47gO3PXjsufbylWIR4mTHq6vCNacxkbhBUoa0nuwsT5E0n5U6PWFkkZirl8ZEixM99Ewdj05HvHwxslZDN8t585781qX3z4hXVOiRp8FFp tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(2))
-		_ = hzLz47gO3PXjsufbylWIR4mTHq6vCNacxkbhBUoa0nuwsT5E0n5U6PWFkkZirl8ZEixM99Ewdj05HvHwxslZDN8t585781qX3z4hXVOiRp8FFp
-		var aU4LYhjGn4d5XsRLPIvK1FIAX8qwM7MFxxS0GcitvGAqZBZLoYBvMXuuBG42WI6pmGhYUiIlr4i24KUBKY5jTmtsWblOCQiDYpqoN9FGwB1RqHEi27 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(3))
-		_ = aU4LYhjGn4d5XsRLPIvK1FIAX8qwM7MFxxS0GcitvGAqZBZLoYBvMXuuBG42WI6pmGhYUiIlr4i24KUBKY5jTmtsWblOCQiDYpqoN9FGwB1RqHEi27
-		var uHl2tqdxD22AMbjUnwvSeSNc9Nv9tmlqWYHct9ZAB51iJCSOrr4FTfoAjoxk0y9uqsv tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(4))
-		_ = uHl2tqdxD22AMbjUnwvSeSNc9Nv9tmlqWYHct9ZAB51iJCSOrr4FTfoAjoxk0y9uqsv
-		var oguxOV5fEaapPZjnfKLbtbeZv1eTdeiMpNhIJvQHkSZCLsLXSHoWa4DY2cwSpkVCXRO7qzQNz9f tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(5))
-		_ = oguxOV5fEaapPZjnfKLbtbeZv1eTdeiMpNhIJvQHkSZCLsLXSHoWa4DY2cwSpkVCXRO7qzQNz9f
-		var hbUt0AZXXz6LYtOO tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(6))
-		_ = hbUt0AZXXz6LYtOO
-		var lgSJuN1uQn6SwALwXUaWvaa8Zy9DNKHfl3HuugQfYRp tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(7))
-		_ = lgSJuN1uQn6SwALwXUaWvaa8Zy9DNKHfl3HuugQfYRp
-		var bd303Ubr6ck750RJxkGKLfD0mIyg6lNIErBRyc4gPUKk8QHXPlFz5gEAcDERgyVwPGAb0tfNnIQkLRLK3ZQJYhdIPnAPeCT5b2L tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(8))
-		_ = bd303Ubr6ck750RJxkGKLfD0mIyg6lNIErBRyc4gPUKk8QHXPlFz5gEAcDERgyVwPGAb0tfNnIQkLRLK3ZQJYhdIPnAPeCT5b2L
-		var ex6IHT0FvHtvyWDlLMD2sMcgH2S7HkcG7tRQEwoUhHUqNALJkiy8mT3M4zLKrTYPYtAGp0UpNuHzbt9SvmywcdGad0u109AEuZVMiEDvFdAZ3elZI tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(9))
-		_ = ex6IHT0FvHtvyWDlLMD2sMcgH2S7HkcG7tRQEwoUhHUqNALJkiy8mT3M4zLKrTYPYtAGp0UpNuHzbt9SvmywcdGad0u109AEuZVMiEDvFdAZ3elZI
-		var mpQxvepo9RZldQx6ztwSteOnGnkDpRRYxuUcT22pJpoj2URIhiYoxFhHdMb667D3OlpFad00IUo7YnDZ2ksaKtDSjBxVkWyx9w5yUs0fr tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(10))
-		_ = mpQxvepo9RZldQx6ztwSteOnGnkDpRRYxuUcT22pJpoj2URIhiYoxFhHdMb667D3OlpFad00IUo7YnDZ2ksaKtDSjBxVkWyx9w5yUs0fr
-		var pN0k58Og1pFVER11g9ZjuYo6GM tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(11))
-		_ = pN0k58Og1pFVER11g9ZjuYo6GM
-		var c5Xdkp5vWkWtDWMFi8S2JRBrztq8TuLtRJ7HFYf5c9jKH4kphxkGnf6LjIoSii24syOzTl8Or2WiXQBOsGSqlQzIuodyPW22KBNDNR2IO7u tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(12))
-		_ = c5Xdkp5vWkWtDWMFi8S2JRBrztq8TuLtRJ7HFYf5c9jKH4kphxkGnf6LjIoSii24syOzTl8Or2WiXQBOsGSqlQzIuodyPW22KBNDNR2IO7u
-		var a5q0T6qoZGsD tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(13))
-		_ = a5q0T6qoZGsD
-		var x5agC7yGKNdin6gg9mjdKORWIMAtNVUPA2McZGoND7O0c3bvExJ tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(14))
-		_ = x5agC7yGKNdin6gg9mjdKORWIMAtNVUPA2McZGoND7O0c3bvExJ
-		var qa5L9ldSLlxfhlJUykYTQwrtgjc5e3qNd0iYIzEbJLm1Z4eSpNmWr0OI8oYNJ tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(15))
-		_ = qa5L9ldSLlxfhlJUykYTQwrtgjc5e3qNd0iYIzEbJLm1Z4eSpNmWr0OI8oYNJ
-		var pvNAMZqvzbiLS05Iv1yiNpYlOLbDjkk0pdXHdIP tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(16))
-		_ = pvNAMZqvzbiLS05Iv1yiNpYlOLbDjkk0pdXHdIP
-		var qxbM0ySqkdzWhn6gaROcgBgxFwsaY7adHaF3zhWU3qvyZMiXDYvhlbWBjZSc6nCHr8DLrsWqkB1ZScZHg8LjT4uJqajwOdLZpq4pEzU6a6Gc tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(17))
-		_ = qxbM0ySqkdzWhn6gaROcgBgxFwsaY7adHaF3zhWU3qvyZMiXDYvhlbWBjZSc6nCHr8DLrsWqkB1ZScZHg8LjT4uJqajwOdLZpq4pEzU6a6Gc
-		var jbOiq5HMFD8cif9XiUh6hnhIT8YiZRNR5GZw9OypWmdXVYJepnHvBmXomux8psyO3wxhnQIbsScWDSENdLfGEnqt4FFPG tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(18))
-		_ = jbOiq5HMFD8cif9XiUh6hnhIT8YiZRNR5GZw9OypWmdXVYJepnHvBmXomux8psyO3wxhnQIbsScWDSENdLfGEnqt4FFPG
-		var t9NNl tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(19))
-		_ = t9NNl
-		var fVzrKexwJierBG3hrZ23gwGhpFJayfb9AVRY9yctyCs1v0eevdU0Tf9sr4PWtDNzC0ShzgWJIAnkD7doIj8WWJIJjln54rx8ePxMH tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(20))
-		_ = fVzrKexwJierBG3hrZ23gwGhpFJayfb9AVRY9yctyCs1v0eevdU0Tf9sr4PWtDNzC0ShzgWJIAnkD7doIj8WWJIJjln54rx8ePxMH
-		var rbYYWIdW8cBhrHYa8Sh4a7WZBIVYLMEMbwictyRa2N44lrijZvcB6 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(21))
-		_ = rbYYWIdW8cBhrHYa8Sh4a7WZBIVYLMEMbwictyRa2N44lrijZvcB6
-		var szsIaKkIkQks2UP6nNmno2w4LjWVnEbO8g1E0Lftg2JPAvdruTprjbguf2bty tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(22))
-		_ = szsIaKkIkQks2UP6nNmno2w4LjWVnEbO8g1E0Lftg2JPAvdruTprjbguf2bty
-		var nfpgB7M0Rilxy58mrAFWru6fZsVLMuymfeOYonnLiXiYVZnwAlK1ciofegFm5M60pk2v6APjYkVibb1tF0 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(23))
-		_ = nfpgB7M0Rilxy58mrAFWru6fZsVLMuymfeOYonnLiXiYVZnwAlK1ciofegFm5M60pk2v6APjYkVibb1tF0
-		var fmfnfeJBnNeOfhWLWmzlliBBK tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(24))
-		_ = fmfnfeJBnNeOfhWLWmzlliBBK
-		var hd00g2nvPGSTW5Mz999GjUaURy2EkwkQ6xvPfWX1W3UNEfmgWOmg3lgpEmVCQi5CzR3asdf9QossCtYY tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(25))
-		_ = hd00g2nvPGSTW5Mz999GjUaURy2EkwkQ6xvPfWX1W3UNEfmgWOmg3lgpEmVCQi5CzR3asdf9QossCtYY
-		var euxA2lDDj2qWZiYTlc1W9QmDMx1g5vx7LxD8h4c8yccTuQb9HAHbgny0QFA88870OURf0URMPbf tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(26))
-		_ = euxA2lDDj2qWZiYTlc1W9QmDMx1g5vx7LxD8h4c8yccTuQb9HAHbgny0QFA88870OURf0URMPbf
-		var xN9TPhSUmZPSl4KWKLpK9QkP6LSFcM9dGokhZrkB32B99q065eQuCNs tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(27))
-		_ = xN9TPhSUmZPSl4KWKLpK9QkP6LSFcM9dGokhZrkB32B99q065eQuCNs
-		var hmNUnDWYdh70I8cTr tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(28))
-		_ = hmNUnDWYdh70I8cTr
-		var oeIqhndp6tenU6QiiB8CUyJSPXjZjQ7Iyg0UKN9OarWDDfdFHKmETPfeVvwvouBxX tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(29))
-		_ = oeIqhndp6tenU6QiiB8CUyJSPXjZjQ7Iyg0UKN9OarWDDfdFHKmETPfeVvwvouBxX
-		var vzc6KpuDfomthXJ2IhgCi tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(30))
-		_ = vzc6KpuDfomthXJ2IhgCi
-		var dWU7d2r0QU74gm7LRVanCRdMemPqnHnO4dI06kxq3sVNkgjp tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(31))
-		_ = dWU7d2r0QU74gm7LRVanCRdMemPqnHnO4dI06kxq3sVNkgjp
-		var b95EGSgn8LhXHgWBXEjm7l2S0lz8uFa2Tz5wEdR4GlXgxiklVgi5pJ7zOxpIRfgrbaPIWGNjjM9e5i7Ddi5sYrUi8iZGlleLgqBEGvYyNCYhD tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(32))
-		_ = b95EGSgn8LhXHgWBXEjm7l2S0lz8uFa2Tz5wEdR4GlXgxiklVgi5pJ7zOxpIRfgrbaPIWGNjjM9e5i7Ddi5sYrUi8iZGlleLgqBEGvYyNCYhD
-		var aoNi5aMtn1gZFOQydE8PHVHEUL16pLenad3darEb4fkWCx7ullptyfy8luXS3RmT3fQu tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(33))
-		_ = aoNi5aMtn1gZFOQydE8PHVHEUL16pLenad3darEb4fkWCx7ullptyfy8luXS3RmT3fQu
-		var pFCOBMSUVeGJ2jEAb0rOPnAC9cJMtkPFuFgef8yyN5sGXeM1BhKXjlvYCBpJYYeGwHbsnK3PSXQn4nlO74xoGSw1Oh tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(34))
-		_ = pFCOBMSUVeGJ2jEAb0rOPnAC9cJMtkPFuFgef8yyN5sGXeM1BhKXjlvYCBpJYYeGwHbsnK3PSXQn4nlO74xoGSw1Oh
-		var cXhaD65hxsJtJPq5qlZElRs96ZAjMkhy9YmiNA2zXg1F9FYTcVccJPRuNhN0VDQcQ9ziyK21RtjEan0MSPAuRX9eZEGau1GSQyo3nJjsllxE tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(35))
-		_ = cXhaD65hxsJtJPq5qlZElRs96ZAjMkhy9YmiNA2zXg1F9FYTcVccJPRuNhN0VDQcQ9ziyK21RtjEan0MSPAuRX9eZEGau1GSQyo3nJjsllxE
-		var bW2468OtxPktGLK4MVXJzYaPqN0OLpxcOkCOsA8X2mL1jfOjgFei7h0ULoVFzxyUV3W5IyY6mvsmTGkjZPQlV15N4Wp613fGA3ZYtbEoH1j2M tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(36))
-		_ = bW2468OtxPktGLK4MVXJzYaPqN0OLpxcOkCOsA8X2mL1jfOjgFei7h0ULoVFzxyUV3W5IyY6mvsmTGkjZPQlV15N4Wp613fGA3ZYtbEoH1j2M
-		var wQVnYM9Z8c4CweQoHvFBVAggPszqqY4XQrgiIt6vstYAaLO6KVlIZY5xBWvI2GRs3YKCuYZ4u6B7k9MIXO4TcvYJh3qYXd324LZT9BRXCcmHMWlh tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(37))
-		_ = wQVnYM9Z8c4CweQoHvFBVAggPszqqY4XQrgiIt6vstYAaLO6KVlIZY5xBWvI2GRs3YKCuYZ4u6B7k9MIXO4TcvYJh3qYXd324LZT9BRXCcmHMWlh
-		var tYV6nVFRhrhf5fAA1M21yImOpdfAqFtobbpJJAUA0AJrAGv90DU7j87Up68NxVL2F2jisMWFfdgBKRzemF9Hl7HEpEXyrpsvp6khqV0NGayJ5QpmpykI tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(38))
-		_ = tYV6nVFRhrhf5fAA1M21yImOpdfAqFtobbpJJAUA0AJrAGv90DU7j87Up68NxVL2F2jisMWFfdgBKRzemF9Hl7HEpEXyrpsvp6khqV0NGayJ5QpmpykI
-		var ruhYsMuvLQfrnoa0mltlCEArVUQXeeKJA26ixaF9QoTfXuwC7ISPYThs8GpoIM5cL0Sy8M9klWTYbZ8SbGyFjM tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(39))
-		_ = ruhYsMuvLQfrnoa0mltlCEArVUQXeeKJA26ixaF9QoTfXuwC7ISPYThs8GpoIM5cL0Sy8M9klWTYbZ8SbGyFjM
-		var x3RlxtzukPGmwZJPSUlaAi tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(40))
-		_ = x3RlxtzukPGmwZJPSUlaAi
-		var oHlbHqPxYDpIj5g tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(41))
-		_ = oHlbHqPxYDpIj5g
-		var hTThjtHRV95wozaOIvq4tthTyiw9kMpSv27JBcvfy8AYIOiOVj83x08xCuq5FSm tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(42))
-		_ = hTThjtHRV95wozaOIvq4tthTyiw9kMpSv27JBcvfy8AYIOiOVj83x08xCuq5FSm
-		var xpb9kfTJuMJbPksb9kT3XvDlzNA16f063WS96oU1SA6UY8I9V21gDGW7ErDpecGIxIqTjKAlRPZJ2b6B5sLfm9iDyjYUA9HAJXzmwnZa2e4Uu tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(43))
-		_ = xpb9kfTJuMJbPksb9kT3XvDlzNA16f063WS96oU1SA6UY8I9V21gDGW7ErDpecGIxIqTjKAlRPZJ2b6B5sLfm9iDyjYUA9HAJXzmwnZa2e4Uu
-		var tqUcmTzXE3Fz7dBRQ9NGCSSle85yvc0bt7fbiKLkEI5iY6iQ0 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(44))
-		_ = tqUcmTzXE3Fz7dBRQ9NGCSSle85yvc0bt7fbiKLkEI5iY6iQ0
-		var iO2zm2YrS6dQSq5auWwaMgTUICDYC4QpF7L89l3NI9MNDej4SfAHgbsoN0YbxMGd2nqo2xuWReMIhXU3DWseoO6R tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(45))
-		_ = iO2zm2YrS6dQSq5auWwaMgTUICDYC4QpF7L89l3NI9MNDej4SfAHgbsoN0YbxMGd2nqo2xuWReMIhXU3DWseoO6R
-		var xuOYHgPWe3HahKfxUK1AgvlT0P31iNMf4c1ljBJ27MCl3lcy7POJ2 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(46))
-		_ = xuOYHgPWe3HahKfxUK1AgvlT0P31iNMf4c1ljBJ27MCl3lcy7POJ2
-		var mTi4I31HoXGf0jefw3zQmQY01GpYftoHZnDN tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(47))
-		_ = mTi4I31HoXGf0jefw3zQmQY01GpYftoHZnDN
-		var l6n46iVh1ybtF5TgV1N1Tds tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(48))
-		_ = l6n46iVh1ybtF5TgV1N1Tds
-		var gvHMDcRUkO0hrJ9xA29NHUz7DxdxTpWMc3aN8zkFziGh77Zn2EPNulzkvaayt2EAtLBnVHyLMxqxGzkvhI4b4q50EVcN tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(49))
-		_ = gvHMDcRUkO0hrJ9xA29NHUz7DxdxTpWMc3aN8zkFziGh77Zn2EPNulzkvaayt2EAtLBnVHyLMxqxGzkvhI4b4q50EVcN
-		var aRypQCmRaaZJwspACFjZEfGvhNPPmdgKBpxPeZ8w7QnaguWpL tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(50))
-		_ = aRypQCmRaaZJwspACFjZEfGvhNPPmdgKBpxPeZ8w7QnaguWpL
-		var c3 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(51))
-		_ = c3
-		var zV5AYeIhWyXMVf7atiSDikFU2ExF1GMMVnyofEuOaHsVTlfAD tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(52))
-		_ = zV5AYeIhWyXMVf7atiSDikFU2ExF1GMMVnyofEuOaHsVTlfAD
-		var coB5hQkxVjx57nec1adAag4F64VJm4SUg6aG5WvGPF1glkmeSCA8H6BiIkDSbrSz7PyDDDjMGItuLXnQASXzDbfj8fXcRd8uWKkoQD tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(53))
-		_ = coB5hQkxVjx57nec1adAag4F64VJm4SUg6aG5WvGPF1glkmeSCA8H6BiIkDSbrSz7PyDDDjMGItuLXnQASXzDbfj8fXcRd8uWKkoQD
-		var oPYzOm2t0FUGe9PmrVWcS7yfQyQNad4sC2SkfPRecH tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(54))
-		_ = oPYzOm2t0FUGe9PmrVWcS7yfQyQNad4sC2SkfPRecH
-		var ssV3KBNB2gZWZJYcmG5HDN1zz8du54zyIRSncFti1rT6jaDzT tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(55))
-		_ = ssV3KBNB2gZWZJYcmG5HDN1zz8du54zyIRSncFti1rT6jaDzT
-		var u6huSF0Ic9nxwLrUuk7M tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(56))
-		_ = u6huSF0Ic9nxwLrUuk7M
-		var zzxYCGcM1GxKfVkCPJTRTFnRpesl6SHRdErcTuXrPbjuzef7OYOkvgN tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(57))
-		_ = zzxYCGcM1GxKfVkCPJTRTFnRpesl6SHRdErcTuXrPbjuzef7OYOkvgN
-		var wArDmuwkH5AI6uMKHTYD2UTkSWvnMed tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(58))
-		_ = wArDmuwkH5AI6uMKHTYD2UTkSWvnMed
-		var oZqiIRaDJ6he5iya2HKw8Z0biC5qyr0TOOrpDP29mTi8OCK6ah9UoN0TpYYrxveSYTzOf0QQr5br7mTOmqDikHfIo7Bb6rDDV tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(59))
-		_ = oZqiIRaDJ6he5iya2HKw8Z0biC5qyr0TOOrpDP29mTi8OCK6ah9UoN0TpYYrxveSYTzOf0QQr5br7mTOmqDikHfIo7Bb6rDDV
-		var fwwceNk1mOejco6Xz85jHol7NovhmD2wy7fZchBoB6KWyQuNYV8n7rqs85GWqPQ9SrGwI tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(60))
-		_ = fwwceNk1mOejco6Xz85jHol7NovhmD2wy7fZchBoB6KWyQuNYV8n7rqs85GWqPQ9SrGwI
-		var g4htEEA2wh2U9wJoiLA5DATskRAbuj6Gb05gM4voIUqN1nOTr68ay8RzNEHuZUGVFnTKa0Nxw9YI9SeoQylB tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(61))
-		_ = g4htEEA2wh2U9wJoiLA5DATskRAbuj6Gb05gM4voIUqN1nOTr68ay8RzNEHuZUGVFnTKa0Nxw9YI9SeoQylB
-		var csAir1tWqElRZGz7r5STeer tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(62))
-		_ = csAir1tWqElRZGz7r5STeer
-		var kBzGPNkjLRYoCtggPUYD1yG2GhP3MO tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(63))
-		_ = kBzGPNkjLRYoCtggPUYD1yG2GhP3MO
-		var pptW9yQU7hmSA9JC8GGDfvwaLWG5I8IYP9PFf2jOY7H7EyEdY1Nlt6E tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(64))
-		_ = pptW9yQU7hmSA9JC8GGDfvwaLWG5I8IYP9PFf2jOY7H7EyEdY1Nlt6E
-		var ynbXCelNrwTY65CpQJIlgq1A2XDtiALVD6TrWR1VlsvUMcPQb5e924lQ3 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(65))
-		_ = ynbXCelNrwTY65CpQJIlgq1A2XDtiALVD6TrWR1VlsvUMcPQb5e924lQ3
-		var iEPaaa63QPMjE1NIlGTtN1GqgSR4Hu365kqi6omVfUPMoojXkmiP1YwGAfTHK70V4IQRrfxHf3UZy6jmmeart2irbIIaPgHPZBK tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(66))
-		_ = iEPaaa63QPMjE1NIlGTtN1GqgSR4Hu365kqi6omVfUPMoojXkmiP1YwGAfTHK70V4IQRrfxHf3UZy6jmmeart2irbIIaPgHPZBK
-		var bOZqBs0VirPBezxCopGvk73IvlxVrzHII4YYSK5RZpmY tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(67))
-		_ = bOZqBs0VirPBezxCopGvk73IvlxVrzHII4YYSK5RZpmY
-		var thlGZFI9F7D8alAGPYfelIjpHjP tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(68))
-		_ = thlGZFI9F7D8alAGPYfelIjpHjP
-		var uFVon832WvRB612oHBxrqdG1xJSE7e9oMIK0OPHSbkEWzZSOqCg8WbMpG9ta7iOSaHs1z1cmM03rSqbqUjMjNDpIaA4vO7UcA5UsJFJ6Gn26FaHLYBVlmA tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(69))
-		_ = uFVon832WvRB612oHBxrqdG1xJSE7e9oMIK0OPHSbkEWzZSOqCg8WbMpG9ta7iOSaHs1z1cmM03rSqbqUjMjNDpIaA4vO7UcA5UsJFJ6Gn26FaHLYBVlmA
-		var pL25wa2HlFiZjpUjv00 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(70))
-		_ = pL25wa2HlFiZjpUjv00
-		var jxT5r6zS8pIPHkWM1qcSje tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(71))
-		_ = jxT5r6zS8pIPHkWM1qcSje
-		var mz4NalYEJpSDSylMyMWsA4KMiAT6NxI4GqTk8KqhAuBqx4MfDyVEw1j2l9JfB7WDeC9Tt7w2AKXsaTRG15uoaoF3Nsk tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(72))
-		_ = mz4NalYEJpSDSylMyMWsA4KMiAT6NxI4GqTk8KqhAuBqx4MfDyVEw1j2l9JfB7WDeC9Tt7w2AKXsaTRG15uoaoF3Nsk
-		var hhjpozL5792SYbOhatWEA7wbfMHgq07cBpgeq2Uv5cuz8lL4nJ2MmfNosM4dPvO tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(73))
-		_ = hhjpozL5792SYbOhatWEA7wbfMHgq07cBpgeq2Uv5cuz8lL4nJ2MmfNosM4dPvO
-		var tX1XLTTnft460ppN tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(74))
-		_ = tX1XLTTnft460ppN
-		var e tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(75))
-		_ = e
-		var t9aMaFibK1tlkCbGxyUcSIQ8ur2fcdGXCVQlUZ3ByHRtNJMusam5kFGTu tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(76))
-		_ = t9aMaFibK1tlkCbGxyUcSIQ8ur2fcdGXCVQlUZ3ByHRtNJMusam5kFGTu
-		var sHHNQe8qgrlR7B9uRDru3D tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(77))
-		_ = sHHNQe8qgrlR7B9uRDru3D
-		var noBLCLFjLNfYpNVney3NI5ELR9NiCoG1NC95WUgXNql2C3uf3nm3AfjrtRd3EzJCnTMF6CazWMhrT1VEAGa0ZjrkZU9hnKeP tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(78))
-		_ = noBLCLFjLNfYpNVney3NI5ELR9NiCoG1NC95WUgXNql2C3uf3nm3AfjrtRd3EzJCnTMF6CazWMhrT1VEAGa0ZjrkZU9hnKeP
-		var i0yLReUUPcRXM2nHgrFWdb3tu4tbfNF9utSUn5urbOKktRgAIGfisfy9wni3V2QVpDGlrSwDqK73XPOhYZuLEJ tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(79))
-		_ = i0yLReUUPcRXM2nHgrFWdb3tu4tbfNF9utSUn5urbOKktRgAIGfisfy9wni3V2QVpDGlrSwDqK73XPOhYZuLEJ
-		var v7WDS7D0Asy4gggWVjoDCg1TYfKZZ6xPTLV6tbKMhBOhxq5qqoBlDhRQLTcLDYdNlk tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(80))
-		_ = v7WDS7D0Asy4gggWVjoDCg1TYfKZZ6xPTLV6tbKMhBOhxq5qqoBlDhRQLTcLDYdNlk
-		var oR3RvtjYHGIlTqE5iuwri3poF5QMU9uv5oAnF6bp7tafQJyvCC4kOkjTjvil1iPS tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(81))
-		_ = oR3RvtjYHGIlTqE5iuwri3poF5QMU9uv5oAnF6bp7tafQJyvCC4kOkjTjvil1iPS
-		var pHcnP0YYWa0i5MobPSWsGCPZKqMaCQV0sGoRMpXRqdTJgzMoYVYm5yqcTi0H0lFjo0Ny7OzlBWDtBmM7oX tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(82))
-		_ = pHcnP0YYWa0i5MobPSWsGCPZKqMaCQV0sGoRMpXRqdTJgzMoYVYm5yqcTi0H0lFjo0Ny7OzlBWDtBmM7oX
-		var tM6lLFnDkCLrBYxLRIcH9nFLmErv5pxlvFlSWOE4I3zDK5OIWIxs1DOp4JP6i64WwdL6uU5gTgfSIHK0Yty8MsfmOK4k58J8ViVB4yvkh320FGkYDojT tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(83))
-		_ = tM6lLFnDkCLrBYxLRIcH9nFLmErv5pxlvFlSWOE4I3zDK5OIWIxs1DOp4JP6i64WwdL6uU5gTgfSIHK0Yty8MsfmOK4k58J8ViVB4yvkh320FGkYDojT
-		var lP52N22FlaSMD8t5esKZIHHkp5rUgjqwL7DR59zBIF5uUklPD2dhpTRhanQa1Vm8MHQRlrmnXsUn2oAb1mPLQLjeMRYZwy tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(84))
-		_ = lP52N22FlaSMD8t5esKZIHHkp5rUgjqwL7DR59zBIF5uUklPD2dhpTRhanQa1Vm8MHQRlrmnXsUn2oAb1mPLQLjeMRYZwy
-		var xDvXYYCkOwNg57 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(85))
-		_ = xDvXYYCkOwNg57
-		var akl5Dks3ctrKj3h7YukzwDX0SLOTZrW tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(86))
-		_ = akl5Dks3ctrKj3h7YukzwDX0SLOTZrW
-		var d4wNzZGpOMK tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(87))
-		_ = d4wNzZGpOMK
-		var ha8qMdi8HkZFnIaCdfkKX7Inj tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(88))
-		_ = ha8qMdi8HkZFnIaCdfkKX7Inj
-		var sN1WU5MRkGQnF9tdlSqVAxs6LmVvtPK7RvwZ2O6CIwFNYCJqipTcqtl3dl2JRw8Y tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(89))
-		_ = sN1WU5MRkGQnF9tdlSqVAxs6LmVvtPK7RvwZ2O6CIwFNYCJqipTcqtl3dl2JRw8Y
-		var hekB4NOymzpTl2en8ijXe2QGIcwTYOaY8IVWGjrOCVj6Gt0mEmA62waj3GEg tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(90))
-		_ = hekB4NOymzpTl2en8ijXe2QGIcwTYOaY8IVWGjrOCVj6Gt0mEmA62waj3GEg
-		var cAfltU1qEbnZT7VGxOO8J4N5EK4gbe0FtHzg37bfquF8RByYx4Wp1z0W8yVtUEMhFmEXYBKFjMDnnmt8ScsdU3iJrxQ tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(91))
-		_ = cAfltU1qEbnZT7VGxOO8J4N5EK4gbe0FtHzg37bfquF8RByYx4Wp1z0W8yVtUEMhFmEXYBKFjMDnnmt8ScsdU3iJrxQ
-		var hY4W2ZRqgGUZ6xKw28xYEC3HEdVpc2eSL2klMfmWD34CujHUvNSTKd2 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(92))
-		_ = hY4W2ZRqgGUZ6xKw28xYEC3HEdVpc2eSL2klMfmWD34CujHUvNSTKd2
-		var t6HUBcO1CSZiUjI tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(93))
-		_ = t6HUBcO1CSZiUjI
-		var vw4CkbUUyRtxOdyh2QRI792vnv2XjqooFRcxMiOh4Y5luzfz9c3621vC5whx3hALYBU tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(94))
-		_ = vw4CkbUUyRtxOdyh2QRI792vnv2XjqooFRcxMiOh4Y5luzfz9c3621vC5whx3hALYBU
-		var acwEWlB2MhKTsdnEGevebAQdgPgWIlVkuQO0oCv3pavCT4jFYN9FuR530Id5EQqfjJEtkYvjFf32Ac74jbNdz3PgYnzQw81kd53CmPcgCemsg3yil tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(95))
-		_ = acwEWlB2MhKTsdnEGevebAQdgPgWIlVkuQO0oCv3pavCT4jFYN9FuR530Id5EQqfjJEtkYvjFf32Ac74jbNdz3PgYnzQw81kd53CmPcgCemsg3yil
-		var gzIkXtbo3H7JgHPYIJKdNE58lMdJhIgROEDfur3rnH1Xpqtqtr7xZiwgkX9dMR7hmL4e5UAKKLHX4198TWEICTdjSEFRaGVVuhzMb5qvNF9eE tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(96))
-		_ = gzIkXtbo3H7JgHPYIJKdNE58lMdJhIgROEDfur3rnH1Xpqtqtr7xZiwgkX9dMR7hmL4e5UAKKLHX4198TWEICTdjSEFRaGVVuhzMb5qvNF9eE
-		var jhVt4A4uYHqLSfA0jfJZegQEqo20wgvNOU9IEFjoAtBmVVf5ghevcVqSdCP5alx tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(97))
-		_ = jhVt4A4uYHqLSfA0jfJZegQEqo20wgvNOU9IEFjoAtBmVVf5ghevcVqSdCP5alx
-		var oN4oa21whYFm80wv7FqqllOleE60lWjJQuvuqeZeBaIUeOfnRQD3BsSEC6PJhQEKgZmcA39r5r0hyJl2V tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(98))
-		_ = oN4oa21whYFm80wv7FqqllOleE60lWjJQuvuqeZeBaIUeOfnRQD3BsSEC6PJhQEKgZmcA39r5r0hyJl2V
-		var m tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(99))
-		_ = m
-		var mVuGApmAkeqeaONPjiYXjYwIHpliIoz0ngSmGKsBicgoX4ZNYacxVj tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(100))
-		_ = mVuGApmAkeqeaONPjiYXjYwIHpliIoz0ngSmGKsBicgoX4ZNYacxVj
-		var t1l8xFbevbYDYD7b3msZzmGqC76gb0ciBRtssFZDCDHRytV tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(101))
-		_ = t1l8xFbevbYDYD7b3msZzmGqC76gb0ciBRtssFZDCDHRytV
-		var aKOuhdDAK0wxIER tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(102))
-		_ = aKOuhdDAK0wxIER
-		var fgz19tsiZKI2BwG4G08tVrys9iVigGhAdXkeE tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(103))
-		_ = fgz19tsiZKI2BwG4G08tVrys9iVigGhAdXkeE
-		var u42m6 tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(104))
-		_ = u42m6
-		var bnJICnFGUBv7h tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(105))
-		_ = bnJICnFGUBv7h
-		var te6GUSAlDbXqoxNqQC6N8F2OOCMQAbJcW2zpzWyKEFyJo3ekpopBWn0TNOcBm7xKrpF3UPXqeff74t8Y6bScZYwqzHWRyS4qE tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(106))
-		_ = te6GUSAlDbXqoxNqQC6N8F2OOCMQAbJcW2zpzWyKEFyJo3ekpopBWn0TNOcBm7xKrpF3UPXqeff74t8Y6bScZYwqzHWRyS4qE
-		var yhxjl4qcuu7hXEyhva4blPVE3QtwsY2r1uhR3lpdO5UAhgqVxbQUhkPGDtbqcb0qip7kEArJP6V95ckFVPmQYMzxcgbFs tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(107))
-		_ = yhxjl4qcuu7hXEyhva4blPVE3QtwsY2r1uhR3lpdO5UAhgqVxbQUhkPGDtbqcb0qip7kEArJP6V95ckFVPmQYMzxcgbFs
-		var pY1D4u6EHqfl970yXrE3DKXnEN9xxtLBuPqVQnl tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(108))
-		_ = pY1D4u6EHqfl970yXrE3DKXnEN9xxtLBuPqVQnl
-		var ekAU4zDRWUqnzAOKcRLmN6tDgXaBtXffZjrc7lqmI82QgSygqhMYjaHGDN8TZ21bIMJzZuPSTEd55MVHCLId5czQGBr tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(109))
-		_ = ekAU4zDRWUqnzAOKcRLmN6tDgXaBtXffZjrc7lqmI82QgSygqhMYjaHGDN8TZ21bIMJzZuPSTEd55MVHCLId5czQGBr
-		var lhHqXinEAJ7m6zgTdg4xjBLTz448zkE3IUwdlz9LCLoqBomJlPU5rE1KKncXigFmHWm6TsGYzqgpcemODayBxJp8UmsyirHrP tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(110))
-		_ = lhHqXinEAJ7m6zgTdg4xjBLTz448zkE3IUwdlz9LCLoqBomJlPU5rE1KKncXigFmHWm6TsGYzqgpcemODayBxJp8UmsyirHrP
-		var sTP979YCAaiYZ0X8hHYXziqQg0fG7ufxcWoQX3PvhZiQJTRrj34T tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(111))
-		_ = sTP979YCAaiYZ0X8hHYXziqQg0fG7ufxcWoQX3PvhZiQJTRrj34T
-		var iVr tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(112))
-		_ = iVr
-		var fGuFtW5imhaTKaFAoHHnoAjGPd3cd tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(113))
-		_ = fGuFtW5imhaTKaFAoHHnoAjGPd3cd
-		var iQAoyH7xQTmTyvutz99yiDkdW17wy0p0UkM9tClnyfCwFVfXyZ8ykSxTJyny6sHOQdSQHtGspLE8w0AbGXnkMjf8Qs9YrtR86vFr8tDcyB tla.TLAValue = args8[3].ApplyFunction(tla.MakeTLANumber(114))
-		_ = iQAoyH7xQTmTyvutz99yiDkdW17wy0p0UkM9tClnyfCwFVfXyZ8ykSxTJyny6sHOQdSQHtGspLE8w0AbGXnkMjf8Qs9YrtR86vFr8tDcyB
-		var nghbg6dp54IHdkATnyFDqhyD7RkJD3VsvpJ0RvZdxkgbTwxHcyPyNtsoVo3bWvBNhPnfi76ZDX5EYF8tSPhNL tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(1))
-		_ = nghbg6dp54IHdkATnyFDqhyD7RkJD3VsvpJ0RvZdxkgbTwxHcyPyNtsoVo3bWvBNhPnfi76ZDX5EYF8tSPhNL
-		var uYElYRh8Us7CWxUYIHrj1Bsv39GpxaB7ZSlyjII5LYSBN3yk tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(2))
-		_ = uYElYRh8Us7CWxUYIHrj1Bsv39GpxaB7ZSlyjII5LYSBN3yk
-		var p0J2xoHWWuEMwzXyG4rHW3cggeKWUGxwOd4mfF9HWLPo5RJSD1tpgNOKVnyCCQwxiGpZvx54iJOHwIKPqjDPRLhooUyzX tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(3))
-		_ = p0J2xoHWWuEMwzXyG4rHW3cggeKWUGxwOd4mfF9HWLPo5RJSD1tpgNOKVnyCCQwxiGpZvx54iJOHwIKPqjDPRLhooUyzX
-		var rIL3VaKXsqSYTq8wMjaYx9oUSwGAuRio3Jt15eHc tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(4))
-		_ = rIL3VaKXsqSYTq8wMjaYx9oUSwGAuRio3Jt15eHc
-		var eLeKq8B2Z8zMAmAdahUhPNJXC16ETOprUXBA5uUJBD tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(5))
-		_ = eLeKq8B2Z8zMAmAdahUhPNJXC16ETOprUXBA5uUJBD
-		var maZXYK2ecLSflBdcV8v1ORf57gXHtyTY0E4JtRbR65zvF7eplLC tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(6))
-		_ = maZXYK2ecLSflBdcV8v1ORf57gXHtyTY0E4JtRbR65zvF7eplLC
-		var jZ471eWPzrlu0iwqFDduMfnr9874RZ90MSKI6qxTbRdMw1sTkEk1yk0eKQ3iW0PywyQ0 tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(7))
-		_ = jZ471eWPzrlu0iwqFDduMfnr9874RZ90MSKI6qxTbRdMw1sTkEk1yk0eKQ3iW0PywyQ0
-		var cNQBsEl4sxU77NuhXKQQqzyC4aude0OMooGjgmABdhhEHO2s9zOXjoQygLdhGtJOkP8mpfx92fOZuHdDauujOh9A tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(8))
-		_ = cNQBsEl4sxU77NuhXKQQqzyC4aude0OMooGjgmABdhhEHO2s9zOXjoQygLdhGtJOkP8mpfx92fOZuHdDauujOh9A
-		var pTlz1MQxM41mkVXq8hVhRGsjXHYZUpFJRmMKhuGhP5Cm5EwJaYWSxWd8GggE6hPheTo9VZr2vR9uNd8PO8 tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(9))
-		_ = pTlz1MQxM41mkVXq8hVhRGsjXHYZUpFJRmMKhuGhP5Cm5EwJaYWSxWd8GggE6hPheTo9VZr2vR9uNd8PO8
-		var opiETaFb5nJEva1Yh9gC4ZdcROtY2kzZp tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(10))
-		_ = opiETaFb5nJEva1Yh9gC4ZdcROtY2kzZp
-		var b1 tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(11))
-		_ = b1
-		var jx0S0Lc5eCgFyUjhMEqQLPwxJ9lGatpMKa1tYXgXylcpzDTg1NMZOKNBnz95dluZSZbCgP9VsANj5OJPEb12daTeTDBWr0 tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(12))
-		_ = jx0S0Lc5eCgFyUjhMEqQLPwxJ9lGatpMKa1tYXgXylcpzDTg1NMZOKNBnz95dluZSZbCgP9VsANj5OJPEb12daTeTDBWr0
-		var eRDj66Urr0kHo5IUL07zojWbwmh7yHQuLAdvBMRi7ckUr4R8Dd9x74qw9aMgZ4RY tla.TLAValue = args8[4].ApplyFunction(tla.MakeTLANumber(13))
-		_ = eRDj66Urr0kHo5IUL07zojWbwmh7yHQuLAdvBMRi7ckUr4R8Dd9x74qw9aMgZ4RY
-		var jHCJuRpvLlP2XWPna4KJXtBUDmBLPBPI tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(1))
-		_ = jHCJuRpvLlP2XWPna4KJXtBUDmBLPBPI
-		var sjkow0o6ArFQDmkpwTbgsZG6ygpdxi8akbjEZeHPpnRHHBDrFjUH9sajamY4KI0hnh8Kk6k tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(2))
-		_ = sjkow0o6ArFQDmkpwTbgsZG6ygpdxi8akbjEZeHPpnRHHBDrFjUH9sajamY4KI0hnh8Kk6k
-		var rIDP2bqRc3A0xjwpbq13TNCKutRyb2MGl9c8oi3I2GZ8ybNDXI8zyCibj9Z8lTZG1bWMEVLJoyU4a13mYuU9Ho4Nhb1Ld5WB tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(3))
-		_ = rIDP2bqRc3A0xjwpbq13TNCKutRyb2MGl9c8oi3I2GZ8ybNDXI8zyCibj9Z8lTZG1bWMEVLJoyU4a13mYuU9Ho4Nhb1Ld5WB
-		var ifzK1T19yxR8Q2OkB8w5TS tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(4))
-		_ = ifzK1T19yxR8Q2OkB8w5TS
-		var xfdqXpiAaoeiKF1z1WD5CBPhPZT374WFKWTAf tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(5))
-		_ = xfdqXpiAaoeiKF1z1WD5CBPhPZT374WFKWTAf
-		var dEuIljNP2b4VhbQMKlrCL tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(6))
-		_ = dEuIljNP2b4VhbQMKlrCL
-		var lGa5ZpUL70V1rZklDRIwA3UoMV7Lpm6Il0lPJDav2SPN4MBds4cXXkJlkhVLWciMup4Kq5aiz4gwVV19aHgT7XCDFTsG3tNOy3wd4Sl tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(7))
-		_ = lGa5ZpUL70V1rZklDRIwA3UoMV7Lpm6Il0lPJDav2SPN4MBds4cXXkJlkhVLWciMup4Kq5aiz4gwVV19aHgT7XCDFTsG3tNOy3wd4Sl
-		var l0xNLcyud4OX0CzdQdeSkCfYrzprRjch9IbHun9QZ6pHFRT tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(8))
-		_ = l0xNLcyud4OX0CzdQdeSkCfYrzprRjch9IbHun9QZ6pHFRT
-		var sjnc9Z57XD1wlcG0o29WZyY3abg26rZqRS2nQ2LhiKAr1vEejFmzEmHnvFVjDFUm6q tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(9))
-		_ = sjnc9Z57XD1wlcG0o29WZyY3abg26rZqRS2nQ2LhiKAr1vEejFmzEmHnvFVjDFUm6q
-		var m7kWa1xpnuL7GChtKEKpvUXwU8X93EAPnzZQKtzshJWzjaF2yfhbrmNF6lrixclpc6ySm5XTKBc92pFj34nCtyYKB3jxFUJOpbq51YKEPM6lz tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(10))
-		_ = m7kWa1xpnuL7GChtKEKpvUXwU8X93EAPnzZQKtzshJWzjaF2yfhbrmNF6lrixclpc6ySm5XTKBc92pFj34nCtyYKB3jxFUJOpbq51YKEPM6lz
-		var i0vZZrp0EHBoUP7xCqepbg8Tef0wEjk2XZYqVlZgRE9SRI4rw8AHDolApbenP tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(11))
-		_ = i0vZZrp0EHBoUP7xCqepbg8Tef0wEjk2XZYqVlZgRE9SRI4rw8AHDolApbenP
-		var mZRCbbqhph8PV9bp6GWiXIOzMUBagM tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(12))
-		_ = mZRCbbqhph8PV9bp6GWiXIOzMUBagM
-		var d35vqopBq91c9r4k4vVn3zN tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(13))
-		_ = d35vqopBq91c9r4k4vVn3zN
-		var nsCGq7i4LGAIn9CqSEvJbUaVg6mDAoIBY4ENvkL4tjnkp6QUXe3fDd8oMGSczvoC6U2tsQP7ubGmCNHFOJcfF9HF tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(14))
-		_ = nsCGq7i4LGAIn9CqSEvJbUaVg6mDAoIBY4ENvkL4tjnkp6QUXe3fDd8oMGSczvoC6U2tsQP7ubGmCNHFOJcfF9HF
-		var pCby2iPey6XbkoZNW38ml5P96K9z64cRxnsqBUUbh9Yt6rq09u3O8fLlPdLkSi5owlAEfaYOjyeaM2kZsfRwLUIiixCMEYBXv tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(15))
-		_ = pCby2iPey6XbkoZNW38ml5P96K9z64cRxnsqBUUbh9Yt6rq09u3O8fLlPdLkSi5owlAEfaYOjyeaM2kZsfRwLUIiixCMEYBXv
-		var eWwzs4iwMxN8g0XVp7y3m62L4KAWyMcJlixJhb2kp07Y61TpGwzCUTr4466jnFC4Fakg12ysTq3vt8L7O3OE1kl8gi7Mjm tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(16))
-		_ = eWwzs4iwMxN8g0XVp7y3m62L4KAWyMcJlixJhb2kp07Y61TpGwzCUTr4466jnFC4Fakg12ysTq3vt8L7O3OE1kl8gi7Mjm
-		var uYVxpXjcFvXHEeObGcHL0NhWchmpTzQI05TvoTIwGoxPNozlxZvfW4cd5IAhVpSJKy tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(17))
-		_ = uYVxpXjcFvXHEeObGcHL0NhWchmpTzQI05TvoTIwGoxPNozlxZvfW4cd5IAhVpSJKy
-		var pAfpgBx4lPajChoPENrTCbSk9scUZiEp2kDupsoiZdsBcB94rwvfRZOFMA tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(18))
-		_ = pAfpgBx4lPajChoPENrTCbSk9scUZiEp2kDupsoiZdsBcB94rwvfRZOFMA
-		var xCo2eUJXSgqC1q2zYnJYZ6KwpLYi7uTKdxDBR941JgQDcXjfeZl tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(19))
-		_ = xCo2eUJXSgqC1q2zYnJYZ6KwpLYi7uTKdxDBR941JgQDcXjfeZl
-		var vDL1OiV tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(20))
-		_ = vDL1OiV
-		var mbhhmiDsTEHMLWvv93GyovUTmnPoPlIy2s56iHib8Dn9QlqJiWrdmCmkaIXcBsJQwOIm2ThUX4tPOzqeJCxdwmmkVajC9v3T tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(21))
-		_ = mbhhmiDsTEHMLWvv93GyovUTmnPoPlIy2s56iHib8Dn9QlqJiWrdmCmkaIXcBsJQwOIm2ThUX4tPOzqeJCxdwmmkVajC9v3T
-		var jFY2R54QeauyHVWpnemZ1CN9G2pILgeY2sxuFxqdBigN9UQCmkjeczbFKkMJSU3Tdq6m011oQW tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(22))
-		_ = jFY2R54QeauyHVWpnemZ1CN9G2pILgeY2sxuFxqdBigN9UQCmkjeczbFKkMJSU3Tdq6m011oQW
-		var zoGRR00PrajULFuD72JL8ZgKHO0aJWlJfMtlY tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(23))
-		_ = zoGRR00PrajULFuD72JL8ZgKHO0aJWlJfMtlY
-		var yy5du8I9blKTUxSxhPPqufIzKW7OxC9kf59ETSd tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(24))
-		_ = yy5du8I9blKTUxSxhPPqufIzKW7OxC9kf59ETSd
-		var s7LwiU06 tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(25))
-		_ = s7LwiU06
-		var dm tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(26))
-		_ = dm
-		var q7G7iIUDhARKfIyeRtXTQpySmg8vuzfkhzGEcWAuE32eSQIV5GZKwSLODQTw3p1Iz0vnwltb2gXQmIWk5upw tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(27))
-		_ = q7G7iIUDhARKfIyeRtXTQpySmg8vuzfkhzGEcWAuE32eSQIV5GZKwSLODQTw3p1Iz0vnwltb2gXQmIWk5upw
-		var b7woXaQt5UKYKw3M2anyqRJwHpB2Rw8jB1tBiLvDG5bqajEMPhihdYh2KxFkmBNI tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(28))
-		_ = b7woXaQt5UKYKw3M2anyqRJwHpB2Rw8jB1tBiLvDG5bqajEMPhihdYh2KxFkmBNI
-		var qGMfQzAU2fN6vCyQs18zRjX1pxjPiLksC tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(29))
-		_ = qGMfQzAU2fN6vCyQs18zRjX1pxjPiLksC
-		var udHap4MoGBwMbWe3wfkwHtySrtHxLY7fUmyjYtOczUnO4wn09ksrx tla.TLAValue = args8[5].ApplyFunction(tla.MakeTLANumber(30))
-		_ = udHap4MoGBwMbWe3wfkwHtySrtHxLY7fUmyjYtOczUnO4wn09ksrx
-		return qxbM0ySqkdzWhn6gaROcgBgxFwsaY7adHaF3zhWU3qvyZMiXDYvhlbWBjZSc6nCHr8DLrsWqkB1ZScZHg8LjT4uJqajwOdLZpq4pEzU6a6Gc
-	}), tla.MakeTLASet(tla.MakeTLAString("w5:3;Z'1<OF377`;e7=u:'~4.`#h8L|?"), tla.TLA_NegationSymbol(tla.MakeTLARecordSet([]tla.TLARecordField{
-		{tla.MakeTLAString("bIomrUoR"), tla.TLA_Zero},
-	})))}, func(args1 []tla.TLAValue) bool {
-		var o0nT5iaxQ0CGr tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(1))
-		_ = o0nT5iaxQ0CGr
-		var a1FO5HvdyoLWp tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(2))
-		_ = a1FO5HvdyoLWp
-		var uXXYnplomNiSbQyMwj71XmbnXjoqCn7xaeTFp3Y0nzrExBsLGbUwrUpo0J9RVYgX6fWdxm8Awun9t8IfyExFH8hVcZQbKQF tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(3))
-		_ = uXXYnplomNiSbQyMwj71XmbnXjoqCn7xaeTFp3Y0nzrExBsLGbUwrUpo0J9RVYgX6fWdxm8Awun9t8IfyExFH8hVcZQbKQF
-		var bYS4kgzZ4nOkgmotsOdGI tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(4))
-		_ = bYS4kgzZ4nOkgmotsOdGI
-		var eRNekFDZ2hFOjF1mOSbQdtgKf4uATvC11401awr5iaVqflx tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(5))
-		_ = eRNekFDZ2hFOjF1mOSbQdtgKf4uATvC11401awr5iaVqflx
-		var y3IVYD3rstSzgTRUSRywWo87sY tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(6))
-		_ = y3IVYD3rstSzgTRUSRywWo87sY
-		var rv7FErcM1Ds7ASPvuKALqKp1pSCTYmAPkNHHc82cm8BtU3Jzkd5KJpJv3NvxBZ7xe81MfPb09Do4ysrf9c1Jd0khQy tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(7))
-		_ = rv7FErcM1Ds7ASPvuKALqKp1pSCTYmAPkNHHc82cm8BtU3Jzkd5KJpJv3NvxBZ7xe81MfPb09Do4ysrf9c1Jd0khQy
-		var u8ACUTegZQwWN6S0BtW0nCaWyf1MM9sD8AZ7kZ4JQ3 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(8))
-		_ = u8ACUTegZQwWN6S0BtW0nCaWyf1MM9sD8AZ7kZ4JQ3
-		var bWr1vUOkonrb3tl4FpMfCqrq17bzS8yHO8ezMPwI8JHFToHhbFYg2Bo29VhAl4okZ3E2tdbAPafGEs9XQIaXCisjluhFOKXJ tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(9))
-		_ = bWr1vUOkonrb3tl4FpMfCqrq17bzS8yHO8ezMPwI8JHFToHhbFYg2Bo29VhAl4okZ3E2tdbAPafGEs9XQIaXCisjluhFOKXJ
-		var anqLfjgzbrCMXYRJxAXXkPO5OvOiC6Wu8j0 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(10))
-		_ = anqLfjgzbrCMXYRJxAXXkPO5OvOiC6Wu8j0
-		var fxIAZeemBplRLwdQ93dWhFqWhqDu tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(11))
-		_ = fxIAZeemBplRLwdQ93dWhFqWhqDu
-		var yw8m0iZw5MrZwUc9VYfhH9Z8S0zHTLIBHO2QvhuPbF18SqKXdOlFoEIHcz1bqiaEm9VBGKC1546WRwKPoBIjToq0ZDWTcMPw3ACt677DPBJLv tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(12))
-		_ = yw8m0iZw5MrZwUc9VYfhH9Z8S0zHTLIBHO2QvhuPbF18SqKXdOlFoEIHcz1bqiaEm9VBGKC1546WRwKPoBIjToq0ZDWTcMPw3ACt677DPBJLv
-		var nfmF2C4O0nKx1tnuC5oj4yUD9B3MOl01HidhOWzjK0QIGaGStCmskWJFoAKUofP tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(13))
-		_ = nfmF2C4O0nKx1tnuC5oj4yUD9B3MOl01HidhOWzjK0QIGaGStCmskWJFoAKUofP
-		var ut9t7O8avTWOkmc3UnpCqSq tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(14))
-		_ = ut9t7O8avTWOkmc3UnpCqSq
-		var dfpyaWZLUhq2ceW0gvIq4v5isU1jdrDa4hWBgQiF1r2R8uj3N1dYxt9L6zPUH935Rv9WNrDAwElgKeHhcIsHCjEynvhUN7eeQd5obWJLiabzUGIC tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(15))
-		_ = dfpyaWZLUhq2ceW0gvIq4v5isU1jdrDa4hWBgQiF1r2R8uj3N1dYxt9L6zPUH935Rv9WNrDAwElgKeHhcIsHCjEynvhUN7eeQd5obWJLiabzUGIC
-		var utW6miQ1zMgzcDXclCo5d3MSBcxMTsk tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(16))
-		_ = utW6miQ1zMgzcDXclCo5d3MSBcxMTsk
-		var bFtRNuQMtk2fC0WxOCr6AfSmf8plsWY8IpZNUTpKivVZ8zAvU7kE1088gAlKS4CN4YRn7jtCeqemEKctduaM9qVure0WC8VYzGfzEUy4ORF5 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(17))
-		_ = bFtRNuQMtk2fC0WxOCr6AfSmf8plsWY8IpZNUTpKivVZ8zAvU7kE1088gAlKS4CN4YRn7jtCeqemEKctduaM9qVure0WC8VYzGfzEUy4ORF5
-		var iNPnZqZGk3kdG6ja0lJrRMPkrKzlrs3QwSPbmzmneJUNgkUdUXmV9tGqGjq tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(18))
-		_ = iNPnZqZGk3kdG6ja0lJrRMPkrKzlrs3QwSPbmzmneJUNgkUdUXmV9tGqGjq
-		var ytNWqLDRimBiRxTurtIGZkrJiCoNkrRWoqr733yZLDf7h9ouxLJ6U25aXlJoYn1HMvNsF7VBux3PmIWWu3JAX8qWFs5LaQOcyi tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(19))
-		_ = ytNWqLDRimBiRxTurtIGZkrJiCoNkrRWoqr733yZLDf7h9ouxLJ6U25aXlJoYn1HMvNsF7VBux3PmIWWu3JAX8qWFs5LaQOcyi
-		var b9EB03SEP4AYw7Rg1vnalvtiLJ9CzGUGHRsthkyWNOBUf3GjUr6pjgtHeG1Y7RttL1JD0in5KDQvJj05pVr5Y6g14AWr tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(20))
-		_ = b9EB03SEP4AYw7Rg1vnalvtiLJ9CzGUGHRsthkyWNOBUf3GjUr6pjgtHeG1Y7RttL1JD0in5KDQvJj05pVr5Y6g14AWr
-		var quMjqujYWsLRZyV1FQQQZK960gsSk3LrpKrYnEzqPHJnJOcWSQkXYVtokuixa35ksIn0tiQW6OghKr0FAtafO7WlFIsHysFECoWg9iQ tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(21))
-		_ = quMjqujYWsLRZyV1FQQQZK960gsSk3LrpKrYnEzqPHJnJOcWSQkXYVtokuixa35ksIn0tiQW6OghKr0FAtafO7WlFIsHysFECoWg9iQ
-		var f0Dxz1BR1eZHEIo9p84reNf9wryYJ4W0CeGSMpNeiNdt1GtrI2Xj3LuP44PQdxAZ9ZADqaaZZAuA120o tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(22))
-		_ = f0Dxz1BR1eZHEIo9p84reNf9wryYJ4W0CeGSMpNeiNdt1GtrI2Xj3LuP44PQdxAZ9ZADqaaZZAuA120o
-		var xPVKxOhU8hY2dNrzlsAUMCcKMNoA21ooC0IIAO6lLa1 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(23))
-		_ = xPVKxOhU8hY2dNrzlsAUMCcKMNoA21ooC0IIAO6lLa1
-		var fi4AzNL5brxCQkWdOnKlZ7b8TNNA1XiKfChWu0jnsTEDBSKprQg0IOyxO4TFjsJ6WPzVy tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(24))
-		_ = fi4AzNL5brxCQkWdOnKlZ7b8TNNA1XiKfChWu0jnsTEDBSKprQg0IOyxO4TFjsJ6WPzVy
-		var wfnvSs9SSS5CmZtsSaipMtZ2SsMMZoWP1Ki4dOEcOgoolLpwxMlDAbOBwpeEPGQEUebCwORUkx8S2pAZtPdjkj558jlgw6GCN20N20KSIEg tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(25))
-		_ = wfnvSs9SSS5CmZtsSaipMtZ2SsMMZoWP1Ki4dOEcOgoolLpwxMlDAbOBwpeEPGQEUebCwORUkx8S2pAZtPdjkj558jlgw6GCN20N20KSIEg
-		var z7WCvf55SPTgNnAopaQ tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(26))
-		_ = z7WCvf55SPTgNnAopaQ
-		var uCP7CBRbK0oHUYOBZarsmc7kXFwxz3TfJaJc1lld2oeToHRWzMd87Ay3AR2rvltrfkUZJwhcgzadXoOhOIvfzHub42HcusniVd1PaG tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(27))
-		_ = uCP7CBRbK0oHUYOBZarsmc7kXFwxz3TfJaJc1lld2oeToHRWzMd87Ay3AR2rvltrfkUZJwhcgzadXoOhOIvfzHub42HcusniVd1PaG
-		var utuVgQy0vnqxIwLuyl0n1QhGGA9QHD5OV5CkQKVLUqKrjW5cGlrD8xUEqf0RVjfyIzpyNFbNYG3SH9Gh tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(28))
-		_ = utuVgQy0vnqxIwLuyl0n1QhGGA9QHD5OV5CkQKVLUqKrjW5cGlrD8xUEqf0RVjfyIzpyNFbNYG3SH9Gh
-		var akW3VdCrNO4EoUwJ0uE0kRFSg1JbmUMDNAmelfxdwKHDAPVTSFCRmyutl71odcQI3sQEjWI2JsG1sFTmdoIPjAkuH74qAoRdKJDHNVwRP44Mukgb tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(29))
-		_ = akW3VdCrNO4EoUwJ0uE0kRFSg1JbmUMDNAmelfxdwKHDAPVTSFCRmyutl71odcQI3sQEjWI2JsG1sFTmdoIPjAkuH74qAoRdKJDHNVwRP44Mukgb
-		var j tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(30))
-		_ = j
-		var sFOFeZ8d1CXFvB3mgm7vq9tmgA7x8rFZzCAuAd6rGd1 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(31))
-		_ = sFOFeZ8d1CXFvB3mgm7vq9tmgA7x8rFZzCAuAd6rGd1
-		var mw2mtHgUPBPU tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(32))
-		_ = mw2mtHgUPBPU
-		var sUzbLovWwkSoSywZ9TWkywxpamyWKK7jxsG tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(33))
-		_ = sUzbLovWwkSoSywZ9TWkywxpamyWKK7jxsG
-		var tTTG8NBpo1siq6y4En4TOL5I5APT9QHcsxrAScgWJF9q2QxNp2BXdhtC2OF4SgLYcUTu tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(34))
-		_ = tTTG8NBpo1siq6y4En4TOL5I5APT9QHcsxrAScgWJF9q2QxNp2BXdhtC2OF4SgLYcUTu
-		var edEtfdJxr6Zf3gFBTvwf8BBzgUeKMj5ViZjmoVffuNDDlHXmtTx9ltlq2C5QUL9KYpy8uzCTIjTzNS3qE tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(35))
-		_ = edEtfdJxr6Zf3gFBTvwf8BBzgUeKMj5ViZjmoVffuNDDlHXmtTx9ltlq2C5QUL9KYpy8uzCTIjTzNS3qE
-		var aHFd5VD9O1lMiAYYwt65Fo1EQN tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(36))
-		_ = aHFd5VD9O1lMiAYYwt65Fo1EQN
-		var tdZ4ydcHZRtLdKOhxrn2SVKdkvMFAeCCN tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(37))
-		_ = tdZ4ydcHZRtLdKOhxrn2SVKdkvMFAeCCN
-		var pPgoT6lo9XOVnwJGZ0KP5B60qCzaVI4yC6iNVnrbFJLAxiiyi tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(38))
-		_ = pPgoT6lo9XOVnwJGZ0KP5B60qCzaVI4yC6iNVnrbFJLAxiiyi
-		var zZ3EZEUkUCbJvEzvtX4SK3ZnHYqRvf2TeBWbzhoUH8OG5AYE6bXMS2pnqSrf tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(39))
-		_ = zZ3EZEUkUCbJvEzvtX4SK3ZnHYqRvf2TeBWbzhoUH8OG5AYE6bXMS2pnqSrf
-		var soR tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(40))
-		_ = soR
-		var y5RMfJ7wdJygDDQ0BV3NMN6YBPohRMqzk0Wng8d44eyThvmVieA2XRiWpSRmEiBWzEsLpUVMi7No6gqi33JI7gSNU4D1ajJH tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(41))
-		_ = y5RMfJ7wdJygDDQ0BV3NMN6YBPohRMqzk0Wng8d44eyThvmVieA2XRiWpSRmEiBWzEsLpUVMi7No6gqi33JI7gSNU4D1ajJH
-		var uip3AI0fr61lgoRz0RTr0vks6GIpPkrMgKlcTZndmxNGw2mpX tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(42))
-		_ = uip3AI0fr61lgoRz0RTr0vks6GIpPkrMgKlcTZndmxNGw2mpX
-		var gw0UHAZGKMWqqTJkpbfHAY0fbEa1JNsADyRUks70OBYQaK775WVBR3mGZwLYuyQ8f6wcfBjhVVSWSrKJ68INhuxjMqFzCV9V6JFVztXYknG73KKPjhxL tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(43))
-		_ = gw0UHAZGKMWqqTJkpbfHAY0fbEa1JNsADyRUks70OBYQaK775WVBR3mGZwLYuyQ8f6wcfBjhVVSWSrKJ68INhuxjMqFzCV9V6JFVztXYknG73KKPjhxL
-		var xSUCIL57 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(44))
-		_ = xSUCIL57
-		var wVeDj6P7lyM1XCxTdGU5saFETV2rA6wUHl7D0MOE5wCFu5y7aYH2QexPhkxcDiZaZ9GCV8iFaoL5CmtR7RbpzgEV9vuLAa5iy692jxZQBZfi4sLa tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(45))
-		_ = wVeDj6P7lyM1XCxTdGU5saFETV2rA6wUHl7D0MOE5wCFu5y7aYH2QexPhkxcDiZaZ9GCV8iFaoL5CmtR7RbpzgEV9vuLAa5iy692jxZQBZfi4sLa
-		var kYKhasNW40uMZouSSolAmJvRnUXJblvJoX4R7Y6rRIF9 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(46))
-		_ = kYKhasNW40uMZouSSolAmJvRnUXJblvJoX4R7Y6rRIF9
-		var djJlNxroMSUMDwpGSJukNAdQna6bTtn3cY8l7bIy tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(47))
-		_ = djJlNxroMSUMDwpGSJukNAdQna6bTtn3cY8l7bIy
-		var drqx6dKduJQ8 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(48))
-		_ = drqx6dKduJQ8
-		var bwYXcW5DSIFxFHriKE8MOOPoIcGEStZAYWdqjBb0GZ2rR8PcSJh4OUp8GXsSSiDpYR6hevDuWbzcYDSLCLNahJJ3a8XTqLP7lnj1eoTWO6hqaUPbnpBEPz tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(49))
-		_ = bwYXcW5DSIFxFHriKE8MOOPoIcGEStZAYWdqjBb0GZ2rR8PcSJh4OUp8GXsSSiDpYR6hevDuWbzcYDSLCLNahJJ3a8XTqLP7lnj1eoTWO6hqaUPbnpBEPz
-		var jA6DXEnIIgyvkcEeoMahu7Q46arA5d7ovgwxN6myQFF8w0r3o9hzme7QBUEhvLhVZSblEB4G1xDUXpPyGlaNJwC5GyvwEt922wHqmeoJ2 tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(50))
-		_ = jA6DXEnIIgyvkcEeoMahu7Q46arA5d7ovgwxN6myQFF8w0r3o9hzme7QBUEhvLhVZSblEB4G1xDUXpPyGlaNJwC5GyvwEt922wHqmeoJ2
-		var qRdovI01GePhYFxf3wRNp36TRcxQlJaYTcTs42znV0QJKtqJgo60Jq9SpxdBKJYgWttjH1asJMDRKGZEzjOknZq5rasUYLvK5Z46eG4qdD8KA9aIg10u tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(51))
-		_ = qRdovI01GePhYFxf3wRNp36TRcxQlJaYTcTs42znV0QJKtqJgo60Jq9SpxdBKJYgWttjH1asJMDRKGZEzjOknZq5rasUYLvK5Z46eG4qdD8KA9aIg10u
-		var fE6iVYI tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(52))
-		_ = fE6iVYI
-		var v5tJF9JOdcleYAjDytdP7HyA3oJXuiOiYjQyowuhEPx2aCy9eHAfIr0k03ZORJoEBaw1rPRoB67NaF8jSKql6U1GqoTC3bkGGOL1Y tla.TLAValue = args1[0].ApplyFunction(tla.MakeTLANumber(53))
-		_ = v5tJF9JOdcleYAjDytdP7HyA3oJXuiOiYjQyowuhEPx2aCy9eHAfIr0k03ZORJoEBaw1rPRoB67NaF8jSKql6U1GqoTC3bkGGOL1Y
-		var qYs2vIkhT8Z3FvQwaQKRAh0mbBye9eYY4IUngBjk8d13QezAVGpXvkMpy6uVDrsqxV3 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(1))
-		_ = qYs2vIkhT8Z3FvQwaQKRAh0mbBye9eYY4IUngBjk8d13QezAVGpXvkMpy6uVDrsqxV3
-		var cXigOXzsJ6onTDjH0xpa91KcgUkbyXW7spQFUJFIMw6BxQA2Gh tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(2))
-		_ = cXigOXzsJ6onTDjH0xpa91KcgUkbyXW7spQFUJFIMw6BxQA2Gh
-		var tKUse1q5VKFc7ve6s2Thq1qelXwdNHqZ1pZkRNOkujjyUc tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(3))
-		_ = tKUse1q5VKFc7ve6s2Thq1qelXwdNHqZ1pZkRNOkujjyUc
-		var dThYebRbgUccDhFALwld5ofDj94ZvvUVOdR1W8rLoVYRqm6byibOCvn8nPyOdcdG4b6ZyfT9X8n8l9Zq5FFdHmHGq9MBgD3tV5iuUWn6mscM9Eq1 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(4))
-		_ = dThYebRbgUccDhFALwld5ofDj94ZvvUVOdR1W8rLoVYRqm6byibOCvn8nPyOdcdG4b6ZyfT9X8n8l9Zq5FFdHmHGq9MBgD3tV5iuUWn6mscM9Eq1
-		var bX2vu7a5IevzZocUz4USvOuAVxLHZb0wJQMLjjtr7OQahd41gWeW7ZryG60injznDh3uzNkZ1Ngi2bwHIzs5k8g4PlO9S tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(5))
-		_ = bX2vu7a5IevzZocUz4USvOuAVxLHZb0wJQMLjjtr7OQahd41gWeW7ZryG60injznDh3uzNkZ1Ngi2bwHIzs5k8g4PlO9S
-		var l9PQOsz92pRSKC41UDrDd2NUutmnV45jtK6CdrGP tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(6))
-		_ = l9PQOsz92pRSKC41UDrDd2NUutmnV45jtK6CdrGP
-		var kFp9oClxBIiX1r8XxUlzcPTm1WjDQXtnqQquJUjt0JYkX7bkCoHINp9E6fhUYa5SjEPAW0XgigtDMtoe tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(7))
-		_ = kFp9oClxBIiX1r8XxUlzcPTm1WjDQXtnqQquJUjt0JYkX7bkCoHINp9E6fhUYa5SjEPAW0XgigtDMtoe
-		var qOF2B7i1tUMet7gl tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(8))
-		_ = qOF2B7i1tUMet7gl
-		var uoXcHoxBeGknbqAeW5OItqYksUaYYFwLgmxNVuuNUhhocQkCzEZX tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(9))
-		_ = uoXcHoxBeGknbqAeW5OItqYksUaYYFwLgmxNVuuNUhhocQkCzEZX
-		var yYJlfXjAHuaHgDEwpshBIAJaVnb2WYdTfTIzH4yu67e4FKQ3PMI21DBFLWr0vL tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(10))
-		_ = yYJlfXjAHuaHgDEwpshBIAJaVnb2WYdTfTIzH4yu67e4FKQ3PMI21DBFLWr0vL
-		var t9QSq5lqH9oyzGxMtgX0FJzpMUqPjxMH7rHAnQYzB4LcsP2nUWRHJdwK2ZUeF3s8ONbq5Yv4fkp8tU5pTMH9nBllpTuNzhTJN9ItikYcny4MqnJg7z tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(11))
-		_ = t9QSq5lqH9oyzGxMtgX0FJzpMUqPjxMH7rHAnQYzB4LcsP2nUWRHJdwK2ZUeF3s8ONbq5Yv4fkp8tU5pTMH9nBllpTuNzhTJN9ItikYcny4MqnJg7z
-		var pciPKP8G7tBFMGgGcGTzme7M8L6F tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(12))
-		_ = pciPKP8G7tBFMGgGcGTzme7M8L6F
-		var yPj5H7YUP17cIAEjOZyZajUzR8o4TSyA4R87 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(13))
-		_ = yPj5H7YUP17cIAEjOZyZajUzR8o4TSyA4R87
-		var qvnHpUR7kEdjNmmJzpLjnG4cCDnT8VoMuJyFjUgcq17HvIk67Par0hu0EtMQo9t tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(14))
-		_ = qvnHpUR7kEdjNmmJzpLjnG4cCDnT8VoMuJyFjUgcq17HvIk67Par0hu0EtMQo9t
-		var fiQ7nIZB3XUCfXNjy8sWW6kN tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(15))
-		_ = fiQ7nIZB3XUCfXNjy8sWW6kN
-		var ljR3tFlFqnopZUFowzy5HGQ4M7Rgo2o2zhshq5fbF4niMx2eSdcf8q85KAh1kgRscREBgkZmoxKIY3c9QsjphrivKdwtAawPCAvvv tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(16))
-		_ = ljR3tFlFqnopZUFowzy5HGQ4M7Rgo2o2zhshq5fbF4niMx2eSdcf8q85KAh1kgRscREBgkZmoxKIY3c9QsjphrivKdwtAawPCAvvv
-		var pBHZWQeL0iuW7ilpuNW3TLTYWWA1uleSO1e3fZ97OZtW3nJEw4K0XUJonrlHyvzApOf0aMvCWviNjYQEqnhMVLKH03olEwjBk7xK8JksJRpqXIs8l tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(17))
-		_ = pBHZWQeL0iuW7ilpuNW3TLTYWWA1uleSO1e3fZ97OZtW3nJEw4K0XUJonrlHyvzApOf0aMvCWviNjYQEqnhMVLKH03olEwjBk7xK8JksJRpqXIs8l
-		var rEdNhyE35SeEZAKcQMFoaAICaMUf3yevCQOHY9UBpuCpv3yA7wQRZQrXTkkZqLqEas6yHyJ8B6Oe1v61IL2 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(18))
-		_ = rEdNhyE35SeEZAKcQMFoaAICaMUf3yevCQOHY9UBpuCpv3yA7wQRZQrXTkkZqLqEas6yHyJ8B6Oe1v61IL2
-		var uQILEXvISXLHYQRyNGS1sfju9A3ZRPbUpnahevm4ikMSe1cQU8fmyI0YmVX2KCprGchBTynMN tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(19))
-		_ = uQILEXvISXLHYQRyNGS1sfju9A3ZRPbUpnahevm4ikMSe1cQU8fmyI0YmVX2KCprGchBTynMN
-		var aS3znIiOYr4XP9fO5JNK7oXV8oay7sVkOQZQS5yiA6LTXrygDRCrl2oYItFZSGIyr3WRNVQoqmOIMtkNCc5FpnWDdGsgzXBBoS1y3LOyj0SQAG9znznTN tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(20))
-		_ = aS3znIiOYr4XP9fO5JNK7oXV8oay7sVkOQZQS5yiA6LTXrygDRCrl2oYItFZSGIyr3WRNVQoqmOIMtkNCc5FpnWDdGsgzXBBoS1y3LOyj0SQAG9znznTN
-		var h98v4RmuBEdTElq9in2ms9d06FISjUnVSRVwSdp55G23p2RUSxIFX6IBYiEEa0 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(21))
-		_ = h98v4RmuBEdTElq9in2ms9d06FISjUnVSRVwSdp55G23p2RUSxIFX6IBYiEEa0
-		var rj00YIZZEaTNEk7f4WfiQsQbYDgio0j tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(22))
-		_ = rj00YIZZEaTNEk7f4WfiQsQbYDgio0j
-		var hllU8bWZN9UCEr96xx5m49reoWGkymIODFB2jj62HI2P7572L86eI1YrrYcXeZfWGO8Z3yfT2ZFVEk1KRAQvwc tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(23))
-		_ = hllU8bWZN9UCEr96xx5m49reoWGkymIODFB2jj62HI2P7572L86eI1YrrYcXeZfWGO8Z3yfT2ZFVEk1KRAQvwc
-		var o8gjCvY399mo3k5WjNMjVq6iprRvOewc9qVDx3Yb6Lpu9HEwGDdXBjjwph8OW5x7UMh2B7OpTddyRi9KjeMDI1AvQPmjgT0S tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(24))
-		_ = o8gjCvY399mo3k5WjNMjVq6iprRvOewc9qVDx3Yb6Lpu9HEwGDdXBjjwph8OW5x7UMh2B7OpTddyRi9KjeMDI1AvQPmjgT0S
-		var klL65eTT7xHxIhRZb7SU8J77peG6NsXY8d8gFZPJK7j4htT5vNSaRyGGOPKnW6M5s2oLrZdRtK7xixk6veMP4QROUl5JsIWQRollboKWVLXFRe tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(25))
-		_ = klL65eTT7xHxIhRZb7SU8J77peG6NsXY8d8gFZPJK7j4htT5vNSaRyGGOPKnW6M5s2oLrZdRtK7xixk6veMP4QROUl5JsIWQRollboKWVLXFRe
-		var tccUGSi7jBtegUiaHUpq8Q8AOZk3qw0DqslHVucrHRyHeDtZTLlan18QdJFR tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(26))
-		_ = tccUGSi7jBtegUiaHUpq8Q8AOZk3qw0DqslHVucrHRyHeDtZTLlan18QdJFR
-		var vB6EJjKguxqIDwTZaUQ4uwZ3O8Irg75e8Hoi6HWE31vJ484wNHkhTPZsvlwLqbvpOman4CgN2sU0WrgAYlREbTObEPa6dSJM6tkQ8lVnykbsReALP9fvC0 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(27))
-		_ = vB6EJjKguxqIDwTZaUQ4uwZ3O8Irg75e8Hoi6HWE31vJ484wNHkhTPZsvlwLqbvpOman4CgN2sU0WrgAYlREbTObEPa6dSJM6tkQ8lVnykbsReALP9fvC0
-		var gUPOB0XsHOwSVFSEOLEOowv6mslRbwuc70SU5VIDuJb4LU5JY2lefPw1mL4zlgNo tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(28))
-		_ = gUPOB0XsHOwSVFSEOLEOowv6mslRbwuc70SU5VIDuJb4LU5JY2lefPw1mL4zlgNo
-		var r00tRqtunlUwYYikSFIAvFnS7tkpR3ZhENv1a0W2pJ2AUkjShOMGm9KBpBwX2r0Qp67ySY3fyav8JLrmjU tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(29))
-		_ = r00tRqtunlUwYYikSFIAvFnS7tkpR3ZhENv1a0W2pJ2AUkjShOMGm9KBpBwX2r0Qp67ySY3fyav8JLrmjU
-		var n tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(30))
-		_ = n
-		var h tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(31))
-		_ = h
-		var e0sQykAXllMBO2F7Zcr tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(32))
-		_ = e0sQykAXllMBO2F7Zcr
-		var iB77i0aoGELURf3G tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(33))
-		_ = iB77i0aoGELURf3G
-		var xUnFhbMjFQHevb0OHm0i6i9BRcS739FBQ3K5LAmkrzE32UiDT tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(34))
-		_ = xUnFhbMjFQHevb0OHm0i6i9BRcS739FBQ3K5LAmkrzE32UiDT
-		var dOepdjh3yW6ITWsVVMqO8uTNs695fC6nmHEGbu7MK2NWVD4FcY tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(35))
-		_ = dOepdjh3yW6ITWsVVMqO8uTNs695fC6nmHEGbu7MK2NWVD4FcY
-		var bUGlUz6QcsP45a4CM3opnTtyAQ10vp8uvmsJFSdi5H7hyEJwyvfm9we4eraoMQuWAtJKnNPSbn75I3iGk01T2o8X5HMc1Jj4lFy5OSyzOmqoBwQxxLu tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(36))
-		_ = bUGlUz6QcsP45a4CM3opnTtyAQ10vp8uvmsJFSdi5H7hyEJwyvfm9we4eraoMQuWAtJKnNPSbn75I3iGk01T2o8X5HMc1Jj4lFy5OSyzOmqoBwQxxLu
-		var ebet2d8z4 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(37))
-		_ = ebet2d8z4
-		var kK42gj0HAwXrLo6Z3h3vuF9kklza9oNuZZitCZOVEyI2Agw5O0ShfvZMauLaqO8zo3410EmnY tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(38))
-		_ = kK42gj0HAwXrLo6Z3h3vuF9kklza9oNuZZitCZOVEyI2Agw5O0ShfvZMauLaqO8zo3410EmnY
-		var t89kNw8SXEmEv7KQTW6G9FDiMLhH8Jyq2Icjf5uftL6oyjAKJ6oPznxJvPu20Ex5b8PgRYrz9wBjI3HA2Rcd8MyM7k8dihEtsImgSKVpyj4jMJULNeGUi tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(39))
-		_ = t89kNw8SXEmEv7KQTW6G9FDiMLhH8Jyq2Icjf5uftL6oyjAKJ6oPznxJvPu20Ex5b8PgRYrz9wBjI3HA2Rcd8MyM7k8dihEtsImgSKVpyj4jMJULNeGUi
-		var jVtDWPvvMlpRfAIFN tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(40))
-		_ = jVtDWPvvMlpRfAIFN
-		var rjDblwG5w06VwxVF2FhnwzCq9hHfxcPGVwTx tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(41))
-		_ = rjDblwG5w06VwxVF2FhnwzCq9hHfxcPGVwTx
-		var a5poG6VJGOje64gV0o32KNLomfvdGx3rPIJC3GJ3qt7s27Iw8xPw6TvJvGHB19iTVuu5JiNrx9SRBRvoGanmW6eUQQUrvrJA7GTw1pxv tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(42))
-		_ = a5poG6VJGOje64gV0o32KNLomfvdGx3rPIJC3GJ3qt7s27Iw8xPw6TvJvGHB19iTVuu5JiNrx9SRBRvoGanmW6eUQQUrvrJA7GTw1pxv
-		var yOYNtXMlTaAaH7PATciJgfRAhiAlbeEtxgyT9NwtG5a0R4vTySlcdj0JNzArgg2xMpFFB4qoV8QUCPed4W9XTGrtIEpnSCGjjJegRw1DbcJh7q7IVI tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(43))
-		_ = yOYNtXMlTaAaH7PATciJgfRAhiAlbeEtxgyT9NwtG5a0R4vTySlcdj0JNzArgg2xMpFFB4qoV8QUCPed4W9XTGrtIEpnSCGjjJegRw1DbcJh7q7IVI
-		var ppJgmVeFxLLqOseXHwxX7VFY5ak0aBSFDc6acefzO7 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(44))
-		_ = ppJgmVeFxLLqOseXHwxX7VFY5ak0aBSFDc6acefzO7
-		var p11NmRi6fkzNJnfKAv2sSb1gguICzCObCpRgPJo2eBgBjQYBMd5ABmKmAQrLjxpYgeII15u2xIfC4rrzLcVw0NciiutagUaugvj83AmGQiq8cvcyt tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(45))
-		_ = p11NmRi6fkzNJnfKAv2sSb1gguICzCObCpRgPJo2eBgBjQYBMd5ABmKmAQrLjxpYgeII15u2xIfC4rrzLcVw0NciiutagUaugvj83AmGQiq8cvcyt
-		var aCqFcgU8u8pZF8YqRxvPB0KB4N151xs36qWBxNvU2ZPrWLdadCN5tJWLXaeEN0xPX9k17O2pORhvSXnOmCPNxe5CCRqK3HJ58SbC6WbQkH7wlP tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(46))
-		_ = aCqFcgU8u8pZF8YqRxvPB0KB4N151xs36qWBxNvU2ZPrWLdadCN5tJWLXaeEN0xPX9k17O2pORhvSXnOmCPNxe5CCRqK3HJ58SbC6WbQkH7wlP
-		var cTIEG1eCnodk4KfNawiNeoxObe37JMs7bHQIQTQNPK20lYtVKc4SRVj4nULZxffcc6FsQfQWEZ3i1bjUTAGBbryJw9HMh5KNlO tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(47))
-		_ = cTIEG1eCnodk4KfNawiNeoxObe37JMs7bHQIQTQNPK20lYtVKc4SRVj4nULZxffcc6FsQfQWEZ3i1bjUTAGBbryJw9HMh5KNlO
-		var kbyqFcHzmKIdkfS tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(48))
-		_ = kbyqFcHzmKIdkfS
-		var qZULqzNCf4c33keIT6QSXtLw2oczBYFqZurDwzXUjhqcsTKr4Wzz5e2WWUtliYkFMS8RyzfzF0jPHEs66rzf2J2OCHh1X tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(49))
-		_ = qZULqzNCf4c33keIT6QSXtLw2oczBYFqZurDwzXUjhqcsTKr4Wzz5e2WWUtliYkFMS8RyzfzF0jPHEs66rzf2J2OCHh1X
-		var uNlhHuC0KVGQI2eQvDquEWPtZtz1alPf7PY2YXBfk tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(50))
-		_ = uNlhHuC0KVGQI2eQvDquEWPtZtz1alPf7PY2YXBfk
-		var tRM3vBR7bpQD7xBwkdX71LMVFvzNY78Wddk93FvTIv6MJNKxKEdC5Au tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(51))
-		_ = tRM3vBR7bpQD7xBwkdX71LMVFvzNY78Wddk93FvTIv6MJNKxKEdC5Au
-		var icF7usaAqXMhWDA9ejQId0ZD0M1xCw9iep0SU1aSDIf3zqnGMaRhxjkWlcnx8NSh6Plecg6rOYDtUqTBmJAZCoEMv6xtUIe0k9It8aYACvBef tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(52))
-		_ = icF7usaAqXMhWDA9ejQId0ZD0M1xCw9iep0SU1aSDIf3zqnGMaRhxjkWlcnx8NSh6Plecg6rOYDtUqTBmJAZCoEMv6xtUIe0k9It8aYACvBef
-		var j0 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(53))
-		_ = j0
-		var rHhxcqYY6k95ChqdhWd4sTse8mY3eMCTSJPcZzGX4Tz2okQI5BYD tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(54))
-		_ = rHhxcqYY6k95ChqdhWd4sTse8mY3eMCTSJPcZzGX4Tz2okQI5BYD
-		var b1UmXHzKlpk9H2wePnDyToZOR4mytyjxuL7BJLIJjrYc6PN7gvf678kdikjfRud8EYRMnPNWtFZj3O9qfpPQWjchG tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(55))
-		_ = b1UmXHzKlpk9H2wePnDyToZOR4mytyjxuL7BJLIJjrYc6PN7gvf678kdikjfRud8EYRMnPNWtFZj3O9qfpPQWjchG
-		var kOg5rWM2E5mItuiMpa2ZYWyErb70zhVsSfpG61mMlX0EClWtIClUoqONIYQfzd0cLMYhBWcWhOubaI tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(56))
-		_ = kOg5rWM2E5mItuiMpa2ZYWyErb70zhVsSfpG61mMlX0EClWtIClUoqONIYQfzd0cLMYhBWcWhOubaI
-		var dXNyAvBGR4G35ac1ciJH tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(57))
-		_ = dXNyAvBGR4G35ac1ciJH
-		var i4HZuRtO0viK2jDOqmmyg9UEKLfMiI23Lzmajt7AnsA3Ykxib3eEUSzSNKYpHM0s1EmsHXcv6SpF6KQkwj7p5nGnUzwE2CD28vpG6MbZUewL tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(58))
-		_ = i4HZuRtO0viK2jDOqmmyg9UEKLfMiI23Lzmajt7AnsA3Ykxib3eEUSzSNKYpHM0s1EmsHXcv6SpF6KQkwj7p5nGnUzwE2CD28vpG6MbZUewL
-		var bsBwDAWVVEx5wW8KfQ86TtbEXRSoHPXe6JiwijfaXdonD32urz63Qr3EMrmhPfvKcGZi0wioGOal4MC4ubda tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(59))
-		_ = bsBwDAWVVEx5wW8KfQ86TtbEXRSoHPXe6JiwijfaXdonD32urz63Qr3EMrmhPfvKcGZi0wioGOal4MC4ubda
-		var yqaBcm6xT7VYm4JWC5Yhtm5E83fZ4a3il4vjKqyOWkHkR0tLz5CBZIfKqGqd5tRfoJdt9ylxLwukA9Bu7P91kiwAKD tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(60))
-		_ = yqaBcm6xT7VYm4JWC5Yhtm5E83fZ4a3il4vjKqyOWkHkR0tLz5CBZIfKqGqd5tRfoJdt9ylxLwukA9Bu7P91kiwAKD
-		var o57HdczedfTs5ptNuPejaeUF1OVUUhBx2WuXeolu5tcbov4juxoz61myFvqfTTr58RS9UapJr0cJ7p4a1 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(61))
-		_ = o57HdczedfTs5ptNuPejaeUF1OVUUhBx2WuXeolu5tcbov4juxoz61myFvqfTTr58RS9UapJr0cJ7p4a1
-		var qtRY3DeNGVpVfbuEGnoQVCGfBz0h4cp1J tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(62))
-		_ = qtRY3DeNGVpVfbuEGnoQVCGfBz0h4cp1J
-		var aM1o6nSAiuCEBSVZey6o6Fe0XUkL5oPGcrupSGtvm tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(63))
-		_ = aM1o6nSAiuCEBSVZey6o6Fe0XUkL5oPGcrupSGtvm
-		var tw8etp1ZIvGFcwbZz1B7EdpNMvBDyyxEUYWM71N7Ju2 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(64))
-		_ = tw8etp1ZIvGFcwbZz1B7EdpNMvBDyyxEUYWM71N7Ju2
-		var s1S61l2YRzbxQxij48HsUAiuOF7vtOOfPEF7GpCe8lMlxUbw5uqzBKc93hTimLmobiuGZIbq8LvQ5kbT6U tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(65))
-		_ = s1S61l2YRzbxQxij48HsUAiuOF7vtOOfPEF7GpCe8lMlxUbw5uqzBKc93hTimLmobiuGZIbq8LvQ5kbT6U
-		var cWf8d4eg3wz8 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(66))
-		_ = cWf8d4eg3wz8
-		var vihCVmtwI298kjYp1SCAyEMLJRi3qOp8MjSs0KqR7fJh7bOrH6g8BOvd3YWArf6dtwoDg83z3KEDWR8vV5lBigEEHtZKMDV4XWAGXIl7QNih5AeL tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(67))
-		_ = vihCVmtwI298kjYp1SCAyEMLJRi3qOp8MjSs0KqR7fJh7bOrH6g8BOvd3YWArf6dtwoDg83z3KEDWR8vV5lBigEEHtZKMDV4XWAGXIl7QNih5AeL
-		var ig6WV4gyDyTrFKUaRUrvCQNLq5D6bL2UFVCYimobKZ7agGiKhSVCV2zlHoAwgfIK tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(68))
-		_ = ig6WV4gyDyTrFKUaRUrvCQNLq5D6bL2UFVCYimobKZ7agGiKhSVCV2zlHoAwgfIK
-		var tzfucOGiRbZcyIylI7yuPi8l2IIZ3JQVMjBOe7mtbw tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(69))
-		_ = tzfucOGiRbZcyIylI7yuPi8l2IIZ3JQVMjBOe7mtbw
-		var ytALmA8QFfn7iXrWaLdf61QBTImHiQxFUnR2BaGu3tdKQ4KX9tYCYLLaal8aeUrkq4mvCgtObPJyGFs tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(70))
-		_ = ytALmA8QFfn7iXrWaLdf61QBTImHiQxFUnR2BaGu3tdKQ4KX9tYCYLLaal8aeUrkq4mvCgtObPJyGFs
-		var yvT43K0Rn9qOqjBQvgviJwcLOYolNYeqx2xhfPWan9GzwbiNiuKyQhqvRdDmnF9PzsDYG5lngbA tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(71))
-		_ = yvT43K0Rn9qOqjBQvgviJwcLOYolNYeqx2xhfPWan9GzwbiNiuKyQhqvRdDmnF9PzsDYG5lngbA
-		var yPiktwBcdC2bJAOvQ5bbfzCbrRkZCVHmK7XoEiY tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(72))
-		_ = yPiktwBcdC2bJAOvQ5bbfzCbrRkZCVHmK7XoEiY
-		var cQSLqZJuFxSG4yQ6aL3Ho tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(73))
-		_ = cQSLqZJuFxSG4yQ6aL3Ho
-		var mR5lq0HdPsAdE5sB89Jyp9Cdbo tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(74))
-		_ = mR5lq0HdPsAdE5sB89Jyp9Cdbo
-		var v9O7IOMjaHz3wyHxQml0PZu4S6eitcdAz6dVdGt5ZUgmbFzjczIU4bnnkwr8UwcSrfkeyNHYfEpXVvLejBxStLlwTJNSEqNvq5wZKbHcB tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(75))
-		_ = v9O7IOMjaHz3wyHxQml0PZu4S6eitcdAz6dVdGt5ZUgmbFzjczIU4bnnkwr8UwcSrfkeyNHYfEpXVvLejBxStLlwTJNSEqNvq5wZKbHcB
-		var yV87iaojrbY11bPi0c2PG0t2BF3qMg8o4W3u3cLkwqcgVLY6QcfCvaeoFhZ9dwzytUnieRRJpAzHjZjfrmdPoSt3vf7VjvAIVDBMKN33rzgrKAuz tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(76))
-		_ = yV87iaojrbY11bPi0c2PG0t2BF3qMg8o4W3u3cLkwqcgVLY6QcfCvaeoFhZ9dwzytUnieRRJpAzHjZjfrmdPoSt3vf7VjvAIVDBMKN33rzgrKAuz
-		var mSi8IsHSaMWarceetBet tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(77))
-		_ = mSi8IsHSaMWarceetBet
-		var wCFmcSiPisOUXeg7KB5ffUJLtzteY6Lmsj5K1uYdKEgTpqiwJ57xCTQYul5VxxlWKpXQoouVnXg2jUHHbgqGGlfO tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(78))
-		_ = wCFmcSiPisOUXeg7KB5ffUJLtzteY6Lmsj5K1uYdKEgTpqiwJ57xCTQYul5VxxlWKpXQoouVnXg2jUHHbgqGGlfO
-		var i4kgpIQgxMdqOongGt87U4gt tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(79))
-		_ = i4kgpIQgxMdqOongGt87U4gt
-		var kg2P2Efa1zAXmgnI2HMy7khopf1mJwH6IGd33D4tZSVMqOPfYO0AAOahNtX tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(80))
-		_ = kg2P2Efa1zAXmgnI2HMy7khopf1mJwH6IGd33D4tZSVMqOPfYO0AAOahNtX
-		var sifOxHC2nNOYLvme62VTjZT0UspDpTPPBvv3xXTSuITFpXCG9VKbnaB0EwiQzwnZBKKUljOyShNretBNGgHWfvj87XZhcK5ageG5ax tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(81))
-		_ = sifOxHC2nNOYLvme62VTjZT0UspDpTPPBvv3xXTSuITFpXCG9VKbnaB0EwiQzwnZBKKUljOyShNretBNGgHWfvj87XZhcK5ageG5ax
-		var q8XJJUa1aVm6W2I9Im58IKTwKQ7Drc4blR5S3lgzl6WZuYu0Arn309QqAK3lGqRPxJxhT tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(82))
-		_ = q8XJJUa1aVm6W2I9Im58IKTwKQ7Drc4blR5S3lgzl6WZuYu0Arn309QqAK3lGqRPxJxhT
-		var khVrD8zteXdl4vWqnyHznKF7nnOTIl05xnhiBgcoA7gWXLR9 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(83))
-		_ = khVrD8zteXdl4vWqnyHznKF7nnOTIl05xnhiBgcoA7gWXLR9
-		var tnfoOloDphHZbt17VgpvwcNehsglU5QVvBkHGqSMs3AkQNSjZv7 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(84))
-		_ = tnfoOloDphHZbt17VgpvwcNehsglU5QVvBkHGqSMs3AkQNSjZv7
-		var mbfwuTpS6XAYc7ERqqofjUuUTf7vbGVAgQSSgGv3Hiva2scON5KnyaTicWGcYSRvVilF tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(85))
-		_ = mbfwuTpS6XAYc7ERqqofjUuUTf7vbGVAgQSSgGv3Hiva2scON5KnyaTicWGcYSRvVilF
-		var cs4WB1zxD9P2Hnn8CCBWRukFZdkBJkv4S3OSV5cOI52Vzj2SiNRxUPjDXgrR2l7frhbwYfFgwG7NmQME3jJa4oAMC tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(86))
-		_ = cs4WB1zxD9P2Hnn8CCBWRukFZdkBJkv4S3OSV5cOI52Vzj2SiNRxUPjDXgrR2l7frhbwYfFgwG7NmQME3jJa4oAMC
-		var yKGU5AHpOBTJLP tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(87))
-		_ = yKGU5AHpOBTJLP
-		var ijI6RZEl4pKMLoGOedwERZAjZQaqkJWt1dmQ1tSQqw7NO5sdWUaNRylqzn7vuAnZPz2osmozprLMhTkg4HqcCmg5Iu0ePsIgeEfXe0Up tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(88))
-		_ = ijI6RZEl4pKMLoGOedwERZAjZQaqkJWt1dmQ1tSQqw7NO5sdWUaNRylqzn7vuAnZPz2osmozprLMhTkg4HqcCmg5Iu0ePsIgeEfXe0Up
-		var okpYMLykH1RJnjo02jqy6qbpbUk7oVr0ifR8w0mr33Ns tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(89))
-		_ = okpYMLykH1RJnjo02jqy6qbpbUk7oVr0ifR8w0mr33Ns
-		var oGZqnloVYnsN5RnGnIXS6bwV5RulgDjF79ZOPcSts5pKLsOcr3E1aLIhdoRM8xp2f4lSQJC4VEbOpWJ6Rz7ClRiatQS6P4NUktYT89ijVVAqXwqwo3I tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(90))
-		_ = oGZqnloVYnsN5RnGnIXS6bwV5RulgDjF79ZOPcSts5pKLsOcr3E1aLIhdoRM8xp2f4lSQJC4VEbOpWJ6Rz7ClRiatQS6P4NUktYT89ijVVAqXwqwo3I
-		var sNwFRxefnEUM8HUCDjTha8 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(91))
-		_ = sNwFRxefnEUM8HUCDjTha8
-		var s1yp9BlabCABm0gxixsNUk7 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(92))
-		_ = s1yp9BlabCABm0gxixsNUk7
-		var rzwl787albEt8Lr2hO5JoBpy3GG7t5WcZErM18RPVl3Y5oYHPRlKqrNkmivisQy5 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(93))
-		_ = rzwl787albEt8Lr2hO5JoBpy3GG7t5WcZErM18RPVl3Y5oYHPRlKqrNkmivisQy5
-		var xagBCy46wPb5s6r3IaUKUL1ORk2AnQWqX tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(94))
-		_ = xagBCy46wPb5s6r3IaUKUL1ORk2AnQWqX
-		var sc4mX89IKqrXlfKvVQuQd6Piqky8z3t9YFHzcVM6i4w7P tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(95))
-		_ = sc4mX89IKqrXlfKvVQuQd6Piqky8z3t9YFHzcVM6i4w7P
-		var zQZVtw3HUcnXJLzoJpzpZhqnypaWcKwm8scYk4VN4r8fzQOrChN8lFdHmAgk2g3AiUFwl8s4kdXOJzsuTIfFEjEiHVcHYBkawPNfL7 tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(96))
-		_ = zQZVtw3HUcnXJLzoJpzpZhqnypaWcKwm8scYk4VN4r8fzQOrChN8lFdHmAgk2g3AiUFwl8s4kdXOJzsuTIfFEjEiHVcHYBkawPNfL7
-		var rEPD1cQuYUmWVany6skCwycvJfttN0pUFCMmcnaiCkzDqL9U7WIaZm6cTe0bmzmrGU7w3b2C4HtMj14l8rR tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(97))
-		_ = rEPD1cQuYUmWVany6skCwycvJfttN0pUFCMmcnaiCkzDqL9U7WIaZm6cTe0bmzmrGU7w3b2C4HtMj14l8rR
-		var vNuNzUH6gCqsyBicChtqwpGzwIctfB5Eo1vO3x6MuFwsT13jOTOLQmQtslTVotib2mm348vExZExlqPLO1DEyRUXaKy4865YLtMZNQFR1pP6l tla.TLAValue = args1[1].ApplyFunction(tla.MakeTLANumber(98))
-		_ = vNuNzUH6gCqsyBicChtqwpGzwIctfB5Eo1vO3x6MuFwsT13jOTOLQmQtslTVotib2mm348vExZExlqPLO1DEyRUXaKy4865YLtMZNQFR1pP6l
-		var g9dxjJezebaDpYNrr28w6llCYuj3CjmHToSEZqvPtvin9r8v1MpikgIWxEysMKgSxTSBFWGU8mToPUsk tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(1))
-		_ = g9dxjJezebaDpYNrr28w6llCYuj3CjmHToSEZqvPtvin9r8v1MpikgIWxEysMKgSxTSBFWGU8mToPUsk
-		var l tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(2))
-		_ = l
-		var cKhN3iR7fDgQSGZgSMSiQ2ggTd3L1SHsceyjjJhnSV87xbOyNU4NzF0CnEdzbvgW9DSB5dCYsGJFD3qN51wvLGsF tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(3))
-		_ = cKhN3iR7fDgQSGZgSMSiQ2ggTd3L1SHsceyjjJhnSV87xbOyNU4NzF0CnEdzbvgW9DSB5dCYsGJFD3qN51wvLGsF
-		var k8c1KOOka4RRg5TBw tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(4))
-		_ = k8c1KOOka4RRg5TBw
-		var y4uGKna8xdgfDzMyI tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(5))
-		_ = y4uGKna8xdgfDzMyI
-		var uVIECX0F3wZf6xCEQ1Cp4DaZ1uAv522Q1zWDHmOjdWAx06AoRDXTjiZJoG9NpLwnsHb7WxQPY0474KClNORLfPME89nll tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(6))
-		_ = uVIECX0F3wZf6xCEQ1Cp4DaZ1uAv522Q1zWDHmOjdWAx06AoRDXTjiZJoG9NpLwnsHb7WxQPY0474KClNORLfPME89nll
-		var fUknjeOOnaWx3sIfmVfYiqRzfrSHiRwiHL6d1mGN7RBv5pmyyYoAfzcn9FkfPxgce4AffaukNe9XfmgLR5yGoYKc41tDVGehUWprtYrbl7fm6qEcOJ8s tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(7))
-		_ = fUknjeOOnaWx3sIfmVfYiqRzfrSHiRwiHL6d1mGN7RBv5pmyyYoAfzcn9FkfPxgce4AffaukNe9XfmgLR5yGoYKc41tDVGehUWprtYrbl7fm6qEcOJ8s
-		var migj7iG0I6GuUTlQKTQM1Rwi tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(8))
-		_ = migj7iG0I6GuUTlQKTQM1Rwi
-		var iGHhVrKWWnlrJbyPVE9LiaLlEamURRAYJiJpwIPn0i5XULAHIHVMH5z2NXcBld4RWouyx728U07dfQMVke tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(9))
-		_ = iGHhVrKWWnlrJbyPVE9LiaLlEamURRAYJiJpwIPn0i5XULAHIHVMH5z2NXcBld4RWouyx728U07dfQMVke
-		var fv69pjleEPVZtnrxqA2rKcBKiIabNlGfygfmwmYMz1zzcPNHBX7MECy18ghSrVVRyZwbcPlOoOdxNywKxhfkTItdoGwtQrEHMBhHyQs9pf tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(10))
-		_ = fv69pjleEPVZtnrxqA2rKcBKiIabNlGfygfmwmYMz1zzcPNHBX7MECy18ghSrVVRyZwbcPlOoOdxNywKxhfkTItdoGwtQrEHMBhHyQs9pf
-		var od4BtGrfKf0GR987y2cxEwXtNGxEYALQZaJmM4 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(11))
-		_ = od4BtGrfKf0GR987y2cxEwXtNGxEYALQZaJmM4
-		var rZGnmALbeC75Agvh7J8v4Hv5SMlZQzjqqpKpoZ2QViCntl5vKg2rAY5ZKbIllVwQtPJd tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(12))
-		_ = rZGnmALbeC75Agvh7J8v4Hv5SMlZQzjqqpKpoZ2QViCntl5vKg2rAY5ZKbIllVwQtPJd
-		var gJjqx5sJfjzNiLoyjIb1EBwSawNCaTsOl8aNGAOmxfonY5da1gqvNgrkIoGSyoAxtnT5vJwfSP7fYi8tRNuYDC9sB7Ql4 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(13))
-		_ = gJjqx5sJfjzNiLoyjIb1EBwSawNCaTsOl8aNGAOmxfonY5da1gqvNgrkIoGSyoAxtnT5vJwfSP7fYi8tRNuYDC9sB7Ql4
-		var i21pI9mfgY9dIH94015PhFHkaQR9Rc5lwTHEjRDc68s tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(14))
-		_ = i21pI9mfgY9dIH94015PhFHkaQR9Rc5lwTHEjRDc68s
-		var ld5jTtZYYG7ltitFE6XDxBsgsA00EjvIqcSA98TQ5JbLCeE1qSZiHVtcGJqrqC7nEfgEs7O7ys915IsyB tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(15))
-		_ = ld5jTtZYYG7ltitFE6XDxBsgsA00EjvIqcSA98TQ5JbLCeE1qSZiHVtcGJqrqC7nEfgEs7O7ys915IsyB
-		var fU4ZhdwnrDTJJMqhfHUhSc5Y3mszaJKxmX8Z69FVsJu6jfrSB0hY0JriPxsDRDO tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(16))
-		_ = fU4ZhdwnrDTJJMqhfHUhSc5Y3mszaJKxmX8Z69FVsJu6jfrSB0hY0JriPxsDRDO
-		var oXwS0QFnTLOvrWlM9aEggYaDnyym0zwlOSg6CdChWvWB1PkbyNSmBoUCJ5YEsflM2krQmr5ztzfgVo7nxQxYS7B9z tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(17))
-		_ = oXwS0QFnTLOvrWlM9aEggYaDnyym0zwlOSg6CdChWvWB1PkbyNSmBoUCJ5YEsflM2krQmr5ztzfgVo7nxQxYS7B9z
-		var iCZihesgSv1Kj1l1vrNQGfeTkJqCYnV1wJDgO2MoHa0wPdYsL tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(18))
-		_ = iCZihesgSv1Kj1l1vrNQGfeTkJqCYnV1wJDgO2MoHa0wPdYsL
-		var wILtvLK0lB49PkUR3L9w0l84bmLTt3ycM7tnqEPeeLbNOZGvGJpmrnoAg1uoL2Mj1r82QA tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(19))
-		_ = wILtvLK0lB49PkUR3L9w0l84bmLTt3ycM7tnqEPeeLbNOZGvGJpmrnoAg1uoL2Mj1r82QA
-		var daU1rD4XvM11Dh0Z1E3f21Sdm34L8fRrIA6xTtgm3xc2QinpI6D7YWEWx tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(20))
-		_ = daU1rD4XvM11Dh0Z1E3f21Sdm34L8fRrIA6xTtgm3xc2QinpI6D7YWEWx
-		var i4Ik0cO9nzF1J1YXWZWVCdP5wFrp8GPul4kchDfR5Bq04tMrIVNjEaqdt7IGPiclnZ2yPuWtK3I3WZzyAfIBM9QN0P7RwsLrLIjR12CMX tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(21))
-		_ = i4Ik0cO9nzF1J1YXWZWVCdP5wFrp8GPul4kchDfR5Bq04tMrIVNjEaqdt7IGPiclnZ2yPuWtK3I3WZzyAfIBM9QN0P7RwsLrLIjR12CMX
-		var uekcvaFwFZEcXj894jrOExqXhp01mcqMAckwEliCDZri1mUXUweapZTs3J tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(22))
-		_ = uekcvaFwFZEcXj894jrOExqXhp01mcqMAckwEliCDZri1mUXUweapZTs3J
-		var hRNCAzpQDvLdhaR7qaP8Zti8Mv14YZM tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(23))
-		_ = hRNCAzpQDvLdhaR7qaP8Zti8Mv14YZM
-		var i tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(24))
-		_ = i
-		var l0 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(25))
-		_ = l0
-		var gt4dpXECGpwdUtRF6HBlT96bQvURftglBYs tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(26))
-		_ = gt4dpXECGpwdUtRF6HBlT96bQvURftglBYs
-		var uBTusjHaRPTcj4gNswGXwxW76CzsY tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(27))
-		_ = uBTusjHaRPTcj4gNswGXwxW76CzsY
-		var lhzAooIW4yPfvZw2gWRctvamtCQaN70QC8p4mI8Ws4SXzM4rZM9GpKYeBfFl9Rrty5qc0l45hFEHT3cIrNEPW1LTToVbaL60IIFiPuva5z tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(28))
-		_ = lhzAooIW4yPfvZw2gWRctvamtCQaN70QC8p4mI8Ws4SXzM4rZM9GpKYeBfFl9Rrty5qc0l45hFEHT3cIrNEPW1LTToVbaL60IIFiPuva5z
-		var mpBtq07NUwOGF1kEHEeyeNuem426dtdDsK1BdPgNtdsEoVSeWm5B1bcOPqL0Za0TLM2nCRl9t7StPa1m97q tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(29))
-		_ = mpBtq07NUwOGF1kEHEeyeNuem426dtdDsK1BdPgNtdsEoVSeWm5B1bcOPqL0Za0TLM2nCRl9t7StPa1m97q
-		var dKw0Nto1qc7vasYEsCjILWcGDc1eeBNqj6Uh0zaZqGVAFm5UFotT4Ee526A7AtK7Gf5KeePcNgvdpBuw tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(30))
-		_ = dKw0Nto1qc7vasYEsCjILWcGDc1eeBNqj6Uh0zaZqGVAFm5UFotT4Ee526A7AtK7Gf5KeePcNgvdpBuw
-		var oCCrpH8fqsnCIWMhBB0jhYslkuBzU7PMJVfB2PLE6CUQCuyRsSnCRJf00dds0OwEJJDIq5Dg tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(31))
-		_ = oCCrpH8fqsnCIWMhBB0jhYslkuBzU7PMJVfB2PLE6CUQCuyRsSnCRJf00dds0OwEJJDIq5Dg
-		var bsoAmv5EnoN4SwF0J9LTzeX1ZkIoCEss8DGBmOpYbidS8di45UP3afUVmYR0 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(32))
-		_ = bsoAmv5EnoN4SwF0J9LTzeX1ZkIoCEss8DGBmOpYbidS8di45UP3afUVmYR0
-		var iqLrhBYxbMXsx4BApRiSr8iknniyuRgEzOn403urwDxafqwWrdrU tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(33))
-		_ = iqLrhBYxbMXsx4BApRiSr8iknniyuRgEzOn403urwDxafqwWrdrU
-		var mhfYEAubS5CatCK4k1kVr0Z60wCQF5C0ChSwDZoasv4WvXLNfvYjymB4PDVMqpL58f5mQOVvQsPtqIFXWkQpAtoohF0FmDyP6By5nql tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(34))
-		_ = mhfYEAubS5CatCK4k1kVr0Z60wCQF5C0ChSwDZoasv4WvXLNfvYjymB4PDVMqpL58f5mQOVvQsPtqIFXWkQpAtoohF0FmDyP6By5nql
-		var vwalgzPsH5Ozvoj4qfq1MaxRw59AiJdUmVZk9JPJ6zeej8s3FiKSjvf5EpGdmaOtY0HObL2EmXEcotBp tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(35))
-		_ = vwalgzPsH5Ozvoj4qfq1MaxRw59AiJdUmVZk9JPJ6zeej8s3FiKSjvf5EpGdmaOtY0HObL2EmXEcotBp
-		var k5 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(36))
-		_ = k5
-		var cIkqeFgSJ2MP tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(37))
-		_ = cIkqeFgSJ2MP
-		var gd25ASMG4bDqQujRTuJzy1HP4w58z60aUpsn7clURfefjdlYqgO1tJz33NDPhqGSrpN8kMhJUouta9 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(38))
-		_ = gd25ASMG4bDqQujRTuJzy1HP4w58z60aUpsn7clURfefjdlYqgO1tJz33NDPhqGSrpN8kMhJUouta9
-		var m9L8zjo0aGswN6WW2XgEFcvnjr6ngLpwPNhfacEb9w7XSXmZAzCFRgmM1N9HkYo0pQD1qHccHVdkQc5mZdgZJd6bJRgZYjtdB1DVTfAAX tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(39))
-		_ = m9L8zjo0aGswN6WW2XgEFcvnjr6ngLpwPNhfacEb9w7XSXmZAzCFRgmM1N9HkYo0pQD1qHccHVdkQc5mZdgZJd6bJRgZYjtdB1DVTfAAX
-		var mLM69dzknrXJVFwKGv8745F8zwRcA1LGr2HnoieWZjn0rPtWxpmSkZcdHMpys3q1l8J21hY8T25moQbUa0bopwSKN2NTZxOrLwHgbt7yXlODrnKL11j tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(40))
-		_ = mLM69dzknrXJVFwKGv8745F8zwRcA1LGr2HnoieWZjn0rPtWxpmSkZcdHMpys3q1l8J21hY8T25moQbUa0bopwSKN2NTZxOrLwHgbt7yXlODrnKL11j
-		var bJE6TGoXVZWMFrsHQC5IyYDgjhtGUuKs1U29AY2BJDkOCZKnpytnPocfBH tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(41))
-		_ = bJE6TGoXVZWMFrsHQC5IyYDgjhtGUuKs1U29AY2BJDkOCZKnpytnPocfBH
-		var y435lNoPv1sluztSYOBOc6Pjndhm9nORNBJlqDlM2SthpKpmwmtPYsr0hAN6RFtpfQkzNp7z8q tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(42))
-		_ = y435lNoPv1sluztSYOBOc6Pjndhm9nORNBJlqDlM2SthpKpmwmtPYsr0hAN6RFtpfQkzNp7z8q
-		var u0GUNrcxqAuzn tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(43))
-		_ = u0GUNrcxqAuzn
-		var hEUpe3bWYezaRaxqIscT tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(44))
-		_ = hEUpe3bWYezaRaxqIscT
-		var fkdAl0MFUeKEF9fByaikUUJlS6oMPyPG0SslERcyRmIClHsmBbJr8n0UrrrKbHgl8C04zZTqiB4pRd193mPztXJrneb6 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(45))
-		_ = fkdAl0MFUeKEF9fByaikUUJlS6oMPyPG0SslERcyRmIClHsmBbJr8n0UrrrKbHgl8C04zZTqiB4pRd193mPztXJrneb6
-		var tokB1hY8lGS9nPu1u0xT4TW5sEwZHbLznfMeMovhz011W7B5NmMsOj63XkGsYx tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(46))
-		_ = tokB1hY8lGS9nPu1u0xT4TW5sEwZHbLznfMeMovhz011W7B5NmMsOj63XkGsYx
-		var nZsJwMxtxC0sWzFQvmXDUyLh9CXD8ITyS4ecSZKFJHbHGkcJFrWSkMBfljapC3dnQCJjxIsxRoPe7Z0PQegW tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(47))
-		_ = nZsJwMxtxC0sWzFQvmXDUyLh9CXD8ITyS4ecSZKFJHbHGkcJFrWSkMBfljapC3dnQCJjxIsxRoPe7Z0PQegW
-		var g7Ohcx5hVjQ8wN tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(48))
-		_ = g7Ohcx5hVjQ8wN
-		var oe3eUfkcUK tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(49))
-		_ = oe3eUfkcUK
-		var hKpvO6G53irm43eluip tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(50))
-		_ = hKpvO6G53irm43eluip
-		var lCdNAfkuc8JxmsLmDSiCsRujrc3Dl0SPaRX5kv tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(51))
-		_ = lCdNAfkuc8JxmsLmDSiCsRujrc3Dl0SPaRX5kv
-		var avRfQVUQPT3eKQtMnGXN83nxrMPiVJ9buZsZdP9B1oZ2gx5sfDNKV tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(52))
-		_ = avRfQVUQPT3eKQtMnGXN83nxrMPiVJ9buZsZdP9B1oZ2gx5sfDNKV
-		var ppZhoZlryewU2MtOrGNrD0AbmblQkKhUBT3RrK6akJejk80fbs9QVgh5Y0NIOiZWHwCxQjKaq6A6RYNXF tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(53))
-		_ = ppZhoZlryewU2MtOrGNrD0AbmblQkKhUBT3RrK6akJejk80fbs9QVgh5Y0NIOiZWHwCxQjKaq6A6RYNXF
-		var npK9y4L16wQtf46IIxON7LOb7ow5Tye tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(54))
-		_ = npK9y4L16wQtf46IIxON7LOb7ow5Tye
-		var aKZOljceAct7mW8H7MlQyBgpVhTReXKOOuLZS9fcfXR00p0RVBLwU9zFQlmsPQnGpX tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(55))
-		_ = aKZOljceAct7mW8H7MlQyBgpVhTReXKOOuLZS9fcfXR00p0RVBLwU9zFQlmsPQnGpX
-		var wAFalERGHrTcVAN01Vi3SFDIFentDeBDNNegEscBZiWAhzNsq3mqOlbvKUuo6T6O9SmUmWaKN7e5htkb0h179EMcJTYUvKpAVAOsLhK1D tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(56))
-		_ = wAFalERGHrTcVAN01Vi3SFDIFentDeBDNNegEscBZiWAhzNsq3mqOlbvKUuo6T6O9SmUmWaKN7e5htkb0h179EMcJTYUvKpAVAOsLhK1D
-		var uOxzAnKeHASwe0Ck6FQSPv tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(57))
-		_ = uOxzAnKeHASwe0Ck6FQSPv
-		var hOwCBUjqZCDEkSgoFaH4F6UygS53ZWBrOjHqBFjbZgWguiEOoWtMQzrxed400ykKkLUW tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(58))
-		_ = hOwCBUjqZCDEkSgoFaH4F6UygS53ZWBrOjHqBFjbZgWguiEOoWtMQzrxed400ykKkLUW
-		var z8jOUHbR4d42VYtR076qjlJcf6EPRBnqbDwHv0c5VfLUQPl3RSzbiCH8o9BRbnn7FFmMMJ2fv2PTuyVem1W8cxvZInSCvaVndfkTrAVHn7qovdYwJ2Rk tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(59))
-		_ = z8jOUHbR4d42VYtR076qjlJcf6EPRBnqbDwHv0c5VfLUQPl3RSzbiCH8o9BRbnn7FFmMMJ2fv2PTuyVem1W8cxvZInSCvaVndfkTrAVHn7qovdYwJ2Rk
-		var e4TrHDH1SHmB5Alqm6OS77JAdzCVPZ tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(60))
-		_ = e4TrHDH1SHmB5Alqm6OS77JAdzCVPZ
-		var gBxNMxfOckUDavLpx7r003iEVCq3MxDu2YuZupWK5ihe9KXYpPFIwbeMNFEtucSLxwqKqcPRUgCqEUxxt tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(61))
-		_ = gBxNMxfOckUDavLpx7r003iEVCq3MxDu2YuZupWK5ihe9KXYpPFIwbeMNFEtucSLxwqKqcPRUgCqEUxxt
-		var xOfJQy5JIG59Tdn484vj8jdNe8jBu0ilWchXlxtHjluXeQK44T0ixOYQ6SVXaCdWiQhlykh1wBNPOJS8 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(62))
-		_ = xOfJQy5JIG59Tdn484vj8jdNe8jBu0ilWchXlxtHjluXeQK44T0ixOYQ6SVXaCdWiQhlykh1wBNPOJS8
-		var uj01lf5daBwCxyu3vFiccGcrAmcH0DkM9sEl2WCiHARi1wtzH0mLHVFh tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(63))
-		_ = uj01lf5daBwCxyu3vFiccGcrAmcH0DkM9sEl2WCiHARi1wtzH0mLHVFh
-		var bg96JRkgXC7ztyPUL1HhmUlAUYdQ tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(64))
-		_ = bg96JRkgXC7ztyPUL1HhmUlAUYdQ
-		var czgFBrnDEVAYgkRzwjdGuhMgHHCgjHFRUU6blcTjPrGIEqnoPWHC9ejJ1L3fZy4rA9RZknrI6cV9EKNR9f3l3jLxFsQbMDpw88ftbtb tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(65))
-		_ = czgFBrnDEVAYgkRzwjdGuhMgHHCgjHFRUU6blcTjPrGIEqnoPWHC9ejJ1L3fZy4rA9RZknrI6cV9EKNR9f3l3jLxFsQbMDpw88ftbtb
-		var lTmYKh4mERXJykE3Cyk tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(66))
-		_ = lTmYKh4mERXJykE3Cyk
-		var kQ161taWV7gyPnNFNiTJCwMjIq26CprqYHrT0TNwEQdVy8F9PxpO6dzBLCKYcM535QDMOdV66tigeFoq7rZL8Z7HU5jJ2XN2LX0gjzoH7 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(67))
-		_ = kQ161taWV7gyPnNFNiTJCwMjIq26CprqYHrT0TNwEQdVy8F9PxpO6dzBLCKYcM535QDMOdV66tigeFoq7rZL8Z7HU5jJ2XN2LX0gjzoH7
-		var aLL36SW1kuGkB4DXXO5I9ZRYK3qRkmGNWTu7YYoSFvY tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(68))
-		_ = aLL36SW1kuGkB4DXXO5I9ZRYK3qRkmGNWTu7YYoSFvY
-		var zDWASlZ6HmEWk9nJW6iZWexymVLLQ2rG7xDFJo4FkzTQkAr2cj2I6AHN7KZr2DxBCMntU5tq8Vb3Tr04R0y198DVlqU tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(69))
-		_ = zDWASlZ6HmEWk9nJW6iZWexymVLLQ2rG7xDFJo4FkzTQkAr2cj2I6AHN7KZr2DxBCMntU5tq8Vb3Tr04R0y198DVlqU
-		var pKyZGPESFkquVLXSJuhqKroq1pKj8erMuVOcTdg5FMVj9zAlOxXgL4w0DcELMWjsgSLbP9o5I0wWtnyBNv9wlAFIMqfKBTrB tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(70))
-		_ = pKyZGPESFkquVLXSJuhqKroq1pKj8erMuVOcTdg5FMVj9zAlOxXgL4w0DcELMWjsgSLbP9o5I0wWtnyBNv9wlAFIMqfKBTrB
-		var t29bgk2ZJQu4GeZbgjlcBaX9Ge1ngNoIaZeDClhh7An1Huf1l7hiTag1Bv7G0sqPsNd5gabU tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(71))
-		_ = t29bgk2ZJQu4GeZbgjlcBaX9Ge1ngNoIaZeDClhh7An1Huf1l7hiTag1Bv7G0sqPsNd5gabU
-		var ywh2uwztKjqcc7DYm8qLFRn0VhXRbZh8oaTbwfFxiR25amKkK8F9E6OP2p35 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(72))
-		_ = ywh2uwztKjqcc7DYm8qLFRn0VhXRbZh8oaTbwfFxiR25amKkK8F9E6OP2p35
-		var gepH5RpkNPeeKo17rEbYqthXISOOQPM6FQbuQSznVUsp2ZSbYFCs2eBMXKbVXuumUwaFJBYJMqR38HMsY0ecc5oS95c9WS45YV tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(73))
-		_ = gepH5RpkNPeeKo17rEbYqthXISOOQPM6FQbuQSznVUsp2ZSbYFCs2eBMXKbVXuumUwaFJBYJMqR38HMsY0ecc5oS95c9WS45YV
-		var iUIVUm1UeBpmSrlciuYAWx1zVM0GyND0FgHMD tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(74))
-		_ = iUIVUm1UeBpmSrlciuYAWx1zVM0GyND0FgHMD
-		var hDoU0uLv6 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(75))
-		_ = hDoU0uLv6
-		var eQfKWPOdbXmS0m2b tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(76))
-		_ = eQfKWPOdbXmS0m2b
-		var qeR797nItpBL5EjEbetJp69Y1PTAbRLtnQRlQPdsoayhRPaDitAjuaKkAxxZfy7rH6J tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(77))
-		_ = qeR797nItpBL5EjEbetJp69Y1PTAbRLtnQRlQPdsoayhRPaDitAjuaKkAxxZfy7rH6J
-		var l1PVzgusG8RkkXAMalbuBN4mqrigJIPcEdVuUK4xaH6GJVoyex23ukdaSw7DTepfgoyKawtLShEyM tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(78))
-		_ = l1PVzgusG8RkkXAMalbuBN4mqrigJIPcEdVuUK4xaH6GJVoyex23ukdaSw7DTepfgoyKawtLShEyM
-		var c tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(79))
-		_ = c
-		var r4Q58pfJyAafhnkbPcmOOS6VbQx1cHwXUeTcuyxRz5XrYpa93Z tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(80))
-		_ = r4Q58pfJyAafhnkbPcmOOS6VbQx1cHwXUeTcuyxRz5XrYpa93Z
-		var zQR5IS9GtzrubxmuLEROGH5emJRdufpf1ihWJMIKs tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(81))
-		_ = zQR5IS9GtzrubxmuLEROGH5emJRdufpf1ihWJMIKs
-		var eAYeTQyXNwDpF5VEOE8KHHNgYZkfbOUhZdKpGVW9I9UIcuzOKmzMhnh90stJDcsrYgZVVsfwuY2n6wfuwcu3 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(82))
-		_ = eAYeTQyXNwDpF5VEOE8KHHNgYZkfbOUhZdKpGVW9I9UIcuzOKmzMhnh90stJDcsrYgZVVsfwuY2n6wfuwcu3
-		var vFn3LNOVpbiq7tuhEJSNIJjveux705iDCJdBjKjxQVws2xFjKnS7b4mAqPXo2KDch6PsGCH0UewUSFvXVGgTFFC0wvWFCw1Vs6owSc9hnZ2Un0BuGHwj tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(83))
-		_ = vFn3LNOVpbiq7tuhEJSNIJjveux705iDCJdBjKjxQVws2xFjKnS7b4mAqPXo2KDch6PsGCH0UewUSFvXVGgTFFC0wvWFCw1Vs6owSc9hnZ2Un0BuGHwj
-		var yuFLCL6aaVmEW4bmbpGjUNLYiuXucuxJZL1pBsMZI1te5gRnMS1FMOyYfkHCwITT tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(84))
-		_ = yuFLCL6aaVmEW4bmbpGjUNLYiuXucuxJZL1pBsMZI1te5gRnMS1FMOyYfkHCwITT
-		var m8LjdcDBbhN12WEjOdZxR0PHq6Ge3cUpfK3LpfIL4J1oZmyzsKDK9f5G6FuQUIkCSi33CYSnHfzwnf4w tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(85))
-		_ = m8LjdcDBbhN12WEjOdZxR0PHq6Ge3cUpfK3LpfIL4J1oZmyzsKDK9f5G6FuQUIkCSi33CYSnHfzwnf4w
-		var gaqh00kjevWJPN3WYAe2ypzagLBB36AUxBfSXpu4q5aI2Nb0iBxkVJlGtkdE6HIoFI0hEvtepz0wBwLNp3 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(86))
-		_ = gaqh00kjevWJPN3WYAe2ypzagLBB36AUxBfSXpu4q5aI2Nb0iBxkVJlGtkdE6HIoFI0hEvtepz0wBwLNp3
-		var tZ55I2zjTaavm9Cn4UOEYMsu6hOq3nbPpaIZEAKAjtiNJvEdLlZzA8Cg6A1X9jmWWtkRkpudhne9TDeTsTeIEIPdSa5ZIdgth2cv319igdN tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(87))
-		_ = tZ55I2zjTaavm9Cn4UOEYMsu6hOq3nbPpaIZEAKAjtiNJvEdLlZzA8Cg6A1X9jmWWtkRkpudhne9TDeTsTeIEIPdSa5ZIdgth2cv319igdN
-		var pF tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(88))
-		_ = pF
-		var sf5 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(89))
-		_ = sf5
-		var wjhEQPRWAesuTYj9dZS tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(90))
-		_ = wjhEQPRWAesuTYj9dZS
-		var l6OZkLfPrAuBHFrSb1vtohYtSEZ7vOHn0ffS9uEDtcjuFZacNRwp690DCqg7KxfppJqFxZrPqm55l30194sRBHHAT tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(91))
-		_ = l6OZkLfPrAuBHFrSb1vtohYtSEZ7vOHn0ffS9uEDtcjuFZacNRwp690DCqg7KxfppJqFxZrPqm55l30194sRBHHAT
-		var rROpgx2EiqCNLxWihN62orN5k1NgzKbzdPHRrffNCPb9Msucn8aLfI5koNa8jMOYBBkEVnbQ tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(92))
-		_ = rROpgx2EiqCNLxWihN62orN5k1NgzKbzdPHRrffNCPb9Msucn8aLfI5koNa8jMOYBBkEVnbQ
-		var mct6JBgDp10C8S3JaA6u0V4ylbKDam31oEi4p5KO5XL3AI4xGCT7JYj6ULFawsrY84rmey8N3GP25Agy2Cwjwh1VExM2 tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(93))
-		_ = mct6JBgDp10C8S3JaA6u0V4ylbKDam31oEi4p5KO5XL3AI4xGCT7JYj6ULFawsrY84rmey8N3GP25Agy2Cwjwh1VExM2
-		var zMfMHuoCZJqr9M4EFEoA6zeHvV8Qcmq3OEZatL2Bs8EJooKxO tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(94))
-		_ = zMfMHuoCZJqr9M4EFEoA6zeHvV8Qcmq3OEZatL2Bs8EJooKxO
-		var y7RreCQzwT2rdCkbl5NQhPYiYq8Xqwl8QlPJXBrE9TS4tYIWmXmJx9iWl0scuReRpyq2I2hSjgpyzGrixQ6TUXVMH1g4cryGl3ITIhtl tla.TLAValue = args1[2].ApplyFunction(tla.MakeTLANumber(95))
-		_ = y7RreCQzwT2rdCkbl5NQhPYiYq8Xqwl8QlPJXBrE9TS4tYIWmXmJx9iWl0scuReRpyq2I2hSjgpyzGrixQ6TUXVMH1g4cryGl3ITIhtl
-		var ydWAMLx2MOT5MVTV4DoLy4m630MxBh8OzY27IXNa1UwzilN9ABwtkToLjGTMbiFUNXwOxBlp3LCAIzc3syTXB tla.TLAValue = args1[3]
-		_ = ydWAMLx2MOT5MVTV4DoLy4m630MxBh8OzY27IXNa1UwzilN9ABwtkToLjGTMbiFUNXwOxBlp3LCAIzc3syTXB
-		var etowK7EFoQUX59yBqoM8xi95lPHpb8CKaWYZGFxKRxvVmmjp1zNKReMj2HrSki1acfNsCMuaJKmBMp tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(1))
-		_ = etowK7EFoQUX59yBqoM8xi95lPHpb8CKaWYZGFxKRxvVmmjp1zNKReMj2HrSki1acfNsCMuaJKmBMp
-		var a284jbPbjVqF0X2UtJwmmVg1SH8p tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(2))
-		_ = a284jbPbjVqF0X2UtJwmmVg1SH8p
-		var iyabbQGHL3A2cofYgYDt8ozmCnXHg7SnWOLkzHp7H02SPsQ89 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(3))
-		_ = iyabbQGHL3A2cofYgYDt8ozmCnXHg7SnWOLkzHp7H02SPsQ89
-		var jyYnukIyxNzevc tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(4))
-		_ = jyYnukIyxNzevc
-		var gc7uZIc4dkcjHD4yMwUjh1X5NPGOeQI3M1omOaHpNWeTQrEp9VvisChoWylFaRVVBevgxw tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(5))
-		_ = gc7uZIc4dkcjHD4yMwUjh1X5NPGOeQI3M1omOaHpNWeTQrEp9VvisChoWylFaRVVBevgxw
-		var gUYwQyGuLX8Pgswn5OGTlo3xsHwsjx2oxuauVhHQvpEch tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(6))
-		_ = gUYwQyGuLX8Pgswn5OGTlo3xsHwsjx2oxuauVhHQvpEch
-		var nc7gNHdXkYpdKm5uY tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(7))
-		_ = nc7gNHdXkYpdKm5uY
-		var kKa0FOAnjnOrXmqpw5mzjiGjspYPvCI3puDlt1jTnz0hhp6eg73Xe9igS9tmIDWBiLcPUA9cZz9cSSqKDCG5DRShCWvfozxKiwTRbTHl93hm tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(8))
-		_ = kKa0FOAnjnOrXmqpw5mzjiGjspYPvCI3puDlt1jTnz0hhp6eg73Xe9igS9tmIDWBiLcPUA9cZz9cSSqKDCG5DRShCWvfozxKiwTRbTHl93hm
-		var rc5DaAuTaan tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(9))
-		_ = rc5DaAuTaan
-		var gSRc0p3YqpK5aKeQWCmRTo7k8eWHkG6Sj5hDc92IfyWAYScvcvVVoCysyt0DEcpBrLYPeRadjFUUky tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(10))
-		_ = gSRc0p3YqpK5aKeQWCmRTo7k8eWHkG6Sj5hDc92IfyWAYScvcvVVoCysyt0DEcpBrLYPeRadjFUUky
-		var wZZlJc0Y tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(11))
-		_ = wZZlJc0Y
-		var gDWU3Yaf5TpHjG16tqZ7Y4XBE9jOmuexTW9QTpXgxBtQtgMXDNLvJ8ouhyo5jTaciR93j0Uy1GSQGA7H68DWEhB8whiA tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(12))
-		_ = gDWU3Yaf5TpHjG16tqZ7Y4XBE9jOmuexTW9QTpXgxBtQtgMXDNLvJ8ouhyo5jTaciR93j0Uy1GSQGA7H68DWEhB8whiA
-		var kpNeEfCmQUFFhVOIKorlbs3VVmV5VtVKjo7GytUjHAClhKO6YsR7BcQ2q tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(13))
-		_ = kpNeEfCmQUFFhVOIKorlbs3VVmV5VtVKjo7GytUjHAClhKO6YsR7BcQ2q
-		var qk43zXiG6c1ynj6IN2RnEt9NrmHi7EgpE1k2xLX1YDLDBJ tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(14))
-		_ = qk43zXiG6c1ynj6IN2RnEt9NrmHi7EgpE1k2xLX1YDLDBJ
-		var ttTPqW49W9sPsq9eTaVglySJNPQcooadMmaqJlunPt1oNgeCYprWMXzH3LGXg243CSkqR8iMLQ4PQqEjagSLoILZVfus4yxYILWZgqXwpAgeQMbn tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(15))
-		_ = ttTPqW49W9sPsq9eTaVglySJNPQcooadMmaqJlunPt1oNgeCYprWMXzH3LGXg243CSkqR8iMLQ4PQqEjagSLoILZVfus4yxYILWZgqXwpAgeQMbn
-		var bLxaB8d6aVKDXuWJr4XzmtR8jYhIrV92vDW9aUsrqsAw4Z6n8352eB7TjeNcZHNnqIfMjPteXrjMZoKbRKBvDomajju tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(16))
-		_ = bLxaB8d6aVKDXuWJr4XzmtR8jYhIrV92vDW9aUsrqsAw4Z6n8352eB7TjeNcZHNnqIfMjPteXrjMZoKbRKBvDomajju
-		var cdfnWfOSyFE18Q5XNQwl5NMMwDXu2ju96Wz9xvDwgMWIotzEYJktrsg54SdkCFMfU01g8y9Pmv1xCpXdZXQB tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(17))
-		_ = cdfnWfOSyFE18Q5XNQwl5NMMwDXu2ju96Wz9xvDwgMWIotzEYJktrsg54SdkCFMfU01g8y9Pmv1xCpXdZXQB
-		var zP5iGEufFFM3CF9z2lpsxoGMC5stqTQHR4y5BPbITV1 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(18))
-		_ = zP5iGEufFFM3CF9z2lpsxoGMC5stqTQHR4y5BPbITV1
-		var hI tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(19))
-		_ = hI
-		var pFpHArc9p5ZIND2njiWMsGm0OvnOtHHZRByIHBxk tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(20))
-		_ = pFpHArc9p5ZIND2njiWMsGm0OvnOtHHZRByIHBxk
-		var cY79colCh1o9bcp435NIcbUct8 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(21))
-		_ = cY79colCh1o9bcp435NIcbUct8
-		var bfN44OtHoWkOhR2F00McwRMlUEsdYGyDDAMvLrzlaSDnfSAaqzO2OY2osJ9vqodMZRIcla5bOqUZ6PrBGDq86Psk tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(22))
-		_ = bfN44OtHoWkOhR2F00McwRMlUEsdYGyDDAMvLrzlaSDnfSAaqzO2OY2osJ9vqodMZRIcla5bOqUZ6PrBGDq86Psk
-		var mTVb3JafsZNJpol3pq8UXmCsBJFOgpbggMC2944raVCtsOb tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(23))
-		_ = mTVb3JafsZNJpol3pq8UXmCsBJFOgpbggMC2944raVCtsOb
-		var tSKI6eYDoE5joNQ3Y6KakTccVsRIeKNwEsWv4yP8TZUbkWd5sVcEVDX6ihRqFT7ZYzvdrSI0koJ7527iwk8Qj1i8TLq193qqcIPNtGrfls7xL4uY4 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(24))
-		_ = tSKI6eYDoE5joNQ3Y6KakTccVsRIeKNwEsWv4yP8TZUbkWd5sVcEVDX6ihRqFT7ZYzvdrSI0koJ7527iwk8Qj1i8TLq193qqcIPNtGrfls7xL4uY4
-		var z6egaVrC5vB2VWsxvCK2Gr82zmP71Pul2X8kxfBAzwdkzlEROGpIBAuEBa3KBwxvSFvYyE4oMKpZUAEpZXTPbV0Pgv tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(25))
-		_ = z6egaVrC5vB2VWsxvCK2Gr82zmP71Pul2X8kxfBAzwdkzlEROGpIBAuEBa3KBwxvSFvYyE4oMKpZUAEpZXTPbV0Pgv
-		var gu8MDnkov0JG5jYPIqtFE tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(26))
-		_ = gu8MDnkov0JG5jYPIqtFE
-		var q5xyWgJ00O99QHe9Fuz19PSzzjh0WMc0Ah5Zsdw40YSy7ki5ifLPnOaXRsDU1G4l4gCubvc78lPqhbqS3brYZYogz3KYRoOolMobW tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(27))
-		_ = q5xyWgJ00O99QHe9Fuz19PSzzjh0WMc0Ah5Zsdw40YSy7ki5ifLPnOaXRsDU1G4l4gCubvc78lPqhbqS3brYZYogz3KYRoOolMobW
-		var fRo9FMKcd6jzIQzPpJvmsLw6jeXmbREp4D tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(28))
-		_ = fRo9FMKcd6jzIQzPpJvmsLw6jeXmbREp4D
-		var pN3P tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(29))
-		_ = pN3P
-		var lC2jzwqrQxR7I0O42dhDQfDZP8QpyXEhKD8WBH4GUtSiLVndQQWiziLGdA2hScIRkXFrCbFtBgT0 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(30))
-		_ = lC2jzwqrQxR7I0O42dhDQfDZP8QpyXEhKD8WBH4GUtSiLVndQQWiziLGdA2hScIRkXFrCbFtBgT0
-		var leLRf5Ch6vAYJug1vzfRvaGEb9DpnXdKSbn69czrhsDRDgNB7TfMRX213AdtHkhnJ4dMLupvEw3dpEShS tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(31))
-		_ = leLRf5Ch6vAYJug1vzfRvaGEb9DpnXdKSbn69czrhsDRDgNB7TfMRX213AdtHkhnJ4dMLupvEw3dpEShS
-		var ptVm7bj9pNQv7K5kMh0F9AzdPZmZwsjkzEZhH5IkqS5Pfc4qz0sjsopB00AlY7xcl0JD022mayWJIozyb7i18Q0N0zwkQDFyUjXEnJZDg6 tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(32))
-		_ = ptVm7bj9pNQv7K5kMh0F9AzdPZmZwsjkzEZhH5IkqS5Pfc4qz0sjsopB00AlY7xcl0JD022mayWJIozyb7i18Q0N0zwkQDFyUjXEnJZDg6
-		var fN4Fe0WJn43vUwG975r6I2jc8th1BpIFzN0t8aZrFs01bVuazR84TTauGkZUw1nEVqQzWtrxQwVXGhD9yHOJTUVus1Q0opNIGUJSZ6pUIo2AUCGe tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(33))
-		_ = fN4Fe0WJn43vUwG975r6I2jc8th1BpIFzN0t8aZrFs01bVuazR84TTauGkZUw1nEVqQzWtrxQwVXGhD9yHOJTUVus1Q0opNIGUJSZ6pUIo2AUCGe
-		var u tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(34))
-		_ = u
-		var yx0qRx7KwCSspcqGOhxVFZiQ41v8QpTI5vpQXBCxpOVllOkKQ4zav8G23KWLQAPt6PFLuzi tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(35))
-		_ = yx0qRx7KwCSspcqGOhxVFZiQ41v8QpTI5vpQXBCxpOVllOkKQ4zav8G23KWLQAPt6PFLuzi
-		var ykyhfTOp9JJ6LKf7iDYHiwcicY1Hk4yR64rrSta7VB7OjqEsXDubmvm42pqtKnPuXLM1R38RuGEX0OAnYeqxbfpSYRbmrKbBmt tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(36))
-		_ = ykyhfTOp9JJ6LKf7iDYHiwcicY1Hk4yR64rrSta7VB7OjqEsXDubmvm42pqtKnPuXLM1R38RuGEX0OAnYeqxbfpSYRbmrKbBmt
-		var jzRCQOkfoelnlGMWFBWD0lDMjB9wXh81Ec8VH66u8XFVQWmjlo8M6PTjeYRU7mNua tla.TLAValue = args1[4].ApplyFunction(tla.MakeTLANumber(37))
-		_ = jzRCQOkfoelnlGMWFBWD0lDMjB9wXh81Ec8VH66u8XFVQWmjlo8M6PTjeYRU7mNua
-		var g540XiUzWuW tla.TLAValue = args1[5]
-		_ = g540XiUzWuW
-		var aYGs4t9TNazIlSTuMV2UZJE2Su42dELDJebUslriCHsCdePddP6kT4herkEEZkaBrLcr19VzcoQUqUxLDj7aM9GlCyqIWhrn1gQ12Wcafcg tla.TLAValue = args1[6]
-		_ = aYGs4t9TNazIlSTuMV2UZJE2Su42dELDJebUslriCHsCdePddP6kT4herkEEZkaBrLcr19VzcoQUqUxLDj7aM9GlCyqIWhrn1gQ12Wcafcg
-		var qvEBcszWN2nDUY1XVCrYsYFk8gOLE76XaPswHtQNF9Wf5L5hSOy7zlHGM8C85CJb tla.TLAValue = args1[7]
-		_ = qvEBcszWN2nDUY1XVCrYsYFk8gOLE76XaPswHtQNF9Wf5L5hSOy7zlHGM8C85CJb
-		var h3eVDpYOYfPEu4Uwhv2oV0zWfhNx8FQsgDrNkvE69UO0lBNdoAH5vCSe tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(1))
-		_ = h3eVDpYOYfPEu4Uwhv2oV0zWfhNx8FQsgDrNkvE69UO0lBNdoAH5vCSe
-		var t1QTK tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(2))
-		_ = t1QTK
-		var w84fPMrBuganXXVvLMtj tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(3))
-		_ = w84fPMrBuganXXVvLMtj
-		var rIzkGpiYy tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(4))
-		_ = rIzkGpiYy
-		var xoBTPIlIinSdHROOaCElKNzITkNhRPihyCCen3xwPLS2C tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(5))
-		_ = xoBTPIlIinSdHROOaCElKNzITkNhRPihyCCen3xwPLS2C
-		var mZHrcqrncSs2AMOV1UW5gS0ihqlvwsHKJiZpGxNkRMRTckDyFNFxBfH15bZLu9jGudmWKhHIQiGiG2POUchzl57W3EddAii8IMlkcGdktelu6nDzn33 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(6))
-		_ = mZHrcqrncSs2AMOV1UW5gS0ihqlvwsHKJiZpGxNkRMRTckDyFNFxBfH15bZLu9jGudmWKhHIQiGiG2POUchzl57W3EddAii8IMlkcGdktelu6nDzn33
-		var rlx7mFbcDMWHiryCJX7Q2XKsPXAlh0yPUfAA5b1N1HqL4PTrTZInaUqzMSexEm3iAilnPspoxsh5i9RVN4xN2TTHquRN1zkb4 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(7))
-		_ = rlx7mFbcDMWHiryCJX7Q2XKsPXAlh0yPUfAA5b1N1HqL4PTrTZInaUqzMSexEm3iAilnPspoxsh5i9RVN4xN2TTHquRN1zkb4
-		var m15lJDoqUAh8v7CsbTs3Wp tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(8))
-		_ = m15lJDoqUAh8v7CsbTs3Wp
-		var eOb6vevL81U tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(9))
-		_ = eOb6vevL81U
-		var hhJEaLY08Po16pXZswc7xw6l9UwQVwPn7bMvpc tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(10))
-		_ = hhJEaLY08Po16pXZswc7xw6l9UwQVwPn7bMvpc
-		var d18ashyGFyk9ia2yYMBEwYSV58ePxfIaztz3YhDg3gaBjp2G9tfUhtrObBNRXaSgjNnk3hB7pyOJ3qu0KMxzt9ZmHhscHn6KQXQWgWMqPuXARjWui0nx1 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(11))
-		_ = d18ashyGFyk9ia2yYMBEwYSV58ePxfIaztz3YhDg3gaBjp2G9tfUhtrObBNRXaSgjNnk3hB7pyOJ3qu0KMxzt9ZmHhscHn6KQXQWgWMqPuXARjWui0nx1
-		var zMNPvYJfm1cx1PCToyuBVV22dkoULajysp1XaJgmYxZcoIrFMyvfu6o6DugoUvktV tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(12))
-		_ = zMNPvYJfm1cx1PCToyuBVV22dkoULajysp1XaJgmYxZcoIrFMyvfu6o6DugoUvktV
-		var gRk00ax6tUAdm1b0w7QRt8L1UzEv tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(13))
-		_ = gRk00ax6tUAdm1b0w7QRt8L1UzEv
-		var vGqMXN0wkJ3AYAPG5KrhvsM586VwgWMfFvnwNwBmfPDmsaFmfXFyAwNuDT2i062k81JdpY6UZWmzrobu7 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(14))
-		_ = vGqMXN0wkJ3AYAPG5KrhvsM586VwgWMfFvnwNwBmfPDmsaFmfXFyAwNuDT2i062k81JdpY6UZWmzrobu7
-		var hmPP4RqXjv3PcM9L tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(15))
-		_ = hmPP4RqXjv3PcM9L
-		var p9BfAdDJta7j5vBu8GwE6v1KKzyg8LkDRBzKu3Th2olbGn6XxRZtsuXKw07F7C89gI9YkqB3M5E6ZZim5a3Eax3WdkROAOnQerx3yHwkJ tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(16))
-		_ = p9BfAdDJta7j5vBu8GwE6v1KKzyg8LkDRBzKu3Th2olbGn6XxRZtsuXKw07F7C89gI9YkqB3M5E6ZZim5a3Eax3WdkROAOnQerx3yHwkJ
-		var oLaDcBJTmTFAlGnwnA6knxTSMaN56l33Ev5hdiegDcn9aXcTPqIsbQU41r4veAanTQlUpeHo53xgaYfPMSVrk tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(17))
-		_ = oLaDcBJTmTFAlGnwnA6knxTSMaN56l33Ev5hdiegDcn9aXcTPqIsbQU41r4veAanTQlUpeHo53xgaYfPMSVrk
-		var ucSNWORVhygfRHKpn4EZ8fElwtzIuW2OApmJpIRLweHEUldjTlvEmB1qPxxjISGYdb tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(18))
-		_ = ucSNWORVhygfRHKpn4EZ8fElwtzIuW2OApmJpIRLweHEUldjTlvEmB1qPxxjISGYdb
-		var iL683B2m9GrO3u34uIAoRw15QQrKBZvTIm55Y tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(19))
-		_ = iL683B2m9GrO3u34uIAoRw15QQrKBZvTIm55Y
-		var mpP4hQJIVPMxCnewQANWCRsuwFPeq7XS tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(20))
-		_ = mpP4hQJIVPMxCnewQANWCRsuwFPeq7XS
-		var ins8blVUi3KO8anCtC3SvgYVqpHuHJ9uEaGaba1spCHti0mKebNfGulWGeIXs4R23MEFiINeQwQS64gSqJcblCd9doyBaQl5UfDHag3qDyTbF9OlKU tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(21))
-		_ = ins8blVUi3KO8anCtC3SvgYVqpHuHJ9uEaGaba1spCHti0mKebNfGulWGeIXs4R23MEFiINeQwQS64gSqJcblCd9doyBaQl5UfDHag3qDyTbF9OlKU
-		var eA5UggaJ3Yx3abzGfcG3ZZF6Y20NEyzjcPOxmvmGcchlkyA2Ch7smnK8omH9skKigTrgJRLsrojZAiKclu5iiQG5cBXj8wuUGSWSqLQ3fuvMZ2jgetpR tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(22))
-		_ = eA5UggaJ3Yx3abzGfcG3ZZF6Y20NEyzjcPOxmvmGcchlkyA2Ch7smnK8omH9skKigTrgJRLsrojZAiKclu5iiQG5cBXj8wuUGSWSqLQ3fuvMZ2jgetpR
-		var p10h4BGcoB tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(23))
-		_ = p10h4BGcoB
-		var gd8MthOgmLZ7OjY6IH7EOevgbEndCnHzCdQjRhvQJEwaPOtJsqh27djWnUtz1nUuNlt8RuG7xsF8ZvpZORalipTZcPseoiSEZ95jjKY2Ie tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(24))
-		_ = gd8MthOgmLZ7OjY6IH7EOevgbEndCnHzCdQjRhvQJEwaPOtJsqh27djWnUtz1nUuNlt8RuG7xsF8ZvpZORalipTZcPseoiSEZ95jjKY2Ie
-		var xHfBzjxJXztcx5YuayReuvyV5yQrePdTonughA5ZWkLq7nMQppIYyP1eYEaM9po3CdJw9qh0rJcyxdi54G4T5lXRVR2dDWIGwxfv tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(25))
-		_ = xHfBzjxJXztcx5YuayReuvyV5yQrePdTonughA5ZWkLq7nMQppIYyP1eYEaM9po3CdJw9qh0rJcyxdi54G4T5lXRVR2dDWIGwxfv
-		var q0tZNVYHRhVAso8zs6F2vKqynheOS tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(26))
-		_ = q0tZNVYHRhVAso8zs6F2vKqynheOS
-		var wyzr7I9kPR2eEemMHXGTWM4aiQjafWoFU67icaZ3djKFtY tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(27))
-		_ = wyzr7I9kPR2eEemMHXGTWM4aiQjafWoFU67icaZ3djKFtY
-		var hINowSsf8KIQkJh0mMmxzXB8QZd6RCSTyLm5Eba4clhn7pnU87NSXxjuKNVax4a9j6LUpgADam82QwidLSuFJT tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(28))
-		_ = hINowSsf8KIQkJh0mMmxzXB8QZd6RCSTyLm5Eba4clhn7pnU87NSXxjuKNVax4a9j6LUpgADam82QwidLSuFJT
-		var n7q7WqSNJijtwPrpV12Yp41f2Rwfrh6w359smpTQfjtX9vrjrvA07Mbv9J8Qu10m53R3zOxFDxvLkKFvl tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(29))
-		_ = n7q7WqSNJijtwPrpV12Yp41f2Rwfrh6w359smpTQfjtX9vrjrvA07Mbv9J8Qu10m53R3zOxFDxvLkKFvl
-		var f8MC8TIHp28cDEZjkWnMaEBEClVsMk20W6EmqKwY2tXsWLZv6FhZ5NmY7hstbJaTDnOowsBWNOMBp9TFNQ5LT1HqjZRjW0pyvfNZshOER tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(30))
-		_ = f8MC8TIHp28cDEZjkWnMaEBEClVsMk20W6EmqKwY2tXsWLZv6FhZ5NmY7hstbJaTDnOowsBWNOMBp9TFNQ5LT1HqjZRjW0pyvfNZshOER
-		var mQ7Xw9sjbTpcM7EC62YahcXERqH88vg8iSLebHbamQexM9ZClCz7rUqHQzR2sMdzmX3pXlzBFDDCXxW7o3YKf tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(31))
-		_ = mQ7Xw9sjbTpcM7EC62YahcXERqH88vg8iSLebHbamQexM9ZClCz7rUqHQzR2sMdzmX3pXlzBFDDCXxW7o3YKf
-		var wdOPDSYTrzfKgTw9FR4fHF0YfrWS47ockurHMQWKNmhCAn3H6MqRlq6NFzYUDMa8Ykf9IeVbI4XwjfjWw tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(32))
-		_ = wdOPDSYTrzfKgTw9FR4fHF0YfrWS47ockurHMQWKNmhCAn3H6MqRlq6NFzYUDMa8Ykf9IeVbI4XwjfjWw
-		var yxVcTiHXLl72oQ3JhJXkIRPuECC0xasg3ZfavcnUGGjiIxJKUWaarEGVHgQuHsIVHWiIoALs tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(33))
-		_ = yxVcTiHXLl72oQ3JhJXkIRPuECC0xasg3ZfavcnUGGjiIxJKUWaarEGVHgQuHsIVHWiIoALs
-		var aula1nESBOVd5gtPGPPMJ2OsrbYw5AhLtJhjCzGK2c4F6wYnFrAVpw1BSqKsTiDJtDEzKi68D0dfD7s6HTU74PRgvlN7vdUzcoK tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(34))
-		_ = aula1nESBOVd5gtPGPPMJ2OsrbYw5AhLtJhjCzGK2c4F6wYnFrAVpw1BSqKsTiDJtDEzKi68D0dfD7s6HTU74PRgvlN7vdUzcoK
-		var uqPDtPMFGvNAUyqhW6ju3Cx5YsOXrFW30p8fSLQkX1LRrt2qmSx tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(35))
-		_ = uqPDtPMFGvNAUyqhW6ju3Cx5YsOXrFW30p8fSLQkX1LRrt2qmSx
-		var ikA8zqABKwdOzA7mEXO5qb4MxJX7pFNthLpKQpBwg66bjDRf3J6uybAyIXjYF2 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(36))
-		_ = ikA8zqABKwdOzA7mEXO5qb4MxJX7pFNthLpKQpBwg66bjDRf3J6uybAyIXjYF2
-		var stAnRH2OXCG0ioTrC0ZJqMfzTMUt5yc6GenUjgt6FIoLViffJj0tgCSTYNWz3GQX8U4xl57eH3hgp4bMmctt4bCcd1WbbtXFhiI1bI5PeyJo tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(37))
-		_ = stAnRH2OXCG0ioTrC0ZJqMfzTMUt5yc6GenUjgt6FIoLViffJj0tgCSTYNWz3GQX8U4xl57eH3hgp4bMmctt4bCcd1WbbtXFhiI1bI5PeyJo
-		var iRGDAtLyvp6Md0oLl5cNrVFOuisIM8yidqHF1c8bmhxfEmTr08USlkdOu06BqiFj4tFluLO9HQbzmR17pjG8T7TsIWryTQ2wmKzGEspd0TRRJP3sz64 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(38))
-		_ = iRGDAtLyvp6Md0oLl5cNrVFOuisIM8yidqHF1c8bmhxfEmTr08USlkdOu06BqiFj4tFluLO9HQbzmR17pjG8T7TsIWryTQ2wmKzGEspd0TRRJP3sz64
-		var t8cbNVkDolkUg9dbWuAS2SexNQ1sUTqri8SJhn72JI95PTAEWEOXmSaoZqXX7m1Ld9vnRlgKOE2xl3qe5PDIJrvKew5F tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(39))
-		_ = t8cbNVkDolkUg9dbWuAS2SexNQ1sUTqri8SJhn72JI95PTAEWEOXmSaoZqXX7m1Ld9vnRlgKOE2xl3qe5PDIJrvKew5F
-		var iIwoThkOieWB6OiZisAXnkWqEAnX7fRewORrYSG3XfgOoJsinufiA4y332X0KJ4JZF72fDx7F7jfeAEZqbiSSdHAJovgiHC7itT52KV1nMtBabZhHN tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(40))
-		_ = iIwoThkOieWB6OiZisAXnkWqEAnX7fRewORrYSG3XfgOoJsinufiA4y332X0KJ4JZF72fDx7F7jfeAEZqbiSSdHAJovgiHC7itT52KV1nMtBabZhHN
-		var hA1qX30I4P9t0OnT7oEvKiuO7L3G84vV2hFEQv1ZEoNictCnScNw8KokcyF8TvQ0mE tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(41))
-		_ = hA1qX30I4P9t0OnT7oEvKiuO7L3G84vV2hFEQv1ZEoNictCnScNw8KokcyF8TvQ0mE
-		var emrTERlQAH3oV9oYXudCUeDgEZMt6kUJ11fyAseG1hC3sicpBsTiycEmSWF5Z6G3RE2 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(42))
-		_ = emrTERlQAH3oV9oYXudCUeDgEZMt6kUJ11fyAseG1hC3sicpBsTiycEmSWF5Z6G3RE2
-		var fijjCjv5z9691cRJJq6MfTy46rX97RrYjqjbjOf44ComM tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(43))
-		_ = fijjCjv5z9691cRJJq6MfTy46rX97RrYjqjbjOf44ComM
-		var v2Iy0SxiELhDNug49ER6Esbp6qXa1tgD1UJV1iejLCaprBqtU6oSGjiOprhLIfA6xTBCNEjuwJnqoSgFZkzybwzGYx3i tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(44))
-		_ = v2Iy0SxiELhDNug49ER6Esbp6qXa1tgD1UJV1iejLCaprBqtU6oSGjiOprhLIfA6xTBCNEjuwJnqoSgFZkzybwzGYx3i
-		var rqNra75eWr7q4pJ0rg2UI3IUo0XvrM1Bu5AEocttlHOLhjtVIvAG4b4B9gpQF7VwcGaO7opgMyAjMPA3hRdvKdWxjIl0Zsv02CoCSEgo6yQFht956oPF tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(45))
-		_ = rqNra75eWr7q4pJ0rg2UI3IUo0XvrM1Bu5AEocttlHOLhjtVIvAG4b4B9gpQF7VwcGaO7opgMyAjMPA3hRdvKdWxjIl0Zsv02CoCSEgo6yQFht956oPF
-		var eX4JH3ImJeq8Z6YyDfevUYywQa4s1Ghu tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(46))
-		_ = eX4JH3ImJeq8Z6YyDfevUYywQa4s1Ghu
-		var uUSpvtA tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(47))
-		_ = uUSpvtA
-		var amAm22z3MgN3OeB86tFuQ6buVYn9P5i tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(48))
-		_ = amAm22z3MgN3OeB86tFuQ6buVYn9P5i
-		var yFxIyFCElUVv0ZZZHWWmPCwaSc4qzJO64oBFBPNJKUvHTNDiqhaYv43JzC8xMyrLDrom6QRrHDOfMuXAVGG tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(49))
-		_ = yFxIyFCElUVv0ZZZHWWmPCwaSc4qzJO64oBFBPNJKUvHTNDiqhaYv43JzC8xMyrLDrom6QRrHDOfMuXAVGG
-		var tVwg55F0kCdzGoYRUoBLZUZRJRtrzpW2sHs3Q6QWPuY tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(50))
-		_ = tVwg55F0kCdzGoYRUoBLZUZRJRtrzpW2sHs3Q6QWPuY
-		var hTRYDwP87ZFQW7563Yzdg2KvcMAfYo4hotUl6S35gEp85Qp9jMuXU4F2JG1r tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(51))
-		_ = hTRYDwP87ZFQW7563Yzdg2KvcMAfYo4hotUl6S35gEp85Qp9jMuXU4F2JG1r
-		var aqqxCscVkHYyTEGoT7AC6RjmRK6b7TKrcvATRrSn0GINIQNOFGAwnXRmvccGp7umbpNOP2cUadlcdmmvoYIFJerJaFAkADDiW6pdngRE9I tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(52))
-		_ = aqqxCscVkHYyTEGoT7AC6RjmRK6b7TKrcvATRrSn0GINIQNOFGAwnXRmvccGp7umbpNOP2cUadlcdmmvoYIFJerJaFAkADDiW6pdngRE9I
-		var ikyVMhosvYlAtsybOWmYykzeofSZ2c2A8iucHrN7rDBke2Lvs93STQrMUzUt6I tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(53))
-		_ = ikyVMhosvYlAtsybOWmYykzeofSZ2c2A8iucHrN7rDBke2Lvs93STQrMUzUt6I
-		var oHZWS2wwae9QE9p595HGbB41GTPhl tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(54))
-		_ = oHZWS2wwae9QE9p595HGbB41GTPhl
-		var rNrjLfORxO2mLlyGCNdSUX2py6sLxw9pdHpcz6JA6WHdINtkCh7fxAlPl5fvPvsRoIrLtLL0c6crJ1IxSdYYynKeqwojXdFMlMsvTpTsobKeeHmX5 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(55))
-		_ = rNrjLfORxO2mLlyGCNdSUX2py6sLxw9pdHpcz6JA6WHdINtkCh7fxAlPl5fvPvsRoIrLtLL0c6crJ1IxSdYYynKeqwojXdFMlMsvTpTsobKeeHmX5
-		var oWO0T1AwaZqJ7ggl2TycDqIzvep9DqVWLPEdKnxzcRIs4va7nYqsPw0ZM4VoCPkSx4Djai4HBiWzptoW5NBqZNrcBnt3XblOsdRntN5 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(56))
-		_ = oWO0T1AwaZqJ7ggl2TycDqIzvep9DqVWLPEdKnxzcRIs4va7nYqsPw0ZM4VoCPkSx4Djai4HBiWzptoW5NBqZNrcBnt3XblOsdRntN5
-		var uuyx34pOqEXHtPRQVm5q4FQCtAGkINPFGuDjg2YWkcrTUsyW4eONwTwYhoEdRwKfd tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(57))
-		_ = uuyx34pOqEXHtPRQVm5q4FQCtAGkINPFGuDjg2YWkcrTUsyW4eONwTwYhoEdRwKfd
-		var i5pdkjoQEmYyAKaccMaT5Y1BbcWEU7hQFdA8D tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(58))
-		_ = i5pdkjoQEmYyAKaccMaT5Y1BbcWEU7hQFdA8D
-		var u4TFyZmNLRu0jMq9kRj5ByuIyaavjaXC tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(59))
-		_ = u4TFyZmNLRu0jMq9kRj5ByuIyaavjaXC
-		var bCnd3lgbn8AEYrz35Olj4mrfMaUA0rMMMJNLlWinfF7xDZT9eQU2hi8c2wby32i02n6 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(60))
-		_ = bCnd3lgbn8AEYrz35Olj4mrfMaUA0rMMMJNLlWinfF7xDZT9eQU2hi8c2wby32i02n6
-		var l5a00qJjCkAUhJbbGXfx tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(61))
-		_ = l5a00qJjCkAUhJbbGXfx
-		var whk5pbbXrl8EyktJfL4uH9OjPtgPDkGie2vGhtitYIhrPMrfWNC1aTbjM0jGvxT0PFRN tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(62))
-		_ = whk5pbbXrl8EyktJfL4uH9OjPtgPDkGie2vGhtitYIhrPMrfWNC1aTbjM0jGvxT0PFRN
-		var vkicNB7VCEXLYqZy8rM8zn3kBDPcraPv tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(63))
-		_ = vkicNB7VCEXLYqZy8rM8zn3kBDPcraPv
-		var vXaFAhLFk01GkX23lxfLzHmoUBEPOaGqmi9nagFcxSInh0WRGRWWgW2B5DICc2qAkY tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(64))
-		_ = vXaFAhLFk01GkX23lxfLzHmoUBEPOaGqmi9nagFcxSInh0WRGRWWgW2B5DICc2qAkY
-		var ug6HFyKbt9wHl5PuLs5fPf2cgXIgDOVT5ZytO6vy1aI8XYQmiWfLwrYBucJXLd tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(65))
-		_ = ug6HFyKbt9wHl5PuLs5fPf2cgXIgDOVT5ZytO6vy1aI8XYQmiWfLwrYBucJXLd
-		var moR8Z3AoE9eRiTrwEqLfeA2v851cvJqmyDosu5r0i5WQBhXfOZofS3gJEnoZdICB2CCP8ukB8COzK7tmUJKXsPcFBcmkOQWLX8KUCpizTD tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(66))
-		_ = moR8Z3AoE9eRiTrwEqLfeA2v851cvJqmyDosu5r0i5WQBhXfOZofS3gJEnoZdICB2CCP8ukB8COzK7tmUJKXsPcFBcmkOQWLX8KUCpizTD
-		var lWqoslAyOe7ZbNPnWBngrgWGxeknwngY4XocKrdbicBzkqMk9SxC0 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(67))
-		_ = lWqoslAyOe7ZbNPnWBngrgWGxeknwngY4XocKrdbicBzkqMk9SxC0
-		var fDZFG5YJL1axDp67CRoJmVHCMA3yYgOE0y06zPHwN9uvyAAhMyBXmoPuh19En4oSL8V6A7a4nCt5qFHme4oO3iXW tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(68))
-		_ = fDZFG5YJL1axDp67CRoJmVHCMA3yYgOE0y06zPHwN9uvyAAhMyBXmoPuh19En4oSL8V6A7a4nCt5qFHme4oO3iXW
-		var iBxgZiS1rbq71UK6IMipVYB5cP0d57cZ4iMqmO6kiNNLaOZBVBXnM3P7jGe0Gu2B tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(69))
-		_ = iBxgZiS1rbq71UK6IMipVYB5cP0d57cZ4iMqmO6kiNNLaOZBVBXnM3P7jGe0Gu2B
-		var klTVkZrEM0oSSpxtOuRrXc9VYtd24XL3vmvOH975eAFX tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(70))
-		_ = klTVkZrEM0oSSpxtOuRrXc9VYtd24XL3vmvOH975eAFX
-		var oCovnHJeKvWaoxLRBc7cLvPOoIkUlkuw7HiTbmTGezyTo6hIra2H1Chv91wdSV32UQT1V tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(71))
-		_ = oCovnHJeKvWaoxLRBc7cLvPOoIkUlkuw7HiTbmTGezyTo6hIra2H1Chv91wdSV32UQT1V
-		var yjjjqdnUl3YMMlHu2yntutqjPMQDv4cVVqze2q7oUid9KMJZaR7aVbdlq26WcXh3ZktjggjvEJRqXl4IvWFfGDYfvWyWuwojYTBcMMpMZ7 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(72))
-		_ = yjjjqdnUl3YMMlHu2yntutqjPMQDv4cVVqze2q7oUid9KMJZaR7aVbdlq26WcXh3ZktjggjvEJRqXl4IvWFfGDYfvWyWuwojYTBcMMpMZ7
-		var rUIeBO4PxP1pyorqVHawVRRnsesBLJ0YIHn7fCRsiXCOT tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(73))
-		_ = rUIeBO4PxP1pyorqVHawVRRnsesBLJ0YIHn7fCRsiXCOT
-		var vh8mQA382qTy9CVlIARC96Xy19uJpT62YDsMtgsN5FgKySieD9BUg006FgKKEkmJwadKGjt4GeD6G7R9UlVnfCG78FBQrQ149xJnYlEui5L tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(74))
-		_ = vh8mQA382qTy9CVlIARC96Xy19uJpT62YDsMtgsN5FgKySieD9BUg006FgKKEkmJwadKGjt4GeD6G7R9UlVnfCG78FBQrQ149xJnYlEui5L
-		var gdR9kpCGH2jL945wF4kUBkjDqTXJ6w1HWXLbbBLA3b8EntiT2gqUtu0vMHVv3fcfxyC0Fw3rokPPDZu64FLpi2lqSx6DU7KHRiwP1ASNE5s4LUYm0kij tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(75))
-		_ = gdR9kpCGH2jL945wF4kUBkjDqTXJ6w1HWXLbbBLA3b8EntiT2gqUtu0vMHVv3fcfxyC0Fw3rokPPDZu64FLpi2lqSx6DU7KHRiwP1ASNE5s4LUYm0kij
-		var cd6WKGw8Hw03xfROLA1IIb9BL1ObPKy1oQVQ1hcsq5KLO6Zxvm0j6LYpO0sXeMkHWlu8pfB0pOQqC8j8qacAsXPKHP0hzwsbbkjrH2YzAK tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(76))
-		_ = cd6WKGw8Hw03xfROLA1IIb9BL1ObPKy1oQVQ1hcsq5KLO6Zxvm0j6LYpO0sXeMkHWlu8pfB0pOQqC8j8qacAsXPKHP0hzwsbbkjrH2YzAK
-		var dbM1TkwpRPc0PgWiaVEXB1tel47umanCFzybpcfmVQvr9XTtONAD9aQx743VciZpEpcgqs3wOTpvBx7hSzrYLz tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(77))
-		_ = dbM1TkwpRPc0PgWiaVEXB1tel47umanCFzybpcfmVQvr9XTtONAD9aQx743VciZpEpcgqs3wOTpvBx7hSzrYLz
-		var jRL2MVQT6WnZXT4qY1nuotAa8YDXkW tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(78))
-		_ = jRL2MVQT6WnZXT4qY1nuotAa8YDXkW
-		var sYuXWqFveyFtOFyAEI3JyV4PzEg7uFAPR1AaaBBzn9aA76x4vNpLnZ3wOuQhIusHBwQZyq9BJq4wIWWsqt8BdwiIaBp0JT tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(79))
-		_ = sYuXWqFveyFtOFyAEI3JyV4PzEg7uFAPR1AaaBBzn9aA76x4vNpLnZ3wOuQhIusHBwQZyq9BJq4wIWWsqt8BdwiIaBp0JT
-		var gFGwu6fi0A4zAoz5i6YEzv6 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(80))
-		_ = gFGwu6fi0A4zAoz5i6YEzv6
-		var swOt2qi0E1rLgTxgU4KDtMlpBWR3M4eqFvkD0BAA1XvYhek92SBYxyv8UwA5Vy6e0n0cs6SXV8c5lZzB9 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(81))
-		_ = swOt2qi0E1rLgTxgU4KDtMlpBWR3M4eqFvkD0BAA1XvYhek92SBYxyv8UwA5Vy6e0n0cs6SXV8c5lZzB9
-		var uvdQCyNEyoGGHcZxJzsIQ53tDYozQsg0NO4wFu2NN3JollurhhbUoTsz3cL tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(82))
-		_ = uvdQCyNEyoGGHcZxJzsIQ53tDYozQsg0NO4wFu2NN3JollurhhbUoTsz3cL
-		var lLQxPtGr4WtU5ofH0 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(83))
-		_ = lLQxPtGr4WtU5ofH0
-		var lRr9cePefrg21fkGJg8dH2OtioaXHT35VyYJHFPtIc1jgcPJwVJvS8kQPR7Nsj3SkZdtXlm tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(84))
-		_ = lRr9cePefrg21fkGJg8dH2OtioaXHT35VyYJHFPtIc1jgcPJwVJvS8kQPR7Nsj3SkZdtXlm
-		var fmkrGQGy0GYAp7uQbt6Tlyvxdvk2gUECy7scMvzPxMz9R2MkeBO tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(85))
-		_ = fmkrGQGy0GYAp7uQbt6Tlyvxdvk2gUECy7scMvzPxMz9R2MkeBO
-		var rAcqRbdabaLQ3A2Q1sjMasaUtyMzXkZ6iwzn97HMGgS39ELQY023rPiYJ1ydURjAj tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(86))
-		_ = rAcqRbdabaLQ3A2Q1sjMasaUtyMzXkZ6iwzn97HMGgS39ELQY023rPiYJ1ydURjAj
-		var bYNTxT6JlQpVaZSTwpz2zzis24SZd91K7fjoxaNAdmmwunHK46gABJY3lDsXe tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(87))
-		_ = bYNTxT6JlQpVaZSTwpz2zzis24SZd91K7fjoxaNAdmmwunHK46gABJY3lDsXe
-		var gLsn8tgsdyQG1eQb8vvFgM7iM47pEE5pM264jxo3wmXJIjircWHKViHv4Lilw9o9IzKMt tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(88))
-		_ = gLsn8tgsdyQG1eQb8vvFgM7iM47pEE5pM264jxo3wmXJIjircWHKViHv4Lilw9o9IzKMt
-		var xYStFbWb0P07CjV3kNi12Xwm88Z9IgMXZYeLGJ7y7m8qeqgMrXo5oH6k08vKgH tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(89))
-		_ = xYStFbWb0P07CjV3kNi12Xwm88Z9IgMXZYeLGJ7y7m8qeqgMrXo5oH6k08vKgH
-		var kQPlBCYdjVDruBURZihFVCbjDJlF2NOMcTdR7APGD4dIY7ejSYZKCoB0k1HyvYm8P62KHgCxSXN21LPZXAo2FYK1qvXzUSirAbtieFA6 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(90))
-		_ = kQPlBCYdjVDruBURZihFVCbjDJlF2NOMcTdR7APGD4dIY7ejSYZKCoB0k1HyvYm8P62KHgCxSXN21LPZXAo2FYK1qvXzUSirAbtieFA6
-		var xkty3141qL91g2tPyGPRcSL9OL5ij82ko9dkJtOelzkIGgCZhEZXmvn tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(91))
-		_ = xkty3141qL91g2tPyGPRcSL9OL5ij82ko9dkJtOelzkIGgCZhEZXmvn
-		var aavJ4HsMryy871Gq tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(92))
-		_ = aavJ4HsMryy871Gq
-		var c2m6u7Zv49W0P5NRrnUVkAIjnRb1jWc2Vt9qfZy58QxIla8Ik1ucwPHORcQ9VbitS01y0ZMx6NnFpq5dQLZ tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(93))
-		_ = c2m6u7Zv49W0P5NRrnUVkAIjnRb1jWc2Vt9qfZy58QxIla8Ik1ucwPHORcQ9VbitS01y0ZMx6NnFpq5dQLZ
-		var xZirE4BsNw6pEvk0z5q7XsTSdYz6Hj75NurpA9xSolCkUdkx3ruVqQKAeHmIaksrWEQBzW2DAsuT8lwSA6PtYc0hzOBTf8pNCkNAQt tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(94))
-		_ = xZirE4BsNw6pEvk0z5q7XsTSdYz6Hj75NurpA9xSolCkUdkx3ruVqQKAeHmIaksrWEQBzW2DAsuT8lwSA6PtYc0hzOBTf8pNCkNAQt
-		var mZxXvgf1HMjOr5hVjtWnsD9tnObCPQq9ZH tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(95))
-		_ = mZxXvgf1HMjOr5hVjtWnsD9tnObCPQq9ZH
-		var nLo3pYo61mbUlziHU8lMAhK9cYnvTXX4Hn1NNzuKI7T68Qk tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(96))
-		_ = nLo3pYo61mbUlziHU8lMAhK9cYnvTXX4Hn1NNzuKI7T68Qk
-		var mmcY3EnEPtq7zUi56lBf9r1yOgCJC10HoMEU6oWVLDzH tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(97))
-		_ = mmcY3EnEPtq7zUi56lBf9r1yOgCJC10HoMEU6oWVLDzH
-		var advsjMU0ozmbKV8ICxJsgpJmWSvFpWyVG9jJAviuTP9BvIRcJqVZXy8stV0MVy6cyNbVKlJ8CmzC0zHJ6YHiKyJ tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(98))
-		_ = advsjMU0ozmbKV8ICxJsgpJmWSvFpWyVG9jJAviuTP9BvIRcJqVZXy8stV0MVy6cyNbVKlJ8CmzC0zHJ6YHiKyJ
-		var fOG2xUTp tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(99))
-		_ = fOG2xUTp
-		var gViVRGnWamBkkT6kyCckMiDCFP8zfADlQ5CfUmeeZ4HriBRjPK5aS54sbrngczZzu7rJcUJ1QdF1hPgw4bOWJQeiA9S6gt tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(100))
-		_ = gViVRGnWamBkkT6kyCckMiDCFP8zfADlQ5CfUmeeZ4HriBRjPK5aS54sbrngczZzu7rJcUJ1QdF1hPgw4bOWJQeiA9S6gt
-		var jCMELIWgOPVtYpCiLoligcNKYM2J4pCNiv5dAPSr4MHZ4aVnUUUMQfz059tqJbMBEZ7vonfVo tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(101))
-		_ = jCMELIWgOPVtYpCiLoligcNKYM2J4pCNiv5dAPSr4MHZ4aVnUUUMQfz059tqJbMBEZ7vonfVo
-		var a8j6jcVYaWWB4ia7c51xTfXWsGEnTtz tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(102))
-		_ = a8j6jcVYaWWB4ia7c51xTfXWsGEnTtz
-		var dEPzrqpjIzC2YP9HlCbOJ5jY7n28ywVJdOLx5UxWxZKx0bwWs0zPOyi7q9j4IZ08tqOmiKaP72tFH4R0EBxpPhefK8fB0G324w1QDghsD2Z tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(103))
-		_ = dEPzrqpjIzC2YP9HlCbOJ5jY7n28ywVJdOLx5UxWxZKx0bwWs0zPOyi7q9j4IZ08tqOmiKaP72tFH4R0EBxpPhefK8fB0G324w1QDghsD2Z
-		var gZYU2nUuXcbdNfMsKtX5OOQ6a tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(104))
-		_ = gZYU2nUuXcbdNfMsKtX5OOQ6a
-		var v tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(105))
-		_ = v
-		var bebyNgc2YIAOawioJV5JakVRVmSU4lyAN tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(106))
-		_ = bebyNgc2YIAOawioJV5JakVRVmSU4lyAN
-		var azxmyMuR1B4RuUOrySet6019Vo6oLvO2x2ldWLuC tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(107))
-		_ = azxmyMuR1B4RuUOrySet6019Vo6oLvO2x2ldWLuC
-		var b0caZBAxMtl4jaZHBNd tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(108))
-		_ = b0caZBAxMtl4jaZHBNd
-		var aXxRiFCwKxGfz890qLVt0RDm1D2UCfbScKRxr3jBLXTLhmFnJbrMn8yJe tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(109))
-		_ = aXxRiFCwKxGfz890qLVt0RDm1D2UCfbScKRxr3jBLXTLhmFnJbrMn8yJe
-		var dv14Jv4a20Ii3B5e7qOTkpxUDsElDMbXTfU93QguIr09FFWO9p1WsrBcl5NuoP63BCt36cGmiV6mF tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(110))
-		_ = dv14Jv4a20Ii3B5e7qOTkpxUDsElDMbXTfU93QguIr09FFWO9p1WsrBcl5NuoP63BCt36cGmiV6mF
-		var vCCAhl4mwt18HbSoKusSlsnSWTjgK6Zprow2qswmC0WSq08sEZbWOu2 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(111))
-		_ = vCCAhl4mwt18HbSoKusSlsnSWTjgK6Zprow2qswmC0WSq08sEZbWOu2
-		var f6wXazlo9oOSxMAFPfg tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(112))
-		_ = f6wXazlo9oOSxMAFPfg
-		var pF6WYVukdXD7y0HVwHrSiGNHrsaoqfNEbnAgy21QYc1qhk67gPxz19SGIwbuYcj6zV6Ln2j6GPVkd tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(113))
-		_ = pF6WYVukdXD7y0HVwHrSiGNHrsaoqfNEbnAgy21QYc1qhk67gPxz19SGIwbuYcj6zV6Ln2j6GPVkd
-		var x03mXGOHv1wZKOeseJlgQCnnvKOyHtLDmLMkieChxwltOerhLXsTIh77OG7rC5TH6VomwMX2 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(114))
-		_ = x03mXGOHv1wZKOeseJlgQCnnvKOyHtLDmLMkieChxwltOerhLXsTIh77OG7rC5TH6VomwMX2
-		var q58754DHbnaOtVpOimLyN8Mk9MrjNHHUIM9Ag8KdSBgFeWYjE0RqlkKTKtQucyBNRgcfm64mFyjkLb8nxiwhR9i8YYblAozjtrojX4 tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(115))
-		_ = q58754DHbnaOtVpOimLyN8Mk9MrjNHHUIM9Ag8KdSBgFeWYjE0RqlkKTKtQucyBNRgcfm64mFyjkLb8nxiwhR9i8YYblAozjtrojX4
-		var eBDMLXipSnFroWeGQ61Dy tla.TLAValue = args1[8].ApplyFunction(tla.MakeTLANumber(116))
-		_ = eBDMLXipSnFroWeGQ61Dy
-		var plOPwJgoxgzqviM tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(1))
-		_ = plOPwJgoxgzqviM
-		var lLkVG6RwjNFpwF4gdo0C5znBgQkOp3Nohr1oMEx8OGQnJOlOr18K9elqol57tkTMSC3pDu9s5x4O24009fmK8elu78ZwXjM6Pz7x tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(2))
-		_ = lLkVG6RwjNFpwF4gdo0C5znBgQkOp3Nohr1oMEx8OGQnJOlOr18K9elqol57tkTMSC3pDu9s5x4O24009fmK8elu78ZwXjM6Pz7x
-		var iVXJdV0Ed5wcpLkYJqRVud tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(3))
-		_ = iVXJdV0Ed5wcpLkYJqRVud
-		var vz4ce5hvEQm4FNzxgbWPoKAVy9mhkLlzOiHDjYd1fXChs9i2byToV8csMq9gT48kdGNCBIofOng1yDgvDI3wCIwZb3oK0yKLMEAEKJWoEJRxSLWp tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(4))
-		_ = vz4ce5hvEQm4FNzxgbWPoKAVy9mhkLlzOiHDjYd1fXChs9i2byToV8csMq9gT48kdGNCBIofOng1yDgvDI3wCIwZb3oK0yKLMEAEKJWoEJRxSLWp
-		var sMJVH3FDAzFuS9dWB465RE6kGmrzWzVBJTqesUvJo9glyqVqhmxNDMr6aSXmljGWOmUTy32XN20Gc9aWnkwPUCzoNutMCGfq04rUd85D5e tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(5))
-		_ = sMJVH3FDAzFuS9dWB465RE6kGmrzWzVBJTqesUvJo9glyqVqhmxNDMr6aSXmljGWOmUTy32XN20Gc9aWnkwPUCzoNutMCGfq04rUd85D5e
-		var kGES tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(6))
-		_ = kGES
-		var sXLbXHZOUP tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(7))
-		_ = sXLbXHZOUP
-		var oeh19d7jQwP7BZxarAJ29bDClVWCgq7SpGTVfCizILGJs tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(8))
-		_ = oeh19d7jQwP7BZxarAJ29bDClVWCgq7SpGTVfCizILGJs
-		var vSBvTtba1KW3YBUBneycVdup8iMxQ tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(9))
-		_ = vSBvTtba1KW3YBUBneycVdup8iMxQ
-		var fUi tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(10))
-		_ = fUi
-		var pR1AzaFJyDPqOMnPfpSMFw6gfD tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(11))
-		_ = pR1AzaFJyDPqOMnPfpSMFw6gfD
-		var yeh1K9tafuypMUrhaRwoHpTe0QpWQtpevewOJgSBPa8NfCGiiMu2oBYK7SJm7kjL9xcSX4L7FJ tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(12))
-		_ = yeh1K9tafuypMUrhaRwoHpTe0QpWQtpevewOJgSBPa8NfCGiiMu2oBYK7SJm7kjL9xcSX4L7FJ
-		var uXjHU9sOcIL1WCQWNklUAGfT05EmeiZ tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(13))
-		_ = uXjHU9sOcIL1WCQWNklUAGfT05EmeiZ
-		var iHXQEcu9qyXkANXzQY33fquAjcAzpCLD5R79imzBPkdJejT0NZrUt4BwZzUf tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(14))
-		_ = iHXQEcu9qyXkANXzQY33fquAjcAzpCLD5R79imzBPkdJejT0NZrUt4BwZzUf
-		var xczoE9RgkQhliGysk8MlSGdnFo6IyvBVuWrGvKC5QkmRNZe1ckc1fot9rAHUMncHLhmM37MRQVYXZ9J7kXh1ufoF1ifnn5uDFkK8 tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(15))
-		_ = xczoE9RgkQhliGysk8MlSGdnFo6IyvBVuWrGvKC5QkmRNZe1ckc1fot9rAHUMncHLhmM37MRQVYXZ9J7kXh1ufoF1ifnn5uDFkK8
-		var j2u3hPxe8 tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(16))
-		_ = j2u3hPxe8
-		var nVSG tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(17))
-		_ = nVSG
-		var mUtBZLlPlN2iq0S21J7JAVd5ZJXDtXIkjvbLtoJjzmsxdmmSxgMPldLR26O0q7IbFK8V6MyQ41sYGqAh5dzICZveUElz4TuY tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(18))
-		_ = mUtBZLlPlN2iq0S21J7JAVd5ZJXDtXIkjvbLtoJjzmsxdmmSxgMPldLR26O0q7IbFK8V6MyQ41sYGqAh5dzICZveUElz4TuY
-		var obGVcTR5BDbXbr5T48aNhBVmCslmxsrdrbyVLsTEnzAl4aEfyvlc0rrSZ73ADPso9SxtB2Yw7oGcEh8c tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(19))
-		_ = obGVcTR5BDbXbr5T48aNhBVmCslmxsrdrbyVLsTEnzAl4aEfyvlc0rrSZ73ADPso9SxtB2Yw7oGcEh8c
-		var f32hH6nVAC73T24FsLInh tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(20))
-		_ = f32hH6nVAC73T24FsLInh
-		var mYyEU6DX7CUyMmyx6lkcNFnOUgpEO4JxukW85KgXJeHj8wYFOZDD tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(21))
-		_ = mYyEU6DX7CUyMmyx6lkcNFnOUgpEO4JxukW85KgXJeHj8wYFOZDD
-		var fPBKRZq2cGeVVHuRrQxG6wFLcJhYxuww1Wofflgo7p43KXF2ZbwdBXLCpCZPI5k9fP9hnyV6VG tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(22))
-		_ = fPBKRZq2cGeVVHuRrQxG6wFLcJhYxuww1Wofflgo7p43KXF2ZbwdBXLCpCZPI5k9fP9hnyV6VG
-		var zHERvF8WhFFQIh0bMkCQbTDGXQrNcpF tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(23))
-		_ = zHERvF8WhFFQIh0bMkCQbTDGXQrNcpF
-		var xpoZoeDqfxqWlWSqZJKcFeUTg6T6YuaH6LtWBX94R13HWf0bwUo0oRfCd8rseYdxyf7axPMXqhThhAPcY tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(24))
-		_ = xpoZoeDqfxqWlWSqZJKcFeUTg6T6YuaH6LtWBX94R13HWf0bwUo0oRfCd8rseYdxyf7axPMXqhThhAPcY
-		var vXVcZtRTP97AS3sUkh5nhwhG4qorJbd0PhGbY6JVHXnGeqDq7OOoM1wyrk2WSbSyXvnXW5NePe9g5yNfm tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(25))
-		_ = vXVcZtRTP97AS3sUkh5nhwhG4qorJbd0PhGbY6JVHXnGeqDq7OOoM1wyrk2WSbSyXvnXW5NePe9g5yNfm
-		var iflGuSHYoDyxSbGLP0dyyXTrcVMjnDaB0QHyDIlWqh987O7pFNwGE5UmPYy tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(26))
-		_ = iflGuSHYoDyxSbGLP0dyyXTrcVMjnDaB0QHyDIlWqh987O7pFNwGE5UmPYy
-		var i0 tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(27))
-		_ = i0
-		var uVzaWqUSraecWRih8zblPmhHjQTnzwcqtoIQd86kUOdsoMRl5HQIomJI20KFqu5zCI2HHe8D tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(28))
-		_ = uVzaWqUSraecWRih8zblPmhHjQTnzwcqtoIQd86kUOdsoMRl5HQIomJI20KFqu5zCI2HHe8D
-		var pywFuNPjvDN2sHaKBsMQN32bi7mTaw4oN tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(29))
-		_ = pywFuNPjvDN2sHaKBsMQN32bi7mTaw4oN
-		var ofPC4qKuNVhfXd8GbSKqjaev2AuFCVJ1MCKcCmKhlqze9B1mkJtOkda0ST2GhkXpUSfInWN tla.TLAValue = args1[9].ApplyFunction(tla.MakeTLANumber(30))
-		_ = ofPC4qKuNVhfXd8GbSKqjaev2AuFCVJ1MCKcCmKhlqze9B1mkJtOkda0ST2GhkXpUSfInWN
-		var gmou6mQtTJaztFADIerbU7wI58pnAmBfYEJMPYfsa5lbp9buZJ7qGn7qkOJ tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(1))
-		_ = gmou6mQtTJaztFADIerbU7wI58pnAmBfYEJMPYfsa5lbp9buZJ7qGn7qkOJ
-		var kJuOB1nGooxmSrS1gx0CHaU9pMV9R4eWM7MnXcB6mEn9WHVqvf38MiaKPOHxwpQA6nxVuyb tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(2))
-		_ = kJuOB1nGooxmSrS1gx0CHaU9pMV9R4eWM7MnXcB6mEn9WHVqvf38MiaKPOHxwpQA6nxVuyb
-		var rmNohfART2OpXi9rXJo4nuo4SISw8TfjfB1YjQs tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(3))
-		_ = rmNohfART2OpXi9rXJo4nuo4SISw8TfjfB1YjQs
-		var r22Q1xRs2J6LMWmbKNqsEo4m7oWmRkMMSNTOvCAUPOB4RNUelgLi6wcyySIQrVubZjQwC tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(4))
-		_ = r22Q1xRs2J6LMWmbKNqsEo4m7oWmRkMMSNTOvCAUPOB4RNUelgLi6wcyySIQrVubZjQwC
-		var ltla6EoPxZ6Z0QpN6LB53zWEKvdbyVWyTZ8FjdxDzhwMs3x7z4RvjboHSZjIZFc8k695LpyrPb2yEI7goDENyQCTi2bl7fBL2uOrTXF64TE tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(5))
-		_ = ltla6EoPxZ6Z0QpN6LB53zWEKvdbyVWyTZ8FjdxDzhwMs3x7z4RvjboHSZjIZFc8k695LpyrPb2yEI7goDENyQCTi2bl7fBL2uOrTXF64TE
-		var bSrm0STdcqVvjoowxFrEzL68P1HvvB2UZTqOH5rWyNYPxxrn9VBLPL8O5bZmDCdgL86ArBRFLYH1OfK9SUaPltfwbdtSXgwtFR tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(6))
-		_ = bSrm0STdcqVvjoowxFrEzL68P1HvvB2UZTqOH5rWyNYPxxrn9VBLPL8O5bZmDCdgL86ArBRFLYH1OfK9SUaPltfwbdtSXgwtFR
-		var kIQquHIkvGZhTwffna3AyquDyK3CVURCWl28j0MKeLY9IkC tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(7))
-		_ = kIQquHIkvGZhTwffna3AyquDyK3CVURCWl28j0MKeLY9IkC
-		var qQVNkcJkoi4VnqQRUv2cTxoSjMKyWv8sRH4jVPzHjnMQ3U9SB9wU0IVSNsNloIkFUSttwSM tla.TLAValue = args1[10].ApplyFunction(tla.MakeTLANumber(8))
-		_ = qQVNkcJkoi4VnqQRUv2cTxoSjMKyWv8sRH4jVPzHjnMQ3U9SB9wU0IVSNsNloIkFUSttwSM
-		var gSapU9sycL2vu74K0nFmmgiTgxxxtWq92c0ho tla.TLAValue = args1[11]
-		_ = gSapU9sycL2vu74K0nFmmgiTgxxxtWq92c0ho
-		var bvYyhkNRnO7sD3ykShl4v9gzq8dllwOxieK6UiYo0PjrsQE2OkRRY8PmHxeljmW2MS9QoioYTokIg6QgP2IdLjfqEGPweJq tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(1))
-		_ = bvYyhkNRnO7sD3ykShl4v9gzq8dllwOxieK6UiYo0PjrsQE2OkRRY8PmHxeljmW2MS9QoioYTokIg6QgP2IdLjfqEGPweJq
-		var k2Uw0xZjKZnCb6xsWmjJsM8LRBJp8kBUXk2G4TntmKi5FZ9flYzrZPAbynbt3srNf16jRGk76ZkQAb tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(2))
-		_ = k2Uw0xZjKZnCb6xsWmjJsM8LRBJp8kBUXk2G4TntmKi5FZ9flYzrZPAbynbt3srNf16jRGk76ZkQAb
-		var iYjzvUHPRlDN8baqJ7qYCHF998vTPHri6nK24Uog0LSasfdO9aFDTk3fXuCl41xeMOm2VQft tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(3))
-		_ = iYjzvUHPRlDN8baqJ7qYCHF998vTPHri6nK24Uog0LSasfdO9aFDTk3fXuCl41xeMOm2VQft
-		var lxEwfeKiQlnT6sNRSWWQ5UXPa39yX5pq tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(4))
-		_ = lxEwfeKiQlnT6sNRSWWQ5UXPa39yX5pq
-		var uTBOegzf41xwPV9PP4Jyej8A4q8GFeUm044NwlkPT5BL3eI1AIfiH8e tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(5))
-		_ = uTBOegzf41xwPV9PP4Jyej8A4q8GFeUm044NwlkPT5BL3eI1AIfiH8e
-		var rBwkXLpv3CehpIQtDRGBUXY4ue823T9acVqNOzFmJSVbDcCN9Fwf1BMLsyAfKrdM2JXyo5EuxImSiFleETRHE2CzE79xAm37HP70BZiaX71CkukP4jH tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(6))
-		_ = rBwkXLpv3CehpIQtDRGBUXY4ue823T9acVqNOzFmJSVbDcCN9Fwf1BMLsyAfKrdM2JXyo5EuxImSiFleETRHE2CzE79xAm37HP70BZiaX71CkukP4jH
-		var sP2QwPQXG6byK83Fz9Odl8SocpG3uAWL8G tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(7))
-		_ = sP2QwPQXG6byK83Fz9Odl8SocpG3uAWL8G
-		var rt0TIrSzEUK963rZ8M5FmZ6K28lmSqHfpUe5u1WKOwrtDrJ tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(8))
-		_ = rt0TIrSzEUK963rZ8M5FmZ6K28lmSqHfpUe5u1WKOwrtDrJ
-		var mRhBltodqGZZZh77e7Jw7Nbn0xeSPlu tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(9))
-		_ = mRhBltodqGZZZh77e7Jw7Nbn0xeSPlu
-		var ohzDWoSvkHgPUyZ1eszr64gW5riSVHY2qQrPA2NXy1BFDyAjg5k tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(10))
-		_ = ohzDWoSvkHgPUyZ1eszr64gW5riSVHY2qQrPA2NXy1BFDyAjg5k
-		var aFQmRxI2ZxC6NGDyFkAU0M97MF3umRnyAjlUd0b5WO7Ky0U9b2dZFj8f3EAM69sZ1lCi5rjRyLkKWgK00qs2cD67ZKX4gzYihXfcac6A7E0 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(11))
-		_ = aFQmRxI2ZxC6NGDyFkAU0M97MF3umRnyAjlUd0b5WO7Ky0U9b2dZFj8f3EAM69sZ1lCi5rjRyLkKWgK00qs2cD67ZKX4gzYihXfcac6A7E0
-		var lLUlCMhiTb3othEt5flbY5BSFDrNWhUhCxAgpmpSBEjmALYYGmNJQYBOts9uEFpYESv tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(12))
-		_ = lLUlCMhiTb3othEt5flbY5BSFDrNWhUhCxAgpmpSBEjmALYYGmNJQYBOts9uEFpYESv
-		var mRUb6rEz2QsRUJEASApew7UfpGOAfuImB9mjWGQTJ1ZfKNkHrL0w3NdvBY723ewRd tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(13))
-		_ = mRUb6rEz2QsRUJEASApew7UfpGOAfuImB9mjWGQTJ1ZfKNkHrL0w3NdvBY723ewRd
-		var kJNVZ92UakTXx62mDIx2gdSy3KOm9KRcLn2kMtwBmLN6umDSPa7baIsWYa1VI19hBW5X6 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(14))
-		_ = kJNVZ92UakTXx62mDIx2gdSy3KOm9KRcLn2kMtwBmLN6umDSPa7baIsWYa1VI19hBW5X6
-		var orxu7P4V5TxZBv1J6sHoDjqjTKhXBjw8jyqMj2IylosKW39s tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(15))
-		_ = orxu7P4V5TxZBv1J6sHoDjqjTKhXBjw8jyqMj2IylosKW39s
-		var m155yah3c7 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(16))
-		_ = m155yah3c7
-		var aBUBA8tg75t2l0lDZd6xg8dU2MLrdeGfyB1EqOayAua36rja tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(17))
-		_ = aBUBA8tg75t2l0lDZd6xg8dU2MLrdeGfyB1EqOayAua36rja
-		var neY0NeiszULJ5hzRwMfs7uRWeKbUJrg05xI7uDXxso3zqMNwYVBWXvSffIQ8A8f54wC4MixM9WyX2apY tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(18))
-		_ = neY0NeiszULJ5hzRwMfs7uRWeKbUJrg05xI7uDXxso3zqMNwYVBWXvSffIQ8A8f54wC4MixM9WyX2apY
-		var dXY738Eucw9bEjbGrpMLwA9IZPS7UjsccwIWzqT3rQhC8Z7VVlQYRKGNMISxpSC2gtGcrKWjITz5FOTsFcl tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(19))
-		_ = dXY738Eucw9bEjbGrpMLwA9IZPS7UjsccwIWzqT3rQhC8Z7VVlQYRKGNMISxpSC2gtGcrKWjITz5FOTsFcl
-		var yT tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(20))
-		_ = yT
-		var k7O2lJMu9pkamRfx4DxPujYEUFfHxsFqhXkqhmEuOTaCBUy8GUIodHF1Lo7MeM8gEbSFZr2AzKqeGpNMi7YjHyfY0ucGxr tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(21))
-		_ = k7O2lJMu9pkamRfx4DxPujYEUFfHxsFqhXkqhmEuOTaCBUy8GUIodHF1Lo7MeM8gEbSFZr2AzKqeGpNMi7YjHyfY0ucGxr
-		var ulM41lZXFsrBTdYMn8UxgL7lowyB7taU3EkcR tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(22))
-		_ = ulM41lZXFsrBTdYMn8UxgL7lowyB7taU3EkcR
-		var izzqlsyk8dIhBy05n0XmRv182nnPp7OU4h4dFKcnP0zhqzTh9xXp4B8y5IGDJE1cIVPavcp0thGd6BsxtEBxQbs7rmdGmlDMr9Xki8UOFzNu tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(23))
-		_ = izzqlsyk8dIhBy05n0XmRv182nnPp7OU4h4dFKcnP0zhqzTh9xXp4B8y5IGDJE1cIVPavcp0thGd6BsxtEBxQbs7rmdGmlDMr9Xki8UOFzNu
-		var o27shTHLzkzoXz4xC5X8DMfkjY1OKXYY1W0Y tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(24))
-		_ = o27shTHLzkzoXz4xC5X8DMfkjY1OKXYY1W0Y
-		var v8wpCzOhZsHGKA0aktqnqZFq0ZexC13UtVdJGCu0273ehLqCA3jXNzmzcPyavqOPoYND tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(25))
-		_ = v8wpCzOhZsHGKA0aktqnqZFq0ZexC13UtVdJGCu0273ehLqCA3jXNzmzcPyavqOPoYND
-		var o9fFyJwuHr9NUP1C0Eq4nRaLLerbPeKeIxeBVAC9lXO1lDmTPPCAxWNPSqcrnJIlrYiXhCdLFgBV9Ie6jBWuqdHxjB3oT9o5R8buRAbQN tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(26))
-		_ = o9fFyJwuHr9NUP1C0Eq4nRaLLerbPeKeIxeBVAC9lXO1lDmTPPCAxWNPSqcrnJIlrYiXhCdLFgBV9Ie6jBWuqdHxjB3oT9o5R8buRAbQN
-		var jeUZQyxTU6 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(27))
-		_ = jeUZQyxTU6
-		var gH01VkUUXyOsLbkcDRBwKJT tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(28))
-		_ = gH01VkUUXyOsLbkcDRBwKJT
-		var eQPEPPdsMigsFNwJJGFbEk8AhRu9WePGlE8mttATvJI5AtiYR5re5cbQhPLgNAsTe1fFuBVv4AKKnW2EjhCtc00MRmkiFgZ tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(29))
-		_ = eQPEPPdsMigsFNwJJGFbEk8AhRu9WePGlE8mttATvJI5AtiYR5re5cbQhPLgNAsTe1fFuBVv4AKKnW2EjhCtc00MRmkiFgZ
-		var muUlbfVkJDAqjVsAlLDWGFCKeqSmbslGLH7zEq5tLrpMog2mjF3kDSwpaW0zvvwgOz8Gjib tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(30))
-		_ = muUlbfVkJDAqjVsAlLDWGFCKeqSmbslGLH7zEq5tLrpMog2mjF3kDSwpaW0zvvwgOz8Gjib
-		var rrNTKQ6Fxx6iLydRgUhb5PgmHQtH tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(31))
-		_ = rrNTKQ6Fxx6iLydRgUhb5PgmHQtH
-		var cP tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(32))
-		_ = cP
-		var pTQwNxDhQif tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(33))
-		_ = pTQwNxDhQif
-		var ecqIED6q tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(34))
-		_ = ecqIED6q
-		var aPGK5QsuCVFDbVbQoqQAep0phsirJfvGgaVL5RdXBsmZ7K9DHS4J8i8rkCiCCtycDXg9cwlsnSnZEc8O9x5PoINS7XFTjwwikHNRqN3bKhBeEsQ8u tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(35))
-		_ = aPGK5QsuCVFDbVbQoqQAep0phsirJfvGgaVL5RdXBsmZ7K9DHS4J8i8rkCiCCtycDXg9cwlsnSnZEc8O9x5PoINS7XFTjwwikHNRqN3bKhBeEsQ8u
-		var a5HdjxlOX9xsqHmFu tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(36))
-		_ = a5HdjxlOX9xsqHmFu
-		var b2nrPB939ZkCdCuvaCbD4Ft0dMPzT tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(37))
-		_ = b2nrPB939ZkCdCuvaCbD4Ft0dMPzT
-		var gydXGdwgPVRYmefrdw9CSQksgXaA8Smn6fBYfaF tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(38))
-		_ = gydXGdwgPVRYmefrdw9CSQksgXaA8Smn6fBYfaF
-		var gEgqOEDVYhIK30iMCf7m166iPHSL4Oyf6Sv tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(39))
-		_ = gEgqOEDVYhIK30iMCf7m166iPHSL4Oyf6Sv
-		var xUjERhSDgWgNE8JRq19waoV5OYwqC tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(40))
-		_ = xUjERhSDgWgNE8JRq19waoV5OYwqC
-		var lEXfIIPZHRNU127yUgJItv3Pvdt2ytA tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(41))
-		_ = lEXfIIPZHRNU127yUgJItv3Pvdt2ytA
-		var jPWpNkqpYYgau8yz3lXsqdEY4N1Ch46JQ4qJXmAOfpYebZmw9Y3krHNzfUskRNki tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(42))
-		_ = jPWpNkqpYYgau8yz3lXsqdEY4N1Ch46JQ4qJXmAOfpYebZmw9Y3krHNzfUskRNki
-		var j1 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(43))
-		_ = j1
-		var xsb tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(44))
-		_ = xsb
-		var xL5o3KBxHW0rby34PX6vVVrgT3zk8X3krRYsTrS4IMt4Dxou8bp7IAL8Nm8YzpHJ6lq tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(45))
-		_ = xL5o3KBxHW0rby34PX6vVVrgT3zk8X3krRYsTrS4IMt4Dxou8bp7IAL8Nm8YzpHJ6lq
-		var yE8KuYUviy1aBAnlIucJOnEtI5jwaZDGtwGbEV1sMmQyq7SUjGyaIyCxzQYSmJly3EykLC47r05E3r94YpXdIqEUjitt9CMCBxRnwBskHYipOMt4g tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(46))
-		_ = yE8KuYUviy1aBAnlIucJOnEtI5jwaZDGtwGbEV1sMmQyq7SUjGyaIyCxzQYSmJly3EykLC47r05E3r94YpXdIqEUjitt9CMCBxRnwBskHYipOMt4g
-		var enpWJSqk9ycQdBqfOQyxYw7iNk5spsnLCwuz5UKCsmefqQlRBC9VG0KDiS30eCdDPtIDdMHEN752 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(47))
-		_ = enpWJSqk9ycQdBqfOQyxYw7iNk5spsnLCwuz5UKCsmefqQlRBC9VG0KDiS30eCdDPtIDdMHEN752
-		var qwZWzqf4fj1pSMWMGD2Pe4CgnFaT16EqANZpINuv1UKXiR9q85xXFEiz7 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(48))
-		_ = qwZWzqf4fj1pSMWMGD2Pe4CgnFaT16EqANZpINuv1UKXiR9q85xXFEiz7
-		var le4JPbTdZx949PcxPq620UWarmRbcOqIUkHGTBy3HUcHmF2x14CmykEpb2YXT2jpPpkhi652xNNtOI2imD tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(49))
-		_ = le4JPbTdZx949PcxPq620UWarmRbcOqIUkHGTBy3HUcHmF2x14CmykEpb2YXT2jpPpkhi652xNNtOI2imD
-		var nuJjACVmXEEYLB2fG0nyz57FInY27ou tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(50))
-		_ = nuJjACVmXEEYLB2fG0nyz57FInY27ou
-		var fh2JnsyKDCae4wvGFFMHgqA9O0T0gMOhOZgFKTSLMjQFxTPDpfxOchdWatPi tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(51))
-		_ = fh2JnsyKDCae4wvGFFMHgqA9O0T0gMOhOZgFKTSLMjQFxTPDpfxOchdWatPi
-		var oRFG8JIB68yJ34Cv0xQgDmavBkAPoOSUkp1 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(52))
-		_ = oRFG8JIB68yJ34Cv0xQgDmavBkAPoOSUkp1
-		var rFGzyRYGXLwv2eePcn3WsJK5FHrNzWomwuesn8UBVc9wwOS48Nk8gL1kEyOAuIMUw2sbrN5RlMYUO6jgJzhHFRfB tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(53))
-		_ = rFGzyRYGXLwv2eePcn3WsJK5FHrNzWomwuesn8UBVc9wwOS48Nk8gL1kEyOAuIMUw2sbrN5RlMYUO6jgJzhHFRfB
-		var ksRtfRFspNe8Ib3SjZVCyOZfiL5 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(54))
-		_ = ksRtfRFspNe8Ib3SjZVCyOZfiL5
-		var cRRbaEpPC9hsYxhSSW8f2l9jkIVYUeZL6RT3A7F0V4rLzrru2YyXeHoaZOJ4 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(55))
-		_ = cRRbaEpPC9hsYxhSSW8f2l9jkIVYUeZL6RT3A7F0V4rLzrru2YyXeHoaZOJ4
-		var j8tmAzZJCrt3oQqKOrci4VyxbeGWfIvnQy56J405KdhGhxx8fYPgY1519xnsdrJOjtTmJdIbJiZ74Q8CmLGdoQeNWwueuTMZAag3jXNCD tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(56))
-		_ = j8tmAzZJCrt3oQqKOrci4VyxbeGWfIvnQy56J405KdhGhxx8fYPgY1519xnsdrJOjtTmJdIbJiZ74Q8CmLGdoQeNWwueuTMZAag3jXNCD
-		var pJGGYp98boWSNR4UY1QCkoM9fybjW4O2ZnsfpBfaGsNgUCEaSQnu3JyJ7mmsrlLl2XmgNul1NLikwvaPEVaTgYuSAfe tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(57))
-		_ = pJGGYp98boWSNR4UY1QCkoM9fybjW4O2ZnsfpBfaGsNgUCEaSQnu3JyJ7mmsrlLl2XmgNul1NLikwvaPEVaTgYuSAfe
-		var nzk0V4ohDBOGMI7FjfR1IVVI3 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(58))
-		_ = nzk0V4ohDBOGMI7FjfR1IVVI3
-		var jNrf6oSOwViQqvBNHLxgMelIQPjL0U3ZRduMHDw0VcwbcMELqTPfqo4KudSPCMHCoWPhE0wQncFJQGuTdYQVGtFitSFgbMc8DxlR3r7nUD7CFTheC tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(59))
-		_ = jNrf6oSOwViQqvBNHLxgMelIQPjL0U3ZRduMHDw0VcwbcMELqTPfqo4KudSPCMHCoWPhE0wQncFJQGuTdYQVGtFitSFgbMc8DxlR3r7nUD7CFTheC
-		var bAX6W1qmvIh tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(60))
-		_ = bAX6W1qmvIh
-		var wHug8VC tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(61))
-		_ = wHug8VC
-		var zlTvgIZI65SG5XQxMxGGv13z4QnKZ1TKIrieQz6viYG3WJIoFfjDeK6jayZK4CimYBDFl5pqul5kSXlWDdYhR0K tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(62))
-		_ = zlTvgIZI65SG5XQxMxGGv13z4QnKZ1TKIrieQz6viYG3WJIoFfjDeK6jayZK4CimYBDFl5pqul5kSXlWDdYhR0K
-		var j2 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(63))
-		_ = j2
-		var xzp12kCzErlpHusVMbj19XCH2mqVEXv4pYRcNMn0tqwgssaBBnGPsnbndDgGmtQj8tOoLpQNvskvRxjOW1mPxQHw6K5oGNY79qb6gKIf tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(64))
-		_ = xzp12kCzErlpHusVMbj19XCH2mqVEXv4pYRcNMn0tqwgssaBBnGPsnbndDgGmtQj8tOoLpQNvskvRxjOW1mPxQHw6K5oGNY79qb6gKIf
-		var o64walz4ovuPNEjD59k2R371ggAQBPMHcXlPFC536z9n3wLoW6Tk72qUu3w4eaReckZ tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(65))
-		_ = o64walz4ovuPNEjD59k2R371ggAQBPMHcXlPFC536z9n3wLoW6Tk72qUu3w4eaReckZ
-		var ufNlMXrsLWRxokYNotZGObHjz0e0nLWRHl5ZL5eYuCT6wQhzrAZG1i6P tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(66))
-		_ = ufNlMXrsLWRxokYNotZGObHjz0e0nLWRHl5ZL5eYuCT6wQhzrAZG1i6P
-		var e0ByBZeHU0jroLESJIvzUKBaU4f7ejew8RIAfJvAJZs4YMTWPeAP4LjLHmDjpo4ygRTr2ohTP4eLRU24Kiv3ZX9nIIMP18KITB7Vlylc tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(67))
-		_ = e0ByBZeHU0jroLESJIvzUKBaU4f7ejew8RIAfJvAJZs4YMTWPeAP4LjLHmDjpo4ygRTr2ohTP4eLRU24Kiv3ZX9nIIMP18KITB7Vlylc
-		var kFKDWDANI9dq6kfizGOd4U0h1imDJFmcPJWBOVJkj7SdIyDD tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(68))
-		_ = kFKDWDANI9dq6kfizGOd4U0h1imDJFmcPJWBOVJkj7SdIyDD
-		var mJhoVpxId6c3cfCUwwhvNRjJUMiFIKXjcXsW3ItMsgBugQ6PS23X9bGOCuZAfcSDKwV0dPSodkKeAyt4VYAYY tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(69))
-		_ = mJhoVpxId6c3cfCUwwhvNRjJUMiFIKXjcXsW3ItMsgBugQ6PS23X9bGOCuZAfcSDKwV0dPSodkKeAyt4VYAYY
-		var wxW3v3LCWQ2k9bsfL2cGOZ23LlO3Q1rIZtjyJv2lKaAFNLdmjfhbctyq20NQFQ1qqjk2uj5xcceY29HChRhFwuV0d9FiiSoqpuG tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(70))
-		_ = wxW3v3LCWQ2k9bsfL2cGOZ23LlO3Q1rIZtjyJv2lKaAFNLdmjfhbctyq20NQFQ1qqjk2uj5xcceY29HChRhFwuV0d9FiiSoqpuG
-		var o4IH tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(71))
-		_ = o4IH
-		var jqVv9a7RJ34hwtjbCfI4h24D6 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(72))
-		_ = jqVv9a7RJ34hwtjbCfI4h24D6
-		var vPJOj3BlqOuTBf0Wh6fnYKOXxPSFwdY4TPjS9vOD8F5XumXcWgwXZ7iZnOb0UThvYjB6EP4POoJ80F4wbASRs7P9M9jIZ90mKmcSdObml5KDWmn8 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(73))
-		_ = vPJOj3BlqOuTBf0Wh6fnYKOXxPSFwdY4TPjS9vOD8F5XumXcWgwXZ7iZnOb0UThvYjB6EP4POoJ80F4wbASRs7P9M9jIZ90mKmcSdObml5KDWmn8
-		var xR7M tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(74))
-		_ = xR7M
-		var klVFkg6hwFeiBoenhzlVR76U5aDHycoY1SKKC tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(75))
-		_ = klVFkg6hwFeiBoenhzlVR76U5aDHycoY1SKKC
-		var k86ihwoOt5vYFd4bpirurV3tUFseZuxgxqUlSi6N8a9QMrpmkXTDJHZ0PG2KGxYYHtK4s tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(76))
-		_ = k86ihwoOt5vYFd4bpirurV3tUFseZuxgxqUlSi6N8a9QMrpmkXTDJHZ0PG2KGxYYHtK4s
-		var nw5sdPfTaR4fEgMRbAd3opwxdBSlWYPSRWkDozBuanVCJpmgVvDxB4sj2wNdBpyRdAOMHzrc0UDNAjnkergYzPJaNICR8gOw6pfDzu tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(77))
-		_ = nw5sdPfTaR4fEgMRbAd3opwxdBSlWYPSRWkDozBuanVCJpmgVvDxB4sj2wNdBpyRdAOMHzrc0UDNAjnkergYzPJaNICR8gOw6pfDzu
-		var njyLNAgKPJeFUPIbbFRhZetMfiziosTwos9CieLJor8k19DVQEy5gHZlFcVnnN2etoO2BYZrMsDjCKqfmjWpmPCvGdN1IH tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(78))
-		_ = njyLNAgKPJeFUPIbbFRhZetMfiziosTwos9CieLJor8k19DVQEy5gHZlFcVnnN2etoO2BYZrMsDjCKqfmjWpmPCvGdN1IH
-		var hZJVCmJyb1bLSJN91540Er1xbQ5CHjcRkBXKrGuf7ltpGIj tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(79))
-		_ = hZJVCmJyb1bLSJN91540Er1xbQ5CHjcRkBXKrGuf7ltpGIj
-		var cXkXltSAIi7cabM1kPkpa tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(80))
-		_ = cXkXltSAIi7cabM1kPkpa
-		var hSxEM3oq4kPoM tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(81))
-		_ = hSxEM3oq4kPoM
-		var mrGvpReT4LRhjCB1nZJSqUdJ6y3Jw20R3XzZSDPnNs tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(82))
-		_ = mrGvpReT4LRhjCB1nZJSqUdJ6y3Jw20R3XzZSDPnNs
-		var ucLPgjqENVqzxeG56EAeKCXtg3S2jp0iNu0qHm2Y7IODe5wFamwwXV9gOoSpyfTA8qbLhxvw9WBswaC7RV4wemDiAGvhf tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(83))
-		_ = ucLPgjqENVqzxeG56EAeKCXtg3S2jp0iNu0qHm2Y7IODe5wFamwwXV9gOoSpyfTA8qbLhxvw9WBswaC7RV4wemDiAGvhf
-		var kfiPtri61kEDKocnKxDonQ6rhdJEa6hOy8e9HvNMttsXYiZccYSG1p9yE3ZP1eSfEqLNRkAsZkWnqzMmh2KD tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(84))
-		_ = kfiPtri61kEDKocnKxDonQ6rhdJEa6hOy8e9HvNMttsXYiZccYSG1p9yE3ZP1eSfEqLNRkAsZkWnqzMmh2KD
-		var dAOyF08iPkcA7TXa4KL76xrc6afchsxyrRFTkH5L1v7ui9fo2svnBSfiQIg1ogKcJv3wB8 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(85))
-		_ = dAOyF08iPkcA7TXa4KL76xrc6afchsxyrRFTkH5L1v7ui9fo2svnBSfiQIg1ogKcJv3wB8
-		var sFCZTEmld24ijkCJiGvYmNZu0rHziX8WJutAvNBppXZASoHfkgoToBYsLZY5uRYUrKOmFRWgPc47O8AM8QJTRjaiQUQ tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(86))
-		_ = sFCZTEmld24ijkCJiGvYmNZu0rHziX8WJutAvNBppXZASoHfkgoToBYsLZY5uRYUrKOmFRWgPc47O8AM8QJTRjaiQUQ
-		var gX8i8BVvIXM0B4iC1ZbCqNOdILmSNwbnymoRPdP7jtOsOJ0tyc4NBVSir55YeC3j9kGHNs6pvYeyYnUgtuISTszR tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(87))
-		_ = gX8i8BVvIXM0B4iC1ZbCqNOdILmSNwbnymoRPdP7jtOsOJ0tyc4NBVSir55YeC3j9kGHNs6pvYeyYnUgtuISTszR
-		var pv3StZMJMxbRov4WRXa8RMWH tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(88))
-		_ = pv3StZMJMxbRov4WRXa8RMWH
-		var mstkgMDlZN4ne0gStWEwGCum5F1p2pjY0E61IrDFPOtf7CLwr0LefhsbUzo tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(89))
-		_ = mstkgMDlZN4ne0gStWEwGCum5F1p2pjY0E61IrDFPOtf7CLwr0LefhsbUzo
-		var gCpRzwdZO6lzQmoptm3Bj71Z55qLuhIznvg050DloYGZFfJRICfc5AHLbzqEipkGl9OK7DQZxjs4pQjz6blgnHU62I3lK77 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(90))
-		_ = gCpRzwdZO6lzQmoptm3Bj71Z55qLuhIznvg050DloYGZFfJRICfc5AHLbzqEipkGl9OK7DQZxjs4pQjz6blgnHU62I3lK77
-		var c9XEV3uxOuAs6nY0iGtGil96iEDQFK91D49IT4OcEiIhAFpfA8ZUGtjlo6IJw75knBnz2z38o2AsLVk tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(91))
-		_ = c9XEV3uxOuAs6nY0iGtGil96iEDQFK91D49IT4OcEiIhAFpfA8ZUGtjlo6IJw75knBnz2z38o2AsLVk
-		var opgDUOIfE2VO7XSSPrTkGZZQx4Q05i1KqjRjfCMT1hnVghMfOpTVSUfO8cOGFGIkhw tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(92))
-		_ = opgDUOIfE2VO7XSSPrTkGZZQx4Q05i1KqjRjfCMT1hnVghMfOpTVSUfO8cOGFGIkhw
-		var hcZ2qdb0RbSGYpDe6cqtH3bidIoxlbkZys0sit9OsSR00y86Kn7ygXQ3XtZDkAPkybdhjoG2HTR2ONAyMCz2hmy8ETPd84myed8IL1hXVQYyc8baltw tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(93))
-		_ = hcZ2qdb0RbSGYpDe6cqtH3bidIoxlbkZys0sit9OsSR00y86Kn7ygXQ3XtZDkAPkybdhjoG2HTR2ONAyMCz2hmy8ETPd84myed8IL1hXVQYyc8baltw
-		var lJLU0whHwG7M2VDlltaDKeoK1LUFQXzKa8uaIWP7q tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(94))
-		_ = lJLU0whHwG7M2VDlltaDKeoK1LUFQXzKa8uaIWP7q
-		var mKotOtJbj1v7ACck5i683vUlY tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(95))
-		_ = mKotOtJbj1v7ACck5i683vUlY
-		var f8FerpPpvVx9MMkWzCPTDTuHTYdIgpO2mcb8SJJFRoo6DrVmr9MPdwFWO4DUFYF6L2lp7vY3RgJuBCEHbcRwltB2NSEmn0RsHtvn4Dchoua9Zh tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(96))
-		_ = f8FerpPpvVx9MMkWzCPTDTuHTYdIgpO2mcb8SJJFRoo6DrVmr9MPdwFWO4DUFYF6L2lp7vY3RgJuBCEHbcRwltB2NSEmn0RsHtvn4Dchoua9Zh
-		var g7kcaa2D8avf tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(97))
-		_ = g7kcaa2D8avf
-		var m7dp8qmLYjlHNFW66mjrhFvyUXNhI7gyTJ4UeEe8iLuF tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(98))
-		_ = m7dp8qmLYjlHNFW66mjrhFvyUXNhI7gyTJ4UeEe8iLuF
-		var noJHAyUU59Bof5aFLlccFMFEVr4dazghvm1wX tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(99))
-		_ = noJHAyUU59Bof5aFLlccFMFEVr4dazghvm1wX
-		var j1vSC2M3EdDlaE tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(100))
-		_ = j1vSC2M3EdDlaE
-		var t49VKmVI3285NY6OdXDOsqjKMjshi21mwRUHVwaX7HsTjAUD8txzKPIoakubQtMI6GC1Au1UbWdKQO16BEIX7KfQvvg0OgvXFTaKSwZHREYDP48fW tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(101))
-		_ = t49VKmVI3285NY6OdXDOsqjKMjshi21mwRUHVwaX7HsTjAUD8txzKPIoakubQtMI6GC1Au1UbWdKQO16BEIX7KfQvvg0OgvXFTaKSwZHREYDP48fW
-		var rHjgqP1tLMrySxAYUHPM8tRniUaMsg0lqzV43X2GlA9sogHhzG3dMGLqDnY3A7dsJcDenG93hVk0NrkBE1rhU0T53fNWDGtXycy3baFdAq03BaHWnH1 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(102))
-		_ = rHjgqP1tLMrySxAYUHPM8tRniUaMsg0lqzV43X2GlA9sogHhzG3dMGLqDnY3A7dsJcDenG93hVk0NrkBE1rhU0T53fNWDGtXycy3baFdAq03BaHWnH1
-		var hQGTMECi2cQYoi7hHqzN9HRs8ChEVuDofSOQmGobNKAl6bvVbWtqzJzgWoswHByKvtuPpQg2yZJn1PgZkW1w0BN6KOcgMQkBObtgEcAMeeumP2eqlD tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(103))
-		_ = hQGTMECi2cQYoi7hHqzN9HRs8ChEVuDofSOQmGobNKAl6bvVbWtqzJzgWoswHByKvtuPpQg2yZJn1PgZkW1w0BN6KOcgMQkBObtgEcAMeeumP2eqlD
-		var v0 tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(104))
-		_ = v0
-		var yv2HeKeX9gnjesExtlMsGGiOI5LSUziePjPJB1XjR61n7zgGtRQUg0bHY805LvJ7DG tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(105))
-		_ = yv2HeKeX9gnjesExtlMsGGiOI5LSUziePjPJB1XjR61n7zgGtRQUg0bHY805LvJ7DG
-		var nImSKYiD3G9E9ePNjSRvUD2EJRwj0oLZC3wJDYQOuEFw28VOHdKjEMhlLCt2uEdLfYIVO6v76rOG1Efqif5jRtSz1DpCGoPW tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(106))
-		_ = nImSKYiD3G9E9ePNjSRvUD2EJRwj0oLZC3wJDYQOuEFw28VOHdKjEMhlLCt2uEdLfYIVO6v76rOG1Efqif5jRtSz1DpCGoPW
-		var lXLJErC1IWunsxYA6jxGgXitZHoxYHm38FPIbHSiz2LGBhETFCTyTGEiNfbwn6GIhGLLEQI tla.TLAValue = args1[12].ApplyFunction(tla.MakeTLANumber(107))
-		_ = lXLJErC1IWunsxYA6jxGgXitZHoxYHm38FPIbHSiz2LGBhETFCTyTGEiNfbwn6GIhGLLEQI
-		var nrk37ZYFObeGv0nT tla.TLAValue = args1[13]
-		_ = nrk37ZYFObeGv0nT
-		return tla.MakeTLAFunction([]tla.TLAValue{f8FerpPpvVx9MMkWzCPTDTuHTYdIgpO2mcb8SJJFRoo6DrVmr9MPdwFWO4DUFYF6L2lp7vY3RgJuBCEHbcRwltB2NSEmn0RsHtvn4Dchoua9Zh, uTBOegzf41xwPV9PP4Jyej8A4q8GFeUm044NwlkPT5BL3eI1AIfiH8e}, func(args2 []tla.TLAValue) tla.TLAValue {
-			var mZtSfNxblZ8ymqCZtiCL0pg5eeMr8Gxe6BL5CqQVhVpgafjFiJEEfDdQMVcwPFLDeExoalXvZwQqJXlZ4 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(1))
-			_ = mZtSfNxblZ8ymqCZtiCL0pg5eeMr8Gxe6BL5CqQVhVpgafjFiJEEfDdQMVcwPFLDeExoalXvZwQqJXlZ4
-			var iE3ATnGX0LUt6HIWHz8QFLbctA8yjE8JvFzYhwy tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(2))
-			_ = iE3ATnGX0LUt6HIWHz8QFLbctA8yjE8JvFzYhwy
-			var sT78rgH2Vk19RTStefeZH3BZrLwYzwXQWSrVJBUtdcYXhblQ4TVIzoOmm4lH08xpnl78QY66TPezs48hqIDNObpwnBP tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(3))
-			_ = sT78rgH2Vk19RTStefeZH3BZrLwYzwXQWSrVJBUtdcYXhblQ4TVIzoOmm4lH08xpnl78QY66TPezs48hqIDNObpwnBP
-			var cxnGYmjLDiibwY33tVQl36TinOHQrqOVJPJRLHbJzELF5nWg1gY9gyDQuZmeWL8or9fnfgr tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(4))
-			_ = cxnGYmjLDiibwY33tVQl36TinOHQrqOVJPJRLHbJzELF5nWg1gY9gyDQuZmeWL8or9fnfgr
-			var zVzVV85qEeVUBxGax9WnyKXsVApkX1qBuZmfYOGcFgzrtEDyWQ0TKMh6g8x9uP0wFGwQ0bZL4iFMNed7g tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(5))
-			_ = zVzVV85qEeVUBxGax9WnyKXsVApkX1qBuZmfYOGcFgzrtEDyWQ0TKMh6g8x9uP0wFGwQ0bZL4iFMNed7g
-			var heWJqUEkz0uIs9exvOsz7s8xkUTSnEfVaUa tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(6))
-			_ = heWJqUEkz0uIs9exvOsz7s8xkUTSnEfVaUa
-			var qM5bkycjpAVPL6Mfwq9RxpE7xJdRWmK5sG5kE1qj tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(7))
-			_ = qM5bkycjpAVPL6Mfwq9RxpE7xJdRWmK5sG5kE1qj
-			var h1z0y1mFNeaRJnJlM3lztF5OSbbN2elpuC2y5a55nWul46m1FX0gOesVTcroL9FSk69RY6ZH0d9JwjXbf7p3 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(8))
-			_ = h1z0y1mFNeaRJnJlM3lztF5OSbbN2elpuC2y5a55nWul46m1FX0gOesVTcroL9FSk69RY6ZH0d9JwjXbf7p3
-			var dLojbdZgVn80iPafVLHN1VrS9SEs3jqvFGZyfQdTmSB4U4YtAbxcPYgFXdIIr8JIo4QRRr tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(9))
-			_ = dLojbdZgVn80iPafVLHN1VrS9SEs3jqvFGZyfQdTmSB4U4YtAbxcPYgFXdIIr8JIo4QRRr
-			var yAzp tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(10))
-			_ = yAzp
-			var amWR5FXuGvcd2Kdy6mrSd8z6Ku8zAqjhZbYbahEN tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(11))
-			_ = amWR5FXuGvcd2Kdy6mrSd8z6Ku8zAqjhZbYbahEN
-			var pUfTEIqBFaPjxcHk27r85Vrn5gjdFrk3HCaVaKtuksHDAgoG1f tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(12))
-			_ = pUfTEIqBFaPjxcHk27r85Vrn5gjdFrk3HCaVaKtuksHDAgoG1f
-			var sh6q1OmFDYqSdSbRQPzURrHzpC10gTiV8V1AmcK7SyEj52PdzIgKzOBlLjWWizGMNh tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(13))
-			_ = sh6q1OmFDYqSdSbRQPzURrHzpC10gTiV8V1AmcK7SyEj52PdzIgKzOBlLjWWizGMNh
-			var usbi7YZDVS0PrxkdtElOF1QGdM6 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(14))
-			_ = usbi7YZDVS0PrxkdtElOF1QGdM6
-			var svaK5d2j4gvI tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(15))
-			_ = svaK5d2j4gvI
-			var nMQXwpLlDT04C866cTLJFcGctg9hm5AFVp8ABuTgJ7c48PY6mLZFra8eGrBMI tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(16))
-			_ = nMQXwpLlDT04C866cTLJFcGctg9hm5AFVp8ABuTgJ7c48PY6mLZFra8eGrBMI
-			var uso2hpfkwUh2so60PqgbIRFO tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(17))
-			_ = uso2hpfkwUh2so60PqgbIRFO
-			var fg1zwKqdGty0mRzCSCUVzIvKw58RF8kfdQ8wPJbI82VEBK6m0dqx5DPuArRgfGVUMPq tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(18))
-			_ = fg1zwKqdGty0mRzCSCUVzIvKw58RF8kfdQ8wPJbI82VEBK6m0dqx5DPuArRgfGVUMPq
-			var eZWtrMs tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(19))
-			_ = eZWtrMs
-			var uRrzLtORVIVwQ2tP tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(20))
-			_ = uRrzLtORVIVwQ2tP
-			var yuJpweijg8Up5Iw6VAvVzU6SQAxuv1fB7ZOFluTo tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(21))
-			_ = yuJpweijg8Up5Iw6VAvVzU6SQAxuv1fB7ZOFluTo
-			var ySGSSIA8lhpRSr6V7LETu tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(22))
-			_ = ySGSSIA8lhpRSr6V7LETu
-			var jmV8QIECpsPKuaEtaWTxmIBEE3sF7GViLzk9UNEHxcwZVSZ4YK8mGFo8nAIw5VSBWuGqNxJAhYjBHJjVXh9jtjaivOambONfmBXrN tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(23))
-			_ = jmV8QIECpsPKuaEtaWTxmIBEE3sF7GViLzk9UNEHxcwZVSZ4YK8mGFo8nAIw5VSBWuGqNxJAhYjBHJjVXh9jtjaivOambONfmBXrN
-			var qly79Eos4URs7r0MFKK5I64XBHIIsVWHORstUGTMlVrIKSrdg7KR9QuNJD3GXtocGJCqq0AelVmmwmGD3uaXYa7jjon8JRYrTRyvzj67v tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(24))
-			_ = qly79Eos4URs7r0MFKK5I64XBHIIsVWHORstUGTMlVrIKSrdg7KR9QuNJD3GXtocGJCqq0AelVmmwmGD3uaXYa7jjon8JRYrTRyvzj67v
-			var qqOmyvM5zemEp801ZVyS5M tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(25))
-			_ = qqOmyvM5zemEp801ZVyS5M
-			var h4WIh9btI8plOFX8POSDeBtflWUlVxE9uaiU79TZfL8xb2ixeZ1qtg5XtXsAaas6qJNkKzrYWjJ4 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(26))
-			_ = h4WIh9btI8plOFX8POSDeBtflWUlVxE9uaiU79TZfL8xb2ixeZ1qtg5XtXsAaas6qJNkKzrYWjJ4
-			var jDxrXDib2hUYGBM7ErKYkjD9Sq tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(27))
-			_ = jDxrXDib2hUYGBM7ErKYkjD9Sq
-			var mnSAQdexeMQlEYr9bK2kC6w65ZWTzyY9L0ObTq7IKhxqiN6sab tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(28))
-			_ = mnSAQdexeMQlEYr9bK2kC6w65ZWTzyY9L0ObTq7IKhxqiN6sab
-			var kyNlfXVsQKPwD0Dg4pK2lZw7PNMF5KxmuirB0F28x1ECx1ICFVLCVDovB6qgQLUiullYH tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(29))
-			_ = kyNlfXVsQKPwD0Dg4pK2lZw7PNMF5KxmuirB0F28x1ECx1ICFVLCVDovB6qgQLUiullYH
-			var nOFQkWKDGXA6fbA9RpVUyM4IiCYxbRctzZ0fmYT20z4EjBL4VWvKRKWuKzClFZ tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(30))
-			_ = nOFQkWKDGXA6fbA9RpVUyM4IiCYxbRctzZ0fmYT20z4EjBL4VWvKRKWuKzClFZ
-			var mCvINybh4uYZyCKtL256l77x6tm8afLqxrDjfTcO42kuUuaRvo1IEMwpuOYu8PRkMji tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(31))
-			_ = mCvINybh4uYZyCKtL256l77x6tm8afLqxrDjfTcO42kuUuaRvo1IEMwpuOYu8PRkMji
-			var cLLdBigq31Pp0lPiMRGcHj0x0sZnex4LLasTFBf9cbvy3TQCuY4xaOoZeFn7sU7FLjmdkGEvWs7AZbs14npozLQWbR34Gn3NgFD3 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(32))
-			_ = cLLdBigq31Pp0lPiMRGcHj0x0sZnex4LLasTFBf9cbvy3TQCuY4xaOoZeFn7sU7FLjmdkGEvWs7AZbs14npozLQWbR34Gn3NgFD3
-			var wiJdDTioo91pSKjIrblpq5p8tkUZeDmxNsqFc6SEcg6XdksLwFRXxTYkU7WoomcSR0d5Wa4QyMa8VPgUM6xiAeB tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(33))
-			_ = wiJdDTioo91pSKjIrblpq5p8tkUZeDmxNsqFc6SEcg6XdksLwFRXxTYkU7WoomcSR0d5Wa4QyMa8VPgUM6xiAeB
-			var pNu tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(34))
-			_ = pNu
-			var mcE05KKvCJ42P0CTgUjNbMZNetE3f0Ksq97aYRUdYY4jlIaVJsz3kF5OzMawj tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(35))
-			_ = mcE05KKvCJ42P0CTgUjNbMZNetE3f0Ksq97aYRUdYY4jlIaVJsz3kF5OzMawj
-			var fW1vtRiNt7NtFq33mpLErpMIJzCgtZuE0a9kVqaGy3oQWv9UefCASSLkbPRycJd0LeHYLfkzwoFCLl4P3fu2N4Evufw tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(36))
-			_ = fW1vtRiNt7NtFq33mpLErpMIJzCgtZuE0a9kVqaGy3oQWv9UefCASSLkbPRycJd0LeHYLfkzwoFCLl4P3fu2N4Evufw
-			var aauQUmIPywkxvMC4DLmTk2NzIiM8aw1A4pH8h0vEqrgNob tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(37))
-			_ = aauQUmIPywkxvMC4DLmTk2NzIiM8aw1A4pH8h0vEqrgNob
-			var pXXcnzldaGNBC3NDCu4D28mLWR5Xs4G7 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(38))
-			_ = pXXcnzldaGNBC3NDCu4D28mLWR5Xs4G7
-			var wMj8vHTd tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(39))
-			_ = wMj8vHTd
-			var bcE4M5BTm3OaDFWGT4Y7iZqww0lLfyxpZNP8KIC3WKegwPru885snHCtrcsMrhPWE tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(40))
-			_ = bcE4M5BTm3OaDFWGT4Y7iZqww0lLfyxpZNP8KIC3WKegwPru885snHCtrcsMrhPWE
-			var pjwP7Sgg70Uz3FisZjfmM1rEFIalBpJ3gCZTFwBwN8vbpEiETHXVwXjtYE tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(41))
-			_ = pjwP7Sgg70Uz3FisZjfmM1rEFIalBpJ3gCZTFwBwN8vbpEiETHXVwXjtYE
-			var yHPQCpTmARRYaB76vStJUvxpZKp5OaCKmfXOm1JK6TeyZnD0 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(42))
-			_ = yHPQCpTmARRYaB76vStJUvxpZKp5OaCKmfXOm1JK6TeyZnD0
-			var i2Xea4P8xwKsdTR0FZxYEEES5aY2OGiPauCMCAlvLeeZk1ve6ChQWbJYB12hhIqUzcninU2XOtAohqESZj2R tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(43))
-			_ = i2Xea4P8xwKsdTR0FZxYEEES5aY2OGiPauCMCAlvLeeZk1ve6ChQWbJYB12hhIqUzcninU2XOtAohqESZj2R
-			var iHj0i7nf5MdtS4CAsm5uG8lqVRm9zVsdKPxwDfdpGJ1IVNzBhR tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(44))
-			_ = iHj0i7nf5MdtS4CAsm5uG8lqVRm9zVsdKPxwDfdpGJ1IVNzBhR
-			var n9O4kXlPCgAg8JVyeqHzveK3joXUVhytJRlMgCSpc6Lg4CPl4XzT9H8 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(45))
-			_ = n9O4kXlPCgAg8JVyeqHzveK3joXUVhytJRlMgCSpc6Lg4CPl4XzT9H8
-			var wahlO2gFJWlqm1sQ5aZASuAcE6e8Ag tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(46))
-			_ = wahlO2gFJWlqm1sQ5aZASuAcE6e8Ag
-			var xmETexUCtvYL105o7DRTTx tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(47))
-			_ = xmETexUCtvYL105o7DRTTx
-			var lqk5gtZp tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(48))
-			_ = lqk5gtZp
-			var dp4mrNuv8js7RCMmbAqk2ZUvTOB78UvvVJMChY5MU tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(49))
-			_ = dp4mrNuv8js7RCMmbAqk2ZUvTOB78UvvVJMChY5MU
-			var a8XkCDA1O96c5XMRY35c8N2Er6Tx30wA tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(50))
-			_ = a8XkCDA1O96c5XMRY35c8N2Er6Tx30wA
-			var nreOTNKGKNLMNUUL6LOjS4STGJLiaLoNDO4 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(51))
-			_ = nreOTNKGKNLMNUUL6LOjS4STGJLiaLoNDO4
-			var lUdg86QAWNbJB9bUDSeWQ17gM5gwVvMsChU8V1d3JZl55wJrSLXLcro1cEgnHv9fPO6hsMvklL1EK9iAzevBHQwfnqDeg28EW4zm40tMayCouY1LL tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(52))
-			_ = lUdg86QAWNbJB9bUDSeWQ17gM5gwVvMsChU8V1d3JZl55wJrSLXLcro1cEgnHv9fPO6hsMvklL1EK9iAzevBHQwfnqDeg28EW4zm40tMayCouY1LL
-			var igUgakCNaa4rAGVRMhS9w8jNFhiRm7q4E tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(53))
-			_ = igUgakCNaa4rAGVRMhS9w8jNFhiRm7q4E
-			var qu35tFhq91sFYUvXnWM18tP8dC330nMkDSrmn6vEamBhvRCj0VMtqn5FMvgzT tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(54))
-			_ = qu35tFhq91sFYUvXnWM18tP8dC330nMkDSrmn6vEamBhvRCj0VMtqn5FMvgzT
-			var tvYQ1QGA9gFeujn5DKUvWWSpXfjAbj1054BIiZooEBHSAeIOKbe3Yhop9R8HQknOdogOPG34WFEqUicycwwoDVXQ tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(55))
-			_ = tvYQ1QGA9gFeujn5DKUvWWSpXfjAbj1054BIiZooEBHSAeIOKbe3Yhop9R8HQknOdogOPG34WFEqUicycwwoDVXQ
-			var ruS72EFiy5M2NRMqqgvSh6eACs7xK2TnRUDhOKkfYcNiiB51FMmIj2gm5LtkTt2MGXPF tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(56))
-			_ = ruS72EFiy5M2NRMqqgvSh6eACs7xK2TnRUDhOKkfYcNiiB51FMmIj2gm5LtkTt2MGXPF
-			var uNv0oI8pTmAIRVSFT9ytJsruawSJUp1VkzXo74wkWxxFnMntJkqkzJJ0FIeurArBlnqJ tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(57))
-			_ = uNv0oI8pTmAIRVSFT9ytJsruawSJUp1VkzXo74wkWxxFnMntJkqkzJJ0FIeurArBlnqJ
-			var rwJ44yOUg3oQVnRVl5Sa8fx30CL7m67M0k5dPs21aWKoJ13LkkNSFODJRGPBTCMPG9q4DjJOulCYXh5PBsPzuV6CjoTPUv5LxDc tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(58))
-			_ = rwJ44yOUg3oQVnRVl5Sa8fx30CL7m67M0k5dPs21aWKoJ13LkkNSFODJRGPBTCMPG9q4DjJOulCYXh5PBsPzuV6CjoTPUv5LxDc
-			var zhiH5ySYmhQGBpcQsoz6VCd8DOirOMOH7iAPYb tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(59))
-			_ = zhiH5ySYmhQGBpcQsoz6VCd8DOirOMOH7iAPYb
-			var xG1km3unFEVb4nqTdRDYaDgggBKmlJy4kz0tMqpWuSbkZ4RB8x4HjTh6esD5SUTxjlkowlQZFxgNha tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(60))
-			_ = xG1km3unFEVb4nqTdRDYaDgggBKmlJy4kz0tMqpWuSbkZ4RB8x4HjTh6esD5SUTxjlkowlQZFxgNha
-			var a9kpCMFZfRPSlNmQXnyd2bpq17xcM8 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(61))
-			_ = a9kpCMFZfRPSlNmQXnyd2bpq17xcM8
-			var t4e9AnPaR217FhJDE7uvFRZj6WDQzhi7xKcepRDofXRumk03F7KE2M7zzorxMDN9jv6ZGoYdX9Fwgxm tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(62))
-			_ = t4e9AnPaR217FhJDE7uvFRZj6WDQzhi7xKcepRDofXRumk03F7KE2M7zzorxMDN9jv6ZGoYdX9Fwgxm
-			var bcg8WuxRA2aqUz1lDOIfAppp7baNeEM tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(63))
-			_ = bcg8WuxRA2aqUz1lDOIfAppp7baNeEM
-			var hvG993zXD8x8QzLZaHRLlOorkxKejT tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(64))
-			_ = hvG993zXD8x8QzLZaHRLlOorkxKejT
-			var hIPCBKVnpjZ4I43tC03iT8NoZCPD4PzdrsTKF51bvFzOxabBL9N tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(65))
-			_ = hIPCBKVnpjZ4I43tC03iT8NoZCPD4PzdrsTKF51bvFzOxabBL9N
-			var u9o1zqPEAhnP4WxUGyvCVII6kfpnxvZFAt0Tjw5g9B9RusP3tgDzk2dH4Pan tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(66))
-			_ = u9o1zqPEAhnP4WxUGyvCVII6kfpnxvZFAt0Tjw5g9B9RusP3tgDzk2dH4Pan
-			var w2p4smZAJe tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(67))
-			_ = w2p4smZAJe
-			var f5EhlQOvdSn0NtQjC2EJSgHiuHehGqLxop6P4wpYVRRUBud2IbuG8ddefn519a4lPmxIW2SXheyDHjrB5m89zI2ncEN4mOSuCBOwYCc3D tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(68))
-			_ = f5EhlQOvdSn0NtQjC2EJSgHiuHehGqLxop6P4wpYVRRUBud2IbuG8ddefn519a4lPmxIW2SXheyDHjrB5m89zI2ncEN4mOSuCBOwYCc3D
-			var xmEcXy1rEr1Z0HfD8reE9XV8LQmuPspH4X4rOnKRzdwyxMv7VrKKdhEZYYIgbt7b1fRzG tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(69))
-			_ = xmEcXy1rEr1Z0HfD8reE9XV8LQmuPspH4X4rOnKRzdwyxMv7VrKKdhEZYYIgbt7b1fRzG
-			var vvXJ tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(70))
-			_ = vvXJ
-			var jbcl2U4KV0ogw2kwRdXxgvuNUnlksstw1u7xwgmz13ENpV9VLzMfIU1fF3rAKlts5i3AbSmdmUmL tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(71))
-			_ = jbcl2U4KV0ogw2kwRdXxgvuNUnlksstw1u7xwgmz13ENpV9VLzMfIU1fF3rAKlts5i3AbSmdmUmL
-			var wMFngDE8vsP3a8vG29U8dCN8ipjrV4EWrB tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(72))
-			_ = wMFngDE8vsP3a8vG29U8dCN8ipjrV4EWrB
-			var wUPBcuS8tlnXxSUjow7IaT82Q887516HmKudjqhV695R9axbknFQgGvAgZx63ztpCgaHla tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(73))
-			_ = wUPBcuS8tlnXxSUjow7IaT82Q887516HmKudjqhV695R9axbknFQgGvAgZx63ztpCgaHla
-			var c0 tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(74))
-			_ = c0
-			var t8hc3IByhWt3z0U3MKkSG tla.TLAValue = args2[0].ApplyFunction(tla.MakeTLANumber(75))
-			_ = t8hc3IByhWt3z0U3MKkSG
-			var unuNrbGOPSiztTLlBI5ADst7vZIrhy3Jt7FBHtIcnHOSfkeAvD1ERWGtg7A0a2TqvUMVJbLLc8EiiN8FknEdqwfEXuBjmE5q1DSgPD9BsfV2zhZd tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(1))
-			_ = unuNrbGOPSiztTLlBI5ADst7vZIrhy3Jt7FBHtIcnHOSfkeAvD1ERWGtg7A0a2TqvUMVJbLLc8EiiN8FknEdqwfEXuBjmE5q1DSgPD9BsfV2zhZd
-			var bpjkupxScuzMNi5RirnzOwja8Da3d0JnOQa26qdbA49yqwNwRhnEEizPTdSZPFwHTrElxuIoqZ tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(2))
-			_ = bpjkupxScuzMNi5RirnzOwja8Da3d0JnOQa26qdbA49yqwNwRhnEEizPTdSZPFwHTrElxuIoqZ
-			var fx7udD9tTCt70NsIvlUimAVfuglohMaDsc tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(3))
-			_ = fx7udD9tTCt70NsIvlUimAVfuglohMaDsc
-			var lIQ7TCDNgCpQAY6pFlFfJo1wiLZk0QyaJDoks8 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(4))
-			_ = lIQ7TCDNgCpQAY6pFlFfJo1wiLZk0QyaJDoks8
-			var n3JftUIqQ8s631NOXJge6qzVLgllarp tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(5))
-			_ = n3JftUIqQ8s631NOXJge6qzVLgllarp
-			var eiMinFn7LreZSWVyj tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(6))
-			_ = eiMinFn7LreZSWVyj
-			var hWX3HlghatRFZ9DdP2pQMNvQK8VyrTpvXJXwuT90JKhFGadSeU92rO4yj5FOijegVv3IO9DrHKSgR tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(7))
-			_ = hWX3HlghatRFZ9DdP2pQMNvQK8VyrTpvXJXwuT90JKhFGadSeU92rO4yj5FOijegVv3IO9DrHKSgR
-			var sS3sksPjRXiK2Q30jlYvsWiQDoWJVwdJJjdmCfuqqXZVUDm4ikbnwszGgUlowvcRe78IjXFHo tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(8))
-			_ = sS3sksPjRXiK2Q30jlYvsWiQDoWJVwdJJjdmCfuqqXZVUDm4ikbnwszGgUlowvcRe78IjXFHo
-			var tYYtXgvZLLHJYs0izWi0oTA5l tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(9))
-			_ = tYYtXgvZLLHJYs0izWi0oTA5l
-			var z tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(10))
-			_ = z
-			var qC52 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(11))
-			_ = qC52
-			var aXwO6VfcY5N5bWdGn tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(12))
-			_ = aXwO6VfcY5N5bWdGn
-			var bi7Kvr4HjzhgfKuwPeaKnYktTX75CRLBiPsLvefadFphH04xPp3tvPCKgTyeyrAkgqOVIul89GCB0i tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(13))
-			_ = bi7Kvr4HjzhgfKuwPeaKnYktTX75CRLBiPsLvefadFphH04xPp3tvPCKgTyeyrAkgqOVIul89GCB0i
-			var iqbuw5x8LIplCV6nALMePYwDu4ffV2NxjdBsbHh3I2MTz8RAtt7YRUSgm9oF9XUx0DVlOoIWTS0pYx3vytpYHikwNXH2UH2qzs7HtYmJKCh tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(14))
-			_ = iqbuw5x8LIplCV6nALMePYwDu4ffV2NxjdBsbHh3I2MTz8RAtt7YRUSgm9oF9XUx0DVlOoIWTS0pYx3vytpYHikwNXH2UH2qzs7HtYmJKCh
-			var vrv4ZHh7BEmju6zOLl9rkHfXJ9wVe8OhXSgCv0w4oUdXjeZtNNGGMQ1dSCzG3PxuV tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(15))
-			_ = vrv4ZHh7BEmju6zOLl9rkHfXJ9wVe8OhXSgCv0w4oUdXjeZtNNGGMQ1dSCzG3PxuV
-			var mm3XszPMlZybr5mValgDcTxawlzQAX53whkmCIiHwGXwyYhDRV0cFOJzjruJK4F6iVz5hOACxgLjT tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(16))
-			_ = mm3XszPMlZybr5mValgDcTxawlzQAX53whkmCIiHwGXwyYhDRV0cFOJzjruJK4F6iVz5hOACxgLjT
-			var qI2QLowp6Sd5YKuWk5ode5hR1T54kzSCrVr tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(17))
-			_ = qI2QLowp6Sd5YKuWk5ode5hR1T54kzSCrVr
-			var t tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(18))
-			_ = t
-			var ek5tO3xhSDsRSMuvEKYmLpemNj23mO9Rt7BIXPW9H2TwpdkFr6XQSnZ5ZQQY3HjYfLVXj9ONP8k3UT tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(19))
-			_ = ek5tO3xhSDsRSMuvEKYmLpemNj23mO9Rt7BIXPW9H2TwpdkFr6XQSnZ5ZQQY3HjYfLVXj9ONP8k3UT
-			var cry0M4HWueevVv tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(20))
-			_ = cry0M4HWueevVv
-			var g3mG9rA6Gvl6S3yXMfcGGiSJf9elIalibmIYEiS4TgZsBu7tOPKFPeExKgu tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(21))
-			_ = g3mG9rA6Gvl6S3yXMfcGGiSJf9elIalibmIYEiS4TgZsBu7tOPKFPeExKgu
-			var ekxkPGGtn5Ed4puZnFxyHN0 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(22))
-			_ = ekxkPGGtn5Ed4puZnFxyHN0
-			var slnKxnfTTp6VXEUpTrnLgMSUHqtzqQIRk3HH8p1sGLeupKa tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(23))
-			_ = slnKxnfTTp6VXEUpTrnLgMSUHqtzqQIRk3HH8p1sGLeupKa
-			var iwHlump7GkjI0F6qYema1yGoqxERkQ9G2oAEW2O6ZWxO9LVLH2PDIwQvobNNVaONkAKROBIStwT tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(24))
-			_ = iwHlump7GkjI0F6qYema1yGoqxERkQ9G2oAEW2O6ZWxO9LVLH2PDIwQvobNNVaONkAKROBIStwT
-			var g3ww3q2V2 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(25))
-			_ = g3ww3q2V2
-			var yEhz9OL6lgKR11i tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(26))
-			_ = yEhz9OL6lgKR11i
-			var dJAVvb3bV3rIWj1aB8OORjWxewXW0y2Luwe4nw4aiVj4gP7UWhpOrr5vIMqFI5Ommbc1QS06ib4QaAbNsIPh16 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(27))
-			_ = dJAVvb3bV3rIWj1aB8OORjWxewXW0y2Luwe4nw4aiVj4gP7UWhpOrr5vIMqFI5Ommbc1QS06ib4QaAbNsIPh16
-			var x8IcqczfKvtW5rxh75YEW2S8bSZGfeRNZVetgavPEjyGQoD2qNRiszM0lZr1zbWs tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(28))
-			_ = x8IcqczfKvtW5rxh75YEW2S8bSZGfeRNZVetgavPEjyGQoD2qNRiszM0lZr1zbWs
-			var zhKU4oWixtT3jMk1qtyDzoUx3Ft9UpZ8jCBXKER9yufV7AFaQJIe8pb62dJQ0RwyxBRcc5uiyaEmNc8BssF9XhVzygP1ZlX5Hkhe tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(29))
-			_ = zhKU4oWixtT3jMk1qtyDzoUx3Ft9UpZ8jCBXKER9yufV7AFaQJIe8pb62dJQ0RwyxBRcc5uiyaEmNc8BssF9XhVzygP1ZlX5Hkhe
-			var kMi3VGoX3z4gAzS45MOhhYgQGlwJAAGQgQgo7hNUV0Bv5bM6GAFDo197GhDPz34SrFkXStiv3uiti2mYlpL2QuVbpzNY4epDXaAz7G1cjXzLLaszic tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(30))
-			_ = kMi3VGoX3z4gAzS45MOhhYgQGlwJAAGQgQgo7hNUV0Bv5bM6GAFDo197GhDPz34SrFkXStiv3uiti2mYlpL2QuVbpzNY4epDXaAz7G1cjXzLLaszic
-			var xDRScvHqAJSzV tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(31))
-			_ = xDRScvHqAJSzV
-			var iT19uS3tHHhlJ4SkcxtQwGiGfiF7yRMxSYj60PtGa0kgMVnsUu7vLWxr9TmMaiU tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(32))
-			_ = iT19uS3tHHhlJ4SkcxtQwGiGfiF7yRMxSYj60PtGa0kgMVnsUu7vLWxr9TmMaiU
-			var jQobGKhyZJXpzLUcgFOd9V6gQBFfjXAv4ggRVNgJwoKYoDrq1QUsvwmHcrrad2KEuhF2v7DLwB0p8pTnHJoYs tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(33))
-			_ = jQobGKhyZJXpzLUcgFOd9V6gQBFfjXAv4ggRVNgJwoKYoDrq1QUsvwmHcrrad2KEuhF2v7DLwB0p8pTnHJoYs
-			var ruSfHcV8Sb74YTg1h5cNtrWODNXuRy240IlNoRebl2DEWnOU3To6BM0cHhrwNlVi84WCQ15CkyroKVLh8GvqS88VvmI6LDqK6N0gPBbLLX0rhjn tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(34))
-			_ = ruSfHcV8Sb74YTg1h5cNtrWODNXuRy240IlNoRebl2DEWnOU3To6BM0cHhrwNlVi84WCQ15CkyroKVLh8GvqS88VvmI6LDqK6N0gPBbLLX0rhjn
-			var xO56IiBDHy5gXSStUMMQ64fQuLP6DYyAgMRAByycLSOVXZi5EoSDYUJsMJqbraIFfIdq tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(35))
-			_ = xO56IiBDHy5gXSStUMMQ64fQuLP6DYyAgMRAByycLSOVXZi5EoSDYUJsMJqbraIFfIdq
-			var liFOYjgnVLS0wdizj5yCC6ot3O0MWApEie8i91sodBsJvLASWo4Lemb18ONKBm3Wx28FosBmKpvjY9z54OBN tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(36))
-			_ = liFOYjgnVLS0wdizj5yCC6ot3O0MWApEie8i91sodBsJvLASWo4Lemb18ONKBm3Wx28FosBmKpvjY9z54OBN
-			var oabuzz9SZfXKIEGx4XV87HNl0Jjv6lXqw6wHTbWFpHZZ tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(37))
-			_ = oabuzz9SZfXKIEGx4XV87HNl0Jjv6lXqw6wHTbWFpHZZ
-			var yw2tjhuJ2G3NwM5Ypw9dU tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(38))
-			_ = yw2tjhuJ2G3NwM5Ypw9dU
-			var wsRjPTyirR5IhGvsORmv7FazT8Ut01xRPR8X9sKGu7tuTiJ4MDeYp4tqLf1TZhbn2ce1W9iDxuBwhmZtNZ4RF8e5IUmDuymNlEZeLxWfPsjvkUwn48O tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(39))
-			_ = wsRjPTyirR5IhGvsORmv7FazT8Ut01xRPR8X9sKGu7tuTiJ4MDeYp4tqLf1TZhbn2ce1W9iDxuBwhmZtNZ4RF8e5IUmDuymNlEZeLxWfPsjvkUwn48O
-			var h0knYxytsXqdKWLilF9mBrov8eiRy57pJ5g871whGgAkdXS tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(40))
-			_ = h0knYxytsXqdKWLilF9mBrov8eiRy57pJ5g871whGgAkdXS
-			var mBhXFWOySq4Sm0yoQ0MNHruYG9QTyQA7kPwn6CDs3bhfoXEr5x2gEOCrDUPWSibHJDYV0Me3KTpw tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(41))
-			_ = mBhXFWOySq4Sm0yoQ0MNHruYG9QTyQA7kPwn6CDs3bhfoXEr5x2gEOCrDUPWSibHJDYV0Me3KTpw
-			var jHOH1KfVAo5pam2hUYMO6xHroHQI2IBioqHxLjnaRmIq5wC3PuZLrZo7nKwNjGZsdL0pBSgAR tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(42))
-			_ = jHOH1KfVAo5pam2hUYMO6xHroHQI2IBioqHxLjnaRmIq5wC3PuZLrZo7nKwNjGZsdL0pBSgAR
-			var zEPWTcdw5CzYp3kIKMljBhdAeCu5h8yC8lNy65MrYq9nrEzAUKCCZgfwxZvqZ0fBN7dsnF3ROy3obfqtfLrw4A823mtp1yNMXxT6ocw tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(43))
-			_ = zEPWTcdw5CzYp3kIKMljBhdAeCu5h8yC8lNy65MrYq9nrEzAUKCCZgfwxZvqZ0fBN7dsnF3ROy3obfqtfLrw4A823mtp1yNMXxT6ocw
-			var ueSzsg8ywWD tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(44))
-			_ = ueSzsg8ywWD
-			var rPeE2NqI65ohRShrtM8 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(45))
-			_ = rPeE2NqI65ohRShrtM8
-			var gxhnhtmakjWCoC1Ueq67yBpz1NuMcsNPKL8qlSthvuPRch1OJi tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(46))
-			_ = gxhnhtmakjWCoC1Ueq67yBpz1NuMcsNPKL8qlSthvuPRch1OJi
-			var fsOSeY9hUHs4xwfBMrpO tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(47))
-			_ = fsOSeY9hUHs4xwfBMrpO
-			var fRgcEqD8sqPjZeiml5ICpYee9cKy9S6t1n8E16Bwh7UdAxfxHbVciO0Y1UmTyiQqRlqpqhfDAijB2jClkHxllWpuvRxZEJ3kpKm tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(48))
-			_ = fRgcEqD8sqPjZeiml5ICpYee9cKy9S6t1n8E16Bwh7UdAxfxHbVciO0Y1UmTyiQqRlqpqhfDAijB2jClkHxllWpuvRxZEJ3kpKm
-			var rSJ16lwMX9kmuSs0eDJNVctg5oCOY82IaIe9VNIxjSRostTZ tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(49))
-			_ = rSJ16lwMX9kmuSs0eDJNVctg5oCOY82IaIe9VNIxjSRostTZ
-			var f6qttWxfqGAfa0hLBmI2adMaiH4qsx4IRBofFfVMcxRaiA7CtI6ggwIZElNAdj7nh7kNdhor0Mpn60DQiS97O545Xvbyh3JiEhUeDiZov48 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(50))
-			_ = f6qttWxfqGAfa0hLBmI2adMaiH4qsx4IRBofFfVMcxRaiA7CtI6ggwIZElNAdj7nh7kNdhor0Mpn60DQiS97O545Xvbyh3JiEhUeDiZov48
-			var muZL2HL32cNAk1pV1PhIO9 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(51))
-			_ = muZL2HL32cNAk1pV1PhIO9
-			var elXTyvCU4yRphjJR5i647PsGKn4axfqx4pNxHuHFs5DKpmyLUjbTKdZrkHzyoEvWyKww7MhOQq0ayr2R tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(52))
-			_ = elXTyvCU4yRphjJR5i647PsGKn4axfqx4pNxHuHFs5DKpmyLUjbTKdZrkHzyoEvWyKww7MhOQq0ayr2R
-			var glUfJbFh1nf tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(53))
-			_ = glUfJbFh1nf
-			var adlKQYD1o777vwl1triPE tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(54))
-			_ = adlKQYD1o777vwl1triPE
-			var gUu1cZQFCLww4BGv tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(55))
-			_ = gUu1cZQFCLww4BGv
-			var ml7J7gAGkUZLq tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(56))
-			_ = ml7J7gAGkUZLq
-			var aIYc7YAdPMPVzI1rmFcLVa96UBn3fk418rd0 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(57))
-			_ = aIYc7YAdPMPVzI1rmFcLVa96UBn3fk418rd0
-			var z7RQ20 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(58))
-			_ = z7RQ20
-			var boYPP6BaFugv3EAZD0mbVTcKGlQQiHGr6SDXIjX63FLPEjGfB5W0OahL1E0OqWkwBthvy709 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(59))
-			_ = boYPP6BaFugv3EAZD0mbVTcKGlQQiHGr6SDXIjX63FLPEjGfB5W0OahL1E0OqWkwBthvy709
-			var d45KC0cEQKyEPEmtfyRMJuDI7Uk5WQv5w0qMTPyu5Sm7RCvcj0tthCrAR5OXPyT11QITva4LkphPn tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(60))
-			_ = d45KC0cEQKyEPEmtfyRMJuDI7Uk5WQv5w0qMTPyu5Sm7RCvcj0tthCrAR5OXPyT11QITva4LkphPn
-			var e5wa8Lt9M7tsAcVO03SqV6FUwyZ tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(61))
-			_ = e5wa8Lt9M7tsAcVO03SqV6FUwyZ
-			var tvuxndBl8COUTxUtpJAvObaYDpKW5QsDZtg9ZwzR4aZSIPRAozgrEqunDv7lLYG5BE6ozDlLdc9L5xaZgTDLAFLxEBWJkCPXFTl8O2BVZgon1oQx2xrV tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(62))
-			_ = tvuxndBl8COUTxUtpJAvObaYDpKW5QsDZtg9ZwzR4aZSIPRAozgrEqunDv7lLYG5BE6ozDlLdc9L5xaZgTDLAFLxEBWJkCPXFTl8O2BVZgon1oQx2xrV
-			var t99FHST41usuOsf8c5SKjxZwmO5EvzMUGrGn29g2L8t2RW7mqHJiiUX54tc tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(63))
-			_ = t99FHST41usuOsf8c5SKjxZwmO5EvzMUGrGn29g2L8t2RW7mqHJiiUX54tc
-			var sb3pgyFeZtScwxNmuUvvkWeUETO5WF5p6YiOXLp3euiOn14O3kJZbijpMwqN75DRKk tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(64))
-			_ = sb3pgyFeZtScwxNmuUvvkWeUETO5WF5p6YiOXLp3euiOn14O3kJZbijpMwqN75DRKk
-			var oGhjM7Ek7rLGKCJsIJfxyLbFZxvFAZiiEQ451c94EqNBaZH3VaYUldakwLyst tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(65))
-			_ = oGhjM7Ek7rLGKCJsIJfxyLbFZxvFAZiiEQ451c94EqNBaZH3VaYUldakwLyst
-			var bwzgiEErLmS8X0QDSB1 tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(66))
-			_ = bwzgiEErLmS8X0QDSB1
-			var uCW4faqdue4pjr3FGVsXkbHnwTPtOeQzsCbxM29v7vE tla.TLAValue = args2[1].ApplyFunction(tla.MakeTLANumber(67))
-			_ = uCW4faqdue4pjr3FGVsXkbHnwTPtOeQzsCbxM29v7vE
-			return kKa0FOAnjnOrXmqpw5mzjiGjspYPvCI3puDlt1jTnz0hhp6eg73Xe9igS9tmIDWBiLcPUA9cZz9cSSqKDCG5DRShCWvfozxKiwTRbTHl93hm
-		}).AsBool()
 	})
 }
 func Test3(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLAChoose(tla.MakeTLASet(tla.MakeTLANumber(1), tla.MakeTLANumber(2), tla.MakeTLANumber(3)), func(element tla.TLAValue) bool {
-		var z0 tla.TLAValue = element
-		_ = z0
-		return tla.TLA_EqualsSymbol(z0, tla.MakeTLANumber(2)).AsBool()
+	return iface.MemoizeDefinition("exprtests.Test3", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLAChoose(tla.MakeTLASet(tla.MakeTLANumber(1), tla.MakeTLANumber(2), tla.MakeTLANumber(3)), func(element tla.TLAValue) bool {
+			var z0 tla.TLAValue = element
+			_ = z0
+			return tla.TLA_EqualsSymbol(z0, tla.MakeTLANumber(2)).AsBool()
+		})
 	})
 }
 
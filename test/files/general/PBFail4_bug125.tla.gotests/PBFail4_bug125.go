@@ -11,7 +11,9 @@ var _ = distsys.ErrContextClosed
 var _ = tla.TLAValue{} // same, for tla
 
 func NUM_NODES(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_CLIENTS")())
+	return iface.MemoizeDefinition("pbfail.NUM_NODES", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_CLIENTS")())
+	})
 }
 func CLIENT_SRC(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLANumber(1)
@@ -883,7 +885,7 @@ var jumpTable = distsys.MakeMPCalJumpTable(
 				if err != nil {
 					return err
 				}
-				tla.MakeTLATuple(tla.MakeTLAString("PUT RESP: "), toPrint).PCalPrint()
+				iface.Print(tla.MakeTLATuple(tla.MakeTLAString("PUT RESP: "), toPrint))
 				return iface.Goto("AClient.sndGetReq")
 			} else {
 				return iface.Goto("AClient.sndPutReq")
@@ -1076,7 +1078,7 @@ var jumpTable = distsys.MakeMPCalJumpTable(
 				if err != nil {
 					return err
 				}
-				tla.MakeTLATuple(tla.MakeTLAString("GET RESP: "), toPrint0).PCalPrint()
+				iface.Print(tla.MakeTLATuple(tla.MakeTLAString("GET RESP: "), toPrint0))
 				return iface.Goto("AClient.Done")
 			} else {
 				return iface.Goto("AClient.sndGetReq")
@@ -135,7 +135,7 @@ var jumpTable = distsys.MakeMPCalJumpTable(
 			if err != nil {
 				return err
 			}
-			toPrint.PCalPrint()
+			iface.Print(toPrint)
 			X0 := iface.ReadArchetypeResourceLocal("RecursiveProcRef.X")
 			return iface.TailCall("RecursiveProcRef", X0)
 		},
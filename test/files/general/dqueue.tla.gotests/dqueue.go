@@ -11,7 +11,9 @@ var _ = distsys.ErrContextClosed
 var _ = tla.TLAValue{} // same, for tla
 
 func NUM_NODES(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_PlusSymbol(iface.GetConstant("NUM_CONSUMERS")(), tla.MakeTLANumber(1))
+	return iface.MemoizeDefinition("dqueue.NUM_NODES", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_PlusSymbol(iface.GetConstant("NUM_CONSUMERS")(), tla.MakeTLANumber(1))
+	})
 }
 
 var procTable = distsys.MakeMPCalProcTable()
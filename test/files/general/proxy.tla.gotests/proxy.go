@@ -14,10 +14,14 @@ func FAIL(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLANumber(100)
 }
 func NUM_NODES(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_PlusSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_SERVERS")(), iface.GetConstant("NUM_CLIENTS")()), tla.MakeTLANumber(1))
+	return iface.MemoizeDefinition("proxy.NUM_NODES", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_PlusSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_SERVERS")(), iface.GetConstant("NUM_CLIENTS")()), tla.MakeTLANumber(1))
+	})
 }
 func ProxyID(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return NUM_NODES(iface)
+	return iface.MemoizeDefinition("proxy.ProxyID", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return NUM_NODES(iface)
+	})
 }
 func REQ_MSG_TYP(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLANumber(1)
@@ -32,16 +36,24 @@ func PROXY_RESP_MSG_TYP(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLANumber(4)
 }
 func NODE_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.MakeTLANumber(1), NUM_NODES(iface))
+	return iface.MemoizeDefinition("proxy.NODE_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.MakeTLANumber(1), NUM_NODES(iface))
+	})
 }
 func SERVER_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.MakeTLANumber(1), iface.GetConstant("NUM_SERVERS")())
+	return iface.MemoizeDefinition("proxy.SERVER_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.MakeTLANumber(1), iface.GetConstant("NUM_SERVERS")())
+	})
 }
 func CLIENT_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_SERVERS")(), tla.MakeTLANumber(1)), tla.TLA_PlusSymbol(iface.GetConstant("NUM_SERVERS")(), iface.GetConstant("NUM_CLIENTS")()))
+	return iface.MemoizeDefinition("proxy.CLIENT_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_SERVERS")(), tla.MakeTLANumber(1)), tla.TLA_PlusSymbol(iface.GetConstant("NUM_SERVERS")(), iface.GetConstant("NUM_CLIENTS")()))
+	})
 }
 func MSG_TYP_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.MakeTLASet(REQ_MSG_TYP(iface), RESP_MSG_TYP(iface), PROXY_REQ_MSG_TYP(iface), PROXY_RESP_MSG_TYP(iface))
+	return iface.MemoizeDefinition("proxy.MSG_TYP_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.MakeTLASet(REQ_MSG_TYP(iface), RESP_MSG_TYP(iface), PROXY_REQ_MSG_TYP(iface), PROXY_RESP_MSG_TYP(iface))
+	})
 }
 func MSG_ID_BOUND(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLANumber(2)
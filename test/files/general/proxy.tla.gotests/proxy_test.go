@@ -161,13 +161,7 @@ func TestProxy_AllServersRunning(t *testing.T) {
 		select {
 		case resp := <-outChan:
 			t.Log(resp)
-			val, ok := resp.AsFunction().Get(tla.MakeTLAString("body"))
-			if !ok {
-				t.Fatalf("response body not found")
-			}
-			if !val.(tla.TLAValue).Equal(tla.MakeTLANumber(1)) {
-				t.Fatalf("wrong response body, got %v, expected %v", val.(tla.TLAValue), tla.MakeTLANumber(1))
-			}
+			checkResponseBody(t, resp, tla.MakeTLANumber(1))
 		case <-time.After(testTimeout):
 			t.Fatal("timeout")
 		}
@@ -222,13 +216,7 @@ func TestProxy_SecondServerRunning(t *testing.T) {
 		select {
 		case resp := <-outChan:
 			t.Log(resp)
-			val, ok := resp.AsFunction().Get(tla.MakeTLAString("body"))
-			if !ok {
-				t.Fatalf("response body not found")
-			}
-			if !val.(tla.TLAValue).Equal(tla.MakeTLANumber(2)) {
-				t.Fatalf("wrong response body, got %v, expected %v", val.(tla.TLAValue), tla.MakeTLANumber(2))
-			}
+			checkResponseBody(t, resp, tla.MakeTLANumber(2))
 		case <-time.After(testTimeout):
 			t.Fatal("timeout")
 		}
@@ -276,13 +264,7 @@ func TestProxy_NoServerRunning(t *testing.T) {
 		select {
 		case resp := <-outChan:
 			t.Log(resp)
-			val, ok := resp.AsFunction().Get(tla.MakeTLAString("body"))
-			if !ok {
-				t.Fatalf("response body not found")
-			}
-			if !val.(tla.TLAValue).Equal(proxy.FAIL(constantsIFace)) {
-				t.Fatalf("wrong response body, got %v, expected %v", val.(tla.TLAValue), proxy.FAIL(constantsIFace))
-			}
+			checkResponseBody(t, resp, proxy.FAIL(constantsIFace))
 		case <-time.After(testTimeout):
 			t.Fatal("timeout")
 		}
@@ -339,13 +321,7 @@ func TestProxy_FirstServerCrashing(t *testing.T) {
 		select {
 		case resp := <-outChan:
 			t.Log(resp)
-			val, ok := resp.AsFunction().Get(tla.MakeTLAString("body"))
-			if !ok {
-				t.Fatalf("response body not found")
-			}
-			if !val.(tla.TLAValue).Equal(tla.MakeTLANumber(1)) {
-				t.Fatalf("wrong response body, got %v, expected %v", val.(tla.TLAValue), tla.MakeTLANumber(1))
-			}
+			checkResponseBody(t, resp, tla.MakeTLANumber(1))
 		case <-time.After(testTimeout):
 			t.Fatal("timeout")
 		}
@@ -362,15 +338,21 @@ func TestProxy_FirstServerCrashing(t *testing.T) {
 		select {
 		case resp := <-outChan:
 			t.Log(resp)
-			val, ok := resp.AsFunction().Get(tla.MakeTLAString("body"))
-			if !ok {
-				t.Fatalf("response body not found")
-			}
-			if !val.(tla.TLAValue).Equal(tla.MakeTLANumber(2)) {
-				t.Fatalf("wrong response body, got %v, expected %v", val.(tla.TLAValue), tla.MakeTLANumber(1))
-			}
+			checkResponseBody(t, resp, tla.MakeTLANumber(2))
 		case <-time.After(testTimeout):
 			t.Fatal("timeout")
 		}
 	}
 }
+
+// checkResponseBody fails the test unless resp has the expected body, and describes how they differ if not.
+func checkResponseBody(t *testing.T, resp, expected tla.TLAValue) {
+	t.Helper()
+	body, ok := resp.AsFunction().Get(tla.MakeTLAString("body"))
+	if !ok {
+		t.Fatalf("response body not found in %v", resp)
+	}
+	if diff := tla.Diff(body.(tla.TLAValue), expected); diff != nil {
+		t.Fatalf("wrong response body in %v, where a is the body and b the expected body:\n%v", resp, diff)
+	}
+}
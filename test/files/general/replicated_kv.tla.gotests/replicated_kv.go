@@ -11,7 +11,9 @@ var _ = distsys.ErrContextClosed
 var _ = tla.TLAValue{} // same, for tla
 
 func KeySpace(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.MakeTLASet(iface.GetConstant("GET_KEY")(), iface.GetConstant("PUT_KEY")())
+	return iface.MemoizeDefinition("replicatedkv.KeySpace", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.MakeTLASet(iface.GetConstant("GET_KEY")(), iface.GetConstant("PUT_KEY")())
+	})
 }
 func GET_ORDER(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLANumber(0)
@@ -26,25 +28,39 @@ func NULL_ORDER(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLANumber(3)
 }
 func GetSet(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_MinusSymbol(iface.GetConstant("NUM_CLIENTS")(), tla.MakeTLANumber(1))))
+	return iface.MemoizeDefinition("replicatedkv.GetSet", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_MinusSymbol(iface.GetConstant("NUM_CLIENTS")(), tla.MakeTLANumber(1))))
+	})
 }
 func PutSet(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_CLIENTS")()), tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_MinusSymbol(tla.TLA_AsteriskSymbol(tla.MakeTLANumber(2), iface.GetConstant("NUM_CLIENTS")()), tla.MakeTLANumber(1))))
+	return iface.MemoizeDefinition("replicatedkv.PutSet", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_CLIENTS")()), tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_MinusSymbol(tla.TLA_AsteriskSymbol(tla.MakeTLANumber(2), iface.GetConstant("NUM_CLIENTS")()), tla.MakeTLANumber(1))))
+	})
 }
 func DisconnectSet(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_AsteriskSymbol(tla.MakeTLANumber(2), iface.GetConstant("NUM_CLIENTS")())), tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_MinusSymbol(tla.TLA_AsteriskSymbol(tla.MakeTLANumber(3), iface.GetConstant("NUM_CLIENTS")()), tla.MakeTLANumber(1))))
+	return iface.MemoizeDefinition("replicatedkv.DisconnectSet", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_AsteriskSymbol(tla.MakeTLANumber(2), iface.GetConstant("NUM_CLIENTS")())), tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_MinusSymbol(tla.TLA_AsteriskSymbol(tla.MakeTLANumber(3), iface.GetConstant("NUM_CLIENTS")()), tla.MakeTLANumber(1))))
+	})
 }
 func NullSet(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_AsteriskSymbol(tla.MakeTLANumber(3), iface.GetConstant("NUM_CLIENTS")())), tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_MinusSymbol(tla.TLA_AsteriskSymbol(tla.MakeTLANumber(4), iface.GetConstant("NUM_CLIENTS")()), tla.MakeTLANumber(1))))
+	return iface.MemoizeDefinition("replicatedkv.NullSet", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_AsteriskSymbol(tla.MakeTLANumber(3), iface.GetConstant("NUM_CLIENTS")())), tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_MinusSymbol(tla.TLA_AsteriskSymbol(tla.MakeTLANumber(4), iface.GetConstant("NUM_CLIENTS")()), tla.MakeTLANumber(1))))
+	})
 }
 func NUM_NODES(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_CLIENTS")())
+	return iface.MemoizeDefinition("replicatedkv.NUM_NODES", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_CLIENTS")())
+	})
 }
 func ReplicaSet(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.MakeTLANumber(0), tla.TLA_MinusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.MakeTLANumber(1)))
+	return iface.MemoizeDefinition("replicatedkv.ReplicaSet", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.MakeTLANumber(0), tla.TLA_MinusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.MakeTLANumber(1)))
+	})
 }
 func ClientSet(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_MinusSymbol(NUM_NODES(iface), tla.MakeTLANumber(1)))
+	return iface.MemoizeDefinition("replicatedkv.ClientSet", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.TLA_MinusSymbol(NUM_NODES(iface), tla.MakeTLANumber(1)))
+	})
 }
 
 var procTable = distsys.MakeMPCalProcTable()
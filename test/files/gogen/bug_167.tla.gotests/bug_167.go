@@ -11,7 +11,9 @@ var _ = distsys.ErrContextClosed
 var _ = tla.TLAValue{} // same, for tla
 
 func NUM_NODES(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_PlusSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_PUT_CLIENTS")()), iface.GetConstant("NUM_GET_CLIENTS")())
+	return iface.MemoizeDefinition("pbkvs.NUM_NODES", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_PlusSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_PUT_CLIENTS")()), iface.GetConstant("NUM_GET_CLIENTS")())
+	})
 }
 func CLIENT_SRC(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLANumber(1)
@@ -47,8 +49,10 @@ func RESP_INDEX(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLANumber(2)
 }
 func ACK_MSG_BODY(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.MakeTLARecord([]tla.TLARecordField{
-		{tla.MakeTLAString("content"), tla.MakeTLAString("ack-body")},
+	return iface.MemoizeDefinition("pbkvs.ACK_MSG_BODY", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.MakeTLARecord([]tla.TLARecordField{
+			{tla.MakeTLAString("content"), tla.MakeTLAString("ack-body")},
+		})
 	})
 }
 func KEY1(iface distsys.ArchetypeInterface) tla.TLAValue {
@@ -58,25 +62,39 @@ func VALUE1(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLAString("VALUE1")
 }
 func NODE_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.MakeTLANumber(1), NUM_NODES(iface))
+	return iface.MemoizeDefinition("pbkvs.NODE_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.MakeTLANumber(1), NUM_NODES(iface))
+	})
 }
 func REPLICA_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.MakeTLANumber(1), iface.GetConstant("NUM_REPLICAS")())
+	return iface.MemoizeDefinition("pbkvs.REPLICA_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.MakeTLANumber(1), iface.GetConstant("NUM_REPLICAS")())
+	})
 }
 func PUT_CLIENT_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.MakeTLANumber(1)), tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_PUT_CLIENTS")()))
+	return iface.MemoizeDefinition("pbkvs.PUT_CLIENT_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), tla.MakeTLANumber(1)), tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_PUT_CLIENTS")()))
+	})
 }
 func GET_CLIENT_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_PUT_CLIENTS")()), tla.MakeTLANumber(1)), tla.TLA_PlusSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_PUT_CLIENTS")()), iface.GetConstant("NUM_GET_CLIENTS")()))
+	return iface.MemoizeDefinition("pbkvs.GET_CLIENT_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_DotDotSymbol(tla.TLA_PlusSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_PUT_CLIENTS")()), tla.MakeTLANumber(1)), tla.TLA_PlusSymbol(tla.TLA_PlusSymbol(iface.GetConstant("NUM_REPLICAS")(), iface.GetConstant("NUM_PUT_CLIENTS")()), iface.GetConstant("NUM_GET_CLIENTS")()))
+	})
 }
 func MSG_INDEX_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.MakeTLASet(REQ_INDEX(iface), RESP_INDEX(iface))
+	return iface.MemoizeDefinition("pbkvs.MSG_INDEX_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.MakeTLASet(REQ_INDEX(iface), RESP_INDEX(iface))
+	})
 }
 func KEY_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.MakeTLASet(KEY1(iface))
+	return iface.MemoizeDefinition("pbkvs.KEY_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.MakeTLASet(KEY1(iface))
+	})
 }
 func VALUE_SET(iface distsys.ArchetypeInterface) tla.TLAValue {
-	return tla.MakeTLASet(VALUE1(iface))
+	return iface.MemoizeDefinition("pbkvs.VALUE_SET", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.MakeTLASet(VALUE1(iface))
+	})
 }
 func NULL(iface distsys.ArchetypeInterface) tla.TLAValue {
 	return tla.MakeTLANumber(0)
@@ -0,0 +1,24 @@
+---- MODULE memoization ----
+EXTENDS Naturals, Sequences, TLC
+
+\* definitions referring only to constants are memoized per context, unless they refer to a constant operator,
+\* which need not be pure
+CONSTANT N
+CONSTANT NEXT_ID(_)
+
+(* --mpcal memoization {
+    define {
+        TWICE_N == N + N
+        FRESH_ID == NEXT_ID(N)
+    }
+
+    archetype AMemo(ref out) {
+    l1:
+        out := <<TWICE_N, FRESH_ID>>;
+    l2:
+        out := <<TWICE_N, FRESH_ID>>;
+    }
+} *)
+
+\* BEGIN TRANSLATION
+====
@@ -0,0 +1,7 @@
+module example.org/memoization
+
+go 1.14
+
+replace github.com/UBC-NSS/pgo/distsys => ../../../../distsys
+
+require github.com/UBC-NSS/pgo/distsys v0.0.0-00010101000000-000000000000
@@ -0,0 +1,74 @@
+package memoization
+
+import (
+	"fmt"
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+var _ = new(fmt.Stringer) // unconditionally prevent go compiler from reporting unused fmt import
+var _ = distsys.ErrContextClosed
+var _ = tla.TLAValue{} // same, for tla
+
+func TWICE_N(iface distsys.ArchetypeInterface) tla.TLAValue {
+	return iface.MemoizeDefinition("memoization.TWICE_N", func(iface distsys.ArchetypeInterface) tla.TLAValue {
+		return tla.TLA_PlusSymbol(iface.GetConstant("N")(), iface.GetConstant("N")())
+	})
+}
+func FRESH_ID(iface distsys.ArchetypeInterface) tla.TLAValue {
+	return iface.GetConstant("NEXT_ID")(iface.GetConstant("N")())
+}
+
+var procTable = distsys.MakeMPCalProcTable()
+
+var jumpTable = distsys.MakeMPCalJumpTable(
+	distsys.MPCalCriticalSection{
+		Name: "AMemo.l1",
+		Body: func(iface distsys.ArchetypeInterface) error {
+			var err error
+			_ = err
+			out, err := iface.RequireArchetypeResourceRef("AMemo.out")
+			if err != nil {
+				return err
+			}
+			err = iface.Write(out, []tla.TLAValue{}, tla.MakeTLATuple(TWICE_N(iface), FRESH_ID(iface)))
+			if err != nil {
+				return err
+			}
+			return iface.Goto("AMemo.l2")
+		},
+	},
+	distsys.MPCalCriticalSection{
+		Name: "AMemo.l2",
+		Body: func(iface distsys.ArchetypeInterface) error {
+			var err error
+			_ = err
+			out0, err := iface.RequireArchetypeResourceRef("AMemo.out")
+			if err != nil {
+				return err
+			}
+			err = iface.Write(out0, []tla.TLAValue{}, tla.MakeTLATuple(TWICE_N(iface), FRESH_ID(iface)))
+			if err != nil {
+				return err
+			}
+			return iface.Goto("AMemo.Done")
+		},
+	},
+	distsys.MPCalCriticalSection{
+		Name: "AMemo.Done",
+		Body: func(distsys.ArchetypeInterface) error {
+			return distsys.ErrDone
+		},
+	},
+)
+
+var AMemo = distsys.MPCalArchetype{
+	Name:              "AMemo",
+	Label:             "AMemo.l1",
+	RequiredRefParams: []string{"AMemo.out"},
+	RequiredValParams: []string{},
+	JumpTable:         jumpTable,
+	ProcTable:         procTable,
+	PreAmble: func(iface distsys.ArchetypeInterface) {
+	},
+}
@@ -0,0 +1,57 @@
+package memoization_test
+
+import (
+	"log"
+	"testing"
+
+	"example.org/memoization"
+	"github.com/UBC-NSS/pgo/distsys"
+	"github.com/UBC-NSS/pgo/distsys/resources"
+	"github.com/UBC-NSS/pgo/distsys/tla"
+)
+
+func TestMemoization(t *testing.T) {
+	nReads := 0
+	nextIDCalls := 0
+	outCh := make(chan tla.TLAValue, 2)
+	ctx := distsys.NewMPCalContext(tla.MakeTLAString("self"), memoization.AMemo,
+		distsys.DefineConstantOperator("N", func() tla.TLAValue {
+			nReads++
+			return tla.MakeTLANumber(3)
+		}),
+		distsys.DefineConstantOperator("NEXT_ID", func(n tla.TLAValue) tla.TLAValue {
+			nextIDCalls++
+			return tla.MakeTLANumber(n.AsNumber() * int32(nextIDCalls))
+		}),
+		distsys.EnsureArchetypeRefParam("out", resources.OutputChannelMaker(outCh)))
+	defer func() {
+		err := ctx.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}()
+
+	if err := ctx.Run(); err != nil {
+		t.Fatalf("non-nil error from AMemo archetype: %s", err)
+	}
+
+	// TWICE_N refers only to N, so it is computed once; FRESH_ID refers to the constant operator NEXT_ID, so it is
+	// computed on every evaluation
+	expected := []tla.TLAValue{
+		tla.MakeTLATuple(tla.MakeTLANumber(6), tla.MakeTLANumber(3)),
+		tla.MakeTLATuple(tla.MakeTLANumber(6), tla.MakeTLANumber(6)),
+	}
+	for _, expectedValue := range expected {
+		val := <-outCh
+		if !val.Equal(expectedValue) {
+			t.Fatalf("wrong value in the output channel, got %v, expected %v", val, expectedValue)
+		}
+	}
+	if nextIDCalls != 2 {
+		t.Fatalf("NEXT_ID was called %d times, expected once per evaluation of FRESH_ID", nextIDCalls)
+	}
+	// once for TWICE_N, which is then memoized, and once per evaluation of FRESH_ID
+	if nReads != 4 {
+		t.Fatalf("N was read %d times, expected 4", nReads)
+	}
+}